@@ -128,7 +128,7 @@ type BaseApp struct { // nolint: maligned
 
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
-	abciListeners []ABCIListener
+	abciListeners []StreamingService
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -151,6 +151,8 @@ func NewBaseApp(
 		fauxMerkleMode:  false,
 	}
 
+	app.cms.SetLogger(logger.With("module", "store"))
+
 	for _, option := range options {
 		option(app)
 	}
@@ -283,6 +285,12 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// CommitMultiStore returns the root multi-store of the BaseApp. It is used by
+// the commands operating on the state of a stopped node, e.g. rollback.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -304,6 +312,15 @@ func (app *BaseApp) init() error {
 				"state sync snapshot interval %v must be a multiple of pruning keep every interval %v",
 				app.snapshotInterval, pruningOpts.KeepEvery)
 		}
+		// the snapshots export every store, so the heights they are taken at
+		// must be kept by the stores overriding the pruning options too
+		for name, pruningOpts := range rms.GetPruningOverrides() {
+			if pruningOpts.KeepEvery > 0 && app.snapshotInterval%pruningOpts.KeepEvery != 0 {
+				return fmt.Errorf(
+					"state sync snapshot interval %v must be a multiple of pruning keep every interval %v of store %s",
+					app.snapshotInterval, pruningOpts.KeepEvery, name)
+			}
+		}
 	}
 
 	return nil
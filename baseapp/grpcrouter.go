@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
@@ -18,10 +19,23 @@ import (
 var protoCodec = encoding.GetCodec(proto.Name)
 
 // GRPCQueryRouter routes ABCI Query requests to GRPC handlers
+//
+// The responses of the queries it routes, either from ABCI queries or from the
+// gRPC server, report the height of the state they were run at: as the height
+// of the ABCI response, which client.Context sets in the
+// x-cosmos-block-height header of the gRPC-gateway and Go clients, or in that
+// header directly. Queries with a x-cosmos-min-block-height header above that
+// height fail with a FailedPrecondition error, checked by the gRPC server and
+// by client.Context for ABCI queries, which cannot carry the header.
 type GRPCQueryRouter struct {
 	routes            map[string]GRPCQueryHandler
 	interfaceRegistry codectypes.InterfaceRegistry
 	serviceData       []serviceData
+
+	// the interceptors wrapping the gRPC query handlers, both on the ABCI
+	// query path and on the gRPC server
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
 }
 
 // serviceData represents a gRPC service, along with its handler.
@@ -91,7 +105,7 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 					return codectypes.UnpackInterfaces(i, qrt.interfaceRegistry)
 				}
 				return nil
-			}, nil)
+			}, qrt.unaryInterceptor())
 			if err != nil {
 				return abci.ResponseQuery{}, err
 			}
@@ -102,9 +116,10 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 				return abci.ResponseQuery{}, err
 			}
 
-			// return the result bytes as the response value
+			// return the result bytes as the response value, at the height of
+			// the query context
 			return abci.ResponseQuery{
-				Height: req.Height,
+				Height: ctx.BlockHeight(),
 				Value:  resBytes,
 			}, nil
 		}
@@ -116,6 +131,48 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 	})
 }
 
+// GetServiceInfo returns the gRPC services registered on the router, keyed by
+// their full name, like the grpc.Server.GetServiceInfo method.
+func (qrt *GRPCQueryRouter) GetServiceInfo() map[string]grpc.ServiceInfo {
+	info := make(map[string]grpc.ServiceInfo, len(qrt.serviceData))
+	for _, data := range qrt.serviceData {
+		methods := make([]grpc.MethodInfo, 0, len(data.serviceDesc.Methods))
+		for _, method := range data.serviceDesc.Methods {
+			methods = append(methods, grpc.MethodInfo{Name: method.MethodName})
+		}
+
+		info[data.serviceDesc.ServiceName] = grpc.ServiceInfo{
+			Methods:  methods,
+			Metadata: data.serviceDesc.Metadata,
+		}
+	}
+
+	return info
+}
+
+// AddUnaryInterceptors adds interceptors wrapping the unary gRPC query
+// handlers, in the order they are called, both when they are routed from ABCI
+// queries and when they are served by the gRPC server.
+func (qrt *GRPCQueryRouter) AddUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) {
+	qrt.unaryInterceptors = append(qrt.unaryInterceptors, interceptors...)
+}
+
+// AddStreamInterceptors adds interceptors wrapping the streaming gRPC handlers
+// served by the gRPC server, in the order they are called.
+func (qrt *GRPCQueryRouter) AddStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) {
+	qrt.streamInterceptors = append(qrt.streamInterceptors, interceptors...)
+}
+
+// unaryInterceptor returns the chain of the unary interceptors of the router,
+// or nil if there are none.
+func (qrt *GRPCQueryRouter) unaryInterceptor() grpc.UnaryServerInterceptor {
+	if len(qrt.unaryInterceptors) == 0 {
+		return nil
+	}
+
+	return grpcmiddleware.ChainUnaryServer(qrt.unaryInterceptors...)
+}
+
 // SetInterfaceRegistry sets the interface registry for the router. This will
 // also register the interface reflection gRPC service.
 func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -50,6 +51,29 @@ func TestGRPCGatewayRouter(t *testing.T) {
 	require.Equal(t, spot, res3.HasAnimal.Animal.GetCachedValue())
 }
 
+func TestGRPCRouterInterceptors(t *testing.T) {
+	var calls []string
+	recorder := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name+" "+info.FullMethod)
+			return handler(ctx, req)
+		}
+	}
+
+	qr := baseapp.NewGRPCQueryRouter()
+	qr.AddUnaryInterceptors(recorder("first"), recorder("second"))
+	testdata.RegisterQueryServer(qr, testdata.QueryImpl{})
+	helper := &baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: qr,
+		Ctx:             sdk.Context{}.WithContext(context.Background()),
+	}
+
+	res, err := testdata.NewQueryClient(helper).Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, "hello", res.Message)
+	require.Equal(t, []string{"first /testdata.Query/Echo", "second /testdata.Query/Echo"}, calls)
+}
+
 func TestRegisterQueryServiceTwice(t *testing.T) {
 	// Setup baseapp.
 	db := dbm.NewMemDB()
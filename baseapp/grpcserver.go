@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
@@ -23,7 +24,11 @@ func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRou
 // RegisterGRPCServer registers gRPC services directly with the gRPC server.
 func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
-	// a new sdk.Context, and pass it into the query handler.
+	// a new sdk.Context, and pass it into the query handler. Every response,
+	// failed or not, carries the height of that context in its height header,
+	// and the queries whose context is below their minimum height header are
+	// rejected with a FailedPrecondition error, so that clients balancing their
+	// queries across nodes can detect stale reads.
 	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		// If there's some metadata in the context, retrieve it.
 		md, ok := metadata.FromIncomingContext(grpcCtx)
@@ -31,41 +36,49 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			return nil, status.Error(codes.Internal, "unable to retrieve metadata")
 		}
 
-		// Get height header from the request context, if present.
-		var height int64
-		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
-			height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
-			if err != nil {
-				return nil, sdkerrors.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
-			}
-			if err := checkNegativeHeight(height); err != nil {
-				return nil, err
-			}
+		// Get the height headers from the request context, if present.
+		height, err := parseHeightHeader(md, grpctypes.GRPCBlockHeightHeader)
+		if err != nil {
+			return nil, err
+		}
+		minHeight, err := parseHeightHeader(md, grpctypes.GRPCMinBlockHeightHeader)
+		if err != nil {
+			return nil, err
 		}
 
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.createQueryContext(height, false)
+		sdkCtx, err := app.CreateQueryContext(height, false)
 		if err != nil {
 			return nil, err
 		}
 
-		// Add relevant gRPC headers
-		if height == 0 {
-			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
-		}
-
 		// Attach the sdk.Context into the gRPC's context.Context.
 		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
 
-		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		// Add relevant gRPC headers: the height of the context is the one
+		// requested, or the latest one if none was.
+		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(sdkCtx.BlockHeight(), 10))
 		grpc.SetHeader(grpcCtx, md)
 
+		if err := grpctypes.CheckMinBlockHeight(sdkCtx.BlockHeight(), minHeight); err != nil {
+			return nil, err
+		}
+
 		return handler(grpcCtx, req)
 	}
 
+	// The interceptors of the app run after the recovery interceptor, so that
+	// their panics are recovered, and before the height interceptor, so that
+	// they see the requests it rejects.
+	unaryInterceptors := append([]grpc.UnaryServerInterceptor{grpcrecovery.UnaryServerInterceptor()}, app.grpcQueryRouter.unaryInterceptors...)
+	unaryInterceptor := grpcmiddleware.ChainUnaryServer(append(unaryInterceptors, interceptor)...)
+
+	var streamInterceptor grpc.StreamServerInterceptor
+	if len(app.grpcQueryRouter.streamInterceptors) > 0 {
+		streamInterceptor = grpcmiddleware.ChainStreamServer(app.grpcQueryRouter.streamInterceptors...)
+	}
+
 	// Loop through all services and methods, add the interceptor, and register
 	// the service.
 	for _, data := range app.GRPCQueryRouter().serviceData {
@@ -76,23 +89,64 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			methodHandler := method.Handler
 			newMethods[i] = grpc.MethodDesc{
 				MethodName: method.MethodName,
-				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
-					return methodHandler(srv, ctx, dec, grpcmiddleware.ChainUnaryServer(
-						grpcrecovery.UnaryServerInterceptor(),
-						interceptor,
-					))
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, serverInterceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+					// the interceptor of the gRPC server, such as its rate
+					// limiter, runs first
+					if serverInterceptor != nil {
+						return methodHandler(srv, ctx, dec, grpcmiddleware.ChainUnaryServer(serverInterceptor, unaryInterceptor))
+					}
+
+					return methodHandler(srv, ctx, dec, unaryInterceptor)
 				},
 			}
 		}
 
+		newStreams := desc.Streams
+		if streamInterceptor != nil {
+			newStreams = make([]grpc.StreamDesc, len(desc.Streams))
+			for i, stream := range desc.Streams {
+				stream := stream
+				info := &grpc.StreamServerInfo{
+					FullMethod:     fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName),
+					IsClientStream: stream.ClientStreams,
+					IsServerStream: stream.ServerStreams,
+				}
+				newStreams[i] = stream
+				newStreams[i].Handler = func(srv interface{}, ss grpc.ServerStream) error {
+					return streamInterceptor(srv, ss, info, stream.Handler)
+				}
+			}
+		}
+
 		newDesc := &grpc.ServiceDesc{
 			ServiceName: desc.ServiceName,
 			HandlerType: desc.HandlerType,
 			Methods:     newMethods,
-			Streams:     desc.Streams,
+			Streams:     newStreams,
 			Metadata:    desc.Metadata,
 		}
 
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// parseHeightHeader returns the height of the given header of the request
+// metadata, or 0 if it is not present.
+func parseHeightHeader(md metadata.MD, header string) (int64, error) {
+	values := md.Get(header)
+	if len(values) != 1 {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"Baseapp.RegisterGRPCServer: invalid height header %q: %v", header, err)
+	}
+	if err := checkNegativeHeight(height); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
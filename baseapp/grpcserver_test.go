@@ -0,0 +1,86 @@
+package baseapp_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+func TestGRPCServerHeightHeaders(t *testing.T) {
+	app := setupBaseApp(t, func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), testdata.QueryImpl{})
+	})
+
+	app.InitChain(abci.RequestInitChain{})
+	for i := 0; i < 3; i++ {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.Commit()
+	}
+	require.Equal(t, int64(3), app.LastBlockHeight())
+
+	srv := grpc.NewServer()
+	app.RegisterGRPCServer(srv)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(listener)
+	defer srv.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := testdata.NewQueryClient(conn)
+
+	testCases := []struct {
+		name      string
+		height    int64
+		minHeight int64
+		expHeight int64
+		expCode   codes.Code
+	}{
+		{"latest height", 0, 0, 3, codes.OK},
+		{"latest height, reached minimum height", 0, 3, 3, codes.OK},
+		// a node lagging behind the minimum height of the query
+		{"latest height below minimum height", 0, 4, 3, codes.FailedPrecondition},
+		{"pinned height", 1, 0, 1, codes.OK},
+		{"pinned height, reached minimum height", 2, 2, 2, codes.OK},
+		// the query context pinned at a height behind the minimum one, as the
+		// latest one of a lagging node
+		{"pinned height below minimum height", 1, 2, 1, codes.FailedPrecondition},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var pairs []string
+			if tc.height != 0 {
+				pairs = append(pairs, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(tc.height, 10))
+			}
+			if tc.minHeight != 0 {
+				pairs = append(pairs, grpctypes.GRPCMinBlockHeightHeader, strconv.FormatInt(tc.minHeight, 10))
+			}
+			ctx := metadata.AppendToOutgoingContext(context.Background(), pairs...)
+
+			var header metadata.MD
+			res, err := client.Echo(ctx, &testdata.EchoRequest{Message: "hello"}, grpc.Header(&header))
+			require.Equal(t, tc.expCode, status.Code(err), err)
+			if tc.expCode == codes.OK {
+				require.Equal(t, "hello", res.Message)
+			}
+
+			// the response, failed or not, carries the height of its query context
+			require.Equal(t, []string{strconv.FormatInt(tc.expHeight, 10)}, header.Get(grpctypes.GRPCBlockHeightHeader))
+		})
+	}
+}
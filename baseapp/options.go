@@ -5,6 +5,7 @@ import (
 	"io"
 
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
@@ -21,6 +22,30 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
 }
 
+// SetPruningOverrides sets the pruning options of the stores of the multistore
+// associated with the app, by store name, overriding the pruning option of the
+// multistore
+func SetPruningOverrides(overrides map[string]sdk.PruningOptions) func(*BaseApp) {
+	return func(bap *BaseApp) {
+		for name, opts := range overrides {
+			bap.cms.SetPruningOverride(name, opts)
+		}
+	}
+}
+
+// SetIAVLCacheSize sets the number of nodes kept in the node cache of each IAVL
+// store of the multistore associated with the app
+func SetIAVLCacheSize(size int) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.cms.SetIAVLCacheSize(size) }
+}
+
+// SetIAVLDisableFastNode disables the fast node index of the IAVL stores of the
+// multistore associated with the app, which are otherwise migrated to it when
+// they are loaded.
+func SetIAVLDisableFastNode(disable bool) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.cms.SetIAVLDisableFastNode(disable) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -79,6 +104,19 @@ func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
 }
 
+// SetGRPCUnaryInterceptors returns a BaseApp option function that adds
+// interceptors wrapping the unary gRPC queries, whether they are served by the
+// gRPC server or by ABCI queries, such as the ones of the gRPC gateway.
+func SetGRPCUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) func(*BaseApp) {
+	return func(app *BaseApp) { app.grpcQueryRouter.AddUnaryInterceptors(interceptors...) }
+}
+
+// SetGRPCStreamInterceptors returns a BaseApp option function that adds
+// interceptors wrapping the streaming gRPC services of the gRPC server.
+func SetGRPCStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) func(*BaseApp) {
+	return func(app *BaseApp) { app.grpcQueryRouter.AddStreamInterceptors(interceptors...) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -237,6 +275,6 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 		app.cms.AddListeners(key, lis)
 	}
 	// register the StreamingService within the BaseApp
-	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock, and Commit requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
 }
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"fmt"
 	"io"
 	"sync"
 
@@ -18,6 +19,9 @@ type ABCIListener interface {
 	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
 	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the steaming service with the latest Commit message,
+	// once the state changes of the block have been written to the listeners
+	ListenCommit(ctx types.Context, res abci.ResponseCommit) error
 }
 
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
@@ -28,6 +32,19 @@ type StreamingService interface {
 	Listeners() map[store.StoreKey][]store.WriteListener
 	// ABCIListener interface for hooking into the ABCI messages from inside the BaseApp
 	ABCIListener
+	// HaltAppOnDeliveryError returns true if the node must stop when the streaming service fails
+	// to deliver the data of a block, and false if the failures are only logged
+	HaltAppOnDeliveryError() bool
 	// Closer interface
 	io.Closer
 }
+
+// listenerFailed logs the error returned by a hook of a streaming service, and
+// panics, stopping the node at this block, if the service guarantees the
+// delivery of the data of the blocks.
+func (app *BaseApp) listenerFailed(listener StreamingService, hook string, height int64, err error) {
+	app.logger.Error(fmt.Sprintf("%s listening hook failed", hook), "height", height, "err", err)
+	if listener.HaltAppOnDeliveryError() {
+		panic(fmt.Errorf("%s listening hook failed at height %d: %w", hook, height, err))
+	}
+}
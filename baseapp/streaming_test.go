@@ -0,0 +1,179 @@
+package baseapp_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+var _ baseapp.StreamingService = &mockStreamingService{}
+
+// mockStreamingService keeps the state changes of each block in memory.
+type mockStreamingService struct {
+	listener   *storetypes.MemoryListener
+	blocks     [][]storetypes.StoreKVPair
+	deliverTxs int
+	commitErr  error
+	halt       bool
+}
+
+func newMockStreamingService() *mockStreamingService {
+	return &mockStreamingService{listener: storetypes.NewMemoryListener()}
+}
+
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{
+		capKey1: {m.listener},
+		capKey2: {m.listener},
+	}
+}
+
+func (m *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	m.deliverTxs++
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+func (m *mockStreamingService) ListenCommit(sdk.Context, abci.ResponseCommit) error {
+	m.blocks = append(m.blocks, m.listener.PopStateCache())
+	return m.commitErr
+}
+
+func (m *mockStreamingService) Stream(*sync.WaitGroup) error { return nil }
+func (m *mockStreamingService) HaltAppOnDeliveryError() bool { return m.halt }
+func (m *mockStreamingService) Close() error                 { return nil }
+
+// runStreamedBlocks runs blocks of txs, some of them failing, on a new app and
+// returns the state changes streamed for each block.
+func runStreamedBlocks(t *testing.T, nBlocks int) [][]storetypes.StoreKVPair {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	beginBlockKey := []byte("begin-block-key")
+	options := func(bapp *baseapp.BaseApp) {
+		legacyRouter := middleware.NewLegacyRouter()
+		legacyRouter.AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+		bapp.SetTxHandler(testTxHandler(
+			middleware.TxHandlerOptions{
+				LegacyRouter:     legacyRouter,
+				MsgServiceRouter: middleware.NewMsgServiceRouter(interfaceRegistry),
+			},
+			customHandlerTxTest(t, capKey1, anteKey),
+		))
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey2).Set(beginBlockKey, []byte(fmt.Sprint(req.Header.Height)))
+			return abci.ResponseBeginBlock{}
+		})
+	}
+	app := setupBaseApp(t, options)
+	streamingService := newMockStreamingService()
+	app.SetStreamingService(streamingService)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	// the ante handler increments its counter for every tx, the message handler
+	// for every successful one
+	anteCounter, msgCounter := int64(0), int64(0)
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: int64(blockN) + 1}})
+
+		for i := 0; i < 3; i++ {
+			tx := newTxCounter(anteCounter, msgCounter)
+			txBytes, err := cdc.Marshal(tx)
+			require.NoError(t, err)
+
+			// the writes of CheckTx are never streamed
+			res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+			require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+			resDeliver := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.True(t, resDeliver.IsOK(), fmt.Sprintf("%v", resDeliver))
+			anteCounter++
+			msgCounter++
+		}
+
+		// the message of a failing tx changes nothing
+		tx := newTxCounter(anteCounter, msgCounter)
+		tx.setFailOnHandler(true)
+		txBytes, err := cdc.Marshal(tx)
+		require.NoError(t, err)
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.False(t, res.IsOK())
+		anteCounter++
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	require.Equal(t, 4*nBlocks, streamingService.deliverTxs)
+	return streamingService.blocks
+}
+
+func TestStreamingServiceObservesWritesInCommitOrder(t *testing.T) {
+	nBlocks := 3
+	blocks := runStreamedBlocks(t, nBlocks)
+	require.Len(t, blocks, nBlocks)
+
+	// each block streams its committed state changes once, store by store in
+	// the order of their names and key by key in each store
+	for blockN, kvPairs := range blocks {
+		require.Equal(t, []storetypes.StoreKVPair{
+			{StoreKey: capKey1.Name(), Key: []byte("ante-key"), Value: varintBytes(int64(blockN+1) * 4)},
+			{StoreKey: capKey1.Name(), Key: []byte("deliver-key"), Value: varintBytes(int64(blockN+1) * 3)},
+			{StoreKey: capKey2.Name(), Key: []byte("begin-block-key"), Value: []byte(fmt.Sprint(blockN + 1))},
+		}, kvPairs, "block %d", blockN+1)
+	}
+
+	// and the same blocks stream the same state changes
+	for i := 0; i < 3; i++ {
+		require.Equal(t, blocks, runStreamedBlocks(t, nBlocks))
+	}
+}
+
+func TestStreamingServiceHaltAppOnDeliveryError(t *testing.T) {
+	for _, halt := range []bool{false, true} {
+		app := setupBaseApp(t)
+		streamingService := newMockStreamingService()
+		streamingService.commitErr = errors.New("disk full")
+		streamingService.halt = halt
+		app.SetStreamingService(streamingService)
+		app.InitChain(abci.RequestInitChain{})
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+		app.EndBlock(abci.RequestEndBlock{})
+
+		if halt {
+			// a guaranteed delivery stops the node at the failing block
+			require.PanicsWithError(t, "Commit listening hook failed at height 1: disk full", func() { app.Commit() })
+		} else {
+			// while the failures of a fire-and-forget delivery are only logged
+			require.NotPanics(t, func() { app.Commit() })
+			require.Equal(t, int64(1), app.LastBlockHeight())
+		}
+	}
+}
+
+func varintBytes(i int64) []byte {
+	bz := make([]byte, 8)
+	n := binary.PutVarint(bz, i)
+	return bz[:n]
+}
@@ -52,13 +52,6 @@ func (app *BaseApp) TxDecoder(txBytes []byte) (sdk.Tx, error) {
 	return app.txDecoder(txBytes)
 }
 
-// CreateQueryContext calls app's createQueryContext.
-//
-// This method is only accessible in baseapp tests.
-func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, error) {
-	return app.createQueryContext(height, prove)
-}
-
 // MinGasPrices returns minGasPrices.
 //
 // This method is only accessible in baseapp tests.
@@ -0,0 +1,129 @@
+// Package cli provides the output formatters shared by the commands of the CLI.
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the ANSI escape sequences colorizing the pretty output on a terminal
+const (
+	colorReset = "\x1b[0m"
+	colorBold  = "\x1b[1m"
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorCyan  = "\x1b[36m"
+)
+
+// PrintTxResponse prints res to the output of the client context with
+// FormatTxResponse if its output format is pretty, colorized if the output is
+// a terminal, and as YAML or JSON with PrintProto otherwise.
+func PrintTxResponse(clientCtx client.Context, res *sdk.TxResponse) error {
+	if clientCtx.OutputFormat != flags.OutputFormatPretty {
+		return clientCtx.PrintProto(res)
+	}
+
+	out := clientCtx.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	var buf bytes.Buffer
+	FormatTxResponse(&buf, res, input.IsTerminal(out))
+
+	return clientCtx.PrintBytes(buf.Bytes())
+}
+
+// FormatTxResponse writes a concise view of res to w: a summary line with its
+// code, hash, gas and height, the log of its error if it failed, then the
+// events emitted by each of its messages, one line per event with its
+// attributes as key=value pairs. The summary and the attribute keys are
+// colorized if color is true.
+func FormatTxResponse(w io.Writer, res *sdk.TxResponse, color bool) {
+	paint := func(s string, codes ...string) string {
+		if !color {
+			return s
+		}
+
+		return strings.Join(codes, "") + s + colorReset
+	}
+
+	status, code := paint("Success", colorBold, colorGreen), strconv.FormatUint(uint64(res.Code), 10)
+	if res.Code != 0 {
+		status = paint("Failed", colorBold, colorRed)
+		if res.Codespace != "" {
+			code = fmt.Sprintf("%s (%s)", code, res.Codespace)
+		}
+	}
+
+	fmt.Fprintf(w, "%s  code %s  txhash %s  gas %d/%d", status, code, res.TxHash, res.GasUsed, res.GasWanted)
+	if res.Height != 0 {
+		fmt.Fprintf(w, "  height %d", res.Height)
+	}
+	fmt.Fprintln(w)
+
+	if res.Code != 0 && res.RawLog != "" {
+		fmt.Fprintf(w, "  %s\n", paint(res.RawLog, colorRed))
+	}
+
+	for _, log := range res.Logs {
+		fmt.Fprintf(w, "Message %d", log.MsgIndex)
+		if action := messageAction(log.Events); action != "" {
+			fmt.Fprintf(w, ": %s", action)
+		}
+		fmt.Fprintln(w)
+
+		typeWidth := 0
+		for _, event := range log.Events {
+			if len(event.Type) > typeWidth {
+				typeWidth = len(event.Type)
+			}
+		}
+
+		for _, event := range log.Events {
+			attributes := make([]string, len(event.Attributes))
+			for i, attribute := range event.Attributes {
+				attributes[i] = paint(attribute.Key, colorCyan) + "=" + formatAttributeValue(attribute.Value)
+			}
+
+			fmt.Fprintf(w, "  %-*s  %s\n", typeWidth, event.Type, strings.Join(attributes, " "))
+		}
+	}
+}
+
+// messageAction returns the action attribute of the message event of the
+// events of a message, its type URL, or an empty string if there is none.
+func messageAction(events sdk.StringEvents) string {
+	for _, event := range events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+
+		for _, attribute := range event.Attributes {
+			if attribute.Key == sdk.AttributeKeyAction {
+				return attribute.Value
+			}
+		}
+	}
+
+	return ""
+}
+
+// formatAttributeValue quotes the attribute values that are empty or hold
+// spaces, so that the key=value pairs of an event remain unambiguous.
+func formatAttributeValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " \t\n\"") {
+		return strconv.Quote(value)
+	}
+
+	return value
+}
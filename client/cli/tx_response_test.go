@@ -0,0 +1,144 @@
+package cli_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/cli"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of the tx response output")
+
+// requireGoldenOutput checks the output against the golden file of testdata,
+// which is written instead with the -update-golden flag.
+func requireGoldenOutput(t *testing.T, goldenFile string, out []byte) {
+	goldenFile = filepath.Join("testdata", goldenFile)
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(goldenFile, out, 0o600))
+	}
+
+	expected, err := os.ReadFile(goldenFile)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(out))
+}
+
+const (
+	testSender    = "cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc"
+	testRecipient = "cosmos1w3h47h6lta047h6lta047h6lta047h6l620gq6"
+	testValidator = "cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j"
+)
+
+func sendEvents(amount string) sdk.StringEvents {
+	return sdk.StringEvents{
+		{Type: "coin_received", Attributes: []sdk.Attribute{{Key: "receiver", Value: testRecipient}, {Key: "amount", Value: amount}}},
+		{Type: "coin_spent", Attributes: []sdk.Attribute{{Key: "spender", Value: testSender}, {Key: "amount", Value: amount}}},
+		{Type: "message", Attributes: []sdk.Attribute{{Key: "action", Value: "/cosmos.bank.v1beta1.MsgSend"}, {Key: "sender", Value: testSender}, {Key: "module", Value: "bank"}}},
+		{Type: "transfer", Attributes: []sdk.Attribute{{Key: "recipient", Value: testRecipient}, {Key: "sender", Value: testSender}, {Key: "amount", Value: amount}}},
+	}
+}
+
+func TestFormatTxResponse(t *testing.T) {
+	testCases := []struct {
+		name   string
+		res    *sdk.TxResponse
+		golden string
+	}{
+		{
+			"success",
+			&sdk.TxResponse{
+				Height:    42,
+				TxHash:    "6A2D7C3B9F0E1A4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0F1A2B3C4D5E6F7A8B",
+				GasWanted: 200000,
+				GasUsed:   61234,
+				Logs:      sdk.ABCIMessageLogs{{MsgIndex: 0, Events: sendEvents("10stake")}},
+			},
+			"tx_response_success.golden",
+		},
+		{
+			"failure",
+			&sdk.TxResponse{
+				Height:    43,
+				TxHash:    "0F1E2D3C4B5A69788796A5B4C3D2E1F00F1E2D3C4B5A69788796A5B4C3D2E1F0",
+				Codespace: "sdk",
+				Code:      5,
+				RawLog:    "failed to execute message; message index: 0: 5stake is smaller than 10stake: insufficient funds",
+				GasWanted: 200000,
+				GasUsed:   48211,
+			},
+			"tx_response_failure.golden",
+		},
+		{
+			"multiple messages",
+			&sdk.TxResponse{
+				Height:    44,
+				TxHash:    "A8B7C6D5E4F3A2B1C0D9E8F7A6B5C4D3E2F1A0B9C8D7E6F5A4B3C2D1E0F9A8B7",
+				GasWanted: 400000,
+				GasUsed:   215008,
+				Logs: sdk.ABCIMessageLogs{
+					{MsgIndex: 0, Events: sendEvents("10stake")},
+					{MsgIndex: 1, Events: sdk.StringEvents{
+						{Type: "delegate", Attributes: []sdk.Attribute{{Key: "validator", Value: testValidator}, {Key: "amount", Value: "100stake"}, {Key: "new_shares", Value: "100.000000000000000000"}}},
+						{Type: "message", Attributes: []sdk.Attribute{{Key: "action", Value: "/cosmos.staking.v1beta1.MsgDelegate"}, {Key: "module", Value: "staking"}, {Key: "sender", Value: testSender}}},
+						{Type: "withdraw_rewards", Attributes: []sdk.Attribute{{Key: "amount", Value: ""}, {Key: "validator", Value: testValidator}}},
+					}},
+				},
+			},
+			"tx_response_multi_msg.golden",
+		},
+		{
+			"sync broadcast",
+			&sdk.TxResponse{
+				TxHash: "6A2D7C3B9F0E1A4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0F1A2B3C4D5E6F7A8B",
+				RawLog: "[]",
+			},
+			"tx_response_sync.golden",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			cli.FormatTxResponse(&buf, tc.res, false)
+			requireGoldenOutput(t, tc.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestFormatTxResponseColor(t *testing.T) {
+	res := &sdk.TxResponse{Code: 5, Codespace: "sdk", RawLog: "insufficient funds", Logs: sdk.ABCIMessageLogs{{Events: sendEvents("10stake")}}}
+
+	var buf bytes.Buffer
+	cli.FormatTxResponse(&buf, res, true)
+	require.Contains(t, buf.String(), "\x1b[1m\x1b[31mFailed\x1b[0m  code 5 (sdk)")
+	require.Contains(t, buf.String(), "\x1b[36mreceiver\x1b[0m="+testRecipient)
+}
+
+func TestPrintTxResponse(t *testing.T) {
+	res := &sdk.TxResponse{Height: 42, TxHash: "AB", GasWanted: 200000, GasUsed: 61234}
+	clientCtx := client.Context{}.WithCodec(simapp.MakeTestEncodingConfig().Codec)
+
+	testCases := []struct {
+		format   string
+		expected string
+	}{
+		{flags.OutputFormatPretty, "Success  code 0  txhash AB  gas 61234/200000  height 42\n"},
+		{"json", `{"height":"42","txhash":"AB","codespace":"","code":0,"data":"","raw_log":"","logs":[],"info":"","gas_wanted":"200000","gas_used":"61234","tx":null,"timestamp":""}` + "\n"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, cli.PrintTxResponse(clientCtx.WithOutput(&buf).WithOutputFormat(tc.format), res))
+			require.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -120,9 +120,21 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithChainID(chainID)
 	}
 
-	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
+	if clientCtx.KeyringPassFile == "" || flagSet.Changed(flags.FlagKeyringPassFile) {
+		passFile, _ := flagSet.GetString(flags.FlagKeyringPassFile)
+		clientCtx = clientCtx.WithKeyringPassFile(passFile)
+	}
+
+	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) ||
+		flagSet.Changed(flags.FlagKeyringPassFile) || flagSet.Changed(flags.FlagKeyringDir) {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
+		// A keyring built beforehand, e.g. from client.toml, keeps its backend
+		// when only its directory or passphrase file is changed by a flag.
+		if clientCtx.Keyring != nil && !flagSet.Changed(flags.FlagKeyringBackend) && clientCtx.Keyring.Backend() != "" {
+			keyringBackend = clientCtx.Keyring.Backend()
+		}
+
 		if keyringBackend != "" {
 			kr, err := NewKeyringFromBackend(clientCtx, keyringBackend)
 			if err != nil {
@@ -190,6 +202,12 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		return clientCtx, err
 	}
 
+	// the tx responses are printed in the pretty format by default when the
+	// output is a terminal, and as YAML or JSON when it is piped
+	if !flagSet.Changed(cli.OutputFlag) && input.IsTerminal(clientCtx.getOutput()) {
+		clientCtx = clientCtx.WithOutputFormat(flags.OutputFormatPretty)
+	}
+
 	if !clientCtx.GenerateOnly || flagSet.Changed(flags.FlagGenerateOnly) {
 		genOnly, _ := flagSet.GetBool(flags.FlagGenerateOnly)
 		clientCtx = clientCtx.WithGenerateOnly(genOnly)
@@ -215,11 +233,61 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithSkipConfirmation(skipConfirm)
 	}
 
+	if !clientCtx.RetryOnSequenceMismatch || flagSet.Changed(flags.FlagRetryOnSequenceMismatch) {
+		retry, _ := flagSet.GetBool(flags.FlagRetryOnSequenceMismatch)
+		clientCtx = clientCtx.WithRetryOnSequenceMismatch(retry)
+	}
+
+	if !clientCtx.ExactDenoms || flagSet.Changed(flags.FlagExactDenoms) {
+		exactDenoms, _ := flagSet.GetBool(flags.FlagExactDenoms)
+		clientCtx = clientCtx.WithExactDenoms(exactDenoms)
+	}
+
+	if !clientCtx.Wait || flagSet.Changed(flags.FlagWait) {
+		wait, _ := flagSet.GetBool(flags.FlagWait)
+		clientCtx = clientCtx.WithWait(wait)
+	}
+
+	if clientCtx.WaitTimeout == 0 || flagSet.Changed(flags.FlagWaitTimeout) {
+		waitTimeout, _ := flagSet.GetDuration(flags.FlagWaitTimeout)
+		clientCtx = clientCtx.WithWaitTimeout(waitTimeout)
+	}
+
 	if clientCtx.SignModeStr == "" || flagSet.Changed(flags.FlagSignMode) {
 		signModeStr, _ := flagSet.GetString(flags.FlagSignMode)
 		clientCtx = clientCtx.WithSignModeStr(signModeStr)
 	}
 
+	if clientCtx.GasStr == "" || flagSet.Changed(flags.FlagGas) {
+		gasStr, _ := flagSet.GetString(flags.FlagGas)
+		clientCtx = clientCtx.WithGasStr(gasStr)
+	}
+
+	if clientCtx.GasAdjustment == 0 || flagSet.Changed(flags.FlagGasAdjustment) {
+		gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
+		clientCtx = clientCtx.WithGasAdjustment(gasAdj)
+	}
+
+	// fees and gas prices are exclusive: the ones set by a flag replace the
+	// other ones pre-populated, e.g. from the client config
+	if flagSet.Changed(flags.FlagFees) && !flagSet.Changed(flags.FlagGasPrices) {
+		clientCtx = clientCtx.WithGasPricesStr("")
+	}
+
+	if flagSet.Changed(flags.FlagGasPrices) && !flagSet.Changed(flags.FlagFees) {
+		clientCtx = clientCtx.WithFeesStr("")
+	}
+
+	if clientCtx.FeesStr == "" || flagSet.Changed(flags.FlagFees) {
+		feesStr, _ := flagSet.GetString(flags.FlagFees)
+		clientCtx = clientCtx.WithFeesStr(feesStr)
+	}
+
+	if clientCtx.GasPricesStr == "" || flagSet.Changed(flags.FlagGasPrices) {
+		gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
+		clientCtx = clientCtx.WithGasPricesStr(gasPricesStr)
+	}
+
 	if clientCtx.FeeGranter == nil || flagSet.Changed(flags.FlagFeeAccount) {
 		granter, _ := flagSet.GetString(flags.FlagFeeAccount)
 
@@ -235,20 +303,14 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 
 	if clientCtx.From == "" || flagSet.Changed(flags.FlagFrom) {
 		from, _ := flagSet.GetString(flags.FlagFrom)
-		fromAddr, fromName, keyType, err := GetFromFields(clientCtx.Keyring, from, clientCtx.GenerateOnly)
+		fromAddr, fromName, _, err := GetFromFields(clientCtx.Keyring, from, clientCtx.GenerateOnly)
 		if err != nil {
 			return clientCtx, err
 		}
 
+		// The sign mode of a ledger key is resolved when signing, as
+		// SIGN_MODE_DIRECT depends on the version of the Ledger app.
 		clientCtx = clientCtx.WithFrom(from).WithFromAddress(fromAddr).WithFromName(fromName)
-
-		// If the `from` signer account is a ledger key, we need to use
-		// SIGN_MODE_AMINO_JSON, because ledger doesn't support proto yet.
-		// ref: https://github.com/cosmos/cosmos-sdk/issues/8109
-		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON {
-			fmt.Println("Default sign-mode 'direct' not supported by Ledger, using sign-mode 'amino-json'.")
-			clientCtx = clientCtx.WithSignModeStr(flags.SignModeLegacyAminoJSON)
-		}
 	}
 
 	return clientCtx, nil
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strconv"
 
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Cmd returns a CLI command to interactively create an application CLI
@@ -22,6 +24,9 @@ func Cmd() *cobra.Command {
 		RunE:  runConfigCmd,
 		Args:  cobra.RangeArgs(0, 2),
 	}
+
+	cmd.AddCommand(chainCmd())
+
 	return cmd
 }
 
@@ -52,12 +57,26 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			cmd.Println(conf.ChainID)
 		case flags.FlagKeyringBackend:
 			cmd.Println(conf.KeyringBackend)
+		case flags.FlagKeyringDir:
+			cmd.Println(conf.KeyringDir)
 		case tmcli.OutputFlag:
 			cmd.Println(conf.Output)
 		case flags.FlagNode:
 			cmd.Println(conf.Node)
 		case flags.FlagBroadcastMode:
 			cmd.Println(conf.BroadcastMode)
+		case flags.FlagGas:
+			cmd.Println(conf.Gas)
+		case flags.FlagGasAdjustment:
+			cmd.Println(conf.GasAdjustment)
+		case flags.FlagGasPrices:
+			cmd.Println(conf.GasPrices)
+		case flags.FlagFees:
+			cmd.Println(conf.Fees)
+		case flags.FlagSignMode:
+			cmd.Println(conf.SignMode)
+		case flags.FlagRetryOnSequenceMismatch:
+			cmd.Println(conf.RetryOnSequenceMismatch)
 		default:
 			err := errUnknownConfigKey(key)
 			return fmt.Errorf("couldn't get the value for the key: %v, error:  %v", key, err)
@@ -72,12 +91,52 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			conf.SetChainID(value)
 		case flags.FlagKeyringBackend:
 			conf.SetKeyringBackend(value)
+		case flags.FlagKeyringDir:
+			conf.SetKeyringDir(value)
 		case tmcli.OutputFlag:
 			conf.SetOutput(value)
 		case flags.FlagNode:
 			conf.SetNode(value)
 		case flags.FlagBroadcastMode:
 			conf.SetBroadcastMode(value)
+		case flags.FlagGas:
+			if _, err := flags.ParseGasSetting(value); err != nil {
+				return fmt.Errorf("invalid value for %s: %v", key, err)
+			}
+			conf.SetGas(value)
+		case flags.FlagGasAdjustment:
+			gasAdj, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %v", key, err)
+			}
+			conf.SetGasAdjustment(gasAdj)
+		case flags.FlagGasPrices:
+			if _, err := sdk.ParseDecCoins(value); err != nil {
+				return fmt.Errorf("invalid value for %s: %v", key, err)
+			}
+			if value != "" && conf.Fees != "" {
+				return fmt.Errorf("cannot set both %s and %s", flags.FlagGasPrices, flags.FlagFees)
+			}
+			conf.SetGasPrices(value)
+		case flags.FlagFees:
+			if _, err := sdk.ParseCoinsNormalized(value); err != nil {
+				return fmt.Errorf("invalid value for %s: %v", key, err)
+			}
+			if value != "" && conf.GasPrices != "" {
+				return fmt.Errorf("cannot set both %s and %s", flags.FlagFees, flags.FlagGasPrices)
+			}
+			conf.SetFees(value)
+		case flags.FlagSignMode:
+			if value != "" && value != flags.SignModeDirect && value != flags.SignModeLegacyAminoJSON {
+				return fmt.Errorf("invalid value for %s: %q, expected %s or %s", key, value, flags.SignModeDirect, flags.SignModeLegacyAminoJSON)
+			}
+			conf.SetSignMode(value)
+		case flags.FlagRetryOnSequenceMismatch:
+			retry, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %v", key, err)
+			}
+			conf.SetRetryOnSequenceMismatch(retry)
 		default:
 			return errUnknownConfigKey(key)
 		}
@@ -6,28 +6,49 @@ import (
 	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
 // Default constants
 const (
 	chainID        = ""
 	keyringBackend = "os"
+	keyringDir     = ""
 	output         = "text"
 	node           = "tcp://localhost:26657"
 	broadcastMode  = "sync"
+	gas            = ""
+	gasAdjustment  = flags.DefaultGasAdjustment
+	gasPrices      = ""
+	fees           = ""
+	signMode       = ""
+
+	retryOnSequenceMismatch = false
 )
 
 type ClientConfig struct {
-	ChainID        string `mapstructure:"chain-id" json:"chain-id"`
-	KeyringBackend string `mapstructure:"keyring-backend" json:"keyring-backend"`
-	Output         string `mapstructure:"output" json:"output"`
-	Node           string `mapstructure:"node" json:"node"`
-	BroadcastMode  string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	ChainID        string  `mapstructure:"chain-id" json:"chain-id"`
+	KeyringBackend string  `mapstructure:"keyring-backend" json:"keyring-backend"`
+	KeyringDir     string  `mapstructure:"keyring-dir" json:"keyring-dir"`
+	Output         string  `mapstructure:"output" json:"output"`
+	Node           string  `mapstructure:"node" json:"node"`
+	BroadcastMode  string  `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	Gas            string  `mapstructure:"gas" json:"gas"`
+	GasAdjustment  float64 `mapstructure:"gas-adjustment" json:"gas-adjustment"`
+	GasPrices      string  `mapstructure:"gas-prices" json:"gas-prices"`
+	Fees           string  `mapstructure:"fees" json:"fees"`
+	SignMode       string  `mapstructure:"sign-mode" json:"sign-mode"`
+
+	RetryOnSequenceMismatch bool `mapstructure:"retry-on-sequence-mismatch" json:"retry-on-sequence-mismatch"`
 }
 
 // defaultClientConfig returns the reference to ClientConfig with default values.
 func defaultClientConfig() *ClientConfig {
-	return &ClientConfig{chainID, keyringBackend, output, node, broadcastMode}
+	return &ClientConfig{
+		chainID, keyringBackend, keyringDir, output, node, broadcastMode,
+		gas, gasAdjustment, gasPrices, fees, signMode,
+		retryOnSequenceMismatch,
+	}
 }
 
 func (c *ClientConfig) SetChainID(chainID string) {
@@ -38,6 +59,10 @@ func (c *ClientConfig) SetKeyringBackend(keyringBackend string) {
 	c.KeyringBackend = keyringBackend
 }
 
+func (c *ClientConfig) SetKeyringDir(keyringDir string) {
+	c.KeyringDir = keyringDir
+}
+
 func (c *ClientConfig) SetOutput(output string) {
 	c.Output = output
 }
@@ -50,6 +75,30 @@ func (c *ClientConfig) SetBroadcastMode(broadcastMode string) {
 	c.BroadcastMode = broadcastMode
 }
 
+func (c *ClientConfig) SetGas(gas string) {
+	c.Gas = gas
+}
+
+func (c *ClientConfig) SetGasAdjustment(gasAdjustment float64) {
+	c.GasAdjustment = gasAdjustment
+}
+
+func (c *ClientConfig) SetGasPrices(gasPrices string) {
+	c.GasPrices = gasPrices
+}
+
+func (c *ClientConfig) SetFees(fees string) {
+	c.Fees = fees
+}
+
+func (c *ClientConfig) SetSignMode(signMode string) {
+	c.SignMode = signMode
+}
+
+func (c *ClientConfig) SetRetryOnSequenceMismatch(retry bool) {
+	c.RetryOnSequenceMismatch = retry
+}
+
 // ReadFromClientConfig reads values from client.toml file and updates them in client Context
 func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	configPath := filepath.Join(ctx.HomeDir, "config")
@@ -71,10 +120,21 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get client config: %v", err)
 	}
+	// the keyring directory falls back to the home directory if omitted
+	keyringDir := conf.KeyringDir
+	if keyringDir == "" {
+		keyringDir = ctx.HomeDir
+	}
+
 	// we need to update KeyringDir field on Client Context first cause it is used in NewKeyringFromBackend
 	ctx = ctx.WithOutputFormat(conf.Output).
 		WithChainID(conf.ChainID).
-		WithKeyringDir(ctx.HomeDir)
+		WithKeyringDir(keyringDir).
+		WithGasStr(conf.Gas).
+		WithGasAdjustment(conf.GasAdjustment).
+		WithGasPricesStr(conf.GasPrices).
+		WithFeesStr(conf.Fees).
+		WithSignModeStr(conf.SignMode)
 
 	keyring, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
 	if err != nil {
@@ -91,7 +151,8 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 
 	ctx = ctx.WithNodeURI(conf.Node).
 		WithClient(client).
-		WithBroadcastMode(conf.BroadcastMode)
+		WithBroadcastMode(conf.BroadcastMode).
+		WithRetryOnSequenceMismatch(conf.RetryOnSequenceMismatch)
 
 	return ctx, nil
 }
@@ -7,12 +7,19 @@ import (
 	"os"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 )
 
@@ -105,3 +112,191 @@ func TestConfigCmdEnvFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigCmdRetryOnSequenceMismatch(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t, "")
+	defer cleanup()
+	require.False(t, clientCtx.RetryOnSequenceMismatch)
+
+	cmd := config.Cmd()
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagRetryOnSequenceMismatch, "maybe"})
+	require.Error(t, err)
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagRetryOnSequenceMismatch, "true"})
+	require.NoError(t, err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagRetryOnSequenceMismatch})
+	require.NoError(t, err)
+	require.Equal(t, "true\n", out.String())
+
+	clientCtx, err = config.ReadFromClientConfig(clientCtx)
+	require.NoError(t, err)
+	require.True(t, clientCtx.RetryOnSequenceMismatch)
+}
+
+// readTxFactory returns the client context and the tx factory of a tx command
+// run with the given flags.
+func readTxFactory(t *testing.T, clientCtx client.Context, args ...string) (client.Context, tx.Factory) {
+	var (
+		txCtx client.Context
+		txf   tx.Factory
+	)
+	cmd := &cobra.Command{
+		Use: "tx",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var err error
+			txCtx, err = client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf = tx.NewFactoryCLI(txCtx, cmd.Flags())
+			return nil
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+	require.NoError(t, err)
+
+	return txCtx, txf
+}
+
+func TestConfigTxDefaultsPrecedence(t *testing.T) {
+	testCases := []struct {
+		key         string
+		configValue string
+		flagValue   string
+		value       func(client.Context, tx.Factory) interface{}
+		expDefault  interface{}
+		expConfig   interface{}
+		expFlag     interface{}
+	}{
+		{
+			flags.FlagGas, "300000", "auto",
+			func(_ client.Context, txf tx.Factory) interface{} { return fmt.Sprint(txf.Gas(), txf.SimulateAndExecute()) },
+			fmt.Sprint(flags.DefaultGasLimit, false), "300000 false", "0 true",
+		},
+		{
+			flags.FlagGasAdjustment, "1.4", "1.5",
+			func(_ client.Context, txf tx.Factory) interface{} { return txf.GasAdjustment() },
+			flags.DefaultGasAdjustment, 1.4, 1.5,
+		},
+		{
+			flags.FlagGasPrices, "0.025stake", "0.05stake",
+			func(_ client.Context, txf tx.Factory) interface{} { return txf.GasPrices().String() },
+			"", "0.025000000000000000stake", "0.050000000000000000stake",
+		},
+		{
+			flags.FlagFees, "10stake", "20stake",
+			func(_ client.Context, txf tx.Factory) interface{} { return txf.Fees().String() },
+			"", "10stake", "20stake",
+		},
+		{
+			flags.FlagSignMode, flags.SignModeLegacyAminoJSON, flags.SignModeDirect,
+			func(_ client.Context, txf tx.Factory) interface{} { return txf.SignMode() },
+			signingtypes.SignMode_SIGN_MODE_UNSPECIFIED, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingtypes.SignMode_SIGN_MODE_DIRECT,
+		},
+		{
+			flags.FlagBroadcastMode, flags.BroadcastBlock, flags.BroadcastAsync,
+			func(clientCtx client.Context, _ tx.Factory) interface{} { return clientCtx.BroadcastMode },
+			flags.BroadcastSync, flags.BroadcastBlock, flags.BroadcastAsync,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.key, func(t *testing.T) {
+			clientCtx, cleanup := initClientContext(t, "")
+			defer cleanup()
+
+			// default
+			require.Equal(t, tc.expDefault, tc.value(readTxFactory(t, clientCtx)))
+
+			// config
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{tc.key, tc.configValue})
+			require.NoError(t, err)
+			clientCtx, err = config.ReadFromClientConfig(clientCtx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expConfig, tc.value(readTxFactory(t, clientCtx)))
+
+			// flag
+			require.Equal(t, tc.expFlag, tc.value(readTxFactory(t, clientCtx, fmt.Sprintf("--%s=%s", tc.key, tc.flagValue))))
+		})
+	}
+}
+
+func TestConfigKeyringDirPrecedence(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t, "")
+	defer cleanup()
+	clientCtx = clientCtx.WithCodec(simapp.MakeTestEncodingConfig().Codec)
+
+	// newKey adds a key to a test keyring in dir.
+	newKey := func(dir, name string) {
+		kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, dir, nil, clientCtx.Codec)
+		require.NoError(t, err)
+		_, _, err = kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+	}
+	// requireKeys checks the keyring of the context has only the key name.
+	requireKeys := func(txCtx client.Context, name string) {
+		require.Equal(t, keyring.BackendTest, txCtx.Keyring.Backend())
+		records, err := txCtx.Keyring.List()
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, name, records[0].Name)
+	}
+
+	configDir, flagDir := t.TempDir(), t.TempDir()
+	newKey(clientCtx.HomeDir, "home")
+	newKey(configDir, "config")
+	newKey(flagDir, "flag")
+
+	// default
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagKeyringBackend, keyring.BackendTest})
+	require.NoError(t, err)
+	clientCtx, err = config.ReadFromClientConfig(clientCtx)
+	require.NoError(t, err)
+	txCtx, _ := readTxFactory(t, clientCtx)
+	require.Equal(t, clientCtx.HomeDir, txCtx.KeyringDir)
+	requireKeys(txCtx, "home")
+
+	// config
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagKeyringDir, configDir})
+	require.NoError(t, err)
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagKeyringDir})
+	require.NoError(t, err)
+	require.Equal(t, configDir+"\n", out.String())
+	clientCtx, err = config.ReadFromClientConfig(clientCtx)
+	require.NoError(t, err)
+	txCtx, _ = readTxFactory(t, clientCtx)
+	require.Equal(t, configDir, txCtx.KeyringDir)
+	requireKeys(txCtx, "config")
+
+	// flag, the keyring keeps the backend of the config
+	txCtx, _ = readTxFactory(t, clientCtx, fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, flagDir))
+	require.Equal(t, flagDir, txCtx.KeyringDir)
+	requireKeys(txCtx, "flag")
+}
+
+func TestConfigFeesAndGasPrices(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t, "")
+	defer cleanup()
+
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagGasPrices, "0.025stake"})
+	require.NoError(t, err)
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagFees, "10stake"})
+	require.EqualError(t, err, "cannot set both fees and gas-prices")
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagGasAdjustment, "high"})
+	require.Error(t, err)
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagSignMode, "textual"})
+	require.Error(t, err)
+
+	clientCtx, err = config.ReadFromClientConfig(clientCtx)
+	require.NoError(t, err)
+
+	// the fees of a flag replace the gas prices of the config
+	_, txf := readTxFactory(t, clientCtx, fmt.Sprintf("--%s=%s", flags.FlagFees, "20stake"))
+	require.Equal(t, "20stake", txf.Fees().String())
+	require.True(t, txf.GasPrices().IsZero())
+}
@@ -0,0 +1,309 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultChainRegistryURL is the base URL of the chain registry the chain
+// entries are fetched from, laid out as <url>/<chain name>/chain.json and
+// <url>/<chain name>/assetlist.json.
+const DefaultChainRegistryURL = "https://raw.githubusercontent.com/cosmos/chain-registry/master"
+
+const (
+	flagRegistryURL = "registry-url"
+
+	registryFetchTimeout = 10 * time.Second
+	rpcProbeTimeout      = 5 * time.Second
+)
+
+// chainNameRegexp matches the names of the chains of the registry, which are
+// the names of their directories.
+var chainNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// chainRegistryEntry is the part of the chain.json file of a chain registry
+// used to configure the CLI.
+type chainRegistryEntry struct {
+	ChainName string       `json:"chain_name"`
+	ChainID   string       `json:"chain_id"`
+	Fees      registryFees `json:"fees"`
+	APIs      registryAPIs `json:"apis"`
+}
+
+type registryFees struct {
+	FeeTokens []registryFeeToken `json:"fee_tokens"`
+}
+
+type registryFeeToken struct {
+	Denom            string  `json:"denom"`
+	FixedMinGasPrice float64 `json:"fixed_min_gas_price"`
+	LowGasPrice      float64 `json:"low_gas_price"`
+	AverageGasPrice  float64 `json:"average_gas_price"`
+	HighGasPrice     float64 `json:"high_gas_price"`
+}
+
+type registryAPIs struct {
+	RPC []registryEndpoint `json:"rpc"`
+}
+
+type registryEndpoint struct {
+	Address  string `json:"address"`
+	Provider string `json:"provider"`
+}
+
+// registryAssetList is the part of the assetlist.json file of a chain registry
+// used to validate the fee denom of a chain.
+type registryAssetList struct {
+	ChainName string          `json:"chain_name"`
+	Assets    []registryAsset `json:"assets"`
+}
+
+type registryAsset struct {
+	Base string `json:"base"`
+}
+
+// chainCmd returns the config chain command, which sets the chain ID, the node
+// and the gas prices of client.toml from the entry of a chain registry.
+func chainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain <name>",
+		Short: "Set the chain-id, node and gas-prices of the CLI configuration from a chain registry",
+		Long: `Fetch the chain.json and assetlist.json entries of the chain of the given name from
+a chain registry, and set the chain-id, node and gas-prices of the CLI configuration from it.
+The node is the first RPC endpoint of the entry that answers a status query with the chain ID
+of the entry, and the gas prices are the average gas price of its first fee token, or else its
+low or fixed minimum gas price. The fees of the configuration are cleared, as they are exclusive
+with the gas prices. The configuration is left untouched if any of these steps fails.
+
+$ <appd> config chain osmosis
+$ <appd> config chain mychain --registry-url https://example.com/chain-registry
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runChainCmd,
+	}
+
+	cmd.Flags().String(flagRegistryURL, DefaultChainRegistryURL, "The base URL of the chain registry")
+	cmd.Flags().Bool(flags.FlagOffline, false, "Offline mode, in which the chain registry cannot be fetched")
+
+	return cmd
+}
+
+func runChainCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	configPath := filepath.Join(clientCtx.HomeDir, "config")
+	name := args[0]
+
+	if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+		return errors.New("cannot fetch the chain registry in offline mode")
+	}
+	if !chainNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid chain name %q", name)
+	}
+
+	conf, err := getClientConfig(configPath, clientCtx.Viper)
+	if err != nil {
+		return fmt.Errorf("couldn't get client config: %v", err)
+	}
+
+	registryURL, _ := cmd.Flags().GetString(flagRegistryURL)
+	registryURL = strings.TrimSuffix(registryURL, "/")
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var entry chainRegistryEntry
+	found, err := fetchRegistryFile(ctx, fmt.Sprintf("%s/%s/chain.json", registryURL, name), &entry)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("chain %s not found in the chain registry %s", name, registryURL)
+	}
+
+	var assetList registryAssetList
+	hasAssetList, err := fetchRegistryFile(ctx, fmt.Sprintf("%s/%s/assetlist.json", registryURL, name), &assetList)
+	if err != nil {
+		return err
+	}
+
+	var assets *registryAssetList
+	if hasAssetList {
+		assets = &assetList
+	}
+
+	gasPrices, err := validateChainRegistryEntry(name, entry, assets)
+	if err != nil {
+		return fmt.Errorf("invalid chain registry entry of %s: %w", name, err)
+	}
+
+	node, err := probeRPCEndpoints(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	conf.SetChainID(entry.ChainID)
+	conf.SetNode(node)
+	conf.SetGasPrices(gasPrices)
+	conf.SetFees("")
+
+	confFile := filepath.Join(configPath, "client.toml")
+	if err := writeConfigToFile(confFile, conf); err != nil {
+		return fmt.Errorf("could not write client config to the file: %v", err)
+	}
+
+	cmd.Printf("%s = %s\n", flags.FlagChainID, conf.ChainID)
+	cmd.Printf("%s = %s\n", flags.FlagNode, conf.Node)
+	cmd.Printf("%s = %s\n", flags.FlagGasPrices, conf.GasPrices)
+
+	return nil
+}
+
+// fetchRegistryFile fetches the JSON file of a chain registry at url into v.
+// It returns false if the file does not exist.
+func fetchRegistryFile(ctx context.Context, url string, v interface{}) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, registryFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, fmt.Errorf("invalid chain registry url %s: %w", url, err)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return false, nil
+	case res.StatusCode != http.StatusOK:
+		return false, fmt.Errorf("failed to fetch %s: %s", url, res.Status)
+	}
+
+	bz, err := io.ReadAll(res.Body)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	if err := json.Unmarshal(bz, v); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", url, err)
+	}
+
+	return true, nil
+}
+
+// validateChainRegistryEntry validates the entry of the chain of the given
+// name, and its asset list if any, and returns the gas prices of its first fee
+// token, empty if it has none or if they are zero.
+func validateChainRegistryEntry(name string, entry chainRegistryEntry, assets *registryAssetList) (string, error) {
+	if entry.ChainName != name {
+		return "", fmt.Errorf("the entry is for the chain %q", entry.ChainName)
+	}
+	if strings.TrimSpace(entry.ChainID) == "" {
+		return "", errors.New("no chain ID")
+	}
+	if len(entry.APIs.RPC) == 0 {
+		return "", errors.New("no RPC endpoint")
+	}
+
+	if len(entry.Fees.FeeTokens) == 0 {
+		return "", nil
+	}
+
+	feeToken := entry.Fees.FeeTokens[0]
+	if err := sdk.ValidateDenom(feeToken.Denom); err != nil {
+		return "", fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if assets != nil && !assets.hasBase(feeToken.Denom) {
+		return "", fmt.Errorf("fee denom %s is not in the asset list", feeToken.Denom)
+	}
+
+	gasPrice := feeToken.AverageGasPrice
+	if gasPrice == 0 {
+		gasPrice = feeToken.LowGasPrice
+	}
+	if gasPrice == 0 {
+		gasPrice = feeToken.FixedMinGasPrice
+	}
+	if gasPrice < 0 {
+		return "", fmt.Errorf("negative gas price of %s", feeToken.Denom)
+	}
+	if gasPrice == 0 {
+		return "", nil
+	}
+
+	gasPrices, err := sdk.ParseDecCoins(strconv.FormatFloat(gasPrice, 'f', -1, 64) + feeToken.Denom)
+	if err != nil {
+		return "", fmt.Errorf("invalid gas price of %s: %w", feeToken.Denom, err)
+	}
+
+	return gasPrices.String(), nil
+}
+
+// hasBase returns true if the asset list has an asset of the base denom.
+func (l registryAssetList) hasBase(denom string) bool {
+	for _, asset := range l.Assets {
+		if asset.Base == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// probeRPCEndpoints returns the first RPC endpoint of the entry answering a
+// status query with the chain ID of the entry.
+func probeRPCEndpoints(ctx context.Context, entry chainRegistryEntry) (string, error) {
+	var failures []string
+	for _, endpoint := range entry.APIs.RPC {
+		err := probeRPCEndpoint(ctx, endpoint.Address, entry.ChainID)
+		if err == nil {
+			return endpoint.Address, nil
+		}
+
+		failures = append(failures, fmt.Sprintf("%s: %v", endpoint.Address, err))
+	}
+
+	return "", fmt.Errorf("no reachable RPC node of %s: %s", entry.ChainID, strings.Join(failures, "; "))
+}
+
+// probeRPCEndpoint queries the status of the node at address, and checks its
+// chain ID.
+func probeRPCEndpoint(ctx context.Context, address, chainID string) error {
+	ctx, cancel := context.WithTimeout(ctx, rpcProbeTimeout)
+	defer cancel()
+
+	node, err := client.NewClientFromNode(address)
+	if err != nil {
+		return err
+	}
+
+	status, err := node.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	if status.NodeInfo.Network != chainID {
+		return fmt.Errorf("the node is on the chain %s", status.NodeInfo.Network)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package config_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+)
+
+const testChainJSON = `{
+  "chain_name": "testchain",
+  "chain_id": "testchain-1",
+  "fees": {
+    "fee_tokens": [
+      {"denom": "utest", "fixed_min_gas_price": 0.001, "low_gas_price": 0.01, "average_gas_price": 0.025, "high_gas_price": 0.04}
+    ]
+  },
+  "apis": {
+    "rpc": [
+      {"address": %q, "provider": "down"},
+      {"address": %q, "provider": "up"}
+    ]
+  }
+}`
+
+const testAssetListJSON = `{
+  "chain_name": "testchain",
+  "assets": [{"base": "utest", "display": "test", "symbol": "TEST"}]
+}`
+
+// newTestRPCServer returns a server answering the status queries of the RPC
+// client with the given chain ID.
+func newTestRPCServer(t *testing.T, chainID string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"node_info":{"network":%q}}}`, req.ID, chainID)
+	}))
+}
+
+// newTestRegistryServer returns a server serving the given files of a chain
+// registry, by path.
+func newTestRegistryServer(files map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, file)
+	}))
+}
+
+func TestConfigChainCmd(t *testing.T) {
+	rpc := newTestRPCServer(t, "testchain-1")
+	defer rpc.Close()
+	otherRPC := newTestRPCServer(t, "otherchain-1")
+	defer otherRPC.Close()
+	downRPC := httptest.NewServer(http.NotFoundHandler())
+	downRPC.Close()
+
+	chainJSON := fmt.Sprintf(testChainJSON, downRPC.URL, rpc.URL)
+	downRegistry := httptest.NewServer(http.NotFoundHandler())
+	downRegistry.Close()
+
+	testCases := []struct {
+		name      string
+		files     map[string]string
+		args      []string
+		expErr    string
+		expNode   string
+		expPrices string
+	}{
+		{
+			"success, skipping the unreachable node",
+			map[string]string{"/testchain/chain.json": chainJSON, "/testchain/assetlist.json": testAssetListJSON},
+			nil,
+			"",
+			rpc.URL,
+			"0.025000000000000000utest",
+		},
+		{
+			"success without asset list",
+			map[string]string{"/testchain/chain.json": chainJSON},
+			nil,
+			"",
+			rpc.URL,
+			"0.025000000000000000utest",
+		},
+		{
+			"chain not in the registry",
+			map[string]string{},
+			nil,
+			"chain testchain not found in the chain registry",
+			"",
+			"",
+		},
+		{
+			"fee denom not in the asset list",
+			map[string]string{"/testchain/chain.json": chainJSON, "/testchain/assetlist.json": `{"chain_name": "testchain", "assets": [{"base": "uother"}]}`},
+			nil,
+			"invalid chain registry entry of testchain: fee denom utest is not in the asset list",
+			"",
+			"",
+		},
+		{
+			"entry of another chain",
+			map[string]string{"/testchain/chain.json": `{"chain_name": "otherchain", "chain_id": "otherchain-1"}`},
+			nil,
+			`invalid chain registry entry of testchain: the entry is for the chain "otherchain"`,
+			"",
+			"",
+		},
+		{
+			"malformed entry",
+			map[string]string{"/testchain/chain.json": `{"chain_name":`},
+			nil,
+			"failed to parse",
+			"",
+			"",
+		},
+		{
+			"no reachable node of the chain",
+			map[string]string{"/testchain/chain.json": fmt.Sprintf(testChainJSON, downRPC.URL, otherRPC.URL)},
+			nil,
+			"no reachable RPC node of testchain-1",
+			"",
+			"",
+		},
+		{
+			"unreachable registry",
+			nil,
+			[]string{fmt.Sprintf("--%s=%s", "registry-url", downRegistry.URL)},
+			"failed to fetch",
+			"",
+			"",
+		},
+		{
+			"offline",
+			map[string]string{"/testchain/chain.json": chainJSON},
+			[]string{fmt.Sprintf("--%s", flags.FlagOffline)},
+			"cannot fetch the chain registry in offline mode",
+			"",
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientCtx, cleanup := initClientContext(t, "")
+			defer cleanup()
+
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagChainID, "oldchain-1"})
+			require.NoError(t, err)
+			_, err = clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{flags.FlagFees, "10stake"})
+			require.NoError(t, err)
+
+			registry := newTestRegistryServer(tc.files)
+			defer registry.Close()
+
+			args := append([]string{"chain", "testchain", fmt.Sprintf("--%s=%s", "registry-url", registry.URL)}, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), args)
+
+			conf, readErr := config.ReadFromClientConfig(client.Context{}.WithHomeDir(clientCtx.HomeDir).WithViper(""))
+			require.NoError(t, readErr)
+
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+
+				// the config is left untouched
+				require.Equal(t, "oldchain-1", conf.ChainID)
+				require.Equal(t, "tcp://localhost:26657", conf.NodeURI)
+				require.Equal(t, "10stake", conf.FeesStr)
+				require.Empty(t, conf.GasPricesStr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Contains(t, out.String(), "chain-id = testchain-1")
+			require.Equal(t, "testchain-1", conf.ChainID)
+			require.Equal(t, tc.expNode, conf.NodeURI)
+			require.Equal(t, tc.expPrices, conf.GasPricesStr)
+			require.Empty(t, conf.FeesStr)
+		})
+	}
+}
@@ -19,12 +19,26 @@ const defaultConfigTemplate = `# This is a TOML config file.
 chain-id = "{{ .ChainID }}"
 # The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
 keyring-backend = "{{ .KeyringBackend }}"
+# The directory of the keyring, the home directory if empty
+keyring-dir = "{{ .KeyringDir }}"
 # CLI output format (text|json)
 output = "{{ .Output }}"
 # <host>:<port> to Tendermint RPC interface for this chain
 node = "{{ .Node }}"
 # Transaction broadcasting mode (sync|async|block)
 broadcast-mode = "{{ .BroadcastMode }}"
+# Default gas limit of the transactions, or "auto" to estimate it by simulation
+gas = "{{ .Gas }}"
+# Default adjustment factor multiplied against the gas estimated by simulation
+gas-adjustment = {{ .GasAdjustment }}
+# Default gas prices of the transactions (e.g. 0.025uatom), exclusive with fees
+gas-prices = "{{ .GasPrices }}"
+# Default fees of the transactions (e.g. 10uatom), exclusive with gas-prices
+fees = "{{ .Fees }}"
+# Default sign mode of the transactions (direct|amino-json)
+sign-mode = "{{ .SignMode }}"
+# Re-sign and rebroadcast transactions rejected with an account sequence mismatch
+retry-on-sequence-mismatch = {{ .RetryOnSequenceMismatch }}
 `
 
 // writeConfigToFile parses defaultConfigTemplate, renders config using the template and writes it to
@@ -2,8 +2,11 @@ package client
 
 import (
 	"bufio"
+	"encoding/json"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -12,6 +15,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -21,33 +26,42 @@ import (
 // Context implements a typical context created in SDK modules for transaction
 // handling and queries.
 type Context struct {
-	FromAddress       sdk.AccAddress
-	Client            rpcclient.Client
-	ChainID           string
-	Codec             codec.Codec
-	InterfaceRegistry codectypes.InterfaceRegistry
-	Input             io.Reader
-	Keyring           keyring.Keyring
-	KeyringOptions    []keyring.Option
-	Output            io.Writer
-	OutputFormat      string
-	Height            int64
-	HomeDir           string
-	KeyringDir        string
-	From              string
-	BroadcastMode     string
-	FromName          string
-	SignModeStr       string
-	UseLedger         bool
-	Simulate          bool
-	GenerateOnly      bool
-	Offline           bool
-	SkipConfirm       bool
-	TxConfig          TxConfig
-	AccountRetriever  AccountRetriever
-	NodeURI           string
-	FeeGranter        sdk.AccAddress
-	Viper             *viper.Viper
+	FromAddress             sdk.AccAddress
+	Client                  rpcclient.Client
+	ChainID                 string
+	Codec                   codec.Codec
+	InterfaceRegistry       codectypes.InterfaceRegistry
+	Input                   io.Reader
+	Keyring                 keyring.Keyring
+	KeyringOptions          []keyring.Option
+	Output                  io.Writer
+	OutputFormat            string
+	Height                  int64
+	HomeDir                 string
+	KeyringDir              string
+	KeyringPassFile         string
+	From                    string
+	BroadcastMode           string
+	FromName                string
+	SignModeStr             string
+	GasStr                  string
+	GasAdjustment           float64
+	GasPricesStr            string
+	FeesStr                 string
+	UseLedger               bool
+	Simulate                bool
+	GenerateOnly            bool
+	Offline                 bool
+	SkipConfirm             bool
+	RetryOnSequenceMismatch bool
+	Wait                    bool
+	WaitTimeout             time.Duration
+	ExactDenoms             bool
+	TxConfig                TxConfig
+	AccountRetriever        AccountRetriever
+	NodeURI                 string
+	FeeGranter              sdk.AccAddress
+	Viper                   *viper.Viper
 
 	// TODO: Deprecated (remove).
 	LegacyAmino *codec.LegacyAmino
@@ -144,6 +158,12 @@ func (ctx Context) WithHomeDir(dir string) Context {
 	return ctx
 }
 
+// WithKeyringPassFile returns a copy of the Context with KeyringPassFile set.
+func (ctx Context) WithKeyringPassFile(passFile string) Context {
+	ctx.KeyringPassFile = passFile
+	return ctx
+}
+
 // WithKeyringDir returns a copy of the Context with KeyringDir set.
 func (ctx Context) WithKeyringDir(dir string) Context {
 	ctx.KeyringDir = dir
@@ -202,6 +222,32 @@ func (ctx Context) WithSignModeStr(signModeStr string) Context {
 	return ctx
 }
 
+// WithGasStr returns a copy of the context with an updated gas setting, a gas
+// limit or "auto".
+func (ctx Context) WithGasStr(gasStr string) Context {
+	ctx.GasStr = gasStr
+	return ctx
+}
+
+// WithGasAdjustment returns a copy of the context with an updated gas
+// adjustment.
+func (ctx Context) WithGasAdjustment(gasAdj float64) Context {
+	ctx.GasAdjustment = gasAdj
+	return ctx
+}
+
+// WithGasPricesStr returns a copy of the context with updated gas prices.
+func (ctx Context) WithGasPricesStr(gasPricesStr string) Context {
+	ctx.GasPricesStr = gasPricesStr
+	return ctx
+}
+
+// WithFeesStr returns a copy of the context with updated fees.
+func (ctx Context) WithFeesStr(feesStr string) Context {
+	ctx.FeesStr = feesStr
+	return ctx
+}
+
 // WithSkipConfirmation returns a copy of the context with an updated SkipConfirm
 // value.
 func (ctx Context) WithSkipConfirmation(skip bool) Context {
@@ -209,6 +255,33 @@ func (ctx Context) WithSkipConfirmation(skip bool) Context {
 	return ctx
 }
 
+// WithRetryOnSequenceMismatch returns a copy of the context with an updated
+// RetryOnSequenceMismatch value.
+func (ctx Context) WithRetryOnSequenceMismatch(retry bool) Context {
+	ctx.RetryOnSequenceMismatch = retry
+	return ctx
+}
+
+// WithWait returns a copy of the context with an updated Wait value, which
+// makes the broadcasts wait for the inclusion of the tx in a block.
+func (ctx Context) WithWait(wait bool) Context {
+	ctx.Wait = wait
+	return ctx
+}
+
+// WithWaitTimeout returns a copy of the context with an updated WaitTimeout.
+func (ctx Context) WithWaitTimeout(timeout time.Duration) Context {
+	ctx.WaitTimeout = timeout
+	return ctx
+}
+
+// WithExactDenoms returns a copy of the context with an updated ExactDenoms
+// value, which disables the resolution of the display denoms of coin inputs.
+func (ctx Context) WithExactDenoms(exact bool) Context {
+	ctx.ExactDenoms = exact
+	return ctx
+}
+
 // WithTxConfig returns the context with an updated TxConfig
 func (ctx Context) WithTxConfig(generator TxConfig) Context {
 	ctx.TxConfig = generator
@@ -245,13 +318,17 @@ func (ctx Context) PrintString(str string) error {
 // PrintBytes prints the raw bytes to ctx.Output if it's defined, otherwise to os.Stdout.
 // NOTE: for printing a complex state object, you should use ctx.PrintOutput
 func (ctx Context) PrintBytes(o []byte) error {
-	writer := ctx.Output
-	if writer == nil {
-		writer = os.Stdout
+	_, err := ctx.getOutput().Write(o)
+	return err
+}
+
+// getOutput returns ctx.Output if it's defined, otherwise os.Stdout.
+func (ctx Context) getOutput() io.Writer {
+	if ctx.Output == nil {
+		return os.Stdout
 	}
 
-	_, err := writer.Write(o)
-	return err
+	return ctx.Output
 }
 
 // PrintProto outputs toPrint to the ctx.Output based on ctx.OutputFormat which is
@@ -266,6 +343,12 @@ func (ctx Context) PrintProto(toPrint proto.Message) error {
 	return ctx.printOutput(out)
 }
 
+// PrintRaw is a variant of PrintProto that outputs JSON encoded data, YAML
+// encoded if ctx.OutputFormat is text.
+func (ctx Context) PrintRaw(toPrint json.RawMessage) error {
+	return ctx.printOutput(toPrint)
+}
+
 // PrintObjectLegacy is a variant of PrintProto that doesn't require a proto.Message type
 // and uses amino JSON encoding.
 // Deprecated: It will be removed in the near future!
@@ -279,24 +362,20 @@ func (ctx Context) PrintObjectLegacy(toPrint interface{}) error {
 
 func (ctx Context) printOutput(out []byte) error {
 	var err error
-	if ctx.OutputFormat == "text" {
+	if ctx.OutputFormat == "text" || ctx.OutputFormat == flags.OutputFormatPretty {
 		out, err = yaml.JSONToYAML(out)
 		if err != nil {
 			return err
 		}
 	}
 
-	writer := ctx.Output
-	if writer == nil {
-		writer = os.Stdout
-	}
-
+	writer := ctx.getOutput()
 	_, err = writer.Write(out)
 	if err != nil {
 		return err
 	}
 
-	if ctx.OutputFormat != "text" {
+	if ctx.OutputFormat != "text" && ctx.OutputFormat != flags.OutputFormatPretty {
 		// append new-line for formats besides YAML
 		_, err = writer.Write([]byte("\n"))
 		if err != nil {
@@ -342,5 +421,43 @@ func NewKeyringFromBackend(ctx Context, backend string) (keyring.Keyring, error)
 		backend = keyring.BackendMemory
 	}
 
-	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, ctx.Codec, ctx.KeyringOptions...)
+	opts := ctx.KeyringOptions
+	if ctx.KeyringPassFile != "" {
+		passFile := ctx.KeyringPassFile
+		opts = append(append([]keyring.Option{}, opts...), func(options *keyring.Options) {
+			options.PassphraseFile = passFile
+		})
+	}
+
+	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, ctx.Codec, opts...)
+}
+
+// WithKeyringPromptsFromTTY returns a copy of the context whose keyring
+// prompts for passphrases on the terminal of the process rather than reading
+// them from Input, which is left to carry data, such as a tx read from the
+// standard input. If the process has no terminal, the prompts fail and the
+// passphrase must be given by a passphrase file or the environment. The
+// keyrings of backends that never prompt are kept as is.
+func (ctx Context) WithKeyringPromptsFromTTY() (Context, error) {
+	if ctx.Keyring == nil {
+		return ctx, nil
+	}
+
+	backend := ctx.Keyring.Backend()
+	switch backend {
+	case "", keyring.BackendTest, keyring.BackendMemory:
+		return ctx, nil
+	}
+
+	var userInput io.Reader = strings.NewReader("")
+	if tty, err := input.OpenTTY(); err == nil {
+		userInput = tty
+	}
+
+	kr, err := NewKeyringFromBackend(ctx.WithInput(userInput), backend)
+	if err != nil {
+		return ctx, err
+	}
+
+	return ctx.WithKeyring(kr), nil
 }
@@ -3,6 +3,8 @@ package flags
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
@@ -31,6 +33,15 @@ const (
 	// immediately.
 	BroadcastAsync = "async"
 
+	// OutputFormatPretty is the value of the --output flag of the tx commands
+	// printing their tx response as a concise summary and a compact view of
+	// its events. It is the default of the tx commands writing to a terminal.
+	OutputFormatPretty = "pretty"
+
+	// DefaultWaitTimeout is the default time a broadcast with --wait waits for
+	// the tx to be included in a block.
+	DefaultWaitTimeout = time.Minute
+
 	// SignModeDirect is the value of the --sign-mode flag for SIGN_MODE_DIRECT
 	SignModeDirect = "direct"
 	// SignModeLegacyAminoJSON is the value of the --sign-mode flag for SIGN_MODE_LEGACY_AMINO_JSON
@@ -41,6 +52,7 @@ const (
 const (
 	FlagHome             = tmcli.HomeFlag
 	FlagKeyringDir       = "keyring-dir"
+	FlagKeyringPassFile  = "keyring-passphrase-file"
 	FlagUseLedger        = "ledger"
 	FlagChainID          = "chain-id"
 	FlagNode             = "node"
@@ -73,6 +85,11 @@ const (
 	FlagFeeAccount       = "fee-account"
 	FlagReverse          = "reverse"
 
+	FlagRetryOnSequenceMismatch = "retry-on-sequence-mismatch"
+	FlagWait                    = "wait"
+	FlagWaitTimeout             = "wait-timeout"
+	FlagExactDenoms             = "exact-denoms"
+
 	// Tendermint logging flags
 	FlagLogLevel  = "log_level"
 	FlagLogFormat = "log_format"
@@ -93,14 +110,14 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 
 // AddTxFlagsToCmd adds common flags to a module tx command.
 func AddTxFlagsToCmd(cmd *cobra.Command) {
-	cmd.Flags().StringP(tmcli.OutputFlag, "o", "json", "Output format (text|json)")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "json", "Output format (text|json|pretty), pretty by default on a terminal")
 	cmd.Flags().String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
 	cmd.Flags().String(FlagFrom, "", "Name or address of private key with which to sign")
 	cmd.Flags().Uint64P(FlagAccountNumber, "a", 0, "The account number of the signing account (offline mode only)")
-	cmd.Flags().Uint64P(FlagSequence, "s", 0, "The sequence number of the signing account (offline mode only)")
+	cmd.Flags().StringP(FlagSequence, "s", "", "The sequence number of the signing account (offline mode only), or +N to add N to its sequence queried from the node")
 	cmd.Flags().String(FlagNote, "", "Note to add a description to the transaction (previously --memo)")
-	cmd.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
-	cmd.Flags().String(FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom)")
+	cmd.Flags().String(FlagFees, "", "Fees to pay along with transaction, in a base or display denom; eg: 10uatom or 0.00001atom")
+	cmd.Flags().String(FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee, in a base or display denom (e.g. 0.1uatom)")
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 	cmd.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	cmd.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
@@ -110,9 +127,14 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	cmd.Flags().String(FlagKeyringPassFile, "", fmt.Sprintf("File holding the passphrase of the os and file keyring backends, instead of the %s environment variable or the prompt", keyring.EnvKeyringPassphrase))
 	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
 	cmd.Flags().String(FlagFeeAccount, "", "Fee account pays fees for the transaction instead of deducting from the signer")
+	cmd.Flags().Bool(FlagWait, false, "After a successful sync broadcast, wait until the transaction is included in a block and print its execution result")
+	cmd.Flags().Duration(FlagWaitTimeout, DefaultWaitTimeout, "Maximum time to wait for the transaction to be included in a block with --wait")
+	cmd.Flags().Bool(FlagExactDenoms, false, "Parse the denoms of the fees, gas prices and amounts as base denoms, without resolving display denoms from the denom metadata of the node")
+	cmd.Flags().Bool(FlagRetryOnSequenceMismatch, false, "Re-sign and rebroadcast the transaction with the expected sequence when the node rejects it with an account sequence mismatch; ignored when --sequence is set")
 
 	// --gas can accept integers and "auto"
 	cmd.Flags().String(FlagGas, "", fmt.Sprintf("gas limit to set per-transaction; set to %q to calculate sufficient gas automatically (default %d)", GasFlagAuto, DefaultGasLimit))
@@ -163,3 +185,30 @@ func ParseGasSetting(gasStr string) (GasSetting, error) {
 		return GasSetting{false, gas}, nil
 	}
 }
+
+// SequenceSetting encapsulates the possible values passed through the
+// --sequence flag.
+type SequenceSetting struct {
+	// Relative is true if Sequence is added to the sequence of the account
+	// queried from the node, rather than replacing it.
+	Relative bool
+	Sequence uint64
+}
+
+// ParseSequenceSetting parses a string sequence value. The value may either be
+// a string integer, or a string integer prefixed by '+', which indicates an
+// offset to the sequence of the account queried from the node. It returns an
+// error if the value cannot be parsed.
+func ParseSequenceSetting(seqStr string) (SequenceSetting, error) {
+	if seqStr == "" {
+		return SequenceSetting{false, 0}, nil
+	}
+
+	relative := strings.HasPrefix(seqStr, "+")
+	seq, err := strconv.ParseUint(strings.TrimPrefix(seqStr, "+"), 10, 64)
+	if err != nil {
+		return SequenceSetting{}, fmt.Errorf("sequence must be either an integer or +N, got %s", seqStr)
+	}
+
+	return SequenceSetting{relative, seq}, nil
+}
@@ -36,3 +36,34 @@ func TestParseGasSetting(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSequenceSetting(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  flags.SequenceSetting
+		expectErr bool
+	}{
+		{"empty input", "", flags.SequenceSetting{false, 0}, false},
+		{"absolute sequence", "12", flags.SequenceSetting{false, 12}, false},
+		{"relative sequence", "+1", flags.SequenceSetting{true, 1}, false},
+		{"negative sequence", "-1", flags.SequenceSetting{}, true},
+		{"relative without offset", "+", flags.SequenceSetting{}, true},
+		{"invalid sequence", "next", flags.SequenceSetting{}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ss, err := flags.ParseSequenceSetting(tc.input)
+
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, ss)
+			}
+		})
+	}
+}
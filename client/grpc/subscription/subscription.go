@@ -0,0 +1,191 @@
+// Package subscription bridges the events of a node, published on the event bus
+// of its Tendermint node, to the server-streaming gRPC methods of its services.
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// BackpressureDrop drops the events of a subscription which do not fit in
+	// its buffer, as its client does not receive them fast enough.
+	BackpressureDrop = "drop"
+
+	// BackpressureDisconnect ends the stream of a subscription whose buffer is
+	// full with a ResourceExhausted error, as its client does not receive the
+	// events fast enough.
+	BackpressureDisconnect = "disconnect"
+
+	// DefaultBufferSize is the default number of events buffered for each
+	// subscription.
+	DefaultBufferSize = 100
+)
+
+// Config defines the limits of the subscriptions of each stream.
+type Config struct {
+	// BufferSize is the number of events buffered for a client of the stream
+	// receiving them slower than they are published.
+	BufferSize uint
+
+	// Backpressure is the policy of the subscription when its buffer is full,
+	// BackpressureDrop or BackpressureDisconnect.
+	Backpressure string
+}
+
+// DefaultConfig returns the default subscription config, disconnecting slow
+// clients.
+func DefaultConfig() Config {
+	return Config{
+		BufferSize:   DefaultBufferSize,
+		Backpressure: BackpressureDisconnect,
+	}
+}
+
+// Validate returns an error if the buffer size is zero or if the backpressure
+// policy is unknown.
+func (c Config) Validate() error {
+	if c.BufferSize == 0 {
+		return fmt.Errorf("subscription buffer size must be positive")
+	}
+
+	switch c.Backpressure {
+	case BackpressureDrop, BackpressureDisconnect:
+		return nil
+	default:
+		return fmt.Errorf("invalid subscription backpressure %q, expected %q or %q", c.Backpressure, BackpressureDrop, BackpressureDisconnect)
+	}
+}
+
+type configKey struct{}
+
+// StreamServerInterceptor returns a gRPC stream interceptor passing the config
+// to the subscriptions of the streams, through their context.
+func StreamServerInterceptor(cfg Config) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = context.WithValue(ss.Context(), configKey{}, cfg)
+
+		return handler(srv, wrapped)
+	}
+}
+
+// ConfigFromContext returns the config of the subscriptions of a stream, set
+// by StreamServerInterceptor, or the default one.
+func ConfigFromContext(ctx context.Context) Config {
+	if cfg, ok := ctx.Value(configKey{}).(Config); ok {
+		return cfg
+	}
+
+	return DefaultConfig()
+}
+
+// subscriberCount numbers the subscribers, whose name must be unique on the
+// event bus.
+var subscriberCount uint64
+
+// Stream subscribes to the events of the query with the events client of the
+// node, and passes each of them to send until the context is done, which it is
+// when the client of a stream disconnects, the subscription is closed or send
+// fails. The events which the client does not receive fast enough are
+// buffered, up to the buffer size of the config, then handled according to its
+// backpressure policy. The subscription is removed when Stream returns, and
+// send is not called anymore, except for a call in progress, which the end of
+// the gRPC stream unblocks.
+func Stream(
+	ctx context.Context, client rpcclient.EventsClient, query string, cfg Config, send func(coretypes.ResultEvent) error,
+) error {
+	if client == nil {
+		return status.Error(codes.Unavailable, "the node has no Tendermint client to subscribe to its events")
+	}
+	if err := cfg.Validate(); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	subscriber := fmt.Sprintf("grpc-subscription-%d", atomic.AddUint64(&subscriberCount, 1))
+	events, err := client.Subscribe(ctx, subscriber, query, int(cfg.BufferSize))
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to subscribe to %s: %v", query, err)
+	}
+	defer func() {
+		_ = client.UnsubscribeAll(context.Background(), subscriber)
+	}()
+
+	// the events are read from the subscription as soon as they are published,
+	// so that the event bus never blocks on this client, and buffered for it
+	buffer := make(chan coretypes.ResultEvent, cfg.BufferSize)
+	overflow := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event, ok := <-events:
+				if !ok {
+					close(buffer)
+					return
+				}
+
+				select {
+				case buffer <- event:
+				default:
+					if cfg.Backpressure == BackpressureDrop {
+						continue
+					}
+
+					close(overflow)
+					return
+				}
+			}
+		}
+	}()
+
+	// the events are sent by another goroutine, so that a client too slow to
+	// receive them, blocking its send, can still be disconnected
+	sendErr := make(chan error, 1)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event, ok := <-buffer:
+				if !ok {
+					sendErr <- status.Error(codes.Unavailable, "the subscription was closed by the node")
+					return
+				}
+				if ctx.Err() != nil {
+					return
+				}
+
+				if err := send(event); err != nil {
+					sendErr <- err
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+
+	case <-overflow:
+		return status.Errorf(codes.ResourceExhausted, "more than %d events pending, the client is too slow", cfg.BufferSize)
+
+	case err := <-sendErr:
+		return err
+	}
+}
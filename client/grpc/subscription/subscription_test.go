@@ -0,0 +1,166 @@
+package subscription_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/subscription"
+)
+
+// fakeEventsClient publishes the events sent on its channel to its subscriber.
+type fakeEventsClient struct {
+	events chan coretypes.ResultEvent
+
+	mu           sync.Mutex
+	query        string
+	subscribed   string
+	unsubscribed string
+}
+
+func newFakeEventsClient() *fakeEventsClient {
+	return &fakeEventsClient{events: make(chan coretypes.ResultEvent)}
+}
+
+func (c *fakeEventsClient) Subscribe(_ context.Context, subscriber, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscribed, c.query = subscriber, query
+
+	return c.events, nil
+}
+
+func (c *fakeEventsClient) Unsubscribe(context.Context, string, string) error {
+	panic("unexpected call")
+}
+
+func (c *fakeEventsClient) UnsubscribeAll(_ context.Context, subscriber string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsubscribed = subscriber
+
+	return nil
+}
+
+// publish publishes n events, numbered from first, as the event bus does.
+func (c *fakeEventsClient) publish(first, n int) {
+	for i := first; i < first+n; i++ {
+		c.events <- coretypes.ResultEvent{SubscriptionID: string(rune('a' + i))}
+	}
+}
+
+// stream runs subscription.Stream in the background, returning the channel of
+// its error. Each send waits for the unblock channel, if not nil.
+func stream(ctx context.Context, client *fakeEventsClient, cfg subscription.Config, sent chan<- string, unblock <-chan struct{}) <-chan error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- subscription.Stream(ctx, client, "tm.event='NewBlock'", cfg, func(event coretypes.ResultEvent) error {
+			if unblock != nil {
+				<-unblock
+			}
+			sent <- event.SubscriptionID
+			return nil
+		})
+	}()
+
+	return errCh
+}
+
+func TestStream(t *testing.T) {
+	client := newFakeEventsClient()
+	sent := make(chan string, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := stream(ctx, client, subscription.DefaultConfig(), sent, nil)
+
+	client.publish(0, 3)
+	require.Equal(t, "a", <-sent)
+	require.Equal(t, "b", <-sent)
+	require.Equal(t, "c", <-sent)
+
+	// the client disconnects
+	cancel()
+	require.Equal(t, codes.Canceled, status.Code(<-errCh))
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	require.Equal(t, "tm.event='NewBlock'", client.query)
+	require.NotEmpty(t, client.subscribed)
+	require.Equal(t, client.subscribed, client.unsubscribed)
+}
+
+func TestStreamBackpressure(t *testing.T) {
+	t.Run("disconnect", func(t *testing.T) {
+		client := newFakeEventsClient()
+		sent := make(chan string, 10)
+		unblock := make(chan struct{})
+		cfg := subscription.Config{BufferSize: 2, Backpressure: subscription.BackpressureDisconnect}
+		errCh := stream(context.Background(), client, cfg, sent, unblock)
+
+		// one event being sent to the slow client, two buffered, one too many
+		client.publish(0, 4)
+
+		err := <-errCh
+		require.Equal(t, codes.ResourceExhausted, status.Code(err), err)
+		close(unblock)
+
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		require.Equal(t, client.subscribed, client.unsubscribed)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		client := newFakeEventsClient()
+		sent := make(chan string, 10)
+		unblock := make(chan struct{})
+		cfg := subscription.Config{BufferSize: 2, Backpressure: subscription.BackpressureDrop}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		errCh := stream(ctx, client, cfg, sent, unblock)
+
+		// one event being sent to the slow client, two buffered, two dropped
+		client.publish(0, 5)
+		close(unblock)
+		require.Equal(t, "a", <-sent)
+		require.Equal(t, "b", <-sent)
+		require.Equal(t, "c", <-sent)
+
+		// the stream goes on
+		client.publish(5, 1)
+		require.Equal(t, "f", <-sent)
+		select {
+		case err := <-errCh:
+			require.FailNow(t, "unexpected end of stream", err)
+		case <-time.After(10 * time.Millisecond):
+		}
+	})
+}
+
+func TestStreamClosed(t *testing.T) {
+	client := newFakeEventsClient()
+	errCh := stream(context.Background(), client, subscription.DefaultConfig(), make(chan string), nil)
+
+	close(client.events)
+	require.Equal(t, codes.Unavailable, status.Code(<-errCh))
+}
+
+func TestStreamNoClient(t *testing.T) {
+	err := subscription.Stream(context.Background(), nil, "tm.event='NewBlock'", subscription.DefaultConfig(), nil)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, subscription.DefaultConfig().Validate())
+	require.NoError(t, subscription.Config{BufferSize: 1, Backpressure: subscription.BackpressureDrop}.Validate())
+	require.Error(t, subscription.Config{BufferSize: 0, Backpressure: subscription.BackpressureDrop}.Validate())
+	require.Error(t, subscription.Config{BufferSize: 1, Backpressure: "block"}.Validate())
+}
+
+func TestConfigFromContext(t *testing.T) {
+	require.Equal(t, subscription.DefaultConfig(), subscription.ConfigFromContext(context.Background()))
+}
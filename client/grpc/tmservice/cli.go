@@ -0,0 +1,98 @@
+package tmservice
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// FlagApp is the flag of NodeInfoCommand querying the application metadata of
+// the node instead of its node info.
+const FlagApp = "app"
+
+// NodeInfoCommand returns the command querying the node info of a node, or the
+// metadata of its application with the --app flag.
+func NodeInfoCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-info",
+		Short: "Query the node info of a node, or the metadata of its application",
+		Long: `Query the node info of a node, reported by Tendermint, along with the version of its application.
+With --app, query the metadata of its application instead: its version, the sign modes it accepts and
+its modules, with their consensus versions.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewServiceClient(clientCtx)
+			if app, _ := cmd.Flags().GetBool(FlagApp); app {
+				res, err := queryClient.GetAppInfo(cmd.Context(), &GetAppInfoRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.GetNodeInfo(cmd.Context(), &GetNodeInfoRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagApp, false, "Query the metadata of the application of the node")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// StoreProofCommand returns the command querying the value of a key of a store,
+// with its ICS-23 proofs against the app hash.
+func StoreProofCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-proof [store] [hex-key]",
+		Short: "Query the value of a key of a store, with its proofs",
+		Long: `Query the value of a key of a store of the application, with the ICS-23 proofs of its existence,
+or of its absence, in the store, and of the root of the store against the app hash of the height.
+The app hash of a height is in the header of the next block.`,
+		Example: fmt.Sprintf("%s query store-proof bank 0200 --height 10", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid hex key %s: %w", args[1], err)
+			}
+
+			queryClient := NewServiceClient(clientCtx)
+			res, err := queryClient.StoreProof(cmd.Context(), &StoreProofRequest{
+				Store:  args[0],
+				Key:    key,
+				Height: clientCtx.Height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
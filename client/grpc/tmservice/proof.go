@@ -0,0 +1,97 @@
+package tmservice
+
+import (
+	"fmt"
+
+	ics23 "github.com/confio/ics23/go"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ABCIQueryFn is the ABCI Query method of an app, e.g. BaseApp.Query.
+type ABCIQueryFn func(abci.RequestQuery) abci.ResponseQuery
+
+// getStoreProof queries the value of the key of the store at the height, with
+// the proof ops of the multistore, and converts them to ICS-23 proofs.
+//
+// The query is run against the app directly rather than through the node, as
+// the gRPC queries served through ABCI would otherwise wait for themselves on
+// the lock of the ABCI connection.
+func getStoreProof(abciQuery ABCIQueryFn, store string, key []byte, height int64) (*StoreProofResponse, error) {
+	res := abciQuery(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", store),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if !res.IsOK() {
+		return nil, sdkerrors.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+
+	// the multistore appends the proof of the store root to the proof of the key
+	if res.ProofOps == nil || len(res.ProofOps.Ops) != 2 {
+		return nil, sdkerrors.Wrap(storetypes.ErrInvalidProof, "expected the proof ops of the key and of the store root")
+	}
+
+	storeProof, err := commitmentProof(res.ProofOps.Ops[0], storetypes.ProofOpIAVLCommitment)
+	if err != nil {
+		return nil, err
+	}
+	rootProof, err := commitmentProof(res.ProofOps.Ops[1], storetypes.ProofOpSimpleMerkleCommitment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreProofResponse{
+		Height:     res.Height,
+		Value:      res.Value,
+		StoreProof: storeProof,
+		RootProof:  rootProof,
+	}, nil
+}
+
+// commitmentProof decodes the ICS-23 proof of a proof op of the given type.
+func commitmentProof(op tmcrypto.ProofOp, opType string) (*ics23.CommitmentProof, error) {
+	if op.Type != opType {
+		return nil, sdkerrors.Wrapf(storetypes.ErrInvalidProof, "unexpected proof op type; got %s, want %s", op.Type, opType)
+	}
+
+	proof := &ics23.CommitmentProof{}
+	if err := proof.Unmarshal(op.Data); err != nil {
+		return nil, sdkerrors.Wrap(storetypes.ErrInvalidProof, err.Error())
+	}
+
+	return proof, nil
+}
+
+// VerifyStoreProof verifies the proofs of a StoreProof response for the key of
+// the store against the app hash of its height, i.e. the app hash in the header
+// of the next block. The key is proven to have the value of the response, or to
+// be absent from the store if the value is empty.
+func VerifyStoreProof(appHash []byte, store string, key []byte, res *StoreProofResponse) error {
+	if res.StoreProof == nil || res.RootProof == nil {
+		return sdkerrors.Wrap(storetypes.ErrInvalidProof, "missing proofs")
+	}
+
+	storeRoot, err := res.StoreProof.Calculate()
+	if err != nil {
+		return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "could not calculate the root of store %s: %v", store, err)
+	}
+
+	if len(res.Value) == 0 {
+		if !ics23.VerifyNonMembership(ics23.IavlSpec, storeRoot, res.StoreProof, key) {
+			return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "proof did not verify the absence of key %X in store %s", key, store)
+		}
+	} else if !ics23.VerifyMembership(ics23.IavlSpec, storeRoot, res.StoreProof, key, res.Value) {
+		return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "proof did not verify key %X with value %X in store %s", key, res.Value, store)
+	}
+
+	if !ics23.VerifyMembership(ics23.TendermintSpec, appHash, res.RootProof, []byte(store), storeRoot) {
+		return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "proof did not verify the root of store %s against app hash %X", store, appHash)
+	}
+
+	return nil
+}
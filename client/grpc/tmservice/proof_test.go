@@ -0,0 +1,123 @@
+package tmservice_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// newProvingServer returns a query server proving the data of the multistore,
+// queried as by BaseApp.Query.
+func newProvingServer(t *testing.T, pruning storetypes.PruningOptions, heights int) (tmservice.ServiceServer, []storetypes.CommitID) {
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	store.SetPruning(pruning)
+	bankKey := storetypes.NewKVStoreKey("bank")
+	store.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(storetypes.NewKVStoreKey("acc"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	commitIDs := make([]storetypes.CommitID, heights)
+	for i := range commitIDs {
+		kvStore := store.GetKVStore(bankKey)
+		kvStore.Set([]byte("a"), []byte{byte(i)})
+		kvStore.Set([]byte("c"), []byte("present"))
+		commitIDs[i] = store.Commit()
+	}
+
+	abciQuery := func(req abci.RequestQuery) abci.ResponseQuery {
+		req.Path = strings.TrimPrefix(req.Path, "/store")
+		if req.Height == 0 {
+			req.Height = store.LastCommitID().Version
+		}
+		return store.Query(req)
+	}
+	return tmservice.NewQueryServer(client.Context{}, nil, nil, abciQuery), commitIDs
+}
+
+func TestStoreProof(t *testing.T) {
+	srv, commitIDs := newProvingServer(t, storetypes.PruneNothing, 3)
+
+	testCases := []struct {
+		name     string
+		key      []byte
+		height   int64
+		expValue []byte
+	}{
+		{"present key", []byte("c"), 2, []byte("present")},
+		{"present key at an older height", []byte("a"), 1, []byte{0}},
+		{"present key at the latest height", []byte("a"), 0, []byte{2}},
+		{"absent key", []byte("b"), 2, nil},
+		{"absent key after the last key", []byte("d"), 3, nil},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := srv.StoreProof(context.Background(), &tmservice.StoreProofRequest{Store: "bank", Key: tc.key, Height: tc.height})
+			require.NoError(t, err)
+			require.Equal(t, tc.expValue, res.Value)
+
+			appHash := commitIDs[res.Height-1].Hash
+			require.NoError(t, tmservice.VerifyStoreProof(appHash, "bank", tc.key, res))
+
+			// the proofs do not hold for another key, store or app hash, nor for a forged value
+			require.Error(t, tmservice.VerifyStoreProof(appHash, "bank", []byte("0"), res))
+			require.Error(t, tmservice.VerifyStoreProof(appHash, "acc", tc.key, res))
+			require.Error(t, tmservice.VerifyStoreProof([]byte("wrong app hash"), "bank", tc.key, res))
+			forged := *res
+			forged.Value = []byte("forged")
+			require.Error(t, tmservice.VerifyStoreProof(appHash, "bank", tc.key, &forged))
+		})
+	}
+}
+
+func TestStoreProofInvalidRequest(t *testing.T) {
+	srv, _ := newProvingServer(t, storetypes.PruneNothing, 2)
+
+	testCases := []struct {
+		name    string
+		req     *tmservice.StoreProofRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"no store", &tmservice.StoreProofRequest{Key: []byte("a")}, codes.InvalidArgument},
+		{"no key", &tmservice.StoreProofRequest{Store: "bank"}, codes.InvalidArgument},
+		{"negative height", &tmservice.StoreProofRequest{Store: "bank", Key: []byte("a"), Height: -1}, codes.InvalidArgument},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.StoreProof(context.Background(), tc.req)
+			require.Equal(t, tc.expCode, status.Code(err))
+		})
+	}
+
+	_, err := srv.StoreProof(context.Background(), &tmservice.StoreProofRequest{Store: "staking", Key: []byte("a")})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no such store")
+
+	srv = tmservice.NewQueryServer(client.Context{}, nil, nil, nil)
+	_, err = srv.StoreProof(context.Background(), &tmservice.StoreProofRequest{Store: "bank", Key: []byte("a")})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestStoreProofPrunedHeight(t *testing.T) {
+	srv, _ := newProvingServer(t, storetypes.NewPruningOptions(2, 0, 1), 5)
+
+	_, err := srv.StoreProof(context.Background(), &tmservice.StoreProofRequest{Store: "bank", Key: []byte("a"), Height: 2})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ensure height has not been pruned")
+
+	_, err = srv.StoreProof(context.Background(), &tmservice.StoreProofRequest{Store: "bank", Key: []byte("a"), Height: 5})
+	require.NoError(t, err)
+}
@@ -6,6 +6,7 @@ package tmservice
 import (
 	context "context"
 	fmt "fmt"
+	_go "github.com/confio/ics23/go"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -853,6 +854,393 @@ func (m *Module) GetSum() string {
 	return ""
 }
 
+// SubscribeBlocksRequest is the request type for the Query/SubscribeBlocks RPC method.
+type SubscribeBlocksRequest struct {
+}
+
+func (m *SubscribeBlocksRequest) Reset()         { *m = SubscribeBlocksRequest{} }
+func (m *SubscribeBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBlocksRequest) ProtoMessage()    {}
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{15}
+}
+func (m *SubscribeBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBlocksRequest.Merge(m, src)
+}
+func (m *SubscribeBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBlocksRequest proto.InternalMessageInfo
+
+// SubscribeBlocksResponse is the response type for the Query/SubscribeBlocks RPC method,
+// streamed for each new block.
+type SubscribeBlocksResponse struct {
+	BlockId *types1.BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block   *types1.Block   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *SubscribeBlocksResponse) Reset()         { *m = SubscribeBlocksResponse{} }
+func (m *SubscribeBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBlocksResponse) ProtoMessage()    {}
+func (*SubscribeBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{16}
+}
+func (m *SubscribeBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBlocksResponse.Merge(m, src)
+}
+func (m *SubscribeBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBlocksResponse proto.InternalMessageInfo
+
+func (m *SubscribeBlocksResponse) GetBlockId() *types1.BlockID {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *SubscribeBlocksResponse) GetBlock() *types1.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+// GetAppInfoRequest is the request type for the Query/GetAppInfo RPC method.
+type GetAppInfoRequest struct {
+}
+
+func (m *GetAppInfoRequest) Reset()         { *m = GetAppInfoRequest{} }
+func (m *GetAppInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAppInfoRequest) ProtoMessage()    {}
+func (*GetAppInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{17}
+}
+func (m *GetAppInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAppInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAppInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAppInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAppInfoRequest.Merge(m, src)
+}
+func (m *GetAppInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAppInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAppInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAppInfoRequest proto.InternalMessageInfo
+
+// GetAppInfoResponse is the response type for the Query/GetAppInfo RPC method.
+type GetAppInfoResponse struct {
+	ApplicationVersion *VersionInfo `protobuf:"bytes,1,opt,name=application_version,json=applicationVersion,proto3" json:"application_version,omitempty"`
+	// sign_modes are the names of the sign modes accepted by the node.
+	SignModes []string `protobuf:"bytes,2,rep,name=sign_modes,json=signModes,proto3" json:"sign_modes,omitempty"`
+	// modules are the modules of the application, sorted by name.
+	Modules []*AppModule `protobuf:"bytes,3,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (m *GetAppInfoResponse) Reset()         { *m = GetAppInfoResponse{} }
+func (m *GetAppInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAppInfoResponse) ProtoMessage()    {}
+func (*GetAppInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{18}
+}
+func (m *GetAppInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAppInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAppInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAppInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAppInfoResponse.Merge(m, src)
+}
+func (m *GetAppInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAppInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAppInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAppInfoResponse proto.InternalMessageInfo
+
+func (m *GetAppInfoResponse) GetApplicationVersion() *VersionInfo {
+	if m != nil {
+		return m.ApplicationVersion
+	}
+	return nil
+}
+
+func (m *GetAppInfoResponse) GetSignModes() []string {
+	if m != nil {
+		return m.SignModes
+	}
+	return nil
+}
+
+func (m *GetAppInfoResponse) GetModules() []*AppModule {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+// AppModule is the type for the modules of GetAppInfoResponse.
+type AppModule struct {
+	// name of the module
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// consensus_version of the module, changed by its state-breaking changes
+	ConsensusVersion uint64 `protobuf:"varint,2,opt,name=consensus_version,json=consensusVersion,proto3" json:"consensus_version,omitempty"`
+}
+
+func (m *AppModule) Reset()         { *m = AppModule{} }
+func (m *AppModule) String() string { return proto.CompactTextString(m) }
+func (*AppModule) ProtoMessage()    {}
+func (*AppModule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{19}
+}
+func (m *AppModule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppModule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppModule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppModule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppModule.Merge(m, src)
+}
+func (m *AppModule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppModule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppModule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppModule proto.InternalMessageInfo
+
+func (m *AppModule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AppModule) GetConsensusVersion() uint64 {
+	if m != nil {
+		return m.ConsensusVersion
+	}
+	return 0
+}
+
+// StoreProofRequest is the request type for the Query/StoreProof RPC method.
+type StoreProofRequest struct {
+	// store is the name of the store, e.g. bank.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// key is the key of the value in the store.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// height is the height of the state, the latest height if 0.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *StoreProofRequest) Reset()         { *m = StoreProofRequest{} }
+func (m *StoreProofRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreProofRequest) ProtoMessage()    {}
+func (*StoreProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{20}
+}
+func (m *StoreProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProofRequest.Merge(m, src)
+}
+func (m *StoreProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProofRequest proto.InternalMessageInfo
+
+func (m *StoreProofRequest) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *StoreProofRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StoreProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// StoreProofResponse is the response type for the Query/StoreProof RPC method.
+// The proofs are verified against the app hash of the height, which is in the
+// header of the next block.
+type StoreProofResponse struct {
+	// height is the height of the state.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// value is the value of the key, empty if the key is absent.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// store_proof proves the existence of the key and its value in the store, or
+	// the absence of the key, against the root hash of the store.
+	StoreProof *_go.CommitmentProof `protobuf:"bytes,3,opt,name=store_proof,json=storeProof,proto3" json:"store_proof,omitempty"`
+	// root_proof proves the root hash of the store in the multistore, against
+	// the app hash.
+	RootProof *_go.CommitmentProof `protobuf:"bytes,4,opt,name=root_proof,json=rootProof,proto3" json:"root_proof,omitempty"`
+}
+
+func (m *StoreProofResponse) Reset()         { *m = StoreProofResponse{} }
+func (m *StoreProofResponse) String() string { return proto.CompactTextString(m) }
+func (*StoreProofResponse) ProtoMessage()    {}
+func (*StoreProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{21}
+}
+func (m *StoreProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProofResponse.Merge(m, src)
+}
+func (m *StoreProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProofResponse proto.InternalMessageInfo
+
+func (m *StoreProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StoreProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StoreProofResponse) GetStoreProof() *_go.CommitmentProof {
+	if m != nil {
+		return m.StoreProof
+	}
+	return nil
+}
+
+func (m *StoreProofResponse) GetRootProof() *_go.CommitmentProof {
+	if m != nil {
+		return m.RootProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetValidatorSetByHeightRequest)(nil), "cosmos.base.tendermint.v1beta1.GetValidatorSetByHeightRequest")
 	proto.RegisterType((*GetValidatorSetByHeightResponse)(nil), "cosmos.base.tendermint.v1beta1.GetValidatorSetByHeightResponse")
@@ -869,6 +1257,13 @@ func init() {
 	proto.RegisterType((*GetNodeInfoResponse)(nil), "cosmos.base.tendermint.v1beta1.GetNodeInfoResponse")
 	proto.RegisterType((*VersionInfo)(nil), "cosmos.base.tendermint.v1beta1.VersionInfo")
 	proto.RegisterType((*Module)(nil), "cosmos.base.tendermint.v1beta1.Module")
+	proto.RegisterType((*SubscribeBlocksRequest)(nil), "cosmos.base.tendermint.v1beta1.SubscribeBlocksRequest")
+	proto.RegisterType((*SubscribeBlocksResponse)(nil), "cosmos.base.tendermint.v1beta1.SubscribeBlocksResponse")
+	proto.RegisterType((*GetAppInfoRequest)(nil), "cosmos.base.tendermint.v1beta1.GetAppInfoRequest")
+	proto.RegisterType((*GetAppInfoResponse)(nil), "cosmos.base.tendermint.v1beta1.GetAppInfoResponse")
+	proto.RegisterType((*AppModule)(nil), "cosmos.base.tendermint.v1beta1.AppModule")
+	proto.RegisterType((*StoreProofRequest)(nil), "cosmos.base.tendermint.v1beta1.StoreProofRequest")
+	proto.RegisterType((*StoreProofResponse)(nil), "cosmos.base.tendermint.v1beta1.StoreProofResponse")
 }
 
 func init() {
@@ -876,76 +1271,95 @@ func init() {
 }
 
 var fileDescriptor_40c93fb3ef485c5d = []byte{
-	// 1096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0xda, 0x6d, 0x1c, 0xbf, 0xfe, 0xe9, 0xa7, 0x74, 0x12, 0x9a, 0xcd, 0x2a, 0x35, 0xc1,
-	0x87, 0x36, 0x21, 0x64, 0x57, 0x76, 0x9b, 0xb4, 0x87, 0x52, 0xd4, 0x50, 0x48, 0x23, 0x4a, 0x15,
-	0x6d, 0x10, 0x07, 0x84, 0xb4, 0x5a, 0x7b, 0x27, 0x9b, 0x91, 0xed, 0x9d, 0xe9, 0xce, 0xd8, 0xc8,
-	0x42, 0x15, 0x88, 0x13, 0x47, 0x24, 0xbe, 0x02, 0x07, 0xe0, 0x8c, 0x38, 0xf6, 0xcc, 0xb1, 0x2a,
-	0x12, 0xaa, 0x38, 0xa1, 0x84, 0x0f, 0x82, 0x76, 0x66, 0xd6, 0xde, 0x6d, 0x92, 0xda, 0xce, 0x01,
-	0x89, 0xd3, 0xce, 0xbc, 0xff, 0xe6, 0x79, 0x9e, 0x99, 0x79, 0x77, 0xe0, 0xed, 0x16, 0xe5, 0x5d,
-	0xca, 0x9d, 0xa6, 0xcf, 0xb1, 0x23, 0x70, 0x14, 0xe0, 0xb8, 0x4b, 0x22, 0xe1, 0xf4, 0xeb, 0x4d,
-	0x2c, 0xfc, 0xba, 0xf3, 0xa4, 0x87, 0xe3, 0x81, 0xcd, 0x62, 0x2a, 0x28, 0xaa, 0xaa, 0x58, 0x3b,
-	0x89, 0xb5, 0x47, 0xb1, 0xb6, 0x8e, 0xb5, 0x16, 0x43, 0x1a, 0x52, 0x19, 0xea, 0x24, 0x23, 0x95,
-	0x65, 0x2d, 0x87, 0x94, 0x86, 0x1d, 0xec, 0xc8, 0x59, 0xb3, 0x77, 0xe8, 0xf8, 0x91, 0x2e, 0x68,
-	0xad, 0x68, 0x97, 0xcf, 0x88, 0xe3, 0x47, 0x11, 0x15, 0xbe, 0x20, 0x34, 0xe2, 0xda, 0x6b, 0x65,
-	0xe0, 0xb0, 0x06, 0x73, 0xc4, 0x80, 0xe1, 0xd4, 0xb7, 0x92, 0xf1, 0x49, 0xbb, 0xd3, 0xec, 0xd0,
-	0x56, 0xfb, 0x5c, 0x6f, 0x36, 0x37, 0x47, 0x59, 0xf2, 0x1b, 0xb2, 0x65, 0x7e, 0x48, 0x22, 0x09,
-	0x22, 0x05, 0xaf, 0x62, 0x3d, 0xc5, 0x4a, 0xf3, 0x97, 0x93, 0xda, 0xd7, 0x06, 0x54, 0x77, 0xb1,
-	0xf8, 0xd4, 0xef, 0x90, 0xc0, 0x17, 0x34, 0x3e, 0xc0, 0x62, 0x67, 0xf0, 0x10, 0x93, 0xf0, 0x48,
-	0xb8, 0xf8, 0x49, 0x0f, 0x73, 0x81, 0xae, 0xc2, 0xec, 0x91, 0x34, 0x98, 0xc6, 0xaa, 0xb1, 0x56,
-	0x74, 0xf5, 0x0c, 0x7d, 0x08, 0x30, 0x5a, 0xc9, 0x2c, 0xac, 0x1a, 0x6b, 0x95, 0xc6, 0x75, 0x3b,
-	0xab, 0xae, 0x92, 0x5d, 0xc3, 0xb2, 0xf7, 0xfd, 0x10, 0xeb, 0x9a, 0x6e, 0x26, 0xb3, 0xf6, 0xd2,
-	0x80, 0x37, 0xcf, 0x85, 0xc0, 0x19, 0x8d, 0x38, 0x46, 0x6f, 0xc1, 0xff, 0xa4, 0x34, 0x5e, 0x0e,
-	0x49, 0x45, 0xda, 0x54, 0x28, 0xda, 0x03, 0xe8, 0xa7, 0x25, 0xb8, 0x59, 0x58, 0x2d, 0xae, 0x55,
-	0x1a, 0xeb, 0xf6, 0xeb, 0x37, 0xdb, 0x1e, 0x2e, 0xea, 0x66, 0x92, 0xd1, 0x6e, 0x8e, 0x59, 0x51,
-	0x32, 0xbb, 0x31, 0x96, 0x99, 0x82, 0x9a, 0xa3, 0x76, 0x08, 0x2b, 0xbb, 0x58, 0x3c, 0xf2, 0x05,
-	0xe6, 0x39, 0x7e, 0xa9, 0xb4, 0x79, 0x09, 0x8d, 0x0b, 0x4b, 0xf8, 0x87, 0x01, 0xd7, 0xce, 0x59,
-	0xe8, 0xbf, 0x2d, 0xe0, 0x33, 0x03, 0xca, 0xc3, 0x25, 0x50, 0x03, 0x4a, 0x7e, 0x10, 0xc4, 0x98,
-	0x73, 0x89, 0xbf, 0xbc, 0x63, 0xbe, 0xf8, 0x65, 0x73, 0x51, 0x97, 0xbd, 0xaf, 0x3c, 0x07, 0x22,
-	0x26, 0x51, 0xe8, 0xa6, 0x81, 0x68, 0x13, 0x4a, 0xac, 0xd7, 0xf4, 0xda, 0x78, 0xa0, 0x8f, 0xe8,
-	0xa2, 0xad, 0xee, 0xab, 0x9d, 0x5e, 0x65, 0xfb, 0x7e, 0x34, 0x70, 0x67, 0x59, 0xaf, 0xf9, 0x11,
-	0x1e, 0x24, 0x3a, 0xf5, 0xa9, 0x20, 0x51, 0xe8, 0x31, 0xfa, 0x05, 0x8e, 0x25, 0xf6, 0xa2, 0x5b,
-	0x51, 0xb6, 0xfd, 0xc4, 0x84, 0x36, 0xe0, 0x0a, 0x8b, 0x29, 0xa3, 0x1c, 0xc7, 0x1e, 0x8b, 0x09,
-	0x8d, 0x89, 0x18, 0x98, 0x97, 0x64, 0xdc, 0x7c, 0xea, 0xd8, 0xd7, 0xf6, 0x5a, 0x1d, 0x96, 0x76,
-	0xb1, 0xd8, 0x49, 0x64, 0x9e, 0xf0, 0x5e, 0xd5, 0xbe, 0x02, 0xf3, 0x74, 0x8a, 0xde, 0xc6, 0x5b,
-	0x30, 0xa7, 0xb6, 0x91, 0x04, 0xfa, 0xb8, 0x2c, 0x67, 0x77, 0x45, 0x35, 0x08, 0x99, 0xba, 0xf7,
-	0xc0, 0x2d, 0xc9, 0xd0, 0xbd, 0x00, 0x6d, 0xc2, 0x65, 0x39, 0xd4, 0x0a, 0x2c, 0x9d, 0x93, 0xe2,
-	0xaa, 0xa8, 0xda, 0x12, 0xbc, 0x31, 0x3c, 0x4c, 0xca, 0xa1, 0x10, 0xd7, 0x9e, 0xc2, 0xd5, 0x57,
-	0x1d, 0xff, 0x26, 0xae, 0x05, 0xb8, 0xb2, 0x8b, 0xc5, 0xc1, 0x20, 0x6a, 0x25, 0x3b, 0xac, 0x31,
-	0xd9, 0x80, 0xb2, 0x46, 0x8d, 0xc7, 0x84, 0x12, 0x57, 0x26, 0x09, 0x67, 0xce, 0x4d, 0xa7, 0xb5,
-	0x45, 0x19, 0xff, 0x98, 0x06, 0x78, 0x2f, 0x3a, 0xa4, 0x69, 0x95, 0x9f, 0x0d, 0x58, 0xc8, 0x99,
-	0x75, 0x9d, 0x2d, 0x28, 0x47, 0x34, 0xc0, 0x1e, 0x89, 0x0e, 0xa9, 0x26, 0x66, 0x66, 0x51, 0xb2,
-	0x06, 0xb3, 0x87, 0x49, 0x73, 0x91, 0x1e, 0xa1, 0xcf, 0x61, 0xc1, 0x67, 0xac, 0x43, 0x5a, 0xf2,
-	0x14, 0x7b, 0x7d, 0x1c, 0xf3, 0x51, 0x8f, 0xdc, 0x18, 0x7b, 0xa7, 0x54, 0xb8, 0xac, 0x89, 0x32,
-	0x75, 0xb4, 0xbd, 0xf6, 0x63, 0x01, 0x2a, 0x99, 0x18, 0x84, 0xe0, 0x52, 0xe4, 0x77, 0xb1, 0xba,
-	0x13, 0xae, 0x1c, 0xa3, 0x65, 0x98, 0xf3, 0x19, 0xf3, 0xa4, 0xbd, 0x20, 0xed, 0x25, 0x9f, 0xb1,
-	0xc7, 0x89, 0xcb, 0x84, 0x52, 0x0a, 0xa8, 0xa8, 0x3c, 0x7a, 0x8a, 0xae, 0x01, 0x84, 0x44, 0x78,
-	0x2d, 0xda, 0xed, 0x12, 0x21, 0x8f, 0x74, 0xd9, 0x2d, 0x87, 0x44, 0xbc, 0x2f, 0x0d, 0x89, 0xbb,
-	0xd9, 0x23, 0x9d, 0xc0, 0x13, 0x7e, 0xc8, 0xcd, 0xcb, 0xca, 0x2d, 0x2d, 0x9f, 0xf8, 0x21, 0x97,
-	0xd9, 0x74, 0xc8, 0x75, 0x56, 0x67, 0x53, 0x8d, 0x14, 0x7d, 0x90, 0x66, 0x07, 0x98, 0x71, 0xb3,
-	0x24, 0xdb, 0xcb, 0xf5, 0x71, 0x52, 0x7c, 0x4c, 0x83, 0x5e, 0x07, 0xeb, 0x55, 0x1e, 0x60, 0xc6,
-	0xd1, 0x3b, 0x80, 0xf4, 0xdf, 0x8c, 0x07, 0xed, 0xe1, 0x6a, 0x73, 0x72, 0xb5, 0x79, 0xe5, 0x39,
-	0x08, 0xda, 0xa9, 0x54, 0x0f, 0x61, 0x56, 0x95, 0x48, 0x44, 0x62, 0xbe, 0x38, 0x4a, 0x45, 0x4a,
-	0xc6, 0x59, 0x25, 0x0a, 0x79, 0x25, 0xe6, 0xa1, 0xc8, 0x7b, 0x5d, 0xad, 0x4f, 0x32, 0x6c, 0x7c,
-	0x5b, 0x86, 0xd2, 0x01, 0x8e, 0xfb, 0xa4, 0x85, 0xd1, 0x4f, 0x06, 0x54, 0x32, 0xa7, 0x05, 0x35,
-	0xc6, 0xd1, 0x38, 0x7d, 0xe2, 0xac, 0x9b, 0x53, 0xe5, 0xa8, 0xe3, 0x58, 0xab, 0x7f, 0xf3, 0xfb,
-	0xdf, 0xdf, 0x17, 0x36, 0xd0, 0xba, 0x33, 0xe6, 0xc1, 0x33, 0x3c, 0xb4, 0xe8, 0x07, 0x03, 0x60,
-	0x74, 0x41, 0x50, 0x7d, 0x82, 0x65, 0xf3, 0x37, 0xcc, 0x6a, 0x4c, 0x93, 0xa2, 0x81, 0x3a, 0x12,
-	0xe8, 0x3a, 0xba, 0x31, 0x0e, 0xa8, 0xbe, 0x96, 0xe8, 0x57, 0x03, 0xfe, 0x9f, 0xef, 0x2d, 0x68,
-	0x6b, 0x82, 0x75, 0x4f, 0x37, 0x29, 0x6b, 0x7b, 0xda, 0x34, 0x0d, 0x79, 0x4b, 0x42, 0x76, 0xd0,
-	0xe6, 0x38, 0xc8, 0xb2, 0x19, 0x71, 0xa7, 0x23, 0x6b, 0xa0, 0x67, 0x06, 0xcc, 0xbf, 0xda, 0xae,
-	0xd1, 0xed, 0x09, 0x30, 0x9c, 0xf5, 0x4f, 0xb0, 0xee, 0x4c, 0x9f, 0xa8, 0xe1, 0xdf, 0x96, 0xf0,
-	0xeb, 0xc8, 0x99, 0x10, 0xfe, 0x97, 0xea, 0x6f, 0xf3, 0x14, 0xbd, 0x30, 0x32, 0xed, 0x3e, 0xfb,
-	0x76, 0x40, 0x77, 0x27, 0x56, 0xf2, 0x8c, 0xb7, 0x8d, 0xf5, 0xee, 0x05, 0xb3, 0x35, 0x9f, 0xbb,
-	0x92, 0xcf, 0x36, 0xba, 0x35, 0x8e, 0xcf, 0xe8, 0xd9, 0x81, 0xc5, 0x70, 0x57, 0xfe, 0x34, 0xe4,
-	0x7f, 0xf7, 0xac, 0x37, 0x25, 0xba, 0x37, 0x01, 0xb0, 0xd7, 0xbc, 0x87, 0xad, 0xf7, 0x2e, 0x9c,
-	0xaf, 0xa9, 0xdd, 0x93, 0xd4, 0xee, 0xa0, 0xed, 0xe9, 0xa8, 0xa5, 0x3b, 0xb6, 0xf3, 0xe8, 0xb7,
-	0xe3, 0xaa, 0xf1, 0xfc, 0xb8, 0x6a, 0xfc, 0x75, 0x5c, 0x35, 0xbe, 0x3b, 0xa9, 0xce, 0x3c, 0x3f,
-	0xa9, 0xce, 0xbc, 0x3c, 0xa9, 0xce, 0x7c, 0xd6, 0x08, 0x89, 0x38, 0xea, 0x35, 0xed, 0x16, 0xed,
-	0xa6, 0xb5, 0xd5, 0x67, 0x93, 0x07, 0x6d, 0xa7, 0xd5, 0x21, 0x38, 0x12, 0x4e, 0x18, 0xb3, 0x96,
-	0x23, 0xba, 0x5c, 0x35, 0xb3, 0xe6, 0xac, 0x7c, 0x07, 0xdd, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x1c, 0x72, 0x7c, 0x16, 0x44, 0x0d, 0x00, 0x00,
+	// 1395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xef, 0xc6, 0x49, 0x1c, 0x3f, 0x57, 0xdf, 0x6f, 0x32, 0x09, 0xc9, 0x76, 0xd5, 0x9a, 0xe0,
+	0x03, 0x4d, 0x1b, 0xb2, 0xdb, 0x38, 0x4d, 0x53, 0x89, 0x52, 0x94, 0xb4, 0x90, 0x46, 0xb4, 0x55,
+	0xb4, 0x46, 0x1c, 0x10, 0x92, 0xb5, 0xde, 0x9d, 0x6c, 0x56, 0xb1, 0x77, 0xa6, 0x3b, 0xe3, 0x20,
+	0xab, 0x2a, 0x20, 0xc4, 0x1f, 0x80, 0xc4, 0xbf, 0x00, 0x12, 0x70, 0x42, 0x08, 0x71, 0xec, 0x99,
+	0x63, 0x55, 0xa4, 0xaa, 0xe2, 0x84, 0x5a, 0x4e, 0xfc, 0x15, 0x68, 0x67, 0x66, 0xd7, 0xbb, 0x4d,
+	0x5c, 0xdb, 0x11, 0xaa, 0xc4, 0xc9, 0x3b, 0xef, 0xe7, 0xe7, 0xbd, 0x79, 0xf3, 0xde, 0x33, 0x5c,
+	0x74, 0x09, 0x6b, 0x13, 0x66, 0x35, 0x1d, 0x86, 0x2d, 0x8e, 0x43, 0x0f, 0x47, 0xed, 0x20, 0xe4,
+	0xd6, 0xe1, 0x6a, 0x13, 0x73, 0x67, 0xd5, 0xba, 0xd7, 0xc1, 0x51, 0xd7, 0xa4, 0x11, 0xe1, 0x04,
+	0x55, 0xa4, 0xac, 0x19, 0xcb, 0x9a, 0x3d, 0x59, 0x53, 0xc9, 0x1a, 0x73, 0x3e, 0xf1, 0x89, 0x10,
+	0xb5, 0xe2, 0x2f, 0xa9, 0x65, 0x9c, 0xf1, 0x09, 0xf1, 0x5b, 0xd8, 0x12, 0xa7, 0x66, 0x67, 0xcf,
+	0x72, 0x42, 0x65, 0xd0, 0x38, 0xab, 0x58, 0x0e, 0x0d, 0x2c, 0x27, 0x0c, 0x09, 0x77, 0x78, 0x40,
+	0x42, 0xa6, 0xb8, 0x46, 0x06, 0x0e, 0xad, 0x51, 0x8b, 0x77, 0x29, 0x4e, 0x78, 0x67, 0x33, 0x3c,
+	0x41, 0xb7, 0x9a, 0x2d, 0xe2, 0x1e, 0xf4, 0xe5, 0x66, 0x75, 0x73, 0x21, 0x8b, 0xf8, 0xd2, 0x68,
+	0xa9, 0xe3, 0x07, 0xa1, 0x00, 0x91, 0x80, 0x97, 0xb2, 0x0d, 0x19, 0x95, 0x3c, 0x28, 0xd6, 0xac,
+	0x4b, 0xc2, 0xbd, 0x80, 0xc4, 0x71, 0x91, 0x3d, 0x45, 0xac, 0x7e, 0xa1, 0x41, 0x65, 0x1b, 0xf3,
+	0x8f, 0x9c, 0x56, 0xe0, 0x39, 0x9c, 0x44, 0x75, 0xcc, 0xb7, 0xba, 0xb7, 0x70, 0xe0, 0xef, 0x73,
+	0x1b, 0xdf, 0xeb, 0x60, 0xc6, 0xd1, 0x3c, 0x4c, 0xee, 0x0b, 0x82, 0xae, 0x2d, 0x6a, 0x4b, 0x05,
+	0x5b, 0x9d, 0xd0, 0xfb, 0x00, 0x3d, 0xf7, 0xfa, 0xd8, 0xa2, 0xb6, 0x54, 0xae, 0xbd, 0x69, 0x66,
+	0x53, 0x2e, 0xef, 0x42, 0x61, 0x35, 0x77, 0x1d, 0x1f, 0x2b, 0x9b, 0x76, 0x46, 0xb3, 0xfa, 0x54,
+	0x83, 0xd7, 0xfb, 0x42, 0x60, 0x94, 0x84, 0x0c, 0xa3, 0x37, 0xe0, 0xb4, 0xc8, 0x57, 0x23, 0x87,
+	0xa4, 0x2c, 0x68, 0x52, 0x14, 0xed, 0x00, 0x1c, 0x26, 0x26, 0x98, 0x3e, 0xb6, 0x58, 0x58, 0x2a,
+	0xd7, 0x2e, 0x98, 0x2f, 0xaf, 0x00, 0x33, 0x75, 0x6a, 0x67, 0x94, 0xd1, 0x76, 0x2e, 0xb2, 0x82,
+	0x88, 0xec, 0xfc, 0xc0, 0xc8, 0x24, 0xd4, 0x5c, 0x68, 0x7b, 0x70, 0x76, 0x1b, 0xf3, 0xdb, 0x0e,
+	0xc7, 0x2c, 0x17, 0x5f, 0x92, 0xda, 0x7c, 0x0a, 0xb5, 0x13, 0xa7, 0xf0, 0x89, 0x06, 0xe7, 0xfa,
+	0x38, 0xfa, 0x6f, 0x27, 0xf0, 0xa1, 0x06, 0xa5, 0xd4, 0x05, 0xaa, 0x41, 0xd1, 0xf1, 0xbc, 0x08,
+	0x33, 0x26, 0xf0, 0x97, 0xb6, 0xf4, 0xc7, 0xbf, 0xac, 0xcc, 0x29, 0xb3, 0x9b, 0x92, 0x53, 0xe7,
+	0x51, 0x10, 0xfa, 0x76, 0x22, 0x88, 0x56, 0xa0, 0x48, 0x3b, 0xcd, 0xc6, 0x01, 0xee, 0xaa, 0x12,
+	0x9d, 0x33, 0xe5, 0x23, 0x36, 0x93, 0xf7, 0x6d, 0x6e, 0x86, 0x5d, 0x7b, 0x92, 0x76, 0x9a, 0x1f,
+	0xe0, 0x6e, 0x9c, 0xa7, 0x43, 0xc2, 0x83, 0xd0, 0x6f, 0x50, 0xf2, 0x29, 0x8e, 0x04, 0xf6, 0x82,
+	0x5d, 0x96, 0xb4, 0xdd, 0x98, 0x84, 0x96, 0x61, 0x86, 0x46, 0x84, 0x12, 0x86, 0xa3, 0x06, 0x8d,
+	0x02, 0x12, 0x05, 0xbc, 0xab, 0x8f, 0x0b, 0xb9, 0xe9, 0x84, 0xb1, 0xab, 0xe8, 0xd5, 0x55, 0x58,
+	0xd8, 0xc6, 0x7c, 0x2b, 0x4e, 0xf3, 0x90, 0xef, 0xaa, 0xfa, 0x39, 0xe8, 0x47, 0x55, 0xd4, 0x35,
+	0x5e, 0x86, 0x29, 0x79, 0x8d, 0x81, 0xa7, 0xca, 0xe5, 0x4c, 0xf6, 0x56, 0x64, 0xd7, 0x10, 0xaa,
+	0x3b, 0x37, 0xed, 0xa2, 0x10, 0xdd, 0xf1, 0xd0, 0x0a, 0x4c, 0x88, 0x4f, 0x95, 0x81, 0x85, 0x3e,
+	0x2a, 0xb6, 0x94, 0xaa, 0x2e, 0xc0, 0x6b, 0x69, 0x31, 0x49, 0x86, 0x44, 0x5c, 0x7d, 0x00, 0xf3,
+	0x2f, 0x32, 0x5e, 0x25, 0xae, 0x59, 0x98, 0xd9, 0xc6, 0xbc, 0xde, 0x0d, 0xdd, 0xf8, 0x86, 0x15,
+	0x26, 0x13, 0x50, 0x96, 0xa8, 0xf0, 0xe8, 0x50, 0x64, 0x92, 0x24, 0xe0, 0x4c, 0xd9, 0xc9, 0xb1,
+	0x3a, 0x27, 0xe4, 0xef, 0x12, 0x0f, 0xef, 0x84, 0x7b, 0x24, 0xb1, 0xf2, 0xa3, 0x06, 0xb3, 0x39,
+	0xb2, 0xb2, 0xb3, 0x0e, 0xa5, 0x90, 0x78, 0xb8, 0x11, 0x84, 0x7b, 0x44, 0x05, 0xa6, 0x67, 0x51,
+	0xd2, 0x1a, 0x35, 0x53, 0xa5, 0xa9, 0x50, 0x7d, 0xa1, 0x4f, 0x60, 0xd6, 0xa1, 0xb4, 0x15, 0xb8,
+	0xa2, 0x8a, 0x1b, 0x87, 0x38, 0x62, 0xbd, 0x1e, 0xb9, 0x3c, 0xf0, 0x4d, 0x49, 0x71, 0x61, 0x13,
+	0x65, 0xec, 0x28, 0x7a, 0xf5, 0xfb, 0x31, 0x28, 0x67, 0x64, 0x10, 0x82, 0xf1, 0xd0, 0x69, 0x63,
+	0xf9, 0x26, 0x6c, 0xf1, 0x8d, 0xce, 0xc0, 0x94, 0x43, 0x69, 0x43, 0xd0, 0xc7, 0x04, 0xbd, 0xe8,
+	0x50, 0x7a, 0x37, 0x66, 0xe9, 0x50, 0x4c, 0x00, 0x15, 0x24, 0x47, 0x1d, 0xd1, 0x39, 0x00, 0x3f,
+	0xe0, 0x0d, 0x97, 0xb4, 0xdb, 0x01, 0x17, 0x25, 0x5d, 0xb2, 0x4b, 0x7e, 0xc0, 0x6f, 0x08, 0x42,
+	0xcc, 0x6e, 0x76, 0x82, 0x96, 0xd7, 0xe0, 0x8e, 0xcf, 0xf4, 0x09, 0xc9, 0x16, 0x94, 0x0f, 0x1d,
+	0x9f, 0x09, 0x6d, 0x92, 0xc6, 0x3a, 0xa9, 0xb4, 0x89, 0x42, 0x8a, 0xde, 0x4b, 0xb4, 0x3d, 0x4c,
+	0x99, 0x5e, 0x5c, 0x2c, 0x1c, 0xe9, 0x75, 0xc7, 0xa4, 0xe2, 0x0e, 0xf1, 0x3a, 0x2d, 0xac, 0xbc,
+	0xdc, 0xc4, 0x94, 0xa1, 0xb7, 0x00, 0xa9, 0x11, 0xc7, 0xbc, 0x83, 0xd4, 0xdb, 0x94, 0xf0, 0x36,
+	0x2d, 0x39, 0x75, 0xef, 0x20, 0x49, 0xd5, 0x2d, 0x98, 0x94, 0x26, 0xe2, 0x24, 0x51, 0x87, 0xef,
+	0x27, 0x49, 0x8a, 0xbf, 0xb3, 0x99, 0x18, 0xcb, 0x67, 0x62, 0x1a, 0x0a, 0xac, 0xd3, 0x56, 0xf9,
+	0x89, 0x3f, 0xab, 0x3a, 0xcc, 0xd7, 0x3b, 0x4d, 0xe6, 0x46, 0x41, 0x13, 0x8b, 0xaa, 0x64, 0x49,
+	0xed, 0x7c, 0x06, 0x0b, 0x47, 0x38, 0xaf, 0xfe, 0x59, 0x6c, 0x52, 0x9a, 0x2d, 0xe8, 0x27, 0x1a,
+	0xa0, 0x2c, 0x55, 0x01, 0xea, 0x53, 0x98, 0xda, 0xbf, 0x52, 0x98, 0x71, 0x05, 0xb0, 0xc0, 0x0f,
+	0x1b, 0x6d, 0xe2, 0x61, 0x39, 0x41, 0x4a, 0x76, 0x29, 0xa6, 0xdc, 0x89, 0x09, 0xe8, 0x06, 0x14,
+	0xdb, 0xe2, 0x32, 0x98, 0x5e, 0x18, 0x6e, 0xba, 0x6c, 0x52, 0xaa, 0x2a, 0x20, 0xd1, 0xac, 0xde,
+	0x86, 0x52, 0x4a, 0x3d, 0xb6, 0xf2, 0x97, 0x61, 0xc6, 0x8d, 0x63, 0x0d, 0x59, 0x87, 0xe5, 0x5e,
+	0xde, 0xb8, 0x3d, 0x9d, 0x32, 0x92, 0xfa, 0xa8, 0xc3, 0x4c, 0x9d, 0x93, 0x08, 0xef, 0xc6, 0x3b,
+	0x51, 0xd2, 0x98, 0xe7, 0x60, 0x82, 0xc5, 0x44, 0x65, 0x56, 0x1e, 0xe2, 0x92, 0x48, 0x86, 0xc8,
+	0x69, 0x3b, 0xfe, 0xcc, 0x34, 0xf0, 0x42, 0xae, 0x81, 0xff, 0xac, 0x01, 0xca, 0x5a, 0x55, 0xb9,
+	0xef, 0xb7, 0x47, 0xcd, 0xc1, 0xc4, 0xa1, 0xd3, 0xea, 0x60, 0x65, 0x5a, 0x1e, 0xd0, 0x06, 0x94,
+	0x85, 0xdf, 0x86, 0x58, 0xd7, 0xd4, 0x0c, 0x9d, 0x37, 0x03, 0x97, 0xd5, 0xd6, 0x4c, 0xf9, 0x20,
+	0xdb, 0x38, 0xe4, 0xd2, 0x05, 0xb0, 0xd4, 0x1d, 0x5a, 0x07, 0x88, 0x08, 0xe1, 0x4a, 0x6f, 0xfc,
+	0xa5, 0x7a, 0xa5, 0x58, 0x52, 0x7c, 0xd6, 0xfe, 0x3e, 0x0d, 0xc5, 0x3a, 0x8e, 0x0e, 0x03, 0x17,
+	0xa3, 0x1f, 0x34, 0x28, 0x67, 0xba, 0x21, 0xaa, 0x0d, 0xba, 0xa7, 0xa3, 0x1d, 0xd5, 0x58, 0x1b,
+	0x49, 0x47, 0xa6, 0xa8, 0xba, 0xfa, 0xe5, 0xef, 0x7f, 0x7d, 0x33, 0xb6, 0x8c, 0x2e, 0x58, 0x03,
+	0xb6, 0xfc, 0xb4, 0x29, 0xa3, 0x6f, 0x35, 0x80, 0xde, 0x00, 0x40, 0xab, 0x43, 0xb8, 0xcd, 0x4f,
+	0x10, 0xa3, 0x36, 0x8a, 0x8a, 0x02, 0x6a, 0x09, 0xa0, 0x17, 0xd0, 0xf9, 0x41, 0x40, 0xd5, 0xd8,
+	0x41, 0xbf, 0x6a, 0xf0, 0xbf, 0xfc, 0xec, 0x44, 0xeb, 0x43, 0xf8, 0x3d, 0x3a, 0x84, 0x8d, 0x2b,
+	0xa3, 0xaa, 0x29, 0xc8, 0xeb, 0x02, 0xb2, 0x85, 0x56, 0x06, 0x41, 0x16, 0x5d, 0x85, 0x59, 0x2d,
+	0x61, 0x03, 0x3d, 0xd4, 0x60, 0xfa, 0xc5, 0x75, 0x04, 0x6d, 0x0c, 0x81, 0xe1, 0xb8, 0x9d, 0xc7,
+	0xb8, 0x3a, 0xba, 0xa2, 0x82, 0xbf, 0x21, 0xe0, 0xaf, 0x22, 0x6b, 0x48, 0xf8, 0xf7, 0xe5, 0xeb,
+	0x7a, 0x80, 0x1e, 0x6b, 0x99, 0x75, 0x26, 0xbb, 0x1b, 0xa3, 0x6b, 0x43, 0x67, 0xf2, 0x98, 0xdd,
+	0xdd, 0x78, 0xe7, 0x84, 0xda, 0x2a, 0x9e, 0x6b, 0x22, 0x9e, 0x2b, 0xe8, 0xf2, 0xa0, 0x78, 0x7a,
+	0x6b, 0x35, 0xe6, 0xe9, 0xad, 0xfc, 0xa1, 0x89, 0xbd, 0xf2, 0xb8, 0xff, 0x4c, 0xe8, 0xfa, 0x10,
+	0xc0, 0x5e, 0xf2, 0x7f, 0xcf, 0x78, 0xf7, 0xc4, 0xfa, 0x2a, 0xb4, 0xeb, 0x22, 0xb4, 0xab, 0xe8,
+	0xca, 0x68, 0xa1, 0xa5, 0x37, 0xf6, 0x95, 0x06, 0xff, 0x7f, 0x61, 0xa2, 0xa2, 0x81, 0x55, 0x7f,
+	0xfc, 0x70, 0x36, 0x36, 0x46, 0xd6, 0x93, 0x41, 0x5c, 0xd2, 0xd0, 0x77, 0xb2, 0xb3, 0xa8, 0x11,
+	0x3a, 0x54, 0x67, 0xc9, 0x0f, 0x61, 0xa3, 0x36, 0x8a, 0x8a, 0x4a, 0xde, 0x25, 0x91, 0xbc, 0x8b,
+	0x68, 0x69, 0x50, 0xf2, 0xe2, 0xf5, 0x4e, 0x74, 0xc0, 0x9f, 0x34, 0x80, 0xde, 0xb8, 0x19, 0x8c,
+	0xf3, 0xc8, 0xc0, 0x33, 0x6a, 0xa3, 0xa8, 0x28, 0x9c, 0x6f, 0x0b, 0x9c, 0xeb, 0x68, 0x6d, 0x60,
+	0x07, 0xec, 0x4d, 0x31, 0xeb, 0xbe, 0x38, 0x3c, 0xd8, 0xba, 0xfd, 0xdb, 0xb3, 0x8a, 0xf6, 0xe8,
+	0x59, 0x45, 0xfb, 0xf3, 0x59, 0x45, 0xfb, 0xfa, 0x79, 0xe5, 0xd4, 0xa3, 0xe7, 0x95, 0x53, 0x4f,
+	0x9f, 0x57, 0x4e, 0x7d, 0x5c, 0xf3, 0x03, 0xbe, 0xdf, 0x69, 0x9a, 0x2e, 0x69, 0x27, 0x86, 0xe5,
+	0xcf, 0x0a, 0xf3, 0x0e, 0x2c, 0xb7, 0x15, 0xe0, 0x90, 0x5b, 0x7e, 0x44, 0x5d, 0x8b, 0xb7, 0x99,
+	0x1c, 0x57, 0xcd, 0x49, 0xf1, 0x4f, 0x6e, 0xed, 0x9f, 0x01, 0x00, 0xd3, 0x5e, 0xeb, 0xb5, 0x1b,
+	0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -972,6 +1386,21 @@ type ServiceClient interface {
 	GetLatestValidatorSet(ctx context.Context, in *GetLatestValidatorSetRequest, opts ...grpc.CallOption) (*GetLatestValidatorSetResponse, error)
 	// GetValidatorSetByHeight queries validator-set at a given height.
 	GetValidatorSetByHeight(ctx context.Context, in *GetValidatorSetByHeightRequest, opts ...grpc.CallOption) (*GetValidatorSetByHeightResponse, error)
+	// SubscribeBlocks streams the new blocks, as the node commits them.
+	//
+	// Since: cosmos-sdk 0.46
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Service_SubscribeBlocksClient, error)
+	// GetAppInfo queries the application metadata of the node: its version, the
+	// sign modes it accepts and its modules, with their consensus versions.
+	//
+	// Since: cosmos-sdk 0.46
+	GetAppInfo(ctx context.Context, in *GetAppInfoRequest, opts ...grpc.CallOption) (*GetAppInfoResponse, error)
+	// StoreProof queries the value of a key of a store of the application, with
+	// the ICS-23 proofs of its existence, or of its absence, against the app hash
+	// of the height.
+	//
+	// Since: cosmos-sdk 0.46
+	StoreProof(ctx context.Context, in *StoreProofRequest, opts ...grpc.CallOption) (*StoreProofResponse, error)
 }
 
 type serviceClient struct {
@@ -1036,6 +1465,56 @@ func (c *serviceClient) GetValidatorSetByHeight(ctx context.Context, in *GetVali
 	return out, nil
 }
 
+func (c *serviceClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Service_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[0], "/cosmos.base.tendermint.v1beta1.Service/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribeBlocksClient interface {
+	Recv() (*SubscribeBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribeBlocksClient) Recv() (*SubscribeBlocksResponse, error) {
+	m := new(SubscribeBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) GetAppInfo(ctx context.Context, in *GetAppInfoRequest, opts ...grpc.CallOption) (*GetAppInfoResponse, error) {
+	out := new(GetAppInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/GetAppInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) StoreProof(ctx context.Context, in *StoreProofRequest, opts ...grpc.CallOption) (*StoreProofResponse, error) {
+	out := new(StoreProofResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/StoreProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// GetNodeInfo queries the current node info.
@@ -1050,6 +1529,21 @@ type ServiceServer interface {
 	GetLatestValidatorSet(context.Context, *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error)
 	// GetValidatorSetByHeight queries validator-set at a given height.
 	GetValidatorSetByHeight(context.Context, *GetValidatorSetByHeightRequest) (*GetValidatorSetByHeightResponse, error)
+	// SubscribeBlocks streams the new blocks, as the node commits them.
+	//
+	// Since: cosmos-sdk 0.46
+	SubscribeBlocks(*SubscribeBlocksRequest, Service_SubscribeBlocksServer) error
+	// GetAppInfo queries the application metadata of the node: its version, the
+	// sign modes it accepts and its modules, with their consensus versions.
+	//
+	// Since: cosmos-sdk 0.46
+	GetAppInfo(context.Context, *GetAppInfoRequest) (*GetAppInfoResponse, error)
+	// StoreProof queries the value of a key of a store of the application, with
+	// the ICS-23 proofs of its existence, or of its absence, against the app hash
+	// of the height.
+	//
+	// Since: cosmos-sdk 0.46
+	StoreProof(context.Context, *StoreProofRequest) (*StoreProofResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1074,6 +1568,15 @@ func (*UnimplementedServiceServer) GetLatestValidatorSet(ctx context.Context, re
 func (*UnimplementedServiceServer) GetValidatorSetByHeight(ctx context.Context, req *GetValidatorSetByHeightRequest) (*GetValidatorSetByHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSetByHeight not implemented")
 }
+func (*UnimplementedServiceServer) SubscribeBlocks(req *SubscribeBlocksRequest, srv Service_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+func (*UnimplementedServiceServer) GetAppInfo(ctx context.Context, req *GetAppInfoRequest) (*GetAppInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppInfo not implemented")
+}
+func (*UnimplementedServiceServer) StoreProof(ctx context.Context, req *StoreProofRequest) (*StoreProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreProof not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1187,7 +1690,64 @@ func _Service_GetValidatorSetByHeight_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Service_serviceDesc = grpc.ServiceDesc{
+func _Service_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).SubscribeBlocks(m, &serviceSubscribeBlocksServer{stream})
+}
+
+type Service_SubscribeBlocksServer interface {
+	Send(*SubscribeBlocksResponse) error
+	grpc.ServerStream
+}
+
+type serviceSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribeBlocksServer) Send(m *SubscribeBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_GetAppInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetAppInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/GetAppInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetAppInfo(ctx, req.(*GetAppInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_StoreProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StoreProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/StoreProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StoreProof(ctx, req.(*StoreProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.tendermint.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -1215,8 +1775,22 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorSetByHeight",
 			Handler:    _Service_GetValidatorSetByHeight_Handler,
 		},
+		{
+			MethodName: "GetAppInfo",
+			Handler:    _Service_GetAppInfo_Handler,
+		},
+		{
+			MethodName: "StoreProof",
+			Handler:    _Service_StoreProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _Service_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/tendermint/v1beta1/query.proto",
 }
 
@@ -1856,116 +2430,403 @@ func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SubscribeBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetValidatorSetByHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SubscribeBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetValidatorSetByHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubscribeBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetLatestValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAppInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAppInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAppInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetLatestValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAppInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAppInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAppInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Modules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.SignModes) > 0 {
+		for iNdEx := len(m.SignModes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignModes[iNdEx])
+			copy(dAtA[i:], m.SignModes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SignModes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ApplicationVersion != nil {
+		{
+			size, err := m.ApplicationVersion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AppModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AppModule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+	if m.ConsensusVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusVersion))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ProposerPriority != 0 {
-		n += 1 + sovQuery(uint64(m.ProposerPriority))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockByHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StoreProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RootProof != nil {
+		{
+			size, err := m.RootProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StoreProof != nil {
+		{
+			size, err := m.StoreProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetValidatorSetByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetValidatorSetByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.ProposerPriority != 0 {
+		n += 1 + sovQuery(uint64(m.ProposerPriority))
+	}
+	return n
+}
+
+func (m *GetBlockByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2129,48 +2990,168 @@ func (m *Module) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SubscribeBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SubscribeBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorSetByHeightRequest: wiretype end group for non-group")
+
+func (m *GetAppInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAppInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationVersion != nil {
+		l = m.ApplicationVersion.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SignModes) > 0 {
+		for _, s := range m.SignModes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorSetByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+	}
+	return n
+}
+
+func (m *AppModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsensusVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusVersion))
+	}
+	return n
+}
+
+func (m *StoreProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *StoreProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StoreProof != nil {
+		l = m.StoreProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RootProof != nil {
+		l = m.RootProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
 				if iNdEx >= l {
@@ -2218,7 +3199,596 @@ func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerPriority", wireType)
+			}
+			m.ProposerPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2240,7 +3810,7 @@ func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2263,34 +3833,15 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorSetByHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBlockByHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorSetByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBlockByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2317,14 +3868,16 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BlockId == nil {
+				m.BlockId = &types1.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2351,10 +3904,10 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Block == nil {
+				m.Block = &types1.Block{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2379,7 +3932,7 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
+func (m *GetLatestBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2402,48 +3955,12 @@ func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestValidatorSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLatestBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLatestBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2465,7 +3982,7 @@ func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *GetLatestBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2488,34 +4005,15 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLatestBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLatestBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2542,14 +4040,16 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BlockId == nil {
+				m.BlockId = &types1.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2576,10 +4076,10 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Block == nil {
+				m.Block = &types1.Block{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2604,7 +4104,7 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *GetSyncingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2627,104 +4127,67 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSyncingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSyncingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSyncingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubKey == nil {
-				m.PubKey = &types.Any{}
-			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
-			}
-			m.VotingPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotingPower |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSyncingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSyncingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerPriority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
 			}
-			m.ProposerPriority = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2734,11 +4197,12 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposerPriority |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Syncing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2760,7 +4224,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockByHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *GetNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2783,31 +4247,12 @@ func (m *GetBlockByHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockByHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNodeInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2829,7 +4274,7 @@ func (m *GetBlockByHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2852,15 +4297,15 @@ func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockByHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNodeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2887,16 +4332,16 @@ func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockId == nil {
-				m.BlockId = &types1.BlockID{}
+			if m.NodeInfo == nil {
+				m.NodeInfo = &p2p.NodeInfo{}
 			}
-			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationVersion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2923,10 +4368,10 @@ func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &types1.Block{}
+			if m.ApplicationVersion == nil {
+				m.ApplicationVersion = &VersionInfo{}
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2951,7 +4396,7 @@ func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2974,65 +4419,207 @@ func (m *GetLatestBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetLatestBlockResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildTags = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestBlockResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildDeps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3059,18 +4646,16 @@ func (m *GetLatestBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockId == nil {
-				m.BlockId = &types1.BlockID{}
-			}
-			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BuildDeps = append(m.BuildDeps, &Module{})
+			if err := m.BuildDeps[len(m.BuildDeps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSdkVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3080,27 +4665,23 @@ func (m *GetLatestBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &types1.Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CosmosSdkVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3123,7 +4704,7 @@ func (m *GetLatestBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSyncingRequest) Unmarshal(dAtA []byte) error {
+func (m *Module) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3146,67 +4727,81 @@ func (m *GetSyncingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSyncingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Module: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSyncingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Module: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetSyncingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetSyncingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSyncingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3216,12 +4811,24 @@ func (m *GetSyncingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Syncing = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3243,7 +4850,7 @@ func (m *GetSyncingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNodeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3266,10 +4873,10 @@ func (m *GetNodeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNodeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeBlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3293,7 +4900,7 @@ func (m *GetNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *SubscribeBlocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3316,15 +4923,15 @@ func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeBlocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3351,16 +4958,16 @@ func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeInfo == nil {
-				m.NodeInfo = &p2p.NodeInfo{}
+			if m.BlockId == nil {
+				m.BlockId = &types1.BlockID{}
 			}
-			if err := m.NodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3387,13 +4994,63 @@ func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplicationVersion == nil {
-				m.ApplicationVersion = &VersionInfo{}
+			if m.Block == nil {
+				m.Block = &types1.Block{}
 			}
-			if err := m.ApplicationVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAppInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAppInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAppInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3415,7 +5072,7 @@ func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionInfo) Unmarshal(dAtA []byte) error {
+func (m *GetAppInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3438,17 +5095,17 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAppInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAppInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationVersion", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3458,27 +5115,31 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ApplicationVersion == nil {
+				m.ApplicationVersion = &VersionInfo{}
+			}
+			if err := m.ApplicationVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignModes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3506,13 +5167,13 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppName = string(dAtA[iNdEx:postIndex])
+			m.SignModes = append(m.SignModes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3522,27 +5183,79 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Modules = append(m.Modules, &AppModule{})
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3570,13 +5283,13 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitCommit = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildTags", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVersion", wireType)
 			}
-			var stringLen uint64
+			m.ConsensusVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3586,27 +5299,64 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ConsensusVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildTags = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3634,13 +5384,13 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			m.Store = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildDeps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3650,31 +5400,31 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildDeps = append(m.BuildDeps, &Module{})
-			if err := m.BuildDeps[len(m.BuildDeps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSdkVersion", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3684,24 +5434,11 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CosmosSdkVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3723,7 +5460,7 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Module) Unmarshal(dAtA []byte) error {
+func (m *StoreProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3746,17 +5483,36 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Module: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Module: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3766,29 +5522,31 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreProof", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3798,29 +5556,33 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.StoreProof == nil {
+				m.StoreProof = &_go.CommitmentProof{}
+			}
+			if err := m.StoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootProof", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3830,23 +5592,27 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sum = string(dAtA[iNdEx:postIndex])
+			if m.RootProof == nil {
+				m.RootProof = &_go.CommitmentProof{}
+			}
+			if err := m.RootProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
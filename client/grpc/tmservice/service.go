@@ -2,16 +2,21 @@ package tmservice
 
 import (
 	"context"
+	"sort"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/subscription"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	qtypes "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -20,16 +25,25 @@ import (
 type queryServer struct {
 	clientCtx         client.Context
 	interfaceRegistry codectypes.InterfaceRegistry
+	moduleVersions    module.VersionMap
+	abciQuery         ABCIQueryFn
 }
 
 var _ ServiceServer = queryServer{}
 var _ codectypes.UnpackInterfacesMessage = &GetLatestValidatorSetResponse{}
 
-// NewQueryServer creates a new tendermint query server.
-func NewQueryServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry) ServiceServer {
+// NewQueryServer creates a new tendermint query server, reporting the given
+// consensus versions of the modules of the app, and proving the data of its
+// stores with its ABCI Query method, if not nil.
+func NewQueryServer(
+	clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry, moduleVersions module.VersionMap,
+	abciQuery ABCIQueryFn,
+) ServiceServer {
 	return queryServer{
 		clientCtx:         clientCtx,
 		interfaceRegistry: interfaceRegistry,
+		moduleVersions:    moduleVersions,
+		abciQuery:         abciQuery,
 	}
 }
 
@@ -169,6 +183,62 @@ func (s queryServer) GetNodeInfo(ctx context.Context, req *GetNodeInfoRequest) (
 	}
 
 	protoNodeInfo := status.NodeInfo.ToProto()
+
+	resp := GetNodeInfoResponse{
+		NodeInfo:           protoNodeInfo,
+		ApplicationVersion: versionInfo(),
+	}
+	return &resp, nil
+}
+
+// GetAppInfo implements ServiceServer.GetAppInfo
+func (s queryServer) GetAppInfo(_ context.Context, _ *GetAppInfoRequest) (*GetAppInfoResponse, error) {
+	var signModes []string
+	if s.clientCtx.TxConfig != nil {
+		for _, mode := range s.clientCtx.TxConfig.SignModeHandler().Modes() {
+			signModes = append(signModes, mode.String())
+		}
+	}
+
+	modules := make([]*AppModule, 0, len(s.moduleVersions))
+	for name, consensusVersion := range s.moduleVersions {
+		modules = append(modules, &AppModule{
+			Name:             name,
+			ConsensusVersion: consensusVersion,
+		})
+	}
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Name < modules[j].Name })
+
+	return &GetAppInfoResponse{
+		ApplicationVersion: versionInfo(),
+		SignModes:          signModes,
+		Modules:            modules,
+	}, nil
+}
+
+// StoreProof implements ServiceServer.StoreProof
+func (s queryServer) StoreProof(_ context.Context, req *StoreProofRequest) (*StoreProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Store == "" {
+		return nil, status.Error(codes.InvalidArgument, "store cannot be empty")
+	}
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "key cannot be empty")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+	if s.abciQuery == nil {
+		return nil, status.Error(codes.Unimplemented, "store proofs are not served by this node")
+	}
+
+	return getStoreProof(s.abciQuery, req.Store, req.Key, req.Height)
+}
+
+// versionInfo returns the version of the app binary.
+func versionInfo() *VersionInfo {
 	nodeInfo := version.NewInfo()
 
 	deps := make([]*Module, len(nodeInfo.BuildDeps))
@@ -181,31 +251,54 @@ func (s queryServer) GetNodeInfo(ctx context.Context, req *GetNodeInfoRequest) (
 		}
 	}
 
-	resp := GetNodeInfoResponse{
-		NodeInfo: protoNodeInfo,
-		ApplicationVersion: &VersionInfo{
-			AppName:          nodeInfo.AppName,
-			Name:             nodeInfo.Name,
-			GitCommit:        nodeInfo.GitCommit,
-			GoVersion:        nodeInfo.GoVersion,
-			Version:          nodeInfo.Version,
-			BuildTags:        nodeInfo.BuildTags,
-			BuildDeps:        deps,
-			CosmosSdkVersion: nodeInfo.CosmosSdkVersion,
-		},
+	return &VersionInfo{
+		AppName:          nodeInfo.AppName,
+		Name:             nodeInfo.Name,
+		GitCommit:        nodeInfo.GitCommit,
+		GoVersion:        nodeInfo.GoVersion,
+		Version:          nodeInfo.Version,
+		BuildTags:        nodeInfo.BuildTags,
+		BuildDeps:        deps,
+		CosmosSdkVersion: nodeInfo.CosmosSdkVersion,
 	}
-	return &resp, nil
 }
 
-// RegisterTendermintService registers the tendermint queries on the gRPC router.
+// SubscribeBlocks implements ServiceServer.SubscribeBlocks
+func (s queryServer) SubscribeBlocks(_ *SubscribeBlocksRequest, stream Service_SubscribeBlocksServer) error {
+	ctx := stream.Context()
+	return subscription.Stream(ctx, s.clientCtx.Client, tmtypes.EventQueryNewBlock.String(), subscription.ConfigFromContext(ctx), func(event coretypes.ResultEvent) error {
+		data, ok := event.Data.(tmtypes.EventDataNewBlock)
+		if !ok {
+			return status.Errorf(codes.Internal, "unexpected new block event data %T", event.Data)
+		}
+
+		protoBlockID := data.BlockID.ToProto()
+		protoBlock, err := data.Block.ToProto()
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(&SubscribeBlocksResponse{
+			BlockId: &protoBlockID,
+			Block:   protoBlock,
+		})
+	})
+}
+
+// RegisterTendermintService registers the tendermint queries on the gRPC router,
+// with the consensus versions of the modules of the app, as returned by
+// module.Manager.GetVersionMap, and its ABCI Query method proving the data of
+// its stores.
 func RegisterTendermintService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	moduleVersions module.VersionMap,
+	abciQuery ABCIQueryFn,
 ) {
 	RegisterServiceServer(
 		qrt,
-		NewQueryServer(clientCtx, interfaceRegistry),
+		NewQueryServer(clientCtx, interfaceRegistry, moduleVersions, abciQuery),
 	)
 }
 
@@ -2,18 +2,26 @@ package tmservice_test
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/subscription"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/cosmos/cosmos-sdk/testutil/rest"
 	qtypes "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 type IntegrationTestSuite struct {
@@ -48,7 +56,7 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 	s.network.Cleanup()
 }
 
-func (s IntegrationTestSuite) TestQueryNodeInfo() {
+func (s *IntegrationTestSuite) TestQueryNodeInfo() {
 	val := s.network.Validators[0]
 
 	res, err := s.queryClient.GetNodeInfo(context.Background(), &tmservice.GetNodeInfoRequest{})
@@ -62,7 +70,70 @@ func (s IntegrationTestSuite) TestQueryNodeInfo() {
 	s.Require().Equal(getInfoRes.ApplicationVersion.AppName, version.NewInfo().AppName)
 }
 
-func (s IntegrationTestSuite) TestQuerySyncing() {
+func (s *IntegrationTestSuite) TestQueryAppInfo() {
+	val := s.network.Validators[0]
+
+	res, err := s.queryClient.GetAppInfo(context.Background(), &tmservice.GetAppInfoRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(version.NewInfo().AppName, res.ApplicationVersion.AppName)
+	s.Require().Contains(res.SignModes, signing.SignMode_SIGN_MODE_DIRECT.String())
+	s.Require().Contains(res.Modules, &tmservice.AppModule{Name: "bank", ConsensusVersion: 4})
+
+	restRes, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/base/tendermint/v1beta1/app_info", val.APIAddress))
+	s.Require().NoError(err)
+	var appInfoRes tmservice.GetAppInfoResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(restRes, &appInfoRes))
+	s.Require().Equal(res.Modules, appInfoRes.Modules)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tmservice.NodeInfoCommand(), []string{"--app", "--output=json"})
+	s.Require().NoError(err)
+	var cliRes tmservice.GetAppInfoResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &cliRes))
+	s.Require().Equal(res.SignModes, cliRes.SignModes)
+	s.Require().Equal(res.Modules, cliRes.Modules)
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, tmservice.NodeInfoCommand(), []string{"--output=json"})
+	s.Require().NoError(err)
+	var nodeInfoRes tmservice.GetNodeInfoResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &nodeInfoRes))
+	s.Require().Equal(s.cfg.ChainID, nodeInfoRes.NodeInfo.Network)
+}
+
+func (s *IntegrationTestSuite) TestQueryStoreProof() {
+	val := s.network.Validators[0]
+
+	height, err := s.network.WaitForHeight(2)
+	s.Require().NoError(err)
+
+	balanceKey := append(banktypes.CreateAccountBalancesPrefix(val.Address), []byte(s.cfg.BondDenom)...)
+	res, err := s.queryClient.StoreProof(context.Background(), &tmservice.StoreProofRequest{Store: "bank", Key: balanceKey, Height: height})
+	s.Require().NoError(err)
+	s.Require().Equal(height, res.Height)
+	s.Require().NotEmpty(res.Value)
+
+	// the app hash of the height is in the header of the next block
+	_, err = s.network.WaitForHeight(height + 1)
+	s.Require().NoError(err)
+	block, err := s.queryClient.GetBlockByHeight(context.Background(), &tmservice.GetBlockByHeightRequest{Height: height + 1})
+	s.Require().NoError(err)
+	appHash := block.Block.Header.AppHash
+	s.Require().NoError(tmservice.VerifyStoreProof(appHash, "bank", balanceKey, res))
+
+	absentKey := append(banktypes.CreateAccountBalancesPrefix(val.Address), []byte("absent")...)
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tmservice.StoreProofCommand(), []string{
+		"bank", hex.EncodeToString(absentKey), fmt.Sprintf("--height=%d", height), "--output=json",
+	})
+	s.Require().NoError(err)
+	var cliRes tmservice.StoreProofResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &cliRes))
+	s.Require().Empty(cliRes.Value)
+	s.Require().NoError(tmservice.VerifyStoreProof(appHash, "bank", absentKey, &cliRes))
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, tmservice.StoreProofCommand(), []string{"bank", "not-hex"})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestQuerySyncing() {
 	val := s.network.Validators[0]
 
 	_, err := s.queryClient.GetSyncing(context.Background(), &tmservice.GetSyncingRequest{})
@@ -74,7 +145,7 @@ func (s IntegrationTestSuite) TestQuerySyncing() {
 	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(restRes, &syncingRes))
 }
 
-func (s IntegrationTestSuite) TestQueryLatestBlock() {
+func (s *IntegrationTestSuite) TestQueryLatestBlock() {
 	val := s.network.Validators[0]
 
 	_, err := s.queryClient.GetLatestBlock(context.Background(), &tmservice.GetLatestBlockRequest{})
@@ -86,7 +157,7 @@ func (s IntegrationTestSuite) TestQueryLatestBlock() {
 	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(restRes, &blockInfoRes))
 }
 
-func (s IntegrationTestSuite) TestQueryBlockByHeight() {
+func (s *IntegrationTestSuite) TestQueryBlockByHeight() {
 	val := s.network.Validators[0]
 	_, err := s.queryClient.GetBlockByHeight(context.Background(), &tmservice.GetBlockByHeightRequest{Height: 1})
 	s.Require().NoError(err)
@@ -97,7 +168,7 @@ func (s IntegrationTestSuite) TestQueryBlockByHeight() {
 	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(restRes, &blockInfoRes))
 }
 
-func (s IntegrationTestSuite) TestQueryLatestValidatorSet() {
+func (s *IntegrationTestSuite) TestQueryLatestValidatorSet() {
 	val := s.network.Validators[0]
 
 	// nil pagination
@@ -132,7 +203,7 @@ func (s IntegrationTestSuite) TestQueryLatestValidatorSet() {
 	s.Require().Equal(validatorSetRes.Validators[0].PubKey, anyPub)
 }
 
-func (s IntegrationTestSuite) TestLatestValidatorSet_GRPC() {
+func (s *IntegrationTestSuite) TestLatestValidatorSet_GRPC() {
 	vals := s.network.Validators
 	testCases := []struct {
 		name      string
@@ -163,7 +234,7 @@ func (s IntegrationTestSuite) TestLatestValidatorSet_GRPC() {
 	}
 }
 
-func (s IntegrationTestSuite) TestLatestValidatorSet_GRPCGateway() {
+func (s *IntegrationTestSuite) TestLatestValidatorSet_GRPCGateway() {
 	vals := s.network.Validators
 	testCases := []struct {
 		name      string
@@ -195,7 +266,7 @@ func (s IntegrationTestSuite) TestLatestValidatorSet_GRPCGateway() {
 	}
 }
 
-func (s IntegrationTestSuite) TestValidatorSetByHeight_GRPC() {
+func (s *IntegrationTestSuite) TestValidatorSetByHeight_GRPC() {
 	vals := s.network.Validators
 	testCases := []struct {
 		name      string
@@ -224,7 +295,7 @@ func (s IntegrationTestSuite) TestValidatorSetByHeight_GRPC() {
 	}
 }
 
-func (s IntegrationTestSuite) TestValidatorSetByHeight_GRPCGateway() {
+func (s *IntegrationTestSuite) TestValidatorSetByHeight_GRPCGateway() {
 	vals := s.network.Validators
 	testCases := []struct {
 		name      string
@@ -254,6 +325,105 @@ func (s IntegrationTestSuite) TestValidatorSetByHeight_GRPCGateway() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestSubscribeBlocks() {
+	val := s.network.Validators[0]
+	conn, err := grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := tmservice.NewServiceClient(conn).SubscribeBlocks(ctx, &tmservice.SubscribeBlocksRequest{})
+	s.Require().NoError(err)
+
+	// the new blocks arrive in order
+	var height int64
+	for i := 0; i < 3; i++ {
+		res, err := stream.Recv()
+		s.Require().NoError(err)
+		s.Require().NotEmpty(res.BlockId.Hash)
+		if i > 0 {
+			s.Require().Equal(height+1, res.Block.Header.Height)
+		}
+		height = res.Block.Header.Height
+	}
+
+	cancel()
+	_, err = stream.Recv()
+	s.Require().Equal(codes.Canceled, status.Code(err))
+}
+
+func (s *IntegrationTestSuite) TestSubscribeBlocksBackpressure() {
+	val := s.network.Validators[0]
+	srv := tmservice.NewQueryServer(val.ClientCtx, val.ClientCtx.InterfaceRegistry, nil, nil)
+
+	s.Run("disconnect", func() {
+		unblock := make(chan struct{})
+		defer close(unblock)
+
+		// a client receiving no block, one block being sent to it, one
+		// buffered and one too many
+		cfg := subscription.Config{BufferSize: 1, Backpressure: subscription.BackpressureDisconnect}
+		err := subscribeBlocks(context.Background(), srv, cfg, func(*tmservice.SubscribeBlocksResponse) error {
+			<-unblock
+			return nil
+		})
+		s.Require().Equal(codes.ResourceExhausted, status.Code(err), err)
+	})
+
+	s.Run("drop", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		heights := make(chan int64, 10)
+		unblock := make(chan struct{})
+		cfg := subscription.Config{BufferSize: 1, Backpressure: subscription.BackpressureDrop}
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- subscribeBlocks(ctx, srv, cfg, func(res *tmservice.SubscribeBlocksResponse) error {
+				heights <- res.Block.Header.Height
+				<-unblock
+				return nil
+			})
+		}()
+
+		// the client receives the first block slowly, while the next one is
+		// buffered and the two after it dropped
+		first := <-heights
+		_, err := s.network.WaitForHeight(first + 3)
+		s.Require().NoError(err)
+		close(unblock)
+
+		s.Require().Equal(first+1, <-heights)
+		s.Require().Greater(<-heights, first+2)
+
+		cancel()
+		s.Require().Equal(codes.Canceled, status.Code(<-errCh))
+	})
+}
+
+// blocksStream is the server stream of SubscribeBlocks of a client passing its
+// blocks to send.
+type blocksStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	send func(*tmservice.SubscribeBlocksResponse) error
+}
+
+func (s blocksStream) Context() context.Context                          { return s.ctx }
+func (s blocksStream) Send(res *tmservice.SubscribeBlocksResponse) error { return s.send(res) }
+
+// subscribeBlocks calls SubscribeBlocks of the server in process, as the gRPC
+// server does with the subscription config.
+func subscribeBlocks(
+	ctx context.Context, srv tmservice.ServiceServer, cfg subscription.Config, send func(*tmservice.SubscribeBlocksResponse) error,
+) error {
+	interceptor := subscription.StreamServerInterceptor(cfg)
+	return interceptor(srv, blocksStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(_ interface{}, ss grpc.ServerStream) error {
+		return srv.SubscribeBlocks(&tmservice.SubscribeBlocksRequest{}, blocksStream{ServerStream: ss, ctx: ss.Context(), send: send})
+	})
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -56,21 +56,19 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 		return err
 	}
 
-	// parse height header
+	// parse height headers
 	md, _ := metadata.FromOutgoingContext(grpcCtx)
-	if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
-		height, err := strconv.ParseInt(heights[0], 10, 64)
-		if err != nil {
-			return err
-		}
-		if height < 0 {
-			return sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"client.Context.Invoke: height (%d) from %q must be >= 0", height, grpctypes.GRPCBlockHeightHeader)
-		}
-
+	height, err := parseHeightHeader(md, grpctypes.GRPCBlockHeightHeader)
+	if err != nil {
+		return err
+	}
+	if height > 0 {
 		ctx = ctx.WithHeight(height)
 	}
+	minHeight, err := parseHeightHeader(md, grpctypes.GRPCMinBlockHeightHeader)
+	if err != nil {
+		return err
+	}
 
 	abciReq := abci.RequestQuery{
 		Path:   method,
@@ -79,28 +77,37 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 	}
 
 	res, err := ctx.QueryABCI(abciReq)
-	if err != nil {
-		return err
-	}
-
-	err = protoCodec.Unmarshal(res.Value, reply)
-	if err != nil {
-		return err
-	}
 
-	// Create header metadata. For now the headers contain:
+	// Create header metadata, also for the failed queries run at a height.
+	// For now the headers contain:
 	// - block height
 	// We then parse all the call options, if the call option is a
 	// HeaderCallOption, then we manually set the value of that header to the
 	// metadata.
-	md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(res.Height, 10))
-	for _, callOpt := range opts {
-		header, ok := callOpt.(grpc.HeaderCallOption)
-		if !ok {
-			continue
+	if err == nil || res.Height > 0 {
+		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(res.Height, 10))
+		for _, callOpt := range opts {
+			header, ok := callOpt.(grpc.HeaderCallOption)
+			if !ok {
+				continue
+			}
+
+			*header.HeaderAddr = md
 		}
+	}
+	if err != nil {
+		return err
+	}
 
-		*header.HeaderAddr = md
+	// ABCI queries cannot carry the minimum height, which is checked against
+	// the height of the response instead.
+	if err := grpctypes.CheckMinBlockHeight(res.Height, minHeight); err != nil {
+		return err
+	}
+
+	err = protoCodec.Unmarshal(res.Value, reply)
+	if err != nil {
+		return err
 	}
 
 	if ctx.InterfaceRegistry != nil {
@@ -110,6 +117,27 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 	return nil
 }
 
+// parseHeightHeader returns the height of the given header of the outgoing
+// metadata, or 0 if it is not present.
+func parseHeightHeader(md metadata.MD, header string) (int64, error) {
+	heights := md.Get(header)
+	if len(heights) == 0 {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if height < 0 {
+		return 0, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"client.Context.Invoke: height (%d) from %q must be >= 0", height, header)
+	}
+
+	return height, nil
+}
+
 // NewStream implements the grpc ClientConn.NewStream method
 func (Context) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
 	return nil, fmt.Errorf("streaming rpc not supported")
@@ -9,7 +9,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -78,6 +80,48 @@ func (s *IntegrationTestSuite) TestGRPCQuery() {
 	s.Require().Equal([]string{"1"}, blockHeight)
 }
 
+func (s *IntegrationTestSuite) TestGRPCQueryMinHeight() {
+	val0 := s.network.Validators[0]
+	denom := fmt.Sprintf("%stoken", val0.Moniker)
+	req := &banktypes.QueryBalanceRequest{Address: val0.Address.String(), Denom: denom}
+
+	latest, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		height    int64
+		minHeight int64
+		expErr    bool
+	}{
+		{"reached minimum height", 0, 1, false},
+		// the client pinned at a height behind the minimum one, as the latest
+		// one of a lagging node
+		{"pinned height below minimum height", 1, 2, true},
+		{"latest height below minimum height", 0, latest + 1000, true},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCMinBlockHeightHeader, fmt.Sprint(tc.minHeight))
+			bankClient := banktypes.NewQueryClient(val0.ClientCtx.WithHeight(tc.height))
+
+			var header metadata.MD
+			_, err := bankClient.Balance(ctx, req, grpc.Header(&header))
+			if tc.expErr {
+				s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+			} else {
+				s.Require().NoError(err)
+			}
+
+			// the response carries the height it was run at, failed or not
+			s.Require().Len(header.Get(grpctypes.GRPCBlockHeightHeader), 1)
+			if tc.height != 0 {
+				s.Require().Equal([]string{fmt.Sprint(tc.height)}, header.Get(grpctypes.GRPCBlockHeightHeader))
+			}
+		})
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/bgentry/speakeasy"
 	isatty "github.com/mattn/go-isatty"
+	"golang.org/x/term"
 )
 
 // MinPassLength is the minimum acceptable password length
@@ -36,6 +37,41 @@ func GetPassword(prompt string, buf *bufio.Reader) (pass string, err error) {
 	return pass, nil
 }
 
+// TTY is the terminal of the process, opened to prompt the user when the
+// standard input carries data, such as a tx piped to tx sign.
+type TTY struct {
+	*os.File
+}
+
+// OpenTTY opens the terminal of the process. It fails if the process has no
+// terminal, e.g. when it runs in a script.
+func OpenTTY() (*TTY, error) {
+	f, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the terminal: %w", err)
+	}
+
+	return &TTY{File: f}, nil
+}
+
+// GetPasswordFromTTY prompts for a password on the terminal, without echoing
+// it. It enforces the password length like GetPassword.
+func GetPasswordFromTTY(prompt string, tty *TTY) (string, error) {
+	fmt.Fprint(tty, prompt)
+	bz, err := term.ReadPassword(int(tty.Fd()))
+	fmt.Fprintln(tty)
+	if err != nil {
+		return "", err
+	}
+
+	pass := strings.TrimSpace(string(bz))
+	if len(pass) < MinPassLength {
+		return pass, fmt.Errorf("password must be at least %d characters", MinPassLength)
+	}
+
+	return pass, nil
+}
+
 // GetConfirmation will request user give the confirmation from stdin.
 // "y", "Y", "yes", "YES", and "Yes" all count as confirmations.
 // If the input is not recognized, it returns false and a nil error.
@@ -83,6 +119,17 @@ func inputIsTty() bool {
 	return isatty.IsTerminal(os.Stdin.Fd()) || isatty.IsCygwinTerminal(os.Stdin.Fd())
 }
 
+// IsTerminal returns true if w is a terminal, such as the standard output of a
+// command that is not piped.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
 // readLineFromBuf reads one line from stdin.
 // Subsequent calls reuse the same buffer, so we don't lose
 // any input when reading a password twice (to verify)
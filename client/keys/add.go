@@ -3,9 +3,12 @@ package keys
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
 	"sort"
+	"strings"
 
 	"github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
@@ -13,11 +16,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32/legacybech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -30,6 +37,7 @@ const (
 	flagMultisig    = "multisig"
 	flagNoSort      = "nosort"
 	flagHDPath      = "hd-path"
+	flagLabel       = "label"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -55,15 +63,19 @@ multisig transactions.
 You can create and store a multisig key by passing the list of key names stored in a keyring
 and the minimum number of signatures required through --multisig-threshold. The keys are
 sorted by address, unless the flag --nosort is set.
+The public keys of keys not stored in the keyring can be passed instead of key names, in
+proto JSON, base64 (compressed secp256k1 keys) or Bech32 account public key format, or as
+@file, where file holds the public key.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+    keys add mymultisig --multisig "keyname1,AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ,@pubkey.json" --multisig-threshold 2
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
 	}
 	f := cmd.Flags()
-	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
+	f.StringArray(flagMultisig, nil, "List of key names stored in keyring, public keys or @files holding public keys to construct a public legacy multisig key")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
@@ -72,11 +84,12 @@ Example:
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
-	f.String(flagHDPath, "", "Manual HD Path derivation (overrides BIP44 config)")
+	f.String(flagHDPath, "", "Manual HD Path derivation (overrides BIP44 config), also for Ledger keys")
 	f.Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
 	f.Uint32(flagAccount, 0, "Account number for HD derivation")
 	f.Uint32(flagIndex, 0, "Address index number for HD derivation")
 	f.String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	f.String(flagLabel, "", "Free-text label describing the key, shown by the list and show commands")
 
 	return cmd
 }
@@ -117,6 +130,11 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return err
 	}
 
+	label, _ := cmd.Flags().GetString(flagLabel)
+	if err := keyring.ValidateLabel(label); err != nil {
+		return err
+	}
+
 	if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); dryRun {
 		// use in memory keybase
 		kb = keyring.NewInMemory(ctx.Codec)
@@ -139,7 +157,8 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 			}
 		}
 
-		multisigKeys, _ := cmd.Flags().GetStringSlice(flagMultisig)
+		multisigFlags, _ := cmd.Flags().GetStringArray(flagMultisig)
+		multisigKeys := splitMultisigKeys(multisigFlags)
 		if len(multisigKeys) != 0 {
 			pks := make([]cryptotypes.PubKey, len(multisigKeys))
 			multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
@@ -147,13 +166,8 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 				return err
 			}
 
-			for i, keyname := range multisigKeys {
-				k, err := kb.Key(keyname)
-				if err != nil {
-					return err
-				}
-
-				key, err := k.GetPubKey()
+			for i, keyref := range multisigKeys {
+				key, err := getMultisigPubKey(kb, ctx.Codec, keyref)
 				if err != nil {
 					return err
 				}
@@ -172,6 +186,10 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 				return err
 			}
 
+			if k, err = setKeyLabel(kb, k, label); err != nil {
+				return err
+			}
+
 			return printCreate(cmd, k, false, "", outputFormat)
 		}
 	}
@@ -188,6 +206,10 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 			return err
 		}
 
+		if k, err = setKeyLabel(kb, k, label); err != nil {
+			return err
+		}
+
 		return printCreate(cmd, k, false, "", outputFormat)
 	}
 
@@ -199,18 +221,22 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 
 	if len(hdPath) == 0 {
 		hdPath = hd.CreateHDPath(coinType, account, index).String()
-	} else if useLedger {
-		return errors.New("cannot set custom bip32 path with ledger")
+	} else if _, err := hd.NewParamsFromPath(hdPath); err != nil {
+		return fmt.Errorf("invalid hd path %s: %w", hdPath, err)
 	}
 
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
 	if useLedger {
 		bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
-		k, err := kb.SaveLedgerKey(name, hd.Secp256k1, bech32PrefixAccAddr, coinType, account, index)
+		k, err := kb.SaveLedgerKeyWithHDPath(name, algo, bech32PrefixAccAddr, hdPath)
 		if err != nil {
 			return err
 		}
 
+		if k, err = setKeyLabel(kb, k, label); err != nil {
+			return err
+		}
+
 		return printCreate(cmd, k, false, "", outputFormat)
 	}
 
@@ -285,6 +311,10 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		mnemonic = ""
 	}
 
+	if k, err = setKeyLabel(kb, k, label); err != nil {
+		return err
+	}
+
 	return printCreate(cmd, k, showMnemonic, mnemonic, outputFormat)
 }
 
@@ -292,7 +322,7 @@ func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemo
 	switch outputFormat {
 	case OutputFormatText:
 		cmd.PrintErrln()
-		printKeyringRecord(cmd.OutOrStdout(), k, keyring.MkAccKeyOutput, outputFormat)
+		printKeyringRecord(cmd.OutOrStdout(), k, keyring.MkAccKeyOutput, "", outputFormat)
 
 		// print mnemonic unless requested not to.
 		if showMnemonic {
@@ -324,3 +354,99 @@ func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemo
 
 	return nil
 }
+
+// setKeyLabel sets the label of the --label flag on a new key.
+func setKeyLabel(kb keyring.Keyring, k *keyring.Record, label string) (*keyring.Record, error) {
+	if label == "" {
+		return k, nil
+	}
+
+	return kb.SetLabel(k.Name, label)
+}
+
+// splitMultisigKeys splits the values of the --multisig flag on the commas
+// outside of the proto JSON public keys.
+func splitMultisigKeys(values []string) []string {
+	var keys []string
+	for _, value := range values {
+		depth, start := 0, 0
+		for i, c := range value {
+			switch c {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			case ',':
+				if depth == 0 {
+					keys = append(keys, strings.TrimSpace(value[start:i]))
+					start = i + 1
+				}
+			}
+		}
+		keys = append(keys, strings.TrimSpace(value[start:]))
+	}
+
+	return keys
+}
+
+// getMultisigPubKey returns the public key of a multisig member, given as the
+// name of a key of the keyring, as a public key or as @file where the file
+// holds the public key.
+func getMultisigPubKey(kb keyring.Keyring, cdc codec.Codec, keyref string) (cryptotypes.PubKey, error) {
+	if strings.HasPrefix(keyref, "@") {
+		bz, err := os.ReadFile(keyref[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		return parsePubKey(cdc, string(bz))
+	}
+
+	if strings.HasPrefix(keyref, "{") {
+		return parsePubKey(cdc, keyref)
+	}
+
+	k, err := kb.Key(keyref)
+	if err == nil {
+		return k.GetPubKey()
+	}
+	if !sdkerrors.IsOf(err, sdkerrors.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	pk, parseErr := parsePubKey(cdc, keyref)
+	if parseErr != nil {
+		return nil, fmt.Errorf("%s is neither a key of the keyring nor a public key: %v", keyref, parseErr)
+	}
+
+	return pk, nil
+}
+
+// parsePubKey parses a public key in proto JSON or Bech32 account public key
+// format, or a compressed secp256k1 public key in base64.
+func parsePubKey(cdc codec.Codec, pkStr string) (cryptotypes.PubKey, error) {
+	pkStr = strings.TrimSpace(pkStr)
+
+	if strings.HasPrefix(pkStr, "{") {
+		var pk cryptotypes.PubKey
+		if err := cdc.UnmarshalInterfaceJSON([]byte(pkStr), &pk); err != nil {
+			return nil, err
+		}
+
+		return pk, nil
+	}
+
+	if strings.HasPrefix(pkStr, sdk.GetConfig().GetBech32AccountPubPrefix()+"1") {
+		return legacybech32.UnmarshalPubKey(legacybech32.AccPK, pkStr) //nolint:staticcheck
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(pkStr)
+	if err != nil {
+		return nil, fmt.Errorf("expected a public key in proto JSON, Bech32 or base64 format")
+	}
+	if len(bz) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid secp256k1 public key length %d", len(bz))
+	}
+
+	return &secp256k1.PubKey{Key: bz}, nil
+}
@@ -136,6 +136,54 @@ func Test_runAddCmdLedger(t *testing.T) {
 		pub.String())
 }
 
+func Test_runAddCmdLedgerWithHDPath(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithCodec(encCfg.Codec)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd.SetArgs([]string{
+		"keyname1",
+		fmt.Sprintf("--%s=true", flags.FlagUseLedger),
+		fmt.Sprintf("--%s=m/44'/118'/3'/0/1", flagHDPath),
+		fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatText),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, encCfg.Codec)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = kb.Delete("keyname1")
+	})
+
+	key1, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeLedger, key1.GetType())
+	require.Equal(t, "m/44'/118'/3'/0/1", key1.GetPath().String())
+	pub, err := key1.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t,
+		"PubKeySecp256k1{03602C0CB4D8C0081FEE794BDE96E7B95FA16F2B5283B764AC070584327B2C7202}",
+		pub.String())
+
+	// malformed paths are rejected
+	cmd.SetArgs([]string{
+		"keyname2",
+		fmt.Sprintf("--%s=true", flags.FlagUseLedger),
+		fmt.Sprintf("--%s=m/44'/118'/3'", flagHDPath),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.Error(t, cmd.ExecuteContext(ctx))
+	_, err = kb.Key("keyname2")
+	require.Error(t, err)
+}
+
 func Test_runAddCmdLedgerDryRun(t *testing.T) {
 	cdc := simapp.MakeTestEncodingConfig().Codec
 	testData := []struct {
@@ -3,8 +3,12 @@ package keys
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -14,9 +18,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32/legacybech32"
 	"github.com/cosmos/go-bip39"
 )
 
@@ -275,3 +282,242 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", k.Name)
 }
+
+func Test_runAddCmdHDPath(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+
+	mockIn, mockOut := testutil.ApplyMockIO(cmd)
+	kbHome := t.TempDir()
+
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	// private key of the first Ethereum account of the mnemonic
+	ethPrivKey, err := hex.DecodeString("1ab42cc412b618bdea3a599e3c9bae199ebf030895b039e9db1e30dafb12b727")
+	require.NoError(t, err)
+
+	testData := []struct {
+		name     string
+		hdPath   string
+		expPath  string
+		expAddr  string
+		expError bool
+	}{
+		{"default path", "", "m/44'/118'/0'/0/0", "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4", false},
+		{"custom path", "m/44'/60'/0'/0/0", "m/44'/60'/0'/0/0", sdk.AccAddress((&secp256k1.PrivKey{Key: ethPrivKey}).PubKey().Address()).String(), false},
+		{"path too short", "m/44'/118'/0'", "", "", true},
+		{"non hardened coin type", "m/44'/118/0'/0/0", "", "", true},
+	}
+
+	for i, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			name := fmt.Sprintf("key%d", i)
+			cmd.SetArgs([]string{
+				name,
+				fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+				fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+				fmt.Sprintf("--%s=%s", flagHDPath, tt.hdPath),
+				fmt.Sprintf("--%s", flagRecover),
+			})
+			mockIn.Reset(mnemonic + "\n")
+			mockOut.Reset()
+
+			err := cmd.ExecuteContext(ctx)
+			if tt.expError {
+				require.Error(t, err)
+				_, err = kb.Key(name)
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Contains(t, mockOut.String(), fmt.Sprintf(`"hd_path":"%s"`, tt.expPath))
+
+			k, err := kb.Key(name)
+			require.NoError(t, err)
+			require.Equal(t, tt.expPath, k.GetPath().String())
+			addr, err := k.GetAddress()
+			require.NoError(t, err)
+			require.Equal(t, tt.expAddr, addr.String())
+		})
+	}
+}
+
+func Test_runAddCmdSecp256r1(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	runAdd := func(args ...string) (string, error) {
+		cmd := AddKeyCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		_, mockOut := testutil.ApplyMockIO(cmd)
+		cmd.SetArgs(append([]string{
+			fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+			fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyAlgorithm, hd.Secp256r1Type),
+		}, args...))
+		err := cmd.ExecuteContext(ctx)
+		return mockOut.String(), err
+	}
+
+	out, err := runAdd("r1")
+	require.NoError(t, err)
+	require.Contains(t, out, `"pubkey":"{\"@type\":\"/cosmos.crypto.secp256r1.PubKey\"`)
+
+	k, err := kb.Key("r1")
+	require.NoError(t, err)
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, string(hd.Secp256r1Type), pub.Type())
+
+	msg := []byte("some message")
+	sig, _, err := kb.Sign("r1", msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// Ledger devices do not support secp256r1 keys
+	_, err = runAdd("ledger", fmt.Sprintf("--%s", flags.FlagUseLedger))
+	require.EqualError(t, err, "unsupported signing algo: Ledger devices do not support secp256r1 keys")
+}
+
+func Test_runAddCmdMultisigPubKeys(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithCodec(cdc).WithKeyringDir(kbHome).WithKeyring(kb)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	runAdd := func(args ...string) error {
+		cmd := AddKeyCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		testutil.ApplyMockIODiscardOutErr(cmd)
+		cmd.SetArgs(args)
+		return cmd.ExecuteContext(ctx)
+	}
+
+	pks := make([]cryptotypes.PubKey, 3)
+	for i := range pks {
+		path := hd.NewFundraiserParams(0, sdk.CoinType, uint32(i)).String()
+		k, err := kb.NewAccount(fmt.Sprintf("key%d", i), testutil.TestMnemonic, "", path, hd.Secp256k1)
+		require.NoError(t, err)
+		pks[i], err = k.GetPubKey()
+		require.NoError(t, err)
+	}
+
+	jsonPubKey, err := cdc.MarshalInterfaceJSON(pks[2])
+	require.NoError(t, err)
+	jsonFile := testutil.WriteToNewTempFile(t, string(jsonPubKey))
+	base64PubKey := base64.StdEncoding.EncodeToString(pks[1].Bytes())
+	bech32PubKey, err := legacybech32.MarshalPubKey(legacybech32.AccPK, pks[2])
+	require.NoError(t, err)
+
+	require.NoError(t, runAdd("local", fmt.Sprintf("--%s=key0,key1,key2", flagMultisig), fmt.Sprintf("--%s=2", flagMultiSigThreshold)))
+	local, err := kb.Key("local")
+	require.NoError(t, err)
+	localAddr, err := local.GetAddress()
+	require.NoError(t, err)
+	// the keyring holds a single key of an address
+	require.NoError(t, kb.Delete("local"))
+
+	testData := []struct {
+		name      string
+		multisigs []string
+	}{
+		{"base64 and bech32", []string{fmt.Sprintf("key0,%s,%s", base64PubKey, bech32PubKey)}},
+		{"proto JSON", []string{fmt.Sprintf("%s,key0,%s", jsonPubKey, base64PubKey)}},
+		{"file", []string{"key0", base64PubKey, "@" + jsonFile.Name()}},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			args := []string{"multi", fmt.Sprintf("--%s=2", flagMultiSigThreshold)}
+			for _, multisig := range tt.multisigs {
+				args = append(args, fmt.Sprintf("--%s=%s", flagMultisig, multisig))
+			}
+			require.NoError(t, runAdd(args...))
+
+			k, err := kb.Key("multi")
+			require.NoError(t, err)
+			require.Equal(t, keyring.TypeMulti, k.GetType())
+			addr, err := k.GetAddress()
+			require.NoError(t, err)
+			require.Equal(t, localAddr, addr)
+
+			// the members are listed in the output of the multisig key
+			ko, err := keyring.MkAccKeyOutput(k)
+			require.NoError(t, err)
+			for _, pk := range pks {
+				require.Contains(t, ko.PubKey, base64.StdEncoding.EncodeToString(pk.Bytes()))
+			}
+			require.NoError(t, kb.Delete("multi"))
+		})
+	}
+
+	for _, invalid := range []string{"unknown", "AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6p", "@" + filepath.Join(t.TempDir(), "missing.json")} {
+		require.Error(t, runAdd("invalid", fmt.Sprintf("--%s=key0,key1,%s", flagMultisig, invalid), fmt.Sprintf("--%s=2", flagMultiSigThreshold)))
+		_, err = kb.Key("invalid")
+		require.Error(t, err)
+	}
+}
+
+func Test_runAddCmdLabel(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	runAdd := func(args ...string) (string, error) {
+		cmd := AddKeyCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		_, mockOut := testutil.ApplyMockIO(cmd)
+		cmd.SetArgs(append([]string{
+			fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+			fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		}, args...))
+		err := cmd.ExecuteContext(ctx)
+		return mockOut.String(), err
+	}
+
+	out, err := runAdd("labeled", fmt.Sprintf("--%s=%s", flagLabel, "backup of the validator"))
+	require.NoError(t, err)
+	require.Contains(t, out, `"label":"backup of the validator"`)
+	require.Contains(t, out, `"created_at":"`)
+
+	k, err := kb.Key("labeled")
+	require.NoError(t, err)
+	require.Equal(t, "backup of the validator", k.Label)
+
+	// the labels of offline keys are set as well
+	pk := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ"}`
+	_, err = runAdd("offline", fmt.Sprintf("--%s=%s", FlagPublicKey, pk), fmt.Sprintf("--%s=%s", flagLabel, "cosigner"))
+	require.NoError(t, err)
+	k, err = kb.Key("offline")
+	require.NoError(t, err)
+	require.Equal(t, "cosigner", k.Label)
+
+	// an invalid label is rejected before the key is created
+	_, err = runAdd("invalid", fmt.Sprintf("--%s=%s", flagLabel, strings.Repeat("a", 257)))
+	require.EqualError(t, err, "label is longer than 256 characters")
+	_, err = kb.Key("invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,74 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// BackupKeysCommand backs up all the keys of the key store in an encrypted file.
+func BackupKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup --output <file>",
+		Short: "Back up all the keys in an encrypted file",
+		Long: `Back up all the keys of the keyring, except the Ledger ones, in a single file
+encrypted with a passphrase (scrypt and AES-GCM). The passphrase is prompted, or
+read from --passphrase-file. The keys are restored with the restore command.
+
+The backup holds the private keys: keep it, and its passphrase, safe.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			outputFile, _ := cmd.Flags().GetString(cli.OutputFlag)
+			if outputFile == "" {
+				return errors.New("the backup file must be set with --output")
+			}
+
+			passphrase, err := readPassphrase(cmd, "Enter passphrase to encrypt the backup:", buf)
+			if err != nil {
+				return err
+			}
+
+			backup, records, err := clientCtx.Keyring.Backup(passphrase)
+			if err != nil {
+				return err
+			}
+
+			// the backup never replaces an existing file
+			f, err := os.OpenFile(outputFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return err
+			}
+			if _, err := f.Write(backup); err != nil {
+				_ = f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+
+			for _, k := range records {
+				cmd.Printf("%s (%s): backed up\n", k.Name, k.GetType())
+			}
+			cmd.Printf("%d keys backed up to %s\n", len(records), outputFile)
+
+			return nil
+		},
+	}
+
+	// the flag shadows the output format flag of the keys commands
+	cmd.Flags().String(cli.OutputFlag, "", "File to write the encrypted backup to")
+	cmd.Flags().String(flagPassphraseFile, "", "File containing the passphrase encrypting the backup")
+
+	return cmd
+}
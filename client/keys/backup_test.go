@@ -0,0 +1,90 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// lowerBackupScryptN lowers the scrypt cost of the backups for the test.
+func lowerBackupScryptN(t *testing.T) {
+	scryptN := crypto.KeystoreScryptN
+	crypto.KeystoreScryptN = 1 << 4
+	t.Cleanup(func() { crypto.KeystoreScryptN = scryptN })
+}
+
+// writePassphraseFile writes the passphrase in a new file and returns the
+// --passphrase-file flag reading it.
+func writePassphraseFile(t *testing.T, passphrase string) string {
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte(passphrase+"\n"), 0600))
+	return fmt.Sprintf("--%s=%s", flagPassphraseFile, passphraseFile)
+}
+
+// execKeysCmd executes a fresh keys command on the keyring and returns its
+// output.
+func execKeysCmd(kb keyring.Keyring, newCmd func() *cobra.Command, args ...string) (string, error) {
+	cmd := newCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs(args)
+
+	clientCtx := client.Context{}.WithKeyring(kb)
+	err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	return out.String(), err
+}
+
+func Test_runBackupCmd(t *testing.T) {
+	lowerBackupScryptN(t)
+
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	_, err = kb.NewAccount("local", testutil.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	withPassphraseFile := writePassphraseFile(t, "123456789")
+	backupFile := filepath.Join(t.TempDir(), "backup.enc")
+
+	_, err = execKeysCmd(kb, BackupKeysCommand, withPassphraseFile)
+	require.EqualError(t, err, "the backup file must be set with --output")
+
+	out, err := execKeysCmd(kb, BackupKeysCommand, fmt.Sprintf("--%s=%s", cli.OutputFlag, backupFile), withPassphraseFile)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("local (local): backed up\noffline (offline): backed up\n2 keys backed up to %s\n", backupFile), out)
+
+	info, err := os.Stat(backupFile)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	backup, err := os.ReadFile(backupFile)
+	require.NoError(t, err)
+	_, err = crypto.DecryptBackup(backup, "123456789")
+	require.NoError(t, err)
+
+	// an existing file is never replaced
+	_, err = execKeysCmd(kb, BackupKeysCommand, fmt.Sprintf("--%s=%s", cli.OutputFlag, backupFile), withPassphraseFile)
+	require.ErrorIs(t, err, os.ErrExist)
+	bz, err := os.ReadFile(backupFile)
+	require.NoError(t, err)
+	require.Equal(t, backup, bz)
+}
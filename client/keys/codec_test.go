@@ -18,19 +18,21 @@ type testCases struct {
 
 func getTestCases() testCases {
 	return testCases{
-		// nolint:govet
 		[]keyring.KeyOutput{
-			{"A", "B", "C", "D", "E"},
-			{"A", "B", "C", "D", ""},
-			{"", "B", "C", "D", ""},
-			{"", "", "", "", ""},
+			{
+				Name: "A", Type: "B", Address: "C", PubKey: "D", PubKeyHex: "E", PubKeyBase64: "F",
+				Mnemonic: "G", HDPath: "H", Backend: "I", Label: "J", CreatedAt: "K",
+			},
+			{Name: "A", Type: "B", Address: "C", PubKey: "D", PubKeyHex: "E", PubKeyBase64: "F"},
+			{Type: "B", Address: "C", PubKey: "D"},
+			{},
 		},
 		make([]keyring.KeyOutput, 4),
 		[][]byte{
-			[]byte(`{"name":"A","type":"B","address":"C","pubkey":"D","mnemonic":"E"}`),
-			[]byte(`{"name":"A","type":"B","address":"C","pubkey":"D"}`),
-			[]byte(`{"name":"","type":"B","address":"C","pubkey":"D"}`),
-			[]byte(`{"name":"","type":"","address":"","pubkey":""}`),
+			[]byte(`{"name":"A","type":"B","address":"C","pubkey":"D","pubkey_hex":"E","pubkey_base64":"F","mnemonic":"G","hd_path":"H","backend":"I","label":"J","created_at":"K"}`),
+			[]byte(`{"name":"A","type":"B","address":"C","pubkey":"D","pubkey_hex":"E","pubkey_base64":"F"}`),
+			[]byte(`{"name":"","type":"B","address":"C","pubkey":"D","pubkey_hex":"","pubkey_base64":""}`),
+			[]byte(`{"name":"","type":"","address":"","pubkey":"","pubkey_hex":"","pubkey_base64":""}`),
 		},
 	}
 }
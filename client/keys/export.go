@@ -3,6 +3,8 @@ package keys
 import (
 	"bufio"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -12,8 +14,13 @@ import (
 )
 
 const (
-	flagUnarmoredHex = "unarmored-hex"
-	flagUnsafe       = "unsafe"
+	flagUnarmoredHex   = "unarmored-hex"
+	flagUnsafe         = "unsafe"
+	flagFormat         = "format"
+	flagPassphraseFile = "passphrase-file"
+
+	formatArmor        = "armor"
+	formatKeystoreJSON = "keystore-json"
 )
 
 // ExportKeyCommand exports private keys from the key store.
@@ -23,6 +30,11 @@ func ExportKeyCommand() *cobra.Command {
 		Short: "Export private keys",
 		Long: `Export a private key from the local keyring in ASCII-armored encrypted format.
 
+With --format=keystore-json, a secp256k1, secp256r1 or ed25519 private key is
+exported in an encrypted JSON keystore (scrypt and AES-GCM), which can be
+imported in other wallets. The passphrase is prompted, or read from
+--passphrase-file.
+
 When both the --unarmored-hex and --unsafe flags are selected, cryptographic
 private key material is exported in an INSECURE fashion that is designed to
 allow users to import their keys in hot wallets. This feature is for advanced
@@ -38,6 +50,7 @@ and export your keys in ASCII-armored encrypted format.`,
 			buf := bufio.NewReader(clientCtx.Input)
 			unarmored, _ := cmd.Flags().GetBool(flagUnarmoredHex)
 			unsafe, _ := cmd.Flags().GetBool(flagUnsafe)
+			format, _ := cmd.Flags().GetString(flagFormat)
 
 			if unarmored && unsafe {
 				return exportUnsafeUnarmored(cmd, args[0], buf, clientCtx.Keyring)
@@ -45,11 +58,26 @@ and export your keys in ASCII-armored encrypted format.`,
 				return fmt.Errorf("the flags %s and %s must be used together", flagUnsafe, flagUnarmoredHex)
 			}
 
-			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
+			if err := validateKeyFormat(format); err != nil {
+				return err
+			}
+
+			encryptPassword, err := readPassphrase(cmd, "Enter passphrase to encrypt the exported key:", buf)
 			if err != nil {
 				return err
 			}
 
+			if format == formatKeystoreJSON {
+				keystore, err := clientCtx.Keyring.ExportPrivKeyKeystore(args[0], encryptPassword)
+				if err != nil {
+					return err
+				}
+
+				cmd.Println(string(keystore))
+
+				return nil
+			}
+
 			armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
 			if err != nil {
 				return err
@@ -63,6 +91,8 @@ and export your keys in ASCII-armored encrypted format.`,
 
 	cmd.Flags().Bool(flagUnarmoredHex, false, "Export unarmored hex privkey. Requires --unsafe.")
 	cmd.Flags().Bool(flagUnsafe, false, "Enable unsafe operations. This flag must be switched on along with all unsafe operation-specific options.")
+	cmd.Flags().String(flagFormat, formatArmor, "Format of the exported key: armor or keystore-json")
+	cmd.Flags().String(flagPassphraseFile, "", "File containing the passphrase encrypting the exported key")
 
 	return cmd
 }
@@ -84,3 +114,34 @@ func exportUnsafeUnarmored(cmd *cobra.Command, uid string, buf *bufio.Reader, kr
 
 	return nil
 }
+
+// validateKeyFormat returns an error if format is not a supported format of
+// exported private keys.
+func validateKeyFormat(format string) error {
+	if format != formatArmor && format != formatKeystoreJSON {
+		return fmt.Errorf("invalid format %s, must be %s or %s", format, formatArmor, formatKeystoreJSON)
+	}
+
+	return nil
+}
+
+// readPassphrase reads the passphrase from the file set by --passphrase-file,
+// ignoring the trailing newline, or prompts for it if the flag is not set.
+func readPassphrase(cmd *cobra.Command, prompt string, buf *bufio.Reader) (string, error) {
+	passphraseFile, _ := cmd.Flags().GetString(flagPassphraseFile)
+	if passphraseFile == "" {
+		return input.GetPassword(prompt, buf)
+	}
+
+	bz, err := os.ReadFile(passphraseFile)
+	if err != nil {
+		return "", err
+	}
+
+	passphrase := strings.TrimRight(string(bz), "\r\n")
+	if len(passphrase) < input.MinPassLength {
+		return "", fmt.Errorf("password must be at least %d characters", input.MinPassLength)
+	}
+
+	return passphrase, nil
+}
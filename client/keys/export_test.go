@@ -40,6 +40,13 @@ func Test_runExportCmd(t *testing.T) {
 			extraArgs:      []string{"--unarmored-hex"},
 			mustFail:       true,
 		},
+		{
+			name:           "invalid --format must fail",
+			keyringBackend: keyring.BackendTest,
+			extraArgs:      []string{"--format=pem"},
+			userInput:      "123456789\n",
+			mustFail:       true,
+		},
 		{
 			name:           "--unsafe --unarmored-hex fail with no user confirmation",
 			keyringBackend: keyring.BackendTest,
@@ -7,16 +7,19 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/input"
 )
 
 // ImportKeyCommand imports private keys from a keyfile.
 func ImportKeyCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "import <name> <keyfile>",
 		Short: "Import private keys into the local keybase",
-		Long:  "Import a ASCII armored private key into the local keybase.",
-		Args:  cobra.ExactArgs(2),
+		Long: `Import a ASCII armored private key into the local keybase.
+
+With --format=keystore-json, the key file is an encrypted JSON keystore of a
+secp256k1, secp256r1 or ed25519 private key. The passphrase is prompted, or read from
+--passphrase-file.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -24,17 +27,31 @@ func ImportKeyCommand() *cobra.Command {
 			}
 			buf := bufio.NewReader(clientCtx.Input)
 
+			format, _ := cmd.Flags().GetString(flagFormat)
+			if err := validateKeyFormat(format); err != nil {
+				return err
+			}
+
 			bz, err := os.ReadFile(args[1])
 			if err != nil {
 				return err
 			}
 
-			passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", buf)
+			passphrase, err := readPassphrase(cmd, "Enter passphrase to decrypt your key:", buf)
 			if err != nil {
 				return err
 			}
 
+			if format == formatKeystoreJSON {
+				return clientCtx.Keyring.ImportPrivKeyKeystore(args[0], bz, passphrase)
+			}
+
 			return clientCtx.Keyring.ImportPrivKey(args[0], string(bz), passphrase)
 		},
 	}
+
+	cmd.Flags().String(flagFormat, formatArmor, "Format of the imported key: armor or keystore-json")
+	cmd.Flags().String(flagPassphraseFile, "", "File containing the passphrase decrypting the imported key")
+
+	return cmd
 }
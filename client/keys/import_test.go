@@ -1,16 +1,20 @@
 package keys
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -118,3 +122,150 @@ HbP+c6JmeJy9JXe2rbbF1QtCX1gLqGcDQPBXiCtFvP7/8wTZtVOPj8vREzhZ9ElO
 		})
 	}
 }
+
+func Test_runExportImportKeystoreCmd(t *testing.T) {
+	scryptN := crypto.KeystoreScryptN
+	crypto.KeystoreScryptN = 1 << 4
+	t.Cleanup(func() { crypto.KeystoreScryptN = scryptN })
+
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("123456789\n"), 0600))
+	keystoreFormat := fmt.Sprintf("--%s=%s", flagFormat, formatKeystoreJSON)
+	withPassphraseFile := fmt.Sprintf("--%s=%s", flagPassphraseFile, passphraseFile)
+
+	testCases := []struct {
+		name           string
+		keyringBackend string
+		exportArgs     []string
+		exportInput    string
+		importArgs     []string
+		importInput    string
+		modifyKeystore func([]byte) []byte
+		expectError    bool
+	}{
+		{
+			name:           "test backend with prompted passphrases",
+			keyringBackend: keyring.BackendTest,
+			exportArgs:     []string{keystoreFormat},
+			exportInput:    "123456789\n",
+			importArgs:     []string{keystoreFormat},
+			importInput:    "123456789\n",
+		},
+		{
+			name:           "test backend with passphrase file",
+			keyringBackend: keyring.BackendTest,
+			exportArgs:     []string{keystoreFormat, withPassphraseFile},
+			importArgs:     []string{keystoreFormat, withPassphraseFile},
+		},
+		{
+			name:           "file backend with prompted passphrases",
+			keyringBackend: keyring.BackendFile,
+			exportArgs:     []string{keystoreFormat},
+			// keyring password x2 for creating the key, keystore passphrase, then unlock keyring pass
+			exportInput: "12345678\n12345678\n123456789\n12345678\n",
+			importArgs:  []string{keystoreFormat},
+			// keystore passphrase + keyring password x2
+			importInput: "123456789\n12345678\n12345678\n",
+		},
+		{
+			name:           "file backend with passphrase file",
+			keyringBackend: keyring.BackendFile,
+			exportArgs:     []string{keystoreFormat, withPassphraseFile},
+			exportInput:    "12345678\n12345678\n12345678\n",
+			importArgs:     []string{keystoreFormat, withPassphraseFile},
+			importInput:    "12345678\n12345678\n",
+		},
+		{
+			name:           "fail with wrong passphrase",
+			keyringBackend: keyring.BackendTest,
+			exportArgs:     []string{keystoreFormat},
+			exportInput:    "123456789\n",
+			importArgs:     []string{keystoreFormat},
+			importInput:    "987654321\n",
+			expectError:    true,
+		},
+		{
+			name:           "fail with truncated keystore",
+			keyringBackend: keyring.BackendTest,
+			exportArgs:     []string{keystoreFormat, withPassphraseFile},
+			importArgs:     []string{keystoreFormat, withPassphraseFile},
+			modifyKeystore: func(bz []byte) []byte { return bz[:len(bz)/2] },
+			expectError:    true,
+		},
+		{
+			name:           "fail importing a keystore as an armored key",
+			keyringBackend: keyring.BackendTest,
+			exportArgs:     []string{keystoreFormat, withPassphraseFile},
+			importArgs:     []string{withPassphraseFile},
+			expectError:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// export a key
+			exportHome := t.TempDir()
+			exportCmd := ExportKeyCommand()
+			exportCmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			exportCmd.SetArgs(append([]string{
+				"keyname1",
+				fmt.Sprintf("--%s=%s", flags.FlagHome, exportHome),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, tc.keyringBackend),
+			}, tc.exportArgs...))
+			mockIn, mockOut := testutil.ApplyMockIO(exportCmd)
+			mockIn.Reset(tc.exportInput)
+			mockInBuf := bufio.NewReader(mockIn)
+
+			kb, err := keyring.New(sdk.KeyringServiceName(), tc.keyringBackend, exportHome, mockInBuf, cdc)
+			require.NoError(t, err)
+			k, err := kb.NewAccount("keyname1", testutil.TestMnemonic, "", sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1)
+			require.NoError(t, err)
+
+			clientCtx := client.Context{}.
+				WithKeyringDir(exportHome).
+				WithKeyring(kb).
+				WithInput(mockInBuf).
+				WithCodec(cdc)
+			require.NoError(t, exportCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+			keystore := mockOut.Bytes()
+			if tc.modifyKeystore != nil {
+				keystore = tc.modifyKeystore(keystore)
+			}
+			keyfile := filepath.Join(t.TempDir(), "key.json")
+			require.NoError(t, os.WriteFile(keyfile, keystore, 0600))
+
+			// import it in another keyring
+			importHome := t.TempDir()
+			importCmd := ImportKeyCommand()
+			importCmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			importCmd.SetArgs(append([]string{
+				"keyname2", keyfile,
+				fmt.Sprintf("--%s=%s", flags.FlagHome, importHome),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, tc.keyringBackend),
+			}, tc.importArgs...))
+			mockIn = testutil.ApplyMockIODiscardOutErr(importCmd)
+			mockIn.Reset(tc.importInput)
+
+			clientCtx = client.Context{}.
+				WithKeyringDir(importHome).
+				WithInput(mockIn).
+				WithCodec(cdc)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+			err = importCmd.ExecuteContext(ctx)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the file backend asks for the keyring password again
+			kb, err = keyring.New(sdk.KeyringServiceName(), tc.keyringBackend, importHome, strings.NewReader("12345678\n"), cdc)
+			require.NoError(t, err)
+			imported, err := kb.Key("keyname2")
+			require.NoError(t, err)
+			require.Equal(t, k.PubKey, imported.PubKey)
+		})
+	}
+}
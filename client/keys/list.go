@@ -34,7 +34,7 @@ func runListCmd(cmd *cobra.Command, _ []string) error {
 	}
 
 	if ok, _ := cmd.Flags().GetBool(flagListNames); !ok {
-		printKeyringRecords(cmd.OutOrStdout(), records, clientCtx.OutputFormat)
+		printKeyringRecords(cmd.OutOrStdout(), records, clientCtx.Keyring.Backend(), clientCtx.OutputFormat)
 		return nil
 	}
 
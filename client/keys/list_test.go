@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -76,3 +77,16 @@ func Test_runListCmd(t *testing.T) {
 		})
 	}
 }
+
+func Test_runListCmdGoldenOutput(t *testing.T) {
+	cmd := ListKeysCmd()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	_, mockOut := testutil.ApplyMockIO(cmd)
+
+	clientCtx := client.Context{}.WithKeyring(newGoldenKeyring(t))
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	requireGoldenOutput(t, "list.json", mockOut.Bytes())
+}
@@ -1,11 +1,17 @@
 package keys
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
+const flagDeleteLegacy = "delete-legacy"
+
 // MigrateCommand migrates key information from legacy keybase to OS secret store.
 func MigrateCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -17,14 +23,21 @@ If this is the case, the key is already migrated. Therefore, we skip it and cont
 Otherwise, we try to deserialize it using Amino into LegacyInfo. If this attempt is successful, we serialize 
 LegacyInfo to Protobuf serialization format and overwrite the keyring entry. If any error occurred, it will be 
 outputted in CLI and migration will be continued until all keys in the keyring DB are exhausted.
+The command reports the outcome of every key and a summary of the migrated, skipped and failed keys.
 See https://github.com/cosmos/cosmos-sdk/pull/9695 for more details.
 
+The Amino data of the migrated keys is kept in the keyring. It is only deleted with --delete-legacy,
+once all the keys have been migrated without failure.
+
 It is recommended to run in 'dry-run' mode first to verify all key migration material.
 `,
 		Args: cobra.NoArgs,
 		RunE: runMigrateCmd,
 	}
 
+	cmd.Flags().Bool(flags.FlagDryRun, false, "List the keys and check whether they would migrate, without migrating them")
+	cmd.Flags().Bool(flagDeleteLegacy, false, "Delete the Amino data of the migrated keys once all the keys have been migrated")
+
 	return cmd
 }
 
@@ -34,10 +47,65 @@ func runMigrateCmd(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	if _, err = clientCtx.Keyring.MigrateAll(); err != nil {
+	dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+	deleteLegacy, _ := cmd.Flags().GetBool(flagDeleteLegacy)
+	if dryRun && deleteLegacy {
+		return fmt.Errorf("cannot use --%s with --%s", flagDeleteLegacy, flags.FlagDryRun)
+	}
+
+	results, err := clientCtx.Keyring.MigrateRecords(dryRun)
+	if err != nil {
 		return err
 	}
 
+	var migrated, skipped, failed int
+	for _, res := range results {
+		switch res.Status {
+		case keyring.MigrationMigrated:
+			migrated++
+		case keyring.MigrationSkipped:
+			skipped++
+		case keyring.MigrationFailed:
+			failed++
+		}
+		cmd.Println(migrationResultLine(res, dryRun))
+	}
+
+	if dryRun {
+		cmd.Printf("Dry run: %d keys would be migrated, %d skipped, %d would fail\n", migrated, skipped, failed)
+		return nil
+	}
+
+	cmd.Printf("Migrated: %d, skipped: %d, failed: %d\n", migrated, skipped, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d keys failed to migrate, the legacy data is kept", failed)
+	}
+
+	if deleteLegacy {
+		if err := clientCtx.Keyring.DeleteLegacyRecords(); err != nil {
+			return err
+		}
+		cmd.Println("The legacy data has been deleted")
+	}
+
 	cmd.Println("Keys migration has been successfully executed")
 	return nil
 }
+
+// migrationResultLine describes the migration of a key.
+func migrationResultLine(res keyring.MigrationResult, dryRun bool) string {
+	switch res.Status {
+	case keyring.MigrationMigrated:
+		if dryRun {
+			return fmt.Sprintf("%s (%s): would be migrated", res.Name, res.Type)
+		}
+		return fmt.Sprintf("%s (%s): migrated", res.Name, res.Type)
+	case keyring.MigrationSkipped:
+		return fmt.Sprintf("%s (%s): skipped, already migrated", res.Name, res.Type)
+	default:
+		if dryRun {
+			return fmt.Sprintf("%s: would fail: %s", res.Name, res.Err)
+		}
+		return fmt.Sprintf("%s: failed: %s", res.Name, res.Err)
+	}
+}
@@ -1,8 +1,11 @@
 package keys
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -149,6 +152,77 @@ func (s *MigrateTestSuite) Test_runMigrateCmdLegacyMultiInfo() {
 	s.Require().NoError(cmd.ExecuteContext(ctx))
 }
 
+func (s *MigrateTestSuite) Test_runMigrateCmdDryRunAndDeleteLegacy() {
+	dir := s.T().TempDir()
+	kb, err := keyring.New(s.appName, keyring.BackendTest, dir, strings.NewReader(""), s.cdc)
+	s.Require().NoError(err)
+	setter, ok := kb.(setter)
+	s.Require().True(ok)
+
+	// legacy keystore fixture with a corrupted record
+	multi := multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{s.pub})
+	legacyMultiInfo, err := keyring.NewLegacyMultiInfo("multi", multi)
+	s.Require().NoError(err)
+	s.Require().NoError(setter.SetItem(design99keyring.Item{Key: "multi.info", Data: keyring.MarshalInfo(legacyMultiInfo)}))
+	s.Require().NoError(setter.SetItem(design99keyring.Item{Key: "corrupted.info", Data: []byte("abckd0s03l")}))
+	_, err = kb.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+
+	clientCtx := client.Context{}.WithKeyring(kb)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	runMigrate := func(args ...string) (string, error) {
+		cmd := MigrateCommand()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(io.Discard)
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+	legacyPath := filepath.Join(dir, "keyring-test", "multi.info.legacy")
+
+	out, err := runMigrate(fmt.Sprintf("--%s", flags.FlagDryRun), fmt.Sprintf("--%s", flagDeleteLegacy))
+	s.Require().EqualError(err, "cannot use --delete-legacy with --dry-run")
+	s.Require().NotContains(out, "Dry run")
+
+	// the dry run reports every key but does not migrate anything
+	for i := 0; i < 2; i++ {
+		out, err = runMigrate(fmt.Sprintf("--%s", flags.FlagDryRun))
+		s.Require().NoError(err)
+		s.Require().Contains(out, "corrupted: would fail: unable to unmarshal item.Data")
+		s.Require().Contains(out, "multi (multi): would be migrated\n")
+		s.Require().Contains(out, "offline (offline): skipped, already migrated\n")
+		s.Require().Contains(out, "Dry run: 1 keys would be migrated, 1 skipped, 1 would fail\n")
+	}
+	s.Require().NoFileExists(legacyPath)
+
+	// the migration continues past the corrupted record and keeps the legacy data
+	out, err = runMigrate(fmt.Sprintf("--%s", flagDeleteLegacy))
+	s.Require().EqualError(err, "1 keys failed to migrate, the legacy data is kept")
+	s.Require().Contains(out, "corrupted: failed: unable to unmarshal item.Data")
+	s.Require().Contains(out, "multi (multi): migrated\n")
+	s.Require().Contains(out, "Migrated: 1, skipped: 1, failed: 1\n")
+	s.Require().FileExists(legacyPath)
+
+	// once the corrupted record is fixed, the legacy data can be deleted
+	fixedInfo, err := keyring.NewLegacyMultiInfo("corrupted", multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey()}))
+	s.Require().NoError(err)
+	s.Require().NoError(setter.SetItem(design99keyring.Item{Key: "corrupted.info", Data: keyring.MarshalInfo(fixedInfo)}))
+
+	out, err = runMigrate(fmt.Sprintf("--%s", flagDeleteLegacy))
+	s.Require().NoError(err)
+	s.Require().Contains(out, "corrupted (multi): migrated\n")
+	s.Require().Contains(out, "multi (multi): skipped, already migrated\n")
+	s.Require().Contains(out, "Migrated: 1, skipped: 2, failed: 0\n")
+	s.Require().Contains(out, "The legacy data has been deleted\n")
+	s.Require().NoFileExists(legacyPath)
+	s.Require().NoFileExists(filepath.Join(dir, "keyring-test", "corrupted.info.legacy"))
+
+	k, err := kb.Key("multi")
+	s.Require().NoError(err)
+	s.Require().Equal(keyring.TypeMulti, k.GetType())
+}
+
 func TestMigrateTestSuite(t *testing.T) {
 	suite.Run(t, new(MigrateTestSuite))
 }
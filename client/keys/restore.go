@@ -0,0 +1,87 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const flagOverwrite = "overwrite"
+
+// RestoreKeysCommand imports the keys of an encrypted backup into the key store.
+func RestoreKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <file>",
+		Short: "Restore the keys of an encrypted backup",
+		Long: `Import the keys of a backup created by the backup command. The passphrase of the
+backup is prompted, or read from --passphrase-file.
+
+The keys whose name is already used in the keyring are skipped, unless --overwrite
+is set. A key whose address is the address of an existing key of another name is
+not restored. The command reports the outcome of every key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+			overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+
+			backup, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := readPassphrase(cmd, "Enter passphrase to decrypt the backup:", buf)
+			if err != nil {
+				return err
+			}
+
+			results, err := clientCtx.Keyring.RestoreBackup(backup, passphrase, overwrite)
+			if err != nil {
+				return err
+			}
+
+			counts := make(map[keyring.RestoreStatus]int)
+			for _, res := range results {
+				counts[res.Status]++
+				cmd.Println(restoreResultLine(res))
+			}
+
+			cmd.Printf("Restored: %d, overwritten: %d, skipped: %d, failed: %d\n",
+				counts[keyring.RestoreRestored], counts[keyring.RestoreOverwritten],
+				counts[keyring.RestoreSkipped], counts[keyring.RestoreFailed])
+			if failed := counts[keyring.RestoreFailed]; failed > 0 {
+				return fmt.Errorf("%d keys failed to restore", failed)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagOverwrite, false, "Replace the existing keys of the same name as the keys of the backup")
+	cmd.Flags().String(flagPassphraseFile, "", "File containing the passphrase decrypting the backup")
+
+	return cmd
+}
+
+// restoreResultLine describes the restore of a key.
+func restoreResultLine(res keyring.RestoreResult) string {
+	switch res.Status {
+	case keyring.RestoreRestored, keyring.RestoreOverwritten:
+		return fmt.Sprintf("%s (%s): %s", res.Name, res.Type, res.Status)
+	case keyring.RestoreSkipped:
+		return fmt.Sprintf("%s (%s): skipped, a key of the same name exists", res.Name, res.Type)
+	default:
+		if res.Name == "" {
+			return fmt.Sprintf("unreadable key: failed: %s", res.Err)
+		}
+		return fmt.Sprintf("%s (%s): failed: %s", res.Name, res.Type, res.Err)
+	}
+}
@@ -0,0 +1,100 @@
+package keys
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func Test_runRestoreCmd(t *testing.T) {
+	lowerBackupScryptN(t)
+	t.Setenv(keyring.EnvKeyringPassphrase, "12345678")
+
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	withPassphraseFile := writePassphraseFile(t, "123456789")
+	newKeyring := func(backend string) keyring.Keyring {
+		kb, err := keyring.New(sdk.KeyringServiceName(), backend, t.TempDir(), strings.NewReader(""), cdc)
+		require.NoError(t, err)
+		return kb
+	}
+
+	for _, backends := range [][2]string{{keyring.BackendFile, keyring.BackendTest}, {keyring.BackendTest, keyring.BackendFile}} {
+		backends := backends
+		t.Run(fmt.Sprintf("%s to %s backend", backends[0], backends[1]), func(t *testing.T) {
+			src, dst := newKeyring(backends[0]), newKeyring(backends[1])
+			_, err := src.NewAccount("local", testutil.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+			require.NoError(t, err)
+			_, err = src.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+			require.NoError(t, err)
+
+			backupFile := filepath.Join(t.TempDir(), "backup.enc")
+			_, err = execKeysCmd(src, BackupKeysCommand, fmt.Sprintf("--%s=%s", cli.OutputFlag, backupFile), withPassphraseFile)
+			require.NoError(t, err)
+
+			_, err = execKeysCmd(dst, RestoreKeysCommand, backupFile, writePassphraseFile(t, "987654321"))
+			require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+			records, err := dst.List()
+			require.NoError(t, err)
+			require.Empty(t, records)
+
+			out, err := execKeysCmd(dst, RestoreKeysCommand, backupFile, withPassphraseFile)
+			require.NoError(t, err)
+			require.Equal(t, "local (local): restored\noffline (offline): restored\n"+
+				"Restored: 2, overwritten: 0, skipped: 0, failed: 0\n", out)
+
+			for _, name := range []string{"local", "offline"} {
+				srcKey, err := src.Key(name)
+				require.NoError(t, err)
+				dstKey, err := dst.Key(name)
+				require.NoError(t, err)
+				require.Equal(t, srcKey.PubKey.Value, dstKey.PubKey.Value)
+			}
+			msg := []byte("some message")
+			sig, pub, err := dst.Sign("local", msg)
+			require.NoError(t, err)
+			require.True(t, pub.VerifySignature(msg, sig))
+
+			out, err = execKeysCmd(dst, RestoreKeysCommand, backupFile, withPassphraseFile)
+			require.NoError(t, err)
+			require.Equal(t, "local (local): skipped, a key of the same name exists\n"+
+				"offline (offline): skipped, a key of the same name exists\n"+
+				"Restored: 0, overwritten: 0, skipped: 2, failed: 0\n", out)
+
+			// the overwritten keys are replaced by the keys of the backup, the key
+			// with the address of a key of the backup is kept
+			require.NoError(t, dst.Delete("local"))
+			_, err = dst.NewAccount("local", testutil.TestMnemonic, "", "m/44'/118'/0'/0/1", hd.Secp256k1)
+			require.NoError(t, err)
+			offline, err := dst.Key("offline")
+			require.NoError(t, err)
+			offlinePub, err := offline.GetPubKey()
+			require.NoError(t, err)
+			require.NoError(t, dst.Delete("offline"))
+			_, err = dst.SaveOfflineKey("other", offlinePub)
+			require.NoError(t, err)
+
+			out, err = execKeysCmd(dst, RestoreKeysCommand, backupFile, withPassphraseFile, fmt.Sprintf("--%s", flagOverwrite))
+			require.EqualError(t, err, "1 keys failed to restore")
+			require.True(t, strings.HasPrefix(out, "local (local): overwritten\n"+
+				"offline (offline): failed: the address of the key is the address of the existing key other\n"+
+				"Restored: 0, overwritten: 1, skipped: 0, failed: 1\n"), out)
+			srcKey, err := src.Key("local")
+			require.NoError(t, err)
+			dstKey, err := dst.Key("local")
+			require.NoError(t, err)
+			require.Equal(t, srcKey.PubKey.Value, dstKey.PubKey.Value)
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package keys
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
 // Commands registers a sub-tree of commands to interact with
@@ -46,13 +49,17 @@ The pass backend requires GnuPG: https://gnupg.org/
 		ShowKeysCmd(),
 		DeleteKeyCommand(),
 		RenameKeyCommand(),
+		SetLabelCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		BackupKeysCommand(),
+		RestoreKeysCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
 	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringPassFile, "", fmt.Sprintf("File holding the passphrase of the os and file keyring backends, instead of the %s environment variable or the prompt", keyring.EnvKeyringPassphrase))
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
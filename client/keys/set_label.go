@@ -0,0 +1,40 @@
+package keys
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// SetLabelCommand sets the label of a key of the key store.
+func SetLabelCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-label <name> <label>",
+		Short: "Set the label of an existing key",
+		Long: `Set the free-text label describing a key, shown by the list and show commands.
+An empty label "" clears the label of the key.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, label := args[0], args[1]
+			if _, err := clientCtx.Keyring.SetLabel(name, label); err != nil {
+				return err
+			}
+
+			if label == "" {
+				cmd.PrintErrf("Label of %s cleared\n", name)
+				return nil
+			}
+
+			cmd.PrintErrf("Label of %s set to %q\n", name, label)
+			return nil
+		},
+	}
+
+	return cmd
+}
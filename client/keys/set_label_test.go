@@ -0,0 +1,84 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runSetLabelCmd(t *testing.T) {
+	kbHome := t.TempDir()
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	_, err = kb.NewAccount("key", testutil.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	runSetLabel := func(args ...string) (string, error) {
+		cmd := SetLabelCommand()
+		cmd.Flags().AddFlagSet(Commands(kbHome).PersistentFlags())
+		_, mockOut := testutil.ApplyMockIO(cmd)
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		))
+		err := cmd.ExecuteContext(ctx)
+		return mockOut.String(), err
+	}
+
+	_, err = runSetLabel("unknown", "label")
+	require.EqualError(t, err, "unknown.info: key not found")
+
+	_, err = runSetLabel("key", "first line\nsecond line")
+	require.EqualError(t, err, "label must be a single line")
+
+	out, err := runSetLabel("key", "validator operator")
+	require.NoError(t, err)
+	require.Equal(t, "Label of key set to \"validator operator\"\n", out)
+	k, err := kb.Key("key")
+	require.NoError(t, err)
+	require.Equal(t, "validator operator", k.Label)
+	require.NotNil(t, k.CreatedAt)
+
+	// the label is kept by the renamed key
+	require.NoError(t, kb.Rename("key", "renamed"))
+	renamed, err := kb.Key("renamed")
+	require.NoError(t, err)
+	require.Equal(t, "validator operator", renamed.Label)
+	require.Equal(t, k.CreatedAt, renamed.CreatedAt)
+
+	out, err = runSetLabel("renamed", "")
+	require.NoError(t, err)
+	require.Equal(t, "Label of renamed cleared\n", out)
+	renamed, err = kb.Key("renamed")
+	require.NoError(t, err)
+	require.Empty(t, renamed.Label)
+
+	// the label is shown by keys list
+	_, err = runSetLabel("renamed", "cold wallet")
+	require.NoError(t, err)
+	cmd := ListKeysCmd()
+	cmd.Flags().AddFlagSet(Commands(kbHome).PersistentFlags())
+	_, mockOut := testutil.ApplyMockIO(cmd)
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.True(t, strings.Contains(mockOut.String(), "label: cold wallet"), mockOut.String())
+	require.True(t, strings.Contains(mockOut.String(), "created_at: "), mockOut.String())
+}
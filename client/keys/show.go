@@ -130,7 +130,7 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 		}
 		fmt.Fprintln(cmd.OutOrStdout(), out)
 	default:
-		printKeyringRecord(cmd.OutOrStdout(), k, bechKeyOut, outputFormat)
+		printKeyringRecord(cmd.OutOrStdout(), k, bechKeyOut, clientCtx.Keyring.Backend(), outputFormat)
 	}
 
 	if isShowDevice {
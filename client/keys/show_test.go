@@ -2,10 +2,17 @@ package keys
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -168,6 +175,91 @@ func Test_runShowCmd(t *testing.T) {
 	require.EqualError(t, cmd.ExecuteContext(ctx), "the device flag (-d) can only be used for addresses not pubkeys")
 }
 
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of the keys commands output")
+
+// newGoldenKeyring returns a test keyring holding a key of each algorithm, all
+// with fixed public keys.
+func newGoldenKeyring(t *testing.T) keyring.Keyring {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	_, err = kb.NewAccount("secp256k1", testutil.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.SetLabel("secp256k1", "validator operator")
+	require.NoError(t, err)
+
+	var edPubKey cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON([]byte(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"ZoX1pFpSt3gayt5lN2IvwrmAV9qt9wVpRs1g8YlmoUc="}`), &edPubKey))
+	_, err = kb.SaveOfflineKey("ed25519", edPubKey)
+	require.NoError(t, err)
+
+	k, err := kb.Key("secp256k1")
+	require.NoError(t, err)
+	secpPubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	_, err = kb.SaveMultisig("multisig", multisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{secpPubKey, edPubKey}))
+	require.NoError(t, err)
+
+	return kb
+}
+
+// createdAtRegexp matches the creation times of the keys in the JSON output,
+// which depend on the time the tests run.
+var createdAtRegexp = regexp.MustCompile(`"created_at":"[^"]+"`)
+
+// requireGoldenOutput checks the output against the golden file of testdata,
+// which is written instead with the -update-golden flag. The creation times of
+// the keys are replaced by a placeholder.
+func requireGoldenOutput(t *testing.T, goldenFile string, out []byte) {
+	out = createdAtRegexp.ReplaceAll(out, []byte(`"created_at":"CREATED_AT"`))
+	goldenFile = filepath.Join("testdata", goldenFile)
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(goldenFile, out, 0o600))
+	}
+
+	expected, err := os.ReadFile(goldenFile)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(out))
+}
+
+func Test_runShowCmdGoldenOutput(t *testing.T) {
+	clientCtx := client.Context{}.WithKeyring(newGoldenKeyring(t))
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	runShow := func(args ...string) []byte {
+		cmd := ShowKeysCmd()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		_, mockOut := testutil.ApplyMockIO(cmd)
+		cmd.SetArgs(args)
+		require.NoError(t, cmd.ExecuteContext(ctx))
+		return mockOut.Bytes()
+	}
+
+	for _, name := range []string{"secp256k1", "ed25519", "multisig"} {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			out := runShow(name, fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON))
+			requireGoldenOutput(t, fmt.Sprintf("show_%s.json", name), out)
+
+			var ko keyring.KeyOutput
+			require.NoError(t, json.Unmarshal(out, &ko))
+			require.Equal(t, name, ko.Name)
+			require.Equal(t, keyring.BackendTest, ko.Backend)
+
+			// the addresses of the other Bech32 prefixes are still shown alone
+			for prefix, hrp := range map[string]string{
+				sdk.PrefixValidator: sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+				sdk.PrefixConsensus: sdk.GetConfig().GetBech32ConsensusAddrPrefix(),
+			} {
+				out := runShow(name, fmt.Sprintf("--%s", FlagAddress), fmt.Sprintf("--%s=%s", FlagBechPrefix, prefix))
+				bz, err := sdk.GetFromBech32(strings.TrimSpace(string(out)), hrp)
+				require.NoError(t, err)
+				require.Equal(t, ko.Address, sdk.AccAddress(bz).String())
+			}
+		})
+	}
+}
+
 func Test_validateMultisigThreshold(t *testing.T) {
 	type args struct {
 		k     int
@@ -17,11 +17,14 @@ const (
 
 type bechKeyOutFn func(k *cryptokeyring.Record) (cryptokeyring.KeyOutput, error)
 
-func printKeyringRecord(w io.Writer, k *cryptokeyring.Record, bechKeyOut bechKeyOutFn, output string) {
+// printKeyringRecord prints the record, with the backend of its keyring unless
+// it is empty.
+func printKeyringRecord(w io.Writer, k *cryptokeyring.Record, bechKeyOut bechKeyOutFn, backend, output string) {
 	ko, err := bechKeyOut(k)
 	if err != nil {
 		panic(err)
 	}
+	ko.Backend = backend
 
 	switch output {
 	case OutputFormatText:
@@ -37,11 +40,15 @@ func printKeyringRecord(w io.Writer, k *cryptokeyring.Record, bechKeyOut bechKey
 	}
 }
 
-func printKeyringRecords(w io.Writer, records []*cryptokeyring.Record, output string) {
+// printKeyringRecords prints the records of the keyring of the given backend.
+func printKeyringRecords(w io.Writer, records []*cryptokeyring.Record, backend, output string) {
 	kos, err := cryptokeyring.MkAccKeysOutput(records)
 	if err != nil {
 		panic(err)
 	}
+	for i := range kos {
+		kos[i].Backend = backend
+	}
 
 	switch output {
 	case OutputFormatText:
@@ -0,0 +1,195 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ParseCoinsDisplay parses a list of coins whose amounts may be given in a
+// display denom of the bank denom metadata, such as 1.5atom, and converts them
+// to their base denom, such as 1500000uatom. The conversion is exact: it fails
+// if an amount is not a whole amount of its base denom, or if a fractional
+// amount has no denom metadata. The denoms are parsed as base denoms, like
+// sdk.ParseCoinsNormalized, when the context is offline or has ExactDenoms set.
+func ParseCoinsDisplay(clientCtx client.Context, coinsStr string) (sdk.Coins, error) {
+	if clientCtx.ExactDenoms || clientCtx.Offline {
+		return sdk.ParseCoinsNormalized(coinsStr)
+	}
+
+	decCoins, err := parseDecCoinsDisplay(clientCtx, coinsStr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins()
+	for _, decCoin := range decCoins {
+		coins = coins.Add(sdk.NewCoin(decCoin.Denom, decCoin.Amount.TruncateInt()))
+	}
+
+	return coins, nil
+}
+
+// ParseCoinDisplay parses a single coin like ParseCoinsDisplay.
+func ParseCoinDisplay(clientCtx client.Context, coinStr string) (sdk.Coin, error) {
+	coins, err := ParseCoinsDisplay(clientCtx, coinStr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(coins) != 1 {
+		return sdk.Coin{}, fmt.Errorf("expected a single coin, got %s", coinStr)
+	}
+
+	return coins[0], nil
+}
+
+// ParseDecCoinsDisplay parses a list of decimal coins, such as gas prices,
+// like ParseCoinsDisplay. The converted amounts, and the amounts of the denoms
+// without metadata, may be fractional.
+func ParseDecCoinsDisplay(clientCtx client.Context, coinsStr string) (sdk.DecCoins, error) {
+	return parseDecCoinsDisplay(clientCtx, coinsStr, false)
+}
+
+// parseDecCoinsDisplay parses a list of decimal coins and converts the coins
+// of a display denom to their base denom. If whole is true, the converted
+// amounts must be whole.
+func parseDecCoinsDisplay(clientCtx client.Context, coinsStr string, whole bool) (sdk.DecCoins, error) {
+	decCoins, err := sdk.ParseDecCoins(coinsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientCtx.ExactDenoms || clientCtx.Offline || decCoins.Empty() {
+		return decCoins, nil
+	}
+
+	metadatas, err := queryDenomsMetadata(clientCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the denom metadata to resolve the denoms of %s, use --%s to parse them as base denoms: %w",
+			coinsStr, flags.FlagExactDenoms, err)
+	}
+
+	resolved := sdk.NewDecCoins()
+	for _, decCoin := range decCoins {
+		resolvedCoin, found, err := resolveDisplayDenom(metadatas, decCoin)
+		if err != nil {
+			return nil, err
+		}
+
+		if whole && !resolvedCoin.Amount.IsInteger() {
+			if !found {
+				return nil, fmt.Errorf("no denom metadata found for %s, which is needed to convert the fractional amount %s", decCoin.Denom, decCoin)
+			}
+			return nil, fmt.Errorf("%s converts to %s, which is not a whole amount of %s", decCoin, resolvedCoin, resolvedCoin.Denom)
+		}
+
+		resolved = resolved.Add(resolvedCoin)
+	}
+
+	return resolved, nil
+}
+
+// resolveDisplayDenom converts a coin of a denom unit of a denom metadata to
+// the base denom of the metadata. It returns false if the denom has no
+// metadata, in which case the coin is kept as is.
+func resolveDisplayDenom(metadatas []banktypes.Metadata, decCoin sdk.DecCoin) (sdk.DecCoin, bool, error) {
+	for _, metadata := range metadatas {
+		if decCoin.Denom == metadata.Base {
+			return decCoin, true, nil
+		}
+
+		unit, ok := findDenomUnit(metadata, decCoin.Denom)
+		if !ok {
+			continue
+		}
+
+		baseUnit, ok := findDenomUnit(metadata, metadata.Base)
+		if !ok || unit.Exponent < baseUnit.Exponent {
+			return sdk.DecCoin{}, false, fmt.Errorf("invalid denom metadata of %s: no denom unit of a lower exponent than %s", metadata.Base, decCoin.Denom)
+		}
+
+		// the amounts of coins are at most 256 bits long, i.e. 77 digits
+		exponent := unit.Exponent - baseUnit.Exponent
+		if exponent >= 77 {
+			return sdk.DecCoin{}, false, fmt.Errorf("%s overflows the maximum amount when converted to %s", decCoin, metadata.Base)
+		}
+		multiplier := sdk.NewIntWithDecimal(1, int(exponent))
+		if decCoin.Amount.TruncateInt().BigInt().BitLen()+multiplier.BigInt().BitLen() > 256 {
+			return sdk.DecCoin{}, false, fmt.Errorf("%s overflows the maximum amount when converted to %s", decCoin, metadata.Base)
+		}
+
+		return sdk.NewDecCoinFromDec(metadata.Base, decCoin.Amount.MulInt(multiplier)), true, nil
+	}
+
+	return decCoin, false, nil
+}
+
+// findDenomUnit returns the denom unit of a metadata with the given denom or
+// alias.
+func findDenomUnit(metadata banktypes.Metadata, denom string) (*banktypes.DenomUnit, bool) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == denom {
+			return unit, true
+		}
+
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return unit, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// queryDenomsMetadata queries all the denom metadata of the bank module.
+func queryDenomsMetadata(clientCtx client.Context) ([]banktypes.Metadata, error) {
+	queryClient := banktypes.NewQueryClient(clientCtx)
+
+	var (
+		metadatas []banktypes.Metadata
+		nextKey   []byte
+	)
+	for {
+		res, err := queryClient.DenomsMetadata(context.Background(), &banktypes.QueryDenomsMetadataRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		metadatas = append(metadatas, res.Metadatas...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return metadatas, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// withDisplayDenoms returns a copy of the factory with the fees and gas prices
+// of the context, if any, parsed by ParseCoinsDisplay and ParseDecCoinsDisplay.
+func (f Factory) withDisplayDenoms(clientCtx client.Context) (Factory, error) {
+	if clientCtx.FeesStr != "" {
+		fees, err := ParseCoinsDisplay(clientCtx, clientCtx.FeesStr)
+		if err != nil {
+			return f, err
+		}
+		f.fees = fees
+	}
+
+	if clientCtx.GasPricesStr != "" {
+		gasPrices, err := ParseDecCoinsDisplay(clientCtx, clientCtx.GasPricesStr)
+		if err != nil {
+			return f, err
+		}
+		f.gasPrices = gasPrices
+	}
+
+	return f, nil
+}
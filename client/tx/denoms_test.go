@@ -0,0 +1,160 @@
+package tx_test
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockDenomsMetadataClient is a mock Tendermint RPC client serving the denom
+// metadata of the bank module, one per page.
+type mockDenomsMetadataClient struct {
+	mock.Client
+	metadatas []banktypes.Metadata
+	err       error
+	queries   int
+}
+
+func (c *mockDenomsMetadataClient) ABCIQueryWithOptions(_ gocontext.Context, path string, data tmbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if path != "/cosmos.bank.v1beta1.Query/DenomsMetadata" {
+		return nil, fmt.Errorf("unexpected query %s", path)
+	}
+
+	var req banktypes.QueryDenomsMetadataRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	c.queries++
+	page := 0
+	if req.Pagination != nil && len(req.Pagination.Key) != 0 {
+		page = int(req.Pagination.Key[0])
+	}
+	res := &banktypes.QueryDenomsMetadataResponse{Pagination: &query.PageResponse{}}
+	if page < len(c.metadatas) {
+		res.Metadatas = c.metadatas[page : page+1]
+	}
+	if page+1 < len(c.metadatas) {
+		res.Pagination.NextKey = []byte{byte(page + 1)}
+	}
+
+	bz, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func TestParseCoinsDisplay(t *testing.T) {
+	node := &mockDenomsMetadataClient{metadatas: []banktypes.Metadata{
+		{
+			Base:    "ufoo",
+			Display: "foo",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "ufoo", Exponent: 0},
+				{Denom: "foo", Exponent: 6},
+				{Denom: "exafoo", Exponent: 60},
+			},
+		},
+		{
+			Base:    "uatom",
+			Display: "atom",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+				{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+				{Denom: "atom", Exponent: 6},
+			},
+		},
+	}}
+	clientCtx := client.Context{}.WithClient(node)
+
+	testCases := []struct {
+		name     string
+		coins    string
+		expCoins sdk.Coins
+		expErr   string
+	}{
+		{"display denom", "1atom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000000)), ""},
+		{"fractional display amount", "1.5atom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)), ""},
+		{"smallest display amount", "0.000001atom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), ""},
+		{"base denom", "1000000uatom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000000)), ""},
+		{"intermediate denom alias", "2.5milliatom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 2500)), ""},
+		{"base denom alias", "7microatom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 7)), ""},
+		{"denoms of the same base", "1atom,5uatom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000005)), ""},
+		{"denoms of several metadata", "1atom,2foo", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000000), sdk.NewInt64Coin("ufoo", 2000000)), ""},
+		{"denom without metadata", "10stake", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), ""},
+		{"precision overflow", "1.0000005atom", nil, "1.000000500000000000atom converts to 1000000.500000000000000000uatom, which is not a whole amount of uatom"},
+		{"more decimals than a dec", "0.0000000000000000001atom", nil, "invalid precision; max: 18, got: 19"},
+		{"fractional amount without metadata", "1.5stake", nil, "no denom metadata found for stake, which is needed to convert the fractional amount 1.500000000000000000stake"},
+		{"amount overflow", fmt.Sprint(sdk.NewIntWithDecimal(1, 55)) + "exafoo", nil, "overflows the maximum amount when converted to ufoo"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			coins, err := tx.ParseCoinsDisplay(clientCtx, tc.coins)
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expCoins, coins)
+		})
+	}
+
+	// all the pages of the metadata are queried
+	node.queries = 0
+	_, err := tx.ParseCoinsDisplay(clientCtx, "1atom")
+	require.NoError(t, err)
+	require.Equal(t, 2, node.queries)
+
+	// a single coin
+	coin, err := tx.ParseCoinDisplay(clientCtx, "0.25atom")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 250000), coin)
+	_, err = tx.ParseCoinDisplay(clientCtx, "1atom,2foo")
+	require.EqualError(t, err, "expected a single coin, got 1atom,2foo")
+
+	// the gas prices may be fractional
+	gasPrices, err := tx.ParseDecCoinsDisplay(clientCtx, "0.0000025atom,0.025stake")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("2.5")),
+		sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("0.025")),
+	), gasPrices)
+
+	// the denoms are not resolved with exact denoms or offline
+	for _, ctx := range []client.Context{clientCtx.WithExactDenoms(true), clientCtx.WithOffline(true)} {
+		node.queries = 0
+		coins, err := tx.ParseCoinsDisplay(ctx, "1atom")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), coins)
+		gasPrices, err := tx.ParseDecCoinsDisplay(ctx, "0.5atom")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.MustNewDecFromStr("0.5"))), gasPrices)
+		require.Zero(t, node.queries)
+	}
+
+	// an unreachable node fails the resolution
+	unreachable := client.Context{}.WithClient(&mockDenomsMetadataClient{err: errors.New("connection refused")})
+	_, err = tx.ParseCoinsDisplay(unreachable, "1atom")
+	require.EqualError(t, err, "failed to query the denom metadata to resolve the denoms of 1atom, use --exact-denoms to parse them as base denoms: connection refused")
+}
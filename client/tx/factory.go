@@ -23,6 +23,7 @@ type Factory struct {
 	accountRetriever   client.AccountRetriever
 	accountNumber      uint64
 	sequence           uint64
+	sequenceOffset     uint64
 	gas                uint64
 	timeoutHeight      uint64
 	gasAdjustment      float64
@@ -47,12 +48,22 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
-	accSeq, _ := flagSet.GetUint64(flags.FlagSequence)
-	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
+	seqStr, _ := flagSet.GetString(flags.FlagSequence)
+	seqSetting, _ := flags.ParseSequenceSetting(seqStr)
 	memo, _ := flagSet.GetString(flags.FlagNote)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
 
-	gasStr, _ := flagSet.GetString(flags.FlagGas)
+	// the gas and fee settings of the context, read from the flags or the
+	// client config by GetClientTxContext, take precedence over the flags
+	gasAdj := clientCtx.GasAdjustment
+	if gasAdj == 0 {
+		gasAdj, _ = flagSet.GetFloat64(flags.FlagGasAdjustment)
+	}
+
+	gasStr := clientCtx.GasStr
+	if gasStr == "" {
+		gasStr, _ = flagSet.GetString(flags.FlagGas)
+	}
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
 
 	f := Factory{
@@ -63,24 +74,32 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		gas:                gasSetting.Gas,
 		simulateAndExecute: gasSetting.Simulate,
 		accountNumber:      accNum,
-		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
 	}
 
-	feesStr, _ := flagSet.GetString(flags.FlagFees)
+	feesStr, gasPricesStr := clientCtx.FeesStr, clientCtx.GasPricesStr
+	if feesStr == "" && gasPricesStr == "" {
+		feesStr, _ = flagSet.GetString(flags.FlagFees)
+		gasPricesStr, _ = flagSet.GetString(flags.FlagGasPrices)
+	}
 	f = f.WithFees(feesStr)
-
-	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	if seqSetting.Relative {
+		f = f.WithSequenceOffset(seqSetting.Sequence)
+	} else {
+		f = f.WithSequence(seqSetting.Sequence)
+	}
+
 	return f
 }
 
 func (f Factory) AccountNumber() uint64                     { return f.accountNumber }
 func (f Factory) Sequence() uint64                          { return f.sequence }
+func (f Factory) SequenceOffset() uint64                    { return f.sequenceOffset }
 func (f Factory) Gas() uint64                               { return f.gas }
 func (f Factory) GasAdjustment() float64                    { return f.gasAdjustment }
 func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
@@ -153,6 +172,14 @@ func (f Factory) WithSequence(sequence uint64) Factory {
 	return f
 }
 
+// WithSequenceOffset returns a copy of the Factory with an updated sequence
+// offset, added to the sequence of the account queried by Prepare when the
+// sequence is not set.
+func (f Factory) WithSequenceOffset(offset uint64) Factory {
+	f.sequenceOffset = offset
+	return f
+}
+
 // WithMemo returns a copy of the Factory with an updated memo.
 func (f Factory) WithMemo(memo string) Factory {
 	f.memo = memo
@@ -317,7 +344,7 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 		}
 
 		if initSeq == 0 {
-			fc = fc.WithSequence(seq)
+			fc = fc.WithSequence(seq + fc.sequenceOffset)
 		}
 	}
 
@@ -0,0 +1,73 @@
+package tx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// FormatTxSummary renders a human-readable summary of an unsigned tx to be
+// confirmed before it is signed: its chain ID, signer, the key fields of each
+// of its messages, as rendered by the given registry, its fee, gas, fee granter
+// and memo.
+func FormatTxSummary(clientCtx client.Context, chainID string, tx client.TxBuilder, renderers *authsigning.MsgRendererRegistry) (string, error) {
+	var sb strings.Builder
+	theTx := tx.GetTx()
+
+	signer := clientCtx.GetFromAddress().String()
+	if name := clientCtx.GetFromName(); name != "" && name != signer {
+		signer = fmt.Sprintf("%s (%s)", signer, name)
+	}
+
+	writeSummaryField(&sb, "Chain ID", chainID)
+	writeSummaryField(&sb, "Signer", signer)
+
+	msgs := theTx.GetMsgs()
+	fmt.Fprintf(&sb, "Messages (%d):\n", len(msgs))
+	for i, msg := range msgs {
+		fmt.Fprintf(&sb, "  %d. %s\n", i+1, sdk.MsgTypeURL(msg))
+
+		fields, err := renderers.Render(clientCtx.Codec, msg)
+		if err != nil {
+			return "", fmt.Errorf("failed to render message %d: %w", i+1, err)
+		}
+
+		labelWidth := 0
+		for _, field := range fields {
+			if len(field.Label) > labelWidth {
+				labelWidth = len(field.Label)
+			}
+		}
+		for _, field := range fields {
+			if field.Label == "" {
+				fmt.Fprintf(&sb, "     %s\n", field.Value)
+				continue
+			}
+			fmt.Fprintf(&sb, "     %-*s %s\n", labelWidth+1, field.Label+":", field.Value)
+		}
+	}
+
+	fee := theTx.GetFee().String()
+	if fee == "" {
+		fee = "none"
+	}
+	writeSummaryField(&sb, "Fee", fee)
+	writeSummaryField(&sb, "Gas", fmt.Sprint(theTx.GetGas()))
+	if granter := clientCtx.GetFeeGranterAddress(); !granter.Empty() {
+		writeSummaryField(&sb, "Fee granter", granter.String())
+	}
+	if memo := theTx.GetMemo(); memo != "" {
+		writeSummaryField(&sb, "Memo", memo)
+	}
+
+	return sb.String(), nil
+}
+
+// writeSummaryField writes a top-level labelled line of a tx summary, with its
+// value aligned to the other top-level values.
+func writeSummaryField(sb *strings.Builder, label, value string) {
+	fmt.Fprintf(sb, "%-12s %s\n", label+":", value)
+}
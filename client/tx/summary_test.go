@@ -0,0 +1,104 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestFormatTxSummary(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	val := sdk.ValAddress("validator___________")
+	granter := sdk.AccAddress("granter_____________")
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithFromAddress(from).
+		WithFromName("alice")
+
+	testCases := []struct {
+		name      string
+		clientCtx client.Context
+		msg       sdk.Msg
+		memo      string
+		fee       sdk.Coins
+		expected  string
+	}{
+		{
+			"bank send",
+			clientCtx,
+			banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 5))),
+			"a memo",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+			`Chain ID:    test-chain
+Signer:      cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc (alice)
+Messages (1):
+  1. /cosmos.bank.v1beta1.MsgSend
+     From:   cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc
+     To:     cosmos1w3h47h6lta047h6lta047h6lta047h6l620gq6
+     Amount: 10stake,5uatom
+Fee:         2stake
+Gas:         200000
+Memo:        a memo
+`,
+		},
+		{
+			"delegate",
+			clientCtx.WithFeeGranterAddress(granter),
+			stakingtypes.NewMsgDelegate(from, val, sdk.NewInt64Coin("stake", 100)),
+			"",
+			nil,
+			`Chain ID:    test-chain
+Signer:      cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc (alice)
+Messages (1):
+  1. /cosmos.staking.v1beta1.MsgDelegate
+     Delegator: cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc
+     Validator: cosmosvaloper1weskc6tyv96x7ujlta047h6lta047h6l0w0r2j
+     Amount:    100stake
+Fee:         none
+Gas:         200000
+Fee granter: cosmos1vaexzmn5v4e97h6lta047h6lta047h6l3kck0u
+`,
+		},
+		{
+			"unknown message type",
+			clientCtx.WithFromName(from.String()),
+			testdata.NewTestMsg(from),
+			"",
+			nil,
+			`Chain ID:    test-chain
+Signer:      cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc
+Messages (1):
+  1. /testdata.TestMsg
+     {"signers":["cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc"]}
+Fee:         none
+Gas:         200000
+`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			txBuilder.SetMemo(tc.memo)
+			txBuilder.SetFeeAmount(tc.fee)
+			txBuilder.SetGasLimit(200000)
+
+			summary, err := tx.FormatTxSummary(tc.clientCtx, "test-chain", txBuilder, signing.DefaultMsgRenderers)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, summary)
+		})
+	}
+}
@@ -6,12 +6,19 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"strconv"
+	"time"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/cli"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -40,6 +47,11 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 		}
 	}
 
+	txf, err := txf.withDisplayDenoms(clientCtx)
+	if err != nil {
+		return err
+	}
+
 	if clientCtx.GenerateOnly {
 		return txf.PrintUnsignedTx(clientCtx, msgs...)
 	}
@@ -47,10 +59,25 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 	return BroadcastTx(clientCtx, txf, msgs...)
 }
 
+// MaxSequenceMismatchRetries is the maximum number of times BroadcastTx
+// re-signs and rebroadcasts a transaction rejected with an account sequence
+// mismatch, when the client context enables it.
+const MaxSequenceMismatchRetries = 3
+
+var expectedSequenceRegexp = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
 // BroadcastTx attempts to generate, sign and broadcast a transaction with the
 // given set of messages. It will also simulate gas requirements if necessary.
+// If the client context enables RetryOnSequenceMismatch, the sequence was not
+// set explicitly and the signer is a local key, a transaction rejected with an
+// account sequence mismatch is re-signed with the expected sequence and
+// rebroadcast, at most MaxSequenceMismatchRetries times.
 // It will return an error upon failure.
 func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
+	// only a sequence queried from the account is replaced when retrying on a
+	// sequence mismatch, never one set explicitly with --sequence
+	autoSequence := txf.Sequence() == 0
+
 	txf, err := txf.Prepare(clientCtx)
 	if err != nil {
 		return err
@@ -76,12 +103,12 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	}
 
 	if !clientCtx.SkipConfirm {
-		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
+		summary, err := FormatTxSummary(clientCtx, txf.ChainID(), tx, authsigning.DefaultMsgRenderers)
 		if err != nil {
 			return err
 		}
 
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", summary)
 
 		buf := bufio.NewReader(os.Stdin)
 		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
@@ -92,24 +119,133 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		}
 	}
 
-	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
-	err = Sign(txf, clientCtx.GetFromName(), tx, true)
-	if err != nil {
-		return err
+	retry := clientCtx.RetryOnSequenceMismatch && autoSequence && isLocalKey(txf, clientCtx.GetFromName())
+	retries := 0
+	for {
+		tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+		err = Sign(txf, clientCtx.GetFromName(), tx, true)
+		if err != nil {
+			return err
+		}
+
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+		if err != nil {
+			return err
+		}
+
+		// broadcast to a Tendermint node
+		res, err := clientCtx.BroadcastTx(txBytes)
+		if err != nil {
+			return err
+		}
+
+		expected, mismatch := sequenceMismatch(res)
+		if !retry || !mismatch || retries == MaxSequenceMismatchRetries {
+			if retries > 0 {
+				_, _ = fmt.Fprintf(os.Stderr, "broadcast retried %d time(s) after an account sequence mismatch\n", retries)
+			}
+
+			res, err = AwaitInclusion(clientCtx, res)
+			if err != nil {
+				return err
+			}
+
+			return cli.PrintTxResponse(clientCtx, res)
+		}
+
+		retries++
+		txf, err = txf.WithSequence(0).Prepare(clientCtx)
+		if err != nil {
+			return err
+		}
+
+		// the sequence expected by the node accounts for the txs of the
+		// signer that are still in its mempool, unlike the queried account
+		if expected > txf.Sequence() {
+			txf = txf.WithSequence(expected)
+		}
+
+		tx, err = txf.BuildUnsignedTx(msgs...)
+		if err != nil {
+			return err
+		}
 	}
+}
 
-	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
-	if err != nil {
-		return err
+// isLocalKey returns true if the key of the given name is a local key of the
+// factory keyring, which signs without any user interaction.
+func isLocalKey(txf Factory, name string) bool {
+	if txf.keybase == nil {
+		return false
 	}
 
-	// broadcast to a Tendermint node
-	res, err := clientCtx.BroadcastTx(txBytes)
+	k, err := txf.keybase.Key(name)
 	if err != nil {
-		return err
+		return false
 	}
 
-	return clientCtx.PrintProto(res)
+	return k.GetType() == keyring.TypeLocal
+}
+
+// sequenceMismatch returns true if the tx of the response was rejected for an
+// account sequence mismatch, along with the sequence expected by the node when
+// its log provides it.
+func sequenceMismatch(res *sdk.TxResponse) (uint64, bool) {
+	if res == nil || res.Codespace != sdkerrors.RootCodespace || res.Code != sdkerrors.ErrWrongSequence.ABCICode() {
+		return 0, false
+	}
+
+	var expected uint64
+	if m := expectedSequenceRegexp.FindStringSubmatch(res.RawLog); m != nil {
+		expected, _ = strconv.ParseUint(m[1], 10, 64)
+	}
+
+	return expected, true
+}
+
+// WaitForTxPollInterval is the interval at which WaitForTx queries the tx. It
+// is a var so that tests can lower it.
+var WaitForTxPollInterval = time.Second
+
+// AwaitInclusion waits for the tx of a successful broadcast response to be
+// included in a block when the client context enables Wait, and returns its
+// execution result. Otherwise, it returns the broadcast response.
+func AwaitInclusion(clientCtx client.Context, res *sdk.TxResponse) (*sdk.TxResponse, error) {
+	if !clientCtx.Wait || res == nil || res.Code != 0 {
+		return res, nil
+	}
+
+	timeout := clientCtx.WaitTimeout
+	if timeout == 0 {
+		timeout = flags.DefaultWaitTimeout
+	}
+
+	return WaitForTx(clientCtx, res.TxHash, timeout)
+}
+
+// WaitForTx queries the tx of the given hex hash with the GetTx service every
+// WaitForTxPollInterval until it is found, and returns its execution result.
+// It returns an error with the hash if the tx is not found before the timeout.
+func WaitForTx(clientCtx gogogrpc.ClientConn, hash string, timeout time.Duration) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(WaitForTxPollInterval)
+	defer ticker.Stop()
+
+	txSvcClient := tx.NewServiceClient(clientCtx)
+	for {
+		res, err := txSvcClient.GetTx(ctx, &tx.GetTxRequest{Hash: hash})
+		if err == nil {
+			return res.TxResponse, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("tx %s was not included in a block after %s, last query error: %w", hash, timeout, err)
+		case <-ticker.C:
+		}
+	}
 }
 
 // CalculateGas simulates the execution of a transaction and returns the
@@ -188,17 +324,25 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		return errors.New("keybase must be set prior to signing a transaction")
 	}
 
+	k, err := txf.keybase.Key(name)
+	if err != nil {
+		return err
+	}
+
 	signMode := txf.signMode
 	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
 		// use the SignModeHandler's default mode if unspecified
 		signMode = txf.txConfig.SignModeHandler().DefaultMode()
 	}
-	if err := checkMultipleSigners(signMode, txBuilder.GetTx()); err != nil {
-		return err
+	isLedgerDirect := k.GetType() == keyring.TypeLedger && signMode == signing.SignMode_SIGN_MODE_DIRECT
+	if isLedgerDirect {
+		signMode, err = ledgerSignMode()
+		if err != nil {
+			return err
+		}
+		isLedgerDirect = signMode == signing.SignMode_SIGN_MODE_DIRECT
 	}
-
-	k, err := txf.keybase.Key(name)
-	if err != nil {
+	if err := checkMultipleSigners(signMode, txBuilder.GetTx()); err != nil {
 		return err
 	}
 
@@ -263,7 +407,12 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	}
 
 	// Sign those bytes
-	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign)
+	var sigBytes []byte
+	if isLedgerDirect {
+		sigBytes, _, err = keyring.SignWithLedgerDirect(k, bytesToSign)
+	} else {
+		sigBytes, _, err = txf.keybase.Sign(name, bytesToSign)
+	}
 	if err != nil {
 		return err
 	}
@@ -286,6 +435,23 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	return txBuilder.SetSignatures(prevSignatures...)
 }
 
+// ledgerSignMode returns the sign mode of a Ledger key configured to sign in
+// SIGN_MODE_DIRECT. It is SIGN_MODE_DIRECT if the Cosmos app of the connected
+// device supports it. Otherwise, it falls back to SIGN_MODE_LEGACY_AMINO_JSON
+// and tells the user why.
+func ledgerSignMode() (signing.SignMode, error) {
+	supported, err := ledger.SupportsSignModeDirect()
+	if err != nil {
+		return signing.SignMode_SIGN_MODE_UNSPECIFIED, err
+	}
+	if supported {
+		return signing.SignMode_SIGN_MODE_DIRECT, nil
+	}
+
+	_, _ = fmt.Fprintln(os.Stderr, "Sign-mode 'direct' is not supported by the version of the Ledger app, using sign-mode 'amino-json'. Update the Cosmos app of the Ledger to sign in 'direct' mode.")
+	return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
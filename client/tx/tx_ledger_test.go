@@ -0,0 +1,82 @@
+//go:build ledger && test_ledger_mock
+// +build ledger,test_ledger_mock
+
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/ledger"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSignLedger(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, encCfg.Codec)
+	require.NoError(t, err)
+
+	k, err := kb.SaveLedgerKey("ledger", hd.Secp256k1, "cosmos", 118, 0, 0)
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	txf := tx.Factory{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithKeybase(kb).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithFees("50stake").
+		WithChainID("test-chain")
+	signerData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 50,
+		Sequence:      23,
+	}
+
+	supportsSignModeDirect := ledger.MockSupportsSignModeDirect
+	t.Cleanup(func() { ledger.MockSupportsSignModeDirect = supportsSignModeDirect })
+
+	testCases := []struct {
+		name                   string
+		signMode               signingtypes.SignMode
+		supportsSignModeDirect bool
+		expSignMode            signingtypes.SignMode
+	}{
+		{"direct with an app supporting direct", signingtypes.SignMode_SIGN_MODE_DIRECT, true, signingtypes.SignMode_SIGN_MODE_DIRECT},
+		{"direct falls back to amino-json", signingtypes.SignMode_SIGN_MODE_DIRECT, false, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+		{"default mode falls back to amino-json", signingtypes.SignMode_SIGN_MODE_UNSPECIFIED, false, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+		{"amino-json with an app supporting direct", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, true, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ledger.MockSupportsSignModeDirect = tc.supportsSignModeDirect
+
+			txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(addr, sdk.AccAddress("to"), nil))
+			require.NoError(t, err)
+			require.NoError(t, tx.Sign(txf.WithSignMode(tc.signMode), "ledger", txb, true))
+
+			sigs, err := txb.GetTx().GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			require.True(t, sigs[0].PubKey.Equals(pubKey))
+			sigData, ok := sigs[0].Data.(*signingtypes.SingleSignatureData)
+			require.True(t, ok)
+			require.Equal(t, tc.expSignMode, sigData.SignMode)
+
+			err = signing.VerifySignature(pubKey, signerData, sigData, encCfg.TxConfig.SignModeHandler(), txb.GetTx())
+			require.NoError(t, err)
+		})
+	}
+}
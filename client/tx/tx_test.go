@@ -1,20 +1,32 @@
 package tx_test
 
 import (
+	"bytes"
 	gocontext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -246,3 +258,233 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+// mockBroadcastClient is a mock Tendermint RPC client whose sync broadcasts
+// are rejected with an account sequence mismatch until the tx is signed with
+// the expected sequence, and whose GetTx queries find the tx after
+// pendingPolls queries.
+type mockBroadcastClient struct {
+	mock.Client
+	txDecoder    sdk.TxDecoder
+	expected     uint64
+	sequences    []uint64
+	pendingPolls int
+	polls        int
+}
+
+func (c *mockBroadcastClient) BroadcastTxSync(_ gocontext.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	decoded, err := c.txDecoder(tx)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := decoded.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	sequence := sigs[0].Sequence
+	c.sequences = append(c.sequences, sequence)
+	if sequence != c.expected {
+		return &coretypes.ResultBroadcastTx{
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Codespace: sdkerrors.RootCodespace,
+			Log:       fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", c.expected, sequence),
+		}, nil
+	}
+
+	return &coretypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (c *mockBroadcastClient) ABCIQueryWithOptions(_ gocontext.Context, path string, data tmbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	if path != "/cosmos.tx.v1beta1.Service/GetTx" {
+		return nil, fmt.Errorf("unexpected query %s", path)
+	}
+
+	var req txtypes.GetTxRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	c.polls++
+	if c.polls <= c.pendingPolls {
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code: sdkerrors.ErrNotFound.ABCICode(),
+			Log:  fmt.Sprintf("tx (%s) not found", req.Hash),
+		}}, nil
+	}
+
+	bz, err := (&txtypes.GetTxResponse{TxResponse: &sdk.TxResponse{
+		Height:  7,
+		TxHash:  req.Hash,
+		GasUsed: 51234,
+		Logs: sdk.ABCIMessageLogs{sdk.NewABCIMessageLog(0, "", sdk.Events{{
+			Type:       "message",
+			Attributes: []abci.EventAttribute{{Key: "action", Value: "send"}},
+		}})},
+	}}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func TestBroadcastTxRetryOnSequenceMismatch(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	kb, err := keyring.New(t.Name(), keyring.BackendTest, t.TempDir(), nil, encCfg.Codec)
+	require.NoError(t, err)
+
+	k, err := kb.NewAccount("local", testutil.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	_, err = kb.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	// the node expects sequence 6 while the account queried still has sequence
+	// 5, as a tx of the signer is in the mempool
+	accountRetriever := client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+		addr.String(): {Address: addr, Num: 1, Seq: 5},
+	}}
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	testCases := []struct {
+		name         string
+		retry        bool
+		from         string
+		sequence     uint64
+		expSequences []uint64
+		expCode      uint32
+	}{
+		{"retries with the expected sequence", true, "local", 0, []uint64{5, 6}, 0},
+		{"disabled", false, "local", 0, []uint64{5}, sdkerrors.ErrWrongSequence.ABCICode()},
+		{"explicit sequence", true, "local", 4, []uint64{4}, sdkerrors.ErrWrongSequence.ABCICode()},
+		{"key not local", true, "offline", 0, nil, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rpcClient := &mockBroadcastClient{txDecoder: encCfg.TxConfig.TxDecoder(), expected: 6}
+			out := &bytes.Buffer{}
+			clientCtx := client.Context{}.
+				WithClient(rpcClient).
+				WithTxConfig(encCfg.TxConfig).
+				WithCodec(encCfg.Codec).
+				WithAccountRetriever(accountRetriever).
+				WithKeyring(kb).
+				WithFromName(tc.from).
+				WithFromAddress(addr).
+				WithChainID("test-chain").
+				WithBroadcastMode(flags.BroadcastSync).
+				WithSkipConfirmation(true).
+				WithOutputFormat("json").
+				WithOutput(out).
+				WithRetryOnSequenceMismatch(tc.retry)
+			txf := tx.Factory{}.
+				WithTxConfig(encCfg.TxConfig).
+				WithKeybase(kb).
+				WithAccountRetriever(accountRetriever).
+				WithChainID("test-chain").
+				WithGas(200000).
+				WithSequence(tc.sequence).
+				WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+
+			err := tx.BroadcastTx(clientCtx, txf, msg)
+			if tc.expSequences == nil {
+				// offline keys cannot sign, so nothing is broadcast
+				require.Error(t, err)
+				require.Empty(t, rpcClient.sequences)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expSequences, rpcClient.sequences)
+
+			var res sdk.TxResponse
+			require.NoError(t, encCfg.Codec.UnmarshalJSON(out.Bytes(), &res))
+			require.Equal(t, tc.expCode, res.Code)
+		})
+	}
+}
+
+func TestBroadcastTxWait(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	kb, err := keyring.New(t.Name(), keyring.BackendTest, t.TempDir(), nil, encCfg.Codec)
+	require.NoError(t, err)
+
+	k, err := kb.NewAccount("local", testutil.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	pollInterval := tx.WaitForTxPollInterval
+	tx.WaitForTxPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { tx.WaitForTxPollInterval = pollInterval })
+
+	accountRetriever := client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+		addr.String(): {Address: addr, Num: 1, Seq: 6},
+	}}
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	testCases := []struct {
+		name         string
+		wait         bool
+		timeout      time.Duration
+		pendingPolls int
+		expPolls     int
+		expErr       bool
+	}{
+		{"found after 3 polls", true, time.Minute, 2, 3, false},
+		{"timeout", true, 50 * time.Millisecond, 1000, 0, true},
+		{"no wait", false, time.Minute, 0, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rpcClient := &mockBroadcastClient{txDecoder: encCfg.TxConfig.TxDecoder(), expected: 6, pendingPolls: tc.pendingPolls}
+			out := &bytes.Buffer{}
+			clientCtx := client.Context{}.
+				WithClient(rpcClient).
+				WithTxConfig(encCfg.TxConfig).
+				WithCodec(encCfg.Codec).
+				WithAccountRetriever(accountRetriever).
+				WithKeyring(kb).
+				WithFromName("local").
+				WithFromAddress(addr).
+				WithChainID("test-chain").
+				WithBroadcastMode(flags.BroadcastSync).
+				WithSkipConfirmation(true).
+				WithOutputFormat("json").
+				WithOutput(out).
+				WithWait(tc.wait).
+				WithWaitTimeout(tc.timeout)
+			txf := tx.Factory{}.
+				WithTxConfig(encCfg.TxConfig).
+				WithKeybase(kb).
+				WithAccountRetriever(accountRetriever).
+				WithChainID("test-chain").
+				WithGas(200000).
+				WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+
+			err := tx.BroadcastTx(clientCtx, txf, msg)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "was not included in a block after 50ms")
+				require.Empty(t, out.String())
+				return
+			}
+
+			require.NoError(t, err)
+			var res sdk.TxResponse
+			require.NoError(t, encCfg.Codec.UnmarshalJSON(out.Bytes(), &res))
+			require.NotEmpty(t, res.TxHash)
+			if tc.expPolls > 0 {
+				require.Equal(t, tc.expPolls, rpcClient.polls)
+				require.Equal(t, int64(7), res.Height)
+				require.Equal(t, int64(51234), res.GasUsed)
+				require.Equal(t, "message", res.Logs[0].Events[0].Type)
+			} else {
+				require.Zero(t, rpcClient.polls)
+				require.Zero(t, res.Height)
+			}
+		})
+	}
+}
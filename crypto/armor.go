@@ -25,6 +25,7 @@ const (
 
 	headerVersion = "version"
 	headerType    = "type"
+	headerLabel   = "label"
 )
 
 // BcryptSecurityParameter is security parameter var, and it can be changed within the lcd test.
@@ -122,6 +123,34 @@ func unarmorBytes(armorStr, blockType string) (bz []byte, header map[string]stri
 	return
 }
 
+// SetArmorLabel sets the label of the key of an armor, such as the armors of
+// ArmorPubKeyBytes and EncryptArmorPrivKey, in its header. An empty label
+// leaves the armor unchanged.
+func SetArmorLabel(armorStr, label string) (string, error) {
+	if label == "" {
+		return armorStr, nil
+	}
+
+	blockType, header, bz, err := DecodeArmor(armorStr)
+	if err != nil {
+		return "", err
+	}
+	header[headerLabel] = label
+
+	return EncodeArmor(blockType, header, bz), nil
+}
+
+// ArmorLabel returns the label of the key of an armor, or an empty string if
+// the armor has none.
+func ArmorLabel(armorStr string) (string, error) {
+	_, header, _, err := DecodeArmor(armorStr)
+	if err != nil {
+		return "", err
+	}
+
+	return header[headerLabel], nil
+}
+
 //-----------------------------------------------------------------
 // encrypt/decrypt with armor
 
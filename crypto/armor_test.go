@@ -136,6 +136,34 @@ func TestArmorUnarmorPubKey(t *testing.T) {
 	require.Equal(t, "unrecognized version: unknown", err.Error())
 }
 
+func TestArmorLabel(t *testing.T) {
+	armored := crypto.ArmorPubKeyBytes([]byte("pubkey"), "secp256k1")
+	label, err := crypto.ArmorLabel(armored)
+	require.NoError(t, err)
+	require.Empty(t, label)
+
+	unchanged, err := crypto.SetArmorLabel(armored, "")
+	require.NoError(t, err)
+	require.Equal(t, armored, unchanged)
+
+	labeled, err := crypto.SetArmorLabel(armored, "validator: main")
+	require.NoError(t, err)
+	label, err = crypto.ArmorLabel(labeled)
+	require.NoError(t, err)
+	require.Equal(t, "validator: main", label)
+
+	// the label does not change the armored key
+	pubBytes, algo, err := crypto.UnarmorPubKeyBytes(labeled)
+	require.NoError(t, err)
+	require.Equal(t, []byte("pubkey"), pubBytes)
+	require.Equal(t, "secp256k1", algo)
+
+	_, err = crypto.SetArmorLabel("not an armor", "label")
+	require.Error(t, err)
+	_, err = crypto.ArmorLabel("not an armor")
+	require.Error(t, err)
+}
+
 func TestArmorInfoBytes(t *testing.T) {
 	bs := []byte("test")
 	armoredString := crypto.ArmorInfoBytes(bs)
@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BackupVersion is the version of the encrypted backups created by
+// EncryptBackup. DecryptBackup rejects the backups of a later version, whose
+// content it may not be able to read.
+const BackupVersion = 1
+
+// backupJSON is an encrypted backup. It uses the scrypt and AES-GCM
+// encryption of the keystores.
+type backupJSON struct {
+	Version int            `json:"version"`
+	Crypto  keystoreCrypto `json:"crypto"`
+}
+
+// EncryptBackup encrypts the content of a backup with a key derived from the
+// passphrase by scrypt, and returns it as a versioned JSON document.
+func EncryptBackup(content []byte, passphrase string) ([]byte, error) {
+	encrypted, err := encryptScrypt(content, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(backupJSON{
+		Version: BackupVersion,
+		Crypto:  encrypted,
+	}, "", "  ")
+}
+
+// DecryptBackup decrypts the content of a backup created by EncryptBackup. It
+// returns ErrWrongPassword if the passphrase does not decrypt the backup.
+func DecryptBackup(bz []byte, passphrase string) ([]byte, error) {
+	var backup backupJSON
+	if err := json.Unmarshal(bz, &backup); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to decode backup")
+	}
+
+	if backup.Version < 1 || backup.Version > BackupVersion {
+		return nil, fmt.Errorf("unsupported backup version: %d", backup.Version)
+	}
+
+	return decryptScrypt(backup.Crypto, passphrase)
+}
@@ -0,0 +1,50 @@
+package crypto_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestEncryptDecryptBackup(t *testing.T) {
+	lowerKeystoreScryptN(t)
+
+	content := []byte(`{"records":[]}`)
+	backup, err := crypto.EncryptBackup(content, "passphrase")
+	require.NoError(t, err)
+
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(backup, &fields))
+	require.Equal(t, float64(crypto.BackupVersion), fields["version"])
+
+	_, err = crypto.DecryptBackup(backup, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	decrypted, err := crypto.DecryptBackup(backup, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, content, decrypted)
+
+	for _, version := range []int{0, crypto.BackupVersion + 1} {
+		fields["version"] = version
+		bz, err := json.Marshal(fields)
+		require.NoError(t, err)
+		_, err = crypto.DecryptBackup(bz, "passphrase")
+		require.EqualError(t, err, fmt.Sprintf("unsupported backup version: %d", version))
+	}
+
+	_, err = crypto.DecryptBackup(backup[:len(backup)/2], "passphrase")
+	require.Error(t, err)
+
+	// the scrypt cost of the backup is bounded before deriving the key
+	fields["version"] = crypto.BackupVersion
+	fields["crypto"].(map[string]interface{})["kdfparams"].(map[string]interface{})["n"] = 1 << 30
+	bz, err := json.Marshal(fields)
+	require.NoError(t, err)
+	_, err = crypto.DecryptBackup(bz, "passphrase")
+	require.EqualError(t, err, "scrypt cost parameter 1073741824 is above the maximum of 262144")
+}
@@ -1,9 +1,12 @@
 package hd
 
 import (
+	"fmt"
+
 	"github.com/cosmos/go-bip39"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -20,11 +23,15 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters.
+	Secp256r1Type = PubKeyType("secp256r1")
 )
 
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	Secp256r1 = secp256r1Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -69,3 +76,42 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type secp256r1Algo struct {
+}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 private key for the given seed and HD path.
+// The secret is derived like the secp256k1 ones. It returns an error in the
+// rare case the secret is not a valid secp256r1 private key, another HD path
+// must be used then.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+		derivedKey, err := Secp256k1.Derive()(mnemonic, bip39Passphrase, hdPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := secp256r1.NewPrivKeyFromSecret(derivedKey); err != nil {
+			return nil, fmt.Errorf("the key derived for the HD path %q is not a valid secp256r1 key, use another path: %w", hdPath, err)
+		}
+
+		return derivedKey, nil
+	}
+}
+
+// Generate generates a secp256r1 private key from the given bytes. The bytes
+// must be a secret returned by Derive.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		privKey, err := secp256r1.NewPrivKeyFromSecret(bz)
+		if err != nil {
+			panic(err)
+		}
+
+		return privKey
+	}
+}
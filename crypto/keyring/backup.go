@@ -0,0 +1,152 @@
+package keyring
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+)
+
+// RestoreStatus is the outcome of the restore of a key of a backup.
+type RestoreStatus string
+
+const (
+	// RestoreRestored is the status of a key imported from the backup.
+	RestoreRestored RestoreStatus = "restored"
+	// RestoreOverwritten is the status of a key of the backup that replaced
+	// the existing key of the same name.
+	RestoreOverwritten RestoreStatus = "overwritten"
+	// RestoreSkipped is the status of a key of the backup that was not
+	// imported because a key of the same name exists.
+	RestoreSkipped RestoreStatus = "skipped"
+	// RestoreFailed is the status of a key of the backup that cannot be
+	// imported.
+	RestoreFailed RestoreStatus = "failed"
+)
+
+// RestoreResult reports the restore of a key of a backup.
+type RestoreResult struct {
+	Name   string
+	Type   KeyType
+	Status RestoreStatus
+	// Err is the reason of the failure of the restore.
+	Err error
+}
+
+// backupContent is the content of an encrypted backup: the records of the
+// keys in the proto binary format.
+type backupContent struct {
+	Records [][]byte `json:"records"`
+}
+
+// Backup returns all the keys but the Ledger ones in an encrypted backup.
+func (ks keystore) Backup(encryptPassphrase string) ([]byte, []*Record, error) {
+	records, err := ks.List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		content  backupContent
+		backedUp []*Record
+	)
+	for _, k := range records {
+		if k.GetType() == TypeLedger {
+			continue
+		}
+
+		bz, err := ks.cdc.Marshal(k)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to serialize record %s, err: %w", k.Name, err)
+		}
+		content.Records = append(content.Records, bz)
+		backedUp = append(backedUp, k)
+	}
+
+	bz, err := json.Marshal(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backup, err := crypto.EncryptBackup(bz, encryptPassphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return backup, backedUp, nil
+}
+
+// RestoreBackup imports the keys of an encrypted backup created by Backup.
+func (ks keystore) RestoreBackup(backup []byte, passphrase string, overwrite bool) ([]RestoreResult, error) {
+	bz, err := crypto.DecryptBackup(backup, passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt backup")
+	}
+
+	var content backupContent
+	if err := json.Unmarshal(bz, &content); err != nil {
+		return nil, errors.Wrap(err, "failed to decode backup")
+	}
+
+	results := make([]RestoreResult, 0, len(content.Records))
+	for _, recordBz := range content.Records {
+		k, err := ks.protoUnmarshalRecord(recordBz)
+		if err != nil {
+			results = append(results, RestoreResult{Status: RestoreFailed, Err: err})
+			continue
+		}
+
+		res := RestoreResult{Name: k.Name, Type: k.GetType()}
+		res.Status, res.Err = ks.restoreRecord(k, overwrite)
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// restoreRecord writes the record of a backup. An existing key of the same
+// name is replaced if overwrite is set, a key of another name with the same
+// address is never replaced. The replaced key is put back if the record of the
+// backup cannot be written.
+func (ks keystore) restoreRecord(k *Record, overwrite bool) (RestoreStatus, error) {
+	addr, err := k.GetAddress()
+	if err != nil {
+		return RestoreFailed, err
+	}
+
+	existing, err := ks.Key(k.Name)
+	if err == nil && !overwrite {
+		return RestoreSkipped, nil
+	}
+
+	if other, err := ks.KeyByAddress(addr); err == nil && other.Name != k.Name {
+		return RestoreFailed, fmt.Errorf("the address of the key is the address of the existing key %s", other.Name)
+	}
+
+	if existing == nil {
+		if err := ks.writeRecord(k); err != nil {
+			return RestoreFailed, err
+		}
+		return RestoreRestored, nil
+	}
+
+	// the record of the backup has the name of the existing key, which must be
+	// deleted before writing it
+	if err := ks.Delete(k.Name); err != nil {
+		return RestoreFailed, err
+	}
+
+	if err := ks.writeRecord(k); err != nil {
+		// drop what was written of the record before putting back the key
+		_ = ks.db.Remove(infoKey(k.Name))
+		_ = ks.db.Remove(addrHexKeyAsString(addr))
+		if restoreErr := ks.writeRecord(existing); restoreErr != nil {
+			return RestoreFailed, fmt.Errorf("%v; failed to put back the existing key: %w", err, restoreErr)
+		}
+		return RestoreFailed, err
+	}
+
+	return RestoreOverwritten, nil
+}
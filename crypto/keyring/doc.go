@@ -35,6 +35,8 @@
 // 			v0.38.1. It stores the keyring encrypted within the app's configuration directory.
 // 			This keyring will request a password each time it is accessed, which may occur
 // 			multiple times in a single command resulting in repeated password prompts.
+// 			The password is not prompted if it is read from the PassphraseFile option or
+// 			from the KEYRING_PASSPHRASE environment variable.
 // 	kwallet	This backend uses KDE Wallet Manager as a credentials management application:
 // 			https://github.com/KDE/kwallet
 // 	pass	This backend uses the pass command line utility to store and retrieve keys:
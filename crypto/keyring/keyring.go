@@ -36,13 +36,17 @@ const (
 	BackendMemory  = "memory"
 )
 
+// EnvKeyringPassphrase is the environment variable holding the passphrase of the
+// file and os keyring backends, used instead of prompting for it unless a
+// passphrase file is set. It is less secure than a passphrase file, as the
+// environment of a process can be read by other processes of the same user and
+// is inherited by its children.
+const EnvKeyringPassphrase = "KEYRING_PASSPHRASE"
+
 const (
 	keyringFileDirName = "keyring-file"
 	keyringTestDirName = "keyring-test"
 	passKeyringPrefix  = "keyring-%s"
-
-	// temporary pass phrase for exporting a key during a key rename
-	passPhrase = "temp"
 )
 
 var (
@@ -58,6 +62,10 @@ type Keyring interface {
 	// Supported signing algorithms for Keyring and Ledger respectively.
 	SupportedAlgorithms() (SigningAlgoList, SigningAlgoList)
 
+	// Backend returns the backend of the keyring, empty for the keyrings created
+	// by NewKeystore.
+	Backend() string
+
 	// Key and KeyByAddress return keys by uid and address respectively.
 	Key(uid string) (*Record, error)
 	KeyByAddress(address sdk.Address) (*Record, error)
@@ -69,6 +77,10 @@ type Keyring interface {
 	// Rename an existing key from the Keyring
 	Rename(from string, to string) error
 
+	// SetLabel sets the free-text label of an existing key, or clears it if the
+	// label is empty.
+	SetLabel(uid, label string) (*Record, error)
+
 	// NewMnemonic generates a new mnemonic, derives a hierarchical deterministic key from it, and
 	// persists the key to storage. Returns the generated mnemonic and the key Info.
 	// It returns an error if it fails to generate a key for the given algo type, or if
@@ -84,6 +96,10 @@ type Keyring interface {
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SaveLedgerKeyWithHDPath retrieves the public key reference of the BIP44 hdPath
+	// from a Ledger device and persists it. The path is used to sign with the key.
+	SaveLedgerKeyWithHDPath(uid string, algo SignatureAlgo, hrp, hdPath string) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -121,11 +137,26 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+
+	// ImportPrivKeyKeystore imports a private key from an encrypted JSON keystore.
+	ImportPrivKeyKeystore(uid string, keystore []byte, passphrase string) error
+
+	// RestoreBackup imports the keys of an encrypted backup created by Backup
+	// and reports the outcome of every key. The existing keys are skipped,
+	// unless overwrite is set.
+	RestoreBackup(backup []byte, passphrase string, overwrite bool) ([]RestoreResult, error)
 }
 
 // Migrator is implemented by key stores and enables migration of  keys from amino to proto
 type Migrator interface {
 	MigrateAll() (bool, error)
+
+	// MigrateRecords migrates all the keys, or only checks them with dryRun,
+	// and reports the outcome of every key.
+	MigrateRecords(dryRun bool) ([]MigrationResult, error)
+
+	// DeleteLegacyRecords removes the amino data kept by the migration.
+	DeleteLegacyRecords() error
 }
 
 // Exporter is implemented by key stores that support export of public and private keys.
@@ -138,6 +169,14 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+
+	// ExportPrivKeyKeystore returns a secp256k1, secp256r1 or ed25519 private
+	// key in an encrypted JSON keystore.
+	ExportPrivKeyKeystore(uid, encryptPassphrase string) (keystore []byte, err error)
+
+	// Backup returns all the keys but the Ledger ones in an encrypted backup,
+	// and the records of the keys backed up.
+	Backup(encryptPassphrase string) (backup []byte, records []*Record, err error)
 }
 
 // UnsafeExporter is implemented by key stores that support unsafe export
@@ -156,13 +195,18 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// file holding the passphrase of the file and os backends, read instead of
+	// prompting for the passphrase
+	PassphraseFile string
 }
 
 // NewInMemory creates a transient keyring useful for testing
 // purposes and on-the-fly key generation.
 // Keybase options can be applied when generating this new Keybase.
 func NewInMemory(cdc codec.Codec, opts ...Option) Keyring {
-	return NewKeystore(keyring.NewArrayKeyring(nil), cdc, opts...)
+	ks := NewKeystore(keyring.NewArrayKeyring(nil), cdc, opts...)
+	ks.backend = BackendMemory
+	return ks
 }
 
 // New creates a new instance of a keyring.
@@ -172,19 +216,24 @@ func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
 	var (
-		db  keyring.Keyring
-		err error
+		db      keyring.Keyring
+		err     error
+		options Options
 	)
 
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
-		db, err = keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput))
+		db, err = keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput, options.PassphraseFile))
 	case BackendOS:
-		db, err = keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput))
+		db, err = keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput, options.PassphraseFile))
 	case BackendKWallet:
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
@@ -197,19 +246,22 @@ func New(
 		return nil, err
 	}
 
-	return NewKeystore(db, cdc, opts...), nil
+	ks := NewKeystore(db, cdc, opts...)
+	ks.backend = backend
+	return ks, nil
 }
 
 type keystore struct {
 	db      keyring.Keyring
 	cdc     codec.Codec
+	backend string
 	options Options
 }
 
 func NewKeystore(kr keyring.Keyring, cdc codec.Codec, opts ...Option) keystore {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -217,7 +269,7 @@ func NewKeystore(kr keyring.Keyring, cdc codec.Codec, opts ...Option) keystore {
 		optionFn(&options)
 	}
 
-	return keystore{kr, cdc, options}
+	return keystore{db: kr, cdc: cdc, options: options}
 }
 
 func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
@@ -236,7 +288,7 @@ func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
 		return "", err
 	}
 
-	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+	return crypto.SetArmorLabel(crypto.ArmorPubKeyBytes(bz, key.Type()), k.Label)
 }
 
 func (ks keystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
@@ -248,14 +300,20 @@ func (ks keystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, erro
 	return ks.ExportPubKeyArmor(k.Name)
 }
 
-// ExportPrivKeyArmor exports encrypted privKey
+// ExportPrivKeyArmor exports encrypted privKey, with the label of the key in
+// the armor header.
 func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error) {
-	priv, err := ks.ExportPrivateKeyObject(uid)
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	priv, err := extractPrivKeyFromRecord(k)
 	if err != nil {
 		return "", err
 	}
 
-	return crypto.EncryptArmorPrivKey(priv, encryptPassphrase, priv.Type()), nil
+	return crypto.SetArmorLabel(crypto.EncryptArmorPrivKey(priv, encryptPassphrase, priv.Type()), k.Label)
 }
 
 // ExportPrivateKeyObject exports an armored private key object.
@@ -282,6 +340,21 @@ func (ks keystore) ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassp
 	return ks.ExportPrivKeyArmor(k.Name, encryptPassphrase)
 }
 
+// ExportPrivKeyKeystore exports the privKey in an encrypted JSON keystore.
+func (ks keystore) ExportPrivKeyKeystore(uid, encryptPassphrase string) ([]byte, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	priv, err := extractPrivKeyFromRecord(k)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.EncryptKeystorePrivKey(priv, encryptPassphrase, k.Label)
+}
+
 func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	if k, err := ks.Key(uid); err == nil {
 		if uid == k.Name {
@@ -294,12 +367,25 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 		return errors.Wrap(err, "failed to decrypt private key")
 	}
 
-	_, err = ks.writeLocalKey(uid, privKey)
+	label, err := crypto.ArmorLabel(armor)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return ks.writeImportedLocalKey(uid, privKey, label)
+}
+
+func (ks keystore) ImportPrivKeyKeystore(uid string, keystore []byte, passphrase string) error {
+	if _, err := ks.Key(uid); err == nil {
+		return fmt.Errorf("cannot overwrite key: %s", uid)
+	}
+
+	privKey, label, err := crypto.DecryptKeystorePrivKey(keystore, passphrase)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt private key")
+	}
+
+	return ks.writeImportedLocalKey(uid, privKey, label)
 }
 
 func (ks keystore) ImportPubKey(uid string, armor string) error {
@@ -317,12 +403,21 @@ func (ks keystore) ImportPubKey(uid string, armor string) error {
 		return err
 	}
 
-	_, err = ks.writeOfflineKey(uid, pubKey)
+	label, err := crypto.ArmorLabel(armor)
 	if err != nil {
 		return err
 	}
+	if err := ValidateLabel(label); err != nil {
+		return err
+	}
 
-	return nil
+	k, err := NewOfflineRecord(uid, pubKey)
+	if err != nil {
+		return err
+	}
+	k.Label = label
+
+	return ks.writeRecord(k)
 }
 
 func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
@@ -369,6 +464,22 @@ func (ks keystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types
 }
 
 func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error) {
+	return ks.saveLedgerKey(uid, algo, hrp, hd.NewFundraiserParams(account, coinType, index))
+}
+
+func (ks keystore) SaveLedgerKeyWithHDPath(uid string, algo SignatureAlgo, hrp, hdPath string) (*Record, error) {
+	path, err := hd.NewParamsFromPath(hdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.saveLedgerKey(uid, algo, hrp, path)
+}
+
+func (ks keystore) saveLedgerKey(uid string, algo SignatureAlgo, hrp string, hdPath *hd.BIP44Params) (*Record, error) {
+	if algo.Name() == hd.Secp256r1Type {
+		return nil, fmt.Errorf("%w: Ledger devices do not support %s keys", ErrUnsupportedSigningAlgo, algo.Name())
+	}
 
 	if !ks.options.SupportedAlgosLedger.Contains(algo) {
 		return nil, fmt.Errorf(
@@ -377,8 +488,6 @@ func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coi
 		)
 	}
 
-	hdPath := hd.NewFundraiserParams(account, coinType, index)
-
 	priv, _, err := ledger.NewPrivKeySecp256k1(*hdPath, hrp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate ledger key: %w", err)
@@ -424,20 +533,53 @@ func (ks keystore) Rename(oldName, newName string) error {
 		return fmt.Errorf("rename failed: %s already exists in the keyring", newName)
 	}
 
-	armor, err := ks.ExportPrivKeyArmor(oldName, passPhrase)
+	k, err := ks.Key(oldName)
 	if err != nil {
 		return err
 	}
 
-	if err := ks.Delete(oldName); err != nil {
+	priv, err := extractPrivKeyFromRecord(k)
+	if err != nil {
 		return err
 	}
 
-	if err := ks.ImportPrivKey(newName, armor, passPhrase); err != nil {
+	renamed, err := NewLocalRecord(newName, priv, priv.PubKey())
+	if err != nil {
 		return err
 	}
+	// the derivation path, the label and the creation time of the key are kept
+	renamed.GetLocal().Path = k.GetPath()
+	renamed.Label = k.Label
+	renamed.CreatedAt = k.CreatedAt
 
-	return nil
+	if err := ks.Delete(oldName); err != nil {
+		return err
+	}
+
+	return ks.writeRecord(renamed)
+}
+
+func (ks keystore) SetLabel(uid, label string) (*Record, error) {
+	if err := ValidateLabel(label); err != nil {
+		return nil, err
+	}
+
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+	k.Label = label
+
+	serializedRecord, err := ks.cdc.Marshal(k)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize record, err - %s", err)
+	}
+
+	if err := ks.SetItem(keyring.Item{Key: infoKey(k.Name), Data: serializedRecord}); err != nil {
+		return nil, err
+	}
+
+	return k, nil
 }
 
 // Delete deletes a key in the keyring. `uid` represents the key name, without
@@ -499,7 +641,7 @@ func (ks keystore) List() ([]*Record, error) {
 	var res []*Record //nolint:prealloc
 	sort.Strings(keys)
 	for _, key := range keys {
-		if strings.Contains(key, addressSuffix) {
+		if strings.Contains(key, addressSuffix) || isLegacyKey(key) || key == keyhashFilename {
 			continue
 		}
 
@@ -576,7 +718,13 @@ func (ks keystore) NewAccount(name string, mnemonic string, bip39Passphrase stri
 		return nil, errors.New("duplicated address created")
 	}
 
-	return ks.writeLocalKey(name, privKey)
+	// keep the path of the keys derived from a BIP44 path
+	path, err := hd.NewParamsFromPath(hdPath)
+	if err != nil {
+		path = nil
+	}
+
+	return ks.writeLocalKey(name, privKey, path)
 }
 
 func (ks keystore) isSupportedSigningAlgo(algo SignatureAlgo) bool {
@@ -598,6 +746,11 @@ func (ks keystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
 	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
 }
 
+// Backend returns the backend the keystore was created with.
+func (ks keystore) Backend() string {
+	return ks.backend
+}
+
 // SignWithLedger signs a binary message with the ledger device referenced by an Info object
 // and returns the signed bytes and the public key. It returns an error if the device could
 // not be queried or it returned an error.
@@ -622,12 +775,35 @@ func SignWithLedger(k *Record, msg []byte) (sig []byte, pub types.PubKey, err er
 	return sig, priv.PubKey(), nil
 }
 
-func newOSBackendKeyringConfig(appName, dir string, buf io.Reader) keyring.Config {
+// SignWithLedgerDirect signs the SIGN_MODE_DIRECT sign bytes of a transaction
+// with the ledger device referenced by an Info object, where SignWithLedger signs
+// amino-JSON. The Cosmos app of the device must support SIGN_MODE_DIRECT, see
+// ledger.SupportsSignModeDirect.
+func SignWithLedgerDirect(k *Record, msg []byte) (sig []byte, pub types.PubKey, err error) {
+	ledgerInfo := k.GetLedger()
+	if ledgerInfo == nil {
+		return nil, nil, errors.New("not a ledger object")
+	}
+
+	priv, err := ledger.NewPrivKeySecp256k1Unsafe(*ledgerInfo.GetPath())
+	if err != nil {
+		return
+	}
+
+	sig, err = priv.(ledger.PrivKeyLedgerSecp256k1).SignDirect(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, priv.PubKey(), nil
+}
+
+func newOSBackendKeyringConfig(appName, dir string, buf io.Reader, passphraseFile string) keyring.Config {
 	return keyring.Config{
 		ServiceName:              appName,
 		FileDir:                  dir,
 		KeychainTrustApplication: true,
-		FilePasswordFunc:         newRealPrompt(dir, buf),
+		FilePasswordFunc:         newRealPrompt(dir, buf, passphraseFile),
 	}
 }
 
@@ -661,21 +837,30 @@ func newPassBackendKeyringConfig(appName, _ string, _ io.Reader) keyring.Config
 	}
 }
 
-func newFileBackendKeyringConfig(name, dir string, buf io.Reader) keyring.Config {
+func newFileBackendKeyringConfig(name, dir string, buf io.Reader, passphraseFile string) keyring.Config {
 	fileDir := filepath.Join(dir, keyringFileDirName)
 
 	return keyring.Config{
 		AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
 		ServiceName:      name,
 		FileDir:          fileDir,
-		FilePasswordFunc: newRealPrompt(fileDir, buf),
+		FilePasswordFunc: newRealPrompt(fileDir, buf, passphraseFile),
 	}
 }
 
-func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
+// newRealPrompt returns the passphrase function of the keyring backends storing
+// keys in files. The passphrase of the passphrase file, or else of the
+// EnvKeyringPassphrase environment variable, is used without prompting. It is
+// read only once, so that all the prompts of a command get the same passphrase.
+func newRealPrompt(dir string, buf io.Reader, passphraseFile string) func(string) (string, error) {
+	var (
+		passphrase     string
+		passphraseRead bool
+	)
+
 	return func(prompt string) (string, error) {
 		keyhashStored := false
-		keyhashFilePath := filepath.Join(dir, "keyhash")
+		keyhashFilePath := filepath.Join(dir, keyhashFilename)
 
 		var keyhash []byte
 
@@ -697,6 +882,31 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 			return "", fmt.Errorf("failed to open %s: %v", keyhashFilePath, err)
 		}
 
+		if !passphraseRead {
+			passphrase, err = readNonInteractivePassphrase(passphraseFile)
+			if err != nil {
+				return "", err
+			}
+			passphraseRead = true
+		}
+
+		if passphrase != "" {
+			if keyhashStored {
+				if err := bcrypt.CompareHashAndPassword(keyhash, []byte(passphrase)); err != nil {
+					return "", fmt.Errorf("incorrect keyring passphrase")
+				}
+
+				return passphrase, nil
+			}
+
+			if err := writeKeyhash(dir, passphrase); err != nil {
+				return "", err
+			}
+
+			return passphrase, nil
+		}
+
+		readPassphrase := newPassphrasePrompt(buf)
 		failureCounter := 0
 
 		for {
@@ -705,8 +915,7 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 				return "", fmt.Errorf("too many failed passphrase attempts")
 			}
 
-			buf := bufio.NewReader(buf)
-			pass, err := input.GetPassword("Enter keyring passphrase:", buf)
+			pass, err := readPassphrase("Enter keyring passphrase:")
 			if err != nil {
 				// NOTE: LGTM.io reports a false positive alert that states we are printing the password,
 				// but we only log the error.
@@ -725,7 +934,7 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 				return pass, nil
 			}
 
-			reEnteredPass, err := input.GetPassword("Re-enter keyring passphrase:", buf)
+			reEnteredPass, err := readPassphrase("Re-enter keyring passphrase:")
 			if err != nil {
 				// NOTE: LGTM.io reports a false positive alert that states we are printing the password,
 				// but we only log the error.
@@ -740,14 +949,7 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 				continue
 			}
 
-			saltBytes := tmcrypto.CRandBytes(16)
-			passwordHash, err := bcrypt.GenerateFromPassword(saltBytes, []byte(pass), 2)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, err)
-				continue
-			}
-
-			if err := os.WriteFile(dir+"/keyhash", passwordHash, 0555); err != nil {
+			if err := writeKeyhash(dir, pass); err != nil {
 				return "", err
 			}
 
@@ -756,15 +958,81 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 	}
 }
 
-func (ks keystore) writeLocalKey(name string, privKey types.PrivKey) (*Record, error) {
+// newPassphrasePrompt returns a function prompting for a passphrase on the
+// terminal, without echoing it, if the user input is an input.TTY, or else
+// reading it from the user input.
+func newPassphrasePrompt(userInput io.Reader) func(string) (string, error) {
+	if tty, ok := userInput.(*input.TTY); ok {
+		return func(prompt string) (string, error) {
+			return input.GetPasswordFromTTY(prompt, tty)
+		}
+	}
+
+	buf := bufio.NewReader(userInput)
+	return func(prompt string) (string, error) {
+		return input.GetPassword(prompt, buf)
+	}
+}
+
+// readNonInteractivePassphrase returns the passphrase of the passphrase file,
+// ignoring the trailing newline, if it is set, or else of the
+// EnvKeyringPassphrase environment variable. It returns an empty passphrase if
+// neither is set, for the passphrase to be prompted.
+func readNonInteractivePassphrase(passphraseFile string) (string, error) {
+	if passphraseFile == "" {
+		return os.Getenv(EnvKeyringPassphrase), nil
+	}
+
+	bz, err := os.ReadFile(passphraseFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the keyring passphrase file: %w", err)
+	}
+
+	passphrase := strings.TrimRight(string(bz), "\r\n")
+	if passphrase == "" {
+		return "", fmt.Errorf("keyring passphrase file %s is empty", passphraseFile)
+	}
+
+	return passphrase, nil
+}
+
+// writeKeyhash stores the hash of the passphrase of the keyring in dir, against
+// which the passphrase is checked when it is entered again.
+func writeKeyhash(dir, passphrase string) error {
+	saltBytes := tmcrypto.CRandBytes(16)
+	passwordHash, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), 2)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dir+"/keyhash", passwordHash, 0555)
+}
+
+func (ks keystore) writeLocalKey(name string, privKey types.PrivKey, path *hd.BIP44Params) (*Record, error) {
 	k, err := NewLocalRecord(name, privKey, privKey.PubKey())
 	if err != nil {
 		return nil, err
 	}
+	k.GetLocal().Path = path
 
 	return k, ks.writeRecord(k)
 }
 
+// writeImportedLocalKey persists an imported private key with its label.
+func (ks keystore) writeImportedLocalKey(name string, privKey types.PrivKey, label string) error {
+	if err := ValidateLabel(label); err != nil {
+		return err
+	}
+
+	k, err := NewLocalRecord(name, privKey, privKey.PubKey())
+	if err != nil {
+		return err
+	}
+	k.Label = label
+
+	return ks.writeRecord(k)
+}
+
 // writeRecord persists a keyring item in keystore if it does not exist there
 func (ks keystore) writeRecord(k *Record) error {
 	addr, err := k.GetAddress()
@@ -848,37 +1116,93 @@ func (ks keystore) writeMultisigKey(name string, pk types.PubKey) (*Record, erro
 }
 
 func (ks keystore) MigrateAll() (bool, error) {
-	keys, err := ks.db.Keys()
+	results, err := ks.MigrateRecords(false)
 	if err != nil {
 		return false, err
 	}
 
-	if len(keys) == 0 {
-		return false, nil
+	var migrated bool
+	for _, res := range results {
+		switch res.Status {
+		case MigrationFailed:
+			fmt.Printf("migrate err: %q", res.Err)
+		case MigrationMigrated:
+			migrated = true
+		}
 	}
 
-	var migrated bool
+	return migrated, nil
+}
+
+// MigrateRecords migrates all the keyring entries from amino to proto
+// serialization format, continuing past the entries that fail to migrate. The
+// amino data of the migrated entries is kept until DeleteLegacyRecords is
+// called. With dryRun, no entry is written and the results report whether the
+// entries would migrate.
+func (ks keystore) MigrateRecords(dryRun bool) ([]MigrationResult, error) {
+	keys, err := ks.db.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(keys)
+	var results []MigrationResult //nolint:prealloc
 	for _, key := range keys {
-		if strings.Contains(key, addressSuffix) {
+		if strings.Contains(key, addressSuffix) || isLegacyKey(key) || key == keyhashFilename {
 			continue
 		}
 
-		_, migrated2, err := ks.migrate(key)
-		if err != nil {
-			fmt.Printf("migrate err: %q", err)
+		res := MigrationResult{
+			Key:  key,
+			Name: strings.TrimSuffix(key, "."+infoSuffix),
+		}
+
+		k, migrated, err := ks.migrateItem(key, dryRun)
+		switch {
+		case err != nil:
+			res.Status, res.Err = MigrationFailed, err
+		case migrated:
+			res.Status, res.Name, res.Type = MigrationMigrated, k.Name, k.GetType()
+		default:
+			res.Status, res.Name, res.Type = MigrationSkipped, k.Name, k.GetType()
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// DeleteLegacyRecords removes the amino data kept by the migration of the
+// keyring entries.
+func (ks keystore) DeleteLegacyRecords() error {
+	keys, err := ks.db.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if !isLegacyKey(key) {
 			continue
 		}
 
-		if migrated2 {
-			migrated = true
+		if err := ks.db.Remove(key); err != nil {
+			return err
 		}
 	}
 
-	return migrated, nil
+	return nil
 }
 
 // migrate converts keyring.Item from amino to proto serialization format.
 func (ks keystore) migrate(key string) (*Record, bool, error) {
+	return ks.migrateItem(key, false)
+}
+
+// migrateItem converts keyring.Item from amino to proto serialization format.
+// The amino data is kept under the legacy key of the entry. With dryRun, the
+// item is converted but nothing is written.
+func (ks keystore) migrateItem(key string, dryRun bool) (*Record, bool, error) {
 	if !(strings.HasSuffix(key, infoSuffix)) && !(strings.HasPrefix(key, sdk.Bech32PrefixAccAddr)) {
 		key = infoKey(key)
 	}
@@ -913,12 +1237,25 @@ func (ks keystore) migrate(key string) (*Record, bool, error) {
 		return nil, false, fmt.Errorf("unable to serialize record, err: %w", err)
 	}
 
+	if dryRun {
+		return k, true, nil
+	}
+
+	// 5.keep the amino data, then overwrite the keyring entry with the record
+	legacyItem := keyring.Item{
+		Key:         legacyKey(key),
+		Data:        item.Data,
+		Description: item.Description,
+	}
+	if err := ks.SetItem(legacyItem); err != nil {
+		return nil, false, fmt.Errorf("unable to keep the legacy keyring.Item, err: %w", err)
+	}
+
 	item = keyring.Item{
 		Key:         key,
 		Data:        serializedRecord,
 		Description: "SDK kerying version",
 	}
-	// 5.overwrite the keyring entry with
 	if err := ks.SetItem(item); err != nil {
 		return nil, false, fmt.Errorf("unable to set keyring.Item, err: %w", err)
 	}
@@ -947,29 +1284,39 @@ func (ks keystore) convertFromLegacyInfo(info LegacyInfo) (*Record, error) {
 	name := info.GetName()
 	pk := info.GetPubKey()
 
+	var (
+		k   *Record
+		err error
+	)
 	switch info.GetType() {
 	case TypeLocal:
-		priv, err := privKeyFromLegacyInfo(info)
-		if err != nil {
+		var priv types.PrivKey
+		if priv, err = privKeyFromLegacyInfo(info); err != nil {
 			return nil, err
 		}
 
-		return NewLocalRecord(name, priv, pk)
+		k, err = NewLocalRecord(name, priv, pk)
 	case TypeOffline:
-		return NewOfflineRecord(name, pk)
+		k, err = NewOfflineRecord(name, pk)
 	case TypeMulti:
-		return NewMultiRecord(name, pk)
+		k, err = NewMultiRecord(name, pk)
 	case TypeLedger:
-		path, err := info.GetPath()
-		if err != nil {
+		var path *hd.BIP44Params
+		if path, err = info.GetPath(); err != nil {
 			return nil, err
 		}
 
-		return NewLedgerRecord(name, pk, path)
+		k, err = NewLedgerRecord(name, pk, path)
 	default:
 		return nil, errors.New("unknown LegacyInfo type")
-
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the legacy keys do not record when they were created
+	k.CreatedAt = nil
+	return k, nil
 }
 
 type unsafeKeystore struct {
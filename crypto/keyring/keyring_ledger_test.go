@@ -133,4 +133,32 @@ func TestAltKeyring_SaveLedgerKey(t *testing.T) {
 
 	path := ledgerInfo.GetPath()
 	require.Equal(t, "m/44'/118'/3'/0/1", path.String())
+	require.Equal(t, path, k.GetPath())
+}
+
+func TestAltKeyring_SaveLedgerKeyWithHDPath(t *testing.T) {
+	dir := t.TempDir()
+	cdc := getCodec()
+
+	kr, err := New(t.Name(), BackendTest, dir, nil, cdc)
+	require.NoError(t, err)
+
+	_, err = kr.SaveLedgerKeyWithHDPath("key", hd.Secp256k1, "cosmos", "m/44'/118'/3'")
+	require.Error(t, err)
+
+	k, err := kr.SaveLedgerKeyWithHDPath("some_account", hd.Secp256k1, "cosmos", "m/44'/118'/3'/0/1")
+	if err != nil {
+		require.Equal(t, "ledger nano S: support for ledger devices is not available in this executable", err.Error())
+		t.Skip("ledger nano S: support for ledger devices is not available in this executable")
+		return
+	}
+
+	// The mock is available, the key is the one of the same path saved by SaveLedgerKey
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, "PubKeySecp256k1{03602C0CB4D8C0081FEE794BDE96E7B95FA16F2B5283B764AC070584327B2C7202}", pubKey.String())
+
+	restoredRecord, err := kr.Key("some_account")
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/118'/3'/0/1", restoredRecord.GetPath().String())
 }
@@ -3,6 +3,8 @@ package keyring
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -18,8 +20,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -30,6 +34,7 @@ const (
 
 func init() {
 	crypto.BcryptSecurityParameter = 1
+	crypto.KeystoreScryptN = 1 << 4
 }
 
 func getCodec() codec.Codec {
@@ -57,6 +62,86 @@ func TestNewKeyring(t *testing.T) {
 	require.Equal(t, "foo", k.Name)
 }
 
+func TestNewKeyringPassphraseFile(t *testing.T) {
+	dir := t.TempDir()
+	cdc := getCodec()
+	writeFile := func(passphrase string) string {
+		f, err := os.CreateTemp(t.TempDir(), "passphrase")
+		require.NoError(t, err)
+		_, err = f.WriteString(passphrase)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		return f.Name()
+	}
+	withPassphraseFile := func(passphraseFile string) Option {
+		return func(options *Options) {
+			options.PassphraseFile = passphraseFile
+		}
+	}
+	// the input is empty, the keyring must not prompt for the passphrase
+	mockIn := strings.NewReader("")
+
+	kr, err := New("cosmos", BackendFile, dir, mockIn, cdc, withPassphraseFile(writeFile("password\n")))
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	// later prompts reuse the passphrase
+	_, _, err = kr.NewMnemonic("bar", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	kr, err = New("cosmos", BackendFile, dir, mockIn, cdc, withPassphraseFile(writeFile("password")))
+	require.NoError(t, err)
+	_, err = kr.Key("foo")
+	require.NoError(t, err)
+	_, err = kr.Key("bar")
+	require.NoError(t, err)
+
+	// the passphrase file takes precedence over the environment variable
+	t.Setenv(EnvKeyringPassphrase, "wrongpassword")
+	kr, err = New("cosmos", BackendFile, dir, mockIn, cdc, withPassphraseFile(writeFile("password")))
+	require.NoError(t, err)
+	_, err = kr.Key("foo")
+	require.NoError(t, err)
+
+	for _, passphraseFile := range []string{writeFile("wrongpassword"), writeFile("\n"), filepath.Join(dir, "missing")} {
+		kr, err = New("cosmos", BackendFile, dir, mockIn, cdc, withPassphraseFile(passphraseFile))
+		require.NoError(t, err)
+		_, err = kr.Key("foo")
+		require.Error(t, err)
+	}
+}
+
+func TestNewKeyringPassphraseEnv(t *testing.T) {
+	dir := t.TempDir()
+	cdc := getCodec()
+	mockIn := strings.NewReader("")
+
+	t.Setenv(EnvKeyringPassphrase, "password")
+	kr, err := New("cosmos", BackendFile, dir, mockIn, cdc)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	kr, err = New("cosmos", BackendFile, dir, mockIn, cdc)
+	require.NoError(t, err)
+	_, err = kr.Key("foo")
+	require.NoError(t, err)
+
+	t.Setenv(EnvKeyringPassphrase, "wrongpassword")
+	kr, err = New("cosmos", BackendFile, dir, mockIn, cdc)
+	require.NoError(t, err)
+	_, err = kr.Key("foo")
+	require.Error(t, err)
+
+	// without the environment variable the passphrase is prompted
+	t.Setenv(EnvKeyringPassphrase, "")
+	kr, err = New("cosmos", BackendFile, dir, mockIn, cdc)
+	require.NoError(t, err)
+	mockIn.Reset("password\n")
+	_, err = kr.Key("foo")
+	require.NoError(t, err)
+}
+
 func TestKeyManagementKeyRing(t *testing.T) {
 	cdc := getCodec()
 	kb, err := New("keybasename", "test", t.TempDir(), nil, cdc)
@@ -1162,6 +1247,297 @@ func TestAltKeyring_ImportExportPrivKey(t *testing.T) {
 	require.EqualError(t, err, fmt.Sprintf("cannot overwrite key: %s", newUID))
 }
 
+func TestAltKeyring_ImportExportPrivKeyKeystore(t *testing.T) {
+	cdc := getCodec()
+	passphrase := "somePass"
+
+	for _, backend := range []string{BackendTest, BackendMemory} {
+		kr, err := New(t.Name(), backend, t.TempDir(), nil, cdc)
+		require.NoError(t, err)
+
+		uid := theID
+		k, _, err := kr.NewMnemonic(uid, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+
+		keystore, err := kr.ExportPrivKeyKeystore(uid, passphrase)
+		require.NoError(t, err)
+		err = kr.Delete(uid)
+		require.NoError(t, err)
+
+		newUID := otherID
+		// Should fail importing with wrong password
+		err = kr.ImportPrivKeyKeystore(newUID, keystore, "wrongPass")
+		require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+		err = kr.ImportPrivKeyKeystore(newUID, keystore, passphrase)
+		require.NoError(t, err)
+		imported, err := kr.Key(newUID)
+		require.NoError(t, err)
+		require.Equal(t, k.PubKey, imported.PubKey)
+
+		// Should fail importing private key on existing key.
+		err = kr.ImportPrivKeyKeystore(newUID, keystore, passphrase)
+		require.EqualError(t, err, fmt.Sprintf("cannot overwrite key: %s", newUID))
+
+		// ed25519 keys are imported and exported as well
+		edPriv := ed25519.GenPrivKey()
+		edKeystore, err := crypto.EncryptKeystorePrivKey(edPriv, passphrase, "")
+		require.NoError(t, err)
+		err = kr.ImportPrivKeyKeystore("ed25519", edKeystore, passphrase)
+		require.NoError(t, err)
+		edKeystore, err = kr.ExportPrivKeyKeystore("ed25519", passphrase)
+		require.NoError(t, err)
+		decrypted, _, err := crypto.DecryptKeystorePrivKey(edKeystore, passphrase)
+		require.NoError(t, err)
+		require.True(t, edPriv.Equals(decrypted))
+	}
+}
+
+func TestAltKeyring_Labels(t *testing.T) {
+	cdc := getCodec()
+	passphrase := "somePass"
+
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	k, _, err := kr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Empty(t, k.Label)
+	require.NotNil(t, k.CreatedAt)
+
+	_, err = kr.SetLabel("unknown", "label")
+	require.Error(t, err)
+	_, err = kr.SetLabel("local", "two\nlines")
+	require.EqualError(t, err, "label must be a single line")
+	k, err = kr.SetLabel("local", "validator: main")
+	require.NoError(t, err)
+	require.Equal(t, "validator: main", k.Label)
+
+	// the armored and keystore exports keep the label
+	armor, err := kr.ExportPrivKeyArmor("local", passphrase)
+	require.NoError(t, err)
+	keystore, err := kr.ExportPrivKeyKeystore("local", passphrase)
+	require.NoError(t, err)
+	pubArmor, err := kr.ExportPubKeyArmor("local")
+	require.NoError(t, err)
+	require.NoError(t, kr.Delete("local"))
+
+	require.NoError(t, kr.ImportPrivKey("armor", armor, passphrase))
+	imported, err := kr.Key("armor")
+	require.NoError(t, err)
+	require.Equal(t, "validator: main", imported.Label)
+	require.NoError(t, kr.Delete("armor"))
+
+	require.NoError(t, kr.ImportPrivKeyKeystore("keystore", keystore, passphrase))
+	imported, err = kr.Key("keystore")
+	require.NoError(t, err)
+	require.Equal(t, "validator: main", imported.Label)
+	require.NoError(t, kr.Delete("keystore"))
+
+	require.NoError(t, kr.ImportPubKey("pubkey", pubArmor))
+	imported, err = kr.Key("pubkey")
+	require.NoError(t, err)
+	require.Equal(t, "validator: main", imported.Label)
+
+	// the exports of unlabeled keys are unchanged
+	_, err = kr.SetLabel("pubkey", "")
+	require.NoError(t, err)
+	pubArmor, err = kr.ExportPubKeyArmor("pubkey")
+	require.NoError(t, err)
+	require.NotContains(t, pubArmor, "label")
+}
+
+func TestAltKeyring_RecordWithoutLabel(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	// the records of older keyrings have neither a label nor a creation time
+	priv := secp256k1.GenPrivKey()
+	k, err := NewLocalRecord("old", priv, priv.PubKey())
+	require.NoError(t, err)
+	k.CreatedAt = nil
+	bz, err := cdc.Marshal(k)
+	require.NoError(t, err)
+	ks := kr.(keystore)
+	require.NoError(t, ks.SetItem(keyring.Item{Key: infoKey("old"), Data: bz}))
+	require.NoError(t, ks.SetItem(keyring.Item{Key: addrHexKeyAsString(sdk.AccAddress(priv.PubKey().Address())), Data: []byte(infoKey("old"))}))
+
+	k, err = kr.Key("old")
+	require.NoError(t, err)
+	require.Empty(t, k.Label)
+	require.Nil(t, k.CreatedAt)
+
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	out, err := MkAccKeyOutput(records[0])
+	require.NoError(t, err)
+	require.Equal(t, "old", out.Name)
+	require.Empty(t, out.Label)
+	require.Empty(t, out.CreatedAt)
+
+	// a label can be set on them
+	k, err = kr.SetLabel("old", "from an older keyring")
+	require.NoError(t, err)
+	require.Nil(t, k.CreatedAt)
+	k, err = kr.Key("old")
+	require.NoError(t, err)
+	require.Equal(t, "from an older keyring", k.Label)
+}
+
+func TestAltKeyring_BackupRestore(t *testing.T) {
+	cdc := getCodec()
+	passphrase := "somePass"
+	t.Setenv(EnvKeyringPassphrase, "keyringPass")
+
+	newKeyring := func(backend string) Keyring {
+		kr, err := New(t.Name(), backend, t.TempDir(), strings.NewReader(""), cdc)
+		require.NoError(t, err)
+		return kr
+	}
+
+	for _, backends := range [][2]string{{BackendFile, BackendTest}, {BackendTest, BackendFile}} {
+		src, dst := newKeyring(backends[0]), newKeyring(backends[1])
+
+		local, _, err := src.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		_, _, err = src.NewMnemonic("r1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256r1)
+		require.NoError(t, err)
+		offlinePub := secp256k1.GenPrivKey().PubKey()
+		_, err = src.SaveOfflineKey("offline", offlinePub)
+		require.NoError(t, err)
+		_, err = src.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(1, []types.PubKey{offlinePub}))
+		require.NoError(t, err)
+		_, err = src.(keystore).writeLedgerKey("ledger", secp256k1.GenPrivKey().PubKey(), hd.NewFundraiserParams(0, sdk.CoinType, 0))
+		require.NoError(t, err)
+
+		backup, records, err := src.Backup(passphrase)
+		require.NoError(t, err)
+		var names []string
+		for _, k := range records {
+			names = append(names, k.Name)
+		}
+		require.Equal(t, []string{"local", "multi", "offline", "r1"}, names)
+
+		_, err = dst.RestoreBackup(backup, "wrongPass", false)
+		require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+		results, err := dst.RestoreBackup(backup, passphrase, false)
+		require.NoError(t, err)
+		require.Equal(t, []RestoreResult{
+			{Name: "local", Type: TypeLocal, Status: RestoreRestored},
+			{Name: "multi", Type: TypeMulti, Status: RestoreRestored},
+			{Name: "offline", Type: TypeOffline, Status: RestoreRestored},
+			{Name: "r1", Type: TypeLocal, Status: RestoreRestored},
+		}, results)
+		_, err = dst.Key("ledger")
+		require.Error(t, err)
+
+		for _, name := range []string{"local", "r1"} {
+			msg := []byte("some message")
+			sig, pub, err := dst.Sign(name, msg)
+			require.NoError(t, err)
+			srcKey, err := src.Key(name)
+			require.NoError(t, err)
+			srcPub, err := srcKey.GetPubKey()
+			require.NoError(t, err)
+			require.True(t, srcPub.Equals(pub))
+			require.True(t, pub.VerifySignature(msg, sig))
+		}
+
+		// the existing keys are skipped, unless overwritten
+		require.NoError(t, dst.Delete("local"))
+		_, _, err = dst.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		results, err = dst.RestoreBackup(backup, passphrase, false)
+		require.NoError(t, err)
+		for _, res := range results {
+			require.Equal(t, RestoreSkipped, res.Status, res.Name)
+		}
+
+		results, err = dst.RestoreBackup(backup, passphrase, true)
+		require.NoError(t, err)
+		for _, res := range results {
+			require.Equal(t, RestoreOverwritten, res.Status, res.Name)
+		}
+		restored, err := dst.Key("local")
+		require.NoError(t, err)
+		require.Equal(t, local.PubKey.Value, restored.PubKey.Value)
+
+		// a key of another name with the same address is not replaced
+		require.NoError(t, dst.Delete("offline"))
+		_, err = dst.SaveOfflineKey("other", offlinePub)
+		require.NoError(t, err)
+		results, err = dst.RestoreBackup(backup, passphrase, true)
+		require.NoError(t, err)
+		require.Equal(t, "offline", results[2].Name)
+		require.Equal(t, RestoreFailed, results[2].Status)
+		require.EqualError(t, results[2].Err, "the address of the key is the address of the existing key other")
+		_, err = dst.Key("other")
+		require.NoError(t, err)
+	}
+}
+
+// failingKeyring is a keyring failing the next write of an item.
+type failingKeyring struct {
+	keyring.Keyring
+	failKey string
+}
+
+func (kr *failingKeyring) Set(item keyring.Item) error {
+	if item.Key == kr.failKey {
+		kr.failKey = ""
+		return fmt.Errorf("failed to write %s", item.Key)
+	}
+	return kr.Keyring.Set(item)
+}
+
+func TestAltKeyring_RestoreFailedOverwrite(t *testing.T) {
+	cdc := getCodec()
+	passphrase := "somePass"
+
+	src, err := New(t.Name(), BackendMemory, "", nil, cdc)
+	require.NoError(t, err)
+	restored, _, err := src.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	restoredAddr, err := restored.GetAddress()
+	require.NoError(t, err)
+	backup, _, err := src.Backup(passphrase)
+	require.NoError(t, err)
+
+	db := &failingKeyring{Keyring: keyring.NewArrayKeyring(nil)}
+	dst := NewKeystore(db, cdc)
+	existing, _, err := dst.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	existingAddr, err := existing.GetAddress()
+	require.NoError(t, err)
+
+	// the write of the record, or of its address, fails
+	for _, failKey := range []string{infoKey("local"), addrHexKeyAsString(restoredAddr)} {
+		db.failKey = failKey
+		results, err := dst.RestoreBackup(backup, passphrase, true)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, RestoreFailed, results[0].Status)
+		require.Error(t, results[0].Err)
+
+		// the existing key is kept
+		k, err := dst.Key("local")
+		require.NoError(t, err)
+		require.Equal(t, existing.PubKey.Value, k.PubKey.Value)
+		k, err = dst.KeyByAddress(existingAddr)
+		require.NoError(t, err)
+		require.Equal(t, "local", k.Name)
+		_, err = dst.KeyByAddress(restoredAddr)
+		require.Error(t, err)
+	}
+
+	results, err := dst.RestoreBackup(backup, passphrase, true)
+	require.NoError(t, err)
+	require.Equal(t, RestoreOverwritten, results[0].Status)
+}
+
 func TestAltKeyring_ImportExportPrivKey_ByAddress(t *testing.T) {
 	cdc := getCodec()
 	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
@@ -1290,6 +1666,70 @@ func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAltKeyring_Secp256r1(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	k, err := kr.NewAccount(theID, mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256r1)
+	require.NoError(t, err)
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &secp256r1.PubKey{}, pub)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(pub.Address()), addr)
+	require.Len(t, addr, 32)
+
+	// the derivation is deterministic
+	kr2 := NewInMemory(cdc)
+	k2, err := kr2.NewAccount(theID, mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256r1)
+	require.NoError(t, err)
+	pub2, err := k2.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pub.Equals(pub2))
+
+	msg := []byte("some message")
+	sig, signPub, err := kr.Sign(theID, msg)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(signPub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	passphrase := "somePass"
+	armor, err := kr.ExportPrivKeyArmor(theID, passphrase)
+	require.NoError(t, err)
+	pubArmor, err := kr.ExportPubKeyArmor(theID)
+	require.NoError(t, err)
+	keystore, err := kr.ExportPrivKeyKeystore(theID, passphrase)
+	require.NoError(t, err)
+	require.NoError(t, kr.Delete(theID))
+
+	for _, importKey := range []func() error{
+		func() error { return kr.ImportPrivKey(otherID, armor, passphrase) },
+		func() error { return kr.ImportPubKey(otherID, pubArmor) },
+		func() error { return kr.ImportPrivKeyKeystore(otherID, keystore, passphrase) },
+	} {
+		require.NoError(t, importKey())
+		imported, err := kr.Key(otherID)
+		require.NoError(t, err)
+		importedPub, err := imported.GetPubKey()
+		require.NoError(t, err)
+		require.True(t, pub.Equals(importedPub))
+		require.NoError(t, kr.Delete(otherID))
+	}
+
+	require.NoError(t, kr.ImportPrivKey(otherID, armor, passphrase))
+	sig, _, err = kr.Sign(otherID, msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// Ledger devices do not support secp256r1 keys
+	_, err = kr.SaveLedgerKey("ledger", hd.Secp256r1, "cosmos", 118, 0, 0)
+	require.ErrorIs(t, err, ErrUnsupportedSigningAlgo)
+	require.EqualError(t, err, "unsupported signing algo: Ledger devices do not support secp256r1 keys")
+}
+
 func TestBackendConfigConstructors(t *testing.T) {
 	backend := newKWalletBackendKeyringConfig("test", "", nil)
 	require.Equal(t, []keyring.BackendType{keyring.KWalletBackend}, backend.AllowedBackends)
@@ -1302,6 +1742,48 @@ func TestBackendConfigConstructors(t *testing.T) {
 	require.Equal(t, "keyring-test", backend.PassPrefix)
 }
 
+func TestNewAccountHDPath(t *testing.T) {
+	kr := newKeyring(t, "testKeyring")
+	// BIP39 reference mnemonic and the private key of its first Ethereum
+	// account, 0x9858EfFD232B4033E47d90003D41EC34EcaEda94
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	expPrivKey, err := hex.DecodeString("1ab42cc412b618bdea3a599e3c9bae199ebf030895b039e9db1e30dafb12b727")
+	require.NoError(t, err)
+
+	k, err := kr.NewAccount("eth", mnemonic, DefaultBIP39Passphrase, "m/44'/60'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/60'/0'/0/0", k.GetPath().String())
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, (&secp256k1.PrivKey{Key: expPrivKey}).PubKey(), pubKey)
+
+	k, err = kr.Key("eth")
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/60'/0'/0/0", k.GetPath().String())
+	out, err := MkAccKeyOutput(k)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/60'/0'/0/0", out.HDPath)
+
+	k, err = kr.NewAccount("atom", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.FullFundraiserPath, k.GetPath().String())
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4", addr.String())
+
+	// imported keys have no derivation path
+	armor, err := kr.ExportPrivKeyArmor("atom", "passphrase")
+	require.NoError(t, err)
+	require.NoError(t, kr.Delete("atom"))
+	require.NoError(t, kr.ImportPrivKey("imported", armor, "passphrase"))
+	k, err = kr.Key("imported")
+	require.NoError(t, err)
+	require.Nil(t, k.GetPath())
+	out, err = MkAccKeyOutput(k)
+	require.NoError(t, err)
+	require.Empty(t, out.HDPath)
+}
+
 func TestRenameKey(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -1376,6 +1858,7 @@ func requireEqualRenamedKey(t *testing.T, key *Record, mnemonic *Record, nameMat
 	require.NoError(t, err)
 	require.Equal(t, key1, key2)
 	require.Equal(t, key.GetType(), mnemonic.GetType())
+	require.Equal(t, key.GetPath(), mnemonic.GetPath())
 }
 
 func newKeyring(t *testing.T, name string) Keyring {
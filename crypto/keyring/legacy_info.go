@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -45,6 +46,11 @@ type legacyLocalInfo struct {
 
 func infoKey(name string) string { return fmt.Sprintf("%s.%s", name, infoSuffix) }
 
+// legacyKey is the key under which the amino data of a migrated entry is kept.
+func legacyKey(key string) string { return fmt.Sprintf("%s.%s", key, legacySuffix) }
+
+func isLegacyKey(key string) bool { return strings.HasSuffix(key, "."+legacySuffix) }
+
 // GetType implements Info interface
 func (i legacyLocalInfo) GetType() KeyType {
 	return TypeLocal
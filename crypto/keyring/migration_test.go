@@ -57,9 +57,12 @@ func (s *MigrationTestSuite) TestMigrateLegacyLocalKey() {
 
 	s.Require().NoError(s.ks.SetItem(item))
 
-	_, migrated, err := s.ks.migrate(n1)
+	k, migrated, err := s.ks.migrate(n1)
 	s.Require().True(migrated)
 	s.Require().NoError(err)
+	// the creation time of the legacy key is not known
+	s.Require().Nil(k.CreatedAt)
+	s.Require().Empty(k.Label)
 }
 
 func (s *MigrationTestSuite) TestMigrateLegacyLedgerKey() {
@@ -239,6 +242,81 @@ func (s *MigrationTestSuite) TestMigrateErrEmptyItemData() {
 	s.Require().False(migrated)
 	s.Require().EqualError(err, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, n1).Error())
 }
+func (s *MigrationTestSuite) TestMigrateRecords() {
+	kb := NewInMemory(getCodec())
+	ks, ok := kb.(keystore)
+	s.Require().True(ok)
+
+	offlinePriv := secp256k1.GenPrivKey()
+	multi := multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{s.pub})
+	legacyMultiInfo, err := NewLegacyMultiInfo("multi", multi)
+	s.Require().NoError(err)
+	record, err := NewLocalRecord("record", s.priv, s.pub)
+	s.Require().NoError(err)
+	serializedRecord, err := ks.cdc.Marshal(record)
+	s.Require().NoError(err)
+
+	legacyItems := map[string][]byte{
+		"corrupted.info": []byte("abckd0s03l"),
+		"multi.info":     MarshalInfo(legacyMultiInfo),
+		"offline.info":   MarshalInfo(newLegacyOfflineInfo("offline", offlinePriv.PubKey(), hd.Secp256k1.Name())),
+	}
+	for key, data := range legacyItems {
+		s.Require().NoError(ks.SetItem(keyring.Item{Key: key, Data: data}))
+	}
+	s.Require().NoError(ks.SetItem(keyring.Item{Key: "record.info", Data: serializedRecord}))
+
+	requireResults := func(results []MigrationResult, migratedStatus MigrationStatus) {
+		s.Require().Len(results, 4)
+		s.Require().Equal("corrupted", results[0].Name)
+		s.Require().Equal(MigrationFailed, results[0].Status)
+		s.Require().Error(results[0].Err)
+		s.Require().Equal(MigrationResult{Key: "multi.info", Name: "multi", Type: TypeMulti, Status: migratedStatus}, results[1])
+		s.Require().Equal(MigrationResult{Key: "offline.info", Name: "offline", Type: TypeOffline, Status: migratedStatus}, results[2])
+		s.Require().Equal(MigrationResult{Key: "record.info", Name: "record", Type: TypeLocal, Status: MigrationSkipped}, results[3])
+	}
+
+	// the dry run does not write anything
+	results, err := ks.MigrateRecords(true)
+	s.Require().NoError(err)
+	requireResults(results, MigrationMigrated)
+	results, err = ks.MigrateRecords(true)
+	s.Require().NoError(err)
+	requireResults(results, MigrationMigrated)
+
+	// the migration continues past the corrupted entry and keeps the amino data
+	results, err = ks.MigrateRecords(false)
+	s.Require().NoError(err)
+	requireResults(results, MigrationMigrated)
+	for key, data := range legacyItems {
+		item, err := ks.db.Get(legacyKey(key))
+		if key == "corrupted.info" {
+			s.Require().ErrorIs(err, keyring.ErrKeyNotFound)
+			continue
+		}
+		s.Require().NoError(err)
+		s.Require().Equal(data, item.Data)
+	}
+
+	k, err := kb.Key("offline")
+	s.Require().NoError(err)
+	s.Require().Equal(TypeOffline, k.GetType())
+
+	// the kept amino data is not migrated again
+	results, err = ks.MigrateRecords(false)
+	s.Require().NoError(err)
+	requireResults(results, MigrationSkipped)
+
+	s.Require().NoError(ks.DeleteLegacyRecords())
+	keys, err := ks.db.Keys()
+	s.Require().NoError(err)
+	for _, key := range keys {
+		s.Require().False(isLegacyKey(key), key)
+	}
+	_, err = kb.Key("multi")
+	s.Require().NoError(err)
+}
+
 func TestMigrationTestSuite(t *testing.T) {
 	suite.Run(t, new(MigrationTestSuite))
 }
@@ -1,6 +1,10 @@
 package keyring
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -11,13 +15,21 @@ import (
 // Use protobuf interface marshaler rather then generic JSON
 
 // KeyOutput defines a structure wrapping around an Info object used for output
-// functionality.
+// functionality. The public key is output as the proto JSON of its Any, and its
+// bytes as hex and base64: compressed for secp256k1 keys, and amino encoded for
+// legacy multisig keys.
 type KeyOutput struct {
-	Name     string `json:"name" yaml:"name"`
-	Type     string `json:"type" yaml:"type"`
-	Address  string `json:"address" yaml:"address"`
-	PubKey   string `json:"pubkey" yaml:"pubkey"`
-	Mnemonic string `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	Name         string `json:"name" yaml:"name"`
+	Type         string `json:"type" yaml:"type"`
+	Address      string `json:"address" yaml:"address"`
+	PubKey       string `json:"pubkey" yaml:"pubkey"`
+	PubKeyHex    string `json:"pubkey_hex" yaml:"pubkey_hex"`
+	PubKeyBase64 string `json:"pubkey_base64" yaml:"pubkey_base64"`
+	Mnemonic     string `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	HDPath       string `json:"hd_path,omitempty" yaml:"hd_path,omitempty"`
+	Backend      string `json:"backend,omitempty" yaml:"backend,omitempty"`
+	Label        string `json:"label,omitempty" yaml:"label,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
 }
 
 // NewKeyOutput creates a default KeyOutput instance without Mnemonic, Threshold and PubKeys
@@ -31,13 +43,35 @@ func NewKeyOutput(name string, keyType KeyType, a sdk.Address, pk cryptotypes.Pu
 		return KeyOutput{}, err
 	}
 	return KeyOutput{
-		Name:    name,
-		Type:    keyType.String(),
-		Address: a.String(),
-		PubKey:  string(bz),
+		Name:         name,
+		Type:         keyType.String(),
+		Address:      a.String(),
+		PubKey:       string(bz),
+		PubKeyHex:    hex.EncodeToString(pk.Bytes()),
+		PubKeyBase64: base64.StdEncoding.EncodeToString(pk.Bytes()),
 	}, nil
 }
 
+// newRecordKeyOutput creates a KeyOutput of the record with the given address,
+// including its derivation path and creation time if they are known, and its
+// label.
+func newRecordKeyOutput(k *Record, a sdk.Address, pk cryptotypes.PubKey) (KeyOutput, error) {
+	ko, err := NewKeyOutput(k.Name, k.GetType(), a, pk)
+	if err != nil {
+		return KeyOutput{}, err
+	}
+
+	if path := k.GetPath(); path != nil {
+		ko.HDPath = path.String()
+	}
+	ko.Label = k.Label
+	if k.CreatedAt != nil {
+		ko.CreatedAt = k.CreatedAt.Format(time.RFC3339)
+	}
+
+	return ko, nil
+}
+
 // MkConsKeyOutput create a KeyOutput in with "cons" Bech32 prefixes.
 func MkConsKeyOutput(k *Record) (KeyOutput, error) {
 	pk, err := k.GetPubKey()
@@ -45,7 +79,7 @@ func MkConsKeyOutput(k *Record) (KeyOutput, error) {
 		return KeyOutput{}, err
 	}
 	addr := sdk.ConsAddress(pk.Address())
-	return NewKeyOutput(k.Name, k.GetType(), addr, pk)
+	return newRecordKeyOutput(k, addr, pk)
 }
 
 // MkValKeyOutput create a KeyOutput in with "val" Bech32 prefixes.
@@ -57,7 +91,7 @@ func MkValKeyOutput(k *Record) (KeyOutput, error) {
 
 	addr := sdk.ValAddress(pk.Address())
 
-	return NewKeyOutput(k.Name, k.GetType(), addr, pk)
+	return newRecordKeyOutput(k, addr, pk)
 }
 
 // MkAccKeyOutput create a KeyOutput in with "acc" Bech32 prefixes. If the
@@ -69,7 +103,7 @@ func MkAccKeyOutput(k *Record) (KeyOutput, error) {
 		return KeyOutput{}, err
 	}
 	addr := sdk.AccAddress(pk.Address())
-	return NewKeyOutput(k.Name, k.GetType(), addr, pk)
+	return newRecordKeyOutput(k, addr, pk)
 }
 
 // MkAccKeysOutput returns a slice of KeyOutput objects, each with the "acc"
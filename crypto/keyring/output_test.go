@@ -3,6 +3,7 @@ package keyring
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -20,14 +21,32 @@ func TestBech32KeysOutput(t *testing.T) {
 	k, err := NewMultiRecord("multisig", multisigPk)
 	require.NotNil(t, k)
 	require.NoError(t, err)
+	require.NotNil(t, k.CreatedAt)
+	createdAt := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	k.CreatedAt = &createdAt
+	k.Label = "team multisig"
 	pubKey, err := k.GetPubKey()
 	require.NoError(t, err)
 	accAddr := sdk.AccAddress(pubKey.Address())
 	expectedOutput, err := NewKeyOutput(k.Name, k.GetType(), accAddr, multisigPk)
 	require.NoError(t, err)
+	expectedOutput.Label = "team multisig"
+	expectedOutput.CreatedAt = "2022-01-02T03:04:05Z"
 
 	out, err := MkAccKeyOutput(k)
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, out)
-	require.Equal(t, "{Name:multisig Type:multi Address:cosmos1nf8lf6n4wa43rzmdzwe6hkrnw5guekhqt595cw PubKey:{\"@type\":\"/cosmos.crypto.multisig.LegacyAminoPubKey\",\"threshold\":1,\"public_keys\":[{\"@type\":\"/cosmos.crypto.secp256k1.PubKey\",\"key\":\"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ\"}]} Mnemonic:}", fmt.Sprintf("%+v", out))
+	require.Equal(t, "{Name:multisig Type:multi Address:cosmos1nf8lf6n4wa43rzmdzwe6hkrnw5guekhqt595cw PubKey:{\"@type\":\"/cosmos.crypto.multisig.LegacyAminoPubKey\",\"threshold\":1,\"public_keys\":[{\"@type\":\"/cosmos.crypto.secp256k1.PubKey\",\"key\":\"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ\"}]} PubKeyHex:22c1f7e208011226eb5ae9872102eaeba00ee3bdf3ddd4069d9a63af58cdab252c22a577d17fcc83ea92db0360c9 PubKeyBase64:IsH34ggBEibrWumHIQLq66AO473z3dQGnZpjr1jNqyUsIqV30X/Mg+qS2wNgyQ== Mnemonic: HDPath: Backend: Label:team multisig CreatedAt:2022-01-02T03:04:05Z}", fmt.Sprintf("%+v", out))
+}
+
+func TestBech32KeysOutputLegacyRecord(t *testing.T) {
+	// the records of older keyrings have neither a label nor a creation time
+	k, err := NewOfflineRecord("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	k.CreatedAt = nil
+
+	out, err := MkAccKeyOutput(k)
+	require.NoError(t, err)
+	require.Empty(t, out.Label)
+	require.Empty(t, out.CreatedAt)
 }
@@ -2,6 +2,9 @@ package keyring
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -9,16 +12,34 @@ import (
 	"github.com/cosmos/cosmos-sdk/types"
 )
 
+// maxLabelLength is the maximum length of the label of a record.
+const maxLabelLength = 256
+
 // ErrPrivKeyExtr is used to output an error if extraction of a private key from Local item fails
 var ErrPrivKeyExtr = errors.New("private key extraction works only for Local")
 
+// newRecord creates a new Record created at the current time.
 func newRecord(name string, pk cryptotypes.PubKey, item isRecord_Item) (*Record, error) {
 	any, err := codectypes.NewAnyWithValue(pk)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Record{name, any, item}, nil
+	createdAt := time.Now().UTC()
+	return &Record{Name: name, PubKey: any, Item: item, CreatedAt: &createdAt}, nil
+}
+
+// ValidateLabel checks that the label of a key fits on one line of the key
+// listings and of the headers of the armored keys.
+func ValidateLabel(label string) error {
+	if len(label) > maxLabelLength {
+		return fmt.Errorf("label is longer than %d characters", maxLabelLength)
+	}
+	if strings.ContainsAny(label, "\r\n") {
+		return errors.New("label must be a single line")
+	}
+
+	return nil
 }
 
 // NewLocalRecord creates a new Record with local key item
@@ -28,7 +49,7 @@ func NewLocalRecord(name string, priv cryptotypes.PrivKey, pk cryptotypes.PubKey
 		return nil, err
 	}
 
-	recordLocal := &Record_Local{any, priv.Type()}
+	recordLocal := &Record_Local{PrivKey: any, PrivKeyType: priv.Type()}
 	recordLocalItem := &Record_Local_{recordLocal}
 
 	return newRecord(name, pk, recordLocalItem)
@@ -45,6 +66,10 @@ func (rl *Record_Ledger) GetPath() *hd.BIP44Params {
 	return rl.Path
 }
 
+func (rl *Record_Local) GetPath() *hd.BIP44Params {
+	return rl.Path
+}
+
 // NewOfflineRecord creates a new Record with offline item
 func NewOfflineRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	recordOffline := &Record_Offline{}
@@ -78,6 +103,19 @@ func (k Record) GetAddress() (types.AccAddress, error) {
 	return pk.Address().Bytes(), nil
 }
 
+// GetPath fetches the BIP44 derivation path of a local or ledger record. It
+// returns nil if the path is not known.
+func (k Record) GetPath() *hd.BIP44Params {
+	switch {
+	case k.GetLocal() != nil:
+		return k.GetLocal().GetPath()
+	case k.GetLedger() != nil:
+		return k.GetLedger().GetPath()
+	default:
+		return nil
+	}
+}
+
 // GetType fetches type of the record
 func (k Record) GetType() KeyType {
 	switch {
@@ -9,15 +9,19 @@ import (
 	hd "github.com/cosmos/cosmos-sdk/crypto/hd"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +43,11 @@ type Record struct {
 	//	*Record_Multi_
 	//	*Record_Offline_
 	Item isRecord_Item `protobuf_oneof:"item"`
+	// label is an optional free-text description of the key.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	// created_at is the time the key was added to the keyring. It is not set for
+	// the keys of older keyrings.
+	CreatedAt *time.Time `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
 }
 
 func (m *Record) Reset()         { *m = Record{} }
@@ -148,6 +157,9 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 type Record_Local struct {
 	PrivKey     *types.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
 	PrivKeyType string     `protobuf:"bytes,2,opt,name=priv_key_type,json=privKeyType,proto3" json:"priv_key_type,omitempty"`
+	// path is the BIP44 derivation path of a key derived from a mnemonic. It
+	// is not set for imported keys.
+	Path *hd.BIP44Params `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
 func (m *Record_Local) Reset()         { *m = Record_Local{} }
@@ -308,34 +320,38 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x8b, 0xd4, 0x30,
-	0x18, 0xc7, 0x5b, 0xed, 0xb4, 0x4e, 0x16, 0x2f, 0x61, 0x0f, 0xb5, 0x48, 0x19, 0x16, 0xd4, 0x01,
-	0xd9, 0x84, 0xd5, 0x39, 0x2f, 0xec, 0xe0, 0x61, 0x16, 0x15, 0x97, 0xe0, 0x49, 0x84, 0xa5, 0x2f,
-	0x99, 0xb6, 0x4c, 0xdb, 0x84, 0x4c, 0x3b, 0x90, 0x2f, 0x21, 0x7e, 0xac, 0x3d, 0xee, 0xd1, 0xa3,
-	0xce, 0x7c, 0x11, 0xc9, 0x93, 0xf6, 0xe0, 0x82, 0x8e, 0xa7, 0xa6, 0xe4, 0xf7, 0xfc, 0x5f, 0x1e,
-	0x82, 0x5e, 0x64, 0x62, 0xdb, 0x88, 0x2d, 0xcd, 0x94, 0x96, 0x9d, 0xa0, 0x1b, 0xae, 0x55, 0xd5,
-	0x16, 0x74, 0x77, 0x41, 0x15, 0xcf, 0x84, 0xca, 0x89, 0x54, 0xa2, 0x13, 0x38, 0xb4, 0x18, 0xb1,
-	0x18, 0x19, 0x30, 0xb2, 0xbb, 0x88, 0x4e, 0x0b, 0x51, 0x08, 0x80, 0xa8, 0x39, 0x59, 0x3e, 0x7a,
-	0x56, 0x08, 0x51, 0xd4, 0x9c, 0xc2, 0x5f, 0xda, 0xaf, 0x69, 0xd2, 0xea, 0xe1, 0xea, 0xf9, 0x9f,
-	0x8e, 0x65, 0x6e, 0xcc, 0xca, 0xc1, 0xe8, 0xec, 0x9b, 0x87, 0x7c, 0x06, 0xce, 0x18, 0x23, 0xaf,
-	0x4d, 0x1a, 0x1e, 0xba, 0x33, 0x77, 0x3e, 0x65, 0x70, 0xc6, 0xe7, 0x28, 0x90, 0x7d, 0x7a, 0xbb,
-	0xe1, 0x3a, 0x7c, 0x34, 0x73, 0xe7, 0x27, 0x6f, 0x4e, 0x89, 0x75, 0x22, 0xa3, 0x13, 0xb9, 0x6a,
-	0x35, 0xf3, 0x65, 0x9f, 0xbe, 0xe7, 0x1a, 0x5f, 0xa2, 0x49, 0x2d, 0xb2, 0xa4, 0x0e, 0x1f, 0x03,
-	0xfc, 0x92, 0xfc, 0xad, 0x06, 0xb1, 0x9e, 0xe4, 0x83, 0xa1, 0x57, 0x0e, 0xb3, 0x63, 0xf8, 0x0a,
-	0xf9, 0x35, 0xcf, 0x0b, 0xae, 0x42, 0x0f, 0x04, 0x5e, 0x1d, 0x17, 0x00, 0x7c, 0xe5, 0xb0, 0x61,
-	0xd0, 0x44, 0x68, 0xfa, 0xba, 0xab, 0xc2, 0xc9, 0x7f, 0x46, 0xf8, 0x68, 0x68, 0x13, 0x01, 0xc6,
-	0xf0, 0x3b, 0x14, 0x88, 0xf5, 0xba, 0xae, 0x5a, 0x1e, 0xfa, 0xa0, 0x30, 0x3f, 0xaa, 0xf0, 0xc9,
-	0xf2, 0x2b, 0x87, 0x8d, 0xa3, 0xd1, 0x57, 0x34, 0x81, 0x6a, 0x98, 0xa2, 0x27, 0x52, 0x55, 0x3b,
-	0xd8, 0xa0, 0xfb, 0x8f, 0x0d, 0x06, 0x86, 0x32, 0x2b, 0x3c, 0x43, 0x4f, 0xc7, 0x81, 0xdb, 0x4e,
-	0x4b, 0x0e, 0x7b, 0x9f, 0xb2, 0x93, 0xe1, 0xfe, 0xb3, 0x96, 0x3c, 0xba, 0x44, 0xbe, 0xed, 0x8d,
-	0x17, 0xc8, 0x93, 0x49, 0x57, 0x0e, 0xd2, 0xb3, 0x07, 0x51, 0xcb, 0xdc, 0xa4, 0x5c, 0x5e, 0xdf,
-	0x2c, 0x16, 0x37, 0x89, 0x4a, 0x9a, 0x2d, 0x03, 0x3a, 0x0a, 0xd0, 0x04, 0x5a, 0x47, 0x53, 0x14,
-	0x0c, 0xe1, 0x97, 0x3e, 0xf2, 0xaa, 0x8e, 0x37, 0xcb, 0xeb, 0xbb, 0x5f, 0xb1, 0x73, 0xb7, 0x8f,
-	0xdd, 0xfb, 0x7d, 0xec, 0xfe, 0xdc, 0xc7, 0xee, 0xf7, 0x43, 0xec, 0xdc, 0x1f, 0x62, 0xe7, 0xc7,
-	0x21, 0x76, 0xbe, 0xbc, 0x2e, 0xaa, 0xae, 0xec, 0x53, 0x92, 0x89, 0x86, 0x8e, 0xef, 0x0a, 0x3e,
-	0xe7, 0xdb, 0x7c, 0xf3, 0xe0, 0x51, 0xa7, 0x3e, 0x34, 0x7c, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xe8, 0x29, 0x24, 0x50, 0xf4, 0x02, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x33, 0x9a, 0x3f, 0xdb, 0x59, 0xbc, 0x0c, 0x3d, 0xc4, 0x20, 0xd9, 0xb2, 0xa0, 0x16,
+	0x64, 0x27, 0xac, 0xf6, 0xbc, 0xd2, 0xe2, 0xa1, 0x8b, 0x8a, 0x4b, 0xd8, 0x93, 0x97, 0x32, 0x49,
+	0xa6, 0x49, 0x68, 0x92, 0x09, 0xc9, 0xa4, 0x90, 0x6f, 0xb1, 0x78, 0xf6, 0x03, 0xed, 0x71, 0x8f,
+	0xde, 0xd4, 0xf6, 0x8b, 0x48, 0xde, 0x4c, 0x04, 0x2b, 0xba, 0x7a, 0xea, 0x0c, 0xf3, 0x7b, 0xde,
+	0xf7, 0x79, 0xdf, 0xa7, 0xc1, 0x4f, 0x43, 0x51, 0xe7, 0xa2, 0xf6, 0xc2, 0xaa, 0x2d, 0xa5, 0xf0,
+	0x36, 0xbc, 0xad, 0xd2, 0x22, 0xf6, 0xb6, 0xe7, 0x5e, 0xc5, 0x43, 0x51, 0x45, 0xb4, 0xac, 0x84,
+	0x14, 0xc4, 0xee, 0x31, 0xda, 0x63, 0x54, 0x61, 0x74, 0x7b, 0xee, 0x8c, 0x63, 0x11, 0x0b, 0x80,
+	0xbc, 0xee, 0xd4, 0xf3, 0xce, 0xe3, 0x58, 0x88, 0x38, 0xe3, 0x1e, 0xdc, 0x82, 0x66, 0xed, 0xb1,
+	0xa2, 0x55, 0x4f, 0x27, 0x87, 0x4f, 0x32, 0xcd, 0x79, 0x2d, 0x59, 0x5e, 0x2a, 0xe0, 0xc9, 0xaf,
+	0x96, 0x92, 0xa8, 0x73, 0x93, 0x28, 0x27, 0xa7, 0x9f, 0x0d, 0x6c, 0xfa, 0x60, 0x8d, 0x10, 0xac,
+	0x17, 0x2c, 0xe7, 0x36, 0x9a, 0xa0, 0xe9, 0xc8, 0x87, 0x33, 0x39, 0xc3, 0x56, 0xd9, 0x04, 0xab,
+	0x0d, 0x6f, 0xed, 0x07, 0x13, 0x34, 0x3d, 0x7e, 0x39, 0xa6, 0x7d, 0x3f, 0x3a, 0xf4, 0xa3, 0xf3,
+	0xa2, 0xf5, 0xcd, 0xb2, 0x09, 0xde, 0xf2, 0x96, 0x5c, 0x60, 0x23, 0x13, 0x21, 0xcb, 0xec, 0x87,
+	0x00, 0x3f, 0xa3, 0x7f, 0x9a, 0x93, 0xf6, 0x3d, 0xe9, 0xbb, 0x8e, 0x5e, 0x6a, 0x7e, 0x2f, 0x23,
+	0x73, 0x6c, 0x66, 0x3c, 0x8a, 0x79, 0x65, 0xeb, 0x50, 0xe0, 0xf9, 0xfd, 0x05, 0x00, 0x5f, 0x6a,
+	0xbe, 0x12, 0x76, 0x16, 0xf2, 0x26, 0x93, 0xa9, 0x6d, 0xfc, 0xa3, 0x85, 0xf7, 0x1d, 0xdd, 0x59,
+	0x00, 0x19, 0x79, 0x83, 0x2d, 0xb1, 0x5e, 0x67, 0x69, 0xc1, 0x6d, 0x13, 0x2a, 0x4c, 0xef, 0xad,
+	0xf0, 0xa1, 0xe7, 0x97, 0x9a, 0x3f, 0x48, 0xc9, 0x18, 0x1b, 0x19, 0x0b, 0x78, 0x66, 0x5b, 0xb0,
+	0xcc, 0xfe, 0x42, 0x5e, 0x63, 0x1c, 0x56, 0x9c, 0x49, 0x1e, 0xad, 0x98, 0xb4, 0x8f, 0xa0, 0xbc,
+	0xf3, 0xdb, 0x42, 0xaf, 0x87, 0x00, 0x17, 0xfa, 0xcd, 0xd7, 0x13, 0xe4, 0x8f, 0x94, 0x66, 0x2e,
+	0x9d, 0x4f, 0x08, 0x1b, 0xb0, 0x32, 0xe2, 0xe1, 0xa3, 0xb2, 0x4a, 0xb7, 0x90, 0x0c, 0xfa, 0x4b,
+	0x32, 0x56, 0x47, 0x75, 0xd1, 0x9c, 0xe2, 0x47, 0x83, 0x60, 0x25, 0xdb, 0x92, 0x43, 0x9e, 0x23,
+	0xff, 0x58, 0xbd, 0x5f, 0xb7, 0x25, 0x27, 0x33, 0xac, 0x97, 0x4c, 0x26, 0x2a, 0xbd, 0xc9, 0xc1,
+	0xe0, 0x49, 0xd4, 0xcd, 0xbc, 0xb8, 0xbc, 0x9a, 0xcd, 0xae, 0x58, 0xc5, 0xf2, 0xda, 0x07, 0xda,
+	0xb9, 0xc0, 0x66, 0x9f, 0xc2, 0x4f, 0x3d, 0xfa, 0x2f, 0xbd, 0x85, 0x0d, 0xc8, 0xc0, 0x19, 0x61,
+	0x4b, 0xad, 0x72, 0x61, 0x62, 0x3d, 0x95, 0x3c, 0x5f, 0x5c, 0xde, 0x7e, 0x77, 0xb5, 0xdb, 0x9d,
+	0x8b, 0xee, 0x76, 0x2e, 0xfa, 0xb6, 0x73, 0xd1, 0xcd, 0xde, 0xd5, 0xee, 0xf6, 0xae, 0xf6, 0x65,
+	0xef, 0x6a, 0x1f, 0x5f, 0xc4, 0xa9, 0x4c, 0x9a, 0x80, 0x86, 0x22, 0xf7, 0x86, 0x7f, 0x39, 0xfc,
+	0x9c, 0xd5, 0xd1, 0xe6, 0xe0, 0x1b, 0x0c, 0x4c, 0xd8, 0xcb, 0xab, 0x1f, 0x03, 0x00, 0xd0, 0xa3,
+	0xb0, 0xe5, 0xa3, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -358,6 +374,23 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CreatedAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintRecord(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Item != nil {
 		{
 			size := m.Item.Size()
@@ -493,6 +526,18 @@ func (m *Record_Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Path != nil {
+		{
+			size, err := m.Path.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.PrivKeyType) > 0 {
 		i -= len(m.PrivKeyType)
 		copy(dAtA[i:], m.PrivKeyType)
@@ -624,6 +669,14 @@ func (m *Record) Size() (n int) {
 	if m.Item != nil {
 		n += m.Item.Size()
 	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -689,6 +742,10 @@ func (m *Record_Local) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRecord(uint64(l))
 	}
+	if m.Path != nil {
+		l = m.Path.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -966,6 +1023,74 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &Record_Offline_{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -1084,6 +1209,42 @@ func (m *Record_Local) Unmarshal(dAtA []byte) error {
 			}
 			m.PrivKeyType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Path == nil {
+				m.Path = &hd.BIP44Params{}
+			}
+			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -38,6 +38,8 @@ const (
 	defaultEntropySize = 256
 	addressSuffix      = "address"
 	infoSuffix         = "info"
+	legacySuffix       = "legacy"
+	keyhashFilename    = "keyhash"
 )
 
 // KeyType reflects a human-readable type for key listing.
@@ -69,3 +71,29 @@ type (
 	// PrivKeyGenFunc defines the function to convert derived key bytes to a tendermint private key
 	PrivKeyGenFunc func(bz []byte, algo hd.PubKeyType) (cryptotypes.PrivKey, error)
 )
+
+// MigrationStatus is the outcome of the migration of a keyring entry.
+type MigrationStatus string
+
+const (
+	// MigrationMigrated is the status of an amino entry converted to a record.
+	MigrationMigrated MigrationStatus = "migrated"
+	// MigrationSkipped is the status of an entry that already is a record.
+	MigrationSkipped MigrationStatus = "skipped"
+	// MigrationFailed is the status of an entry that cannot be migrated.
+	MigrationFailed MigrationStatus = "failed"
+)
+
+// MigrationResult reports the migration of a keyring entry.
+type MigrationResult struct {
+	// Key is the key of the entry in the keyring DB.
+	Key string
+	// Name is the name of the key. It is derived from Key if the entry failed
+	// to migrate.
+	Name string
+	// Type is the type of the key. It is only set if the entry did not fail.
+	Type   KeyType
+	Status MigrationStatus
+	// Err is the reason of the failure of the migration.
+	Err error
+}
@@ -1,16 +1,39 @@
 package secp256r1
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+var _ codec.AminoMarshaler = &PrivKey{}
+
 // GenPrivKey generates a new secp256r1 private key. It uses operating system randomness.
 func GenPrivKey() (*PrivKey, error) {
 	key, err := ecdsa.GenPrivKey(secp256r1)
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// NewPrivKeyFromSecret creates a secp256r1 private key from its big-endian
+// secret. It returns an error if the secret is not a valid scalar of the curve.
+func NewPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	d := new(big.Int).SetBytes(secret)
+	if len(secret) != fieldSize || d.Sign() == 0 || d.Cmp(secp256r1.Params().N) >= 0 {
+		return nil, fmt.Errorf("invalid secp256r1 private key secret")
+	}
+
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(secret); err != nil {
+		return nil, err
+	}
+
+	return &PrivKey{sk}, nil
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -48,6 +71,27 @@ func (m *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 	return m.Secret.Equal(&sk2.Secret.PrivateKey)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PrivKey) MarshalAmino() ([]byte, error) {
+	return m.Secret.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PrivKey) UnmarshalAmino(bz []byte) error {
+	m.Secret = &ecdsaSK{}
+	return m.Secret.Unmarshal(bz)
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (m PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (m *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaSK struct {
 	ecdsa.PrivKey
 }
@@ -64,3 +108,19 @@ func (sk *ecdsaSK) Size() int {
 func (sk *ecdsaSK) Unmarshal(bz []byte) error {
 	return sk.PrivKey.Unmarshal(bz, secp256r1, fieldSize)
 }
+
+// MarshalJSON implements json.Marshaler interface. The secret is encoded like
+// a proto bytes field.
+func (sk ecdsaSK) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sk.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (sk *ecdsaSK) UnmarshalJSON(bz []byte) error {
+	var secret []byte
+	if err := json.Unmarshal(bz, &secret); err != nil {
+		return err
+	}
+
+	return sk.Unmarshal(secret)
+}
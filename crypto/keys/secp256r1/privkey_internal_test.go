@@ -105,6 +105,36 @@ func (suite *SKSuite) TestSign() {
 	require.False(suite.pk.VerifySignature(msg, sig))
 }
 
+func (suite *SKSuite) TestNewPrivKeyFromSecret() {
+	require := suite.Require()
+
+	sk, err := NewPrivKeyFromSecret(suite.sk.Bytes())
+	require.NoError(err)
+	require.True(sk.Equals(suite.sk))
+	require.True(sk.PubKey().Equals(suite.pk))
+
+	for _, secret := range [][]byte{
+		make([]byte, fieldSize),
+		secp256r1.Params().N.Bytes(),
+		suite.sk.Bytes()[1:],
+	} {
+		_, err = NewPrivKeyFromSecret(secret)
+		require.Error(err)
+	}
+}
+
+func (suite *SKSuite) TestMarshalAmino() {
+	require := suite.Require()
+
+	bz, err := suite.sk.(*PrivKey).MarshalAmino()
+	require.NoError(err)
+	require.Equal(suite.sk.Bytes(), bz)
+
+	var sk PrivKey
+	require.NoError(sk.UnmarshalAmino(bz))
+	require.True(sk.Equals(suite.sk))
+}
+
 func (suite *SKSuite) TestSize() {
 	require := suite.Require()
 	var pk ecdsaSK
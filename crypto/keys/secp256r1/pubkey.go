@@ -1,6 +1,8 @@
 package secp256r1
 
 import (
+	"encoding/json"
+
 	"github.com/gogo/protobuf/proto"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 
@@ -45,6 +47,27 @@ func (m *PubKey) VerifySignature(msg []byte, sig []byte) bool {
 	return m.Key.VerifySignature(msg, sig)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PubKey) MarshalAmino() ([]byte, error) {
+	return m.Key.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PubKey) UnmarshalAmino(bz []byte) error {
+	m.Key = &ecdsaPK{}
+	return m.Key.Unmarshal(bz)
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (m PubKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (m *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaPK struct {
 	ecdsa.PubKey
 }
@@ -61,3 +84,19 @@ func (pk *ecdsaPK) Size() int {
 func (pk *ecdsaPK) Unmarshal(bz []byte) error {
 	return pk.PubKey.Unmarshal(bz, secp256r1, pubKeySize)
 }
+
+// MarshalJSON implements json.Marshaler interface. The key is encoded like a
+// proto bytes field.
+func (pk ecdsaPK) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pk.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (pk *ecdsaPK) UnmarshalJSON(bz []byte) error {
+	var key []byte
+	if err := json.Unmarshal(bz, &key); err != nil {
+		return err
+	}
+
+	return pk.Unmarshal(key)
+}
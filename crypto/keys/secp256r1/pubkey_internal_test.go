@@ -115,6 +115,35 @@ func (suite *PKSuite) TestMarshalProto() {
 	require.Error(err, "nil should fail")
 }
 
+func (suite *PKSuite) TestMarshalJSON() {
+	require := suite.Require()
+
+	registry := types.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterfaceJSON(suite.pk)
+	require.NoError(err)
+	require.Contains(string(bz), `"@type":"/cosmos.crypto.secp256r1.PubKey"`)
+
+	var pkI cryptotypes.PubKey
+	require.NoError(cdc.UnmarshalInterfaceJSON(bz, &pkI))
+	require.True(pkI.Equals(suite.pk))
+}
+
+func (suite *PKSuite) TestMarshalAmino() {
+	require := suite.Require()
+
+	bz, err := suite.pk.MarshalAmino()
+	require.NoError(err)
+	require.Equal(suite.pk.Bytes(), bz)
+
+	var pk PubKey
+	require.NoError(pk.UnmarshalAmino(bz))
+	require.True(pk.Equals(suite.pk))
+	require.Error(pk.UnmarshalAmino(bz[1:]))
+}
+
 func (suite *PKSuite) TestSize() {
 	require := suite.Require()
 	var pk ecdsaPK
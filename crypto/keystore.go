@@ -0,0 +1,235 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	keystoreVersion = 1
+	keystoreKDF     = "scrypt"
+	keystoreCipher  = "aes-256-gcm"
+
+	keystoreScryptR   = 8
+	keystoreScryptP   = 1
+	keystoreKeyLength = 32
+	keystoreSaltSize  = 32
+)
+
+// keystoreMaxScryptN is the highest scrypt cost accepted when decrypting, the
+// one of the exported keystores, so that a crafted keystore cannot exhaust the
+// memory or the CPU of the importer.
+const keystoreMaxScryptN = 1 << 18
+
+// KeystoreScryptN is the scrypt CPU/memory cost parameter of the exported
+// keystores. Like BcryptSecurityParameter it is a var so that tests can lower
+// it; the keystores store the parameters they were encrypted with, so they are
+// always decrypted with their own cost, up to keystoreMaxScryptN.
+var KeystoreScryptN = keystoreMaxScryptN
+
+// keystoreJSON is the encrypted JSON keystore of a private key. It follows the
+// layout of the web3 secret storage, with an AES-GCM cipher.
+type keystoreJSON struct {
+	Version int            `json:"version"`
+	Type    string         `json:"type"`
+	Address string         `json:"address"`
+	Label   string         `json:"label,omitempty"`
+	Crypto  keystoreCrypto `json:"crypto"`
+}
+
+type keystoreCrypto struct {
+	Cipher       string               `json:"cipher"`
+	CipherText   string               `json:"ciphertext"`
+	CipherParams keystoreCipherParams `json:"cipherparams"`
+	KDF          string               `json:"kdf"`
+	KDFParams    keystoreKDFParams    `json:"kdfparams"`
+}
+
+type keystoreCipherParams struct {
+	Nonce string `json:"nonce"`
+}
+
+type keystoreKDFParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// EncryptKeystorePrivKey encrypts a secp256k1, secp256r1 or ed25519 private
+// key with a key derived from the passphrase by scrypt, and returns it as a
+// JSON keystore. The optional label of the key is stored unencrypted.
+func EncryptKeystorePrivKey(privKey cryptotypes.PrivKey, passphrase, label string) ([]byte, error) {
+	switch privKey.(type) {
+	case *secp256k1.PrivKey, *secp256r1.PrivKey, *ed25519.PrivKey:
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unsupported key type %s for a keystore", privKey.Type())
+	}
+
+	encrypted, err := encryptScrypt(privKey.Bytes(), passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(keystoreJSON{
+		Version: keystoreVersion,
+		Type:    privKey.Type(),
+		Address: hex.EncodeToString(privKey.PubKey().Address()),
+		Label:   label,
+		Crypto:  encrypted,
+	}, "", "  ")
+}
+
+// DecryptKeystorePrivKey decrypts the private key of a JSON keystore created
+// by EncryptKeystorePrivKey, and its label. It returns ErrWrongPassword if the
+// passphrase does not decrypt the key.
+func DecryptKeystorePrivKey(bz []byte, passphrase string) (cryptotypes.PrivKey, string, error) {
+	var ks keystoreJSON
+	if err := json.Unmarshal(bz, &ks); err != nil {
+		return nil, "", sdkerrors.Wrap(err, "failed to decode keystore")
+	}
+
+	if ks.Version != keystoreVersion {
+		return nil, "", fmt.Errorf("unsupported keystore version: %d", ks.Version)
+	}
+
+	privKeyBytes, err := decryptScrypt(ks.Crypto, passphrase)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var privKey cryptotypes.PrivKey
+	switch ks.Type {
+	case string(hd.Secp256k1Type):
+		if len(privKeyBytes) != secp256k1.PrivKeySize {
+			return nil, "", fmt.Errorf("invalid secp256k1 private key length: %d", len(privKeyBytes))
+		}
+		privKey = &secp256k1.PrivKey{Key: privKeyBytes}
+	case string(hd.Secp256r1Type):
+		privKey, err = secp256r1.NewPrivKeyFromSecret(privKeyBytes)
+		if err != nil {
+			return nil, "", err
+		}
+	case string(hd.Ed25519Type):
+		if len(privKeyBytes) != ed25519.PrivKeySize {
+			return nil, "", fmt.Errorf("invalid ed25519 private key length: %d", len(privKeyBytes))
+		}
+		privKey = &ed25519.PrivKey{Key: privKeyBytes}
+	default:
+		return nil, "", fmt.Errorf("unsupported key type: %v", ks.Type)
+	}
+
+	if address, err := hex.DecodeString(ks.Address); err != nil || !bytes.Equal(address, privKey.PubKey().Address()) {
+		return nil, "", fmt.Errorf("keystore address does not match the private key")
+	}
+
+	return privKey, ks.Label, nil
+}
+
+// encryptScrypt encrypts the plaintext with the AES-GCM cipher keyed by the
+// scrypt key derived from the passphrase.
+func encryptScrypt(plaintext []byte, passphrase string) (keystoreCrypto, error) {
+	salt := crypto.CRandBytes(keystoreSaltSize)
+	gcm, err := keystoreGCM(passphrase, salt, KeystoreScryptN, keystoreScryptR, keystoreScryptP, keystoreKeyLength)
+	if err != nil {
+		return keystoreCrypto{}, err
+	}
+
+	nonce := crypto.CRandBytes(gcm.NonceSize())
+	cipherText := gcm.Seal(nil, nonce, plaintext, nil)
+
+	return keystoreCrypto{
+		Cipher:       keystoreCipher,
+		CipherText:   hex.EncodeToString(cipherText),
+		CipherParams: keystoreCipherParams{Nonce: hex.EncodeToString(nonce)},
+		KDF:          keystoreKDF,
+		KDFParams: keystoreKDFParams{
+			N:     KeystoreScryptN,
+			R:     keystoreScryptR,
+			P:     keystoreScryptP,
+			DKLen: keystoreKeyLength,
+			Salt:  hex.EncodeToString(salt),
+		},
+	}, nil
+}
+
+// decryptScrypt decrypts the ciphertext encrypted by encryptScrypt. It rejects
+// the scrypt parameters encryptScrypt does not use before deriving the key, and
+// returns ErrWrongPassword if the passphrase does not decrypt it.
+func decryptScrypt(c keystoreCrypto, passphrase string) ([]byte, error) {
+	if c.KDF != keystoreKDF {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", c.KDF)
+	}
+	if c.Cipher != keystoreCipher {
+		return nil, fmt.Errorf("unrecognized cipher: %v", c.Cipher)
+	}
+
+	params := c.KDFParams
+	if params.DKLen != keystoreKeyLength {
+		return nil, fmt.Errorf("invalid derived key length: %d", params.DKLen)
+	}
+	if params.N > keystoreMaxScryptN {
+		return nil, fmt.Errorf("scrypt cost parameter %d is above the maximum of %d", params.N, keystoreMaxScryptN)
+	}
+	if params.R != keystoreScryptR || params.P != keystoreScryptP {
+		return nil, fmt.Errorf("unsupported scrypt parameters r=%d, p=%d", params.R, params.P)
+	}
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding salt: %v", err.Error())
+	}
+	nonce, err := hex.DecodeString(c.CipherParams.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding nonce: %v", err.Error())
+	}
+	cipherText, err := hex.DecodeString(c.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ciphertext: %v", err.Error())
+	}
+
+	gcm, err := keystoreGCM(passphrase, salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce length: %d", len(nonce))
+	}
+
+	plaintext, err := gcm.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+
+	return plaintext, nil
+}
+
+// keystoreGCM returns the AES-GCM cipher keyed by the scrypt key derived from
+// the passphrase.
+func keystoreGCM(passphrase string, salt []byte, n, r, p, keyLen int) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, n, r, p, keyLen)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "error generating scrypt key from passphrase")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,134 @@
+package crypto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func lowerKeystoreScryptN(t *testing.T) {
+	scryptN := crypto.KeystoreScryptN
+	crypto.KeystoreScryptN = 1 << 4
+	t.Cleanup(func() { crypto.KeystoreScryptN = scryptN })
+}
+
+func TestEncryptDecryptKeystorePrivKey(t *testing.T) {
+	lowerKeystoreScryptN(t)
+
+	r1Priv, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	for _, priv := range []cryptotypes.PrivKey{secp256k1.GenPrivKey(), r1Priv, ed25519.GenPrivKey()} {
+		keystore, err := crypto.EncryptKeystorePrivKey(priv, "passphrase", "validator key")
+		require.NoError(t, err)
+
+		var fields map[string]interface{}
+		require.NoError(t, json.Unmarshal(keystore, &fields))
+		require.Equal(t, priv.Type(), fields["type"])
+		require.Equal(t, "validator key", fields["label"])
+
+		_, _, err = crypto.DecryptKeystorePrivKey(keystore, "wrongpassphrase")
+		require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+		decrypted, label, err := crypto.DecryptKeystorePrivKey(keystore, "passphrase")
+		require.NoError(t, err)
+		require.True(t, priv.Equals(decrypted))
+		require.Equal(t, "validator key", label)
+
+		// the keystore is encrypted with a new salt and nonce every time
+		other, err := crypto.EncryptKeystorePrivKey(priv, "passphrase", "validator key")
+		require.NoError(t, err)
+		require.NotEqual(t, keystore, other)
+	}
+}
+
+// unsupportedPrivKey is a private key type the keystores do not support.
+type unsupportedPrivKey struct {
+	*secp256k1.PrivKey
+}
+
+func TestEncryptKeystorePrivKeyUnsupportedType(t *testing.T) {
+	lowerKeystoreScryptN(t)
+
+	_, err := crypto.EncryptKeystorePrivKey(unsupportedPrivKey{secp256k1.GenPrivKey()}, "passphrase", "")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestDecryptKeystorePrivKeyInvalid(t *testing.T) {
+	lowerKeystoreScryptN(t)
+
+	priv := secp256k1.GenPrivKey()
+	keystore, err := crypto.EncryptKeystorePrivKey(priv, "passphrase", "")
+	require.NoError(t, err)
+
+	modify := func(f func(fields map[string]interface{})) []byte {
+		var fields map[string]interface{}
+		require.NoError(t, json.Unmarshal(keystore, &fields))
+		f(fields)
+		bz, err := json.Marshal(fields)
+		require.NoError(t, err)
+		return bz
+	}
+	cryptoFields := func(fields map[string]interface{}) map[string]interface{} {
+		return fields["crypto"].(map[string]interface{})
+	}
+
+	cases := map[string][]byte{
+		"empty":     {},
+		"truncated": keystore[:len(keystore)/2],
+		"unsupported version": modify(func(fields map[string]interface{}) {
+			fields["version"] = 2
+		}),
+		"unsupported key type": modify(func(fields map[string]interface{}) {
+			fields["type"] = "sr25519"
+		}),
+		"other key type": modify(func(fields map[string]interface{}) {
+			fields["type"] = "ed25519"
+		}),
+		"other address": modify(func(fields map[string]interface{}) {
+			fields["address"] = "0000000000000000000000000000000000000000"
+		}),
+		"unrecognized kdf": modify(func(fields map[string]interface{}) {
+			cryptoFields(fields)["kdf"] = "bcrypt"
+		}),
+		"unrecognized cipher": modify(func(fields map[string]interface{}) {
+			cryptoFields(fields)["cipher"] = "aes-128-ctr"
+		}),
+		"truncated ciphertext": modify(func(fields map[string]interface{}) {
+			cipherText := cryptoFields(fields)["ciphertext"].(string)
+			cryptoFields(fields)["ciphertext"] = cipherText[:len(cipherText)-2]
+		}),
+		"invalid salt": modify(func(fields map[string]interface{}) {
+			cryptoFields(fields)["kdfparams"].(map[string]interface{})["salt"] = "not hex"
+		}),
+		"invalid scrypt cost": modify(func(fields map[string]interface{}) {
+			cryptoFields(fields)["kdfparams"].(map[string]interface{})["n"] = 3
+		}),
+		"oversized scrypt cost": modify(func(fields map[string]interface{}) {
+			cryptoFields(fields)["kdfparams"].(map[string]interface{})["n"] = 1 << 30
+		}),
+		"other scrypt block size": modify(func(fields map[string]interface{}) {
+			cryptoFields(fields)["kdfparams"].(map[string]interface{})["r"] = 1 << 20
+		}),
+		"other scrypt parallelization": modify(func(fields map[string]interface{}) {
+			cryptoFields(fields)["kdfparams"].(map[string]interface{})["p"] = 1 << 20
+		}),
+	}
+
+	for name, bz := range cases {
+		bz := bz
+		t.Run(name, func(t *testing.T) {
+			decrypted, _, err := crypto.DecryptKeystorePrivKey(bz, "passphrase")
+			require.Error(t, err)
+			require.Nil(t, decrypted)
+		})
+	}
+}
@@ -27,6 +27,11 @@ func init() {
 	}
 }
 
+// MockSupportsSignModeDirect sets whether the Cosmos app of the mock device
+// supports SIGN_MODE_DIRECT, so that tests can exercise the devices of both app
+// versions.
+var MockSupportsSignModeDirect = true
+
 type LedgerSECP256K1Mock struct {
 }
 
@@ -85,6 +90,21 @@ func (mock LedgerSECP256K1Mock) GetAddressPubKeySECP256K1(derivationPath []uint3
 	return pk, addr, err
 }
 
+// SupportsSignModeDirect mocks the probe of the version of the Cosmos app,
+// which supports SIGN_MODE_DIRECT if MockSupportsSignModeDirect is set.
+func (mock LedgerSECP256K1Mock) SupportsSignModeDirect() (bool, error) {
+	return MockSupportsSignModeDirect, nil
+}
+
+// SignDirectSECP256K1 mocks a ledger device signing SIGN_MODE_DIRECT sign bytes
+func (mock LedgerSECP256K1Mock) SignDirectSECP256K1(derivationPath []uint32, message []byte) ([]byte, error) {
+	if !MockSupportsSignModeDirect {
+		return nil, errors.New("SIGN_MODE_DIRECT is not supported by the app")
+	}
+
+	return mock.SignSECP256K1(derivationPath, message)
+}
+
 func (mock LedgerSECP256K1Mock) SignSECP256K1(derivationPath []uint32, message []byte) ([]byte, error) {
 	path := hd.NewParams(derivationPath[0], derivationPath[1], derivationPath[2], derivationPath[3] != 0, derivationPath[4])
 	seed, err := bip39.NewSeedWithErrorChecking(testutil.TestMnemonic, "")
@@ -37,6 +37,15 @@ type (
 		SignSECP256K1([]uint32, []byte) ([]byte, error)
 	}
 
+	// SECP256K1Direct reflects an interface a Ledger API implements when it can
+	// sign the SIGN_MODE_DIRECT sign bytes of a transaction
+	SECP256K1Direct interface {
+		// Returns whether the version of the Cosmos app supports SIGN_MODE_DIRECT
+		SupportsSignModeDirect() (bool, error)
+		// Signs SIGN_MODE_DIRECT sign bytes (requires user confirmation)
+		SignDirectSECP256K1([]uint32, []byte) ([]byte, error)
+	}
+
 	// PrivKeyLedgerSecp256k1 implements PrivKey, calling the ledger nano we
 	// cache the PubKey from the first call to use it later.
 	PrivKeyLedgerSecp256k1 struct {
@@ -101,6 +110,37 @@ func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
 	return sign(device, pkl, message)
 }
 
+// SignDirect returns a secp256k1 signature for the corresponding SIGN_MODE_DIRECT
+// sign bytes. It returns an error if the Ledger app does not support
+// SIGN_MODE_DIRECT, see SupportsSignModeDirect.
+func (pkl PrivKeyLedgerSecp256k1) SignDirect(message []byte) ([]byte, error) {
+	device, err := getDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	return signDirect(device, pkl, message)
+}
+
+// SupportsSignModeDirect probes the connected Ledger device for the support of
+// SIGN_MODE_DIRECT by the version of its Cosmos app. The devices whose API
+// cannot sign SIGN_MODE_DIRECT sign bytes do not support it.
+func SupportsSignModeDirect() (bool, error) {
+	device, err := getDevice()
+	if err != nil {
+		return false, err
+	}
+	defer warnIfErrors(device.Close)
+
+	directDevice, ok := device.(SECP256K1Direct)
+	if !ok {
+		return false, nil
+	}
+
+	return directDevice.SupportsSignModeDirect()
+}
+
 // ShowAddress triggers a ledger device to show the corresponding address.
 func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey,
 	accountAddressPrefix string) error {
@@ -226,6 +266,27 @@ func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte) ([]byte, err
 	return convertDERtoBER(sig)
 }
 
+// signDirect calls the ledger to sign SIGN_MODE_DIRECT sign bytes, after the
+// same checks as sign.
+func signDirect(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte) ([]byte, error) {
+	directDevice, ok := device.(SECP256K1Direct)
+	if !ok {
+		return nil, errors.New("the Ledger app does not support SIGN_MODE_DIRECT")
+	}
+
+	err := validateKey(device, pkl)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := directDevice.SignDirectSECP256K1(pkl.Path.DerivationPath(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDERtoBER(sig)
+}
+
 // getPubKeyUnsafe reads the pubkey from a ledger device
 //
 // This function is marked as unsafe as it will retrieve a pubkey without user verification
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+)
+
+// WrapGRPCWeb wraps the gRPC server into a gRPC-Web handler. Cross-origin
+// requests are accepted from the allowed origins, "*" allowing all of them, or
+// from any origin if allowAllOrigins is true.
+func WrapGRPCWeb(grpcSrv *grpc.Server, allowedOrigins []string, allowAllOrigins bool) *grpcweb.WrappedGrpcServer {
+	return grpcweb.WrapServer(grpcSrv, grpcweb.WithOriginFunc(func(origin string) bool {
+		if allowAllOrigins {
+			return true
+		}
+
+		for _, allowed := range allowedOrigins {
+			if allowed == "*" || allowed == origin {
+				return true
+			}
+		}
+
+		return false
+	}))
+}
+
+// grpcWebMux returns a handler passing the gRPC-Web requests, including their
+// CORS preflight requests, to the wrapped gRPC server and the others to h.
+func grpcWebMux(wrappedGRPC *grpcweb.WrappedGrpcServer, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrappedGRPC.IsGrpcWebRequest(r) || wrappedGRPC.IsAcceptableGrpcCorsRequest(r) {
+			wrappedGRPC.ServeHTTP(w, r)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,53 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/cosmos/cosmos-sdk/server/api"
+)
+
+func TestWrapGRPCWebCORS(t *testing.T) {
+	grpcSrv := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcSrv, health.NewServer())
+
+	testCases := []struct {
+		name            string
+		allowedOrigins  []string
+		allowAllOrigins bool
+		origin          string
+		expAllowed      bool
+	}{
+		{"no allowed origins", nil, false, "https://app.example.com", false},
+		{"allowed origin", []string{"https://app.example.com"}, false, "https://app.example.com", true},
+		{"other origin", []string{"https://app.example.com"}, false, "https://evil.example.com", false},
+		{"wildcard origin", []string{"*"}, false, "https://evil.example.com", true},
+		{"all origins allowed", nil, true, "https://evil.example.com", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrapped := api.WrapGRPCWeb(grpcSrv, tc.allowedOrigins, tc.allowAllOrigins)
+
+			// the CORS preflight request of a browser
+			req := httptest.NewRequest(http.MethodOptions, "/grpc.health.v1.Health/Check", nil)
+			req.Header.Set("Origin", tc.origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+			require.True(t, wrapped.IsAcceptableGrpcCorsRequest(req))
+
+			rec := httptest.NewRecorder()
+			wrapped.ServeHTTP(rec, req)
+			if tc.expAllowed {
+				require.Equal(t, tc.origin, rec.Header().Get("Access-Control-Allow-Origin"))
+			} else {
+				require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+			}
+		})
+	}
+}
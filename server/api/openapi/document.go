@@ -0,0 +1,64 @@
+// Package openapi generates the OpenAPI (Swagger 2.0) document of the REST
+// routes of an app at runtime, from the google.api.http annotations of the gRPC
+// services actually registered in the app, so that the document follows the
+// modules wired into the app, custom ones included.
+package openapi
+
+// Document is an OpenAPI 2.0 document.
+type Document struct {
+	Swagger     string              `json:"swagger"`
+	Info        Info                `json:"info"`
+	Consumes    []string            `json:"consumes"`
+	Produces    []string            `json:"produces"`
+	Paths       map[string]PathItem `json:"paths"`
+	Definitions map[string]*Schema  `json:"definitions"`
+}
+
+// Info describes the API of a Document.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem maps the lowercase HTTP methods of a path to their operation.
+type PathItem map[string]*Operation
+
+// Operation is a REST route, forwarded to a gRPC method.
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Tags        []string            `json:"tags"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter is a path, query or body parameter of an Operation.
+type Parameter struct {
+	Name             string   `json:"name"`
+	In               string   `json:"in"`
+	Required         bool     `json:"required,omitempty"`
+	Type             string   `json:"type,omitempty"`
+	Format           string   `json:"format,omitempty"`
+	Items            *Schema  `json:"items,omitempty"`
+	CollectionFormat string   `json:"collectionFormat,omitempty"`
+	Enum             []string `json:"enum,omitempty"`
+	Schema           *Schema  `json:"schema,omitempty"`
+}
+
+// Response is a response of an Operation.
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+// Schema is the JSON schema of a value, either a reference to a definition of
+// the Document or an inline schema.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+}
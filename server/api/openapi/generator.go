@@ -0,0 +1,516 @@
+package openapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	golangproto "github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ServiceInfoProvider provides the gRPC services registered on a server, like
+// *grpc.Server and *baseapp.GRPCQueryRouter.
+type ServiceInfoProvider interface {
+	GetServiceInfo() map[string]grpc.ServiceInfo
+}
+
+const (
+	// errorDefinition is the definition of the error responses of the
+	// gRPC-gateway.
+	errorDefinition = "grpc.gateway.runtime.Error"
+
+	// maxQueryDepth is the maximum depth of the fields of the nested messages
+	// of a request bound to query parameters, e.g. pagination.key.
+	maxQueryDepth = 3
+)
+
+// pathParamRegex matches the parameters of a path template, either {name} or
+// {name=pattern}.
+var pathParamRegex = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// Generate returns the OpenAPI document of the REST routes of the gRPC services
+// of the provider, which are their methods annotated with a google.api.http
+// rule, as the gRPC-gateway forwards them. The file descriptors of the
+// services are looked up in the gogoproto and golang/protobuf registries.
+func Generate(info Info, services ServiceInfoProvider) (*Document, error) {
+	g := &generator{
+		files:       make(map[string]*descriptorpb.FileDescriptorProto),
+		messages:    make(map[string]*descriptorpb.DescriptorProto),
+		enums:       make(map[string]*descriptorpb.EnumDescriptorProto),
+		definitions: make(map[string]*Schema),
+	}
+
+	doc := &Document{
+		Swagger:     "2.0",
+		Info:        info,
+		Consumes:    []string{"application/json"},
+		Produces:    []string{"application/json"},
+		Paths:       make(map[string]PathItem),
+		Definitions: g.definitions,
+	}
+
+	serviceInfo := services.GetServiceInfo()
+	names := make([]string, 0, len(serviceInfo))
+	for name := range serviceInfo {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// services without a file descriptor cannot have REST routes
+		fileName, ok := serviceInfo[name].Metadata.(string)
+		if !ok || fileName == "" {
+			continue
+		}
+
+		if err := g.addService(doc, name, fileName); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(doc.Paths) > 0 {
+		g.defineError()
+	}
+
+	return doc, nil
+}
+
+// generator builds the operations and the definitions of a Document from the
+// file descriptors of the services.
+type generator struct {
+	files       map[string]*descriptorpb.FileDescriptorProto
+	messages    map[string]*descriptorpb.DescriptorProto
+	enums       map[string]*descriptorpb.EnumDescriptorProto
+	definitions map[string]*Schema
+}
+
+// addService adds to the document the operations of the REST routes of the
+// service, declared in the given file.
+func (g *generator) addService(doc *Document, service, fileName string) error {
+	fd, err := g.loadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	var sd *descriptorpb.ServiceDescriptorProto
+	for _, s := range fd.GetService() {
+		if qualify(fd.GetPackage(), s.GetName()) == service {
+			sd = s
+			break
+		}
+	}
+	if sd == nil {
+		return fmt.Errorf("service %s not found in file descriptor %s", service, fileName)
+	}
+
+	for _, method := range sd.GetMethod() {
+		if method.GetOptions() == nil || method.GetClientStreaming() || method.GetServerStreaming() {
+			continue
+		}
+
+		rule, ok := proto.GetExtension(method.GetOptions(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+
+		operationID := fmt.Sprintf("%s.%s", service, method.GetName())
+		for i, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			httpMethod, path := httpPattern(binding)
+			if httpMethod == "" {
+				continue
+			}
+
+			op, err := g.operation(service, method, binding, path)
+			if err != nil {
+				return err
+			}
+
+			op.OperationID = operationID
+			if i > 0 {
+				op.OperationID += strconv.Itoa(i + 1)
+			}
+
+			path = pathParamRegex.ReplaceAllString(path, "{$1}")
+			if doc.Paths[path] == nil {
+				doc.Paths[path] = make(PathItem)
+			}
+			doc.Paths[path][strings.ToLower(httpMethod)] = op
+		}
+	}
+
+	return nil
+}
+
+// operation returns the operation of the REST route of the method bound to the
+// given rule and path template.
+func (g *generator) operation(
+	service string, method *descriptorpb.MethodDescriptorProto, rule *annotations.HttpRule, path string,
+) (*Operation, error) {
+	input, ok := g.messages[strings.TrimPrefix(method.GetInputType(), ".")]
+	if !ok {
+		return nil, fmt.Errorf("request type %s of %s/%s not found", method.GetInputType(), service, method.GetName())
+	}
+
+	op := &Operation{
+		Tags: []string{service},
+		Responses: map[string]Response{
+			"200": {
+				Description: "A successful response.",
+				Schema:      g.messageSchema(method.GetOutputType()),
+			},
+			"default": {
+				Description: "An unexpected error response.",
+				Schema:      &Schema{Ref: ref(errorDefinition)},
+			},
+		},
+	}
+
+	bound := make(map[string]bool)
+	for _, match := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		name := match[1]
+		param := Parameter{Name: name, In: "path", Required: true, Type: "string"}
+		if fld := g.fieldByPath(input, name); fld != nil {
+			setParameterType(&param, g.scalarSchema(fld))
+		}
+
+		op.Parameters = append(op.Parameters, param)
+		bound[name] = true
+	}
+
+	switch body := rule.GetBody(); body {
+	case "*":
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     "body",
+			In:       "body",
+			Required: true,
+			Schema:   g.messageSchema(method.GetInputType()),
+		})
+
+		return op, nil
+
+	case "":
+
+	default:
+		if fld := g.fieldByPath(input, body); fld != nil {
+			op.Parameters = append(op.Parameters, Parameter{
+				Name:     body,
+				In:       "body",
+				Required: true,
+				Schema:   g.fieldSchema(fld),
+			})
+		}
+		bound[body] = true
+	}
+
+	// the other fields of the request are bound to query parameters
+	op.Parameters = append(op.Parameters, g.queryParameters("", input, bound, 0)...)
+
+	return op, nil
+}
+
+// queryParameters returns the query parameters of the fields of the message
+// which are not bound to the path or the body, flattening the fields of nested
+// messages, e.g. pagination.key.
+func (g *generator) queryParameters(prefix string, msg *descriptorpb.DescriptorProto, bound map[string]bool, depth int) []Parameter {
+	var params []Parameter
+	for _, fld := range msg.GetField() {
+		name := prefix + fld.GetName()
+		if bound[name] {
+			continue
+		}
+
+		repeated := fld.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		if fld.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+			switch typeName := strings.TrimPrefix(fld.GetTypeName(), "."); {
+			case repeated:
+				// repeated messages and maps cannot be query parameters
+			case typeName == "google.protobuf.Timestamp" || typeName == "google.protobuf.Duration":
+				params = append(params, Parameter{Name: name, In: "query", Type: "string", Format: g.messageSchema(typeName).Format})
+			default:
+				if nested, ok := g.messages[typeName]; ok && depth < maxQueryDepth {
+					params = append(params, g.queryParameters(name+".", nested, bound, depth+1)...)
+				}
+			}
+
+			continue
+		}
+
+		param := Parameter{Name: name, In: "query"}
+		if repeated {
+			param.Type = "array"
+			param.Items = g.scalarSchema(fld)
+			param.CollectionFormat = "multi"
+		} else {
+			setParameterType(&param, g.scalarSchema(fld))
+		}
+		params = append(params, param)
+	}
+
+	return params
+}
+
+// fieldByPath returns the field of the message at the given dotted path, or nil.
+func (g *generator) fieldByPath(msg *descriptorpb.DescriptorProto, path string) *descriptorpb.FieldDescriptorProto {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		var fld *descriptorpb.FieldDescriptorProto
+		for _, f := range msg.GetField() {
+			if f.GetName() == name {
+				fld = f
+				break
+			}
+		}
+		if fld == nil || i == len(names)-1 {
+			return fld
+		}
+
+		var ok bool
+		if msg, ok = g.messages[strings.TrimPrefix(fld.GetTypeName(), ".")]; !ok {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// fieldSchema returns the schema of the JSON value of a field, named after the
+// protobuf field as by the gRPC-gateway marshaler of the API server.
+func (g *generator) fieldSchema(fld *descriptorpb.FieldDescriptorProto) *Schema {
+	var schema *Schema
+	if fld.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		typeName := strings.TrimPrefix(fld.GetTypeName(), ".")
+		if entry, ok := g.messages[typeName]; ok && entry.GetOptions().GetMapEntry() && len(entry.GetField()) == 2 {
+			return &Schema{Type: "object", AdditionalProperties: g.fieldSchema(entry.GetField()[1])}
+		}
+
+		schema = g.messageSchema(typeName)
+	} else {
+		schema = g.scalarSchema(fld)
+	}
+
+	if fld.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return &Schema{Type: "array", Items: schema}
+	}
+
+	return schema
+}
+
+// scalarSchema returns the schema of the JSON value of a non-message field,
+// ignoring its label.
+func (g *generator) scalarSchema(fld *descriptorpb.FieldDescriptorProto) *Schema {
+	switch fld.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return &Schema{Type: "number", Format: "double"}
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		return &Schema{Type: "number", Format: "float"}
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		// 64-bit integers are encoded as JSON strings
+		return &Schema{Type: "string", Format: "int64"}
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		return &Schema{Type: "string", Format: "uint64"}
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
+		return &Schema{Type: "integer", Format: "int64"}
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return &Schema{Type: "boolean"}
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return &Schema{Type: "string", Format: "byte"}
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		schema := &Schema{Type: "string"}
+		if enum, ok := g.enums[strings.TrimPrefix(fld.GetTypeName(), ".")]; ok {
+			for _, value := range enum.GetValue() {
+				schema.Enum = append(schema.Enum, value.GetName())
+			}
+		}
+
+		return schema
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+// messageSchema returns the schema of a message: the well-known types encoded
+// as JSON strings are inlined, the other messages refer to their definition.
+func (g *generator) messageSchema(typeName string) *Schema {
+	typeName = strings.TrimPrefix(typeName, ".")
+	switch typeName {
+	case "google.protobuf.Timestamp":
+		return &Schema{Type: "string", Format: "date-time"}
+	case "google.protobuf.Duration":
+		return &Schema{Type: "string"}
+	case "google.protobuf.Any":
+		// the JSON of an Any is the JSON of the packed message, with its type URL
+		if _, ok := g.definitions[typeName]; !ok {
+			g.definitions[typeName] = &Schema{
+				Type:                 "object",
+				Properties:           map[string]*Schema{"@type": {Type: "string"}},
+				AdditionalProperties: &Schema{},
+			}
+		}
+	default:
+		g.define(typeName)
+	}
+
+	return &Schema{Ref: ref(typeName)}
+}
+
+// define adds the definition of the message and of the messages of its fields
+// to the document, if not defined yet.
+func (g *generator) define(typeName string) {
+	if _, ok := g.definitions[typeName]; ok {
+		return
+	}
+
+	// defined before its fields, for recursive messages
+	schema := &Schema{Type: "object"}
+	g.definitions[typeName] = schema
+
+	msg, ok := g.messages[typeName]
+	if !ok {
+		return
+	}
+
+	for _, fld := range msg.GetField() {
+		if schema.Properties == nil {
+			schema.Properties = make(map[string]*Schema)
+		}
+		schema.Properties[fld.GetName()] = g.fieldSchema(fld)
+	}
+}
+
+// defineError adds the definition of the error responses of the gRPC-gateway.
+func (g *generator) defineError() {
+	g.messageSchema("google.protobuf.Any")
+	g.definitions[errorDefinition] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"error":   {Type: "string"},
+			"code":    {Type: "integer", Format: "int32"},
+			"message": {Type: "string"},
+			"details": {Type: "array", Items: &Schema{Ref: ref("google.protobuf.Any")}},
+		},
+	}
+}
+
+// loadFile returns the registered file descriptor of the given name, indexing
+// its messages and enums and the ones of its dependencies.
+func (g *generator) loadFile(name string) (*descriptorpb.FileDescriptorProto, error) {
+	if fd, ok := g.files[name]; ok {
+		return fd, nil
+	}
+
+	gzipped := gogoproto.FileDescriptor(name)
+	if gzipped == nil {
+		gzipped = golangproto.FileDescriptor(name)
+	}
+	if gzipped == nil {
+		return nil, fmt.Errorf("file descriptor %s not registered", name)
+	}
+
+	fd, err := decodeFileDescriptor(gzipped)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file descriptor %s: %w", name, err)
+	}
+	g.files[name] = fd
+
+	for _, msg := range fd.GetMessageType() {
+		g.indexMessage(fd.GetPackage(), msg)
+	}
+	for _, enum := range fd.GetEnumType() {
+		g.enums[qualify(fd.GetPackage(), enum.GetName())] = enum
+	}
+
+	// the types of the dependencies which are not registered are described
+	// as plain objects
+	for _, dep := range fd.GetDependency() {
+		_, _ = g.loadFile(dep)
+	}
+
+	return fd, nil
+}
+
+// indexMessage indexes the message and its nested messages and enums.
+func (g *generator) indexMessage(prefix string, msg *descriptorpb.DescriptorProto) {
+	name := qualify(prefix, msg.GetName())
+	g.messages[name] = msg
+
+	for _, nested := range msg.GetNestedType() {
+		g.indexMessage(name, nested)
+	}
+	for _, enum := range msg.GetEnumType() {
+		g.enums[qualify(name, enum.GetName())] = enum
+	}
+}
+
+// httpPattern returns the HTTP method and the path template of the rule.
+func httpPattern(rule *annotations.HttpRule) (method, path string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return "GET", pattern.Get
+	case *annotations.HttpRule_Put:
+		return "PUT", pattern.Put
+	case *annotations.HttpRule_Post:
+		return "POST", pattern.Post
+	case *annotations.HttpRule_Delete:
+		return "DELETE", pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return "PATCH", pattern.Patch
+	case *annotations.HttpRule_Custom:
+		return strings.ToUpper(pattern.Custom.GetKind()), pattern.Custom.GetPath()
+	default:
+		return "", ""
+	}
+}
+
+// setParameterType sets the type of the non-body parameter to the one of the
+// schema.
+func setParameterType(param *Parameter, schema *Schema) {
+	param.Type, param.Format, param.Enum = schema.Type, schema.Format, schema.Enum
+}
+
+// decodeFileDescriptor decodes a gzipped file descriptor, along with the
+// google.api.http options of its methods.
+func decodeFileDescriptor(gzipped []byte) (*descriptorpb.FileDescriptorProto, error) {
+	r, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(bz, fd); err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
+
+func qualify(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+func ref(definition string) string {
+	return "#/definitions/" + definition
+}
@@ -0,0 +1,68 @@
+package openapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/api/openapi"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGenerate(t *testing.T) {
+	// an app wiring the bank module, but not the staking one
+	qrt := baseapp.NewGRPCQueryRouter()
+	banktypes.RegisterQueryServer(qrt, &banktypes.UnimplementedQueryServer{})
+	tx.RegisterServiceServer(qrt, &tx.UnimplementedServiceServer{})
+	// a service without REST routes
+	testdata.RegisterQueryServer(qrt, testdata.QueryImpl{})
+
+	doc, err := openapi.Generate(openapi.Info{Title: "test", Version: "1.0.0"}, qrt)
+	require.NoError(t, err)
+	require.Equal(t, "2.0", doc.Swagger)
+
+	// the routes of the bank module are present, with their parameters
+	op := doc.Paths["/cosmos/bank/v1beta1/balances/{address}"]["get"]
+	require.NotNil(t, op)
+	require.Equal(t, "cosmos.bank.v1beta1.Query.AllBalances", op.OperationID)
+	require.Equal(t, []string{"cosmos.bank.v1beta1.Query"}, op.Tags)
+	require.Equal(t, openapi.Parameter{Name: "address", In: "path", Required: true, Type: "string"}, op.Parameters[0])
+	require.Contains(t, op.Parameters, openapi.Parameter{Name: "pagination.key", In: "query", Type: "string", Format: "byte"})
+	require.Contains(t, op.Parameters, openapi.Parameter{Name: "pagination.limit", In: "query", Type: "string", Format: "uint64"})
+	require.Equal(t, "#/definitions/cosmos.bank.v1beta1.QueryAllBalancesResponse", op.Responses["200"].Schema.Ref)
+	require.Equal(t, "#/definitions/grpc.gateway.runtime.Error", op.Responses["default"].Schema.Ref)
+
+	// along with the definitions of their messages
+	res := doc.Definitions["cosmos.bank.v1beta1.QueryAllBalancesResponse"]
+	require.NotNil(t, res)
+	require.Equal(t, &openapi.Schema{Type: "array", Items: &openapi.Schema{Ref: "#/definitions/cosmos.base.v1beta1.Coin"}}, res.Properties["balances"])
+	require.Equal(t, &openapi.Schema{Type: "string"}, doc.Definitions["cosmos.base.v1beta1.Coin"].Properties["amount"])
+
+	// the requests of the POST routes are their body
+	op = doc.Paths["/cosmos/tx/v1beta1/txs"]["post"]
+	require.NotNil(t, op)
+	require.Equal(t, []openapi.Parameter{{
+		Name:     "body",
+		In:       "body",
+		Required: true,
+		Schema:   &openapi.Schema{Ref: "#/definitions/cosmos.tx.v1beta1.BroadcastTxRequest"},
+	}}, op.Parameters)
+	require.Equal(t, []string{"BROADCAST_MODE_UNSPECIFIED", "BROADCAST_MODE_BLOCK", "BROADCAST_MODE_SYNC", "BROADCAST_MODE_ASYNC"},
+		doc.Definitions["cosmos.tx.v1beta1.BroadcastTxRequest"].Properties["mode"].Enum)
+
+	// the routes of the modules not wired in are absent
+	for path := range doc.Paths {
+		require.NotContains(t, path, "/cosmos/staking/")
+	}
+	require.NotContains(t, doc.Definitions, "cosmos.staking.v1beta1.QueryValidatorsResponse")
+
+	// unless they are
+	stakingtypes.RegisterQueryServer(qrt, &stakingtypes.UnimplementedQueryServer{})
+	doc, err = openapi.Generate(openapi.Info{Title: "test", Version: "1.0.0"}, qrt)
+	require.NoError(t, err)
+	require.Contains(t, doc.Paths, "/cosmos/staking/v1beta1/validators")
+}
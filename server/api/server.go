@@ -1,7 +1,10 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"strings"
@@ -13,10 +16,13 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/server/api/openapi"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 
@@ -24,12 +30,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
 )
 
+// OpenAPIPath is the path of the OpenAPI document of the REST routes, read by
+// the swagger UI.
+const OpenAPIPath = "/swagger/openapi.json"
+
 // Server defines the server's API interface.
 type Server struct {
 	Router            *mux.Router
 	GRPCGatewayRouter *runtime.ServeMux
 	ClientCtx         client.Context
 
+	// GRPCSrv is the gRPC server of the node, served to the gRPC-Web clients
+	// if enabled, or nil.
+	GRPCSrv *grpc.Server
+
 	logger   log.Logger
 	metrics  *telemetry.Metrics
 	listener net.Listener
@@ -44,12 +58,16 @@ func CustomGRPCHeaderMatcher(key string) (string, bool) {
 	switch strings.ToLower(key) {
 	case grpctypes.GRPCBlockHeightHeader:
 		return grpctypes.GRPCBlockHeightHeader, true
+	case grpctypes.GRPCMinBlockHeightHeader:
+		return grpctypes.GRPCMinBlockHeightHeader, true
 	default:
 		return runtime.DefaultHeaderMatcher(key)
 	}
 }
 
-func New(clientCtx client.Context, logger log.Logger) *Server {
+// New returns an API server, serving the gRPC-Web requests with the given gRPC
+// server if enabled, which may be nil.
+func New(clientCtx client.Context, logger log.Logger, grpcSrv *grpc.Server) *Server {
 	// The default JSON marshaller used by the gRPC-Gateway is unable to marshal non-nullable non-scalar fields.
 	// Using the gogo/gateway package with the gRPC-Gateway WithMarshaler option fixes the scalar field marshalling issue.
 	marshalerOption := &gateway.JSONPb{
@@ -62,6 +80,7 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 	return &Server{
 		Router:    mux.NewRouter(),
 		ClientCtx: clientCtx,
+		GRPCSrv:   grpcSrv,
 		logger:    logger,
 		GRPCGatewayRouter: runtime.NewServeMux(
 			// Custom marshaler option is required for gogo proto
@@ -109,13 +128,42 @@ func (s *Server) Start(cfg config.Config) error {
 	s.listener = listener
 	var h http.Handler = s.Router
 
-	if cfg.API.EnableUnsafeCORS {
-		allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-		return tmrpcserver.Serve(s.listener, allowAllCORS(h), s.logger, tmCfg)
+	if cfg.API.RPCMaxBodyBytes > 0 {
+		h = MaxBodyBytesMiddleware(int64(cfg.API.RPCMaxBodyBytes), h)
+	}
+
+	// the gRPC-Web requests, which may be streamed, are routed before the
+	// compression
+	if cfg.API.EnableGzip {
+		h = handlers.CompressHandler(h)
+	}
+
+	switch {
+	case cfg.API.EnableUnsafeCORS:
+		h = handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))(h)
+
+	case len(cfg.API.CORSAllowedOrigins) > 0:
+		h = handlers.CORS(
+			handlers.AllowedHeaders([]string{"Content-Type"}),
+			handlers.AllowedOrigins(cfg.API.CORSAllowedOrigins),
+		)(h)
+	}
+
+	if cfg.API.EnableGRPCWeb {
+		if s.GRPCSrv == nil {
+			return fmt.Errorf("gRPC-Web on the API server requires the gRPC server to be enabled")
+		}
+
+		h = grpcWebMux(WrapGRPCWeb(s.GRPCSrv, cfg.API.CORSAllowedOrigins, cfg.API.EnableUnsafeCORS), h)
+	}
+
+	limiter := ratelimit.NewLimiter(cfg.API.RateLimit, cfg.API.RateLimitBurst, cfg.API.RateLimitExemptLocalhost)
+	if limiter != nil {
+		h = RateLimitMiddleware(limiter, h)
 	}
 
 	s.logger.Info("starting API server...")
-	return tmrpcserver.Serve(s.listener, s.Router, s.logger, tmCfg)
+	return tmrpcserver.Serve(s.listener, h, s.logger, tmCfg)
 }
 
 // Close closes the API server.
@@ -127,6 +175,33 @@ func (s *Server) registerGRPCGatewayRoutes() {
 	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
 }
 
+// RegisterOpenAPIRoute generates the OpenAPI document of the REST routes of the
+// gRPC services, usually the ones registered on the query router of the app,
+// and serves it at OpenAPIPath. It must be registered before the swagger UI,
+// which is served under the same /swagger/ prefix.
+func (s *Server) RegisterOpenAPIRoute(services openapi.ServiceInfoProvider) error {
+	doc, err := openapi.Generate(openapi.Info{
+		Title:       "Cosmos SDK - gRPC Gateway docs",
+		Description: "A REST interface for state queries",
+		Version:     "1.0.0",
+	}, services)
+	if err != nil {
+		return fmt.Errorf("failed to generate the OpenAPI document: %w", err)
+	}
+
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	s.Router.HandleFunc(OpenAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}).Methods("GET")
+
+	return nil
+}
+
 func (s *Server) registerMetrics() {
 	metricsHandler := func(w http.ResponseWriter, r *http.Request) {
 		format := strings.TrimSpace(r.FormValue("format"))
@@ -144,6 +219,58 @@ func (s *Server) registerMetrics() {
 	s.Router.HandleFunc("/metrics", metricsHandler).Methods("GET")
 }
 
+// RateLimitMiddleware returns a handler answering with 429 Too Many Requests
+// the requests of the clients over the rate of the limiter, and passing the
+// others to h. Clients are identified by the remote address of their
+// connection, not by forwarding headers, which they could forge.
+func RateLimitMiddleware(limiter *ratelimit.Limiter, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !limiter.Allow(r.RemoteAddr) {
+			w.Header().Set("Retry-After", "1")
+			writeErrorResponse(w, http.StatusTooManyRequests, "rate limit exceeded, please retry later")
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// MaxBodyBytesMiddleware returns a handler answering with 413 Request Entity Too
+// Large the requests whose body exceeds maxBytes, and passing the others to h.
+// The bodies are read before h is called, so that the requests over the limit
+// are answered the same whether they declare their length or not.
+func MaxBodyBytesMiddleware(maxBytes int64, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > maxBytes {
+			writeBodyTooLargeResponse(w, maxBytes)
+			return
+		}
+
+		if r.Body != nil && r.Body != http.NoBody {
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxBytes+1))
+			switch {
+			// the server may already limit the body to maxBytes, failing to
+			// read more of it
+			case int64(len(body)) > maxBytes, err != nil && int64(len(body)) == maxBytes:
+				writeBodyTooLargeResponse(w, maxBytes)
+				return
+
+			case err != nil:
+				writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to read the request body: %s", err))
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func writeBodyTooLargeResponse(w http.ResponseWriter, maxBytes int64) {
+	writeErrorResponse(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body exceeds %d bytes", maxBytes))
+}
+
 // errorResponse defines the attributes of a JSON error response.
 type errorResponse struct {
 	Code  int    `json:"code,omitempty"`
@@ -0,0 +1,130 @@
+package api_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+	client  *http.Client
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 2
+	s.cfg = cfg
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	// a client leaving the responses as the server compressed them
+	s.client = &http.Client{Transport: &http.Transport{DisableCompression: true}}
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestGzip() {
+	val := s.network.Validators[0]
+	url := fmt.Sprintf("%s/cosmos/staking/v1beta1/validators", val.APIAddress)
+
+	// the response is not compressed by default
+	res, err := s.client.Get(url)
+	s.Require().NoError(err)
+	plain, err := io.ReadAll(res.Body)
+	s.Require().NoError(err)
+	res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	s.Require().Empty(res.Header.Get("Content-Encoding"))
+	s.Require().Contains(string(plain), val.ValAddress.String())
+
+	// but it is when requested
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	s.Require().NoError(err)
+	req.Header.Set("Accept-Encoding", "gzip")
+	res, err = s.client.Do(req)
+	s.Require().NoError(err)
+	compressed, err := io.ReadAll(res.Body)
+	s.Require().NoError(err)
+	res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	s.Require().Equal("gzip", res.Header.Get("Content-Encoding"))
+	s.Require().Less(len(compressed), len(plain))
+
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	s.Require().NoError(err)
+	decompressed, err := io.ReadAll(zr)
+	s.Require().NoError(err)
+	s.Require().Equal(string(plain), string(decompressed))
+}
+
+func (s *IntegrationTestSuite) TestGzipGRPCWeb() {
+	val := s.network.Validators[0]
+	msg, err := proto.Marshal(&banktypes.QueryBalanceRequest{Address: val.Address.String(), Denom: s.cfg.BondDenom})
+	s.Require().NoError(err)
+	frame := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	frame = append(frame, msg...)
+
+	req, err := http.NewRequest(
+		http.MethodPost, fmt.Sprintf("%s/cosmos.bank.v1beta1.Query/Balance", val.APIAddress),
+		bytes.NewReader([]byte(base64.StdEncoding.EncodeToString(frame))),
+	)
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/grpc-web-text")
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	// the gRPC-Web responses, which may be streamed, are not compressed
+	res, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	s.Require().Equal("application/grpc-web-text", res.Header.Get("Content-Type"))
+	s.Require().Empty(res.Header.Get("Content-Encoding"))
+}
+
+func (s *IntegrationTestSuite) TestBroadcastTxBodyTooLarge() {
+	val := s.network.Validators[0]
+	url := fmt.Sprintf("%s/cosmos/tx/v1beta1/txs", val.APIAddress)
+	txBytes := make([]byte, val.AppConfig.API.RPCMaxBodyBytes)
+	body := fmt.Sprintf(`{"tx_bytes":"%s","mode":"BROADCAST_MODE_SYNC"}`, base64.StdEncoding.EncodeToString(txBytes))
+
+	res, err := s.client.Post(url, "application/json", bytes.NewReader([]byte(body)))
+	s.Require().NoError(err)
+	res.Body.Close()
+	s.Require().Equal(http.StatusRequestEntityTooLarge, res.StatusCode)
+
+	// a body within the limit reaches the tx service
+	res, err = s.client.Post(url, "application/json", bytes.NewReader([]byte(`{"tx_bytes":"AA==","mode":"BROADCAST_MODE_SYNC"}`)))
+	s.Require().NoError(err)
+	res.Body.Close()
+	s.Require().NotEqual(http.StatusRequestEntityTooLarge, res.StatusCode)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
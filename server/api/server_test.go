@@ -0,0 +1,120 @@
+package api_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
+)
+
+// floodStatusCodes sends n concurrent requests to url, and returns the number
+// of responses of each status code.
+func floodStatusCodes(t *testing.T, url string, n int) map[int]int {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		codes = make(map[int]int)
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := http.Get(url)
+			require.NoError(t, err)
+			res.Body.Close()
+
+			mu.Lock()
+			codes[res.StatusCode]++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return codes
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	testCases := []struct {
+		name           string
+		exemptLoopback bool
+		expCodes       map[int]int
+	}{
+		{"limited", false, map[int]int{http.StatusOK: 10, http.StatusTooManyRequests: 40}},
+		{"localhost exempted", true, map[int]int{http.StatusOK: 50}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// a rate low enough for the burst not to be refilled during the test
+			limiter := ratelimit.NewLimiter(0.001, 10, tc.exemptLoopback)
+			srv := httptest.NewServer(api.RateLimitMiddleware(limiter, okHandler))
+			defer srv.Close()
+
+			require.Equal(t, tc.expCodes, floodStatusCodes(t, srv.URL, 50))
+		})
+	}
+}
+
+func TestRateLimitMiddlewareResponse(t *testing.T) {
+	limiter := ratelimit.NewLimiter(0.001, 1, false)
+	h := api.RateLimitMiddleware(limiter, http.NotFoundHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1", nil)
+	req.RemoteAddr = "203.0.113.1:1234"
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.JSONEq(t, `{"error":"rate limit exceeded, please retry later"}`, rec.Body.String())
+}
+
+func TestMaxBodyBytesMiddleware(t *testing.T) {
+	echoHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	})
+	srv := httptest.NewServer(api.MaxBodyBytesMiddleware(10, echoHandler))
+	defer srv.Close()
+
+	testCases := []struct {
+		name    string
+		body    io.Reader
+		expCode int
+	}{
+		{"within the limit", strings.NewReader("0123456789"), http.StatusOK},
+		{"declared length over the limit", strings.NewReader("0123456789a"), http.StatusRequestEntityTooLarge},
+		// a reader of unknown length is sent chunked
+		{"chunked body over the limit", io.MultiReader(strings.NewReader("0123456789"), strings.NewReader("a")), http.StatusRequestEntityTooLarge},
+		{"no body", nil, http.StatusOK},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := http.Post(srv.URL, "application/json", tc.body)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			require.Equal(t, tc.expCode, res.StatusCode)
+
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			if tc.expCode == http.StatusOK && tc.body != nil {
+				require.Equal(t, "0123456789", string(body))
+			}
+		})
+	}
+}
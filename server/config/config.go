@@ -2,10 +2,13 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/store/cache"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +23,22 @@ const (
 
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultGRPCMaxRecvMsgSize defines the default maximum size of the messages
+	// received by the gRPC server, the default of gRPC.
+	DefaultGRPCMaxRecvMsgSize = 1024 * 1024 * 4
+
+	// DefaultGRPCMaxSendMsgSize defines the default maximum size of the messages
+	// sent by the gRPC server, the default of gRPC.
+	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// DefaultGRPCSubscriptionBufferSize defines the default number of events
+	// buffered for each stream of the gRPC subscription methods.
+	DefaultGRPCSubscriptionBufferSize = 100
+
+	// DefaultGRPCSubscriptionBackpressure defines the default policy of the
+	// streams of the gRPC subscription methods whose buffer is full.
+	DefaultGRPCSubscriptionBackpressure = "disconnect"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -34,6 +53,11 @@ type BaseConfig struct {
 	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningOverrides sets the pruning strategy of single stores, overriding
+	// the one above, as <store name>:<strategy> with the strategy default,
+	// nothing or everything (e.g. "bank:nothing").
+	PruningOverrides []string `mapstructure:"pruning-overrides"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -66,9 +90,21 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// InterBlockCacheSize sets the number of entries kept in the inter-block
+	// cache of each store.
+	InterBlockCacheSize uint64 `mapstructure:"inter-block-cache-size"`
+
+	// IAVLCacheSize sets the number of nodes kept in the node cache of each IAVL
+	// store.
+	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
+
+	// IAVLDisableFastNode disables the fast node index of the IAVL stores,
+	// which are otherwise migrated to it, if needed, when the node starts.
+	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -82,6 +118,14 @@ type APIConfig struct {
 	// EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
 	EnableUnsafeCORS bool `mapstructure:"enabled-unsafe-cors"`
 
+	// CORSAllowedOrigins defines the origins allowed to send cross-origin
+	// requests to the REST API and the gRPC-Web servers
+	CORSAllowedOrigins []string `mapstructure:"cors-allowed-origins"`
+
+	// EnableGRPCWeb defines if the gRPC-Web requests should be served by the
+	// API server, next to the REST API (gRPC must also be enabled)
+	EnableGRPCWeb bool `mapstructure:"enable-grpc-web"`
+
 	// Address defines the API server to listen on
 	Address string `mapstructure:"address"`
 
@@ -94,9 +138,26 @@ type APIConfig struct {
 	// RPCWriteTimeout defines the Tendermint RPC write timeout (in seconds)
 	RPCWriteTimeout uint `mapstructure:"rpc-write-timeout"`
 
-	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
+	// RPCMaxBodyBytes defines the maximum size of the request bodies (in
+	// bytes), answered with 413 Request Entity Too Large beyond it
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// EnableGzip defines if the responses of the REST API should be gzip
+	// compressed for the clients accepting it
+	EnableGzip bool `mapstructure:"enable-gzip"`
+
+	// RateLimit defines the maximum number of requests per second of each
+	// client IP, answered with 429 Too Many Requests beyond it (0 = unlimited)
+	RateLimit float64 `mapstructure:"rate-limit"`
+
+	// RateLimitBurst defines the number of requests each client IP can send
+	// at once, beyond the rate limit
+	RateLimitBurst uint `mapstructure:"rate-limit-burst"`
+
+	// RateLimitExemptLocalhost defines if the requests of the loopback
+	// addresses are exempted from the rate limit
+	RateLimitExemptLocalhost bool `mapstructure:"rate-limit-exempt-localhost"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -132,6 +193,59 @@ type GRPCConfig struct {
 
 	// Address defines the API server to listen on
 	Address string `mapstructure:"address"`
+
+	// EnableMetrics defines if the count and the latency of the gRPC queries,
+	// served by the gRPC server or the gRPC gateway, should be emitted per
+	// method through telemetry.
+	EnableMetrics bool `mapstructure:"enable-metrics"`
+
+	// EnableReflection defines if the gRPC server reflection service, used by
+	// clients such as grpcurl to list the services of the node, should be
+	// registered.
+	EnableReflection bool `mapstructure:"enable-reflection"`
+
+	// EnableHealth defines if the grpc.health.v1 service, serving if the node
+	// is not catching up, should be registered.
+	EnableHealth bool `mapstructure:"enable-health"`
+
+	// MaxOpenConnections defines the number of maximum open connections
+	// (0 = unlimited).
+	MaxOpenConnections uint `mapstructure:"max-open-connections"`
+
+	// MaxConcurrentStreams defines the maximum number of concurrent streams,
+	// that is requests, of each connection (0 = unlimited).
+	MaxConcurrentStreams uint32 `mapstructure:"max-concurrent-streams"`
+
+	// MaxRecvMsgSize defines the maximum size of the messages the server can
+	// receive, in bytes (0 = the default of gRPC, 4MB).
+	MaxRecvMsgSize int `mapstructure:"max-recv-msg-size"`
+
+	// MaxSendMsgSize defines the maximum size of the messages the server can
+	// send, in bytes (0 = the default of gRPC, unlimited).
+	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// RateLimit defines the maximum number of requests per second of each
+	// client IP, answered with ResourceExhausted errors beyond it
+	// (0 = unlimited).
+	RateLimit float64 `mapstructure:"rate-limit"`
+
+	// RateLimitBurst defines the number of requests each client IP can send
+	// at once, beyond the rate limit.
+	RateLimitBurst uint `mapstructure:"rate-limit-burst"`
+
+	// RateLimitExemptLocalhost defines if the requests of the loopback
+	// addresses are exempted from the rate limit.
+	RateLimitExemptLocalhost bool `mapstructure:"rate-limit-exempt-localhost"`
+
+	// SubscriptionBufferSize defines the number of events buffered for each
+	// stream of the subscription methods, such as SubscribeBlocks, whose
+	// client receives them slower than they are published.
+	SubscriptionBufferSize uint `mapstructure:"subscription-buffer-size"`
+
+	// SubscriptionBackpressure defines what happens to the events of a stream
+	// whose buffer is full: "drop" drops them, "disconnect" ends the stream
+	// with a ResourceExhausted error.
+	SubscriptionBackpressure string `mapstructure:"subscription-backpressure"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -157,6 +271,43 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StoreConfig defines the configuration of the state streaming of the
+// application.
+type StoreConfig struct {
+	// Streamers defines the names of the streaming services the state changes
+	// of the blocks are streamed with, an empty list turning the streaming off.
+	Streamers []string `mapstructure:"streamers"`
+}
+
+// StreamersConfig defines the configuration of each streaming service.
+type StreamersConfig struct {
+	File FileStreamerConfig `mapstructure:"file"`
+}
+
+// FileStreamerConfig defines the configuration of the file streaming service.
+type FileStreamerConfig struct {
+	// Keys defines the names of the stores whose state changes are streamed,
+	// "*" selecting all of them.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir defines the directory the files are written to.
+	WriteDir string `mapstructure:"write_dir"`
+
+	// Prefix defines an optional prefix of the names of the files.
+	Prefix string `mapstructure:"prefix"`
+
+	// OutputMetadata defines if the ABCI requests and responses of the blocks
+	// are written out along with their state changes.
+	OutputMetadata bool `mapstructure:"output-metadata"`
+
+	// StopNodeOnError defines if the node stops when the files of a block
+	// cannot be written, instead of only logging the error.
+	StopNodeOnError bool `mapstructure:"stop-node-on-error"`
+
+	// Fsync defines if the files are synced to the disk before the next block.
+	Fsync bool `mapstructure:"fsync"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -168,6 +319,8 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
+	Streamers StreamersConfig  `mapstructure:"streamers"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -201,14 +354,17 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      defaultMinGasPrices,
-			InterBlockCache:   true,
-			Pruning:           storetypes.PruningOptionDefault,
-			PruningKeepRecent: "0",
-			PruningKeepEvery:  "0",
-			PruningInterval:   "0",
-			MinRetainBlocks:   0,
-			IndexEvents:       make([]string, 0),
+			MinGasPrices:        defaultMinGasPrices,
+			InterBlockCache:     true,
+			InterBlockCacheSize: uint64(cache.DefaultCommitKVStoreCacheSize),
+			IAVLCacheSize:       iavl.DefaultIAVLCacheSize,
+			Pruning:             storetypes.PruningOptionDefault,
+			PruningKeepRecent:   "0",
+			PruningKeepEvery:    "0",
+			PruningInterval:     "0",
+			PruningOverrides:    make([]string, 0),
+			MinRetainBlocks:     0,
+			IndexEvents:         make([]string, 0),
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -221,10 +377,20 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			EnableGzip:         true,
+			RateLimitBurst:     100,
+			CORSAllowedOrigins: make([]string, 0),
 		},
 		GRPC: GRPCConfig{
-			Enable:  true,
-			Address: DefaultGRPCAddress,
+			Enable:                   true,
+			Address:                  DefaultGRPCAddress,
+			EnableReflection:         true,
+			EnableHealth:             true,
+			MaxRecvMsgSize:           DefaultGRPCMaxRecvMsgSize,
+			MaxSendMsgSize:           DefaultGRPCMaxSendMsgSize,
+			RateLimitBurst:           100,
+			SubscriptionBufferSize:   DefaultGRPCSubscriptionBufferSize,
+			SubscriptionBackpressure: DefaultGRPCSubscriptionBackpressure,
 		},
 		Rosetta: RosettaConfig{
 			Enable:     false,
@@ -242,6 +408,16 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Store: StoreConfig{
+			Streamers: []string{},
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:           []string{"*"},
+				WriteDir:       "data/file_streamer",
+				OutputMetadata: true,
+			},
+		},
 	}
 }
 
@@ -256,18 +432,33 @@ func GetConfig(v *viper.Viper) Config {
 		}
 	}
 
+	// the app.toml files written before the subscription settings were added
+	// have none, which the defaults replace
+	subscriptionBufferSize := v.GetUint("grpc.subscription-buffer-size")
+	if subscriptionBufferSize == 0 {
+		subscriptionBufferSize = DefaultGRPCSubscriptionBufferSize
+	}
+	subscriptionBackpressure := v.GetString("grpc.subscription-backpressure")
+	if subscriptionBackpressure == "" {
+		subscriptionBackpressure = DefaultGRPCSubscriptionBackpressure
+	}
+
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			MinGasPrices:        v.GetString("minimum-gas-prices"),
+			InterBlockCache:     v.GetBool("inter-block-cache"),
+			InterBlockCacheSize: v.GetUint64("inter-block-cache-size"),
+			IAVLCacheSize:       v.GetUint64("iavl-cache-size"),
+			Pruning:             v.GetString("pruning"),
+			PruningKeepRecent:   v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:    v.GetString("pruning-keep-every"),
+			PruningInterval:     v.GetString("pruning-interval"),
+			PruningOverrides:    v.GetStringSlice("pruning-overrides"),
+			HaltHeight:          v.GetUint64("halt-height"),
+			HaltTime:            v.GetUint64("halt-time"),
+			IndexEvents:         v.GetStringSlice("index-events"),
+			MinRetainBlocks:     v.GetUint64("min-retain-blocks"),
+			IAVLDisableFastNode: v.GetBool("iavl-disable-fastnode"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -279,14 +470,20 @@ func GetConfig(v *viper.Viper) Config {
 			GlobalLabels:            globalLabels,
 		},
 		API: APIConfig{
-			Enable:             v.GetBool("api.enable"),
-			Swagger:            v.GetBool("api.swagger"),
-			Address:            v.GetString("api.address"),
-			MaxOpenConnections: v.GetUint("api.max-open-connections"),
-			RPCReadTimeout:     v.GetUint("api.rpc-read-timeout"),
-			RPCWriteTimeout:    v.GetUint("api.rpc-write-timeout"),
-			RPCMaxBodyBytes:    v.GetUint("api.rpc-max-body-bytes"),
-			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
+			Enable:                   v.GetBool("api.enable"),
+			Swagger:                  v.GetBool("api.swagger"),
+			Address:                  v.GetString("api.address"),
+			MaxOpenConnections:       v.GetUint("api.max-open-connections"),
+			RPCReadTimeout:           v.GetUint("api.rpc-read-timeout"),
+			RPCWriteTimeout:          v.GetUint("api.rpc-write-timeout"),
+			RPCMaxBodyBytes:          v.GetUint("api.rpc-max-body-bytes"),
+			EnableGzip:               v.GetBool("api.enable-gzip"),
+			EnableUnsafeCORS:         v.GetBool("api.enabled-unsafe-cors"),
+			CORSAllowedOrigins:       v.GetStringSlice("api.cors-allowed-origins"),
+			EnableGRPCWeb:            v.GetBool("api.enable-grpc-web"),
+			RateLimit:                v.GetFloat64("api.rate-limit"),
+			RateLimitBurst:           v.GetUint("api.rate-limit-burst"),
+			RateLimitExemptLocalhost: v.GetBool("api.rate-limit-exempt-localhost"),
 		},
 		Rosetta: RosettaConfig{
 			Enable:     v.GetBool("rosetta.enable"),
@@ -297,8 +494,20 @@ func GetConfig(v *viper.Viper) Config {
 			Offline:    v.GetBool("rosetta.offline"),
 		},
 		GRPC: GRPCConfig{
-			Enable:  v.GetBool("grpc.enable"),
-			Address: v.GetString("grpc.address"),
+			Enable:                   v.GetBool("grpc.enable"),
+			Address:                  v.GetString("grpc.address"),
+			EnableMetrics:            v.GetBool("grpc.enable-metrics"),
+			EnableReflection:         v.GetBool("grpc.enable-reflection"),
+			EnableHealth:             v.GetBool("grpc.enable-health"),
+			MaxOpenConnections:       v.GetUint("grpc.max-open-connections"),
+			MaxConcurrentStreams:     v.GetUint32("grpc.max-concurrent-streams"),
+			MaxRecvMsgSize:           v.GetInt("grpc.max-recv-msg-size"),
+			MaxSendMsgSize:           v.GetInt("grpc.max-send-msg-size"),
+			RateLimit:                v.GetFloat64("grpc.rate-limit"),
+			RateLimitBurst:           v.GetUint("grpc.rate-limit-burst"),
+			RateLimitExemptLocalhost: v.GetBool("grpc.rate-limit-exempt-localhost"),
+			SubscriptionBufferSize:   subscriptionBufferSize,
+			SubscriptionBackpressure: subscriptionBackpressure,
 		},
 		GRPCWeb: GRPCWebConfig{
 			Enable:           v.GetBool("grpc-web.enable"),
@@ -309,6 +518,19 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Store: StoreConfig{
+			Streamers: v.GetStringSlice("store.streamers"),
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:            v.GetStringSlice("streamers.file.keys"),
+				WriteDir:        v.GetString("streamers.file.write_dir"),
+				Prefix:          v.GetString("streamers.file.prefix"),
+				OutputMetadata:  v.GetBool("streamers.file.output-metadata"),
+				StopNodeOnError: v.GetBool("streamers.file.stop-node-on-error"),
+				Fsync:           v.GetBool("streamers.file.fsync"),
+			},
+		},
 	}
 }
 
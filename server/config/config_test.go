@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/store/cache"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -58,6 +62,26 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestCacheSizesWriteRead(t *testing.T) {
+	// the defaults are the sizes the stores were built with
+	conf := DefaultConfig()
+	require.Equal(t, uint64(cache.DefaultCommitKVStoreCacheSize), conf.InterBlockCacheSize)
+	require.Equal(t, uint64(iavl.DefaultIAVLCacheSize), conf.IAVLCacheSize)
+
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf.InterBlockCacheSize = 500
+	conf.IAVLCacheSize = 50000
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, uint64(500), cfg.InterBlockCacheSize)
+	require.Equal(t, uint64(50000), cfg.IAVLCacheSize)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
@@ -128,3 +152,26 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestGetConfigSubscriptionDefaults(t *testing.T) {
+	// an app.toml written before the subscription settings were added
+	var buf bytes.Buffer
+	require.NoError(t, configTemplate.Execute(&buf, DefaultConfig()))
+	var lines []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if !strings.HasPrefix(line, "subscription-") {
+			lines = append(lines, line)
+		}
+	}
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	require.NoError(t, os.WriteFile(confFile, []byte(strings.Join(lines, "\n")), 0o600))
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+	require.False(t, vpr.IsSet("grpc.subscription-buffer-size"))
+
+	cfg := GetConfig(vpr)
+	require.Equal(t, uint(DefaultGRPCSubscriptionBufferSize), cfg.GRPC.SubscriptionBufferSize)
+	require.Equal(t, DefaultGRPCSubscriptionBackpressure, cfg.GRPC.SubscriptionBackpressure)
+}
@@ -33,6 +33,12 @@ pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-keep-every = "{{ .BaseConfig.PruningKeepEvery }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningOverrides sets the pruning strategy of single stores, overriding the one above,
+# as <store name>:<strategy> with the strategy default, nothing or everything,
+# e.g. ["bank:nothing"] to keep the whole history of the bank store only.
+# The node fails to start if no store has one of the names.
+pruning-overrides = [{{ range .BaseConfig.PruningOverrides }}{{ printf "%q, " . }}{{end}}]
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -65,6 +71,14 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# InterBlockCacheSize sets the number of entries kept in the inter-block cache
+# of each store.
+inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
+
+# IAVLCacheSize sets the number of nodes kept in the node cache of each IAVL
+# store.
+iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -72,6 +86,11 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # ["message.sender", "message.recipient"]
 index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 
+# IAVLDisableFastNode disables the fast node index of the IAVL stores. When it is
+# enabled, the stores without it are migrated to it when the node starts, which
+# takes a while for large stores. The iavl-migrate command migrates them offline.
+iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -131,12 +150,44 @@ rpc-read-timeout = {{ .API.RPCReadTimeout }}
 # RPCWriteTimeout defines the Tendermint RPC write timeout (in seconds).
 rpc-write-timeout = {{ .API.RPCWriteTimeout }}
 
-# RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes).
+# RPCMaxBodyBytes defines the maximum size of the request bodies (in bytes),
+# answered with 413 Request Entity Too Large beyond it, e.g. for the txs
+# broadcast to /cosmos/tx/v1beta1/txs.
 rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 
+# EnableGzip defines if the responses of the REST API should be gzip compressed
+# for the clients sending an Accept-Encoding: gzip header. The gRPC-Web
+# responses are never compressed.
+enable-gzip = {{ .API.EnableGzip }}
+
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# CORSAllowedOrigins defines the origins allowed to send cross-origin requests
+# to the REST API and the gRPC-Web servers, ignored if enabled-unsafe-cors is set.
+#
+# Example:
+# ["https://app.example.com", "http://localhost:3000"]
+cors-allowed-origins = [{{ range .API.CORSAllowedOrigins }}{{ printf "%q, " . }}{{end}}]
+
+# EnableGRPCWeb defines if the gRPC-Web requests should be served by the API
+# server, next to the REST API, instead of or along with the gRPC-Web server.
+# NOTE: gRPC must also be enabled.
+enable-grpc-web = {{ .API.EnableGRPCWeb }}
+
+# RateLimit defines the maximum number of requests per second of each client IP,
+# answered with 429 Too Many Requests beyond it (0 = unlimited).
+rate-limit = {{ .API.RateLimit }}
+
+# RateLimitBurst defines the number of requests each client IP can send at once,
+# beyond the rate limit.
+rate-limit-burst = {{ .API.RateLimitBurst }}
+
+# RateLimitExemptLocalhost defines if the requests of the loopback addresses are
+# exempted from the rate limit. NOTE: behind a local reverse proxy, all the
+# requests come from a loopback address.
+rate-limit-exempt-localhost = {{ .API.RateLimitExemptLocalhost }}
+
 ###############################################################################
 ###                           Rosetta Configuration                         ###
 ###############################################################################
@@ -173,6 +224,57 @@ enable = {{ .GRPC.Enable }}
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
 
+# EnableMetrics defines if the count and the latency of the gRPC queries, served
+# by the gRPC server or the gRPC gateway, should be emitted per method through
+# telemetry. NOTE: telemetry must also be enabled to collect them.
+enable-metrics = {{ .GRPC.EnableMetrics }}
+
+# EnableReflection defines if the gRPC server reflection service, used by clients
+# such as grpcurl to list the services of the node, should be registered.
+enable-reflection = {{ .GRPC.EnableReflection }}
+
+# EnableHealth defines if the grpc.health.v1 service, serving if the node is not
+# catching up, should be registered.
+enable-health = {{ .GRPC.EnableHealth }}
+
+# MaxOpenConnections defines the number of maximum open connections (0 = unlimited).
+max-open-connections = {{ .GRPC.MaxOpenConnections }}
+
+# MaxConcurrentStreams defines the maximum number of concurrent streams, that is
+# requests, of each connection (0 = unlimited).
+max-concurrent-streams = {{ .GRPC.MaxConcurrentStreams }}
+
+# MaxRecvMsgSize defines the maximum size of the messages the server can receive,
+# in bytes (0 = the default of gRPC, 4MB).
+max-recv-msg-size = {{ .GRPC.MaxRecvMsgSize }}
+
+# MaxSendMsgSize defines the maximum size of the messages the server can send,
+# in bytes (0 = the default of gRPC, unlimited).
+max-send-msg-size = {{ .GRPC.MaxSendMsgSize }}
+
+# RateLimit defines the maximum number of requests per second of each client IP,
+# answered with ResourceExhausted errors beyond it (0 = unlimited).
+rate-limit = {{ .GRPC.RateLimit }}
+
+# RateLimitBurst defines the number of requests each client IP can send at once,
+# beyond the rate limit.
+rate-limit-burst = {{ .GRPC.RateLimitBurst }}
+
+# RateLimitExemptLocalhost defines if the requests of the loopback addresses are
+# exempted from the rate limit. NOTE: behind a local reverse proxy, all the
+# requests come from a loopback address.
+rate-limit-exempt-localhost = {{ .GRPC.RateLimitExemptLocalhost }}
+
+# SubscriptionBufferSize defines the number of events buffered for each stream of
+# the subscription methods, such as SubscribeBlocks, whose client receives them
+# slower than they are published.
+subscription-buffer-size = {{ .GRPC.SubscriptionBufferSize }}
+
+# SubscriptionBackpressure defines what happens to the events of a stream whose
+# buffer is full: "drop" drops them, "disconnect" ends the stream with a
+# ResourceExhausted error, for the client to subscribe again.
+subscription-backpressure = "{{ .GRPC.SubscriptionBackpressure }}"
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -187,6 +289,7 @@ enable = {{ .GRPCWeb.Enable }}
 address = "{{ .GRPCWeb.Address }}"
 
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
+# Otherwise, the origins of api.cors-allowed-origins are allowed.
 enable-unsafe-cors = {{ .GRPCWeb.EnableUnsafeCORS }}
 
 ###############################################################################
@@ -203,6 +306,44 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                         State Streaming Configuration                   ###
+###############################################################################
+
+# The state streaming writes out the state changes of each block, with its ABCI
+# requests and responses, for the off-chain services indexing the state.
+[store]
+
+# streamers defines the names of the streaming services the state changes are
+# streamed with, e.g. ["file"]. An empty list turns the state streaming off.
+streamers = [{{ range .Store.Streamers }}{{ printf "%q, " . }}{{end}}]
+
+[streamers]
+
+[streamers.file]
+
+# keys defines the names of the stores whose state changes are streamed, "*"
+# selecting all of them.
+keys = [{{ range .Streamers.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# write_dir defines the directory the files of the blocks are written to,
+# relative to the node home directory if not absolute.
+write_dir = "{{ .Streamers.File.WriteDir }}"
+
+# prefix defines an optional prefix of the names of the files.
+prefix = "{{ .Streamers.File.Prefix }}"
+
+# output-metadata defines if the ABCI requests and responses of the blocks are
+# written out along with their state changes.
+output-metadata = {{ .Streamers.File.OutputMetadata }}
+
+# stop-node-on-error defines if the node stops when the files of a block cannot
+# be written (guaranteed delivery), instead of only logging the error.
+stop-node-on-error = {{ .Streamers.File.StopNodeOnError }}
+
+# fsync defines if the files are synced to the disk before the next block.
+fsync = {{ .Streamers.File.Fsync }}
 `
 
 var configTemplate *template.Template
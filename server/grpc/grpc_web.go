@@ -5,25 +5,17 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"google.golang.org/grpc"
 
+	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
 )
 
-// StartGRPCWeb starts a gRPC-Web server on the given address.
+// StartGRPCWeb starts a gRPC-Web server on the given address, accepting the
+// cross-origin requests of the CORS allowed origins of the API server.
 func StartGRPCWeb(grpcSrv *grpc.Server, config config.Config) (*http.Server, error) {
-	var options []grpcweb.Option
-	if config.GRPCWeb.EnableUnsafeCORS {
-		options = append(options,
-			grpcweb.WithOriginFunc(func(origin string) bool {
-				return true
-			}),
-		)
-	}
-
-	wrappedServer := grpcweb.WrapServer(grpcSrv, options...)
+	wrappedServer := api.WrapGRPCWeb(grpcSrv, config.API.CORSAllowedOrigins, config.GRPCWeb.EnableUnsafeCORS)
 	grpcWebSrv := &http.Server{
 		Addr:    config.GRPCWeb.Address,
 		Handler: wrappedServer,
@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/textproto"
+	"net/url"
 	"strconv"
 	"strings"
 	"testing"
@@ -22,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -97,6 +99,34 @@ func (s *GRPCWebTestSuite) Test_Total_Supply() {
 	}
 }
 
+func (s *GRPCWebTestSuite) Test_Balance() {
+	val := s.network.Validators[0]
+	apiURL, err := url.Parse(val.APIAddress)
+	s.Require().NoError(err)
+
+	for _, addr := range []string{
+		val.AppConfig.GRPCWeb.Address, // the gRPC-Web server
+		apiURL.Host,                   // the gRPC-Web requests multiplexed onto the API server
+	} {
+		headers, trailers, responses, err := s.makeGrpcRequestAt(
+			addr,
+			"/cosmos.bank.v1beta1.Query/Balance",
+			headerWithFlag(),
+			serializeProtoMessages([]proto.Message{&banktypes.QueryBalanceRequest{
+				Address: val.Address.String(),
+				Denom:   fmt.Sprintf("%stoken", val.Moniker),
+			}}), false)
+
+		s.Require().NoError(err)
+		s.Require().Equal(1, len(responses))
+		s.assertTrailerGrpcCode(trailers, codes.OK, "")
+		s.assertContentTypeSet(headers, grpcWebContentType)
+		var balance banktypes.QueryBalanceResponse
+		s.Require().NoError(s.protoCdc.Unmarshal(responses[0], &balance))
+		s.Require().Equal(sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens), *balance.Balance)
+	}
+}
+
 func (s *GRPCWebTestSuite) assertContentTypeSet(headers http.Header, contentType string) {
 	s.Require().Equal(contentType, headers.Get("content-type"), `Expected there to be content-type=%v`, contentType)
 }
@@ -119,9 +149,8 @@ func serializeProtoMessages(messages []proto.Message) [][]byte {
 }
 
 func (s *GRPCWebTestSuite) makeRequest(
-	verb string, method string, headers http.Header, body io.Reader, isText bool,
+	addr string, verb string, method string, headers http.Header, body io.Reader, isText bool,
 ) (*http.Response, error) {
-	val := s.network.Validators[0]
 	contentType := "application/grpc-web"
 	if isText {
 		// base64 encode the body
@@ -139,8 +168,7 @@ func (s *GRPCWebTestSuite) makeRequest(
 		contentType = "application/grpc-web-text"
 	}
 
-	url := fmt.Sprintf("http://%s%s", val.AppConfig.GRPCWeb.Address, method)
-	req, err := http.NewRequest(verb, url, body)
+	req, err := http.NewRequest(verb, fmt.Sprintf("http://%s%s", addr, method), body)
 	s.Require().NoError(err, "failed creating a request")
 	req.Header = headers
 
@@ -183,6 +211,13 @@ func decodeMultipleBase64Chunks(b []byte) ([]byte, error) {
 
 func (s *GRPCWebTestSuite) makeGrpcRequest(
 	method string, reqHeaders http.Header, requestMessages [][]byte, isText bool,
+) (headers http.Header, trailers Trailer, responseMessages [][]byte, err error) {
+	return s.makeGrpcRequestAt(s.network.Validators[0].AppConfig.GRPCWeb.Address, method, reqHeaders, requestMessages, isText)
+}
+
+// makeGrpcRequestAt sends a gRPC-Web request to the server of the given address.
+func (s *GRPCWebTestSuite) makeGrpcRequestAt(
+	addr string, method string, reqHeaders http.Header, requestMessages [][]byte, isText bool,
 ) (headers http.Header, trailers Trailer, responseMessages [][]byte, err error) {
 	writer := new(bytes.Buffer)
 	for _, msgBytes := range requestMessages {
@@ -191,7 +226,7 @@ func (s *GRPCWebTestSuite) makeGrpcRequest(
 		writer.Write(grpcPreamble)
 		writer.Write(msgBytes)
 	}
-	resp, err := s.makeRequest("POST", method, reqHeaders, writer, isText)
+	resp, err := s.makeRequest(addr, "POST", method, reqHeaders, writer, isText)
 	if err != nil {
 		return nil, Trailer{}, nil, err
 	}
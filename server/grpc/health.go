@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthWatchInterval is the interval at which the status of the node is
+// polled for the watchers of the health service.
+var healthWatchInterval = 5 * time.Second
+
+// healthServer implements the grpc.health.v1 service of a node: the node is
+// serving if it is not catching up with the chain, according to the status of
+// its Tendermint node.
+type healthServer struct {
+	srv    *grpc.Server
+	client rpcclient.StatusClient
+}
+
+var _ healthpb.HealthServer = healthServer{}
+
+// RegisterHealthServer registers on the gRPC server the health service of a
+// node, querying its status with the given Tendermint client. The service
+// reports the overall health of the node, for the empty service name, and the
+// health of each of the services of the gRPC server, which are the same.
+func RegisterHealthServer(srv *grpc.Server, client rpcclient.StatusClient) {
+	healthpb.RegisterHealthServer(srv, healthServer{srv: srv, client: client})
+}
+
+// Check implements the Check method of the grpc.health.v1 service.
+func (h healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if err := h.checkService(req.Service); err != nil {
+		return nil, err
+	}
+
+	return &healthpb.HealthCheckResponse{Status: h.servingStatus(ctx)}, nil
+}
+
+// Watch implements the Watch method of the grpc.health.v1 service, sending the
+// status of the node then each of its changes.
+func (h healthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	if err := h.checkService(req.Service); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		if current := h.servingStatus(stream.Context()); current != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkService returns a NotFound error if the service is neither empty nor a
+// service of the gRPC server.
+func (h healthServer) checkService(service string) error {
+	if service == "" {
+		return nil
+	}
+	if _, ok := h.srv.GetServiceInfo()[service]; !ok {
+		return status.Errorf(codes.NotFound, "unknown service %s", service)
+	}
+
+	return nil
+}
+
+// servingStatus returns the status of the node, which is not serving if its
+// status cannot be queried or if it is catching up.
+func (h healthServer) servingStatus(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	if h.client == nil {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	res, err := h.client.Status(ctx)
+	if err != nil || res.SyncInfo.CatchingUp {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_SERVING
+}
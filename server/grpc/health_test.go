@@ -0,0 +1,84 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+)
+
+// mockStatusClient returns a status catching up or not, or an error.
+type mockStatusClient struct {
+	catchingUp bool
+	err        error
+}
+
+func (c *mockStatusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{CatchingUp: c.catchingUp}}, nil
+}
+
+func TestHealthServer(t *testing.T) {
+	statusClient := &mockStatusClient{}
+	srv := grpc.NewServer()
+	servergrpc.RegisterHealthServer(srv, statusClient)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(listener)
+	defer srv.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	healthClient := healthpb.NewHealthClient(conn)
+
+	testCases := []struct {
+		name       string
+		service    string
+		catchingUp bool
+		statusErr  error
+		expStatus  healthpb.HealthCheckResponse_ServingStatus
+		expCode    codes.Code
+	}{
+		{"in sync", "", false, nil, healthpb.HealthCheckResponse_SERVING, codes.OK},
+		{"in sync, service of the server", "grpc.health.v1.Health", false, nil, healthpb.HealthCheckResponse_SERVING, codes.OK},
+		{"catching up", "", true, nil, healthpb.HealthCheckResponse_NOT_SERVING, codes.OK},
+		{"status error", "", false, errors.New("node down"), healthpb.HealthCheckResponse_NOT_SERVING, codes.OK},
+		{"unknown service", "cosmos.unknown.v1beta1.Query", false, nil, healthpb.HealthCheckResponse_UNKNOWN, codes.NotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statusClient.catchingUp, statusClient.err = tc.catchingUp, tc.statusErr
+
+			res, err := healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: tc.service})
+			if tc.expCode != codes.OK {
+				require.Equal(t, tc.expCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, res.Status)
+
+			// watching sends the current status first
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stream, err := healthClient.Watch(ctx, &healthpb.HealthCheckRequest{Service: tc.service})
+			require.NoError(t, err)
+			res, err = stream.Recv()
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, res.Status)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// the keys and labels of the metrics of the gRPC methods
+const (
+	MetricKeyGRPCRequests = "grpc_requests"
+	MetricKeyGRPCLatency  = "grpc_latency"
+	MetricLabelNameMethod = "method"
+	MetricLabelNameCode   = "code"
+)
+
+// MetricsUnaryInterceptor returns an interceptor emitting through the telemetry
+// package the count and the latency of the unary gRPC requests, labeled by
+// method and status code.
+func MetricsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		emitMethodMetrics(info.FullMethod, start, err)
+
+		return res, err
+	}
+}
+
+// MetricsStreamInterceptor returns an interceptor emitting through the
+// telemetry package the count and the duration of the gRPC streams, labeled by
+// method and status code.
+func MetricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		emitMethodMetrics(info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+func emitMethodMetrics(method string, start time.Time, err error) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(MetricLabelNameMethod, method),
+		telemetry.NewLabel(MetricLabelNameCode, status.Code(err).String()),
+	}
+
+	telemetry.IncrCounterWithLabels([]string{MetricKeyGRPCRequests}, 1, labels)
+	telemetry.MeasureSinceWithLabels([]string{MetricKeyGRPCLatency}, start, labels)
+}
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
+)
+
+// RateLimitUnaryInterceptor returns an interceptor rejecting with a
+// ResourceExhausted error the unary requests of the clients over the rate of
+// the limiter.
+func RateLimitUnaryInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkRateLimit(ctx, limiter); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamInterceptor returns an interceptor rejecting with a
+// ResourceExhausted error the streams of the clients over the rate of the
+// limiter.
+func RateLimitStreamInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkRateLimit(ss.Context(), limiter); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func checkRateLimit(ctx context.Context, limiter *ratelimit.Limiter) error {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+
+	if !limiter.Allow(addr) {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded, please retry later")
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+// freeAddress returns a local address with a free port.
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	return listener.Addr().String()
+}
+
+// floodStatusCodes sends n concurrent echo queries, and returns the number of
+// responses of each status code.
+func floodStatusCodes(t *testing.T, queryClient testdata.QueryClient, n int) map[codes.Code]int {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		codes = make(map[codes.Code]int)
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := queryClient.Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+
+			mu.Lock()
+			codes[status.Code(err)]++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return codes
+}
+
+func TestGRPCServerLimits(t *testing.T) {
+	app := simapp.Setup(t, false)
+
+	testCases := []struct {
+		name           string
+		exemptLoopback bool
+		expCodes       map[codes.Code]int
+	}{
+		{"limited", false, map[codes.Code]int{codes.OK: 10, codes.ResourceExhausted: 40}},
+		{"localhost exempted", true, map[codes.Code]int{codes.OK: 50}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.DefaultConfig().GRPC
+			cfg.Address = freeAddress(t)
+			cfg.EnableReflection = false
+			cfg.EnableHealth = false
+			cfg.MaxRecvMsgSize = 1024
+			// a rate low enough for the burst not to be refilled during the test
+			cfg.RateLimit = 0.001
+			cfg.RateLimitBurst = 10
+			cfg.RateLimitExemptLocalhost = tc.exemptLoopback
+
+			srv, err := servergrpc.StartGRPCServer(client.Context{}, app, cfg)
+			require.NoError(t, err)
+			defer srv.Stop()
+
+			conn, err := grpc.Dial(cfg.Address, grpc.WithInsecure())
+			require.NoError(t, err)
+			defer conn.Close()
+			queryClient := testdata.NewQueryClient(conn)
+
+			require.Equal(t, tc.expCodes, floodStatusCodes(t, queryClient, 50))
+
+			// the messages over the maximum size are rejected
+			_, err = queryClient.Echo(context.Background(), &testdata.EchoRequest{Message: strings.Repeat("a", 2048)})
+			require.Equal(t, codes.ResourceExhausted, status.Code(err))
+			require.Contains(t, err.Error(), "larger than max")
+		})
+	}
+}
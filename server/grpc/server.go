@@ -5,43 +5,70 @@ import (
 	"net"
 	"time"
 
+	"golang.org/x/net/netutil"
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/subscription"
+	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// StartGRPCServer starts a gRPC server on the given address.
-func StartGRPCServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
-	grpcSrv := grpc.NewServer()
-	app.RegisterGRPCServer(grpcSrv)
-	// reflection allows consumers to build dynamic clients that can write
-	// to any cosmos-sdk application without relying on application packages at compile time
-	err := reflection.Register(grpcSrv, reflection.Config{
-		SigningModes: func() map[string]int32 {
-			modes := make(map[string]int32, len(clientCtx.TxConfig.SignModeHandler().Modes()))
-			for _, m := range clientCtx.TxConfig.SignModeHandler().Modes() {
-				modes[m.String()] = (int32)(m)
-			}
-			return modes
-		}(),
-		ChainID:           clientCtx.ChainID,
-		SdkConfig:         sdk.GetConfig(),
-		InterfaceRegistry: clientCtx.InterfaceRegistry,
-	})
-	if err != nil {
+// StartGRPCServer starts a gRPC server on the address of the given
+// configuration, along with its reflection and health services if enabled,
+// and with its connection, stream, message size and rate limits.
+func StartGRPCServer(clientCtx client.Context, app types.Application, cfg config.GRPCConfig) (*grpc.Server, error) {
+	subscriptionCfg := subscription.Config{
+		BufferSize:   cfg.SubscriptionBufferSize,
+		Backpressure: cfg.SubscriptionBackpressure,
+	}
+	if err := subscriptionCfg.Validate(); err != nil {
 		return nil, err
 	}
-	// Reflection allows external clients to see what services and methods
-	// the gRPC server exposes.
-	gogoreflection.Register(grpcSrv)
-	listener, err := net.Listen("tcp", address)
+
+	grpcSrv := grpc.NewServer(serverOptions(cfg, subscriptionCfg)...)
+	app.RegisterGRPCServer(grpcSrv)
+
+	if cfg.EnableReflection {
+		// reflection allows consumers to build dynamic clients that can write
+		// to any cosmos-sdk application without relying on application packages at compile time
+		err := reflection.Register(grpcSrv, reflection.Config{
+			SigningModes: func() map[string]int32 {
+				modes := make(map[string]int32, len(clientCtx.TxConfig.SignModeHandler().Modes()))
+				for _, m := range clientCtx.TxConfig.SignModeHandler().Modes() {
+					modes[m.String()] = (int32)(m)
+				}
+				return modes
+			}(),
+			ChainID:           clientCtx.ChainID,
+			SdkConfig:         sdk.GetConfig(),
+			InterfaceRegistry: clientCtx.InterfaceRegistry,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Reflection allows external clients to see what services and methods
+		// the gRPC server exposes.
+		gogoreflection.Register(grpcSrv)
+	}
+
+	if cfg.EnableHealth {
+		// The health service allows load balancers to check if the node is
+		// in sync with the chain.
+		RegisterHealthServer(grpcSrv, clientCtx.Client)
+	}
+
+	listener, err := net.Listen("tcp", cfg.Address)
 	if err != nil {
 		return nil, err
 	}
+	if cfg.MaxOpenConnections > 0 {
+		listener = netutil.LimitListener(listener, int(cfg.MaxOpenConnections))
+	}
 
 	errCh := make(chan error)
 	go func() {
@@ -58,3 +85,27 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, address st
 		return grpcSrv, nil
 	}
 }
+
+// serverOptions returns the options of the gRPC server of the configuration,
+// leaving the defaults of gRPC for its zero values.
+func serverOptions(cfg config.GRPCConfig, subscriptionCfg subscription.Config) []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if cfg.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(cfg.MaxConcurrentStreams))
+	}
+	if cfg.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize))
+	}
+	if cfg.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(cfg.MaxSendMsgSize))
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{subscription.StreamServerInterceptor(subscriptionCfg)}
+	if limiter := ratelimit.NewLimiter(cfg.RateLimit, cfg.RateLimitBurst, cfg.RateLimitExemptLocalhost); limiter != nil {
+		opts = append(opts, grpc.UnaryInterceptor(RateLimitUnaryInterceptor(limiter)))
+		streamInterceptors = append([]grpc.StreamServerInterceptor{RateLimitStreamInterceptor(limiter)}, streamInterceptors...)
+	}
+	opts = append(opts, grpc.ChainStreamInterceptor(streamInterceptors...))
+
+	return opts
+}
@@ -5,6 +5,7 @@ package grpc_test
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,17 +13,25 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	dbm "github.com/tendermint/tm-db"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	reflectionv1 "github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	reflectionv2 "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/testutil/rest"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
@@ -41,6 +50,45 @@ type IntegrationTestSuite struct {
 	cfg     network.Config
 	network *network.Network
 	conn    *grpc.ClientConn
+
+	// the methods seen by the interceptor of the apps of the network
+	recorder *methodRecorder
+}
+
+// methodRecorder records the methods of the gRPC queries it intercepts.
+type methodRecorder struct {
+	mu      sync.Mutex
+	methods []string
+}
+
+func (r *methodRecorder) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	r.mu.Lock()
+	r.methods = append(r.methods, info.FullMethod)
+	r.mu.Unlock()
+
+	return handler(ctx, req)
+}
+
+// reset clears the recorded methods.
+func (r *methodRecorder) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.methods = nil
+}
+
+// count returns the number of recorded queries of the method.
+func (r *methodRecorder) count(method string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, m := range r.methods {
+		if m == method {
+			n++
+		}
+	}
+
+	return n
 }
 
 func (s *IntegrationTestSuite) SetupSuite() {
@@ -49,6 +97,19 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.cfg = network.DefaultConfig()
 	s.cfg.NumValidators = 1
 
+	s.recorder = &methodRecorder{}
+	encCfg := simapp.MakeTestEncodingConfig()
+	s.cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return simapp.NewSimApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encCfg,
+			simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+			baseapp.SetGRPCUnaryInterceptors(s.recorder.intercept),
+		)
+	}
+
 	var err error
 	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
 	s.Require().NoError(err)
@@ -109,6 +170,28 @@ func (s *IntegrationTestSuite) TestGRPCServer_BankBalance() {
 	s.Require().Equal([]string{"1"}, blockHeight)
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_Interceptors() {
+	val0 := s.network.Validators[0]
+	denom := fmt.Sprintf("%stoken", val0.Moniker)
+	method := "/cosmos.bank.v1beta1.Query/Balance"
+	s.recorder.reset()
+
+	// the interceptor sees the queries of the gRPC server
+	bankClient := banktypes.NewQueryClient(s.conn)
+	_, err := bankClient.Balance(context.Background(), &banktypes.QueryBalanceRequest{Address: val0.Address.String(), Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(1, s.recorder.count(method))
+
+	// and the queries of the gRPC gateway, routed through ABCI queries
+	url := fmt.Sprintf("%s/cosmos/bank/v1beta1/balances/%s/by_denom?denom=%s", val0.APIAddress, val0.Address, denom)
+	resp, err := rest.GetRequest(url)
+	s.Require().NoError(err)
+	var balanceRes banktypes.QueryBalanceResponse
+	s.Require().NoError(val0.ClientCtx.Codec.UnmarshalJSON(resp, &balanceRes))
+	s.Require().Equal(sdk.NewCoin(denom, s.network.Config.AccountTokens), *balanceRes.Balance)
+	s.Require().Equal(2, s.recorder.count(method))
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	// Test server reflection
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -123,6 +206,9 @@ func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	services, err := rc.ListServices()
 	s.Require().NoError(err)
 	s.Require().Greater(len(services), 0)
+	s.Require().Contains(services, "cosmos.bank.v1beta1.Query")
+	s.Require().Contains(services, "cosmos.staking.v1beta1.Query")
+	s.Require().Contains(services, "grpc.health.v1.Health")
 
 	for _, svc := range services {
 		file, err := rc.FileContainingSymbol(svc)
@@ -132,6 +218,22 @@ func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_Health() {
+	healthClient := healthpb.NewHealthClient(s.conn)
+
+	// the node of the network is in sync
+	res, err := healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(healthpb.HealthCheckResponse_SERVING, res.Status)
+
+	res, err = healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "cosmos.bank.v1beta1.Query"})
+	s.Require().NoError(err)
+	s.Require().Equal(healthpb.HealthCheckResponse_SERVING, res.Status)
+
+	_, err = healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "cosmos.unknown.v1beta1.Query"})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_InterfaceReflection() {
 	// this tests the application reflection capabilities and compatibility between v1 and v2
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
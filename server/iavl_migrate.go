@@ -0,0 +1,41 @@
+package server
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// IAVLMigrateCmd creates a command migrating the IAVL stores of the
+// application to the fast node index offline.
+func IAVLMigrateCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iavl-migrate",
+		Short: "Migrate the IAVL stores of the application to the fast node index",
+		Long: `Migrate the IAVL stores of the application to the fast node index, which speeds up the reads
+and iterations of the latest state. The stores without it are otherwise migrated when the node
+starts, unless iavl-disable-fastnode is set, which takes a while for large stores: the command
+runs the migration ahead of restarting the node, reporting its progress, keys migrated and
+estimated time left, for each store. The stores already migrated are left untouched.
+
+The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// the stores are migrated while the application loads them
+			serverCtx := GetServerContextFromCmd(cmd)
+			serverCtx.Viper.Set(FlagDisableIAVLFastNode, false)
+
+			return withApp(cmd, appCreator, func(app types.Application) error {
+				commitID := app.CommitMultiStore().LastCommitID()
+				cmd.Printf("Migrated the IAVL stores to the fast node index at height %d\n", commitID.Version)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/iavl"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// isStoreMigrated returns whether the IAVL store with the given name of the
+// node at home has the fast node index.
+func isStoreMigrated(t *testing.T, home, name string) bool {
+	db := openAppDB(t, home)
+	defer db.Close()
+
+	tree, err := iavl.NewMutableTree(dbm.NewPrefixDB(db, []byte("s/k:"+name+"/")), 100, false)
+	require.NoError(t, err)
+	isUpgradeable, err := tree.IsUpgradeable()
+	require.NoError(t, err)
+	return !isUpgradeable
+}
+
+func TestIAVLMigrateCmd(t *testing.T) {
+	home := t.TempDir()
+	appOpts := viper.New()
+	appOpts.Set(server.FlagDisableIAVLFastNode, true)
+	setupLevelDBAppWithOpts(t, home, appOpts)
+	require.False(t, isStoreMigrated(t, home, banktypes.StoreKey))
+
+	// the stores are migrated even if the node disables the fast node index
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.RootDir = home
+	serverCtx.Viper.Set(server.FlagDisableIAVLFastNode, true)
+
+	var out bytes.Buffer
+	cmd := server.IAVLMigrateCmd(newLevelDBApp, home)
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flags.FlagHome, home)})
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), server.ServerContextKey, serverCtx)))
+	require.Equal(t, "Migrated the IAVL stores to the fast node index at height 5\n", out.String())
+	require.True(t, isStoreMigrated(t, home, banktypes.StoreKey))
+
+	// and the application has the same state
+	db := openAppDB(t, home)
+	defer db.Close()
+	app := newLevelDBApp(log.NewNopLogger(), db, nil, simapp.EmptyAppOptions{}).(*simapp.SimApp)
+	require.Equal(t, int64(5), app.LastBlockHeight())
+	ctx := app.NewContext(true, tmproto.Header{Height: 5})
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4), app.BankKeeper.GetBalance(ctx, fundedAddr, sdk.DefaultBondDenom))
+}
@@ -3,6 +3,7 @@ package mock
 import (
 	"io"
 
+	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -71,6 +72,14 @@ func (ms multiStore) GetPruning() sdk.PruningOptions {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetPruningOverride(name string, opts sdk.PruningOptions) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetIAVLCacheSize(size int) {
+	panic("not implemented")
+}
+
 func (ms multiStore) GetCommitKVStore(key storetypes.StoreKey) storetypes.CommitKVStore {
 	panic("not implemented")
 }
@@ -119,6 +128,18 @@ func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetIAVLDisableFastNode(disable bool) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetLogger(logger log.Logger) {
+	panic("not implemented")
+}
+
+func (ms multiStore) RollbackToVersion(version int64) error {
+	panic("not implemented")
+}
+
 func (ms multiStore) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
 	panic("not implemented")
 }
@@ -38,3 +38,32 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (storetypes.PruningOpt
 		return store.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// GetPruningOverridesFromFlags parses the pruning overrides flag, a list of
+// <store name>:<strategy> overrides where the strategy is default, nothing or
+// everything, and returns the PruningOptions of the overridden stores by
+// name. The store names are checked when the app loads its stores.
+func GetPruningOverridesFromFlags(appOpts types.AppOptions) (map[string]storetypes.PruningOptions, error) {
+	overrides := make(map[string]storetypes.PruningOptions)
+	for _, override := range cast.ToStringSlice(appOpts.Get(FlagPruningOverrides)) {
+		parts := strings.Split(strings.TrimSpace(override), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid pruning override %q, expected <store name>:<strategy>", override)
+		}
+
+		name, strategy := parts[0], strings.ToLower(parts[1])
+		if _, ok := overrides[name]; ok {
+			return nil, fmt.Errorf("duplicate pruning override of store %s", name)
+		}
+
+		switch strategy {
+		case storetypes.PruningOptionDefault, storetypes.PruningOptionNothing, storetypes.PruningOptionEverything:
+			overrides[name] = storetypes.NewPruningOptionsFromString(strategy)
+
+		default:
+			return nil, fmt.Errorf("unknown pruning strategy %s of store %s", strategy, name)
+		}
+	}
+
+	return overrides, nil
+}
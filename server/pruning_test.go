@@ -71,3 +71,64 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPruningOverridesFromFlags(t *testing.T) {
+	tests := []struct {
+		name              string
+		overrides         []string
+		expectedOverrides map[string]types.PruningOptions
+		wantErr           string
+	}{
+		{
+			name:              "no overrides",
+			expectedOverrides: map[string]types.PruningOptions{},
+		},
+		{
+			name:      "overrides",
+			overrides: []string{"bank:nothing", " staking:Default", "distribution:everything"},
+			expectedOverrides: map[string]types.PruningOptions{
+				"bank":         types.PruneNothing,
+				"staking":      types.PruneDefault,
+				"distribution": types.PruneEverything,
+			},
+		},
+		{
+			name:      "custom strategy",
+			overrides: []string{"bank:custom"},
+			wantErr:   "unknown pruning strategy custom of store bank",
+		},
+		{
+			name:      "missing strategy",
+			overrides: []string{"bank"},
+			wantErr:   `invalid pruning override "bank", expected <store name>:<strategy>`,
+		},
+		{
+			name:      "missing store name",
+			overrides: []string{":nothing"},
+			wantErr:   `invalid pruning override ":nothing", expected <store name>:<strategy>`,
+		},
+		{
+			name:      "duplicate store",
+			overrides: []string{"bank:nothing", "bank:default"},
+			wantErr:   "duplicate pruning override of store bank",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			v.Set(FlagPruningOverrides, tt.overrides)
+
+			overrides, err := GetPruningOverridesFromFlags(v)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOverrides, overrides)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Package ratelimit limits the rate of the requests of each client of the API
+// and gRPC servers, by IP address.
+package ratelimit
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the interval at which the buckets of the clients which
+// have not sent requests for long enough to refill them are dropped.
+const sweepInterval = time.Minute
+
+// Limiter limits the rate of the requests of each client IP with a token
+// bucket: a client can send bursts of up to burst requests, refilled at rate
+// requests per second. It is safe for concurrent use.
+type Limiter struct {
+	rate           float64
+	burst          float64
+	exemptLoopback bool
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+
+	// now returns the current time, replaced in tests
+	now func() time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a limiter of rate requests per second with bursts of
+// burst requests for each client IP, at least 1. The requests of the loopback
+// addresses are not limited if exemptLoopback is true. It returns nil if rate
+// is not positive, which is a limiter allowing all the requests.
+func NewLimiter(rate float64, burst uint, exemptLoopback bool) *Limiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst == 0 {
+		burst = 1
+	}
+
+	return &Limiter{
+		rate:           rate,
+		burst:          float64(burst),
+		exemptLoopback: exemptLoopback,
+		buckets:        make(map[string]*bucket),
+		now:            time.Now,
+	}
+}
+
+// Allow reports whether a request of the client of the given address, either
+// an IP or a host:port pair, is allowed, and consumes a token of its bucket if
+// it is.
+func (l *Limiter) Allow(addr string) bool {
+	if l == nil {
+		return true
+	}
+
+	ip := hostIP(addr)
+	if l.exemptLoopback && ip != nil && ip.IsLoopback() {
+		return true
+	}
+
+	key := addr
+	if ip != nil {
+		key = ip.String()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+
+	return true
+}
+
+// sweep drops the buckets which are full again, so that the memory of the
+// limiter does not grow with the number of clients it has ever seen.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// hostIP returns the IP of the host of addr, or nil if it is not an IP.
+func hostIP(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+
+	return net.ParseIP(addr)
+}
@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock advanced by hand.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestLimiter(rate float64, burst uint, exemptLoopback bool) (*Limiter, *fakeClock) {
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	l := NewLimiter(rate, burst, exemptLoopback)
+	l.now = clock.now
+
+	return l, clock
+}
+
+// allowed returns the number of the n requests of addr allowed by l.
+func allowed(l *Limiter, addr string, n int) int {
+	count := 0
+	for i := 0; i < n; i++ {
+		if l.Allow(addr) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	l := NewLimiter(0, 10, false)
+	require.Nil(t, l)
+	require.Equal(t, 1000, allowed(l, "203.0.113.1:26657", 1000))
+}
+
+func TestLimiterBurstAndRefill(t *testing.T) {
+	l, clock := newTestLimiter(2, 5, false)
+
+	// a flood is cut after the burst
+	require.Equal(t, 5, allowed(l, "203.0.113.1:1234", 100))
+
+	// then refilled at the rate, up to the burst
+	clock.advance(time.Second)
+	require.Equal(t, 2, allowed(l, "203.0.113.1:1234", 100))
+	clock.advance(time.Hour)
+	require.Equal(t, 5, allowed(l, "203.0.113.1:1234", 100))
+}
+
+func TestLimiterPerIP(t *testing.T) {
+	l, _ := newTestLimiter(1, 3, false)
+
+	require.Equal(t, 3, allowed(l, "203.0.113.1:1234", 10))
+	// the port of the client does not matter
+	require.Equal(t, 0, allowed(l, "203.0.113.1:5678", 10))
+	// other clients have their own bucket
+	require.Equal(t, 3, allowed(l, "203.0.113.2:1234", 10))
+	require.Equal(t, 3, allowed(l, "2001:db8::1", 10))
+}
+
+func TestLimiterLoopback(t *testing.T) {
+	l, _ := newTestLimiter(1, 3, true)
+	require.Equal(t, 100, allowed(l, "127.0.0.1:1234", 100))
+	require.Equal(t, 100, allowed(l, "[::1]:1234", 100))
+	require.Equal(t, 3, allowed(l, "203.0.113.1:1234", 100))
+
+	// loopback clients are limited unless exempted
+	l, _ = newTestLimiter(1, 3, false)
+	require.Equal(t, 3, allowed(l, "127.0.0.1:1234", 100))
+}
+
+func TestLimiterSweep(t *testing.T) {
+	l, clock := newTestLimiter(1, 2, false)
+
+	l.Allow("203.0.113.1:1234")
+	clock.advance(sweepInterval)
+	require.Equal(t, 2, allowed(l, "203.0.113.2:1234", 3))
+
+	// the bucket of the first client refilled, and was dropped
+	l.mu.Lock()
+	require.Len(t, l.buckets, 1)
+	l.mu.Unlock()
+}
+
+func TestLimiterConcurrent(t *testing.T) {
+	l, _ := newTestLimiter(1, 50, false)
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		count int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := allowed(l, "203.0.113.1:1234", 10)
+			mu.Lock()
+			count += n
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 50, count)
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	FlagRollbackBlocks = "blocks"
+	FlagRollbackHard   = "hard"
+)
+
+// RollbackCmd creates a command rolling back the state of the application,
+// and with --hard the state of Tendermint, to a previous height.
+func RollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback the application state by one or more heights",
+		Long: `A state rollback is performed to recover from an incorrect application state transition,
+e.g. an app hash divergence after a consensus failure, so that the blocks can be executed again
+with a patched binary. The latest --blocks versions of the multi-store are deleted and the height
+before them becomes the latest height of the application. It fails, changing nothing, if that
+height has been pruned.
+
+With --hard, the state of Tendermint is rolled back by one height as well, so that Tendermint
+executes the latest block again instead of checking the app hash of the application against the
+one it persisted. Without it, only the application state is rolled back, and Tendermint replays
+the blocks it stores against the application when the node restarts.
+
+The node must be stopped: the command refuses to run while the node holds the lock of its
+databases.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			cfg.SetRoot(homeDir)
+
+			blocks, _ := cmd.Flags().GetInt64(FlagRollbackBlocks)
+			if blocks <= 0 {
+				return fmt.Errorf("the number of blocks to roll back must be positive, got %d", blocks)
+			}
+
+			db, err := openDB(cfg.RootDir)
+			if err != nil {
+				return fmt.Errorf("failed to open the application database, make sure the node is stopped: %w", err)
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			cms := app.CommitMultiStore()
+
+			height := cms.LastCommitID().Version - blocks
+			if err := cms.RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to rollback the application state: %w", err)
+			}
+
+			commitID := cms.LastCommitID()
+			cmd.Printf("Rolled back the application state to height %d and hash %X\n", commitID.Version, commitID.Hash)
+
+			if hard, _ := cmd.Flags().GetBool(FlagRollbackHard); hard {
+				tmHeight, tmHash, err := tmcmd.RollbackState(cfg)
+				if err != nil {
+					return fmt.Errorf("failed to rollback the tendermint state: %w", err)
+				}
+
+				cmd.Printf("Rolled back the tendermint state to height %d and hash %X\n", tmHeight, tmHash)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagRollbackBlocks, 1, "Number of heights to roll back the application state by")
+	cmd.Flags().Bool(FlagRollbackHard, false, "Roll back the tendermint state by one height as well")
+
+	return cmd
+}
@@ -0,0 +1,149 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+var fundedAddr = sdk.AccAddress([]byte("funded-address______"))
+
+func newLevelDBApp(logger log.Logger, db dbm.DB, _ io.Writer, appOpts types.AppOptions) types.Application {
+	return simapp.NewSimApp(
+		logger, db, nil, true, map[int64]bool{}, "", 0, simapp.MakeTestEncodingConfig(), appOpts,
+		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(server.FlagDisableIAVLFastNode))),
+	)
+}
+
+// openAppDB opens the application database of the node at home.
+func openAppDB(t *testing.T, home string) dbm.DB {
+	db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	return db
+}
+
+// setupLevelDBApp commits the heights 1 to 5 of a simapp, funding fundedAddr
+// with one stake more at each height.
+func setupLevelDBApp(t *testing.T, home string) {
+	setupLevelDBAppWithOpts(t, home, simapp.EmptyAppOptions{})
+}
+
+// setupLevelDBAppWithOpts is setupLevelDBApp with the given app options.
+func setupLevelDBAppWithOpts(t *testing.T, home string, appOpts types.AppOptions) {
+	logger := log.NewNopLogger()
+	db := openAppDB(t, home)
+	defer db.Close()
+	app := newLevelDBApp(logger, db, nil, appOpts).(*simapp.SimApp)
+
+	genesisState := simapp.GenesisStateWithSingleValidator(t, app)
+	stateBytes, err := tmjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	for height := int64(2); height <= 5; height++ {
+		header := tmproto.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		ctx := app.NewContext(false, header)
+		require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, fundedAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))))
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+	require.Equal(t, int64(5), app.LastBlockHeight())
+}
+
+func newServerCmdContext(home string) context.Context {
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.RootDir = home
+	return context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+}
+
+func TestRollbackCmd(t *testing.T) {
+	home := t.TempDir()
+	setupLevelDBApp(t, home)
+
+	cmd := server.RollbackCmd(newLevelDBApp, home)
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+		fmt.Sprintf("--%s=%d", server.FlagRollbackBlocks, 2),
+	})
+	require.NoError(t, cmd.ExecuteContext(newServerCmdContext(home)))
+
+	db := openAppDB(t, home)
+	defer db.Close()
+	app := newLevelDBApp(log.NewNopLogger(), db, nil, simapp.EmptyAppOptions{}).(*simapp.SimApp)
+
+	// the head is the height 3, with the balance funded up to it
+	require.Equal(t, int64(3), app.LastBlockHeight())
+	ctx := app.NewContext(true, tmproto.Header{Height: 3})
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2), app.BankKeeper.GetBalance(ctx, fundedAddr, sdk.DefaultBondDenom))
+
+	// and the rolled back heights can be committed again
+	header := tmproto.Header{Height: 4}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: 4})
+	app.Commit()
+	require.Equal(t, int64(4), app.LastBlockHeight())
+}
+
+func TestRollbackCmd_Refused(t *testing.T) {
+	home := t.TempDir()
+	setupLevelDBApp(t, home)
+
+	testCases := []struct {
+		name   string
+		blocks int64
+		expErr string
+	}{
+		{"zero blocks", 0, "the number of blocks to roll back must be positive, got 0"},
+		{"before the first height", 5, "failed to rollback the application state"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := server.RollbackCmd(newLevelDBApp, home)
+			cmd.SetArgs([]string{
+				fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+				fmt.Sprintf("--%s=%d", server.FlagRollbackBlocks, tc.blocks),
+			})
+			err := cmd.ExecuteContext(newServerCmdContext(home))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expErr)
+		})
+	}
+
+	// the command refuses to run while the node holds the database
+	db := openAppDB(t, home)
+	defer db.Close()
+	cmd := server.RollbackCmd(newLevelDBApp, home)
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flags.FlagHome, home)})
+	err := cmd.ExecuteContext(newServerCmdContext(home))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "make sure the node is stopped")
+
+	// and nothing was rolled back
+	app := newLevelDBApp(log.NewNopLogger(), db, nil, simapp.EmptyAppOptions{}).(*simapp.SimApp)
+	require.Equal(t, int64(5), app.LastBlockHeight())
+}
@@ -0,0 +1,238 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	FlagSnapshotHeight = "height"
+	FlagSnapshotOutput = "output"
+)
+
+// SnapshotsCmd returns the snapshots subcommands, exporting and restoring the
+// application state to and from a directory, and managing the snapshots kept
+// by the node for state sync.
+func SnapshotsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Export, restore and manage state snapshots",
+	}
+
+	cmd.AddCommand(
+		ExportSnapshotCmd(appCreator, defaultNodeHome),
+		RestoreSnapshotCmd(appCreator, defaultNodeHome),
+		ListSnapshotsCmd(defaultNodeHome),
+		DeleteSnapshotCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// ExportSnapshotCmd creates a command exporting a snapshot of the application
+// state to a directory.
+func ExportSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a snapshot of the application state to a directory",
+		Long: `Export a snapshot of the application state at --height, by default the latest height, to the
+--output directory, in the format of the state sync snapshots: one file per chunk, named by its
+index, and a manifest.json file with the height, the format and the SHA-256 hashes of the chunks.
+The manifest is written last, so that an interrupted export cannot be restored.
+
+The node must be stopped, and the height must not have been pruned.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, _ := cmd.Flags().GetString(FlagSnapshotOutput)
+			if output == "" {
+				return fmt.Errorf("the --%s directory is required", FlagSnapshotOutput)
+			}
+
+			return withApp(cmd, appCreator, func(app types.Application) error {
+				cms := app.CommitMultiStore()
+
+				height, _ := cmd.Flags().GetUint64(FlagSnapshotHeight)
+				if height == 0 {
+					height = uint64(cms.LastCommitID().Version)
+				}
+
+				snapshot, err := snapshots.ExportToDir(cms, height, output)
+				if err != nil {
+					return fmt.Errorf("failed to export the snapshot of height %d: %w", height, err)
+				}
+
+				cmd.Printf("Exported the snapshot of height %d to %s: %d chunks, hash %X\n",
+					snapshot.Height, output, snapshot.Chunks, snapshot.Hash)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Uint64(FlagSnapshotHeight, 0, "Height of the snapshot, the latest height if 0")
+	cmd.Flags().String(FlagSnapshotOutput, "", "Directory to export the snapshot to")
+
+	return cmd
+}
+
+// RestoreSnapshotCmd creates a command restoring a snapshot exported to a
+// directory into the application state.
+func RestoreSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [dir]",
+		Short: "Restore a snapshot exported to a directory into an empty application state",
+		Long: `Restore the snapshot exported to a directory by the export command into the application
+state of a new node, whose application database must be empty. The chunks are checked against the
+hashes of the manifest before anything is restored, so that a missing or corrupted chunk leaves the
+application state untouched.
+
+Once restored, the snapshot height is the latest height of the application. Tendermint must hold
+the same height, e.g. with its state and block store copied from the node the snapshot was taken
+from, for the node to start and replay the next blocks.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withApp(cmd, appCreator, func(app types.Application) error {
+				cms := app.CommitMultiStore()
+				if version := cms.LastCommitID().Version; version != 0 {
+					return fmt.Errorf("the application state is not empty, its latest height is %d", version)
+				}
+
+				snapshot, err := snapshots.RestoreFromDir(cms, args[0])
+				if err != nil {
+					return fmt.Errorf("failed to restore the snapshot: %w", err)
+				}
+
+				commitID := cms.LastCommitID()
+				cmd.Printf("Restored the snapshot of height %d, app hash %X\n", snapshot.Height, commitID.Hash)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// ListSnapshotsCmd creates a command listing the snapshots kept by the node.
+func ListSnapshotsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the snapshots kept by the node for state sync",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withSnapshotStore(cmd, func(store *snapshots.Store) error {
+				list, err := store.List()
+				if err != nil {
+					return err
+				}
+
+				for _, snapshot := range list {
+					cmd.Printf("height: %d format: %d chunks: %d hash: %X\n",
+						snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// DeleteSnapshotCmd creates a command deleting a snapshot kept by the node.
+func DeleteSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [height] [format]",
+		Short: "Delete a snapshot kept by the node for state sync, of the current format by default",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			format := snapshottypes.CurrentFormat
+			if len(args) > 1 {
+				f, err := strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid format %s: %w", args[1], err)
+				}
+				format = uint32(f)
+			}
+
+			return withSnapshotStore(cmd, func(store *snapshots.Store) error {
+				snapshot, err := store.Get(height, format)
+				if err != nil {
+					return err
+				}
+				if snapshot == nil {
+					return fmt.Errorf("no snapshot of height %d and format %d", height, format)
+				}
+
+				return store.Delete(height, format)
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// withApp runs fn with the application of the node, which must be stopped.
+func withApp(cmd *cobra.Command, appCreator types.AppCreator, fn func(types.Application) error) error {
+	serverCtx := GetServerContextFromCmd(cmd)
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	serverCtx.Config.SetRoot(homeDir)
+
+	db, err := openDB(serverCtx.Config.RootDir)
+	if err != nil {
+		return fmt.Errorf("failed to open the application database, make sure the node is stopped: %w", err)
+	}
+	defer db.Close()
+
+	return fn(appCreator(serverCtx.Logger, db, nil, serverCtx.Viper))
+}
+
+// withSnapshotStore runs fn with the snapshot store of the node, which must be
+// stopped.
+func withSnapshotStore(cmd *cobra.Command, fn func(*snapshots.Store) error) error {
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	store, db, err := openSnapshotStore(homeDir)
+	if err != nil {
+		return fmt.Errorf("failed to open the snapshot store, make sure the node is stopped: %w", err)
+	}
+	defer db.Close()
+
+	return fn(store)
+}
+
+// openSnapshotStore opens the store of the snapshots served to state sync.
+func openSnapshotStore(rootDir string) (*snapshots.Store, dbm.DB, error) {
+	dir := filepath.Join(rootDir, "data", "snapshots")
+	db, err := sdk.NewLevelDB("metadata", dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store, err := snapshots.NewStore(db, dir)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+
+	return store, db, nil
+}
@@ -0,0 +1,151 @@
+package server_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// runSnapshotsCmd runs the snapshots subcommand with the given args on the node
+// at home, and returns its output.
+func runSnapshotsCmd(t *testing.T, home string, args ...string) (string, error) {
+	cmd := server.SnapshotsCmd(newLevelDBApp, home)
+	output := &bytes.Buffer{}
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+	err := cmd.ExecuteContext(newServerCmdContext(home))
+	return output.String(), err
+}
+
+func TestSnapshotsCmd_ExportRestore(t *testing.T) {
+	home := t.TempDir()
+	setupLevelDBApp(t, home)
+	dir := filepath.Join(t.TempDir(), "snapshot")
+
+	// the height must be kept by the node
+	_, err := runSnapshotsCmd(t, home, "export", "--height=6", fmt.Sprintf("--output=%s", dir))
+	require.Error(t, err)
+
+	_, err = runSnapshotsCmd(t, home, "export", "--height=3", fmt.Sprintf("--output=%s", dir))
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(dir, snapshots.ManifestFile))
+
+	// a second export to the same directory is refused
+	_, err = runSnapshotsCmd(t, home, "export", "--height=4", fmt.Sprintf("--output=%s", dir))
+	require.Error(t, err)
+
+	// the snapshot cannot be restored into a node with a state
+	_, err = runSnapshotsCmd(t, home, "restore", dir)
+	require.EqualError(t, err, "the application state is not empty, its latest height is 5")
+
+	newHome := t.TempDir()
+	_, err = runSnapshotsCmd(t, newHome, "restore", dir)
+	require.NoError(t, err)
+
+	// the snapshot of the latest height, by default, restores its app hash
+	latestDir := filepath.Join(t.TempDir(), "latest")
+	_, err = runSnapshotsCmd(t, home, "export", fmt.Sprintf("--output=%s", latestDir))
+	require.NoError(t, err)
+	latestHome := t.TempDir()
+	_, err = runSnapshotsCmd(t, latestHome, "restore", latestDir)
+	require.NoError(t, err)
+	latestDB := openAppDB(t, latestHome)
+	latestApp := newLevelDBApp(log.NewNopLogger(), latestDB, nil, simapp.EmptyAppOptions{}).(*simapp.SimApp)
+	latestCommitID := latestApp.LastCommitID()
+	require.NoError(t, latestDB.Close())
+
+	db := openAppDB(t, home)
+	app := newLevelDBApp(log.NewNopLogger(), db, nil, simapp.EmptyAppOptions{}).(*simapp.SimApp)
+	require.Equal(t, app.LastCommitID(), latestCommitID)
+	cms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	expBalance := app.BankKeeper.GetBalance(app.NewContext(true, tmproto.Header{}).WithMultiStore(cms), fundedAddr, sdk.DefaultBondDenom)
+	require.NoError(t, db.Close())
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2), expBalance)
+
+	// the restored node is at the height of the snapshot, with its state
+	newDB := openAppDB(t, newHome)
+	defer newDB.Close()
+	newApp := newLevelDBApp(log.NewNopLogger(), newDB, nil, simapp.EmptyAppOptions{}).(*simapp.SimApp)
+	require.Equal(t, int64(3), newApp.LastBlockHeight())
+	ctx := newApp.NewContext(true, tmproto.Header{Height: 3})
+	require.Equal(t, expBalance, newApp.BankKeeper.GetBalance(ctx, fundedAddr, sdk.DefaultBondDenom))
+}
+
+func TestSnapshotsCmd_RestoreCorrupted(t *testing.T) {
+	home := t.TempDir()
+	setupLevelDBApp(t, home)
+	dir := filepath.Join(t.TempDir(), "snapshot")
+	_, err := runSnapshotsCmd(t, home, "export", fmt.Sprintf("--output=%s", dir))
+	require.NoError(t, err)
+
+	chunk := filepath.Join(dir, "0")
+	bz, err := os.ReadFile(chunk)
+	require.NoError(t, err)
+	bz[len(bz)/2]++
+	require.NoError(t, os.WriteFile(chunk, bz, 0644))
+
+	// the corrupted chunk is detected before anything is restored
+	newHome := t.TempDir()
+	_, err = runSnapshotsCmd(t, newHome, "restore", dir)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "chunk hash verification failed")
+
+	newDB := openAppDB(t, newHome)
+	defer newDB.Close()
+	newApp := newLevelDBApp(log.NewNopLogger(), newDB, nil, simapp.EmptyAppOptions{}).(*simapp.SimApp)
+	require.Equal(t, int64(0), newApp.LastBlockHeight())
+}
+
+func TestSnapshotsCmd_ListDelete(t *testing.T) {
+	home := t.TempDir()
+	setupLevelDBApp(t, home)
+
+	// take the snapshots of heights 3 and 5 into the snapshot store of the node,
+	// as state sync does
+	db := openAppDB(t, home)
+	app := newLevelDBApp(log.NewNopLogger(), db, nil, simapp.EmptyAppOptions{}).(*simapp.SimApp)
+	snapshotDir := filepath.Join(home, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	require.NoError(t, err)
+	store, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	require.NoError(t, err)
+	manager := snapshots.NewManager(store, app.CommitMultiStore())
+	snapshot3, err := manager.Create(3)
+	require.NoError(t, err)
+	snapshot5, err := manager.Create(5)
+	require.NoError(t, err)
+	require.NoError(t, snapshotDB.Close())
+	require.NoError(t, db.Close())
+
+	output, err := runSnapshotsCmd(t, home, "list")
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf(
+		"height: 5 format: 1 chunks: %d hash: %X\nheight: 3 format: 1 chunks: %d hash: %X\n",
+		snapshot5.Chunks, snapshot5.Hash, snapshot3.Chunks, snapshot3.Hash,
+	), output)
+
+	_, err = runSnapshotsCmd(t, home, "delete", "4")
+	require.EqualError(t, err, "no snapshot of height 4 and format 1")
+
+	_, err = runSnapshotsCmd(t, home, "delete", "5")
+	require.NoError(t, err)
+	require.NoDirExists(t, filepath.Join(snapshotDir, "5", "1"))
+
+	output, err = runSnapshotsCmd(t, home, "list")
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("height: 3 format: 1 chunks: %d hash: %X\n", snapshot3.Chunks, snapshot3.Hash), output)
+}
@@ -279,6 +279,9 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		UnsafeResetAllCmd(),
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		RollbackCmd(appCreator, defaultNodeHome),
+		SnapshotsCmd(appCreator, defaultNodeHome),
+		IAVLMigrateCmd(appCreator, defaultNodeHome),
 		version.NewVersionCommand(),
 	)
 }
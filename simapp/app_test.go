@@ -1,7 +1,13 @@
 package simapp
 
 import (
+	"bytes"
 	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -12,9 +18,15 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/api/openapi"
+	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
@@ -49,10 +61,11 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 		AppOpts:            EmptyAppOptions{},
 	})
 
+	ctx := app.NewContext(true, tmproto.Header{})
 	for acc := range maccPerms {
 		require.True(
 			t,
-			app.BankKeeper.BlockedAddr(app.AccountKeeper.GetModuleAddress(acc)),
+			app.BankKeeper.BlockedAddr(ctx, app.AccountKeeper.GetModuleAddress(acc)),
 			"ensure that blocked addresses are properly set in bank keeper",
 		)
 	}
@@ -66,6 +79,74 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestRegisterAPIRoutesOpenAPI(t *testing.T) {
+	app := Setup(t, false)
+
+	getOpenAPI := func() openapi.Document {
+		apiSrv := api.New(client.Context{}, log.NewNopLogger(), nil)
+		app.RegisterAPIRoutes(apiSrv, config.APIConfig{Swagger: true})
+
+		rec := httptest.NewRecorder()
+		apiSrv.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, api.OpenAPIPath, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var doc openapi.Document
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+
+		// the swagger UI reads the generated document
+		rec = httptest.NewRecorder()
+		apiSrv.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/swagger/", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `url: "./openapi.json"`)
+
+		return doc
+	}
+
+	// the routes of the modules wired into SimApp only are present
+	doc := getOpenAPI()
+	require.Contains(t, doc.Paths, "/cosmos/nft/v1beta1/balance/{owner}/{class_id}")
+	require.Contains(t, doc.Paths, "/cosmos/bank/v1beta1/balances/{address}")
+	// the tx service is not wired in until the node registers it
+	require.NotContains(t, doc.Paths, "/cosmos/tx/v1beta1/txs")
+
+	app.RegisterTxService(client.Context{})
+	doc = getOpenAPI()
+	require.Contains(t, doc.Paths, "/cosmos/tx/v1beta1/txs")
+}
+
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of the app info")
+
+func TestRegisterTendermintServiceAppInfo(t *testing.T) {
+	app := Setup(t, false)
+	encCfg := MakeTestEncodingConfig()
+	app.RegisterTendermintService(client.Context{}.WithTxConfig(encCfg.TxConfig))
+
+	reqBz, err := app.appCodec.Marshal(&tmservice.GetAppInfoRequest{})
+	require.NoError(t, err)
+	resQuery := app.Query(abci.RequestQuery{Path: "/cosmos.base.tendermint.v1beta1.Service/GetAppInfo", Data: reqBz})
+	require.Equal(t, uint32(0), resQuery.Code, resQuery.Log)
+
+	var res tmservice.GetAppInfoResponse
+	require.NoError(t, app.appCodec.Unmarshal(resQuery.Value, &res))
+	require.Equal(t, version.NewInfo().Version, res.ApplicationVersion.Version)
+	require.Equal(t, version.NewInfo().CosmosSdkVersion, res.ApplicationVersion.CosmosSdkVersion)
+
+	// the sign modes and the modules of SimApp, the version depending on the build
+	res.ApplicationVersion = nil
+	bz, err := app.appCodec.MarshalJSON(&res)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	require.NoError(t, json.Indent(&out, bz, "", "  "))
+
+	goldenFile := filepath.Join("testdata", "app_info.golden")
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(goldenFile, out.Bytes(), 0o600))
+	}
+	expected, err := os.ReadFile(goldenFile)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), out.String())
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -128,8 +209,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -49,10 +49,27 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 			if err != nil {
 				inBuf := bufio.NewReader(cmd.InOrStdin())
 				keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+				keyringDir, _ := cmd.Flags().GetString(flags.FlagKeyringDir)
 
-				if keyringBackend != "" && clientCtx.Keyring == nil {
+				// The keyring directory is optional and falls back to the one of
+				// client.toml, or to the home directory, if omitted.
+				if keyringDir == "" {
+					keyringDir = clientCtx.KeyringDir
+				}
+				if keyringDir == "" {
+					keyringDir = clientCtx.HomeDir
+				}
+
+				// A keyring built beforehand, e.g. from client.toml, keeps its backend
+				// when only its directory is changed by a flag.
+				if clientCtx.Keyring != nil && !cmd.Flags().Changed(flags.FlagKeyringBackend) && clientCtx.Keyring.Backend() != "" {
+					keyringBackend = clientCtx.Keyring.Backend()
+				}
+
+				if keyringBackend != "" && (clientCtx.Keyring == nil ||
+					cmd.Flags().Changed(flags.FlagKeyringBackend) || cmd.Flags().Changed(flags.FlagKeyringDir)) {
 					var err error
-					kr, err = keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, inBuf, clientCtx.Codec)
+					kr, err = keyring.New(sdk.KeyringServiceName(), keyringBackend, keyringDir, inBuf, clientCtx.Codec)
 					if err != nil {
 						return err
 					}
@@ -176,6 +193,7 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
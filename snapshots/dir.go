@@ -0,0 +1,221 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ManifestFile is the name of the manifest of a snapshot exported to a directory.
+const ManifestFile = "manifest.json"
+
+// Manifest describes a snapshot exported to a directory, next to its chunks,
+// which are stored in files named by their index.
+type Manifest struct {
+	Height      uint64             `json:"height"`
+	Format      uint32             `json:"format"`
+	Chunks      uint32             `json:"chunks"`
+	Hash        tmbytes.HexBytes   `json:"hash"`         // SHA-256 hash of the chunks
+	ChunkHashes []tmbytes.HexBytes `json:"chunk_hashes"` // SHA-256 hash of each chunk
+}
+
+// NewManifest returns the manifest of a snapshot.
+func NewManifest(snapshot *types.Snapshot) Manifest {
+	chunkHashes := make([]tmbytes.HexBytes, len(snapshot.Metadata.ChunkHashes))
+	for i, hash := range snapshot.Metadata.ChunkHashes {
+		chunkHashes[i] = hash
+	}
+	return Manifest{
+		Height:      snapshot.Height,
+		Format:      snapshot.Format,
+		Chunks:      snapshot.Chunks,
+		Hash:        snapshot.Hash,
+		ChunkHashes: chunkHashes,
+	}
+}
+
+// Snapshot returns the snapshot described by the manifest.
+func (m Manifest) Snapshot() types.Snapshot {
+	chunkHashes := make([][]byte, len(m.ChunkHashes))
+	for i, hash := range m.ChunkHashes {
+		chunkHashes[i] = hash
+	}
+	return types.Snapshot{
+		Height:   m.Height,
+		Format:   m.Format,
+		Chunks:   m.Chunks,
+		Hash:     m.Hash,
+		Metadata: types.Metadata{ChunkHashes: chunkHashes},
+	}
+}
+
+// ExportToDir writes a snapshot of the target at the given height to dir, which
+// must not hold another snapshot. The manifest is written once every chunk has
+// been, so that an interrupted export is never mistaken for a complete one, and
+// the chunks are removed if the export fails.
+func ExportToDir(target types.Snapshotter, height uint64, dir string) (*types.Snapshot, error) {
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot height cannot be 0")
+	}
+	manifestPath := filepath.Join(dir, ManifestFile)
+	if _, err := os.Stat(manifestPath); err == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict, "a snapshot has already been exported to %q", dir)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+
+	chunks, err := target.Snapshot(height, types.CurrentFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := writeChunks(chunks, dir, height)
+	if err != nil {
+		for i := uint32(0); ; i++ {
+			if os.Remove(chunkPath(dir, i)) != nil {
+				break
+			}
+		}
+		return nil, err
+	}
+
+	bz, err := json.MarshalIndent(NewManifest(snapshot), "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to encode snapshot manifest")
+	}
+	if err := os.WriteFile(manifestPath, bz, 0644); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to write snapshot manifest %q", manifestPath)
+	}
+
+	return snapshot, nil
+}
+
+// writeChunks writes the chunks to dir, hashing them as Store.Save does.
+func writeChunks(chunks <-chan io.ReadCloser, dir string, height uint64) (*types.Snapshot, error) {
+	defer DrainChunks(chunks)
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: types.CurrentFormat,
+	}
+	index := uint32(0)
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+	for chunkBody := range chunks {
+		err := func() error {
+			defer chunkBody.Close()
+			path := chunkPath(dir, index)
+			file, err := os.Create(path)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to create snapshot chunk file %q", path)
+			}
+			defer file.Close()
+
+			chunkHasher.Reset()
+			if _, err := io.Copy(io.MultiWriter(file, chunkHasher, snapshotHasher), chunkBody); err != nil {
+				return sdkerrors.Wrapf(err, "failed to generate snapshot chunk %v", index)
+			}
+			return sdkerrors.Wrapf(file.Close(), "failed to close snapshot chunk %v", index)
+		}()
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
+		index++
+	}
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+	return snapshot, nil
+}
+
+// LoadFromDir loads the snapshot exported to dir, and verifies that each of its
+// chunks is present and matches its hash in the manifest.
+func LoadFromDir(dir string) (*types.Snapshot, error) {
+	manifestPath := filepath.Join(dir, ManifestFile)
+	bz, err := os.ReadFile(manifestPath)
+	if os.IsNotExist(err) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata,
+			"no snapshot manifest in %q, the export may be incomplete", dir)
+	}
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to read snapshot manifest %q", manifestPath)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "failed to decode snapshot manifest: %v", err)
+	}
+	snapshot := manifest.Snapshot()
+	if snapshot.Chunks == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	snapshotHasher := sha256.New()
+	for i, expected := range snapshot.Metadata.ChunkHashes {
+		chunk, err := os.ReadFile(chunkPath(dir, uint32(i)))
+		if os.IsNotExist(err) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot chunk %v is missing", i)
+		}
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", i)
+		}
+		hash := sha256.Sum256(chunk)
+		if !bytes.Equal(hash[:], expected) {
+			return nil, sdkerrors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %X, got %X", i, expected, hash)
+		}
+		snapshotHasher.Write(chunk)
+	}
+	if hash := snapshotHasher.Sum(nil); !bytes.Equal(hash, snapshot.Hash) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "expected snapshot hash %X, got %X", snapshot.Hash, hash)
+	}
+
+	return &snapshot, nil
+}
+
+// RestoreFromDir restores the snapshot exported to dir into the target. The
+// chunks are verified before the restore starts, so that a missing or corrupted
+// chunk leaves the target untouched.
+func RestoreFromDir(target types.Snapshotter, dir string) (*types.Snapshot, error) {
+	snapshot, err := LoadFromDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	manager := NewManager(nil, target)
+	if err := manager.Restore(*snapshot); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := os.ReadFile(chunkPath(dir, i))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", i)
+		}
+		done, err := manager.RestoreChunk(chunk)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to restore snapshot chunk %v", i)
+		}
+		if done != (i == snapshot.Chunks-1) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "restore ended at chunk %v of %v", i, snapshot.Chunks)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// chunkPath generates the path of a chunk of a snapshot exported to dir.
+func chunkPath(dir string, chunk uint32) string {
+	return filepath.Join(dir, strconv.FormatUint(uint64(chunk), 10))
+}
@@ -0,0 +1,96 @@
+package snapshots_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+func TestExportToDir_RestoreFromDir(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	dir := filepath.Join(t.TempDir(), "export")
+
+	snapshot, err := snapshots.ExportToDir(&mockSnapshotter{chunks: chunks}, 3, dir)
+	require.NoError(t, err)
+	assert.Equal(t, &types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Chunks:   3,
+		Hash:     hash(chunks),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	}, snapshot)
+
+	// the chunks are written as is, next to the manifest
+	for i, chunk := range chunks {
+		bz, err := os.ReadFile(filepath.Join(dir, []string{"0", "1", "2"}[i]))
+		require.NoError(t, err)
+		assert.Equal(t, chunk, bz)
+	}
+	loaded, err := snapshots.LoadFromDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+
+	// the directory holds a snapshot already
+	_, err = snapshots.ExportToDir(&mockSnapshotter{chunks: chunks}, 3, dir)
+	require.Error(t, err)
+
+	target := &mockSnapshotter{}
+	restored, err := snapshots.RestoreFromDir(target, dir)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, restored)
+	assert.Equal(t, chunks, target.chunks)
+}
+
+func TestRestoreFromDir_Errors(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+
+	testCases := map[string]struct {
+		corrupt func(dir string)
+		expErr  error
+	}{
+		"missing manifest": {
+			func(dir string) { require.NoError(t, os.Remove(filepath.Join(dir, snapshots.ManifestFile))) },
+			types.ErrInvalidMetadata,
+		},
+		"invalid manifest": {
+			func(dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, snapshots.ManifestFile), []byte("{"), 0644))
+			},
+			types.ErrInvalidMetadata,
+		},
+		"missing chunk": {
+			func(dir string) { require.NoError(t, os.Remove(filepath.Join(dir, "1"))) },
+			types.ErrInvalidMetadata,
+		},
+		"corrupted chunk": {
+			func(dir string) { require.NoError(t, os.WriteFile(filepath.Join(dir, "2"), []byte{7, 8, 0}, 0644)) },
+			types.ErrChunkHashMismatch,
+		},
+		"truncated chunk": {
+			func(dir string) { require.NoError(t, os.WriteFile(filepath.Join(dir, "0"), []byte{1, 2}, 0644)) },
+			types.ErrChunkHashMismatch,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			_, err := snapshots.ExportToDir(&mockSnapshotter{chunks: chunks}, 3, dir)
+			require.NoError(t, err)
+			tc.corrupt(dir)
+
+			// nothing is restored
+			target := &mockSnapshotter{}
+			_, err = snapshots.RestoreFromDir(target, dir)
+			require.ErrorIs(t, err, tc.expErr)
+			assert.Nil(t, target.chunks)
+		})
+	}
+}
@@ -1,8 +1,15 @@
 package cache
 
 import (
+	"crypto/rand"
+	"fmt"
 	"testing"
 
+	"github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -47,3 +54,30 @@ func BenchmarkReset(b *testing.B) {
 		b.Fatal("Impossible condition")
 	}
 }
+
+// BenchmarkCommitKVStoreCacheGet measures the reads of random keys through the
+// inter-block cache of a store holding more keys than the smallest caches.
+func BenchmarkCommitKVStoreCacheGet(b *testing.B) {
+	for _, cacheSize := range []uint{100, DefaultCommitKVStoreCacheSize, 10000, 100000} {
+		b.Run(fmt.Sprintf("cache-size-%d", cacheSize), func(b *testing.B) {
+			tree, err := iavl.NewMutableTree(dbm.NewMemDB(), iavlstore.DefaultIAVLCacheSize, false)
+			require.NoError(b, err)
+			store := NewCommitKVStoreCache(iavlstore.UnsafeNewStore(tree), cacheSize)
+
+			keys := make([][]byte, 20000)
+			for i := range keys {
+				keys[i] = make([]byte, 8)
+				_, err := rand.Read(keys[i])
+				require.NoError(b, err)
+				store.Set(keys[i], keys[i])
+			}
+			store.Commit()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				store.Get(keys[i%len(keys)])
+			}
+		})
+	}
+}
@@ -3,8 +3,11 @@ package cache
 import (
 	"fmt"
 
+	"github.com/armon/go-metrics"
+
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -25,9 +28,14 @@ type (
 	// and cached. Deletes and writes always happen to both the cache and the
 	// CommitKVStore in a write-through manner. Caching performed in the
 	// CommitKVStore and below is completely irrelevant to this layer.
+	//
+	// The cache hits and misses are counted, and its number of entries measured
+	// at each commit, in the store/cache telemetry metrics, labeled with the name
+	// of the store.
 	CommitKVStoreCache struct {
 		types.CommitKVStore
-		cache *lru.ARCCache
+		cache  *lru.ARCCache
+		labels []metrics.Label
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -64,7 +72,9 @@ func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
 // The returned Cache is meant to be used in a persistent manner.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		cache := NewCommitKVStoreCache(store, cmgr.cacheSize)
+		cache.labels = []metrics.Label{telemetry.NewLabel("store", key.Name())}
+		cmgr.caches[key.Name()] = cache
 	}
 
 	return cmgr.caches[key.Name()]
@@ -104,10 +114,12 @@ func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	valueI, ok := ckv.cache.Get(keyStr)
 	if ok {
 		// cache hit
+		telemetry.IncrCounterWithLabels([]string{"store", "cache", "hit"}, 1, ckv.labels)
 		return valueI.([]byte)
 	}
 
 	// cache miss; write to cache
+	telemetry.IncrCounterWithLabels([]string{"store", "cache", "miss"}, 1, ckv.labels)
 	value := ckv.CommitKVStore.Get(key)
 	ckv.cache.Add(keyStr, value)
 
@@ -130,3 +142,10 @@ func (ckv *CommitKVStoreCache) Delete(key []byte) {
 	ckv.cache.Remove(string(key))
 	ckv.CommitKVStore.Delete(key)
 }
+
+// Commit commits the underlying CommitKVStore, and reports the number of entries
+// of the cache.
+func (ckv *CommitKVStoreCache) Commit() types.CommitID {
+	telemetry.SetGaugeWithLabels([]string{"store", "cache", "entries"}, float32(ckv.cache.Len()), ckv.labels)
+	return ckv.CommitKVStore.Commit()
+}
@@ -3,7 +3,9 @@ package cache_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/iavl"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
@@ -18,7 +20,7 @@ func TestGetOrSetStoreCache(t *testing.T) {
 	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 
 	sKey := types.NewKVStoreKey("test")
-	tree, err := iavl.NewMutableTree(db, 100)
+	tree, err := iavl.NewMutableTree(db, 100, false)
 	require.NoError(t, err)
 	store := iavlstore.UnsafeNewStore(tree)
 	store2 := mngr.GetStoreCache(sKey, store)
@@ -32,7 +34,7 @@ func TestUnwrap(t *testing.T) {
 	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 
 	sKey := types.NewKVStoreKey("test")
-	tree, err := iavl.NewMutableTree(db, 100)
+	tree, err := iavl.NewMutableTree(db, 100, false)
 	require.NoError(t, err)
 	store := iavlstore.UnsafeNewStore(tree)
 	_ = mngr.GetStoreCache(sKey, store)
@@ -46,7 +48,7 @@ func TestStoreCache(t *testing.T) {
 	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 
 	sKey := types.NewKVStoreKey("test")
-	tree, err := iavl.NewMutableTree(db, 100)
+	tree, err := iavl.NewMutableTree(db, 100, false)
 	require.NoError(t, err)
 	store := iavlstore.UnsafeNewStore(tree)
 	kvStore := mngr.GetStoreCache(sKey, store)
@@ -67,3 +69,32 @@ func TestStoreCache(t *testing.T) {
 		require.Nil(t, store.Get(key))
 	}
 }
+
+func TestStoreCacheTelemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(cfg, &metrics.BlackholeSink{}) //nolint:errcheck
+
+	db := dbm.NewMemDB()
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	kvStore := mngr.GetStoreCache(types.NewKVStoreKey("test"), iavlstore.UnsafeNewStore(tree))
+
+	kvStore.Set([]byte("key1"), []byte("value1"))
+	kvStore.Get([]byte("key1"))
+	kvStore.Get([]byte("key1"))
+	kvStore.Get([]byte("key2"))
+	kvStore.Commit()
+
+	// the metrics are labeled with the name of the store
+	data := sink.Data()
+	require.Len(t, data, 1)
+	require.Equal(t, 2, data[0].Counters["store.cache.hit;store=test"].Count)
+	require.Equal(t, 1, data[0].Counters["store.cache.miss;store=test"].Count)
+	require.Equal(t, float32(2), data[0].Gauges["store.cache.entries;store=test"].Value)
+}
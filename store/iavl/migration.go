@@ -0,0 +1,159 @@
+package iavl
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/iavl"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// fastNodePrefix is the prefix of the keys of the fast nodes in the database
+// of an IAVL tree.
+const fastNodePrefix = 'f'
+
+// MigrationReportInterval is the interval at which the progress of the
+// migration of an IAVL tree to the fast node index is reported.
+var MigrationReportInterval = 10 * time.Second
+
+// treeSize returns the number of keys of the given version, or the latest one
+// if 0, of the tree stored in db, without migrating it.
+func treeSize(db dbm.DB, version int64, opts *iavl.Options) (int64, error) {
+	tree, err := iavl.NewMutableTreeWithOpts(db, DefaultIAVLCacheSize, opts, true)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tree.LazyLoadVersion(version); err != nil {
+		return 0, err
+	}
+
+	return tree.Size(), nil
+}
+
+// migrationProgress reports the progress of the migration of an IAVL tree to
+// the fast node index, which is written while the tree is loaded, by counting
+// the fast nodes written to the database of the tree.
+type migrationProgress struct {
+	logger   log.Logger
+	labels   []metrics.Label
+	size     int64
+	migrated uint64 // accessed atomically
+
+	startTime time.Time
+	quit      chan struct{}
+	stopped   chan struct{}
+}
+
+func newMigrationProgress(logger log.Logger, key types.StoreKey, size int64) *migrationProgress {
+	var labels []metrics.Label
+	if key != nil {
+		logger = logger.With("store", key.Name())
+		labels = []metrics.Label{telemetry.NewLabel("store", key.Name())}
+	}
+
+	return &migrationProgress{
+		logger:  logger,
+		labels:  labels,
+		size:    size,
+		quit:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+}
+
+// wrapDB returns the database of the tree being migrated, counting the fast
+// nodes written to db.
+func (m *migrationProgress) wrapDB(db dbm.DB) dbm.DB {
+	return &fastNodeCountingDB{DB: db, count: &m.migrated}
+}
+
+// start reports the progress at every MigrationReportInterval until stop is
+// called.
+func (m *migrationProgress) start() {
+	m.startTime = time.Now()
+	m.logger.Info("migrating the IAVL store to the fast node index, this may take a while", "keys", m.size)
+
+	go func() {
+		defer close(m.stopped)
+
+		ticker := time.NewTicker(MigrationReportInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-m.quit:
+				return
+
+			case <-ticker.C:
+				migrated := m.report()
+				m.logger.Info(
+					"migrating the IAVL store to the fast node index",
+					"migrated", migrated, "keys", m.size, "eta", migrationETA(time.Since(m.startTime), migrated, m.size),
+				)
+			}
+		}
+	}()
+}
+
+// stop stops the reports, logging the end of the migration unless it failed
+// with err.
+func (m *migrationProgress) stop(err error) {
+	close(m.quit)
+	<-m.stopped
+
+	migrated := m.report()
+	if err != nil {
+		m.logger.Error("failed to migrate the IAVL store to the fast node index", "migrated", migrated, "err", err)
+		return
+	}
+
+	m.logger.Info("migrated the IAVL store to the fast node index", "migrated", migrated, "duration", time.Since(m.startTime))
+}
+
+// report sets the telemetry gauge of the number of migrated keys, which it
+// returns.
+func (m *migrationProgress) report() uint64 {
+	migrated := atomic.LoadUint64(&m.migrated)
+	telemetry.SetGaugeWithLabels([]string{"store", "iavl", "fastnode", "migrated"}, float32(migrated), m.labels)
+	return migrated
+}
+
+// migrationETA estimates the time left to migrate the size keys of a tree,
+// given that migrated of them were migrated in elapsed.
+func migrationETA(elapsed time.Duration, migrated uint64, size int64) time.Duration {
+	if migrated == 0 || int64(migrated) >= size {
+		return 0
+	}
+
+	left := float64(size - int64(migrated))
+	return time.Duration(float64(elapsed) * left / float64(migrated)).Round(time.Second)
+}
+
+// fastNodeCountingDB is a database whose batches count the fast nodes written
+// to them.
+type fastNodeCountingDB struct {
+	dbm.DB
+	count *uint64
+}
+
+func (db *fastNodeCountingDB) NewBatch() dbm.Batch {
+	return &fastNodeCountingBatch{Batch: db.DB.NewBatch(), count: db.count}
+}
+
+type fastNodeCountingBatch struct {
+	dbm.Batch
+	count *uint64
+}
+
+func (b *fastNodeCountingBatch) Set(key, value []byte) error {
+	if len(key) > 0 && key[0] == fastNodePrefix {
+		atomic.AddUint64(b.count, 1)
+	}
+
+	return b.Batch.Set(key, value)
+}
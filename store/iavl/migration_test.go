@@ -0,0 +1,165 @@
+package iavl
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// recordLogger records the messages logged at the info level.
+type recordLogger struct {
+	log.Logger
+	mtx  *sync.Mutex
+	msgs *[]string
+}
+
+func newRecordLogger() recordLogger {
+	return recordLogger{Logger: log.NewNopLogger(), mtx: &sync.Mutex{}, msgs: &[]string{}}
+}
+
+func (l recordLogger) Info(msg string, keyVals ...interface{}) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	*l.msgs = append(*l.msgs, fmt.Sprint(append([]interface{}{msg}, keyVals...)...))
+}
+
+func (l recordLogger) With(_ ...interface{}) log.Logger {
+	return l
+}
+
+// isMigrated returns whether the tree stored in db has the fast node index.
+func isMigrated(t *testing.T, db dbm.DB) bool {
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
+	require.NoError(t, err)
+	isUpgradeable, err := tree.IsUpgradeable()
+	require.NoError(t, err)
+	return !isUpgradeable
+}
+
+// queryAll returns the answers of the store to the reads and queries of the
+// given keys.
+func queryAll(t *testing.T, store *Store, keys [][]byte) []interface{} {
+	var answers []interface{}
+	for _, key := range keys {
+		answers = append(answers, store.Get(key), store.Has(key))
+
+		for _, height := range []int64{0, store.LastCommitID().Version} {
+			res := store.Query(abci.RequestQuery{Path: "/key", Data: key, Height: height, Prove: true})
+			require.EqualValues(t, 0, res.Code)
+			answers = append(answers, res)
+		}
+	}
+
+	for _, iterator := range []types.Iterator{
+		store.Iterator(nil, nil),
+		store.ReverseIterator(nil, nil),
+		store.Iterator(keys[1], keys[len(keys)/2]),
+		store.ReverseIterator(keys[1], keys[len(keys)/2]),
+	} {
+		for ; iterator.Valid(); iterator.Next() {
+			answers = append(answers, iterator.Key(), iterator.Value())
+		}
+		require.NoError(t, iterator.Close())
+	}
+
+	res := store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("key")})
+	require.EqualValues(t, 0, res.Code)
+	return append(answers, res)
+}
+
+func TestLoadStoreMigratesFastNodes(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := types.NewKVStoreKey("test")
+
+	// a store built without the fast node index, with keys set, overwritten
+	// and deleted across versions
+	store, err := LoadStoreWithOpts(db, log.NewNopLogger(), key, types.CommitID{}, false, 0, DefaultIAVLCacheSize, true)
+	require.NoError(t, err)
+
+	var keys [][]byte
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, []byte(fmt.Sprintf("key%04d", i)))
+		store.Set(keys[i], []byte(fmt.Sprintf("value%d", i)))
+	}
+	store.Commit()
+	for i := 0; i < len(keys); i += 3 {
+		store.Set(keys[i], []byte(fmt.Sprintf("overwritten%d", i)))
+	}
+	for i := 1; i < len(keys); i += 5 {
+		store.Delete(keys[i])
+	}
+	commitID := store.Commit()
+	keys = append(keys, []byte("missing"))
+
+	require.False(t, isMigrated(t, db))
+	expected := queryAll(t, store.(*Store), keys)
+
+	// loading it with the fast node index disabled leaves it as it is
+	store, err = LoadStoreWithOpts(db, log.NewNopLogger(), key, commitID, false, 0, DefaultIAVLCacheSize, true)
+	require.NoError(t, err)
+	require.False(t, isMigrated(t, db))
+	require.Equal(t, expected, queryAll(t, store.(*Store), keys))
+
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(cfg, &metrics.BlackholeSink{}) //nolint:errcheck
+
+	// otherwise it is migrated, reporting the keys of the loaded version
+	logger := newRecordLogger()
+	store, err = LoadStoreWithOpts(db, logger, key, commitID, false, 0, DefaultIAVLCacheSize, false)
+	require.NoError(t, err)
+	require.True(t, isMigrated(t, db))
+
+	size := store.(*Store).tree.(*iavl.MutableTree).Size()
+	require.Equal(t, int64(800), size)
+	msgs := *logger.msgs
+	require.Equal(t, fmt.Sprint("migrating the IAVL store to the fast node index, this may take a while", "keys", size), msgs[0])
+	require.Contains(t, msgs[len(msgs)-1], fmt.Sprint("migrated the IAVL store to the fast node index", "migrated", size))
+	require.Equal(t, float32(size), sink.Data()[0].Gauges["store.iavl.fastnode.migrated;store=test"].Value)
+
+	// and answers the same
+	require.Equal(t, expected, queryAll(t, store.(*Store), keys))
+
+	// the next loads leave it as it is
+	logger = newRecordLogger()
+	store, err = LoadStoreWithOpts(db, logger, key, commitID, false, 0, DefaultIAVLCacheSize, false)
+	require.NoError(t, err)
+	require.Empty(t, *logger.msgs)
+	require.Equal(t, expected, queryAll(t, store.(*Store), keys))
+}
+
+func TestMigrationETA(t *testing.T) {
+	testCases := []struct {
+		name     string
+		elapsed  time.Duration
+		migrated uint64
+		size     int64
+		expETA   time.Duration
+	}{
+		{"nothing migrated", time.Minute, 0, 100, 0},
+		{"quarter migrated", time.Minute, 25, 100, 3 * time.Minute},
+		{"half migrated", 10 * time.Second, 500, 1000, 10 * time.Second},
+		{"rounded", 10 * time.Second, 3, 4, 3 * time.Second},
+		{"all migrated", time.Minute, 100, 100, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expETA, migrationETA(tc.elapsed, tc.migrated, tc.size))
+		})
+	}
+}
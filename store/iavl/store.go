@@ -9,6 +9,7 @@ import (
 	ics23 "github.com/confio/ics23/go"
 	"github.com/cosmos/iavl"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	dbm "github.com/tendermint/tm-db"
 
@@ -22,7 +23,9 @@ import (
 )
 
 const (
-	defaultIAVLCacheSize = 10000
+	// DefaultIAVLCacheSize is the number of nodes kept in the node cache of
+	// each IAVL store by default.
+	DefaultIAVLCacheSize = 10000
 )
 
 var (
@@ -50,17 +53,64 @@ func LoadStore(db dbm.DB, id types.CommitID, lazyLoading bool) (types.CommitKVSt
 // provided DB. An error is returned if the version fails to load, or if called with a positive
 // version on an empty tree.
 func LoadStoreWithInitialVersion(db dbm.DB, id types.CommitID, lazyLoading bool, initialVersion uint64) (types.CommitKVStore, error) {
-	tree, err := iavl.NewMutableTreeWithOpts(db, defaultIAVLCacheSize, &iavl.Options{InitialVersion: initialVersion})
+	return LoadStoreWithOpts(db, log.NewNopLogger(), nil, id, lazyLoading, initialVersion, DefaultIAVLCacheSize, false)
+}
+
+// LoadStoreWithOpts returns an IAVL Store as a CommitKVStore setting its
+// initialVersion and the number of nodes kept in its node cache to the ones
+// given, and disabling its fast node index or not. Internally, it will load
+// the store's version (id) from the provided DB. An error is returned if the
+// version fails to load, or if called with a positive version on an empty tree.
+//
+// Unless it is disabled, a tree without the fast node index is migrated to it
+// while loading, which takes a while for large trees. The progress is then
+// reported to the logger, and to telemetry labeled with the name of the key,
+// which may be nil.
+func LoadStoreWithOpts(
+	db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID, lazyLoading bool, initialVersion uint64,
+	cacheSize int, disableFastNode bool,
+) (types.CommitKVStore, error) {
+	opts := &iavl.Options{InitialVersion: initialVersion}
+	tree, err := iavl.NewMutableTreeWithOpts(db, cacheSize, opts, disableFastNode)
+	if err != nil {
+		return nil, err
+	}
+
+	isUpgradeable, err := tree.IsUpgradeable()
 	if err != nil {
 		return nil, err
 	}
 
+	var migration *migrationProgress
+	if isUpgradeable {
+		size, err := treeSize(db, id.Version, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// the fast nodes are written while loading the version, they are
+		// counted to report the progress
+		if size > 0 {
+			migration = newMigrationProgress(logger, key, size)
+			tree, err = iavl.NewMutableTreeWithOpts(migration.wrapDB(db), cacheSize, opts, disableFastNode)
+			if err != nil {
+				return nil, err
+			}
+
+			migration.start()
+		}
+	}
+
 	if lazyLoading {
 		_, err = tree.LazyLoadVersion(id.Version)
 	} else {
 		_, err = tree.LoadVersion(id.Version)
 	}
 
+	if migration != nil {
+		migration.stop(err)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -120,9 +170,14 @@ func (st *Store) Commit() types.CommitID {
 
 // LastCommitID implements Committer.
 func (st *Store) LastCommitID() types.CommitID {
+	hash, err := st.tree.Hash()
+	if err != nil {
+		panic(err)
+	}
+
 	return types.CommitID{
 		Version: st.tree.Version(),
-		Hash:    st.tree.Hash(),
+		Hash:    hash,
 	}
 }
 
@@ -167,26 +222,39 @@ func (st *Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []typ
 func (st *Store) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
-	st.tree.Set(key, value)
+	_, err := st.tree.Set(key, value)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "get")
-	_, value := st.tree.Get(key)
+	value, err := st.tree.Get(key)
+	if err != nil {
+		panic(err)
+	}
 	return value
 }
 
 // Implements types.KVStore.
 func (st *Store) Has(key []byte) (exists bool) {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "has")
-	return st.tree.Has(key)
+	has, err := st.tree.Has(key)
+	if err != nil {
+		panic(err)
+	}
+	return has
 }
 
 // Implements types.KVStore.
 func (st *Store) Delete(key []byte) {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "delete")
-	st.tree.Remove(key)
+	_, _, err := st.tree.Remove(key)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // DeleteVersions deletes a series of versions from the MutableTree. An error
@@ -196,32 +264,34 @@ func (st *Store) DeleteVersions(versions ...int64) error {
 	return st.tree.DeleteVersions(versions...)
 }
 
+// LoadVersionForOverwriting loads a previously committed version of the
+// MutableTree and deletes all the versions after it, which makes it the latest
+// version. An error is returned if the version does not exist, e.g. because it
+// was pruned.
+func (st *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return 0, errors.New("cannot overwrite the versions of an immutable IAVL tree")
+	}
+	return tree.LoadVersionForOverwriting(targetVersion)
+}
+
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
-	var iTree *iavl.ImmutableTree
-
-	switch tree := st.tree.(type) {
-	case *immutableTree:
-		iTree = tree.ImmutableTree
-	case *iavl.MutableTree:
-		iTree = tree.ImmutableTree
+	iterator, err := st.tree.Iterator(start, end, true)
+	if err != nil {
+		panic(err)
 	}
-
-	return newIAVLIterator(iTree, start, end, true)
+	return iterator
 }
 
 // Implements types.KVStore.
 func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
-	var iTree *iavl.ImmutableTree
-
-	switch tree := st.tree.(type) {
-	case *immutableTree:
-		iTree = tree.ImmutableTree
-	case *iavl.MutableTree:
-		iTree = tree.ImmutableTree
+	iterator, err := st.tree.Iterator(start, end, false)
+	if err != nil {
+		panic(err)
 	}
-
-	return newIAVLIterator(iTree, start, end, false)
+	return iterator
 }
 
 // SetInitialVersion sets the initial version of the IAVL tree. It is used when
@@ -232,6 +302,11 @@ func (st *Store) SetInitialVersion(version int64) {
 
 // Exports the IAVL store at the given version, returning an iavl.Exporter for the tree.
 func (st *Store) Export(version int64) (*iavl.Exporter, error) {
+	// GetImmutable returns an empty tree, which cannot be exported, for the
+	// versions which do not exist, e.g. because they have been pruned
+	if !st.VersionExists(version) {
+		return nil, fmt.Errorf("iavl export failed: version %v does not exist", version)
+	}
 	istore, err := st.GetImmutable(version)
 	if err != nil {
 		return nil, fmt.Errorf("iavl export failed for version %v: %w", version, err)
@@ -296,7 +371,12 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 			break
 		}
 
-		_, res.Value = tree.GetVersioned(key, res.Height)
+		value, err := tree.GetVersioned(key, res.Height)
+		if err != nil {
+			panic(err)
+		}
+		res.Value = value
+
 		if !req.Prove {
 			break
 		}
@@ -371,22 +451,3 @@ func getProofFromTree(tree *iavl.MutableTree, key []byte, exists bool) *tmcrypto
 	op := types.NewIavlCommitmentOp(key, commitmentProof)
 	return &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{op.ProofOp()}}
 }
-
-//----------------------------------------
-
-// Implements types.Iterator.
-type iavlIterator struct {
-	*iavl.Iterator
-}
-
-var _ types.Iterator = (*iavlIterator)(nil)
-
-// newIAVLIterator will create a new iavlIterator.
-// CONTRACT: Caller must release the iavlIterator, as each one creates a new
-// goroutine.
-func newIAVLIterator(tree *iavl.ImmutableTree, start, end []byte, ascending bool) *iavlIterator {
-	iter := &iavlIterator{
-		Iterator: tree.Iterator(start, end, ascending),
-	}
-	return iter
-}
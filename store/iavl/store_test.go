@@ -3,6 +3,7 @@ package iavl
 import (
 	crand "crypto/rand"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/iavl"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -33,7 +35,7 @@ func randBytes(numBytes int) []byte {
 
 // make a tree with data from above and save it
 func newAlohaTree(t *testing.T, db dbm.DB) (*iavl.MutableTree, types.CommitID) {
-	tree, err := iavl.NewMutableTree(db, cacheSize)
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
 	require.NoError(t, err)
 
 	for k, v := range treeData {
@@ -58,13 +60,17 @@ func TestLoadStore(t *testing.T) {
 	store := UnsafeNewStore(tree)
 
 	// Create non-pruned height H
-	require.True(t, tree.Set([]byte("hello"), []byte("hallo")))
+	updated, err := tree.Set([]byte("hello"), []byte("hallo"))
+	require.NoError(t, err)
+	require.True(t, updated)
 	hash, verH, err := tree.SaveVersion()
 	cIDH := types.CommitID{Version: verH, Hash: hash}
 	require.Nil(t, err)
 
 	// Create pruned height Hp
-	require.True(t, tree.Set([]byte("hello"), []byte("hola")))
+	updated, err = tree.Set([]byte("hello"), []byte("hola"))
+	require.NoError(t, err)
+	require.True(t, updated)
 	hash, verHp, err := tree.SaveVersion()
 	cIDHp := types.CommitID{Version: verHp, Hash: hash}
 	require.Nil(t, err)
@@ -72,7 +78,9 @@ func TestLoadStore(t *testing.T) {
 	// TODO: Prune this height
 
 	// Create current height Hc
-	require.True(t, tree.Set([]byte("hello"), []byte("ciao")))
+	updated, err = tree.Set([]byte("hello"), []byte("ciao"))
+	require.NoError(t, err)
+	require.True(t, updated)
 	hash, verHc, err := tree.SaveVersion()
 	cIDHc := types.CommitID{Version: verHc, Hash: hash}
 	require.Nil(t, err)
@@ -113,7 +121,9 @@ func TestGetImmutable(t *testing.T) {
 	tree, cID := newAlohaTree(t, db)
 	store := UnsafeNewStore(tree)
 
-	require.True(t, tree.Set([]byte("hello"), []byte("adios")))
+	updated, err := tree.Set([]byte("hello"), []byte("adios"))
+	require.NoError(t, err)
+	require.True(t, updated)
 	hash, ver, err := tree.SaveVersion()
 	cID = types.CommitID{Version: ver, Hash: hash}
 	require.Nil(t, err)
@@ -273,7 +283,7 @@ func TestIAVLIterator(t *testing.T) {
 func TestIAVLReverseIterator(t *testing.T) {
 	db := dbm.NewMemDB()
 
-	tree, err := iavl.NewMutableTree(db, cacheSize)
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
 	require.NoError(t, err)
 
 	iavlStore := UnsafeNewStore(tree)
@@ -306,7 +316,7 @@ func TestIAVLReverseIterator(t *testing.T) {
 
 func TestIAVLPrefixIterator(t *testing.T) {
 	db := dbm.NewMemDB()
-	tree, err := iavl.NewMutableTree(db, cacheSize)
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
 	require.NoError(t, err)
 
 	iavlStore := UnsafeNewStore(tree)
@@ -370,7 +380,7 @@ func TestIAVLPrefixIterator(t *testing.T) {
 
 func TestIAVLReversePrefixIterator(t *testing.T) {
 	db := dbm.NewMemDB()
-	tree, err := iavl.NewMutableTree(db, cacheSize)
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
 	require.NoError(t, err)
 
 	iavlStore := UnsafeNewStore(tree)
@@ -438,7 +448,7 @@ func nextVersion(iavl *Store) {
 
 func TestIAVLNoPrune(t *testing.T) {
 	db := dbm.NewMemDB()
-	tree, err := iavl.NewMutableTree(db, cacheSize)
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
 	require.NoError(t, err)
 
 	iavlStore := UnsafeNewStore(tree)
@@ -457,7 +467,7 @@ func TestIAVLNoPrune(t *testing.T) {
 
 func TestIAVLStoreQuery(t *testing.T) {
 	db := dbm.NewMemDB()
-	tree, err := iavl.NewMutableTree(db, cacheSize)
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
 	require.NoError(t, err)
 
 	iavlStore := UnsafeNewStore(tree)
@@ -561,7 +571,7 @@ func BenchmarkIAVLIteratorNext(b *testing.B) {
 	b.ReportAllocs()
 	db := dbm.NewMemDB()
 	treeSize := 1000
-	tree, err := iavl.NewMutableTree(db, cacheSize)
+	tree, err := iavl.NewMutableTree(db, cacheSize, false)
 	require.NoError(b, err)
 
 	for i := 0; i < treeSize; i++ {
@@ -586,6 +596,50 @@ func BenchmarkIAVLIteratorNext(b *testing.B) {
 	}
 }
 
+// nodeCacheSize returns the size of the node cache of the tree of the store.
+func nodeCacheSize(store *Store) int64 {
+	ndb := reflect.ValueOf(store.tree).Elem().FieldByName("ndb")
+	return ndb.Elem().FieldByName("nodeCache").Elem().Elem().FieldByName("maxElementCount").Int()
+}
+
+func TestLoadStoreWithOptsCacheSize(t *testing.T) {
+	db := dbm.NewMemDB()
+	id := types.CommitID{}
+
+	store, err := LoadStore(db, id, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(DefaultIAVLCacheSize), nodeCacheSize(store.(*Store)))
+
+	store, err = LoadStoreWithOpts(db, log.NewNopLogger(), nil, id, false, 0, 42, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), nodeCacheSize(store.(*Store)))
+}
+
+// BenchmarkIAVLGet measures the reads of random keys of a store holding more
+// nodes than the smallest node caches.
+func BenchmarkIAVLGet(b *testing.B) {
+	for _, cacheSize := range []int{0, 1000, DefaultIAVLCacheSize, 100000} {
+		b.Run(fmt.Sprintf("cache-size-%d", cacheSize), func(b *testing.B) {
+			db := dbm.NewMemDB()
+			store, err := LoadStoreWithOpts(db, log.NewNopLogger(), nil, types.CommitID{}, false, 0, cacheSize, false)
+			require.NoError(b, err)
+
+			keys := make([][]byte, 20000)
+			for i := range keys {
+				keys[i] = randBytes(8)
+				store.Set(keys[i], randBytes(50))
+			}
+			store.Commit()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				store.Get(keys[i%len(keys)])
+			}
+		})
+	}
+}
+
 func TestSetInitialVersion(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -595,7 +649,7 @@ func TestSetInitialVersion(t *testing.T) {
 		{
 			"works with a mutable tree",
 			func(db *dbm.MemDB) *Store {
-				tree, err := iavl.NewMutableTree(db, cacheSize)
+				tree, err := iavl.NewMutableTree(db, cacheSize, false)
 				require.NoError(t, err)
 				store := UnsafeNewStore(tree)
 
@@ -605,7 +659,7 @@ func TestSetInitialVersion(t *testing.T) {
 		{
 			"throws error on immutable tree",
 			func(db *dbm.MemDB) *Store {
-				tree, err := iavl.NewMutableTree(db, cacheSize)
+				tree, err := iavl.NewMutableTree(db, cacheSize, false)
 				require.NoError(t, err)
 				store := UnsafeNewStore(tree)
 				_, version, err := store.tree.SaveVersion()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/iavl"
+	dbm "github.com/tendermint/tm-db"
 )
 
 var (
@@ -17,20 +18,21 @@ type (
 	// implemented by an iavl.MutableTree. For an immutable IAVL tree, a wrapper
 	// must be made.
 	Tree interface {
-		Has(key []byte) bool
-		Get(key []byte) (index int64, value []byte)
-		Set(key, value []byte) bool
-		Remove(key []byte) ([]byte, bool)
+		Has(key []byte) (bool, error)
+		Get(key []byte) ([]byte, error)
+		Set(key, value []byte) (bool, error)
+		Remove(key []byte) ([]byte, bool, error)
 		SaveVersion() ([]byte, int64, error)
 		DeleteVersion(version int64) error
 		DeleteVersions(versions ...int64) error
 		Version() int64
-		Hash() []byte
+		Hash() ([]byte, error)
 		VersionExists(version int64) bool
-		GetVersioned(key []byte, version int64) (int64, []byte)
+		GetVersioned(key []byte, version int64) ([]byte, error)
 		GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error)
 		GetImmutable(version int64) (*iavl.ImmutableTree, error)
 		SetInitialVersion(version uint64)
+		Iterator(start, end []byte, ascending bool) (dbm.Iterator, error)
 	}
 
 	// immutableTree is a simple wrapper around a reference to an iavl.ImmutableTree
@@ -41,11 +43,11 @@ type (
 	}
 )
 
-func (it *immutableTree) Set(_, _ []byte) bool {
+func (it *immutableTree) Set(_, _ []byte) (bool, error) {
 	panic("cannot call 'Set' on an immutable IAVL tree")
 }
 
-func (it *immutableTree) Remove(_ []byte) ([]byte, bool) {
+func (it *immutableTree) Remove(_ []byte) ([]byte, bool, error) {
 	panic("cannot call 'Remove' on an immutable IAVL tree")
 }
 
@@ -69,14 +71,24 @@ func (it *immutableTree) VersionExists(version int64) bool {
 	return it.Version() == version
 }
 
-func (it *immutableTree) GetVersioned(key []byte, version int64) (int64, []byte) {
+func (it *immutableTree) GetVersioned(key []byte, version int64) ([]byte, error) {
 	if it.Version() != version {
-		return -1, nil
+		return nil, nil
 	}
 
 	return it.Get(key)
 }
 
+func (it *immutableTree) Iterator(start, end []byte, ascending bool) (dbm.Iterator, error) {
+	// the empty tree of the versions which do not exist has no node db to
+	// look the fast nodes up in
+	if it.Size() == 0 {
+		return iavl.NewIterator(start, end, ascending, it.ImmutableTree), nil
+	}
+
+	return it.ImmutableTree.Iterator(start, end, ascending)
+}
+
 func (it *immutableTree) GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error) {
 	if it.Version() != version {
 		return nil, nil, fmt.Errorf("version mismatch on immutable IAVL tree; got: %d, expected: %d", version, it.Version())
@@ -15,6 +15,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
@@ -31,9 +32,12 @@ import (
 )
 
 const (
-	latestVersionKey = "s/latest"
-	pruneHeightsKey  = "s/pruneheights"
-	commitInfoKeyFmt = "s/%d" // s/<version>
+	latestVersionKey        = "s/latest"
+	pruneHeightsKey         = "s/pruneheights"
+	storePruneHeightsKeyFmt = "s/pruneheights/%s" // s/pruneheights/<store name>
+	storePrefixKeyPrefix    = "s/prefix/"
+	storePrefixKeyFmt       = "s/prefix/%s" // s/prefix/<store name>
+	commitInfoKeyFmt        = "s/%d"        // s/<version>
 
 	// Do not change chunk size without new snapshot format (must be uniform across nodes)
 	snapshotChunkSize   = uint64(10e6)
@@ -48,13 +52,28 @@ type Store struct {
 	db             dbm.DB
 	lastCommitInfo *types.CommitInfo
 	pruningOpts    types.PruningOptions
-	storesParams   map[types.StoreKey]storeParams
-	stores         map[types.StoreKey]types.CommitKVStore
-	keysByName     map[string]types.StoreKey
-	lazyLoading    bool
-	pruneHeights   []int64
-	initialVersion int64
-	removalMap     map[types.StoreKey]bool
+	// the pruning strategies of the stores not pruned with pruningOpts, and
+	// their heights to prune, by store name
+	pruningOverrides  map[string]types.PruningOptions
+	storePruneHeights map[string][]int64
+	storesParams      map[types.StoreKey]storeParams
+	stores            map[types.StoreKey]types.CommitKVStore
+	keysByName        map[string]types.StoreKey
+	lazyLoading       bool
+	iavlCacheSize     int
+	pruneHeights      []int64
+	initialVersion    int64
+	removalMap        map[types.StoreKey]bool
+	// the prefixes of the stores of rs.db not stored under their default
+	// prefix, as the stores renamed in place, and the new ones to write at the
+	// next commit, by store name
+	storePrefixes    map[string]string
+	newStorePrefixes map[string]string
+
+	// iavlDisableFastNode disables the fast node index of the IAVL stores,
+	// which are otherwise migrated to it when they are loaded
+	iavlDisableFastNode bool
+	logger              log.Logger
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
@@ -75,14 +94,20 @@ var (
 // LoadVersion must be called.
 func NewStore(db dbm.DB) *Store {
 	return &Store{
-		db:           db,
-		pruningOpts:  types.PruneNothing,
-		storesParams: make(map[types.StoreKey]storeParams),
-		stores:       make(map[types.StoreKey]types.CommitKVStore),
-		keysByName:   make(map[string]types.StoreKey),
-		pruneHeights: make([]int64, 0),
-		listeners:    make(map[types.StoreKey][]types.WriteListener),
-		removalMap:   make(map[types.StoreKey]bool),
+		db:                db,
+		pruningOpts:       types.PruneNothing,
+		storesParams:      make(map[types.StoreKey]storeParams),
+		stores:            make(map[types.StoreKey]types.CommitKVStore),
+		keysByName:        make(map[string]types.StoreKey),
+		iavlCacheSize:     iavl.DefaultIAVLCacheSize,
+		pruneHeights:      make([]int64, 0),
+		pruningOverrides:  make(map[string]types.PruningOptions),
+		storePruneHeights: make(map[string][]int64),
+		listeners:         make(map[types.StoreKey][]types.WriteListener),
+		removalMap:        make(map[types.StoreKey]bool),
+		storePrefixes:     make(map[string]string),
+		newStorePrefixes:  make(map[string]string),
+		logger:            log.NewNopLogger(),
 	}
 }
 
@@ -98,11 +123,44 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
 }
 
+// SetPruningOverride implements CommitMultiStore. The heights of the store
+// with the given name are pruned with the given strategy instead of the one of
+// the root store, e.g. to keep the history of a single store. Note, it must be
+// called prior to LoadVersion or LoadLatestVersion, which fail if no IAVL
+// store is mounted with this name.
+func (rs *Store) SetPruningOverride(name string, pruningOpts types.PruningOptions) {
+	rs.pruningOverrides[name] = pruningOpts
+}
+
+// GetPruningOverrides returns the pruning strategies of the stores overriding
+// the one of the root store, by store name.
+func (rs *Store) GetPruningOverrides() map[string]types.PruningOptions {
+	return rs.pruningOverrides
+}
+
+// SetIAVLCacheSize implements CommitMultiStore. It sets the number of nodes
+// kept in the node cache of each IAVL store, iavl.DefaultIAVLCacheSize by
+// default. It must be called prior to loading a version to apply to its stores.
+func (rs *Store) SetIAVLCacheSize(size int) {
+	rs.iavlCacheSize = size
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetIAVLDisableFastNode implements CommitMultiStore. Note, it must be called
+// prior to LoadVersion or LoadLatestVersion.
+func (rs *Store) SetIAVLDisableFastNode(disable bool) {
+	rs.iavlDisableFastNode = disable
+}
+
+// SetLogger implements CommitMultiStore.
+func (rs *Store) SetLogger(logger log.Logger) {
+	rs.logger = logger
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -171,13 +229,26 @@ func (rs *Store) LoadVersion(ver int64) error {
 }
 
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	if err := rs.validatePruningOverrides(); err != nil {
+		return err
+	}
+	if err := rs.validateStoreUpgrades(upgrades); err != nil {
+		return err
+	}
+
+	storePrefixes, err := getStorePrefixes(rs.db)
+	if err != nil {
+		return err
+	}
+	rs.storePrefixes = storePrefixes
+	rs.newStorePrefixes = make(map[string]string)
+
 	infos := make(map[string]types.StoreInfo)
 
 	cInfo := &types.CommitInfo{}
 
 	// load old data if we are not version 0
 	if ver != 0 {
-		var err error
 		cInfo, err = getCommitInfo(rs.db, ver)
 		if err != nil {
 			return err
@@ -189,6 +260,26 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	// rename the IAVL stores in place, by loading the tree of the old store
+	// under the new name
+	renamedInPlace := make(map[string]bool)
+	if upgrades != nil {
+		for _, rename := range upgrades.Renamed {
+			oldInfo, ok := infos[rename.OldKey]
+			if !ok || !rs.isRenamedInPlace(rename.NewKey) {
+				continue
+			}
+
+			rs.setStorePrefix(rename.NewKey, rs.storePrefix(rename.OldKey))
+			// the old name no longer owns its prefix, should it be mounted again
+			rs.setStorePrefix(rename.OldKey, fmt.Sprintf("s/k:%s@%d/", rename.OldKey, ver+1))
+
+			infos[rename.NewKey] = types.StoreInfo{Name: rename.NewKey, CommitId: oldInfo.CommitId}
+			delete(infos, rename.OldKey)
+			renamedInPlace[rename.NewKey] = true
+		}
+	}
+
 	// load each Store (note this doesn't panic on unmounted keys now)
 	var newStores = make(map[types.StoreKey]types.CommitKVStore)
 
@@ -228,8 +319,9 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 				return errors.Wrapf(err, "failed to delete store %s", key.Name())
 			}
 			rs.removalMap[key] = true
-		} else if oldName := upgrades.RenamedFrom(key.Name()); oldName != "" {
-			// handle renames specially
+		} else if oldName := upgrades.RenamedFrom(key.Name()); oldName != "" && !renamedInPlace[key.Name()] {
+			// handle the renames of the stores which cannot be renamed in place
+			// specially
 			// make an unregistered key to satisfy loadCommitStore params
 			oldKey := types.NewKVStoreKey(oldName)
 			oldParams := storeParams
@@ -257,14 +349,87 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	rs.stores = newStores
 
 	// load any pruned heights we missed from disk to be pruned on the next run
-	ph, err := getPruningHeights(rs.db)
+	ph, err := getPruningHeights(rs.db, pruneHeightsKey)
 	if err == nil && len(ph) > 0 {
 		rs.pruneHeights = ph
 	}
+	for name := range rs.pruningOverrides {
+		ph, err := getPruningHeights(rs.db, fmt.Sprintf(storePruneHeightsKeyFmt, name))
+		if err == nil && len(ph) > 0 {
+			rs.storePruneHeights[name] = ph
+		}
+	}
 
 	return nil
 }
 
+// validatePruningOverrides checks that the pruning overrides are valid
+// strategies of mounted IAVL stores.
+func (rs *Store) validatePruningOverrides() error {
+	for name, pruningOpts := range rs.pruningOverrides {
+		key, ok := rs.keysByName[name]
+		if !ok {
+			return fmt.Errorf("pruning override of unknown store %s", name)
+		}
+		if typ := rs.storesParams[key].typ; typ != types.StoreTypeIAVL {
+			return fmt.Errorf("pruning override of store %s of type %v, only IAVL stores are pruned", name, typ)
+		}
+		if err := pruningOpts.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid pruning override of store %s", name)
+		}
+	}
+
+	return nil
+}
+
+// validateStoreUpgrades checks that the stores are renamed to mounted stores,
+// from stores which are no longer mounted.
+func (rs *Store) validateStoreUpgrades(upgrades *types.StoreUpgrades) error {
+	if upgrades == nil {
+		return nil
+	}
+
+	renamed := make(map[string]bool)
+	for _, rename := range upgrades.Renamed {
+		if _, ok := rs.keysByName[rename.NewKey]; !ok {
+			return fmt.Errorf("store %s renamed from %s is not mounted", rename.NewKey, rename.OldKey)
+		}
+		if _, ok := rs.keysByName[rename.OldKey]; ok {
+			return fmt.Errorf("store %s renamed to %s is still mounted", rename.OldKey, rename.NewKey)
+		}
+		if renamed[rename.OldKey] || renamed[rename.NewKey] {
+			return fmt.Errorf("store %s or %s is renamed twice", rename.OldKey, rename.NewKey)
+		}
+		renamed[rename.OldKey], renamed[rename.NewKey] = true, true
+	}
+
+	return nil
+}
+
+// isRenamedInPlace returns whether the store with the given name is an IAVL
+// store of rs.db, which a store is renamed to by loading the tree of the old
+// store under the new name, instead of copying its data.
+func (rs *Store) isRenamedInPlace(name string) bool {
+	params := rs.storesParams[rs.keysByName[name]]
+	return params.typ == types.StoreTypeIAVL && params.db == nil
+}
+
+// storePrefix returns the prefix of the store with the given name in rs.db.
+func (rs *Store) storePrefix(name string) string {
+	if prefix, ok := rs.storePrefixes[name]; ok {
+		return prefix
+	}
+
+	return "s/k:" + name + "/"
+}
+
+// setStorePrefix sets the prefix of the store with the given name in rs.db,
+// which is persisted at the next commit.
+func (rs *Store) setStorePrefix(name, prefix string) {
+	rs.storePrefixes[name] = prefix
+	rs.newStorePrefixes[name] = prefix
+}
+
 func (rs *Store) getCommitID(infos map[string]types.StoreInfo, name string) types.CommitID {
 	info, ok := infos[name]
 	if !ok {
@@ -398,26 +563,19 @@ func (rs *Store) Commit() types.CommitID {
 	// reset the removalMap
 	rs.removalMap = make(map[types.StoreKey]bool)
 
-	// Determine if pruneHeight height needs to be added to the list of heights to
-	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
-	if int64(rs.pruningOpts.KeepRecent) < previousHeight {
-		pruneHeight := previousHeight - int64(rs.pruningOpts.KeepRecent)
-		// We consider this height to be pruned iff:
-		//
-		// - KeepEvery is zero as that means that all heights should be pruned.
-		// - KeepEvery % (height - KeepRecent) != 0 as that means the height is not
-		// a 'snapshot' height.
-		if rs.pruningOpts.KeepEvery == 0 || pruneHeight%int64(rs.pruningOpts.KeepEvery) != 0 {
-			rs.pruneHeights = append(rs.pruneHeights, pruneHeight)
+	if pruneHeight, ok := getPruneHeight(rs.pruningOpts, previousHeight); ok {
+		rs.pruneHeights = append(rs.pruneHeights, pruneHeight)
+	}
+	for name, pruningOpts := range rs.pruningOverrides {
+		if pruneHeight, ok := getPruneHeight(pruningOpts, previousHeight); ok {
+			rs.storePruneHeights[name] = append(rs.storePruneHeights[name], pruneHeight)
 		}
 	}
 
-	// batch prune if the current height is a pruning interval height
-	if rs.pruningOpts.Interval > 0 && version%int64(rs.pruningOpts.Interval) == 0 {
-		rs.pruneStores()
-	}
+	rs.pruneStores(version)
 
-	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
+	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights, rs.storePruneHeights, rs.newStorePrefixes)
+	rs.newStorePrefixes = make(map[string]string)
 
 	return types.CommitID{
 		Version: version,
@@ -425,28 +583,137 @@ func (rs *Store) Commit() types.CommitID {
 	}
 }
 
-// pruneStores will batch delete a list of heights from each mounted sub-store.
-// Afterwards, pruneHeights is reset.
-func (rs *Store) pruneStores() {
-	if len(rs.pruneHeights) == 0 {
-		return
+// RollbackToVersion implements CommitMultiStore. It deletes the versions of the
+// IAVL stores after the target version, drops the pending pruning heights which
+// are no longer behind the latest version, and reloads the stores at the target
+// version.
+func (rs *Store) RollbackToVersion(target int64) error {
+	latest := getLatestVersion(rs.db)
+	if target <= 0 || target >= latest {
+		return fmt.Errorf("cannot roll back to version %d, the latest version is %d", target, latest)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, target)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the commit info of version %d", target)
+	}
+	infos := make(map[string]types.StoreInfo, len(cInfo.StoreInfos))
+	for _, storeInfo := range cInfo.StoreInfos {
+		infos[storeInfo.Name] = storeInfo
 	}
 
+	// check every store before deleting anything, so that a failure leaves the
+	// stores untouched
+	iavlStores := make(map[types.StoreKey]*iavl.Store)
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		if _, ok := infos[key.Name()]; !ok {
+			return fmt.Errorf("store %s did not exist at version %d", key.Name(), target)
+		}
 
-			if err := store.(*iavl.Store).DeleteVersions(rs.pruneHeights...); err != nil {
-				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
-					panic(err)
-				}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !iavlStore.VersionExists(target) {
+			return fmt.Errorf("version %d of store %s has been pruned", target, key.Name())
+		}
+		iavlStores[key] = iavlStore
+	}
+
+	for key, iavlStore := range iavlStores {
+		if _, err := iavlStore.LoadVersionForOverwriting(target); err != nil {
+			return errors.Wrapf(err, "failed to roll back store %s", key.Name())
+		}
+	}
+
+	pruneHeights := make([]int64, 0, len(rs.pruneHeights))
+	for _, height := range rs.pruneHeights {
+		if height < target {
+			pruneHeights = append(pruneHeights, height)
+		}
+	}
+	rs.pruneHeights = pruneHeights
+	for name, heights := range rs.storePruneHeights {
+		storePruneHeights := make([]int64, 0, len(heights))
+		for _, height := range heights {
+			if height < target {
+				storePruneHeights = append(storePruneHeights, height)
+			}
+		}
+		rs.storePruneHeights[name] = storePruneHeights
+	}
+	flushMetadata(rs.db, target, cInfo, pruneHeights, rs.storePruneHeights, nil)
+
+	// the inter-block caches hold the values of the deleted versions
+	if rs.interBlockCache != nil {
+		rs.interBlockCache.Reset()
+	}
+
+	return rs.LoadLatestVersion()
+}
+
+// getPruneHeight returns the height to prune, if any, when the height after
+// previousHeight is committed with the given pruning strategy.
+func getPruneHeight(pruningOpts types.PruningOptions, previousHeight int64) (int64, bool) {
+	// Determine if pruneHeight height needs to be added to the list of heights to
+	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
+	if int64(pruningOpts.KeepRecent) >= previousHeight {
+		return 0, false
+	}
+
+	pruneHeight := previousHeight - int64(pruningOpts.KeepRecent)
+	// We consider this height to be pruned iff:
+	//
+	// - KeepEvery is zero as that means that all heights should be pruned.
+	// - KeepEvery % (height - KeepRecent) != 0 as that means the height is not
+	// a 'snapshot' height.
+	return pruneHeight, pruningOpts.KeepEvery == 0 || pruneHeight%int64(pruningOpts.KeepEvery) != 0
+}
+
+// isPruningInterval returns true if the given version is a pruning interval
+// height of the given pruning strategy, at which the heights are batch pruned.
+func isPruningInterval(pruningOpts types.PruningOptions, version int64) bool {
+	return pruningOpts.Interval > 0 && version%int64(pruningOpts.Interval) == 0
+}
+
+// pruneStores will batch delete the heights to prune from each mounted sub-store
+// whose pruning strategy has reached a pruning interval height at the given
+// version. Afterwards, the pruned heights are reset.
+func (rs *Store) pruneStores(version int64) {
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		pruningOpts, pruneHeights := rs.pruningOpts, rs.pruneHeights
+		if override, ok := rs.pruningOverrides[key.Name()]; ok {
+			pruningOpts, pruneHeights = override, rs.storePruneHeights[key.Name()]
+		}
+		if len(pruneHeights) == 0 || !isPruningInterval(pruningOpts, version) {
+			continue
+		}
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		store = rs.GetCommitKVStore(key)
+
+		if err := store.(*iavl.Store).DeleteVersions(pruneHeights...); err != nil {
+			if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+				panic(err)
 			}
 		}
 	}
 
-	rs.pruneHeights = make([]int64, 0)
+	if isPruningInterval(rs.pruningOpts, version) {
+		rs.pruneHeights = make([]int64, 0)
+	}
+	for name, pruningOpts := range rs.pruningOverrides {
+		if isPruningInterval(pruningOpts, version) {
+			rs.storePruneHeights[name] = make([]int64, 0)
+		}
+	}
 }
 
 // CacheWrap implements CacheWrapper/Store/CommitStore.
@@ -501,7 +768,8 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		}
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners), nil
+	// the branches of past versions are only read, the listeners have nothing to observe
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext, nil), nil
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -671,6 +939,11 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
+			// check the height now, as the stores can be pruned with different
+			// strategies and the errors of the export may not reach the caller
+			if !store.VersionExists(int64(height)) {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot pruned height %v of store %q", height, key.Name())
+			}
 			stores = append(stores, namedStore{name: key.Name(), Store: store})
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
@@ -872,7 +1145,7 @@ func (rs *Store) Restore(
 		importer.Close()
 	}
 
-	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{}, nil, nil)
 	return rs.LoadLatestVersion()
 }
 
@@ -882,8 +1155,7 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	if params.db != nil {
 		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		db = dbm.NewPrefixDB(rs.db, []byte(rs.storePrefix(params.key.Name())))
 	}
 
 	switch params.typ {
@@ -891,15 +1163,9 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		panic("recursive MultiStores not yet supported")
 
 	case types.StoreTypeIAVL:
-		var store types.CommitKVStore
-		var err error
-
-		if params.initialVersion == 0 {
-			store, err = iavl.LoadStore(db, id, rs.lazyLoading)
-		} else {
-			store, err = iavl.LoadStoreWithInitialVersion(db, id, rs.lazyLoading, params.initialVersion)
-		}
-
+		store, err := iavl.LoadStoreWithOpts(
+			db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -1040,7 +1306,7 @@ func setLatestVersion(batch dbm.Batch, version int64) {
 	batch.Set([]byte(latestVersionKey), bz)
 }
 
-func setPruningHeights(batch dbm.Batch, pruneHeights []int64) {
+func setPruningHeights(batch dbm.Batch, key string, pruneHeights []int64) {
 	bz := make([]byte, 0)
 	for _, ph := range pruneHeights {
 		buf := make([]byte, 8)
@@ -1048,11 +1314,11 @@ func setPruningHeights(batch dbm.Batch, pruneHeights []int64) {
 		bz = append(bz, buf...)
 	}
 
-	batch.Set([]byte(pruneHeightsKey), bz)
+	batch.Set([]byte(key), bz)
 }
 
-func getPruningHeights(db dbm.DB) ([]int64, error) {
-	bz, err := db.Get([]byte(pruneHeightsKey))
+func getPruningHeights(db dbm.DB, key string) ([]int64, error) {
+	bz, err := db.Get([]byte(key))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pruned heights: %w", err)
 	}
@@ -1071,15 +1337,40 @@ func getPruningHeights(db dbm.DB) ([]int64, error) {
 	return prunedHeights, nil
 }
 
-func flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo, pruneHeights []int64) {
+func flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo, pruneHeights []int64, storePruneHeights map[string][]int64, storePrefixes map[string]string) {
 	batch := db.NewBatch()
 	defer batch.Close()
 
 	setCommitInfo(batch, version, cInfo)
 	setLatestVersion(batch, version)
-	setPruningHeights(batch, pruneHeights)
+	setPruningHeights(batch, pruneHeightsKey, pruneHeights)
+	for name, heights := range storePruneHeights {
+		setPruningHeights(batch, fmt.Sprintf(storePruneHeightsKeyFmt, name), heights)
+	}
+	for name, prefix := range storePrefixes {
+		if err := batch.Set([]byte(fmt.Sprintf(storePrefixKeyFmt, name)), []byte(prefix)); err != nil {
+			panic(err)
+		}
+	}
 
 	if err := batch.Write(); err != nil {
 		panic(fmt.Errorf("error on batch write %w", err))
 	}
 }
+
+// getStorePrefixes returns the prefixes of the stores not stored under their
+// default prefix, by store name.
+func getStorePrefixes(db dbm.DB) (map[string]string, error) {
+	itr, err := dbm.IteratePrefix(db, []byte(storePrefixKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	storePrefixes := make(map[string]string)
+	for ; itr.Valid(); itr.Next() {
+		storePrefixes[string(itr.Key()[len(storePrefixKeyPrefix):])] = string(itr.Value())
+	}
+
+	return storePrefixes, itr.Error()
+}
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"reflect"
 	"testing"
 
+	iavltree "github.com/cosmos/iavl"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -303,6 +305,96 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store4"})
 }
 
+func TestMultistoreRenameInPlace(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	k, v := []byte("key"), []byte("value")
+	store.getStoreByName("store2").(types.KVStore).Set(k, v)
+	store.Commit()
+	store.getStoreByName("store2").(types.KVStore).Set(k, []byte("value2"))
+	store.Commit()
+	oldCommitID := store.GetCommitKVStore(testStoreKey2).LastCommitID()
+
+	upgrades := &types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "store2", NewKey: "renamed2"}},
+	}
+	renamedKey := types.NewKVStoreKey("renamed2")
+	restore := NewStore(db)
+	restore.MountStoreWithDB(testStoreKey1, types.StoreTypeIAVL, nil)
+	restore.MountStoreWithDB(renamedKey, types.StoreTypeIAVL, nil)
+	restore.MountStoreWithDB(testStoreKey3, types.StoreTypeIAVL, nil)
+	require.NoError(t, restore.LoadLatestVersionAndUpgrade(upgrades))
+
+	// the tree of store2 is loaded under the new name, with its history,
+	// instead of being copied
+	renamed := restore.GetCommitKVStore(renamedKey).(*iavl.Store)
+	require.Equal(t, oldCommitID, renamed.LastCommitID())
+	require.True(t, renamed.VersionExists(1))
+	require.Equal(t, []byte("value2"), renamed.Get(k))
+	itr, err := dbm.IteratePrefix(db, []byte("s/k:renamed2/"))
+	require.NoError(t, err)
+	require.False(t, itr.Valid())
+	require.NoError(t, itr.Close())
+
+	commitID := restore.Commit()
+	require.Equal(t, int64(3), commitID.Version)
+	require.Equal(t, types.CommitID{Version: 3, Hash: oldCommitID.Hash}, renamed.LastCommitID())
+	ci, err := getCommitInfo(db, 3)
+	require.NoError(t, err)
+	checkContains(t, ci.StoreInfos, []string{"store1", "renamed2", "store3"})
+	for _, info := range ci.StoreInfos {
+		require.NotEqual(t, "store2", info.Name)
+	}
+
+	// the values of the renamed store are proven under its new name
+	res := restore.Query(abci.RequestQuery{Path: "/renamed2/key", Data: k, Height: 3, Prove: true})
+	require.EqualValues(t, 0, res.Code, res.Log)
+	prt := DefaultProofRuntime()
+	require.NoError(t, prt.VerifyValue(res.ProofOps, commitID.Hash, "/renamed2/key", []byte("value2")))
+	require.Error(t, prt.VerifyValue(res.ProofOps, commitID.Hash, "/store2/key", []byte("value2")))
+
+	// the rename persists without the upgrades
+	reload := NewStore(db)
+	reload.MountStoreWithDB(testStoreKey1, types.StoreTypeIAVL, nil)
+	reload.MountStoreWithDB(renamedKey, types.StoreTypeIAVL, nil)
+	reload.MountStoreWithDB(testStoreKey3, types.StoreTypeIAVL, nil)
+	require.NoError(t, reload.LoadLatestVersion())
+	require.Equal(t, commitID, reload.LastCommitID())
+	require.Equal(t, []byte("value2"), reload.getStoreByName("renamed2").(types.KVStore).Get(k))
+	reload.getStoreByName("renamed2").(types.KVStore).Set(k, []byte("value3"))
+	reload.Commit()
+
+	// and a store added with the old name is empty
+	readd := NewStore(db)
+	readd.MountStoreWithDB(testStoreKey1, types.StoreTypeIAVL, nil)
+	readd.MountStoreWithDB(renamedKey, types.StoreTypeIAVL, nil)
+	readd.MountStoreWithDB(testStoreKey2, types.StoreTypeIAVL, nil)
+	readd.MountStoreWithDB(testStoreKey3, types.StoreTypeIAVL, nil)
+	require.NoError(t, readd.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{Added: []string{"store2"}}))
+	require.Nil(t, readd.getStoreByName("store2").(types.KVStore).Get(k))
+	require.Equal(t, []byte("value3"), readd.getStoreByName("renamed2").(types.KVStore).Get(k))
+}
+
+func TestMultistoreRenameValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		rename types.StoreRename
+		expErr string
+	}{
+		{"unmounted new store", types.StoreRename{OldKey: "store4", NewKey: "store5"}, "store store5 renamed from store4 is not mounted"},
+		{"mounted old store", types.StoreRename{OldKey: "store1", NewKey: "store2"}, "store store1 renamed to store2 is still mounted"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+			err := store.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{Renamed: []types.StoreRename{tc.rename}})
+			require.EqualError(t, err, tc.expErr)
+		})
+	}
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -531,7 +623,7 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	pruneHeights := []int64{1, 2, 4, 5, 7}
 
 	// ensure we've persisted the current batch of heights to prune to the store's DB
-	ph, err := getPruningHeights(ms.db)
+	ph, err := getPruningHeights(ms.db, pruneHeightsKey)
 	require.NoError(t, err)
 	require.Equal(t, pruneHeights, ph)
 
@@ -551,6 +643,123 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruningOverrides(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneEverything)
+	ms.SetPruningOverride(testStoreKey1.Name(), types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(1); i <= 10; i++ {
+		for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+			ms.GetKVStore(key).Set([]byte("key"), []byte(fmt.Sprint(i)))
+		}
+		ms.Commit()
+	}
+
+	// the overridden store keeps every version while the others are pruned
+	for v := int64(1); v <= 10; v++ {
+		require.True(t, ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(v), "version %d of store1", v)
+		for _, key := range []types.StoreKey{testStoreKey2, testStoreKey3} {
+			require.Equal(t, v == 10, ms.GetCommitKVStore(key).(*iavl.Store).VersionExists(v), "version %d of %s", v, key.Name())
+		}
+	}
+}
+
+func TestMultiStore_PruningOverridesRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	pruning := types.NewPruningOptions(2, 3, 11)
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.SetPruningOverride(testStoreKey2.Name(), pruning)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	// the heights to prune of the overridden store are persisted apart
+	pruneHeights := []int64{1, 2, 4, 5, 7}
+	ph, err := getPruningHeights(ms.db, fmt.Sprintf(storePruneHeightsKeyFmt, testStoreKey2.Name()))
+	require.NoError(t, err)
+	require.Equal(t, pruneHeights, ph)
+	ph, err = getPruningHeights(ms.db, pruneHeightsKey)
+	require.Error(t, err)
+	require.Empty(t, ph)
+
+	// "restart"
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.SetPruningOverride(testStoreKey2.Name(), pruning)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, pruneHeights, ms.storePruneHeights[testStoreKey2.Name()])
+
+	// commit one more block and ensure the heights have been pruned from the
+	// overridden store only
+	ms.Commit()
+	require.Empty(t, ms.storePruneHeights[testStoreKey2.Name()])
+	for _, v := range pruneHeights {
+		require.False(t, ms.GetCommitKVStore(testStoreKey2).(*iavl.Store).VersionExists(v), "version %d of store2", v)
+		require.True(t, ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(v), "version %d of store1", v)
+	}
+}
+
+func TestMultiStore_PruningOverridesValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		store  string
+		opts   types.PruningOptions
+		expErr string
+	}{
+		{"unknown store", "unknown", types.PruneNothing, "pruning override of unknown store unknown"},
+		{"transient store", "trans1", types.PruneNothing, "pruning override of store trans1 of type StoreTypeTransient, only IAVL stores are pruned"},
+		{"invalid options", "iavl1", types.NewPruningOptions(0, 0, 0), "invalid pruning override of store iavl1: invalid 'Interval' when pruning everything: 0"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStore(dbm.NewMemDB())
+			store.MountStoreWithDB(types.NewKVStoreKey("iavl1"), types.StoreTypeIAVL, nil)
+			store.MountStoreWithDB(types.NewTransientStoreKey("trans1"), types.StoreTypeTransient, nil)
+			store.SetPruningOverride(tc.store, tc.opts)
+			require.EqualError(t, store.LoadLatestVersion(), tc.expErr)
+		})
+	}
+}
+
+func TestMultistoreSnapshotRestore_PruningOverrides(t *testing.T) {
+	// the heights of iavl2 are kept every 5th, the ones of the other stores all
+	source := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	source.SetPruning(types.NewPruningOptions(0, 5, 1))
+	source.SetPruningOverride("iavl1", types.PruneNothing)
+	source.SetPruningOverride("iavl3", types.PruneNothing)
+	require.NoError(t, source.LoadLatestVersion())
+	for i := 1; i <= 7; i++ {
+		for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+			source.getStoreByName(name).(types.KVStore).Set([]byte(fmt.Sprint(i)), []byte(name))
+		}
+		source.Commit()
+	}
+
+	// a height pruned from a single store cannot be snapshotted
+	require.True(t, source.GetCommitKVStore(source.keysByName["iavl1"]).(*iavl.Store).VersionExists(4))
+	require.False(t, source.GetCommitKVStore(source.keysByName["iavl2"]).(*iavl.Store).VersionExists(4))
+	_, err := source.Snapshot(4, snapshottypes.CurrentFormat)
+	require.EqualError(t, err, `cannot snapshot pruned height 4 of store "iavl2": internal logic error`)
+
+	// while the heights kept by every store can
+	chunks, err := source.Snapshot(5, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	ready := make(chan struct{})
+	require.NoError(t, target.Restore(5, snapshottypes.CurrentFormat, chunks, ready))
+	assert.EqualValues(t, struct{}{}, <-ready)
+
+	require.EqualValues(t, 5, target.LastCommitID().Version)
+	cInfo, err := getCommitInfo(source.db, 5)
+	require.NoError(t, err)
+	require.Equal(t, cInfo.CommitID().Hash, target.LastCommitID().Hash)
+}
+
 func TestMultistoreSnapshot_Checksum(t *testing.T) {
 	// Chunks from different nodes must fit together, so all nodes must produce identical chunks.
 	// This checksum test makes sure that the byte stream remains identical. If the test fails
@@ -684,6 +893,102 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+func TestSetIAVLDisableFastNode(t *testing.T) {
+	db := dbm.NewMemDB()
+	// isMigrated returns whether the IAVL store has the fast node index
+	isMigrated := func(name string) bool {
+		tree, err := iavltree.NewMutableTree(dbm.NewPrefixDB(db, []byte("s/k:"+name+"/")), 100, false)
+		require.NoError(t, err)
+		isUpgradeable, err := tree.IsUpgradeable()
+		require.NoError(t, err)
+		return !isUpgradeable
+	}
+
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	multi.SetIAVLDisableFastNode(true)
+	require.NoError(t, multi.LoadLatestVersion())
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+		multi.GetKVStore(key).Set([]byte("key"), []byte(key.Name()))
+	}
+	commitID := multi.Commit()
+	require.False(t, isMigrated("store1"))
+
+	// the stores are migrated when loaded with the fast node index enabled
+	multi = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+	require.True(t, isMigrated("store1"))
+	require.True(t, isMigrated("store2"))
+	require.True(t, isMigrated("store3"))
+	require.Equal(t, commitID, multi.LastCommitID())
+	require.Equal(t, []byte("store1"), multi.GetKVStore(testStoreKey1).Get([]byte("key")))
+}
+
+func TestRollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key := []byte("key")
+	commitIDs := make([]types.CommitID, 0, 5)
+	for i := 1; i <= 5; i++ {
+		multi.GetKVStore(testStoreKey1).Set(key, []byte(fmt.Sprint(i)))
+		multi.GetKVStore(testStoreKey2).Set([]byte(fmt.Sprint(i)), key)
+		commitIDs = append(commitIDs, multi.Commit())
+	}
+
+	require.Error(t, multi.RollbackToVersion(0))
+	require.Error(t, multi.RollbackToVersion(5))
+	require.NoError(t, multi.RollbackToVersion(3))
+
+	// the version 3 is the latest one, in memory and on disk
+	require.Equal(t, commitIDs[2], multi.LastCommitID())
+	require.Equal(t, []byte("3"), multi.GetKVStore(testStoreKey1).Get(key))
+	require.False(t, multi.GetKVStore(testStoreKey2).Has([]byte("4")))
+	for _, storeKey := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+		iavlStore := multi.GetCommitKVStore(storeKey).(*iavl.Store)
+		require.True(t, iavlStore.VersionExists(3))
+		require.False(t, iavlStore.VersionExists(4))
+	}
+
+	reloaded := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, commitIDs[2], reloaded.LastCommitID())
+
+	// and the next commits overwrite the deleted versions
+	reloaded.GetKVStore(testStoreKey1).Set(key, []byte("4"))
+	reloaded.GetKVStore(testStoreKey2).Set([]byte("4"), key)
+	require.Equal(t, commitIDs[3], reloaded.Commit())
+}
+
+func TestRollbackToPrunedVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.NewPruningOptions(1, 0, 1))
+	require.NoError(t, multi.LoadLatestVersion())
+
+	for i := 1; i <= 5; i++ {
+		multi.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprint(i)))
+		multi.Commit()
+	}
+
+	// the version 2 was pruned, the stores are left untouched
+	err := multi.RollbackToVersion(2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "has been pruned")
+	require.Equal(t, int64(5), multi.LastCommitID().Version)
+	require.Equal(t, []byte("5"), multi.GetKVStore(testStoreKey1).Get([]byte("key")))
+
+	require.NoError(t, multi.RollbackToVersion(4))
+	require.Equal(t, []byte("4"), multi.GetKVStore(testStoreKey1).Get([]byte("key")))
+
+	// the pruning goes on from the new latest version
+	multi.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("5"))
+	multi.Commit()
+	multi.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("6"))
+	multi.Commit()
+	require.False(t, multi.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(4))
+	require.True(t, multi.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(5))
+}
+
 func TestAddListenersAndListeningEnabled(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -789,6 +1094,44 @@ func TestGetListenWrappedKVStore(t *testing.T) {
 	require.Equal(t, []byte{}, kvPairDelete3Bytes)
 }
 
+func TestListenersObserveWritesInCommitOrder(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	listener := types.NewMemoryListener()
+	ms.AddListeners(testStoreKey1, []types.WriteListener{listener})
+	ms.AddListeners(testStoreKey3, []types.WriteListener{listener})
+
+	// a block branch, written to by the branches of its txs
+	block := ms.CacheMultiStore()
+	tx := block.CacheMultiStore()
+	tx.GetKVStore(testStoreKey3).Set([]byte("b"), []byte("1"))
+	tx.GetKVStore(testStoreKey2).Set([]byte("a"), []byte("1"))
+	tx.GetKVStore(testStoreKey1).Set([]byte("c"), []byte("1"))
+	tx.Write()
+	tx = block.CacheMultiStore()
+	tx.GetKVStore(testStoreKey3).Set([]byte("a"), []byte("2"))
+	tx.GetKVStore(testStoreKey1).Delete([]byte("c"))
+	tx.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("2"))
+	tx.Write()
+
+	// a discarded branch is never observed
+	tx = block.CacheMultiStore()
+	tx.GetKVStore(testStoreKey1).Set([]byte("z"), []byte("3"))
+
+	// the writes of the txs are only observed once the block branch is written,
+	// store by store in the order of their names, key by key in each store
+	require.Empty(t, listener.PopStateCache())
+	block.Write()
+	ms.Commit()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey1.Name(), Key: []byte("a"), Value: []byte("2")},
+		{StoreKey: testStoreKey1.Name(), Key: []byte("c"), Delete: true},
+		{StoreKey: testStoreKey3.Name(), Key: []byte("a"), Value: []byte("2")},
+		{StoreKey: testStoreKey3.Name(), Key: []byte("b"), Value: []byte("1")},
+	}, listener.PopStateCache())
+}
+
 func TestCacheWraps(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -885,6 +1228,21 @@ var (
 	testStoreKey3 = types.NewKVStoreKey("store3")
 )
 
+func TestSetIAVLCacheSize(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	multi.SetIAVLCacheSize(42)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	// every IAVL store is loaded with the cache size
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+		tree := reflect.ValueOf(multi.GetCommitKVStore(key)).Elem().FieldByName("tree")
+		ndb := tree.Elem().Elem().FieldByName("ndb")
+		nodeCache := ndb.Elem().FieldByName("nodeCache").Elem().Elem()
+		require.Equal(t, int64(42), nodeCache.FieldByName("maxElementCount").Int(), key.Name())
+	}
+}
+
 func newMultiStoreWithMounts(db dbm.DB, pruningOpts types.PruningOptions) *Store {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
@@ -15,3 +15,9 @@ func NewCommitMultiStore(db dbm.DB) types.CommitMultiStore {
 func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
 	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 }
+
+// NewCommitKVStoreCacheManagerWithSize returns an inter-block cache keeping up
+// to size entries per store.
+func NewCommitKVStoreCacheManagerWithSize(size uint) types.MultiStorePersistentCache {
+	return cache.NewCommitKVStoreCacheManager(size)
+}
@@ -2,10 +2,13 @@ package streaming
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
@@ -17,57 +20,57 @@ import (
 // ServiceConstructor is used to construct a streaming service
 type ServiceConstructor func(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error)
 
-// ServiceType enum for specifying the type of StreamingService
-type ServiceType int
-
-const (
-	Unknown ServiceType = iota
-	File
-	// add more in the future
-)
-
-// ServiceTypeFromString returns the streaming.ServiceType corresponding to the provided name
-func ServiceTypeFromString(name string) ServiceType {
-	switch strings.ToLower(name) {
-	case "file", "f":
-		return File
-	default:
-		return Unknown
-	}
+// serviceConstructors maps the names of the streaming services, as listed in
+// the store.streamers option, to their constructors
+var serviceConstructors = map[string]ServiceConstructor{
+	"file": NewFileStreamingService,
 }
 
-// String returns the string name of a streaming.ServiceType
-func (sst ServiceType) String() string {
-	switch sst {
-	case File:
-		return "file"
-	default:
-		return "unknown"
+// RegisterServiceConstructor registers the constructor of a streaming service under the provided name,
+// which can then be listed in the store.streamers option of app.toml. It allows the apps to add their own
+// streaming services, e.g. pushing the state changes to Kafka or to a gRPC server, and must be called before
+// LoadStreamingServices
+func RegisterServiceConstructor(name string, constructor ServiceConstructor) error {
+	name = strings.ToLower(name)
+	if constructor == nil {
+		return fmt.Errorf("streaming service constructor of %s is nil", name)
 	}
+	if _, ok := serviceConstructors[name]; ok {
+		return fmt.Errorf("streaming service constructor of %s is already registered", name)
+	}
+	serviceConstructors[name] = constructor
+	return nil
 }
 
-// ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
-var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
-}
-
-// NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
+// NewServiceConstructor returns the streaming.ServiceConstructor registered under the provided name
 func NewServiceConstructor(name string) (ServiceConstructor, error) {
-	ssType := ServiceTypeFromString(name)
-	if ssType == Unknown {
-		return nil, fmt.Errorf("unrecognized streaming service name %s", name)
-	}
-	if constructor, ok := ServiceConstructorLookupTable[ssType]; ok && constructor != nil {
+	if constructor, ok := serviceConstructors[strings.ToLower(name)]; ok {
 		return constructor, nil
 	}
-	return nil, fmt.Errorf("streaming service constructor of type %s not found", ssType.String())
+	return nil, fmt.Errorf("unrecognized streaming service name %s", name)
 }
 
 // NewFileStreamingService is the streaming.ServiceConstructor function for creating a FileStreamingService
 func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
 	filePrefix := cast.ToString(opts.Get("streamers.file.prefix"))
 	fileDir := cast.ToString(opts.Get("streamers.file.write_dir"))
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
+	if fileDir != "" {
+		if !filepath.IsAbs(fileDir) {
+			fileDir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), fileDir)
+		}
+		if err := os.MkdirAll(fileDir, 0700); err != nil {
+			return nil, err
+		}
+	}
+	// the metadata is written out unless turned off, including by the app.toml files
+	// written before the option was added
+	outputMetadata := true
+	if v := opts.Get("streamers.file.output-metadata"); v != nil {
+		outputMetadata = cast.ToBool(v)
+	}
+	stopNodeOnErr := cast.ToBool(opts.Get("streamers.file.stop-node-on-error"))
+	fsync := cast.ToBool(opts.Get("streamers.file.fsync"))
+	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync)
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
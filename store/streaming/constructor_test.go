@@ -1,21 +1,31 @@
 package streaming
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 type fakeOptions struct{}
 
 func (f *fakeOptions) Get(string) interface{} { return nil }
 
+type mapOptions map[string]interface{}
+
+func (m mapOptions) Get(key string) interface{} { return m[key] }
+
 var (
 	mockOptions       = new(fakeOptions)
 	mockKeys          = []types.StoreKey{sdk.NewKVStoreKey("mockKey1"), sdk.NewKVStoreKey("mockKey2")}
@@ -41,3 +51,56 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		require.True(t, ok)
 	}
 }
+
+func TestRegisterServiceConstructor(t *testing.T) {
+	var constructed bool
+	constructor := func(serverTypes.AppOptions, []types.StoreKey, codec.BinaryCodec) (baseapp.StreamingService, error) {
+		constructed = true
+		return nil, nil
+	}
+	require.NoError(t, RegisterServiceConstructor("Plugin", constructor))
+	t.Cleanup(func() { delete(serviceConstructors, "plugin") })
+
+	// the names are case insensitive, and registered once
+	require.Error(t, RegisterServiceConstructor("plugin", constructor))
+	require.Error(t, RegisterServiceConstructor("file", constructor))
+	require.Error(t, RegisterServiceConstructor("other", nil))
+
+	registered, err := NewServiceConstructor("PLUGIN")
+	require.NoError(t, err)
+	_, err = registered(mockOptions, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.True(t, constructed)
+}
+
+func TestFileStreamingServiceOptions(t *testing.T) {
+	home := t.TempDir()
+
+	// the write directory is relative to the home directory, and the metadata is
+	// written out by default
+	serv, err := NewFileStreamingService(mapOptions{
+		flags.FlagHome:                      home,
+		"streamers.file.write_dir":          "data/file_streamer",
+		"streamers.file.stop-node-on-error": true,
+	}, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.True(t, serv.HaltAppOnDeliveryError())
+	require.DirExists(t, filepath.Join(home, "data", "file_streamer"))
+
+	require.NoError(t, serv.ListenBeginBlock(sdk.Context{}, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, serv.ListenCommit(sdk.Context{}, abci.ResponseCommit{}))
+	require.FileExists(t, filepath.Join(home, "data", "file_streamer", "block-1-meta"))
+	require.FileExists(t, filepath.Join(home, "data", "file_streamer", "block-1-data"))
+
+	serv, err = NewFileStreamingService(mapOptions{
+		"streamers.file.write_dir":       home,
+		"streamers.file.output-metadata": false,
+	}, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.False(t, serv.HaltAppOnDeliveryError())
+
+	require.NoError(t, serv.ListenBeginBlock(sdk.Context{}, abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, serv.ListenCommit(sdk.Context{}, abci.ResponseCommit{}))
+	require.NoFileExists(t, filepath.Join(home, "block-2-meta"))
+	require.FileExists(t, filepath.Join(home, "block-2-data"))
+}
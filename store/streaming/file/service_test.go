@@ -20,11 +20,9 @@ import (
 )
 
 var (
-	interfaceRegistry            = codecTypes.NewInterfaceRegistry()
-	testMarshaller               = codec.NewProtoCodec(interfaceRegistry)
-	testStreamingService         *StreamingService
-	testListener1, testListener2 types.WriteListener
-	emptyContext                 = sdk.Context{}
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+	emptyContext      = sdk.Context{}
 
 	// test abci message types
 	mockHash          = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}
@@ -57,6 +55,10 @@ var (
 		ConsensusParamUpdates: &types1.ConsensusParams{},
 		ValidatorUpdates:      []abci.ValidatorUpdate{},
 	}
+	testCommitRes = abci.ResponseCommit{
+		Data:         mockHash,
+		RetainHeight: 1,
+	}
 	mockTxBytes1      = []byte{9, 8, 7, 6, 5, 4, 3, 2, 1}
 	testDeliverTxReq1 = abci.RequestDeliverTx{
 		Tx: mockTxBytes1,
@@ -94,7 +96,6 @@ var (
 
 	// file stuff
 	testPrefix = "testPrefix"
-	testDir    = "./.test"
 
 	// mock state changes
 	mockKey1   = []byte{1, 2, 3}
@@ -105,269 +106,111 @@ var (
 	mockValue3 = []byte{5, 4, 3}
 )
 
-func TestIntermediateWriter(t *testing.T) {
-	outChan := make(chan []byte, 0)
-	iw := NewIntermediateWriter(outChan)
-	require.IsType(t, &IntermediateWriter{}, iw)
-	testBytes := []byte{1, 2, 3, 4, 5}
-	var length int
-	var err error
-	waitChan := make(chan struct{}, 0)
-	go func() {
-		length, err = iw.Write(testBytes)
-		waitChan <- struct{}{}
-	}()
-	receivedBytes := <-outChan
-	<-waitChan
-	require.Equal(t, len(testBytes), length)
-	require.Equal(t, testBytes, receivedBytes)
-	require.Nil(t, err)
-}
-
 func TestFileStreamingService(t *testing.T) {
-	if os.Getenv("CI") != "" {
-		t.Skip("Skipping TestFileStreamingService in CI environment")
-	}
-	err := os.Mkdir(testDir, 0700)
-	require.Nil(t, err)
-	defer os.RemoveAll(testDir)
-
+	testDir := t.TempDir()
 	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
-	testStreamingService, err = NewStreamingService(testDir, testPrefix, testKeys, testMarshaller)
+	testStreamingService, err := NewStreamingService(testDir, testPrefix, testKeys, testMarshaller, true, false, true)
 	require.Nil(t, err)
 	require.IsType(t, &StreamingService{}, testStreamingService)
 	require.Equal(t, testPrefix, testStreamingService.filePrefix)
 	require.Equal(t, testDir, testStreamingService.writeDir)
 	require.Equal(t, testMarshaller, testStreamingService.codec)
-	testListener1 = testStreamingService.listeners[mockStoreKey1][0]
-	testListener2 = testStreamingService.listeners[mockStoreKey2][0]
+	require.False(t, testStreamingService.HaltAppOnDeliveryError())
+	testListener1 := testStreamingService.listeners[mockStoreKey1][0]
+	testListener2 := testStreamingService.listeners[mockStoreKey2][0]
 	wg := new(sync.WaitGroup)
-	testStreamingService.Stream(wg)
-	testListenBeginBlock(t)
-	testListenDeliverTx1(t)
-	testListenDeliverTx2(t)
-	testListenEndBlock(t)
-	testStreamingService.Close()
-	wg.Wait()
-}
+	require.Nil(t, testStreamingService.Stream(wg))
 
-func testListenBeginBlock(t *testing.T) {
-	expectedBeginBlockReqBytes, err := testMarshaller.Marshal(&testBeginBlockReq)
-	require.Nil(t, err)
-	expectedBeginBlockResBytes, err := testMarshaller.Marshal(&testBeginBlockRes)
-	require.Nil(t, err)
+	// send the ABCI messages of the block
+	require.Nil(t, testStreamingService.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.Nil(t, testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1))
+	require.Nil(t, testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq2, testDeliverTxRes2))
+	require.Nil(t, testStreamingService.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes))
 
-	// write state changes
-	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
-	testListener2.OnWrite(mockStoreKey2, mockKey2, mockValue2, false)
-	testListener1.OnWrite(mockStoreKey1, mockKey3, mockValue3, false)
+	// write the state changes of the block, as the commit multi-store does
+	require.Nil(t, testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false))
+	require.Nil(t, testListener1.OnWrite(mockStoreKey1, mockKey2, nil, true))
+	require.Nil(t, testListener2.OnWrite(mockStoreKey2, mockKey3, mockValue3, false))
+	require.Nil(t, testStreamingService.ListenCommit(emptyContext, testCommitRes))
 
-	// expected KV pairs
-	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey1,
-		Value:    mockValue1,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey2,
-		Value:    mockValue2,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey3,
-		Value:    mockValue3,
-		Delete:   false,
-	})
+	// the state changes are written out in the order of the writes
+	fileBytes, err := readInFile(testDir, fmt.Sprintf("%s-block-%d-data", testPrefix, testBeginBlockReq.GetHeader().Height))
 	require.Nil(t, err)
-
-	// send the ABCI messages
-	err = testStreamingService.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes)
-	require.Nil(t, err)
-
-	// load the file, checking that it was created with the expected name
-	fileName := fmt.Sprintf("%s-block-%d-begin", testPrefix, testBeginBlockReq.GetHeader().Height)
-	fileBytes, err := readInFile(fileName)
-	require.Nil(t, err)
-
-	// segment the file into the separate gRPC messages and check the correctness of each
 	segments, err := segmentBytes(fileBytes)
 	require.Nil(t, err)
-	require.Equal(t, 5, len(segments))
-	require.Equal(t, expectedBeginBlockReqBytes, segments[0])
-	require.Equal(t, expectedKVPair1, segments[1])
-	require.Equal(t, expectedKVPair2, segments[2])
-	require.Equal(t, expectedKVPair3, segments[3])
-	require.Equal(t, expectedBeginBlockResBytes, segments[4])
-}
-
-func testListenDeliverTx1(t *testing.T) {
-	expectedDeliverTxReq1Bytes, err := testMarshaller.Marshal(&testDeliverTxReq1)
-	require.Nil(t, err)
-	expectedDeliverTxRes1Bytes, err := testMarshaller.Marshal(&testDeliverTxRes1)
-	require.Nil(t, err)
-
-	// write state changes
-	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
-	testListener2.OnWrite(mockStoreKey2, mockKey2, mockValue2, false)
-	testListener1.OnWrite(mockStoreKey2, mockKey3, mockValue3, false)
+	expectedKVPairs := []types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey2, Delete: true},
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey3, Value: mockValue3},
+	}
+	require.Equal(t, len(expectedKVPairs), len(segments))
+	for i, segment := range segments {
+		expectedKVPairBytes, err := testMarshaller.Marshal(&expectedKVPairs[i])
+		require.Nil(t, err)
+		require.Equal(t, expectedKVPairBytes, segment)
+	}
 
-	// expected KV pairs
-	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey1,
-		Value:    mockValue1,
-		Delete:   false,
-	})
+	// the metadata holds the ABCI requests and responses of the block
+	fileBytes, err = readInFile(testDir, fmt.Sprintf("%s-block-%d-meta", testPrefix, testBeginBlockReq.GetHeader().Height))
 	require.Nil(t, err)
-	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey2,
-		Value:    mockValue2,
-		Delete:   false,
-	})
+	segments, err = segmentBytes(fileBytes)
 	require.Nil(t, err)
-	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey3,
-		Value:    mockValue3,
-		Delete:   false,
+	require.Equal(t, 1, len(segments))
+	expectedMetadataBytes, err := testMarshaller.Marshal(&types.BlockMetadata{
+		RequestBeginBlock:  &testBeginBlockReq,
+		ResponseBeginBlock: &testBeginBlockRes,
+		DeliverTxs: []*types.BlockMetadata_DeliverTx{
+			{Request: &testDeliverTxReq1, Response: &testDeliverTxRes1},
+			{Request: &testDeliverTxReq2, Response: &testDeliverTxRes2},
+		},
+		RequestEndBlock:  &testEndBlockReq,
+		ResponseEndBlock: &testEndBlockRes,
+		ResponseCommit:   &testCommitRes,
 	})
 	require.Nil(t, err)
+	require.Equal(t, expectedMetadataBytes, segments[0])
 
-	// send the ABCI messages
-	err = testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1)
-	require.Nil(t, err)
+	// the next block only holds its own state changes
+	nextBeginBlockReq := abci.RequestBeginBlock{Header: types1.Header{Height: 2}}
+	require.Nil(t, testStreamingService.ListenBeginBlock(emptyContext, nextBeginBlockReq, abci.ResponseBeginBlock{}))
+	require.Nil(t, testStreamingService.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: 2}, abci.ResponseEndBlock{}))
+	require.Nil(t, testListener2.OnWrite(mockStoreKey2, mockKey1, mockValue2, false))
+	require.Nil(t, testStreamingService.ListenCommit(emptyContext, testCommitRes))
 
-	// load the file, checking that it was created with the expected name
-	fileName := fmt.Sprintf("%s-block-%d-tx-%d", testPrefix, testBeginBlockReq.GetHeader().Height, 0)
-	fileBytes, err := readInFile(fileName)
+	fileBytes, err = readInFile(testDir, fmt.Sprintf("%s-block-%d-data", testPrefix, 2))
 	require.Nil(t, err)
-
-	// segment the file into the separate gRPC messages and check the correctness of each
-	segments, err := segmentBytes(fileBytes)
-	require.Nil(t, err)
-	require.Equal(t, 5, len(segments))
-	require.Equal(t, expectedDeliverTxReq1Bytes, segments[0])
-	require.Equal(t, expectedKVPair1, segments[1])
-	require.Equal(t, expectedKVPair2, segments[2])
-	require.Equal(t, expectedKVPair3, segments[3])
-	require.Equal(t, expectedDeliverTxRes1Bytes, segments[4])
-}
-
-func testListenDeliverTx2(t *testing.T) {
-	expectedDeliverTxReq2Bytes, err := testMarshaller.Marshal(&testDeliverTxReq2)
-	require.Nil(t, err)
-	expectedDeliverTxRes2Bytes, err := testMarshaller.Marshal(&testDeliverTxRes2)
-	require.Nil(t, err)
-
-	// write state changes
-	testListener1.OnWrite(mockStoreKey2, mockKey1, mockValue1, false)
-	testListener2.OnWrite(mockStoreKey1, mockKey2, mockValue2, false)
-	testListener1.OnWrite(mockStoreKey2, mockKey3, mockValue3, false)
-
-	// expected KV pairs
-	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey1,
-		Value:    mockValue1,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey2,
-		Value:    mockValue2,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey3,
-		Value:    mockValue3,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-
-	// send the ABCI messages
-	err = testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq2, testDeliverTxRes2)
+	segments, err = segmentBytes(fileBytes)
 	require.Nil(t, err)
-
-	// load the file, checking that it was created with the expected name
-	fileName := fmt.Sprintf("%s-block-%d-tx-%d", testPrefix, testBeginBlockReq.GetHeader().Height, 1)
-	fileBytes, err := readInFile(fileName)
+	require.Equal(t, 1, len(segments))
+	expectedKVPairBytes, err := testMarshaller.Marshal(&types.StoreKVPair{StoreKey: mockStoreKey2.Name(), Key: mockKey1, Value: mockValue2})
 	require.Nil(t, err)
+	require.Equal(t, expectedKVPairBytes, segments[0])
 
-	// segment the file into the separate gRPC messages and check the correctness of each
-	segments, err := segmentBytes(fileBytes)
-	require.Nil(t, err)
-	require.Equal(t, 5, len(segments))
-	require.Equal(t, expectedDeliverTxReq2Bytes, segments[0])
-	require.Equal(t, expectedKVPair1, segments[1])
-	require.Equal(t, expectedKVPair2, segments[2])
-	require.Equal(t, expectedKVPair3, segments[3])
-	require.Equal(t, expectedDeliverTxRes2Bytes, segments[4])
+	require.Nil(t, testStreamingService.Close())
+	wg.Wait()
 }
 
-func testListenEndBlock(t *testing.T) {
-	expectedEndBlockReqBytes, err := testMarshaller.Marshal(&testEndBlockReq)
-	require.Nil(t, err)
-	expectedEndBlockResBytes, err := testMarshaller.Marshal(&testEndBlockRes)
-	require.Nil(t, err)
-
-	// write state changes
-	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
-	testListener2.OnWrite(mockStoreKey1, mockKey2, mockValue2, false)
-	testListener1.OnWrite(mockStoreKey2, mockKey3, mockValue3, false)
-
-	// expected KV pairs
-	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey1,
-		Value:    mockValue1,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey1.Name(),
-		Key:      mockKey2,
-		Value:    mockValue2,
-		Delete:   false,
-	})
-	require.Nil(t, err)
-	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
-		StoreKey: mockStoreKey2.Name(),
-		Key:      mockKey3,
-		Value:    mockValue3,
-		Delete:   false,
-	})
+func TestFileStreamingServiceWithoutMetadata(t *testing.T) {
+	testDir := t.TempDir()
+	testStreamingService, err := NewStreamingService(testDir, "", []types.StoreKey{mockStoreKey1}, testMarshaller, false, true, false)
 	require.Nil(t, err)
+	require.True(t, testStreamingService.HaltAppOnDeliveryError())
 
-	// send the ABCI messages
-	err = testStreamingService.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes)
-	require.Nil(t, err)
+	require.Nil(t, testStreamingService.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.Nil(t, testStreamingService.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes))
+	require.Nil(t, testStreamingService.ListenCommit(emptyContext, testCommitRes))
 
-	// load the file, checking that it was created with the expected name
-	fileName := fmt.Sprintf("%s-block-%d-end", testPrefix, testEndBlockReq.Height)
-	fileBytes, err := readInFile(fileName)
+	_, err = readInFile(testDir, "block-1-data")
 	require.Nil(t, err)
+	_, err = readInFile(testDir, "block-1-meta")
+	require.True(t, os.IsNotExist(err))
 
-	// segment the file into the separate gRPC messages and check the correctness of each
-	segments, err := segmentBytes(fileBytes)
-	require.Nil(t, err)
-	require.Equal(t, 5, len(segments))
-	require.Equal(t, expectedEndBlockReqBytes, segments[0])
-	require.Equal(t, expectedKVPair1, segments[1])
-	require.Equal(t, expectedKVPair2, segments[2])
-	require.Equal(t, expectedKVPair3, segments[3])
-	require.Equal(t, expectedEndBlockResBytes, segments[4])
+	// the failures to write the files are returned to the BaseApp
+	require.Nil(t, os.RemoveAll(testDir))
+	require.NotNil(t, testStreamingService.ListenCommit(emptyContext, testCommitRes))
 }
 
-func readInFile(name string) ([]byte, error) {
+func readInFile(testDir, name string) ([]byte, error) {
 	path := filepath.Join(testDir, name)
 	return ioutil.ReadFile(path)
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"io"
+	"sync"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -45,3 +46,39 @@ func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value
 	}
 	return nil
 }
+
+// MemoryListener is a WriteListener keeping the state changes it observes in
+// memory, in the order of the writes, until they are popped with PopStateCache
+type MemoryListener struct {
+	stateCache     []StoreKVPair
+	stateCacheLock sync.Mutex
+}
+
+// NewMemoryListener creates a MemoryListener with an empty state cache
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{}
+}
+
+// OnWrite satisfies the WriteListener interface by caching the state change as a StoreKVPair
+func (ml *MemoryListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	ml.stateCacheLock.Lock()
+	defer ml.stateCacheLock.Unlock()
+
+	ml.stateCache = append(ml.stateCache, StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// PopStateCache returns the state changes observed since the last call, and empties the state cache
+func (ml *MemoryListener) PopStateCache() []StoreKVPair {
+	ml.stateCacheLock.Lock()
+	defer ml.stateCacheLock.Unlock()
+
+	res := ml.stateCache
+	ml.stateCache = nil
+	return res
+}
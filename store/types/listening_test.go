@@ -64,3 +64,19 @@ func TestOnWrite(t *testing.T) {
 	testMarshaller.UnmarshalLengthPrefixed(outputBytes, outputKVPair)
 	require.EqualValues(t, expectedOutputKVPair, outputKVPair)
 }
+
+func TestMemoryListener(t *testing.T) {
+	ml := NewMemoryListener()
+	storeKey1 := NewKVStoreKey("store1")
+	storeKey2 := NewKVStoreKey("store2")
+
+	require.NoError(t, ml.OnWrite(storeKey2, []byte("b"), []byte("2"), false))
+	require.NoError(t, ml.OnWrite(storeKey1, []byte("a"), nil, true))
+
+	// the state changes are kept in the order of the writes
+	require.Equal(t, []StoreKVPair{
+		{StoreKey: "store2", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "store1", Key: []byte("a"), Delete: true},
+	}, ml.PopStateCache())
+	require.Empty(t, ml.PopStateCache())
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	tmstrings "github.com/tendermint/tendermint/libs/strings"
 	dbm "github.com/tendermint/tm-db"
 
@@ -51,7 +52,9 @@ type StoreUpgrades struct {
 }
 
 // StoreRename defines a name change of a sub-store.
-// All data previously under a PrefixStore with OldKey will be copied
+// The IAVL stores of the root store DB are renamed in place: the tree of the
+// OldKey store is loaded under NewKey, with its data and history. The data of
+// the other stores previously under a PrefixStore with OldKey will be copied
 // to a PrefixStore with NewKey, then deleted from OldKey store.
 type StoreRename struct {
 	OldKey string `json:"old_key"`
@@ -181,6 +184,28 @@ type CommitMultiStore interface {
 	// SetInitialVersion sets the initial version of the IAVL tree. It is used when
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
+
+	// SetIAVLDisableFastNode disables the fast node index of the IAVL stores,
+	// which are otherwise migrated to it, if needed, when they are loaded.
+	SetIAVLDisableFastNode(disable bool)
+
+	// SetLogger sets the logger of the multi-store, reporting e.g. the progress
+	// of the migration of the IAVL stores to the fast node index.
+	SetLogger(logger log.Logger)
+
+	// SetPruningOverride sets the pruning strategy of the store with the given
+	// name, overriding the one of the multi-store. Loading a version fails if
+	// no IAVL store is mounted with this name.
+	SetPruningOverride(name string, opts PruningOptions)
+
+	// SetIAVLCacheSize sets the number of nodes kept in the node cache of each
+	// IAVL store, for the stores loaded after the call.
+	SetIAVLCacheSize(size int)
+
+	// RollbackToVersion deletes the versions of the stores after the given
+	// version, which becomes the latest persisted version. It fails, changing
+	// nothing, if the version is not persisted, e.g. because it was pruned.
+	RollbackToVersion(version int64) error
 }
 
 //---------subsp-------------------------------
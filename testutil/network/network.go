@@ -288,6 +288,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			}
 			appCfg.GRPCWeb.Address = fmt.Sprintf("0.0.0.0:%s", grpcWebPort)
 			appCfg.GRPCWeb.Enable = true
+			appCfg.API.EnableGRPCWeb = true
 		}
 
 		logger := server.ZeroLogWrapper{Logger: zerolog.Nop()}
@@ -323,6 +323,8 @@ type Result struct {
 	// Events contains a slice of Event objects that were emitted during message
 	// or handler execution.
 	Events []types1.Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+	// msg_responses contains the Msg handler responses type packed in Anys.
+	MsgResponses []*types.Any `protobuf:"bytes,4,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
 }
 
 func (m *Result) Reset()      { *m = Result{} }
@@ -609,62 +611,63 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xbd, 0x6f, 0xdb, 0x46,
-	0x14, 0xc0, 0x45, 0x89, 0xa1, 0xcc, 0x27, 0x27, 0x2e, 0x0e, 0x46, 0x42, 0x27, 0xad, 0xa4, 0x2a,
-	0x29, 0xa0, 0x25, 0x54, 0xe3, 0xb4, 0x45, 0x91, 0xa1, 0x68, 0xe8, 0x7e, 0xc4, 0x40, 0xd2, 0xe1,
-	0xac, 0xa0, 0x40, 0x17, 0xe1, 0x24, 0x5d, 0x4e, 0x6c, 0x44, 0x9e, 0xc0, 0x3b, 0xda, 0xf4, 0xd6,
-	0xb1, 0x53, 0xd1, 0x29, 0x43, 0xa7, 0xce, 0xfd, 0x4b, 0x32, 0x7a, 0xcc, 0x50, 0xa8, 0xad, 0xbd,
-	0xe5, 0xaf, 0x28, 0xee, 0x1d, 0xf5, 0xe1, 0x04, 0xf2, 0xa4, 0xf7, 0x75, 0xef, 0xde, 0xfb, 0xbd,
-	0xa7, 0x23, 0xdc, 0x1d, 0x49, 0x95, 0x48, 0xd5, 0x1b, 0x32, 0xc5, 0x7b, 0x6c, 0x38, 0x8a, 0x7b,
-	0xc7, 0x0f, 0x86, 0x5c, 0xb3, 0x07, 0xa8, 0x84, 0xb3, 0x4c, 0x6a, 0x49, 0x02, 0x1b, 0x14, 0x9a,
-	0xa0, 0x10, 0xed, 0x65, 0xd0, 0xed, 0x5d, 0x21, 0x85, 0xc4, 0xa0, 0x9e, 0x91, 0x6c, 0xfc, 0xed,
-	0x3b, 0x9a, 0xa7, 0x63, 0x9e, 0x25, 0x71, 0xaa, 0x6d, 0x4e, 0x7d, 0x3a, 0xe3, 0xaa, 0x74, 0xee,
-	0x09, 0x29, 0xc5, 0x94, 0xf7, 0x50, 0x1b, 0xe6, 0x2f, 0x7a, 0x2c, 0x3d, 0xb5, 0xae, 0xce, 0xab,
-	0x1a, 0x40, 0xbf, 0xa0, 0x5c, 0xcd, 0x64, 0xaa, 0x38, 0xb9, 0x09, 0xde, 0x84, 0xc7, 0x62, 0xa2,
-	0x03, 0xa7, 0xed, 0x74, 0x6b, 0xb4, 0xd4, 0x48, 0x07, 0x3c, 0x5d, 0x4c, 0x98, 0x9a, 0x04, 0xd5,
-	0xb6, 0xd3, 0xf5, 0x23, 0x38, 0x9f, 0xb7, 0xbc, 0x7e, 0xf1, 0x84, 0xa9, 0x09, 0x2d, 0x3d, 0xe4,
-	0x43, 0xf0, 0x47, 0x72, 0xcc, 0xd5, 0x8c, 0x8d, 0x78, 0x50, 0x33, 0x61, 0x74, 0x65, 0x20, 0x04,
-	0x5c, 0xa3, 0x04, 0x6e, 0xdb, 0xe9, 0x5e, 0xa7, 0x28, 0x1b, 0xdb, 0x98, 0x69, 0x16, 0x5c, 0xc3,
-	0x60, 0x94, 0xc9, 0x2d, 0xa8, 0x67, 0xec, 0x64, 0x30, 0x95, 0x22, 0xf0, 0xd0, 0xec, 0x65, 0xec,
-	0xe4, 0xa9, 0x14, 0xe4, 0x39, 0xb8, 0x53, 0x29, 0x54, 0x50, 0x6f, 0xd7, 0xba, 0x8d, 0xfd, 0x6e,
-	0xb8, 0x09, 0x50, 0xf8, 0x38, 0x3a, 0x38, 0x7c, 0xc6, 0x95, 0x62, 0x82, 0x3f, 0x95, 0x22, 0xba,
-	0xf5, 0x7a, 0xde, 0xaa, 0xfc, 0xf5, 0x4f, 0x6b, 0xe7, 0xb2, 0x5d, 0x51, 0x4c, 0x67, 0x6a, 0x88,
-	0xd3, 0x17, 0x32, 0xd8, 0xb2, 0x35, 0x18, 0x99, 0x7c, 0x04, 0x20, 0x98, 0x1a, 0x9c, 0xb0, 0x54,
-	0xf3, 0x71, 0xe0, 0x23, 0x09, 0x5f, 0x30, 0xf5, 0x23, 0x1a, 0xc8, 0x1e, 0x6c, 0x19, 0x77, 0xae,
-	0xf8, 0x38, 0x00, 0x74, 0xd6, 0x05, 0x53, 0xcf, 0x15, 0x1f, 0x93, 0x7b, 0x50, 0xd5, 0x45, 0xd0,
-	0x68, 0x3b, 0xdd, 0xc6, 0xfe, 0x6e, 0x68, 0xb1, 0x87, 0x0b, 0xec, 0xe1, 0xe3, 0xf4, 0x94, 0x56,
-	0x75, 0x61, 0x48, 0xe9, 0x38, 0xe1, 0x4a, 0xb3, 0x64, 0x16, 0x6c, 0x5b, 0x52, 0x4b, 0xc3, 0x23,
-	0xf7, 0xd7, 0x3f, 0x5b, 0x95, 0xce, 0x1f, 0x0e, 0xdc, 0xb8, 0x5c, 0x31, 0xb9, 0x03, 0x7e, 0xa2,
-	0xc4, 0x20, 0x4e, 0xc7, 0xbc, 0xc0, 0xf9, 0x5c, 0xa7, 0x5b, 0x89, 0x12, 0x87, 0x46, 0x27, 0x1f,
-	0x40, 0xcd, 0x30, 0xc3, 0xf1, 0x50, 0x23, 0x92, 0x23, 0xf0, 0xf8, 0x31, 0x4f, 0xb5, 0x0a, 0x6a,
-	0x88, 0xec, 0x93, 0xcd, 0xc8, 0x8e, 0x74, 0x16, 0xa7, 0xe2, 0x5b, 0x13, 0x1d, 0xed, 0x96, 0xbc,
-	0xb6, 0xd7, 0x8c, 0x8a, 0x96, 0xa9, 0x1e, 0xb9, 0xbf, 0xfc, 0xdd, 0x76, 0x3a, 0x19, 0x34, 0xd6,
-	0xbc, 0x86, 0xa1, 0x59, 0x37, 0xac, 0xc9, 0xa7, 0x28, 0x93, 0x43, 0x00, 0xa6, 0x75, 0x16, 0x0f,
-	0x73, 0xcd, 0x55, 0x50, 0xc5, 0x0a, 0xee, 0x5e, 0x31, 0xb4, 0x45, 0x6c, 0xe4, 0x9a, 0xfb, 0xe9,
-	0xda, 0xe1, 0xf2, 0xce, 0x87, 0xe0, 0x2f, 0x83, 0x4c, 0xb7, 0x2f, 0xf9, 0x69, 0x79, 0xa1, 0x11,
-	0xc9, 0x2e, 0x5c, 0x3b, 0x66, 0xd3, 0x9c, 0x97, 0x04, 0xac, 0xd2, 0x39, 0x80, 0xfa, 0xf7, 0x4c,
-	0x1d, 0xbe, 0x3f, 0x54, 0x73, 0xd2, 0xdd, 0x34, 0xd4, 0x2a, 0x3a, 0x17, 0x43, 0xed, 0xfc, 0x0c,
-	0x1e, 0xe5, 0x2a, 0x9f, 0xea, 0xe5, 0xc2, 0x9a, 0xd3, 0xdb, 0xe5, 0xc2, 0xbe, 0x0f, 0xfe, 0xb3,
-	0x77, 0xc0, 0xdf, 0x0c, 0x57, 0x7f, 0x4e, 0xdb, 0xb5, 0x25, 0x6d, 0x3b, 0x5d, 0x92, 0xc5, 0xb1,
-	0xbf, 0x72, 0x80, 0x1c, 0xc5, 0x49, 0x3e, 0x65, 0x3a, 0x96, 0xe9, 0xf2, 0x7f, 0xf9, 0x9d, 0xad,
-	0x0e, 0x37, 0xd5, 0xc1, 0xed, 0xfa, 0x78, 0x33, 0xcb, 0xb2, 0xe3, 0x68, 0xcb, 0xe4, 0x3f, 0x9b,
-	0xb7, 0x1c, 0x6c, 0x05, 0x21, 0x7c, 0x09, 0x5e, 0x86, 0xad, 0x60, 0xbd, 0x8d, 0xfd, 0xf6, 0xe6,
-	0x2c, 0xb6, 0x65, 0x5a, 0xc6, 0x77, 0xbe, 0x82, 0xfa, 0x33, 0x25, 0xbe, 0x31, 0x1d, 0xef, 0x81,
-	0x59, 0xbb, 0xc1, 0xda, 0xc8, 0xeb, 0x89, 0x12, 0x7d, 0x33, 0xf5, 0x05, 0xa0, 0xea, 0x0a, 0x50,
-	0x39, 0xbe, 0x27, 0xe0, 0xf7, 0x8b, 0x45, 0x86, 0xcf, 0x97, 0x1c, 0x6b, 0x57, 0xb7, 0x52, 0x1e,
-	0xb8, 0x94, 0xe9, 0xb7, 0x2a, 0xec, 0x1c, 0x71, 0x96, 0x8d, 0x26, 0xfd, 0x42, 0x95, 0x83, 0xf9,
-	0x14, 0x1a, 0x5a, 0x6a, 0x36, 0x1d, 0x8c, 0x64, 0x9e, 0xda, 0xc7, 0xcb, 0x8d, 0x76, 0xde, 0xce,
-	0x5b, 0xeb, 0x66, 0x0a, 0xa8, 0x1c, 0x18, 0xd9, 0xec, 0x8b, 0x8d, 0xb5, 0xc3, 0xb6, 0x8a, 0xc9,
-	0x33, 0x63, 0x82, 0x0f, 0xd2, 0x3c, 0x19, 0xf2, 0x0c, 0x5f, 0xb1, 0x32, 0xcf, 0x9a, 0x99, 0x82,
-	0x51, 0x7e, 0x40, 0x99, 0xdc, 0x07, 0xd4, 0x06, 0x98, 0x1a, 0x5f, 0x37, 0x37, 0xba, 0xf1, 0x76,
-	0xde, 0x5a, 0xb3, 0x52, 0xdf, 0xc8, 0x7d, 0x23, 0x9a, 0x6b, 0xa7, 0x71, 0x12, 0x6b, 0x7c, 0xf3,
-	0x5c, 0x6a, 0x15, 0xf2, 0x05, 0xd4, 0x74, 0xa1, 0x02, 0x0f, 0x71, 0xdc, 0xdb, 0x8c, 0x63, 0xf5,
-	0x52, 0x53, 0x73, 0xc0, 0x02, 0x89, 0xbe, 0x7e, 0xf3, 0x5f, 0xb3, 0xf2, 0xfa, 0xbc, 0xe9, 0x9c,
-	0x9d, 0x37, 0x9d, 0x7f, 0xcf, 0x9b, 0xce, 0xef, 0x17, 0xcd, 0xca, 0xd9, 0x45, 0xb3, 0xf2, 0xe6,
-	0xa2, 0x59, 0xf9, 0xa9, 0x23, 0x62, 0x3d, 0xc9, 0x87, 0xe1, 0x48, 0x26, 0xbd, 0xf2, 0xcb, 0x63,
-	0x7f, 0xee, 0xab, 0xf1, 0x4b, 0xfb, 0x99, 0x18, 0x7a, 0xf8, 0x44, 0x3d, 0xfc, 0x3f, 0x00, 0x00,
-	0xff, 0xff, 0x84, 0x62, 0x26, 0x5f, 0x9b, 0x06, 0x00, 0x00,
+	// 893 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x3d, 0x6f, 0x1b, 0x37,
+	0x18, 0xc7, 0x75, 0xba, 0xcb, 0xc9, 0xf7, 0xc8, 0x8e, 0x0b, 0xc2, 0x48, 0xe8, 0xa4, 0x95, 0x54,
+	0x25, 0x05, 0xb4, 0xe4, 0xd4, 0x38, 0x6d, 0xd1, 0x66, 0x28, 0x9a, 0x73, 0x5f, 0x62, 0x20, 0xe9,
+	0x40, 0x2b, 0x28, 0xd0, 0x45, 0xa0, 0x24, 0x86, 0x3a, 0x44, 0x77, 0x14, 0x8e, 0x3c, 0xfb, 0xbc,
+	0x75, 0xec, 0x54, 0x74, 0xca, 0xd0, 0xa9, 0x4b, 0x97, 0x7e, 0x92, 0x8c, 0x1e, 0x33, 0x14, 0x6e,
+	0x6b, 0x6f, 0xf9, 0x14, 0x05, 0x5f, 0x64, 0xc9, 0x0d, 0x94, 0x49, 0xcf, 0x1b, 0x1f, 0x92, 0xbf,
+	0xe7, 0x7f, 0x14, 0xdc, 0x19, 0x0b, 0x99, 0x09, 0xd9, 0x1f, 0x51, 0xc9, 0xfa, 0x74, 0x34, 0x4e,
+	0xfb, 0x47, 0xf7, 0x47, 0x4c, 0xd1, 0xfb, 0xc6, 0x89, 0xe7, 0x85, 0x50, 0x02, 0x61, 0x5b, 0x14,
+	0xeb, 0xa2, 0xd8, 0xc4, 0x5d, 0xd1, 0xad, 0x1d, 0x2e, 0xb8, 0x30, 0x45, 0x7d, 0x6d, 0xd9, 0xfa,
+	0x5b, 0xb7, 0x15, 0xcb, 0x27, 0xac, 0xc8, 0xd2, 0x5c, 0xd9, 0x9e, 0xea, 0x64, 0xce, 0xa4, 0x4b,
+	0xee, 0x72, 0x21, 0xf8, 0x8c, 0xf5, 0x8d, 0x37, 0x2a, 0x9f, 0xf7, 0x69, 0x7e, 0x62, 0x53, 0xdd,
+	0x97, 0x3e, 0xc0, 0xa0, 0x22, 0x4c, 0xce, 0x45, 0x2e, 0x19, 0xba, 0x01, 0xe1, 0x94, 0xa5, 0x7c,
+	0xaa, 0xb0, 0xd7, 0xf1, 0x7a, 0x3e, 0x71, 0x1e, 0xea, 0x42, 0xa8, 0xaa, 0x29, 0x95, 0x53, 0x5c,
+	0xef, 0x78, 0xbd, 0x28, 0x81, 0xf3, 0xb3, 0x76, 0x38, 0xa8, 0x1e, 0x53, 0x39, 0x25, 0x2e, 0x83,
+	0xde, 0x87, 0x68, 0x2c, 0x26, 0x4c, 0xce, 0xe9, 0x98, 0x61, 0x5f, 0x97, 0x91, 0x65, 0x00, 0x21,
+	0x08, 0xb4, 0x83, 0x83, 0x8e, 0xd7, 0xdb, 0x22, 0xc6, 0xd6, 0xb1, 0x09, 0x55, 0x14, 0x5f, 0x33,
+	0xc5, 0xc6, 0x46, 0x37, 0xa1, 0x51, 0xd0, 0xe3, 0xe1, 0x4c, 0x70, 0x1c, 0x9a, 0x70, 0x58, 0xd0,
+	0xe3, 0x27, 0x82, 0xa3, 0x67, 0x10, 0xcc, 0x04, 0x97, 0xb8, 0xd1, 0xf1, 0x7b, 0xcd, 0xbd, 0x5e,
+	0xbc, 0x0e, 0x50, 0xfc, 0x28, 0xd9, 0x3f, 0x78, 0xca, 0xa4, 0xa4, 0x9c, 0x3d, 0x11, 0x3c, 0xb9,
+	0xf9, 0xea, 0xac, 0x5d, 0xfb, 0xf3, 0xef, 0xf6, 0xf6, 0xd5, 0xb8, 0x24, 0xa6, 0x9d, 0x3e, 0x43,
+	0x9a, 0x3f, 0x17, 0x78, 0xc3, 0x9e, 0x41, 0xdb, 0xe8, 0x03, 0x00, 0x4e, 0xe5, 0xf0, 0x98, 0xe6,
+	0x8a, 0x4d, 0x70, 0x64, 0x48, 0x44, 0x9c, 0xca, 0x1f, 0x4c, 0x00, 0xed, 0xc2, 0x86, 0x4e, 0x97,
+	0x92, 0x4d, 0x30, 0x98, 0x64, 0x83, 0x53, 0xf9, 0x4c, 0xb2, 0x09, 0xba, 0x0b, 0x75, 0x55, 0xe1,
+	0x66, 0xc7, 0xeb, 0x35, 0xf7, 0x76, 0x62, 0x8b, 0x3d, 0x5e, 0x60, 0x8f, 0x1f, 0xe5, 0x27, 0xa4,
+	0xae, 0x2a, 0x4d, 0x4a, 0xa5, 0x19, 0x93, 0x8a, 0x66, 0x73, 0xbc, 0x69, 0x49, 0x5d, 0x06, 0x1e,
+	0x06, 0x3f, 0xff, 0xde, 0xae, 0x75, 0x7f, 0xf3, 0xe0, 0xfa, 0xd5, 0x13, 0xa3, 0xdb, 0x10, 0x65,
+	0x92, 0x0f, 0xd3, 0x7c, 0xc2, 0x2a, 0x33, 0x9f, 0x2d, 0xb2, 0x91, 0x49, 0x7e, 0xa0, 0x7d, 0xf4,
+	0x1e, 0xf8, 0x9a, 0x99, 0x19, 0x0f, 0xd1, 0x26, 0x3a, 0x84, 0x90, 0x1d, 0xb1, 0x5c, 0x49, 0xec,
+	0x1b, 0x64, 0x1f, 0xad, 0x47, 0x76, 0xa8, 0x8a, 0x34, 0xe7, 0xdf, 0xe8, 0xea, 0x64, 0xc7, 0xf1,
+	0xda, 0x5c, 0x09, 0x4a, 0xe2, 0x5a, 0x3d, 0x0c, 0x7e, 0xfa, 0xab, 0xe3, 0x75, 0x0b, 0x68, 0xae,
+	0x64, 0x35, 0x43, 0x2d, 0x37, 0x73, 0xa6, 0x88, 0x18, 0x1b, 0x1d, 0x00, 0x50, 0xa5, 0x8a, 0x74,
+	0x54, 0x2a, 0x26, 0x71, 0xdd, 0x9c, 0xe0, 0xce, 0x3b, 0x86, 0xb6, 0xa8, 0x4d, 0x02, 0xbd, 0x3f,
+	0x59, 0x59, 0xec, 0xf6, 0x7c, 0x00, 0xd1, 0x65, 0x91, 0xbe, 0xed, 0x0b, 0x76, 0xe2, 0x36, 0xd4,
+	0x26, 0xda, 0x81, 0x6b, 0x47, 0x74, 0x56, 0x32, 0x47, 0xc0, 0x3a, 0xdd, 0x7d, 0x68, 0x7c, 0x47,
+	0xe5, 0xc1, 0xdb, 0x43, 0xd5, 0x2b, 0x83, 0x75, 0x43, 0xad, 0x9b, 0xe4, 0x62, 0xa8, 0xdd, 0x3f,
+	0x3c, 0x08, 0x09, 0x93, 0xe5, 0x4c, 0x5d, 0x2a, 0x56, 0x2f, 0xdf, 0x74, 0x8a, 0x7d, 0x9b, 0xfc,
+	0x27, 0xff, 0x23, 0x7f, 0x23, 0x5e, 0x7e, 0x9d, 0xf6, 0xda, 0x16, 0xb5, 0xbd, 0xaa, 0xab, 0x45,
+	0x5f, 0xc0, 0x96, 0x1e, 0x6f, 0xe1, 0xbe, 0x45, 0x89, 0x83, 0x8e, 0xbf, 0x56, 0x46, 0x9b, 0x99,
+	0xe4, 0x8b, 0xaf, 0x56, 0x3a, 0xc9, 0xbc, 0xf4, 0x00, 0x1d, 0xa6, 0x59, 0x39, 0xa3, 0x2a, 0x15,
+	0xf9, 0x22, 0x8b, 0xbe, 0xb5, 0x37, 0x33, 0x2a, 0xf7, 0x8c, 0x32, 0x3f, 0x5c, 0x3f, 0x07, 0x47,
+	0x2b, 0xd9, 0xd0, 0x47, 0x3b, 0x3d, 0x6b, 0x7b, 0x06, 0x83, 0x01, 0xf8, 0x39, 0x84, 0x85, 0xa1,
+	0x60, 0xae, 0xda, 0xdc, 0xeb, 0xac, 0xef, 0x62, 0x69, 0x11, 0x57, 0xdf, 0xfd, 0x12, 0x1a, 0x4f,
+	0x25, 0xff, 0x5a, 0xc3, 0xda, 0x05, 0x2d, 0xd9, 0xe1, 0x8a, 0x5c, 0x1a, 0x99, 0xe4, 0x03, 0xad,
+	0x98, 0x05, 0xdb, 0xfa, 0x92, 0xad, 0x1b, 0xfd, 0x63, 0x88, 0x06, 0xd5, 0xa2, 0xc3, 0xa7, 0x97,
+	0x23, 0xf0, 0xdf, 0x7d, 0x15, 0xb7, 0xe0, 0x4a, 0xa7, 0x5f, 0xea, 0xb0, 0x7d, 0xc8, 0x68, 0x31,
+	0x9e, 0x0e, 0x2a, 0xe9, 0x66, 0xfa, 0x31, 0x34, 0x95, 0x50, 0x74, 0x36, 0x1c, 0x8b, 0x32, 0xb7,
+	0x0f, 0x5f, 0x90, 0x6c, 0xbf, 0x39, 0x6b, 0xaf, 0x86, 0x09, 0x18, 0x67, 0x5f, 0xdb, 0x5a, 0x6b,
+	0xb6, 0xd6, 0x0a, 0xc5, 0x3a, 0xba, 0xcf, 0x9c, 0x72, 0x36, 0xcc, 0xcb, 0x6c, 0xc4, 0x0a, 0xec,
+	0x2f, 0xfb, 0xac, 0x84, 0x09, 0x68, 0xe7, 0x7b, 0x63, 0xa3, 0x7b, 0x60, 0xbc, 0xa1, 0x69, 0x6d,
+	0x5e, 0xc6, 0x20, 0xb9, 0xfe, 0xe6, 0xac, 0xbd, 0x12, 0x25, 0x91, 0xb6, 0x07, 0xda, 0xd4, 0xdb,
+	0xce, 0xd2, 0x2c, 0x55, 0xe6, 0xbd, 0x0c, 0x88, 0x75, 0xd0, 0x67, 0xe0, 0xab, 0x4a, 0xe2, 0xd0,
+	0xe0, 0xb8, 0xbb, 0x1e, 0xc7, 0xf2, 0x95, 0x27, 0x7a, 0x81, 0x05, 0x92, 0x7c, 0xf5, 0xfa, 0xdf,
+	0x56, 0xed, 0xd5, 0x79, 0xcb, 0x3b, 0x3d, 0x6f, 0x79, 0xff, 0x9c, 0xb7, 0xbc, 0x5f, 0x2f, 0x5a,
+	0xb5, 0xd3, 0x8b, 0x56, 0xed, 0xf5, 0x45, 0xab, 0xf6, 0x63, 0x97, 0xa7, 0x6a, 0x5a, 0x8e, 0xe2,
+	0xb1, 0xc8, 0xfa, 0xee, 0x5f, 0xcb, 0xfe, 0xdc, 0x93, 0x93, 0x17, 0xf6, 0x2f, 0x66, 0x14, 0x1a,
+	0x5d, 0x3e, 0xf8, 0x6f, 0x00, 0x1d, 0x23, 0xc8, 0xcc, 0xd7, 0x06, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -961,6 +964,20 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1332,6 +1349,12 @@ func (m *Result) Size() (n int) {
 			n += 1 + l + sovAbci(uint64(l))
 		}
 	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2475,6 +2498,40 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
@@ -1,6 +1,27 @@
 package grpc
 
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+	// GRPCMinBlockHeightHeader is the gRPC request header for the minimum block
+	// height a query must be run at, which nodes that have not reached it
+	// reject.
+	GRPCMinBlockHeightHeader = "x-cosmos-min-block-height"
 )
+
+// CheckMinBlockHeight returns a FailedPrecondition error if the height a query
+// is run at is below the minimum height of its GRPCMinBlockHeightHeader, which
+// is ignored if zero.
+func CheckMinBlockHeight(height, minHeight int64) error {
+	if height < minHeight {
+		return status.Errorf(codes.FailedPrecondition, "query height %d is below the minimum height %d of %s", height, minHeight, GRPCMinBlockHeightHeader)
+	}
+
+	return nil
+}
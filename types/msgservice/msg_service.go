@@ -1,17 +1,26 @@
 package msgservice
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
+	"reflect"
+	"strings"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"google.golang.org/grpc"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // RegisterMsgServiceDesc registers all type_urls from Msg services described
-// in `sd` into the registry.
+// in `sd` into the registry. The responses of the Msg service methods are
+// registered as tx.MsgResponse implementations.
 func RegisterMsgServiceDesc(registry codectypes.InterfaceRegistry, sd *grpc.ServiceDesc) {
 	// Adds a top-level type_url based on the Msg service name.
 	for _, method := range sd.Methods {
@@ -36,6 +45,64 @@ func RegisterMsgServiceDesc(registry codectypes.InterfaceRegistry, sd *grpc.Serv
 		}, noopInterceptor)
 
 	}
+
+	responses, err := msgServiceResponses(sd)
+	if err != nil {
+		// Same as above, this should only happen if there is a problem with code generation.
+		panic(fmt.Errorf("can't register response types of service %s: %w", sd.ServiceName, err))
+	}
+	registry.RegisterImplementations((*tx.MsgResponse)(nil), responses...)
+}
+
+// msgServiceResponses returns the response types of the methods of the Msg
+// service, read from the file descriptor registered in the gogoproto registry.
+func msgServiceResponses(sd *grpc.ServiceDesc) ([]proto.Message, error) {
+	file, ok := sd.Metadata.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected service metadata %v", sd.Metadata)
+	}
+	fd, err := decodeFileDesc(proto.FileDescriptor(file))
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []proto.Message
+	for _, service := range fd.GetService() {
+		if fmt.Sprintf("%s.%s", fd.GetPackage(), service.GetName()) != sd.ServiceName {
+			continue
+		}
+		for _, method := range service.GetMethod() {
+			outputType := strings.TrimPrefix(method.GetOutputType(), ".")
+			typ := proto.MessageType(outputType)
+			if typ == nil {
+				return nil, fmt.Errorf("type %s is not registered", outputType)
+			}
+			responses = append(responses, reflect.New(typ.Elem()).Interface().(proto.Message))
+		}
+	}
+
+	return responses, nil
+}
+
+// decodeFileDesc decompresses and unmarshals a gzipped file descriptor.
+func decodeFileDesc(enc []byte) (*descriptor.FileDescriptorProto, error) {
+	if len(enc) == 0 {
+		return nil, fmt.Errorf("file descriptor not found")
+	}
+	r, err := gzip.NewReader(bytes.NewReader(enc))
+	if err != nil {
+		return nil, fmt.Errorf("bad gzipped descriptor: %v", err)
+	}
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("bad gzipped descriptor: %v", err)
+	}
+
+	fd := new(descriptor.FileDescriptorProto)
+	if err := proto.Unmarshal(raw, fd); err != nil {
+		return nil, fmt.Errorf("bad descriptor: %v", err)
+	}
+	return fd, nil
 }
 
 // gRPC NOOP interceptor
@@ -90,6 +90,50 @@ func (s *paginationTestSuite) TestFilteredPaginations() {
 	s.Require().LessOrEqual(len(balances), 2)
 }
 
+func (s *paginationTestSuite) TestFilteredPaginationsNextKey() {
+	app, ctx, appCodec := setupTest(s.T())
+
+	// the filtered out balances are interleaved with the matching ones
+	var balances sdk.Coins
+	for i := 0; i < 10; i++ {
+		denom := fmt.Sprintf("test%ddenom", i)
+		if i%2 == 0 {
+			balances = append(balances, sdk.NewInt64Coin(denom, 250))
+		} else {
+			balances = append(balances, sdk.NewInt64Coin(denom, 100))
+		}
+	}
+
+	balances = balances.Sort()
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	s.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	s.T().Log("verify nextKey is the first result past the limit when counting the total")
+	pageReq := &query.PageRequest{Limit: 2, CountTotal: true}
+	balns, res, err := execFilterPaginate(store, pageReq, appCodec)
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+	s.Require().Equal("250test0denom,250test2denom", balns.String())
+	s.Require().Equal("test4denom", string(res.NextKey))
+	s.Require().Equal(uint64(5), res.Total)
+
+	s.T().Log("verify nextKey is the same without counting the total")
+	pageReq = &query.PageRequest{Limit: 2}
+	_, res, err = execFilterPaginate(store, pageReq, appCodec)
+	s.Require().NoError(err)
+	s.Require().Equal("test4denom", string(res.NextKey))
+
+	s.T().Log("use nextKey for query")
+	pageReq = &query.PageRequest{Key: res.NextKey, Limit: 2}
+	balns, res, err = execFilterPaginate(store, pageReq, appCodec)
+	s.Require().NoError(err)
+	s.Require().Equal("250test4denom,250test6denom", balns.String())
+	s.Require().Equal("test7denom", string(res.NextKey))
+}
+
 func (s *paginationTestSuite) TestReverseFilteredPaginations() {
 	app, ctx, appCodec := setupTest(s.T())
 
@@ -269,18 +269,28 @@ func (r TxResponse) GetTx() Tx {
 
 // WrapServiceResult wraps a result from a protobuf RPC service method call in
 // a Result object or error. This method takes care of marshaling the res param to
-// protobuf and attaching any events on the ctx.EventManager() to the Result.
+// protobuf, packing it in an Any and attaching any events on the ctx.EventManager()
+// to the Result.
 func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, error) {
 	if err != nil {
 		return nil, err
 	}
 
-	var data []byte
+	var (
+		data         []byte
+		msgResponses []*codectypes.Any
+	)
 	if res != nil {
 		data, err = proto.Marshal(res)
 		if err != nil {
 			return nil, err
 		}
+
+		any, err := codectypes.NewAnyWithValue(res)
+		if err != nil {
+			return nil, err
+		}
+		msgResponses = []*codectypes.Any{any}
 	}
 
 	var events []abci.Event
@@ -289,7 +299,8 @@ func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, erro
 	}
 
 	return &Result{
-		Data:   data,
-		Events: events,
+		Data:         data,
+		Events:       events,
+		MsgResponses: msgResponses,
 	}, nil
 }
@@ -214,4 +214,7 @@ func TestWrapServiceResult(t *testing.T) {
 	err = proto.Unmarshal(res.Data, &spot2)
 	require.NoError(t, err)
 	require.Equal(t, spot, spot2)
+	require.Len(t, res.MsgResponses, 1)
+	require.Equal(t, "/testdata.Dog", res.MsgResponses[0].TypeUrl)
+	require.Equal(t, res.Data, res.MsgResponses[0].Value)
 }
@@ -553,6 +553,101 @@ func (m *GetTxResponse) GetTxResponse() *types.TxResponse {
 	return nil
 }
 
+// SubscribeTxEventsRequest is the request type for the Service.SubscribeTxEvents
+// RPC method.
+type SubscribeTxEventsRequest struct {
+	// query is the Tendermint query of the events of the txs to stream, e.g.
+	// "message.sender='cosmos1...'", or empty for all the txs.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *SubscribeTxEventsRequest) Reset()         { *m = SubscribeTxEventsRequest{} }
+func (m *SubscribeTxEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxEventsRequest) ProtoMessage()    {}
+func (*SubscribeTxEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *SubscribeTxEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTxEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTxEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeTxEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxEventsRequest.Merge(m, src)
+}
+func (m *SubscribeTxEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTxEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxEventsRequest proto.InternalMessageInfo
+
+func (m *SubscribeTxEventsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+// SubscribeTxEventsResponse is the response type for the Service.SubscribeTxEvents
+// RPC method, streamed for each tx matching the query.
+type SubscribeTxEventsResponse struct {
+	// tx_response is the result of the tx, along with the tx.
+	TxResponse *types.TxResponse `protobuf:"bytes,1,opt,name=tx_response,json=txResponse,proto3" json:"tx_response,omitempty"`
+}
+
+func (m *SubscribeTxEventsResponse) Reset()         { *m = SubscribeTxEventsResponse{} }
+func (m *SubscribeTxEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxEventsResponse) ProtoMessage()    {}
+func (*SubscribeTxEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{9}
+}
+func (m *SubscribeTxEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTxEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTxEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeTxEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxEventsResponse.Merge(m, src)
+}
+func (m *SubscribeTxEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTxEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxEventsResponse proto.InternalMessageInfo
+
+func (m *SubscribeTxEventsResponse) GetTxResponse() *types.TxResponse {
+	if m != nil {
+		return m.TxResponse
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	golang_proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
@@ -574,6 +669,10 @@ func init() {
 	golang_proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	golang_proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
+	proto.RegisterType((*SubscribeTxEventsRequest)(nil), "cosmos.tx.v1beta1.SubscribeTxEventsRequest")
+	golang_proto.RegisterType((*SubscribeTxEventsRequest)(nil), "cosmos.tx.v1beta1.SubscribeTxEventsRequest")
+	proto.RegisterType((*SubscribeTxEventsResponse)(nil), "cosmos.tx.v1beta1.SubscribeTxEventsResponse")
+	golang_proto.RegisterType((*SubscribeTxEventsResponse)(nil), "cosmos.tx.v1beta1.SubscribeTxEventsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
@@ -582,59 +681,63 @@ func init() {
 }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x3b, 0x65, 0x93, 0xbe, 0x64, 0x4b, 0x3a, 0xbb, 0x14, 0x93, 0x82, 0x37, 0x75, 0xc9,
-	0x36, 0x44, 0xc2, 0x56, 0x03, 0x48, 0x08, 0x71, 0x89, 0x93, 0x74, 0x59, 0x41, 0x9b, 0x6a, 0xb2,
-	0x08, 0x15, 0x21, 0x45, 0x4e, 0x32, 0xf5, 0x5a, 0x6c, 0x3c, 0x59, 0xcf, 0x64, 0xe5, 0xa8, 0xad,
-	0x90, 0x38, 0x72, 0x42, 0xe2, 0x67, 0xf0, 0x27, 0x38, 0x72, 0x5c, 0x89, 0x0b, 0x47, 0xb4, 0xe1,
-	0x47, 0x70, 0x44, 0x1e, 0x4f, 0x12, 0x27, 0xeb, 0x74, 0x11, 0xa7, 0xbc, 0xc9, 0x7c, 0xef, 0x7b,
-	0xdf, 0xfb, 0xe6, 0xcd, 0x18, 0xf6, 0x07, 0x94, 0x8d, 0x28, 0xb3, 0x78, 0x68, 0x9d, 0x3f, 0xec,
-	0x13, 0xee, 0x3c, 0xb4, 0x18, 0x09, 0xce, 0xbd, 0x01, 0x31, 0xc7, 0x01, 0xe5, 0x14, 0xdd, 0x8e,
-	0x01, 0x26, 0x0f, 0x4d, 0x09, 0x28, 0xbd, 0xeb, 0x52, 0xea, 0x9e, 0x12, 0xcb, 0x19, 0x7b, 0x96,
-	0xe3, 0xfb, 0x94, 0x3b, 0xdc, 0xa3, 0x3e, 0x8b, 0x13, 0x4a, 0xf7, 0x25, 0x63, 0xdf, 0x61, 0xc4,
-	0x72, 0xfa, 0x03, 0x6f, 0x41, 0x1c, 0x2d, 0x24, 0xa8, 0x74, 0xb5, 0x2c, 0x0f, 0xe5, 0xde, 0x9e,
-	0x4b, 0x5d, 0x2a, 0x42, 0x2b, 0x8a, 0xe4, 0xbf, 0xb5, 0x24, 0xed, 0xd9, 0x84, 0x04, 0xd3, 0x45,
-	0xe6, 0xd8, 0x71, 0x3d, 0x5f, 0x68, 0x88, 0xb1, 0xc6, 0xaf, 0x0a, 0xa0, 0x43, 0xc2, 0x8f, 0x43,
-	0xd6, 0x3e, 0x27, 0x3e, 0xc7, 0xe4, 0x6c, 0x42, 0x18, 0x47, 0x77, 0x60, 0x9b, 0x44, 0x6b, 0xa6,
-	0x29, 0xe5, 0x4c, 0xf5, 0x26, 0x96, 0x2b, 0xf4, 0x08, 0x60, 0x49, 0xa1, 0xa9, 0x65, 0xa5, 0x9a,
-	0xaf, 0x1f, 0x98, 0xb2, 0xef, 0xa8, 0x9e, 0x29, 0xea, 0xcd, 0xfb, 0x37, 0x9f, 0x3a, 0x2e, 0x91,
-	0x9c, 0x38, 0x91, 0x89, 0x3e, 0x81, 0x1c, 0x0d, 0x86, 0x24, 0xe8, 0xf5, 0xa7, 0x5a, 0xa6, 0xac,
-	0x54, 0x6f, 0xd5, 0x4b, 0xe6, 0x15, 0xf7, 0xcc, 0x4e, 0x04, 0xb1, 0xa7, 0x38, 0x4b, 0xe3, 0xc0,
-	0xb8, 0x50, 0x60, 0x77, 0x45, 0x2d, 0x1b, 0x53, 0x9f, 0x11, 0xf4, 0x00, 0x32, 0x3c, 0x8c, 0xb5,
-	0xe6, 0xeb, 0x6f, 0xa5, 0x30, 0x1d, 0x87, 0x38, 0x42, 0xa0, 0x43, 0x28, 0xf0, 0xb0, 0x17, 0xc8,
-	0x3c, 0xa6, 0xa9, 0x22, 0xe3, 0xfd, 0x95, 0x0e, 0x84, 0xf7, 0x89, 0x44, 0x09, 0xc6, 0x79, 0xbe,
-	0x88, 0x23, 0xa2, 0xa4, 0x11, 0x19, 0x61, 0xc4, 0x83, 0x6b, 0x8d, 0x90, 0x4c, 0x89, 0x54, 0x83,
-	0x00, 0xb2, 0x03, 0xea, 0x0c, 0x07, 0x0e, 0xe3, 0x51, 0xb1, 0xd8, 0xff, 0x77, 0x20, 0xc7, 0xc3,
-	0x5e, 0x7f, 0xca, 0x49, 0xd4, 0x95, 0x52, 0x2d, 0xe0, 0x2c, 0x0f, 0xed, 0x68, 0x89, 0x3e, 0x86,
-	0x1b, 0x23, 0x3a, 0x24, 0xc2, 0xfc, 0x5b, 0xf5, 0x72, 0x4a, 0xb3, 0x0b, 0xbe, 0xc7, 0x74, 0x48,
-	0xb0, 0x40, 0x1b, 0xdf, 0xc1, 0xee, 0x4a, 0x19, 0x69, 0x5c, 0x1b, 0xf2, 0x09, 0x3f, 0x44, 0xa9,
-	0xff, 0x6a, 0x07, 0x2c, 0xed, 0x30, 0xbe, 0x81, 0x37, 0xbb, 0xde, 0x68, 0x72, 0xea, 0xf0, 0xf9,
-	0x69, 0xa3, 0x0f, 0x40, 0xe5, 0xa1, 0x24, 0x4c, 0x3f, 0x11, 0x5b, 0xd5, 0x14, 0xac, 0xf2, 0x70,
-	0xa5, 0x59, 0x75, 0xa5, 0x59, 0xe3, 0x27, 0x05, 0x8a, 0x4b, 0x66, 0x29, 0xfa, 0x73, 0xc8, 0xb9,
-	0x0e, 0xeb, 0x79, 0xfe, 0x73, 0x2a, 0x0b, 0xdc, 0xdb, 0xac, 0xf8, 0xd0, 0x61, 0x47, 0xfe, 0x73,
-	0x8a, 0xb3, 0x6e, 0x1c, 0xa0, 0x4f, 0x61, 0x3b, 0x20, 0x6c, 0x72, 0xca, 0xe5, 0xf8, 0x96, 0x37,
-	0xe7, 0x62, 0x81, 0xc3, 0x12, 0x6f, 0x18, 0x50, 0x10, 0xc3, 0x37, 0x6f, 0x11, 0xc1, 0x8d, 0x13,
-	0x87, 0x9d, 0x08, 0x0d, 0x37, 0xb1, 0x88, 0x8d, 0x57, 0xb0, 0x23, 0x31, 0x52, 0x6c, 0xe5, 0x5a,
-	0x1f, 0x84, 0x07, 0x6b, 0x07, 0xa1, 0xfe, 0xbf, 0x83, 0xa8, 0x7d, 0x01, 0x59, 0x79, 0x69, 0x90,
-	0x06, 0x7b, 0x1d, 0xdc, 0x6a, 0xe3, 0x9e, 0xfd, 0xac, 0xf7, 0xf5, 0x93, 0xee, 0xd3, 0x76, 0xf3,
-	0xe8, 0xd1, 0x51, 0xbb, 0x55, 0xdc, 0x42, 0x45, 0x28, 0x2c, 0x76, 0x1a, 0xdd, 0x66, 0x51, 0x41,
-	0xb7, 0x61, 0x67, 0xf1, 0x4f, 0xab, 0xdd, 0x6d, 0x16, 0xd5, 0xda, 0x4b, 0xd8, 0x59, 0x99, 0x23,
-	0xa4, 0x43, 0xc9, 0xc6, 0x9d, 0x46, 0xab, 0xd9, 0xe8, 0x1e, 0xf7, 0x1e, 0x77, 0x5a, 0xed, 0x35,
-	0x56, 0x0d, 0xf6, 0xd6, 0xf6, 0xed, 0xaf, 0x3a, 0xcd, 0x2f, 0x8b, 0x0a, 0x7a, 0x1b, 0x76, 0xd7,
-	0x76, 0xba, 0xcf, 0x9e, 0x34, 0x8b, 0x6a, 0x4a, 0x4a, 0x43, 0xec, 0x64, 0xea, 0xff, 0x64, 0x20,
-	0xdb, 0x8d, 0x1f, 0x57, 0xf4, 0x02, 0x72, 0xf3, 0x11, 0x40, 0x46, 0x8a, 0x83, 0x6b, 0x93, 0x57,
-	0xba, 0xff, 0x5a, 0x8c, 0x9c, 0xd8, 0x83, 0x1f, 0xff, 0xf8, 0xfb, 0x17, 0xb5, 0x6c, 0xdc, 0xb5,
-	0x52, 0x5e, 0x75, 0x09, 0xfe, 0x4c, 0xa9, 0xa1, 0x33, 0x78, 0x43, 0x9c, 0x27, 0xda, 0x4f, 0x61,
-	0x4d, 0x4e, 0x43, 0xa9, 0xbc, 0x19, 0x20, 0x6b, 0x56, 0x44, 0xcd, 0x7d, 0xf4, 0x9e, 0x95, 0xf6,
-	0xa4, 0x33, 0xeb, 0x45, 0x34, 0x41, 0xaf, 0xd0, 0x0f, 0x90, 0x4f, 0x5c, 0x55, 0x54, 0x79, 0xdd,
-	0x0d, 0x5f, 0x96, 0x3f, 0xb8, 0x0e, 0x26, 0x45, 0xdc, 0x13, 0x22, 0xee, 0x1a, 0x77, 0xd2, 0x45,
-	0x44, 0x3d, 0xbf, 0x84, 0x7c, 0xe2, 0x91, 0x4d, 0x15, 0x70, 0xf5, 0x93, 0x91, 0x2a, 0x20, 0xe5,
-	0xad, 0x36, 0x74, 0x21, 0x40, 0x43, 0x1b, 0x04, 0xd8, 0xcd, 0xdf, 0x2f, 0x75, 0xe5, 0xe2, 0x52,
-	0x57, 0xfe, 0xba, 0xd4, 0x95, 0x9f, 0x67, 0xfa, 0xd6, 0x6f, 0x33, 0x5d, 0xb9, 0x98, 0xe9, 0x5b,
-	0x7f, 0xce, 0xf4, 0xad, 0x6f, 0x2b, 0xae, 0xc7, 0x4f, 0x26, 0x7d, 0x73, 0x40, 0x47, 0xf3, 0xfc,
-	0xf8, 0xe7, 0x43, 0x36, 0xfc, 0xde, 0xe2, 0xd3, 0x31, 0x89, 0x08, 0xfb, 0xdb, 0xe2, 0xeb, 0xf6,
-	0xd1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x86, 0xcd, 0x5c, 0xb4, 0x07, 0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xac, 0xd3, 0x38, 0x7d, 0x4e, 0x8a, 0x33, 0x09, 0x65, 0xbb, 0x85, 0x8d, 0xbb, 0x25,
+	0x69, 0x08, 0xb0, 0xdb, 0x1a, 0x90, 0x10, 0xe2, 0x12, 0xff, 0x68, 0x88, 0xa0, 0x75, 0x35, 0x36,
+	0x42, 0x45, 0x48, 0xd6, 0xae, 0x3d, 0xdd, 0xac, 0x48, 0x3c, 0xce, 0xce, 0x38, 0x5a, 0xab, 0xad,
+	0x90, 0x38, 0x72, 0x42, 0xe2, 0xcf, 0xe0, 0x9f, 0xe0, 0xc8, 0x31, 0x12, 0x17, 0x8e, 0x28, 0xe1,
+	0xc4, 0x5f, 0x81, 0x76, 0x76, 0xec, 0xac, 0x9d, 0x75, 0x5d, 0x21, 0x4e, 0x9e, 0xf1, 0x7c, 0xef,
+	0x7b, 0xdf, 0xfb, 0xde, 0x9b, 0x1d, 0xd8, 0xec, 0x30, 0x7e, 0xcc, 0xb8, 0x23, 0x22, 0xe7, 0xf4,
+	0x81, 0x47, 0x85, 0xfb, 0xc0, 0xe1, 0x34, 0x3c, 0x0d, 0x3a, 0xd4, 0xee, 0x87, 0x4c, 0x30, 0xbc,
+	0x96, 0x00, 0x6c, 0x11, 0xd9, 0x0a, 0x60, 0xbc, 0xed, 0x33, 0xe6, 0x1f, 0x51, 0xc7, 0xed, 0x07,
+	0x8e, 0xdb, 0xeb, 0x31, 0xe1, 0x8a, 0x80, 0xf5, 0x78, 0x12, 0x60, 0xdc, 0x55, 0x8c, 0x9e, 0xcb,
+	0xa9, 0xe3, 0x7a, 0x9d, 0x60, 0x4c, 0x1c, 0x6f, 0x14, 0xc8, 0xb8, 0x9a, 0x56, 0x44, 0xea, 0x6c,
+	0xc3, 0x67, 0x3e, 0x93, 0x4b, 0x27, 0x5e, 0xa9, 0x7f, 0x77, 0xd3, 0xb4, 0x27, 0x03, 0x1a, 0x0e,
+	0xc7, 0x91, 0x7d, 0xd7, 0x0f, 0x7a, 0x52, 0x43, 0x82, 0xb5, 0x7e, 0x45, 0x80, 0xf7, 0xa9, 0x68,
+	0x45, 0xbc, 0x7e, 0x4a, 0x7b, 0x82, 0xd0, 0x93, 0x01, 0xe5, 0x02, 0xdf, 0x84, 0x25, 0x1a, 0xef,
+	0xb9, 0x8e, 0x4a, 0xb9, 0x9d, 0xeb, 0x44, 0xed, 0xf0, 0x43, 0x80, 0x4b, 0x0a, 0x5d, 0x2b, 0xa1,
+	0x9d, 0x42, 0x79, 0xdb, 0x56, 0x75, 0xc7, 0xf9, 0x6c, 0x99, 0x6f, 0x54, 0xbf, 0xfd, 0xc4, 0xf5,
+	0xa9, 0xe2, 0x24, 0xa9, 0x48, 0xfc, 0x09, 0x2c, 0xb3, 0xb0, 0x4b, 0xc3, 0xb6, 0x37, 0xd4, 0x73,
+	0x25, 0xb4, 0x73, 0xa3, 0x6c, 0xd8, 0x57, 0xdc, 0xb3, 0x1b, 0x31, 0xa4, 0x32, 0x24, 0x79, 0x96,
+	0x2c, 0xac, 0x33, 0x04, 0xeb, 0x13, 0x6a, 0x79, 0x9f, 0xf5, 0x38, 0xc5, 0xf7, 0x20, 0x27, 0xa2,
+	0x44, 0x6b, 0xa1, 0xfc, 0x66, 0x06, 0x53, 0x2b, 0x22, 0x31, 0x02, 0xef, 0xc3, 0x8a, 0x88, 0xda,
+	0xa1, 0x8a, 0xe3, 0xba, 0x26, 0x23, 0xde, 0x9d, 0xa8, 0x40, 0x7a, 0x9f, 0x0a, 0x54, 0x60, 0x52,
+	0x10, 0xe3, 0x75, 0x4c, 0x94, 0x36, 0x22, 0x27, 0x8d, 0xb8, 0x37, 0xd7, 0x08, 0xc5, 0x94, 0x0a,
+	0xb5, 0x28, 0xe0, 0x4a, 0xc8, 0xdc, 0x6e, 0xc7, 0xe5, 0xa2, 0x15, 0x29, 0xaf, 0xf0, 0x2d, 0x58,
+	0x16, 0x51, 0xdb, 0x1b, 0x0a, 0x1a, 0x57, 0x85, 0x76, 0x56, 0x48, 0x5e, 0x44, 0x95, 0x78, 0x8b,
+	0x3f, 0x86, 0xc5, 0x63, 0xd6, 0xa5, 0xd2, 0xfc, 0x1b, 0xe5, 0x52, 0x46, 0xb1, 0x63, 0xbe, 0x47,
+	0xac, 0x4b, 0x89, 0x44, 0x5b, 0xdf, 0xc1, 0xfa, 0x44, 0x1a, 0x65, 0x5c, 0x1d, 0x0a, 0x29, 0x3f,
+	0x64, 0xaa, 0xd7, 0xb5, 0x03, 0x2e, 0xed, 0xb0, 0xbe, 0x81, 0x37, 0x9a, 0xc1, 0xf1, 0xe0, 0xc8,
+	0x15, 0xa3, 0x6e, 0xe3, 0xf7, 0x40, 0x13, 0x91, 0x22, 0xcc, 0xee, 0x48, 0x45, 0xd3, 0x11, 0xd1,
+	0x44, 0x34, 0x51, 0xac, 0x36, 0x51, 0xac, 0xf5, 0x13, 0x82, 0xe2, 0x25, 0xb3, 0x12, 0xfd, 0x39,
+	0x2c, 0xfb, 0x2e, 0x6f, 0x07, 0xbd, 0x67, 0x4c, 0x25, 0xb8, 0x33, 0x5b, 0xf1, 0xbe, 0xcb, 0x0f,
+	0x7a, 0xcf, 0x18, 0xc9, 0xfb, 0xc9, 0x02, 0x7f, 0x0a, 0x4b, 0x21, 0xe5, 0x83, 0x23, 0xa1, 0xc6,
+	0xb7, 0x34, 0x3b, 0x96, 0x48, 0x1c, 0x51, 0x78, 0xcb, 0x82, 0x15, 0x39, 0x7c, 0xa3, 0x12, 0x31,
+	0x2c, 0x1e, 0xba, 0xfc, 0x50, 0x6a, 0xb8, 0x4e, 0xe4, 0xda, 0x7a, 0x09, 0xab, 0x0a, 0xa3, 0xc4,
+	0x6e, 0xcd, 0xf5, 0x41, 0x7a, 0x30, 0xd5, 0x08, 0xed, 0x3f, 0x36, 0xe2, 0x3e, 0xe8, 0xcd, 0x81,
+	0xc7, 0x3b, 0x61, 0xe0, 0xd1, 0x56, 0x24, 0x2f, 0x09, 0x1f, 0xc9, 0xdd, 0x80, 0x6b, 0x72, 0x26,
+	0x95, 0xde, 0x64, 0x63, 0x79, 0x70, 0x2b, 0x23, 0xe2, 0x7f, 0x1d, 0x8f, 0xdd, 0x2f, 0x20, 0xaf,
+	0xae, 0x32, 0xd6, 0x61, 0xa3, 0x41, 0x6a, 0x75, 0xd2, 0xae, 0x3c, 0x6d, 0x7f, 0xfd, 0xb8, 0xf9,
+	0xa4, 0x5e, 0x3d, 0x78, 0x78, 0x50, 0xaf, 0x15, 0x17, 0x70, 0x11, 0x56, 0xc6, 0x27, 0x7b, 0xcd,
+	0x6a, 0x11, 0xe1, 0x35, 0x58, 0x1d, 0xff, 0x53, 0xab, 0x37, 0xab, 0x45, 0x6d, 0xf7, 0x05, 0xac,
+	0x4e, 0x4c, 0x37, 0x36, 0xc1, 0xa8, 0x90, 0xc6, 0x5e, 0xad, 0xba, 0xd7, 0x6c, 0xb5, 0x1f, 0x35,
+	0x6a, 0xf5, 0x29, 0x56, 0x1d, 0x36, 0xa6, 0xce, 0x2b, 0x5f, 0x35, 0xaa, 0x5f, 0x16, 0x11, 0x7e,
+	0x0b, 0xd6, 0xa7, 0x4e, 0x9a, 0x4f, 0x1f, 0x57, 0x8b, 0x5a, 0x46, 0xc8, 0x9e, 0x3c, 0xc9, 0x95,
+	0xff, 0x59, 0x84, 0x7c, 0x33, 0xf9, 0xe4, 0xe3, 0xe7, 0xb0, 0x3c, 0x1a, 0x4c, 0x6c, 0x65, 0xf4,
+	0x75, 0xea, 0x3e, 0x18, 0x77, 0x5f, 0x89, 0x51, 0xed, 0xdb, 0xfe, 0xf1, 0x8f, 0xbf, 0x7f, 0xd1,
+	0x4a, 0xd6, 0x6d, 0x27, 0xe3, 0xad, 0x51, 0xe0, 0xcf, 0xd0, 0x2e, 0x3e, 0x81, 0x6b, 0x72, 0xca,
+	0xf0, 0x66, 0x06, 0x6b, 0x7a, 0x46, 0x8d, 0xd2, 0x6c, 0x80, 0xca, 0xb9, 0x25, 0x73, 0x6e, 0xe2,
+	0x77, 0x9c, 0xac, 0x87, 0x86, 0x3b, 0xcf, 0xe3, 0xb9, 0x7e, 0x89, 0x7f, 0x80, 0x42, 0xea, 0x03,
+	0x82, 0xb7, 0x5e, 0xf5, 0xdd, 0xb9, 0x4c, 0xbf, 0x3d, 0x0f, 0xa6, 0x44, 0xdc, 0x91, 0x22, 0x6e,
+	0x5b, 0x37, 0xb3, 0x45, 0xc4, 0x35, 0xbf, 0x80, 0x42, 0xea, 0xd3, 0x9f, 0x29, 0xe0, 0xea, 0x43,
+	0x66, 0x6c, 0xcf, 0x83, 0x29, 0x01, 0xa6, 0x14, 0xa0, 0xe3, 0x19, 0x02, 0x70, 0x1f, 0xd6, 0xae,
+	0x5c, 0x13, 0xfc, 0x7e, 0x56, 0x4f, 0x67, 0x5c, 0x3f, 0xe3, 0x83, 0xd7, 0x03, 0x27, 0x7a, 0xee,
+	0xa3, 0x4a, 0xf5, 0xf7, 0x73, 0x13, 0x9d, 0x9d, 0x9b, 0xe8, 0xaf, 0x73, 0x13, 0xfd, 0x7c, 0x61,
+	0x2e, 0xfc, 0x76, 0x61, 0xa2, 0xb3, 0x0b, 0x73, 0xe1, 0xcf, 0x0b, 0x73, 0xe1, 0xdb, 0x2d, 0x3f,
+	0x10, 0x87, 0x03, 0xcf, 0xee, 0xb0, 0xe3, 0x91, 0xe2, 0xe4, 0xe7, 0x43, 0xde, 0xfd, 0xde, 0x11,
+	0xc3, 0x3e, 0x8d, 0x4b, 0xf0, 0x96, 0xe4, 0x2b, 0xff, 0xd1, 0xbf, 0x03, 0x00, 0x92, 0xcd, 0x55,
+	0xe9, 0xbc, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -657,6 +760,11 @@ type ServiceClient interface {
 	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
 	GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error)
+	// SubscribeTxEvents streams the results of the txs matching a query, as the
+	// node commits them.
+	//
+	// Since: cosmos-sdk 0.46
+	SubscribeTxEvents(ctx context.Context, in *SubscribeTxEventsRequest, opts ...grpc.CallOption) (Service_SubscribeTxEventsClient, error)
 }
 
 type serviceClient struct {
@@ -703,6 +811,38 @@ func (c *serviceClient) GetTxsEvent(ctx context.Context, in *GetTxsEventRequest,
 	return out, nil
 }
 
+func (c *serviceClient) SubscribeTxEvents(ctx context.Context, in *SubscribeTxEventsRequest, opts ...grpc.CallOption) (Service_SubscribeTxEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[0], "/cosmos.tx.v1beta1.Service/SubscribeTxEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribeTxEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribeTxEventsClient interface {
+	Recv() (*SubscribeTxEventsResponse, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribeTxEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribeTxEventsClient) Recv() (*SubscribeTxEventsResponse, error) {
+	m := new(SubscribeTxEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -713,6 +853,11 @@ type ServiceServer interface {
 	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
 	GetTxsEvent(context.Context, *GetTxsEventRequest) (*GetTxsEventResponse, error)
+	// SubscribeTxEvents streams the results of the txs matching a query, as the
+	// node commits them.
+	//
+	// Since: cosmos-sdk 0.46
+	SubscribeTxEvents(*SubscribeTxEventsRequest, Service_SubscribeTxEventsServer) error
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -731,6 +876,9 @@ func (*UnimplementedServiceServer) BroadcastTx(ctx context.Context, req *Broadca
 func (*UnimplementedServiceServer) GetTxsEvent(ctx context.Context, req *GetTxsEventRequest) (*GetTxsEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxsEvent not implemented")
 }
+func (*UnimplementedServiceServer) SubscribeTxEvents(req *SubscribeTxEventsRequest, srv Service_SubscribeTxEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxEvents not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -808,6 +956,27 @@ func _Service_GetTxsEvent_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SubscribeTxEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTxEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).SubscribeTxEvents(m, &serviceSubscribeTxEventsServer{stream})
+}
+
+type Service_SubscribeTxEventsServer interface {
+	Send(*SubscribeTxEventsResponse) error
+	grpc.ServerStream
+}
+
+type serviceSubscribeTxEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribeTxEventsServer) Send(m *SubscribeTxEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -829,7 +998,13 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Service_GetTxsEvent_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTxEvents",
+			Handler:       _Service_SubscribeTxEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
 }
 
@@ -1181,6 +1356,71 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubscribeTxEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTxEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTxEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeTxEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTxEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTxEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -1332,6 +1572,32 @@ func (m *GetTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *SubscribeTxEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeTxEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2264,6 +2530,174 @@ func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SubscribeTxEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTxEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTxEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeTxEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTxEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTxEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxResponse == nil {
+				m.TxResponse = &types.TxResponse{}
+			}
+			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
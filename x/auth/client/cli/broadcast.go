@@ -7,7 +7,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	clientcli "github.com/cosmos/cosmos-sdk/client/cli"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
 
@@ -22,6 +24,11 @@ broadcast it to a node. If you supply a dash (-) argument in place of an input
 filename, the command reads from standard input.
 
 $ <appd> tx broadcast ./mytxn.json
+
+With --wait, after a successful sync broadcast the command polls the node until the
+transaction is included in a block, and prints its execution result, with its code,
+gas used and events. If it is not included within --wait-timeout, the command fails
+with the hash of the transaction, which can still be queried later.
 `),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -49,7 +56,12 @@ $ <appd> tx broadcast ./mytxn.json
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			res, err = tx.AwaitInclusion(clientCtx, res)
+			if err != nil {
+				return err
+			}
+
+			return clientcli.PrintTxResponse(clientCtx, res)
 		},
 	}
 
@@ -3,6 +3,9 @@ package cli
 import (
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -12,37 +15,61 @@ import (
 
 const flagHex = "hex"
 
+// decodeOutput is the output of the decode command.
+type decodeOutput struct {
+	Encoding string          `json:"encoding"`
+	Tx       json.RawMessage `json:"tx"`
+}
+
 // GetDecodeCommand returns the decode command to take serialized bytes and turn
 // it into a JSON-encoded transaction.
 func GetDecodeCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "decode [protobuf-byte-string]",
+		Use:   "decode [encoded-tx | @file]",
 		Short: "Decode a binary encoded transaction string",
-		Args:  cobra.ExactArgs(1),
+		Long: `Decode the base64, or hexadecimal with --hex, bytes of a transaction given as argument,
+or read from the file named after an at sign (@). The bytes are decoded as a protobuf transaction,
+or else as an amino StdTx, and the transaction is output as protobuf JSON along with the detected
+encoding.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
-			var txBytes []byte
 
+			encoded := args[0]
+			if strings.HasPrefix(encoded, "@") {
+				bz, err := os.ReadFile(strings.TrimPrefix(encoded, "@"))
+				if err != nil {
+					return err
+				}
+				encoded = strings.TrimSpace(string(bz))
+			}
+
+			var txBytes []byte
 			if useHex, _ := cmd.Flags().GetBool(flagHex); useHex {
-				txBytes, err = hex.DecodeString(args[0])
+				txBytes, err = hex.DecodeString(encoded)
 			} else {
-				txBytes, err = base64.StdEncoding.DecodeString(args[0])
+				txBytes, err = base64.StdEncoding.DecodeString(encoded)
 			}
 			if err != nil {
 				return err
 			}
 
-			tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
+			txBuilder, encoding, err := decodeTxBytes(clientCtx, txBytes)
+			if err != nil {
+				return err
+			}
+
+			txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
 			if err != nil {
 				return err
 			}
 
-			json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+			out, err := json.Marshal(decodeOutput{Encoding: encoding, Tx: txJSON})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintBytes(json)
+			return clientCtx.PrintBytes(out)
 		},
 	}
 
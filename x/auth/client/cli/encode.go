@@ -2,38 +2,61 @@ package cli
 
 import (
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// The encodings of a tx detected by the decode, encode and simulate commands.
+const (
+	txEncodingProtobuf = "protobuf"
+	txEncodingAmino    = "amino"
 )
 
 // GetEncodeCommand returns the encode command to take a JSONified transaction and turn it into
-// Amino-serialized bytes
+// Protobuf-serialized bytes
 func GetEncodeCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "encode [file]",
 		Short: "Encode transactions generated offline",
 		Long: `Encode transactions created with the --generate-only flag and signed with the sign command.
-Read a transaction from <file>, serialize it to the Protobuf wire protocol, and output it as base64.
+Read a transaction from <file>, serialize it to the Protobuf wire protocol, and output it as base64,
+or as hexadecimal with --hex.
+The file holds the protobuf JSON or the amino JSON of the transaction, as the format is detected,
+or the output of the decode command.
 If you supply a dash (-) argument in place of an input filename, the command reads from standard input.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
-			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			bz, err := readFileOrStdin(args[0])
+			if err != nil {
+				return err
+			}
+
+			txBuilder, _, err := decodeTxJSON(clientCtx, bz)
 			if err != nil {
 				return err
 			}
 
 			// re-encode it
-			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
 			if err != nil {
 				return err
 			}
 
+			if useHex, _ := cmd.Flags().GetBool(flagHex); useHex {
+				return clientCtx.PrintString(hex.EncodeToString(txBytes) + "\n")
+			}
+
 			// base64 encode the encoded tx bytes
 			txBytesBase64 := base64.StdEncoding.EncodeToString(txBytes)
 
@@ -41,7 +64,76 @@ If you supply a dash (-) argument in place of an input filename, the command rea
 		},
 	}
 
+	cmd.Flags().BoolP(flagHex, "x", false, "Output the transaction bytes as hexadecimal instead of base64")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// decodeTxJSON decodes the protobuf JSON or the amino JSON of a tx, as
+// detected from its fields, into a tx builder, and returns the detected
+// encoding. The output of the decode command is decoded as the tx it holds.
+func decodeTxJSON(clientCtx client.Context, bz []byte) (client.TxBuilder, string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, "", fmt.Errorf("malformed tx file, expected a JSON object: %w", err)
+	}
+
+	switch {
+	case fields["body"] != nil:
+		decoded, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to decode the protobuf JSON tx: %w", err)
+		}
+
+		txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(decoded)
+		return txBuilder, txEncodingProtobuf, err
+
+	case fields["encoding"] != nil && fields["tx"] != nil:
+		return decodeTxJSON(clientCtx, fields["tx"])
+
+	case fields["type"] != nil || fields["msg"] != nil:
+		var stdTx legacytx.StdTx
+		if fields["type"] == nil {
+			// the StdTx value, without the amino type wrapper
+			bz = []byte(fmt.Sprintf(`{"type":"cosmos-sdk/StdTx","value":%s}`, bz))
+		}
+		if err := clientCtx.LegacyAmino.UnmarshalJSON(bz, &stdTx); err != nil {
+			return nil, "", fmt.Errorf("failed to decode the amino JSON tx: %w", err)
+		}
+
+		txBuilder, err := stdTxBuilder(clientCtx, stdTx)
+		return txBuilder, txEncodingAmino, err
+
+	default:
+		return nil, "", errors.New("unrecognized tx file, expected the protobuf JSON of a tx with a body, or the amino JSON of a StdTx")
+	}
+}
+
+// decodeTxBytes decodes the protobuf bytes of a tx, or else the amino bytes of
+// a StdTx, into a tx builder, and returns the detected encoding.
+func decodeTxBytes(clientCtx client.Context, txBytes []byte) (client.TxBuilder, string, error) {
+	decoded, protoErr := clientCtx.TxConfig.TxDecoder()(txBytes)
+	if protoErr == nil {
+		txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(decoded)
+		return txBuilder, txEncodingProtobuf, err
+	}
+
+	var stdTx legacytx.StdTx
+	if clientCtx.LegacyAmino == nil || clientCtx.LegacyAmino.Unmarshal(txBytes, &stdTx) != nil {
+		return nil, "", fmt.Errorf("failed to decode the tx as protobuf or amino: %w", protoErr)
+	}
+
+	txBuilder, err := stdTxBuilder(clientCtx, stdTx)
+	return txBuilder, txEncodingAmino, err
+}
+
+// stdTxBuilder copies an amino StdTx into a new tx builder.
+func stdTxBuilder(clientCtx client.Context, stdTx legacytx.StdTx) (client.TxBuilder, error) {
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	if err := tx.CopyTx(stdTx, txBuilder, false); err != nil {
+		return nil, err
+	}
+
+	return txBuilder, nil
+}
@@ -1,17 +1,29 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestGetCommandEncode(t *testing.T) {
@@ -82,3 +94,132 @@ func TestGetCommandDecode(t *testing.T) {
 	cmd.SetArgs([]string{base64Encoded})
 	require.NoError(t, cmd.ExecuteContext(ctx))
 }
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	encodingConfig := simappparams.MakeTestEncodingConfig()
+	std.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	authtypes.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	banktypes.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	txCfg := encodingConfig.TxConfig
+	clientCtx := client.Context{}.
+		WithTxConfig(txCfg).
+		WithCodec(encodingConfig.Codec).
+		WithLegacyAmino(encodingConfig.Amino)
+
+	run := func(cmd *cobra.Command, args ...string) (string, error) {
+		out := &bytes.Buffer{}
+		ctx := clientCtx.WithOutput(out)
+		_ = testutil.ApplyMockIODiscardOutErr(cmd)
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &ctx))
+		return strings.TrimSpace(out.String()), err
+	}
+
+	// Build a test transaction with a msg
+	_, _, from := testdata.KeyTestPubAddr()
+	_, _, to := testdata.KeyTestPubAddr()
+	builder := txCfg.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))))
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	builder.SetMemo("foomemo")
+
+	protoJSON, err := txCfg.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	protoBytes, err := txCfg.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	stdTx, err := tx.ConvertTxToStdTx(encodingConfig.Amino, builder.GetTx())
+	require.NoError(t, err)
+	aminoJSON, err := encodingConfig.Amino.MarshalJSON(stdTx)
+	require.NoError(t, err)
+	aminoBytes, err := encodingConfig.Amino.Marshal(stdTx)
+	require.NoError(t, err)
+
+	decodeCases := []struct {
+		name        string
+		txBytes     []byte
+		expEncoding string
+	}{
+		{"protobuf bytes", protoBytes, txEncodingProtobuf},
+		{"amino bytes", aminoBytes, txEncodingAmino},
+	}
+	for _, tc := range decodeCases {
+		for _, useHex := range []bool{false, true} {
+			for _, fromFile := range []bool{false, true} {
+				t.Run(fmt.Sprintf("decode %s, hex %t, from file %t", tc.name, useHex, fromFile), func(t *testing.T) {
+					encoded := base64.StdEncoding.EncodeToString(tc.txBytes)
+					if useHex {
+						encoded = hex.EncodeToString(tc.txBytes)
+					}
+					arg := encoded
+					if fromFile {
+						arg = "@" + testutil.WriteToNewTempFile(t, encoded+"\n").Name()
+					}
+
+					out, err := run(GetDecodeCommand(), arg, fmt.Sprintf("--%s=%t", flagHex, useHex))
+					require.NoError(t, err)
+					var decoded decodeOutput
+					require.NoError(t, json.Unmarshal([]byte(out), &decoded))
+					require.Equal(t, tc.expEncoding, decoded.Encoding)
+					require.JSONEq(t, string(protoJSON), string(decoded.Tx))
+
+					// the output of decode is encoded back into the protobuf bytes
+					decodedFile := testutil.WriteToNewTempFile(t, out)
+					out, err = run(GetEncodeCommand(), decodedFile.Name())
+					require.NoError(t, err)
+					require.Equal(t, base64.StdEncoding.EncodeToString(protoBytes), out)
+				})
+			}
+		}
+	}
+
+	encodeCases := []struct {
+		name   string
+		txJSON []byte
+	}{
+		{"protobuf JSON", protoJSON},
+		{"amino JSON", aminoJSON},
+	}
+	for _, tc := range encodeCases {
+		for _, useHex := range []bool{false, true} {
+			t.Run(fmt.Sprintf("encode %s, hex %t", tc.name, useHex), func(t *testing.T) {
+				txFile := testutil.WriteToNewTempFile(t, string(tc.txJSON))
+				encoded, err := run(GetEncodeCommand(), txFile.Name(), fmt.Sprintf("--%s=%t", flagHex, useHex))
+				require.NoError(t, err)
+
+				expEncoded := base64.StdEncoding.EncodeToString(protoBytes)
+				if useHex {
+					expEncoded = hex.EncodeToString(protoBytes)
+				}
+				require.Equal(t, expEncoded, encoded)
+
+				out, err := run(GetDecodeCommand(), encoded, fmt.Sprintf("--%s=%t", flagHex, useHex))
+				require.NoError(t, err)
+				var decoded decodeOutput
+				require.NoError(t, json.Unmarshal([]byte(out), &decoded))
+				require.Equal(t, txEncodingProtobuf, decoded.Encoding)
+				require.JSONEq(t, string(protoJSON), string(decoded.Tx))
+			})
+		}
+	}
+
+	// a tx with a msg of an unregistered type fails with its type URL
+	const unknownTypeURL = "/unknown.v1beta1.MsgUnknown"
+	bodyBytes, err := (&txtypes.TxBody{
+		Messages: []*codectypes.Any{{TypeUrl: unknownTypeURL, Value: []byte{}}},
+	}).Marshal()
+	require.NoError(t, err)
+	unknownBytes, err := (&txtypes.TxRaw{BodyBytes: bodyBytes}).Marshal()
+	require.NoError(t, err)
+	_, err = run(GetDecodeCommand(), base64.StdEncoding.EncodeToString(unknownBytes))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), unknownTypeURL)
+
+	unknownJSON := strings.Replace(string(protoJSON), "/cosmos.bank.v1beta1.MsgSend", unknownTypeURL, 1)
+	_, err = run(GetEncodeCommand(), testutil.WriteToNewTempFile(t, unknownJSON).Name())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), unknownTypeURL)
+}
@@ -2,8 +2,10 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -20,6 +22,7 @@ import (
 
 const (
 	flagEvents = "events"
+	flagSigner = "signer"
 	flagType   = "type"
 
 	typeHash   = "hash"
@@ -41,6 +44,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetAccountCmd(),
+		GetAccountNumbersCmd(),
 		GetAccountsCmd(),
 		QueryParamsCmd(),
 		QueryModuleAccountsCmd(),
@@ -112,6 +116,68 @@ func GetAccountCmd() *cobra.Command {
 	return cmd
 }
 
+// accountNumbers is the output of the account-numbers command, the fields of an
+// account needed to sign a tx offline.
+type accountNumbers struct {
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+	HasPubKey     bool   `json:"has_pub_key"`
+}
+
+// GetAccountNumbersCmd returns a query command that will display the account
+// number and the sequence of the account at a given address, and whether its
+// public key is known, as needed to sign a tx offline.
+func GetAccountNumbersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-numbers [address]",
+		Short: "Query the account number and the sequence of an account, as needed to sign offline",
+		Long: strings.TrimSpace(`Query the account number and the sequence of an account, and whether its public key
+is known on chain, which it is once the account has signed a tx. They are the values of the
+--account-number and --sequence flags of a tx signed offline by the account:
+
+$ <appd> query auth account-numbers <address>
+$ <appd> tx sign tx.json --from <address> --offline --account-number <account_number> --sequence <sequence>
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			key, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: key.String()})
+			if err != nil {
+				return err
+			}
+
+			var acc types.AccountI
+			if err := clientCtx.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(accountNumbers{
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+				HasPubKey:     acc.GetPubKey() != nil,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAccountsCmd returns a query command that will display a list of accounts
 func GetAccountsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -183,16 +249,48 @@ Each event takes the form of '%s'. Please refer
 to each module's documentation for the full set of events to query for. Each module
 documents its respective events under 'xx_events.md'.
 
+With --%s, search instead for the transactions signed by an address: the transactions
+with a message sent by the address, signed by the address as any of their signers, or whose
+fees were paid by the address as a fee granter. They are ordered by height, and printed as a
+table unless --output is json.
+
 Example:
 $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator_reward' --page 1 --limit 30
-`, eventFormat, version.AppName, flagEvents),
+$ %s query txs --%s cosmos1... --page 1 --limit 30
+`, eventFormat, flagSigner, version.AppName, flagEvents, version.AppName, flagSigner),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
+			page, _ := cmd.Flags().GetInt(flags.FlagPage)
+			limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
 			eventsRaw, _ := cmd.Flags().GetString(flagEvents)
+			signer, _ := cmd.Flags().GetString(flagSigner)
+
+			switch {
+			case eventsRaw != "" && signer != "":
+				return fmt.Errorf("only one of --%s and --%s can be given", flagEvents, flagSigner)
+			case eventsRaw == "" && signer == "":
+				return fmt.Errorf("either --%s or --%s must be given", flagEvents, flagSigner)
+			case signer != "":
+				if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+					return err
+				}
+
+				txs, err := authtx.QueryTxsBySigner(clientCtx, signer, page, limit)
+				if err != nil {
+					return err
+				}
+
+				if clientCtx.OutputFormat == "json" {
+					return clientCtx.PrintProto(txs)
+				}
+
+				return clientCtx.PrintString(formatTxsTable(txs))
+			}
+
 			eventsStr := strings.Trim(eventsRaw, "'")
 
 			var events []string
@@ -221,9 +319,6 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 				tmEvents = append(tmEvents, event)
 			}
 
-			page, _ := cmd.Flags().GetInt(flags.FlagPage)
-			limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
-
 			txs, err := authtx.QueryTxsByEvents(clientCtx, tmEvents, page, limit, "")
 			if err != nil {
 				return err
@@ -237,11 +332,26 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 	cmd.Flags().Int(flags.FlagPage, query.DefaultPage, "Query a specific page of paginated results")
 	cmd.Flags().Int(flags.FlagLimit, query.DefaultLimit, "Query number of transactions results per page returned")
 	cmd.Flags().String(flagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", eventFormat))
-	cmd.MarkFlagRequired(flagEvents)
+	cmd.Flags().String(flagSigner, "", "Query the transactions signed by an address, instead of --events")
 
 	return cmd
 }
 
+// formatTxsTable formats the txs of a search result as a table, with a line
+// per tx, followed by the page and total count of the result.
+func formatTxsTable(res *sdk.SearchTxsResult) string {
+	var buf strings.Builder
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HEIGHT\tTXHASH\tCODE\tGAS USED\tTIMESTAMP")
+	for _, tx := range res.Txs {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%s\n", tx.Height, tx.TxHash, tx.Code, tx.GasUsed, tx.Timestamp)
+	}
+	w.Flush()
+
+	fmt.Fprintf(&buf, "page %d of %d, %d txs in total\n", res.PageNumber, res.PageTotal, res.TotalCount)
+	return buf.String()
+}
+
 // QueryTxCmd implements the default command for a tx query.
 func QueryTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
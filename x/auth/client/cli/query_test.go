@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParseSigs(t *testing.T) {
@@ -30,3 +32,16 @@ func TestParseSigs(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatTxsTable(t *testing.T) {
+	res := sdk.NewSearchTxsResult(3, 2, 1, 2, []*sdk.TxResponse{
+		{Height: 5, TxHash: "AB12", GasUsed: 61234, Timestamp: "2021-01-01T00:00:00Z"},
+		{Height: 12, TxHash: "CD34", Code: 5, GasUsed: 100, Timestamp: "2021-01-01T00:01:00Z"},
+	})
+
+	require.Equal(t, `HEIGHT  TXHASH  CODE  GAS USED  TIMESTAMP
+5       AB12    0     61234     2021-01-01T00:00:00Z
+12      CD34    5     100       2021-01-01T00:01:00Z
+page 1 of 2, 3 txs in total
+`, formatTxsTable(res))
+}
@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// simulateOutput is the output of the simulate command.
+type simulateOutput struct {
+	GasUsed       uint64                `json:"gas_used"`
+	GasEstimate   uint64                `json:"gas_estimate"`
+	GasAdjustment float64               `json:"gas_adjustment"`
+	Events        []abci.Event          `json:"events"`
+	MsgResponses  []simulateMsgResponse `json:"msg_responses"`
+}
+
+// simulateMsgResponse is the response of a msg of the simulated tx. The raw
+// data of the response is output if its type is not registered.
+type simulateMsgResponse struct {
+	MsgType  string          `json:"msg_type"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Data     []byte          `json:"data,omitempty"`
+}
+
+// GetSimulateCommand returns the tx simulate command.
+func GetSimulateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [file]",
+		Short: "Simulate the execution of a signed or unsigned transaction file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the execution of a transaction read from [file], or from standard
+input if [file] is a dash (-), and print the gas it uses, the gas limit estimated
+with --gas-adjustment, and the events and responses of its messages.
+
+The file holds the protobuf JSON or the amino JSON of the transaction, as the
+format is detected. The signatures of an unsigned transaction are filled in with
+empty signatures of the current sequence of its signers, as simulations do not
+verify the signatures.
+
+Example:
+$ %s tx simulate unsigned_tx.json
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Offline {
+				return errors.New("cannot simulate a tx during offline mode")
+			}
+
+			bz, err := readFileOrStdin(args[0])
+			if err != nil {
+				return err
+			}
+
+			txBuilder, encoding, err := decodeTxJSON(clientCtx, bz)
+			if err != nil {
+				return err
+			}
+
+			if err := setSimulationSignatures(clientCtx, txBuilder, encoding == txEncodingAmino); err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			simRes, err := txtypes.NewServiceClient(clientCtx).Simulate(context.Background(), &txtypes.SimulateRequest{
+				TxBytes: txBytes,
+			})
+			if err != nil {
+				return err
+			}
+
+			msgResponses, err := decodeMsgResponses(clientCtx, simRes.Result.Data)
+			if err != nil {
+				return err
+			}
+
+			gasAdj := tx.NewFactoryCLI(clientCtx, cmd.Flags()).GasAdjustment()
+			out := simulateOutput{
+				GasUsed:       simRes.GasInfo.GasUsed,
+				GasEstimate:   uint64(gasAdj * float64(simRes.GasInfo.GasUsed)),
+				GasAdjustment: gasAdj,
+				Events:        simRes.Result.Events,
+				MsgResponses:  msgResponses,
+			}
+
+			outJSON, err := json.Marshal(out)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(outJSON)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// decodeMsgResponses decodes the msg responses of the data of a simulated tx
+// result. The response of a msg is decoded into the <Msg>Response type of its
+// Msg service method.
+func decodeMsgResponses(clientCtx client.Context, data []byte) ([]simulateMsgResponse, error) {
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(data, &txMsgData); err != nil {
+		return nil, fmt.Errorf("failed to decode the simulated tx result data: %w", err)
+	}
+
+	msgResponses := make([]simulateMsgResponse, 0, len(txMsgData.Data))
+	for _, msgData := range txMsgData.Data {
+		msgResponse := simulateMsgResponse{MsgType: msgData.MsgType}
+
+		resType := proto.MessageType(strings.TrimPrefix(msgData.MsgType, "/") + "Response")
+		if resType == nil {
+			msgResponse.Data = msgData.Data
+			msgResponses = append(msgResponses, msgResponse)
+			continue
+		}
+
+		res := reflect.New(resType.Elem()).Interface().(proto.Message)
+		if err := proto.Unmarshal(msgData.Data, res); err != nil {
+			return nil, fmt.Errorf("failed to decode the response of %s: %w", msgData.MsgType, err)
+		}
+		resJSON, err := clientCtx.Codec.MarshalJSON(res)
+		if err != nil {
+			return nil, err
+		}
+
+		msgResponse.Response = resJSON
+		msgResponses = append(msgResponses, msgResponse)
+	}
+
+	return msgResponses, nil
+}
+
+// readFileOrStdin reads the file of the given name, or the standard input if
+// the name is a dash (-).
+func readFileOrStdin(filename string) ([]byte, error) {
+	if filename == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+
+	return os.ReadFile(filename)
+}
+
+// setSimulationSignatures fills in the signatures of an unsigned tx with empty
+// signatures of the current sequences of its signers. The signatures of an
+// amino JSON tx, which do not hold their sequence, are set the current
+// sequences of their signers.
+func setSimulationSignatures(clientCtx client.Context, txBuilder client.TxBuilder, isAmino bool) error {
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	signers := txBuilder.GetTx().GetSigners()
+	if len(sigs) != 0 && len(sigs) != len(signers) {
+		return fmt.Errorf("tx has %d signatures for %d signers, expected none or all of them", len(sigs), len(signers))
+	}
+	if len(sigs) != 0 && !isAmino {
+		return nil
+	}
+
+	unsigned := len(sigs) == 0
+	if unsigned {
+		sigs = make([]signing.SignatureV2, len(signers))
+	}
+
+	signMode := clientCtx.TxConfig.SignModeHandler().DefaultMode()
+	for i, signer := range signers {
+		acc, err := clientCtx.AccountRetriever.GetAccount(clientCtx, signer)
+		if err != nil {
+			return err
+		}
+
+		sigs[i].Sequence = acc.GetSequence()
+		if !unsigned {
+			continue
+		}
+
+		// the simulation replaces a missing public key of the account with
+		// a secp256k1 key
+		pubKey := acc.GetPubKey()
+		if pubKey == nil {
+			pubKey = &secp256k1.PubKey{}
+		}
+		sigs[i].PubKey = pubKey
+		sigs[i].Data = simulationSignatureData(pubKey, signMode)
+	}
+
+	return txBuilder.SetSignatures(sigs...)
+}
+
+// simulationSignatureData returns the empty signature data of a public key,
+// with as many signatures as the threshold of a multisig public key, so that
+// the simulation consumes the gas of their verification.
+func simulationSignatureData(pubKey cryptotypes.PubKey, signMode signing.SignMode) signing.SignatureData {
+	multisigPubKey, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return &signing.SingleSignatureData{SignMode: signMode}
+	}
+
+	pubKeys := multisigPubKey.GetPubKeys()
+	data := multisig.NewMultisig(len(pubKeys))
+	for i := 0; i < int(multisigPubKey.GetThreshold()) && i < len(pubKeys); i++ {
+		data.BitArray.SetIndex(i, true)
+		data.Signatures = append(data.Signatures, simulationSignatureData(pubKeys[i], signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))
+	}
+
+	return data
+}
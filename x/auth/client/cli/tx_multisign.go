@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -222,14 +221,21 @@ multisig key [name], and attach the key name to the transaction read from [file]
 Example:
 $ %s tx multisign-batch transactions.json multisigk1k2k3 k1sigs.json k2sigs.json k3sig.json
 
+Each [signature-file] is the output of the sign-batch command run with --multisig
+on the same [file], with one signature per transaction. The sequence of the first
+transaction is the sequence of the multisig account, queried unless --offline is set,
+or the one set by --sequence; it is incremented for each following transaction unless
+--no-auto-increment is set. The signed transactions are printed one per line, ready to
+be broadcast.
+
 The current multisig implementation defaults to amino-json sign mode.
 The SIGN_MODE_DIRECT sign mode is not supported.'
 `, version.AppName,
 			),
 		),
-		PreRun: preSignCmd,
-		RunE:   makeBatchMultisignCmd(),
-		Args:   cobra.MinimumNArgs(3),
+		PreRunE: preSignCmd,
+		RunE:    makeBatchMultisignCmd(),
+		Args:    cobra.MinimumNArgs(3),
 	}
 
 	cmd.Flags().Bool(flagNoAutoIncrement, false, "disable sequence auto increment")
@@ -294,15 +300,13 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		if !clientCtx.Offline {
-			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
-			if err != nil {
-				return err
-			}
-
-			txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
+		txFactory, err = prepareBatchFactory(cmd, clientCtx, txFactory, addr)
+		if err != nil {
+			return err
 		}
 
+		noAutoIncrement, _ := cmd.Flags().GetBool(flagNoAutoIncrement)
+
 		// prepare output document
 		closeFunc, err := setOutputFile(cmd)
 		if err != nil {
@@ -312,7 +316,9 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 		defer closeFunc()
 		clientCtx.WithOutput(cmd.OutOrStdout())
 
+		txCount := 0
 		for i := 0; scanner.Scan(); i++ {
+			txCount++
 			txBldr, err := txCfg.WrapTxBuilder(scanner.Tx())
 			if err != nil {
 				return err
@@ -328,16 +334,24 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 				ChainID:       txFactory.ChainID(),
 				AccountNumber: txFactory.AccountNumber(),
 				Sequence:      txFactory.Sequence(),
-				SignerIndex:   i,
 			}
 
-			for _, sig := range signatureBatch {
-				err = signing.VerifySignature(sig[i].PubKey, signingData, sig[i].Data, txCfg.SignModeHandler(), txBldr.GetTx())
+			for j, sigs := range signatureBatch {
+				if i >= len(sigs) {
+					return fmt.Errorf("%s has %d signatures, fewer than the transactions of %s", args[j+2], len(sigs), args[0])
+				}
+
+				sig := sigs[i]
+				if sig.Sequence != txFactory.Sequence() {
+					return fmt.Errorf("signature %d of %s is for sequence %d, expected %d", i, args[j+2], sig.Sequence, txFactory.Sequence())
+				}
+
+				err = signing.VerifySignature(sig.PubKey, signingData, sig.Data, txCfg.SignModeHandler(), txBldr.GetTx())
 				if err != nil {
-					return fmt.Errorf("couldn't verify signature: %w %v", err, sig)
+					return fmt.Errorf("couldn't verify signature %d of %s: %w", i, args[j+2], err)
 				}
 
-				if err := multisig.AddSignatureV2(multisigSig, sig[i], multisigPub.GetPubKeys()); err != nil {
+				if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
 					return err
 				}
 			}
@@ -383,14 +397,24 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 				return err
 			}
 
-			if viper.GetBool(flagNoAutoIncrement) {
+			if noAutoIncrement {
 				continue
 			}
 			sequence := txFactory.Sequence() + 1
 			txFactory = txFactory.WithSequence(sequence)
 		}
 
-		return scanner.UnmarshalErr()
+		if err := scanner.UnmarshalErr(); err != nil {
+			return err
+		}
+
+		for j, sigs := range signatureBatch {
+			if len(sigs) != txCount {
+				return fmt.Errorf("%s has %d signatures for the %d transactions of %s", args[j+2], len(sigs), txCount, args[0])
+			}
+		}
+
+		return nil
 	}
 }
 
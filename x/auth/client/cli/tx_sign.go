@@ -2,13 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
 
@@ -38,15 +41,19 @@ it is required to set such parameters manually. Note, invalid values will cause
 the transaction to fail. The sequence will be incremented automatically for each
 transaction that is signed.
 
-If --account-number or --sequence flag is used when offline=false, they are ignored and 
-overwritten by the default flag values.
+When offline=false, the account number and the sequence of the signing account are
+queried once for the whole batch, and the --account-number flag is ignored. The
+--sequence flag sets the sequence of the first transaction instead of the queried one,
+or, prefixed by +, is added to the queried one, e.g. --sequence +1 to skip a transaction
+of the account still in the mempool.
 
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig
-account key. It implies --signature-only.
+account key, whose account number and sequence are used. It implies --signature-only.
+The signature files of the signers are assembled with the multisign-batch command.
 `,
-		PreRun: preSignCmd,
-		RunE:   makeSignBatchCmd(),
-		Args:   cobra.ExactArgs(1),
+		PreRunE: preSignCmd,
+		RunE:    makeSignBatchCmd(),
+		Args:    cobra.ExactArgs(1),
 	}
 
 	cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig account on behalf of which the transaction shall be signed")
@@ -68,7 +75,10 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 		txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
 		txCfg := clientCtx.TxConfig
 		printSignatureOnly, _ := cmd.Flags().GetBool(flagSigOnly)
-		infile := os.Stdin
+		var infile io.Reader = os.Stdin
+		if clientCtx.Input != nil {
+			infile = clientCtx.Input
+		}
 
 		ms, err := cmd.Flags().GetString(flagMultisig)
 		if err != nil {
@@ -89,11 +99,37 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 			if err != nil {
 				return err
 			}
+		} else {
+			// the txs are read from the standard input, the keyring must not prompt on it
+			clientCtx, err = clientCtx.WithKeyringPromptsFromTTY()
+			if err != nil {
+				return err
+			}
+			txFactory = txFactory.WithKeybase(clientCtx.Keyring)
 		}
 		scanner := authclient.NewBatchScanner(txCfg, infile)
 
-		if !clientCtx.Offline {
-			txFactory = txFactory.WithAccountNumber(0).WithSequence(0)
+		// the txs are signed by the from key, or on behalf of the multisig
+		var (
+			signerAddr sdk.AccAddress
+			fromName   string
+		)
+		if ms == "" {
+			from, _ := cmd.Flags().GetString(flags.FlagFrom)
+			signerAddr, fromName, _, err = client.GetFromFields(txFactory.Keybase(), from, clientCtx.GenerateOnly)
+			if err != nil {
+				return fmt.Errorf("error getting account from keybase: %w", err)
+			}
+		} else {
+			signerAddr, _, _, err = client.GetFromFields(txFactory.Keybase(), ms, clientCtx.GenerateOnly)
+			if err != nil {
+				return fmt.Errorf("error getting account from keybase: %w", err)
+			}
+		}
+
+		txFactory, err = prepareBatchFactory(cmd, clientCtx, txFactory, signerAddr)
+		if err != nil {
+			return err
 		}
 
 		for sequence := txFactory.Sequence(); scanner.Scan(); sequence++ {
@@ -104,25 +140,10 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 				return err
 			}
 			if ms == "" {
-				from, _ := cmd.Flags().GetString(flags.FlagFrom)
-				_, fromName, _, err := client.GetFromFields(txFactory.Keybase(), from, clientCtx.GenerateOnly)
-				if err != nil {
-					return fmt.Errorf("error getting account from keybase: %w", err)
-				}
 				err = authclient.SignTx(txFactory, clientCtx, fromName, txBuilder, true, true)
-				if err != nil {
-					return err
-				}
 			} else {
-				multisigAddr, _, _, err := client.GetFromFields(txFactory.Keybase(), ms, clientCtx.GenerateOnly)
-				if err != nil {
-					return fmt.Errorf("error getting account from keybase: %w", err)
-				}
 				err = authclient.SignTxWithSignerAddress(
-					txFactory, clientCtx, multisigAddr, clientCtx.GetFromName(), txBuilder, clientCtx.Offline, true)
-				if err != nil {
-					return err
-				}
+					txFactory, clientCtx, signerAddr, clientCtx.GetFromName(), txBuilder, true, true)
 			}
 
 			if err != nil {
@@ -134,7 +155,9 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 				return err
 			}
 
-			cmd.Printf("%s\n", json)
+			if _, err := fmt.Fprintf(cmd.OutOrStdout(), "%s\n", json); err != nil {
+				return err
+			}
 		}
 
 		if err := scanner.UnmarshalErr(); err != nil {
@@ -145,6 +168,36 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// prepareBatchFactory sets the account number and the first sequence of a
+// batch of txs signed by the account of addr. Unless offline, they are queried
+// once for the whole batch, and the --sequence flag overrides the queried
+// sequence, or is added to it if relative, e.g. +1.
+func prepareBatchFactory(cmd *cobra.Command, clientCtx client.Context, txFactory tx.Factory, addr sdk.AccAddress) (tx.Factory, error) {
+	if clientCtx.Offline {
+		return txFactory, nil
+	}
+
+	accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+	if err != nil {
+		return txFactory, err
+	}
+
+	seqStr, _ := cmd.Flags().GetString(flags.FlagSequence)
+	seqSetting, err := flags.ParseSequenceSetting(seqStr)
+	if err != nil {
+		return txFactory, err
+	}
+
+	switch {
+	case seqSetting.Relative:
+		seq += seqSetting.Sequence
+	case cmd.Flags().Changed(flags.FlagSequence):
+		seq = seqSetting.Sequence
+	}
+
+	return txFactory.WithAccountNumber(accNum).WithSequence(seq), nil
+}
+
 func setOutputFile(cmd *cobra.Command) (func(), error) {
 	outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
 	if outputDoc == "" {
@@ -168,6 +221,11 @@ func GetSignCommand() *cobra.Command {
 		Short: "Sign a transaction generated offline",
 		Long: `Sign a transaction created with the --generate-only flag.
 It will read a transaction from [file], sign it, and print its JSON encoding.
+If you supply a dash (-) argument in place of an input filename, the command reads
+from standard input, and the keyring passphrase is prompted on the terminal, unless
+it is given by --keyring-passphrase-file or the KEYRING_PASSPHRASE variable:
+
+$ <appd> tx bank send <from> <to> 10stake --generate-only | <appd> tx sign - --from <from> | <appd> tx broadcast -
 
 If the --signature-only flag is set, it will output the signature parts only.
 
@@ -180,9 +238,9 @@ The --multisig=<multisig_key> flag generates a signature on behalf of a multisig
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
 `,
-		PreRun: preSignCmd,
-		RunE:   makeSignCmd(),
-		Args:   cobra.ExactArgs(1),
+		PreRunE: preSignCmd,
+		RunE:    makeSignCmd(),
+		Args:    cobra.ExactArgs(1),
 	}
 
 	cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig account on behalf of which the transaction shall be signed")
@@ -197,13 +255,39 @@ be generated via the 'multisign' command.
 	return cmd
 }
 
-func preSignCmd(cmd *cobra.Command, _ []string) {
-	// Conditionally mark the account and sequence numbers required as no RPC
-	// query will be done.
-	if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
-		cmd.MarkFlagRequired(flags.FlagAccountNumber)
-		cmd.MarkFlagRequired(flags.FlagSequence)
+// preSignCmd checks that the account number, the sequence and the chain ID are
+// set when offline, as no RPC query will be done, and names those missing.
+func preSignCmd(cmd *cobra.Command, _ []string) error {
+	if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); !offline {
+		return nil
+	}
+
+	var missing []string
+	for _, flag := range []string{flags.FlagAccountNumber, flags.FlagSequence} {
+		if !cmd.Flags().Changed(flag) {
+			missing = append(missing, "--"+flag)
+		}
+	}
+
+	chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+	if chainID == "" && client.GetClientContextFromCmd(cmd).ChainID == "" {
+		missing = append(missing, "--"+flags.FlagChainID)
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("offline mode requires %s to be set, as they cannot be queried from the node", strings.Join(missing, ", "))
 	}
+
+	seqStr, _ := cmd.Flags().GetString(flags.FlagSequence)
+	seqSetting, err := flags.ParseSequenceSetting(seqStr)
+	if err != nil {
+		return err
+	}
+	if seqSetting.Relative {
+		return fmt.Errorf("--%s %s is relative to the sequence of the account, which cannot be queried in offline mode", flags.FlagSequence, seqStr)
+	}
+
+	return nil
 }
 
 func makeSignCmd() func(cmd *cobra.Command, args []string) error {
@@ -216,6 +300,14 @@ func makeSignCmd() func(cmd *cobra.Command, args []string) error {
 		}
 		f := cmd.Flags()
 
+		// the tx is read from the standard input, the keyring must not prompt on it
+		if args[0] == "-" {
+			clientCtx, err = clientCtx.WithKeyringPromptsFromTTY()
+			if err != nil {
+				return err
+			}
+		}
+
 		clientCtx, txF, newTx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
 		if err != nil {
 			return err
@@ -291,8 +383,8 @@ func makeSignCmd() func(cmd *cobra.Command, args []string) error {
 
 		outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
 		if outputDoc == "" {
-			cmd.Printf("%s\n", json)
-			return nil
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", json)
+			return err
 		}
 
 		fp, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
@@ -25,9 +25,9 @@ the signatures were collected in the right order, and if the signature is valid
 given transaction. If the --offline flag is also set, signature validation over the
 transaction will be not be performed as that will require RPC communication with a full node.
 `,
-		PreRun: preSignCmd,
-		RunE:   makeValidateSignaturesCmd(),
-		Args:   cobra.ExactArgs(1),
+		PreRunE: preSignCmd,
+		RunE:    makeValidateSignaturesCmd(),
+		Args:    cobra.ExactArgs(1),
 	}
 
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
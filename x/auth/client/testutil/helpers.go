@@ -46,6 +46,14 @@ func TxEncodeExec(clientCtx client.Context, filename string, extraArgs ...string
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetEncodeCommand(), append(args, extraArgs...))
 }
 
+func TxSimulateExec(clientCtx client.Context, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		filename,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetSimulateCommand(), append(args, extraArgs...))
+}
+
 func TxValidateSignaturesExec(clientCtx client.Context, filename string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
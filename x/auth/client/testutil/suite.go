@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -29,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authcli "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
@@ -185,7 +187,7 @@ func (s *IntegrationTestSuite) TestCLISignGenOnly() {
 				fmt.Sprintf("--%s=%d", flags.FlagSequence, account.GetSequence()),
 			},
 			true,
-			`required flag(s) "account-number" not set`,
+			"offline mode requires --account-number to be set",
 		},
 		{
 			"offline mode without sequence and keyname (invalid)",
@@ -196,7 +198,7 @@ func (s *IntegrationTestSuite) TestCLISignGenOnly() {
 				fmt.Sprintf("--%s=%d", flags.FlagAccountNumber, account.GetAccountNumber()),
 			},
 			true,
-			`required flag(s) "sequence" not set`,
+			"offline mode requires --sequence to be set",
 		},
 		{
 			"offline mode without account-number, sequence and keyname (invalid)",
@@ -206,7 +208,19 @@ func (s *IntegrationTestSuite) TestCLISignGenOnly() {
 				fmt.Sprintf("--%s=true", flags.FlagOffline),
 			},
 			true,
-			`required flag(s) "account-number", "sequence" not set`,
+			"offline mode requires --account-number, --sequence to be set",
+		},
+		{
+			"offline mode with a relative sequence (invalid)",
+			[]string{
+				opFile.Name(),
+				fmt.Sprintf("--%s=true", flags.FlagOffline),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, keyName),
+				fmt.Sprintf("--%s=%d", flags.FlagAccountNumber, account.GetAccountNumber()),
+				fmt.Sprintf("--%s=+1", flags.FlagSequence),
+			},
+			true,
+			"--sequence +1 is relative to the sequence of the account, which cannot be queried in offline mode",
 		},
 	}
 
@@ -224,6 +238,14 @@ func (s *IntegrationTestSuite) TestCLISignGenOnly() {
 			s.Require().NoError(err)
 		}
 	}
+
+	// the chain ID is required offline too, when neither the flag nor the client config sets it
+	_, err = TxSignExec(val.ClientCtx.WithChainID(""), val.Address, opFile.Name(),
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+		fmt.Sprintf("--%s=%d", flags.FlagAccountNumber, account.GetAccountNumber()),
+		fmt.Sprintf("--%s=%d", flags.FlagSequence, account.GetSequence()),
+	)
+	s.Require().EqualError(err, "offline mode requires --chain-id to be set, as they cannot be queried from the node")
 }
 
 func (s *IntegrationTestSuite) TestCLISignBatch() {
@@ -242,15 +264,15 @@ func (s *IntegrationTestSuite) TestCLISignBatch() {
 
 	// sign-batch file - offline is set but account-number and sequence are not
 	_, err = TxSignBatchExec(val.ClientCtx, val.Address, outputFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID), "--offline")
-	s.Require().EqualError(err, "required flag(s) \"account-number\", \"sequence\" not set")
+	s.Require().EqualError(err, "offline mode requires --account-number, --sequence to be set, as they cannot be queried from the node")
 
 	// sign-batch file - offline and sequence is set but account-number is not set
 	_, err = TxSignBatchExec(val.ClientCtx, val.Address, outputFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID), fmt.Sprintf("--%s=%s", flags.FlagSequence, "1"), "--offline")
-	s.Require().EqualError(err, "required flag(s) \"account-number\" not set")
+	s.Require().EqualError(err, "offline mode requires --account-number to be set, as they cannot be queried from the node")
 
 	// sign-batch file - offline and account-number is set but sequence is not set
 	_, err = TxSignBatchExec(val.ClientCtx, val.Address, outputFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID), fmt.Sprintf("--%s=%s", flags.FlagAccountNumber, "1"), "--offline")
-	s.Require().EqualError(err, "required flag(s) \"sequence\" not set")
+	s.Require().EqualError(err, "offline mode requires --sequence to be set, as they cannot be queried from the node")
 
 	// sign-batch file - sequence and account-number are set when offline is false
 	res, err := TxSignBatchExec(val.ClientCtx, val.Address, outputFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID), fmt.Sprintf("--%s=%s", flags.FlagSequence, "1"), fmt.Sprintf("--%s=%s", flags.FlagAccountNumber, "1"))
@@ -267,6 +289,23 @@ func (s *IntegrationTestSuite) TestCLISignBatch() {
 	s.Require().NoError(err)
 	s.Require().Equal(3, len(strings.Split(strings.Trim(res.String(), "\n"), "\n")))
 
+	// sign-batch file - the sequence of the first tx is relative to the queried one
+	account, err := val.ClientCtx.AccountRetriever.GetAccount(val.ClientCtx, val.Address)
+	s.Require().NoError(err)
+	res, err = TxSignBatchExec(val.ClientCtx, val.Address, outputFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID), fmt.Sprintf("--%s=+2", flags.FlagSequence))
+	s.Require().NoError(err)
+	lines := strings.Split(strings.Trim(res.String(), "\n"), "\n")
+	s.Require().Len(lines, 3)
+	for i, line := range lines {
+		sigs, err := val.ClientCtx.TxConfig.UnmarshalSignatureJSON([]byte(line))
+		s.Require().NoError(err)
+		s.Require().Equal(account.GetSequence()+2+uint64(i), sigs[0].Sequence)
+	}
+
+	// sign-batch file - a relative sequence cannot be used offline, as the sequence is not queried
+	_, err = TxSignBatchExec(val.ClientCtx, val.Address, outputFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID), fmt.Sprintf("--%s=+1", flags.FlagSequence), fmt.Sprintf("--%s=%s", flags.FlagAccountNumber, "1"), "--offline")
+	s.Require().EqualError(err, "--sequence +1 is relative to the sequence of the account, which cannot be queried in offline mode")
+
 	// Sign batch malformed tx file.
 	malformedFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf("%smalformed", generatedStd))
 	_, err = TxSignBatchExec(val.ClientCtx, val.Address, malformedFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID))
@@ -639,6 +678,53 @@ func (s *IntegrationTestSuite) TestCLIQueryTxsCmdByEvents() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCLIQueryTxsCmdBySigner() {
+	val := s.network.Validators[0]
+
+	account2, err := val.ClientCtx.Keyring.Key("newAccount2")
+	s.Require().NoError(err)
+	addr2, err := account2.GetAddress()
+	s.Require().NoError(err)
+
+	out, err := s.createBankMsg(val, addr2, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)))
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// the tx is found by its signer, but not by its recipient
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.QueryTxsByEventsCmd(), []string{
+		fmt.Sprintf("--signer=%s", val.Address),
+		fmt.Sprintf("--%s=1000", flags.FlagLimit),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var result sdk.SearchTxsResult
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &result))
+	hashes := make([]string, len(result.Txs))
+	for i, tx := range result.Txs {
+		hashes[i] = tx.TxHash
+	}
+	s.Require().Contains(hashes, txRes.TxHash)
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.QueryTxsByEventsCmd(), []string{
+		fmt.Sprintf("--signer=%s", addr2),
+		fmt.Sprintf("--%s=1000", flags.FlagLimit),
+	})
+	s.Require().NoError(err)
+	s.Require().True(strings.HasPrefix(out.String(), "HEIGHT"), out.String())
+	s.Require().NotContains(out.String(), txRes.TxHash)
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.QueryTxsByEventsCmd(), []string{
+		fmt.Sprintf("--signer=%s", val.Address),
+		"--events=tx.fee=10stake",
+	})
+	s.Require().EqualError(err, "only one of --events and --signer can be given")
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.QueryTxsByEventsCmd(), []string{})
+	s.Require().EqualError(err, "either --events or --signer must be given")
+}
+
 func (s *IntegrationTestSuite) TestCLISendGenerateSignAndBroadcast() {
 	val1 := s.network.Validators[0]
 
@@ -715,7 +801,7 @@ func (s *IntegrationTestSuite) TestCLISendGenerateSignAndBroadcast() {
 
 	// Does not work in offline mode
 	_, err = TxSignExec(val1.ClientCtx, val1.Address, unsignedTxFile.Name(), "--offline")
-	s.Require().EqualError(err, "required flag(s) \"account-number\", \"sequence\" not set")
+	s.Require().EqualError(err, "offline mode requires --account-number, --sequence to be set, as they cannot be queried from the node")
 
 	// But works offline if we set account number and sequence
 	val1.ClientCtx.HomeDir = strings.Replace(val1.ClientCtx.HomeDir, "simd", "simcli", 1)
@@ -866,14 +952,115 @@ func (s *IntegrationTestSuite) TestCLIEncode() {
 	decodedTx, err := TxDecodeExec(val1.ClientCtx, trimmedBase64)
 	s.Require().NoError(err)
 
+	var decodeOut struct {
+		Encoding string          `json:"encoding"`
+		Tx       json.RawMessage `json:"tx"`
+	}
+	s.Require().NoError(json.Unmarshal(decodedTx.Bytes(), &decodeOut))
+	s.Require().Equal("protobuf", decodeOut.Encoding)
+
 	txCfg := val1.ClientCtx.TxConfig
-	theTx, err := txCfg.TxJSONDecoder()(decodedTx.Bytes())
+	theTx, err := txCfg.TxJSONDecoder()(decodeOut.Tx)
 	s.Require().NoError(err)
 	txBuilder, err := val1.ClientCtx.TxConfig.WrapTxBuilder(theTx)
 	s.Require().NoError(err)
 	s.Require().Equal("deadbeef", txBuilder.GetTx().GetMemo())
 }
 
+func (s *IntegrationTestSuite) TestCLISimulate() {
+	val1 := s.network.Validators[0]
+	txCfg := val1.ClientCtx.TxConfig
+
+	// a tx with two msgs, in the protobuf JSON and amino JSON formats
+	_, _, addr := testdata.KeyTestPubAddr()
+	txBuilder := txCfg.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(
+		banktypes.NewMsgSend(val1.Address, addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))),
+		banktypes.NewMsgSend(val1.Address, addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 20))),
+	))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)))
+	txBuilder.SetGasLimit(flags.DefaultGasLimit)
+
+	unsignedJSON, err := txCfg.TxJSONEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	unsignedFile := testutil.WriteToNewTempFile(s.T(), string(unsignedJSON))
+
+	stdTx, err := clienttx.ConvertTxToStdTx(val1.ClientCtx.LegacyAmino, txBuilder.GetTx())
+	s.Require().NoError(err)
+	aminoJSON, err := val1.ClientCtx.LegacyAmino.MarshalJSON(stdTx)
+	s.Require().NoError(err)
+	aminoFile := testutil.WriteToNewTempFile(s.T(), string(aminoJSON))
+
+	signedTx, err := TxSignExec(val1.ClientCtx, val1.Address, unsignedFile.Name())
+	s.Require().NoError(err)
+	signedFile := testutil.WriteToNewTempFile(s.T(), signedTx.String())
+
+	malformedFile := testutil.WriteToNewTempFile(s.T(), "{malformed")
+	notATxFile := testutil.WriteToNewTempFile(s.T(), `{"foo":"bar"}`)
+	unknownMsgFile := testutil.WriteToNewTempFile(s.T(),
+		strings.Replace(string(unsignedJSON), "/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgUnknown", 1))
+
+	testCases := []struct {
+		name   string
+		file   string
+		args   []string
+		expErr string
+	}{
+		{"unsigned protobuf JSON tx", unsignedFile.Name(), nil, ""},
+		{"unsigned amino JSON tx", aminoFile.Name(), nil, ""},
+		{"signed tx", signedFile.Name(), nil, ""},
+		{"gas adjustment", unsignedFile.Name(), []string{fmt.Sprintf("--%s=1.5", flags.FlagGasAdjustment)}, ""},
+		{"malformed file", malformedFile.Name(), nil, "malformed tx file, expected a JSON object"},
+		{"not a tx", notATxFile.Name(), nil, "unrecognized tx file"},
+		{"unknown msg type", unknownMsgFile.Name(), nil, "failed to decode the protobuf JSON tx"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := TxSimulateExec(val1.ClientCtx, tc.file, append(tc.args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))...)
+			if tc.expErr != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res struct {
+				GasUsed       uint64  `json:"gas_used"`
+				GasEstimate   uint64  `json:"gas_estimate"`
+				GasAdjustment float64 `json:"gas_adjustment"`
+				Events        []struct {
+					Type string `json:"type"`
+				} `json:"events"`
+				MsgResponses []struct {
+					MsgType  string          `json:"msg_type"`
+					Response json.RawMessage `json:"response"`
+				} `json:"msg_responses"`
+			}
+			s.Require().NoError(json.Unmarshal(out.Bytes(), &res), out.String())
+			s.Require().Greater(res.GasUsed, uint64(0))
+			s.Require().Equal(uint64(res.GasAdjustment*float64(res.GasUsed)), res.GasEstimate)
+			if tc.args != nil {
+				s.Require().Equal(1.5, res.GasAdjustment)
+			}
+
+			transfers := 0
+			for _, event := range res.Events {
+				if event.Type == banktypes.EventTypeTransfer {
+					transfers++
+				}
+			}
+			// the transfers of the fees and of the two msgs
+			s.Require().Equal(3, transfers)
+			s.Require().Len(res.MsgResponses, 2)
+			for _, msgRes := range res.MsgResponses {
+				s.Require().Equal("/cosmos.bank.v1beta1.MsgSend", msgRes.MsgType)
+				s.Require().JSONEq("{}", string(msgRes.Response))
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCLIMultisignSortSignatures() {
 	val1 := s.network.Validators[0]
 
@@ -1197,6 +1384,110 @@ func (s *IntegrationTestSuite) TestMultisignBatch() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestMultisignBatch2of3() {
+	val := s.network.Validators[0]
+	kb := val.ClientCtx.Keyring
+	txCfg := val.ClientCtx.TxConfig
+	const batchSize = 5
+
+	// Create a 2-of-3 multisig, whose txs are signed by its first and third keys.
+	var (
+		signers []sdk.AccAddress
+		pubKeys []cryptotypes.PubKey
+	)
+	for i := 0; i < 3; i++ {
+		k, _, err := kb.NewMnemonic(fmt.Sprintf("batchSigner%d", i), keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		s.Require().NoError(err)
+		addr, err := k.GetAddress()
+		s.Require().NoError(err)
+		pubKey, err := k.GetPubKey()
+		s.Require().NoError(err)
+		signers = append(signers, addr)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	multisigRecord, err := kb.SaveMultisig("batchMulti", kmultisig.NewLegacyAminoPubKey(2, pubKeys))
+	s.Require().NoError(err)
+	addr, err := multisigRecord.GetAddress()
+	s.Require().NoError(err)
+
+	// Send coins from validator to multisig.
+	_, err = s.createBankMsg(val, addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1000)))
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	generatedStd, err := bankcli.MsgSendExec(
+		val.ClientCtx,
+		addr,
+		val.Address,
+		sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1)),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	unsignedFile := testutil.WriteToNewTempFile(s.T(), strings.Repeat(generatedStd.String(), batchSize))
+
+	queryResJSON, err := QueryAccountExec(val.ClientCtx, addr)
+	s.Require().NoError(err)
+	var account authtypes.AccountI
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalInterfaceJSON(queryResJSON.Bytes(), &account))
+	seq := account.GetSequence()
+
+	// sign-batch queries the multisig account once and increments its sequence
+	// for each tx.
+	var sigFiles, sigLines []string
+	for _, signer := range []sdk.AccAddress{signers[0], signers[2]} {
+		res, err := TxSignBatchExec(val.ClientCtx, signer, unsignedFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID), "--multisig", addr.String())
+		s.Require().NoError(err)
+		sigLines = strings.Split(strings.Trim(res.String(), "\n"), "\n")
+		s.Require().Len(sigLines, batchSize)
+		for i, line := range sigLines {
+			sigs, err := txCfg.UnmarshalSignatureJSON([]byte(line))
+			s.Require().NoError(err)
+			s.Require().Len(sigs, 1)
+			s.Require().Equal(seq+uint64(i), sigs[0].Sequence)
+		}
+		sigFiles = append(sigFiles, testutil.WriteToNewTempFile(s.T(), res.String()).Name())
+	}
+
+	// A signature file missing the signature of the last tx is rejected.
+	truncatedFile := testutil.WriteToNewTempFile(s.T(), strings.Join(sigLines[:batchSize-1], "\n"))
+	_, err = TxMultiSignBatchExec(val.ClientCtx, unsignedFile.Name(), multisigRecord.Name, sigFiles[0], truncatedFile.Name())
+	s.Require().Error(err)
+
+	// Signatures made for other sequences are rejected.
+	_, err = TxMultiSignBatchExec(val.ClientCtx, unsignedFile.Name(), multisigRecord.Name, sigFiles[0], sigFiles[1], fmt.Sprintf("--%s=%d", flags.FlagSequence, seq+1))
+	s.Require().Error(err)
+
+	res, err := TxMultiSignBatchExec(val.ClientCtx, unsignedFile.Name(), multisigRecord.Name, sigFiles[0], sigFiles[1])
+	s.Require().NoError(err)
+	signedTxs := strings.Split(strings.Trim(res.String(), "\n"), "\n")
+	s.Require().Len(signedTxs, batchSize)
+
+	// Broadcast the signed transactions one after the other.
+	val.ClientCtx.BroadcastMode = flags.BroadcastSync
+	for i, signedTx := range signedTxs {
+		stdTx, err := txCfg.TxJSONDecoder()([]byte(signedTx))
+		s.Require().NoError(err)
+		sigs, err := stdTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+		s.Require().NoError(err)
+		s.Require().Len(sigs, 1)
+		s.Require().Equal(seq+uint64(i), sigs[0].Sequence)
+
+		signedTxFile := testutil.WriteToNewTempFile(s.T(), signedTx)
+		out, err := TxBroadcastExec(val.ClientCtx, signedTxFile.Name())
+		s.Require().NoError(err)
+		var txRes sdk.TxResponse
+		s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+		s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+	}
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	queryResJSON, err = QueryAccountExec(val.ClientCtx, addr)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalInterfaceJSON(queryResJSON.Bytes(), &account))
+	s.Require().Equal(seq+batchSize, account.GetSequence())
+}
+
 func (s *IntegrationTestSuite) TestGetAccountCmd() {
 	val := s.network.Validators[0]
 	_, _, addr1 := testdata.KeyTestPubAddr()
@@ -1236,6 +1527,39 @@ func (s *IntegrationTestSuite) TestGetAccountCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetAccountNumbersCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, val.Address)
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, authcli.GetAccountNumbersCmd(), []string{
+		val.Address.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(
+		fmt.Sprintf(`{"account_number":%d,"sequence":%d,"has_pub_key":true}`, account.GetAccountNumber(), account.GetSequence()),
+		strings.TrimSpace(out.String()),
+	)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.GetAccountNumbersCmd(), []string{
+		val.Address.String(),
+		fmt.Sprintf("--%s=text", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(
+		fmt.Sprintf("account_number: %d\nhas_pub_key: true\nsequence: %d\n", account.GetAccountNumber(), account.GetSequence()),
+		out.String(),
+	)
+
+	// an address that never received any tokens has no account
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.GetAccountNumbersCmd(), []string{addr.String()})
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestGetAccountsCmd() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -1389,6 +1713,139 @@ func (s *IntegrationTestSuite) TestTxWithoutPublicKey() {
 	s.Require().NotEqual(0, res.Code)
 }
 
+// TestCLISignFileKeyringNonInteractive signs a transaction with a key of a file
+// backend keyring, reading the keyring passphrase from a file instead of
+// prompting for it.
+func (s *IntegrationTestSuite) TestCLISignFileKeyringNonInteractive() {
+	val1 := s.network.Validators[0]
+	txCfg := val1.ClientCtx.TxConfig
+
+	keyringDir := s.T().TempDir()
+	passphraseFile := testutil.WriteToNewTempFile(s.T(), "password\n")
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, keyringDir, strings.NewReader(""), val1.ClientCtx.Codec,
+		func(options *keyring.Options) {
+			options.PassphraseFile = passphraseFile.Name()
+		})
+	s.Require().NoError(err)
+	k, _, err := kr.NewMnemonic("fileKey", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	addr, err := k.GetAddress()
+	s.Require().NoError(err)
+
+	txBuilder := txCfg.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(addr, val1.Address, sdk.NewCoins(
+		sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)),
+	))))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(150))))
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txJSON, err := txCfg.TxJSONEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	unsignedTxFile := testutil.WriteToNewTempFile(s.T(), string(txJSON))
+
+	// the input is empty, signing fails if the passphrase is prompted
+	clientCtx := val1.ClientCtx.WithInput(strings.NewReader(""))
+	signArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendFile),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, keyringDir),
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+		fmt.Sprintf("--%s=1", flags.FlagAccountNumber),
+		fmt.Sprintf("--%s=0", flags.FlagSequence),
+	}
+
+	// the passphrase file is read by the keyring for all the prompts of the command
+	out, err := TxSignExec(clientCtx, addr, unsignedTxFile.Name(),
+		append(signArgs, fmt.Sprintf("--%s=%s", flags.FlagKeyringPassFile, passphraseFile.Name()))...)
+	s.Require().NoError(err)
+	signedTx, err := txCfg.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	sigs, err := signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Len(sigs, 1)
+	pub, err := k.GetPubKey()
+	s.Require().NoError(err)
+	s.Require().True(pub.Equals(sigs[0].PubKey))
+
+	wrongPassphraseFile := testutil.WriteToNewTempFile(s.T(), "wrongpassword\n")
+	_, err = TxSignExec(clientCtx, addr, unsignedTxFile.Name(),
+		append(signArgs, fmt.Sprintf("--%s=%s", flags.FlagKeyringPassFile, wrongPassphraseFile.Name()))...)
+	s.Require().Error(err)
+}
+
+// TestCLIGenerateSignBroadcastPipeline runs the pipeline of a tx generated
+// with --generate-only, piped to tx sign - and then to tx broadcast -.
+func (s *IntegrationTestSuite) TestCLIGenerateSignBroadcastPipeline() {
+	val1 := s.network.Validators[0]
+	_, _, addr := testdata.KeyTestPubAddr()
+	sendCoins := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+
+	generated, err := s.createBankMsg(val1, addr, sendCoins, fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
+	s.Require().NoError(err)
+
+	signed, err := TxSignExec(val1.ClientCtx.WithInput(strings.NewReader(generated.String())), val1.Address, "-")
+	s.Require().NoError(err)
+	signedTx, err := val1.ClientCtx.TxConfig.TxJSONDecoder()(signed.Bytes())
+	s.Require().NoError(err, signed.String())
+	sigs, err := signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Len(sigs, 1)
+
+	out, err := TxBroadcastExec(val1.ClientCtx.WithInput(strings.NewReader(signed.String())), "-",
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock))
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes), out.String())
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	out, err = bankcli.QueryBalancesExec(val1.ClientCtx, addr)
+	s.Require().NoError(err)
+	var balances banktypes.QueryAllBalancesResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &balances))
+	s.Require().Equal(sendCoins, balances.Balances)
+
+	// an empty or malformed input fails
+	_, err = TxBroadcastExec(val1.ClientCtx.WithInput(strings.NewReader("")), "-")
+	s.Require().Error(err)
+	_, err = TxSignExec(val1.ClientCtx.WithInput(strings.NewReader("not a tx")), val1.Address, "-")
+	s.Require().Error(err)
+
+	// the passphrase of a file keyring is not read from the standard input
+	keyringDir := s.T().TempDir()
+	passphraseFile := testutil.WriteToNewTempFile(s.T(), "password\n")
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, keyringDir, strings.NewReader(""), val1.ClientCtx.Codec,
+		func(options *keyring.Options) {
+			options.PassphraseFile = passphraseFile.Name()
+		})
+	s.Require().NoError(err)
+	k, _, err := kr.NewMnemonic("pipelineKey", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	fileAddr, err := k.GetAddress()
+	s.Require().NoError(err)
+
+	txBuilder := val1.ClientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(fileAddr, val1.Address, sendCoins)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))))
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txJSON, err := val1.ClientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	signed, err = TxSignExec(val1.ClientCtx.WithInput(strings.NewReader(string(txJSON))), fileAddr, "-",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendFile),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, keyringDir),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringPassFile, passphraseFile.Name()),
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+		fmt.Sprintf("--%s=1", flags.FlagAccountNumber),
+		fmt.Sprintf("--%s=0", flags.FlagSequence),
+	)
+	s.Require().NoError(err)
+	signedTx, err = val1.ClientCtx.TxConfig.TxJSONDecoder()(signed.Bytes())
+	s.Require().NoError(err, signed.String())
+	sigs, err = signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Len(sigs, 1)
+	pub, err := k.GetPubKey()
+	s.Require().NoError(err)
+	s.Require().True(pub.Equals(sigs[0].PubKey))
+}
+
 // TestSignWithMultiSignersAminoJSON tests the case where a transaction with 2
 // messages which has to be signed with 2 different keys. Sign and append the
 // signatures using the CLI with Amino signing mode. Finally, send the
@@ -89,12 +89,17 @@ func SignTxWithSignerAddress(txFactory tx.Factory, clientCtx client.Context, add
 	return tx.Sign(txFactory, name, txBuilder, overwrite)
 }
 
-// Read and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
+// Read and decode a StdTx from the given filename.  Can pass "-" to read from stdin,
+// i.e. from the Input of the context, if set.
 func ReadTxFromFile(ctx client.Context, filename string) (tx sdk.Tx, err error) {
 	var bytes []byte
 
 	if filename == "-" {
-		bytes, err = io.ReadAll(os.Stdin)
+		var stdin io.Reader = os.Stdin
+		if ctx.Input != nil {
+			stdin = ctx.Input
+		}
+		bytes, err = io.ReadAll(stdin)
 	} else {
 		bytes, err = os.ReadFile(filename)
 	}
@@ -0,0 +1,72 @@
+package signing
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Field is a labelled value of the human-readable rendering of a Msg, such as
+// the recipient of a bank send.
+type Field struct {
+	Label string
+	Value string
+}
+
+// MsgRenderer renders the key fields of a Msg in a human-readable form. It is
+// used to summarize a tx before it is signed, and is meant to be shared with
+// SIGN_MODE_TEXTUAL.
+type MsgRenderer func(msg sdk.Msg) ([]Field, error)
+
+// MsgRendererRegistry maps the types of Msgs to their MsgRenderer. The Msgs
+// without a renderer are rendered as compact JSON.
+type MsgRendererRegistry struct {
+	mtx       sync.RWMutex
+	renderers map[reflect.Type]MsgRenderer
+}
+
+// DefaultMsgRenderers is the registry the modules register the renderers of
+// their Msgs to.
+var DefaultMsgRenderers = NewMsgRendererRegistry()
+
+// NewMsgRendererRegistry returns an empty MsgRendererRegistry.
+func NewMsgRendererRegistry() *MsgRendererRegistry {
+	return &MsgRendererRegistry{renderers: make(map[reflect.Type]MsgRenderer)}
+}
+
+// Register registers the renderer of the Msgs of the type of msg. It panics if
+// the type already has a renderer. The registry is keyed by the Go types of the
+// Msgs, rather than their type URLs, so that the renderers can be registered
+// from the init functions of the modules.
+func (r *MsgRendererRegistry) Register(msg sdk.Msg, renderer MsgRenderer) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	typ := reflect.TypeOf(msg)
+	if _, have := r.renderers[typ]; have {
+		panic(fmt.Errorf("duplicate msg renderer for %s", typ))
+	}
+	r.renderers[typ] = renderer
+}
+
+// Render renders msg with its registered renderer, or as a single unlabelled
+// field holding its compact JSON encoding by cdc if it has none.
+func (r *MsgRendererRegistry) Render(cdc codec.JSONCodec, msg sdk.Msg) ([]Field, error) {
+	r.mtx.RLock()
+	renderer, ok := r.renderers[reflect.TypeOf(msg)]
+	r.mtx.RUnlock()
+
+	if ok {
+		return renderer(msg)
+	}
+
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Field{{Value: string(bz)}}, nil
+}
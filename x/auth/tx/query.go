@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -64,6 +65,94 @@ func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int
 	return result, nil
 }
 
+// signerSearchPageSize is the number of txs fetched per TxSearch request by
+// QueryTxsBySigner.
+const signerSearchPageSize = 100
+
+// SignerEventQueries returns the Tendermint event queries matching the txs
+// signed by an address: the txs with a msg sent by the address, the txs it
+// signed as any of their signers, and the txs whose fees it paid, as a fee
+// granter.
+func SignerEventQueries(signer string) []string {
+	return []string{
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, signer),
+		fmt.Sprintf("%s.%s CONTAINS '%s/'", sdk.EventTypeTx, sdk.AttributeKeyAccountSequence, signer),
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeTx, sdk.AttributeKeyFeePayer, signer),
+	}
+}
+
+// QueryTxsBySigner performs the search queries of SignerEventQueries for the
+// signer address via the Tendermint RPC, and returns a page of the union of
+// their results, without duplicates, ordered by height and index in the
+// block. As the results of the queries are merged, all of them are fetched
+// whatever the page.
+func QueryTxsBySigner(clientCtx client.Context, signer string, page, limit int) (*sdk.SearchTxsResult, error) {
+	if page <= 0 {
+		return nil, errors.New("page must greater than 0")
+	}
+
+	if limit <= 0 {
+		return nil, errors.New("limit must greater than 0")
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var resTxs []*coretypes.ResultTx
+	for _, query := range SignerEventQueries(signer) {
+		for searchPage := 1; ; searchPage++ {
+			perPage := signerSearchPageSize
+			res, err := node.TxSearch(context.Background(), query, true, &searchPage, &perPage, "asc")
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resTx := range res.Txs {
+				if !seen[resTx.Hash.String()] {
+					seen[resTx.Hash.String()] = true
+					resTxs = append(resTxs, resTx)
+				}
+			}
+
+			if len(res.Txs) == 0 || searchPage*perPage >= res.TotalCount {
+				break
+			}
+		}
+	}
+
+	sort.Slice(resTxs, func(i, j int) bool {
+		if resTxs[i].Height != resTxs[j].Height {
+			return resTxs[i].Height < resTxs[j].Height
+		}
+		return resTxs[i].Index < resTxs[j].Index
+	})
+
+	totalCount := len(resTxs)
+	start, end := (page-1)*limit, page*limit
+	if start > totalCount {
+		start = totalCount
+	}
+	if end > totalCount {
+		end = totalCount
+	}
+	resTxs = resTxs[start:end]
+
+	resBlocks, err := getBlocksForTxResults(clientCtx, resTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := formatTxResults(clientCtx.TxConfig, resTxs, resBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewSearchTxsResult(uint64(totalCount), uint64(len(txs)), uint64(page), uint64(limit), txs), nil
+}
+
 // QueryTx queries for a single transaction by a hash string in hex format. An
 // error is returned if the transaction does not exist or cannot be queried.
 func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, error) {
@@ -0,0 +1,140 @@
+package tx_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// mockTxSearchClient is a mock Tendermint RPC client serving the results of
+// TxSearch queries, in pages, and blocks timestamped with their height.
+type mockTxSearchClient struct {
+	mock.Client
+	results map[string][]*coretypes.ResultTx
+	queries []string
+}
+
+func (c *mockTxSearchClient) TxSearch(_ context.Context, query string, _ bool, page, perPage *int, _ string) (*coretypes.ResultTxSearch, error) {
+	c.queries = append(c.queries, query)
+
+	txs := c.results[query]
+	start, end := (*page-1)**perPage, *page**perPage
+	if start > len(txs) {
+		start = len(txs)
+	}
+	if end > len(txs) {
+		end = len(txs)
+	}
+
+	return &coretypes.ResultTxSearch{Txs: txs[start:end], TotalCount: len(txs)}, nil
+}
+
+func (c *mockTxSearchClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{
+		Height: *height,
+		Time:   time.Unix(*height, 0).UTC(),
+	}}}, nil
+}
+
+func TestQueryTxsBySigner(t *testing.T) {
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), authtx.DefaultSignModes)
+	resultTx := func(height int64, index uint32) *coretypes.ResultTx {
+		txBuilder := txConfig.NewTxBuilder()
+		txBuilder.SetMemo(fmt.Sprintf("%d/%d", height, index))
+		txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+
+		return &coretypes.ResultTx{
+			Hash:   tmtypes.Tx(txBytes).Hash(),
+			Height: height,
+			Index:  index,
+			Tx:     txBytes,
+		}
+	}
+
+	const signer = "cosmos1signer"
+	queries := authtx.SignerEventQueries(signer)
+	require.Equal(t, []string{
+		"message.sender='cosmos1signer'",
+		"tx.acc_seq CONTAINS 'cosmos1signer/'",
+		"tx.fee_payer='cosmos1signer'",
+	}, queries)
+	sender, accSeq, feePayer := queries[0], queries[1], queries[2]
+
+	t.Run("merges and orders the results", func(t *testing.T) {
+		txA, txB, txC, txD := resultTx(2, 0), resultTx(1, 1), resultTx(1, 0), resultTx(2, 1)
+		node := &mockTxSearchClient{results: map[string][]*coretypes.ResultTx{
+			sender:   {txA, txD},
+			accSeq:   {txC, txA, txD},
+			feePayer: {txB, txD},
+		}}
+		clientCtx := client.Context{}.WithTxConfig(txConfig).WithClient(node)
+
+		testCases := []struct {
+			page, limit int
+			expTxs      []*coretypes.ResultTx
+		}{
+			{1, 10, []*coretypes.ResultTx{txC, txB, txA, txD}},
+			{1, 3, []*coretypes.ResultTx{txC, txB, txA}},
+			{2, 3, []*coretypes.ResultTx{txD}},
+			{3, 3, []*coretypes.ResultTx{}},
+		}
+		for _, tc := range testCases {
+			res, err := authtx.QueryTxsBySigner(clientCtx, signer, tc.page, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, uint64(4), res.TotalCount)
+			require.Equal(t, uint64(tc.page), res.PageNumber)
+			require.Equal(t, uint64((4+tc.limit-1)/tc.limit), res.PageTotal)
+
+			hashes := make([]string, len(res.Txs))
+			for i, tx := range res.Txs {
+				hashes[i] = tx.TxHash
+				require.Equal(t, time.Unix(tx.Height, 0).UTC().Format(time.RFC3339), tx.Timestamp)
+			}
+			expHashes := make([]string, len(tc.expTxs))
+			for i, tx := range tc.expTxs {
+				expHashes[i] = tx.Hash.String()
+			}
+			require.Equal(t, expHashes, hashes)
+		}
+	})
+
+	t.Run("fetches all the pages of the results", func(t *testing.T) {
+		var signed []*coretypes.ResultTx
+		for i := 0; i < 150; i++ {
+			signed = append(signed, resultTx(int64(10+i), 0))
+		}
+		node := &mockTxSearchClient{results: map[string][]*coretypes.ResultTx{
+			accSeq:   signed,
+			feePayer: signed[140:],
+		}}
+		clientCtx := client.Context{}.WithTxConfig(txConfig).WithClient(node)
+
+		res, err := authtx.QueryTxsBySigner(clientCtx, signer, 3, 60)
+		require.NoError(t, err)
+		require.Equal(t, uint64(150), res.TotalCount)
+		require.Len(t, res.Txs, 30)
+		require.Equal(t, signed[120].Hash.String(), res.Txs[0].TxHash)
+		require.Equal(t, signed[149].Hash.String(), res.Txs[29].TxHash)
+		require.Equal(t, []string{sender, accSeq, accSeq, feePayer}, node.queries)
+	})
+
+	t.Run("invalid pagination", func(t *testing.T) {
+		clientCtx := client.Context{}.WithTxConfig(txConfig).WithClient(&mockTxSearchClient{})
+		_, err := authtx.QueryTxsBySigner(clientCtx, signer, 0, 10)
+		require.EqualError(t, err, "page must greater than 0")
+		_, err = authtx.QueryTxsBySigner(clientCtx, signer, 1, 0)
+		require.EqualError(t, err, "limit must greater than 0")
+	})
+}
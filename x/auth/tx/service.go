@@ -8,10 +8,14 @@ import (
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/golang/protobuf/proto" // nolint: staticcheck
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/subscription"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	pagination "github.com/cosmos/cosmos-sdk/types/query"
@@ -153,6 +157,54 @@ func (s txServer) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxReque
 	return client.TxServiceBroadcast(ctx, s.clientCtx, req)
 }
 
+// SubscribeTxEvents implements the ServiceServer.SubscribeTxEvents RPC method,
+// streaming the results of the txs matching the query of the request, along
+// with the time of their block, as GetTx.
+func (s txServer) SubscribeTxEvents(req *txtypes.SubscribeTxEventsRequest, stream txtypes.Service_SubscribeTxEventsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	query := tmtypes.EventQueryTx.String()
+	if strings.TrimSpace(req.Query) != "" {
+		query = fmt.Sprintf("%s AND %s", query, req.Query)
+	}
+	if _, err := tmquery.New(query); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid query %q: %v", req.Query, err)
+	}
+
+	ctx := stream.Context()
+	// the txs of a block are published one after the other
+	var resBlock *coretypes.ResultBlock
+	return subscription.Stream(ctx, s.clientCtx.Client, query, subscription.ConfigFromContext(ctx), func(event coretypes.ResultEvent) error {
+		data, ok := event.Data.(tmtypes.EventDataTx)
+		if !ok {
+			return status.Errorf(codes.Internal, "unexpected tx event data %T", event.Data)
+		}
+
+		if resBlock == nil || resBlock.Block.Height != data.Height {
+			var err error
+			if resBlock, err = s.clientCtx.Client.Block(ctx, &data.Height); err != nil {
+				return err
+			}
+		}
+
+		resTx := &coretypes.ResultTx{
+			Hash:     tmtypes.Tx(data.Tx).Hash(),
+			Height:   data.Height,
+			Index:    data.Index,
+			TxResult: data.Result,
+			Tx:       data.Tx,
+		}
+		txResponse, err := mkTxResult(s.clientCtx.TxConfig, resTx, resBlock)
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(&txtypes.SubscribeTxEventsResponse{TxResponse: txResponse})
+	})
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
@@ -9,6 +9,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -88,7 +91,7 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 	s.network.Cleanup()
 }
 
-func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
+func (s *IntegrationTestSuite) TestSimulateTx_GRPC() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
 	// Convert the txBuilder to a tx.Tx.
@@ -136,7 +139,7 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
-func (s IntegrationTestSuite) TestSimulateTx_GRPCGateway() {
+func (s *IntegrationTestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
 	// Convert the txBuilder to a tx.Tx.
@@ -177,7 +180,7 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPCGateway() {
 	}
 }
 
-func (s IntegrationTestSuite) TestGetTxEvents_GRPC() {
+func (s *IntegrationTestSuite) TestGetTxEvents_GRPC() {
 	testCases := []struct {
 		name      string
 		req       *tx.GetTxsEventRequest
@@ -256,7 +259,7 @@ func (s IntegrationTestSuite) TestGetTxEvents_GRPC() {
 	}
 }
 
-func (s IntegrationTestSuite) TestGetTxEvents_GRPCGateway() {
+func (s *IntegrationTestSuite) TestGetTxEvents_GRPCGateway() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -331,7 +334,7 @@ func (s IntegrationTestSuite) TestGetTxEvents_GRPCGateway() {
 	}
 }
 
-func (s IntegrationTestSuite) TestGetTx_GRPC() {
+func (s *IntegrationTestSuite) TestGetTx_GRPC() {
 	testCases := []struct {
 		name      string
 		req       *tx.GetTxRequest
@@ -358,7 +361,7 @@ func (s IntegrationTestSuite) TestGetTx_GRPC() {
 	}
 }
 
-func (s IntegrationTestSuite) TestGetTx_GRPCGateway() {
+func (s *IntegrationTestSuite) TestGetTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	testCases := []struct {
 		name      string
@@ -405,7 +408,7 @@ func (s IntegrationTestSuite) TestGetTx_GRPCGateway() {
 	}
 }
 
-func (s IntegrationTestSuite) TestBroadcastTx_GRPC() {
+func (s *IntegrationTestSuite) TestBroadcastTx_GRPC() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
 	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
@@ -445,7 +448,62 @@ func (s IntegrationTestSuite) TestBroadcastTx_GRPC() {
 	}
 }
 
-func (s IntegrationTestSuite) TestBroadcastTx_GRPCGateway() {
+func (s *IntegrationTestSuite) TestSubscribeTxEvents() {
+	val := s.network.Validators[0]
+	conn, err := grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	s.Require().NoError(err)
+	defer conn.Close()
+	client := tx.NewServiceClient(conn)
+
+	// a recipient receiving only the txs of this test
+	_, _, recipient := testdata.KeyTestPubAddr()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.SubscribeTxEvents(ctx, &tx.SubscribeTxEventsRequest{
+		Query: fmt.Sprintf("transfer.recipient='%s'", recipient),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	var hashes []string
+	for _, memo := range []string{"first", "second"} {
+		out, err := bankcli.MsgSendExec(
+			val.ClientCtx,
+			val.Address,
+			recipient,
+			sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			fmt.Sprintf("--%s=%s", flags.FlagNote, memo),
+		)
+		s.Require().NoError(err)
+		var txRes sdk.TxResponse
+		s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+		s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+		hashes = append(hashes, txRes.TxHash)
+	}
+
+	// the txs arrive in order, as GetTx returns them
+	for i, hash := range hashes {
+		res, err := stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(hash, res.TxResponse.TxHash)
+		s.Require().NotEmpty(res.TxResponse.Timestamp)
+
+		var resTx tx.Tx
+		s.Require().NoError(val.ClientCtx.Codec.Unmarshal(res.TxResponse.Tx.Value, &resTx))
+		s.Require().Equal([]string{"first", "second"}[i], resTx.Body.Memo)
+	}
+
+	// an invalid query ends the stream
+	stream, err = client.SubscribeTxEvents(ctx, &tx.SubscribeTxEventsRequest{Query: "transfer.recipient="})
+	s.Require().NoError(err)
+	_, err = stream.Recv()
+	s.Require().Equal(codes.InvalidArgument, status.Code(err), err)
+}
+
+func (s *IntegrationTestSuite) TestBroadcastTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
 	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
@@ -590,7 +648,7 @@ func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
 
-func (s IntegrationTestSuite) mkTxBuilder() client.TxBuilder {
+func (s *IntegrationTestSuite) mkTxBuilder() client.TxBuilder {
 	val := s.network.Validators[0]
 	s.Require().NoError(s.network.WaitForNextBlock())
 
@@ -9,5 +9,5 @@ import (
 type BankKeeper interface {
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
-	BlockedAddr(addr sdk.AccAddress) bool
+	BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool
 }
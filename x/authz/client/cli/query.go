@@ -28,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 	authorizationQueryCmd.AddCommand(
 		GetCmdQueryGrants(),
 		GetQueryGranterGrants(),
+		GetQueryGranteeGrants(),
+		GetQueryMsgTypeGrants(),
 	)
 
 	return authorizationQueryCmd
@@ -99,11 +101,15 @@ func GetQueryGranterGrants() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "query authorization grants granted by granter",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query authorization grants granted by granter.
+			fmt.Sprintf(`Query authorization grants granted by granter. If --%s is set,
+it will select grants only for that msg type.
 Examples:
 $ %s q %s granter-grants cosmos1skj..
+$ %s q %s granter-grants cosmos1skj.. --%s=%s
 `,
-				version.AppName, authz.ModuleName),
+				FlagMsgType,
+				version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, FlagMsgType, bank.SendAuthorization{}.MsgTypeURL()),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -116,6 +122,11 @@ $ %s q %s granter-grants cosmos1skj..
 				return err
 			}
 
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -126,6 +137,7 @@ $ %s q %s granter-grants cosmos1skj..
 				cmd.Context(),
 				&authz.QueryGranterGrantsRequest{
 					Granter:    granter.String(),
+					MsgTypeUrl: msgType,
 					Pagination: pageReq,
 				},
 			)
@@ -138,5 +150,110 @@ $ %s q %s granter-grants cosmos1skj..
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "granter-grants")
+	cmd.Flags().String(FlagMsgType, "", "The msg type url to select grants for")
+	return cmd
+}
+
+// GetQueryGranteeGrants implements the query grants-by-grantee command.
+func GetQueryGranteeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants-by-grantee [grantee-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query authorization grants granted to grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants granted to grantee. If --%s is set,
+it will select grants only for that msg type.
+Examples:
+$ %s q %s grants-by-grantee cosmos1skj..
+$ %s q %s grants-by-grantee cosmos1skj.. --%s=%s
+`,
+				FlagMsgType,
+				version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, FlagMsgType, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.GranteeGrants(
+				cmd.Context(),
+				&authz.QueryGranteeGrantsRequest{
+					Grantee:    grantee.String(),
+					MsgTypeUrl: msgType,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
+	cmd.Flags().String(FlagMsgType, "", "The msg type url to select grants for")
+	return cmd
+}
+
+// GetQueryMsgTypeGrants implements the query grants-by-msg-type command.
+func GetQueryMsgTypeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants-by-msg-type [msg-type-url]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query authorization grants for a msg-type-url",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants of all granters and grantees for a msg-type-url.
+Examples:
+$ %s q %s grants-by-msg-type %s
+`,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.MsgTypeGrants(
+				cmd.Context(),
+				&authz.QueryMsgTypeGrantsRequest{
+					MsgTypeUrl: args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "msg-type-grants")
 	return cmd
 }
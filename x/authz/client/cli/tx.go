@@ -3,10 +3,13 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +29,7 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagPeriod            = "period"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -46,6 +50,7 @@ func GetTxCmd() *cobra.Command {
 		NewCmdGrantAuthorization(),
 		NewCmdRevokeAuthorization(),
 		NewCmdExecAuthorization(),
+		NewCmdRenewAuthorization(),
 	)
 
 	return AuthorizationTxCmd
@@ -60,8 +65,9 @@ func NewCmdGrantAuthorization() *cobra.Command {
 
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=100stake --period=24h --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -97,7 +103,19 @@ Examples:
 					return fmt.Errorf("spend-limit should be greater than zero")
 				}
 
-				authorization = bank.NewSendAuthorization(spendLimit)
+				period, err := cmd.Flags().GetDuration(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				switch {
+				case period < 0:
+					return fmt.Errorf("period should be greater than zero")
+				case period > 0:
+					authorization = bank.NewPeriodicSendAuthorization(period, spendLimit)
+				default:
+					authorization = bank.NewSendAuthorization(spendLimit)
+				}
 			case "generic":
 				msgType, err := cmd.Flags().GetString(FlagMsgType)
 				if err != nil {
@@ -173,6 +191,7 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	cmd.Flags().Duration(FlagPeriod, 0, "Period of a Send Authorization, the spend limit is then allowed per period (e.g. 24h)")
 	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
 	return cmd
 }
@@ -210,6 +229,46 @@ Example:
 	return cmd
 }
 
+func NewCmdRenewAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew [grantee] [msg-type-url] --expiration=[unix-timestamp] --from=[granter]",
+		Short: "renew authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`extend the expiration of an authorization from a granter to a grantee,
+keeping the remaining limits of the authorization:
+Example:
+ $ %s tx %s renew cosmos1skj.. %s --expiration=1735689600 --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			granter := clientCtx.GetFromAddress()
+			msg := authz.NewMsgRenewGrant(granter, grantee, args[1], time.Unix(exp, 0))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagExpiration, 0, "The new expiration Unix timestamp, after the current one")
+	cmd.MarkFlagRequired(FlagExpiration)
+	return cmd
+}
+
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "exec [tx-json-file] --from [grantee]",
@@ -219,6 +278,8 @@ func NewCmdExecAuthorization() *cobra.Command {
 Example:
  $ %s tx %s exec tx.json --from grantee
  $ %s tx bank send <granter> <recipient> --from <granter> --chain-id <chain-id> --generate-only > tx.json && %s tx %s exec tx.json --from grantee
+
+With --dry-run, the results of the messages and the gas consumed by each of them are printed.
 			`, version.AppName, authz.ModuleName, version.AppName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
@@ -240,6 +301,10 @@ Example:
 			}
 			msg := authz.NewMsgExec(grantee, theTx.GetMsgs())
 
+			if clientCtx.Simulate {
+				return simulateExec(clientCtx, cmd.Flags(), &msg)
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
@@ -249,6 +314,40 @@ Example:
 	return cmd
 }
 
+// simulateExec simulates the MsgExec and prints the results of the executed
+// messages, including the gas consumed by each of them.
+func simulateExec(clientCtx client.Context, flagSet *pflag.FlagSet, msg *authz.MsgExec) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	txf, err := tx.NewFactoryCLI(clientCtx, flagSet).Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	simRes, adjusted, err := tx.CalculateGas(clientCtx, txf, msg)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", tx.GasEstimateResponse{GasEstimate: adjusted})
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(simRes.Result.Data, &txMsgData); err != nil {
+		return err
+	}
+	if len(txMsgData.Data) != 1 {
+		return fmt.Errorf("expected 1 message result, got %d", len(txMsgData.Data))
+	}
+
+	var res authz.MsgExecResponse
+	if err := proto.Unmarshal(txMsgData.Data[0].Data, &res); err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(&res)
+}
+
 func bech32toValidatorAddresses(validators []string) ([]sdk.ValAddress, error) {
 	vals := make([]sdk.ValAddress, len(validators))
 	for i, validator := range validators {
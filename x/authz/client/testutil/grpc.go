@@ -209,12 +209,118 @@ func (s *IntegrationTestSuite) TestQueryGranterGrantsGRPC() {
 			if tc.expectErr {
 				require.Contains(string(resp), tc.errMsg)
 			} else {
-				var authorizations authz.QueryGrantsResponse
+				var authorizations authz.QueryGranterGrantsResponse
+				err := val.ClientCtx.Codec.UnmarshalJSON(resp, &authorizations)
+				require.NoError(err)
+				require.Len(authorizations.Grants, tc.numItems)
+			}
+
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryGranteeGrantsGRPC() {
+	val := s.network.Validators[0]
+	grantee := s.grantee[1]
+	require := s.Require()
+
+	testCases := []struct {
+		name      string
+		url       string
+		expectErr bool
+		errMsg    string
+		numItems  int
+	}{
+		{
+			"invalid account address",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/grants/grantee/%s", val.APIAddress, "invalid address"),
+			true,
+			"decoding bech32 failed",
+			0,
+		},
+		{
+			"no authorizations found",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/grants/grantee/%s", val.APIAddress, val.Address.String()),
+			false,
+			"",
+			0,
+		},
+		{
+			"valid query",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/grants/grantee/%s", val.APIAddress, grantee.String()),
+			false,
+			"",
+			1,
+		},
+		{
+			"valid query with msg type filter",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/grants/grantee/%s?msg_type_url=%s", val.APIAddress, grantee.String(), typeMsgVote),
+			false,
+			"",
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, _ := rest.GetRequest(tc.url)
+			if tc.expectErr {
+				require.Contains(string(resp), tc.errMsg)
+			} else {
+				var authorizations authz.QueryGranteeGrantsResponse
 				err := val.ClientCtx.Codec.UnmarshalJSON(resp, &authorizations)
 				require.NoError(err)
 				require.Len(authorizations.Grants, tc.numItems)
+				for _, grant := range authorizations.Grants {
+					require.Equal(val.Address.String(), grant.Granter)
+					require.Equal(grantee.String(), grant.Grantee)
+				}
 			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryMsgTypeGrantsGRPC() {
+	val := s.network.Validators[0]
+	grantee := s.grantee[1]
+	require := s.Require()
 
+	testCases := []struct {
+		name      string
+		url       string
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"empty msg type url",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/msg_type_grants", val.APIAddress),
+			true,
+			"empty msg type url",
+		},
+		{
+			"valid query",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/msg_type_grants?msg_type_url=%s", val.APIAddress, typeMsgSend),
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, _ := rest.GetRequest(tc.url)
+			if tc.expectErr {
+				require.Contains(string(resp), tc.errMsg)
+			} else {
+				var authorizations authz.QueryMsgTypeGrantsResponse
+				err := val.ClientCtx.Codec.UnmarshalJSON(resp, &authorizations)
+				require.NoError(err)
+
+				found := false
+				for _, grant := range authorizations.Grants {
+					if grant.Granter == val.Address.String() && grant.Grantee == grantee.String() {
+						found = true
+					}
+				}
+				require.True(found)
+			}
 		})
 	}
 }
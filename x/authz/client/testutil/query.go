@@ -252,3 +252,113 @@ func (s *IntegrationTestSuite) TestQueryGranterGrants() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryGranteeGrants() {
+	val := s.network.Validators[0]
+	grantee := s.grantee[1]
+	require := s.Require()
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expectedErr string
+		expItems    int
+	}{
+		{
+			"invalid address",
+			[]string{
+				"invalid-address",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"decoding bech32 failed",
+			0,
+		},
+		{
+			"no authorization found",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			0,
+		},
+		{
+			"valid case",
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			1,
+		},
+		{
+			"valid case with msg type filter",
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			1,
+		},
+		{
+			"valid case with other msg type filter",
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgVote),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetQueryGranteeGrants()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				require.Error(err)
+				require.Contains(out.String(), tc.expectedErr)
+			} else {
+				require.NoError(err)
+				var grants authz.QueryGranteeGrantsResponse
+				require.NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &grants))
+				require.Len(grants.Grants, tc.expItems)
+				for _, grant := range grants.Grants {
+					require.Equal(val.Address.String(), grant.Granter)
+					require.Equal(grantee.String(), grant.Grantee)
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryMsgTypeGrants() {
+	val := s.network.Validators[0]
+	grantee := s.grantee[1]
+	require := s.Require()
+
+	cmd := cli.GetQueryMsgTypeGrants()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{
+		typeMsgSend,
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	require.NoError(err)
+
+	var grants authz.QueryMsgTypeGrantsResponse
+	require.NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &grants))
+	found := false
+	for _, grant := range grants.Grants {
+		if grant.Granter == val.Address.String() && grant.Grantee == grantee.String() {
+			found = true
+		}
+	}
+	require.True(found)
+}
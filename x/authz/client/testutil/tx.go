@@ -6,6 +6,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -14,6 +15,8 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/authz/client/cli"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -265,6 +268,36 @@ func (s *IntegrationTestSuite) TestCLITxGrantAuthorization() {
 			0,
 			false,
 		},
+		{
+			"Invalid period",
+			[]string{
+				grantee.String(),
+				"send",
+				fmt.Sprintf("--%s=100steak", cli.FlagSpendLimit),
+				fmt.Sprintf("--%s=-24h", cli.FlagPeriod),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			},
+			0,
+			true,
+		},
+		{
+			"Valid tx periodic send authorization",
+			[]string{
+				grantee.String(),
+				"send",
+				fmt.Sprintf("--%s=100steak", cli.FlagSpendLimit),
+				fmt.Sprintf("--%s=24h", cli.FlagPeriod),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			0,
+			false,
+		},
 		{
 			"Valid tx send authorization",
 			[]string{
@@ -486,6 +519,162 @@ func (s *IntegrationTestSuite) TestCmdRevokeAuthorizations() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdRenewAuthorization() {
+	val := s.network.Validators[0]
+	grantee := s.createAccount("grantee4")
+	oneHour := time.Now().Add(time.Hour).Unix()
+	twoHours := time.Now().Add(2 * time.Hour).Unix()
+
+	_, err := ExecGrant(
+		val,
+		[]string{
+			grantee.String(),
+			"send",
+			fmt.Sprintf("--%s=100steak", cli.FlagSpendLimit),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%d", cli.FlagExpiration, oneHour),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		},
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		respType     proto.Message
+		expectedCode uint32
+		expectErr    bool
+	}{
+		{
+			"invalid grantee address",
+			[]string{
+				"invalid grantee",
+				typeMsgSend,
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			},
+			nil,
+			0,
+			true,
+		},
+		{
+			"missing expiration",
+			[]string{
+				grantee.String(),
+				typeMsgSend,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			},
+			nil,
+			0,
+			true,
+		},
+		{
+			"expiration in the past",
+			[]string{
+				grantee.String(),
+				typeMsgSend,
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, time.Now().Add(-time.Hour).Unix()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			},
+			nil,
+			0,
+			true,
+		},
+		{
+			"grant not found",
+			[]string{
+				grantee.String(),
+				typeMsgVote,
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			&sdk.TxResponse{}, sdkerrors.ErrNotFound.ABCICode(),
+			false,
+		},
+		{
+			"valid tx",
+			[]string{
+				grantee.String(),
+				typeMsgSend,
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			&sdk.TxResponse{}, 0,
+			false,
+		},
+		{
+			"expiration before the current one",
+			[]string{
+				grantee.String(),
+				typeMsgSend,
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, oneHour),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			&sdk.TxResponse{}, authz.ErrInvalidExpirationTime.ABCICode(),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdRenewAuthorization()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	s.T().Log("verify that the grant was renewed with its spend limit")
+	clientCtx := val.ClientCtx
+	resp, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryGrants(), []string{
+		val.Address.String(),
+		grantee.String(),
+		typeMsgSend,
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var grants authz.QueryGrantsResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(resp.Bytes(), &grants), resp.String())
+	s.Require().Len(grants.Grants, 1)
+	s.Require().Equal(twoHours, grants.Grants[0].Expiration.Unix())
+	s.Require().Contains(resp.String(), `"amount":"100"`)
+
+	// revoke the grant, so it is not counted by the granter grants queries
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdRevokeAuthorization(), []string{
+		grantee.String(),
+		typeMsgSend,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), `"code":0`)
+}
+
 func (s *IntegrationTestSuite) TestExecAuthorizationWithExpiration() {
 	val := s.network.Validators[0]
 	grantee := s.grantee[0]
@@ -713,6 +902,162 @@ func (s *IntegrationTestSuite) TestNewExecGrantAuthorized() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestExecPeriodicSendAuthorization() {
+	val := s.network.Validators[0]
+	grantee := s.createAccount("grantee5")
+	s.msgSendExec(grantee)
+	denom := fmt.Sprintf("%stoken", val.Moniker)
+
+	out, err := ExecGrant(val, []string{
+		grantee.String(),
+		"send",
+		fmt.Sprintf("--%s=12%s", cli.FlagSpendLimit, denom),
+		fmt.Sprintf("--%s=24h", cli.FlagPeriod),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=%d", cli.FlagExpiration, time.Now().Add(time.Hour).Unix()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), `"code":0`)
+
+	sendTx, err := banktestutil.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		grantee,
+		sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(8))),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	execMsg := testutil.WriteToNewTempFile(s.T(), sendTx.String())
+
+	testCases := []struct {
+		name         string
+		expectedCode uint32
+	}{
+		{"send within the period spend limit", 0},
+		{"send over the period spend limit", sdkerrors.ErrInsufficientFunds.ABCICode()},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdExecAuthorization()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+				execMsg.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, grantee.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			})
+			s.Require().NoError(err)
+			var response sdk.TxResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response), out.String())
+			s.Require().Equal(tc.expectedCode, response.Code, out.String())
+		})
+	}
+
+	s.T().Log("verify that the grant tracks the coins spent in the period")
+	clientCtx := val.ClientCtx
+	resp, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryGrants(), []string{
+		val.Address.String(),
+		grantee.String(),
+		typeMsgSend,
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var grants authz.QueryGrantsResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(resp.Bytes(), &grants), resp.String())
+	s.Require().Len(grants.Grants, 1)
+	var authorization authz.Authorization
+	s.Require().NoError(clientCtx.InterfaceRegistry.UnpackAny(grants.Grants[0].Authorization, &authorization))
+	s.Require().IsType(&bank.PeriodicSendAuthorization{}, authorization)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(8))), authorization.(*bank.PeriodicSendAuthorization).PeriodSpent)
+
+	// revoke the grant, so it is not counted by the granter grants queries
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdRevokeAuthorization(), []string{
+		grantee.String(),
+		typeMsgSend,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), `"code":0`)
+}
+
+func (s *IntegrationTestSuite) TestExecAuthorizationDryRun() {
+	val := s.network.Validators[0]
+	grantee := s.createAccount("grantee3")
+	s.msgSendExec(grantee)
+	denom := fmt.Sprintf("%stoken", val.Moniker)
+
+	out, err := ExecGrant(val, []string{
+		grantee.String(),
+		"send",
+		fmt.Sprintf("--%s=20%s", cli.FlagSpendLimit, denom),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=%d", cli.FlagExpiration, time.Now().Add(time.Hour).Unix()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), `"code":0`)
+
+	sendMsg := fmt.Sprintf(`{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[{"denom":"%s","amount":"10"}]}`, val.Address.String(), grantee.String(), denom)
+	sendTx := fmt.Sprintf(`{"body":{"messages":[%s,%s],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[],"fee":{"amount":[],"gas_limit":"200000","payer":"","granter":""}},"signatures":[]}`, sendMsg, sendMsg)
+	execMsg := testutil.WriteToNewTempFile(s.T(), sendTx)
+
+	cmd := cli.NewCmdExecAuthorization()
+	clientCtx := val.ClientCtx
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		execMsg.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, grantee.String()),
+		fmt.Sprintf("--%s=true", flags.FlagDryRun),
+	})
+	s.Require().NoError(err)
+
+	var res authz.MsgExecResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Len(res.MsgResults, 2)
+	for i, msgResult := range res.MsgResults {
+		s.Require().Equal("/cosmos.bank.v1beta1.MsgSendResponse", msgResult.MsgResponse.TypeUrl)
+		s.Require().NotZero(msgResult.GasUsed)
+		s.Require().NotEmpty(msgResult.Events)
+		for _, event := range msgResult.Events {
+			attribute := event.Attributes[len(event.Attributes)-1]
+			s.Require().Equal(authz.AttributeKeyMsgIndex, attribute.Key)
+			s.Require().Equal(fmt.Sprint(i), attribute.Value)
+		}
+	}
+
+	s.T().Log("verify that the dry run did not use the grant")
+	resp, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryGrants(), []string{
+		val.Address.String(),
+		grantee.String(),
+		typeMsgSend,
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(resp.String(), `"amount":"20"`)
+
+	// revoke the grant, so it is not counted by the granter grants queries
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdRevokeAuthorization(), []string{
+		grantee.String(),
+		typeMsgSend,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), `"code":0`)
+}
+
 func (s *IntegrationTestSuite) TestExecDelegateAuthorization() {
 	val := s.network.Validators[0]
 	grantee := s.grantee[0]
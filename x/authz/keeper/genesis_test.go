@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -53,6 +54,24 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	suite.Require().Equal(genesis, newGenesis)
 }
 
+func (suite *GenesisTestSuite) TestImportExportGenesisExpirationQueue() {
+	now := suite.ctx.BlockHeader().Time
+	grant := &bank.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))}
+	suite.Require().NoError(suite.keeper.SaveGrant(suite.ctx, granteeAddr, granterAddr, grant, now.Add(time.Hour)))
+	genesis := suite.keeper.ExportGenesis(suite.ctx)
+
+	// Clear keeper
+	suite.Require().NoError(suite.keeper.DeleteGrant(suite.ctx, granteeAddr, granterAddr, grant.MsgTypeURL()))
+
+	// the expiration queue is rebuilt from the imported grants
+	suite.keeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().NoError(suite.keeper.DequeueAndDeleteExpiredGrants(suite.ctx.WithBlockTime(now.Add(time.Minute)), authz.MaxPrunedPerBlock))
+	suite.Require().Equal(genesis, suite.keeper.ExportGenesis(suite.ctx))
+
+	suite.Require().NoError(suite.keeper.DequeueAndDeleteExpiredGrants(suite.ctx.WithBlockTime(now.Add(2*time.Hour)), authz.MaxPrunedPerBlock))
+	suite.Require().Empty(suite.keeper.ExportGenesis(suite.ctx).Authorization)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
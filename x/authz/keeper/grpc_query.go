@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -53,10 +54,12 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 				Authorization: authorizationAny,
 				Expiration:    expiration,
 			}},
+			SecondsUntilExpiration: []int64{secondsUntilExpiration(ctx, expiration)},
 		}, nil
 	}
 
 	var authorizations []*authz.Grant
+	var secondsUntilExpirations []int64
 	pageRes, err := query.FilteredPaginate(authStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		auth, err := unmarshalAuthorization(k.cdc, value)
 		if err != nil {
@@ -77,6 +80,7 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 				Authorization: authorizationAny,
 				Expiration:    auth.Expiration,
 			})
+			secondsUntilExpirations = append(secondsUntilExpirations, secondsUntilExpiration(ctx, auth.Expiration))
 		}
 		return true, nil
 	})
@@ -85,11 +89,22 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 	}
 
 	return &authz.QueryGrantsResponse{
-		Grants:     authorizations,
-		Pagination: pageRes,
+		Grants:                 authorizations,
+		Pagination:             pageRes,
+		SecondsUntilExpiration: secondsUntilExpirations,
 	}, nil
 }
 
+// secondsUntilExpiration returns the whole seconds left before the expiration
+// at the block time, or zero if the expiration is passed.
+func secondsUntilExpiration(ctx sdk.Context, expiration time.Time) int64 {
+	left := expiration.Sub(ctx.BlockTime())
+	if left <= 0 {
+		return 0
+	}
+	return int64(left / time.Second)
+}
+
 // GranterGrants implements the Query/GranterGrants gRPC method.
 func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error) {
 	if req == nil {
@@ -105,25 +120,25 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 	store := ctx.KVStore(k.storeKey)
 	authzStore := prefix.NewStore(store, grantStoreKey(nil, granter, ""))
 
-	var authorizations []*authz.Grant
+	var grants []*authz.GrantAuthorization
 	pageRes, err := query.FilteredPaginate(authzStore, req.Pagination, func(key []byte, value []byte,
 		accumulate bool) (bool, error) {
-		auth, err := unmarshalAuthorization(k.cdc, value)
-		if err != nil {
-			return false, err
+		grantee, msgType := splitLengthPrefixed(key)
+		if req.MsgTypeUrl != "" && string(msgType) != req.MsgTypeUrl {
+			return false, nil
 		}
 
-		auth1 := auth.GetAuthorization()
 		if accumulate {
-			any, err := codectypes.NewAnyWithValue(auth1)
+			auth, err := unmarshalAuthorization(k.cdc, value)
 			if err != nil {
-				return false, status.Errorf(codes.Internal, err.Error())
+				return false, err
 			}
 
-			authorizations = append(authorizations, &authz.Grant{
-				Authorization: any,
-				Expiration:    auth.Expiration,
-			})
+			grant, err := newGrantAuthorization(granter, grantee, auth)
+			if err != nil {
+				return false, err
+			}
+			grants = append(grants, grant)
 		}
 		return true, nil
 	})
@@ -132,11 +147,114 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 	}
 
 	return &authz.QueryGranterGrantsResponse{
-		Grants:     authorizations,
+		Grants:     grants,
 		Pagination: pageRes,
 	}, nil
 }
 
+// GranteeGrants implements the Query/GranteeGrants gRPC method.
+func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRequest) (*authz.QueryGranteeGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, grantByGranteeStoreKey(grantee, nil, ""))
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte,
+		accumulate bool) (bool, error) {
+		granter, msgType := splitLengthPrefixed(key)
+		if req.MsgTypeUrl != "" && string(msgType) != req.MsgTypeUrl {
+			return false, nil
+		}
+
+		if accumulate {
+			grant, err := k.getGrantAuthorization(ctx, granter, grantee, string(msgType))
+			if err != nil {
+				return false, err
+			}
+			grants = append(grants, grant)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranteeGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MsgTypeGrants implements the Query/MsgTypeGrants gRPC method.
+func (k Keeper) MsgTypeGrants(c context.Context, req *authz.QueryMsgTypeGrantsRequest) (*authz.QueryMsgTypeGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty msg type url")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, grantByMsgTypeStoreKey(req.MsgTypeUrl, nil, nil))
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		granter, rest := splitLengthPrefixed(key)
+		grantee, _ := splitLengthPrefixed(rest)
+
+		grant, err := k.getGrantAuthorization(ctx, granter, grantee, req.MsgTypeUrl)
+		if err != nil {
+			return err
+		}
+		grants = append(grants, grant)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryMsgTypeGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// getGrantAuthorization returns the grant referenced by an index entry.
+func (k Keeper) getGrantAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) (*authz.GrantAuthorization, error) {
+	grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+	if !found {
+		return nil, status.Errorf(codes.Internal, "indexed grant not found for %s type", msgType)
+	}
+
+	return newGrantAuthorization(granter, grantee, grant)
+}
+
+// newGrantAuthorization extends the grant with the granter and grantee addresses.
+func newGrantAuthorization(granter, grantee sdk.AccAddress, grant authz.Grant) (*authz.GrantAuthorization, error) {
+	any, err := codectypes.NewAnyWithValue(grant.GetAuthorization())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &authz.GrantAuthorization{
+		Granter:       granter.String(),
+		Grantee:       grantee.String(),
+		Authorization: any,
+		Expiration:    grant.Expiration,
+	}, nil
+}
+
 // unmarshal an authorization from a store value
 func unmarshalAuthorization(cdc codec.BinaryCodec, value []byte) (v authz.Grant, err error) {
 	err = cdc.Unmarshal(value, &v)
@@ -77,6 +77,7 @@ func (suite *TestSuite) TestGRPCQueryAuthorization() {
 				require.NoError(err)
 				require.NotNil(auth)
 				require.Equal(auth.String(), expAuthorization.String())
+				require.Equal([]int64{3600}, res.SecondsUntilExpiration)
 			},
 		},
 	}
@@ -224,6 +225,21 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, msg type filter",
+			func() {
+				now := ctx.BlockHeader().Time
+				authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, now.Add(time.Hour))
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -235,6 +251,181 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 			} else {
 				require.NoError(err)
 				require.Len(result.Grants, tc.numItems)
+				for _, grant := range result.Grants {
+					require.Equal(tc.request.Granter, grant.Granter)
+				}
+			}
+		})
+	}
+}
+
+func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
+	require := suite.Require()
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	testCases := []struct {
+		msg      string
+		preRun   func()
+		expError bool
+		request  authz.QueryGranteeGrantsRequest
+		numItems int
+	}{
+		{
+			"fail invalid grantee addr",
+			func() {},
+			true,
+			authz.QueryGranteeGrantsRequest{},
+			0,
+		},
+		{
+			"valid case, no authorization",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee: addrs[0].String(),
+			},
+			0,
+		},
+		{
+			"valid case, single authorization",
+			func() {
+				now := ctx.BlockHeader().Time
+				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+				authorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], authorization, now.Add(time.Hour))
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee: addrs[0].String(),
+			},
+			1,
+		},
+		{
+			"valid case, multiple authorization",
+			func() {
+				now := ctx.BlockHeader().Time
+				authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[2], authorization, now.Add(time.Hour))
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee: addrs[0].String(),
+			},
+			2,
+		},
+		{
+			"valid case, msg type filter",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			1,
+		},
+		{
+			"valid case, pagination",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee: addrs[0].String(),
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.preRun()
+			result, err := queryClient.GranteeGrants(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+				for _, grant := range result.Grants {
+					require.Equal(tc.request.Grantee, grant.Grantee)
+				}
+			}
+		})
+	}
+}
+
+func (suite *TestSuite) TestGRPCQueryMsgTypeGrants() {
+	require := suite.Require()
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	testCases := []struct {
+		msg      string
+		preRun   func()
+		expError bool
+		request  authz.QueryMsgTypeGrantsRequest
+		numItems int
+	}{
+		{
+			"fail empty msg type url",
+			func() {},
+			true,
+			authz.QueryMsgTypeGrantsRequest{},
+			0,
+		},
+		{
+			"valid case, no authorization",
+			func() {},
+			false,
+			authz.QueryMsgTypeGrantsRequest{
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			0,
+		},
+		{
+			"valid case, multiple authorization",
+			func() {
+				now := ctx.BlockHeader().Time
+				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+				authorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
+				require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, now.Add(time.Hour)))
+				require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[2], authorization, now.Add(time.Hour)))
+				generic := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+				require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], generic, now.Add(time.Hour)))
+			},
+			false,
+			authz.QueryMsgTypeGrantsRequest{
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, pagination",
+			func() {},
+			false,
+			authz.QueryMsgTypeGrantsRequest{
+				MsgTypeUrl: bankSendAuthMsgType,
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.preRun()
+			result, err := queryClient.MsgTypeGrants(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+				for _, grant := range result.Grants {
+					require.Equal("/cosmos.bank.v1beta1.SendAuthorization", grant.Authorization.TypeUrl)
+				}
 			}
 		})
 	}
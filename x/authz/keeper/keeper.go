@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -72,10 +74,14 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 }
 
 // DispatchActions attempts to execute the provided messages via authorization
-// grants from the message signer to the grantee.
-func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
-	var results = make([][]byte, len(msgs))
+// grants from the message signer to the grantee. The results of the messages are
+// returned ordered by message index, and the events of each message are emitted
+// with the authz_msg_index attribute.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([]authz.MsgExecResult, error) {
+	var results = make([]authz.MsgExecResult, len(msgs))
 	for i, msg := range msgs {
+		gasBefore := ctx.GasMeter().GasConsumed()
+
 		signers := msg.GetSigners()
 		if len(signers) != 1 {
 			return nil, sdkerrors.ErrInvalidRequest.Wrap("authorization can be given to msg with only one signer")
@@ -114,15 +120,25 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
 		}
-		results[i] = msgResp.Data
 
-		// emit the events from the dispatched actions
-		events := msgResp.Events
+		// emit the events from the dispatched actions, tagged with the message index
+		msgIndex := sdk.NewAttribute(authz.AttributeKeyMsgIndex, strconv.Itoa(i))
+		events := make([]abci.Event, len(msgResp.Events))
 		sdkEvents := make([]sdk.Event, 0, len(events))
-		for i := 0; i < len(events); i++ {
-			sdkEvents = append(sdkEvents, sdk.Event(events[i]))
+		for j, event := range msgResp.Events {
+			sdkEvent := sdk.Event(event).AppendAttributes(msgIndex)
+			events[j] = abci.Event(sdkEvent)
+			sdkEvents = append(sdkEvents, sdkEvent)
 		}
 		ctx.EventManager().EmitEvents(sdkEvents)
+
+		results[i] = authz.MsgExecResult{
+			Events:  events,
+			GasUsed: ctx.GasMeter().GasConsumed() - gasBefore,
+		}
+		if len(msgResp.MsgResponses) > 0 {
+			results[i].MsgResponse = msgResp.MsgResponses[0]
+		}
 	}
 
 	return results, nil
@@ -130,7 +146,8 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time. If there is an existing authorization grant for the
-// same `sdk.Msg` type, this grant overwrites that.
+// same `sdk.Msg` type, this grant overwrites that. The grant is also added to the grantee
+// and msg type indexes, and to the expiration queue.
 func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration time.Time) error {
 	store := ctx.KVStore(k.storeKey)
 
@@ -140,8 +157,18 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 	}
 
 	bz := k.cdc.MustMarshal(&grant)
-	skey := grantStoreKey(grantee, granter, authorization.MsgTypeURL())
+	msgType := authorization.MsgTypeURL()
+	skey := grantStoreKey(grantee, granter, msgType)
+
+	// a grant overwritten with a new expiration is moved in the expiration queue
+	if existing, found := k.getGrant(ctx, skey); found && !existing.Expiration.Equal(expiration) {
+		store.Delete(grantQueueKey(existing.Expiration, granter, grantee, msgType))
+	}
+
 	store.Set(skey, bz)
+	store.Set(grantQueueKey(expiration, granter, grantee, msgType), []byte{})
+	store.Set(grantByGranteeStoreKey(grantee, granter, msgType), []byte{})
+	store.Set(grantByMsgTypeStoreKey(msgType, granter, grantee), []byte{})
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
 		Granter:    granter.String(),
@@ -149,23 +176,94 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 	})
 }
 
+// ExtendGrantExpiration moves the expiration of the grant for the provided message
+// type to the new expiration, which must be after both the block time and the current
+// expiration. The authorization is kept as is, so the remaining limits of the
+// grant are not reset.
+func (k Keeper) ExtendGrantExpiration(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string, expiration time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	skey := grantStoreKey(grantee, granter, msgType)
+	grant, found := k.getGrant(ctx, skey)
+	if !found || grant.Expiration.Before(ctx.BlockTime()) {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "authorization not found")
+	}
+
+	if !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(authz.ErrInvalidExpirationTime, "new expiration %s is not after the block time %s", expiration, ctx.BlockTime())
+	}
+	if !expiration.After(grant.Expiration) {
+		return sdkerrors.Wrapf(authz.ErrInvalidExpirationTime, "new expiration %s is not after the current expiration %s", expiration, grant.Expiration)
+	}
+
+	store.Delete(grantQueueKey(grant.Expiration, granter, grantee, msgType))
+	grant.Expiration = expiration
+	store.Set(skey, k.cdc.MustMarshal(&grant))
+	store.Set(grantQueueKey(expiration, granter, grantee, msgType), []byte{})
+	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
+		MsgTypeUrl: msgType,
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+	})
+}
+
 // DeleteGrant revokes any authorization for the provided message type granted to the grantee
-// by the granter.
+// by the granter, and removes it from the grantee and msg type indexes and from the
+// expiration queue.
 func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
+	return k.deleteGrant(ctx, grantee, granter, msgType, "")
+}
+
+// deleteGrant removes a grant and emits a revoke event with the given reason.
+func (k Keeper) deleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType, reason string) error {
 	store := ctx.KVStore(k.storeKey)
 	skey := grantStoreKey(grantee, granter, msgType)
-	_, found := k.getGrant(ctx, skey)
+	grant, found := k.getGrant(ctx, skey)
 	if !found {
 		return sdkerrors.ErrNotFound.Wrap("authorization not found")
 	}
 	store.Delete(skey)
+	store.Delete(grantByGranteeStoreKey(grantee, granter, msgType))
+	store.Delete(grantByMsgTypeStoreKey(msgType, granter, grantee))
+	store.Delete(grantQueueKey(grant.Expiration, granter, grantee, msgType))
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
 		Grantee:    grantee.String(),
+		Reason:     reason,
 	})
 }
 
+// DequeueAndDeleteExpiredGrants removes at most limit grants which expired
+// before the block time, emitting a revoke event with the expired reason for
+// each of them.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context, limit int) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(GrantQueueKey, grantQueueByTimeKey(ctx.BlockTime()))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		exp, granter, grantee, msgType := parseGrantQueueKey(key)
+
+		// the entry is stale if the grant was re-granted with another expiration
+		grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+		if !found || !grant.Expiration.Equal(exp) {
+			store.Delete(key)
+			continue
+		}
+
+		if err := k.deleteGrant(ctx, grantee, granter, msgType, authz.RevokeReasonExpired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) (authorizations []authz.Authorization) {
 	store := ctx.KVStore(k.storeKey)
@@ -189,7 +287,7 @@ func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee sdk.AccAddress, g
 		return nil, time.Time{}
 	}
 	if grant.Expiration.Before(ctx.BlockHeader().Time) {
-		k.DeleteGrant(ctx, grantee, granter, msgType)
+		k.deleteGrant(ctx, grantee, granter, msgType, authz.RevokeReasonExpired)
 		return nil, time.Time{}
 	}
 
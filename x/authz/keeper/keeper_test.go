@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	gocontext "context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 	tmtime "github.com/tendermint/tendermint/libs/time"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -11,9 +14,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var bankSendAuthMsgType = banktypes.SendAuthorization{}.MsgTypeURL()
@@ -248,6 +256,304 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestExecResults() {
+	require := s.Require()
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	now := ctx.BlockHeader().Time
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10))
+
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: coins}, now.Add(time.Hour)))
+	stakeAuth, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{validator.GetOperator()}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+	require.NoError(err)
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, stakeAuth, now.Add(time.Hour)))
+
+	msg := authz.NewMsgExec(granteeAddr, []sdk.Msg{
+		banktypes.NewMsgSend(granterAddr, recipientAddr, coins),
+		stakingtypes.NewMsgDelegate(granterAddr, validator.GetOperator(), coins[0]),
+	})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := app.AuthzKeeper.Exec(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(err)
+	require.Len(res.Results, 2)
+	require.Len(res.MsgResults, 2)
+
+	expectedResponses := []proto.Message{&banktypes.MsgSendResponse{}, &stakingtypes.MsgDelegateResponse{}}
+	for i, msgResult := range res.MsgResults {
+		require.NotNil(msgResult.MsgResponse)
+		require.Equal("/"+proto.MessageName(expectedResponses[i]), msgResult.MsgResponse.TypeUrl)
+		require.Equal(res.Results[i], msgResult.MsgResponse.Value)
+
+		var msgResponse txtypes.MsgResponse
+		require.NoError(app.InterfaceRegistry().UnpackAny(msgResult.MsgResponse, &msgResponse))
+		require.IsType(expectedResponses[i], msgResponse)
+
+		require.NotEmpty(msgResult.Events)
+		for _, event := range msgResult.Events {
+			attribute := event.Attributes[len(event.Attributes)-1]
+			require.Equal(authz.AttributeKeyMsgIndex, attribute.Key)
+			require.Equal(fmt.Sprint(i), attribute.Value)
+		}
+		require.NotZero(msgResult.GasUsed)
+	}
+
+	s.T().Log("verify that the emitted events carry the message index")
+	msgIndexes := map[string]int{}
+	for _, event := range ctx.EventManager().Events() {
+		for _, attribute := range event.Attributes {
+			if attribute.Key == authz.AttributeKeyMsgIndex {
+				msgIndexes[attribute.Value]++
+			}
+		}
+	}
+	require.Equal(map[string]int{"0": len(res.MsgResults[0].Events), "1": len(res.MsgResults[1].Events)}, msgIndexes)
+}
+
+// requireIndexedGrants checks that the grantee and msg type indexes reference
+// exactly the expected grants, given as granter-grantee pairs.
+func (s *TestSuite) requireIndexedGrants(grantee sdk.AccAddress, msgType string, expected [][2]sdk.AccAddress) {
+	granteeRes, err := s.queryClient.GranteeGrants(gocontext.Background(), &authz.QueryGranteeGrantsRequest{
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgType,
+	})
+	s.Require().NoError(err)
+
+	msgTypeRes, err := s.queryClient.MsgTypeGrants(gocontext.Background(), &authz.QueryMsgTypeGrantsRequest{
+		MsgTypeUrl: msgType,
+	})
+	s.Require().NoError(err)
+
+	var byGrantee, byMsgType [][2]string
+	for _, g := range granteeRes.Grants {
+		byGrantee = append(byGrantee, [2]string{g.Granter, g.Grantee})
+	}
+	for _, g := range msgTypeRes.Grants {
+		byMsgType = append(byMsgType, [2]string{g.Granter, g.Grantee})
+	}
+
+	var exp [][2]string
+	for _, e := range expected {
+		exp = append(exp, [2]string{e[0].String(), e[1].String()})
+	}
+	s.Require().ElementsMatch(exp, byGrantee)
+	s.Require().ElementsMatch(exp, byMsgType)
+}
+
+func (s *TestSuite) TestGrantIndexes() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granter1, grantee, granter2 := addrs[0], addrs[1], addrs[2]
+	now := ctx.BlockHeader().Time
+	voteMsgType := sdk.MsgTypeURL(&govtypes.MsgVote{})
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	s.T().Log("verify that granting adds the grant to the indexes")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter1, &banktypes.SendAuthorization{SpendLimit: spendLimit}, now.Add(time.Hour)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter2, &banktypes.SendAuthorization{SpendLimit: spendLimit}, now.Add(time.Minute)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter1, authz.NewGenericAuthorization(voteMsgType), now.Add(time.Hour)))
+	s.requireIndexedGrants(grantee, bankSendAuthMsgType, [][2]sdk.AccAddress{{granter1, grantee}, {granter2, grantee}})
+	s.requireIndexedGrants(grantee, voteMsgType, [][2]sdk.AccAddress{{granter1, grantee}})
+
+	res, err := s.queryClient.GranteeGrants(gocontext.Background(), &authz.QueryGranteeGrantsRequest{Grantee: grantee.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Grants, 3)
+
+	s.T().Log("verify that granting again does not duplicate the index entries")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter1, &banktypes.SendAuthorization{SpendLimit: spendLimit.Add(spendLimit...)}, now.Add(time.Hour)))
+	s.requireIndexedGrants(grantee, bankSendAuthMsgType, [][2]sdk.AccAddress{{granter1, grantee}, {granter2, grantee}})
+
+	s.T().Log("verify that revoking removes the grant from the indexes")
+	s.Require().NoError(app.AuthzKeeper.DeleteGrant(ctx, grantee, granter1, voteMsgType))
+	s.requireIndexedGrants(grantee, voteMsgType, nil)
+
+	s.T().Log("verify that an expired grant is removed from the indexes")
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx.WithBlockTime(now.Add(time.Hour)), grantee, granter2, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+	s.requireIndexedGrants(grantee, bankSendAuthMsgType, [][2]sdk.AccAddress{{granter1, grantee}})
+
+	s.T().Log("verify that a grant deleted on exec is removed from the indexes")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{&banktypes.MsgSend{
+		FromAddress: granter1.String(),
+		ToAddress:   granter2.String(),
+		Amount:      spendLimit.Add(spendLimit...),
+	}})
+	s.Require().NoError(err)
+	s.requireIndexedGrants(grantee, bankSendAuthMsgType, nil)
+
+	res, err = s.queryClient.GranteeGrants(gocontext.Background(), &authz.QueryGranteeGrantsRequest{Grantee: grantee.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Grants)
+}
+
+// queuedGrants returns the number of entries of the grant expiration queue.
+func (s *TestSuite) queuedGrants(ctx sdk.Context) int {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(s.app.GetKey(authz.ModuleName)), keeper.GrantQueueKey)
+	defer iter.Close()
+
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
+// expiredRevokeEvents returns the number of revoke events with the expired reason.
+func expiredRevokeEvents(ctx sdk.Context) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&authz.EventRevoke{}) {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "reason" && string(attr.Value) == fmt.Sprintf("%q", authz.RevokeReasonExpired) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (s *TestSuite) TestDequeueAndDeleteExpiredGrants() {
+	app, addrs := s.app, s.addrs
+	granter, grantee := addrs[0], addrs[1]
+	now := s.ctx.BlockHeader().Time
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+
+	// 5 grants expire at the same time and one an hour later
+	for i := 0; i < 5; i++ {
+		msgType := fmt.Sprintf("/test.MsgTest%d", i)
+		s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter, authz.NewGenericAuthorization(msgType), now.Add(time.Minute)))
+	}
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter, authz.NewGenericAuthorization("/test.MsgLater"), now.Add(time.Hour)))
+	s.Require().Equal(6, s.queuedGrants(ctx))
+
+	s.T().Log("verify that grants are not removed before they expire")
+	s.Require().NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(now.Add(time.Minute)), 10))
+	s.Require().Equal(6, s.queuedGrants(ctx))
+	s.Require().Len(app.AuthzKeeper.GetAuthorizations(ctx, grantee, granter), 6)
+
+	s.T().Log("verify that at most limit expired grants are removed")
+	expiredCtx := ctx.WithBlockTime(now.Add(2 * time.Minute))
+	s.Require().NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(expiredCtx, 2))
+	s.Require().Equal(4, s.queuedGrants(ctx))
+	s.Require().Len(app.AuthzKeeper.GetAuthorizations(ctx, grantee, granter), 4)
+	s.Require().Equal(2, expiredRevokeEvents(ctx))
+
+	s.Require().NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(expiredCtx, 3))
+	s.Require().Equal(1, s.queuedGrants(ctx))
+	s.Require().Equal(5, expiredRevokeEvents(ctx))
+
+	s.T().Log("verify that the grant which did not expire is kept")
+	s.Require().NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(expiredCtx, 3))
+	s.Require().Equal(1, s.queuedGrants(ctx))
+	authorizations := app.AuthzKeeper.GetAuthorizations(ctx, grantee, granter)
+	s.Require().Len(authorizations, 1)
+	s.Require().Equal("/test.MsgLater", authorizations[0].MsgTypeURL())
+
+	s.Require().NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(now.Add(2*time.Hour)), 3))
+	s.Require().Zero(s.queuedGrants(ctx))
+	s.Require().Empty(app.AuthzKeeper.GetAuthorizations(ctx, grantee, granter))
+	s.Require().Equal(6, expiredRevokeEvents(ctx))
+}
+
+func (s *TestSuite) TestDequeueAndDeleteRegrantedGrants() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granter, grantee := addrs[0], addrs[1]
+	now := ctx.BlockHeader().Time
+	authorization := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+
+	s.T().Log("verify that re-granting with a later expiration moves the grant in the queue")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter, authorization, now.Add(time.Minute)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter, authorization, now.Add(time.Hour)))
+	s.Require().Equal(1, s.queuedGrants(ctx))
+
+	s.Require().NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(now.Add(2*time.Minute)), 10))
+	found, _ := app.AuthzKeeper.GetCleanAuthorization(ctx.WithBlockTime(now.Add(2*time.Minute)), grantee, granter, bankSendAuthMsgType)
+	s.Require().NotNil(found)
+	s.Require().Equal(1, s.queuedGrants(ctx))
+
+	s.T().Log("verify that re-granting with an earlier expiration moves the grant in the queue")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter, authorization, now.Add(time.Minute)))
+	s.Require().Equal(1, s.queuedGrants(ctx))
+
+	s.Require().NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(now.Add(2*time.Minute)), 10))
+	s.Require().Zero(s.queuedGrants(ctx))
+	s.Require().Empty(app.AuthzKeeper.GetAuthorizations(ctx, grantee, granter))
+
+	s.T().Log("verify that revoking removes the grant from the queue")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter, authorization, now.Add(time.Hour)))
+	s.Require().NoError(app.AuthzKeeper.DeleteGrant(ctx, grantee, granter, bankSendAuthMsgType))
+	s.Require().Zero(s.queuedGrants(ctx))
+}
+
+func (s *TestSuite) TestRenewGrant() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granter, grantee, recipient := addrs[0], addrs[1], addrs[2]
+	now := ctx.BlockHeader().Time
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	delegateMsgType := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter, &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))}, now.Add(time.Hour)))
+	maxTokens := sdk.NewInt64Coin(bondDenom, 100)
+	stakeAuth, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{validator.GetOperator()}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &maxTokens)
+	s.Require().NoError(err)
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee, granter, stakeAuth, now.Add(time.Hour)))
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{
+		banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30))),
+		stakingtypes.NewMsgDelegate(granter, validator.GetOperator(), sdk.NewInt64Coin(bondDenom, 40)),
+	})
+	s.Require().NoError(err)
+
+	s.T().Log("verify that renewing keeps the remaining limits")
+	for _, msgType := range []string{bankSendAuthMsgType, delegateMsgType} {
+		_, err = app.AuthzKeeper.RenewGrant(goCtx, &authz.MsgRenewGrant{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			MsgTypeUrl:    msgType,
+			NewExpiration: now.Add(2 * time.Hour),
+		})
+		s.Require().NoError(err)
+	}
+
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	s.Require().Equal(now.Add(2*time.Hour), expiration)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 70)), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	authorization, expiration = app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, delegateMsgType)
+	s.Require().Equal(now.Add(2*time.Hour), expiration)
+	s.Require().Equal(sdk.NewInt64Coin(bondDenom, 60), *authorization.(*stakingtypes.StakeAuthorization).MaxTokens)
+
+	s.T().Log("verify that the renewed grants are moved in the expiration queue")
+	s.Require().Equal(2, s.queuedGrants(ctx))
+	s.Require().NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(now.Add(90*time.Minute)), 10))
+	s.Require().Len(app.AuthzKeeper.GetAuthorizations(ctx, grantee, granter), 2)
+
+	s.T().Log("verify that the new expiration must be after the current one and the block time")
+	for _, newExpiration := range []time.Time{now.Add(time.Hour), now.Add(2 * time.Hour)} {
+		_, err = app.AuthzKeeper.RenewGrant(goCtx, &authz.MsgRenewGrant{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			MsgTypeUrl:    bankSendAuthMsgType,
+			NewExpiration: newExpiration,
+		})
+		s.Require().ErrorIs(err, authz.ErrInvalidExpirationTime)
+	}
+	err = app.AuthzKeeper.ExtendGrantExpiration(ctx.WithBlockTime(now.Add(time.Hour)), grantee, granter, bankSendAuthMsgType, now.Add(time.Minute))
+	s.Require().ErrorIs(err, authz.ErrInvalidExpirationTime)
+
+	s.T().Log("verify that a missing or expired grant cannot be renewed")
+	err = app.AuthzKeeper.ExtendGrantExpiration(ctx, grantee, granter, "/test.MsgMissing", now.Add(3*time.Hour))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	err = app.AuthzKeeper.ExtendGrantExpiration(ctx.WithBlockTime(now.Add(3*time.Hour)), grantee, granter, bankSendAuthMsgType, now.Add(4*time.Hour))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
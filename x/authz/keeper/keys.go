@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -10,7 +12,10 @@ import (
 
 // Keys for store prefixes
 var (
-	GrantKey = []byte{0x01} // prefix for each key
+	GrantKey          = []byte{0x01} // prefix for each key
+	GrantByGranteeKey = []byte{0x02} // prefix for the grantee index
+	GrantByMsgTypeKey = []byte{0x03} // prefix for the msg type index
+	GrantQueueKey     = []byte{0x04} // prefix for the grant expiration queue
 )
 
 // StoreKey is the store key string for authz
@@ -49,3 +54,73 @@ func addressesFromGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAdd
 
 	return granterAddr, granteeAddr
 }
+
+// grantByGranteeStoreKey - return the grantee index key of a grant
+// Items are stored with the following key: values
+//
+// - 0x02<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+func grantByGranteeStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+
+	key := make([]byte, 0, 1+len(grantee)+len(granter)+len(m))
+	key = append(key, GrantByGranteeKey...)
+	key = append(key, grantee...)
+	key = append(key, granter...)
+	return append(key, m...)
+}
+
+// grantByMsgTypeStoreKey - return the msg type index key of a grant
+// Items are stored with the following key: values
+//
+// - 0x03<msgTypeLen (1 Byte)><msgType_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>: []byte{}
+func grantByMsgTypeStoreKey(msgType string, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	m := address.MustLengthPrefix([]byte(msgType))
+	granter = address.MustLengthPrefix(granter)
+	grantee = address.MustLengthPrefix(grantee)
+
+	key := make([]byte, 0, 1+len(m)+len(granter)+len(grantee))
+	key = append(key, GrantByMsgTypeKey...)
+	key = append(key, m...)
+	key = append(key, granter...)
+	return append(key, grantee...)
+}
+
+// grantQueueByTimeKey - return the prefix of the expiration queue entries of
+// the grants expiring at exp
+func grantQueueByTimeKey(exp time.Time) []byte {
+	return append(append([]byte{}, GrantQueueKey...), sdk.FormatTimeBytes(exp)...)
+}
+
+// grantQueueKey - return the expiration queue key of a grant
+// Items are stored with the following key: values
+//
+// - 0x04<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: []byte{}
+func grantQueueKey(exp time.Time, granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) []byte {
+	return append(grantQueueByTimeKey(exp), grantStoreKey(grantee, granter, msgType)[1:]...)
+}
+
+// parseGrantQueueKey - split the expiration time, the granter & grantee address
+// and the msg type from the expiration queue key
+func parseGrantQueueKey(key []byte) (exp time.Time, granter, grantee sdk.AccAddress, msgType string) {
+	lenTime := len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, 1+lenTime)
+	exp, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	granter, rest := splitLengthPrefixed(key[1+lenTime:])
+	grantee, m := splitLengthPrefixed(rest)
+	return exp, granter, grantee, string(m)
+}
+
+// splitLengthPrefixed - split a length prefixed value from the beginning of bz
+// and return it with the remaining bytes
+func splitLengthPrefixed(bz []byte) (value, rest []byte) {
+	kv.AssertKeyAtLeastLength(bz, 1)
+	l := int(bz[0])
+	kv.AssertKeyAtLeastLength(bz, 1+l)
+	return bz[1 : 1+l], bz[1+l:]
+}
@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -24,3 +27,48 @@ func TestGrantkey(t *testing.T) {
 	require.Equal(granter, granter1)
 	require.Equal(grantee, grantee1)
 }
+
+func TestGrantIndexKeys(t *testing.T) {
+	require := require.New(t)
+
+	key := grantByGranteeStoreKey(grantee, granter, msgType)
+	require.Equal(GrantByGranteeKey, key[:1])
+	grantee1, rest := splitLengthPrefixed(key[1:])
+	granter1, msgType1 := splitLengthPrefixed(rest)
+	require.Equal(grantee, sdk.AccAddress(grantee1))
+	require.Equal(granter, sdk.AccAddress(granter1))
+	require.Equal(msgType, string(msgType1))
+
+	key = grantByMsgTypeStoreKey(msgType, granter, grantee)
+	require.Equal(GrantByMsgTypeKey, key[:1])
+	msgType1, rest = splitLengthPrefixed(key[1:])
+	granter1, rest = splitLengthPrefixed(rest)
+	grantee1, rest = splitLengthPrefixed(rest)
+	require.Equal(msgType, string(msgType1))
+	require.Equal(granter, sdk.AccAddress(granter1))
+	require.Equal(grantee, sdk.AccAddress(grantee1))
+	require.Empty(rest)
+
+	// the migration must write the same index keys as the keeper
+	require.Equal(v046.GrantByGranteeStoreKey(grantee, granter, msgType), grantByGranteeStoreKey(grantee, granter, msgType))
+	require.Equal(v046.GrantByMsgTypeStoreKey(msgType, granter, grantee), grantByMsgTypeStoreKey(msgType, granter, grantee))
+	require.Equal(v046.GrantStoreKey(grantee, granter, msgType), grantStoreKey(grantee, granter, msgType))
+}
+
+func TestGrantQueueKey(t *testing.T) {
+	require := require.New(t)
+	exp := time.Unix(1000, 5).UTC()
+
+	key := grantQueueKey(exp, granter, grantee, msgType)
+	require.Equal(GrantQueueKey, key[:1])
+	require.True(bytes.HasPrefix(key, grantQueueByTimeKey(exp)))
+
+	exp1, granter1, grantee1, msgType1 := parseGrantQueueKey(key)
+	require.Equal(exp, exp1)
+	require.Equal(granter, granter1)
+	require.Equal(grantee, grantee1)
+	require.Equal(msgType, msgType1)
+
+	// the migration must write the same queue keys as the keeper
+	require.Equal(v046.GrantQueueStoreKey(exp, granter, grantee, msgType), key)
+}
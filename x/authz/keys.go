@@ -10,3 +10,18 @@ const (
 	// QuerierRoute is the querier route for authz
 	QuerierRoute = ModuleName
 )
+
+const (
+	// MaxPrunedPerBlock is the maximum number of expired grants removed at the
+	// beginning of a block. The remaining expired grants are removed in the
+	// following blocks.
+	MaxPrunedPerBlock = 200
+
+	// RevokeReasonExpired is the reason of the revoke event emitted when an
+	// expired grant is removed.
+	RevokeReasonExpired = "expired"
+
+	// AttributeKeyMsgIndex is the attribute added to the events emitted by a
+	// message executed by MsgExec, set to the index of the message.
+	AttributeKeyMsgIndex = "authz_msg_index"
+)
@@ -0,0 +1,72 @@
+package v046
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// Keys for store prefixes
+var (
+	GrantKey          = []byte{0x01} // prefix for each key
+	GrantByGranteeKey = []byte{0x02} // prefix for the grantee index
+	GrantByMsgTypeKey = []byte{0x03} // prefix for the msg type index
+	GrantQueueKey     = []byte{0x04} // prefix for the grant expiration queue
+)
+
+// GrantStoreKey - return authorization store key
+//
+// - 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: Grant
+func GrantStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	key := append([]byte{}, GrantKey...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	key = append(key, address.MustLengthPrefix(grantee)...)
+	return append(key, msgType...)
+}
+
+// GrantByGranteeStoreKey - return the grantee index key of a grant
+//
+// - 0x02<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+func GrantByGranteeStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	key := append([]byte{}, GrantByGranteeKey...)
+	key = append(key, address.MustLengthPrefix(grantee)...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, msgType...)
+}
+
+// GrantByMsgTypeStoreKey - return the msg type index key of a grant
+//
+// - 0x03<msgTypeLen (1 Byte)><msgType_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>: []byte{}
+func GrantByMsgTypeStoreKey(msgType string, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := append([]byte{}, GrantByMsgTypeKey...)
+	key = append(key, address.MustLengthPrefix([]byte(msgType))...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, address.MustLengthPrefix(grantee)...)
+}
+
+// GrantQueueStoreKey - return the expiration queue key of a grant
+//
+// - 0x04<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: []byte{}
+func GrantQueueStoreKey(exp time.Time, granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) []byte {
+	key := append([]byte{}, GrantQueueKey...)
+	key = append(key, sdk.FormatTimeBytes(exp)...)
+	return append(key, GrantStoreKey(grantee, granter, msgType)[1:]...)
+}
+
+// ParseGrantStoreKey - split granter, grantee address and msg type from the authorization key
+func ParseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
+	// key is of format:
+	// 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	granterAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+granterAddrLen)
+	granterAddr = sdk.AccAddress(key[2 : 2+granterAddrLen])
+	granteeAddrLen := int(key[2+granterAddrLen])
+	granteeStart := 3 + granterAddrLen
+	kv.AssertKeyAtLeastLength(key, granteeStart+granteeAddrLen)
+	granteeAddr = sdk.AccAddress(key[granteeStart : granteeStart+granteeAddrLen])
+
+	return granterAddr, granteeAddr, string(key[granteeStart+granteeAddrLen:])
+}
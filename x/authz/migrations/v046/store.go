@@ -0,0 +1,42 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MigrateStore performs in-place store migrations from v0.43/v0.45 to v0.46.
+// The migration includes:
+//
+// - Adding the grantee index entry of every existing grant.
+// - Adding the msg type index entry of every existing grant.
+// - Adding every existing grant to the expiration queue.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GrantKey)
+	defer iter.Close()
+
+	var (
+		keys   [][]byte
+		grants []authz.Grant
+	)
+	for ; iter.Valid(); iter.Next() {
+		var grant authz.Grant
+		if err := cdc.Unmarshal(iter.Value(), &grant); err != nil {
+			return err
+		}
+		keys = append(keys, iter.Key())
+		grants = append(grants, grant)
+	}
+
+	for i, key := range keys {
+		granter, grantee, msgType := ParseGrantStoreKey(key)
+		store.Set(GrantByGranteeStoreKey(grantee, granter, msgType), []byte{})
+		store.Set(GrantByMsgTypeStoreKey(msgType, granter, grantee), []byte{})
+		store.Set(GrantQueueStoreKey(grants[i].Expiration, granter, grantee, msgType), []byte{})
+	}
+
+	return nil
+}
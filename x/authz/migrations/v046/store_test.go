@@ -0,0 +1,71 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authzKey := sdk.NewKVStoreKey(authz.ModuleName)
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+
+	_, _, granter1 := testdata.KeyTestPubAddr()
+	_, _, granter2 := testdata.KeyTestPubAddr()
+	_, _, grantee1 := testdata.KeyTestPubAddr()
+	_, _, grantee2 := testdata.KeyTestPubAddr()
+
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	voteMsgType := sdk.MsgTypeURL(&govtypes.MsgVote{})
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	// v0.45 grants are only stored by granter and grantee
+	grants := []struct {
+		granter, grantee sdk.AccAddress
+		authorization    authz.Authorization
+	}{
+		{granter1, grantee1, banktypes.NewSendAuthorization(spendLimit)},
+		{granter1, grantee1, authz.NewGenericAuthorization(voteMsgType)},
+		{granter1, grantee2, banktypes.NewSendAuthorization(spendLimit)},
+		{granter2, grantee1, authz.NewGenericAuthorization(voteMsgType)},
+	}
+	store := ctx.KVStore(authzKey)
+	for i, g := range grants {
+		grant, err := authz.NewGrant(g.authorization, time.Unix(int64(1000+i), 0).UTC())
+		require.NoError(t, err)
+		store.Set(v046.GrantStoreKey(g.grantee, g.granter, g.authorization.MsgTypeURL()), encCfg.Codec.MustMarshal(&grant))
+	}
+
+	require.NoError(t, v046.MigrateStore(ctx, authzKey, encCfg.Codec))
+
+	for i, g := range grants {
+		msgType := g.authorization.MsgTypeURL()
+		require.True(t, store.Has(v046.GrantByGranteeStoreKey(g.grantee, g.granter, msgType)))
+		require.True(t, store.Has(v046.GrantByMsgTypeStoreKey(msgType, g.granter, g.grantee)))
+		require.True(t, store.Has(v046.GrantQueueStoreKey(time.Unix(int64(1000+i), 0).UTC(), g.granter, g.grantee, msgType)))
+	}
+	require.False(t, store.Has(v046.GrantByGranteeStoreKey(grantee2, granter2, sendMsgType)))
+	require.False(t, store.Has(v046.GrantByMsgTypeStoreKey(sendMsgType, granter2, grantee1)))
+
+	// every index and the expiration queue have exactly one entry per grant
+	for _, prefix := range [][]byte{v046.GrantKey, v046.GrantByGranteeKey, v046.GrantByMsgTypeKey, v046.GrantQueueKey} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		count := 0
+		for ; iter.Valid(); iter.Next() {
+			count++
+		}
+		iter.Close()
+		require.Equal(t, len(grants), count)
+	}
+}
@@ -0,0 +1,19 @@
+package authz
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+)
+
+// BeginBlocker removes the grants which expired before the block time
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(authz.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	if err := k.DequeueAndDeleteExpiredGrants(ctx, authz.MaxPrunedPerBlock); err != nil {
+		panic(err)
+	}
+}
@@ -66,6 +66,32 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgRenewGrant(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		msgType          string
+		expiration       time.Time
+		expectPass       bool
+	}{
+		{"nil Granter address", nil, grantee, "hello", now.Add(time.Hour), false},
+		{"nil Grantee address", granter, nil, "hello", now.Add(time.Hour), false},
+		{"same Granter and Grantee address", granter, granter, "hello", now.Add(time.Hour), false},
+		{"missing msg type", granter, grantee, "", now.Add(time.Hour), false},
+		{"past expiration", granter, grantee, "hello", now.AddDate(0, -1, 0), false},
+		{"valid test case", granter, grantee, "hello", now.Add(time.Hour), true},
+	}
+	for i, tc := range tests {
+		msg := authz.NewMsgRenewGrant(tc.granter, tc.grantee, tc.msgType, tc.expiration)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgGrantAuthorization(t *testing.T) {
 	tests := []struct {
 		title            string
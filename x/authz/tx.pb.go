@@ -12,6 +12,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types1 "github.com/tendermint/tendermint/abci/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -76,6 +80,9 @@ var xxx_messageInfo_MsgGrant proto.InternalMessageInfo
 // MsgExecResponse defines the Msg/MsgExecResponse response type.
 type MsgExecResponse struct {
 	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// msg_results are the results of the executed messages, ordered by message
+	// index.
+	MsgResults []MsgExecResult `protobuf:"bytes,2,rep,name=msg_results,json=msgResults,proto3" json:"msg_results"`
 }
 
 func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
@@ -111,6 +118,51 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
+// MsgExecResult defines the result of a message executed by MsgExec.
+type MsgExecResult struct {
+	// msg_response is the response of the executed message packed in an Any.
+	MsgResponse *types.Any `protobuf:"bytes,1,opt,name=msg_response,json=msgResponse,proto3" json:"msg_response,omitempty"`
+	// events are the events emitted by the executed message. Each of them has
+	// the authz_msg_index attribute set to the index of the message.
+	Events []types1.Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events"`
+	// gas_used is the gas consumed by the authorization and the execution of
+	// the message.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *MsgExecResult) Reset()         { *m = MsgExecResult{} }
+func (m *MsgExecResult) String() string { return proto.CompactTextString(m) }
+func (*MsgExecResult) ProtoMessage()    {}
+func (*MsgExecResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{2}
+}
+func (m *MsgExecResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecResult.Merge(m, src)
+}
+func (m *MsgExecResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecResult proto.InternalMessageInfo
+
 // MsgExec attempts to execute the provided messages using
 // authorizations granted to the grantee. Each message should have only
 // one signer corresponding to the granter of the authorization.
@@ -126,7 +178,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{2}
+	return fileDescriptor_3ceddab7d8589ad1, []int{3}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +215,7 @@ func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
 func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantResponse) ProtoMessage()    {}
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{3}
+	return fileDescriptor_3ceddab7d8589ad1, []int{4}
 }
 func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +256,7 @@ func (m *MsgRevoke) Reset()         { *m = MsgRevoke{} }
 func (m *MsgRevoke) String() string { return proto.CompactTextString(m) }
 func (*MsgRevoke) ProtoMessage()    {}
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{4}
+	return fileDescriptor_3ceddab7d8589ad1, []int{5}
 }
 func (m *MsgRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +293,7 @@ func (m *MsgRevokeResponse) Reset()         { *m = MsgRevokeResponse{} }
 func (m *MsgRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeResponse) ProtoMessage()    {}
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{5}
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
 }
 func (m *MsgRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,52 +322,146 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgRenewGrant extends the expiration of a grant from the granter to the
+// grantee for the provided method name.
+type MsgRenewGrant struct {
+	Granter    string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee    string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// new_expiration must be in the future and after the current expiration of
+	// the grant.
+	NewExpiration time.Time `protobuf:"bytes,4,opt,name=new_expiration,json=newExpiration,proto3,stdtime" json:"new_expiration"`
+}
+
+func (m *MsgRenewGrant) Reset()         { *m = MsgRenewGrant{} }
+func (m *MsgRenewGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewGrant) ProtoMessage()    {}
+func (*MsgRenewGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{7}
+}
+func (m *MsgRenewGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewGrant.Merge(m, src)
+}
+func (m *MsgRenewGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewGrant proto.InternalMessageInfo
+
+// MsgRenewGrantResponse defines the Msg/MsgRenewGrant response type.
+type MsgRenewGrantResponse struct {
+}
+
+func (m *MsgRenewGrantResponse) Reset()         { *m = MsgRenewGrantResponse{} }
+func (m *MsgRenewGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewGrantResponse) ProtoMessage()    {}
+func (*MsgRenewGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{8}
+}
+func (m *MsgRenewGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewGrantResponse.Merge(m, src)
+}
+func (m *MsgRenewGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewGrantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
+	proto.RegisterType((*MsgExecResult)(nil), "cosmos.authz.v1beta1.MsgExecResult")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.authz.v1beta1.MsgExec")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
+	proto.RegisterType((*MsgRenewGrant)(nil), "cosmos.authz.v1beta1.MsgRenewGrant")
+	proto.RegisterType((*MsgRenewGrantResponse)(nil), "cosmos.authz.v1beta1.MsgRenewGrantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0x33, 0x4d, 0xda, 0x7c, 0x99, 0x56, 0xfa, 0xc0, 0x64, 0xe1, 0x06, 0xea, 0x58, 0xe6,
-	0x5f, 0x24, 0xc8, 0x58, 0x0d, 0x0b, 0xd6, 0xb1, 0x84, 0x90, 0x10, 0x16, 0x92, 0x81, 0x0d, 0x9b,
-	0xc8, 0x4e, 0x86, 0x89, 0x95, 0xd8, 0x63, 0xf9, 0x8e, 0xab, 0xa4, 0x6f, 0xc0, 0x8e, 0x0d, 0x4f,
-	0xc1, 0xb6, 0x0f, 0x11, 0xb1, 0xaa, 0x58, 0xb1, 0x42, 0x90, 0xbc, 0x04, 0x4b, 0x94, 0x99, 0x71,
-	0x40, 0x28, 0x2d, 0x5d, 0xb1, 0xf2, 0xdc, 0x39, 0xbf, 0x19, 0x9d, 0x7b, 0x8f, 0x07, 0x1f, 0x0d,
-	0x39, 0x24, 0x1c, 0xdc, 0xb0, 0x10, 0xe3, 0x53, 0xf7, 0xe4, 0x38, 0xa2, 0x22, 0x3c, 0x76, 0xc5,
-	0x8c, 0x64, 0x39, 0x17, 0xdc, 0x68, 0x2a, 0x99, 0x48, 0x99, 0x68, 0xb9, 0x75, 0xa8, 0x76, 0x07,
-	0x92, 0x71, 0x35, 0x22, 0x8b, 0x56, 0x93, 0x71, 0xc6, 0xd5, 0xfe, 0x7a, 0xa5, 0x77, 0xdb, 0x8c,
-	0x73, 0x36, 0xa5, 0xae, 0xac, 0xa2, 0xe2, 0xad, 0x2b, 0xe2, 0x84, 0x82, 0x08, 0x93, 0x4c, 0x03,
-	0x87, 0x7f, 0x02, 0x61, 0x3a, 0xd7, 0xd2, 0x6d, 0xed, 0x30, 0x0a, 0x81, 0xba, 0x61, 0x34, 0x8c,
-	0x37, 0x2e, 0xd7, 0x85, 0x86, 0xec, 0xad, 0x6d, 0x28, 0xd7, 0x92, 0x70, 0x3e, 0x22, 0xfc, 0x9f,
-	0x0f, 0xec, 0x69, 0x1e, 0xa6, 0xc2, 0xe8, 0xe1, 0x3a, 0x5b, 0x2f, 0x68, 0x6e, 0x22, 0x1b, 0x75,
-	0x1a, 0x9e, 0xf9, 0xf9, 0xac, 0x5b, 0xf6, 0xda, 0x1f, 0x8d, 0x72, 0x0a, 0xf0, 0x52, 0xe4, 0x71,
-	0xca, 0x82, 0x12, 0xfc, 0x75, 0x86, 0x9a, 0x3b, 0x57, 0x3b, 0x43, 0x8d, 0xc7, 0x78, 0x57, 0x2e,
-	0xcd, 0xaa, 0x8d, 0x3a, 0xfb, 0xbd, 0x9b, 0x64, 0xdb, 0x38, 0x89, 0xf4, 0xe4, 0xd5, 0x16, 0x5f,
-	0xdb, 0x95, 0x40, 0xf1, 0xce, 0x03, 0xfc, 0xbf, 0x0f, 0xec, 0xc9, 0x8c, 0x0e, 0x03, 0x0a, 0x19,
-	0x4f, 0x81, 0x1a, 0x26, 0xae, 0xe7, 0x14, 0x8a, 0xa9, 0x00, 0x13, 0xd9, 0xd5, 0xce, 0x41, 0x50,
-	0x96, 0xce, 0x3b, 0x84, 0xeb, 0x9a, 0xfe, 0xdd, 0x25, 0xba, 0xaa, 0xcb, 0x67, 0xb8, 0x96, 0x00,
-	0x03, 0x73, 0xc7, 0xae, 0x76, 0xf6, 0x7b, 0x4d, 0xa2, 0xb2, 0x20, 0x65, 0x16, 0xa4, 0x9f, 0xce,
-	0x3d, 0xfb, 0xd3, 0x59, 0xf7, 0x16, 0x8c, 0x26, 0xc4, 0x07, 0xf6, 0xd0, 0x56, 0xfe, 0xfb, 0x85,
-	0x18, 0xf3, 0x3c, 0x3e, 0x0d, 0x45, 0xcc, 0xd3, 0x40, 0xde, 0xe1, 0x18, 0xf8, 0x5a, 0x39, 0xe5,
-	0xd2, 0xb9, 0xf3, 0x01, 0xe1, 0x86, 0x0f, 0x2c, 0xa0, 0x27, 0x7c, 0x42, 0xff, 0xd9, 0xec, 0x6d,
-	0x7c, 0x90, 0x00, 0x1b, 0x88, 0x79, 0x46, 0x07, 0x45, 0x3e, 0x95, 0x11, 0x34, 0x02, 0x9c, 0x00,
-	0x7b, 0x35, 0xcf, 0xe8, 0xeb, 0x7c, 0xea, 0xdc, 0xc0, 0xd7, 0x37, 0xb6, 0x4a, 0xb3, 0xbd, 0x1f,
-	0x08, 0x57, 0x7d, 0x60, 0xc6, 0x0b, 0xbc, 0xab, 0xfe, 0x15, 0x6b, 0x7b, 0x68, 0x65, 0x97, 0xad,
-	0x7b, 0x97, 0xeb, 0x9b, 0xfc, 0x9e, 0xe3, 0x9a, 0x4c, 0xe8, 0xe8, 0x42, 0x7e, 0x2d, 0xb7, 0xee,
-	0x5e, 0x2a, 0x6f, 0x6e, 0x0b, 0xf0, 0x9e, 0x9e, 0x67, 0xfb, 0xc2, 0x03, 0x0a, 0x68, 0xdd, 0xff,
-	0x0b, 0x50, 0xde, 0xe9, 0x79, 0x8b, 0xef, 0x56, 0x65, 0xb1, 0xb4, 0xd0, 0xf9, 0xd2, 0x42, 0xdf,
-	0x96, 0x16, 0x7a, 0xbf, 0xb2, 0x2a, 0xe7, 0x2b, 0xab, 0xf2, 0x65, 0x65, 0x55, 0xde, 0xdc, 0x61,
-	0xb1, 0x18, 0x17, 0x11, 0x19, 0xf2, 0x44, 0x3f, 0x79, 0xfd, 0xe9, 0xc2, 0x68, 0xe2, 0xce, 0xd4,
-	0x63, 0x8b, 0xf6, 0xe4, 0x5f, 0xf3, 0xe8, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0x05, 0x3d,
-	0x79, 0x58, 0x04, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x4e, 0xdb, 0x4c,
+	0x18, 0xcd, 0x90, 0x40, 0x60, 0x02, 0xff, 0xdf, 0xba, 0xb4, 0x35, 0xa6, 0x38, 0x96, 0xe9, 0x25,
+	0x52, 0x8b, 0x2d, 0xd2, 0x4a, 0xac, 0x89, 0x84, 0x2a, 0xd1, 0x46, 0x95, 0x5c, 0xd8, 0x74, 0xd1,
+	0xc8, 0x89, 0xa7, 0x83, 0x45, 0xec, 0x89, 0x3c, 0x63, 0x92, 0xf0, 0x06, 0xdd, 0xb1, 0xe9, 0xa2,
+	0xcf, 0xd0, 0x2d, 0x0f, 0x81, 0xba, 0x42, 0x5d, 0xb1, 0xea, 0x05, 0xa4, 0x3e, 0x47, 0xe5, 0xb9,
+	0x18, 0x68, 0xc3, 0x65, 0xc5, 0x2a, 0x9e, 0x39, 0xe7, 0xfb, 0x7c, 0xe6, 0x9c, 0x6f, 0x1c, 0xb8,
+	0xd0, 0x21, 0x34, 0x22, 0xd4, 0xf5, 0x53, 0xb6, 0xb5, 0xeb, 0xee, 0x2c, 0xb7, 0x11, 0xf3, 0x97,
+	0x5d, 0x36, 0x70, 0x7a, 0x09, 0x61, 0x44, 0x9b, 0x15, 0xb0, 0xc3, 0x61, 0x47, 0xc2, 0xc6, 0x9c,
+	0xd8, 0x6d, 0x71, 0x8e, 0x2b, 0x29, 0x7c, 0x61, 0xcc, 0x62, 0x82, 0x89, 0xd8, 0xcf, 0x9e, 0xe4,
+	0xee, 0x1c, 0x26, 0x04, 0x77, 0x91, 0xcb, 0x57, 0xed, 0xf4, 0x83, 0xeb, 0xc7, 0x43, 0x09, 0x55,
+	0xff, 0x86, 0x58, 0x18, 0x21, 0xca, 0xfc, 0xa8, 0x27, 0x09, 0xd6, 0x48, 0x85, 0x42, 0x90, 0x60,
+	0xcc, 0x33, 0x14, 0x07, 0x28, 0x89, 0xc2, 0x98, 0xb9, 0x7e, 0xbb, 0x13, 0xba, 0x6c, 0xd8, 0x43,
+	0x52, 0x90, 0xfd, 0x05, 0xc0, 0xc9, 0x26, 0xc5, 0x2f, 0x13, 0x3f, 0x66, 0x5a, 0x1d, 0x96, 0x71,
+	0xf6, 0x80, 0x12, 0x1d, 0x58, 0xa0, 0x36, 0xd5, 0xd0, 0xbf, 0xed, 0x2f, 0xa9, 0x33, 0xae, 0x06,
+	0x41, 0x82, 0x28, 0x7d, 0xcb, 0x92, 0x30, 0xc6, 0x9e, 0x22, 0x9e, 0xd6, 0x20, 0x7d, 0xec, 0x7a,
+	0x35, 0x48, 0x5b, 0x81, 0xe3, 0xfc, 0x51, 0x2f, 0x5a, 0xa0, 0x56, 0xa9, 0xcf, 0x3b, 0xa3, 0x6c,
+	0x74, 0xb8, 0xa6, 0x46, 0xe9, 0xe0, 0x7b, 0xb5, 0xe0, 0x09, 0xbe, 0xdd, 0x87, 0xff, 0x37, 0x29,
+	0x5e, 0x1b, 0xa0, 0x8e, 0x87, 0x68, 0x8f, 0xc4, 0x14, 0x69, 0x3a, 0x2c, 0x27, 0x88, 0xa6, 0x5d,
+	0x46, 0x75, 0x60, 0x15, 0x6b, 0xd3, 0x9e, 0x5a, 0x6a, 0xeb, 0xb0, 0x12, 0x51, 0xdc, 0x52, 0xe8,
+	0x98, 0x55, 0xac, 0x55, 0xea, 0x8b, 0xa3, 0xdf, 0x75, 0xda, 0x35, 0xed, 0xaa, 0x77, 0xc2, 0x88,
+	0x62, 0xb1, 0x41, 0xed, 0xcf, 0x00, 0xce, 0x9c, 0xe3, 0x68, 0x2b, 0x70, 0x5a, 0x76, 0xe7, 0x3a,
+	0xb8, 0x61, 0x95, 0xfa, 0xac, 0x23, 0xf2, 0x72, 0x54, 0x5e, 0xce, 0x6a, 0x3c, 0xf4, 0x2a, 0xa2,
+	0x93, 0x10, 0xfc, 0x02, 0x4e, 0xa0, 0x1d, 0x14, 0xe7, 0x8a, 0xee, 0x39, 0xa7, 0xf9, 0x38, 0x59,
+	0x3e, 0xce, 0x5a, 0x06, 0x4b, 0x11, 0x92, 0xab, 0xcd, 0xc1, 0x49, 0xec, 0xd3, 0x56, 0x4a, 0x51,
+	0xc0, 0x5d, 0x2b, 0x79, 0x65, 0xec, 0xd3, 0x4d, 0x8a, 0x02, 0xfb, 0x23, 0x80, 0x65, 0xa9, 0xed,
+	0x6c, 0x1a, 0xe0, 0xba, 0x69, 0xac, 0xc3, 0x52, 0x44, 0xb1, 0x92, 0x33, 0xf2, 0x04, 0x0d, 0xeb,
+	0xeb, 0xfe, 0xd2, 0x03, 0x1a, 0x6c, 0x67, 0x46, 0x3d, 0xb3, 0x84, 0x77, 0xab, 0x29, 0xdb, 0x22,
+	0x49, 0xb8, 0xeb, 0xb3, 0x90, 0xc4, 0x1e, 0xef, 0x61, 0x6b, 0xf0, 0x96, 0x9a, 0x26, 0x75, 0x60,
+	0xfb, 0x13, 0x80, 0x53, 0xcd, 0xcc, 0x80, 0x1d, 0xb2, 0x8d, 0x6e, 0x6c, 0xc6, 0x2c, 0x91, 0x4f,
+	0x36, 0xeb, 0xad, 0x34, 0xe9, 0x72, 0xd3, 0xa6, 0x78, 0xa6, 0x1b, 0xc3, 0x1e, 0xda, 0x4c, 0xba,
+	0xf6, 0x1d, 0x78, 0x3b, 0x97, 0x95, 0x8b, 0xfd, 0x2d, 0x82, 0xf6, 0x50, 0x8c, 0xfa, 0x37, 0x7b,
+	0x29, 0xae, 0x14, 0xac, 0xbd, 0x82, 0xff, 0xc5, 0xa8, 0xdf, 0x42, 0x83, 0x5e, 0x98, 0x70, 0xd3,
+	0xf5, 0x12, 0x1f, 0x3a, 0xe3, 0x9f, 0xc8, 0x36, 0xd4, 0x47, 0xa2, 0x31, 0x99, 0x4d, 0xd1, 0xde,
+	0x8f, 0x2a, 0xf0, 0x66, 0x62, 0xd4, 0x5f, 0xcb, 0x4b, 0xed, 0xfb, 0xf0, 0xee, 0xb9, 0x73, 0x2a,
+	0x07, 0xea, 0x47, 0x63, 0xb0, 0xd8, 0xa4, 0x58, 0x7b, 0x03, 0xc7, 0x85, 0x01, 0xe6, 0x85, 0x57,
+	0x86, 0xe3, 0xc6, 0xe3, 0xcb, 0xf1, 0x7c, 0xf0, 0x5f, 0xc3, 0x12, 0x9f, 0xd1, 0x85, 0x4b, 0xaf,
+	0xa0, 0xf1, 0xe8, 0xaa, 0x1b, 0x2a, 0xba, 0x79, 0x70, 0x42, 0x4e, 0x54, 0xf5, 0xc2, 0x02, 0x41,
+	0x30, 0x9e, 0x5c, 0x41, 0xc8, 0x7b, 0xbe, 0x87, 0xf0, 0x4c, 0xf0, 0x8b, 0x97, 0x94, 0x29, 0x92,
+	0xf1, 0xf4, 0x1a, 0x24, 0xd5, 0xbf, 0xd1, 0x38, 0xf8, 0x65, 0x16, 0x0e, 0x8e, 0x4d, 0x70, 0x78,
+	0x6c, 0x82, 0x9f, 0xc7, 0x26, 0xd8, 0x3b, 0x31, 0x0b, 0x87, 0x27, 0x66, 0xe1, 0xe8, 0xc4, 0x2c,
+	0xbc, 0x7b, 0x88, 0x43, 0xb6, 0x95, 0xb6, 0x9d, 0x0e, 0x89, 0xe4, 0x9f, 0x86, 0xfc, 0x59, 0xa2,
+	0xc1, 0xb6, 0x3b, 0x10, 0xdf, 0xf4, 0xf6, 0x04, 0x0f, 0xf9, 0xf9, 0x9f, 0x01, 0x00, 0x6b, 0x5b,
+	0x70, 0x0d, 0x9a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -342,6 +488,10 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// RenewGrant extends the expiration of the grant corresponding to the
+	// provided method name on the granter's account. The authorization of the
+	// grant, with its remaining limits, is kept.
+	RenewGrant(ctx context.Context, in *MsgRenewGrant, opts ...grpc.CallOption) (*MsgRenewGrantResponse, error)
 }
 
 type msgClient struct {
@@ -379,6 +529,15 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RenewGrant(ctx context.Context, in *MsgRenewGrant, opts ...grpc.CallOption) (*MsgRenewGrantResponse, error) {
+	out := new(MsgRenewGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RenewGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -393,6 +552,10 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// RenewGrant extends the expiration of the grant corresponding to the
+	// provided method name on the granter's account. The authorization of the
+	// grant, with its remaining limits, is kept.
+	RenewGrant(context.Context, *MsgRenewGrant) (*MsgRenewGrantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -408,6 +571,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) RenewGrant(ctx context.Context, req *MsgRenewGrant) (*MsgRenewGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewGrant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -467,6 +633,24 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RenewGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RenewGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewGrant(ctx, req.(*MsgRenewGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -483,6 +667,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "RenewGrant",
+			Handler:    _Msg_RenewGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -555,6 +743,20 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Results) > 0 {
 		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Results[iNdEx])
@@ -567,6 +769,60 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExecResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MsgResponse != nil {
+		{
+			size, err := m.MsgResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -701,6 +957,81 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRenewGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NewExpiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NewExpiration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -743,6 +1074,34 @@ func (m *MsgExecResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgResponse != nil {
+		l = m.MsgResponse.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -804,6 +1163,38 @@ func (m *MsgRevokeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRenewGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NewExpiration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRenewGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1018,6 +1409,179 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
 			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, MsgExecResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MsgResponse == nil {
+				m.MsgResponse = &types.Any{}
+			}
+			if err := m.MsgResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1401,6 +1965,235 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRenewGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExpiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NewExpiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -259,6 +259,20 @@ func TestMsgMultiSendMultipleInOut(t *testing.T) {
 
 	testCases := []appTestCase{
 		{
+			desc:       "every input must sign",
+			msgs:       []sdk.Msg{multiSendMsg3},
+			accNums:    []uint64{0},
+			accSeqs:    []uint64{0},
+			expSimPass: false,
+			expPass:    false,
+			privKeys:   []cryptotypes.PrivKey{priv1},
+			expectedBalances: []expectedBalance{
+				{addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 42)}},
+				{addr4, sdk.Coins{sdk.NewInt64Coin("foocoin", 42)}},
+			},
+		},
+		{
+			desc:       "make a valid tx with multiple inputs",
 			msgs:       []sdk.Msg{multiSendMsg3},
 			accNums:    []uint64{0, 2},
 			accSeqs:    []uint64{0, 0},
@@ -278,7 +292,11 @@ func TestMsgMultiSendMultipleInOut(t *testing.T) {
 		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
 		txGen := simapp.MakeTestEncodingConfig().TxConfig
 		_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, tc.msgs, "", tc.accNums, tc.accSeqs, tc.expSimPass, tc.expPass, tc.privKeys...)
-		require.NoError(t, err)
+		if tc.expPass {
+			require.NoError(t, err, tc.desc)
+		} else {
+			require.Error(t, err, tc.desc)
+		}
 
 		for _, eb := range tc.expectedBalances {
 			simapp.CheckBalance(t, app, eb.addr, eb.coins)
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetSpendableBalanceCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 	)
@@ -101,6 +102,51 @@ Example:
 	return cmd
 }
 
+// GetSpendableBalanceCmd defines the cobra command to query the spendable
+// balance of a single denomination for an account.
+func GetSpendableBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balance [address] [denom]",
+		Short: "Query the spendable balance of a denomination for an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of a denomination an account can currently send, along
+with the amount that is still locked by the account's vesting schedule.
+
+Example:
+  $ %s query %s spendable-balance [address] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySpendableBalanceByDenomRequest(addr, args[1])
+			res, err := queryClient.SpendableBalanceByDenom(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
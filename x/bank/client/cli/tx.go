@@ -1,12 +1,16 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -20,7 +24,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand(NewSendTxCmd())
+	txCmd.AddCommand(
+		NewSendTxCmd(),
+		NewSetDenomMetadataTxCmd(),
+	)
 
 	return txCmd
 }
@@ -31,6 +38,10 @@ func NewSendTxCmd() *cobra.Command {
 		Use: "send [from_key_or_address] [to_address] [amount]",
 		Short: `Send funds from one account to another. Note, the'--from' flag is
 ignored as it is implied from [from_key_or_address].`,
+		Long: `Send funds from one account to another. Note, the'--from' flag is
+ignored as it is implied from [from_key_or_address].
+The amount may be given in a display denom of the denom metadata of the node,
+e.g. 1.5atom, which is converted to its base denom, unless --exact-denoms is set.`,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.Flags().Set(flags.FlagFrom, args[0])
@@ -43,7 +54,7 @@ ignored as it is implied from [from_key_or_address].`,
 				return err
 			}
 
-			coins, err := sdk.ParseCoinsNormalized(args[2])
+			coins, err := tx.ParseCoinsDisplay(clientCtx, args[2])
 			if err != nil {
 				return err
 			}
@@ -58,3 +69,55 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// NewSetDenomMetadataTxCmd returns a CLI command handler for creating a
+// MsgSetDenomMetadata transaction.
+func NewSetDenomMetadataTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [signer_key_or_address] [metadata_file]",
+		Short: "Set the metadata of a denom as its admin",
+		Long: `Set the metadata of a denom. The signer must be the admin of the metadata
+base denom. Note, the '--from' flag is ignored as it is implied from [signer_key_or_address].
+
+Where metadata.json contains:
+
+{
+  "description": "The native staking token of the Cosmos Hub.",
+  "denom_units": [
+    {"denom": "uatom", "exponent": 0, "aliases": ["microatom"]},
+    {"denom": "atom", "exponent": 6}
+  ],
+  "base": "uatom",
+  "display": "atom",
+  "name": "Cosmos Hub Atom",
+  "symbol": "ATOM"
+}`,
+		Example: fmt.Sprintf("$ %s tx %s set-denom-metadata [signer_key_or_address] metadata.json", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var metadata types.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress(), metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,6 +8,9 @@ import (
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -163,6 +166,53 @@ func (s *IntegrationTestSuite) TestGetBalancesCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetSpendableBalanceCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{"no denom provided", []string{val.Address.String()}, true, nil, nil},
+		{"invalid denom", []string{val.Address.String(), "1foo"}, true, nil, nil},
+		{
+			"spendable balance of a specific denom",
+			[]string{
+				val.Address.String(),
+				s.cfg.BondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&types.QuerySpendableBalanceByDenomResponse{},
+			&types.QuerySpendableBalanceByDenomResponse{
+				Balance: NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				Locked:  NewCoin(s.cfg.BondDenom, sdk.ZeroInt()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetSpendableBalanceCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	val := s.network.Validators[0]
 
@@ -387,6 +437,108 @@ func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	s.Require().Equal([]sdk.Msg{types.NewMsgSend(from, to, amount)}, tx.GetMsgs())
 }
 
+func (s *IntegrationTestSuite) TestNewSendTxCmdDisplayDenoms() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		amount    fmt.Stringer
+		args      []string
+		expAmount sdk.Coins
+		expFees   sdk.Coins
+		expErr    string
+	}{
+		{
+			"display denoms",
+			sdk.NewDecCoinFromDec("atom", sdk.MustNewDecFromStr("1.5")),
+			[]string{fmt.Sprintf("--%s=0.00001atom", flags.FlagFees)},
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)),
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+			"",
+		},
+		{
+			"display denom alias and base denom without metadata",
+			sdk.NewCoins(sdk.NewInt64Coin("ATOM", 2)),
+			[]string{fmt.Sprintf("--%s=10%s", flags.FlagFees, s.cfg.BondDenom)},
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000000)),
+			sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)),
+			"",
+		},
+		{
+			"exact denoms",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			[]string{fmt.Sprintf("--%s=1atom", flags.FlagFees), fmt.Sprintf("--%s", flags.FlagExactDenoms)},
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			"",
+		},
+		{
+			"precision overflow",
+			sdk.NewDecCoinFromDec("atom", sdk.MustNewDecFromStr("1.0000005")),
+			nil,
+			nil,
+			nil,
+			"which is not a whole amount of uatom",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			args := append([]string{fmt.Sprintf("--%s=true", flags.FlagGenerateOnly)}, tc.args...)
+			bz, err := MsgSendExec(val.ClientCtx, val.Address, val.Address, tc.amount, args...)
+			if tc.expErr != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := s.cfg.TxConfig.TxJSONDecoder()(bz.Bytes())
+			s.Require().NoError(err)
+			s.Require().Equal([]sdk.Msg{types.NewMsgSend(val.Address, val.Address, tc.expAmount)}, tx.GetMsgs())
+			s.Require().Equal(tc.expFees, tx.(sdk.FeeTx).GetFee())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewSetDenomMetadataTxCmdGenOnly() {
+	val := s.network.Validators[0]
+
+	metadata := types.Metadata{
+		Name:        "Foo Token",
+		Symbol:      "FOO",
+		Description: "A token used for testing.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "ufoo", Exponent: 0},
+			{Denom: "foo", Exponent: 6},
+		},
+		Base:    "ufoo",
+		Display: "foo",
+	}
+	bz, err := val.ClientCtx.Codec.MarshalJSON(&metadata)
+	s.Require().NoError(err)
+	metadataFile := testutil.WriteToNewTempFile(s.T(), string(bz))
+
+	args := []string{
+		val.Address.String(),
+		metadataFile.Name(),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewSetDenomMetadataTxCmd(), args)
+	s.Require().NoError(err)
+	tx, err := s.cfg.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Msg{types.NewMsgSetDenomMetadata(val.Address, metadata)}, tx.GetMsgs())
+
+	// invalid metadata file
+	args[1] = testutil.WriteToNewTempFile(s.T(), "{").Name()
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewSetDenomMetadataTxCmd(), args)
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	val := s.network.Validators[0]
 
@@ -471,6 +623,89 @@ func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewSendTxCmdSecp256r1() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	k, _, err := clientCtx.Keyring.NewMnemonic("secp256r1", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256r1)
+	s.Require().NoError(err)
+	addr, err := k.GetAddress()
+	s.Require().NoError(err)
+
+	fees := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()
+	args := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, fees),
+	}
+
+	// fund the secp256r1 account
+	bz, err := MsgSendExec(clientCtx, val.Address, addr, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000))), args...)
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &txResp), bz.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+
+	// send from the secp256r1 account, signed in SIGN_MODE_DIRECT
+	bz, err = MsgSendExec(clientCtx, addr, val.Address, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))), args...)
+	s.Require().NoError(err)
+	txResp = sdk.TxResponse{}
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &txResp), bz.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+
+	bz, err = QueryBalancesExec(clientCtx, addr)
+	s.Require().NoError(err)
+	var balances types.QueryAllBalancesResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &balances))
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(890))), balances.Balances)
+}
+
+// TestNewSendTxCmdKeyringDir signs a send with a key of a keyring in a
+// directory different from the home directory of the validator.
+func (s *IntegrationTestSuite) TestNewSendTxCmdKeyringDir() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	keyringDir := s.T().TempDir()
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, keyringDir, nil, clientCtx.Codec)
+	s.Require().NoError(err)
+	k, _, err := kr.NewMnemonic("keyringDirKey", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	addr, err := k.GetAddress()
+	s.Require().NoError(err)
+
+	fees := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()
+	args := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, fees),
+	}
+
+	// fund the account of the keyring
+	bz, err := MsgSendExec(clientCtx, val.Address, addr, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000))), args...)
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &txResp), bz.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+
+	// the key is not in the keyring of the home directory
+	_, err = MsgSendExec(clientCtx, addr, val.Address, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))), args...)
+	s.Require().Error(err)
+
+	bz, err = MsgSendExec(clientCtx, addr, val.Address, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))),
+		append(args, fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, keyringDir))...)
+	s.Require().NoError(err)
+	txResp = sdk.TxResponse{}
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &txResp), bz.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+
+	bz, err = QueryBalancesExec(clientCtx, addr)
+	s.Require().NoError(err)
+	var balances types.QueryAllBalancesResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &balances))
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(890))), balances.Balances)
+}
+
 func NewCoin(denom string, amount sdk.Int) *sdk.Coin {
 	coin := sdk.NewCoin(denom, amount)
 	return &coin
@@ -39,6 +39,24 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, blocked := range genState.BlockedAddresses {
+		addr, err := sdk.AccAddressFromBech32(blocked)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetBlockedAddr(ctx, addr)
+	}
+
+	for _, admin := range genState.DenomMetadataAdmins {
+		addr, err := sdk.AccAddressFromBech32(admin.Admin)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetDenomMetadataAdmin(ctx, admin.Denom, addr)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -48,10 +66,25 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
+
+	k.IterateBlockedAddrs(ctx, func(addr sdk.AccAddress) bool {
+		genState.BlockedAddresses = append(genState.BlockedAddresses, addr.String())
+		return false
+	})
+
+	k.IterateDenomMetadataAdmins(ctx, func(denom string, admin sdk.AccAddress) bool {
+		genState.DenomMetadataAdmins = append(genState.DenomMetadataAdmins, types.DenomMetadataAdmin{
+			Denom: denom,
+			Admin: admin.String(),
+		})
+		return false
+	})
+
+	return genState
 }
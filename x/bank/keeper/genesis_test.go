@@ -43,6 +43,19 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
 
+func (suite *IntegrationTestSuite) TestBlockedAddressesGenesis() {
+	app, ctx := suite.app, suite.ctx
+	blocked := sdk.AccAddress([]byte("blocked_____________"))
+
+	g := types.DefaultGenesisState()
+	g.BlockedAddresses = []string{blocked.String()}
+	app.BankKeeper.InitGenesis(ctx, g)
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, blocked))
+
+	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]string{blocked.String()}, exportGenesis.BlockedAddresses)
+}
+
 func (suite *IntegrationTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
 	addr2, _ := sdk.AccAddressFromBech32("cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0")
 	addr1, _ := sdk.AccAddressFromBech32("cosmos1t5u0jfg3ljsjrh2m9e47d4ny2hea7eehxrzdgd")
@@ -124,3 +137,19 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestDenomMetadataAdminsGenesis() {
+	app, ctx := suite.app, suite.ctx
+	admin := sdk.AccAddress([]byte("admin_______________"))
+
+	g := types.DefaultGenesisState()
+	g.DenomMetadataAdmins = []types.DenomMetadataAdmin{{Denom: "ufoo", Admin: admin.String()}}
+	app.BankKeeper.InitGenesis(ctx, g)
+
+	actual, found := app.BankKeeper.GetDenomMetadataAdmin(ctx, "ufoo")
+	suite.Require().True(found)
+	suite.Require().Equal(admin, actual)
+
+	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(g.DenomMetadataAdmins, exportGenesis.DenomMetadataAdmins)
+}
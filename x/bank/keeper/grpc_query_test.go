@@ -3,11 +3,17 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
+	tmtime "github.com/tendermint/tendermint/libs/time"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -86,6 +92,124 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+// setupPartiallyDelegatedVestingAccount creates a periodic vesting account
+// holding 100stake (half of it vested after 12 hours) and 20foo, and delegates
+// 30stake of its still vesting coins. It returns a context 12 hours after the
+// vesting start time along with a query client bound to that context.
+func (suite *IntegrationTestSuite) setupPartiallyDelegatedVestingAccount(addr sdk.AccAddress) (sdk.Context, types.QueryClient) {
+	app := suite.app
+	now := tmtime.Now()
+	ctx := suite.ctx.WithBlockHeader(tmproto.Header{Time: now})
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	periods := vesting.Periods{
+		vesting.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 50)}},
+		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 25)}},
+		vesting.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin("stake", 25)}},
+	}
+
+	addrModule := sdk.AccAddress([]byte("moduleAcc___________"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrModule))
+
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	vacc := vesting.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, origCoins.Add(newFooCoin(20))))
+
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr, addrModule, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+
+	return ctx, types.NewQueryClient(queryHelper)
+}
+
+func (suite *IntegrationTestSuite) TestQuerySpendableBalances() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, err := suite.queryClient.SpendableBalances(gocontext.Background(), &types.QuerySpendableBalancesRequest{})
+	suite.Require().Error(err)
+
+	ctx, queryClient := suite.setupPartiallyDelegatedVestingAccount(addr)
+
+	// 70stake remain after delegating, of which 50 - 30 are still locked by the
+	// vesting schedule
+	res, err := queryClient.SpendableBalances(gocontext.Background(), &types.QuerySpendableBalancesRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20), sdk.NewInt64Coin("stake", 50)), res.Balances)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), res.Locked)
+	suite.Require().Equal(suite.app.BankKeeper.SpendableCoins(ctx, addr), res.Balances)
+
+	pageReq := &query.PageRequest{Limit: 1}
+	res, err = queryClient.SpendableBalances(gocontext.Background(), &types.QuerySpendableBalancesRequest{Address: addr.String(), Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), res.Balances)
+	suite.Require().Empty(res.Locked)
+	suite.Require().NotNil(res.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQuerySpendableBalanceByDenom() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	_, err := suite.queryClient.SpendableBalanceByDenom(gocontext.Background(), &types.QuerySpendableBalanceByDenomRequest{})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.SpendableBalanceByDenom(gocontext.Background(), &types.QuerySpendableBalanceByDenomRequest{Address: addr.String()})
+	suite.Require().Error(err)
+
+	ctx, queryClient := suite.setupPartiallyDelegatedVestingAccount(addr)
+
+	res, err := queryClient.SpendableBalanceByDenom(gocontext.Background(), types.NewQuerySpendableBalanceByDenomRequest(addr, "stake"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 50), *res.Balance)
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 20), *res.Locked)
+	suite.Require().Equal(*res.Balance, suite.app.BankKeeper.SpendableCoin(ctx, addr, "stake"))
+
+	// the reported amount is exactly what a send allows
+	cacheCtx, _ := ctx.CacheContext()
+	suite.Require().Error(suite.app.BankKeeper.SendCoins(cacheCtx, addr, addr2, sdk.NewCoins(res.Balance.AddAmount(sdk.OneInt()))))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(*res.Balance)))
+
+	res, err = queryClient.SpendableBalanceByDenom(gocontext.Background(), types.NewQuerySpendableBalanceByDenomRequest(addr, "stake"))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Balance.IsZero())
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 20), *res.Locked)
+
+	res, err = queryClient.SpendableBalanceByDenom(gocontext.Background(), types.NewQuerySpendableBalanceByDenomRequest(addr, fooDenom))
+	suite.Require().NoError(err)
+	suite.Require().Equal(newFooCoin(20), *res.Balance)
+	suite.Require().True(res.Locked.IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestQueryBlockedAddresses() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+		sdk.AccAddress([]byte("addr3_______________")),
+	}
+	for _, addr := range addrs {
+		app.BankKeeper.SetBlockedAddr(ctx, addr)
+	}
+
+	res, err := queryClient.BlockedAddresses(gocontext.Background(), &types.QueryBlockedAddressesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Addresses, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().Contains(res.StaticAddresses, authtypes.NewModuleAddress(authtypes.FeeCollectorName).String())
+
+	res, err = queryClient.BlockedAddresses(gocontext.Background(), &types.QueryBlockedAddressesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Addresses, 1)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
@@ -33,6 +33,10 @@ type Keeper interface {
 	HasDenomMetaData(ctx sdk.Context, denom string) bool
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
 	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
+	GetDenomMetadataAdmin(ctx sdk.Context, denom string) (sdk.AccAddress, bool)
+	SetDenomMetadataAdmin(ctx sdk.Context, denom string, admin sdk.AccAddress)
+	RemoveDenomMetadataAdmin(ctx sdk.Context, denom string)
+	IterateDenomMetadataAdmins(ctx sdk.Context, cb func(denom string, admin sdk.AccAddress) bool)
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -88,13 +92,15 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 // store and fetch module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. The authority is the address
+// allowed to extend that set on-chain with MsgSetBlockedAddress.
 func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ak types.AccountKeeper,
 	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
 
 	// set KeyTable if it has not already been set
@@ -103,7 +109,7 @@ func NewBaseKeeper(
 	}
 
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs, authority),
 		ak:             ak,
 		cdc:            cdc,
 		storeKey:       storeKey,
@@ -111,6 +117,15 @@ func NewBaseKeeper(
 	}
 }
 
+// WithModuleEventAttribute returns a copy of the keeper which, when enabled,
+// adds a module attribute holding the module account name to the coin_spent
+// and coin_received events of module accounts. It is disabled by default as
+// the extra attribute changes the events seen by existing indexers.
+func (k BaseKeeper) WithModuleEventAttribute(enabled bool) BaseKeeper {
+	k.moduleEventAttr = enabled
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -148,7 +163,7 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 	}
 	// emit coin spent event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinSpentEvent(delegatorAddr, amt),
+		k.withModuleAttribute(ctx, delegatorAddr, types.NewCoinSpentEvent(delegatorAddr, amt)),
 	)
 
 	err := k.addCoins(ctx, moduleAccAddr, amt)
@@ -287,9 +302,56 @@ func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metada
 	denomMetaDataStore.Set([]byte(denomMetaData.Base), m)
 }
 
+// GetDenomMetadataAdmin returns the address allowed to set the metadata of the
+// given base denom and true if an admin is assigned, false otherwise.
+func (k BaseKeeper) GetDenomMetadataAdmin(ctx sdk.Context, denom string) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataAdminPrefix)
+
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetDenomMetadataAdmin assigns the address allowed to set the metadata of the
+// given base denom, replacing any existing admin.
+func (k BaseKeeper) SetDenomMetadataAdmin(ctx sdk.Context, denom string, admin sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataAdminPrefix)
+	store.Set([]byte(denom), admin)
+}
+
+// RemoveDenomMetadataAdmin removes the admin of the given base denom, if any.
+func (k BaseKeeper) RemoveDenomMetadataAdmin(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataAdminPrefix)
+	store.Delete([]byte(denom))
+}
+
+// IterateDenomMetadataAdmins iterates over all the denom metadata admins and
+// provides the base denom and admin to a callback. If true is returned from the
+// callback, iteration is halted.
+func (k BaseKeeper) IterateDenomMetadataAdmins(ctx sdk.Context, cb func(denom string, admin sdk.AccAddress) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataAdminPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // It will panic if the module account does not exist. An error is returned if
 // the recipient address is black-listed or if sending the tokens fails.
+//
+// Only the addresses blocked at construction time are rejected: the on-chain
+// blocked address set does not apply to the funds owed by modules, e.g. the
+// rewards and the commission withdrawn from x/distribution, so that a blocked
+// delegator can still undelegate, redelegate and withdraw its rewards.
 func (k BaseKeeper) SendCoinsFromModuleToAccount(
 	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
 ) error {
@@ -299,7 +361,7 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
 	}
 
-	if k.BlockedAddr(recipientAddr) {
+	if k.blockedAddrs[recipientAddr.String()] {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
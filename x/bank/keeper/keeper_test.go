@@ -6,20 +6,25 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	tmtime "github.com/tendermint/tendermint/libs/time"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -87,7 +92,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, authtypes.NewModuleAddress("gov").String(),
 	)
 
 	return authKeeper, keeper
@@ -157,6 +162,74 @@ func (suite *IntegrationTestSuite) TestSendCoinsFromModuleToAccount_Blocklist()
 	))
 }
 
+func (suite *IntegrationTestSuite) TestBlockedAddrs() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := authtypes.NewModuleAddress("gov")
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	staticAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, staticAddr))
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr2))
+
+	// only the authority may manage the on-chain set
+	_, err := msgServer.SetBlockedAddress(sdk.WrapSDKContext(ctx), types.NewMsgSetBlockedAddress(addr1, addr2))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr2))
+
+	_, err = msgServer.SetBlockedAddress(sdk.WrapSDKContext(ctx), types.NewMsgSetBlockedAddress(authority, addr2))
+	suite.Require().NoError(err)
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, addr2))
+
+	// user sends to a blocked address are rejected
+	coins := sdk.NewCoins(newFooCoin(100))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, coins))
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), types.NewMsgSend(addr1, addr2, coins))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), &types.MsgMultiSend{
+		Inputs:  []types.Input{types.NewInput(addr1, coins)},
+		Outputs: []types.Output{types.NewOutput(addr2, coins)},
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the IBC transfers bypass the on-chain blocked address set: the escrow
+	// and the unescrow of the tokens are internal transfers, and the vouchers
+	// are minted then sent from the transfer module
+	escrowAddr := authtypes.NewModuleAddress("escrow")
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, escrowAddr, coins))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, escrowAddr, addr2, coins))
+	suite.Require().Equal(coins, app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, coins))
+	suite.Require().Equal(coins.Add(coins...), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// while the statically blocked addresses cannot receive them
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().Error(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, staticAddr, coins))
+
+	// statically blocked addresses cannot be removed
+	_, err = msgServer.RemoveBlockedAddress(sdk.WrapSDKContext(ctx), types.NewMsgRemoveBlockedAddress(authority, staticAddr))
+	suite.Require().ErrorIs(err, types.ErrBlockedAddrNotFound)
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, staticAddr))
+
+	_, err = msgServer.RemoveBlockedAddress(sdk.WrapSDKContext(ctx), types.NewMsgRemoveBlockedAddress(addr1, addr2))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.RemoveBlockedAddress(sdk.WrapSDKContext(ctx), types.NewMsgRemoveBlockedAddress(authority, addr2))
+	suite.Require().NoError(err)
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr2))
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), types.NewMsgSend(addr2, addr1, coins))
+	suite.Require().NoError(err)
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), types.NewMsgSend(addr1, addr2, coins))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.RemoveBlockedAddress(sdk.WrapSDKContext(ctx), types.NewMsgRemoveBlockedAddress(authority, addr2))
+	suite.Require().ErrorIs(err, types.ErrBlockedAddrNotFound)
+}
+
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	ctx := suite.ctx
 
@@ -385,9 +458,13 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	acc3 := app.AccountKeeper.NewAccountWithAddress(ctx, addr3)
 	app.AccountKeeper.SetAccount(ctx, acc3)
 
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+	acc4 := app.AccountKeeper.NewAccountWithAddress(ctx, addr4)
+	app.AccountKeeper.SetAccount(ctx, acc4)
+
 	inputs := []types.Input{
 		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
-		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+		{Address: addr4.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
 	}
 	outputs := []types.Output{
 		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
@@ -398,10 +475,11 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr4, sdk.NewCoins(newFooCoin(30), newBarCoin(10))))
 
 	insufficientInputs := []types.Input{
 		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(300), newBarCoin(100))},
-		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(300), newBarCoin(100))},
+		{Address: addr4.String(), Coins: sdk.NewCoins(newFooCoin(300), newBarCoin(100))},
 	}
 	insufficientOutputs := []types.Output{
 		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(300), newBarCoin(100))},
@@ -411,9 +489,12 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	acc1Balances := app.BankKeeper.GetAllBalances(ctx, addr1)
-	expected := sdk.NewCoins(newFooCoin(30), newBarCoin(10))
-	suite.Require().Equal(expected, acc1Balances)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(20)), acc1Balances)
+
+	acc4Balances := app.BankKeeper.GetAllBalances(ctx, addr4)
+	suite.Require().True(acc4Balances.IsZero())
 
+	expected := sdk.NewCoins(newFooCoin(30), newBarCoin(10))
 	acc2Balances := app.BankKeeper.GetAllBalances(ctx, addr2)
 	suite.Require().Equal(expected, acc2Balances)
 
@@ -421,6 +502,61 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	suite.Require().Equal(expected, acc3Balances)
 }
 
+func (suite *IntegrationTestSuite) TestInputOutputCoinsValidation() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(90), newBarCoin(30))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, balances))
+
+	coins := sdk.NewCoins(newFooCoin(30), newBarCoin(10))
+
+	// the same address cannot be used by more than one input
+	duplicateInputs := []types.Input{
+		{Address: addr1.String(), Coins: coins},
+		{Address: addr1.String(), Coins: coins},
+	}
+	outputs := []types.Output{
+		{Address: addr3.String(), Coins: coins},
+		{Address: addr4.String(), Coins: coins},
+	}
+	err := app.BankKeeper.InputOutputCoins(ctx, duplicateInputs, outputs)
+	suite.Require().ErrorIs(err, types.ErrDuplicateInput)
+
+	// totals must match per denom, not only in aggregate
+	inputs := []types.Input{
+		{Address: addr1.String(), Coins: coins},
+		{Address: addr2.String(), Coins: coins},
+	}
+	mismatchedOutputs := []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(31), newBarCoin(9))},
+		{Address: addr4.String(), Coins: coins},
+	}
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, mismatchedOutputs)
+	suite.Require().ErrorIs(err, types.ErrInputOutputMismatch)
+
+	// the number of inputs and outputs is bounded by the params
+	params := app.BankKeeper.GetParams(ctx)
+	params.MaxMultiSendEntries = 3
+	app.BankKeeper.SetParams(ctx, params)
+
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().ErrorIs(err, types.ErrTooManyEntries)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	params.MaxMultiSendEntries = 4
+	app.BankKeeper.SetParams(ctx, params)
+
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(coins, app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Equal(coins, app.BankKeeper.GetAllBalances(ctx, addr4))
+}
+
 func (suite *IntegrationTestSuite) TestSendCoins() {
 	app, ctx := suite.app, suite.ctx
 	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
@@ -1016,6 +1152,58 @@ func (suite *IntegrationTestSuite) TestSetDenomMetaData() {
 	suite.Require().Equal(metadata[1].GetDenomUnits()[1].GetAliases(), actualMetadata.GetDenomUnits()[1].GetAliases())
 }
 
+func (suite *IntegrationTestSuite) TestMsgSetDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := authtypes.NewModuleAddress("gov")
+	admin := sdk.AccAddress([]byte("admin_______________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+
+	metadata := suite.getTestMetadata()[0]
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// the authority can always set metadata
+	_, err := msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(authority, metadata))
+	suite.Require().NoError(err)
+	actual, found := app.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, actual)
+
+	// nobody else can until an admin is assigned
+	metadata.Description = "updated by the admin"
+	_, err = msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenomAdmin)
+
+	// only the authority can assign an admin
+	_, err = msgServer.SetDenomMetadataAdmin(goCtx, types.NewMsgSetDenomMetadataAdmin(admin, metadata.Base, admin))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.SetDenomMetadataAdmin(goCtx, types.NewMsgSetDenomMetadataAdmin(authority, metadata.Base, admin))
+	suite.Require().NoError(err)
+	actualAdmin, found := app.BankKeeper.GetDenomMetadataAdmin(ctx, metadata.Base)
+	suite.Require().True(found)
+	suite.Require().Equal(admin, actualAdmin)
+
+	_, err = msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().NoError(err)
+	actual, _ = app.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+	suite.Require().Equal("updated by the admin", actual.Description)
+
+	// the admin is scoped to its denom
+	_, err = msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(admin, suite.getTestMetadata()[1]))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenomAdmin)
+	_, err = msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(other, metadata))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenomAdmin)
+
+	// removing the admin revokes its access
+	_, err = msgServer.SetDenomMetadataAdmin(goCtx, types.NewMsgSetDenomMetadataAdmin(authority, metadata.Base, nil))
+	suite.Require().NoError(err)
+	_, found = app.BankKeeper.GetDenomMetadataAdmin(ctx, metadata.Base)
+	suite.Require().False(found)
+	_, err = msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenomAdmin)
+}
+
 func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
 	app, ctx := suite.app, suite.ctx
 
@@ -1041,6 +1229,83 @@ func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
 	}
 }
 
+// appOptions is a map of app options passed to the app constructor.
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+func (suite *IntegrationTestSuite) TestModuleEventAttributes() {
+	app := simapp.NewSimappWithCustomOptions(suite.T(), false, simapp.SetupOptions{
+		Logger:         log.NewNopLogger(),
+		DB:             dbm.NewMemDB(),
+		InvCheckPeriod: 0,
+		HomePath:       simapp.DefaultNodeHome,
+		EncConfig:      simapp.MakeTestEncodingConfig(),
+		AppOpts:        appOptions{bank.FlagModuleEventAttribute: true},
+	})
+
+	// inflation is minted to x/mint, moved to the fee collector and then
+	// allocated by x/distribution, which only starts from the second block
+	var events []abci.Event
+	for i := 0; i < 2; i++ {
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+		res := app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+		events = res.Events
+	}
+
+	// collect the module attribute of every balance tracking event, keyed by
+	// event type and account
+	modules := make(map[string]string)
+	for _, e := range events {
+		if e.Type != types.EventTypeCoinSpent && e.Type != types.EventTypeCoinReceived {
+			continue
+		}
+
+		var addr, module string
+		for _, attr := range e.Attributes {
+			switch attr.Key {
+			case types.AttributeKeySpender, types.AttributeKeyReceiver:
+				addr = attr.Value
+			case sdk.AttributeKeyModule:
+				module = attr.Value
+			}
+		}
+		modules[e.Type+"/"+addr] = module
+	}
+
+	mintAddr := authtypes.NewModuleAddress(minttypes.ModuleName).String()
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	distrAddr := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+
+	suite.Require().Equal(minttypes.ModuleName, modules[types.EventTypeCoinReceived+"/"+mintAddr])
+	suite.Require().Equal(minttypes.ModuleName, modules[types.EventTypeCoinSpent+"/"+mintAddr])
+	suite.Require().Equal(authtypes.FeeCollectorName, modules[types.EventTypeCoinReceived+"/"+feeCollectorAddr])
+	suite.Require().Equal(authtypes.FeeCollectorName, modules[types.EventTypeCoinSpent+"/"+feeCollectorAddr])
+	suite.Require().Equal(distrtypes.ModuleName, modules[types.EventTypeCoinReceived+"/"+distrAddr])
+
+	// the attribute is not added for regular accounts
+	coins := sdk.NewCoins(newFooCoin(100))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr1, coins))
+	suite.Require().Contains(ctx.EventManager().Events(), types.NewCoinReceivedEvent(addr1, coins))
+
+	// nor when the option is not set, as for the suite app
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().Equal(
+		sdk.Events{
+			types.NewCoinReceivedEvent(authtypes.NewModuleAddress(minttypes.ModuleName), coins),
+			types.NewCoinMintEvent(authtypes.NewModuleAddress(minttypes.ModuleName), coins),
+		},
+		ctx.EventManager().Events(),
+	)
+}
+
 func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	// replace account keeper and bank keeper otherwise the account keeper won't be aware of the
 	// existence of the new module account because GetModuleAccount checks for the existence via
@@ -1054,7 +1319,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress("gov").String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -39,7 +39,7 @@ func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSe
 		return nil, err
 	}
 
-	if k.BlockedAddr(to) {
+	if k.BlockedAddr(ctx, to) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
@@ -85,7 +85,7 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 		if err != nil {
 			panic(err)
 		}
-		if k.BlockedAddr(accAddr) {
+		if k.BlockedAddr(ctx, accAddr) {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", out.Address)
 		}
 	}
@@ -104,3 +104,103 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 
 	return &types.MsgMultiSendResponse{}, nil
 }
+
+func (k msgServer) SetBlockedAddress(goCtx context.Context, msg *types.MsgSetBlockedAddress) (*types.MsgSetBlockedAddressResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetBlockedAddr(ctx, addr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetBlockedAddress,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &types.MsgSetBlockedAddressResponse{}, nil
+}
+
+func (k msgServer) RemoveBlockedAddress(goCtx context.Context, msg *types.MsgRemoveBlockedAddress) (*types.MsgRemoveBlockedAddressResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.RemoveBlockedAddr(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveBlockedAddress,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &types.MsgRemoveBlockedAddressResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Signer {
+		admin, found := k.GetDenomMetadataAdmin(ctx, msg.Metadata.Base)
+		if !found || admin.String() != msg.Signer {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidDenomAdmin, "%s cannot set the metadata of %s", msg.Signer, msg.Metadata.Base)
+		}
+	}
+
+	k.SetDenomMetaData(ctx, msg.Metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Signer),
+		),
+	)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadataAdmin(goCtx context.Context, msg *types.MsgSetDenomMetadataAdmin) (*types.MsgSetDenomMetadataAdminResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Admin == "" {
+		k.RemoveDenomMetadataAdmin(ctx, msg.Denom)
+	} else {
+		admin, err := sdk.AccAddressFromBech32(msg.Admin)
+		if err != nil {
+			return nil, err
+		}
+
+		k.Keeper.SetDenomMetadataAdmin(ctx, msg.Denom, admin)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadataAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.Admin),
+		),
+	)
+
+	return &types.MsgSetDenomMetadataAdminResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -8,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -26,7 +29,12 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
-	BlockedAddr(addr sdk.AccAddress) bool
+	BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool
+	SetBlockedAddr(ctx sdk.Context, addr sdk.AccAddress)
+	RemoveBlockedAddr(ctx sdk.Context, addr sdk.AccAddress) error
+	IterateBlockedAddrs(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool))
+
+	GetAuthority() string
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -43,10 +51,16 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address capable of managing the on-chain blocked address set
+	authority string
+
+	// whether balance tracking events of module accounts carry the module name
+	moduleEventAttr bool
 }
 
 func NewBaseSendKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool, authority string,
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
@@ -56,9 +70,16 @@ func NewBaseSendKeeper(
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
 		blockedAddrs:   blockedAddrs,
+		authority:      authority,
 	}
 }
 
+// GetAuthority returns the address allowed to manage the on-chain blocked
+// address set.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -72,7 +93,9 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
+// inputs and outputs don't lineup, if an address is used by more than one
+// input, if there are more entries than the MaxMultiSendEntries param allows
+// or if any single transfer of tokens fails.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -80,6 +103,12 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	// bound the amount of work a single call can do
+	maxEntries := k.GetParams(ctx).MaxMultiSendEntries
+	if entries := uint64(len(inputs) + len(outputs)); maxEntries > 0 && entries > maxEntries {
+		return sdkerrors.Wrapf(types.ErrTooManyEntries, "got %d, max %d", entries, maxEntries)
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -182,8 +211,7 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, a
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
-		locked := sdk.NewCoin(coin.Denom, lockedCoins.AmountOf(coin.Denom))
-		spendable := balance.Sub(locked)
+		spendable, _ := spendableCoin(balance, lockedCoins)
 
 		_, hasNeg := sdk.Coins{spendable}.SafeSub(sdk.Coins{coin})
 		if hasNeg {
@@ -200,7 +228,7 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, a
 
 	// emit coin spent event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinSpentEvent(addr, amt),
+		k.withModuleAttribute(ctx, addr, types.NewCoinSpentEvent(addr, amt)),
 	)
 	return nil
 }
@@ -224,12 +252,29 @@ func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 
 	// emit coin received event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinReceivedEvent(addr, amt),
+		k.withModuleAttribute(ctx, addr, types.NewCoinReceivedEvent(addr, amt)),
 	)
 
 	return nil
 }
 
+// withModuleAttribute adds the module name to a balance tracking event if the
+// given address is a module account and module event attributes are enabled.
+func (k BaseSendKeeper) withModuleAttribute(ctx sdk.Context, addr sdk.AccAddress, event sdk.Event) sdk.Event {
+	if !k.moduleEventAttr {
+		return event
+	}
+
+	// the account lookup only serves event attribution, so it must not change
+	// the gas consumed by the caller
+	acc := k.ak.GetAccount(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), addr)
+	if macc, ok := acc.(authtypes.ModuleAccountI); ok {
+		return event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyModule, macc.GetName()))
+	}
+
+	return event
+}
+
 // initBalances sets the balance (multiple coins) for an account by address.
 // An error is returned upon failure.
 func (k BaseSendKeeper) initBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
@@ -316,8 +361,68 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
 }
 
-// BlockedAddr checks if a given address is restricted from
-// receiving funds.
-func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
-	return k.blockedAddrs[addr.String()]
+// BlockedAddr checks if a given address is restricted from receiving funds,
+// either because it was blocked when the keeper was constructed or because it
+// was added to the on-chain blocked address set. It is consulted on the send
+// paths, MsgSend and MsgMultiSend, and by the modules before they make an
+// address the recipient of future transfers, e.g. a withdraw address. The
+// transfers of modules to accounts only reject the statically blocked
+// addresses, see SendCoinsFromModuleToAccount.
+func (k BaseSendKeeper) BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.blockedAddrs[addr.String()] {
+		return true
+	}
+
+	return ctx.KVStore(k.storeKey).Has(types.CreateBlockedAddressKey(addr))
+}
+
+// SetBlockedAddr adds an address to the on-chain blocked address set.
+func (k BaseSendKeeper) SetBlockedAddr(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateBlockedAddressKey(addr), []byte{0})
+}
+
+// RemoveBlockedAddr removes an address from the on-chain blocked address set.
+// An error is returned if the address is not part of that set, which includes
+// addresses blocked at construction time as those can only be unblocked with a
+// binary change.
+func (k BaseSendKeeper) RemoveBlockedAddr(ctx sdk.Context, addr sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.CreateBlockedAddressKey(addr)
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrBlockedAddrNotFound, "%s is not in the on-chain blocked address set", addr)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// IterateBlockedAddrs iterates over the on-chain blocked address set and
+// performs a callback function on each address.
+func (k BaseSendKeeper) IterateBlockedAddrs(ctx sdk.Context, cb func(addr sdk.AccAddress) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedAddressPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length prefixed address
+		if cb(sdk.AccAddress(iterator.Key()[1:])) {
+			break
+		}
+	}
+}
+
+// getStaticBlockedAddrs returns the addresses blocked at construction time in
+// a deterministic order.
+func (k BaseSendKeeper) getStaticBlockedAddrs() []string {
+	addrs := make([]string, 0, len(k.blockedAddrs))
+	for addr, blocked := range k.blockedAddrs {
+		if blocked {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	sort.Strings(addrs)
+	return addrs
 }
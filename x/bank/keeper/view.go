@@ -27,6 +27,7 @@ type ViewKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
@@ -202,6 +203,27 @@ func (k BaseViewKeeper) spendableCoins(ctx sdk.Context, addr sdk.AccAddress) (sp
 	return
 }
 
+// SpendableCoin returns the balance of specific denomination of spendable coins
+// for an account by address. If the account has no spendable coin, a zero Coin
+// is returned.
+func (k BaseViewKeeper) SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	balance := k.GetBalance(ctx, addr, denom)
+	spendable, _ := spendableCoin(balance, k.LockedCoins(ctx, addr))
+	return spendable
+}
+
+// spendableCoin splits a single denom balance into the amount that can be sent
+// and the amount held back by the given locked coins. It is shared by queries
+// and by subUnlockedCoins so that reported and enforced limits never diverge.
+func spendableCoin(balance sdk.Coin, lockedCoins sdk.Coins) (spendable, locked sdk.Coin) {
+	locked = sdk.NewCoin(balance.Denom, lockedCoins.AmountOf(balance.Denom))
+	if locked.IsGTE(balance) {
+		return sdk.NewCoin(balance.Denom, sdk.ZeroInt()), locked
+	}
+
+	return balance.Sub(locked), locked
+}
+
 // ValidateBalance validates all balances for a given account address returning
 // an error if any balance is invalid. It will check for vesting account types
 // and validate the balances against the original vesting balances.
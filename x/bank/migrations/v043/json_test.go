@@ -72,9 +72,12 @@ func TestMigrateJSON(t *testing.T) {
 			]
 		}
 	],
+	"blocked_addresses": [],
 	"denom_metadata": [],
+	"denom_metadata_admins": [],
 	"params": {
 		"default_send_enabled": false,
+		"max_multi_send_entries": "0",
 		"send_enabled": []
 	},
 	"supply": [
@@ -0,0 +1,30 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, tBankKey, types.ModuleName)
+
+	require.False(t, paramstore.Has(ctx, types.KeyMaxMultiSendEntries))
+
+	require.NoError(t, v046.MigrateStore(ctx, paramstore))
+
+	var maxEntries uint64
+	paramstore.Get(ctx, types.KeyMaxMultiSendEntries, &maxEntries)
+	require.Equal(t, types.DefaultMaxMultiSendEntries, maxEntries)
+}
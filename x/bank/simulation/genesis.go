@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -35,6 +36,13 @@ func RandomGenesisSendParams(r *rand.Rand) types.SendEnabledParams {
 	return params.SendEnabled
 }
 
+// RandomGenesisMaxMultiSendEntries computes randomized max multi-send entries
+// param for the bank module
+func RandomGenesisMaxMultiSendEntries(r *rand.Rand) uint64 {
+	// simulated multi-sends carry at most 3 inputs and 3 outputs
+	return uint64(simtypes.RandIntBetween(r, 6, 200))
+}
+
 // RandomGenesisBalances returns a slice of account balances. Each account has
 // a balance of simState.InitialStake for sdk.DefaultBondDenom.
 func RandomGenesisBalances(simState *module.SimulationState) []types.Balance {
@@ -64,14 +72,21 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { defaultSendEnabledParam = RandomGenesisDefaultSendParam(r) },
 	)
 
+	var maxMultiSendEntries uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.KeyMaxMultiSendEntries), &maxMultiSendEntries, simState.Rand,
+		func(r *rand.Rand) { maxMultiSendEntries = RandomGenesisMaxMultiSendEntries(r) },
+	)
+
 	numAccs := int64(len(simState.Accounts))
 	totalSupply := sdk.NewInt(simState.InitialStake * (numAccs + simState.NumBonded))
 	supply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply))
 
 	bankGenesis := types.GenesisState{
 		Params: types.Params{
-			SendEnabled:        sendEnabledParams,
-			DefaultSendEnabled: defaultSendEnabledParam,
+			SendEnabled:         sendEnabledParams,
+			DefaultSendEnabled:  defaultSendEnabledParam,
+			MaxMultiSendEntries: maxMultiSendEntries,
 		},
 		Balances: RandomGenesisBalances(simState),
 		Supply:   supply,
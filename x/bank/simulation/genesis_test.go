@@ -40,6 +40,7 @@ func TestRandomizedGenState(t *testing.T) {
 
 	require.Equal(t, true, bankGenesis.Params.GetDefaultSendEnabled())
 	require.Len(t, bankGenesis.Params.GetSendEnabled(), 1)
+	require.GreaterOrEqual(t, bankGenesis.Params.GetMaxMultiSendEntries(), uint64(6))
 	require.Len(t, bankGenesis.Balances, 3)
 	require.Equal(t, "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", bankGenesis.Balances[2].GetAddress().String())
 	require.Equal(t, "1000stake", bankGenesis.Balances[2].GetCoins().String())
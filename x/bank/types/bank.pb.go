@@ -30,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// max_multi_send_entries is the maximum number of inputs and outputs,
+	// combined, that a single multi-send may carry. Zero disables the bound.
+	MaxMultiSendEntries uint64 `protobuf:"varint,3,opt,name=max_multi_send_entries,json=maxMultiSendEntries,proto3" json:"max_multi_send_entries,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +81,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxMultiSendEntries() uint64 {
+	if m != nil {
+		return m.MaxMultiSendEntries
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -453,47 +463,48 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x32, 0xed, 0x61, 0x13, 0x72, 0x90, 0x28,
-	0x34, 0x49, 0xab, 0xa7, 0x20, 0x88, 0xad, 0xa2, 0x11, 0x44, 0xd9, 0x52, 0x04, 0x2f, 0x61, 0x92,
-	0x19, 0x93, 0xa1, 0xbb, 0x33, 0xcb, 0xce, 0x6c, 0x69, 0x7e, 0x80, 0x20, 0x9e, 0x3c, 0x7a, 0xec,
-	0x51, 0x3d, 0x17, 0xfc, 0x0b, 0xc5, 0x53, 0xf1, 0xe4, 0xa9, 0x4a, 0x7a, 0xf1, 0x67, 0xc8, 0xcc,
-	0xec, 0xa6, 0x29, 0x54, 0xf1, 0xe0, 0xc5, 0xd3, 0xbe, 0xef, 0x7d, 0xef, 0x7d, 0xef, 0xbd, 0x99,
-	0x37, 0x0b, 0xfd, 0x91, 0x54, 0x91, 0x54, 0x9d, 0x21, 0x11, 0x7b, 0x9d, 0xfd, 0x8d, 0x21, 0xd3,
-	0x64, 0xc3, 0x82, 0x76, 0x9c, 0x48, 0x2d, 0xd1, 0x35, 0xc7, 0xb7, 0xad, 0x2b, 0xe3, 0xd7, 0x6a,
-	0x63, 0x39, 0x96, 0x96, 0xef, 0x18, 0xcb, 0x85, 0xae, 0xad, 0xba, 0xd0, 0x81, 0x23, 0xb2, 0x3c,
-	0x47, 0x9d, 0x57, 0x51, 0x6c, 0x5e, 0x65, 0x24, 0xb9, 0x70, 0x7c, 0xf3, 0x35, 0x80, 0xe5, 0xe7,
-	0x24, 0x21, 0x91, 0x42, 0xdb, 0xf0, 0x8a, 0x62, 0x82, 0x0e, 0x98, 0x20, 0xc3, 0x90, 0x51, 0x0c,
-	0x1a, 0xc5, 0x56, 0x75, 0xb3, 0xd1, 0xbe, 0xa4, 0x8f, 0xf6, 0x0e, 0x13, 0xf4, 0xa1, 0x8b, 0x0b,
-	0xaa, 0xea, 0x1c, 0xa0, 0x2e, 0xac, 0x51, 0xf6, 0x8a, 0xa4, 0xa1, 0x1e, 0x5c, 0x10, 0x5b, 0x6a,
-	0x80, 0x96, 0x17, 0xa0, 0x8c, 0x5b, 0x48, 0xef, 0x2d, 0xbf, 0x3f, 0xac, 0x17, 0x9a, 0x8f, 0x60,
-	0x75, 0xc1, 0x89, 0x6a, 0xb0, 0x44, 0x99, 0x90, 0x11, 0x06, 0x0d, 0xd0, 0x5a, 0x09, 0x1c, 0x40,
-	0x18, 0x56, 0x2e, 0xea, 0xe5, 0xb0, 0xe7, 0x19, 0x91, 0x9f, 0x87, 0x75, 0xd0, 0xfc, 0x00, 0x60,
-	0xa9, 0x2f, 0xe2, 0x54, 0xa3, 0x4d, 0x58, 0x21, 0x94, 0x26, 0x4c, 0x29, 0xa7, 0xb2, 0x85, 0xbf,
-	0x1e, 0xad, 0xd7, 0xb2, 0x69, 0xee, 0x3b, 0x66, 0x47, 0x27, 0x5c, 0x8c, 0x83, 0x3c, 0x10, 0x11,
-	0x58, 0x32, 0x87, 0xa3, 0xf0, 0x92, 0x1d, 0x7e, 0xf5, 0x7c, 0x78, 0xc5, 0xe6, 0xc3, 0x6f, 0x4b,
-	0x2e, 0xb6, 0xba, 0xc7, 0xa7, 0xf5, 0xc2, 0xa7, 0xef, 0xf5, 0xd6, 0x98, 0xeb, 0x49, 0x3a, 0x6c,
-	0x8f, 0x64, 0x94, 0x9d, 0x7c, 0xf6, 0x59, 0x57, 0x74, 0xaf, 0xa3, 0xa7, 0x31, 0x53, 0x36, 0x41,
-	0x05, 0x4e, 0xb9, 0xe7, 0xbd, 0x71, 0xad, 0x16, 0x9a, 0x1f, 0x01, 0x2c, 0x3f, 0x4b, 0xf5, 0x7f,
-	0xd1, 0xeb, 0x67, 0x00, 0xcb, 0x3b, 0x69, 0x1c, 0x87, 0x53, 0x53, 0x57, 0x4b, 0x4d, 0xc2, 0x6c,
-	0x41, 0xfe, 0x6d, 0x5d, 0xab, 0xdc, 0x7b, 0x92, 0xd5, 0x05, 0x5f, 0x8e, 0xd6, 0xef, 0xde, 0xfa,
-	0x63, 0xf6, 0x81, 0x7b, 0x40, 0x11, 0x1f, 0x27, 0x44, 0x73, 0x29, 0x54, 0x67, 0xbf, 0x7b, 0xa7,
-	0xdb, 0x76, 0xbd, 0xf6, 0x31, 0x68, 0xbe, 0x80, 0x2b, 0x0f, 0xcc, 0xf6, 0xec, 0x0a, 0xae, 0x7f,
-	0xb3, 0x57, 0x6b, 0xd0, 0x63, 0x07, 0xb1, 0x14, 0x4c, 0x68, 0xbb, 0x58, 0x57, 0x83, 0x39, 0x36,
-	0x3b, 0x47, 0x42, 0x4e, 0x14, 0x53, 0xb8, 0xd8, 0x28, 0xb6, 0x56, 0x82, 0x1c, 0x36, 0xdf, 0x2e,
-	0x41, 0xef, 0x29, 0xd3, 0x84, 0x12, 0x4d, 0x50, 0x03, 0x56, 0x29, 0x53, 0xa3, 0x84, 0xc7, 0xa6,
-	0x89, 0x4c, 0x7e, 0xd1, 0x85, 0xee, 0x99, 0x08, 0x21, 0xa3, 0x41, 0x2a, 0xb8, 0xce, 0x2f, 0xcd,
-	0xbf, 0xf4, 0x75, 0xcd, 0xfb, 0x0d, 0x20, 0xcd, 0x4d, 0x85, 0x10, 0x5c, 0x36, 0x47, 0x8c, 0x8b,
-	0x56, 0xdb, 0xda, 0xa6, 0x3b, 0xca, 0x55, 0x1c, 0x92, 0x29, 0x5e, 0xb6, 0xee, 0x1c, 0x9a, 0x68,
-	0x41, 0x22, 0x86, 0x4b, 0x2e, 0xda, 0xd8, 0xe8, 0x3a, 0x2c, 0xab, 0x69, 0x34, 0x94, 0x21, 0x2e,
-	0x5b, 0x6f, 0x86, 0xd0, 0x2a, 0x2c, 0xa6, 0x09, 0xc7, 0x15, 0xbb, 0x79, 0x95, 0xd9, 0x69, 0xbd,
-	0xb8, 0x1b, 0xf4, 0x03, 0xe3, 0x43, 0x37, 0xa0, 0x97, 0x26, 0x7c, 0x30, 0x21, 0x6a, 0x82, 0x3d,
-	0xcb, 0x57, 0x67, 0xa7, 0xf5, 0xca, 0x6e, 0xd0, 0x7f, 0x4c, 0xd4, 0x24, 0xa8, 0xa4, 0x09, 0x37,
-	0xc6, 0xd6, 0xf6, 0xf1, 0xcc, 0x07, 0x27, 0x33, 0x1f, 0xfc, 0x98, 0xf9, 0xe0, 0xdd, 0x99, 0x5f,
-	0x38, 0x39, 0xf3, 0x0b, 0xdf, 0xce, 0xfc, 0xc2, 0xcb, 0x9b, 0x7f, 0x73, 0x7d, 0x76, 0x07, 0x86,
-	0x65, 0xfb, 0x4f, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x80, 0x89, 0x32, 0x1b, 0x05,
-	0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0x34, 0xff, 0x36, 0x13, 0xbd, 0x4c, 0x43, 0xd9, 0xf6, 0xb0, 0x09, 0x39, 0x48, 0x14,
+	0x9a, 0xa4, 0xad, 0xa7, 0x20, 0x88, 0xad, 0xa2, 0x11, 0x8a, 0x32, 0xa5, 0x08, 0x5e, 0xc2, 0x24,
+	0x3b, 0x26, 0x43, 0x77, 0x67, 0x96, 0x9d, 0xd9, 0x92, 0x7c, 0x03, 0xf1, 0xe4, 0xd1, 0x63, 0x8f,
+	0xea, 0xb9, 0x20, 0xf8, 0x09, 0x8a, 0xa7, 0xe2, 0xc9, 0x53, 0x95, 0xf4, 0xe2, 0xc7, 0x90, 0x99,
+	0xd9, 0x4d, 0x5b, 0xa8, 0xe2, 0xc5, 0x83, 0xa7, 0x7d, 0xbf, 0xf7, 0x7b, 0x7f, 0x7e, 0xbc, 0x79,
+	0x6f, 0xa1, 0x37, 0x12, 0x32, 0x14, 0xb2, 0x33, 0x24, 0xfc, 0xa0, 0x73, 0xb8, 0x31, 0xa4, 0x8a,
+	0x6c, 0x18, 0xd0, 0x8e, 0x62, 0xa1, 0x04, 0x5a, 0xb6, 0x7c, 0xdb, 0xb8, 0x52, 0x7e, 0xad, 0x36,
+	0x16, 0x63, 0x61, 0xf8, 0x8e, 0xb6, 0x6c, 0xe8, 0xda, 0xaa, 0x0d, 0x1d, 0x58, 0x22, 0xcd, 0xb3,
+	0xd4, 0x45, 0x17, 0x49, 0x17, 0x5d, 0x46, 0x82, 0x71, 0xcb, 0x37, 0x3f, 0x03, 0x58, 0x7a, 0x4e,
+	0x62, 0x12, 0x4a, 0xb4, 0x03, 0x6f, 0x48, 0xca, 0xfd, 0x01, 0xe5, 0x64, 0x18, 0x50, 0xdf, 0x05,
+	0x8d, 0x7c, 0xab, 0xba, 0xd9, 0x68, 0x5f, 0xa3, 0xa3, 0xbd, 0x47, 0xb9, 0xff, 0xc8, 0xc6, 0xe1,
+	0xaa, 0xbc, 0x00, 0xa8, 0x0b, 0x6b, 0x3e, 0x7d, 0x45, 0x92, 0x40, 0x0d, 0xae, 0x14, 0x5b, 0x6a,
+	0x80, 0x96, 0x83, 0x51, 0xca, 0x5d, 0x4a, 0x47, 0x5b, 0x70, 0x25, 0x24, 0xd3, 0x41, 0x98, 0x04,
+	0x8a, 0x65, 0x39, 0x2a, 0x66, 0x54, 0xba, 0xf9, 0x06, 0x68, 0x15, 0xf0, 0x72, 0x48, 0xa6, 0xbb,
+	0x9a, 0xb4, 0x49, 0x86, 0xea, 0x15, 0xde, 0x1d, 0xd5, 0x73, 0xcd, 0xc7, 0xb0, 0x7a, 0xb9, 0x52,
+	0x0d, 0x16, 0x7d, 0xca, 0x45, 0xe8, 0x82, 0x06, 0x68, 0x55, 0xb0, 0x05, 0xc8, 0x85, 0xe5, 0xab,
+	0x22, 0x32, 0xd8, 0x73, 0x74, 0x91, 0x9f, 0x47, 0x75, 0xd0, 0x7c, 0x0f, 0x60, 0xb1, 0xcf, 0xa3,
+	0x44, 0xa1, 0x4d, 0x58, 0x26, 0xbe, 0x1f, 0x53, 0x29, 0x6d, 0x95, 0x6d, 0xf7, 0xeb, 0xf1, 0x7a,
+	0x2d, 0x1d, 0xc1, 0x03, 0xcb, 0xec, 0xa9, 0x98, 0xf1, 0x31, 0xce, 0x02, 0x11, 0x81, 0x45, 0x3d,
+	0x51, 0xe9, 0x2e, 0x99, 0x89, 0xad, 0x5e, 0x4c, 0x4c, 0xd2, 0xc5, 0xc4, 0x76, 0x04, 0xe3, 0xdb,
+	0xdd, 0x93, 0xb3, 0x7a, 0xee, 0xe3, 0xf7, 0x7a, 0x6b, 0xcc, 0xd4, 0x24, 0x19, 0xb6, 0x47, 0x22,
+	0x4c, 0x9f, 0x2b, 0xfd, 0xac, 0x4b, 0xff, 0xa0, 0xa3, 0x66, 0x11, 0x95, 0x26, 0x41, 0x62, 0x5b,
+	0xb9, 0xe7, 0xbc, 0xb6, 0x52, 0x73, 0xcd, 0x0f, 0x00, 0x96, 0x9e, 0x25, 0xea, 0xbf, 0xd0, 0xfa,
+	0x09, 0xc0, 0xd2, 0x5e, 0x12, 0x45, 0xc1, 0x4c, 0xf7, 0x55, 0x42, 0x91, 0xc0, 0x05, 0xff, 0xa0,
+	0xaf, 0xa9, 0xdc, 0x7b, 0x9a, 0xf6, 0x05, 0x5f, 0x8e, 0xd7, 0xef, 0xdd, 0xf9, 0x63, 0xf6, 0xd4,
+	0x5e, 0x5d, 0xc8, 0xc6, 0x31, 0x51, 0x4c, 0x70, 0xd9, 0x39, 0xec, 0xde, 0xed, 0xb6, 0xad, 0xd6,
+	0xbe, 0x0b, 0x9a, 0x2f, 0x60, 0xe5, 0xa1, 0xde, 0x9e, 0x7d, 0xce, 0xd4, 0x6f, 0xf6, 0x6a, 0x0d,
+	0x3a, 0x74, 0x1a, 0x09, 0x4e, 0xb9, 0x32, 0x8b, 0x75, 0x13, 0x2f, 0xb0, 0xde, 0x39, 0x12, 0x30,
+	0x22, 0xcd, 0x12, 0xe7, 0x5b, 0x15, 0x9c, 0xc1, 0xe6, 0x9b, 0x25, 0xe8, 0xec, 0x52, 0x45, 0x7c,
+	0xa2, 0x08, 0x6a, 0xc0, 0xaa, 0x4f, 0xe5, 0x28, 0x66, 0x91, 0x16, 0x91, 0x96, 0xbf, 0xec, 0x42,
+	0xf7, 0x75, 0x04, 0x17, 0xe1, 0x20, 0xe1, 0x4c, 0x65, 0x8f, 0xe6, 0x5d, 0x7b, 0x92, 0x0b, 0xbd,
+	0x18, 0xfa, 0x99, 0x29, 0x11, 0x82, 0x05, 0x3d, 0x62, 0x73, 0x4b, 0x15, 0x6c, 0x6c, 0xad, 0xce,
+	0x67, 0x32, 0x0a, 0xc8, 0xcc, 0x2d, 0x18, 0x77, 0x06, 0x75, 0x34, 0x27, 0x21, 0x75, 0x8b, 0x36,
+	0x5a, 0xdb, 0x68, 0x05, 0x96, 0xe4, 0x2c, 0x1c, 0x8a, 0xc0, 0x2d, 0x19, 0x6f, 0x8a, 0xd0, 0x2a,
+	0xcc, 0x27, 0x31, 0x73, 0xcb, 0x66, 0xf3, 0xca, 0xf3, 0xb3, 0x7a, 0x7e, 0x1f, 0xf7, 0xb1, 0xf6,
+	0xa1, 0x5b, 0xd0, 0x49, 0x62, 0x36, 0x98, 0x10, 0x39, 0x71, 0x1d, 0xc3, 0x57, 0xe7, 0x67, 0xf5,
+	0xf2, 0x3e, 0xee, 0x3f, 0x21, 0x72, 0x82, 0xcb, 0x49, 0xcc, 0xb4, 0xb1, 0xbd, 0x73, 0x32, 0xf7,
+	0xc0, 0xe9, 0xdc, 0x03, 0x3f, 0xe6, 0x1e, 0x78, 0x7b, 0xee, 0xe5, 0x4e, 0xcf, 0xbd, 0xdc, 0xb7,
+	0x73, 0x2f, 0xf7, 0xf2, 0xf6, 0xdf, 0x3c, 0x9f, 0xd9, 0x81, 0x61, 0xc9, 0xfc, 0xc8, 0xb6, 0x7e,
+	0x0d, 0x00, 0x8b, 0x84, 0x69, 0x2c, 0x50, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -572,6 +583,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMultiSendEntries != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxMultiSendEntries))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -920,6 +936,9 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if m.MaxMultiSendEntries != 0 {
+		n += 1 + sovBank(uint64(m.MaxMultiSendEntries))
+	}
 	return n
 }
 
@@ -1146,6 +1165,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiSendEntries", wireType)
+			}
+			m.MaxMultiSendEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMultiSendEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
@@ -14,21 +15,43 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&MsgSetBlockedAddress{}, "cosmos-sdk/MsgSetBlockedAddress", nil)
+	cdc.RegisterConcrete(&MsgRemoveBlockedAddress{}, "cosmos-sdk/MsgRemoveBlockedAddress", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "cosmos-sdk/MsgSetDenomMetadata", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadataAdmin{}, "cosmos-sdk/MsgSetDenomMetadataAdmin", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 		&MsgMultiSend{},
+		&MsgSetBlockedAddress{},
+		&MsgRemoveBlockedAddress{},
+		&MsgSetDenomMetadata{},
+		&MsgSetDenomMetadataAdmin{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&SendAuthorization{},
+		&PeriodicSendAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
+// RegisterMsgRenderers registers the human-readable renderers of the x/bank
+// Msgs on the provided registry.
+func RegisterMsgRenderers(registry *signing.MsgRendererRegistry) {
+	registry.Register(&MsgSend{}, func(msg sdk.Msg) ([]signing.Field, error) {
+		m := msg.(*MsgSend)
+		return []signing.Field{
+			{Label: "From", Value: m.FromAddress},
+			{Label: "To", Value: m.ToAddress},
+			{Label: "Amount", Value: m.Amount.String()},
+		}, nil
+	})
+}
+
 var (
 	amino = codec.NewLegacyAmino()
 
@@ -45,4 +68,6 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	RegisterMsgRenderers(signing.DefaultMsgRenderers)
 }
@@ -12,4 +12,9 @@ var (
 	ErrSendDisabled          = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
 	ErrDenomMetadataNotFound = sdkerrors.Register(ModuleName, 6, "client denom metadata not found")
 	ErrInvalidKey            = sdkerrors.Register(ModuleName, 7, "invalid key")
+	ErrDuplicateInput        = sdkerrors.Register(ModuleName, 8, "duplicate input address")
+	ErrTooManyEntries        = sdkerrors.Register(ModuleName, 9, "too many multi-send inputs and outputs")
+	ErrInvalidAuthority      = sdkerrors.Register(ModuleName, 10, "invalid authority")
+	ErrBlockedAddrNotFound   = sdkerrors.Register(ModuleName, 11, "blocked address not found")
+	ErrInvalidDenomAdmin     = sdkerrors.Register(ModuleName, 12, "signer is not the denom metadata admin")
 )
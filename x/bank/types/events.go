@@ -6,10 +6,17 @@ import (
 
 // bank module event types
 const (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer              = "transfer"
+	EventTypeSetBlockedAddress     = "set_blocked_address"
+	EventTypeRemoveBlockedAddress  = "remove_blocked_address"
+	EventTypeSetDenomMetadata      = "set_denom_metadata"
+	EventTypeSetDenomMetadataAdmin = "set_denom_metadata_admin"
 
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
+	AttributeKeyAddress   = "address"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAdmin     = "admin"
 
 	AttributeValueCategory = ModuleName
 
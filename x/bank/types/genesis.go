@@ -46,6 +46,36 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenBlocked := make(map[string]bool)
+	for _, blocked := range gs.BlockedAddresses {
+		if seenBlocked[blocked] {
+			return fmt.Errorf("duplicate blocked address %s", blocked)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(blocked); err != nil {
+			return fmt.Errorf("invalid blocked address %s: %w", blocked, err)
+		}
+
+		seenBlocked[blocked] = true
+	}
+
+	seenAdmins := make(map[string]bool)
+	for _, admin := range gs.DenomMetadataAdmins {
+		if seenAdmins[admin.Denom] {
+			return fmt.Errorf("duplicate metadata admin for denom %s", admin.Denom)
+		}
+
+		if err := sdk.ValidateDenom(admin.Denom); err != nil {
+			return fmt.Errorf("invalid metadata admin denom: %w", err)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(admin.Admin); err != nil {
+			return fmt.Errorf("invalid metadata admin for denom %s: %w", admin.Denom, err)
+		}
+
+		seenAdmins[admin.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -37,6 +37,12 @@ type GenesisState struct {
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
 	// denom_metadata defines the metadata of the differents coins.
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// blocked_addresses are the addresses added to the on-chain set of addresses
+	// that are not allowed to receive funds. Addresses blocked by the application
+	// at construction time are not part of the genesis state.
+	BlockedAddresses []string `protobuf:"bytes,5,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// denom_metadata_admins are the admins allowed to set the metadata of a denom.
+	DenomMetadataAdmins []DenomMetadataAdmin `protobuf:"bytes,6,rep,name=denom_metadata_admins,json=denomMetadataAdmins,proto3" json:"denom_metadata_admins"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +106,20 @@ func (m *GenesisState) GetDenomMetadata() []Metadata {
 	return nil
 }
 
+func (m *GenesisState) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomMetadataAdmins() []DenomMetadataAdmin {
+	if m != nil {
+		return m.DenomMetadataAdmins
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -142,40 +162,88 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// DenomMetadataAdmin defines a base denom and admin address pair used in the
+// bank module's genesis state.
+type DenomMetadataAdmin struct {
+	// denom is the base denom of the metadata.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// admin is the address allowed to set the metadata of the denom.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *DenomMetadataAdmin) Reset()         { *m = DenomMetadataAdmin{} }
+func (m *DenomMetadataAdmin) String() string { return proto.CompactTextString(m) }
+func (*DenomMetadataAdmin) ProtoMessage()    {}
+func (*DenomMetadataAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *DenomMetadataAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMetadataAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMetadataAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMetadataAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMetadataAdmin.Merge(m, src)
+}
+func (m *DenomMetadataAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMetadataAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMetadataAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMetadataAdmin proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
+	proto.RegisterType((*DenomMetadataAdmin)(nil), "cosmos.bank.v1beta1.DenomMetadataAdmin")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
-	0x18, 0xc7, 0x5b, 0xe0, 0x07, 0xfc, 0x0e, 0x75, 0xa8, 0x0c, 0x05, 0xb5, 0x45, 0x26, 0x1c, 0x68,
-	0x05, 0x27, 0x1d, 0x4c, 0x2c, 0x83, 0x89, 0x89, 0x89, 0x81, 0xcd, 0x85, 0x5c, 0xdb, 0x4b, 0x6d,
-	0xa0, 0xbd, 0xa6, 0x77, 0x18, 0x79, 0x07, 0x8e, 0xbe, 0x04, 0x66, 0x66, 0x27, 0x5f, 0x01, 0x23,
-	0x71, 0x72, 0x52, 0x03, 0x8b, 0x2f, 0xc3, 0xf4, 0xee, 0xa8, 0x26, 0x12, 0x27, 0xa7, 0xfe, 0xf9,
-	0x7e, 0x3f, 0xdf, 0xe7, 0x79, 0xee, 0x1e, 0xb0, 0xef, 0x60, 0x12, 0x60, 0x62, 0xda, 0x30, 0x1c,
-	0x98, 0xb7, 0x2d, 0x1b, 0x51, 0xd8, 0x32, 0x3d, 0x14, 0x22, 0xe2, 0x13, 0x23, 0x8a, 0x31, 0xc5,
-	0xca, 0x36, 0xb7, 0x18, 0x89, 0xc5, 0x10, 0x96, 0x6a, 0xd9, 0xc3, 0x1e, 0x66, 0xba, 0x99, 0xbc,
-	0x71, 0x6b, 0x55, 0x4b, 0xd3, 0x08, 0x4a, 0xd3, 0x1c, 0xec, 0x87, 0x3f, 0xf4, 0x6f, 0xd5, 0x58,
-	0x2e, 0xd7, 0x2b, 0x5c, 0xef, 0xf3, 0x60, 0x51, 0x97, 0x7d, 0xd4, 0x9f, 0x32, 0x60, 0xe3, 0x9c,
-	0xf7, 0xd5, 0xa3, 0x90, 0x22, 0xe5, 0x18, 0xe4, 0x23, 0x18, 0xc3, 0x80, 0xa8, 0x72, 0x4d, 0x6e,
-	0x94, 0xda, 0x3b, 0xc6, 0x9a, 0x3e, 0x8d, 0x2b, 0x66, 0xb1, 0x72, 0xb3, 0x57, 0x5d, 0xea, 0x0a,
-	0x40, 0x39, 0x05, 0x45, 0x1b, 0x0e, 0x61, 0xe8, 0x20, 0xa2, 0x66, 0x6a, 0xd9, 0x46, 0xa9, 0xbd,
-	0xbb, 0x16, 0xb6, 0xb8, 0x49, 0xd0, 0x29, 0xa3, 0x38, 0x20, 0x4f, 0x46, 0x51, 0x34, 0x1c, 0xab,
-	0x59, 0x46, 0x57, 0xbe, 0x68, 0x82, 0x52, 0xba, 0x83, 0xfd, 0xd0, 0x3a, 0x4c, 0xd0, 0xe9, 0x9b,
-	0xde, 0xf0, 0x7c, 0x7a, 0x33, 0xb2, 0x0d, 0x07, 0x07, 0x62, 0x2e, 0xf1, 0x68, 0x12, 0x77, 0x60,
-	0xd2, 0x71, 0x84, 0x08, 0x03, 0x48, 0x57, 0x44, 0x2b, 0x17, 0x60, 0xcb, 0x45, 0x21, 0x0e, 0xfa,
-	0x01, 0xa2, 0xd0, 0x85, 0x14, 0xaa, 0x39, 0x56, 0x6c, 0x6f, 0x6d, 0xab, 0x97, 0xc2, 0x24, 0x7a,
-	0xdd, 0x64, 0xe8, 0xea, 0x67, 0x7d, 0x2a, 0x83, 0x82, 0x18, 0x46, 0x69, 0x83, 0x02, 0x74, 0xdd,
-	0x18, 0x11, 0x7e, 0x70, 0xff, 0x2d, 0xf5, 0xf9, 0xb1, 0x59, 0x16, 0x99, 0x67, 0x5c, 0xe9, 0xd1,
-	0xd8, 0x0f, 0xbd, 0xee, 0xca, 0xa8, 0x40, 0xf0, 0x2f, 0xb9, 0xc5, 0xd5, 0x69, 0xfd, 0xe9, 0xbc,
-	0x3c, 0xf9, 0xa4, 0x78, 0x3f, 0xd1, 0xa5, 0x8f, 0x89, 0x2e, 0x59, 0x9d, 0xd9, 0x42, 0x93, 0xe7,
-	0x0b, 0x4d, 0x7e, 0x5f, 0x68, 0xf2, 0xc3, 0x52, 0x93, 0xe6, 0x4b, 0x4d, 0x7a, 0x59, 0x6a, 0xd2,
-	0xf5, 0xc1, 0xaf, 0xa1, 0x77, 0x7c, 0xad, 0x58, 0xb6, 0x9d, 0x67, 0x5b, 0x73, 0xf4, 0x19, 0x00,
-	0x00, 0xff, 0xff, 0x78, 0xd8, 0xf3, 0x7f, 0xe0, 0x02, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x93, 0x75, 0xed, 0x86, 0x07, 0x08, 0xbc, 0x22, 0x79, 0x03, 0xd2, 0xd2, 0x0b, 0xe5,
+	0xb0, 0x84, 0x95, 0x13, 0x1c, 0x90, 0x9a, 0x81, 0x90, 0x90, 0x90, 0x50, 0x77, 0xe3, 0x52, 0x39,
+	0xb1, 0x15, 0xa2, 0x36, 0x76, 0x94, 0xcf, 0x43, 0xec, 0x0d, 0x38, 0xf2, 0x08, 0x3b, 0xef, 0xcc,
+	0x43, 0xec, 0x38, 0x71, 0xe2, 0x04, 0xa8, 0xe5, 0xc0, 0x63, 0xa0, 0xd8, 0x4e, 0x36, 0xd4, 0x68,
+	0x5c, 0x38, 0x25, 0xce, 0xf7, 0xff, 0xff, 0xbf, 0xdf, 0x67, 0xc7, 0xe8, 0x41, 0x2c, 0x21, 0x93,
+	0x10, 0x44, 0x54, 0xcc, 0x82, 0x0f, 0xfb, 0x11, 0x57, 0x74, 0x3f, 0x48, 0xb8, 0xe0, 0x90, 0x82,
+	0x9f, 0x17, 0x52, 0x49, 0xbc, 0x6d, 0x24, 0x7e, 0x29, 0xf1, 0xad, 0x64, 0xb7, 0x9b, 0xc8, 0x44,
+	0xea, 0x7a, 0x50, 0xbe, 0x19, 0xe9, 0xae, 0x57, 0xa7, 0x01, 0xaf, 0xd3, 0x62, 0x99, 0x8a, 0x95,
+	0xfa, 0xa5, 0x6e, 0x3a, 0xd7, 0xd4, 0x77, 0x4c, 0x7d, 0x6a, 0x82, 0x6d, 0x5f, 0xbd, 0x18, 0xfc,
+	0x6a, 0xa1, 0xeb, 0xaf, 0x0c, 0xd7, 0xa1, 0xa2, 0x8a, 0xe3, 0xa7, 0xa8, 0x93, 0xd3, 0x82, 0x66,
+	0x40, 0xdc, 0xbe, 0x3b, 0xdc, 0x1a, 0xdd, 0xf5, 0x1b, 0x38, 0xfd, 0xb7, 0x5a, 0x12, 0xae, 0x9f,
+	0x7d, 0xef, 0x39, 0x13, 0x6b, 0xc0, 0xcf, 0xd1, 0x66, 0x44, 0xe7, 0x54, 0xc4, 0x1c, 0xc8, 0x5a,
+	0xbf, 0x35, 0xdc, 0x1a, 0xdd, 0x6b, 0x34, 0x87, 0x46, 0x64, 0xdd, 0xb5, 0x07, 0xc7, 0xa8, 0x03,
+	0x47, 0x79, 0x3e, 0x3f, 0x26, 0x2d, 0xed, 0xde, 0xb9, 0x70, 0x03, 0xaf, 0xdd, 0x07, 0x32, 0x15,
+	0xe1, 0xe3, 0xd2, 0x7a, 0xfa, 0xa3, 0x37, 0x4c, 0x52, 0xf5, 0xfe, 0x28, 0xf2, 0x63, 0x99, 0xd9,
+	0xb9, 0xec, 0x63, 0x0f, 0xd8, 0x2c, 0x50, 0xc7, 0x39, 0x07, 0x6d, 0x80, 0x89, 0x8d, 0xc6, 0xaf,
+	0xd1, 0x4d, 0xc6, 0x85, 0xcc, 0xa6, 0x19, 0x57, 0x94, 0x51, 0x45, 0xc9, 0xba, 0x6e, 0x76, 0xbf,
+	0x11, 0xf5, 0x8d, 0x15, 0x59, 0xd6, 0x1b, 0xda, 0x5a, 0x7d, 0xc4, 0x2f, 0xd1, 0xed, 0x68, 0x2e,
+	0xe3, 0x19, 0x67, 0x53, 0xca, 0x58, 0xc1, 0x01, 0x38, 0x90, 0x76, 0xbf, 0x35, 0xbc, 0x16, 0x92,
+	0xaf, 0x5f, 0xf6, 0xba, 0x36, 0x71, 0x6c, 0x6a, 0x87, 0xaa, 0x48, 0x45, 0x32, 0xb9, 0x65, 0x2d,
+	0xe3, 0xca, 0x81, 0x29, 0xba, 0xf3, 0x37, 0xd2, 0x94, 0xb2, 0x2c, 0x15, 0x40, 0x3a, 0x9a, 0xec,
+	0x61, 0x23, 0xd9, 0x8b, 0xcb, 0x24, 0xe3, 0x52, 0x6f, 0x19, 0xb7, 0xd9, 0x4a, 0x05, 0x06, 0xa7,
+	0x2e, 0xda, 0xb0, 0xdb, 0x8e, 0x47, 0x68, 0xc3, 0xd2, 0xea, 0x23, 0xbe, 0x8a, 0xb5, 0x12, 0x62,
+	0x8a, 0xda, 0xe5, 0xff, 0x56, 0x9d, 0xeb, 0x7f, 0x3d, 0x19, 0x93, 0xfc, 0x6c, 0xf3, 0xd3, 0x49,
+	0xcf, 0xf9, 0x7d, 0xd2, 0x73, 0x06, 0x0c, 0xe1, 0xd5, 0xe9, 0x70, 0x17, 0xb5, 0xf5, 0x64, 0x06,
+	0x7a, 0x62, 0x16, 0xd8, 0x47, 0x6d, 0xbd, 0x59, 0x64, 0xed, 0x1f, 0xa3, 0x18, 0xd9, 0x45, 0x97,
+	0xf0, 0xe0, 0x6c, 0xe1, 0xb9, 0xe7, 0x0b, 0xcf, 0xfd, 0xb9, 0xf0, 0xdc, 0xcf, 0x4b, 0xcf, 0x39,
+	0x5f, 0x7a, 0xce, 0xb7, 0xa5, 0xe7, 0xbc, 0x7b, 0x74, 0x25, 0xfa, 0x47, 0x73, 0xcd, 0xf4, 0x04,
+	0x51, 0x47, 0xdf, 0xa2, 0x27, 0x7f, 0x06, 0x00, 0xc0, 0x3a, 0xc1, 0x62, 0xf0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +266,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomMetadataAdmins) > 0 {
+		for iNdEx := len(m.DenomMetadataAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMetadataAdmins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenomMetadata) > 0 {
 		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -297,6 +388,43 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomMetadataAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMetadataAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMetadataAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -334,6 +462,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMetadataAdmins) > 0 {
+		for _, e := range m.DenomMetadataAdmins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -356,6 +496,23 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *DenomMetadataAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -526,6 +683,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadataAdmins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMetadataAdmins = append(m.DenomMetadataAdmins, DenomMetadataAdmin{})
+			if err := m.DenomMetadataAdmins[len(m.DenomMetadataAdmins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -663,6 +886,120 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomMetadataAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMetadataAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMetadataAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
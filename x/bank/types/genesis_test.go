@@ -134,6 +134,40 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dup blocked address",
+			GenesisState{
+				BlockedAddresses: []string{
+					"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+					"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+				},
+			},
+			true,
+		},
+		{
+			"invalid blocked address",
+			GenesisState{
+				BlockedAddresses: []string{"invalid"},
+			},
+			true,
+		},
+		{
+			"dup metadata admin",
+			GenesisState{
+				DenomMetadataAdmins: []DenomMetadataAdmin{
+					{Denom: "uatom", Admin: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+					{Denom: "uatom", Admin: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid metadata admin",
+			GenesisState{
+				DenomMetadataAdmins: []DenomMetadataAdmin{{Denom: "uatom", Admin: "invalid"}},
+			},
+			true,
+		},
 		{
 			"invalid supply",
 			GenesisState{
@@ -26,6 +26,14 @@ var (
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
 
+	// BlockedAddressPrefix is the prefix for the on-chain set of addresses that
+	// are not allowed to receive funds.
+	BlockedAddressPrefix = []byte{0x04}
+
+	// DenomMetadataAdminPrefix is the prefix for the admins allowed to set the
+	// metadata of a base denom.
+	DenomMetadataAdminPrefix = []byte{0x05}
+
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
 	BalancesPrefix = []byte{0x02}
@@ -57,6 +65,12 @@ func CreateAccountBalancesPrefix(addr []byte) []byte {
 	return append(BalancesPrefix, address.MustLengthPrefix(addr)...)
 }
 
+// CreateBlockedAddressKey creates the key of an address in the on-chain
+// blocked address set.
+func CreateBlockedAddressKey(addr sdk.AccAddress) []byte {
+	return append(BlockedAddressPrefix, address.MustLengthPrefix(addr)...)
+}
+
 // CreateDenomAddressPrefix creates a prefix for a reverse index of denomination
 // to account balance for that denomination.
 func CreateDenomAddressPrefix(denom string) []byte {
@@ -7,8 +7,12 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend                  = "send"
+	TypeMsgMultiSend             = "multisend"
+	TypeMsgSetBlockedAddress     = "set_blocked_address"
+	TypeMsgRemoveBlockedAddress  = "remove_blocked_address"
+	TypeMsgSetDenomMetadata      = "set_denom_metadata"
+	TypeMsgSetDenomMetadataAdmin = "set_denom_metadata_admin"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -101,6 +105,168 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgSetBlockedAddress{}
+
+// NewMsgSetBlockedAddress - construct a msg to add an address to the on-chain
+// blocked address set.
+//nolint:interfacer
+func NewMsgSetBlockedAddress(authority, addr sdk.AccAddress) *MsgSetBlockedAddress {
+	return &MsgSetBlockedAddress{Authority: authority.String(), Address: addr.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgSetBlockedAddress) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetBlockedAddress) Type() string { return TypeMsgSetBlockedAddress }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetBlockedAddress) ValidateBasic() error {
+	return validateAuthorityAndAddress(msg.Authority, msg.Address)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetBlockedAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetBlockedAddress) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgRemoveBlockedAddress{}
+
+// NewMsgRemoveBlockedAddress - construct a msg to remove an address from the
+// on-chain blocked address set.
+//nolint:interfacer
+func NewMsgRemoveBlockedAddress(authority, addr sdk.AccAddress) *MsgRemoveBlockedAddress {
+	return &MsgRemoveBlockedAddress{Authority: authority.String(), Address: addr.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgRemoveBlockedAddress) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRemoveBlockedAddress) Type() string { return TypeMsgRemoveBlockedAddress }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRemoveBlockedAddress) ValidateBasic() error {
+	return validateAuthorityAndAddress(msg.Authority, msg.Address)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRemoveBlockedAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRemoveBlockedAddress) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgSetDenomMetadata{}
+
+// NewMsgSetDenomMetadata - construct a msg to set the metadata of a denom.
+//nolint:interfacer
+func NewMsgSetDenomMetadata(signer sdk.AccAddress, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Signer: signer.String(), Metadata: metadata}
+}
+
+// Route Implements Msg.
+func (msg MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgSetDenomMetadataAdmin{}
+
+// NewMsgSetDenomMetadataAdmin - construct a msg to assign the admin allowed to
+// set the metadata of a denom. An empty admin removes the existing one.
+//nolint:interfacer
+func NewMsgSetDenomMetadataAdmin(authority sdk.AccAddress, denom string, admin sdk.AccAddress) *MsgSetDenomMetadataAdmin {
+	msg := &MsgSetDenomMetadataAdmin{Authority: authority.String(), Denom: denom}
+	if !admin.Empty() {
+		msg.Admin = admin.String()
+	}
+
+	return msg
+}
+
+// Route Implements Msg.
+func (msg MsgSetDenomMetadataAdmin) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetDenomMetadataAdmin) Type() string { return TypeMsgSetDenomMetadataAdmin }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetDenomMetadataAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if msg.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetDenomMetadataAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetDenomMetadataAdmin) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func validateAuthorityAndAddress(authority, addr string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
@@ -154,15 +320,23 @@ func NewOutput(addr sdk.AccAddress, coins sdk.Coins) Output {
 }
 
 // ValidateInputsOutputs validates that each respective input and output is
-// valid and that the sum of inputs is equal to the sum of outputs.
+// valid, that no address is used by more than one input and that the sum of
+// inputs is equal to the sum of outputs.
 func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 	var totalIn, totalOut sdk.Coins
 
+	seenInputs := make(map[string]bool, len(inputs))
 	for _, in := range inputs {
 		if err := in.ValidateBasic(); err != nil {
 			return err
 		}
 
+		inAddr, _ := sdk.AccAddressFromBech32(in.Address)
+		if seenInputs[string(inAddr)] {
+			return sdkerrors.Wrap(ErrDuplicateInput, in.Address)
+		}
+		seenInputs[string(inAddr)] = true
+
 		totalIn = totalIn.Add(in.Coins...)
 	}
 
@@ -164,13 +164,15 @@ func TestOutputValidation(t *testing.T) {
 func TestMsgMultiSendValidation(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("_______alice________"))
 	addr2 := sdk.AccAddress([]byte("________bob_________"))
+	addr3 := sdk.AccAddress([]byte("_______carol________"))
 	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
 	atom124 := sdk.NewCoins(sdk.NewInt64Coin("atom", 124))
 	eth123 := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	atom123eth123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 123))
+	atom124eth122 := sdk.NewCoins(sdk.NewInt64Coin("atom", 124), sdk.NewInt64Coin("eth", 122))
 
 	input1 := NewInput(addr1, atom123)
-	input2 := NewInput(addr1, eth123)
+	input2 := NewInput(addr3, eth123)
 	output1 := NewOutput(addr2, atom123)
 	output2 := NewOutput(addr2, atom124)
 	outputMulti := NewOutput(addr2, atom123eth123)
@@ -203,6 +205,14 @@ func TestMsgMultiSendValidation(t *testing.T) {
 			Inputs:  []Input{input1, input2},
 			Outputs: []Output{outputMulti}},
 		},
+		{false, MsgMultiSend{
+			Inputs:  []Input{input1, NewInput(addr1, eth123)}, // duplicate input
+			Outputs: []Output{outputMulti}},
+		},
+		{false, MsgMultiSend{
+			Inputs:  []Input{input1, input2},
+			Outputs: []Output{NewOutput(addr2, atom124eth122)}}, // totals dont match per denom
+		},
 	}
 
 	for i, tc := range cases {
@@ -252,3 +262,79 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgSetBlockedAddressValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	addr := sdk.AccAddress([]byte("blocked_____________"))
+
+	msg := NewMsgSetBlockedAddress(authority, addr)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+	require.Equal(t, TypeMsgSetBlockedAddress, msg.Type())
+
+	require.Error(t, NewMsgSetBlockedAddress(sdk.AccAddress{}, addr).ValidateBasic())
+	require.Error(t, NewMsgSetBlockedAddress(authority, sdk.AccAddress{}).ValidateBasic())
+}
+
+func TestMsgRemoveBlockedAddressValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	addr := sdk.AccAddress([]byte("blocked_____________"))
+
+	msg := NewMsgRemoveBlockedAddress(authority, addr)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+	require.Equal(t, TypeMsgRemoveBlockedAddress, msg.Type())
+
+	require.Error(t, NewMsgRemoveBlockedAddress(sdk.AccAddress{}, addr).ValidateBasic())
+	require.Error(t, NewMsgRemoveBlockedAddress(authority, sdk.AccAddress{}).ValidateBasic())
+}
+
+func TestMsgSetDenomMetadataValidation(t *testing.T) {
+	signer := sdk.AccAddress([]byte("signer______________"))
+	metadata := Metadata{
+		Name:   "Foo Token",
+		Symbol: "FOO",
+		DenomUnits: []*DenomUnit{
+			{Denom: "ufoo", Exponent: 0},
+			{Denom: "foo", Exponent: 6},
+		},
+		Base:    "ufoo",
+		Display: "foo",
+	}
+
+	msg := NewMsgSetDenomMetadata(signer, metadata)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{signer}, msg.GetSigners())
+	require.Equal(t, TypeMsgSetDenomMetadata, msg.Type())
+
+	require.Error(t, NewMsgSetDenomMetadata(sdk.AccAddress{}, metadata).ValidateBasic())
+
+	noDisplay := metadata
+	noDisplay.Display = "kfoo"
+	require.Error(t, NewMsgSetDenomMetadata(signer, noDisplay).ValidateBasic())
+
+	unsorted := metadata
+	unsorted.DenomUnits = []*DenomUnit{
+		{Denom: "ufoo", Exponent: 0},
+		{Denom: "foo", Exponent: 6},
+		{Denom: "mfoo", Exponent: 3},
+	}
+	require.Error(t, NewMsgSetDenomMetadata(signer, unsorted).ValidateBasic())
+}
+
+func TestMsgSetDenomMetadataAdminValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	admin := sdk.AccAddress([]byte("admin_______________"))
+
+	msg := NewMsgSetDenomMetadataAdmin(authority, "ufoo", admin)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+	require.Equal(t, TypeMsgSetDenomMetadataAdmin, msg.Type())
+
+	// an empty admin removes the existing one
+	require.NoError(t, NewMsgSetDenomMetadataAdmin(authority, "ufoo", nil).ValidateBasic())
+
+	require.Error(t, NewMsgSetDenomMetadataAdmin(sdk.AccAddress{}, "ufoo", admin).ValidateBasic())
+	require.Error(t, NewMsgSetDenomMetadataAdmin(authority, "", admin).ValidateBasic())
+	require.Error(t, (&MsgSetDenomMetadataAdmin{Authority: authority.String(), Denom: "ufoo", Admin: "invalid"}).ValidateBasic())
+}
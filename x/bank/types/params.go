@@ -13,6 +13,9 @@ import (
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
+	// DefaultMaxMultiSendEntries is the default bound on the number of inputs
+	// and outputs in a multi-send
+	DefaultMaxMultiSendEntries uint64 = 100
 )
 
 var (
@@ -20,6 +23,8 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeyMaxMultiSendEntries is store's key for the MaxMultiSendEntries option
+	KeyMaxMultiSendEntries = []byte("MaxMultiSendEntries")
 )
 
 // ParamKeyTable for bank module.
@@ -28,10 +33,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the bank module
-func NewParams(defaultSendEnabled bool, sendEnabledParams SendEnabledParams) Params {
+func NewParams(defaultSendEnabled bool, sendEnabledParams SendEnabledParams, maxMultiSendEntries uint64) Params {
 	return Params{
-		SendEnabled:        sendEnabledParams,
-		DefaultSendEnabled: defaultSendEnabled,
+		SendEnabled:         sendEnabledParams,
+		DefaultSendEnabled:  defaultSendEnabled,
+		MaxMultiSendEntries: maxMultiSendEntries,
 	}
 }
 
@@ -40,7 +46,8 @@ func DefaultParams() Params {
 	return Params{
 		SendEnabled: SendEnabledParams{},
 		// The default send enabled value allows send transfers for all coin denoms
-		DefaultSendEnabled: true,
+		DefaultSendEnabled:  true,
+		MaxMultiSendEntries: DefaultMaxMultiSendEntries,
 	}
 }
 
@@ -49,6 +56,9 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
+	if err := validateMaxMultiSendEntries(p.MaxMultiSendEntries); err != nil {
+		return err
+	}
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
@@ -78,7 +88,7 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
+	return NewParams(p.DefaultSendEnabled, sendParams, p.MaxMultiSendEntries)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -86,6 +96,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeyMaxMultiSendEntries, &p.MaxMultiSendEntries, validateMaxMultiSendEntries),
 	}
 }
 
@@ -150,3 +161,18 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMaxMultiSendEntries(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// zero disables the bound, otherwise there must be room for at least one
+	// input and one output
+	if v == 1 {
+		return fmt.Errorf("max multi-send entries must be zero or at least 2: %d", v)
+	}
+
+	return nil
+}
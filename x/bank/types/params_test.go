@@ -91,18 +91,25 @@ func Test_validateParams(t *testing.T) {
 	require.True(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
 	require.False(t, params.SendEnabledDenom("foodenom2"))
 
-	paramYaml := "default_send_enabled: true\nsend_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
+	paramYaml := "default_send_enabled: true\nmax_multi_send_entries: 100\nsend_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
 	require.Equal(t, paramYaml, params.String())
 
 	// Ensure proper format of yaml output when false
 	params.DefaultSendEnabled = false
-	paramYaml = "send_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
+	paramYaml = "max_multi_send_entries: 100\nsend_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
 	require.Equal(t, paramYaml, params.String())
 
+	// a multi-send always needs room for one input and one output
+	params.MaxMultiSendEntries = 1
+	require.Error(t, params.Validate())
+	params.MaxMultiSendEntries = 0
+	require.NoError(t, params.Validate())
+	require.Error(t, validateMaxMultiSendEntries(int64(100)))
+
 	params = NewParams(true, SendEnabledParams{
 		NewSendEnabled("foodenom", false),
 		NewSendEnabled("foodenom", true), // this is not allowed
-	})
+	}, DefaultMaxMultiSendEntries)
 
 	// fails due to duplicate entries.
 	require.Error(t, params.Validate())
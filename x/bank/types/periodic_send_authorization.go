@@ -0,0 +1,85 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &PeriodicSendAuthorization{}
+)
+
+// NewPeriodicSendAuthorization creates a new PeriodicSendAuthorization object.
+// The first period starts at the block time of the first send.
+func NewPeriodicSendAuthorization(period time.Duration, periodSpendLimit sdk.Coins) *PeriodicSendAuthorization {
+	return &PeriodicSendAuthorization{
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. The amount is added to the coins
+// spent in the current period, which must stay within the period spend limit.
+// The authorization is never deleted, the period spend limit is available
+// again in the next period.
+func (a PeriodicSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	a.tryResetPeriod(ctx.BlockTime())
+
+	spent := a.PeriodSpent.Add(mSend.Amount...)
+	if _, isNegative := a.PeriodSpendLimit.SafeSub(spent); isNegative {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "requested amount is more than the period spend limit")
+	}
+	a.PeriodSpent = spent
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+}
+
+// tryResetPeriod starts a new period once the block time reaches PeriodReset,
+// clearing the coins spent. Within one period of the previous reset, the new
+// period starts at the previous reset, so periods stay aligned. Otherwise, as
+// for the first send, it starts at the block time. The block time is always
+// before the new PeriodReset, so all the sends of a block share the period.
+func (a *PeriodicSendAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodSpent = nil
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if !blockTime.Before(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicSendAuthorization) ValidateBasic() error {
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+	if a.PeriodSpendLimit == nil {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit cannot be nil")
+	}
+	if !a.PeriodSpendLimit.IsValid() || !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodSpent.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spent is invalid: %s", a.PeriodSpent)
+	}
+	if _, isNegative := a.PeriodSpendLimit.SafeSub(a.PeriodSpent); isNegative {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spent is more than the period spend limit")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestPeriodicSendAuthorizationAccept(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	authorization := types.NewPeriodicSendAuthorization(24*time.Hour, stake(100))
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	_, err := authorization.Accept(ctx, &stakingtypes.MsgDelegate{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	// the steps are run in order, each one on the authorization updated by the
+	// previous accepted steps
+	steps := []struct {
+		name      string
+		blockTime time.Time
+		amount    sdk.Coins
+		expErr    bool
+		expSpent  sdk.Coins
+		expReset  time.Time
+	}{
+		{"first send starts the period", now, stake(60), false, stake(60), now.Add(24 * time.Hour)},
+		{"second send in the same block", now, stake(40), false, stake(100), now.Add(24 * time.Hour)},
+		{"third send in the same block exceeds the limit", now, stake(1), true, nil, time.Time{}},
+		{"send just before the period ends", now.Add(24*time.Hour - time.Second), stake(1), true, nil, time.Time{}},
+		{"send at the period reset", now.Add(24 * time.Hour), stake(100), false, stake(100), now.Add(48 * time.Hour)},
+		{"send in the same block as the period reset", now.Add(24 * time.Hour), stake(1), true, nil, time.Time{}},
+		{"send in the next period keeps the periods aligned", now.Add(50 * time.Hour), stake(10), false, stake(10), now.Add(72 * time.Hour)},
+		{"send of another denom", now.Add(50 * time.Hour), sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), true, nil, time.Time{}},
+		{"send of the remaining limit", now.Add(51 * time.Hour), stake(90), false, stake(100), now.Add(72 * time.Hour)},
+		{"send after several idle periods starts at the block time", now.Add(200 * time.Hour), stake(30), false, stake(30), now.Add(224 * time.Hour)},
+		{"send of more than the limit in a new period", now.Add(224 * time.Hour), stake(101), true, nil, time.Time{}},
+	}
+
+	for _, step := range steps {
+		resp, err := authorization.Accept(ctx.WithBlockTime(step.blockTime), types.NewMsgSend(fromAddr, toAddr, step.amount))
+		if step.expErr {
+			require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds, step.name)
+			continue
+		}
+
+		require.NoError(t, err, step.name)
+		require.True(t, resp.Accept, step.name)
+		require.False(t, resp.Delete, step.name)
+		updated, ok := resp.Updated.(*types.PeriodicSendAuthorization)
+		require.True(t, ok, step.name)
+		require.Equal(t, step.expSpent, updated.PeriodSpent, step.name)
+		require.Equal(t, step.expReset, updated.PeriodReset, step.name)
+		require.Equal(t, stake(100), updated.PeriodSpendLimit, step.name)
+		require.NoError(t, updated.ValidateBasic(), step.name)
+		authorization = updated
+	}
+}
+
+func TestPeriodicSendAuthorizationAcceptDoesNotModifyAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	authorization := types.NewPeriodicSendAuthorization(time.Hour, coins1000)
+	expected := *authorization
+
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.Equal(t, coins500, resp.Updated.(*types.PeriodicSendAuthorization).PeriodSpent)
+	require.Equal(t, expected, *authorization)
+}
+
+func TestPeriodicSendAuthorizationValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		authorization types.PeriodicSendAuthorization
+		expErr        bool
+	}{
+		"valid": {
+			authorization: types.PeriodicSendAuthorization{Period: time.Hour, PeriodSpendLimit: coins1000, PeriodSpent: coins500},
+		},
+		"zero period": {
+			authorization: types.PeriodicSendAuthorization{PeriodSpendLimit: coins1000},
+			expErr:        true,
+		},
+		"negative period": {
+			authorization: types.PeriodicSendAuthorization{Period: -time.Hour, PeriodSpendLimit: coins1000},
+			expErr:        true,
+		},
+		"nil period spend limit": {
+			authorization: types.PeriodicSendAuthorization{Period: time.Hour},
+			expErr:        true,
+		},
+		"zero period spend limit": {
+			authorization: types.PeriodicSendAuthorization{Period: time.Hour, PeriodSpendLimit: sdk.Coins{sdk.NewInt64Coin("stake", 0)}},
+			expErr:        true,
+		},
+		"period spent more than the limit": {
+			authorization: types.PeriodicSendAuthorization{Period: time.Hour, PeriodSpendLimit: coins500, PeriodSpent: coins1000},
+			expErr:        true,
+		},
+		"period spent of another denom": {
+			authorization: types.PeriodicSendAuthorization{Period: time.Hour, PeriodSpendLimit: coins1000, PeriodSpent: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))},
+			expErr:        true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
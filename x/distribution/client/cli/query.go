@@ -27,10 +27,15 @@ func GetQueryCmd() *cobra.Command {
 	distQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryValidatorOutstandingRewards(),
+		GetCmdQueryAllValidatorOutstandingRewards(),
 		GetCmdQueryValidatorCommission(),
+		GetCmdQueryValidatorCommissionWithdrawAddress(),
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
+		GetCmdQueryDelegationRewardHistory(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryCommunityTaxDestinations(),
+		GetCmdQueryRestakeEntries(),
 	)
 
 	return distQueryCmd
@@ -108,6 +113,67 @@ $ %s query distribution validator-outstanding-rewards %s1lwjmdnks33xwnmfayc64ycp
 	return cmd
 }
 
+// GetCmdQueryAllValidatorOutstandingRewards implements the query all
+// validators outstanding rewards command.
+func GetCmdQueryAllValidatorOutstandingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outstanding-all",
+		Args:  cobra.NoArgs,
+		Short: "Query distribution outstanding rewards and commission of all the validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query distribution outstanding (un-withdrawn) rewards and accumulated commission of
+all the validators, ordered by validator address. The validators whose outstanding
+rewards are below the optional minimum amount are skipped.
+
+Example:
+$ %s query distribution outstanding-all
+$ %s query distribution outstanding-all --%s=1000stake
+`,
+				version.AppName, version.AppName, FlagMinAmount,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			minAmountStr, err := cmd.Flags().GetString(FlagMinAmount)
+			if err != nil {
+				return err
+			}
+			minAmount, err := sdk.ParseDecCoins(minAmountStr)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllValidatorOutstandingRewards(
+				cmd.Context(),
+				&types.QueryAllValidatorOutstandingRewardsRequest{
+					MinAmount:  minAmount,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagMinAmount, "", "Skip the validators with less outstanding rewards than this amount")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators outstanding rewards")
+	return cmd
+}
+
 // GetCmdQueryValidatorCommission implements the query validator commission command.
 func GetCmdQueryValidatorCommission() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -153,6 +219,52 @@ $ %s query distribution commission %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorCommissionWithdrawAddress implements the query validator
+// commission withdraw address command.
+func GetCmdQueryValidatorCommissionWithdrawAddress() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "commission-withdraw-addr [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query distribution validator commission withdraw address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address the commission of a validator is withdrawn to.
+
+Example:
+$ %s query distribution commission-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorCommissionWithdrawAddress(
+				cmd.Context(),
+				&types.QueryValidatorCommissionWithdrawAddressRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryValidatorSlashes implements the query validator slashes command.
 func GetCmdQueryValidatorSlashes() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -271,7 +383,7 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 
 			res, err := queryClient.DelegationTotalRewards(
 				ctx,
-				&types.QueryDelegationTotalRewardsRequest{DelegatorAddress: delegatorAddr.String()},
+				&types.QueryDelegationTotalRewardsRequest{DelegatorAddress: delegatorAddr.String(), Height: clientCtx.Height},
 			)
 			if err != nil {
 				return err
@@ -285,6 +397,68 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 	return cmd
 }
 
+// GetCmdQueryDelegationRewardHistory implements the query delegation reward history command.
+func GetCmdQueryDelegationRewardHistory() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "reward-history [delegator-addr] [validator-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the reference points the rewards of a delegation are derived from",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator periods, cumulative reward ratios and slashes from which
+the rewards of a delegation are calculated, since the delegation last withdrew its rewards.
+
+Example:
+$ %s query distribution reward-history %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationRewardHistory(
+				cmd.Context(),
+				&types.QueryDelegationRewardHistoryRequest{
+					DelegatorAddress: delegatorAddr.String(),
+					ValidatorAddress: validatorAddr.String(),
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegation reward history slashes")
+	return cmd
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info.
 func GetCmdQueryCommunityPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -319,3 +493,101 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryCommunityTaxDestinations implements the query community tax
+// destinations command.
+func GetCmdQueryCommunityTaxDestinations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-tax-destinations",
+		Args:  cobra.NoArgs,
+		Short: "Query the split of the community tax",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fractions of the collected fees sent to each destination out of the
+community tax. An empty address stands for the community pool, which receives the
+rest of the community tax.
+
+Example:
+$ %s query distribution community-tax-destinations
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CommunityTaxDestinations(cmd.Context(), &types.QueryCommunityTaxDestinationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRestakeEntries implements the query restake entries command.
+func GetCmdQueryRestakeEntries() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "restake-entries [delegator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the delegations opted in to auto-restaking",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations opted in to auto-restaking, optionally only the ones
+of a delegator.
+
+Example:
+$ %s query distribution restake-entries
+$ %s query distribution restake-entries %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var delegatorAddr string
+			if len(args) > 0 {
+				delAddr, err := sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				delegatorAddr = delAddr.String()
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RestakeEntries(
+				cmd.Context(),
+				&types.QueryRestakeEntriesRequest{
+					DelegatorAddress: delegatorAddr,
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "restake entries")
+	return cmd
+}
@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -16,14 +15,10 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// Transaction flags for the x/distribution module
+// Flags for the x/distribution module
 var (
-	FlagCommission       = "commission"
-	FlagMaxMessagesPerTx = "max-msgs"
-)
-
-const (
-	MaxMessagesPerTxDefault = 0
+	FlagCommission = "commission"
+	FlagMinAmount  = "min-amount"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/distribution transaction commands.
@@ -40,41 +35,15 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawRewardsCmd(),
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
+		NewSetCommissionWithdrawAddrCmd(),
+		NewEnableAutoRestakeCmd(),
+		NewDisableAutoRestakeCmd(),
 		NewFundCommunityPoolCmd(),
 	)
 
 	return distTxCmd
 }
 
-type newGenerateOrBroadcastFunc func(client.Context, *pflag.FlagSet, ...sdk.Msg) error
-
-func newSplitAndApply(
-	genOrBroadcastFn newGenerateOrBroadcastFunc, clientCtx client.Context,
-	fs *pflag.FlagSet, msgs []sdk.Msg, chunkSize int,
-) error {
-
-	if chunkSize == 0 {
-		return genOrBroadcastFn(clientCtx, fs, msgs...)
-	}
-
-	// split messages into slices of length chunkSize
-	totalMessages := len(msgs)
-	for i := 0; i < len(msgs); i += chunkSize {
-
-		sliceEnd := i + chunkSize
-		if sliceEnd > totalMessages {
-			sliceEnd = totalMessages
-		}
-
-		msgChunk := msgs[i:sliceEnd]
-		if err := genOrBroadcastFn(clientCtx, fs, msgChunk...); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 func NewWithdrawRewardsCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
@@ -123,15 +92,17 @@ $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 func NewWithdrawAllRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw-all-rewards",
-		Short: "withdraw all delegations rewards for a delegator",
+		Short: "withdraw all delegations rewards for a delegator, and optionally the validator commission",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw all rewards for a single delegator.
-Note that if you use this command with --%[2]s=%[3]s or --%[2]s=%[4]s, the %[5]s flag will automatically be set to 0.
+			fmt.Sprintf(`Withdraw all rewards for a single delegator in a single message,
+and optionally withdraw validator commission if the delegator is a validator operator.
+The number of delegations is bounded by the max_withdraw_all_delegations param.
 
 Example:
 $ %[1]s tx distribution withdraw-all-rewards --from mykey
+$ %[1]s tx distribution withdraw-all-rewards --from mykey --commission
 `,
-				version.AppName, flags.FlagBroadcastMode, flags.BroadcastSync, flags.BroadcastAsync, FlagMaxMessagesPerTx,
+				version.AppName,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -142,42 +113,14 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 			}
 			delAddr := clientCtx.GetFromAddress()
 
-			// The transaction cannot be generated offline since it requires a query
-			// to get all the validators.
-			if clientCtx.Offline {
-				return fmt.Errorf("cannot generate tx in offline mode")
-			}
-
-			queryClient := types.NewQueryClient(clientCtx)
-			delValsRes, err := queryClient.DelegatorValidators(cmd.Context(), &types.QueryDelegatorValidatorsRequest{DelegatorAddress: delAddr.String()})
-			if err != nil {
-				return err
-			}
-
-			validators := delValsRes.Validators
-			// build multi-message transaction
-			msgs := make([]sdk.Msg, 0, len(validators))
-			for _, valAddr := range validators {
-				val, err := sdk.ValAddressFromBech32(valAddr)
-				if err != nil {
-					return err
-				}
-
-				msg := types.NewMsgWithdrawDelegatorReward(delAddr, val)
-				msgs = append(msgs, msg)
-			}
-
-			chunkSize, _ := cmd.Flags().GetInt(FlagMaxMessagesPerTx)
-			if clientCtx.BroadcastMode != flags.BroadcastBlock && chunkSize > 0 {
-				return fmt.Errorf("cannot use broadcast mode %[1]s with %[2]s != 0",
-					clientCtx.BroadcastMode, FlagMaxMessagesPerTx)
-			}
+			commission, _ := cmd.Flags().GetBool(FlagCommission)
+			msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr, commission)
 
-			return newSplitAndApply(tx.GenerateOrBroadcastTxCLI, clientCtx, cmd.Flags(), msgs, chunkSize)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().Bool(FlagCommission, false, "Withdraw the validator's commission in addition to the rewards")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -221,6 +164,129 @@ $ %s tx distribution set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+func NewSetCommissionWithdrawAddrCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-commission-withdraw-addr [withdraw-addr]",
+		Short: "change the withdraw address for the commission of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the withdraw address for the commission of the validator operated by the
+sender. The self-delegation rewards of the validator keep being withdrawn to the
+address set with set-withdraw-addr.
+
+Example:
+$ %s tx distribution set-commission-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCommissionWithdrawAddress(valAddr, withdrawAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewEnableAutoRestakeCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "enable-auto-restake [validator-addr] [threshold]",
+		Short: "periodically delegate the rewards of a delegation back to its validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt a delegation in to auto-restaking. Its rewards are periodically withdrawn and
+delegated back to the validator once they reach the threshold, an amount of the
+bond denom. The rewards of the sender must be withdrawn to the sender itself.
+
+Example:
+$ %s tx distribution enable-auto-restake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			threshold, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid threshold: %s", args[1])
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, valAddr, true, threshold)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDisableAutoRestakeCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "disable-auto-restake [validator-addr]",
+		Short: "stop restaking the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt a delegation out of auto-restaking.
+
+Example:
+$ %s tx distribution disable-auto-restake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, valAddr, false, sdk.ZeroInt())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewFundCommunityPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-community-pool [amount]",
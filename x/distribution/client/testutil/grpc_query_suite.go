@@ -13,6 +13,7 @@ import (
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type GRPCQueryTestSuite struct {
@@ -242,6 +243,14 @@ func (s *GRPCQueryTestSuite) TestQueryDelegatorRewardsGRPC() {
 	rewards, err := sdk.ParseDecCoins("9.8stake")
 	s.Require().NoError(err)
 
+	expReward := types.NewDelegationDelegatorReward(val.ValAddress, rewards)
+	expReward.Description = stakingtypes.Description{Moniker: val.Moniker}
+	expTotalRewards := &types.QueryDelegationTotalRewardsResponse{
+		Rewards:      []types.DelegationDelegatorReward{expReward},
+		Total:        rewards,
+		TotalByDenom: map[string]string{"stake": "9.800000000000000000"},
+	}
+
 	testCases := []struct {
 		name     string
 		url      string
@@ -266,12 +275,15 @@ func (s *GRPCQueryTestSuite) TestQueryDelegatorRewardsGRPC() {
 			},
 			false,
 			&types.QueryDelegationTotalRewardsResponse{},
-			&types.QueryDelegationTotalRewardsResponse{
-				Rewards: []types.DelegationDelegatorReward{
-					types.NewDelegationDelegatorReward(val.ValAddress, rewards),
-				},
-				Total: rewards,
-			},
+			expTotalRewards,
+		},
+		{
+			"valid request with height",
+			fmt.Sprintf("%s/cosmos/distribution/v1beta1/delegators/%s/rewards?height=2", baseURL, val.Address.String()),
+			map[string]string{},
+			false,
+			&types.QueryDelegationTotalRewardsResponse{},
+			expTotalRewards,
 		},
 		{
 			"wrong validator address(specific validator rewards)",
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
@@ -14,7 +15,10 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -74,7 +78,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true,"max_withdraw_all_delegations":"100","restake_interval":"100","max_restakes_per_block":"100"}`,
 		},
 		{
 			"text output",
@@ -82,6 +86,9 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			`base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
 community_tax: "0.020000000000000000"
+max_restakes_per_block: "100"
+max_withdraw_all_delegations: "100"
+restake_interval: "100"
 withdraw_addr_enabled: true`,
 		},
 	}
@@ -163,6 +170,74 @@ func (s *IntegrationTestSuite) TestGetCmdQueryValidatorOutstandingRewards() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryAllValidatorOutstandingRewards() {
+	val := s.network.Validators[0]
+
+	_, err := s.network.WaitForHeight(4)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name       string
+		args       []string
+		expectErr  bool
+		expEntries int
+	}{
+		{
+			"invalid min amount",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				fmt.Sprintf("--%s=foo", cli.FlagMinAmount),
+			},
+			true,
+			0,
+		},
+		{
+			"all validators",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			1,
+		},
+		{
+			"min amount above the outstanding rewards",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				fmt.Sprintf("--%s=1000000stake", cli.FlagMinAmount),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryAllValidatorOutstandingRewards()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryAllValidatorOutstandingRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().Len(res.Entries, tc.expEntries)
+				for _, entry := range res.Entries {
+					s.Require().Equal(sdk.ValAddress(val.Address).String(), entry.ValidatorAddress)
+					s.Require().False(entry.OutstandingRewards.IsZero())
+					s.Require().False(entry.Commission.IsZero())
+				}
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryValidatorCommission() {
 	val := s.network.Validators[0]
 
@@ -226,6 +301,67 @@ func (s *IntegrationTestSuite) TestGetCmdQueryValidatorCommission() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorCommissionWithdrawAddress() {
+	val := s.network.Validators[0]
+
+	_, err := s.network.WaitForHeight(4)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{
+			"invalid validator address",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				"foo",
+			},
+			true,
+			"",
+		},
+		{
+			"json output",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				sdk.ValAddress(val.Address).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			fmt.Sprintf(`{"withdraw_address":"%s"}`, val.Address),
+		},
+		{
+			"text output",
+			[]string{
+				fmt.Sprintf("--%s=text", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				sdk.ValAddress(val.Address).String(),
+			},
+			false,
+			fmt.Sprintf(`withdraw_address: %s`, val.Address),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorCommissionWithdrawAddress()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryValidatorSlashes() {
 	val := s.network.Validators[0]
 
@@ -345,7 +481,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegatorRewards() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			fmt.Sprintf(`{"rewards":[{"validator_address":"%s","reward":[{"denom":"stake","amount":"387.100000000000000000"}]}],"total":[{"denom":"stake","amount":"387.100000000000000000"}]}`, valAddr.String()),
+			fmt.Sprintf(`{"rewards":[{"validator_address":"%s","reward":[{"denom":"stake","amount":"387.100000000000000000"}],"description":{"moniker":"node0","identity":"","website":"","security_contact":"","details":""}}],"total":[{"denom":"stake","amount":"387.100000000000000000"}],"total_by_denom":{"stake":"387.100000000000000000"}}`, valAddr.String()),
 		},
 		{
 			"json output (specific validator)",
@@ -366,13 +502,21 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegatorRewards() {
 			},
 			false,
 			fmt.Sprintf(`rewards:
-- reward:
+- description:
+    details: ""
+    identity: ""
+    moniker: node0
+    security_contact: ""
+    website: ""
+  reward:
   - amount: "387.100000000000000000"
     denom: stake
   validator_address: %s
 total:
 - amount: "387.100000000000000000"
-  denom: stake`, valAddr.String()),
+  denom: stake
+total_by_denom:
+  stake: "387.100000000000000000"`, valAddr.String()),
 		},
 		{
 			"text output (specific validator)",
@@ -406,6 +550,70 @@ total:
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryDelegationRewardHistory() {
+	val := s.network.Validators[0]
+	addr := val.Address
+	valAddr := sdk.ValAddress(addr)
+
+	_, err := s.network.WaitForHeight(4)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid delegator address",
+			[]string{
+				fmt.Sprintf("--%s=5", flags.FlagHeight),
+				"foo", valAddr.String(),
+			},
+			true,
+		},
+		{
+			"invalid validator address",
+			[]string{
+				fmt.Sprintf("--%s=5", flags.FlagHeight),
+				addr.String(), "foo",
+			},
+			true,
+		},
+		{
+			"json output",
+			[]string{
+				fmt.Sprintf("--%s=5", flags.FlagHeight),
+				addr.String(), valAddr.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryDelegationRewardHistory()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryDelegationRewardHistoryResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().Empty(res.Slashes)
+				s.Require().Equal(res.StartingInfo.Stake, res.EndingPoint.Stake)
+				s.Require().False(res.Rewards.IsZero())
+				s.Require().Equal(res.Rewards, res.EndingPoint.Rewards)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryCommunityPool() {
 	val := s.network.Validators[0]
 
@@ -523,20 +731,21 @@ func (s *IntegrationTestSuite) TestNewWithdrawAllRewardsCmd() {
 		respType     proto.Message
 	}{
 		{
-			"valid transaction (offline)",
+			"valid transaction",
 			[]string{
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagOffline),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			true, 0, nil,
+			false, 0, &sdk.TxResponse{},
 		},
 		{
-			"valid transaction",
+			"valid transaction (with commission)",
 			[]string{
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=true", cli.FlagCommission),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
@@ -565,6 +774,26 @@ func (s *IntegrationTestSuite) TestNewWithdrawAllRewardsCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewWithdrawAllRewardsCmdGenerateOnly() {
+	val := s.network.Validators[0]
+
+	// the transaction no longer queries the delegations, so it can be generated
+	// offline as a single message
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=true", cli.FlagCommission),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewWithdrawAllRewardsCmd(), args)
+	s.Require().NoError(err)
+
+	tx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Len(tx.GetMsgs(), 1)
+	s.Require().Equal(types.NewMsgWithdrawAllDelegatorRewards(val.Address, true), tx.GetMsgs()[0])
+}
+
 func (s *IntegrationTestSuite) TestNewSetWithdrawAddrCmd() {
 	val := s.network.Validators[0]
 
@@ -620,6 +849,186 @@ func (s *IntegrationTestSuite) TestNewSetWithdrawAddrCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewSetCommissionWithdrawAddrCmd() {
+	val := s.network.Validators[0]
+	withdrawAddr := sdk.AccAddress("commission_withdraw_")
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"invalid withdraw address",
+			[]string{
+				"foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"blocked withdraw address",
+			[]string{
+				authtypes.NewModuleAddress(minttypes.ModuleName).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, sdkerrors.ErrUnauthorized.ABCICode(), &sdk.TxResponse{},
+		},
+		{
+			"valid transaction",
+			[]string{
+				withdrawAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetCommissionWithdrawAddrCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryValidatorCommissionWithdrawAddress(), []string{
+		sdk.ValAddress(val.Address).String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(fmt.Sprintf(`{"withdraw_address":"%s"}`, withdrawAddr), strings.TrimSpace(out.String()))
+}
+
+func (s *IntegrationTestSuite) TestNewAutoRestakeCmds() {
+	val := s.network.Validators[0]
+	valAddr := sdk.ValAddress(val.Address)
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"invalid validator address",
+			cli.NewEnableAutoRestakeCmd(),
+			[]string{
+				"foo", "10",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"invalid threshold",
+			cli.NewEnableAutoRestakeCmd(),
+			[]string{
+				valAddr.String(), "foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"no delegation",
+			cli.NewEnableAutoRestakeCmd(),
+			[]string{
+				sdk.ValAddress("no_such_validator___").String(), "10",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, types.ErrNoDelegationExists.ABCICode(), &sdk.TxResponse{},
+		},
+		{
+			"enable auto-restaking",
+			cli.NewEnableAutoRestakeCmd(),
+			[]string{
+				valAddr.String(), "10",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+
+	queryArgs := []string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryRestakeEntries(), queryArgs)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		fmt.Sprintf(`{"entries":[{"delegator_address":"%s","validator_address":"%s","threshold":"10"}],"pagination":{"next_key":null,"total":"0"}}`, val.Address, valAddr),
+		strings.TrimSpace(out.String()),
+	)
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewDisableAutoRestakeCmd(), []string{
+		valAddr.String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	})
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code)
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryRestakeEntries(), queryArgs)
+	s.Require().NoError(err)
+	s.Require().Equal(`{"entries":[],"pagination":{"next_key":null,"total":"0"}}`, strings.TrimSpace(out.String()))
+}
+
 func (s *IntegrationTestSuite) TestNewFundCommunityPoolCmd() {
 	val := s.network.Validators[0]
 
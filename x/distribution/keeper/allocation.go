@@ -96,7 +96,8 @@ func (k Keeper) AllocateTokens(
 		remaining = remaining.Sub(reward)
 	}
 
-	// allocate community funding
+	// allocate community funding, split with the community tax destinations
+	remaining = k.allocateCommunityTax(ctx, feesCollected, remaining)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
 	k.SetFeePool(ctx, feePool)
 }
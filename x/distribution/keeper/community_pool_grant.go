@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// check whether an account is the recipient of a vesting community pool grant
+func (k Keeper) IsCommunityPoolGrantee(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetCommunityPoolGranteeKey(addr))
+}
+
+// set an account as the recipient of a vesting community pool grant
+func (k Keeper) SetCommunityPoolGrantee(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCommunityPoolGranteeKey(addr), []byte{})
+}
+
+// iterate over the recipients of vesting community pool grants
+func (k Keeper) IterateCommunityPoolGrantees(ctx sdk.Context, handler func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CommunityPoolGranteePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetCommunityPoolGranteeAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// CommunityPoolSpendWithSchedule grants the sum of the vesting periods from the
+// community pool to the recipient, which vests it from startTime on. The
+// recipient must either not exist yet or be a base account, and becomes a
+// periodic vesting account.
+func (k Keeper) CommunityPoolSpendWithSchedule(ctx sdk.Context, recipient sdk.AccAddress, startTime int64, periods vestingtypes.Periods) error {
+	if k.blockedAddrs[recipient.String()] || k.bankKeeper.BlockedAddr(ctx, recipient) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	acc := k.authKeeper.GetAccount(ctx, recipient)
+	if acc == nil {
+		acc = k.authKeeper.NewAccountWithAddress(ctx, recipient)
+	}
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s cannot be given a vesting schedule", recipient)
+	}
+
+	var amount sdk.Coins
+	for _, period := range periods {
+		amount = amount.Add(period.Amount...)
+	}
+
+	k.authKeeper.SetAccount(ctx, vestingtypes.NewPeriodicVestingAccount(baseAcc, amount, startTime, periods))
+	if err := k.DistributeFromFeePool(ctx, amount, recipient); err != nil {
+		return err
+	}
+	k.SetCommunityPoolGrantee(ctx, recipient)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolSpend,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("granted community pool funds with a vesting schedule", "amount", amount.String(), "recipient", recipient.String())
+
+	return nil
+}
+
+// CommunityPoolClawback returns the coins of a community pool grant which have
+// not vested yet to the community pool. The unvested coins delegated by the
+// recipient cannot be reclaimed and keep vesting.
+func (k Keeper) CommunityPoolClawback(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	if !k.IsCommunityPoolGrantee(ctx, addr) {
+		return nil, sdkerrors.Wrapf(types.ErrNoCommunityPoolGrant, "account %s", addr)
+	}
+
+	acc, ok := k.authKeeper.GetAccount(ctx, addr).(*vestingtypes.PeriodicVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNoCommunityPoolGrant, "account %s is not a periodic vesting account", addr)
+	}
+
+	// the locked coins are the unvested coins not delegated
+	amount := acc.LockedCoins(ctx.BlockTime())
+	if amount.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	// remove the reclaimed coins from the schedule, the latest periods first
+	remaining := amount
+	for i := len(acc.VestingPeriods) - 1; i >= 0 && !remaining.IsZero(); i-- {
+		var taken sdk.Coins
+		for _, coin := range acc.VestingPeriods[i].Amount {
+			if amt := sdk.MinInt(coin.Amount, remaining.AmountOf(coin.Denom)); amt.IsPositive() {
+				taken = taken.Add(sdk.NewCoin(coin.Denom, amt))
+			}
+		}
+		acc.VestingPeriods[i].Amount = acc.VestingPeriods[i].Amount.Sub(taken)
+		remaining = remaining.Sub(taken)
+	}
+	acc.OriginalVesting = acc.OriginalVesting.Sub(amount)
+	k.authKeeper.SetAccount(ctx, acc)
+
+	if err := k.FundCommunityPool(ctx, amount, addr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolClawback,
+			sdk.NewAttribute(types.AttributeKeyRecipient, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return amount, nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// grantPeriods returns three periods of ten seconds vesting 100 bond tokens
+// each.
+func grantPeriods() vestingtypes.Periods {
+	return vestingtypes.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+	}
+}
+
+func setupCommunityPoolGrant(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000))
+
+	return app, ctx, addrs
+}
+
+func TestCommunityPoolSpendWithSchedule(t *testing.T) {
+	app, ctx, addrs := setupCommunityPoolGrant(t)
+	grantee := sdk.AccAddress("grantee_____________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	pool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	// a new account vests the grant from the start time on
+	require.NoError(t, app.DistrKeeper.CommunityPoolSpendWithSchedule(ctx, grantee, 1000, grantPeriods()))
+	acc, ok := app.AccountKeeper.GetAccount(ctx, grantee).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, amount, acc.OriginalVesting)
+	require.Equal(t, int64(1030), acc.EndTime)
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, grantee))
+	require.True(t, app.BankKeeper.SpendableCoins(ctx, grantee).IsZero())
+	require.Equal(t, pool.Sub(sdk.NewDecCoinsFromCoins(amount...)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	require.True(t, app.DistrKeeper.IsCommunityPoolGrantee(ctx, grantee))
+
+	// an existing base account keeps its balance spendable
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	require.NoError(t, app.DistrKeeper.CommunityPoolSpendWithSchedule(ctx, addrs[1], 1000, grantPeriods()))
+	require.Equal(t, balance, app.BankKeeper.SpendableCoins(ctx, addrs[1]))
+	ctx = ctx.WithBlockTime(time.Unix(1010, 0))
+	require.Equal(t, balance.Add(grantPeriods()[0].Amount...), app.BankKeeper.SpendableCoins(ctx, addrs[1]))
+
+	// vesting accounts cannot be given another schedule
+	err := app.DistrKeeper.CommunityPoolSpendWithSchedule(ctx, grantee, 1000, grantPeriods())
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the grant cannot exceed the community pool
+	periods := grantPeriods()
+	pool = app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	periods[2].Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, pool.AmountOf(sdk.DefaultBondDenom).TruncateInt()))
+	err = app.DistrKeeper.CommunityPoolSpendWithSchedule(ctx, sdk.AccAddress("other_grantee_______"), 1000, periods)
+	require.ErrorIs(t, err, types.ErrBadDistribution)
+}
+
+func TestCommunityPoolSpendWithScheduleBlockedAddr(t *testing.T) {
+	app, ctx, addrs := setupCommunityPoolGrant(t)
+
+	// statically and on-chain blocked addresses
+	app.BankKeeper.SetBlockedAddr(ctx, addrs[1])
+	for _, recipient := range []sdk.AccAddress{
+		authtypes.NewModuleAddress(minttypes.ModuleName),
+		addrs[1],
+	} {
+		err := app.DistrKeeper.CommunityPoolSpendWithSchedule(ctx, recipient, 1000, grantPeriods())
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.False(t, app.DistrKeeper.IsCommunityPoolGrantee(ctx, recipient))
+	}
+}
+
+func TestCommunityPoolClawback(t *testing.T) {
+	app, ctx, addrs := setupCommunityPoolGrant(t)
+	grantee := sdk.AccAddress("grantee_____________")
+
+	_, err := app.DistrKeeper.CommunityPoolClawback(ctx, grantee)
+	require.ErrorIs(t, err, types.ErrNoCommunityPoolGrant)
+	pool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	require.NoError(t, app.DistrKeeper.CommunityPoolSpendWithSchedule(ctx, grantee, 1000, grantPeriods()))
+	require.NoError(t, app.DistrKeeper.CommunityPoolSpendWithSchedule(ctx, addrs[1], 1000, grantPeriods()))
+	ctx = ctx.WithBlockTime(time.Unix(1015, 0))
+
+	// the two periods left to vest are reclaimed
+	amount, err := app.DistrKeeper.CommunityPoolClawback(ctx, grantee)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)), amount)
+	acc := app.AccountKeeper.GetAccount(ctx, grantee).(*vestingtypes.PeriodicVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), acc.OriginalVesting)
+	require.True(t, acc.VestingPeriods[1].Amount.IsZero())
+	require.True(t, acc.VestingPeriods[2].Amount.IsZero())
+	require.NoError(t, acc.Validate())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), app.BankKeeper.SpendableCoins(ctx, grantee))
+	require.Equal(t, pool.Sub(sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 400))), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// a second clawback has nothing left to reclaim
+	amount, err = app.DistrKeeper.CommunityPoolClawback(ctx, grantee)
+	require.NoError(t, err)
+	require.True(t, amount.IsZero())
+
+	// the unvested coins delegated by the recipient keep vesting, the latest
+	// periods being reclaimed first
+	valAddr := sdk.ValAddress(addrs[0])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, PKS[0], sdk.NewInt(100), true)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[1], sdk.NewInt(150), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	amount, err = app.DistrKeeper.CommunityPoolClawback(ctx, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), amount)
+	acc = app.AccountKeeper.GetAccount(ctx, addrs[1]).(*vestingtypes.PeriodicVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)), acc.OriginalVesting)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), acc.VestingPeriods[1].Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), acc.VestingPeriods[2].Amount)
+	require.NoError(t, acc.Validate())
+	require.Equal(t, pool.Sub(sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 350))), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
+func TestCommunityPoolGrantMsgs(t *testing.T) {
+	app, ctx, addrs := setupCommunityPoolGrant(t)
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := authtypes.NewModuleAddress("gov")
+	grantee := sdk.AccAddress("grantee_____________")
+
+	_, err := msgServer.CommunityPoolSpendWithSchedule(sdk.WrapSDKContext(ctx),
+		types.NewMsgCommunityPoolSpendWithSchedule(addrs[0], grantee, 1000, grantPeriods()))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.CommunityPoolSpendWithSchedule(sdk.WrapSDKContext(ctx),
+		types.NewMsgCommunityPoolSpendWithSchedule(authority, grantee, 1000, grantPeriods()))
+	require.NoError(t, err)
+
+	_, err = msgServer.CommunityPoolClawback(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolClawback(addrs[0], grantee))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	res, err := msgServer.CommunityPoolClawback(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolClawback(authority, grantee))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), res.Amount)
+
+	// the grantees are exported and imported with the genesis
+	genState := app.DistrKeeper.ExportGenesis(ctx)
+	require.Equal(t, []string{grantee.String()}, genState.CommunityPoolGrantees)
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx.KVStore(app.GetKey(types.StoreKey)).Delete(types.GetCommunityPoolGranteeKey(grantee))
+	app.DistrKeeper.InitGenesis(cacheCtx, *genState)
+	require.True(t, app.DistrKeeper.IsCommunityPoolGrantee(cacheCtx, grantee))
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// get the destinations of the community tax
+func (k Keeper) GetCommunityTaxDestinations(ctx sdk.Context) []types.CommunityTaxDestination {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CommunityTaxDestinationsKey)
+	if bz == nil {
+		return []types.CommunityTaxDestination{}
+	}
+
+	var destinations types.CommunityTaxDestinations
+	k.cdc.MustUnmarshal(bz, &destinations)
+	return destinations.Destinations
+}
+
+// set the destinations of the community tax
+func (k Keeper) SetCommunityTaxDestinations(ctx sdk.Context, destinations []types.CommunityTaxDestination) {
+	store := ctx.KVStore(k.storeKey)
+	if len(destinations) == 0 {
+		store.Delete(types.CommunityTaxDestinationsKey)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&types.CommunityTaxDestinations{Destinations: destinations})
+	store.Set(types.CommunityTaxDestinationsKey, b)
+}
+
+// UpdateCommunityTaxDestinations replaces the destinations of the community
+// tax. Their fractions must sum to at most the community tax, and none of them
+// may be an address blocked from receiving funds.
+func (k Keeper) UpdateCommunityTaxDestinations(ctx sdk.Context, destinations []types.CommunityTaxDestination) error {
+	if err := types.ValidateCommunityTaxDestinations(destinations, k.GetCommunityTax(ctx)); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidCommunityTax, err.Error())
+	}
+
+	for _, dest := range destinations {
+		if dest.Address == "" {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(dest.Address)
+		if err != nil {
+			return err
+		}
+		if k.blockedAddrs[addr.String()] || k.bankKeeper.BlockedAddr(ctx, addr) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", addr)
+		}
+	}
+
+	k.SetCommunityTaxDestinations(ctx, destinations)
+	return nil
+}
+
+// allocateCommunityTax sends the destinations of the community tax their
+// fraction of the collected fees out of the remaining ones, and returns what
+// is left for the community pool. The destinations only receive whole coins,
+// so the decimal remainders always stay in the community pool, as do the
+// shares which cannot be sent.
+func (k Keeper) allocateCommunityTax(ctx sdk.Context, feesCollected, remaining sdk.DecCoins) sdk.DecCoins {
+	destinations := k.GetCommunityTaxDestinations(ctx)
+	if len(destinations) == 0 {
+		return remaining
+	}
+
+	// the community tax may have been lowered since the destinations were set,
+	// they never receive more than it in total
+	budget := feesCollected.MulDecTruncate(k.GetCommunityTax(ctx)).Intersect(remaining)
+
+	for _, dest := range destinations {
+		// the community pool receives whatever is left
+		if dest.Address == "" {
+			continue
+		}
+
+		amount, _ := feesCollected.MulDecTruncate(dest.Fraction).Intersect(budget).TruncateDecimal()
+		if amount.IsZero() {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(dest.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		// the destinations blocked since they were set are not paid, while
+		// the module transfers only reject the statically blocked addresses
+		if k.bankKeeper.BlockedAddr(ctx, addr) {
+			k.Logger(ctx).Error("community tax destination is blocked, leaving its share to the community pool", "recipient", dest.Address)
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, addr, amount); err != nil {
+			k.Logger(ctx).Error("failed to send the community tax, leaving it to the community pool", "recipient", dest.Address, "err", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommunityTax,
+				sdk.NewAttribute(types.AttributeKeyRecipient, dest.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+
+		sent := sdk.NewDecCoinsFromCoins(amount...)
+		remaining = remaining.Sub(sent)
+		budget = budget.Sub(sent)
+	}
+
+	return remaining
+}
@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	devFund   = sdk.AccAddress("dev_fund____________")
+	otherFund = sdk.AccAddress("other_fund__________")
+)
+
+// setupCommunityTax creates two validators of equal power with a community
+// tax of 10%, and returns a function allocating the given fees as if both had
+// voted and the second was the proposer. The proposer then receives 5% of the
+// fees, and the community tax is what remains after the 85% allocated to the
+// validators.
+func setupCommunityTax(t *testing.T) (*simapp.SimApp, sdk.Context, func(ctx sdk.Context, fees int64)) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.CommunityTax = sdk.NewDecWithPrec(1, 1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 100}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: valConsPk2.Address(), Power: 100}, SignedLastBlock: true},
+	}
+	allocate := func(ctx sdk.Context, fees int64) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, fees))
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, authtypes.FeeCollectorName, coins))
+		app.DistrKeeper.AllocateTokens(ctx, 200, 200, valConsAddr2, votes)
+	}
+
+	return app, ctx, allocate
+}
+
+func requireDistributionInvariants(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+	msg, broken := keeper.AllInvariants(app.DistrKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestAllocateTokensCommunityTaxDestinations(t *testing.T) {
+	app, ctx, allocate := setupCommunityTax(t)
+	pool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	require.NoError(t, app.DistrKeeper.UpdateCommunityTaxDestinations(ctx, []types.CommunityTaxDestination{
+		{Address: devFund.String(), Fraction: sdk.NewDecWithPrec(5, 2)},
+		{Address: "", Fraction: sdk.NewDecWithPrec(37, 3)},
+		{Address: otherFund.String(), Fraction: sdk.NewDecWithPrec(13, 3)},
+	}))
+
+	// the community tax of 1001 fees is 100.1, of which 50.05 and 13.013 go
+	// to the funds, only in whole coins
+	allocate(ctx, 1001)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), app.BankKeeper.GetAllBalances(ctx, devFund))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 13)), app.BankKeeper.GetAllBalances(ctx, otherFund))
+
+	// the community pool receives its own fraction and every remainder
+	poolDelta := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).Sub(pool)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(371, 1)}}, poolDelta)
+	requireDistributionInvariants(t, app, ctx)
+
+	var recipients []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeCommunityTax {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyRecipient {
+				recipients = append(recipients, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{devFund.String(), otherFund.String()}, recipients)
+}
+
+func TestAllocateTokensCommunityTaxNoCoinsLost(t *testing.T) {
+	app, ctx, allocate := setupCommunityTax(t)
+
+	require.NoError(t, app.DistrKeeper.UpdateCommunityTaxDestinations(ctx, []types.CommunityTaxDestination{
+		{Address: devFund.String(), Fraction: sdk.NewDecWithPrec(33, 3)},
+		{Address: otherFund.String(), Fraction: sdk.NewDecWithPrec(67, 3)},
+	}))
+
+	distrAddr := app.DistrKeeper.GetDistributionAccount(ctx).GetAddress()
+	for _, fees := range []int64{1, 7, 29, 999, 1001, 123457} {
+		balance := app.BankKeeper.GetBalance(ctx, distrAddr, sdk.DefaultBondDenom)
+		pool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+		funds := app.BankKeeper.GetBalance(ctx, devFund, sdk.DefaultBondDenom).Add(app.BankKeeper.GetBalance(ctx, otherFund, sdk.DefaultBondDenom))
+
+		allocate(ctx, fees)
+
+		// whatever the funds do not receive stays in the distribution module
+		// account, with the rounding remainders credited to the community pool
+		sent := app.BankKeeper.GetBalance(ctx, devFund, sdk.DefaultBondDenom).Add(app.BankKeeper.GetBalance(ctx, otherFund, sdk.DefaultBondDenom)).Sub(funds)
+		kept := app.BankKeeper.GetBalance(ctx, distrAddr, sdk.DefaultBondDenom).Sub(balance)
+		require.Equal(t, fees, sent.Amount.Add(kept.Amount).Int64(), "fees %d", fees)
+		_, negative := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).SafeSub(pool)
+		require.False(t, negative, "fees %d", fees)
+		requireDistributionInvariants(t, app, ctx)
+	}
+}
+
+func TestAllocateTokensCommunityTaxDefault(t *testing.T) {
+	app, ctx, allocate := setupCommunityTax(t)
+	require.Empty(t, app.DistrKeeper.GetCommunityTaxDestinations(ctx))
+
+	// without destinations, as with a single community pool destination, the
+	// whole community tax goes to the community pool
+	for _, destinations := range [][]types.CommunityTaxDestination{
+		nil,
+		{{Address: "", Fraction: sdk.NewDecWithPrec(1, 1)}},
+	} {
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, app.DistrKeeper.UpdateCommunityTaxDestinations(cacheCtx, destinations))
+		pool := app.DistrKeeper.GetFeePoolCommunityCoins(cacheCtx)
+
+		allocate(cacheCtx, 1001)
+		poolDelta := app.DistrKeeper.GetFeePoolCommunityCoins(cacheCtx).Sub(pool)
+		require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(1001, 1)}}, poolDelta)
+		requireDistributionInvariants(t, app, cacheCtx)
+	}
+}
+
+func TestAllocateTokensCommunityTaxCapped(t *testing.T) {
+	app, ctx, allocate := setupCommunityTax(t)
+
+	require.NoError(t, app.DistrKeeper.UpdateCommunityTaxDestinations(ctx, []types.CommunityTaxDestination{
+		{Address: devFund.String(), Fraction: sdk.NewDecWithPrec(5, 2)},
+		{Address: otherFund.String(), Fraction: sdk.NewDecWithPrec(5, 2)},
+	}))
+
+	// a lowered community tax of 2% caps what the destinations receive, the
+	// first ones being served first
+	params := app.DistrKeeper.GetParams(ctx)
+	params.CommunityTax = sdk.NewDecWithPrec(2, 2)
+	app.DistrKeeper.SetParams(ctx, params)
+	pool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	allocate(ctx, 1001)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), app.BankKeeper.GetAllBalances(ctx, devFund))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, otherFund).IsZero())
+	poolDelta := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).Sub(pool)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(2, 2)}}, poolDelta)
+	requireDistributionInvariants(t, app, ctx)
+}
+
+func TestAllocateTokensCommunityTaxBlockedDestination(t *testing.T) {
+	app, ctx, allocate := setupCommunityTax(t)
+
+	require.NoError(t, app.DistrKeeper.UpdateCommunityTaxDestinations(ctx, []types.CommunityTaxDestination{
+		{Address: devFund.String(), Fraction: sdk.NewDecWithPrec(5, 2)},
+	}))
+
+	// a destination blocked since it was set leaves its share to the
+	// community pool
+	app.BankKeeper.SetBlockedAddr(ctx, devFund)
+	pool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	allocate(ctx, 1001)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, devFund).IsZero())
+	poolDelta := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).Sub(pool)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(1001, 1)}}, poolDelta)
+	requireDistributionInvariants(t, app, ctx)
+}
+
+func TestUpdateCommunityTaxDestinations(t *testing.T) {
+	app, ctx, _ := setupCommunityTax(t)
+
+	// the fractions cannot exceed the community tax
+	err := app.DistrKeeper.UpdateCommunityTaxDestinations(ctx, []types.CommunityTaxDestination{
+		{Address: devFund.String(), Fraction: sdk.NewDecWithPrec(6, 2)},
+		{Address: otherFund.String(), Fraction: sdk.NewDecWithPrec(5, 2)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCommunityTax)
+
+	// blocked addresses cannot be destinations
+	err = app.DistrKeeper.UpdateCommunityTaxDestinations(ctx, []types.CommunityTaxDestination{
+		{Address: authtypes.NewModuleAddress(minttypes.ModuleName).String(), Fraction: sdk.NewDecWithPrec(5, 2)},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Empty(t, app.DistrKeeper.GetCommunityTaxDestinations(ctx))
+
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	destinations := []types.CommunityTaxDestination{{Address: devFund.String(), Fraction: sdk.NewDecWithPrec(5, 2)}}
+
+	_, err = msgServer.SetCommunityTaxDestinations(sdk.WrapSDKContext(ctx), types.NewMsgSetCommunityTaxDestinations(devFund, destinations))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	authority := authtypes.NewModuleAddress("gov")
+	_, err = msgServer.SetCommunityTaxDestinations(sdk.WrapSDKContext(ctx), types.NewMsgSetCommunityTaxDestinations(authority, destinations))
+	require.NoError(t, err)
+	require.Equal(t, destinations, app.DistrKeeper.GetCommunityTaxDestinations(ctx))
+
+	// the destinations are exported and imported with the genesis
+	genState := app.DistrKeeper.ExportGenesis(ctx)
+	require.Equal(t, destinations, genState.CommunityTaxDestinations)
+	cacheCtx, _ := ctx.CacheContext()
+	app.DistrKeeper.SetCommunityTaxDestinations(cacheCtx, nil)
+	app.DistrKeeper.InitGenesis(cacheCtx, *genState)
+	require.Equal(t, destinations, app.DistrKeeper.GetCommunityTaxDestinations(cacheCtx))
+
+	// an empty list restores the whole community tax to the community pool
+	_, err = msgServer.SetCommunityTaxDestinations(sdk.WrapSDKContext(ctx), types.NewMsgSetCommunityTaxDestinations(authority, nil))
+	require.NoError(t, err)
+	require.Empty(t, app.DistrKeeper.GetCommunityTaxDestinations(ctx))
+}
@@ -136,6 +136,57 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.Val
 	return rewards
 }
 
+// delegationRewardReferencePoints returns the reference points the rewards of a
+// delegation are calculated from in CalculateDelegationRewards: the period the
+// delegation started at, the slashes applied to its stake since then and the
+// ending period.
+func (k Keeper) delegationRewardReferencePoints(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (
+	start types.DelegationRewardReferencePoint, slashes []types.DelegationRewardReferencePoint, end types.DelegationRewardReferencePoint) {
+	referencePoint := func(period, height uint64, stake sdk.Dec, rewards sdk.DecCoins, fraction sdk.Dec) types.DelegationRewardReferencePoint {
+		return types.DelegationRewardReferencePoint{
+			Period:                period,
+			Height:                height,
+			CumulativeRewardRatio: k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), period).CumulativeRewardRatio,
+			Stake:                 stake,
+			Rewards:               rewards,
+			SlashFraction:         fraction,
+		}
+	}
+
+	startingInfo := k.GetDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+	startingPeriod := startingInfo.PreviousPeriod
+	stake := startingInfo.Stake
+	start = referencePoint(startingPeriod, startingInfo.Height, stake, nil, sdk.ZeroDec())
+
+	endingHeight := uint64(ctx.BlockHeight())
+	if startingInfo.Height == endingHeight {
+		// started this height, no rewards yet
+		return start, nil, referencePoint(endingPeriod, endingHeight, stake, nil, sdk.ZeroDec())
+	}
+
+	k.IterateValidatorSlashEventsBetween(ctx, del.GetValidatorAddr(), startingInfo.Height, endingHeight,
+		func(height uint64, event types.ValidatorSlashEvent) (stop bool) {
+			if event.ValidatorPeriod > startingPeriod {
+				rewards := k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, event.ValidatorPeriod, stake)
+				slashes = append(slashes, referencePoint(event.ValidatorPeriod, height, stake, rewards, event.Fraction))
+
+				stake = stake.MulTruncate(sdk.OneDec().Sub(event.Fraction))
+				startingPeriod = event.ValidatorPeriod
+			}
+			return false
+		},
+	)
+
+	// the rounding error tolerated by CalculateDelegationRewards is corrected
+	// for in the same way
+	if currentStake := val.TokensFromShares(del.GetShares()); stake.GT(currentStake) {
+		stake = currentStake
+	}
+
+	rewards := k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)
+	return start, slashes, referencePoint(endingPeriod, endingHeight, stake, rewards, sdk.ZeroDec())
+}
+
 func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
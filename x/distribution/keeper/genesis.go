@@ -85,6 +85,39 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, cwi := range data.ValidatorCommissionWithdrawInfos {
+		valAddr, err := sdk.ValAddressFromBech32(cwi.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		withdrawAddress, err := sdk.AccAddressFromBech32(cwi.WithdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddress)
+	}
+	for _, entry := range data.RestakeEntries {
+		delegatorAddress, err := sdk.AccAddressFromBech32(entry.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetRestakeEntry(ctx, delegatorAddress, valAddr, entry.Threshold)
+	}
+	for _, grantee := range data.CommunityPoolGrantees {
+		addr, err := sdk.AccAddressFromBech32(grantee)
+		if err != nil {
+			panic(err)
+		}
+		k.SetCommunityPoolGrantee(ctx, addr)
+	}
+
+	if err := k.UpdateCommunityTaxDestinations(ctx, data.CommunityTaxDestinations); err != nil {
+		panic(err)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -190,5 +223,28 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	cwi := make([]types.ValidatorCommissionWithdrawInfo, 0)
+	k.IterateValidatorCommissionWithdrawAddrs(ctx, func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool) {
+		cwi = append(cwi, types.ValidatorCommissionWithdrawInfo{
+			ValidatorAddress: val.String(),
+			WithdrawAddress:  addr.String(),
+		})
+		return false
+	})
+
+	restakes := make([]types.RestakeEntry, 0)
+	k.IterateRestakeEntries(ctx, func(entry types.RestakeEntry) (stop bool) {
+		restakes = append(restakes, entry)
+		return false
+	})
+
+	grantees := make([]string, 0)
+	k.IterateCommunityPoolGrantees(ctx, func(addr sdk.AccAddress) (stop bool) {
+		grantees = append(grantees, addr.String())
+		return false
+	})
+
+	taxDestinations := k.GetCommunityTaxDestinations(ctx)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, cwi, restakes, grantees, taxDestinations)
 }
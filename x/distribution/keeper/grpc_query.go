@@ -46,6 +46,51 @@ func (k Keeper) ValidatorOutstandingRewards(c context.Context, req *types.QueryV
 	return &types.QueryValidatorOutstandingRewardsResponse{Rewards: rewards}, nil
 }
 
+// AllValidatorOutstandingRewards queries the outstanding rewards and the
+// accumulated commission of all the validators
+func (k Keeper) AllValidatorOutstandingRewards(c context.Context, req *types.QueryAllValidatorOutstandingRewardsRequest) (*types.QueryAllValidatorOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if !req.MinAmount.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min amount: %s", req.MinAmount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	outstandingStore := prefix.NewStore(store, types.ValidatorOutstandingRewardsPrefix)
+
+	var entries []types.ValidatorOutstandingRewardsEntry
+	pageRes, err := query.FilteredPaginate(outstandingStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var rewards types.ValidatorOutstandingRewards
+		if err := k.cdc.Unmarshal(value, &rewards); err != nil {
+			return false, err
+		}
+
+		for _, minCoin := range req.MinAmount {
+			if rewards.Rewards.AmountOf(minCoin.Denom).LT(minCoin.Amount) {
+				return false, nil
+			}
+		}
+
+		if accumulate {
+			valAddr := types.GetValidatorOutstandingRewardsAddress(append(types.ValidatorOutstandingRewardsPrefix, key...))
+			entries = append(entries, types.ValidatorOutstandingRewardsEntry{
+				ValidatorAddress:   valAddr.String(),
+				OutstandingRewards: rewards.Rewards,
+				Commission:         k.GetValidatorAccumulatedCommission(ctx, valAddr).Commission,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllValidatorOutstandingRewardsResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
 // ValidatorCommission queries accumulated commission for a validator
 func (k Keeper) ValidatorCommission(c context.Context, req *types.QueryValidatorCommissionRequest) (*types.QueryValidatorCommissionResponse, error) {
 	if req == nil {
@@ -156,6 +201,85 @@ func (k Keeper) DelegationRewards(c context.Context, req *types.QueryDelegationR
 	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
 }
 
+// DelegationRewardHistory queries the reference points the rewards of a delegation are derived from
+func (k Keeper) DelegationRewardHistory(c context.Context, req *types.QueryDelegationRewardHistoryRequest) (*types.QueryDelegationRewardHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	val := k.stakingKeeper.Validator(ctx, valAdr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	del := k.stakingKeeper.Delegation(ctx, delAdr, valAdr)
+	if del == nil {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	// ending the current period of the validator writes to the store, which
+	// is discarded so that the query only reads the existing state
+	ctx, _ = ctx.CacheContext()
+	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+	start, slashes, end := k.delegationRewardReferencePoints(ctx, val, del, endingPeriod)
+	rewards := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+
+	applied := make(map[uint64]types.DelegationRewardReferencePoint, len(slashes))
+	for _, slash := range slashes {
+		applied[slash.Period] = slash
+	}
+
+	points := make([]types.DelegationRewardReferencePoint, 0)
+	slashesStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetValidatorSlashEventPrefix(valAdr))
+	pageRes, err := query.FilteredPaginate(slashesStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var event types.ValidatorSlashEvent
+		if err := k.cdc.Unmarshal(value, &event); err != nil {
+			return false, err
+		}
+
+		point, ok := applied[event.ValidatorPeriod]
+		if !ok {
+			return false, nil
+		}
+
+		if accumulate {
+			points = append(points, point)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationRewardHistoryResponse{
+		StartingInfo:  k.GetDelegatorStartingInfo(ctx, valAdr, delAdr),
+		StartingPoint: start,
+		Slashes:       points,
+		EndingPoint:   end,
+		Rewards:       rewards,
+		Pagination:    pageRes,
+	}, nil
+}
+
 // DelegationTotalRewards the total rewards accrued by a each validator
 func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelegationTotalRewardsRequest) (*types.QueryDelegationTotalRewardsResponse, error) {
 	if req == nil {
@@ -167,6 +291,12 @@ func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelega
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height != 0 {
+		var err error
+		if ctx, err = k.heightQueryContext(req.Height); err != nil {
+			return nil, err
+		}
+	}
 
 	total := sdk.DecCoins{}
 	var delRewards []types.DelegationDelegatorReward
@@ -184,13 +314,47 @@ func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelega
 			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
 			delReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
-			delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, delReward))
+			reward := types.NewDelegationDelegatorReward(valAddr, delReward)
+			if validator, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+				reward.Description = validator.Description
+			}
+			delRewards = append(delRewards, reward)
 			total = total.Add(delReward...)
 			return false
 		},
 	)
 
-	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
+	totalByDenom := make(map[string]string, len(total))
+	for _, coin := range total {
+		totalByDenom[coin.Denom] = coin.Amount.String()
+	}
+
+	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total, TotalByDenom: totalByDenom}, nil
+}
+
+// heightQueryContext returns a context over the state committed at the given
+// height.
+func (k Keeper) heightQueryContext(height int64) (sdk.Context, error) {
+	if height < 0 {
+		return sdk.Context{}, status.Errorf(codes.InvalidArgument, "invalid height %d", height)
+	}
+
+	if k.queryContextFn == nil {
+		return sdk.Context{}, status.Error(codes.Unimplemented, "queries at a past height are not supported")
+	}
+
+	ctx, err := k.queryContextFn(height)
+	if err != nil {
+		return sdk.Context{}, status.Errorf(codes.NotFound, "state at height %d is not available: %s", height, err)
+	}
+
+	// the fee pool is set from genesis on, so the store is empty at the
+	// heights which were pruned or are not committed yet
+	if !ctx.KVStore(k.storeKey).Has(types.FeePoolKey) {
+		return sdk.Context{}, status.Errorf(codes.NotFound, "state at height %d is not available, it may have been pruned", height)
+	}
+
+	return ctx, nil
 }
 
 // DelegatorValidators queries the validators list of a delegator
@@ -241,6 +405,26 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// ValidatorCommissionWithdrawAddress queries Query/ValidatorCommissionWithdrawAddress
+func (k Keeper) ValidatorCommissionWithdrawAddress(c context.Context, req *types.QueryValidatorCommissionWithdrawAddressRequest) (*types.QueryValidatorCommissionWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawAddr := k.GetValidatorCommissionWithdrawAddr(ctx, valAdr)
+
+	return &types.QueryValidatorCommissionWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -248,3 +432,46 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// CommunityTaxDestinations queries the split of the community tax
+func (k Keeper) CommunityTaxDestinations(c context.Context, req *types.QueryCommunityTaxDestinationsRequest) (*types.QueryCommunityTaxDestinationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	destinations := k.GetCommunityTaxDestinations(ctx)
+
+	return &types.QueryCommunityTaxDestinationsResponse{Destinations: destinations}, nil
+}
+
+// RestakeEntries queries the delegations opted in to auto-restaking
+func (k Keeper) RestakeEntries(c context.Context, req *types.QueryRestakeEntriesRequest) (*types.QueryRestakeEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	storePrefix := types.RestakeEntryPrefix
+	if req.DelegatorAddress != "" {
+		delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address")
+		}
+		storePrefix = types.GetRestakeEntriesPrefix(delAdr)
+	}
+
+	var entries []types.RestakeEntry
+	entriesStore := prefix.NewStore(store, storePrefix)
+	pageRes, err := query.Paginate(entriesStore, req.Pagination, func(_ []byte, value []byte) error {
+		var entry types.RestakeEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRestakeEntriesResponse{Entries: entries, Pagination: pageRes}, nil
+}
@@ -1,16 +1,21 @@
 package keeper_test
 
 import (
+	"bytes"
 	gocontext "context"
 	"fmt"
+	"sort"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -201,6 +206,84 @@ func (suite *KeeperTestSuite) TestGRPCValidatorCommission() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCAllValidatorOutstandingRewards() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	// the genesis validator holds no rewards and is skipped by any min amount
+	valAddrs := simapp.ConvertAddrsToValAddrs(simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1000000000)))
+	sort.Slice(valAddrs, func(i, j int) bool { return bytes.Compare(valAddrs[i], valAddrs[j]) < 0 })
+	for i, valAddr := range valAddrs {
+		rewards := sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, int64(10*(i+1)))}
+		commission := sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, int64(i+1))}
+		app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: rewards})
+		app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission})
+	}
+
+	entryAddrs := func(entries []types.ValidatorOutstandingRewardsEntry) []string {
+		var addrs []string
+		for _, entry := range entries {
+			addrs = append(addrs, entry.ValidatorAddress)
+		}
+		return addrs
+	}
+	minAmount := func(amount int64) sdk.DecCoins {
+		return sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, amount)}
+	}
+
+	_, err := queryClient.AllValidatorOutstandingRewards(gocontext.Background(), &types.QueryAllValidatorOutstandingRewardsRequest{
+		MinAmount: sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(-1)}},
+	})
+	suite.Require().Error(err)
+
+	// every validator is returned without a min amount
+	res, err := queryClient.AllValidatorOutstandingRewards(gocontext.Background(), &types.QueryAllValidatorOutstandingRewardsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, len(valAddrs)+1)
+
+	// the entries hold the rewards and commission, ordered by operator address
+	res, err = queryClient.AllValidatorOutstandingRewards(gocontext.Background(), &types.QueryAllValidatorOutstandingRewardsRequest{
+		MinAmount: minAmount(1),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, len(valAddrs))
+	for i, entry := range res.Entries {
+		suite.Require().Equal(valAddrs[i].String(), entry.ValidatorAddress)
+		suite.Require().Equal(minAmount(int64(10*(i+1))), entry.OutstandingRewards)
+		suite.Require().Equal(minAmount(int64(i+1)), entry.Commission)
+	}
+
+	// the entries below the min amount are skipped
+	res, err = queryClient.AllValidatorOutstandingRewards(gocontext.Background(), &types.QueryAllValidatorOutstandingRewardsRequest{
+		MinAmount: minAmount(25),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{valAddrs[2].String(), valAddrs[3].String()}, entryAddrs(res.Entries))
+
+	res, err = queryClient.AllValidatorOutstandingRewards(gocontext.Background(), &types.QueryAllValidatorOutstandingRewardsRequest{
+		MinAmount: sdk.DecCoins{sdk.NewInt64DecCoin("token", 1)},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Entries)
+
+	// the pages continue from the next key
+	res, err = queryClient.AllValidatorOutstandingRewards(gocontext.Background(), &types.QueryAllValidatorOutstandingRewardsRequest{
+		MinAmount:  minAmount(15),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{valAddrs[1].String(), valAddrs[2].String()}, entryAddrs(res.Entries))
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.AllValidatorOutstandingRewards(gocontext.Background(), &types.QueryAllValidatorOutstandingRewardsRequest{
+		MinAmount:  minAmount(15),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{valAddrs[3].String()}, entryAddrs(res.Entries))
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
 func (suite *KeeperTestSuite) TestGRPCValidatorSlashes() {
 	app, ctx, queryClient, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.valAddrs
 
@@ -466,10 +549,14 @@ func (suite *KeeperTestSuite) TestGRPCDelegationRewards() {
 
 				expectedDelReward := types.NewDelegationDelegatorReward(valAddrs[0],
 					sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5)})
+				validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+				suite.Require().True(found)
+				expectedDelReward.Description = validator.Description
 
 				expTotalRewardsRes = &types.QueryDelegationTotalRewardsResponse{
-					Rewards: []types.DelegationDelegatorReward{expectedDelReward},
-					Total:   expectedDelReward.Reward,
+					Rewards:      []types.DelegationDelegatorReward{expectedDelReward},
+					Total:        expectedDelReward.Reward,
+					TotalByDenom: map[string]string{"stake": "5.000000000000000000"},
 				}
 			},
 			true,
@@ -599,6 +686,147 @@ func (suite *KeeperTestSuite) TestGRPCDelegatorWithdrawAddress() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCValidatorCommissionWithdrawAddress() {
+	app, ctx, queryClient, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.valAddrs
+
+	var (
+		req     *types.QueryValidatorCommissionWithdrawAddressRequest
+		expAddr sdk.AccAddress
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryValidatorCommissionWithdrawAddressRequest{}
+			},
+			false,
+		},
+		{
+			"invalid validator address",
+			func() {
+				req = &types.QueryValidatorCommissionWithdrawAddressRequest{ValidatorAddress: "invalid"}
+			},
+			false,
+		},
+		{
+			"defaults to the operator withdraw address",
+			func() {
+				req = &types.QueryValidatorCommissionWithdrawAddressRequest{ValidatorAddress: valAddrs[0].String()}
+				expAddr = sdk.AccAddress(valAddrs[0])
+			},
+			true,
+		},
+		{
+			"commission withdraw address set",
+			func() {
+				app.DistrKeeper.SetValidatorCommissionWithdrawAddr(ctx, valAddrs[0], suite.addrs[1])
+				req = &types.QueryValidatorCommissionWithdrawAddressRequest{ValidatorAddress: valAddrs[0].String()}
+				expAddr = suite.addrs[1]
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.ValidatorCommissionWithdrawAddress(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAddr.String(), res.WithdrawAddress)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCRestakeEntries() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	for _, delAddr := range addrs {
+		for _, valAddr := range valAddrs {
+			app.DistrKeeper.SetRestakeEntry(ctx, delAddr, valAddr, sdk.NewInt(10))
+		}
+	}
+
+	var (
+		req        *types.QueryRestakeEntriesRequest
+		expEntries int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid delegator address",
+			func() {
+				req = &types.QueryRestakeEntriesRequest{DelegatorAddress: "invalid"}
+			},
+			false,
+		},
+		{
+			"all the entries",
+			func() {
+				req = &types.QueryRestakeEntriesRequest{}
+				expEntries = len(addrs) * len(valAddrs)
+			},
+			true,
+		},
+		{
+			"the entries of a delegator",
+			func() {
+				req = &types.QueryRestakeEntriesRequest{DelegatorAddress: addrs[0].String()}
+				expEntries = len(valAddrs)
+			},
+			true,
+		},
+		{
+			"request entries with pagination",
+			func() {
+				req = &types.QueryRestakeEntriesRequest{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}}
+				expEntries = 3
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.RestakeEntries(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Entries, expEntries)
+				for _, entry := range res.Entries {
+					suite.Require().Equal(sdk.NewInt(10), entry.Threshold)
+					if req.DelegatorAddress != "" {
+						suite.Require().Equal(req.DelegatorAddress, entry.DelegatorAddress)
+					}
+				}
+				if req.Pagination != nil {
+					suite.Require().Equal(uint64(len(addrs)*len(valAddrs)), res.Pagination.Total)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 	// reset fee pool
@@ -655,6 +883,194 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCCommunityTaxDestinations() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	res, err := queryClient.CommunityTaxDestinations(gocontext.Background(), &types.QueryCommunityTaxDestinationsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Destinations)
+
+	destinations := []types.CommunityTaxDestination{
+		{Address: addrs[0].String(), Fraction: sdk.NewDecWithPrec(1, 2)},
+		{Address: "", Fraction: sdk.NewDecWithPrec(1, 2)},
+	}
+	suite.Require().NoError(app.DistrKeeper.UpdateCommunityTaxDestinations(ctx, destinations))
+
+	res, err = queryClient.CommunityTaxDestinations(gocontext.Background(), &types.QueryCommunityTaxDestinationsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(destinations, res.Destinations)
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func TestGRPCDelegationTotalRewardsHeight(t *testing.T) {
+	app := simapp.Setup(t, false)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// the genesis validator and its only delegation
+	val := app.StakingKeeper.GetAllValidators(ctx)[0]
+	delAddr := app.StakingKeeper.GetValidatorDelegations(ctx, val.GetOperator())[0].GetDelegatorAddr()
+
+	accrue := func(amount int64) {
+		rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, rewards))
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, val.GetOperator()), sdk.NewDecCoinsFromCoins(rewards...))
+	}
+	commit := func() {
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+		header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		ctx = app.BaseApp.NewContext(false, header)
+	}
+	query := func(reqHeight, height int64) (*types.QueryDelegationTotalRewardsResponse, abci.ResponseQuery) {
+		req := &types.QueryDelegationTotalRewardsRequest{DelegatorAddress: delAddr.String(), Height: reqHeight}
+		res := app.Query(abci.RequestQuery{
+			Path:   "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
+			Data:   app.AppCodec().MustMarshal(req),
+			Height: height,
+		})
+		if !res.IsOK() {
+			return nil, res
+		}
+		var resp types.QueryDelegationTotalRewardsResponse
+		app.AppCodec().MustUnmarshal(res.Value, &resp)
+		return &resp, res
+	}
+
+	// the rewards accrue and the validator is renamed between the commits
+	accrue(100)
+	commit()
+	firstHeight := app.LastBlockHeight()
+	accrue(200)
+	val.Description.Moniker = "renamed"
+	app.StakingKeeper.SetValidator(ctx, val)
+	commit()
+	secondHeight := app.LastBlockHeight()
+
+	resp, _ := query(firstHeight, 0)
+	require.Len(t, resp.Rewards, 1)
+	require.Equal(t, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100)}, resp.Total)
+	require.Equal(t, map[string]string{sdk.DefaultBondDenom: "100.000000000000000000"}, resp.TotalByDenom)
+	require.Empty(t, resp.Rewards[0].Description.Moniker)
+
+	resp, _ = query(secondHeight, 0)
+	require.Equal(t, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 300)}, resp.Total)
+	require.Equal(t, map[string]string{sdk.DefaultBondDenom: "300.000000000000000000"}, resp.TotalByDenom)
+	require.Equal(t, val.OperatorAddress, resp.Rewards[0].ValidatorAddress)
+	require.Equal(t, "renamed", resp.Rewards[0].Description.Moniker)
+
+	// without a height, the rewards are the ones of the query context
+	latest, _ := query(0, 0)
+	require.Equal(t, resp, latest)
+	atFirstHeight, _ := query(0, firstHeight)
+	requested, _ := query(firstHeight, secondHeight)
+	require.Equal(t, atFirstHeight, requested)
+
+	// the state of heights not committed or pruned cannot be queried
+	_, res := query(secondHeight+10, 0)
+	require.Equal(t, sdkerrors.ErrKeyNotFound.ABCICode(), res.Code)
+	_, res = query(-1, 0)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+}
+
+func TestGRPCDelegationRewardHistory(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// create validator with 50% commission and a second delegation
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.DelegateWithPower(addr[1], valAddrs[0], 100)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// allocate rewards and slash the validator twice
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))
+	allocate := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, tokens))
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddrs[0]), sdk.NewDecCoinsFromCoins(tokens...))
+	}
+	slash := func(fraction sdk.Dec) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+		power := app.StakingKeeper.Validator(ctx, valAddrs[0]).GetConsensusPower(app.StakingKeeper.PowerReduction(ctx))
+		app.StakingKeeper.Slash(ctx, valConsAddr1, ctx.BlockHeight(), power, fraction)
+	}
+	allocate()
+	slash(sdk.NewDecWithPrec(5, 1))
+	allocate()
+	slash(sdk.NewDecWithPrec(1, 1))
+	allocate()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.DistrKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	req := &types.QueryDelegationRewardHistoryRequest{DelegatorAddress: addr[1].String(), ValidatorAddress: valAddrs[0].String()}
+	resp, err := queryClient.DelegationRewardHistory(gocontext.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Slashes, 2)
+	require.Equal(t, app.DistrKeeper.GetDelegatorStartingInfo(ctx, valAddrs[0], addr[1]), resp.StartingInfo)
+	require.Equal(t, resp.StartingInfo.PreviousPeriod, resp.StartingPoint.Period)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), resp.Slashes[0].SlashFraction)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), resp.Slashes[1].SlashFraction)
+
+	// the slashes can be paginated
+	req.Pagination = &query.PageRequest{Limit: 1}
+	page, err := queryClient.DelegationRewardHistory(gocontext.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, resp.Slashes[:1], page.Slashes)
+	req.Pagination = &query.PageRequest{Key: page.Pagination.NextKey}
+	page, err = queryClient.DelegationRewardHistory(gocontext.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, resp.Slashes[1:], page.Slashes)
+	require.Equal(t, resp.EndingPoint, page.EndingPoint)
+
+	// the reference points reproduce the rewards
+	stake := resp.StartingInfo.Stake
+	previous := resp.StartingPoint
+	var rewards sdk.DecCoins
+	for _, point := range append(resp.Slashes, resp.EndingPoint) {
+		require.Equal(t, stake, point.Stake)
+		accrued := point.CumulativeRewardRatio.Sub(previous.CumulativeRewardRatio).MulDecTruncate(stake)
+		require.Equal(t, accrued, point.Rewards)
+		rewards = rewards.Add(accrued...)
+
+		stake = stake.MulTruncate(sdk.OneDec().Sub(point.SlashFraction))
+		previous = point
+	}
+	require.False(t, rewards.IsZero())
+	require.Equal(t, resp.Rewards, rewards)
+
+	// the query does not modify the state
+	period := app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddrs[0]).Period
+	_, err = queryClient.DelegationRewardHistory(gocontext.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, period, app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddrs[0]).Period)
+
+	// the withdrawn rewards are the reproduced ones
+	balance := app.BankKeeper.GetAllBalances(ctx, addr[1])
+	withdrawn, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, addr[1], valAddrs[0])
+	require.NoError(t, err)
+	expected, _ := rewards.TruncateDecimal()
+	require.Equal(t, expected, withdrawn)
+	require.Equal(t, balance.Add(expected...), app.BankKeeper.GetAllBalances(ctx, addr[1]))
+
+	_, err = queryClient.DelegationRewardHistory(gocontext.Background(), &types.QueryDelegationRewardHistoryRequest{DelegatorAddress: addr[1].String()})
+	require.Error(t, err)
+	_, err = queryClient.DelegationRewardHistory(gocontext.Background(), &types.QueryDelegationRewardHistoryRequest{
+		DelegatorAddress: addr[0].String(), ValidatorAddress: valAddrs[1].String(),
+	})
+	require.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -25,13 +26,19 @@ type Keeper struct {
 	blockedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address allowed to grant community pool funds with a vesting schedule
+	authority string
+
+	// creates the contexts of the queries made at a past height
+	queryContextFn func(height int64) (sdk.Context, error)
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string, blockedAddrs map[string]bool,
+	feeCollectorName string, blockedAddrs map[string]bool, authority string,
 ) Keeper {
 
 	// ensure distribution module account is set
@@ -53,9 +60,23 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
 		blockedAddrs:     blockedAddrs,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the address allowed to grant community pool funds with
+// a vesting schedule and to claw them back.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetQueryContextFn sets the function creating a context over the state
+// committed at a given height, which the queries taking a height require. It
+// must be set before the keeper is passed to the module.
+func (k *Keeper) SetQueryContextFn(fn func(height int64) (sdk.Context, error)) {
+	k.queryContextFn = fn
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -63,7 +84,7 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // SetWithdrawAddr sets a new address that will receive the rewards upon withdrawal
 func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
-	if k.blockedAddrs[withdrawAddr.String()] {
+	if k.blockedAddrs[withdrawAddr.String()] || k.bankKeeper.BlockedAddr(ctx, withdrawAddr) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
 	}
 
@@ -82,6 +103,34 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	return nil
 }
 
+// SetCommissionWithdrawAddr sets a new address that will receive the commission
+// of a validator upon withdrawal, instead of the withdraw address of its
+// operator.
+func (k Keeper) SetCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) error {
+	if k.blockedAddrs[withdrawAddr.String()] || k.bankKeeper.BlockedAddr(ctx, withdrawAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+	}
+
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+		),
+	)
+
+	k.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddr)
+	return nil
+}
+
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	val := k.stakingKeeper.Validator(ctx, valAddr)
@@ -121,6 +170,48 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of
+// a delegator, and the commission of the validator it operates if
+// withCommission is set, returning the total withdrawn. It fails if the
+// delegator has more delegations than the MaxWithdrawAllDelegations param
+// allows.
+func (k Keeper) WithdrawAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, withCommission bool) (sdk.Coins, error) {
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	// bound the amount of work a single call can do
+	maxDelegations := k.GetParams(ctx).MaxWithdrawAllDelegations
+	if delegations := uint64(len(valAddrs)); maxDelegations > 0 && delegations > maxDelegations {
+		return nil, sdkerrors.Wrapf(types.ErrTooManyDelegations, "got %d, max %d", delegations, maxDelegations)
+	}
+
+	total := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(rewards...)
+	}
+
+	// the commission is only withdrawn if the delegator operates a validator
+	// which has some
+	valAddr := sdk.ValAddress(delAddr)
+	if withCommission && k.stakingKeeper.Validator(ctx, valAddr) != nil &&
+		!k.GetValidatorAccumulatedCommission(ctx, valAddr).Commission.IsZero() {
+		commission, err := k.WithdrawValidatorCommission(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(commission...)
+	}
+
+	return total, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -137,8 +228,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
+		withdrawAddr := k.GetValidatorCommissionWithdrawAddr(ctx, valAddr)
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
 		if err != nil {
 			return nil, err
@@ -11,6 +11,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -33,6 +36,133 @@ func TestSetWithdrawAddr(t *testing.T) {
 	require.Nil(t, err)
 
 	require.Error(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], distrAcc.GetAddress()))
+
+	// addresses blocked on-chain by x/bank are rejected as well
+	app.BankKeeper.SetBlockedAddr(ctx, addr[1])
+	require.Error(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[1]))
+}
+
+func TestWithdrawBlockedAddrs(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delAddr := addr[2]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+	tstaking.Delegate(delAddr, valAddrs[0], sdk.NewInt(100))
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	allocate := func() {
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(100)}}
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+	}
+
+	// the delegator and the operator are blocked on-chain after delegating
+	app.BankKeeper.SetBlockedAddr(ctx, delAddr)
+	app.BankKeeper.SetBlockedAddr(ctx, addr[0])
+
+	// they can no longer be chosen as withdraw addresses
+	require.Error(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[1], delAddr))
+
+	// but they still withdraw their rewards and commission
+	allocate()
+	balance := app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+	rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+	require.NoError(t, err)
+	require.False(t, rewards.IsZero())
+	require.Equal(t, balance.Add(sdk.NewCoin(sdk.DefaultBondDenom, rewards.AmountOf(sdk.DefaultBondDenom))), app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+
+	commission, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.False(t, commission.IsZero())
+
+	// and redelegate and undelegate, which withdraw their rewards first
+	allocate()
+	shares := sdk.NewDec(50)
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1], shares)
+	require.NoError(t, err)
+
+	allocate()
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddr, valAddrs[0], shares)
+	require.NoError(t, err)
+}
+
+func TestSetCommissionWithdrawAddr(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// the validator must exist
+	require.ErrorIs(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], addr[1]), types.ErrNoValidatorExists)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.WithdrawAddrEnabled = false
+	app.DistrKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], addr[1]), types.ErrSetWithdrawAddrDisabled)
+
+	params.WithdrawAddrEnabled = true
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// blocked addresses are rejected
+	require.Error(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], distrAcc.GetAddress()))
+	app.BankKeeper.SetBlockedAddr(ctx, addr[3])
+	require.Error(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], addr[3]))
+
+	// defaults to the operator address, then to its withdraw address
+	require.Equal(t, addr[0], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[2]))
+	require.Equal(t, addr[2], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+
+	require.NoError(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], addr[1]))
+	require.Equal(t, addr[1], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+	require.Equal(t, addr[2], app.DistrKeeper.GetDelegatorWithdrawAddr(ctx, addr[0]))
+
+	// the commission goes to the commission withdraw address and the
+	// self-delegation rewards to the legacy withdraw address
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(10)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))))
+
+	commissionBalance := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	rewardsBalance := app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+
+	commission, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), commission)
+	rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, addr[0], valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), rewards)
+
+	require.Equal(t, commissionBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, commission.AmountOf(sdk.DefaultBondDenom))), app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom))
+	require.Equal(t, rewardsBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, rewards.AmountOf(sdk.DefaultBondDenom))), app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom))
+
+	// the address is exported and imported with the genesis state
+	genesis := app.DistrKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.ValidatorCommissionWithdrawInfo{
+		{ValidatorAddress: valAddrs[0].String(), WithdrawAddress: addr[1].String()},
+	}, genesis.ValidatorCommissionWithdrawInfos)
+
+	app.DistrKeeper.DeleteValidatorCommissionWithdrawAddr(ctx, valAddrs[0])
+	require.Equal(t, addr[2], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+	app.DistrKeeper.InitGenesis(ctx, *genesis)
+	require.Equal(t, addr[1], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
 }
 
 func TestWithdrawValidatorCommission(t *testing.T) {
@@ -87,6 +217,90 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	require.True(t, true)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, len(PKS), sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validators with 50% commission, the first one being operated by
+	// the delegator which also delegates to all the others
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	for i, pk := range PKS {
+		tstaking.CreateValidator(valAddrs[i], pk, sdk.NewInt(100), true)
+	}
+	for _, valAddr := range valAddrs[1:] {
+		tstaking.Delegate(addr[0], valAddr, sdk.NewInt(100))
+	}
+
+	// end block to bond validators and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate the same rewards to every validator
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(100)}}
+	for _, valAddr := range valAddrs {
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), tokens)
+	}
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100*int64(len(valAddrs))))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), coins))
+
+	// the whole self-delegation rewards (50) plus a quarter of the rewards
+	// of every other validator (4 * 25), and the commission (50) on top
+	expRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+	expCommission := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+
+	setMax := func(ctx sdk.Context, max uint64) {
+		params := app.DistrKeeper.GetParams(ctx)
+		params.MaxWithdrawAllDelegations = max
+		app.DistrKeeper.SetParams(ctx, params)
+	}
+
+	// a cap below the number of delegations rejects the withdrawal
+	setMax(ctx, uint64(len(valAddrs)-1))
+	_, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, addr[0], true)
+	require.ErrorIs(t, err, types.ErrTooManyDelegations)
+
+	// a zero cap disables the bound
+	cacheCtx, _ := ctx.CacheContext()
+	setMax(cacheCtx, 0)
+	total, err := app.DistrKeeper.WithdrawAllDelegationRewards(cacheCtx, addr[0], false)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, total)
+	require.Equal(t, types.ValidatorAccumulatedCommission{Commission: tokens.QuoDec(sdk.NewDec(2))},
+		app.DistrKeeper.GetValidatorAccumulatedCommission(cacheCtx, valAddrs[0]))
+
+	// a cap equal to the number of delegations is accepted
+	setMax(ctx, uint64(len(valAddrs)))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	balance := app.BankKeeper.GetAllBalances(ctx, addr[0])
+	total, err = app.DistrKeeper.WithdrawAllDelegationRewards(ctx, addr[0], true)
+	require.NoError(t, err)
+	require.Equal(t, expRewards.Add(expCommission...), total)
+	require.Equal(t, balance.Add(total...), app.BankKeeper.GetAllBalances(ctx, addr[0]))
+	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
+
+	// an event is still emitted for every validator
+	withdrawn := make(map[string]bool)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeWithdrawRewards {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyValidator {
+				withdrawn[string(attr.Value)] = true
+			}
+		}
+	}
+	require.Len(t, withdrawn, len(valAddrs))
+	for _, valAddr := range valAddrs {
+		require.True(t, withdrawn[valAddr.String()])
+	}
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
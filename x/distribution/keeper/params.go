@@ -41,3 +41,17 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetRestakeInterval returns the current distribution restake interval
+// parameter.
+func (k Keeper) GetRestakeInterval(ctx sdk.Context) (interval uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyRestakeInterval, &interval)
+	return interval
+}
+
+// GetMaxRestakesPerBlock returns the current distribution max restakes per
+// block parameter.
+func (k Keeper) GetMaxRestakesPerBlock(ctx sdk.Context) (max uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxRestakesPerBlock, &max)
+	return max
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// get the restake entry of a delegation
+func (k Keeper) GetRestakeEntry(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (entry types.RestakeEntry, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetRestakeEntryKey(delAddr, valAddr))
+	if b == nil {
+		return entry, false
+	}
+	k.cdc.MustUnmarshal(b, &entry)
+	return entry, true
+}
+
+// set the restake entry of a delegation
+func (k Keeper) SetRestakeEntry(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, threshold sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.RestakeEntry{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Threshold:        threshold,
+	})
+	store.Set(types.GetRestakeEntryKey(delAddr, valAddr), b)
+}
+
+// delete the restake entry of a delegation
+func (k Keeper) DeleteRestakeEntry(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRestakeEntryKey(delAddr, valAddr))
+}
+
+// iterate over the restake entries
+func (k Keeper) IterateRestakeEntries(ctx sdk.Context, handler func(entry types.RestakeEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RestakeEntryPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.RestakeEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		if handler(entry) {
+			break
+		}
+	}
+}
+
+// SetAutoRestake opts a delegation in or out of the auto-restaking of its
+// rewards. Opting in requires the delegation to exist and its rewards to be
+// withdrawn to the delegator, which the restaked tokens are delegated from.
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool, threshold sdk.Int) error {
+	if enabled {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationExists
+		}
+
+		if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+			return types.ErrRestakeWithdrawAddr
+		}
+
+		k.SetRestakeEntry(ctx, delAddr, valAddr, threshold)
+	} else {
+		k.DeleteRestakeEntry(ctx, delAddr, valAddr)
+	}
+
+	event := sdk.NewEvent(
+		types.EventTypeSetAutoRestake,
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyEnabled, fmt.Sprintf("%t", enabled)),
+	)
+	if enabled {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyThreshold, threshold.String()))
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	return nil
+}
+
+// RestakeRewards restakes the rewards of the delegations opted in to
+// auto-restaking, every RestakeInterval blocks. A run visits at most
+// MaxRestakesPerBlock entries and the next run resumes after the last entry
+// visited, so that all the entries get their turn.
+func (k Keeper) RestakeRewards(ctx sdk.Context) {
+	interval := k.GetRestakeInterval(ctx)
+	if interval == 0 || ctx.BlockHeight()%int64(interval) != 0 {
+		return
+	}
+
+	maxRestakes := k.GetMaxRestakesPerBlock(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the entries first as restaking writes to the store
+	var (
+		entries []types.RestakeEntry
+		lastKey []byte
+	)
+	collect := func(start, end []byte) {
+		iter := store.Iterator(start, end)
+		defer iter.Close()
+		for ; iter.Valid() && uint64(len(entries)) < maxRestakes; iter.Next() {
+			var entry types.RestakeEntry
+			k.cdc.MustUnmarshal(iter.Value(), &entry)
+			entries = append(entries, entry)
+			lastKey = iter.Key()
+		}
+	}
+
+	end := sdk.PrefixEndBytes(types.RestakeEntryPrefix)
+	if cursor := store.Get(types.RestakeCursorKey); cursor != nil {
+		// resume right after the cursor, then wrap around to the first entries
+		next := append(append([]byte{}, cursor...), 0x00)
+		collect(next, end)
+		collect(types.RestakeEntryPrefix, next)
+	} else {
+		collect(types.RestakeEntryPrefix, end)
+	}
+
+	if uint64(len(entries)) < maxRestakes {
+		// all the entries were visited, the next run starts over
+		store.Delete(types.RestakeCursorKey)
+	} else {
+		store.Set(types.RestakeCursorKey, lastKey)
+	}
+
+	for _, entry := range entries {
+		k.restake(ctx, entry)
+	}
+}
+
+// restake withdraws the rewards of a delegation and delegates them back if
+// they reach the threshold of its restake entry and keep the validator within
+// the power cap. Failures are logged and the delegation is left untouched.
+func (k Keeper) restake(ctx sdk.Context, entry types.RestakeEntry) {
+	delAddr, err := sdk.AccAddressFromBech32(entry.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if del == nil {
+		k.DeleteRestakeEntry(ctx, delAddr, valAddr)
+		return
+	}
+
+	// the rewards withdrawn elsewhere cannot be delegated back
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+
+	// compute the accrued rewards without ending the validator period
+	queryCtx, _ := ctx.CacheContext()
+	endingPeriod := k.IncrementValidatorPeriod(queryCtx, val)
+	accrued := k.CalculateDelegationRewards(queryCtx, val, del, endingPeriod).AmountOf(bondDenom).TruncateInt()
+	if !accrued.IsPositive() || accrued.LT(entry.Threshold) {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+	amount := rewards.AmountOf(bondDenom)
+	if err == nil {
+		if !amount.IsPositive() {
+			return
+		}
+		validator, _ := k.stakingKeeper.GetValidator(cacheCtx, valAddr)
+
+		// as for MsgDelegate, the restaked rewards may not put the validator
+		// above the power cap, they are then left to accrue
+		bondedDelta := sdk.ZeroInt()
+		if validator.IsBonded() {
+			bondedDelta = amount
+		}
+		if capErr := k.stakingKeeper.ValidateValidatorPowerCap(cacheCtx, validator, amount, bondedDelta); capErr != nil {
+			k.Logger(ctx).Info(
+				"skipping the restake of rewards above the validator power cap",
+				"delegator", entry.DelegatorAddress,
+				"validator", entry.ValidatorAddress,
+				"err", capErr,
+			)
+			return
+		}
+
+		_, err = k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, validator, true)
+	}
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to restake rewards",
+			"delegator", entry.DelegatorAddress,
+			"validator", entry.ValidatorAddress,
+			"err", err,
+		)
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, entry.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, entry.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		),
+	)
+}
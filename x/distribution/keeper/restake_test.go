@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+// setupRestake creates a validator for each of PKS, all delegated to by the
+// first address, and returns the addresses and a function allocating rewards
+// to every validator.
+func setupRestake(t *testing.T, app *simapp.SimApp, ctx sdk.Context) ([]sdk.AccAddress, []sdk.ValAddress, func(ctx sdk.Context)) {
+	addr := simapp.AddTestAddrs(app, ctx, len(PKS), sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	for i, pk := range PKS {
+		tstaking.CreateValidator(valAddrs[i], pk, sdk.NewInt(100), true)
+	}
+	for _, valAddr := range valAddrs[1:] {
+		tstaking.Delegate(addr[0], valAddr, sdk.NewInt(100))
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	allocate := func(ctx sdk.Context) {
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(100)}}
+		for _, valAddr := range valAddrs {
+			app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), tokens)
+		}
+		distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100*int64(len(valAddrs))))
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), coins))
+	}
+
+	return addr, valAddrs, allocate
+}
+
+// restakedValidators returns the validators of the restake events emitted in
+// the context.
+func restakedValidators(ctx sdk.Context) []string {
+	var vals []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRestake {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyValidator {
+				vals = append(vals, string(attr.Value))
+			}
+		}
+	}
+	return vals
+}
+
+func TestSetAutoRestake(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addr, valAddrs, _ := setupRestake(t, app, ctx)
+
+	// the delegation must exist
+	err := app.DistrKeeper.SetAutoRestake(ctx, addr[1], valAddrs[0], true, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	// the rewards must be withdrawn to the delegator
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[1]))
+	err = app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[1], true, sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrRestakeWithdrawAddr)
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[1], true, sdk.NewInt(10)))
+	entry, found := app.DistrKeeper.GetRestakeEntry(ctx, addr[0], valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(10), entry.Threshold)
+
+	// the entries are exported and imported with the genesis
+	genState := app.DistrKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.RestakeEntry{entry}, genState.RestakeEntries)
+	app.DistrKeeper.DeleteRestakeEntry(ctx, addr[0], valAddrs[1])
+	app.DistrKeeper.InitGenesis(ctx, *genState)
+	_, found = app.DistrKeeper.GetRestakeEntry(ctx, addr[0], valAddrs[1])
+	require.True(t, found)
+
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[1], false, sdk.Int{}))
+	_, found = app.DistrKeeper.GetRestakeEntry(ctx, addr[0], valAddrs[1])
+	require.False(t, found)
+
+	// removing the delegation removes its entry
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[2], true, sdk.ZeroInt()))
+	tstaking.Undelegate(addr[0], valAddrs[2], sdk.NewInt(100), true)
+	_, found = app.DistrKeeper.GetRestakeEntry(ctx, addr[0], valAddrs[2])
+	require.False(t, found)
+}
+
+func TestRestakeRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addr, valAddrs, allocate := setupRestake(t, app, ctx)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakeInterval = 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// the delegator earns 50 per block from the second validator
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[1], true, sdk.NewInt(60)))
+
+	// nothing happens off the interval
+	ctx = ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+	allocate(ctx)
+	app.DistrKeeper.RestakeRewards(ctx)
+	require.Empty(t, restakedValidators(ctx))
+
+	// the rewards below the threshold are left to accrue
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.RestakeRewards(ctx)
+	require.Empty(t, restakedValidators(ctx))
+
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	allocate(ctx)
+	balance := app.BankKeeper.GetAllBalances(ctx, addr[0])
+	app.DistrKeeper.RestakeRewards(ctx)
+	require.Equal(t, []string{valAddrs[1].String()}, restakedValidators(ctx))
+
+	// the rewards were delegated back to the validator
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addr[0]))
+	del, found := app.StakingKeeper.GetDelegation(ctx, addr[0], valAddrs[1])
+	require.True(t, found)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[1])
+	require.Equal(t, sdk.NewInt(200), val.TokensFromShares(del.GetShares()).TruncateInt())
+	require.True(t, app.DistrKeeper.GetDelegatorStartingInfo(ctx, valAddrs[1], addr[0]).Stake.Equal(sdk.NewDec(200)))
+}
+
+func TestRestakeRewardsPowerCap(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addr, valAddrs, allocate := setupRestake(t, app, ctx)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakeInterval = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// the second validator holds 200 tokens while the genesis validator bonds
+	// 1000000, restaking 50 more would put it above 0.02% of them
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidatorPowerFraction = sdk.NewDecWithPrec(2, 4)
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+
+	require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddrs[1], true, sdk.ZeroInt()))
+
+	ctx = ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+	allocate(ctx)
+	balance := app.BankKeeper.GetAllBalances(ctx, addr[0])
+	app.DistrKeeper.RestakeRewards(ctx)
+	require.Empty(t, restakedValidators(ctx))
+
+	// the entry was skipped, leaving the rewards to accrue
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addr[0]))
+	require.Equal(t, sdk.NewInt(200), app.StakingKeeper.Validator(ctx, valAddrs[1]).GetTokens())
+	_, found := app.DistrKeeper.GetRestakeEntry(ctx, addr[0], valAddrs[1])
+	require.True(t, found)
+
+	// the rewards are restaked once the cap allows it
+	stakingParams.MaxValidatorPowerFraction = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.RestakeRewards(ctx)
+	require.Equal(t, []string{valAddrs[1].String()}, restakedValidators(ctx))
+	require.Equal(t, sdk.NewInt(250), app.StakingKeeper.Validator(ctx, valAddrs[1]).GetTokens())
+}
+
+func TestRestakeRewardsCursor(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addr, valAddrs, allocate := setupRestake(t, app, ctx)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakeInterval = 1
+	params.MaxRestakesPerBlock = 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	for _, valAddr := range valAddrs {
+		require.NoError(t, app.DistrKeeper.SetAutoRestake(ctx, addr[0], valAddr, true, sdk.ZeroInt()))
+	}
+
+	// the entries in store order
+	var entries []string
+	app.DistrKeeper.IterateRestakeEntries(ctx, func(entry types.RestakeEntry) bool {
+		entries = append(entries, entry.ValidatorAddress)
+		return false
+	})
+	require.Len(t, entries, len(valAddrs))
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	cursorAt := func(i int) []byte {
+		valAddr, err := sdk.ValAddressFromBech32(entries[i])
+		require.NoError(t, err)
+		return types.GetRestakeEntryKey(addr[0], valAddr)
+	}
+
+	// every run resumes after the last entry visited by the previous one,
+	// wrapping around to the first entries
+	expected := [][]string{
+		{entries[0], entries[1]},
+		{entries[2], entries[3]},
+		{entries[4], entries[0]},
+		{entries[1], entries[2]},
+	}
+	cursors := [][]byte{cursorAt(1), cursorAt(3), cursorAt(0), cursorAt(2)}
+	for i := range expected {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).WithEventManager(sdk.NewEventManager())
+		allocate(ctx)
+		app.DistrKeeper.RestakeRewards(ctx)
+		require.Equal(t, expected[i], restakedValidators(ctx), "block %d", i+1)
+		require.Equal(t, cursors[i], store.Get(types.RestakeCursorKey), "block %d", i+1)
+	}
+
+	// a run visiting every entry starts the next one over
+	params.MaxRestakesPerBlock = uint64(len(entries) + 1)
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	allocate(ctx)
+	app.DistrKeeper.RestakeRewards(ctx)
+	require.Equal(t, append(entries[3:], entries[:3]...), restakedValidators(ctx))
+	require.Nil(t, store.Get(types.RestakeCursorKey))
+}
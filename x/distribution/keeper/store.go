@@ -43,6 +43,43 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// get the validator commission withdraw address, defaulting to the withdraw
+// address of the validator operator
+func (k Keeper) GetValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorCommissionWithdrawAddrKey(valAddr))
+	if b == nil {
+		return k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+	}
+	return sdk.AccAddress(b)
+}
+
+// set the validator commission withdraw address
+func (k Keeper) SetValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorCommissionWithdrawAddrKey(valAddr), withdrawAddr.Bytes())
+}
+
+// delete a validator commission withdraw addr
+func (k Keeper) DeleteValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorCommissionWithdrawAddrKey(valAddr))
+}
+
+// iterate over validator commission withdraw addrs
+func (k Keeper) IterateValidatorCommissionWithdrawAddrs(ctx sdk.Context, handler func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorCommissionWithdrawAddrPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Value())
+		val := types.GetValidatorCommissionWithdrawInfoAddress(iter.Key())
+		if handler(val, addr) {
+			break
+		}
+	}
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
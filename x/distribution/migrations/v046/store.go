@@ -0,0 +1,23 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43/v0.45 to v0.46.
+// The migration includes:
+//
+// - Setting the MaxWithdrawAllDelegations param in the paramstore.
+// - Setting the RestakeInterval and MaxRestakesPerBlock params in the paramstore.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyMaxWithdrawAllDelegations, types.DefaultMaxWithdrawAllDelegations)
+	paramstore.Set(ctx, types.ParamStoreKeyRestakeInterval, types.DefaultRestakeInterval)
+	paramstore.Set(ctx, types.ParamStoreKeyMaxRestakesPerBlock, types.DefaultMaxRestakesPerBlock)
+	return nil
+}
@@ -0,0 +1,38 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distrKey := sdk.NewKVStoreKey("distribution")
+	tDistrKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(distrKey, tDistrKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, distrKey, tDistrKey, types.ModuleName)
+
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyMaxWithdrawAllDelegations))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyRestakeInterval))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyMaxRestakesPerBlock))
+
+	require.NoError(t, v046.MigrateStore(ctx, paramstore))
+
+	var maxDelegations uint64
+	paramstore.Get(ctx, types.ParamStoreKeyMaxWithdrawAllDelegations, &maxDelegations)
+	require.Equal(t, types.DefaultMaxWithdrawAllDelegations, maxDelegations)
+
+	var interval, maxRestakes uint64
+	paramstore.Get(ctx, types.ParamStoreKeyRestakeInterval, &interval)
+	paramstore.Get(ctx, types.ParamStoreKeyMaxRestakesPerBlock, &maxRestakes)
+	require.Equal(t, types.DefaultRestakeInterval, interval)
+	require.Equal(t, types.DefaultMaxRestakesPerBlock, maxRestakes)
+}
@@ -63,6 +63,27 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionWithdrawAddrPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.RestakeEntryPrefix):
+			var entryA, entryB types.RestakeEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		case bytes.Equal(kvA.Key[:1], types.RestakeCursorKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.CommunityPoolGranteePrefix):
+			return fmt.Sprintf("%v\n%v", types.GetCommunityPoolGranteeAddress(kvA.Key), types.GetCommunityPoolGranteeAddress(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.CommunityTaxDestinationsKey):
+			var destinationsA, destinationsB types.CommunityTaxDestinations
+			cdc.MustUnmarshal(kvA.Value, &destinationsA)
+			cdc.MustUnmarshal(kvB.Value, &destinationsB)
+			return fmt.Sprintf("%v\n%v", destinationsA, destinationsB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -34,6 +34,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	restakeEntry := types.RestakeEntry{DelegatorAddress: delAddr1.String(), ValidatorAddress: valAddr1.String(), Threshold: sdk.NewInt(10)}
+	taxDestinations := types.CommunityTaxDestinations{Destinations: []types.CommunityTaxDestination{{Address: delAddr1.String(), Fraction: sdk.NewDecWithPrec(1, 2)}}}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -46,6 +48,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetValidatorCommissionWithdrawAddrKey(valAddr1), Value: delAddr1.Bytes()},
+			{Key: types.GetRestakeEntryKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&restakeEntry)},
+			{Key: types.RestakeCursorKey, Value: types.GetRestakeEntryKey(delAddr1, valAddr1)},
+			{Key: types.GetCommunityPoolGranteeKey(delAddr1), Value: []byte{}},
+			{Key: types.CommunityTaxDestinationsKey, Value: cdc.MustMarshal(&taxDestinations)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -63,6 +70,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"ValidatorCommissionWithdrawAddr", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"RestakeEntry", fmt.Sprintf("%v\n%v", restakeEntry, restakeEntry)},
+		{"RestakeCursor", fmt.Sprintf("%X\n%X", types.GetRestakeEntryKey(delAddr1, valAddr1), types.GetRestakeEntryKey(delAddr1, valAddr1))},
+		{"CommunityPoolGrantee", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"CommunityTaxDestinations", fmt.Sprintf("%v\n%v", taxDestinations, taxDestinations)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
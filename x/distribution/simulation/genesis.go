@@ -18,6 +18,9 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	MaxWithdrawAll      = "max_withdraw_all_delegations"
+	RestakeInterval     = "restake_interval"
+	MaxRestakes         = "max_restakes_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +43,22 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenMaxWithdrawAllDelegations returns a randomized MaxWithdrawAllDelegations
+// parameter.
+func GenMaxWithdrawAllDelegations(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenRestakeInterval returns a randomized RestakeInterval parameter.
+func GenRestakeInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20))
+}
+
+// GenMaxRestakesPerBlock returns a randomized MaxRestakesPerBlock parameter.
+func GenMaxRestakesPerBlock(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,13 +85,34 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var maxWithdrawAll uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxWithdrawAll, &maxWithdrawAll, simState.Rand,
+		func(r *rand.Rand) { maxWithdrawAll = GenMaxWithdrawAllDelegations(r) },
+	)
+
+	var restakeInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RestakeInterval, &restakeInterval, simState.Rand,
+		func(r *rand.Rand) { restakeInterval = GenRestakeInterval(r) },
+	)
+
+	var maxRestakes uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxRestakes, &maxRestakes, simState.Rand,
+		func(r *rand.Rand) { maxRestakes = GenMaxRestakesPerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:              communityTax,
+			BaseProposerReward:        baseProposerReward,
+			BonusProposerReward:       bonusProposerReward,
+			WithdrawAddrEnabled:       withdrawEnabled,
+			MaxWithdrawAllDelegations: maxWithdrawAll,
+			RestakeInterval:           restakeInterval,
+			MaxRestakesPerBlock:       maxRestakes,
 		},
 	}
 
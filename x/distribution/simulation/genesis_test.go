@@ -47,6 +47,9 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec2, distrGenesis.Params.BonusProposerReward)
 	require.Equal(t, dec3, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, uint64(11), distrGenesis.Params.MaxWithdrawAllDelegations)
+	require.Equal(t, uint64(2), distrGenesis.Params.RestakeInterval)
+	require.Equal(t, uint64(89), distrGenesis.Params.MaxRestakesPerBlock)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
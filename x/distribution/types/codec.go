@@ -13,9 +13,15 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawDelegatorReward{}, "cosmos-sdk/MsgWithdrawDelegationReward", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/MsgWithdrawAllRewards", nil)
 	cdc.RegisterConcrete(&MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValidatorCommission", nil)
 	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgSetCommissionWithdrawAddress{}, "cosmos-sdk/MsgSetCommissionWithdrawAddr", nil)
 	cdc.RegisterConcrete(&MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool", nil)
+	cdc.RegisterConcrete(&MsgSetAutoRestake{}, "cosmos-sdk/MsgSetAutoRestake", nil)
+	cdc.RegisterConcrete(&MsgCommunityPoolSpendWithSchedule{}, "cosmos-sdk/MsgCommunityPoolSpendWithSchedule", nil)
+	cdc.RegisterConcrete(&MsgCommunityPoolClawback{}, "cosmos-sdk/MsgCommunityPoolClawback", nil)
+	cdc.RegisterConcrete(&MsgSetCommunityTaxDestinations{}, "cosmos-sdk/MsgSetCommunityTaxDestinations", nil)
 	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
 }
 
@@ -23,9 +29,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgWithdrawDelegatorReward{},
+		&MsgWithdrawAllDelegatorRewards{},
 		&MsgWithdrawValidatorCommission{},
 		&MsgSetWithdrawAddress{},
+		&MsgSetCommissionWithdrawAddress{},
 		&MsgFundCommunityPool{},
+		&MsgSetAutoRestake{},
+		&MsgCommunityPoolSpendWithSchedule{},
+		&MsgCommunityPoolClawback{},
+		&MsgSetCommunityTaxDestinations{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateCommunityTaxDestinations validates the destinations of the community
+// tax, whose fractions must sum to at most the community tax.
+func ValidateCommunityTaxDestinations(destinations []CommunityTaxDestination, communityTax sdk.Dec) error {
+	seen := make(map[string]bool, len(destinations))
+	total := sdk.ZeroDec()
+	for _, dest := range destinations {
+		if dest.Address != "" {
+			if _, err := sdk.AccAddressFromBech32(dest.Address); err != nil {
+				return fmt.Errorf("invalid community tax destination address %s: %w", dest.Address, err)
+			}
+		}
+		if seen[dest.Address] {
+			return fmt.Errorf("duplicate community tax destination %q", dest.Address)
+		}
+		seen[dest.Address] = true
+
+		if dest.Fraction.IsNil() || !dest.Fraction.IsPositive() {
+			return fmt.Errorf("community tax destination fraction must be positive: %s", dest.Fraction)
+		}
+		total = total.Add(dest.Fraction)
+	}
+
+	if total.GT(communityTax) {
+		return fmt.Errorf("community tax destination fractions %s exceed the community tax %s", total, communityTax)
+	}
+
+	return nil
+}
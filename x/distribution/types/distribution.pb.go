@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -32,6 +33,16 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// max_withdraw_all_delegations is the maximum number of delegations whose
+	// rewards a single MsgWithdrawAllDelegatorRewards may withdraw. Zero disables
+	// the bound.
+	MaxWithdrawAllDelegations uint64 `protobuf:"varint,5,opt,name=max_withdraw_all_delegations,json=maxWithdrawAllDelegations,proto3" json:"max_withdraw_all_delegations,omitempty"`
+	// restake_interval is the number of blocks between two runs of the
+	// auto-restaking of the delegation rewards. Zero disables auto-restaking.
+	RestakeInterval uint64 `protobuf:"varint,6,opt,name=restake_interval,json=restakeInterval,proto3" json:"restake_interval,omitempty"`
+	// max_restakes_per_block is the maximum number of restake entries visited
+	// by a single run of the auto-restaking.
+	MaxRestakesPerBlock uint64 `protobuf:"varint,7,opt,name=max_restakes_per_block,json=maxRestakesPerBlock,proto3" json:"max_restakes_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +84,71 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxWithdrawAllDelegations() uint64 {
+	if m != nil {
+		return m.MaxWithdrawAllDelegations
+	}
+	return 0
+}
+
+func (m *Params) GetRestakeInterval() uint64 {
+	if m != nil {
+		return m.RestakeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRestakesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRestakesPerBlock
+	}
+	return 0
+}
+
+// RestakeEntry opts a delegation in to the auto-restaking of its rewards.
+type RestakeEntry struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// threshold is the minimum amount of bond denom rewards accrued by the
+	// delegation for them to be restaked.
+	Threshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"threshold"`
+}
+
+func (m *RestakeEntry) Reset()         { *m = RestakeEntry{} }
+func (m *RestakeEntry) String() string { return proto.CompactTextString(m) }
+func (*RestakeEntry) ProtoMessage()    {}
+func (*RestakeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+func (m *RestakeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestakeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestakeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestakeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestakeEntry.Merge(m, src)
+}
+func (m *RestakeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestakeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestakeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestakeEntry proto.InternalMessageInfo
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,11 +156,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -94,7 +171,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{1}
+	return fileDescriptor_cd78a31ea281a992, []int{2}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,7 +226,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{3}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,7 +279,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +325,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +374,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +418,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +463,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,6 +499,101 @@ func (m *FeePool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins
 	return nil
 }
 
+// CommunityTaxDestination receives a fraction of the collected fees out of the
+// community tax.
+type CommunityTaxDestination struct {
+	// address is the account receiving the fraction, the community pool if
+	// empty.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// fraction is the fraction of the collected fees sent to the destination.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+}
+
+func (m *CommunityTaxDestination) Reset()         { *m = CommunityTaxDestination{} }
+func (m *CommunityTaxDestination) String() string { return proto.CompactTextString(m) }
+func (*CommunityTaxDestination) ProtoMessage()    {}
+func (*CommunityTaxDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{9}
+}
+func (m *CommunityTaxDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityTaxDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityTaxDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityTaxDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityTaxDestination.Merge(m, src)
+}
+func (m *CommunityTaxDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityTaxDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityTaxDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityTaxDestination proto.InternalMessageInfo
+
+func (m *CommunityTaxDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// CommunityTaxDestinations defines the split of the community tax, as stored.
+type CommunityTaxDestinations struct {
+	Destinations []CommunityTaxDestination `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations"`
+}
+
+func (m *CommunityTaxDestinations) Reset()         { *m = CommunityTaxDestinations{} }
+func (m *CommunityTaxDestinations) String() string { return proto.CompactTextString(m) }
+func (*CommunityTaxDestinations) ProtoMessage()    {}
+func (*CommunityTaxDestinations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{10}
+}
+func (m *CommunityTaxDestinations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityTaxDestinations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityTaxDestinations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityTaxDestinations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityTaxDestinations.Merge(m, src)
+}
+func (m *CommunityTaxDestinations) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityTaxDestinations) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityTaxDestinations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityTaxDestinations proto.InternalMessageInfo
+
+func (m *CommunityTaxDestinations) GetDestinations() []CommunityTaxDestination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
 // CommunityPoolSpendProposal details a proposal for use of community funds,
 // together with how many coins are proposed to be spent, and to which
 // recipient account.
@@ -435,7 +607,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +652,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,13 +700,16 @@ func (m *DelegatorStartingInfo) GetHeight() uint64 {
 type DelegationDelegatorReward struct {
 	ValidatorAddress string                                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Reward           github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
+	// description is the description of the validator, when queried through
+	// Query/DelegationTotalRewards.
+	Description types1.Description `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
 }
 
 func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorReward{} }
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +752,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{14}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,6 +783,7 @@ var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageI
 
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
+	proto.RegisterType((*RestakeEntry)(nil), "cosmos.distribution.v1beta1.RestakeEntry")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
 	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
@@ -615,6 +791,8 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
+	proto.RegisterType((*CommunityTaxDestination)(nil), "cosmos.distribution.v1beta1.CommunityTaxDestination")
+	proto.RegisterType((*CommunityTaxDestinations)(nil), "cosmos.distribution.v1beta1.CommunityTaxDestinations")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
@@ -626,65 +804,79 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7c, 0xe3, 0x38, 0xe9, 0x6b, 0x9b, 0x7c, 0x99, 0x38, 0xa9, 0xe3, 0x56, 0x76, 0xb4,
-	0x12, 0x10, 0x54, 0xc5, 0x69, 0xda, 0x5b, 0xc4, 0x25, 0x76, 0x82, 0xe0, 0xd4, 0x68, 0x83, 0x00,
-	0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x39, 0xf0,
-	0xe3, 0x84, 0xc4, 0x05, 0x71, 0x40, 0x3d, 0x22, 0xce, 0xbd, 0x70, 0xe4, 0xd6, 0x63, 0xe9, 0x05,
-	0xc4, 0x21, 0xa0, 0x44, 0x48, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xd7, 0x81, 0x50, 0xf5, 0x10,
-	0x8b, 0x93, 0x3d, 0xef, 0xcd, 0x7e, 0x7e, 0xbc, 0x79, 0xfb, 0x66, 0xa1, 0x13, 0x0a, 0x95, 0x08,
-	0xb5, 0x19, 0x31, 0xa5, 0x25, 0xeb, 0x67, 0x9a, 0x09, 0xbe, 0x39, 0xda, 0xea, 0x53, 0x4d, 0xb6,
-	0x2e, 0x04, 0x3b, 0xa9, 0x14, 0x5a, 0xe0, 0xdb, 0x76, 0x7f, 0xe7, 0x42, 0xca, 0xed, 0x6f, 0xd6,
-	0x07, 0x62, 0x20, 0xf2, 0x7d, 0x9b, 0xe6, 0x9f, 0x7d, 0xa4, 0xd9, 0x72, 0x14, 0x7d, 0xa2, 0x68,
-	0x01, 0x1d, 0x0a, 0xe6, 0x20, 0x9b, 0xab, 0x36, 0x1f, 0xd8, 0x07, 0x1d, 0x7e, 0xbe, 0xf0, 0x3e,
-	0x99, 0x81, 0xda, 0x3e, 0x91, 0x24, 0x51, 0x98, 0xc0, 0xcd, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x14, 0x68, 0x72, 0xd2, 0x40, 0x6b, 0x68, 0xfd, 0x5a, 0xf7, 0xcd, 0xa7, 0xa7, 0xed, 0xca, 0x2f,
-	0xa7, 0xed, 0xd7, 0x06, 0x4c, 0x0f, 0xb3, 0x7e, 0x27, 0x14, 0x89, 0x83, 0x70, 0x3f, 0x1b, 0x2a,
-	0x3a, 0xda, 0xd4, 0x8f, 0x52, 0xaa, 0x3a, 0xbb, 0x34, 0x7c, 0xfe, 0x64, 0x03, 0x1c, 0xc3, 0x2e,
-	0x0d, 0xfd, 0x1b, 0x05, 0xe4, 0xbb, 0xe4, 0x04, 0x73, 0xa8, 0x1b, 0x8d, 0x46, 0x48, 0x2a, 0x14,
-	0x95, 0x81, 0xa4, 0xc7, 0x44, 0x46, 0x8d, 0xff, 0x5d, 0x01, 0x13, 0x36, 0xc8, 0xfb, 0x0e, 0xd8,
-	0xcf, 0x71, 0x71, 0x0a, 0xcb, 0x7d, 0xc1, 0x33, 0xf5, 0x0f, 0xc2, 0x99, 0x2b, 0x20, 0x5c, 0xca,
-	0xa1, 0xff, 0xc6, 0x78, 0x1f, 0x96, 0x8f, 0x99, 0x1e, 0x46, 0x92, 0x1c, 0x07, 0x24, 0x8a, 0x64,
-	0x40, 0x39, 0xe9, 0xc7, 0x34, 0x6a, 0x54, 0xd7, 0xd0, 0xfa, 0xbc, 0xbf, 0x34, 0x4e, 0xee, 0x44,
-	0x91, 0xdc, 0xb3, 0xa9, 0xed, 0xea, 0x57, 0x8f, 0xdb, 0x15, 0xef, 0x47, 0x04, 0xcd, 0xf7, 0x48,
-	0xcc, 0x22, 0xa2, 0x85, 0x7c, 0x9b, 0x29, 0x2d, 0x24, 0x0b, 0x49, 0x6c, 0x71, 0x15, 0xfe, 0x0c,
-	0xc1, 0xad, 0x30, 0x4b, 0xb2, 0x98, 0x68, 0x36, 0xa2, 0xce, 0x47, 0x20, 0x89, 0x66, 0xa2, 0x81,
-	0xd6, 0x66, 0xd6, 0xaf, 0xdf, 0xbf, 0xe3, 0x3a, 0xad, 0x63, 0x0a, 0x31, 0xee, 0x18, 0xa3, 0xb4,
-	0x27, 0x18, 0xef, 0x3e, 0x30, 0x5e, 0xbf, 0xfb, 0xb5, 0x7d, 0xf7, 0xe5, 0xbc, 0x9a, 0x67, 0x94,
-	0xbf, 0x5c, 0x32, 0x5a, 0x1d, 0xbe, 0xe1, 0xc3, 0xaf, 0xc3, 0xa2, 0xa4, 0x87, 0x54, 0x52, 0x1e,
-	0xd2, 0x20, 0x14, 0x19, 0xd7, 0xf9, 0x09, 0xde, 0xf4, 0x17, 0x8a, 0x70, 0xcf, 0x44, 0xbd, 0x6f,
-	0x10, 0xdc, 0x2a, 0x3c, 0xf5, 0x32, 0x29, 0x29, 0xd7, 0x63, 0x43, 0x47, 0x30, 0x67, 0x4d, 0xa8,
-	0xe9, 0xe9, 0x1f, 0x33, 0xe0, 0x15, 0xa8, 0xa5, 0x54, 0x32, 0x61, 0x5b, 0xad, 0xea, 0xbb, 0x95,
-	0xf7, 0x25, 0x82, 0x56, 0x21, 0x70, 0x27, 0x74, 0x76, 0x69, 0xd4, 0x13, 0x49, 0xc2, 0x94, 0x62,
-	0x82, 0xe3, 0x8f, 0x00, 0xc2, 0x62, 0x35, 0x3d, 0xa9, 0x13, 0x24, 0xde, 0xe7, 0x08, 0x6e, 0x17,
-	0xaa, 0x1e, 0x66, 0x5a, 0x69, 0xc2, 0x23, 0xc6, 0x07, 0xff, 0x45, 0xe9, 0xbc, 0xaf, 0x11, 0x2c,
-	0x15, 0x62, 0x0e, 0x62, 0xa2, 0x86, 0x7b, 0x23, 0xca, 0x35, 0x7e, 0x03, 0xfe, 0x3f, 0x1a, 0x87,
-	0x03, 0x57, 0x5c, 0x94, 0x17, 0x77, 0xb1, 0x88, 0xef, 0xe7, 0x61, 0xfc, 0x01, 0xcc, 0x1f, 0x4a,
-	0x12, 0x9a, 0x49, 0x76, 0x25, 0xaf, 0x7a, 0x81, 0x66, 0x2a, 0x55, 0xbf, 0x44, 0x9c, 0xc2, 0x31,
-	0xac, 0x94, 0xea, 0x94, 0x49, 0x04, 0x34, 0xcf, 0xb8, 0x8a, 0xdd, 0xeb, 0xbc, 0x60, 0xcc, 0x76,
-	0x2e, 0x81, 0xec, 0x56, 0x8d, 0x64, 0xbf, 0x3e, 0xba, 0x84, 0xcd, 0xbd, 0xc1, 0x1f, 0x23, 0x98,
-	0x7b, 0x8b, 0xd2, 0x7d, 0x21, 0x62, 0x7c, 0x02, 0x0b, 0xe5, 0x30, 0x4d, 0x85, 0x88, 0xa7, 0x77,
-	0x52, 0xe5, 0xd4, 0x36, 0xcc, 0xde, 0xef, 0x08, 0x9a, 0xbd, 0xc9, 0xc8, 0x41, 0x4a, 0x79, 0x64,
-	0xc7, 0x14, 0x89, 0x71, 0x1d, 0x66, 0x35, 0xd3, 0x31, 0xb5, 0xd3, 0xdd, 0xb7, 0x0b, 0xbc, 0x06,
-	0xd7, 0x23, 0xaa, 0x42, 0xc9, 0xd2, 0xf2, 0x90, 0xfc, 0xc9, 0x10, 0xbe, 0x03, 0xd7, 0x24, 0x0d,
-	0x59, 0xca, 0x28, 0xd7, 0x76, 0x7c, 0xfa, 0x65, 0x00, 0x87, 0x50, 0x23, 0x49, 0x3e, 0x08, 0xaa,
-	0xb9, 0xcd, 0xd5, 0x4b, 0x6d, 0xe6, 0x1e, 0xef, 0x39, 0x8f, 0xeb, 0x2f, 0xe1, 0xd1, 0x1a, 0x74,
-	0xd0, 0xdb, 0x37, 0x3e, 0x7d, 0xdc, 0xae, 0x98, 0x4a, 0xff, 0x61, 0xaa, 0xfd, 0x03, 0x82, 0xe5,
-	0x5d, 0x1a, 0xd3, 0x41, 0x7e, 0x18, 0x9a, 0x48, 0xcd, 0xf8, 0xe0, 0x1d, 0x7e, 0x98, 0x8f, 0xa7,
-	0x54, 0xd2, 0x11, 0x13, 0x66, 0xf0, 0x4f, 0x36, 0xe6, 0xc2, 0x38, 0xec, 0xfa, 0xd2, 0x87, 0x59,
-	0xa5, 0xc9, 0x11, 0xbd, 0x92, 0xa6, 0xb4, 0x50, 0xf8, 0x2e, 0xd4, 0x86, 0x94, 0x0d, 0x86, 0xb6,
-	0x48, 0xd5, 0xee, 0xd2, 0x9f, 0xa7, 0xed, 0xc5, 0x50, 0x52, 0x33, 0x38, 0x79, 0x60, 0x53, 0xbe,
-	0xdb, 0xe2, 0xfd, 0x84, 0x60, 0xd5, 0x79, 0x60, 0x82, 0x17, 0x6e, 0xdc, 0x5d, 0xb2, 0x07, 0xaf,
-	0x94, 0x3d, 0x6c, 0x2e, 0x13, 0xaa, 0x94, 0xbb, 0x94, 0x1b, 0xcf, 0x9f, 0x6c, 0xd4, 0x1d, 0xf9,
-	0x8e, 0xcd, 0x1c, 0x68, 0x69, 0x46, 0x44, 0xf9, 0x52, 0xba, 0x38, 0x66, 0x50, 0x2b, 0xae, 0xd9,
-	0x29, 0xb5, 0xa0, 0x23, 0xd8, 0x9e, 0x77, 0x27, 0x84, 0xbc, 0xef, 0x11, 0xbc, 0xfa, 0xef, 0x5d,
-	0xf8, 0x3e, 0xd3, 0xc3, 0x5d, 0x9a, 0x0a, 0xc5, 0xf4, 0x94, 0x1a, 0x72, 0x65, 0xa2, 0x21, 0x4d,
-	0xca, 0xad, 0x70, 0x03, 0xe6, 0x22, 0x4b, 0xdc, 0x98, 0xcd, 0x13, 0xe3, 0x65, 0xa9, 0xbd, 0xfb,
-	0xf0, 0xdb, 0xb3, 0x16, 0x7a, 0x7a, 0xd6, 0x42, 0xcf, 0xce, 0x5a, 0xe8, 0xb7, 0xb3, 0x16, 0xfa,
-	0xe2, 0xbc, 0x55, 0x79, 0x76, 0xde, 0xaa, 0xfc, 0x7c, 0xde, 0xaa, 0x7c, 0xb8, 0xf5, 0xc2, 0xc2,
-	0x9c, 0x5c, 0xfc, 0xce, 0xcb, 0xeb, 0xd4, 0xaf, 0xe5, 0xdf, 0x5a, 0x0f, 0xfe, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x12, 0x5a, 0x76, 0x0b, 0x0a, 0x00, 0x00,
+	// 1147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x4f, 0x24, 0x45,
+	0x14, 0x9e, 0x66, 0x87, 0x01, 0x0a, 0x16, 0xd6, 0x62, 0x80, 0x81, 0x25, 0x33, 0x64, 0xfc, 0x85,
+	0xd9, 0x30, 0x2c, 0xe0, 0x89, 0x98, 0x18, 0x66, 0x06, 0x23, 0xf1, 0xb0, 0xa4, 0x31, 0x6a, 0xf6,
+	0x60, 0xa7, 0xa6, 0xbb, 0x98, 0xa9, 0xd0, 0x5d, 0xd5, 0x56, 0xd5, 0x0c, 0x83, 0x47, 0xbd, 0xa8,
+	0x27, 0x13, 0x2f, 0xc4, 0x83, 0xe1, 0x60, 0xe2, 0xc6, 0xf3, 0x5e, 0x3c, 0x7a, 0xdb, 0xe3, 0xba,
+	0x27, 0xe3, 0x01, 0x0d, 0xc4, 0xc4, 0xf8, 0x57, 0x98, 0xea, 0xaa, 0xee, 0x9e, 0x51, 0x40, 0x34,
+	0x4c, 0xf6, 0x34, 0x53, 0xef, 0xd5, 0xfb, 0xbe, 0x57, 0xef, 0xbd, 0x7a, 0xaf, 0x1a, 0x54, 0x5c,
+	0x26, 0x02, 0x26, 0x56, 0x3d, 0x22, 0x24, 0x27, 0x8d, 0xb6, 0x24, 0x8c, 0xae, 0x76, 0xd6, 0x1a,
+	0x58, 0xa2, 0xb5, 0x3e, 0x61, 0x25, 0xe4, 0x4c, 0x32, 0x78, 0x57, 0xef, 0xaf, 0xf4, 0xa9, 0xcc,
+	0xfe, 0x85, 0x7c, 0x93, 0x35, 0x59, 0xb4, 0x6f, 0x55, 0xfd, 0xd3, 0x26, 0x0b, 0x45, 0x43, 0xd1,
+	0x40, 0x02, 0x27, 0xd0, 0x2e, 0x23, 0x06, 0x72, 0x61, 0x5e, 0xeb, 0x1d, 0x6d, 0x68, 0xf0, 0xb5,
+	0xea, 0x25, 0x63, 0x2a, 0x24, 0x3a, 0x20, 0xb4, 0x99, 0x58, 0x9b, 0xb5, 0xde, 0x55, 0x7e, 0x94,
+	0x05, 0xb9, 0x5d, 0xc4, 0x51, 0x20, 0x20, 0x02, 0xb7, 0x5d, 0x16, 0x04, 0x6d, 0x4a, 0xe4, 0x91,
+	0x23, 0x51, 0xb7, 0x60, 0x2d, 0x59, 0xcb, 0x63, 0xd5, 0x37, 0x9e, 0x9c, 0x96, 0x32, 0xbf, 0x9c,
+	0x96, 0x5e, 0x69, 0x12, 0xd9, 0x6a, 0x37, 0x2a, 0x2e, 0x0b, 0x0c, 0x91, 0xf9, 0x59, 0x11, 0xde,
+	0xc1, 0xaa, 0x3c, 0x0a, 0xb1, 0xa8, 0xd4, 0xb1, 0xfb, 0xec, 0xf1, 0x0a, 0x30, 0x7e, 0xd4, 0xb1,
+	0x6b, 0x4f, 0x24, 0x90, 0xef, 0xa2, 0x2e, 0xa4, 0x20, 0xaf, 0x4e, 0xa2, 0xdc, 0x0d, 0x99, 0xc0,
+	0xdc, 0xe1, 0xf8, 0x10, 0x71, 0xaf, 0x30, 0x74, 0x03, 0x4c, 0x50, 0x21, 0xef, 0x1a, 0x60, 0x3b,
+	0xc2, 0x85, 0x21, 0x98, 0x69, 0x30, 0xda, 0x16, 0xff, 0x20, 0xbc, 0x75, 0x03, 0x84, 0xd3, 0x11,
+	0xf4, 0xdf, 0x18, 0xd7, 0xc1, 0xcc, 0x21, 0x91, 0x2d, 0x8f, 0xa3, 0x43, 0x07, 0x79, 0x1e, 0x77,
+	0x30, 0x45, 0x0d, 0x1f, 0x7b, 0x85, 0xec, 0x92, 0xb5, 0x3c, 0x6a, 0x4f, 0xc7, 0xca, 0x2d, 0xcf,
+	0xe3, 0xdb, 0x5a, 0x05, 0xdf, 0x04, 0x8b, 0x01, 0xea, 0x3a, 0xa9, 0x9d, 0xef, 0x3b, 0x1e, 0xf6,
+	0x71, 0x13, 0xa9, 0x0a, 0x11, 0x85, 0xe1, 0x25, 0x6b, 0x39, 0x6b, 0xcf, 0x07, 0xa8, 0xfb, 0x7e,
+	0x6c, 0xed, 0xfb, 0xf5, 0x74, 0x03, 0x7c, 0x0d, 0xdc, 0xe1, 0x58, 0xe5, 0x15, 0x3b, 0x84, 0x4a,
+	0xcc, 0x3b, 0xc8, 0x2f, 0xe4, 0x22, 0xa3, 0x29, 0x23, 0xdf, 0x31, 0x62, 0xb8, 0x01, 0x66, 0x15,
+	0x97, 0x11, 0x0b, 0x27, 0xc4, 0xdc, 0x69, 0xf8, 0xcc, 0x3d, 0x28, 0x8c, 0x44, 0x06, 0xd3, 0x01,
+	0xea, 0xda, 0x46, 0xb9, 0x8b, 0x79, 0x55, 0xa9, 0x36, 0xb3, 0xc7, 0x27, 0xa5, 0x4c, 0xf9, 0x93,
+	0x21, 0x30, 0x61, 0x54, 0xdb, 0x54, 0xf2, 0x23, 0xb8, 0x0d, 0x5e, 0x30, 0x6e, 0x32, 0x1e, 0x1d,
+	0x16, 0x0b, 0x61, 0x8a, 0xa6, 0xf0, 0xec, 0xf1, 0x4a, 0xde, 0xc4, 0x6a, 0x4b, 0x6b, 0xf6, 0x24,
+	0x27, 0xb4, 0x69, 0xdf, 0x49, 0x4c, 0x8c, 0x5c, 0xc1, 0x74, 0x90, 0x4f, 0xbc, 0x3e, 0x98, 0xa1,
+	0x7f, 0x83, 0x49, 0x4c, 0x62, 0x98, 0x87, 0x60, 0x4c, 0xb6, 0x38, 0x16, 0x2d, 0xe6, 0xff, 0x9f,
+	0xfc, 0xee, 0x50, 0xd9, 0x93, 0xdf, 0x1d, 0x2a, 0xed, 0x14, 0x6e, 0x73, 0xf4, 0xb3, 0x93, 0x52,
+	0xe6, 0x0f, 0x15, 0x84, 0x9f, 0x2c, 0xb0, 0xf0, 0x5e, 0x4c, 0xfd, 0x36, 0x11, 0x92, 0x71, 0xe2,
+	0x22, 0x5f, 0x67, 0x5f, 0xc0, 0xcf, 0x2d, 0x30, 0xe7, 0xb6, 0x83, 0xb6, 0x8f, 0x24, 0xe9, 0x60,
+	0x53, 0x6d, 0x0e, 0x57, 0x79, 0x2a, 0x58, 0x4b, 0xb7, 0x96, 0xc7, 0xd7, 0x17, 0x4d, 0xd7, 0xa8,
+	0xa8, 0x72, 0x8d, 0x6f, 0xbf, 0xaa, 0xa7, 0x1a, 0x23, 0xb4, 0xba, 0xa1, 0x3c, 0xfe, 0xfe, 0xd7,
+	0xd2, 0xbd, 0xeb, 0x55, 0xa4, 0xb2, 0x11, 0xf6, 0x4c, 0xca, 0xa8, 0xfd, 0xb0, 0x15, 0x1f, 0x7c,
+	0x15, 0x4c, 0x71, 0xbc, 0x8f, 0x39, 0xa6, 0x2e, 0x76, 0x5c, 0xd6, 0xa6, 0x32, 0x8a, 0xea, 0x6d,
+	0x7b, 0x32, 0x11, 0xd7, 0x94, 0xb4, 0xfc, 0x8d, 0x05, 0xe6, 0x92, 0x33, 0xd5, 0xda, 0x9c, 0x63,
+	0x2a, 0xe3, 0x03, 0x1d, 0x80, 0x11, 0x7d, 0x08, 0x31, 0x38, 0xff, 0x63, 0x06, 0x38, 0x0b, 0x72,
+	0x21, 0xe6, 0x84, 0xe9, 0x86, 0x90, 0xb5, 0xcd, 0xaa, 0xfc, 0x95, 0x05, 0x8a, 0x89, 0x83, 0x5b,
+	0xae, 0x39, 0x2e, 0xf6, 0x6a, 0x2c, 0x08, 0x88, 0x10, 0x84, 0x51, 0xf8, 0x11, 0x00, 0x6e, 0xb2,
+	0x1a, 0x9c, 0xab, 0x3d, 0x24, 0xe5, 0x2f, 0x2c, 0x70, 0x37, 0xf1, 0xea, 0x41, 0x5b, 0x0a, 0x89,
+	0xa8, 0xa7, 0x6a, 0xf3, 0x39, 0x84, 0xae, 0xfc, 0xb5, 0x05, 0xa6, 0x13, 0x67, 0xf6, 0x7c, 0x24,
+	0x5a, 0xdb, 0x1d, 0x4c, 0xa5, 0x6a, 0x0d, 0xe9, 0xe5, 0x32, 0xc1, 0xb5, 0x74, 0x6b, 0x48, 0xe4,
+	0xbb, 0x91, 0x18, 0x7e, 0x00, 0x46, 0xf7, 0x39, 0x72, 0x55, 0x4b, 0xb9, 0x91, 0x86, 0x9c, 0xa0,
+	0xa9, 0x48, 0xe5, 0x2f, 0x70, 0x4e, 0x40, 0x1f, 0xcc, 0xa6, 0xde, 0x09, 0xa5, 0x70, 0x70, 0xa4,
+	0x31, 0x11, 0xbb, 0x5f, 0xb9, 0x62, 0x64, 0x56, 0x2e, 0x80, 0xac, 0x66, 0x95, 0xcb, 0x76, 0xbe,
+	0x73, 0x01, 0x9b, 0x69, 0x63, 0x9f, 0x5a, 0x60, 0xe4, 0x2d, 0x8c, 0x77, 0x19, 0xf3, 0x61, 0x17,
+	0x4c, 0xa6, 0x23, 0x2f, 0x64, 0xcc, 0x1f, 0x5c, 0xa6, 0xd2, 0xd9, 0xaa, 0x98, 0xcb, 0xdf, 0x59,
+	0x60, 0xae, 0xd6, 0x33, 0x1a, 0xeb, 0x58, 0x48, 0x42, 0xa3, 0x7e, 0x0e, 0xd7, 0xc1, 0xc8, 0x75,
+	0xbb, 0x69, 0xbc, 0x71, 0x80, 0xc9, 0xfb, 0x18, 0x14, 0x2e, 0x71, 0x54, 0xc0, 0x0f, 0xc1, 0x84,
+	0xd7, 0xb3, 0x36, 0xd1, 0x7b, 0xfd, 0xca, 0xac, 0x5d, 0x02, 0x66, 0x32, 0xd7, 0x87, 0x57, 0xfe,
+	0xdd, 0x02, 0x0b, 0xb5, 0xde, 0xb8, 0xed, 0x85, 0x98, 0x7a, 0x7a, 0xe4, 0x22, 0x1f, 0xe6, 0xc1,
+	0xb0, 0x24, 0xd2, 0xc7, 0x3a, 0x4c, 0xb6, 0x5e, 0xc0, 0x25, 0x30, 0xee, 0x61, 0xe1, 0x72, 0x12,
+	0xa6, 0xd1, 0xb0, 0x7b, 0x45, 0x70, 0x11, 0x8c, 0x71, 0xec, 0x92, 0x90, 0x60, 0x2a, 0xf5, 0xa8,
+	0xb0, 0x53, 0x01, 0x74, 0x41, 0x0e, 0x05, 0x51, 0xbb, 0xcc, 0x46, 0xc7, 0x99, 0xbf, 0xb0, 0x18,
+	0xa2, 0x4a, 0xb8, 0x6f, 0x2a, 0x61, 0xf9, 0x1a, 0x31, 0xd6, 0x65, 0x60, 0xa0, 0x37, 0x27, 0xd4,
+	0x44, 0x39, 0x8e, 0xa7, 0xca, 0x8f, 0x16, 0x98, 0xa9, 0xc7, 0x73, 0x71, 0x4f, 0x22, 0x2e, 0x09,
+	0x6d, 0xee, 0xd0, 0xfd, 0xa8, 0x89, 0x87, 0x1c, 0x77, 0x08, 0x6b, 0x8b, 0xfe, 0xeb, 0x3b, 0x19,
+	0x8b, 0xcd, 0xed, 0xb5, 0xc1, 0x70, 0x34, 0x9a, 0x6f, 0x24, 0xfb, 0x1a, 0x0a, 0xde, 0x03, 0xb9,
+	0x16, 0x26, 0xcd, 0x96, 0x0e, 0x52, 0xb6, 0x3a, 0xfd, 0xe7, 0x69, 0x69, 0xca, 0xe5, 0x38, 0xca,
+	0x8e, 0xa3, 0x55, 0xb6, 0xd9, 0x52, 0xfe, 0x76, 0x08, 0xcc, 0xa7, 0x8f, 0x92, 0xe4, 0x34, 0xe6,
+	0x5d, 0x74, 0xe1, 0x90, 0xb7, 0xfe, 0xf3, 0x90, 0x27, 0x20, 0x97, 0x3c, 0x19, 0x07, 0x74, 0x51,
+	0x0d, 0x01, 0x7c, 0xa7, 0xbf, 0x8c, 0x54, 0x04, 0xc6, 0xd7, 0x5f, 0x8c, 0xf9, 0xe2, 0x57, 0x74,
+	0x4a, 0x99, 0x6c, 0x35, 0x95, 0xdc, 0x6b, 0xad, 0x1f, 0x10, 0xc7, 0x27, 0x25, 0xab, 0xfc, 0x83,
+	0x05, 0x5e, 0xbe, 0xbc, 0xa4, 0xd5, 0x03, 0xaf, 0x8e, 0x43, 0x26, 0x88, 0x1c, 0x50, 0x75, 0xcf,
+	0xf6, 0x54, 0xb7, 0x52, 0x99, 0x15, 0x2c, 0x80, 0x11, 0x4f, 0x13, 0x47, 0xef, 0xcd, 0x31, 0x3b,
+	0x5e, 0xa6, 0xbe, 0x57, 0x1f, 0x3c, 0x3a, 0x2b, 0x5a, 0x4f, 0xce, 0x8a, 0xd6, 0xd3, 0xb3, 0xa2,
+	0xf5, 0xdb, 0x59, 0xd1, 0xfa, 0xf2, 0xbc, 0x98, 0x79, 0x7a, 0x5e, 0xcc, 0xfc, 0x7c, 0x5e, 0xcc,
+	0x3c, 0x5c, 0xbb, 0x32, 0xca, 0xdd, 0xfe, 0xcf, 0xa4, 0x28, 0xe8, 0x8d, 0x5c, 0xf4, 0x11, 0xb2,
+	0xf1, 0xd7, 0x00, 0x70, 0x59, 0xc3, 0x80, 0x4a, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -718,6 +910,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.MaxWithdrawAllDelegations != that1.MaxWithdrawAllDelegations {
+		return false
+	}
+	if this.RestakeInterval != that1.RestakeInterval {
+		return false
+	}
+	if this.MaxRestakesPerBlock != that1.MaxRestakesPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -927,6 +1128,62 @@ func (this *FeePool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CommunityTaxDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityTaxDestination)
+	if !ok {
+		that2, ok := that.(CommunityTaxDestination)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	return true
+}
+func (this *CommunityTaxDestinations) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityTaxDestinations)
+	if !ok {
+		that2, ok := that.(CommunityTaxDestinations)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Destinations) != len(that1.Destinations) {
+		return false
+	}
+	for i := range this.Destinations {
+		if !this.Destinations[i].Equal(&that1.Destinations[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -987,6 +1244,9 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Description.Equal(&that1.Description) {
+		return false
+	}
 	return true
 }
 func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
@@ -1045,6 +1305,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRestakesPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxRestakesPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RestakeInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RestakeInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxWithdrawAllDelegations != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxWithdrawAllDelegations))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1088,6 +1363,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RestakeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestakeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestakeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1358,7 +1680,7 @@ func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
+func (m *CommunityTaxDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1368,55 +1690,37 @@ func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommunityTaxDestination) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommunityTaxDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+func (m *CommunityTaxDestinations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1426,21 +1730,116 @@ func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommunityTaxDestinations) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommunityTaxDestinations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.Stake.Size()
 		i -= size
@@ -1479,6 +1878,16 @@ func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Reward) > 0 {
 		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1587,6 +1996,34 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.MaxWithdrawAllDelegations != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxWithdrawAllDelegations))
+	}
+	if m.RestakeInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.RestakeInterval))
+	}
+	if m.MaxRestakesPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxRestakesPerBlock))
+	}
+	return n
+}
+
+func (m *RestakeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1700,6 +2137,36 @@ func (m *FeePool) Size() (n int) {
 	return n
 }
 
+func (m *CommunityTaxDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CommunityTaxDestinations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *CommunityPoolSpendProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1760,6 +2227,8 @@ func (m *DelegationDelegatorReward) Size() (n int) {
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
+	l = m.Description.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1798,7 +2267,236 @@ func sovDistribution(x uint64) (n int) {
 func sozDistribution(x uint64) (n int) {
 	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawAllDelegations", wireType)
+			}
+			m.MaxWithdrawAllDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithdrawAllDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeInterval", wireType)
+			}
+			m.RestakeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestakeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestakesPerBlock", wireType)
+			}
+			m.MaxRestakesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestakesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestakeEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1821,15 +2519,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestakeEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestakeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,13 +2555,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1891,13 +2587,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1925,30 +2619,10 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.WithdrawAddrEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2615,6 +3289,206 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommunityTaxDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityTaxDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityTaxDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityTaxDestinations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityTaxDestinations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityTaxDestinations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, CommunityTaxDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3012,6 +3886,39 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -18,4 +18,9 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrTooManyDelegations      = sdkerrors.Register(ModuleName, 14, "too many delegations to withdraw rewards from")
+	ErrRestakeWithdrawAddr     = sdkerrors.Register(ModuleName, 15, "auto-restaking requires the rewards to be withdrawn to the delegator")
+	ErrInvalidAuthority        = sdkerrors.Register(ModuleName, 16, "invalid authority")
+	ErrNoCommunityPoolGrant    = sdkerrors.Register(ModuleName, 17, "no community pool grant to claw back")
+	ErrInvalidCommunityTax     = sdkerrors.Register(ModuleName, 18, "invalid community tax destinations")
 )
@@ -2,15 +2,25 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress           = "set_withdraw_address"
+	EventTypeSetCommissionWithdrawAddress = "set_commission_withdraw_address"
+	EventTypeRewards                      = "rewards"
+	EventTypeCommission                   = "commission"
+	EventTypeWithdrawRewards              = "withdraw_rewards"
+	EventTypeWithdrawCommission           = "withdraw_commission"
+	EventTypeProposerReward               = "proposer_reward"
+	EventTypeSetAutoRestake               = "set_auto_restake"
+	EventTypeRestake                      = "restake"
+	EventTypeCommunityPoolSpend           = "community_pool_spend"
+	EventTypeCommunityPoolClawback        = "community_pool_clawback"
+	EventTypeCommunityTax                 = "community_tax"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyThreshold       = "threshold"
+	AttributeKeyRecipient       = "recipient"
 
 	AttributeValueCategory = ModuleName
 )
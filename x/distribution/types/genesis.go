@@ -9,35 +9,45 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	cwis []ValidatorCommissionWithdrawInfo, restakes []RestakeEntry, grantees []string,
+	taxDestinations []CommunityTaxDestination,
 ) *GenesisState {
 
 	return &GenesisState{
-		Params:                          params,
-		FeePool:                         fp,
-		DelegatorWithdrawInfos:          dwis,
-		PreviousProposer:                pp.String(),
-		OutstandingRewards:              r,
-		ValidatorAccumulatedCommissions: acc,
-		ValidatorHistoricalRewards:      historical,
-		ValidatorCurrentRewards:         cur,
-		DelegatorStartingInfos:          dels,
-		ValidatorSlashEvents:            slashes,
+		Params:                           params,
+		FeePool:                          fp,
+		DelegatorWithdrawInfos:           dwis,
+		PreviousProposer:                 pp.String(),
+		OutstandingRewards:               r,
+		ValidatorAccumulatedCommissions:  acc,
+		ValidatorHistoricalRewards:       historical,
+		ValidatorCurrentRewards:          cur,
+		DelegatorStartingInfos:           dels,
+		ValidatorSlashEvents:             slashes,
+		ValidatorCommissionWithdrawInfos: cwis,
+		RestakeEntries:                   restakes,
+		CommunityPoolGrantees:            grantees,
+		CommunityTaxDestinations:         taxDestinations,
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		FeePool:                         InitialFeePool(),
-		Params:                          DefaultParams(),
-		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
-		PreviousProposer:                "",
-		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
-		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
-		ValidatorHistoricalRewards:      []ValidatorHistoricalRewardsRecord{},
-		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
-		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
-		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FeePool:                          InitialFeePool(),
+		Params:                           DefaultParams(),
+		DelegatorWithdrawInfos:           []DelegatorWithdrawInfo{},
+		PreviousProposer:                 "",
+		OutstandingRewards:               []ValidatorOutstandingRewardsRecord{},
+		ValidatorAccumulatedCommissions:  []ValidatorAccumulatedCommissionRecord{},
+		ValidatorHistoricalRewards:       []ValidatorHistoricalRewardsRecord{},
+		ValidatorCurrentRewards:          []ValidatorCurrentRewardsRecord{},
+		DelegatorStartingInfos:           []DelegatorStartingInfoRecord{},
+		ValidatorSlashEvents:             []ValidatorSlashEventRecord{},
+		ValidatorCommissionWithdrawInfos: []ValidatorCommissionWithdrawInfo{},
+		RestakeEntries:                   []RestakeEntry{},
+		CommunityPoolGrantees:            []string{},
+		CommunityTaxDestinations:         []CommunityTaxDestination{},
 	}
 }
 
@@ -46,5 +56,8 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := ValidateCommunityTaxDestinations(gs.CommunityTaxDestinations, gs.Params.CommunityTax); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
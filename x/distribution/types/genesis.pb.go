@@ -69,6 +69,49 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// ValidatorCommissionWithdrawInfo is the address where the commission of a
+// validator is withdrawn to, when it differs from the validator withdraw
+// address. It is used for import/export via genesis json.
+type ValidatorCommissionWithdrawInfo struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// withdraw_address is the address to withdraw the validator commission to.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *ValidatorCommissionWithdrawInfo) Reset()         { *m = ValidatorCommissionWithdrawInfo{} }
+func (m *ValidatorCommissionWithdrawInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionWithdrawInfo) ProtoMessage()    {}
+func (*ValidatorCommissionWithdrawInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{1}
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionWithdrawInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionWithdrawInfo.Merge(m, src)
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionWithdrawInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionWithdrawInfo proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -81,7 +124,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{1}
+	return fileDescriptor_76eed0f9489db580, []int{2}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +166,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{2}
+	return fileDescriptor_76eed0f9489db580, []int{3}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +210,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{3}
+	return fileDescriptor_76eed0f9489db580, []int{4}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +251,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{4}
+	return fileDescriptor_76eed0f9489db580, []int{5}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +294,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{5}
+	return fileDescriptor_76eed0f9489db580, []int{6}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +339,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,13 +390,25 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// validator_commission_withdraw_infos defines the validator commission
+	// withdraw infos at genesis.
+	ValidatorCommissionWithdrawInfos []ValidatorCommissionWithdrawInfo `protobuf:"bytes,11,rep,name=validator_commission_withdraw_infos,json=validatorCommissionWithdrawInfos,proto3" json:"validator_commission_withdraw_infos"`
+	// restake_entries defines the delegations opted in to auto-restaking at
+	// genesis.
+	RestakeEntries []RestakeEntry `protobuf:"bytes,12,rep,name=restake_entries,json=restakeEntries,proto3" json:"restake_entries"`
+	// community_pool_grantees defines the recipients of community pool grants
+	// with a vesting schedule, which can be clawed back, at genesis.
+	CommunityPoolGrantees []string `protobuf:"bytes,13,rep,name=community_pool_grantees,json=communityPoolGrantees,proto3" json:"community_pool_grantees,omitempty"`
+	// community_tax_destinations defines the split of the community tax at
+	// genesis.
+	CommunityTaxDestinations []CommunityTaxDestination `protobuf:"bytes,14,rep,name=community_tax_destinations,json=communityTaxDestinations,proto3" json:"community_tax_destinations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,6 +439,7 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*ValidatorCommissionWithdrawInfo)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionWithdrawInfo")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
@@ -398,63 +454,73 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x5e, 0xef, 0x86, 0x34, 0x9d, 0x4d, 0x3f, 0x98, 0x26, 0xa9, 0x93, 0x96, 0xdd, 0xed, 0xc7,
+	0xa1, 0x15, 0xaa, 0x97, 0xa4, 0x08, 0x50, 0xf9, 0x90, 0xf2, 0x45, 0xe1, 0xd4, 0x68, 0x53, 0x51,
+	0x84, 0x84, 0xac, 0x59, 0x7b, 0xe2, 0x1d, 0xba, 0xeb, 0x59, 0xcd, 0x8c, 0x9d, 0x8d, 0xc4, 0x09,
+	0x09, 0xa9, 0x47, 0x10, 0x1c, 0x38, 0xf6, 0x88, 0x90, 0xb8, 0x21, 0xf1, 0x0f, 0x50, 0x8f, 0x15,
+	0x27, 0x0e, 0x08, 0x50, 0xc2, 0x81, 0xbf, 0xc0, 0x0d, 0x79, 0x3c, 0x1e, 0xdb, 0x8d, 0xe3, 0x6c,
+	0x42, 0x72, 0x4a, 0xec, 0x79, 0xdf, 0xf7, 0x79, 0x9e, 0x77, 0x1e, 0xbf, 0x33, 0x0b, 0x6e, 0x3b,
+	0x94, 0x0f, 0x28, 0x6f, 0xbb, 0x84, 0x0b, 0x46, 0xba, 0x81, 0x20, 0xd4, 0x6f, 0x87, 0x8b, 0x5d,
+	0x2c, 0xd0, 0x62, 0xdb, 0xc3, 0x3e, 0xe6, 0x84, 0x5b, 0x43, 0x46, 0x05, 0x85, 0x57, 0xe2, 0x50,
+	0x2b, 0x1b, 0x6a, 0xa9, 0xd0, 0x85, 0x19, 0x8f, 0x7a, 0x54, 0xc6, 0xb5, 0xa3, 0xff, 0xe2, 0x94,
+	0x85, 0x86, 0xaa, 0xde, 0x45, 0x1c, 0xeb, 0xaa, 0x0e, 0x25, 0xbe, 0x5a, 0xb7, 0xca, 0xd0, 0x73,
+	0x38, 0x71, 0xfc, 0x7c, 0x1c, 0x6f, 0xc7, 0x40, 0x8a, 0x8f, 0x7c, 0xb8, 0xfe, 0xa3, 0x01, 0x66,
+	0xd7, 0x70, 0x1f, 0x7b, 0x48, 0x50, 0xf6, 0x88, 0x88, 0x9e, 0xcb, 0xd0, 0xf6, 0x87, 0xfe, 0x16,
+	0x85, 0xeb, 0xe0, 0x65, 0x37, 0x59, 0xb0, 0x91, 0xeb, 0x32, 0xcc, 0xb9, 0x69, 0xb4, 0x8c, 0x5b,
+	0x67, 0x57, 0xcc, 0x5f, 0x7f, 0xba, 0x33, 0xa3, 0xca, 0x2c, 0xc7, 0x2b, 0x9b, 0x82, 0x11, 0xdf,
+	0xeb, 0x5c, 0xd4, 0x29, 0xea, 0x3d, 0x5c, 0x05, 0x17, 0xb7, 0x55, 0x59, 0x5d, 0xa5, 0x7a, 0x48,
+	0x95, 0x0b, 0x49, 0x86, 0x7a, 0x7d, 0x6f, 0xea, 0xc9, 0xd3, 0x66, 0xe5, 0x9f, 0xa7, 0xcd, 0xca,
+	0xf5, 0x9f, 0x0d, 0xd0, 0xfc, 0x08, 0xf5, 0x89, 0x1b, 0x61, 0xac, 0xd2, 0xc1, 0x80, 0x70, 0x4e,
+	0xa8, 0xff, 0x22, 0xf3, 0x30, 0x09, 0x19, 0x9f, 0xb9, 0x4e, 0x39, 0x25, 0xe6, 0xff, 0x1a, 0xe0,
+	0x9a, 0x66, 0xfe, 0x20, 0x10, 0x5c, 0x20, 0xdf, 0x8d, 0x72, 0xf0, 0x36, 0x62, 0x2e, 0xef, 0x60,
+	0x87, 0x32, 0xf7, 0xa4, 0xb8, 0x7f, 0x61, 0x80, 0x4b, 0x34, 0xc5, 0xb0, 0x59, 0x0c, 0x62, 0x56,
+	0x5b, 0xb5, 0x5b, 0xf5, 0xa5, 0xab, 0xca, 0x40, 0x56, 0x64, 0xb0, 0xc4, 0x8b, 0xd6, 0x1a, 0x76,
+	0x56, 0x29, 0xf1, 0x57, 0xee, 0x3e, 0xfb, 0xa3, 0x59, 0xf9, 0xe1, 0xcf, 0xe6, 0xab, 0x1e, 0x11,
+	0xbd, 0xa0, 0x6b, 0x39, 0x74, 0xa0, 0x3c, 0xa3, 0xfe, 0xdc, 0xe1, 0xee, 0xe3, 0xb6, 0xd8, 0x19,
+	0x62, 0x9e, 0xe4, 0xf0, 0x0e, 0xa4, 0xfb, 0x14, 0x65, 0xb4, 0xff, 0x6e, 0x80, 0x9b, 0x5a, 0xfb,
+	0xb2, 0xe3, 0x04, 0x83, 0xa0, 0x8f, 0x04, 0x76, 0xd3, 0x0d, 0x3c, 0x59, 0xf9, 0x0e, 0xa8, 0xa3,
+	0x14, 0x45, 0xee, 0x5a, 0x7d, 0xe9, 0x6d, 0xab, 0xe4, 0x4b, 0xb4, 0xca, 0xe9, 0xad, 0x4c, 0x44,
+	0x4d, 0xe9, 0x64, 0xab, 0x66, 0xe4, 0xfd, 0x6d, 0x80, 0x96, 0xce, 0xff, 0x80, 0x70, 0x41, 0x19,
+	0x71, 0x50, 0xff, 0x54, 0x76, 0x76, 0x0e, 0x4c, 0x0e, 0x31, 0x23, 0x34, 0x56, 0x35, 0xd1, 0x51,
+	0x4f, 0xf0, 0x11, 0x38, 0x93, 0x6c, 0x72, 0x4d, 0xca, 0x7d, 0x73, 0x3c, 0xb9, 0xfb, 0xe8, 0x2a,
+	0xa9, 0x49, 0xb5, 0x8c, 0xcc, 0x5f, 0x0c, 0xf0, 0x4a, 0xfa, 0xed, 0x05, 0x8c, 0x61, 0x5f, 0x9c,
+	0x8a, 0xc6, 0x87, 0xa9, 0x96, 0x78, 0xeb, 0x5e, 0x1f, 0x4f, 0x4b, 0x9e, 0xd3, 0xc1, 0x42, 0xbe,
+	0xad, 0x82, 0x2b, 0x7a, 0xe8, 0x6d, 0x0a, 0xc4, 0x04, 0xf1, 0xbd, 0x68, 0x74, 0xa4, 0x32, 0x4e,
+	0x62, 0xf4, 0x15, 0x76, 0xa3, 0x7a, 0xe4, 0x6e, 0x7c, 0x0a, 0xce, 0x71, 0xc5, 0xd1, 0x26, 0xfe,
+	0x16, 0x55, 0xfb, 0xbb, 0x54, 0xda, 0x93, 0x42, 0x79, 0xaa, 0x23, 0xd3, 0x3c, 0xf3, 0x2e, 0xd3,
+	0x96, 0x27, 0x55, 0x30, 0xaf, 0x7b, 0xb9, 0xd9, 0x47, 0xbc, 0xb7, 0x1e, 0xca, 0x76, 0x9e, 0xb0,
+	0x7f, 0x7b, 0x98, 0x78, 0x3d, 0x91, 0xf8, 0x37, 0x7e, 0xca, 0xf8, 0xba, 0x96, 0xf3, 0xf5, 0x67,
+	0x60, 0x36, 0x85, 0xe5, 0x11, 0x29, 0x1b, 0x47, 0xac, 0xcc, 0x09, 0xd9, 0x85, 0xd7, 0xc6, 0x73,
+	0x46, 0xaa, 0x46, 0xf5, 0xe0, 0x52, 0xb8, 0x7f, 0x29, 0xd3, 0x8a, 0xef, 0xa6, 0xc1, 0xf4, 0xfd,
+	0xf8, 0x18, 0xdf, 0x14, 0x48, 0x60, 0xb8, 0x0c, 0x26, 0x87, 0x88, 0xa1, 0x41, 0x2c, 0xb9, 0xbe,
+	0x74, 0xa3, 0x14, 0x77, 0x43, 0x86, 0x2a, 0x28, 0x95, 0x08, 0xd7, 0xc1, 0xd4, 0x16, 0xc6, 0xf6,
+	0x90, 0xd2, 0xbe, 0xb2, 0xf5, 0xcd, 0xd2, 0x22, 0xef, 0x63, 0xbc, 0x41, 0x69, 0x3f, 0xb1, 0xf1,
+	0x56, 0xfc, 0x08, 0x19, 0x30, 0x53, 0x73, 0xea, 0x03, 0x2a, 0x32, 0x46, 0xf4, 0xe5, 0xd7, 0xc6,
+	0x77, 0x46, 0xf6, 0xcc, 0x54, 0x20, 0x73, 0x6e, 0xd1, 0xa2, 0x74, 0xf2, 0x90, 0xe1, 0x90, 0xd0,
+	0x40, 0x5e, 0x22, 0x86, 0x94, 0x63, 0x66, 0x4e, 0x1c, 0xb6, 0xf7, 0x49, 0xca, 0x86, 0xca, 0x80,
+	0x41, 0xf1, 0xa1, 0xf4, 0x92, 0x64, 0xfd, 0xde, 0x78, 0x3b, 0x79, 0xd0, 0xc9, 0xa9, 0x14, 0x14,
+	0x9c, 0x43, 0xf0, 0x1b, 0x03, 0x5c, 0xcb, 0x58, 0x37, 0x1d, 0xe1, 0xb6, 0xa3, 0x07, 0x3c, 0x37,
+	0x27, 0x25, 0x8b, 0xe5, 0xff, 0x71, 0x48, 0xe4, 0x88, 0x34, 0xc3, 0xd2, 0x58, 0x0e, 0xbf, 0x34,
+	0xc0, 0xd5, 0x94, 0x55, 0x4f, 0x8f, 0x61, 0xdd, 0x96, 0x33, 0x92, 0xd0, 0xbb, 0xc7, 0x1c, 0xe3,
+	0x39, 0x32, 0x0b, 0xe1, 0x81, 0x71, 0xf0, 0x73, 0x30, 0x9f, 0xd2, 0x70, 0xe2, 0x09, 0xaa, 0x39,
+	0x4c, 0x49, 0x0e, 0xf7, 0x8e, 0x33, 0x7e, 0x73, 0x04, 0x2e, 0x87, 0xc5, 0x41, 0x70, 0x94, 0x75,
+	0x73, 0x6e, 0xcc, 0x71, 0xf3, 0xac, 0x04, 0x7f, 0xeb, 0xe8, 0x73, 0x2e, 0x07, 0x3d, 0xe7, 0x16,
+	0x85, 0x70, 0xc8, 0xc0, 0x5c, 0xe1, 0x60, 0xe1, 0x26, 0x90, 0xb8, 0x6f, 0x1c, 0x75, 0xb2, 0xe4,
+	0x50, 0x67, 0x0a, 0xe6, 0x0b, 0x87, 0x5f, 0x1b, 0xe0, 0x46, 0xa6, 0xd9, 0xda, 0x0d, 0x2f, 0x7e,
+	0xc7, 0x75, 0xc9, 0xe0, 0x9d, 0x31, 0xdb, 0x5e, 0x78, 0x0b, 0x56, 0x3c, 0x5a, 0x61, 0x79, 0x18,
+	0x87, 0x1f, 0x83, 0x0b, 0x0c, 0x73, 0x81, 0x1e, 0x63, 0x1b, 0xfb, 0x82, 0x11, 0xcc, 0xcd, 0x69,
+	0x09, 0x7f, 0xbb, 0x14, 0xbe, 0x13, 0xe7, 0xac, 0xfb, 0x82, 0xed, 0x28, 0xac, 0xf3, 0x2c, 0x7d,
+	0x47, 0x30, 0x87, 0x1b, 0xe0, 0x72, 0x24, 0x31, 0xf0, 0x89, 0xd8, 0x91, 0x63, 0xcf, 0xf6, 0x18,
+	0xf2, 0x05, 0xc6, 0xdc, 0x3c, 0xd7, 0xaa, 0x95, 0xce, 0x8e, 0x59, 0x9d, 0x18, 0xcd, 0xbc, 0xfb,
+	0x2a, 0x0d, 0x8e, 0xc0, 0x42, 0x5a, 0x51, 0xa0, 0x91, 0xed, 0x62, 0x2e, 0x88, 0x8f, 0x84, 0xfc,
+	0x82, 0xcf, 0xb7, 0x6a, 0x87, 0xde, 0x15, 0x56, 0x93, 0xf4, 0x87, 0x68, 0xb4, 0x96, 0x26, 0x2b,
+	0x05, 0xa6, 0x53, 0xbc, 0x9c, 0xb9, 0x3c, 0xac, 0x3c, 0xf8, 0x7e, 0xb7, 0x61, 0x3c, 0xdb, 0x6d,
+	0x18, 0xcf, 0x77, 0x1b, 0xc6, 0x5f, 0xbb, 0x0d, 0xe3, 0xab, 0xbd, 0x46, 0xe5, 0xf9, 0x5e, 0xa3,
+	0xf2, 0xdb, 0x5e, 0xa3, 0xf2, 0xc9, 0x62, 0xe9, 0xa5, 0x79, 0x94, 0xff, 0xc9, 0x26, 0xef, 0xd0,
+	0xdd, 0x49, 0xf9, 0x4b, 0xec, 0xee, 0x7f, 0x03, 0x00, 0x23, 0x8a, 0xc5, 0x06, 0x54, 0x0e, 0x00,
+	0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -494,6 +560,43 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorCommissionWithdrawInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionWithdrawInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -780,6 +883,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommunityTaxDestinations) > 0 {
+		for iNdEx := len(m.CommunityTaxDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityTaxDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CommunityPoolGrantees) > 0 {
+		for iNdEx := len(m.CommunityPoolGrantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommunityPoolGrantees[iNdEx])
+			copy(dAtA[i:], m.CommunityPoolGrantees[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CommunityPoolGrantees[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RestakeEntries) > 0 {
+		for iNdEx := len(m.RestakeEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestakeEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ValidatorCommissionWithdrawInfos) > 0 {
+		for iNdEx := len(m.ValidatorCommissionWithdrawInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionWithdrawInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -936,6 +1090,23 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorCommissionWithdrawInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1099,6 +1270,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorCommissionWithdrawInfos) > 0 {
+		for _, e := range m.ValidatorCommissionWithdrawInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RestakeEntries) > 0 {
+		for _, e := range m.RestakeEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityPoolGrantees) > 0 {
+		for _, s := range m.CommunityPoolGrantees {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityTaxDestinations) > 0 {
+		for _, e := range m.CommunityTaxDestinations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1222,6 +1417,120 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorCommissionWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionWithdrawInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2367,6 +2676,140 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionWithdrawInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCommissionWithdrawInfos = append(m.ValidatorCommissionWithdrawInfos, ValidatorCommissionWithdrawInfo{})
+			if err := m.ValidatorCommissionWithdrawInfos[len(m.ValidatorCommissionWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestakeEntries = append(m.RestakeEntries, RestakeEntry{})
+			if err := m.RestakeEntries[len(m.RestakeEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolGrantees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolGrantees = append(m.CommunityPoolGrantees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTaxDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityTaxDestinations = append(m.CommunityTaxDestinations, CommunityTaxDestination{})
+			if err := m.CommunityTaxDestinations[len(m.CommunityTaxDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,16 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<valAddrLen (1 Byte)><valAddr_Bytes>: sdk.AccAddress
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: RestakeEntry
+//
+// - 0x0B: RestakeEntry key
+//
+// - 0x0C<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{}
+//
+// - 0x0D: CommunityTaxDestinations
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +63,15 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	ValidatorCommissionWithdrawAddrPrefix = []byte{0x09} // key for validator commission withdraw address
+
+	RestakeEntryPrefix = []byte{0x0A} // key for delegations opted in to auto-restaking
+	RestakeCursorKey   = []byte{0x0B} // key for the last restake entry visited by auto-restaking
+
+	CommunityPoolGranteePrefix = []byte{0x0C} // key for recipients of vesting community pool grants
+
+	CommunityTaxDestinationsKey = []byte{0x0D} // key for the split of the community tax
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -152,6 +171,48 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetValidatorCommissionWithdrawInfoAddress creates an address from a validator's commission withdraw info key.
+func GetValidatorCommissionWithdrawInfoAddress(key []byte) (valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x09<valAddrLen (1 Byte)><valAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.ValAddress(addr)
+}
+
+// GetCommunityPoolGranteeAddress creates an address from a community pool grantee key.
+func GetCommunityPoolGranteeAddress(key []byte) (addr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0C<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	bz := key[2:]
+	kv.AssertKeyLength(bz, int(key[1]))
+
+	return sdk.AccAddress(bz)
+}
+
+// GetRestakeEntryAddresses creates the addresses from a restake entry key.
+func GetRestakeEntryAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -162,6 +223,16 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
+// GetValidatorCommissionWithdrawAddrKey creates the key for a validator's commission withdraw addr.
+func GetValidatorCommissionWithdrawAddrKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorCommissionWithdrawAddrPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetCommunityPoolGranteeKey creates the key for a community pool grantee.
+func GetCommunityPoolGranteeKey(addr sdk.AccAddress) []byte {
+	return append(CommunityPoolGranteePrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
 // GetDelegatorStartingInfoKey creates the key for a delegator's starting info.
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
@@ -213,3 +284,13 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetRestakeEntriesPrefix creates the prefix key for the restake entries of a delegator.
+func GetRestakeEntriesPrefix(d sdk.AccAddress) []byte {
+	return append(RestakeEntryPrefix, address.MustLengthPrefix(d.Bytes())...)
+}
+
+// GetRestakeEntryKey creates the key for a delegation's restake entry.
+func GetRestakeEntryKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetRestakeEntriesPrefix(d), address.MustLengthPrefix(v.Bytes())...)
+}
@@ -3,18 +3,25 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // distribution message types
 const (
-	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
-	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
-	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
-	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetWithdrawAddress             = "set_withdraw_address"
+	TypeMsgSetCommissionWithdrawAddress   = "set_commission_withdraw_address"
+	TypeMsgWithdrawDelegatorReward        = "withdraw_delegator_reward"
+	TypeMsgWithdrawAllDelegatorRewards    = "withdraw_all_delegator_rewards"
+	TypeMsgWithdrawValidatorCommission    = "withdraw_validator_commission"
+	TypeMsgFundCommunityPool              = "fund_community_pool"
+	TypeMsgSetAutoRestake                 = "set_auto_restake"
+	TypeMsgCommunityPoolSpendWithSchedule = "community_pool_spend_with_schedule"
+	TypeMsgCommunityPoolClawback          = "community_pool_clawback"
+	TypeMsgSetCommunityTaxDestinations    = "set_community_tax_destinations"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgSetCommissionWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawAllDelegatorRewards{}, &MsgWithdrawValidatorCommission{}, &MsgSetAutoRestake{}, &MsgCommunityPoolSpendWithSchedule{}, &MsgCommunityPoolClawback{}, &MsgSetCommunityTaxDestinations{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -50,6 +57,40 @@ func (msg MsgSetWithdrawAddress) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgSetCommissionWithdrawAddress(valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) *MsgSetCommissionWithdrawAddress {
+	return &MsgSetCommissionWithdrawAddress{
+		ValidatorAddress: valAddr.String(),
+		WithdrawAddress:  withdrawAddr.String(),
+	}
+}
+
+func (msg MsgSetCommissionWithdrawAddress) Route() string { return ModuleName }
+func (msg MsgSetCommissionWithdrawAddress) Type() string  { return TypeMsgSetCommissionWithdrawAddress }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetCommissionWithdrawAddress) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetCommissionWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetCommissionWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw address: %s", err)
+	}
+
+	return nil
+}
+
 func NewMsgWithdrawDelegatorReward(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgWithdrawDelegatorReward {
 	return &MsgWithdrawDelegatorReward{
 		DelegatorAddress: delAddr.String(),
@@ -83,6 +124,39 @@ func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of all the delegations of a delegator, and the
+// commission of the validator it operates if withCommission is set.
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress, withCommission bool) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr.String(),
+		WithCommission:   withCommission,
+	}
+}
+
+func (msg MsgWithdrawAllDelegatorRewards) Route() string { return ModuleName }
+func (msg MsgWithdrawAllDelegatorRewards) Type() string  { return TypeMsgWithdrawAllDelegatorRewards }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawAllDelegatorRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return nil
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr sdk.ValAddress) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr.String(),
@@ -151,3 +225,195 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgSetAutoRestake returns a new MsgSetAutoRestake opting a delegation in
+// or out of auto-restaking.
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool, threshold sdk.Int) *MsgSetAutoRestake {
+	return &MsgSetAutoRestake{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+		Threshold:        threshold,
+	}
+}
+
+// Route returns the MsgSetAutoRestake message route.
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoRestake message type.
+func (msg MsgSetAutoRestake) Type() string { return TypeMsgSetAutoRestake }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoRestake message that
+// the expected signer needs to sign.
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoRestake message validation.
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.Enabled && (msg.Threshold.IsNil() || msg.Threshold.IsNegative()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid restake threshold: %s", msg.Threshold)
+	}
+	return nil
+}
+
+// NewMsgCommunityPoolSpendWithSchedule returns a new
+// MsgCommunityPoolSpendWithSchedule granting the sum of the periods to the
+// recipient.
+func NewMsgCommunityPoolSpendWithSchedule(authority, recipient sdk.AccAddress, startTime int64, periods vestingtypes.Periods) *MsgCommunityPoolSpendWithSchedule {
+	return &MsgCommunityPoolSpendWithSchedule{
+		Authority:      authority.String(),
+		Recipient:      recipient.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the MsgCommunityPoolSpendWithSchedule message route.
+func (msg MsgCommunityPoolSpendWithSchedule) Route() string { return ModuleName }
+
+// Type returns the MsgCommunityPoolSpendWithSchedule message type.
+func (msg MsgCommunityPoolSpendWithSchedule) Type() string {
+	return TypeMsgCommunityPoolSpendWithSchedule
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCommunityPoolSpendWithSchedule) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCommunityPoolSpendWithSchedule
+// message that the expected signer needs to sign.
+func (msg MsgCommunityPoolSpendWithSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCommunityPoolSpendWithSchedule message
+// validation.
+func (msg MsgCommunityPoolSpendWithSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if msg.StartTime < 1 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid start time of %d, must be greater than 0", msg.StartTime)
+	}
+	if len(msg.VestingPeriods) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("vesting periods cannot be empty")
+	}
+
+	var total sdk.Coins
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid period length of %d in period %d, must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount in period %d: %s", i, period.Amount)
+		}
+		total = total.Add(period.Amount...)
+	}
+	if total.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap("the vesting periods grant no coins")
+	}
+
+	return nil
+}
+
+// NewMsgCommunityPoolClawback returns a new MsgCommunityPoolClawback.
+func NewMsgCommunityPoolClawback(authority, addr sdk.AccAddress) *MsgCommunityPoolClawback {
+	return &MsgCommunityPoolClawback{
+		Authority: authority.String(),
+		Address:   addr.String(),
+	}
+}
+
+// Route returns the MsgCommunityPoolClawback message route.
+func (msg MsgCommunityPoolClawback) Route() string { return ModuleName }
+
+// Type returns the MsgCommunityPoolClawback message type.
+func (msg MsgCommunityPoolClawback) Type() string { return TypeMsgCommunityPoolClawback }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCommunityPoolClawback) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCommunityPoolClawback message
+// that the expected signer needs to sign.
+func (msg MsgCommunityPoolClawback) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCommunityPoolClawback message validation.
+func (msg MsgCommunityPoolClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgSetCommunityTaxDestinations returns a new MsgSetCommunityTaxDestinations.
+func NewMsgSetCommunityTaxDestinations(authority sdk.AccAddress, destinations []CommunityTaxDestination) *MsgSetCommunityTaxDestinations {
+	return &MsgSetCommunityTaxDestinations{
+		Authority:    authority.String(),
+		Destinations: destinations,
+	}
+}
+
+// Route returns the MsgSetCommunityTaxDestinations message route.
+func (msg MsgSetCommunityTaxDestinations) Route() string { return ModuleName }
+
+// Type returns the MsgSetCommunityTaxDestinations message type.
+func (msg MsgSetCommunityTaxDestinations) Type() string { return TypeMsgSetCommunityTaxDestinations }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetCommunityTaxDestinations) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetCommunityTaxDestinations
+// message that the expected signer needs to sign.
+func (msg MsgSetCommunityTaxDestinations) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetCommunityTaxDestinations message
+// validation. The sum of the fractions is checked against the community tax
+// when the message is executed.
+func (msg MsgSetCommunityTaxDestinations) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := ValidateCommunityTaxDestinations(msg.Destinations, sdk.OneDec()); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCommunityTax, err.Error())
+	}
+	return nil
+}
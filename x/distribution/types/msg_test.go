@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // test ValidateBasic for MsgSetWithdrawAddress
@@ -32,6 +33,28 @@ func TestMsgSetWithdrawAddress(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSetCommissionWithdrawAddress
+func TestMsgSetCommissionWithdrawAddress(t *testing.T) {
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		withdrawAddr  sdk.AccAddress
+		expectPass    bool
+	}{
+		{valAddr1, delAddr1, true},
+		{emptyValAddr, delAddr1, false},
+		{valAddr1, emptyDelAddr, false},
+		{emptyValAddr, emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionWithdrawAddress(tc.validatorAddr, tc.withdrawAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawDelegatorReward
 func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	tests := []struct {
@@ -54,6 +77,28 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawAllDelegatorRewards
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	tests := []struct {
+		delegatorAddr  sdk.AccAddress
+		withCommission bool
+		expectPass     bool
+	}{
+		{delAddr1, false, true},
+		{delAddr1, true, true},
+		{emptyDelAddr, false, false},
+		{emptyDelAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllDelegatorRewards(tc.delegatorAddr, tc.withCommission)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawValidatorCommission
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	tests := []struct {
@@ -93,3 +138,107 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetAutoRestake
+func TestMsgSetAutoRestake(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		enabled       bool
+		threshold     sdk.Int
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, sdk.NewInt(10), true},
+		{delAddr1, valAddr1, true, sdk.ZeroInt(), true},
+		{delAddr1, valAddr1, true, sdk.NewInt(-1), false},
+		{delAddr1, valAddr1, true, sdk.Int{}, false},
+		{delAddr1, valAddr1, false, sdk.Int{}, true},
+		{emptyDelAddr, valAddr1, true, sdk.NewInt(10), false},
+		{delAddr1, emptyValAddr, false, sdk.ZeroInt(), false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoRestake(tc.delegatorAddr, tc.validatorAddr, tc.enabled, tc.threshold)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCommunityPoolSpendWithSchedule
+func TestMsgCommunityPoolSpendWithSchedule(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	tests := []struct {
+		authority  sdk.AccAddress
+		recipient  sdk.AccAddress
+		startTime  int64
+		periods    vestingtypes.Periods
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, 1, vestingtypes.Periods{{Length: 10, Amount: coins}}, true},
+		{delAddr1, delAddr2, 1, vestingtypes.Periods{{Length: 10}, {Length: 10, Amount: coins}}, true},
+		{emptyDelAddr, delAddr2, 1, vestingtypes.Periods{{Length: 10, Amount: coins}}, false},
+		{delAddr1, emptyDelAddr, 1, vestingtypes.Periods{{Length: 10, Amount: coins}}, false},
+		{delAddr1, delAddr2, 0, vestingtypes.Periods{{Length: 10, Amount: coins}}, false},
+		{delAddr1, delAddr2, 1, vestingtypes.Periods{}, false},
+		{delAddr1, delAddr2, 1, vestingtypes.Periods{{Length: 0, Amount: coins}}, false},
+		{delAddr1, delAddr2, 1, vestingtypes.Periods{{Length: 10, Amount: sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-1)}}}}, false},
+		{delAddr1, delAddr2, 1, vestingtypes.Periods{{Length: 10}}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolSpendWithSchedule(tc.authority, tc.recipient, tc.startTime, tc.periods)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCommunityPoolClawback
+func TestMsgCommunityPoolClawback(t *testing.T) {
+	tests := []struct {
+		authority  sdk.AccAddress
+		addr       sdk.AccAddress
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, true},
+		{emptyDelAddr, delAddr2, false},
+		{delAddr1, emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolClawback(tc.authority, tc.addr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgSetCommunityTaxDestinations(t *testing.T) {
+	fraction := sdk.NewDecWithPrec(1, 2)
+	tests := []struct {
+		authority    sdk.AccAddress
+		destinations []CommunityTaxDestination
+		expectPass   bool
+	}{
+		{delAddr1, nil, true},
+		{delAddr1, []CommunityTaxDestination{{Address: delAddr2.String(), Fraction: fraction}, {Address: "", Fraction: fraction}}, true},
+		{emptyDelAddr, []CommunityTaxDestination{{Address: delAddr2.String(), Fraction: fraction}}, false},
+		{delAddr1, []CommunityTaxDestination{{Address: "invalid", Fraction: fraction}}, false},
+		{delAddr1, []CommunityTaxDestination{{Address: delAddr2.String(), Fraction: fraction}, {Address: delAddr2.String(), Fraction: fraction}}, false},
+		{delAddr1, []CommunityTaxDestination{{Address: delAddr2.String(), Fraction: sdk.ZeroDec()}}, false},
+		{delAddr1, []CommunityTaxDestination{{Address: delAddr2.String(), Fraction: sdk.Dec{}}}, false},
+		{delAddr1, []CommunityTaxDestination{{Address: delAddr2.String(), Fraction: sdk.OneDec()}, {Address: "", Fraction: fraction}}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommunityTaxDestinations(tc.authority, tc.destinations)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
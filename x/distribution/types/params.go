@@ -9,12 +9,25 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// DefaultMaxWithdrawAllDelegations is the default bound on the number of
+// delegations a single MsgWithdrawAllDelegatorRewards may withdraw from.
+const DefaultMaxWithdrawAllDelegations uint64 = 100
+
+// Default auto-restaking parameters
+const (
+	DefaultRestakeInterval     uint64 = 100
+	DefaultMaxRestakesPerBlock uint64 = 100
+)
+
 // Parameter keys
 var (
-	ParamStoreKeyCommunityTax        = []byte("communitytax")
-	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
-	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
-	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyCommunityTax              = []byte("communitytax")
+	ParamStoreKeyBaseProposerReward        = []byte("baseproposerreward")
+	ParamStoreKeyBonusProposerReward       = []byte("bonusproposerreward")
+	ParamStoreKeyWithdrawAddrEnabled       = []byte("withdrawaddrenabled")
+	ParamStoreKeyMaxWithdrawAllDelegations = []byte("maxwithdrawalldelegations")
+	ParamStoreKeyRestakeInterval           = []byte("restakeinterval")
+	ParamStoreKeyMaxRestakesPerBlock       = []byte("maxrestakesperblock")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -25,10 +38,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:              sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:        sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:       sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:       true,
+		MaxWithdrawAllDelegations: DefaultMaxWithdrawAllDelegations,
+		RestakeInterval:           DefaultRestakeInterval,
+		MaxRestakesPerBlock:       DefaultMaxRestakesPerBlock,
 	}
 }
 
@@ -44,6 +60,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxWithdrawAllDelegations, &p.MaxWithdrawAllDelegations, validateMaxWithdrawAllDelegations),
+		paramtypes.NewParamSetPair(ParamStoreKeyRestakeInterval, &p.RestakeInterval, validateRestakeInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxRestakesPerBlock, &p.MaxRestakesPerBlock, validateMaxRestakesPerBlock),
 	}
 }
 
@@ -138,3 +157,30 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxWithdrawAllDelegations(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRestakeInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxRestakesPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -70,6 +70,14 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// The evidence may have been committed with a consensus key the validator
+	// has rotated away from since, the signing info is kept under the current one.
+	currConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+	consAddr = currConsAddr
+
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
@@ -3,10 +3,13 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (suite *KeeperTestSuite) TestHandleDoubleSign() {
@@ -75,6 +78,48 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.Len(evidences, 1)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSignAfterConsPubKeyRotation() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, oldPk := valAddresses[0], pubkeys[0]
+	newPk := ed25519.GenPrivKey().PubKey()
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, oldPk, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), selfDelegation.Int64(), true)
+
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(operatorAddr, newPk)
+	suite.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper).RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	// the signing info follows the validator to its new consensus address
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPk.Address()), sdk.ConsAddress(newPk.Address())
+	suite.False(suite.app.SlashingKeeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+	suite.True(suite.app.SlashingKeeper.HasValidatorSigningInfo(ctx, newConsAddr))
+
+	// Tendermint keeps reporting signatures of the old key until the update takes effect
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), selfDelegation.Int64(), true)
+
+	// double sign with the old key
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: oldConsAddr.String(),
+	}
+	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+
+	// should be slashed, jailed and tombstoned
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, newConsAddr))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -22,6 +24,10 @@ func (a *BasicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg) (bo
 		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "basic allowance")
 	}
 
+	if err := a.checkFeeDenoms(fee); err != nil {
+		return false, err
+	}
+
 	if a.SpendLimit != nil {
 		left, invalid := a.SpendLimit.SafeSub(fee)
 		if invalid {
@@ -50,5 +56,44 @@ func (a BasicAllowance) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
 	}
 
+	seenDenoms := make(map[string]bool, len(a.AllowedFeeDenoms))
+	for _, denom := range a.AllowedFeeDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid allowed fee denom: %s", err)
+		}
+		if seenDenoms[denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "duplicate allowed fee denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+// checkFeeDenoms returns an error if the fee contains a denom which is not in
+// AllowedFeeDenoms. Any denom is allowed when AllowedFeeDenoms is empty.
+func (a BasicAllowance) checkFeeDenoms(fee sdk.Coins) error {
+	if len(a.AllowedFeeDenoms) == 0 {
+		return nil
+	}
+
+	for _, coin := range fee {
+		allowed := false
+		for _, denom := range a.AllowedFeeDenoms {
+			if coin.Denom == denom {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return sdkerrors.Wrapf(ErrFeeDenomNotAllowed, "%s is not allowed", coin.Denom)
+		}
+	}
+
 	return nil
 }
+
+// ExpiresAt returns the expiry time of the BasicAllowance.
+func (a BasicAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Expiration, nil
+}
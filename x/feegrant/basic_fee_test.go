@@ -123,6 +123,41 @@ func TestBasicFeeValidAllow(t *testing.T) {
 			blockTime: oneHour,
 			accept:    false,
 		},
+		"allowed fee denom": {
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit:       atom,
+				AllowedFeeDenoms: []string{"atom"},
+			},
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"mixed denom fee with all denoms allowed": {
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit:       atom.Add(eth...),
+				AllowedFeeDenoms: []string{"eth", "atom"},
+			},
+			fee:     smallAtom.Add(eth...),
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"mixed denom fee with a denom not allowed": {
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit:       atom.Add(eth...),
+				AllowedFeeDenoms: []string{"atom"},
+			},
+			fee:    smallAtom.Add(eth...),
+			accept: false,
+		},
+		"fee denom not allowed without spend limit": {
+			allowance: &feegrant.BasicAllowance{
+				AllowedFeeDenoms: []string{"atom"},
+			},
+			fee:    eth,
+			accept: false,
+		},
 	}
 
 	for name, stc := range cases {
@@ -148,3 +183,30 @@ func TestBasicFeeValidAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestBasicFeeAllowedFeeDenoms(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	for name, denoms := range map[string][]string{
+		"invalid denom":   {"atom", "1nvalid"},
+		"duplicate denom": {"atom", "eth", "atom"},
+	} {
+		allowance := &feegrant.BasicAllowance{AllowedFeeDenoms: denoms}
+		require.Error(t, allowance.ValidateBasic(), name)
+	}
+
+	// the fee denoms are checked before the spend limit, which is left untouched
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("eth", 10))
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit:       limit,
+		AllowedFeeDenoms: []string{"atom"},
+	}
+	require.NoError(t, allowance.ValidateBasic())
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("eth", 100))
+	remove, err := allowance.Accept(ctx, fee, []sdk.Msg{})
+	require.ErrorIs(t, err, feegrant.ErrFeeDenomNotAllowed)
+	require.False(t, remove)
+	require.Equal(t, limit, allowance.SpendLimit)
+}
@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 	feegrantQueryCmd.AddCommand(
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrants(),
+		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryFeeGrantStatus(),
 	)
 
 	return feegrantQueryCmd
@@ -128,3 +130,101 @@ $ %s query feegrant grants [grantee]
 
 	return cmd
 }
+
+// GetCmdQueryFeeGrantsByGranter returns cmd to query for all grants by a granter.
+func GetCmdQueryFeeGrantsByGranter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants-by-granter [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all grants by a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all the grants issued by a granter address.
+
+Example:
+$ %s query feegrant grants-by-granter [granter]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowancesByGranter(
+				cmd.Context(),
+				&feegrant.QueryAllowancesByGranterRequest{
+					Granter:    granterAddr.String(),
+					Pagination: pageReq,
+				},
+			)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+
+	return cmd
+}
+
+// GetCmdQueryFeeGrantStatus returns cmd to query for the remaining allowance of a grant between granter and grantee.
+func GetCmdQueryFeeGrantStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the remaining allowance of a single grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the status of a grant at the current block time: the remaining spend limit,
+the expiration, the remaining period allowance and the allowed messages.
+
+Example:
+$ %s query feegrant status [granter] [grantee]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowanceStatus(
+				cmd.Context(),
+				&feegrant.QueryAllowanceStatusRequest{
+					Granter: granterAddr.String(),
+					Grantee: granteeAddr.String(),
+				},
+			)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Status)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,11 +17,13 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration    = "expiration"
+	FlagPeriod        = "period"
+	FlagPeriodLimit   = "period-limit"
+	FlagSpendLimit    = "spend-limit"
+	FlagAllowedMsgs   = "allowed-messages"
+	FlagUnwrapMsgExec = "unwrap-msg-exec"
+	FlagAllowedDenoms = "allowed-fee-denoms"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +40,7 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdRevokeAllFeegrants(),
 	)
 
 	return feegrantTxCmd
@@ -57,8 +60,17 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 36000 or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --period 3600 --period-limit 10stake
+	--allowed-messages "/cosmos.bank.v1beta1.MsgSend" --unwrap-msg-exec or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --allowed-fee-denoms stake
+
+The allowed messages wrap the basic or periodic allowance. With --unwrap-msg-exec, the
+messages executed through an authz MsgExec are checked against the allowed messages in
+place of the MsgExec itself. With --allowed-fee-denoms, fees paid in other denoms are
+rejected.
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -92,8 +104,14 @@ Examples:
 				return err
 			}
 
+			allowedDenoms, err := getAllowedFeeDenoms(cmd)
+			if err != nil {
+				return err
+			}
+
 			basic := feegrant.BasicAllowance{
-				SpendLimit: limit,
+				SpendLimit:       limit,
+				AllowedFeeDenoms: allowedDenoms,
 			}
 
 			var expiresAtTime time.Time
@@ -141,7 +159,7 @@ Examples:
 				periodic := feegrant.PeriodicAllowance{
 					Basic:            basic,
 					Period:           getPeriod(periodClock),
-					PeriodReset:      getPeriodReset(periodClock),
+					PeriodReset:      periodReset,
 					PeriodSpendLimit: periodLimit,
 					PeriodCanSpend:   periodLimit,
 				}
@@ -149,16 +167,29 @@ Examples:
 				grant = &periodic
 			}
 
-			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			allowedMsgs, err := getAllowedMsgs(cmd)
 			if err != nil {
 				return err
 			}
 
+			unwrapExec, err := cmd.Flags().GetBool(FlagUnwrapMsgExec)
+			if err != nil {
+				return err
+			}
+
+			if unwrapExec && len(allowedMsgs) == 0 {
+				return fmt.Errorf("--%s requires --%s", FlagUnwrapMsgExec, FlagAllowedMsgs)
+			}
+
+			// the allowed messages wrap either the basic or the periodic allowance
 			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+				allowedMsgGrant, err := feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
 				if err != nil {
 					return err
 				}
+				allowedMsgGrant.UnwrapMsgExec = unwrapExec
+
+				grant = allowedMsgGrant
 			}
 
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
@@ -172,6 +203,8 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().Bool(FlagUnwrapMsgExec, false, "Check the messages executed through an authz MsgExec against the allowed messages")
+	cmd.Flags().StringSlice(FlagAllowedDenoms, []string{}, "Set of denoms the fees can be paid in, if not mentioned any denom is allowed")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration in which period_spend_limit coins can be spent before that allowance is reset")
@@ -216,6 +249,38 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllFeegrants returns a CLI command handler for creating a MsgRevokeAllAllowances transaction.
+func NewCmdRevokeAllFeegrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all [granter]",
+		Short: "revoke all fee-grants of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke the fee grants from a granter, at most %d of them per transaction.
+The transaction response reports how many grants remain, in which case it should be sent again.
+Note, the '--from' flag is ignored as it is implied from [granter].
+
+Example:
+ $ %s tx %s revoke-all cosmos1skj..
+			`, feegrant.MaxRevokedPerMsg, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgRevokeAllAllowances(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -223,3 +288,43 @@ func getPeriodReset(duration int64) time.Time {
 func getPeriod(duration int64) time.Duration {
 	return time.Duration(duration) * time.Second
 }
+
+// getAllowedMsgs returns the type URLs of the allowed messages flag, trimming
+// the spaces around them.
+func getAllowedMsgs(cmd *cobra.Command) ([]string, error) {
+	allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]string, 0, len(allowedMsgs))
+	for _, msg := range allowedMsgs {
+		msg = strings.TrimSpace(msg)
+		if !strings.HasPrefix(msg, "/") {
+			return nil, fmt.Errorf("invalid allowed message type URL: %q", msg)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// getAllowedFeeDenoms returns the denoms of the allowed fee denoms flag,
+// trimming the spaces around them.
+func getAllowedFeeDenoms(cmd *cobra.Command) ([]string, error) {
+	allowedDenoms, err := cmd.Flags().GetStringSlice(FlagAllowedDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, 0, len(allowedDenoms))
+	for _, denom := range allowedDenoms {
+		denom = strings.TrimSpace(denom)
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, fmt.Errorf("invalid allowed fee denom: %w", err)
+		}
+		denoms = append(denoms, denom)
+	}
+
+	return denoms, nil
+}
@@ -18,6 +18,8 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
 	govtestutil "github.com/cosmos/cosmos-sdk/x/gov/client/testutil"
@@ -253,6 +255,140 @@ func (s *IntegrationTestSuite) TestCmdGetFeeGrants() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdGetFeeGrantsByGranter() {
+	val := s.network.Validators[0]
+	granter := s.addedGranter
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		resp         *feegrant.QueryAllowancesByGranterResponse
+		expectLength int
+	}{
+		{
+			"wrong granter",
+			[]string{
+				"wrong_granter",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true, nil, 0,
+		},
+		{
+			"non existed granter",
+			[]string{
+				"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false, &feegrant.QueryAllowancesByGranterResponse{}, 0,
+		},
+		{
+			"valid req",
+			[]string{
+				granter.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false, &feegrant.QueryAllowancesByGranterResponse{}, 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFeeGrantsByGranter()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.resp), out.String())
+				s.Require().Len(tc.resp.Allowances, tc.expectLength)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdGetFeeGrantStatus() {
+	val := s.network.Validators[0]
+	granter := val.Address
+	grantee := s.addedGrantee
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectErr    bool
+	}{
+		{
+			"wrong granter",
+			[]string{
+				"wrong_granter",
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			"decoding bech32 failed",
+			true,
+		},
+		{
+			"wrong grantee",
+			[]string{
+				granter.String(),
+				"wrong_grantee",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			"decoding bech32 failed",
+			true,
+		},
+		{
+			"non existed grant",
+			[]string{
+				"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl",
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			"fee-grant not found",
+			true,
+		},
+		{
+			"valid req",
+			[]string{
+				granter.String(),
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFeeGrantStatus()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				var status feegrant.AllowanceStatus
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &status), out.String())
+				s.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", status.AllowanceType)
+				s.Require().Equal(s.addedGrant.Allowance.GetCachedValue().(*feegrant.BasicAllowance).SpendLimit, status.SpendLimit)
+				s.Require().NotNil(status.Expiration)
+				s.Require().False(status.Expired)
+				s.Require().Empty(status.AllowedMessages)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
 	val := s.network.Validators[0]
 	granter := val.Address
@@ -548,6 +684,132 @@ func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCmdRevokeAllFeegrants() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a new granter so the grants of the other tests are not revoked
+	k, _, err := clientCtx.Keyring.NewMnemonic("revoker", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	pub, err := k.GetPubKey()
+	s.Require().NoError(err)
+	granter := sdk.AccAddress(pub.Address())
+
+	_, err = banktestutil.MsgSendExec(clientCtx, val.Address, granter,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000))), commonFlags...)
+	s.Require().NoError(err)
+
+	grant := func(grantee string) {
+		args := append(
+			[]string{
+				granter.String(),
+				grantee,
+				fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, sdk.NewCoin("stake", sdk.NewInt(100))),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+			},
+			commonFlags...,
+		)
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdFeeGrant(), args)
+		s.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name         string
+		preRun       func()
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"invalid granter",
+			func() {},
+			append(
+				[]string{
+					"wrong_granter",
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"Valid revoke-all",
+			func() {
+				grant("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+				grant("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+			},
+			append(
+				[]string{
+					granter.String(),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"No grants left",
+			func() {},
+			append(
+				[]string{
+					granter.String(),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, sdkerrors.ErrNotFound.ABCICode(), &sdk.TxResponse{},
+		},
+		{
+			"Valid revoke-all with amino",
+			func() {
+				grant("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+			},
+			append(
+				[]string{
+					granter.String(),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+					fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			tc.preRun()
+			cmd := cli.NewCmdRevokeAllFeegrants()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryFeeGrantsByGranter(), []string{granter.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var resp feegrant.QueryAllowancesByGranterResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+	s.Require().Empty(resp.Allowances)
+}
+
 func (s *IntegrationTestSuite) TestNewCmdRevokeFeegrant() {
 	val := s.network.Validators[0]
 	granter := s.addedGranter
@@ -890,6 +1152,221 @@ func (s *IntegrationTestSuite) TestFilteredFeeAllowance() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestFilteredPeriodicFeeAllowance() {
+	val := s.network.Validators[0]
+
+	granter := val.Address
+	k, _, err := val.ClientCtx.Keyring.NewMnemonic("grantee2", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	pub, err := k.GetPubKey()
+	s.Require().NoError(err)
+	grantee := sdk.AccAddress(pub.Address())
+
+	clientCtx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	spendLimit := sdk.NewCoin("stake", sdk.NewInt(1000))
+	periodLimit := sdk.NewCoin("stake", sdk.NewInt(100))
+	oneHour := 60 * 60
+
+	allowMsgs := sdk.MsgTypeURL(&govtypes.MsgVote{})
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"unwrap msg exec without allowed messages",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, periodLimit.String()),
+					fmt.Sprintf("--%s=true", cli.FlagUnwrapMsgExec),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid allowed message",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, "MsgVote"),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, periodLimit.String()),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"valid filtered periodic fee grant",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, allowMsgs),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, spendLimit.String()),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, periodLimit.String()),
+					fmt.Sprintf("--%s=true", cli.FlagUnwrapMsgExec),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdFeeGrant()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	args := []string{
+		granter.String(),
+		grantee.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	// get filtered periodic fee allowance and check info
+	cmd := cli.GetCmdQueryFeeGrant()
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+	s.Require().NoError(err)
+
+	resp := &feegrant.Grant{}
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), resp), out.String())
+
+	grant, err := resp.GetGrant()
+	s.Require().NoError(err)
+
+	filteredFeeGrant, ok := grant.(*feegrant.AllowedMsgAllowance)
+	s.Require().True(ok)
+	s.Require().True(filteredFeeGrant.UnwrapMsgExec)
+	s.Require().Equal([]string{allowMsgs}, filteredFeeGrant.AllowedMessages)
+
+	allowance, err := filteredFeeGrant.GetAllowance()
+	s.Require().NoError(err)
+
+	periodicFeeGrant, ok := allowance.(*feegrant.PeriodicAllowance)
+	s.Require().True(ok)
+	s.Require().Equal(time.Duration(oneHour)*time.Second, periodicFeeGrant.Period)
+	s.Require().Equal(periodLimit.String(), periodicFeeGrant.PeriodSpendLimit.String())
+	s.Require().Equal(spendLimit.String(), periodicFeeGrant.Basic.SpendLimit.String())
+}
+
+func (s *IntegrationTestSuite) TestFeeAllowanceAllowedFeeDenoms() {
+	val := s.network.Validators[0]
+	granter := val.Address
+	grantee := sdk.AccAddress("allowed_fee_denoms__")
+	clientCtx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	spendLimit := sdk.NewCoin("stake", sdk.NewInt(1000))
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid allowed fee denom",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, spendLimit.String()),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedDenoms, "1stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"valid fee grant with allowed fee denoms",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, spendLimit.String()),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedDenoms, "stake, node0token"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdFeeGrant()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	args := []string{
+		granter.String(),
+		grantee.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryFeeGrant(), args)
+	s.Require().NoError(err)
+
+	var resp feegrant.Grant
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+	allowance, err := resp.GetGrant()
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"stake", "node0token"}, allowance.(*feegrant.BasicAllowance).AllowedFeeDenoms)
+}
+
 func getFormattedExpiration(duration int64) string {
 	return time.Now().Add(time.Duration(duration) * time.Second).Format(time.RFC3339)
 }
@@ -8,6 +8,9 @@ const (
 
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
+	AttributeKeyReason  = "reason"
+
+	AttributeValueExpired = "expired"
 
 	AttributeValueCategory = ModuleName
 )
@@ -41,6 +41,10 @@ type BasicAllowance struct {
 	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
 	// expiration specifies an optional time when this allowance expires
 	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// allowed_fee_denoms specifies the denoms the fees can be paid in with this
+	// allowance. If it is empty, fees can be paid in any denom. It also applies
+	// to the PeriodicAllowance wrapping this allowance.
+	AllowedFeeDenoms []string `protobuf:"bytes,3,rep,name=allowed_fee_denoms,json=allowedFeeDenoms,proto3" json:"allowed_fee_denoms,omitempty"`
 }
 
 func (m *BasicAllowance) Reset()         { *m = BasicAllowance{} }
@@ -90,6 +94,13 @@ func (m *BasicAllowance) GetExpiration() *time.Time {
 	return nil
 }
 
+func (m *BasicAllowance) GetAllowedFeeDenoms() []string {
+	if m != nil {
+		return m.AllowedFeeDenoms
+	}
+	return nil
+}
+
 // PeriodicAllowance extends Allowance to allow for both a maximum cap,
 // as well as a limit per time period.
 type PeriodicAllowance struct {
@@ -183,6 +194,9 @@ type AllowedMsgAllowance struct {
 	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
 	// allowed_messages are the messages for which the grantee has the access.
 	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// unwrap_msg_exec checks the messages executed by an authz MsgExec against
+	// the allowed messages, in place of the MsgExec itself.
+	UnwrapMsgExec bool `protobuf:"varint,3,opt,name=unwrap_msg_exec,json=unwrapMsgExec,proto3" json:"unwrap_msg_exec,omitempty"`
 }
 
 func (m *AllowedMsgAllowance) Reset()         { *m = AllowedMsgAllowance{} }
@@ -282,11 +296,59 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+// Params defines the parameters for the feegrant module.
+type Params struct {
+	// max_pruned_per_block is the maximum number of expired allowances removed
+	// at the end of a block. Zero disables the removal of expired allowances.
+	MaxPrunedPerBlock uint32 `protobuf:"varint,1,opt,name=max_pruned_per_block,json=maxPrunedPerBlock,proto3" json:"max_pruned_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxPrunedPerBlock() uint32 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*Params)(nil), "cosmos.feegrant.v1beta1.Params")
 }
 
 func init() {
@@ -294,44 +356,50 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa6, 0x90, 0x0b, 0x94, 0xd6, 0x04, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb1, 0x69, 0xd8, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x72, 0x99, 0x58, 0xa2, 0xb3, 0xfd,
-	0x6a, 0x4e, 0xc4, 0x3e, 0xcb, 0x77, 0x81, 0xe6, 0x1b, 0x30, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x15,
-	0x9f, 0xa1, 0x62, 0xaa, 0x60, 0x61, 0xa2, 0x28, 0xf9, 0x22, 0xc8, 0x77, 0xe7, 0x24, 0x24, 0xfc,
-	0x13, 0xea, 0x14, 0xdf, 0xbb, 0xf7, 0xfb, 0xf7, 0xde, 0x29, 0xe8, 0x96, 0x4f, 0x59, 0x44, 0x99,
-	0x7d, 0x08, 0x10, 0xa6, 0x38, 0xe6, 0xf6, 0xab, 0x1d, 0x0f, 0x38, 0xde, 0x99, 0x16, 0xac, 0x24,
-	0xa5, 0x9c, 0xea, 0x37, 0x65, 0x9f, 0x35, 0x2d, 0xab, 0xbe, 0x7a, 0x35, 0xa4, 0x21, 0x15, 0x3d,
-	0x76, 0xf6, 0x25, 0xdb, 0xeb, 0xb5, 0x90, 0xd2, 0x70, 0x00, 0xb6, 0x38, 0x79, 0xc3, 0x43, 0x1b,
-	0xc7, 0xa3, 0xfc, 0x4a, 0x32, 0xf5, 0x25, 0x46, 0xd1, 0xca, 0x2b, 0x53, 0x99, 0xf1, 0x30, 0x83,
-	0xa9, 0x11, 0x9f, 0x92, 0x58, 0xdd, 0x37, 0x16, 0x59, 0x39, 0x89, 0x80, 0x71, 0x1c, 0x25, 0x39,
-	0xc1, 0x62, 0x43, 0x30, 0x4c, 0x31, 0x27, 0x54, 0x11, 0x6c, 0x7d, 0xd1, 0xd0, 0xba, 0x83, 0x19,
-	0xf1, 0xbb, 0x83, 0x01, 0x7d, 0x8d, 0x63, 0x1f, 0xf4, 0x01, 0xaa, 0xb0, 0x04, 0xe2, 0xa0, 0x3f,
-	0x20, 0x11, 0xe1, 0x86, 0xd6, 0x2c, 0xb6, 0x2a, 0x9d, 0x9a, 0xa5, 0x7c, 0x65, 0x4e, 0xf2, 0xa8,
-	0x56, 0x8f, 0x92, 0xd8, 0xb9, 0x73, 0xfa, 0xad, 0x51, 0xf8, 0x70, 0xde, 0x68, 0x85, 0x84, 0xbf,
-	0x18, 0x7a, 0x96, 0x4f, 0x23, 0x15, 0x42, 0xfd, 0xb4, 0x59, 0xf0, 0xd2, 0xe6, 0xa3, 0x04, 0x98,
-	0x00, 0x30, 0x17, 0x09, 0xfe, 0x27, 0x19, 0xbd, 0x7e, 0x1f, 0x21, 0x38, 0x4a, 0x88, 0x34, 0x65,
-	0xac, 0x34, 0xb5, 0x56, 0xa5, 0x53, 0xb7, 0xa4, 0x6b, 0x2b, 0x77, 0x6d, 0x3d, 0xcb, 0x63, 0x39,
-	0xab, 0xc7, 0xe7, 0x0d, 0xcd, 0x9d, 0xc3, 0xec, 0x6e, 0x7e, 0x3a, 0x69, 0x5f, 0x7d, 0x04, 0x30,
-	0x4d, 0xf0, 0x78, 0x6b, 0x52, 0x44, 0x9b, 0x4f, 0x21, 0x25, 0x34, 0x98, 0x0f, 0xd6, 0x43, 0x25,
-	0x2f, 0x8b, 0x6a, 0x68, 0x42, 0x65, 0xdb, 0xfa, 0xcd, 0x06, 0xad, 0x9f, 0x07, 0xe2, 0xac, 0x66,
-	0x01, 0x5d, 0x89, 0xd5, 0xef, 0xa1, 0xb5, 0x44, 0x30, 0x2b, 0xaf, 0xb5, 0x25, 0xaf, 0x0f, 0xd4,
-	0x84, 0x9d, 0xcb, 0x19, 0xee, 0x6d, 0x66, 0x57, 0x41, 0xf4, 0x11, 0xd2, 0xe5, 0x57, 0x7f, 0x7e,
-	0xc2, 0xc5, 0x8b, 0x9f, 0xf0, 0x86, 0x94, 0x39, 0x98, 0xcd, 0x79, 0x88, 0x54, 0xad, 0xef, 0xe3,
-	0x58, 0xca, 0x1b, 0xab, 0x17, 0x2f, 0xbc, 0x2e, 0x45, 0x7a, 0x38, 0x16, 0xda, 0xfa, 0x1e, 0xba,
-	0xa2, 0x64, 0x53, 0x60, 0xc0, 0x8d, 0xd2, 0x5f, 0x17, 0x2c, 0xa6, 0x26, 0x96, 0x5c, 0x91, 0x48,
-	0x37, 0x03, 0xfe, 0x6a, 0xcb, 0xef, 0x34, 0x74, 0x5d, 0x1c, 0x21, 0xd8, 0x67, 0xe1, 0x6c, 0xcf,
-	0x0f, 0x51, 0x19, 0xe7, 0x07, 0xb5, 0xeb, 0xea, 0x92, 0x60, 0x37, 0x1e, 0x39, 0xcb, 0x9c, 0xee,
-	0x0c, 0xa9, 0xdf, 0x46, 0x1b, 0x58, 0xb2, 0xf7, 0x23, 0x60, 0x0c, 0x87, 0xc0, 0x8c, 0x95, 0x66,
-	0xb1, 0x55, 0x76, 0xaf, 0xa9, 0xfa, 0xbe, 0x2a, 0xef, 0xde, 0x78, 0xf3, 0xbe, 0x51, 0x58, 0x36,
-	0xf8, 0x51, 0x43, 0xa5, 0xbd, 0xec, 0x65, 0xe9, 0x1d, 0x74, 0x49, 0x3c, 0x31, 0x48, 0x85, 0xa1,
-	0xb2, 0x63, 0x7c, 0x3e, 0x69, 0x57, 0xd5, 0xdc, 0xbb, 0x41, 0x90, 0x02, 0x63, 0x07, 0x3c, 0x25,
-	0x71, 0xe8, 0xe6, 0x8d, 0x33, 0x0c, 0x88, 0xa7, 0xf6, 0x0f, 0x98, 0x85, 0xe8, 0xc5, 0xff, 0x8d,
-	0xee, 0x74, 0x4f, 0xc7, 0xa6, 0x76, 0x36, 0x36, 0xb5, 0xef, 0x63, 0x53, 0x3b, 0x9e, 0x98, 0x85,
-	0xb3, 0x89, 0x59, 0xf8, 0x3a, 0x31, 0x0b, 0xcf, 0xb7, 0xff, 0xf8, 0x12, 0x8e, 0xa6, 0x7f, 0x92,
-	0xde, 0x9a, 0x90, 0xbb, 0xfb, 0x23, 0x00, 0x00, 0xff, 0xff, 0x41, 0xd8, 0x15, 0xc6, 0x4f, 0x05,
-	0x00, 0x00,
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0xe3, 0xb6, 0xb4, 0x17, 0xfa, 0xcb, 0x04, 0xe1, 0x76, 0x70, 0xa2, 0x0e, 0x6d, 0x90,
+	0xa8, 0x4d, 0xcb, 0x44, 0x59, 0x88, 0xfb, 0x4b, 0x48, 0x54, 0x8a, 0x5c, 0x26, 0x16, 0xeb, 0x6c,
+	0xbf, 0x1a, 0xab, 0xb1, 0xcf, 0xba, 0x73, 0x68, 0x32, 0xb2, 0x31, 0x76, 0x64, 0x64, 0x66, 0xae,
+	0xf8, 0x13, 0x50, 0xc5, 0x54, 0x31, 0x31, 0x51, 0xd4, 0xfc, 0x23, 0xc8, 0x77, 0xe7, 0xa4, 0x24,
+	0xfc, 0x12, 0xea, 0x14, 0xdf, 0x7b, 0xef, 0x7b, 0xef, 0xfb, 0xde, 0xf7, 0x14, 0xb4, 0xea, 0x13,
+	0x16, 0x13, 0x66, 0x1d, 0x01, 0x84, 0x14, 0x27, 0x99, 0xf5, 0x7a, 0xc3, 0x83, 0x0c, 0x6f, 0x0c,
+	0x02, 0x66, 0x4a, 0x49, 0x46, 0xb4, 0x7b, 0xa2, 0xce, 0x1c, 0x84, 0x65, 0xdd, 0x72, 0x35, 0x24,
+	0x21, 0xe1, 0x35, 0x56, 0xfe, 0x25, 0xca, 0x97, 0x97, 0x42, 0x42, 0xc2, 0x36, 0x58, 0xfc, 0xe5,
+	0x75, 0x8e, 0x2c, 0x9c, 0xf4, 0x8a, 0x94, 0xe8, 0xe4, 0x0a, 0x8c, 0x6c, 0x2b, 0x52, 0x86, 0x24,
+	0xe3, 0x61, 0x06, 0x03, 0x22, 0x3e, 0x89, 0x12, 0x99, 0xaf, 0x8d, 0x76, 0xcd, 0xa2, 0x18, 0x58,
+	0x86, 0xe3, 0xb4, 0x68, 0x30, 0x5a, 0x10, 0x74, 0x28, 0xce, 0x22, 0x22, 0x1b, 0xac, 0xbc, 0x29,
+	0xa3, 0x39, 0x1b, 0xb3, 0xc8, 0x6f, 0xb6, 0xdb, 0xe4, 0x04, 0x27, 0x3e, 0x68, 0x6d, 0x54, 0x61,
+	0x29, 0x24, 0x81, 0xdb, 0x8e, 0xe2, 0x28, 0xd3, 0x95, 0xba, 0xda, 0xa8, 0x6c, 0x2e, 0x99, 0x92,
+	0x57, 0xce, 0xa4, 0x90, 0x6a, 0x6e, 0x93, 0x28, 0xb1, 0x1f, 0x9e, 0x7f, 0xab, 0x95, 0x3e, 0x5c,
+	0xd6, 0x1a, 0x61, 0x94, 0xbd, 0xea, 0x78, 0xa6, 0x4f, 0x62, 0x29, 0x42, 0xfe, 0xac, 0xb3, 0xe0,
+	0xd8, 0xca, 0x7a, 0x29, 0x30, 0x0e, 0x60, 0x0e, 0xe2, 0xfd, 0x9f, 0xe7, 0xed, 0xb5, 0xa7, 0x08,
+	0x41, 0x37, 0x8d, 0x04, 0x29, 0xbd, 0x5c, 0x57, 0x1a, 0x95, 0xcd, 0x65, 0x53, 0xb0, 0x36, 0x0b,
+	0xd6, 0xe6, 0x8b, 0x42, 0x96, 0x3d, 0x71, 0x7a, 0x59, 0x53, 0x9c, 0x6b, 0x18, 0xed, 0x01, 0xd2,
+	0x70, 0x4e, 0x1e, 0x02, 0xf7, 0x08, 0xc0, 0x0d, 0x20, 0x21, 0x31, 0xd3, 0xd5, 0xba, 0xda, 0x98,
+	0x71, 0x16, 0x64, 0x66, 0x0f, 0x60, 0x87, 0xc7, 0xb7, 0x16, 0x3f, 0x9f, 0xad, 0xcf, 0xee, 0x01,
+	0x0c, 0xf4, 0x3e, 0x5b, 0xe9, 0xab, 0x68, 0xb1, 0x05, 0x34, 0x22, 0xc1, 0xf5, 0x35, 0x6c, 0xa3,
+	0x49, 0x2f, 0x5f, 0x8c, 0xae, 0x70, 0x4e, 0x6b, 0xe6, 0x6f, 0xfc, 0x36, 0x7f, 0x5e, 0x9f, 0x3d,
+	0x91, 0xaf, 0xc3, 0x11, 0x58, 0xed, 0x09, 0x9a, 0x4a, 0x79, 0x67, 0xa9, 0x6c, 0x69, 0x4c, 0xd9,
+	0x8e, 0xf4, 0xc3, 0x9e, 0xce, 0x71, 0xef, 0x72, 0x71, 0x12, 0xa2, 0xf5, 0x90, 0x26, 0xbe, 0xdc,
+	0xeb, 0x7e, 0xa8, 0x37, 0xef, 0xc7, 0x82, 0x18, 0x73, 0x38, 0x74, 0xa5, 0x83, 0x64, 0xcc, 0xf5,
+	0x71, 0x22, 0xc6, 0xeb, 0x13, 0x37, 0x3f, 0x78, 0x4e, 0x0c, 0xd9, 0xc6, 0x09, 0x9f, 0xad, 0xed,
+	0xa3, 0xdb, 0x72, 0x2c, 0x05, 0x06, 0x99, 0x3e, 0xf9, 0xd7, 0x73, 0xe0, 0x5b, 0xe3, 0x27, 0x51,
+	0x11, 0x48, 0x27, 0x07, 0xfe, 0xca, 0xe5, 0x4f, 0x0a, 0xba, 0xd3, 0x14, 0xd7, 0x70, 0xc0, 0xc2,
+	0xa1, 0xcf, 0xbb, 0x68, 0x06, 0x17, 0x0f, 0xe9, 0x75, 0x75, 0x6c, 0x60, 0x33, 0xe9, 0xd9, 0xe3,
+	0x3d, 0x9d, 0x21, 0x52, 0xbb, 0x8f, 0x8a, 0x5b, 0x73, 0x63, 0x60, 0x0c, 0x87, 0xc0, 0xf4, 0x32,
+	0xbf, 0xc1, 0x79, 0x19, 0x3f, 0x90, 0x61, 0x6d, 0x15, 0xcd, 0x77, 0x92, 0x13, 0x8a, 0x53, 0x37,
+	0x66, 0xa1, 0x0b, 0x5d, 0xf0, 0x75, 0xb5, 0xae, 0x34, 0xa6, 0x9d, 0x59, 0x11, 0x3e, 0x60, 0xe1,
+	0x6e, 0x17, 0xfc, 0xad, 0xbb, 0x6f, 0xdf, 0xd7, 0x4a, 0xe3, 0x42, 0x3e, 0x2a, 0x68, 0x72, 0x3f,
+	0xbf, 0x40, 0x6d, 0x13, 0xdd, 0xe2, 0xa7, 0x08, 0x94, 0x13, 0x9f, 0xb1, 0xf5, 0x2f, 0x67, 0xeb,
+	0x55, 0xe9, 0x4f, 0x33, 0x08, 0x28, 0x30, 0x76, 0x98, 0xd1, 0x28, 0x09, 0x9d, 0xa2, 0x70, 0x88,
+	0x01, 0xbd, 0xfc, 0x6f, 0x98, 0x91, 0x15, 0xa9, 0xff, 0xbb, 0xa2, 0x95, 0xc7, 0x68, 0xaa, 0x85,
+	0x29, 0x8e, 0x99, 0x66, 0xa1, 0x6a, 0x8c, 0xbb, 0x6e, 0x4a, 0x3b, 0x09, 0x04, 0x6e, 0x0a, 0xd4,
+	0xf5, 0xda, 0xc4, 0x3f, 0xe6, 0x2a, 0x66, 0x9d, 0xc5, 0x18, 0x77, 0x5b, 0x3c, 0xd5, 0x02, 0x6a,
+	0xe7, 0x09, 0xbb, 0x79, 0x7e, 0x65, 0x28, 0x17, 0x57, 0x86, 0xf2, 0xfd, 0xca, 0x50, 0x4e, 0xfb,
+	0x46, 0xe9, 0xa2, 0x6f, 0x94, 0xbe, 0xf6, 0x8d, 0xd2, 0xcb, 0xb5, 0x3f, 0x1e, 0x5b, 0x77, 0xf0,
+	0xaf, 0xed, 0x4d, 0x71, 0xa6, 0x8f, 0x7e, 0x0c, 0x00, 0x5e, 0x00, 0x82, 0xcf, 0xe0, 0x05, 0x00,
+	0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -354,6 +422,15 @@ func (m *BasicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedFeeDenoms) > 0 {
+		for iNdEx := len(m.AllowedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedFeeDenoms[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Expiration != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
 		if err1 != nil {
@@ -478,6 +555,16 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnwrapMsgExec {
+		i--
+		if m.UnwrapMsgExec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AllowedMessages) > 0 {
 		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedMessages[iNdEx])
@@ -551,6 +638,34 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxPrunedPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeegrant(v)
 	base := offset
@@ -578,6 +693,12 @@ func (m *BasicAllowance) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	if len(m.AllowedFeeDenoms) > 0 {
+		for _, s := range m.AllowedFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -624,6 +745,9 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 			n += 1 + l + sovFeegrant(uint64(l))
 		}
 	}
+	if m.UnwrapMsgExec {
+		n += 2
+	}
 	return n
 }
 
@@ -648,6 +772,18 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxPrunedPerBlock))
+	}
+	return n
+}
+
 func sovFeegrant(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -753,6 +889,38 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFeeDenoms = append(m.AllowedFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
@@ -1088,6 +1256,26 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnwrapMsgExec", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnwrapMsgExec = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
@@ -1259,6 +1447,75 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
+			}
+			m.MaxPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFeegrant(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,11 +1,14 @@
 package feegrant
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // TODO: Revisit this once we have propoer gas fee framework.
@@ -50,6 +53,21 @@ func (a *AllowedMsgAllowance) GetAllowance() (FeeAllowanceI, error) {
 	return allowance, nil
 }
 
+// SetAllowance sets allowed fee allowance.
+func (a *AllowedMsgAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	a.Allowance = any
+
+	return nil
+}
+
 // Accept method checks for the filtered messages has valid expiry
 func (a *AllowedMsgAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
 	if !a.allMsgTypesAllowed(ctx, msgs) {
@@ -61,7 +79,16 @@ func (a *AllowedMsgAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.
 		return false, err
 	}
 
-	return allowance.Accept(ctx, fee, msgs)
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		// the wrapped allowance was updated in place, repack it so the new
+		// state is what gets stored
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+
+	return remove, err
 }
 
 func (a *AllowedMsgAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool {
@@ -75,10 +102,24 @@ func (a *AllowedMsgAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool
 }
 
 func (a *AllowedMsgAllowance) allMsgTypesAllowed(ctx sdk.Context, msgs []sdk.Msg) bool {
-	msgsMap := a.allowedMsgsToMap(ctx)
+	return a.msgTypesAllowed(ctx, a.allowedMsgsToMap(ctx), msgs)
+}
 
+// msgTypesAllowed checks the messages against the allowed messages. When
+// UnwrapMsgExec is set, the messages executed by a MsgExec are checked in place
+// of the MsgExec, down to the innermost MsgExec.
+func (a *AllowedMsgAllowance) msgTypesAllowed(ctx sdk.Context, msgsMap map[string]bool, msgs []sdk.Msg) bool {
 	for _, msg := range msgs {
 		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+
+		if exec, ok := msg.(*authz.MsgExec); ok && a.UnwrapMsgExec {
+			execMsgs, err := exec.GetMessages()
+			if err != nil || !a.msgTypesAllowed(ctx, msgsMap, execMsgs) {
+				return false
+			}
+			continue
+		}
+
 		if !msgsMap[sdk.MsgTypeURL(msg)] {
 			return false
 		}
@@ -103,3 +144,13 @@ func (a *AllowedMsgAllowance) ValidateBasic() error {
 
 	return allowance.ValidateBasic()
 }
+
+// ExpiresAt returns the expiry time of the wrapped allowance.
+func (a *AllowedMsgAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
+}
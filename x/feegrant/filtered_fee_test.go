@@ -0,0 +1,102 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestAllowedMsgAllowanceMsgExec(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(1000))
+	send := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+	vote := govtypes.NewMsgVote(addrs[0], 1, govtypes.OptionYes)
+	exec := func(msgs ...sdk.Msg) *authz.MsgExec {
+		msg := authz.NewMsgExec(addrs[1], msgs)
+		return &msg
+	}
+
+	cases := map[string]struct {
+		allowedMsgs   []sdk.Msg
+		unwrapMsgExec bool
+		msgs          []sdk.Msg
+		accept        bool
+	}{
+		"allowed message": {
+			allowedMsgs: []sdk.Msg{send},
+			msgs:        []sdk.Msg{send},
+			accept:      true,
+		},
+		"exec not allowed without unwrapping": {
+			allowedMsgs: []sdk.Msg{send},
+			msgs:        []sdk.Msg{exec(send)},
+			accept:      false,
+		},
+		"allowed exec without unwrapping": {
+			allowedMsgs: []sdk.Msg{&authz.MsgExec{}},
+			msgs:        []sdk.Msg{exec(vote)},
+			accept:      true,
+		},
+		"unwrapped exec of an allowed message": {
+			allowedMsgs:   []sdk.Msg{send},
+			unwrapMsgExec: true,
+			msgs:          []sdk.Msg{exec(send), send},
+			accept:        true,
+		},
+		"unwrapped exec of a message not allowed": {
+			allowedMsgs:   []sdk.Msg{send},
+			unwrapMsgExec: true,
+			msgs:          []sdk.Msg{exec(send, vote)},
+			accept:        false,
+		},
+		"unwrapped exec of a message not allowed with exec allowed": {
+			allowedMsgs:   []sdk.Msg{send, &authz.MsgExec{}},
+			unwrapMsgExec: true,
+			msgs:          []sdk.Msg{exec(vote)},
+			accept:        false,
+		},
+		"unwrapped nested exec of an allowed message": {
+			allowedMsgs:   []sdk.Msg{send},
+			unwrapMsgExec: true,
+			msgs:          []sdk.Msg{exec(exec(send))},
+			accept:        true,
+		},
+		"unwrapped nested exec of a message not allowed": {
+			allowedMsgs:   []sdk.Msg{send},
+			unwrapMsgExec: true,
+			msgs:          []sdk.Msg{exec(exec(send), exec(vote))},
+			accept:        false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allowedMsgs := make([]string, len(tc.allowedMsgs))
+			for i, msg := range tc.allowedMsgs {
+				allowedMsgs[i] = sdk.MsgTypeURL(msg)
+			}
+
+			allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, allowedMsgs)
+			require.NoError(t, err)
+			allowance.UnwrapMsgExec = tc.unwrapMsgExec
+
+			_, err = allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), tc.msgs)
+			if tc.accept {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+			}
+		})
+	}
+}
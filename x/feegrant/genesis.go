@@ -7,14 +7,19 @@ import (
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
 // NewGenesisState creates new GenesisState object
-func NewGenesisState(entries []Grant) *GenesisState {
+func NewGenesisState(params Params, entries []Grant) *GenesisState {
 	return &GenesisState{
 		Allowances: entries,
+		Params:     params,
 	}
 }
 
 // ValidateGenesis ensures all grants in the genesis state are valid
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, f := range data.Allowances {
 		grant, err := f.GetGrant()
 		if err != nil {
@@ -30,7 +35,9 @@ func ValidateGenesis(data GenesisState) error {
 
 // DefaultGenesisState returns default state for feegrant module.
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
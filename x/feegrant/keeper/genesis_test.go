@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -44,6 +45,7 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	allowance := &feegrant.BasicAllowance{SpendLimit: coins, Expiration: &oneYear}
 	err := suite.keeper.GrantAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
 	suite.Require().NoError(err)
+	suite.keeper.SetParams(suite.ctx, feegrant.NewParams(10))
 
 	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
@@ -59,6 +61,11 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	newGenesis, err := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
 	suite.Require().Equal(genesis, newGenesis)
+
+	// the expiration queue is rebuilt from the imported allowances
+	suite.keeper.RemoveExpiredAllowances(suite.ctx.WithBlockTime(oneYear.Add(time.Second)))
+	_, err = suite.keeper.GetAllowance(suite.ctx, granterAddr, granteeAddr)
+	suite.Require().Error(err)
 }
 
 func (suite *GenesisTestSuite) TestInitGenesis() {
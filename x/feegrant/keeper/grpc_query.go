@@ -93,3 +93,70 @@ func (q Keeper) Allowances(c context.Context, req *feegrant.QueryAllowancesReque
 
 	return &feegrant.QueryAllowancesResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowancesByGranter queries all the allowances granted by the given granter.
+func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowancesByGranterRequest) (*feegrant.QueryAllowancesByGranterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*feegrant.Grant
+
+	store := ctx.KVStore(q.storeKey)
+	granterStore := prefix.NewStore(store, feegrant.FeeAllowancePrefixByGranter(granterAddr))
+
+	pageRes, err := query.Paginate(granterStore, req.Pagination, func(key []byte, _ []byte) error {
+		grant, err := q.getGrant(ctx, granterAddr, feegrant.ParseGranteeFromGranterKey(key))
+		if err != nil {
+			return err
+		}
+
+		grants = append(grants, grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
+}
+
+// AllowanceStatus returns the status of the fee granted to the grantee by the
+// granter at the current block time.
+func (q Keeper) AllowanceStatus(c context.Context, req *feegrant.QueryAllowanceStatusRequest) (*feegrant.QueryAllowanceStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	feeAllowance, err := q.GetAllowance(ctx, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	allowanceStatus, err := feegrant.NewAllowanceStatus(feeAllowance, ctx.BlockTime())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowanceStatusResponse{Status: allowanceStatus}, nil
+}
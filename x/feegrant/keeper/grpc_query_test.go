@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
@@ -148,6 +151,215 @@ func (suite *KeeperTestSuite) TestFeeAllowances() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
+	testCases := []struct {
+		name      string
+		req       *feegrant.QueryAllowancesByGranterRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *feegrant.QueryAllowancesByGranterResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			func() {},
+			func(*feegrant.QueryAllowancesByGranterResponse) {},
+		},
+		{
+			"fail: invalid granter",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter: "invalid_granter",
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowancesByGranterResponse) {},
+		},
+		{
+			"no grants",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter: suite.addrs[0].String(),
+			},
+			false,
+			func() {},
+			func(resp *feegrant.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 0)
+			},
+		},
+		{
+			"valid query: expect single grant",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter: suite.addrs[0].String(),
+			},
+			false,
+			func() {
+				grantFeeAllowance(suite)
+			},
+			func(resp *feegrant.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 1)
+				suite.Require().Equal(resp.Allowances[0].Granter, suite.addrs[0].String())
+				suite.Require().Equal(resp.Allowances[0].Grantee, suite.addrs[1].String())
+			},
+		},
+		{
+			"valid query: paginated grants",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter:    suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			false,
+			func() {
+				for _, grantee := range suite.addrs[1:] {
+					err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], grantee, &feegrant.BasicAllowance{})
+					suite.Require().NoError(err)
+				}
+				// grants issued by other granters are not returned
+				err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[1], suite.addrs[2], &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			func(resp *feegrant.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 2)
+				suite.Require().Equal(uint64(len(suite.addrs)-1), resp.Pagination.Total)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+				for _, grant := range resp.Allowances {
+					suite.Require().Equal(grant.Granter, suite.addrs[0].String())
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.keeper.AllowancesByGranter(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeeAllowanceStatus() {
+	blockTime := suite.sdkCtx.BlockTime()
+	exp := blockTime.AddDate(1, 0, 0)
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	sendMsg := "/cosmos.bank.v1beta1.MsgSend"
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.QueryAllowanceStatusRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *feegrant.QueryAllowanceStatusResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			func() {},
+			func(*feegrant.QueryAllowanceStatusResponse) {},
+		},
+		{
+			"fail: invalid granter",
+			&feegrant.QueryAllowanceStatusRequest{
+				Granter: "invalid_granter",
+				Grantee: suite.addrs[0].String(),
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowanceStatusResponse) {},
+		},
+		{
+			"fail: invalid grantee",
+			&feegrant.QueryAllowanceStatusRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: "invalid_grantee",
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowanceStatusResponse) {},
+		},
+		{
+			"fail: no grant",
+			&feegrant.QueryAllowanceStatusRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[2].String(),
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowanceStatusResponse) {},
+		},
+		{
+			"valid query: basic allowance",
+			&feegrant.QueryAllowanceStatusRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {
+				grantFeeAllowance(suite)
+			},
+			func(resp *feegrant.QueryAllowanceStatusResponse) {
+				suite.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", resp.Status.AllowanceType)
+				suite.Require().Equal(atom, resp.Status.SpendLimit)
+				suite.Require().Equal(exp, *resp.Status.Expiration)
+				suite.Require().False(resp.Status.Expired)
+				suite.Require().Nil(resp.Status.PeriodReset)
+			},
+		},
+		{
+			"valid query: periodic allowance with allowed messages",
+			&feegrant.QueryAllowanceStatusRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[2].String(),
+			},
+			false,
+			func() {
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.PeriodicAllowance{
+					Basic:            feegrant.BasicAllowance{SpendLimit: atom},
+					Period:           time.Hour,
+					PeriodSpendLimit: smallAtom,
+					PeriodReset:      blockTime,
+				}, []string{sendMsg})
+				suite.Require().NoError(err)
+				err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2], allowance)
+				suite.Require().NoError(err)
+			},
+			func(resp *feegrant.QueryAllowanceStatusResponse) {
+				suite.Require().Equal("/cosmos.feegrant.v1beta1.AllowedMsgAllowance", resp.Status.AllowanceType)
+				suite.Require().Equal(atom, resp.Status.SpendLimit)
+				suite.Require().Equal(smallAtom, resp.Status.PeriodCanSpend)
+				suite.Require().Equal(blockTime.Add(time.Hour), *resp.Status.PeriodReset)
+				suite.Require().Equal([]string{sendMsg}, resp.Status.AllowedMessages)
+
+				// the stored allowance is not reset by the query
+				grant, err := suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2])
+				suite.Require().NoError(err)
+				periodic, err := grant.(*feegrant.AllowedMsgAllowance).GetAllowance()
+				suite.Require().NoError(err)
+				suite.Require().Empty(periodic.(*feegrant.PeriodicAllowance).PeriodCanSpend)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.keeper.AllowanceStatus(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
 func grantFeeAllowance(suite *KeeperTestSuite) {
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
 	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{
@@ -2,15 +2,18 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper manages state of all fee grants, as well as calculating approval.
@@ -18,16 +21,23 @@ import (
 type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
 	authKeeper feegrant.AccountKeeper
 }
 
 var _ middleware.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a fee grant Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, ak feegrant.AccountKeeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(feegrant.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
+		paramSpace: paramSpace,
 		authKeeper: ak,
 	}
 }
@@ -59,7 +69,27 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 		return err
 	}
 
+	exp, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	// an updated grant is moved to the position of its new expiration in the queue
+	if existing, err := k.getGrant(ctx, granter, grantee); err == nil {
+		oldExp, err := grantExpiration(existing)
+		if err != nil {
+			return err
+		}
+		if oldExp != nil {
+			store.Delete(feegrant.FeeAllowanceQueueKey(*oldExp, granter, grantee))
+		}
+	}
+
 	store.Set(key, bz)
+	store.Set(feegrant.FeeAllowanceByGranterKey(granter, grantee), []byte{})
+	if exp != nil {
+		store.Set(feegrant.FeeAllowanceQueueKey(*exp, granter, grantee), []byte{})
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -74,14 +104,17 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 
 // revokeAllowance removes an existing grant
 func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
-	_, err := k.getGrant(ctx, granter, grantee)
+	grant, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
 		return err
 	}
 
-	store := ctx.KVStore(k.storeKey)
-	key := feegrant.FeeAllowanceKey(granter, grantee)
-	store.Delete(key)
+	exp, err := grantExpiration(grant)
+	if err != nil {
+		return err
+	}
+
+	k.deleteGrant(ctx, granter, grantee, exp)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -93,6 +126,90 @@ func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	return nil
 }
 
+// RevokeAllAllowances revokes the allowances granted by the granter, at most
+// limit of them, and returns the number of revoked allowances along with the
+// number of allowances of the granter left to be revoked.
+func (k Keeper) RevokeAllAllowances(ctx sdk.Context, granter sdk.AccAddress, limit uint64) (revoked, remaining uint64, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), feegrant.FeeAllowancePrefixByGranter(granter))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var grantees []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(grantees)) < limit {
+			grantees = append(grantees, feegrant.ParseGranteeFromGranterKey(iter.Key()))
+		} else {
+			remaining++
+		}
+	}
+
+	if len(grantees) == 0 && remaining == 0 {
+		return 0, 0, sdkerrors.Wrap(sdkerrors.ErrNotFound, "fee-grant not found")
+	}
+
+	for _, grantee := range grantees {
+		if err := k.revokeAllowance(ctx, granter, grantee); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return uint64(len(grantees)), remaining, nil
+}
+
+// deleteGrant removes a grant along with its granter index and expiration
+// queue entries.
+func (k Keeper) deleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, exp *time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(feegrant.FeeAllowanceKey(granter, grantee))
+	store.Delete(feegrant.FeeAllowanceByGranterKey(granter, grantee))
+	if exp != nil {
+		store.Delete(feegrant.FeeAllowanceQueueKey(*exp, granter, grantee))
+	}
+}
+
+// grantExpiration returns the expiration time of the allowance of a grant.
+func grantExpiration(grant *feegrant.Grant) (*time.Time, error) {
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
+}
+
+// RemoveExpiredAllowances removes the allowances which expired before the
+// block time, oldest first and at most MaxPrunedPerBlock of them, emitting a
+// revoke event with the expired reason for each of them.
+func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context) {
+	limit := k.MaxPrunedPerBlock(ctx)
+	if limit == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(feegrant.FeeAllowanceQueueKeyPrefix, feegrant.FeeAllowanceQueueByTimeKey(ctx.BlockTime()))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid() && len(keys) < int(limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		exp, granter, grantee := feegrant.SplitFeeAllowanceQueueKey(key)
+		k.deleteGrant(ctx, granter, grantee, &exp)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				feegrant.EventTypeRevokeFeeGrant,
+				sdk.NewAttribute(feegrant.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee.String()),
+				sdk.NewAttribute(feegrant.AttributeKeyReason, feegrant.AttributeValueExpired),
+			),
+		)
+	}
+}
+
 // GetAllowance returns the allowance between the granter and grantee.
 // If there is none, it returns nil, nil.
 // Returns an error on parsing issues
@@ -192,6 +309,8 @@ func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
 func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error {
+	k.SetParams(ctx, data.Params)
+
 	for _, f := range data.Allowances {
 		granter, err := sdk.AccAddressFromBech32(f.Granter)
 		if err != nil {
@@ -226,5 +345,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 
 	return &feegrant.GenesisState{
 		Allowances: grants,
+		Params:     k.GetParams(ctx),
 	}, err
 }
@@ -3,14 +3,20 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type KeeperTestSuite struct {
@@ -235,6 +241,243 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 
 }
 
+func (suite *KeeperTestSuite) TestUseGrantedFeeMsgExec() {
+	blockTime := suite.sdkCtx.BlockTime()
+	oneDay := blockTime.AddDate(0, 0, 1)
+	send := banktypes.NewMsgSend(suite.addrs[1], suite.addrs[2], suite.atom)
+	vote := govtypes.NewMsgVote(suite.addrs[1], 1, govtypes.OptionYes)
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(suite.addrs[1], msgs)
+		return &msg
+	}
+
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{SpendLimit: suite.atom},
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		PeriodReset:      oneDay,
+	}
+	allowance, err := feegrant.NewAllowedMsgAllowance(periodic, []string{sdk.MsgTypeURL(send)})
+	suite.Require().NoError(err)
+	allowance.UnwrapMsgExec = true
+
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], allowance)
+	suite.Require().NoError(err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	// messages executed through authz are checked against the allowed messages
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{exec(send)})
+	suite.Require().NoError(err)
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{exec(exec(send))})
+	suite.Require().NoError(err)
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{exec(send, vote)})
+	suite.Require().ErrorIs(err, feegrant.ErrMessageNotAllowed)
+
+	// the wrapped periodic allowance was charged for the accepted fees only
+	loaded, err := suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	loadedAllowance, ok := loaded.(*feegrant.AllowedMsgAllowance)
+	suite.Require().True(ok)
+	suite.Require().True(loadedAllowance.UnwrapMsgExec)
+	inner, err := loadedAllowance.GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 80)), inner.(*feegrant.PeriodicAllowance).PeriodCanSpend)
+}
+
+func (suite *KeeperTestSuite) TestGranterIndex() {
+	exp := suite.sdkCtx.BlockTime().AddDate(0, 0, 1)
+	expiring := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &exp,
+	}
+
+	err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], expiring)
+	suite.Require().NoError(err)
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2], &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[3], &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[1], suite.addrs[2], &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+	suite.requireGranterIndex(map[int][]int{0: {1, 2, 3}, 1: {2}})
+
+	// updating a grant keeps a single index entry
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2], &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+	suite.requireGranterIndex(map[int][]int{0: {1, 2, 3}, 1: {2}})
+
+	// revoking a grant removes its index entry
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: suite.addrs[0].String(), Grantee: suite.addrs[3].String()})
+	suite.Require().NoError(err)
+	suite.requireGranterIndex(map[int][]int{0: {1, 2}, 1: {2}})
+
+	// an expired grant removed on use removes its index entry
+	ctx := suite.sdkCtx.WithBlockTime(exp.AddDate(0, 0, 1))
+	err = suite.keeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], suite.atom, []sdk.Msg{})
+	suite.Require().Error(err)
+	suite.requireGranterIndex(map[int][]int{0: {2}, 1: {2}})
+}
+
+// requireGranterIndex checks that the grants indexed by granter match the grants
+// stored by grantee, given as the grantees of each granter.
+func (suite *KeeperTestSuite) requireGranterIndex(expected map[int][]int) {
+	var stored []string
+	err := suite.keeper.IterateAllFeeAllowances(suite.sdkCtx, func(grant feegrant.Grant) bool {
+		stored = append(stored, grant.Granter+"/"+grant.Grantee)
+		return false
+	})
+	suite.Require().NoError(err)
+
+	var indexed, wanted []string
+	for i, granter := range suite.addrs {
+		resp, err := suite.keeper.AllowancesByGranter(suite.ctx, &feegrant.QueryAllowancesByGranterRequest{Granter: granter.String()})
+		suite.Require().NoError(err)
+		for _, grant := range resp.Allowances {
+			indexed = append(indexed, grant.Granter+"/"+grant.Grantee)
+		}
+		for _, j := range expected[i] {
+			wanted = append(wanted, granter.String()+"/"+suite.addrs[j].String())
+		}
+	}
+
+	suite.Require().ElementsMatch(wanted, stored)
+	suite.Require().ElementsMatch(wanted, indexed)
+}
+
+func (suite *KeeperTestSuite) TestRemoveExpiredAllowances() {
+	exp := suite.sdkCtx.BlockTime().AddDate(0, 0, 1)
+	later := exp.AddDate(0, 0, 1)
+	granter := suite.addrs[0]
+	suite.keeper.SetParams(suite.sdkCtx, feegrant.NewParams(3))
+
+	// 8 allowances expiring at once, one expiring later and one never expiring
+	var expiring []sdk.AccAddress
+	for i := 0; i < 8; i++ {
+		_, _, grantee := testdata.KeyTestPubAddr()
+		err := suite.keeper.GrantAllowance(suite.sdkCtx, granter, grantee, &feegrant.BasicAllowance{Expiration: &exp})
+		suite.Require().NoError(err)
+		expiring = append(expiring, grantee)
+	}
+	err := suite.keeper.GrantAllowance(suite.sdkCtx, granter, suite.addrs[1], &feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{Expiration: &later},
+		Period:           time.Hour,
+		PeriodSpendLimit: suite.atom,
+	})
+	suite.Require().NoError(err)
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, granter, suite.addrs[2], &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+
+	countGrants := func(ctx sdk.Context) int {
+		resp, err := suite.keeper.AllowancesByGranter(sdk.WrapSDKContext(ctx), &feegrant.QueryAllowancesByGranterRequest{Granter: granter.String()})
+		suite.Require().NoError(err)
+		return len(resp.Allowances)
+	}
+
+	// allowances are only removed once they expired before the block time
+	ctx := suite.sdkCtx.WithBlockTime(exp)
+	suite.keeper.RemoveExpiredAllowances(ctx)
+	suite.Require().Equal(10, countGrants(ctx))
+
+	// at most MaxPrunedPerBlock allowances are removed per block
+	ctx = suite.sdkCtx.WithBlockTime(exp.Add(time.Second))
+	for _, expected := range []int{7, 4, 2, 2} {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		removed := countGrants(ctx) - expected
+		suite.keeper.RemoveExpiredAllowances(ctx)
+		suite.Require().Equal(expected, countGrants(ctx))
+
+		events := ctx.EventManager().Events()
+		suite.Require().Len(events, removed)
+		for _, event := range events {
+			suite.Require().Equal(feegrant.EventTypeRevokeFeeGrant, event.Type)
+			suite.Require().Contains(event.Attributes, abci.EventAttribute{
+				Key: feegrant.AttributeKeyReason, Value: feegrant.AttributeValueExpired,
+			})
+		}
+	}
+	for _, grantee := range expiring {
+		_, err := suite.keeper.GetAllowance(ctx, granter, grantee)
+		suite.Require().Error(err)
+	}
+
+	ctx = suite.sdkCtx.WithBlockTime(later.Add(time.Second))
+	suite.keeper.RemoveExpiredAllowances(ctx)
+	suite.requireGranterIndex(map[int][]int{0: {2}})
+
+	// no allowance is removed when MaxPrunedPerBlock is zero
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, granter, suite.addrs[1], &feegrant.BasicAllowance{Expiration: &exp})
+	suite.Require().NoError(err)
+	suite.keeper.SetParams(ctx, feegrant.NewParams(0))
+	suite.keeper.RemoveExpiredAllowances(ctx)
+	suite.requireGranterIndex(map[int][]int{0: {1, 2}})
+}
+
+func (suite *KeeperTestSuite) TestUpdateAllowanceExpiration() {
+	exp := suite.sdkCtx.BlockTime().AddDate(0, 0, 1)
+	later := exp.AddDate(0, 0, 1)
+	afterExp := suite.sdkCtx.WithBlockTime(exp.Add(time.Second))
+	afterLater := suite.sdkCtx.WithBlockTime(later.Add(time.Second))
+
+	err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{Expiration: &exp})
+	suite.Require().NoError(err)
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2], &feegrant.BasicAllowance{Expiration: &exp})
+	suite.Require().NoError(err)
+
+	// extending the expiration moves the allowance in the queue
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{Expiration: &later})
+	suite.Require().NoError(err)
+	// removing the expiration removes the allowance from the queue
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2], &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+
+	suite.keeper.RemoveExpiredAllowances(afterExp)
+	suite.requireGranterIndex(map[int][]int{0: {1, 2}})
+
+	suite.keeper.RemoveExpiredAllowances(afterLater)
+	suite.requireGranterIndex(map[int][]int{0: {2}})
+
+	// a revoked allowance is removed from the queue
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{Expiration: &exp})
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: suite.addrs[0].String(), Grantee: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+
+	suite.keeper.RemoveExpiredAllowances(afterLater)
+	suite.requireGranterIndex(map[int][]int{0: {1, 2}})
+}
+
+func (suite *KeeperTestSuite) TestRevokeAllAllowancesLimit() {
+	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
+	granter := suite.addrs[0]
+	for _, grantee := range suite.addrs[1:] {
+		err := suite.keeper.GrantAllowance(suite.sdkCtx, granter, grantee, &feegrant.BasicAllowance{Expiration: &exp})
+		suite.Require().NoError(err)
+	}
+
+	// exactly at the limit, nothing remains
+	cacheCtx, _ := suite.sdkCtx.CacheContext()
+	revoked, remaining, err := suite.keeper.RevokeAllAllowances(cacheCtx, granter, 3)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), revoked)
+	suite.Require().Equal(uint64(0), remaining)
+
+	revoked, remaining, err = suite.keeper.RevokeAllAllowances(suite.sdkCtx, granter, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), revoked)
+	suite.Require().Equal(uint64(1), remaining)
+	suite.requireGranterIndex(map[int][]int{0: {3}})
+
+	// the expiration queue entries of the revoked allowances are removed
+	ctx := suite.sdkCtx.WithBlockTime(exp.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	suite.keeper.RemoveExpiredAllowances(ctx)
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+	suite.requireGranterIndex(map[int][]int{})
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
@@ -76,3 +76,21 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// RevokeAllAllowances revokes the fee allowances of a granter, at most
+// MaxRevokedPerMsg of them.
+func (k msgServer) RevokeAllAllowances(goCtx context.Context, msg *feegrant.MsgRevokeAllAllowances) (*feegrant.MsgRevokeAllAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, remaining, err := k.Keeper.RevokeAllAllowances(ctx, granter, feegrant.MaxRevokedPerMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeAllAllowancesResponse{Revoked: revoked, Remaining: remaining}, nil
+}
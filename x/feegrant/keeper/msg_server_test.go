@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
@@ -221,3 +223,86 @@ func (suite *KeeperTestSuite) TestRevokeAllowance() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestRevokeAllAllowances() {
+	granter := suite.addrs[0]
+	grantCount := feegrant.MaxRevokedPerMsg + 5
+	for i := 0; i < grantCount; i++ {
+		_, _, grantee := testdata.KeyTestPubAddr()
+		err := suite.keeper.GrantAllowance(suite.sdkCtx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: suite.atom})
+		suite.Require().NoError(err)
+	}
+	// allowances of other granters are not revoked
+	err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[1], suite.addrs[2], &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		request   *feegrant.MsgRevokeAllAllowances
+		expectErr bool
+		errMsg    string
+		revoked   uint64
+		remaining uint64
+	}{
+		{
+			"error: invalid granter",
+			&feegrant.MsgRevokeAllAllowances{Granter: "invalid-granter"},
+			true,
+			"decoding bech32 failed",
+			0, 0,
+		},
+		{
+			"error: fee allowance not found",
+			&feegrant.MsgRevokeAllAllowances{Granter: suite.addrs[3].String()},
+			true,
+			"fee-grant not found",
+			0, 0,
+		},
+		{
+			"success: revoke up to the cap",
+			&feegrant.MsgRevokeAllAllowances{Granter: granter.String()},
+			false,
+			"",
+			feegrant.MaxRevokedPerMsg, 5,
+		},
+		{
+			"success: revoke the remaining allowances",
+			&feegrant.MsgRevokeAllAllowances{Granter: granter.String()},
+			false,
+			"",
+			5, 0,
+		},
+		{
+			"error: all allowances revoked",
+			&feegrant.MsgRevokeAllAllowances{Granter: granter.String()},
+			true,
+			"fee-grant not found",
+			0, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.sdkCtx.WithEventManager(sdk.NewEventManager())
+			resp, err := suite.msgSrvr.RevokeAllAllowances(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.revoked, resp.Revoked)
+			suite.Require().Equal(tc.remaining, resp.Remaining)
+
+			events := ctx.EventManager().Events()
+			suite.Require().Len(events, int(tc.revoked))
+			for _, event := range events {
+				suite.Require().Equal(feegrant.EventTypeRevokeFeeGrant, event.Type)
+			}
+		})
+	}
+
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[1], suite.addrs[2])
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// MaxPrunedPerBlock - maximum number of expired allowances removed per block
+func (k Keeper) MaxPrunedPerBlock(ctx sdk.Context) (res uint32) {
+	k.paramSpace.Get(ctx, feegrant.KeyMaxPrunedPerBlock, &res)
+	return
+}
+
+// GetParams returns the total set of feegrant parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params feegrant.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the feegrant parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params feegrant.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
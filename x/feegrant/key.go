@@ -1,8 +1,11 @@
 package feegrant
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
 const (
@@ -22,8 +25,16 @@ const (
 var (
 	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
 	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// FeeAllowanceByGranterKeyPrefix is the set of the kvstore for the granter index of the fee allowances
+	FeeAllowanceByGranterKeyPrefix = []byte{0x01}
+
+	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for the expiration queue of the fee allowances
+	FeeAllowanceQueueKeyPrefix = []byte{0x02}
 )
 
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
 // We store by grantee first to allow searching by everyone who granted to you
 func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
@@ -34,3 +45,62 @@ func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
 func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
 }
+
+// FeeAllowanceByGranterKey is the key of the granter index entry of a grant from
+// granter to grantee. The grant itself is stored under FeeAllowanceKey.
+func FeeAllowanceByGranterKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGranter(granter), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// FeeAllowancePrefixByGranter returns a prefix to scan for all grants issued by this given address.
+func FeeAllowancePrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(FeeAllowanceByGranterKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
+
+// ParseGranteeFromGranterKey returns the grantee address of a granter index key
+// stripped of its FeeAllowancePrefixByGranter prefix.
+func ParseGranteeFromGranterKey(key []byte) sdk.AccAddress {
+	// key is of format:
+	// <granteeAddressLen (1 Byte)><granteeAddress_Bytes>
+	kv.AssertKeyAtLeastLength(key, 1)
+	granteeAddrLen := int(key[0])
+	kv.AssertKeyLength(key, 1+granteeAddrLen)
+
+	return sdk.AccAddress(key[1 : 1+granteeAddrLen])
+}
+
+// FeeAllowanceQueueByTimeKey returns the prefix of the expiration queue entries
+// of the grants expiring at exp.
+func FeeAllowanceQueueByTimeKey(exp time.Time) []byte {
+	return append(FeeAllowanceQueueKeyPrefix, sdk.FormatTimeBytes(exp)...)
+}
+
+// FeeAllowanceQueueKey is the key of the expiration queue entry of a grant from
+// granter to grantee expiring at exp.
+func FeeAllowanceQueueKey(exp time.Time, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceQueueByTimeKey(exp), FeeAllowanceKey(granter, grantee)[1:]...)
+}
+
+// SplitFeeAllowanceQueueKey splits an expiration queue key and returns the
+// expiration time, the granter and the grantee of the grant.
+func SplitFeeAllowanceQueueKey(key []byte) (exp time.Time, granter, grantee sdk.AccAddress) {
+	// key is of format:
+	// <prefix (1 Byte)><exp_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes>
+	kv.AssertKeyAtLeastLength(key, 1+lenTime+1)
+	exp, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	key = key[1+lenTime:]
+	granteeAddrLen := int(key[0])
+	kv.AssertKeyAtLeastLength(key, 1+granteeAddrLen+1)
+	grantee = sdk.AccAddress(key[1 : 1+granteeAddrLen])
+
+	key = key[1+granteeAddrLen:]
+	granterAddrLen := int(key[0])
+	kv.AssertKeyLength(key, 1+granterAddrLen)
+	granter = sdk.AccAddress(key[1:])
+
+	return exp, granter, grantee
+}
@@ -0,0 +1,62 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43/v0.45 to v0.46.
+// The migration includes:
+//
+// - Setting the MaxPrunedPerBlock param in the paramstore.
+// - Adding the granter index entry of every existing grant.
+// - Adding the grants with an expiration to the expiration queue.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramstore paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(feegrant.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, feegrant.KeyMaxPrunedPerBlock, feegrant.DefaultMaxPrunedPerBlock)
+
+	store := ctx.KVStore(storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.FeeAllowanceKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant feegrant.Grant
+		if err := cdc.Unmarshal(iter.Value(), &grant); err != nil {
+			return err
+		}
+
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			return err
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			return err
+		}
+
+		store.Set(feegrant.FeeAllowanceByGranterKey(granter, grantee), []byte{})
+
+		allowance, err := grant.GetGrant()
+		if err != nil {
+			return err
+		}
+
+		exp, err := allowance.ExpiresAt()
+		if err != nil {
+			return err
+		}
+
+		if exp != nil {
+			store.Set(feegrant.FeeAllowanceQueueKey(*exp, granter, grantee), []byte{})
+		}
+	}
+
+	return nil
+}
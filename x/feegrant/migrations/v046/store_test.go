@@ -0,0 +1,75 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	v046 "github.com/cosmos/cosmos-sdk/x/feegrant/migrations/v046"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	feegrantKey := sdk.NewKVStoreKey(feegrant.StoreKey)
+	tFeegrantKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(feegrantKey, tFeegrantKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, feegrantKey, tFeegrantKey, feegrant.ModuleName)
+
+	_, _, granter1 := testdata.KeyTestPubAddr()
+	_, _, granter2 := testdata.KeyTestPubAddr()
+	_, _, grantee1 := testdata.KeyTestPubAddr()
+	_, _, grantee2 := testdata.KeyTestPubAddr()
+
+	exp := time.Unix(1000, 0).UTC()
+	expiring, err := feegrant.NewAllowedMsgAllowance(&feegrant.PeriodicAllowance{
+		Basic: feegrant.BasicAllowance{Expiration: &exp},
+	}, []string{"/cosmos.gov.v1beta1.MsgVote"})
+	require.NoError(t, err)
+
+	// v0.45 grants are only stored by grantee
+	grants := []struct {
+		granter, grantee sdk.AccAddress
+		allowance        feegrant.FeeAllowanceI
+	}{
+		{granter1, grantee1, &feegrant.BasicAllowance{}},
+		{granter1, grantee2, expiring},
+		{granter2, grantee1, &feegrant.BasicAllowance{}},
+	}
+	store := ctx.KVStore(feegrantKey)
+	for _, g := range grants {
+		grant, err := feegrant.NewGrant(g.granter, g.grantee, g.allowance)
+		require.NoError(t, err)
+		store.Set(feegrant.FeeAllowanceKey(g.granter, g.grantee), encCfg.Codec.MustMarshal(&grant))
+	}
+
+	require.False(t, paramstore.Has(ctx, feegrant.KeyMaxPrunedPerBlock))
+
+	require.NoError(t, v046.MigrateStore(ctx, feegrantKey, paramstore, encCfg.Codec))
+
+	var maxPrunedPerBlock uint32
+	paramstore.Get(ctx, feegrant.KeyMaxPrunedPerBlock, &maxPrunedPerBlock)
+	require.Equal(t, feegrant.DefaultMaxPrunedPerBlock, maxPrunedPerBlock)
+
+	for _, g := range grants {
+		require.True(t, store.Has(feegrant.FeeAllowanceByGranterKey(g.granter, g.grantee)))
+	}
+	require.False(t, store.Has(feegrant.FeeAllowanceByGranterKey(granter2, grantee2)))
+
+	// only the wrapped allowance with an expiration is queued
+	iter := sdk.KVStorePrefixIterator(store, feegrant.FeeAllowanceQueueKeyPrefix)
+	defer iter.Close()
+	require.True(t, iter.Valid())
+	queuedExp, granter, grantee := feegrant.SplitFeeAllowanceQueueKey(iter.Key())
+	require.Equal(t, exp, queuedExp)
+	require.Equal(t, granter1, granter)
+	require.Equal(t, grantee2, grantee)
+	iter.Next()
+	require.False(t, iter.Valid())
+}
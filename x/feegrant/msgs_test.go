@@ -132,3 +132,33 @@ func TestMsgRevokeAllowance(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgRevokeAllAllowances(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+
+	cases := map[string]struct {
+		granter sdk.AccAddress
+		valid   bool
+	}{
+		"valid": {
+			granter: addr,
+			valid:   true,
+		},
+		"no granter": {
+			granter: sdk.AccAddress{},
+			valid:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg := feegrant.NewMsgRevokeAllAllowances(tc.granter)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err)
+			addrSlice := msg.GetSigners()
+			require.True(t, tc.granter.Equals(addrSlice[0]))
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package feegrant
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultMaxPrunedPerBlock = uint32(100)
+)
+
+// Parameter store keys
+var (
+	KeyMaxPrunedPerBlock = []byte("MaxPrunedPerBlock")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for feegrant module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(maxPrunedPerBlock uint32) Params {
+	return Params{
+		MaxPrunedPerBlock: maxPrunedPerBlock,
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return NewParams(DefaultMaxPrunedPerBlock)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxPrunedPerBlock, &p.MaxPrunedPerBlock, validateMaxPrunedPerBlock),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateMaxPrunedPerBlock(p.MaxPrunedPerBlock)
+}
+
+func validateMaxPrunedPerBlock(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -26,6 +26,10 @@ func (a *PeriodicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg)
 		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "absolute limit")
 	}
 
+	if err := a.Basic.checkFeeDenoms(fee); err != nil {
+		return false, err
+	}
+
 	a.tryResetPeriod(blockTime)
 
 	// deduct from both the current period and the max amount
@@ -105,3 +109,8 @@ func (a PeriodicAllowance) ValidateBasic() error {
 
 	return nil
 }
+
+// ExpiresAt returns the expiry time of the PeriodicAllowance.
+func (a PeriodicAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Basic.ExpiresAt()
+}
@@ -163,6 +163,51 @@ func TestPeriodicFeeValidAllow(t *testing.T) {
 			accept:    false,
 			remove:    true,
 		},
+		"allowed fee denom": {
+			allow: feegrant.PeriodicAllowance{
+				Basic: feegrant.BasicAllowance{
+					SpendLimit:       atom,
+					AllowedFeeDenoms: []string{"atom"},
+				},
+				Period:           tenMinutes,
+				PeriodReset:      now.Add(1 * time.Hour),
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   smallAtom,
+			},
+			valid:         true,
+			fee:           smallAtom,
+			blockTime:     now,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: nil,
+			remains:       leftAtom,
+			periodReset:   now.Add(1 * time.Hour),
+		},
+		"mixed denom fee with a denom not allowed": {
+			allow: feegrant.PeriodicAllowance{
+				Basic: feegrant.BasicAllowance{
+					AllowedFeeDenoms: []string{"atom"},
+				},
+				Period:           tenMinutes,
+				PeriodReset:      now.Add(1 * time.Hour),
+				PeriodSpendLimit: atom.Add(eth...),
+				PeriodCanSpend:   atom.Add(eth...),
+			},
+			valid:     true,
+			fee:       oneAtom.Add(eth...),
+			blockTime: now,
+			accept:    false,
+		},
+		"invalid allowed fee denom": {
+			allow: feegrant.PeriodicAllowance{
+				Basic: feegrant.BasicAllowance{
+					AllowedFeeDenoms: []string{"1nvalid"},
+				},
+				Period:           tenMinutes,
+				PeriodSpendLimit: atom,
+			},
+			valid: false,
+		},
 		"over period limit": {
 			allow: feegrant.PeriodicAllowance{
 				Basic: feegrant.BasicAllowance{
@@ -10,6 +10,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
+// Simulation parameter constants
+const (
+	MaxPrunedPerBlock = "max_pruned_per_block"
+)
+
+// GenMaxPrunedPerBlock randomized MaxPrunedPerBlock
+func GenMaxPrunedPerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(200))
+}
+
 // genFeeGrants returns a slice of randomly generated allowances.
 func genFeeGrants(r *rand.Rand, accounts []simtypes.Account) []feegrant.Grant {
 	allowances := make([]feegrant.Grant, len(accounts)-1)
@@ -67,7 +77,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { feegrants = genFeeGrants(r, simState.Accounts) },
 	)
 
-	feegrantGenesis := feegrant.NewGenesisState(feegrants)
+	var maxPrunedPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxPrunedPerBlock, &maxPrunedPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxPrunedPerBlock = GenMaxPrunedPerBlock(r) },
+	)
+
+	feegrantGenesis := feegrant.NewGenesisState(feegrant.NewParams(maxPrunedPerBlock), feegrants)
 	bz, err := simState.Cdc.MarshalJSON(feegrantGenesis)
 	if err != nil {
 		panic(err)
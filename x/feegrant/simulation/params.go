@@ -0,0 +1,28 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+const (
+	keyMaxPrunedPerBlock = "MaxPrunedPerBlock"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(feegrant.ModuleName, keyMaxPrunedPerBlock,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxPrunedPerBlock(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,92 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAllowanceStatus returns the status of the allowance at the given block
+// time. The period of a PeriodicAllowance is reset the same way Accept does,
+// on a copy, so the allowance itself is never modified.
+func NewAllowanceStatus(allowance FeeAllowanceI, blockTime time.Time) (AllowanceStatus, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return AllowanceStatus{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot proto marshal %T", allowance)
+	}
+
+	status, err := allowanceStatus(allowance, blockTime)
+	if err != nil {
+		return AllowanceStatus{}, err
+	}
+	status.AllowanceType = "/" + proto.MessageName(msg)
+
+	return status, nil
+}
+
+func allowanceStatus(allowance FeeAllowanceI, blockTime time.Time) (AllowanceStatus, error) {
+	switch a := allowance.(type) {
+	case *BasicAllowance:
+		return AllowanceStatus{
+			SpendLimit:       a.SpendLimit,
+			Expiration:       a.Expiration,
+			Expired:          a.Expiration != nil && a.Expiration.Before(blockTime),
+			AllowedFeeDenoms: a.AllowedFeeDenoms,
+		}, nil
+
+	case *PeriodicAllowance:
+		periodic := *a
+		periodic.tryResetPeriod(blockTime)
+		periodReset := periodic.PeriodReset
+
+		return AllowanceStatus{
+			SpendLimit:       periodic.Basic.SpendLimit,
+			Expiration:       periodic.Basic.Expiration,
+			Expired:          periodic.Basic.Expiration != nil && periodic.Basic.Expiration.Before(blockTime),
+			PeriodSpendLimit: periodic.PeriodSpendLimit,
+			PeriodCanSpend:   periodic.PeriodCanSpend,
+			PeriodReset:      &periodReset,
+			AllowedFeeDenoms: periodic.Basic.AllowedFeeDenoms,
+		}, nil
+
+	case *AllowedMsgAllowance:
+		wrapped, err := a.GetAllowance()
+		if err != nil {
+			return AllowanceStatus{}, err
+		}
+
+		status, err := allowanceStatus(wrapped, blockTime)
+		if err != nil {
+			return AllowanceStatus{}, err
+		}
+		if _, nested := wrapped.(*AllowedMsgAllowance); nested {
+			status.AllowedMessages = intersectAllowedMessages(a.AllowedMessages, status.AllowedMessages)
+		} else {
+			status.AllowedMessages = a.AllowedMessages
+		}
+
+		return status, nil
+
+	default:
+		return AllowanceStatus{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unsupported allowance type %T", allowance)
+	}
+}
+
+// intersectAllowedMessages returns the messages of outer which are also in inner.
+func intersectAllowedMessages(outer, inner []string) []string {
+	innerMap := make(map[string]bool, len(inner))
+	for _, msg := range inner {
+		innerMap[msg] = true
+	}
+
+	allowed := []string{}
+	for _, msg := range outer {
+		if innerMap[msg] {
+			allowed = append(allowed, msg)
+		}
+	}
+
+	return allowed
+}
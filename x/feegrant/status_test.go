@@ -0,0 +1,150 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestNewAllowanceStatus(t *testing.T) {
+	now := time.Now().UTC()
+	oneHour := now.Add(time.Hour)
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	sendMsg := "/cosmos.bank.v1beta1.MsgSend"
+	voteMsg := "/cosmos.gov.v1beta1.MsgVote"
+
+	periodic := func() *feegrant.PeriodicAllowance {
+		return &feegrant.PeriodicAllowance{
+			Basic:            feegrant.BasicAllowance{SpendLimit: atom, Expiration: &oneHour},
+			Period:           10 * time.Minute,
+			PeriodSpendLimit: smallAtom,
+			PeriodCanSpend:   leftAtom,
+			PeriodReset:      now.Add(5 * time.Minute),
+		}
+	}
+	allowedMsg := func(allowance feegrant.FeeAllowanceI, msgs ...string) *feegrant.AllowedMsgAllowance {
+		allowed, err := feegrant.NewAllowedMsgAllowance(allowance, msgs)
+		require.NoError(t, err)
+		return allowed
+	}
+	periodReset := func(t time.Time) *time.Time { return &t }
+
+	cases := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		blockTime time.Time
+		expected  feegrant.AllowanceStatus
+	}{
+		"basic": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom, Expiration: &oneHour, AllowedFeeDenoms: []string{"atom"}},
+			blockTime: now,
+			expected: feegrant.AllowanceStatus{
+				AllowanceType:    "/cosmos.feegrant.v1beta1.BasicAllowance",
+				SpendLimit:       atom,
+				Expiration:       &oneHour,
+				AllowedFeeDenoms: []string{"atom"},
+			},
+		},
+		"basic without limits": {
+			allowance: &feegrant.BasicAllowance{},
+			blockTime: now,
+			expected: feegrant.AllowanceStatus{
+				AllowanceType: "/cosmos.feegrant.v1beta1.BasicAllowance",
+			},
+		},
+		"basic expired": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom, Expiration: &oneHour},
+			blockTime: oneHour.Add(time.Second),
+			expected: feegrant.AllowanceStatus{
+				AllowanceType: "/cosmos.feegrant.v1beta1.BasicAllowance",
+				SpendLimit:    atom,
+				Expiration:    &oneHour,
+				Expired:       true,
+			},
+		},
+		"periodic in the same period": {
+			allowance: periodic(),
+			blockTime: now,
+			expected: feegrant.AllowanceStatus{
+				AllowanceType:    "/cosmos.feegrant.v1beta1.PeriodicAllowance",
+				SpendLimit:       atom,
+				Expiration:       &oneHour,
+				PeriodSpendLimit: smallAtom,
+				PeriodCanSpend:   leftAtom,
+				PeriodReset:      periodReset(now.Add(5 * time.Minute)),
+			},
+		},
+		"periodic after the period reset": {
+			allowance: periodic(),
+			blockTime: now.Add(7 * time.Minute),
+			expected: feegrant.AllowanceStatus{
+				AllowanceType:    "/cosmos.feegrant.v1beta1.PeriodicAllowance",
+				SpendLimit:       atom,
+				Expiration:       &oneHour,
+				PeriodSpendLimit: smallAtom,
+				PeriodCanSpend:   smallAtom,
+				PeriodReset:      periodReset(now.Add(15 * time.Minute)),
+			},
+		},
+		"allowed messages wrapping a periodic allowance": {
+			allowance: allowedMsg(periodic(), sendMsg, voteMsg),
+			blockTime: now.Add(7 * time.Minute),
+			expected: feegrant.AllowanceStatus{
+				AllowanceType:    "/cosmos.feegrant.v1beta1.AllowedMsgAllowance",
+				SpendLimit:       atom,
+				Expiration:       &oneHour,
+				PeriodSpendLimit: smallAtom,
+				PeriodCanSpend:   smallAtom,
+				PeriodReset:      periodReset(now.Add(15 * time.Minute)),
+				AllowedMessages:  []string{sendMsg, voteMsg},
+			},
+		},
+		"nested allowed messages": {
+			allowance: allowedMsg(allowedMsg(&feegrant.BasicAllowance{SpendLimit: atom}, voteMsg), sendMsg, voteMsg),
+			blockTime: now,
+			expected: feegrant.AllowanceStatus{
+				AllowanceType:   "/cosmos.feegrant.v1beta1.AllowedMsgAllowance",
+				SpendLimit:      atom,
+				AllowedMessages: []string{voteMsg},
+			},
+		},
+		"nested allowed messages without common messages": {
+			allowance: allowedMsg(allowedMsg(&feegrant.BasicAllowance{}, voteMsg), sendMsg),
+			blockTime: now,
+			expected: feegrant.AllowanceStatus{
+				AllowanceType:   "/cosmos.feegrant.v1beta1.AllowedMsgAllowance",
+				AllowedMessages: []string{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			status, err := feegrant.NewAllowanceStatus(tc.allowance, tc.blockTime)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, status)
+		})
+	}
+}
+
+func TestNewAllowanceStatusDoesNotModifyAllowance(t *testing.T) {
+	now := time.Now().UTC()
+	allowance := &feegrant.PeriodicAllowance{
+		Period:           10 * time.Minute,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 43)),
+		PeriodReset:      now,
+	}
+	expected := *allowance
+
+	status, err := feegrant.NewAllowanceStatus(allowance, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, allowance.PeriodSpendLimit, status.PeriodCanSpend)
+	require.Equal(t, now.Add(10*time.Minute), *status.PeriodReset)
+	require.Equal(t, expected, *allowance)
+}
@@ -8,7 +8,6 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -224,40 +223,148 @@ func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
 
+// MsgRevokeAllAllowances removes the existing Allowances from Granter.
+type MsgRevokeAllAllowances struct {
+	// granter is the address of the user whose allowances are revoked.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *MsgRevokeAllAllowances) Reset()         { *m = MsgRevokeAllAllowances{} }
+func (m *MsgRevokeAllAllowances) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllAllowances) ProtoMessage()    {}
+func (*MsgRevokeAllAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{4}
+}
+func (m *MsgRevokeAllAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllAllowances.Merge(m, src)
+}
+func (m *MsgRevokeAllAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllAllowances proto.InternalMessageInfo
+
+func (m *MsgRevokeAllAllowances) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+// MsgRevokeAllAllowancesResponse defines the Msg/RevokeAllAllowancesResponse response type.
+type MsgRevokeAllAllowancesResponse struct {
+	// revoked is the number of allowances revoked by the message.
+	Revoked uint64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// remaining is the number of allowances of the granter left to be revoked
+	// by another message.
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *MsgRevokeAllAllowancesResponse) Reset()         { *m = MsgRevokeAllAllowancesResponse{} }
+func (m *MsgRevokeAllAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllAllowancesResponse) ProtoMessage()    {}
+func (*MsgRevokeAllAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{5}
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllAllowancesResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllAllowancesResponse proto.InternalMessageInfo
+
+func (m *MsgRevokeAllAllowancesResponse) GetRevoked() uint64 {
+	if m != nil {
+		return m.Revoked
+	}
+	return 0
+}
+
+func (m *MsgRevokeAllAllowancesResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
 	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgRevokeAllAllowances)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllAllowances")
+	proto.RegisterType((*MsgRevokeAllAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllAllowancesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x60, 0x8e,
-	0xd2, 0x21, 0x46, 0x2e, 0x41, 0xdf, 0xe2, 0x74, 0x77, 0x90, 0x05, 0x8e, 0x39, 0x39, 0xf9, 0xe5,
-	0x89, 0x79, 0xc9, 0xa9, 0x42, 0x46, 0x5c, 0xec, 0x60, 0x2b, 0x53, 0x8b, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x6a, 0x74, 0x4c, 0x49, 0x29, 0x4a, 0x2d,
-	0x2e, 0x0e, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x82, 0x29, 0x44, 0xe8, 0x49, 0x95, 0x60, 0x22,
-	0x4e, 0x4f, 0xaa, 0x90, 0x2b, 0x17, 0x67, 0x22, 0xcc, 0x52, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x6e,
-	0x23, 0x11, 0x3d, 0x88, 0x3f, 0xf4, 0x60, 0xfe, 0xd0, 0x73, 0xcc, 0xab, 0x74, 0x12, 0x3c, 0xb5,
-	0x45, 0x97, 0xd7, 0x2d, 0x35, 0x15, 0xee, 0x44, 0xcf, 0x20, 0x84, 0x4e, 0x25, 0x69, 0x2e, 0x49,
-	0x0c, 0x3f, 0x04, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x2a, 0xd5, 0x70, 0x09, 0xf9, 0x16,
-	0xa7, 0x07, 0xa5, 0x96, 0xe5, 0x67, 0xa7, 0xd2, 0xdd, 0x87, 0x4a, 0x32, 0x5c, 0x52, 0x98, 0xb6,
-	0xc3, 0xdc, 0x66, 0xf4, 0x86, 0x91, 0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0xa8, 0x80, 0x8b, 0x0f, 0x2d,
-	0x06, 0xb4, 0xf4, 0x70, 0xc4, 0xbe, 0x1e, 0x86, 0x4f, 0xa5, 0x8c, 0x88, 0x57, 0x0b, 0xb3, 0x59,
-	0xa8, 0x98, 0x8b, 0x1f, 0x3d, 0x48, 0xb4, 0xf1, 0x19, 0x83, 0xa6, 0x58, 0xca, 0x98, 0x04, 0xc5,
-	0x30, 0x4b, 0x9d, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3d,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x9a, 0x3e, 0xa1, 0x94, 0x6e, 0x71,
-	0x4a, 0xb6, 0x7e, 0x05, 0x3c, 0x97, 0x24, 0xb1, 0x81, 0x93, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0xa8, 0x2c, 0xa7, 0x12, 0x3f, 0x03, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4d, 0xaf, 0xd2, 0x40,
+	0x14, 0xa5, 0x40, 0x24, 0x8c, 0x51, 0x43, 0x25, 0x5a, 0x2a, 0x69, 0x48, 0x37, 0x12, 0x0d, 0x33,
+	0xa1, 0x2c, 0x5c, 0x97, 0x44, 0x8d, 0x89, 0x6c, 0xea, 0xc6, 0xb8, 0x31, 0x2d, 0xbd, 0x8c, 0x0d,
+	0x30, 0x43, 0x3a, 0x05, 0x21, 0x31, 0xf1, 0x2f, 0xf8, 0x63, 0xf8, 0x05, 0xae, 0x8c, 0x2b, 0xe2,
+	0xca, 0xa5, 0x81, 0xb5, 0xff, 0xe1, 0xe5, 0x4d, 0xbf, 0x5e, 0x80, 0xf7, 0x02, 0x6f, 0xf1, 0x56,
+	0xcd, 0xed, 0x9c, 0x73, 0xee, 0x39, 0xb9, 0x73, 0x07, 0xb5, 0x86, 0x5c, 0x4c, 0xb9, 0x20, 0x23,
+	0x00, 0x1a, 0xba, 0x2c, 0x22, 0x8b, 0xae, 0x07, 0x91, 0xdb, 0x25, 0xd1, 0x12, 0xcf, 0x42, 0x1e,
+	0x71, 0xf5, 0x69, 0x8c, 0xc0, 0x29, 0x02, 0x27, 0x08, 0xbd, 0x41, 0x39, 0xa7, 0x13, 0x20, 0x12,
+	0xe6, 0xcd, 0x47, 0xc4, 0x65, 0xab, 0x98, 0xa3, 0x37, 0x62, 0xce, 0x67, 0x59, 0x91, 0x44, 0x40,
+	0x16, 0xe6, 0x4f, 0x05, 0xd5, 0x06, 0x82, 0xbe, 0xbd, 0x94, 0xb2, 0x27, 0x13, 0xfe, 0xd5, 0x65,
+	0x43, 0x50, 0x2d, 0x54, 0x91, 0xe2, 0x10, 0x6a, 0x4a, 0x4b, 0x69, 0x57, 0xfb, 0xda, 0x9f, 0x75,
+	0xa7, 0x9e, 0x10, 0x6d, 0xdf, 0x0f, 0x41, 0x88, 0x0f, 0x51, 0x18, 0x30, 0xea, 0xa4, 0xc0, 0x9c,
+	0x03, 0x5a, 0xf1, 0x34, 0x0e, 0xa8, 0xaf, 0x51, 0xd5, 0x4d, 0x9b, 0x6a, 0xa5, 0x96, 0xd2, 0xbe,
+	0x6f, 0xd5, 0x71, 0x9c, 0x03, 0xa7, 0x39, 0xb0, 0xcd, 0x56, 0xfd, 0xda, 0xef, 0x75, 0xe7, 0xc1,
+	0x1b, 0x80, 0xcc, 0xe2, 0x3b, 0x27, 0x67, 0x9a, 0xcf, 0x50, 0xe3, 0x20, 0x83, 0x03, 0x62, 0xc6,
+	0x99, 0x00, 0xf3, 0x1b, 0x52, 0x07, 0x82, 0x3a, 0xb0, 0xe0, 0x63, 0xb8, 0xf3, 0x84, 0x66, 0x13,
+	0xe9, 0x87, 0xdd, 0x33, 0x6f, 0xef, 0xd1, 0x93, 0xab, 0xa7, 0x19, 0x40, 0xdc, 0xc6, 0x9f, 0xf9,
+	0x11, 0x19, 0xc7, 0xd5, 0xd2, 0x7e, 0xaa, 0x86, 0x2a, 0xa1, 0x3c, 0xf6, 0xa5, 0x6a, 0xd9, 0x49,
+	0x4b, 0xb5, 0x89, 0xaa, 0x21, 0x4c, 0xdd, 0x80, 0x05, 0x8c, 0xca, 0x74, 0x65, 0x27, 0xff, 0x61,
+	0xfd, 0x2f, 0xa2, 0xd2, 0x40, 0x50, 0x75, 0x86, 0x1e, 0xee, 0xdd, 0x94, 0x17, 0xf8, 0x9a, 0xfb,
+	0x88, 0x0f, 0x26, 0xa2, 0x5b, 0xa7, 0x63, 0x33, 0xc7, 0x02, 0x3d, 0xda, 0x1f, 0xdd, 0xcb, 0x9b,
+	0x64, 0xf6, 0xc0, 0x7a, 0xef, 0x0c, 0x70, 0xd6, 0xf4, 0x3b, 0x7a, 0x7c, 0x6c, 0x26, 0xe4, 0x24,
+	0xad, 0x9c, 0xa0, 0xbf, 0x3a, 0x93, 0x90, 0x1a, 0xe8, 0xdb, 0xbf, 0xb6, 0x86, 0xb2, 0xd9, 0x1a,
+	0xca, 0xbf, 0xad, 0xa1, 0xfc, 0xd8, 0x19, 0x85, 0xcd, 0xce, 0x28, 0xfc, 0xdd, 0x19, 0x85, 0x4f,
+	0xcf, 0x69, 0x10, 0x7d, 0x99, 0x7b, 0x78, 0xc8, 0xa7, 0xc9, 0x22, 0x27, 0x9f, 0x8e, 0xf0, 0xc7,
+	0x64, 0x99, 0x3d, 0x1c, 0xde, 0x3d, 0xb9, 0x3f, 0xbd, 0x8b, 0x01, 0x00, 0x3f, 0x13, 0xe8, 0xb7,
+	0x52, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -278,6 +385,9 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// RevokeAllAllowances revokes the fee allowances of granter's account, at
+	// most MaxRevokedPerMsg of them per message.
+	RevokeAllAllowances(ctx context.Context, in *MsgRevokeAllAllowances, opts ...grpc.CallOption) (*MsgRevokeAllAllowancesResponse, error)
 }
 
 type msgClient struct {
@@ -306,6 +416,15 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) RevokeAllAllowances(ctx context.Context, in *MsgRevokeAllAllowances, opts ...grpc.CallOption) (*MsgRevokeAllAllowancesResponse, error) {
+	out := new(MsgRevokeAllAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeAllAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -314,6 +433,9 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// RevokeAllAllowances revokes the fee allowances of granter's account, at
+	// most MaxRevokedPerMsg of them per message.
+	RevokeAllAllowances(context.Context, *MsgRevokeAllAllowances) (*MsgRevokeAllAllowancesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -326,6 +448,9 @@ func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrant
 func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (*UnimplementedMsgServer) RevokeAllAllowances(ctx context.Context, req *MsgRevokeAllAllowances) (*MsgRevokeAllAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllAllowances not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -367,6 +492,24 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAllAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeAllAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllAllowances(ctx, req.(*MsgRevokeAllAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -379,6 +522,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "RevokeAllAllowances",
+			Handler:    _Msg_RevokeAllAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -516,6 +663,69 @@ func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeAllAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Revoked != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revoked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -583,6 +793,34 @@ func (m *MsgRevokeAllowanceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokeAllAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		n += 1 + sovTx(uint64(m.Revoked))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovTx(uint64(m.Remaining))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -953,6 +1191,176 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeAllAllowances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllAllowances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			m.Revoked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revoked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
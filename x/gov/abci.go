@@ -16,10 +16,19 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 	logger := keeper.Logger(ctx)
 
-	// delete dead proposals from store and burn theirs deposits. A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
+	// prune the records of refunded or burned deposits whose retention period ended
+	keeper.PruneDepositRecords(ctx)
+
+	// delete dead proposals from store and burn or refund theirs deposits. A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalId)
-		keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
+
+		burnDeposits := keeper.GetDepositParams(ctx).BurnProposalDepositPrevote
+		if burnDeposits {
+			keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
+		} else {
+			keeper.RefundAndDeleteDeposits(ctx, proposal.ProposalId)
+		}
 
 		// called when proposal become inactive
 		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalId)
@@ -29,6 +38,8 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				types.EventTypeInactiveProposal,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
+				sdk.NewAttribute(types.AttributeKeyDeposits, depositsResult(burnDeposits)),
+				sdk.NewAttribute(types.AttributeKeyDepositsReason, types.AttributeValueDepositsReasonMinDeposit),
 			),
 		)
 
@@ -36,7 +47,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.ProposalId,
 			"title", proposal.GetTitle(),
-			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
+			"min_deposit", keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited).String(),
 			"total_deposit", proposal.TotalDeposit.String(),
 		)
 
@@ -47,7 +58,27 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// Tallying deletes the votes, which are kept if an expedited proposal
+		// is converted to a regular one so that they count in its final tally.
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, depositsReason, tallyResults := keeper.Tally(tallyCtx, proposal)
+
+		if proposal.Expedited && !passes {
+			regularEndTime := proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+			if regularEndTime.After(ctx.BlockHeader().Time) {
+				convertExpeditedProposal(ctx, keeper, proposal, regularEndTime)
+				return false
+			}
+
+			// the regular voting period already ended, e.g. after a halt of
+			// the chain, re-queueing the proposal would have it processed again
+			// by this iteration, it is tallied as a regular proposal at once
+			proposal.Expedited = false
+			tallyCtx, writeTally = ctx.CacheContext()
+			passes, burnDeposits, depositsReason, tallyResults = keeper.Tally(tallyCtx, proposal)
+		}
+
+		writeTally()
 
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
@@ -107,8 +138,47 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				types.EventTypeActiveProposal,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyDeposits, depositsResult(burnDeposits)),
+				sdk.NewAttribute(types.AttributeKeyDepositsReason, depositsReason),
 			),
 		)
 		return false
 	})
 }
+
+// convertExpeditedProposal converts an expedited proposal which did not pass
+// into a regular proposal, whose voting period ends at the given end time, a
+// regular voting period after it started and after the block time. Its
+// deposits and votes are kept until then.
+func convertExpeditedProposal(ctx sdk.Context, keeper keeper.Keeper, proposal types.Proposal, endTime time.Time) {
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	proposal.Expedited = false
+	proposal.VotingEndTime = endTime
+	keeper.SetProposal(ctx, proposal)
+	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	keeper.Logger(ctx).Info(
+		"expedited proposal converted to regular",
+		"proposal", proposal.ProposalId,
+		"title", proposal.GetTitle(),
+		"voting_end_time", proposal.VotingEndTime.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeActiveProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+		),
+	)
+}
+
+// depositsResult returns the event attribute value telling whether the
+// deposits of a proposal were burned or refunded.
+func depositsResult(burnDeposits bool) string {
+	if burnDeposits {
+		return types.AttributeValueDepositsBurned
+	}
+	return types.AttributeValueDepositsRefunded
+}
@@ -288,7 +288,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -305,7 +305,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -338,7 +338,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -349,7 +349,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -364,6 +364,207 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
 
+func TestExpeditedProposalPassed(t *testing.T) {
+	app, ctx, addrs := setupBondedValidators(t, []int64{10})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", true, nil)
+	require.NoError(t, err)
+	require.True(t, proposal.Expedited)
+
+	depositExpeditedProposal(t, app, ctx, addrs[0], proposal.ProposalId)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
+	require.NoError(t, err)
+
+	// the proposal is tallied once the expedited voting period ends
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.True(t, proposal.Expedited)
+	require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+}
+
+func TestExpeditedProposalConverted(t *testing.T) {
+	app, ctx, addrs := setupBondedValidators(t, []int64{6, 4})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", true, nil)
+	require.NoError(t, err)
+
+	depositExpeditedProposal(t, app, ctx, addrs[0], proposal.ProposalId)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+
+	// 60% of the voting power is enough to pass a regular proposal, but not
+	// an expedited one
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
+	require.NoError(t, err)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), "")
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// the proposal is converted to a regular one and keeps its votes and deposits
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, proposal.VotingStartTime.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod), proposal.VotingEndTime)
+	require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 2)
+	require.NotEmpty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockTime())
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// the proposal passes once the regular voting period ends
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+	require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+}
+
+func TestExpeditedProposalConvertedAfterRegularPeriod(t *testing.T) {
+	app, ctx, addrs := setupBondedValidators(t, []int64{6, 4})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", true, nil)
+	require.NoError(t, err)
+
+	depositExpeditedProposal(t, app, ctx, addrs[0], proposal.ProposalId)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
+	require.NoError(t, err)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), "")
+	require.NoError(t, err)
+
+	// the first block after the expedited voting period is past the end of
+	// the regular one, e.g. after a halt of the chain
+	regularEndTime := proposal.VotingStartTime.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockTime(regularEndTime).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// the proposal is tallied once, as a regular proposal
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.False(t, proposal.Expedited)
+	require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+	require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+
+	var results []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeActiveProposal {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyProposalResult {
+				results = append(results, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{types.AttributeValueProposalPassed}, results)
+
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, regularEndTime.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod))
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+}
+
+func TestMultipleChoiceProposalEndBlocker(t *testing.T) {
+	voteOptions := []string{"red", "green", "blue"}
+	red, green, blue := types.NewMultipleChoiceVoteOption(0), types.NewMultipleChoiceVoteOption(1), types.NewMultipleChoiceVoteOption(2)
+
+	testCases := []struct {
+		name           string
+		powers         []int64
+		votes          []types.WeightedVoteOptions
+		expStatus      types.ProposalStatus
+		expOptionPower []int64
+	}{
+		{"plurality passes", []int64{6, 4, 3}, []types.WeightedVoteOptions{red, green, green}, types.StatusPassed, []int64{6, 7, 0}},
+		{"tie is rejected", []int64{5, 5, 2}, []types.WeightedVoteOptions{red, blue}, types.StatusRejected, []int64{5, 0, 5}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx, addrs := setupBondedValidators(t, tc.powers)
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, voteOptions)
+			require.NoError(t, err)
+
+			minDeposit := app.GovKeeper.GetDepositParams(ctx).MinDeposit
+			_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), types.NewMsgDeposit(addrs[0], proposal.ProposalId, minDeposit))
+			require.NoError(t, err)
+
+			for i, vote := range tc.votes {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[i], vote, ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			ctx = ctx.WithBlockTime(proposal.VotingEndTime)
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+			require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+
+			require.Len(t, proposal.FinalTallyResult.Options, len(voteOptions))
+			for i, option := range proposal.FinalTallyResult.Options {
+				require.Equal(t, voteOptions[i], option.Name)
+				require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, tc.expOptionPower[i]), option.Count)
+			}
+		})
+	}
+}
+
+// setupExpeditedProposal creates bonded validators with the given powers, whose
+// operators can afford the expedited minimum deposit.
+func setupBondedValidators(t *testing.T, powers []int64) (*simapp.SimApp, sdk.Context, []sdk.AccAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, len(powers), sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+
+	SortAddresses(addrs)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	createValidators(t, stakingMsgSvr, ctx, simapp.ConvertAddrsToValAddrs(addrs), powers)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	return app, ctx, addrs
+}
+
+func depositExpeditedProposal(t *testing.T, app *simapp.SimApp, ctx sdk.Context, depositor sdk.AccAddress, proposalID uint64) {
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	minDeposit := app.GovKeeper.GetDepositParams(ctx).ExpeditedMinDeposit
+
+	res, err := govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), types.NewMsgDeposit(depositor, proposalID, minDeposit))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -379,3 +580,60 @@ func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sd
 		require.NotNil(t, res)
 	}
 }
+
+func TestDepositRecordsEndBlocker(t *testing.T) {
+	testCases := []struct {
+		name      string
+		deposit   bool
+		vote      bool
+		expStatus types.DepositStatus
+	}{
+		{"dropped before voting period is burned", false, false, types.DepositStatusBurned},
+		{"quorum not reached is burned", true, false, types.DepositStatusBurned},
+		{"passed is refunded", true, true, types.DepositStatusRefunded},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx, addrs := setupBondedValidators(t, []int64{10})
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+			require.NoError(t, err)
+
+			deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+			if tc.deposit {
+				deposit = depositParams.MinDeposit
+			}
+			_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), types.NewMsgDeposit(addrs[0], proposal.ProposalId, deposit))
+			require.NoError(t, err)
+
+			if tc.vote {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+			}
+
+			record, found := app.GovKeeper.GetDepositRecord(ctx, addrs[0], proposal.ProposalId)
+			require.True(t, found)
+			require.Equal(t, types.DepositStatusActive, record.Status)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(depositParams.MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod))
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			record, found = app.GovKeeper.GetDepositRecord(ctx, addrs[0], proposal.ProposalId)
+			require.True(t, found)
+			require.Equal(t, types.NewDepositRecord(types.NewDeposit(proposal.ProposalId, addrs[0], deposit), tc.expStatus, ctx.BlockHeight()), record)
+
+			// the record is pruned once the retention period ends
+			pruneHeight := ctx.BlockHeight() + int64(depositParams.DepositRecordRetention)
+			gov.EndBlocker(ctx.WithBlockHeight(pruneHeight-1), app.GovKeeper)
+			_, found = app.GovKeeper.GetDepositRecord(ctx, addrs[0], proposal.ProposalId)
+			require.True(t, found)
+
+			gov.EndBlocker(ctx.WithBlockHeight(pruneHeight), app.GovKeeper)
+			_, found = app.GovKeeper.GetDepositRecord(ctx, addrs[0], proposal.ProposalId)
+			require.False(t, found)
+		})
+	}
+}
@@ -21,6 +21,9 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		proposal.Description, _ = fs.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(proposalType)
 		proposal.Deposit, _ = fs.GetString(FlagDeposit)
+		proposal.Expedited, _ = fs.GetBool(FlagExpedited)
+		proposal.Metadata, _ = fs.GetString(FlagMetadata)
+		proposal.VoteOptions, _ = fs.GetStringArray(FlagVoteOption)
 		return proposal, nil
 	}
 
@@ -29,6 +32,15 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 			return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", flag)
 		}
 	}
+	if expedited, _ := fs.GetBool(FlagExpedited); expedited {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagExpedited)
+	}
+	if metadata, _ := fs.GetString(FlagMetadata); metadata != "" {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagMetadata)
+	}
+	if voteOptions, _ := fs.GetStringArray(FlagVoteOption); len(voteOptions) > 0 {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagVoteOption)
+	}
 
 	contents, err := os.ReadFile(proposalFile)
 	if err != nil {
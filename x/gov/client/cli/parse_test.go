@@ -14,7 +14,10 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "1000test"
+  "deposit": "1000test",
+  "expedited": true,
+  "metadata": "ipfs://CID",
+  "vote_options": ["red", "blue"]
 }
 `)
 
@@ -39,6 +42,9 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.True(t, proposal1.Expedited)
+	require.Equal(t, "ipfs://CID", proposal1.Metadata)
+	require.Equal(t, []string{"red", "blue"}, proposal1.VoteOptions)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -47,6 +53,17 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 		require.Error(t, err)
 		fs.Set(incompatibleFlag, "")
 	}
+	fs.Set(FlagMetadata, "some value")
+	_, err = parseSubmitProposalFlags(fs)
+	require.Error(t, err)
+	fs.Set(FlagMetadata, "")
+	fs.Set(FlagExpedited, "true")
+	_, err = parseSubmitProposalFlags(fs)
+	require.Error(t, err)
+	fs.Set(FlagExpedited, "false")
+	fs.Set(FlagVoteOption, proposal1.VoteOptions[0])
+	_, err = parseSubmitProposalFlags(fs)
+	require.Error(t, err)
 
 	// no --proposal, only flags
 	fs.Set(FlagProposal, "")
@@ -54,6 +71,10 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	fs.Set(FlagDescription, proposal1.Description)
 	fs.Set(FlagProposalType, proposal1.Type)
 	fs.Set(FlagDeposit, proposal1.Deposit)
+	fs.Set(FlagExpedited, "true")
+	fs.Set(FlagMetadata, proposal1.Metadata)
+	// repeating the flag appends to the vote options set above
+	fs.Set(FlagVoteOption, proposal1.VoteOptions[1])
 	proposal2, err := parseSubmitProposalFlags(fs)
 
 	require.Nil(t, err, "unexpected error")
@@ -61,6 +82,9 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, proposal1.Description, proposal2.Description)
 	require.Equal(t, proposal1.Type, proposal2.Type)
 	require.Equal(t, proposal1.Deposit, proposal2.Deposit)
+	require.Equal(t, proposal1.Expedited, proposal2.Expedited)
+	require.Equal(t, proposal1.Metadata, proposal2.Metadata)
+	require.Equal(t, proposal1.VoteOptions, proposal2.VoteOptions)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
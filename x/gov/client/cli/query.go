@@ -31,11 +31,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryProposals(),
 		GetCmdQueryVote(),
 		GetCmdQueryVotes(),
+		GetCmdQueryVoterVotes(),
 		GetCmdQueryParam(),
 		GetCmdQueryParams(),
 		GetCmdQueryProposer(),
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
+		GetCmdQueryDepositsByDepositor(),
 		GetCmdQueryTally(),
 	)
 
@@ -101,7 +103,7 @@ func GetCmdQueryProposals() *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected|Cancelled)
 $ %s query gov proposals --page=2 --limit=100
 `,
 				version.AppName, version.AppName, version.AppName, version.AppName,
@@ -170,7 +172,7 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected/cancelled")
 	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -335,6 +337,58 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 	return cmd
 }
 
+// GetCmdQueryVoterVotes implements the command to query the votes cast by a
+// voter across proposals.
+func GetCmdQueryVoterVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-voter [voter-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the votes cast by a voter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the votes cast by a voter on the proposals in voting period.
+
+Example:
+$ %[1]s query gov votes-by-voter cosmos1skjw..
+$ %[1]s query gov votes-by-voter cosmos1skjw.. --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			voterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoterVotes(
+				cmd.Context(),
+				&types.QueryVoterVotesRequest{Voter: voterAddr.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "voter votes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryDeposit implements the query proposal deposit command. Command to
 // get a specific Deposit Information
 func GetCmdQueryDeposit() *cobra.Command {
@@ -454,6 +508,60 @@ $ %s query gov deposits 1
 	return cmd
 }
 
+// GetCmdQueryDepositsByDepositor implements the command to query the deposits
+// made by a depositor across proposals.
+func GetCmdQueryDepositsByDepositor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits-by-depositor [depositor-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the deposits made by a depositor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deposits made by a depositor along with their status: active,
+refunded or burned. Refunded and burned deposits are kept for the deposit record
+retention number of blocks after they were settled.
+
+Example:
+$ %[1]s query gov deposits-by-depositor cosmos1skjw..
+$ %[1]s query gov deposits-by-depositor cosmos1skjw.. --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			depositorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DepositsByDepositor(
+				cmd.Context(),
+				&types.QueryDepositsByDepositorRequest{Depositor: depositorAddr.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "depositor deposits")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryTally implements the command to query for proposal tally result.
 func GetCmdQueryTally() *cobra.Command {
 	cmd := &cobra.Command{
@@ -466,6 +574,10 @@ the proposal-id by running "%s query gov proposals".
 
 Example:
 $ %s query gov tally 1
+
+With the --detailed flag, the tally of a proposal in its voting period is
+broken down by validator, showing how the tokens bonded to each validator
+inherit its vote or are voted by their delegators.
 `,
 				version.AppName, version.AppName,
 			),
@@ -493,19 +605,26 @@ $ %s query gov tally 1
 				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
 			}
 
+			detailed, _ := cmd.Flags().GetBool(flagDetailed)
+
 			// Query store
 			res, err := queryClient.TallyResult(
 				ctx,
-				&types.QueryTallyResultRequest{ProposalId: proposalID},
+				&types.QueryTallyResultRequest{ProposalId: proposalID, Detailed: detailed},
 			)
 			if err != nil {
 				return err
 			}
 
+			if detailed {
+				return clientCtx.PrintProto(res)
+			}
+
 			return clientCtx.PrintProto(&res.Tally)
 		},
 	}
 
+	cmd.Flags().Bool(flagDetailed, false, "Break the tally down by validator")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -25,7 +25,11 @@ const (
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
+	flagDetailed     = "detailed"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
+	FlagMetadata     = "metadata"
+	FlagVoteOption   = "vote-option"
 )
 
 type proposal struct {
@@ -33,6 +37,9 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
+	Metadata    string
+	VoteOptions []string `json:"vote_options"`
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -69,6 +76,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		NewCmdDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdCancelProposal(),
 		cmdSubmitProp,
 	)
 
@@ -93,14 +101,29 @@ Where proposal.json contains:
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "10test"
+  "deposit": "10test",
+  "expedited": false,
+  "metadata": "ipfs://CID"
 }
 
 Which is equivalent to:
 
-$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --metadata="ipfs://CID" --from mykey
+
+Expedited proposals require a higher deposit and threshold but are voted on over a shorter period:
+
+$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="50test" --expedited --from mykey
+
+A text proposal given named vote options is a multiple-choice proposal, on which the option with
+the most votes wins. The options can also be given as a "vote_options" list in the proposal JSON:
+
+$ %s tx gov submit-proposal --title="Test Proposal" --description="Pick a color" --type="Text" --deposit="10test" --vote-option=red --vote-option=blue --from mykey
+
+The initial deposit must be at least the min_initial_deposit_ratio fraction of the minimum
+deposit (see "%s query gov params"). Simulating the transaction with --gas=auto or --dry-run
+reports the required amount before anything is broadcast.
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -125,6 +148,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.SetExpedited(proposal.Expedited)
+			msg.SetMetadata(proposal.Metadata)
+			msg.SetVoteOptions(proposal.VoteOptions)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -134,6 +160,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagDescription, "", "The proposal description")
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as expedited")
+	cmd.Flags().String(FlagMetadata, "", "The proposal metadata, such as an IPFS CID")
+	cmd.Flags().StringArray(FlagVoteOption, nil, "A named vote option of a multiple-choice proposal, can be repeated")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -178,6 +207,48 @@ $ %s tx gov deposit 1 10stake --from mykey
 			}
 
 			msg := types.NewMsgDeposit(from, proposalID, amount)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The deposit metadata")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelProposal implements cancelling a proposal by its proposer.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal you submitted",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal you submitted, during its deposit period or before the
+proposal_cancel_max_period fraction of its voting period has elapsed. Its deposits
+are refunded minus a cancellation fee given by the proposal_cancel_ratio param.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress())
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -198,10 +269,13 @@ func NewCmdVote() *cobra.Command {
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
 
-Example:
+On a multiple-choice proposal, vote for one of its named options instead.
+
+Examples:
 $ %s tx gov vote 1 yes --from mykey
+$ %s tx gov vote 2 red --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -218,19 +292,36 @@ $ %s tx gov vote 1 yes --from mykey
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
-			// Find out which vote option user chose
+			// Find out which vote option user chose, which is one of the named
+			// options if the proposal is multiple-choice
+			var optionIndex uint32
 			byteVoteOption, err := types.VoteOptionFromString(govutils.NormalizeVoteOption(args[1]))
 			if err != nil {
-				return err
+				voteOptions, qErr := queryVoteOptions(cmd, clientCtx, proposalID)
+				if qErr != nil || len(voteOptions) == 0 {
+					return err
+				}
+
+				options, err := govutils.ParseMultipleChoiceWeightedVoteOptions(args[1], voteOptions)
+				if err != nil {
+					return err
+				}
+				if len(options) != 1 {
+					return fmt.Errorf("expected a single vote option, got %s", args[1])
+				}
+				optionIndex = options[0].OptionIndex
 			}
 
 			// Build vote message and run basic validation
 			msg := types.NewMsgVote(from, proposalID, byteVoteOption)
+			msg.OptionIndex = optionIndex
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMetadata, "", "The vote metadata, such as a rationale")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -246,10 +337,20 @@ func NewCmdWeightedVote() *cobra.Command {
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
 
-Example:
+Weights can be given as decimals or fractions summing to 1, as percentages
+summing to 100%%, or as integers relative to each other. They are normalized
+to sum to exactly 1, and sums off by at most %s%% are accepted.
+
+On a multiple-choice proposal, the weights are given to its named options.
+
+Examples:
 $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+$ %s tx gov weighted-vote 1 yes=60%%,abstain=40%% --from mykey
+$ %s tx gov weighted-vote 1 yes=2,no=1 --from mykey
+$ %s tx gov weighted-vote 2 red=0.7,blue=0.3 --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, govutils.WeightSumTolerance.MulInt64(100).TruncateInt(),
+				version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -267,19 +368,42 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
-			// Figure out which vote options user chose
-			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			// Figure out which vote options user chose, which are named options
+			// if the proposal is multiple-choice
+			options, err := govutils.ParseWeightedVoteOptions(args[1])
 			if err != nil {
-				return err
+				voteOptions, qErr := queryVoteOptions(cmd, clientCtx, proposalID)
+				if qErr != nil || len(voteOptions) == 0 {
+					return err
+				}
+
+				options, err = govutils.ParseMultipleChoiceWeightedVoteOptions(args[1], voteOptions)
+				if err != nil {
+					return err
+				}
 			}
 
 			// Build vote message and run basic validation
 			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMetadata, "", "The vote metadata, such as a rationale")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// queryVoteOptions returns the named vote options of a proposal, which are
+// empty unless the proposal is multiple-choice.
+func queryVoteOptions(cmd *cobra.Command, clientCtx client.Context, proposalID uint64) ([]string, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Proposal(cmd.Context(), &types.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Proposal.VoteOptions, nil
+}
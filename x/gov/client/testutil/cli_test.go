@@ -18,8 +18,13 @@ func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 
 	genesisState := types.DefaultGenesisState()
-	genesisState.DepositParams = types.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, types.DefaultMinDepositTokens)), time.Duration(15)*time.Second)
-	genesisState.VotingParams = types.NewVotingParams(time.Duration(5) * time.Second)
+	genesisState.DepositParams = types.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, types.DefaultMinDepositTokens)), time.Duration(15)*time.Second,
+		sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, types.DefaultMinExpeditedDepositTokens)),
+		types.DefaultBurnVoteQuorum, types.DefaultBurnProposalDepositPrevote, types.DefaultBurnVoteVeto, types.DefaultMaxMetadataLen,
+		types.DefaultMinInitialDepositRatio, types.DefaultProposalCancelRatio, types.DefaultProposalCancelBurn,
+		types.DefaultMaxVoteOptions, types.DefaultMaxVoteOptionLen, types.DefaultDepositRecordRetention)
+	genesisState.VotingParams = types.NewVotingParams(time.Duration(5)*time.Second, time.Duration(2)*time.Second,
+		types.DefaultProposalCancelMaxPeriod)
 	bz, err := cfg.Codec.MarshalJSON(genesisState)
 	require.NoError(t, err)
 	cfg.GenesisState["gov"] = bz
@@ -7,14 +7,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -90,22 +94,38 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"voting_params":{"voting_period":"172800000000000","expedited_voting_period":"86400000000000","proposal_cancel_max_period":"0.500000000000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_quorum":true,"burn_proposal_deposit_prevote":true,"burn_vote_veto":true,"max_metadata_len":"255","min_initial_deposit_ratio":"0.000000000000000000","proposal_cancel_ratio":"0.500000000000000000","proposal_cancel_burn":true,"max_vote_options":"10","max_vote_option_len":"100","deposit_record_retention":"100800"}}`,
 		},
 		{
 			"text output",
 			[]string{},
 			`
 deposit_params:
+  burn_proposal_deposit_prevote: true
+  burn_vote_quorum: true
+  burn_vote_veto: true
+  deposit_record_retention: "100800"
+  expedited_min_deposit:
+  - amount: "50000000"
+    denom: stake
   max_deposit_period: "172800000000000"
+  max_metadata_len: "255"
+  max_vote_option_len: "100"
+  max_vote_options: "10"
   min_deposit:
   - amount: "10000000"
     denom: stake
+  min_initial_deposit_ratio: "0.000000000000000000"
+  proposal_cancel_burn: true
+  proposal_cancel_ratio: "0.500000000000000000"
 tally_params:
+  expedited_threshold: "0.667000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
 voting_params:
+  expedited_voting_period: "86400000000000"
+  proposal_cancel_max_period: "0.500000000000000000"
   voting_period: "172800000000000"
 	`,
 		},
@@ -139,7 +159,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"voting",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"voting_period":"172800000000000"}`,
+			`{"voting_period":"172800000000000","expedited_voting_period":"86400000000000","proposal_cancel_max_period":"0.500000000000000000"}`,
 		},
 		{
 			"tally params",
@@ -147,7 +167,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"tallying",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"}`,
+			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"}`,
 		},
 		{
 			"deposit params",
@@ -155,7 +175,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"deposit",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}`,
+			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_quorum":true,"burn_proposal_deposit_prevote":true,"burn_vote_veto":true,"max_metadata_len":"255","min_initial_deposit_ratio":"0.000000000000000000","proposal_cancel_ratio":"0.500000000000000000","proposal_cancel_burn":true,"max_vote_options":"10","max_vote_option_len":"100","deposit_record_retention":"100800"}`,
 		},
 	}
 
@@ -269,12 +289,36 @@ func (s *IntegrationTestSuite) TestCmdTally() {
 			} else {
 				var tally types.TallyResult
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &tally), out.String())
-				s.Require().Equal(tally, tc.expectedOutput)
+				s.Require().True(tc.expectedOutput.Equals(tally), tally.String())
 			}
 		})
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdTallyDetailed() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	args := []string{
+		"1",
+		"--detailed",
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryTally(), args)
+	s.Require().NoError(err)
+
+	var res types.QueryTallyResultResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+
+	// the only validator voted yes on proposal 1 with its self-delegation
+	s.Require().True(types.NewTallyResult(s.cfg.BondedTokens, sdk.NewInt(0), sdk.NewInt(0), sdk.NewInt(0)).Equals(res.Tally), res.Tally.String())
+	s.Require().Len(res.ValidatorTallies, 1)
+	s.Require().Equal(val.ValAddress.String(), res.ValidatorTallies[0].ValidatorAddress)
+	s.Require().Equal(types.NewNonSplitVoteOption(types.OptionYes), types.WeightedVoteOptions(res.ValidatorTallies[0].Vote))
+	s.Require().Equal(res.Tally, res.ValidatorTallies[0].OverriddenTally)
+	s.Require().True(types.EmptyTallyResult().Equals(res.ValidatorTallies[0].InheritedTally), res.ValidatorTallies[0].InheritedTally.String())
+}
+
 func (s *IntegrationTestSuite) TestNewCmdSubmitProposal() {
 	val := s.network.Validators[0]
 	invalidProp := `{
@@ -345,6 +389,51 @@ func (s *IntegrationTestSuite) TestNewCmdSubmitProposal() {
 			},
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid expedited transaction",
+			[]string{
+				fmt.Sprintf("--%s='Text Proposal'", cli.FlagTitle),
+				fmt.Sprintf("--%s='Where is the title!?'", cli.FlagDescription),
+				fmt.Sprintf("--%s=%s", cli.FlagProposalType, types.ProposalTypeText),
+				fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)).String()),
+				fmt.Sprintf("--%s=true", cli.FlagExpedited),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"valid transaction with metadata",
+			[]string{
+				fmt.Sprintf("--%s='Text Proposal'", cli.FlagTitle),
+				fmt.Sprintf("--%s='Where is the title!?'", cli.FlagDescription),
+				fmt.Sprintf("--%s=%s", cli.FlagProposalType, types.ProposalTypeText),
+				fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)).String()),
+				fmt.Sprintf("--%s=%s", cli.FlagMetadata, strings.Repeat("a", int(types.DefaultMaxMetadataLen))),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"metadata too long",
+			[]string{
+				fmt.Sprintf("--%s='Text Proposal'", cli.FlagTitle),
+				fmt.Sprintf("--%s='Where is the title!?'", cli.FlagDescription),
+				fmt.Sprintf("--%s=%s", cli.FlagProposalType, types.ProposalTypeText),
+				fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)).String()),
+				fmt.Sprintf("--%s=%s", cli.FlagMetadata, strings.Repeat("a", int(types.DefaultMaxMetadataLen)+1)),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, types.ErrMetadataTooLong.ABCICode(), &sdk.TxResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -563,6 +652,98 @@ func (s *IntegrationTestSuite) TestCmdQueryDeposit() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCmdCancelProposal() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	// submit a proposal to cancel
+	_, err := MsgSubmitProposal(clientCtx, val.Address.String(),
+		"Text Proposal to cancel", "Where is the title!?", types.ProposalTypeText,
+		fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000)).String()))
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryProposals(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var proposals types.QueryProposalsResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &proposals), out.String())
+	proposalID := fmt.Sprintf("%d", proposals.Proposals[len(proposals.Proposals)-1].ProposalId)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"without proposal id",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true, 0,
+		},
+		{
+			"invalid proposal id",
+			[]string{
+				"abc",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true, 0,
+		},
+		{
+			"cancel non existing proposal",
+			[]string{
+				"100",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			false, types.ErrUnknownProposal.ABCICode(),
+		},
+		{
+			"valid transaction",
+			[]string{
+				proposalID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			false, 0,
+		},
+		{
+			"cancel already cancelled proposal",
+			[]string{
+				proposalID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			false, types.ErrInactiveProposal.ABCICode(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		var resp sdk.TxResponse
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdCancelProposal(), append(tc.args, commonArgs...))
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				s.Require().Equal(tc.expectedCode, resp.Code, out.String())
+			}
+		})
+	}
+
+	// the cancelled proposal is kept and can be filtered by status
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryProposals(), []string{
+		"--status=cancelled",
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &proposals), out.String())
+	s.Require().Len(proposals.Proposals, 1)
+	s.Require().Equal(proposalID, fmt.Sprintf("%d", proposals.Proposals[0].ProposalId))
+	s.Require().Equal(types.StatusCancelled, proposals.Proposals[0].Status)
+}
+
 func (s *IntegrationTestSuite) TestNewCmdDeposit() {
 	val := s.network.Validators[0]
 
@@ -692,6 +873,144 @@ func (s *IntegrationTestSuite) TestCmdQueryVotes() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdQueryVoterVotes() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expProposalIDs []uint64
+	}{
+		{
+			"get votes with no voter",
+			[]string{},
+			true,
+			nil,
+		},
+		{
+			"get votes of invalid voter",
+			[]string{
+				"invalid",
+			},
+			true,
+			nil,
+		},
+		{
+			"get votes of voter",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			[]uint64{1, 3},
+		},
+		{
+			"get votes of voter with pagination",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			[]uint64{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryVoterVotes()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var votes types.QueryVoterVotesResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &votes), out.String())
+				s.Require().Len(votes.Votes, len(tc.expProposalIDs))
+				for i, vote := range votes.Votes {
+					s.Require().Equal(tc.expProposalIDs[i], vote.ProposalId)
+					s.Require().Equal(val.Address.String(), vote.Voter)
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdQueryDepositsByDepositor() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expProposalIDs []uint64
+	}{
+		{
+			"get deposits with no depositor",
+			[]string{},
+			true,
+			nil,
+		},
+		{
+			"get deposits of invalid depositor",
+			[]string{
+				"invalid",
+			},
+			true,
+			nil,
+		},
+		{
+			"get deposits of depositor",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			[]uint64{1, 2, 3},
+		},
+		{
+			"get deposits of depositor with pagination",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			[]uint64{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryDepositsByDepositor()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var deposits types.QueryDepositsByDepositorResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &deposits), out.String())
+				s.Require().Len(deposits.Deposits, len(tc.expProposalIDs))
+				for i, record := range deposits.Deposits {
+					s.Require().Equal(tc.expProposalIDs[i], record.ProposalId)
+					s.Require().Equal(val.Address.String(), record.Depositor)
+				}
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCmdQueryVote() {
 	val := s.network.Validators[0]
 
@@ -809,6 +1128,32 @@ func (s *IntegrationTestSuite) TestNewCmdVote() {
 			},
 			false, 0,
 		},
+		{
+			"valid vote with metadata",
+			[]string{
+				"1",
+				"yes",
+				fmt.Sprintf("--%s=%s", cli.FlagMetadata, "ipfs://CID"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0,
+		},
+		{
+			"vote metadata too long",
+			[]string{
+				"1",
+				"yes",
+				fmt.Sprintf("--%s=%s", cli.FlagMetadata, strings.Repeat("a", int(types.DefaultMaxMetadataLen)+1)),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, types.ErrMetadataTooLong.ABCICode(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -831,6 +1176,110 @@ func (s *IntegrationTestSuite) TestNewCmdVote() {
 	}
 }
 
+// TestNewCmdVoteKeyringDir votes with a key of a keyring in a directory
+// different from the home directory of the validator.
+func (s *IntegrationTestSuite) TestNewCmdVoteKeyringDir() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	keyringDir := s.T().TempDir()
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, keyringDir, nil, clientCtx.Codec)
+	s.Require().NoError(err)
+	k, _, err := kr.NewMnemonic("keyringDirKey", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	addr, err := k.GetAddress()
+	s.Require().NoError(err)
+
+	_, err = banktestutil.MsgSendExec(clientCtx, val.Address, addr,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000))), commonArgs...)
+	s.Require().NoError(err)
+
+	// the key is not in the keyring of the home directory
+	_, err = MsgVote(clientCtx, "keyringDirKey", "1", "yes")
+	s.Require().Error(err)
+
+	out, err := MsgVote(clientCtx, "keyringDirKey", "1", "yes", fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, keyringDir))
+	s.Require().NoError(err, out.String())
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryVote(), []string{
+		"1",
+		addr.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var vote types.Vote
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &vote), out.String())
+	s.Require().Equal(addr.String(), vote.Voter)
+}
+
+func (s *IntegrationTestSuite) TestNewCmdVoteMultipleChoice() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	// submit a multiple-choice proposal with enough deposit to be voted on
+	_, err := MsgSubmitProposal(clientCtx, val.Address.String(),
+		"Multiple-choice Proposal", "Pick a color", types.ProposalTypeText,
+		fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, types.DefaultMinDepositTokens).String()),
+		fmt.Sprintf("--%s=red", cli.FlagVoteOption),
+		fmt.Sprintf("--%s=blue", cli.FlagVoteOption))
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryProposals(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var proposals types.QueryProposalsResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &proposals), out.String())
+	proposal := proposals.Proposals[len(proposals.Proposals)-1]
+	s.Require().Equal([]string{"red", "blue"}, proposal.VoteOptions)
+	s.Require().Equal(types.StatusVotingPeriod, proposal.Status)
+	proposalID := fmt.Sprintf("%d", proposal.ProposalId)
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		option       string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{"unknown option", cli.NewCmdVote(), "purple", true, 0},
+		{"standard option", cli.NewCmdVote(), "yes", false, types.ErrInvalidVote.ABCICode()},
+		{"valid vote", cli.NewCmdVote(), "blue", false, 0},
+		{"valid weighted vote", cli.NewCmdWeightedVote(), "red=1/4,blue=3/4", false, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			var txResp sdk.TxResponse
+
+			args := append([]string{proposalID, tc.option, fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String())}, commonArgs...)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	// the tally counts the weighted vote on the named options
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryTally(), []string{proposalID, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var tally types.TallyResult
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &tally), out.String())
+
+	expTally := types.EmptyTallyResult()
+	expTally.Options = []types.OptionTally{
+		{Name: "red", Count: s.cfg.BondedTokens.QuoRaw(4)},
+		{Name: "blue", Count: s.cfg.BondedTokens.MulRaw(3).QuoRaw(4)},
+	}
+	s.Require().True(expTally.Equals(tally), tally.String())
+}
+
 func (s *IntegrationTestSuite) TestNewCmdWeightedVote() {
 	val := s.network.Validators[0]
 
@@ -893,6 +1342,42 @@ func (s *IntegrationTestSuite) TestNewCmdWeightedVote() {
 			},
 			false, 0,
 		},
+		{
+			"valid split vote with percentages",
+			[]string{
+				"1",
+				"yes=60%,abstain=40%",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0,
+		},
+		{
+			"valid split vote with relative weights",
+			[]string{
+				"1",
+				"yes=2,no=1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0,
+		},
+		{
+			"invalid split vote sum",
+			[]string{
+				"1",
+				"yes=0.6,no=0.3",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,11 +1,29 @@
 package utils
 
 import (
+	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// WeightSumTolerance is how far from 1 (or 100%) the weights given to
+// ParseWeightedVoteOptions may sum before being rejected.
+var WeightSumTolerance = sdk.NewDecWithPrec(1, 2)
+
+// voteWeightForm is the notation a vote weight is written in.
+type voteWeightForm int
+
+const (
+	// weightFormDecimal is a decimal or a fraction, e.g. 0.6 or 2/3.
+	weightFormDecimal voteWeightForm = iota
+	// weightFormPercent is a percentage, e.g. 60%.
+	weightFormPercent
+	// weightFormRelative is an integer relative to the other weights, e.g. 2.
+	weightFormRelative
+)
+
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
 	switch option {
@@ -40,6 +58,147 @@ func NormalizeWeightedVoteOptions(options string) string {
 	return strings.Join(newOptions, ",")
 }
 
+// ParseWeightedVoteOptions parses user specified weighted vote options, such as
+// "yes=0.6,no=0.4". Weights can be given as decimals or fractions summing to 1
+// ("yes=2/3,no=1/3"), as percentages summing to 100% ("yes=60%,abstain=40%"),
+// or as integers relative to each other ("yes=2,no=1"), and an option without
+// weight has a weight of 1. Sums within WeightSumTolerance are accepted, and the
+// weights are normalized to sum to exactly 1 as MsgVoteWeighted requires.
+func ParseWeightedVoteOptions(options string) (types.WeightedVoteOptions, error) {
+	return parseWeightedVoteOptions(options, func(name string) (types.WeightedVoteOption, error) {
+		voteOption, err := types.VoteOptionFromString(NormalizeVoteOption(name))
+		if err != nil {
+			return types.WeightedVoteOption{}, err
+		}
+		return types.WeightedVoteOption{Option: voteOption}, nil
+	})
+}
+
+// ParseMultipleChoiceWeightedVoteOptions parses weighted vote options on a
+// multiple-choice proposal, such as "red=0.6,blue=0.4", where the options are
+// named after the proposal's voteOptions. The weights are given as for
+// ParseWeightedVoteOptions, and each option refers to its index in voteOptions.
+func ParseMultipleChoiceWeightedVoteOptions(options string, voteOptions []string) (types.WeightedVoteOptions, error) {
+	return parseWeightedVoteOptions(options, func(name string) (types.WeightedVoteOption, error) {
+		for i, voteOption := range voteOptions {
+			if voteOption == name {
+				return types.WeightedVoteOption{OptionIndex: uint32(i)}, nil
+			}
+		}
+		return types.WeightedVoteOption{}, fmt.Errorf("'%s' is not a vote option of the proposal, expected one of %s", name, strings.Join(voteOptions, ", "))
+	})
+}
+
+// parseWeightedVoteOptions parses weighted vote options, using parseOption to
+// turn each option name into the option it votes for.
+func parseWeightedVoteOptions(options string, parseOption func(name string) (types.WeightedVoteOption, error)) (types.WeightedVoteOptions, error) {
+	var (
+		weightedOptions types.WeightedVoteOptions
+		form            voteWeightForm
+		sum             = sdk.ZeroDec()
+	)
+
+	usedOptions := make(map[types.WeightedVoteOption]bool)
+	for i, option := range strings.Split(options, ",") {
+		fields := strings.SplitN(option, "=", 2)
+		name := strings.TrimSpace(fields[0])
+		voteOption, err := parseOption(name)
+		if err != nil {
+			return nil, err
+		}
+		if usedOptions[voteOption] {
+			return nil, fmt.Errorf("duplicated vote option %s", name)
+		}
+		usedOptions[voteOption] = true
+
+		weightStr := "1"
+		if len(fields) == 2 {
+			weightStr = strings.TrimSpace(fields[1])
+		}
+		weight, weightForm, err := parseVoteWeight(weightStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for %s option: %w", name, err)
+		}
+		if !weight.IsPositive() {
+			return nil, fmt.Errorf("weight of %s option must be positive, got %s", name, weightStr)
+		}
+
+		switch {
+		case i == 0:
+			form = weightForm
+		case (weightForm == weightFormPercent) != (form == weightFormPercent):
+			return nil, fmt.Errorf("cannot mix percentages with other weights: %s", options)
+		case weightForm == weightFormDecimal && form == weightFormRelative:
+			// a decimal turns the integers seen so far into absolute weights
+			form = weightFormDecimal
+		}
+
+		sum = sum.Add(weight)
+		voteOption.Weight = weight
+		weightedOptions = append(weightedOptions, voteOption)
+	}
+
+	if form != weightFormRelative && sum.Sub(sdk.OneDec()).Abs().GT(WeightSumTolerance) {
+		if form == weightFormPercent {
+			return nil, fmt.Errorf("weights must sum to 100%%, got %s%%", sum.MulInt64(100))
+		}
+		return nil, fmt.Errorf("weights must sum to 1, got %s", sum)
+	}
+
+	return normalizeWeights(weightedOptions, sum), nil
+}
+
+// parseVoteWeight parses a vote weight and returns it along with its form. A
+// percentage is returned as a fraction of 1.
+func parseVoteWeight(weight string) (sdk.Dec, voteWeightForm, error) {
+	if percent := strings.TrimSuffix(weight, "%"); percent != weight {
+		dec, err := sdk.NewDecFromStr(percent)
+		if err != nil {
+			return sdk.Dec{}, weightFormPercent, err
+		}
+		return dec.QuoInt64(100), weightFormPercent, nil
+	}
+
+	if fraction := strings.SplitN(weight, "/", 2); len(fraction) == 2 {
+		numerator, err := sdk.NewDecFromStr(fraction[0])
+		if err != nil {
+			return sdk.Dec{}, weightFormDecimal, err
+		}
+		denominator, err := sdk.NewDecFromStr(fraction[1])
+		if err != nil {
+			return sdk.Dec{}, weightFormDecimal, err
+		}
+		if !denominator.IsPositive() {
+			return sdk.Dec{}, weightFormDecimal, fmt.Errorf("denominator must be positive, got %s", fraction[1])
+		}
+		return numerator.Quo(denominator), weightFormDecimal, nil
+	}
+
+	if i, ok := sdk.NewIntFromString(weight); ok {
+		return i.ToDec(), weightFormRelative, nil
+	}
+
+	dec, err := sdk.NewDecFromStr(weight)
+	return dec, weightFormDecimal, err
+}
+
+// normalizeWeights divides the weights by their sum. The rounding remainder
+// goes to the heaviest option so that the weights sum to exactly 1.
+func normalizeWeights(options types.WeightedVoteOptions, sum sdk.Dec) types.WeightedVoteOptions {
+	total := sdk.ZeroDec()
+	heaviest := 0
+	for i := range options {
+		options[i].Weight = options[i].Weight.Quo(sum)
+		total = total.Add(options[i].Weight)
+		if options[i].Weight.GT(options[heaviest].Weight) {
+			heaviest = i
+		}
+	}
+
+	options[heaviest].Weight = options[heaviest].Weight.Add(sdk.OneDec().Sub(total))
+	return options
+}
+
 // NormalizeProposalType - normalize user specified proposal type.
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -62,6 +221,8 @@ func NormalizeProposalStatus(status string) string {
 		return types.StatusPassed.String()
 	case "Rejected", "rejected":
 		return types.StatusRejected.String()
+	case "Cancelled", "cancelled":
+		return types.StatusCancelled.String()
 	default:
 		return status
 	}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestNormalizeWeightedVoteOptions(t *testing.T) {
@@ -57,6 +59,119 @@ func TestNormalizeWeightedVoteOptions(t *testing.T) {
 	}
 }
 
+func TestParseWeightedVoteOptions(t *testing.T) {
+	yes, no, abstain, veto := types.OptionYes, types.OptionNo, types.OptionAbstain, types.OptionNoWithVeto
+	weighted := func(options ...interface{}) types.WeightedVoteOptions {
+		res := types.WeightedVoteOptions{}
+		for i := 0; i < len(options); i += 2 {
+			res = append(res, types.WeightedVoteOption{
+				Option: options[i].(types.VoteOption),
+				Weight: sdk.MustNewDecFromStr(options[i+1].(string)),
+			})
+		}
+		return res
+	}
+
+	cases := map[string]struct {
+		options  string
+		expected types.WeightedVoteOptions
+		expErr   string
+	}{
+		"single option":                {options: "yes", expected: weighted(yes, "1")},
+		"single weighted option":       {options: "Yes=1", expected: weighted(yes, "1")},
+		"decimals":                     {options: "yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05", expected: weighted(yes, "0.6", no, "0.3", abstain, "0.05", veto, "0.05")},
+		"decimals with spaces":         {options: "yes = 0.6, no = 0.4", expected: weighted(yes, "0.6", no, "0.4")},
+		"decimals within tolerance":    {options: "yes=0.33,no=0.33,abstain=0.33", expected: weighted(yes, "0.333333333333333334", no, "0.333333333333333333", abstain, "0.333333333333333333")},
+		"fractions":                    {options: "yes=2/3,no=1/3", expected: weighted(yes, "0.666666666666666667", no, "0.333333333333333333")},
+		"relative weights":             {options: "yes=2,no=1", expected: weighted(yes, "0.666666666666666667", no, "0.333333333333333333")},
+		"relative weights rounded":     {options: "yes=1,no=1,abstain=1", expected: weighted(yes, "0.333333333333333334", no, "0.333333333333333333", abstain, "0.333333333333333333")},
+		"options without weight":       {options: "yes,no", expected: weighted(yes, "0.5", no, "0.5")},
+		"percentages":                  {options: "yes=60%,abstain=40%", expected: weighted(yes, "0.6", abstain, "0.4")},
+		"percentages within tolerance": {options: "yes=33.3%,no=33.3%,NoWithVeto=33.3%", expected: weighted(yes, "0.333333333333333334", no, "0.333333333333333333", veto, "0.333333333333333333")},
+		"zero relative weight":         {options: "yes=1,no=0", expErr: "must be positive"},
+		"empty options":                {options: "", expErr: "is not a valid vote option"},
+		"unknown option":               {options: "yessss=1", expErr: "is not a valid vote option"},
+		"duplicated option":            {options: "yes=0.5,Yes=0.5", expErr: "duplicated vote option"},
+		"invalid weight":               {options: "yes=abc", expErr: "invalid weight"},
+		"zero weight":                  {options: "yes=1,no=0.0", expErr: "must be positive"},
+		"negative weight":              {options: "yes=1.1,no=-0.1", expErr: "must be positive"},
+		"zero denominator":             {options: "yes=1/0", expErr: "denominator must be positive"},
+		"decimals sum too low":         {options: "yes=0.5,no=0.3", expErr: "weights must sum to 1, got 0.8"},
+		"decimals sum too high":        {options: "yes=0.6,no=0.5", expErr: "weights must sum to 1, got 1.1"},
+		"decimal and integer":          {options: "yes=0.5,no=1", expErr: "weights must sum to 1, got 1.5"},
+		"percentages sum too low":      {options: "yes=60%,no=30%", expErr: "weights must sum to 100%, got 90"},
+		"percentage and decimal":       {options: "yes=60%,no=0.4", expErr: "cannot mix percentages"},
+		"decimal and percentage":       {options: "yes=0.6,no=40%", expErr: "cannot mix percentages"},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			options, err := utils.ParseWeightedVoteOptions(tc.options)
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.String(), options.String())
+
+			// the parsed options must pass the strict on-chain validation
+			msg := types.NewMsgVoteWeighted(sdk.AccAddress("voter"), 1, options)
+			require.NoError(t, msg.ValidateBasic())
+		})
+	}
+}
+
+func TestParseMultipleChoiceWeightedVoteOptions(t *testing.T) {
+	voteOptions := []string{"red", "green", "blue"}
+	weighted := func(options ...interface{}) types.WeightedVoteOptions {
+		res := types.WeightedVoteOptions{}
+		for i := 0; i < len(options); i += 2 {
+			res = append(res, types.WeightedVoteOption{
+				OptionIndex: options[i].(uint32),
+				Weight:      sdk.MustNewDecFromStr(options[i+1].(string)),
+			})
+		}
+		return res
+	}
+
+	cases := map[string]struct {
+		options  string
+		expected types.WeightedVoteOptions
+		expErr   string
+	}{
+		"single option":      {options: "green", expected: weighted(uint32(1), "1")},
+		"decimals":           {options: "red=0.6,blue=0.4", expected: weighted(uint32(0), "0.6", uint32(2), "0.4")},
+		"percentages":        {options: "blue=75%,green=25%", expected: weighted(uint32(2), "0.75", uint32(1), "0.25")},
+		"relative weights":   {options: "red=1,green=1,blue=2", expected: weighted(uint32(0), "0.25", uint32(1), "0.25", uint32(2), "0.5")},
+		"standard option":    {options: "yes", expErr: "is not a vote option of the proposal"},
+		"unknown option":     {options: "red=0.5,purple=0.5", expErr: "is not a vote option of the proposal"},
+		"options are exact":  {options: "Red", expErr: "is not a vote option of the proposal"},
+		"duplicated option":  {options: "red=0.5,red=0.5", expErr: "duplicated vote option red"},
+		"decimals sum wrong": {options: "red=0.5,blue=0.3", expErr: "weights must sum to 1, got 0.8"},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			options, err := utils.ParseMultipleChoiceWeightedVoteOptions(tc.options, voteOptions)
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.String(), options.String())
+
+			msg := types.NewMsgVoteWeighted(sdk.AccAddress("voter"), 1, options)
+			require.NoError(t, msg.ValidateBasic())
+		})
+	}
+}
+
 func TestNormalizeProposalStatus(t *testing.T) {
 	type args struct {
 		status string
@@ -75,6 +190,8 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		{"Passed", args{"Passed"}, "PROPOSAL_STATUS_PASSED"},
 		{"Rejected", args{"Rejected"}, "PROPOSAL_STATUS_REJECTED"},
 		{"rejected", args{"rejected"}, "PROPOSAL_STATUS_REJECTED"},
+		{"Cancelled", args{"Cancelled"}, "PROPOSAL_STATUS_CANCELLED"},
+		{"cancelled", args{"cancelled"}, "PROPOSAL_STATUS_CANCELLED"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
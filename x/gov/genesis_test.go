@@ -34,19 +34,21 @@ func TestImportExportQueues(t *testing.T) {
 
 	ctx = app.BaseApp.NewContext(false, tmproto.Header{})
 
-	// Create two proposals, put the second into the voting period
+	// Create two proposals, the first one with metadata, put the second into
+	// the voting period and vote on it
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, addrs[0], "ipfs://CID", false, nil)
 	require.NoError(t, err)
 	proposalID1 := proposal1.ProposalId
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, addrs[0], "", false, nil)
 	require.NoError(t, err)
 	proposalID2 := proposal2.ProposalId
 
-	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID2, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID2, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit, "")
 	require.NoError(t, err)
 	require.True(t, votingStarted)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID2, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), "rationale"))
 
 	proposal1, ok := app.GovKeeper.GetProposal(ctx, proposalID1)
 	require.True(t, ok)
@@ -105,6 +107,13 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	// Make sure that the metadata is preserved, empty metadata included
+	require.Equal(t, "ipfs://CID", proposal1.Metadata)
+	require.Empty(t, proposal2.Metadata)
+	vote, found := app2.GovKeeper.GetVote(ctx2, proposalID2, addrs[1])
+	require.True(t, found)
+	require.Equal(t, "rationale", vote.Metadata)
+
 	macc := app2.GovKeeper.GetGovernanceAccount(ctx2)
 	require.Equal(t, app2.GovKeeper.GetDepositParams(ctx2).MinDeposit, app2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
@@ -119,6 +128,45 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal2.Status == types.StatusRejected)
 }
 
+func TestImportExportDepositRecords(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	// refund the deposits on a first proposal, burn those on a second one
+	var proposalIDs []uint64
+	for _, settle := range []func(sdk.Context, uint64){app.GovKeeper.RefundAndDeleteDeposits, app.GovKeeper.DeleteAndBurnDeposits} {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+		require.NoError(t, err)
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], amount, "")
+		require.NoError(t, err)
+		settle(ctx, proposal.ProposalId)
+		proposalIDs = append(proposalIDs, proposal.ProposalId)
+	}
+
+	// only the settled records are exported, active ones follow the deposits
+	govGenState := gov.ExportGenesis(ctx, app.GovKeeper)
+	require.Empty(t, govGenState.Deposits)
+	require.Equal(t, types.DepositRecords{
+		types.NewDepositRecord(types.NewDeposit(proposalIDs[0], addrs[0], amount), types.DepositStatusRefunded, 10),
+		types.NewDepositRecord(types.NewDeposit(proposalIDs[1], addrs[0], amount), types.DepositStatusBurned, 10),
+	}, govGenState.DepositRecords)
+	require.NoError(t, types.ValidateGenesis(govGenState))
+
+	app2 := simapp.Setup(t, false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{Height: 20})
+	gov.InitGenesis(ctx2, app2.AccountKeeper, app2.BankKeeper, app2.GovKeeper, govGenState)
+	require.True(t, govGenState.Equal(*gov.ExportGenesis(ctx2, app2.GovKeeper)))
+
+	// the imported records are pruned when their retention period ends
+	pruneHeight := 10 + int64(govGenState.DepositParams.DepositRecordRetention)
+	app2.GovKeeper.PruneDepositRecords(ctx2.WithBlockHeight(pruneHeight - 1))
+	require.Len(t, app2.GovKeeper.GetAllSettledDepositRecords(ctx2), 2)
+	app2.GovKeeper.PruneDepositRecords(ctx2.WithBlockHeight(pruneHeight))
+	require.Empty(t, app2.GovKeeper.GetAllSettledDepositRecords(ctx2))
+}
+
 func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -152,10 +200,10 @@ func TestEqualProposals(t *testing.T) {
 
 	// Submit two proposals
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, addrs[0], "", false, nil)
 	require.NoError(t, err)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, addrs[0], "", false, nil)
 	require.NoError(t, err)
 
 	// They are similar but their IDs should be different
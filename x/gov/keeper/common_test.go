@@ -15,6 +15,7 @@ import (
 
 var (
 	TestProposal = types.NewTextProposal("Test", "description")
+	TestProposer = sdk.AccAddress("test_proposer_______")
 )
 
 func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers []int64) ([]sdk.AccAddress, []sdk.ValAddress) {
@@ -31,6 +31,107 @@ func (keeper Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
 	}
 
 	store.Set(types.DepositKey(deposit.ProposalId, depositor), bz)
+	keeper.setDepositRecord(ctx, depositor, types.NewDepositRecord(deposit, types.DepositStatusActive, 0))
+}
+
+// GetDepositRecord gets the record of a specific depositor's deposit on a
+// specific proposal
+func (keeper Keeper) GetDepositRecord(ctx sdk.Context, depositorAddr sdk.AccAddress, proposalID uint64) (record types.DepositRecord, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DepositRecordKey(depositorAddr, proposalID))
+	if bz == nil {
+		return record, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetDepositRecord sets a settled deposit record to the gov store and
+// schedules its pruning once the deposit record retention has elapsed.
+func (keeper Keeper) SetDepositRecord(ctx sdk.Context, record types.DepositRecord) {
+	depositor, err := sdk.AccAddressFromBech32(record.Depositor)
+	if err != nil {
+		panic(err)
+	}
+
+	keeper.setDepositRecord(ctx, depositor, record)
+
+	pruneHeight := record.SettledHeight + int64(keeper.GetDepositParams(ctx).DepositRecordRetention)
+	ctx.KVStore(keeper.storeKey).Set(types.DepositRecordPruneQueueKey(pruneHeight, record.ProposalId, depositor), []byte{})
+}
+
+func (keeper Keeper) setDepositRecord(ctx sdk.Context, depositor sdk.AccAddress, record types.DepositRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&record)
+	store.Set(types.DepositRecordKey(depositor, record.ProposalId), bz)
+}
+
+// settleDeposit deletes a deposit and records it as settled with the given
+// status at the current height.
+func (keeper Keeper) settleDeposit(ctx sdk.Context, deposit types.Deposit, depositor sdk.AccAddress, status types.DepositStatus) {
+	ctx.KVStore(keeper.storeKey).Delete(types.DepositKey(deposit.ProposalId, depositor))
+	keeper.SetDepositRecord(ctx, types.NewDepositRecord(deposit, status, ctx.BlockHeight()))
+}
+
+// GetAllSettledDepositRecords returns all the records of refunded or burned
+// deposits that are not pruned yet
+func (keeper Keeper) GetAllSettledDepositRecords(ctx sdk.Context) (records types.DepositRecords) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositRecordsKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DepositRecord
+
+		keeper.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if record.IsSettled() {
+			records = append(records, record)
+		}
+	}
+
+	return
+}
+
+// IterateDepositorDeposits iterates over the deposit records of a depositor,
+// ordered by proposal ID, and performs a callback function
+func (keeper Keeper) IterateDepositorDeposits(ctx sdk.Context, depositorAddr sdk.AccAddress, cb func(record types.DepositRecord) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositorDepositsKey(depositorAddr))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DepositRecord
+
+		keeper.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// PruneDepositRecords deletes the settled deposit records whose retention
+// period ended at or before the current block height.
+func (keeper Keeper) PruneDepositRecords(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.DepositRecordPruneQueuePrefix, sdk.PrefixEndBytes(types.DepositRecordPruneByHeightKey(ctx.BlockHeight())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		_, proposalID, depositor := types.SplitDepositRecordPruneQueueKey(key)
+		store.Delete(types.DepositRecordKey(depositor, proposalID))
+		store.Delete(key)
+	}
 }
 
 // GetAllDeposits returns all the deposits from the store
@@ -55,8 +156,6 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits t
 
 // DeleteAndBurnDeposits deletes and burn all the deposits on a specific proposal.
 func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
 		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
 		if err != nil {
@@ -67,7 +166,7 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 		if err != nil {
 			panic(err)
 		}
-		store.Delete(types.DepositKey(proposalID, depositor))
+		keeper.settleDeposit(ctx, deposit, depositor, types.DepositStatusBurned)
 		return false
 	})
 }
@@ -110,7 +209,7 @@ func (keeper Keeper) IterateDeposits(ctx sdk.Context, proposalID uint64, cb func
 
 // AddDeposit adds or updates a deposit of a specific depositor on a specific proposal
 // Activates voting period when appropriate
-func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (bool, error) {
+func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins, metadata string) (bool, error) {
 	// Checks to see if proposal exists
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
@@ -122,6 +221,10 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	if err := keeper.assertMetadataLength(ctx, metadata); err != nil {
+		return false, err
+	}
+
 	// update the governance module's account coins pool
 	err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
 	if err != nil {
@@ -135,7 +238,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	minDeposit := keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited)
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(minDeposit) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -158,6 +262,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 			types.EventTypeProposalDeposit,
 			sdk.NewAttribute(sdk.AttributeKeyAmount, depositAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyMetadata, metadata),
 		),
 	)
 
@@ -166,10 +271,58 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
+// ChargeAndRefundDeposits deletes all the deposits on a specific proposal,
+// charging the given fee ratio of each of them and refunding the rest. The
+// charged fee is burned or sent to the community pool and returned.
+func (keeper Keeper) ChargeAndRefundDeposits(ctx sdk.Context, proposalID uint64, feeRatio sdk.Dec, burnFee bool) (sdk.Coins, error) {
+	fee := sdk.NewCoins()
+
+	var err error
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		depositor, e := sdk.AccAddressFromBech32(deposit.Depositor)
+		if e != nil {
+			panic(e)
+		}
+
+		depositFee := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			amount := coin.Amount.ToDec().Mul(feeRatio).TruncateInt()
+			depositFee = depositFee.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+
+		refund := deposit.Amount.Sub(depositFee)
+		if !refund.IsZero() {
+			if err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund); err != nil {
+				return true
+			}
+		}
+
+		fee = fee.Add(depositFee...)
+		keeper.settleDeposit(ctx, deposit, depositor, types.DepositStatusRefunded)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	if burnFee {
+		err = keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+	} else {
+		err = keeper.dk.FundCommunityPool(ctx, fee, keeper.GetGovernanceAccount(ctx).GetAddress())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return fee, nil
+}
+
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
 		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
 		if err != nil {
@@ -181,7 +334,7 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 			panic(err)
 		}
 
-		store.Delete(types.DepositKey(proposalID, depositor))
+		keeper.settleDeposit(ctx, deposit, depositor, types.DepositStatusRefunded)
 		return false
 	})
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -18,7 +20,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, TestAddrs[0], "", false, nil)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 
@@ -38,7 +40,7 @@ func TestDeposits(t *testing.T) {
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
 
 	// Check first deposit
-	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake, "")
 	require.NoError(t, err)
 	require.False(t, votingStarted)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
@@ -51,7 +53,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 
 	// Check a second deposit from same address
-	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fiveStake)
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fiveStake, "")
 	require.NoError(t, err)
 	require.False(t, votingStarted)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
@@ -64,7 +66,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial.Sub(fourStake).Sub(fiveStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 
 	// Check third deposit from a new address
-	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake)
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake, "")
 	require.NoError(t, err)
 	require.True(t, votingStarted)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
@@ -102,13 +104,139 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, TestAddrs[0], "", false, nil)
 	require.NoError(t, err)
 	proposalID = proposal.ProposalId
-	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake, "")
 	require.NoError(t, err)
 	app.GovKeeper.DeleteAndBurnDeposits(ctx, proposalID)
 	deposits = app.GovKeeper.GetDeposits(ctx, proposalID)
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestExpeditedDeposits(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, depositParams.ExpeditedMinDeposit.AmountOf(sdk.DefaultBondDenom))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, TestAddrs[0], "", true, nil)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+
+	// the regular minimum deposit does not activate an expedited proposal
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], depositParams.MinDeposit, "")
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	remaining := depositParams.ExpeditedMinDeposit.Sub(depositParams.MinDeposit)
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], remaining, "")
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, depositParams.ExpeditedMinDeposit, proposal.TotalDeposit)
+	require.Equal(t, ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod), proposal.VotingEndTime)
+}
+
+func TestDepositMetadata(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+	maxMetadataLen := int(app.GovKeeper.GetDepositParams(ctx).MaxMetadataLen)
+	oneStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 1)))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+	require.NoError(t, err)
+
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], oneStake, strings.Repeat("a", maxMetadataLen+1))
+	require.ErrorIs(t, err, types.ErrMetadataTooLong)
+	_, found := app.GovKeeper.GetDeposit(ctx, proposal.ProposalId, addrs[0])
+	require.False(t, found)
+
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], oneStake, strings.Repeat("a", maxMetadataLen))
+	require.NoError(t, err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], oneStake, "")
+	require.NoError(t, err)
+	deposit, found := app.GovKeeper.GetDeposit(ctx, proposal.ProposalId, addrs[0])
+	require.True(t, found)
+	require.Equal(t, oneStake.Add(oneStake...), deposit.Amount)
+}
+
+func TestDepositRecords(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(100000000))
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
+	retention := int64(app.GovKeeper.GetDepositParams(ctx).DepositRecordRetention)
+
+	// refunded, burned and cancelled proposals, all deposited on by both addresses
+	settle := []struct {
+		settle    func(proposalID uint64)
+		expStatus types.DepositStatus
+	}{
+		{func(proposalID uint64) { app.GovKeeper.RefundAndDeleteDeposits(ctx, proposalID) }, types.DepositStatusRefunded},
+		{func(proposalID uint64) { app.GovKeeper.DeleteAndBurnDeposits(ctx, proposalID) }, types.DepositStatusBurned},
+		{func(proposalID uint64) {
+			_, err := app.GovKeeper.ChargeAndRefundDeposits(ctx, proposalID, sdk.NewDecWithPrec(5, 1), true)
+			require.NoError(t, err)
+		}, types.DepositStatusRefunded},
+	}
+
+	var proposalIDs []uint64
+	for range settle {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, TestAddrs[0], "", false, nil)
+		require.NoError(t, err)
+		for _, addr := range TestAddrs {
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addr, fourStake, "")
+			require.NoError(t, err)
+		}
+		proposalIDs = append(proposalIDs, proposal.ProposalId)
+	}
+
+	// every deposit is recorded as active for its depositor
+	for _, addr := range TestAddrs {
+		var records types.DepositRecords
+		app.GovKeeper.IterateDepositorDeposits(ctx, addr, func(record types.DepositRecord) bool {
+			records = append(records, record)
+			return false
+		})
+		require.Len(t, records, len(proposalIDs))
+		for i, record := range records {
+			require.Equal(t, proposalIDs[i], record.ProposalId)
+			require.Equal(t, addr.String(), record.Depositor)
+			require.Equal(t, fourStake, record.Amount)
+			require.Equal(t, types.DepositStatusActive, record.Status)
+			require.False(t, record.IsSettled())
+		}
+	}
+	require.Empty(t, app.GovKeeper.GetAllSettledDepositRecords(ctx))
+
+	// settling the deposits records their outcome and the settlement height,
+	// along with the full deposited amount even if a cancellation fee was charged
+	for i, tc := range settle {
+		tc.settle(proposalIDs[i])
+		for _, addr := range TestAddrs {
+			record, found := app.GovKeeper.GetDepositRecord(ctx, addr, proposalIDs[i])
+			require.True(t, found)
+			require.Equal(t, tc.expStatus, record.Status)
+			require.Equal(t, fourStake, record.Amount)
+			require.Equal(t, ctx.BlockHeight(), record.SettledHeight)
+		}
+	}
+	require.Len(t, app.GovKeeper.GetAllSettledDepositRecords(ctx), len(proposalIDs)*len(TestAddrs))
+
+	// records are kept until the retention period ends
+	app.GovKeeper.PruneDepositRecords(ctx.WithBlockHeight(ctx.BlockHeight() + retention - 1))
+	require.Len(t, app.GovKeeper.GetAllSettledDepositRecords(ctx), len(proposalIDs)*len(TestAddrs))
+
+	app.GovKeeper.PruneDepositRecords(ctx.WithBlockHeight(ctx.BlockHeight() + retention))
+	require.Empty(t, app.GovKeeper.GetAllSettledDepositRecords(ctx))
+	_, found := app.GovKeeper.GetDepositRecord(ctx, TestAddrs[0], proposalIDs[0])
+	require.False(t, found)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -155,6 +156,45 @@ func (q Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.Q
 	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
 }
 
+// VoterVotes returns the votes cast by a voter across proposals
+func (q Keeper) VoterVotes(c context.Context, req *types.QueryVoterVotesRequest) (*types.QueryVoterVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var votes types.Votes
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	voterVotesStore := prefix.NewStore(store, types.VoterVotesKey(voter))
+
+	pageRes, err := query.Paginate(voterVotesStore, req.Pagination, func(key []byte, _ []byte) error {
+		proposalID := types.GetProposalIDFromBytes(key)
+		vote, found := q.GetVote(ctx, proposalID, voter)
+		if !found {
+			return fmt.Errorf("vote of %s on proposal %d is indexed but not stored", req.Voter, proposalID)
+		}
+
+		votes = append(votes, vote)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVoterVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
 // Params queries all params
 func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -244,6 +284,45 @@ func (q Keeper) Deposits(c context.Context, req *types.QueryDepositsRequest) (*t
 	return &types.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
+// DepositsByDepositor returns the records of a depositor's deposits across
+// proposals, including refunded or burned ones that are not pruned yet
+func (q Keeper) DepositsByDepositor(c context.Context, req *types.QueryDepositsByDepositorRequest) (*types.QueryDepositsByDepositorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Depositor == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty depositor address")
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(req.Depositor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var records types.DepositRecords
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	depositorStore := prefix.NewStore(store, types.DepositorDepositsKey(depositor))
+
+	pageRes, err := query.Paginate(depositorStore, req.Pagination, func(_ []byte, value []byte) error {
+		var record types.DepositRecord
+		if err := q.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositsByDepositorResponse{Deposits: records, Pagination: pageRes}, nil
+}
+
 // TallyResult queries the tally of a proposal vote
 func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultRequest) (*types.QueryTallyResultResponse, error) {
 	if req == nil {
@@ -261,19 +340,33 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
 	}
 
-	var tallyResult types.TallyResult
+	var (
+		tallyResult      types.TallyResult
+		validatorTallies []types.ValidatorTally
+	)
+	weightedTally := types.EmptyTallyResult()
 
 	switch {
 	case proposal.Status == types.StatusDepositPeriod:
-		tallyResult = types.EmptyTallyResult()
+		tallyResult = newOptionTally(proposal.VoteOptions).tallyResult()
 
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
+	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected ||
+		proposal.Status == types.StatusCancelled:
 		tallyResult = proposal.FinalTallyResult
 
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = q.Tally(ctx, proposal)
+		tally := q.tallyVotes(ctx, proposal, req.Detailed)
+		tallyResult = tally.results.tallyResult()
+		weightedTally = tally.splitResults.tallyResult()
+		if req.Detailed {
+			validatorTallies = tally.validatorTallies()
+		}
 	}
 
-	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
+	return &types.QueryTallyResultResponse{
+		Tally:            tallyResult,
+		WeightedTally:    weightedTally,
+		ValidatorTallies: validatorTallies,
+	}, nil
 }
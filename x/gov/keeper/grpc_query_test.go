@@ -50,7 +50,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 			func() {
 				req = &types.QueryProposalRequest{ProposalId: 1}
 				testProposal := types.NewTextProposal("Proposal", "testing proposal")
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, suite.addrs[0], "", false, nil)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -106,7 +106,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 				for i := 0; i < 5; i++ {
 					num := strconv.Itoa(i + 1)
 					testProposal := types.NewTextProposal("Proposal"+num, "testing proposal "+num)
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, suite.addrs[0], "", false, nil)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, proposal)
@@ -183,7 +183,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 			func() {
 				testProposals[1].Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, testProposals[1])
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, testProposals[1].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, testProposals[1].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
 
 				req = &types.QueryProposalsRequest{
 					Voter: addrs[0].String(),
@@ -274,7 +274,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, suite.addrs[0], "", false, nil)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVoteRequest{
@@ -291,7 +291,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			func() {
 				proposal.Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
 
 				req = &types.QueryVoteRequest{
 					ProposalId: proposal.ProposalId,
@@ -379,7 +379,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, suite.addrs[0], "", false, nil)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVotesRequest{
@@ -402,8 +402,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 				accAddr2, err2 := sdk.AccAddressFromBech32(votes[1].Voter)
 				suite.Require().NoError(err1)
 				suite.Require().NoError(err2)
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr1, votes[0].Options))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr2, votes[1].Options))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr1, votes[0].Options, ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr2, votes[1].Options, ""))
 
 				req = &types.QueryVotesRequest{
 					ProposalId: proposal.ProposalId,
@@ -434,6 +434,99 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryVoterVotes() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	var (
+		req   *types.QueryVoterVotesRequest
+		votes []types.Vote
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		expVotes func() []types.Vote
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryVoterVotesRequest{}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid voter address",
+			func() {
+				req = &types.QueryVoterVotesRequest{Voter: "invalid"}
+			},
+			false,
+			nil,
+		},
+		{
+			"voter without votes",
+			func() {
+				req = &types.QueryVoterVotesRequest{Voter: addrs[0].String()}
+			},
+			true,
+			func() []types.Vote { return nil },
+		},
+		{
+			"votes on several proposals",
+			func() {
+				votes = nil
+				for i := 0; i < 3; i++ {
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, suite.addrs[0], "", false, nil)
+					suite.Require().NoError(err)
+					proposal.Status = types.StatusVotingPeriod
+					app.GovKeeper.SetProposal(ctx, proposal)
+
+					options := types.NewNonSplitVoteOption(types.OptionYes)
+					suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], options, ""))
+					votes = append(votes, types.Vote{ProposalId: proposal.ProposalId, Voter: addrs[0].String(), Option: types.OptionYes, Options: options})
+
+					// votes of other voters are not returned
+					suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+				}
+
+				req = &types.QueryVoterVotesRequest{Voter: addrs[0].String()}
+			},
+			true,
+			func() []types.Vote { return votes },
+		},
+		{
+			"paginated",
+			func() {
+				req = &types.QueryVoterVotesRequest{
+					Voter:      addrs[0].String(),
+					Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+				}
+			},
+			true,
+			func() []types.Vote { return votes[1:2] },
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.VoterVotes(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(testCase.expVotes(), res.GetVotes())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 	queryClient := suite.queryClient
 
@@ -460,7 +553,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamDeposit}
 				expRes = &types.QueryParamsResponse{
 					DepositParams: types.DefaultDepositParams(),
-					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					VotingParams:  types.VotingParams{ProposalCancelMaxPeriod: sdk.NewDec(0)},
 				}
 			},
 			true,
@@ -470,8 +564,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
 				expRes = &types.QueryParamsResponse{
-					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					VotingParams:  types.DefaultVotingParams(),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					DepositParams: types.DepositParams{MinInitialDepositRatio: sdk.NewDec(0), ProposalCancelRatio: sdk.NewDec(0)},
 				}
 			},
 			true,
@@ -481,7 +576,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamTallying}
 				expRes = &types.QueryParamsResponse{
-					TallyParams: types.DefaultTallyParams(),
+					TallyParams:   types.DefaultTallyParams(),
+					DepositParams: types.DepositParams{MinInitialDepositRatio: sdk.NewDec(0), ProposalCancelRatio: sdk.NewDec(0)},
+					VotingParams:  types.VotingParams{ProposalCancelMaxPeriod: sdk.NewDec(0)},
 				}
 			},
 			true,
@@ -570,7 +667,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, suite.addrs[0], "", false, nil)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -659,7 +756,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, suite.addrs[0], "", false, nil)
 				suite.Require().NoError(err)
 
 				req = &types.QueryDepositsRequest{
@@ -710,6 +807,113 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryDepositsByDepositor() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	var (
+		req     *types.QueryDepositsByDepositorRequest
+		records []types.DepositRecord
+	)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expPass    bool
+		expRecords func() []types.DepositRecord
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryDepositsByDepositorRequest{}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid depositor address",
+			func() {
+				req = &types.QueryDepositsByDepositorRequest{Depositor: "invalid"}
+			},
+			false,
+			nil,
+		},
+		{
+			"depositor without deposits",
+			func() {
+				req = &types.QueryDepositsByDepositorRequest{Depositor: addrs[0].String()}
+			},
+			true,
+			func() []types.DepositRecord { return nil },
+		},
+		{
+			"active, refunded and burned deposits",
+			func() {
+				records = nil
+				settle := []func(proposalID uint64){
+					nil,
+					func(proposalID uint64) { app.GovKeeper.RefundAndDeleteDeposits(ctx, proposalID) },
+					func(proposalID uint64) { app.GovKeeper.DeleteAndBurnDeposits(ctx, proposalID) },
+				}
+				statuses := []types.DepositStatus{types.DepositStatusActive, types.DepositStatusRefunded, types.DepositStatusBurned}
+
+				for i, settleDeposits := range settle {
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, suite.addrs[0], "", false, nil)
+					suite.Require().NoError(err)
+
+					_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], amount, "")
+					suite.Require().NoError(err)
+					// deposits of other depositors are not returned
+					_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[1], amount, "")
+					suite.Require().NoError(err)
+
+					var settledHeight int64
+					if settleDeposits != nil {
+						settleDeposits(proposal.ProposalId)
+						settledHeight = ctx.BlockHeight()
+					}
+
+					deposit := types.NewDeposit(proposal.ProposalId, addrs[0], amount)
+					records = append(records, types.NewDepositRecord(deposit, statuses[i], settledHeight))
+				}
+
+				req = &types.QueryDepositsByDepositorRequest{Depositor: addrs[0].String()}
+			},
+			true,
+			func() []types.DepositRecord { return records },
+		},
+		{
+			"paginated",
+			func() {
+				req = &types.QueryDepositsByDepositorRequest{
+					Depositor:  addrs[0].String(),
+					Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+				}
+			},
+			true,
+			func() []types.DepositRecord { return records[1:2] },
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.DepositsByDepositor(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(testCase.expRecords(), res.GetDeposits())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 
@@ -751,14 +955,15 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, suite.addrs[0], "", false, nil)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
 				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
 
 				expRes = &types.QueryTallyResultResponse{
-					Tally: types.EmptyTallyResult(),
+					Tally:         types.EmptyTallyResult(),
+					WeightedTally: types.EmptyTallyResult(),
 				}
 			},
 			true,
@@ -769,9 +974,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 				proposal.Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
 
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
 
 				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
 
@@ -779,6 +984,31 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 					Tally: types.TallyResult{
 						Yes: sdk.NewInt(3 * 5 * 1000000),
 					},
+					WeightedTally: types.EmptyTallyResult(),
+				}
+			},
+			true,
+		},
+		{
+			"request tally after a weighted vote",
+			func() {
+				options := types.WeightedVoteOptions{
+					{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+					{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+				}
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], options, ""))
+
+				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
+
+				expRes = &types.QueryTallyResultResponse{
+					Tally: types.TallyResult{
+						Yes: sdk.NewInt(13 * 1000000),
+						No:  sdk.NewInt(2 * 1000000),
+					},
+					WeightedTally: types.TallyResult{
+						Yes: sdk.NewInt(3 * 1000000),
+						No:  sdk.NewInt(2 * 1000000),
+					},
 				}
 			},
 			true,
@@ -793,7 +1023,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
 
 				expRes = &types.QueryTallyResultResponse{
-					Tally: proposal.FinalTallyResult,
+					Tally:         proposal.FinalTallyResult,
+					WeightedTally: types.EmptyTallyResult(),
 				}
 			},
 			true,
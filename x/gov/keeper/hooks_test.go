@@ -16,31 +16,34 @@ import (
 
 var _ types.GovHooks = &MockGovHooksReceiver{}
 
-// GovHooks event hooks for governance proposal object (noalias)
+// MockGovHooksReceiver records the number of times each governance hook is
+// called.
 type MockGovHooksReceiver struct {
-	AfterProposalSubmissionValid        bool
-	AfterProposalDepositValid           bool
-	AfterProposalVoteValid              bool
-	AfterProposalFailedMinDepositValid  bool
-	AfterProposalVotingPeriodEndedValid bool
+	AfterProposalSubmissionCount        int
+	AfterProposalDepositCount           int
+	AfterProposalVoteCount              int
+	AfterProposalFailedMinDepositCount  int
+	AfterProposalVotingPeriodEndedCount int
 }
 
 func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
-	h.AfterProposalSubmissionValid = true
+	h.AfterProposalSubmissionCount++
 }
 
 func (h *MockGovHooksReceiver) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
-	h.AfterProposalDepositValid = true
+	h.AfterProposalDepositCount++
 }
 
 func (h *MockGovHooksReceiver) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
-	h.AfterProposalVoteValid = true
+	h.AfterProposalVoteCount++
 }
+
 func (h *MockGovHooksReceiver) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
-	h.AfterProposalFailedMinDepositValid = true
+	h.AfterProposalFailedMinDepositCount++
 }
+
 func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
-	h.AfterProposalVotingPeriodEndedValid = true
+	h.AfterProposalVotingPeriodEndedCount++
 }
 
 func TestHooks(t *testing.T) {
@@ -56,39 +59,111 @@ func TestHooks(t *testing.T) {
 		&app.GovKeeper, types.NewMultiGovHooks(&govHooksReceiver),
 	)
 
-	require.False(t, govHooksReceiver.AfterProposalSubmissionValid)
-	require.False(t, govHooksReceiver.AfterProposalDepositValid)
-	require.False(t, govHooksReceiver.AfterProposalVoteValid)
-	require.False(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
-	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.Equal(t, MockGovHooksReceiver{}, govHooksReceiver)
 
 	tp := TestProposal
-	_, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	p1, err := app.GovKeeper.SubmitProposal(ctx, tp, addrs[0], "", false, nil)
 	require.NoError(t, err)
-	require.True(t, govHooksReceiver.AfterProposalSubmissionValid)
+	require.Equal(t, MockGovHooksReceiver{AfterProposalSubmissionCount: 1}, govHooksReceiver)
 
+	// the proposal is deleted once its deposit period expires
 	newHeader := ctx.BlockHeader()
 	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(time.Duration(1) * time.Second)
 	ctx = ctx.WithBlockHeader(newHeader)
 	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, MockGovHooksReceiver{
+		AfterProposalSubmissionCount:       1,
+		AfterProposalFailedMinDepositCount: 1,
+	}, govHooksReceiver)
 
-	require.True(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
+	// a later block does not delete it again
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, 1, govHooksReceiver.AfterProposalFailedMinDepositCount)
 
-	p2, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	// failed deposits and votes do not call the hooks
+	_, err = app.GovKeeper.AddDeposit(ctx, p1.ProposalId, addrs[0], minDeposit, "")
+	require.Error(t, err)
+	err = app.GovKeeper.AddVote(ctx, p1.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
+	require.Error(t, err)
+	require.Equal(t, 0, govHooksReceiver.AfterProposalDepositCount)
+	require.Equal(t, 0, govHooksReceiver.AfterProposalVoteCount)
+
+	p2, err := app.GovKeeper.SubmitProposal(ctx, tp, addrs[0], "", false, nil)
 	require.NoError(t, err)
+	require.Equal(t, 2, govHooksReceiver.AfterProposalSubmissionCount)
 
-	activated, err := app.GovKeeper.AddDeposit(ctx, p2.ProposalId, addrs[0], minDeposit)
+	activated, err := app.GovKeeper.AddDeposit(ctx, p2.ProposalId, addrs[0], minDeposit, "")
 	require.True(t, activated)
 	require.NoError(t, err)
-	require.True(t, govHooksReceiver.AfterProposalDepositValid)
+	require.Equal(t, 1, govHooksReceiver.AfterProposalDepositCount)
 
-	err = app.GovKeeper.AddVote(ctx, p2.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	err = app.GovKeeper.AddVote(ctx, p2.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
 	require.NoError(t, err)
-	require.True(t, govHooksReceiver.AfterProposalVoteValid)
+	require.Equal(t, 1, govHooksReceiver.AfterProposalVoteCount)
 
 	newHeader = ctx.BlockHeader()
 	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod).Add(time.Duration(1) * time.Second)
 	ctx = ctx.WithBlockHeader(newHeader)
 	gov.EndBlocker(ctx, app.GovKeeper)
-	require.True(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, MockGovHooksReceiver{
+		AfterProposalSubmissionCount:        2,
+		AfterProposalDepositCount:           1,
+		AfterProposalVoteCount:              1,
+		AfterProposalFailedMinDepositCount:  1,
+		AfterProposalVotingPeriodEndedCount: 1,
+	}, govHooksReceiver)
+}
+
+func TestMultiGovHooks(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	first, second := MockGovHooksReceiver{}, MockGovHooksReceiver{}
+	keeper.UnsafeSetHooks(
+		&app.GovKeeper, types.NewMultiGovHooks(&first, &second),
+	)
+
+	_, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, TestProposer, "", false, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, MockGovHooksReceiver{AfterProposalSubmissionCount: 1}, first)
+	require.Equal(t, first, second)
+}
+
+func TestHooksExpeditedProposal(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	expeditedMinDeposit := app.GovKeeper.GetDepositParams(ctx).ExpeditedMinDeposit
+	addrs := simapp.AddTestAddrs(app, ctx, 1, expeditedMinDeposit[0].Amount)
+
+	govHooksReceiver := MockGovHooksReceiver{}
+	keeper.UnsafeSetHooks(
+		&app.GovKeeper, types.NewMultiGovHooks(&govHooksReceiver),
+	)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", true, nil)
+	require.NoError(t, err)
+	activated, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], expeditedMinDeposit, "")
+	require.NoError(t, err)
+	require.True(t, activated)
+
+	// without votes the expedited proposal fails and is converted to a regular one
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod).Add(time.Duration(1) * time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, 0, govHooksReceiver.AfterProposalVotingPeriodEndedCount)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, 1, govHooksReceiver.AfterProposalVotingPeriodEndedCount)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, types.StatusRejected, proposal.Status)
 }
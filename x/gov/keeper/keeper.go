@@ -24,6 +24,9 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The distribution keeper to fund the community pool with cancellation fees
+	dk types.DistributionKeeper
+
 	// GovHooks
 	hooks types.GovHooks
 
@@ -46,7 +49,8 @@ type Keeper struct {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace types.ParamSubspace,
-	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	dk types.DistributionKeeper, rtr types.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -65,6 +69,7 @@ func NewKeeper(
 		authKeeper: authKeeper,
 		bankKeeper: bankKeeper,
 		sk:         sk,
+		dk:         dk,
 		cdc:        cdc,
 		router:     rtr,
 	}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgSubmitProposalMinInitialDeposit() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.GovKeeper)
+
+	// fund the proposer with a second denom for the multi-denom cases
+	suite.Require().NoError(banktestutil.FundAccount(app.BankKeeper, ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("atom", 10000000))))
+
+	testCases := []struct {
+		name       string
+		minDeposit sdk.Coins
+		ratio      sdk.Dec
+		deposit    sdk.Coins
+		expedited  bool
+		expErr     bool
+	}{
+		{
+			name:       "zero ratio, no initial deposit",
+			minDeposit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000)),
+			ratio:      sdk.ZeroDec(),
+			deposit:    sdk.NewCoins(),
+		},
+		{
+			name:       "exactly the minimum initial deposit",
+			minDeposit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000)),
+			ratio:      sdk.NewDecWithPrec(5, 1),
+			deposit:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000000)),
+		},
+		{
+			name:       "just below the minimum initial deposit",
+			minDeposit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000)),
+			ratio:      sdk.NewDecWithPrec(5, 1),
+			deposit:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 4999999)),
+			expErr:     true,
+		},
+		{
+			name:       "minimum initial deposit is rounded up",
+			minDeposit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000001)),
+			ratio:      sdk.NewDecWithPrec(5, 1),
+			deposit:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000000)),
+			expErr:     true,
+		},
+		{
+			name:       "expedited proposal uses the expedited minimum deposit",
+			minDeposit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000)),
+			ratio:      sdk.NewDecWithPrec(5, 1),
+			deposit:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000000)),
+			expedited:  true,
+			expErr:     true,
+		},
+		{
+			name:       "multi-denom, all denoms covered",
+			minDeposit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000), sdk.NewInt64Coin("atom", 1000000)),
+			ratio:      sdk.NewDecWithPrec(5, 1),
+			deposit:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000000), sdk.NewInt64Coin("atom", 500000)),
+		},
+		{
+			name:       "multi-denom, one denom below",
+			minDeposit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000), sdk.NewInt64Coin("atom", 1000000)),
+			ratio:      sdk.NewDecWithPrec(5, 1),
+			deposit:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000000), sdk.NewInt64Coin("atom", 499999)),
+			expErr:     true,
+		},
+		{
+			name:       "multi-denom, one denom missing",
+			minDeposit: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000), sdk.NewInt64Coin("atom", 1000000)),
+			ratio:      sdk.NewDecWithPrec(5, 1),
+			deposit:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000000)),
+			expErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			depositParams.MinDeposit = tc.minDeposit
+			depositParams.MinInitialDepositRatio = tc.ratio
+			app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+			msg, err := types.NewMsgSubmitProposal(TestProposal, tc.deposit, addrs[0])
+			suite.Require().NoError(err)
+			msg.SetExpedited(tc.expedited)
+
+			_, err = msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrMinDepositTooSmall)
+				suite.Require().Contains(err.Error(), depositParams.GetMinInitialDeposit(tc.expedited).String())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
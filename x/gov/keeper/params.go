@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -40,3 +41,31 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// assertMetadataLength returns an error if the metadata is longer than the
+// max_metadata_len deposit param.
+func (keeper Keeper) assertMetadataLength(ctx sdk.Context, metadata string) error {
+	maxMetadataLen := keeper.GetDepositParams(ctx).MaxMetadataLen
+	if uint64(len(metadata)) > maxMetadataLen {
+		return sdkerrors.Wrapf(types.ErrMetadataTooLong, "got %d bytes, max %d", len(metadata), maxMetadataLen)
+	}
+
+	return nil
+}
+
+// assertVoteOptionsBounds returns an error if the vote options of a
+// multiple-choice proposal exceed the max_vote_options or max_vote_option_len
+// deposit params.
+func (keeper Keeper) assertVoteOptionsBounds(ctx sdk.Context, voteOptions []string) error {
+	depositParams := keeper.GetDepositParams(ctx)
+	if uint64(len(voteOptions)) > depositParams.MaxVoteOptions {
+		return sdkerrors.Wrapf(types.ErrInvalidVoteOptions, "got %d vote options, max %d", len(voteOptions), depositParams.MaxVoteOptions)
+	}
+	for _, option := range voteOptions {
+		if uint64(len(option)) > depositParams.MaxVoteOptionLen {
+			return sdkerrors.Wrapf(types.ErrInvalidVoteOptions, "vote option %q is %d bytes long, max %d", option, len(option), depositParams.MaxVoteOptionLen)
+		}
+	}
+
+	return nil
+}
@@ -9,8 +9,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content, its proposer, its
+// metadata, whether it is expedited and its vote options if it is a
+// multiple-choice proposal
+func (keeper Keeper) SubmitProposal(
+	ctx sdk.Context, content types.Content, proposer sdk.AccAddress, metadata string, expedited bool, voteOptions []string,
+) (types.Proposal, error) {
+	if err := keeper.assertMetadataLength(ctx, metadata); err != nil {
+		return types.Proposal{}, err
+	}
+	if err := types.ValidateMultipleChoiceProposal(content, expedited, voteOptions); err != nil {
+		return types.Proposal{}, err
+	}
+	if err := keeper.assertVoteOptionsBounds(ctx, voteOptions); err != nil {
+		return types.Proposal{}, err
+	}
+
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
@@ -36,6 +50,10 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	if err != nil {
 		return types.Proposal{}, err
 	}
+	proposal.Expedited = expedited
+	proposal.Metadata = metadata
+	proposal.Proposer = proposer.String()
+	proposal.VoteOptions = voteOptions
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -48,6 +66,8 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		sdk.NewEvent(
 			types.EventTypeSubmitProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyExpedited, fmt.Sprintf("%t", expedited)),
+			sdk.NewAttribute(types.AttributeKeyMetadata, metadata),
 		),
 	)
 
@@ -84,7 +104,7 @@ func (keeper Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
 	store.Set(types.ProposalKey(proposal.ProposalId), bz)
 }
 
-// DeleteProposal deletes a proposal from store.
+// DeleteProposal deletes a proposal and its votes from store.
 // Panics if the proposal doesn't exist.
 func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -95,6 +115,74 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
 	store.Delete(types.ProposalKey(proposalID))
+	keeper.deleteVotes(ctx, proposalID)
+}
+
+// CancelProposal cancels a proposal on behalf of its proposer, as long as it
+// is in deposit period or the ProposalCancelMaxPeriod fraction of its voting
+// period has not elapsed. Its votes are deleted and its deposits refunded minus
+// the cancellation fee, but the proposal itself is kept with the cancelled
+// status.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	switch proposal.Status {
+	case types.StatusDepositPeriod:
+		keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
+
+	case types.StatusVotingPeriod:
+		cutoff := keeper.GetVotingParams(ctx).GetProposalCancelCutoff(proposal.VotingStartTime, proposal.VotingEndTime)
+		if !ctx.BlockTime().Before(cutoff) {
+			return sdkerrors.Wrapf(types.ErrCancelTooLate, "proposal %d could be cancelled until %s", proposalID, cutoff)
+		}
+
+		keeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+		keeper.deleteVotes(ctx, proposalID)
+
+	default:
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	depositParams := keeper.GetDepositParams(ctx)
+	fee, err := keeper.ChargeAndRefundDeposits(ctx, proposalID, depositParams.ProposalCancelRatio, depositParams.ProposalCancelBurn)
+	if err != nil {
+		return err
+	}
+
+	proposal.Status = types.StatusCancelled
+	proposal.FinalTallyResult = types.EmptyTallyResult()
+	keeper.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposal.Proposer),
+			sdk.NewAttribute(types.AttributeKeyCancellationFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyCancellationFeeBurned, fmt.Sprintf("%t", depositParams.ProposalCancelBurn)),
+		),
+	)
+
+	return nil
+}
+
+// deleteVotes deletes all the votes on a proposal along with their voter index
+// entries.
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	for _, vote := range keeper.GetVotes(ctx, proposalID) {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+		keeper.deleteVote(ctx, proposalID, voter)
+	}
 }
 
 // IterateProposals iterates over the all the proposals and performs a callback function.
@@ -193,7 +281,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.Expedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
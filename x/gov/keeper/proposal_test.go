@@ -12,7 +12,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, suite.addrs[0], "", false, nil)
 	suite.Require().NoError(err)
 	proposalID := proposal.ProposalId
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -24,7 +24,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, suite.addrs[0], "", false, nil)
 	suite.Require().NoError(err)
 
 	suite.Require().True(proposal.VotingStartTime.Equal(time.Time{}))
@@ -64,11 +64,71 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 
 	for i, tc := range testCases {
-		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content)
+		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content, suite.addrs[0], "", false, nil)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalMetadata() {
+	maxMetadataLen := int(suite.app.GovKeeper.GetDepositParams(suite.ctx).MaxMetadataLen)
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, suite.addrs[0], strings.Repeat("a", maxMetadataLen), false, nil)
+	suite.Require().NoError(err)
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().Equal(strings.Repeat("a", maxMetadataLen), proposal.Metadata)
+
+	_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, suite.addrs[0], strings.Repeat("a", maxMetadataLen+1), false, nil)
+	suite.Require().ErrorIs(err, types.ErrMetadataTooLong)
+
+	// empty metadata is accepted even when no metadata is allowed
+	depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+	depositParams.MaxMetadataLen = 0
+	suite.app.GovKeeper.SetDepositParams(suite.ctx, depositParams)
+
+	_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, suite.addrs[0], "a", false, nil)
+	suite.Require().ErrorIs(err, types.ErrMetadataTooLong)
+	proposal, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, suite.addrs[0], "", false, nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(proposal.Metadata)
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalVoteOptions() {
+	depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+	depositParams.MaxVoteOptions = 3
+	depositParams.MaxVoteOptionLen = 5
+	suite.app.GovKeeper.SetDepositParams(suite.ctx, depositParams)
+
+	testCases := []struct {
+		name        string
+		expedited   bool
+		voteOptions []string
+		expectedErr error
+	}{
+		{"standard proposal", false, nil, nil},
+		{"multiple-choice", false, []string{"red", "green", "blue"}, nil},
+		{"too many options", false, []string{"red", "green", "blue", "black"}, types.ErrInvalidVoteOptions},
+		{"option too long", false, []string{"red", "yellow"}, types.ErrInvalidVoteOptions},
+		{"single option", false, []string{"red"}, types.ErrInvalidVoteOptions},
+		{"duplicated option", false, []string{"red", "red"}, types.ErrInvalidVoteOptions},
+		{"expedited", true, []string{"red", "blue"}, types.ErrInvalidVoteOptions},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, suite.addrs[0], "", tc.expedited, tc.voteOptions)
+		if tc.expectedErr != nil {
+			suite.Require().ErrorIs(err, tc.expectedErr, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+		proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+		suite.Require().True(ok, tc.name)
+		suite.Require().Equal(tc.voteOptions, proposal.VoteOptions, tc.name)
+		suite.Require().Equal(len(tc.voteOptions) > 0, proposal.IsMultipleChoice(), tc.name)
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []types.ProposalStatus{types.StatusDepositPeriod, types.StatusVotingPeriod}
@@ -125,3 +185,135 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+	suite.Require().NoError(err)
+	proposalID := proposal.ProposalId
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], deposit, "")
+	suite.Require().NoError(err)
+
+	// only the proposer can cancel
+	err = app.GovKeeper.CancelProposal(ctx, proposalID, addrs[1])
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+	err = app.GovKeeper.CancelProposal(ctx, proposalID+1, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrUnknownProposal)
+
+	balance := app.BankKeeper.GetBalance(ctx, addrs[0], bondDenom)
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom)
+
+	suite.Require().NoError(app.GovKeeper.CancelProposal(ctx, proposalID, addrs[0]))
+
+	// half of the deposit is refunded and the other half is burned with the default params
+	suite.Require().Equal(balance.AddAmount(sdk.NewInt(500)), app.BankKeeper.GetBalance(ctx, addrs[0], bondDenom))
+	suite.Require().Equal(supply.SubAmount(sdk.NewInt(500)), app.BankKeeper.GetSupply(ctx, bondDenom))
+	suite.Require().Empty(app.GovKeeper.GetDeposits(ctx, proposalID))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.StatusCancelled, proposal.Status)
+
+	inactiveIterator := app.GovKeeper.InactiveProposalQueueIterator(ctx, proposal.DepositEndTime)
+	suite.Require().False(inactiveIterator.Valid())
+	inactiveIterator.Close()
+
+	// a cancelled proposal can't be cancelled again, nor receive deposits or votes
+	err = app.GovKeeper.CancelProposal(ctx, proposalID, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrInactiveProposal)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], deposit, "")
+	suite.Require().ErrorIs(err, types.ErrInactiveProposal)
+	err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
+	suite.Require().ErrorIs(err, types.ErrInactiveProposal)
+
+	// cancelled proposals can be filtered by status
+	res, err := suite.queryClient.Proposals(sdk.WrapSDKContext(ctx), &types.QueryProposalsRequest{ProposalStatus: types.StatusCancelled})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 1)
+	suite.Require().Equal(proposalID, res.Proposals[0].ProposalId)
+}
+
+func (suite *KeeperTestSuite) TestCancelProposalCommunityPool() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.ProposalCancelRatio = sdk.NewDecWithPrec(25, 2)
+	depositParams.ProposalCancelBurn = false
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+	suite.Require().NoError(err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), "")
+	suite.Require().NoError(err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[1], sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 3)), "")
+	suite.Require().NoError(err)
+
+	balance0 := app.BankKeeper.GetBalance(ctx, addrs[0], bondDenom)
+	balance1 := app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom)
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom)
+	pool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	suite.Require().NoError(app.GovKeeper.CancelProposal(ctx, proposal.ProposalId, addrs[0]))
+
+	// the fee is truncated per deposit: 250 out of 1000 and 0 out of 3
+	suite.Require().Equal(balance0.AddAmount(sdk.NewInt(750)), app.BankKeeper.GetBalance(ctx, addrs[0], bondDenom))
+	suite.Require().Equal(balance1.AddAmount(sdk.NewInt(3)), app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom))
+	suite.Require().Equal(supply, app.BankKeeper.GetSupply(ctx, bondDenom))
+	suite.Require().Equal(pool.Add(sdk.NewInt64DecCoin(bondDenom, 250)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
+func (suite *KeeperTestSuite) TestCancelProposalVotingPeriodCutoff() {
+	votingParams := suite.app.GovKeeper.GetVotingParams(suite.ctx)
+	startTime := suite.ctx.BlockTime()
+	cutoff := votingParams.GetProposalCancelCutoff(startTime, startTime.Add(votingParams.VotingPeriod))
+	suite.Require().True(cutoff.After(startTime))
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		expErr    error
+	}{
+		{"at voting start", startTime, nil},
+		{"just before the cutoff", cutoff.Add(-time.Nanosecond), nil},
+		{"at the cutoff", cutoff, types.ErrCancelTooLate},
+		{"after the cutoff", cutoff.Add(time.Nanosecond), types.ErrCancelTooLate},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			app, addrs := suite.app, suite.addrs
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+			suite.Require().NoError(err)
+			votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit, "")
+			suite.Require().NoError(err)
+			suite.Require().True(votingStarted)
+			suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+
+			ctx = ctx.WithBlockTime(tc.blockTime)
+			err = app.GovKeeper.CancelProposal(ctx, proposal.ProposalId, addrs[0])
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			suite.Require().True(ok)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(types.StatusVotingPeriod, proposal.Status)
+				suite.Require().Len(app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 1)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.StatusCancelled, proposal.Status)
+			suite.Require().Empty(app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+
+			activeIterator := app.GovKeeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+			suite.Require().False(activeIterator.Valid())
+			activeIterator.Close()
+		})
+	}
+}
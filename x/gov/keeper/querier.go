@@ -167,14 +167,15 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 
 	switch {
 	case proposal.Status == types.StatusDepositPeriod:
-		tallyResult = types.EmptyTallyResult()
+		tallyResult = newOptionTally(proposal.VoteOptions).tallyResult()
 
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
+	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected ||
+		proposal.Status == types.StatusCancelled:
 		tallyResult = proposal.FinalTallyResult
 
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = keeper.Tally(ctx, proposal)
+		_, _, _, tallyResult = keeper.Tally(ctx, proposal)
 	}
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, tallyResult)
@@ -6,23 +6,99 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
-
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
-func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
-	results := make(map[types.VoteOption]sdk.Dec)
-	results[types.OptionYes] = sdk.ZeroDec()
-	results[types.OptionAbstain] = sdk.ZeroDec()
-	results[types.OptionNo] = sdk.ZeroDec()
-	results[types.OptionNoWithVeto] = sdk.ZeroDec()
-
-	totalVotingPower := sdk.ZeroDec()
+// voters. Whether the deposits are burned depends on the deposit params, and depositsReason is the
+// AttributeValueDepositsReason* value explaining it.
+func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, depositsReason string, tallyResults types.TallyResult) {
+	tally := keeper.tallyVotes(ctx, proposal, false)
+	keeper.deleteVotes(ctx, proposal.ProposalId)
+	results, totalVotingPower := tally.results.options, tally.totalVotingPower
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	depositParams := keeper.GetDepositParams(ctx)
+	tallyResults = tally.results.tallyResult()
+
+	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
+	// If there is no staked coins, the proposal fails
+	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+		return false, false, types.AttributeValueDepositsReasonTally, tallyResults
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, depositParams.BurnVoteQuorum, types.AttributeValueDepositsReasonQuorum, tallyResults
+	}
+
+	// Multiple-choice proposals have no veto: the option with the most votes
+	// wins, and a tie for the most votes fails the proposal
+	if proposal.IsMultipleChoice() {
+		return tally.results.hasPluralityWinner(), false, types.AttributeValueDepositsReasonTally, tallyResults
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, types.AttributeValueDepositsReasonTally, tallyResults
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
+		return false, depositParams.BurnVoteVeto, types.AttributeValueDepositsReasonVeto, tallyResults
+	}
+
+	// If more than 1/2 (or the expedited threshold for expedited proposals) of
+	// non-abstaining voters vote Yes, proposal passes
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.GetThreshold(proposal.Expedited)) {
+		return true, false, types.AttributeValueDepositsReasonTally, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, types.AttributeValueDepositsReasonTally, tallyResults
+}
+
+// voteTally holds the voting power cast on a proposal along with the
+// intermediate data it is computed from.
+type voteTally struct {
+	// results is the voting power cast for each option.
+	results optionTally
+	// splitResults is the part of results cast by weighted votes, i.e. votes
+	// split across several options.
+	splitResults optionTally
+	// totalVotingPower is the voting power of all the votes.
+	totalVotingPower sdk.Dec
+	// validators are the bonded validators by decreasing power, along with
+	// their vote and the shares of their delegators who voted themselves.
+	validators []types.ValidatorGovInfo
+	// overrides is the voting power cast by the delegators of each validator who
+	// voted themselves, by validator address. It is only set when detailed.
+	overrides map[string]optionTally
+	// voteOptions are the named options of a multiple-choice proposal.
+	voteOptions []string
+}
+
+// tallyVotes counts the votes on a proposal without deleting them. When
+// detailed, it also records how the delegators of each validator voted.
+func (keeper Keeper) tallyVotes(ctx sdk.Context, proposal types.Proposal, detailed bool) voteTally {
+	tally := voteTally{
+		results:          newOptionTally(proposal.VoteOptions),
+		splitResults:     newOptionTally(proposal.VoteOptions),
+		totalVotingPower: sdk.ZeroDec(),
+		voteOptions:      proposal.VoteOptions,
+	}
+	if detailed {
+		tally.overrides = make(map[string]optionTally)
+	}
+
 	currValidators := make(map[string]types.ValidatorGovInfo)
+	var valAddrs []string
 
 	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+		valAddrStr := validator.GetOperator().String()
+		if _, ok := currValidators[valAddrStr]; !ok {
+			valAddrs = append(valAddrs, valAddrStr)
+		}
+		currValidators[valAddrStr] = types.NewValidatorGovInfo(
 			validator.GetOperator(),
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
@@ -60,66 +136,145 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-				for _, option := range vote.Options {
-					subPower := votingPower.Mul(option.Weight)
-					results[option.Option] = results[option.Option].Add(subPower)
+				tally.addVote(vote.Options, votingPower)
+				if detailed {
+					if _, ok := tally.overrides[valAddrStr]; !ok {
+						tally.overrides[valAddrStr] = newOptionTally(proposal.VoteOptions)
+					}
+					tally.overrides[valAddrStr].add(vote.Options, votingPower)
 				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
 
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
 	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
+	for _, valAddrStr := range valAddrs {
+		val := currValidators[valAddrStr]
+		tally.validators = append(tally.validators, val)
+
 		if len(val.Vote) == 0 {
 			continue
 		}
 
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		tally.addVote(val.Vote, inheritedVotingPower(val))
+	}
+
+	return tally
+}
 
-		for _, option := range val.Vote {
-			subPower := votingPower.Mul(option.Weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+// addVote adds the voting power cast with a vote to the tally.
+func (tally *voteTally) addVote(options types.WeightedVoteOptions, votingPower sdk.Dec) {
+	tally.results.add(options, votingPower)
+	if len(options) > 1 {
+		tally.splitResults.add(options, votingPower)
+	}
+	tally.totalVotingPower = tally.totalVotingPower.Add(votingPower)
+}
+
+// validatorTallies returns the breakdown of the tally by validator. The tally
+// must be detailed.
+func (tally voteTally) validatorTallies() []types.ValidatorTally {
+	validatorTallies := make([]types.ValidatorTally, 0, len(tally.validators))
+	for _, val := range tally.validators {
+		inherited := newOptionTally(tally.voteOptions)
+		inherited.add(val.Vote, inheritedVotingPower(val))
+
+		overridden, ok := tally.overrides[val.Address.String()]
+		if !ok {
+			overridden = newOptionTally(tally.voteOptions)
 		}
-		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		validatorTallies = append(validatorTallies, types.ValidatorTally{
+			ValidatorAddress: val.Address.String(),
+			Vote:             val.Vote,
+			BondedTokens:     val.BondedTokens,
+			InheritedTally:   inherited.tallyResult(),
+			OverriddenTally:  overridden.tallyResult(),
+		})
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
-	tallyResults = types.NewTallyResultFromMap(results)
+	return validatorTallies
+}
 
-	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
+// inheritedVotingPower returns the voting power of the delegators of a
+// validator who have not voted themselves, which goes to the validator's vote.
+func inheritedVotingPower(val types.ValidatorGovInfo) sdk.Dec {
+	sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+	return sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+}
+
+// optionTally is the voting power cast for each option of a proposal. Votes on
+// the named options of a multiple-choice proposal are counted by index.
+type optionTally struct {
+	options map[types.VoteOption]sdk.Dec
+	names   []string
+	named   []sdk.Dec
+}
+
+func newOptionTally(voteOptions []string) optionTally {
+	named := make([]sdk.Dec, len(voteOptions))
+	for i := range named {
+		named[i] = sdk.ZeroDec()
 	}
 
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
+	return optionTally{
+		options: map[types.VoteOption]sdk.Dec{
+			types.OptionYes:        sdk.ZeroDec(),
+			types.OptionAbstain:    sdk.ZeroDec(),
+			types.OptionNo:         sdk.ZeroDec(),
+			types.OptionNoWithVeto: sdk.ZeroDec(),
+		},
+		names: voteOptions,
+		named: named,
 	}
+}
 
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, false, tallyResults
+// add splits the voting power of a vote across the options it was cast for.
+func (t optionTally) add(options types.WeightedVoteOptions, votingPower sdk.Dec) {
+	for _, option := range options {
+		subPower := votingPower.Mul(option.Weight)
+		if option.Option != types.OptionEmpty {
+			t.options[option.Option] = t.options[option.Option].Add(subPower)
+			continue
+		}
+
+		// votes are validated against the proposal when cast, so an out of
+		// range index can only come from a corrupted store
+		if int(option.OptionIndex) < len(t.named) {
+			t.named[option.OptionIndex] = t.named[option.OptionIndex].Add(subPower)
+		}
 	}
+}
 
-	// If more than 1/3 of voters veto, proposal fails
-	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
-		return false, true, tallyResults
+// hasPluralityWinner returns whether a single named option has more votes than
+// any other. It is false if there are no votes or several options tie.
+func (t optionTally) hasPluralityWinner() bool {
+	tie := false
+	maxPower := sdk.ZeroDec()
+	for _, power := range t.named {
+		switch {
+		case power.GT(maxPower):
+			maxPower, tie = power, false
+		case power.Equal(maxPower):
+			tie = true
+		}
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
-		return true, false, tallyResults
+	return maxPower.IsPositive() && !tie
+}
+
+func (t optionTally) tallyResult() types.TallyResult {
+	tallyResult := types.NewTallyResultFromMap(t.options)
+	for i, name := range t.names {
+		tallyResult.Options = append(tallyResult.Options, types.OptionTally{
+			Name:  name,
+			Count: t.named[i].TruncateInt(),
+		})
 	}
 
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return tallyResult
 }
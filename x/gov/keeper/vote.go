@@ -9,7 +9,7 @@ import (
 )
 
 // AddVote adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions, metadata string) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -19,12 +19,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	}
 
 	for _, option := range options {
-		if !types.ValidWeightedVoteOption(option) {
+		if !proposal.ValidWeightedVoteOption(option) {
 			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
 		}
 	}
 
+	if err := keeper.assertMetadataLength(ctx, metadata); err != nil {
+		return err
+	}
+
 	vote := types.NewVote(proposalID, voterAddr, options)
+	vote.Metadata = metadata
 	keeper.SetVote(ctx, vote)
 
 	// called after a vote on a proposal is cast
@@ -35,6 +40,7 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 			types.EventTypeProposalVote,
 			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyMetadata, metadata),
 		),
 	)
 
@@ -89,6 +95,7 @@ func (keeper Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
 		panic(err)
 	}
 	store.Set(types.VoteKey(vote.ProposalId, addr), bz)
+	store.Set(types.VoterVoteKey(addr, vote.ProposalId), []byte{})
 }
 
 // IterateAllVotes iterates over the all the stored votes and performs a callback function
@@ -125,10 +132,40 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
+// IterateVoterVotes iterates over the votes cast by a voter, ordered by
+// proposal id, and performs a callback function
+func (keeper Keeper) IterateVoterVotes(ctx sdk.Context, voterAddr sdk.AccAddress, cb func(vote types.Vote) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoterVotesKey(voterAddr))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, proposalID := types.SplitKeyVoterVote(iterator.Key())
+		vote, found := keeper.GetVote(ctx, proposalID, voterAddr)
+		if !found {
+			panic(fmt.Sprintf("vote of %s on proposal %d is indexed but not stored", voterAddr, proposalID))
+		}
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// GetVoterVotes returns all the votes cast by a voter
+func (keeper Keeper) GetVoterVotes(ctx sdk.Context, voterAddr sdk.AccAddress) (votes types.Votes) {
+	keeper.IterateVoterVotes(ctx, voterAddr, func(vote types.Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return
+}
+
 // deleteVote deletes a vote from a given proposalID and voter from the store
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
+	store.Delete(types.VoterVoteKey(voterAddr, proposalID))
 }
 
 // populateLegacyOption adds graceful fallback of deprecated `Option` field, in case
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,22 +19,22 @@ func TestVotes(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, addrs[0], "", false, nil)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 
 	var invalidOption types.VoteOption = 0x10
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "proposal not on voting period")
-	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "invalid proposal ID")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""), "proposal not on voting period")
+	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""), "invalid proposal ID")
 
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(invalidOption)), "invalid option")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(invalidOption), ""), "invalid option")
 
 	// Test first vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
 	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0].String(), vote.Voter)
@@ -43,7 +44,7 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, types.OptionAbstain, vote.Option)
 
 	// Test change of vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0].String(), vote.Voter)
@@ -58,7 +59,7 @@ func TestVotes(t *testing.T) {
 		types.WeightedVoteOption{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(30, 2)},
 		types.WeightedVoteOption{Option: types.OptionAbstain, Weight: sdk.NewDecWithPrec(5, 2)},
 		types.WeightedVoteOption{Option: types.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(5, 2)},
-	}))
+	}, ""))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[1])
 	require.True(t, found)
 	require.Equal(t, addrs[1].String(), vote.Voter)
@@ -92,3 +93,144 @@ func TestVotes(t *testing.T) {
 	require.True(t, votes[1].Options[3].Weight.Equal(sdk.NewDecWithPrec(5, 2)))
 	require.Equal(t, types.OptionEmpty, vote.Option)
 }
+
+// requireVoterVotesIndex checks that the voter votes index holds exactly the
+// stored votes.
+func requireVoterVotesIndex(t *testing.T, ctx sdk.Context, app *simapp.SimApp) {
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.VoterVotesKeyPrefix)
+	defer iterator.Close()
+
+	var indexed int
+	for ; iterator.Valid(); iterator.Next() {
+		voter, proposalID := types.SplitKeyVoterVote(iterator.Key())
+		_, found := app.GovKeeper.GetVote(ctx, proposalID, voter)
+		require.True(t, found, "vote of %s on proposal %d is indexed but not stored", voter, proposalID)
+		indexed++
+	}
+
+	require.Len(t, app.GovKeeper.GetAllVotes(ctx), indexed)
+}
+
+func TestMultipleChoiceVotes(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, []string{"red", "green", "blue"})
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	standard, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+	require.NoError(t, err)
+	standard.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, standard)
+
+	// multiple-choice proposals only take named options, and standard proposals
+	// only standard ones
+	require.ErrorIs(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""), types.ErrInvalidVote)
+	require.ErrorIs(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewMultipleChoiceVoteOption(3), ""), types.ErrInvalidVote)
+	require.ErrorIs(t, app.GovKeeper.AddVote(ctx, standard.ProposalId, addrs[0], types.NewMultipleChoiceVoteOption(0), ""), types.ErrInvalidVote)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewMultipleChoiceVoteOption(2), ""))
+	vote, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewMultipleChoiceVoteOption(2).String(), types.WeightedVoteOptions(vote.Options).String())
+
+	options := types.WeightedVoteOptions{
+		{OptionIndex: 0, Weight: sdk.NewDecWithPrec(25, 2)},
+		{OptionIndex: 1, Weight: sdk.NewDecWithPrec(75, 2)},
+	}
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], options, ""))
+	vote, found = app.GovKeeper.GetVote(ctx, proposal.ProposalId, addrs[1])
+	require.True(t, found)
+	require.Equal(t, options.String(), types.WeightedVoteOptions(vote.Options).String())
+}
+
+func TestVoterVotes(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	var proposalIDs []uint64
+	for i := 0; i < 3; i++ {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+		require.NoError(t, err)
+		proposal.Status = types.StatusVotingPeriod
+		app.GovKeeper.SetProposal(ctx, proposal)
+		proposalIDs = append(proposalIDs, proposal.ProposalId)
+	}
+
+	require.Empty(t, app.GovKeeper.GetVoterVotes(ctx, addrs[0]))
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalIDs[2], addrs[0], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalIDs[0], addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalIDs[0], addrs[1], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
+	requireVoterVotesIndex(t, ctx, app)
+
+	// votes are ordered by proposal id
+	votes := app.GovKeeper.GetVoterVotes(ctx, addrs[0])
+	require.Len(t, votes, 2)
+	require.Equal(t, proposalIDs[0], votes[0].ProposalId)
+	require.Equal(t, types.OptionYes, votes[0].Options[0].Option)
+	require.Equal(t, proposalIDs[2], votes[1].ProposalId)
+
+	// overwriting a vote keeps a single index entry
+	options := types.WeightedVoteOptions{
+		types.WeightedVoteOption{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(70, 2)},
+		types.WeightedVoteOption{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(30, 2)},
+	}
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalIDs[0], addrs[0], options, ""))
+	requireVoterVotesIndex(t, ctx, app)
+	votes = app.GovKeeper.GetVoterVotes(ctx, addrs[0])
+	require.Len(t, votes, 2)
+	require.Equal(t, []types.WeightedVoteOption(options), votes[0].Options)
+
+	// tallying removes the votes of the proposal from the index
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalIDs[0])
+	require.True(t, ok)
+	app.GovKeeper.Tally(ctx, proposal)
+	requireVoterVotesIndex(t, ctx, app)
+	votes = app.GovKeeper.GetVoterVotes(ctx, addrs[0])
+	require.Len(t, votes, 1)
+	require.Equal(t, proposalIDs[2], votes[0].ProposalId)
+	require.Empty(t, app.GovKeeper.GetVoterVotes(ctx, addrs[1]))
+
+	// so does deleting the proposal
+	app.GovKeeper.DeleteProposal(ctx, proposalIDs[2])
+	requireVoterVotesIndex(t, ctx, app)
+	require.Empty(t, app.GovKeeper.GetVoterVotes(ctx, addrs[0]))
+}
+
+func TestVoteMetadata(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))
+	maxMetadataLen := int(app.GovKeeper.GetDepositParams(ctx).MaxMetadataLen)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", false, nil)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	options := types.NewNonSplitVoteOption(types.OptionYes)
+	require.ErrorIs(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], options, strings.Repeat("a", maxMetadataLen+1)), types.ErrMetadataTooLong)
+	_, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, addrs[0])
+	require.False(t, found)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], options, strings.Repeat("a", maxMetadataLen)))
+	vote, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, addrs[0])
+	require.True(t, found)
+	require.Equal(t, strings.Repeat("a", maxMetadataLen), vote.Metadata)
+
+	// changing the vote replaces its metadata
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], options, ""))
+	vote, found = app.GovKeeper.GetVote(ctx, proposal.ProposalId, addrs[0])
+	require.True(t, found)
+	require.Empty(t, vote.Metadata)
+}
@@ -96,9 +96,21 @@ func TestMigrate(t *testing.T) {
 	// - ParameterChangeProposal has correct JSON.
 	expected := `{
 	"deposit_params": {
+		"burn_proposal_deposit_prevote": false,
+		"burn_vote_quorum": false,
+		"burn_vote_veto": false,
+		"deposit_record_retention": "0",
+		"expedited_min_deposit": [],
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"max_metadata_len": "0",
+		"max_vote_option_len": "0",
+		"max_vote_options": "0",
+		"min_deposit": [],
+		"min_initial_deposit_ratio": "0",
+		"proposal_cancel_burn": false,
+		"proposal_cancel_ratio": "0"
 	},
+	"deposit_records": [],
 	"deposits": [],
 	"proposals": [
 		{
@@ -108,16 +120,21 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_text"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
+				"options": [],
 				"yes": "0"
 			},
+			"metadata": "",
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"vote_options": [],
 			"voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		},
@@ -135,16 +152,21 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_community"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
+				"options": [],
 				"yes": "0"
 			},
+			"metadata": "",
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"vote_options": [],
 			"voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		},
@@ -155,16 +177,21 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_cancel_upgrade"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
+				"options": [],
 				"yes": "0"
 			},
+			"metadata": "",
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"vote_options": [],
 			"voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		},
@@ -182,16 +209,21 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_software_upgrade"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
+				"options": [],
 				"yes": "0"
 			},
+			"metadata": "",
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"vote_options": [],
 			"voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		},
@@ -209,28 +241,36 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_param_change"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
+				"options": [],
 				"yes": "0"
 			},
+			"metadata": "",
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"vote_options": [],
 			"voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		}
 	],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"expedited_threshold": "0",
 		"quorum": "0",
 		"threshold": "0",
 		"veto_threshold": "0"
 	},
 	"votes": [],
 	"voting_params": {
+		"expedited_voting_period": "0s",
+		"proposal_cancel_max_period": "0",
 		"voting_period": "0s"
 	}
 }`
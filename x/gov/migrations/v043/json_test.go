@@ -49,23 +49,38 @@ func TestMigrateJSON(t *testing.T) {
 	// - Votes are all ADR-037 weighted votes with weight 1.
 	expected := `{
 	"deposit_params": {
+		"burn_proposal_deposit_prevote": false,
+		"burn_vote_quorum": false,
+		"burn_vote_veto": false,
+		"deposit_record_retention": "0",
+		"expedited_min_deposit": [],
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"max_metadata_len": "0",
+		"max_vote_option_len": "0",
+		"max_vote_options": "0",
+		"min_deposit": [],
+		"min_initial_deposit_ratio": "0",
+		"proposal_cancel_burn": false,
+		"proposal_cancel_ratio": "0"
 	},
+	"deposit_records": [],
 	"deposits": [],
 	"proposals": [],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"expedited_threshold": "0",
 		"quorum": "0",
 		"threshold": "0",
 		"veto_threshold": "0"
 	},
 	"votes": [
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
 					"option": "VOTE_OPTION_ABSTAIN",
+					"option_index": 0,
 					"weight": "1.000000000000000000"
 				}
 			],
@@ -73,10 +88,12 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
 					"option": "VOTE_OPTION_UNSPECIFIED",
+					"option_index": 0,
 					"weight": "1.000000000000000000"
 				}
 			],
@@ -84,10 +101,12 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
 					"option": "VOTE_OPTION_NO",
+					"option_index": 0,
 					"weight": "1.000000000000000000"
 				}
 			],
@@ -95,10 +114,12 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
 					"option": "VOTE_OPTION_NO_WITH_VETO",
+					"option_index": 0,
 					"weight": "1.000000000000000000"
 				}
 			],
@@ -106,10 +127,12 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
 					"option": "VOTE_OPTION_YES",
+					"option_index": 0,
 					"weight": "1.000000000000000000"
 				}
 			],
@@ -118,6 +141,8 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": "0s",
+		"proposal_cancel_max_period": "0",
 		"voting_period": "0s"
 	}
 }`
@@ -0,0 +1,126 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// expeditedMinDepositMultiplier is the ratio between the default expedited
+// minimum deposit and the default minimum deposit.
+const expeditedMinDepositMultiplier = 5
+
+// MigrateStore performs in-place store migrations from v0.43/v0.45 to v0.46.
+// The migration includes:
+//
+// - Setting the expedited proposal params, derived from the regular params
+// so that they remain valid alongside them.
+// - Enabling all the deposit burn conditions, so that deposits keep being
+// burned when a proposal does not reach quorum, is vetoed or is dropped before
+// its voting period.
+// - Setting the maximum metadata length of proposals, votes and deposits, the
+// minimum initial deposit ratio, the proposal cancellation params, the bounds
+// of the vote options of multiple-choice proposals and the deposit record
+// retention to their defaults. Proposals submitted before the migration have
+// no recorded proposer and cannot be cancelled.
+// - Indexing the votes of the active proposals by voter.
+// - Recording the deposits of the active proposals as active deposits of their
+// depositors. Deposits settled before the migration have no record.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace types.ParamSubspace) error {
+	migrateDepositParams(ctx, paramSpace)
+	migrateVotingParams(ctx, paramSpace)
+	migrateTallyParams(ctx, paramSpace)
+	migrateVoterVotes(ctx.KVStore(storeKey))
+	migrateDepositRecords(ctx.KVStore(storeKey), cdc)
+
+	return nil
+}
+
+// migrateVoterVotes writes the voter votes index entry of every stored vote.
+func migrateVoterVotes(store sdk.KVStore) {
+	var keys [][]byte
+
+	iterator := sdk.KVStorePrefixIterator(store, types.VotesKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, voter := types.SplitKeyVote(iterator.Key())
+		keys = append(keys, types.VoterVoteKey(voter, proposalID))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+}
+
+// migrateDepositRecords writes the active deposit record of every stored
+// deposit.
+func migrateDepositRecords(store sdk.KVStore, cdc codec.BinaryCodec) {
+	var records []types.DepositRecord
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositsKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		cdc.MustUnmarshal(iterator.Value(), &deposit)
+		records = append(records, types.NewDepositRecord(deposit, types.DepositStatusActive, 0))
+	}
+	iterator.Close()
+
+	for _, record := range records {
+		depositor, err := sdk.AccAddressFromBech32(record.Depositor)
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.DepositRecordKey(depositor, record.ProposalId), cdc.MustMarshal(&record))
+	}
+}
+
+func migrateDepositParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+
+	expeditedMinDeposit := sdk.NewCoins()
+	for _, coin := range depositParams.MinDeposit {
+		expeditedMinDeposit = expeditedMinDeposit.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(expeditedMinDepositMultiplier)))
+	}
+	depositParams.ExpeditedMinDeposit = expeditedMinDeposit
+
+	depositParams.BurnVoteQuorum = true
+	depositParams.BurnProposalDepositPrevote = true
+	depositParams.BurnVoteVeto = true
+	depositParams.MaxMetadataLen = types.DefaultMaxMetadataLen
+	depositParams.MinInitialDepositRatio = types.DefaultMinInitialDepositRatio
+	depositParams.ProposalCancelRatio = types.DefaultProposalCancelRatio
+	depositParams.ProposalCancelBurn = types.DefaultProposalCancelBurn
+	depositParams.MaxVoteOptions = types.DefaultMaxVoteOptions
+	depositParams.MaxVoteOptionLen = types.DefaultMaxVoteOptionLen
+	depositParams.DepositRecordRetention = types.DefaultDepositRecordRetention
+
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+}
+
+func migrateVotingParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+
+	votingParams.ExpeditedVotingPeriod = types.DefaultExpeditedPeriod
+	if votingParams.ExpeditedVotingPeriod >= votingParams.VotingPeriod {
+		votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+	}
+	votingParams.ProposalCancelMaxPeriod = types.DefaultProposalCancelMaxPeriod
+
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+}
+
+func migrateTallyParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	tallyParams.ExpeditedThreshold = types.DefaultExpeditedThreshold
+	if tallyParams.ExpeditedThreshold.LTE(tallyParams.Threshold) {
+		// halfway between the threshold and unanimity
+		tallyParams.ExpeditedThreshold = tallyParams.Threshold.Add(sdk.OneDec()).QuoInt64(2)
+	}
+
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+}
@@ -0,0 +1,181 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		minDeposit            sdk.Coins
+		votingPeriod          time.Duration
+		threshold             sdk.Dec
+		expeditedMinDeposit   sdk.Coins
+		expeditedVotingPeriod time.Duration
+		expeditedThreshold    sdk.Dec
+	}{
+		{
+			"default params",
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.DefaultMinDepositTokens)),
+			types.DefaultPeriod,
+			types.DefaultThreshold,
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.DefaultMinExpeditedDepositTokens)),
+			types.DefaultExpeditedPeriod,
+			types.DefaultExpeditedThreshold,
+		},
+		{
+			"short voting period and high threshold",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			12 * time.Hour,
+			sdk.NewDecWithPrec(8, 1),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+			6 * time.Hour,
+			sdk.NewDecWithPrec(9, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			encCfg := simapp.MakeTestEncodingConfig()
+			govKey := sdk.NewKVStoreKey("gov")
+			tGovKey := sdk.NewTransientStoreKey("transient_test")
+			ctx := testutil.DefaultContext(govKey, tGovKey)
+			paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(types.ParamKeyTable())
+
+			// Set the params as they were before the migration.
+			paramstore.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{MinDeposit: tc.minDeposit, MaxDepositPeriod: types.DefaultPeriod})
+			paramstore.Set(ctx, types.ParamStoreKeyVotingParams, &types.VotingParams{VotingPeriod: tc.votingPeriod})
+			paramstore.Set(ctx, types.ParamStoreKeyTallyParams, &types.TallyParams{
+				Quorum: types.DefaultQuorum, Threshold: tc.threshold, VetoThreshold: types.DefaultVetoThreshold,
+			})
+
+			// Run migrations.
+			err := v046gov.MigrateStore(ctx, govKey, encCfg.Codec, paramstore)
+			require.NoError(t, err)
+
+			// Make sure the expedited params, deposit burn conditions, maximum
+			// metadata length, minimum initial deposit ratio and cancellation
+			// params are set and the others unchanged.
+			var depositParams types.DepositParams
+			paramstore.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+			require.Equal(t, types.NewDepositParams(tc.minDeposit, types.DefaultPeriod, tc.expeditedMinDeposit, true, true, true, types.DefaultMaxMetadataLen,
+				sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), true, types.DefaultMaxVoteOptions, types.DefaultMaxVoteOptionLen,
+				types.DefaultDepositRecordRetention), depositParams)
+
+			var votingParams types.VotingParams
+			paramstore.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+			require.Equal(t, types.NewVotingParams(tc.votingPeriod, tc.expeditedVotingPeriod, sdk.NewDecWithPrec(5, 1)), votingParams)
+
+			var tallyParams types.TallyParams
+			paramstore.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+			require.Equal(t, types.NewTallyParams(types.DefaultQuorum, tc.threshold, types.DefaultVetoThreshold, tc.expeditedThreshold), tallyParams)
+
+			// The migrated params are valid.
+			require.NoError(t, types.ValidateGenesis(types.NewGenesisState(types.DefaultStartingProposalID, depositParams, votingParams, tallyParams)))
+		})
+	}
+}
+
+func TestMigrateVoterVotes(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(types.ParamKeyTable())
+	paramstore.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{MinDeposit: sdk.NewCoins(), MaxDepositPeriod: types.DefaultPeriod})
+	paramstore.Set(ctx, types.ParamStoreKeyVotingParams, &types.VotingParams{VotingPeriod: types.DefaultPeriod})
+	paramstore.Set(ctx, types.ParamStoreKeyTallyParams, &types.TallyParams{
+		Quorum: types.DefaultQuorum, Threshold: types.DefaultThreshold, VetoThreshold: types.DefaultVetoThreshold,
+	})
+
+	voter1 := sdk.AccAddress("voter1______________")
+	voter2 := sdk.AccAddress("voter2______________")
+	votes := []types.Vote{
+		types.NewVote(1, voter1, types.NewNonSplitVoteOption(types.OptionYes)),
+		types.NewVote(2, voter1, types.NewNonSplitVoteOption(types.OptionNo)),
+		types.NewVote(2, voter2, types.NewNonSplitVoteOption(types.OptionAbstain)),
+	}
+
+	// Store the votes without their voter index entries.
+	store := ctx.KVStore(govKey)
+	for _, vote := range votes {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		require.NoError(t, err)
+		store.Set(types.VoteKey(vote.ProposalId, voter), encCfg.Codec.MustMarshal(&vote))
+	}
+
+	err := v046gov.MigrateStore(ctx, govKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Every vote is indexed by its voter, and only those.
+	for _, vote := range votes {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		require.NoError(t, err)
+		require.True(t, store.Has(types.VoterVoteKey(voter, vote.ProposalId)))
+	}
+	require.False(t, store.Has(types.VoterVoteKey(voter2, 1)))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.VoterVotesKeyPrefix)
+	defer iterator.Close()
+	var indexed int
+	for ; iterator.Valid(); iterator.Next() {
+		indexed++
+	}
+	require.Equal(t, len(votes), indexed)
+}
+
+func TestMigrateDepositRecords(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(types.ParamKeyTable())
+	paramstore.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{MinDeposit: sdk.NewCoins(), MaxDepositPeriod: types.DefaultPeriod})
+	paramstore.Set(ctx, types.ParamStoreKeyVotingParams, &types.VotingParams{VotingPeriod: types.DefaultPeriod})
+	paramstore.Set(ctx, types.ParamStoreKeyTallyParams, &types.TallyParams{
+		Quorum: types.DefaultQuorum, Threshold: types.DefaultThreshold, VetoThreshold: types.DefaultVetoThreshold,
+	})
+
+	depositor1 := sdk.AccAddress("depositor1__________")
+	depositor2 := sdk.AccAddress("depositor2__________")
+	deposits := types.Deposits{
+		types.NewDeposit(1, depositor1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))),
+		types.NewDeposit(2, depositor1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))),
+		types.NewDeposit(2, depositor2, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))),
+	}
+
+	// Store the deposits without their records.
+	store := ctx.KVStore(govKey)
+	for _, deposit := range deposits {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		require.NoError(t, err)
+		store.Set(types.DepositKey(deposit.ProposalId, depositor), encCfg.Codec.MustMarshal(&deposit))
+	}
+
+	err := v046gov.MigrateStore(ctx, govKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Every deposit is recorded as active for its depositor.
+	for _, deposit := range deposits {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		require.NoError(t, err)
+
+		bz := store.Get(types.DepositRecordKey(depositor, deposit.ProposalId))
+		require.NotNil(t, bz)
+		var record types.DepositRecord
+		encCfg.Codec.MustUnmarshal(bz, &record)
+		require.Equal(t, types.NewDepositRecord(deposit, types.DepositStatusActive, 0), record)
+	}
+	require.False(t, store.Has(types.DepositRecordKey(depositor2, 1)))
+}
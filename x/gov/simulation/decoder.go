@@ -41,12 +41,29 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &depositB)
 			return fmt.Sprintf("%v\n%v", depositA, depositB)
 
+		case bytes.Equal(kvA.Key[:1], types.DepositRecordsKeyPrefix):
+			var recordA, recordB types.DepositRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.DepositRecordPruneQueuePrefix):
+			heightA, proposalIDA, depositorA := types.SplitDepositRecordPruneQueueKey(kvA.Key)
+			heightB, proposalIDB, depositorB := types.SplitDepositRecordPruneQueueKey(kvB.Key)
+			return fmt.Sprintf("heightA: %d proposalIDA: %d depositorA: %s\nheightB: %d proposalIDB: %d depositorB: %s",
+				heightA, proposalIDA, depositorA, heightB, proposalIDB, depositorB)
+
 		case bytes.Equal(kvA.Key[:1], types.VotesKeyPrefix):
 			var voteA, voteB types.Vote
 			cdc.MustUnmarshal(kvA.Value, &voteA)
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.VoterVotesKeyPrefix):
+			voterA, proposalIDA := types.SplitKeyVoterVote(kvA.Key)
+			voterB, proposalIDB := types.SplitKeyVoterVote(kvB.Key)
+			return fmt.Sprintf("voterA: %s proposalIDA: %d\nvoterB: %s proposalIDB: %d", voterA, proposalIDA, voterB, proposalIDB)
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
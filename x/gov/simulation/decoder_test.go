@@ -36,6 +36,7 @@ func TestDecodeStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes))
+	record := types.NewDepositRecord(deposit, types.DepositStatusRefunded, 10)
 
 	proposalBzA, err := cdc.Marshal(&proposalA)
 	require.NoError(t, err)
@@ -66,12 +67,30 @@ func TestDecodeStore(t *testing.T) {
 			kv.Pair{Key: types.DepositKey(1, delAddr1), Value: cdc.MustMarshal(&deposit)},
 			fmt.Sprintf("%v\n%v", deposit, deposit), false,
 		},
+		{
+			"deposit records",
+			kv.Pair{Key: types.DepositRecordKey(delAddr1, 1), Value: cdc.MustMarshal(&record)},
+			kv.Pair{Key: types.DepositRecordKey(delAddr1, 1), Value: cdc.MustMarshal(&record)},
+			fmt.Sprintf("%v\n%v", record, record), false,
+		},
+		{
+			"deposit record prune queue",
+			kv.Pair{Key: types.DepositRecordPruneQueueKey(110, 1, delAddr1), Value: []byte{}},
+			kv.Pair{Key: types.DepositRecordPruneQueueKey(110, 1, delAddr1), Value: []byte{}},
+			fmt.Sprintf("heightA: 110 proposalIDA: 1 depositorA: %s\nheightB: 110 proposalIDB: 1 depositorB: %s", delAddr1, delAddr1), false,
+		},
 		{
 			"votes",
 			kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshal(&vote)},
 			kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshal(&vote)},
 			fmt.Sprintf("%v\n%v", vote, vote), false,
 		},
+		{
+			"voter votes",
+			kv.Pair{Key: types.VoterVoteKey(delAddr1, 1), Value: []byte{}},
+			kv.Pair{Key: types.VoterVoteKey(delAddr1, 1), Value: []byte{}},
+			fmt.Sprintf("voterA: %s proposalIDA: 1\nvoterB: %s proposalIDB: 1", delAddr1, delAddr1), false,
+		},
 		{
 			"other",
 			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
@@ -16,12 +16,26 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit           = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod        = "deposit_params_deposit_period"
+	DepositParamsExpeditedMinDeposit  = "deposit_params_expedited_min_deposit"
+	DepositParamsBurnVoteQuorum       = "deposit_params_burn_vote_quorum"
+	DepositParamsBurnPrevote          = "deposit_params_burn_proposal_deposit_prevote"
+	DepositParamsBurnVoteVeto         = "deposit_params_burn_vote_veto"
+	DepositParamsMaxMetadataLen       = "deposit_params_max_metadata_len"
+	DepositParamsMinInitialRatio      = "deposit_params_min_initial_deposit_ratio"
+	DepositParamsProposalCancelRatio  = "deposit_params_proposal_cancel_ratio"
+	DepositParamsProposalCancelBurn   = "deposit_params_proposal_cancel_burn"
+	DepositParamsMaxVoteOptions       = "deposit_params_max_vote_options"
+	DepositParamsMaxVoteOptionLen     = "deposit_params_max_vote_option_len"
+	DepositParamsRecordRetention      = "deposit_params_deposit_record_retention"
+	VotingParamsProposalCancelMax     = "voting_params_proposal_cancel_max_period"
+	VotingParamsVotingPeriod          = "voting_params_voting_period"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                 = "tally_params_quorum"
+	TallyParamsThreshold              = "tally_params_threshold"
+	TallyParamsVeto                   = "tally_params_veto"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -34,11 +48,64 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit randomized DepositParamsExpeditedMinDeposit,
+// always greater than the generated DepositParamsMinDeposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1e3, 1e4))))
+}
+
+// GenDepositParamsBurnDeposits randomized DepositParamsBurnVoteQuorum,
+// DepositParamsBurnPrevote and DepositParamsBurnVoteVeto
+func GenDepositParamsBurnDeposits(r *rand.Rand) bool {
+	return r.Int63n(2) == 0
+}
+
+// GenDepositParamsMaxMetadataLen randomized DepositParamsMaxMetadataLen
+func GenDepositParamsMaxMetadataLen(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, types.MaxMetadataLength))
+}
+
+// GenDepositParamsMinInitialDepositRatio randomized DepositParamsMinInitialRatio
+func GenDepositParamsMinInitialDepositRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 50)), 2)
+}
+
+// GenDepositParamsProposalCancelRatio randomized DepositParamsProposalCancelRatio
+func GenDepositParamsProposalCancelRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 101)), 2)
+}
+
+// GenDepositParamsMaxVoteOptions randomized DepositParamsMaxVoteOptions
+func GenDepositParamsMaxVoteOptions(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 20))
+}
+
+// GenDepositParamsMaxVoteOptionLen randomized DepositParamsMaxVoteOptionLen
+func GenDepositParamsMaxVoteOptionLen(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 200))
+}
+
+// GenDepositParamsRecordRetention randomized DepositParamsRecordRetention
+func GenDepositParamsRecordRetention(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 100))
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedVotingPeriod,
+// shorter than the given voting period
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return votingPeriod * time.Duration(simulation.RandIntBetween(r, 1, 100)) / 100
+}
+
+// GenVotingParamsProposalCancelMaxPeriod randomized VotingParamsProposalCancelMax
+func GenVotingParamsProposalCancelMaxPeriod(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 101)), 2)
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -54,6 +121,12 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold,
+// always greater than the generated TallyParamsThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 550, 700)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -94,11 +167,99 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r) },
+	)
+
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r, votingPeriod) },
+	)
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
+	var burnVoteQuorum bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVoteQuorum, &burnVoteQuorum, simState.Rand,
+		func(r *rand.Rand) { burnVoteQuorum = GenDepositParamsBurnDeposits(r) },
+	)
+
+	var burnPrevote bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnPrevote, &burnPrevote, simState.Rand,
+		func(r *rand.Rand) { burnPrevote = GenDepositParamsBurnDeposits(r) },
+	)
+
+	var burnVoteVeto bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVoteVeto, &burnVoteVeto, simState.Rand,
+		func(r *rand.Rand) { burnVoteVeto = GenDepositParamsBurnDeposits(r) },
+	)
+
+	var maxMetadataLen uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsMaxMetadataLen, &maxMetadataLen, simState.Rand,
+		func(r *rand.Rand) { maxMetadataLen = GenDepositParamsMaxMetadataLen(r) },
+	)
+
+	var minInitialDepositRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsMinInitialRatio, &minInitialDepositRatio, simState.Rand,
+		func(r *rand.Rand) { minInitialDepositRatio = GenDepositParamsMinInitialDepositRatio(r) },
+	)
+
+	var proposalCancelRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsProposalCancelRatio, &proposalCancelRatio, simState.Rand,
+		func(r *rand.Rand) { proposalCancelRatio = GenDepositParamsProposalCancelRatio(r) },
+	)
+
+	var proposalCancelBurn bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsProposalCancelBurn, &proposalCancelBurn, simState.Rand,
+		func(r *rand.Rand) { proposalCancelBurn = GenDepositParamsBurnDeposits(r) },
+	)
+
+	var maxVoteOptions uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsMaxVoteOptions, &maxVoteOptions, simState.Rand,
+		func(r *rand.Rand) { maxVoteOptions = GenDepositParamsMaxVoteOptions(r) },
+	)
+
+	var maxVoteOptionLen uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsMaxVoteOptionLen, &maxVoteOptionLen, simState.Rand,
+		func(r *rand.Rand) { maxVoteOptionLen = GenDepositParamsMaxVoteOptionLen(r) },
+	)
+
+	var depositRecordRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsRecordRetention, &depositRecordRetention, simState.Rand,
+		func(r *rand.Rand) { depositRecordRetention = GenDepositParamsRecordRetention(r) },
+	)
+
+	var proposalCancelMaxPeriod sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsProposalCancelMax, &proposalCancelMaxPeriod, simState.Rand,
+		func(r *rand.Rand) { proposalCancelMaxPeriod = GenVotingParamsProposalCancelMaxPeriod(r) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
-		types.NewVotingParams(votingPeriod),
-		types.NewTallyParams(quorum, threshold, veto),
+		types.NewDepositParams(
+			minDeposit, depositPeriod, expeditedMinDeposit, burnVoteQuorum, burnPrevote, burnVoteVeto, maxMetadataLen,
+			minInitialDepositRatio, proposalCancelRatio, proposalCancelBurn, maxVoteOptions, maxVoteOptionLen,
+			depositRecordRetention,
+		),
+		types.NewVotingParams(votingPeriod, expeditedVotingPeriod, proposalCancelMaxPeriod),
+		types.NewTallyParams(quorum, threshold, veto, expeditedThreshold),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
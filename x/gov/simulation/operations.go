@@ -129,7 +129,7 @@ func SimulateMsgSubmitProposal(
 		}
 
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		deposit, skip, err := randomDeposit(r, ctx, ak, bk, k, simAccount.Address)
+		deposit, skip, err := randomDeposit(r, ctx, ak, bk, k, simAccount.Address, true)
 		switch {
 		case skip:
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, "skip deposit"), nil, nil
@@ -219,7 +219,7 @@ func SimulateMsgDeposit(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, "unable to generate proposalID"), nil, nil
 		}
 
-		deposit, skip, err := randomDeposit(r, ctx, ak, bk, k, simAccount.Address)
+		deposit, skip, err := randomDeposit(r, ctx, ak, bk, k, simAccount.Address, false)
 		switch {
 		case skip:
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, "skip deposit"), nil, nil
@@ -368,7 +368,7 @@ func operationSimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeepe
 // This is to simulate multiple users depositing to get the
 // proposal above the minimum deposit amount
 func randomDeposit(r *rand.Rand, ctx sdk.Context,
-	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, addr sdk.AccAddress,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, addr sdk.AccAddress, useMinAmount bool,
 ) (deposit sdk.Coins, skip bool, err error) {
 	account := ak.GetAccount(ctx, addr)
 	spendable := bk.SpendableCoins(ctx, account.GetAddress())
@@ -377,7 +377,8 @@ func randomDeposit(r *rand.Rand, ctx sdk.Context,
 		return nil, true, nil // skip
 	}
 
-	minDeposit := k.GetDepositParams(ctx).MinDeposit
+	depositParams := k.GetDepositParams(ctx)
+	minDeposit := depositParams.MinDeposit
 	denomIndex := r.Intn(len(minDeposit))
 	denom := minDeposit[denomIndex].Denom
 
@@ -391,12 +392,27 @@ func randomDeposit(r *rand.Rand, ctx sdk.Context,
 		maxAmt = minDeposit[denomIndex].Amount
 	}
 
-	amount, err := simtypes.RandPositiveInt(r, maxAmt)
+	// initial deposits must cover the minimum initial deposit, which a single
+	// denom deposit cannot do if more than one denom is required
+	minAmount := sdk.ZeroInt()
+	if useMinAmount {
+		minInitialDeposit := depositParams.GetMinInitialDeposit(false)
+		if len(minInitialDeposit) > 1 {
+			return nil, true, nil
+		}
+		minAmount = minInitialDeposit.AmountOf(denom)
+	}
+
+	if maxAmt.LTE(minAmount) {
+		return nil, true, nil
+	}
+
+	amount, err := simtypes.RandPositiveInt(r, maxAmt.Sub(minAmount))
 	if err != nil {
 		return nil, false, err
 	}
 
-	return sdk.Coins{sdk.NewCoin(denom, amount)}, false, nil
+	return sdk.Coins{sdk.NewCoin(denom, amount.Add(minAmount))}, false, nil
 }
 
 // Pick a random proposal ID between the initial proposal ID
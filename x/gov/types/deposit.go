@@ -52,3 +52,44 @@ func (d Deposits) String() string {
 func (d Deposit) Empty() bool {
 	return d.String() == Deposit{}.String()
 }
+
+// NewDepositRecord creates the record of a deposit with the given status,
+// settled at the given height unless it is active.
+func NewDepositRecord(deposit Deposit, status DepositStatus, settledHeight int64) DepositRecord {
+	return DepositRecord{
+		ProposalId:    deposit.ProposalId,
+		Depositor:     deposit.Depositor,
+		Amount:        deposit.Amount,
+		Status:        status,
+		SettledHeight: settledHeight,
+	}
+}
+
+func (r DepositRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// IsSettled returns whether the deposit was refunded or burned.
+func (r DepositRecord) IsSettled() bool {
+	return r.Status == DepositStatusRefunded || r.Status == DepositStatusBurned
+}
+
+// DepositRecords is a collection of DepositRecord objects
+type DepositRecords []DepositRecord
+
+// Equal returns true if two slices (order-dependant) of deposit records are
+// equal.
+func (r DepositRecords) Equal(other DepositRecords) bool {
+	if len(r) != len(other) {
+		return false
+	}
+
+	for i, record := range r {
+		if record.String() != other[i].String() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -14,4 +14,9 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 10, "metadata too long")
+	ErrMinDepositTooSmall      = sdkerrors.Register(ModuleName, 11, "initial deposit is too small")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 12, "invalid proposer")
+	ErrCancelTooLate           = sdkerrors.Register(ModuleName, 13, "proposal can no longer be cancelled")
+	ErrInvalidVoteOptions      = sdkerrors.Register(ModuleName, 14, "invalid vote options")
 )
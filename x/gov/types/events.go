@@ -7,6 +7,7 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeCancelProposal   = "cancel_proposal"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
@@ -18,4 +19,21 @@ const (
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
 	AttributeKeyProposalType       = "proposal_type"
+	AttributeKeyExpedited          = "expedited"
+	AttributeKeyMetadata           = "metadata"
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // converted to a regular proposal
+
+	AttributeKeyProposer              = "proposer"
+	AttributeKeyCancellationFee       = "cancellation_fee"
+	AttributeKeyCancellationFeeBurned = "cancellation_fee_burned"
+
+	AttributeKeyDeposits                   = "deposits"
+	AttributeKeyDepositsReason             = "deposits_reason"
+	AttributeValueDepositsBurned           = "burned"
+	AttributeValueDepositsRefunded         = "refunded"
+	AttributeValueDepositsReasonMinDeposit = "min_deposit_not_reached" // dropped before voting period
+	AttributeValueDepositsReasonQuorum     = "quorum_not_reached"
+	AttributeValueDepositsReasonVeto       = "vetoed"
+	AttributeValueDepositsReasonTally      = "tallied" // passed or rejected by the votes
 )
@@ -34,7 +34,8 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		data.DepositRecords.Equal(other.DepositRecords)
 }
 
 // Empty returns true if a GenesisState is empty
@@ -50,6 +51,12 @@ func ValidateGenesis(data *GenesisState) error {
 			threshold.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.LTE(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold.String())
+	}
+
 	veto := data.TallyParams.VetoThreshold
 	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
@@ -61,6 +68,47 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if !data.DepositParams.ExpeditedMinDeposit.IsValid() || data.DepositParams.ExpeditedMinDeposit.IsAllLTE(data.DepositParams.MinDeposit) {
+		return fmt.Errorf("governance expedited deposit amount must be a valid sdk.Coins amount greater than the deposit amount, is %s",
+			data.DepositParams.ExpeditedMinDeposit.String())
+	}
+
+	if data.DepositParams.MaxMetadataLen > MaxMetadataLength {
+		return fmt.Errorf("governance max metadata length should not exceed %d, is %d",
+			MaxMetadataLength, data.DepositParams.MaxMetadataLen)
+	}
+
+	minInitialDepositRatio := data.DepositParams.MinInitialDepositRatio
+	if minInitialDepositRatio.IsNil() || minInitialDepositRatio.IsNegative() || minInitialDepositRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance minimum initial deposit ratio should be positive and less or equal to one, is %s",
+			minInitialDepositRatio)
+	}
+
+	proposalCancelRatio := data.DepositParams.ProposalCancelRatio
+	if proposalCancelRatio.IsNil() || proposalCancelRatio.IsNegative() || proposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance proposal cancel ratio should be positive and less or equal to one, is %s",
+			proposalCancelRatio)
+	}
+
+	proposalCancelMaxPeriod := data.VotingParams.ProposalCancelMaxPeriod
+	if proposalCancelMaxPeriod.IsNil() || proposalCancelMaxPeriod.IsNegative() || proposalCancelMaxPeriod.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance proposal cancel max period should be positive and less or equal to one, is %s",
+			proposalCancelMaxPeriod)
+	}
+
+	expeditedVotingPeriod := data.VotingParams.ExpeditedVotingPeriod
+	if expeditedVotingPeriod <= 0 || expeditedVotingPeriod >= data.VotingParams.VotingPeriod {
+		return fmt.Errorf("governance expedited voting period should be positive and shorter than the voting period, is %s",
+			expeditedVotingPeriod.String())
+	}
+
+	for _, record := range data.DepositRecords {
+		if !record.IsSettled() {
+			return fmt.Errorf("governance deposit record of %s on proposal %d should be refunded or burned, is %s",
+				record.Depositor, record.ProposalId, record.Status)
+		}
+	}
+
 	return nil
 }
 
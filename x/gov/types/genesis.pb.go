@@ -39,6 +39,9 @@ type GenesisState struct {
 	VotingParams VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+	// deposit_records defines the records of the refunded and burned deposits
+	// which are not pruned yet.
+	DepositRecords DepositRecords `protobuf:"bytes,8,rep,name=deposit_records,json=depositRecords,proto3,castrepeated=DepositRecords" json:"deposit_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +126,13 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetDepositRecords() DepositRecords {
+	if m != nil {
+		return m.DepositRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1beta1.GenesisState")
 }
@@ -130,32 +140,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/genesis.proto", fileDescriptor_43cd825e0fa7a627) }
 
 var fileDescriptor_43cd825e0fa7a627 = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xda, 0x40,
-	0x14, 0x45, 0xed, 0x62, 0x28, 0x0c, 0x50, 0xb5, 0x23, 0x16, 0x16, 0x45, 0xc6, 0xed, 0xca, 0x9b,
-	0xda, 0x85, 0xae, 0xbb, 0xb1, 0x2a, 0xb5, 0xa8, 0x6a, 0x85, 0xdc, 0x2a, 0x8b, 0x6c, 0x90, 0xc1,
-	0x23, 0xc7, 0x0a, 0xf0, 0x2c, 0xbf, 0x89, 0x15, 0x76, 0xf9, 0x84, 0x7c, 0x47, 0xbe, 0x84, 0x25,
-	0xcb, 0xac, 0x92, 0x08, 0x7e, 0x24, 0xf2, 0x8c, 0x9d, 0x38, 0x8a, 0x93, 0x95, 0x3d, 0xf7, 0xde,
-	0x39, 0xba, 0xf3, 0xf4, 0x88, 0xb9, 0x00, 0x5c, 0x01, 0x3a, 0x21, 0xa4, 0x4e, 0x3a, 0x9a, 0x33,
-	0xee, 0x8f, 0x9c, 0x90, 0xad, 0x19, 0x46, 0x68, 0xc7, 0x09, 0x70, 0xa0, 0x54, 0x26, 0xec, 0x10,
-	0x52, 0x3b, 0x4f, 0xf4, 0x7b, 0x21, 0x84, 0x20, 0x6c, 0x27, 0xfb, 0x93, 0xc9, 0xfe, 0xa0, 0x8a,
-	0x05, 0xa9, 0x74, 0x3f, 0x5f, 0x68, 0xa4, 0xf3, 0x53, 0x92, 0xff, 0x71, 0x9f, 0x33, 0xfa, 0x95,
-	0xf4, 0x90, 0xfb, 0x09, 0x8f, 0xd6, 0xe1, 0x2c, 0x4e, 0x20, 0x06, 0xf4, 0x97, 0xb3, 0x28, 0xd0,
-	0x55, 0x53, 0xb5, 0x34, 0x8f, 0x16, 0xde, 0x34, 0xb7, 0x26, 0x01, 0x9d, 0x90, 0x66, 0xc0, 0x62,
-	0xc0, 0x88, 0xa3, 0xfe, 0xc6, 0xac, 0x59, 0xed, 0xf1, 0x47, 0xfb, 0x79, 0x3b, 0xfb, 0x87, 0xcc,
-	0xb8, 0xef, 0xb7, 0x37, 0x43, 0xe5, 0xea, 0x76, 0xd8, 0xcc, 0x05, 0xf4, 0x1e, 0xae, 0xd3, 0xef,
-	0xa4, 0x9e, 0x02, 0x67, 0xa8, 0xd7, 0x04, 0x47, 0xaf, 0xe2, 0x1c, 0x01, 0x67, 0x6e, 0x37, 0x87,
-	0xd4, 0xb3, 0x13, 0x7a, 0xf2, 0x16, 0xfd, 0x43, 0x5a, 0x45, 0x65, 0xd4, 0x35, 0x81, 0x18, 0x54,
-	0x21, 0x8a, 0xf2, 0xee, 0x87, 0x1c, 0xd3, 0x2a, 0x14, 0xf4, 0x1e, 0x09, 0xf4, 0x2f, 0x79, 0x97,
-	0x37, 0x9b, 0xc5, 0x7e, 0xe2, 0xaf, 0x50, 0xaf, 0x9b, 0xaa, 0xd5, 0x1e, 0x7f, 0x7a, 0xe5, 0x79,
-	0x53, 0x11, 0x74, 0xb5, 0x0c, 0xec, 0x75, 0x83, 0xb2, 0x48, 0x7f, 0x93, 0x6e, 0x0a, 0x72, 0xb0,
-	0x12, 0xd7, 0x10, 0x38, 0xf3, 0x85, 0x57, 0x66, 0x53, 0x2e, 0xd3, 0x3a, 0x69, 0x49, 0xa3, 0xbf,
-	0x48, 0x87, 0xfb, 0xcb, 0xe5, 0xa6, 0x60, 0xbd, 0x15, 0xac, 0x61, 0x15, 0xeb, 0x7f, 0x96, 0x7b,
-	0x82, 0x6a, 0xf3, 0x92, 0xe4, 0x6e, 0xf7, 0x86, 0xba, 0xdb, 0x1b, 0xea, 0xdd, 0xde, 0x50, 0x2f,
-	0x0f, 0x86, 0xb2, 0x3b, 0x18, 0xca, 0xf5, 0xc1, 0x50, 0x8e, 0xad, 0x30, 0xe2, 0x27, 0x67, 0x73,
-	0x7b, 0x01, 0x2b, 0x27, 0xdf, 0x22, 0xf9, 0xf9, 0x82, 0xc1, 0xa9, 0x73, 0x2e, 0x56, 0x8a, 0x6f,
-	0x62, 0x86, 0xf3, 0x86, 0xd8, 0xa6, 0x6f, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x3b, 0x1a,
-	0xbf, 0xb9, 0x02, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x0e, 0xd2, 0x40,
+	0x10, 0xc6, 0x5b, 0x29, 0x08, 0xcb, 0x1f, 0x75, 0x43, 0x4c, 0x83, 0xa4, 0x54, 0x4f, 0xbd, 0xd8,
+	0x0a, 0x9e, 0xbd, 0x34, 0x26, 0x4a, 0x8c, 0x86, 0x54, 0xe3, 0xc1, 0x0b, 0x69, 0xe9, 0xa6, 0x36,
+	0x02, 0xd3, 0x74, 0xd6, 0x46, 0xde, 0xc2, 0xe7, 0xf0, 0x49, 0x38, 0x72, 0xf4, 0xa4, 0x06, 0x7c,
+	0x10, 0xd3, 0xdd, 0xad, 0x40, 0xac, 0x7a, 0x6a, 0xf7, 0x9b, 0x6f, 0x7e, 0x99, 0x6f, 0x32, 0xc4,
+	0x5e, 0x01, 0x6e, 0x00, 0xbd, 0x04, 0x0a, 0xaf, 0x98, 0x46, 0x8c, 0x87, 0x53, 0x2f, 0x61, 0x5b,
+	0x86, 0x29, 0xba, 0x59, 0x0e, 0x1c, 0x28, 0x95, 0x0e, 0x37, 0x81, 0xc2, 0x55, 0x8e, 0xd1, 0x30,
+	0x81, 0x04, 0x44, 0xd9, 0x2b, 0xff, 0xa4, 0x73, 0x34, 0xae, 0x63, 0x41, 0x21, 0xab, 0x0f, 0x7e,
+	0x1a, 0xa4, 0xf7, 0x4c, 0x92, 0x5f, 0xf3, 0x90, 0x33, 0xfa, 0x88, 0x0c, 0x91, 0x87, 0x39, 0x4f,
+	0xb7, 0xc9, 0x32, 0xcb, 0x21, 0x03, 0x0c, 0xd7, 0xcb, 0x34, 0x36, 0x75, 0x5b, 0x77, 0x8c, 0x80,
+	0x56, 0xb5, 0x85, 0x2a, 0xcd, 0x63, 0x3a, 0x27, 0xed, 0x98, 0x65, 0x80, 0x29, 0x47, 0xf3, 0x86,
+	0xdd, 0x70, 0xba, 0xb3, 0x7b, 0xee, 0x9f, 0xd3, 0xb9, 0x4f, 0xa5, 0xc7, 0xbf, 0xbd, 0xff, 0x36,
+	0xd1, 0xbe, 0x7c, 0x9f, 0xb4, 0x95, 0x80, 0xc1, 0xef, 0x76, 0xfa, 0x84, 0x34, 0x0b, 0xe0, 0x0c,
+	0xcd, 0x86, 0xe0, 0x98, 0x75, 0x9c, 0xb7, 0xc0, 0x99, 0xdf, 0x57, 0x90, 0x66, 0xf9, 0xc2, 0x40,
+	0x76, 0xd1, 0x97, 0xa4, 0x53, 0x8d, 0x8c, 0xa6, 0x21, 0x10, 0xe3, 0x3a, 0x44, 0x35, 0xbc, 0x7f,
+	0x47, 0x61, 0x3a, 0x95, 0x82, 0xc1, 0x99, 0x40, 0x5f, 0x91, 0x81, 0x9a, 0x6c, 0x99, 0x85, 0x79,
+	0xb8, 0x41, 0xb3, 0x69, 0xeb, 0x4e, 0x77, 0x76, 0xff, 0x1f, 0xf1, 0x16, 0xc2, 0xe8, 0x1b, 0x25,
+	0x38, 0xe8, 0xc7, 0x97, 0x22, 0x7d, 0x41, 0xfa, 0x05, 0xc8, 0xc5, 0x4a, 0x5c, 0x4b, 0xe0, 0xec,
+	0xbf, 0xa4, 0x2c, 0xb7, 0x7c, 0x49, 0xeb, 0x15, 0x17, 0x1a, 0x7d, 0x4e, 0x7a, 0x3c, 0x5c, 0xaf,
+	0x77, 0x15, 0xeb, 0xa6, 0x60, 0x4d, 0xea, 0x58, 0x6f, 0x4a, 0xdf, 0x15, 0xaa, 0xcb, 0xcf, 0x12,
+	0x8d, 0xc8, 0xad, 0x2a, 0x66, 0xce, 0x56, 0x90, 0xc7, 0x68, 0xb6, 0xed, 0xc6, 0x7f, 0x72, 0x06,
+	0xc2, 0xe9, 0xdf, 0x55, 0x0b, 0x1c, 0x5c, 0xc9, 0x18, 0x0c, 0xe2, 0xab, 0xb7, 0xef, 0xef, 0x8f,
+	0x96, 0x7e, 0x38, 0x5a, 0xfa, 0x8f, 0xa3, 0xa5, 0x7f, 0x3e, 0x59, 0xda, 0xe1, 0x64, 0x69, 0x5f,
+	0x4f, 0x96, 0xf6, 0xce, 0x49, 0x52, 0xfe, 0xfe, 0x63, 0xe4, 0xae, 0x60, 0xe3, 0xa9, 0x4b, 0x95,
+	0x9f, 0x87, 0x18, 0x7f, 0xf0, 0x3e, 0x89, 0xb3, 0xe5, 0xbb, 0x8c, 0x61, 0xd4, 0x12, 0x17, 0xfb,
+	0xf8, 0xd7, 0x00, 0x83, 0x90, 0xe0, 0x08, 0x1d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +189,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DepositRecords) > 0 {
+		for iNdEx := len(m.DepositRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -302,6 +327,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DepositRecords) > 0 {
+		for _, e := range m.DepositRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -560,6 +591,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRecords = append(m.DepositRecords, DepositRecord{})
+			if err := m.DepositRecords[len(m.DepositRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,8 +2,11 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +23,71 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisParams(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*GenesisState)
+		expErr   bool
+	}{
+		{"default", func(*GenesisState) {}, false},
+		{"expedited threshold equal to threshold", func(gs *GenesisState) {
+			gs.TallyParams.ExpeditedThreshold = gs.TallyParams.Threshold
+		}, true},
+		{"expedited threshold above one", func(gs *GenesisState) {
+			gs.TallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(11, 1)
+		}, true},
+		{"expedited min deposit equal to min deposit", func(gs *GenesisState) {
+			gs.DepositParams.ExpeditedMinDeposit = gs.DepositParams.MinDeposit
+		}, true},
+		{"expedited voting period equal to voting period", func(gs *GenesisState) {
+			gs.VotingParams.ExpeditedVotingPeriod = gs.VotingParams.VotingPeriod
+		}, true},
+		{"zero expedited voting period", func(gs *GenesisState) {
+			gs.VotingParams.ExpeditedVotingPeriod = time.Duration(0)
+		}, true},
+		{"zero max metadata length", func(gs *GenesisState) {
+			gs.DepositParams.MaxMetadataLen = 0
+		}, false},
+		{"max metadata length at the limit", func(gs *GenesisState) {
+			gs.DepositParams.MaxMetadataLen = MaxMetadataLength
+		}, false},
+		{"max metadata length above the limit", func(gs *GenesisState) {
+			gs.DepositParams.MaxMetadataLen = MaxMetadataLength + 1
+		}, true},
+		{"min initial deposit ratio of one", func(gs *GenesisState) {
+			gs.DepositParams.MinInitialDepositRatio = sdk.OneDec()
+		}, false},
+		{"negative min initial deposit ratio", func(gs *GenesisState) {
+			gs.DepositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(-1, 2)
+		}, true},
+		{"min initial deposit ratio above one", func(gs *GenesisState) {
+			gs.DepositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(101, 2)
+		}, true},
+		{"settled deposit records", func(gs *GenesisState) {
+			deposit := NewDeposit(1, sdk.AccAddress("depositor"), sdk.NewCoins())
+			gs.DepositRecords = DepositRecords{
+				NewDepositRecord(deposit, DepositStatusRefunded, 10),
+				NewDepositRecord(deposit, DepositStatusBurned, 10),
+			}
+		}, false},
+		{"active deposit record", func(gs *GenesisState) {
+			deposit := NewDeposit(1, sdk.AccAddress("depositor"), sdk.NewCoins())
+			gs.DepositRecords = DepositRecords{NewDepositRecord(deposit, DepositStatusActive, 0)}
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			tc.malleate(gs)
+
+			err := ValidateGenesis(gs)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -36,16 +36,25 @@ const (
 //
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
+// - 0x11<depositorAddrLen (1 Byte)><depositorAddr_Bytes><proposalID_Bytes>: DepositRecord
+//
+// - 0x12<pruneHeight_Bytes><proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: []byte{}
+//
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x21<voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID_Bytes>: []byte{}
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
 	InactiveProposalQueuePrefix = []byte{0x02}
 	ProposalIDKey               = []byte{0x03}
 
-	DepositsKeyPrefix = []byte{0x10}
+	DepositsKeyPrefix             = []byte{0x10}
+	DepositRecordsKeyPrefix       = []byte{0x11}
+	DepositRecordPruneQueuePrefix = []byte{0x12}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix      = []byte{0x20}
+	VoterVotesKeyPrefix = []byte{0x21}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -97,6 +106,30 @@ func DepositKey(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
 	return append(DepositsKey(proposalID), address.MustLengthPrefix(depositorAddr.Bytes())...)
 }
 
+// DepositorDepositsKey gets the first part of the deposit records key based on
+// the depositor address
+func DepositorDepositsKey(depositorAddr sdk.AccAddress) []byte {
+	return append(DepositRecordsKeyPrefix, address.MustLengthPrefix(depositorAddr.Bytes())...)
+}
+
+// DepositRecordKey key of a specific deposit record from the store
+func DepositRecordKey(depositorAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(DepositorDepositsKey(depositorAddr), GetProposalIDBytes(proposalID)...)
+}
+
+// DepositRecordPruneByHeightKey gets the deposit record prune queue key by
+// prune height
+func DepositRecordPruneByHeightKey(height int64) []byte {
+	return append(DepositRecordPruneQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// DepositRecordPruneQueueKey returns the key of a deposit record in the
+// deposit record prune queue
+func DepositRecordPruneQueueKey(height int64, proposalID uint64, depositorAddr sdk.AccAddress) []byte {
+	return append(append(DepositRecordPruneByHeightKey(height), GetProposalIDBytes(proposalID)...),
+		address.MustLengthPrefix(depositorAddr.Bytes())...)
+}
+
 // VotesKey gets the first part of the votes key based on the proposalID
 func VotesKey(proposalID uint64) []byte {
 	return append(VotesKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -107,6 +140,17 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// VoterVotesKey gets the first part of the voter votes index key based on the
+// voter address
+func VoterVotesKey(voterAddr sdk.AccAddress) []byte {
+	return append(VoterVotesKeyPrefix, address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// VoterVoteKey key of a specific vote in the voter votes index
+func VoterVoteKey(voterAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(VoterVotesKey(voterAddr), GetProposalIDBytes(proposalID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -136,6 +180,34 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitKeyVoterVote split the voter votes index key and returns the voter
+// address and proposal id
+func SplitKeyVoterVote(key []byte) (voterAddr sdk.AccAddress, proposalID uint64) {
+	// <prefix (1 Byte)><voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID (8 bytes)>
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrLen := int(key[1])
+	kv.AssertKeyLength(key[2:], addrLen+8)
+	voterAddr = sdk.AccAddress(key[2 : 2+addrLen])
+	proposalID = GetProposalIDFromBytes(key[2+addrLen:])
+	return
+}
+
+// SplitKeyDepositRecord split the deposit records key and returns the
+// depositor address and proposal id
+func SplitKeyDepositRecord(key []byte) (depositorAddr sdk.AccAddress, proposalID uint64) {
+	return SplitKeyVoterVote(key)
+}
+
+// SplitDepositRecordPruneQueueKey split the deposit record prune queue key and
+// returns the prune height, proposal id and depositor address
+func SplitDepositRecordPruneQueueKey(key []byte) (height int64, proposalID uint64, depositorAddr sdk.AccAddress) {
+	// <prefix (1 Byte)><height (8 Bytes)><proposalID (8 Bytes)><depositorAddrLen (1 Byte)><depositorAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 18)
+	height = int64(sdk.BigEndianToUint64(key[1:9]))
+	proposalID, depositorAddr = splitKeyWithAddress(key[8:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
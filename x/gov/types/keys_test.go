@@ -46,6 +46,21 @@ func TestDepositKeys(t *testing.T) {
 	proposalID, depositorAddr := SplitKeyDeposit(key)
 	require.Equal(t, int(proposalID), 2)
 	require.Equal(t, addr, depositorAddr)
+
+	key = DepositRecordKey(addr, 3)
+	depositorAddr, proposalID = SplitKeyDepositRecord(key)
+	require.Equal(t, addr, depositorAddr)
+	require.Equal(t, int(proposalID), 3)
+
+	key = DepositRecordPruneQueueKey(100, 3, addr)
+	height, proposalID, depositorAddr := SplitDepositRecordPruneQueueKey(key)
+	require.Equal(t, int64(100), height)
+	require.Equal(t, int(proposalID), 3)
+	require.Equal(t, addr, depositorAddr)
+
+	// malformed keys should panic
+	require.Panics(t, func() { SplitKeyDepositRecord(DepositorDepositsKey(addr)) })
+	require.Panics(t, func() { SplitDepositRecordPruneQueueKey(DepositRecordPruneByHeightKey(100)) })
 }
 
 func TestVoteKeys(t *testing.T) {
@@ -58,4 +73,12 @@ func TestVoteKeys(t *testing.T) {
 	proposalID, voterAddr := SplitKeyDeposit(key)
 	require.Equal(t, int(proposalID), 2)
 	require.Equal(t, addr, voterAddr)
+
+	key = VoterVoteKey(addr, 3)
+	voterAddr, proposalID = SplitKeyVoterVote(key)
+	require.Equal(t, addr, voterAddr)
+	require.Equal(t, int(proposalID), 3)
+
+	// malformed key should panic
+	require.Panics(t, func() { SplitKeyVoterVote(VoterVotesKey(addr)) })
 }
@@ -18,11 +18,17 @@ const (
 	TypeMsgVote           = "vote"
 	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
 )
 
+// MaxMetadataLength is the upper bound on the length in bytes of the metadata
+// attached to governance messages. The max_metadata_len deposit param narrows
+// it down further when the messages are executed.
+const MaxMetadataLength = 10000
+
 var (
-	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
-	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgCancelProposal{}
+	_             types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -62,6 +68,18 @@ func (m *MsgSubmitProposal) SetProposer(address fmt.Stringer) {
 	m.Proposer = address.String()
 }
 
+func (m *MsgSubmitProposal) SetExpedited(expedited bool) {
+	m.Expedited = expedited
+}
+
+func (m *MsgSubmitProposal) SetMetadata(metadata string) {
+	m.Metadata = metadata
+}
+
+func (m *MsgSubmitProposal) SetVoteOptions(voteOptions []string) {
+	m.VoteOptions = voteOptions
+}
+
 func (m *MsgSubmitProposal) SetContent(content Content) error {
 	msg, ok := content.(proto.Message)
 	if !ok {
@@ -103,8 +121,11 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 	if err := content.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := ValidateMultipleChoiceProposal(content, m.Expedited, m.VoteOptions); err != nil {
+		return err
+	}
 
-	return nil
+	return validateMetadata(m.Metadata)
 }
 
 // GetSignBytes implements Msg
@@ -134,7 +155,7 @@ func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 // NewMsgDeposit creates a new MsgDeposit instance
 //nolint:interfacer
 func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) *MsgDeposit {
-	return &MsgDeposit{proposalID, depositor.String(), amount}
+	return &MsgDeposit{ProposalId: proposalID, Depositor: depositor.String(), Amount: amount}
 }
 
 // Route implements Msg
@@ -155,7 +176,7 @@ func (msg MsgDeposit) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
 	}
 
-	return nil
+	return validateMetadata(msg.Metadata)
 }
 
 // String implements the Stringer interface
@@ -176,10 +197,49 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{depositor}
 }
 
+// NewMsgCancelProposal creates a new MsgCancelProposal instance
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{ProposalId: proposalID, Proposer: proposer.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelProposal) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
 // NewMsgVote creates a message to cast a vote on an active proposal
 //nolint:interfacer
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option}
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option}
 }
 
 // Route implements Msg
@@ -193,11 +253,11 @@ func (msg MsgVote) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
-	if !ValidVoteOption(msg.Option) {
-		return sdkerrors.Wrap(ErrInvalidVote, msg.Option.String())
+	if !ValidWeightedVoteOption(WeightedVoteOption{Option: msg.Option, Weight: sdk.OneDec(), OptionIndex: msg.OptionIndex}) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "%s with option index %d", msg.Option, msg.OptionIndex)
 	}
 
-	return nil
+	return validateMetadata(msg.Metadata)
 }
 
 // String implements the Stringer interface
@@ -221,7 +281,7 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 // NewMsgVoteWeighted creates a message to cast a vote on an active proposal
 //nolint:interfacer
 func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
-	return &MsgVoteWeighted{proposalID, voter.String(), options}
+	return &MsgVoteWeighted{ProposalId: proposalID, Voter: voter.String(), Options: options}
 }
 
 // Route implements Msg
@@ -239,17 +299,23 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, WeightedVoteOptions(msg.Options).String())
 	}
 
+	type optionKey struct {
+		option VoteOption
+		index  uint32
+	}
+
 	totalWeight := sdk.NewDec(0)
-	usedOptions := make(map[VoteOption]bool)
+	usedOptions := make(map[optionKey]bool)
 	for _, option := range msg.Options {
 		if !ValidWeightedVoteOption(option) {
 			return sdkerrors.Wrap(ErrInvalidVote, option.String())
 		}
 		totalWeight = totalWeight.Add(option.Weight)
-		if usedOptions[option.Option] {
+		key := optionKey{option.Option, option.OptionIndex}
+		if usedOptions[key] {
 			return sdkerrors.Wrap(ErrInvalidVote, "Duplicated vote option")
 		}
-		usedOptions[option.Option] = true
+		usedOptions[key] = true
 	}
 
 	if totalWeight.GT(sdk.NewDec(1)) {
@@ -260,7 +326,7 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidVote, "Total weight lower than 1.00")
 	}
 
-	return nil
+	return validateMetadata(msg.Metadata)
 }
 
 // String implements the Stringer interface
@@ -280,3 +346,13 @@ func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
 	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
 	return []sdk.AccAddress{voter}
 }
+
+// validateMetadata checks that the metadata attached to a message does not
+// exceed MaxMetadataLength. Empty metadata is always valid.
+func validateMetadata(metadata string) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrMetadataTooLong, "got %d bytes, max %d", len(metadata), MaxMetadataLength)
+	}
+
+	return nil
+}
@@ -59,6 +59,36 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposalVoteOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     Content
+		expedited   bool
+		voteOptions []string
+		expectPass  bool
+	}{
+		{"standard proposal", NewTextProposal("Test", "description"), false, nil, true},
+		{"multiple-choice", NewTextProposal("Test", "description"), false, []string{"red", "blue"}, true},
+		{"single option", NewTextProposal("Test", "description"), false, []string{"red"}, false},
+		{"blank option", NewTextProposal("Test", "description"), false, []string{"red", " "}, false},
+		{"duplicated option", NewTextProposal("Test", "description"), false, []string{"red", "blue", "red"}, false},
+		{"expedited", NewTextProposal("Test", "description"), true, []string{"red", "blue"}, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := NewMsgSubmitProposal(tc.content, coinsPos, addrs[0])
+		require.NoError(t, err)
+		msg.SetExpedited(tc.expedited)
+		msg.SetVoteOptions(tc.voteOptions)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -92,6 +122,37 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+func TestMsgCancelProposalGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	msg := NewMsgCancelProposal(1, addr)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgCancelProposal","value":{"proposal_id":"1","proposer":"cosmos1v9jxgu33kfsgr5"}}`
+	require.Equal(t, expected, string(res))
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{1, addrs[0], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposalID, tc.proposerAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgVote
 func TestMsgVote(t *testing.T) {
 	tests := []struct {
@@ -150,6 +211,18 @@ func TestMsgVoteWeighted(t *testing.T) {
 		{0, addrs[0], WeightedVoteOptions{ // weight sum <1
 			WeightedVoteOption{Option: OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
 		}, false},
+		{0, addrs[0], NewMultipleChoiceVoteOption(1), true},
+		{0, addrs[0], WeightedVoteOptions{ // split across named options
+			WeightedVoteOption{OptionIndex: 0, Weight: sdk.NewDecWithPrec(5, 1)},
+			WeightedVoteOption{OptionIndex: 2, Weight: sdk.NewDecWithPrec(5, 1)},
+		}, true},
+		{0, addrs[0], WeightedVoteOptions{ // duplicate named option
+			WeightedVoteOption{OptionIndex: 1, Weight: sdk.NewDecWithPrec(5, 1)},
+			WeightedVoteOption{OptionIndex: 1, Weight: sdk.NewDecWithPrec(5, 1)},
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{ // standard option with an index
+			WeightedVoteOption{Option: OptionYes, OptionIndex: 1, Weight: sdk.NewDec(1)},
+		}, false},
 	}
 
 	for i, tc := range tests {
@@ -162,6 +235,42 @@ func TestMsgVoteWeighted(t *testing.T) {
 	}
 }
 
+func TestMsgsMetadata(t *testing.T) {
+	tests := []struct {
+		name       string
+		metadata   string
+		expectPass bool
+	}{
+		{"empty", "", true},
+		{"max length", strings.Repeat("a", MaxMetadataLength), true},
+		{"too long", strings.Repeat("a", MaxMetadataLength+1), false},
+	}
+
+	for _, tc := range tests {
+		proposal, err := NewMsgSubmitProposal(NewTextProposal("Test Proposal", "the purpose of this proposal is to test"), coinsPos, addrs[0])
+		require.NoError(t, err)
+		proposal.SetMetadata(tc.metadata)
+
+		deposit := NewMsgDeposit(addrs[0], 1, coinsPos)
+		deposit.Metadata = tc.metadata
+
+		vote := NewMsgVote(addrs[0], 1, OptionYes)
+		vote.Metadata = tc.metadata
+
+		weightedVote := NewMsgVoteWeighted(addrs[0], 1, NewNonSplitVoteOption(OptionYes))
+		weightedVote.Metadata = tc.metadata
+
+		for _, msg := range []sdk.Msg{proposal, deposit, vote, weightedVote} {
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err, "%s: %T", tc.name, msg)
+			} else {
+				require.ErrorIs(t, err, ErrMetadataTooLong, "%s: %T", tc.name, msg)
+			}
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
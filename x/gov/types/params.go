@@ -12,15 +12,44 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
+// Default conditions for burning deposits
+const (
+	DefaultBurnVoteQuorum             = true
+	DefaultBurnProposalDepositPrevote = true
+	DefaultBurnVoteVeto               = true
+	DefaultProposalCancelBurn         = true
+)
+
+// DefaultMaxMetadataLen is the default maximum length in bytes of the metadata
+// attached to proposals, votes and deposits, enough for an IPFS CID or a small
+// JSON document.
+const DefaultMaxMetadataLen uint64 = 255
+
+// Default bounds of the vote options of multiple-choice proposals
+const (
+	DefaultMaxVoteOptions   uint64 = 10
+	DefaultMaxVoteOptionLen uint64 = 100
+)
+
+// DefaultDepositRecordRetention is the default number of blocks for which the
+// record of a refunded or burned deposit is kept, about a week of 6s blocks.
+const DefaultDepositRecordRetention uint64 = 100800
+
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens = sdk.NewInt(50000000)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultMinInitialDepositRatio    = sdk.ZeroDec()
+	DefaultProposalCancelRatio       = sdk.NewDecWithPrec(5, 1)
+	DefaultProposalCancelMaxPeriod   = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -40,10 +69,26 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins,
+	burnVoteQuorum, burnProposalDepositPrevote, burnVoteVeto bool, maxMetadataLen uint64,
+	minInitialDepositRatio, proposalCancelRatio sdk.Dec, proposalCancelBurn bool,
+	maxVoteOptions, maxVoteOptionLen, depositRecordRetention uint64,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:                 minDeposit,
+		MaxDepositPeriod:           maxDepositPeriod,
+		ExpeditedMinDeposit:        expeditedMinDeposit,
+		BurnVoteQuorum:             burnVoteQuorum,
+		BurnProposalDepositPrevote: burnProposalDepositPrevote,
+		BurnVoteVeto:               burnVoteVeto,
+		MaxMetadataLen:             maxMetadataLen,
+		MinInitialDepositRatio:     minInitialDepositRatio,
+		ProposalCancelRatio:        proposalCancelRatio,
+		ProposalCancelBurn:         proposalCancelBurn,
+		MaxVoteOptions:             maxVoteOptions,
+		MaxVoteOptionLen:           maxVoteOptionLen,
+		DepositRecordRetention:     depositRecordRetention,
 	}
 }
 
@@ -52,9 +97,41 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
+		DefaultBurnVoteQuorum,
+		DefaultBurnProposalDepositPrevote,
+		DefaultBurnVoteVeto,
+		DefaultMaxMetadataLen,
+		DefaultMinInitialDepositRatio,
+		DefaultProposalCancelRatio,
+		DefaultProposalCancelBurn,
+		DefaultMaxVoteOptions,
+		DefaultMaxVoteOptionLen,
+		DefaultDepositRecordRetention,
 	)
 }
 
+// GetMinDeposit returns the minimum deposit for a proposal to enter voting
+// period, depending on whether it is expedited.
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
+}
+
+// GetMinInitialDeposit returns the minimum deposit a proposal must be submitted
+// with, i.e. the MinInitialDepositRatio fraction of its minimum deposit rounded
+// up, depending on whether it is expedited.
+func (dp DepositParams) GetMinInitialDeposit(expedited bool) sdk.Coins {
+	minInitialDeposit := sdk.NewCoins()
+	for _, coin := range dp.GetMinDeposit(expedited) {
+		amount := coin.Amount.ToDec().Mul(dp.MinInitialDepositRatio).Ceil().TruncateInt()
+		minInitialDeposit = minInitialDeposit.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return minInitialDeposit
+}
+
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	out, _ := yaml.Marshal(dp)
@@ -63,7 +140,13 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit) && dp.BurnVoteQuorum == dp2.BurnVoteQuorum &&
+		dp.BurnProposalDepositPrevote == dp2.BurnProposalDepositPrevote && dp.BurnVoteVeto == dp2.BurnVoteVeto &&
+		dp.MaxMetadataLen == dp2.MaxMetadataLen && dp.MinInitialDepositRatio.Equal(dp2.MinInitialDepositRatio) &&
+		dp.ProposalCancelRatio.Equal(dp2.ProposalCancelRatio) && dp.ProposalCancelBurn == dp2.ProposalCancelBurn &&
+		dp.MaxVoteOptions == dp2.MaxVoteOptions && dp.MaxVoteOptionLen == dp2.MaxVoteOptionLen &&
+		dp.DepositRecordRetention == dp2.DepositRecordRetention
 }
 
 func validateDepositParams(i interface{}) error {
@@ -78,27 +161,65 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if v.ExpeditedMinDeposit.IsAllLTE(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than the minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if v.MaxMetadataLen > MaxMetadataLength {
+		return fmt.Errorf("maximum metadata length must not exceed %d: %d", MaxMetadataLength, v.MaxMetadataLen)
+	}
+	if v.MinInitialDepositRatio.IsNil() {
+		return fmt.Errorf("minimum initial deposit ratio cannot be nil")
+	}
+	if v.MinInitialDepositRatio.IsNegative() {
+		return fmt.Errorf("minimum initial deposit ratio cannot be negative: %s", v.MinInitialDepositRatio)
+	}
+	if v.MinInitialDepositRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum initial deposit ratio too large: %s", v.MinInitialDepositRatio)
+	}
+	if v.ProposalCancelRatio.IsNil() {
+		return fmt.Errorf("proposal cancel ratio cannot be nil")
+	}
+	if v.ProposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", v.ProposalCancelRatio)
+	}
+	if v.ProposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", v.ProposalCancelRatio)
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum,
-		Threshold:     threshold,
-		VetoThreshold: vetoThreshold,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		VetoThreshold:      vetoThreshold,
+		ExpeditedThreshold: expeditedThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
+}
+
+// GetThreshold returns the minimum proportion of Yes votes for a proposal to
+// pass, depending on whether it is expedited.
+func (tp TallyParams) GetThreshold(expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedThreshold
+	}
+	return tp.Threshold
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold)
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold) &&
+		tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold)
 }
 
 // String implements stringer insterface
@@ -131,25 +252,51 @@ func validateTallyParams(i interface{}) error {
 	if v.VetoThreshold.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedThreshold.LTE(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s", v.ExpeditedThreshold)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
 
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration, proposalCancelMaxPeriod sdk.Dec) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:            votingPeriod,
+		ExpeditedVotingPeriod:   expeditedVotingPeriod,
+		ProposalCancelMaxPeriod: proposalCancelMaxPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod, DefaultProposalCancelMaxPeriod)
+}
+
+// GetVotingPeriod returns the length of the voting period of a proposal,
+// depending on whether it is expedited.
+func (vp VotingParams) GetVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
+}
+
+// GetProposalCancelCutoff returns the time until which a proposal whose voting
+// period spans the given times can be cancelled by its proposer.
+func (vp VotingParams) GetProposalCancelCutoff(votingStartTime, votingEndTime time.Time) time.Time {
+	votingPeriod := votingEndTime.Sub(votingStartTime)
+	cancelPeriod := vp.ProposalCancelMaxPeriod.MulInt64(int64(votingPeriod)).TruncateInt64()
+	return votingStartTime.Add(time.Duration(cancelPeriod))
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod &&
+		vp.ProposalCancelMaxPeriod.Equal(other.ProposalCancelMaxPeriod)
 }
 
 // String implements stringer interface
@@ -167,6 +314,21 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period must be shorter than the voting period: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ProposalCancelMaxPeriod.IsNil() {
+		return fmt.Errorf("proposal cancel max period cannot be nil")
+	}
+	if v.ProposalCancelMaxPeriod.IsNegative() {
+		return fmt.Errorf("proposal cancel max period cannot be negative: %s", v.ProposalCancelMaxPeriod)
+	}
+	if v.ProposalCancelMaxPeriod.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel max period too large: %s", v.ProposalCancelMaxPeriod)
+	}
 
 	return nil
 }
@@ -86,6 +86,56 @@ func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return unpacker.UnpackAny(p.Content, &content)
 }
 
+// IsMultipleChoice returns whether the proposal is voted on with its own named
+// vote options instead of the standard ones.
+func (p Proposal) IsMultipleChoice() bool {
+	return len(p.VoteOptions) > 0
+}
+
+// ValidWeightedVoteOption returns true if the sub vote is valid on the
+// proposal: it must reference one of its vote options if the proposal is a
+// multiple-choice one, and be a standard option otherwise.
+func (p Proposal) ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !ValidWeightedVoteOption(option) {
+		return false
+	}
+	if p.IsMultipleChoice() {
+		return option.Option == OptionEmpty && int(option.OptionIndex) < len(p.VoteOptions)
+	}
+	return option.Option != OptionEmpty
+}
+
+// ValidateMultipleChoiceProposal checks the vote options of a proposal, if
+// any: only text proposals which are not expedited can be multiple-choice
+// ones, and they need at least two distinct and non-empty vote options.
+func ValidateMultipleChoiceProposal(content Content, expedited bool, voteOptions []string) error {
+	if len(voteOptions) == 0 {
+		return nil
+	}
+	if content.ProposalType() != ProposalTypeText {
+		return sdkerrors.Wrapf(ErrInvalidVoteOptions, "multiple-choice proposals must be text proposals, got %s", content.ProposalType())
+	}
+	if expedited {
+		return sdkerrors.Wrap(ErrInvalidVoteOptions, "multiple-choice proposals cannot be expedited")
+	}
+	if len(voteOptions) < 2 {
+		return sdkerrors.Wrap(ErrInvalidVoteOptions, "multiple-choice proposals need at least 2 vote options")
+	}
+
+	usedOptions := make(map[string]bool, len(voteOptions))
+	for _, option := range voteOptions {
+		if strings.TrimSpace(option) == "" {
+			return sdkerrors.Wrap(ErrInvalidVoteOptions, "vote option cannot be blank")
+		}
+		if usedOptions[option] {
+			return sdkerrors.Wrapf(ErrInvalidVoteOptions, "duplicated vote option %s", option)
+		}
+		usedOptions[option] = true
+	}
+
+	return nil
+}
+
 // Proposals is an array of proposal
 type Proposals []Proposal
 
@@ -149,7 +199,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusCancelled {
 		return true
 	}
 	return false
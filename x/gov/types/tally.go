@@ -55,6 +55,15 @@ func EmptyTallyResult() TallyResult {
 
 // Equals returns if two proposals are equal.
 func (tr TallyResult) Equals(comp TallyResult) bool {
+	if len(tr.Options) != len(comp.Options) {
+		return false
+	}
+	for i, option := range tr.Options {
+		if option.Name != comp.Options[i].Name || !option.Count.Equal(comp.Options[i].Count) {
+			return false
+		}
+	}
+
 	return tr.Yes.Equal(comp.Yes) &&
 		tr.Abstain.Equal(comp.Abstain) &&
 		tr.No.Equal(comp.No) &&
@@ -66,3 +75,9 @@ func (tr TallyResult) String() string {
 	out, _ := yaml.Marshal(tr)
 	return string(out)
 }
+
+// String implements stringer interface
+func (ot OptionTally) String() string {
+	out, _ := yaml.Marshal(ot)
+	return string(out)
+}
@@ -57,7 +57,13 @@ func (v Vote) Empty() bool {
 
 // NewNonSplitVoteOption creates a single option vote with weight 1
 func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
-	return WeightedVoteOptions{{option, sdk.NewDec(1)}}
+	return WeightedVoteOptions{{Option: option, Weight: sdk.NewDec(1)}}
+}
+
+// NewMultipleChoiceVoteOption creates a single option vote with weight 1 for
+// the option at the given index of a multiple-choice proposal.
+func NewMultipleChoiceVoteOption(optionIndex uint32) WeightedVoteOptions {
+	return WeightedVoteOptions{{Option: OptionEmpty, Weight: sdk.NewDec(1), OptionIndex: optionIndex}}
 }
 
 func (v WeightedVoteOption) String() string {
@@ -77,11 +83,16 @@ func (v WeightedVoteOptions) String() (out string) {
 }
 
 // ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
+// An empty option references an option of a multiple-choice proposal by its
+// index, which can only be checked against the proposal.
 func ValidWeightedVoteOption(option WeightedVoteOption) bool {
 	if !option.Weight.IsPositive() || option.Weight.GT(sdk.NewDec(1)) {
 		return false
 	}
-	return ValidVoteOption(option.Option)
+	if option.Option == OptionEmpty {
+		return true
+	}
+	return option.OptionIndex == 0 && ValidVoteOption(option.Option)
 }
 
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
@@ -111,7 +122,7 @@ func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
 		if err != nil {
 			return options, err
 		}
-		options = append(options, WeightedVoteOption{option, weight})
+		options = append(options, WeightedVoteOption{Option: option, Weight: weight})
 	}
 	return options, nil
 }
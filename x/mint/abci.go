@@ -17,15 +17,57 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
-	// recalculate inflation rate
-	totalStakingSupply := k.StakingTokenSupply(ctx)
-	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
-	k.SetMinter(ctx, minter)
+	// nothing is minted once the total supply of the mint denom reached the max
+	// supply, the remaining supply bounding the block provisions until then
+	capped := params.MaxSupply.IsPositive()
+	var remainingSupply sdk.Int
+	if capped {
+		remainingSupply = params.MaxSupply.Sub(k.TotalSupply(ctx, params.MintDenom))
+		if !remainingSupply.IsPositive() {
+			reachMaxSupply(ctx, k, minter, params)
+			return
+		}
+	}
+
+	// the reduction schedule overrides the inflation calculation from its first
+	// entry on, the annual provisions stepping at the boundary of each entry
+	position := params.SchedulePosition(ctx.BlockHeight(), ctx.BlockTime())
+
+	// recalculate inflation rate every BlocksPerRecalculation blocks, or as long
+	// as no annual provisions were calculated so that minting starts right away,
+	// and as soon as a boundary of the reduction schedule is reached
+	recalculate := minter.AnnualProvisions.IsZero() ||
+		ctx.BlockHeight()%int64(params.BlocksPerRecalculation) == 0 ||
+		position != minter.SchedulePosition
+
+	var attrs []sdk.Attribute
+	switch {
+	case recalculate && position > 0:
+		totalStakingSupply := k.StakingTokenSupply(ctx)
+		minter.AnnualProvisions = params.ReductionSchedule[position-1].AnnualProvisions
+		minter.Inflation = sdk.ZeroDec()
+		if totalStakingSupply.IsPositive() {
+			minter.Inflation = minter.AnnualProvisions.QuoInt(totalStakingSupply)
+		}
+		minter.SchedulePosition = position
+		k.SetMinter(ctx, minter)
+
+	case recalculate:
+		totalStakingSupply := k.StakingTokenSupply(ctx)
+		bondedRatio := k.BondedRatio(ctx)
+		minter.Inflation = ic(ctx, minter, params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+		minter.SchedulePosition = 0
+		k.SetMinter(ctx, minter)
+
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()))
+	}
 
 	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
+	if capped && mintedCoin.Amount.GT(remainingSupply) {
+		mintedCoin.Amount = remainingSupply
+	}
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -33,8 +75,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// send the minted coins to the destinations of the distribution proportions
+	amounts, err := k.DistributeMintedCoins(ctx, params.DistributionProportions, mintedCoin)
 	if err != nil {
 		panic(err)
 	}
@@ -43,13 +85,42 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
 	}
 
+	attrs = append(attrs,
+		sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
+		sdk.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+	)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMint, attrs...))
+
+	for i, dp := range params.DistributionProportions {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDistribution,
+				sdk.NewAttribute(types.AttributeKeyDestination, dp.Destination),
+				sdk.NewAttribute(types.AttributeKeyProportion, dp.Proportion.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amounts[i].Amount.String()),
+			),
+		)
+	}
+
+	if capped && mintedCoin.Amount.Equal(remainingSupply) {
+		reachMaxSupply(ctx, k, minter, params)
+	}
+}
+
+// reachMaxSupply zeroes the inflation and the annual provisions of the minter
+// once the max supply is reached, emitting an event the first time it is.
+func reachMaxSupply(ctx sdk.Context, k keeper.Keeper, minter types.Minter, params types.Params) {
+	if minter.Inflation.IsZero() && minter.AnnualProvisions.IsZero() {
+		return
+	}
+
+	k.SetMinter(ctx, types.NewMinter(sdk.ZeroDec(), sdk.ZeroDec()))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeMint,
-			sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
-			sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
-			sdk.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+			types.EventTypeMaxSupplyReached,
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, params.MaxSupply.String()),
 		),
 	)
 }
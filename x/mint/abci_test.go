@@ -0,0 +1,370 @@
+package mint_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func TestBeginBlockerInflationCalculationFn(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	// a fixed inflation schedule in place of the default bonded ratio curve
+	fixedInflation := sdk.NewDecWithPrec(5, 2)
+	var calledParams types.Params
+	var calledBondedRatio sdk.Dec
+	ic := func(_ sdk.Context, _ types.Minter, params types.Params, bondedRatio sdk.Dec) sdk.Dec {
+		calledParams, calledBondedRatio = params, bondedRatio
+		return fixedInflation
+	}
+	am := mint.NewAppModule(app.AppCodec(), app.MintKeeper, app.AccountKeeper, ic)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	params := app.MintKeeper.GetParams(ctx)
+	feesBefore := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom)
+	stakingSupply := app.MintKeeper.StakingTokenSupply(ctx)
+	am.BeginBlock(ctx, abcitypes.RequestBeginBlock{})
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	require.Equal(t, fixedInflation, minter.Inflation)
+	require.Equal(t, fixedInflation.MulInt(stakingSupply), minter.AnnualProvisions)
+	require.Equal(t, params, calledParams)
+	require.False(t, calledBondedRatio.IsNil())
+
+	feesAfter := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom)
+	require.Equal(t, minter.BlockProvision(params), feesAfter.Sub(feesBefore))
+}
+
+func TestBeginBlockerRecalculationCadence(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.BlocksPerRecalculation = 3
+	app.MintKeeper.SetParams(ctx, params)
+	app.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+	var recalculations []int64
+	ic := func(ctx sdk.Context, minter types.Minter, params types.Params, bondedRatio sdk.Dec) sdk.Dec {
+		recalculations = append(recalculations, ctx.BlockHeight())
+		return types.DefaultInflationCalculationFn(ctx, minter, params, bondedRatio)
+	}
+
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom)
+	for height := int64(1); height <= 7; height++ {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		mint.BeginBlocker(ctx, app.MintKeeper, ic)
+
+		// the block provisions are minted every block
+		minted := app.MintKeeper.GetMinter(ctx).BlockProvision(params)
+		require.True(t, minted.IsPositive())
+		require.Equal(t, supply.Add(minted), app.BankKeeper.GetSupply(ctx, params.MintDenom))
+		supply = supply.Add(minted)
+
+		// the bonded ratio is only reported when the inflation is recalculated
+		var mintEvents int
+		recalculated := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeMint {
+				continue
+			}
+			mintEvents++
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyBondedRatio {
+					recalculated = true
+				}
+			}
+		}
+		require.Equal(t, 1, mintEvents)
+		require.Equal(t, height == recalculations[len(recalculations)-1], recalculated)
+	}
+
+	// the first block recalculates as no annual provisions were calculated yet,
+	// then every BlocksPerRecalculation blocks
+	require.Equal(t, []int64{1, 3, 6}, recalculations)
+}
+
+func TestBeginBlockerDistributionProportions(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	// the minted tokens are split between the fee collector, the community pool
+	// and a module account
+	third := sdk.OneDec().QuoInt64(3)
+	params := app.MintKeeper.GetParams(ctx)
+	params.DistributionProportions = []types.DistributionProportion{
+		{Destination: types.DestinationFeeCollector, Proportion: sdk.OneDec().Sub(third).Sub(third)},
+		{Destination: types.DestinationCommunityPool, Proportion: third},
+		{Destination: nft.ModuleName, Proportion: third},
+	}
+	app.MintKeeper.SetParams(ctx, params)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	nftAccount := app.AccountKeeper.GetModuleAddress(nft.ModuleName)
+	feesBefore := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom)
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(params.MintDenom)
+	supplyBefore := app.BankKeeper.GetSupply(ctx, params.MintDenom)
+
+	mint.BeginBlocker(ctx, app.MintKeeper, types.DefaultInflationCalculationFn)
+
+	minted := app.BankKeeper.GetSupply(ctx, params.MintDenom).Sub(supplyBefore)
+	require.Equal(t, app.MintKeeper.GetMinter(ctx).BlockProvision(params), minted)
+
+	fees := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Sub(feesBefore)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(params.MintDenom).Sub(communityPoolBefore)
+	nftBalance := app.BankKeeper.GetBalance(ctx, nftAccount, params.MintDenom)
+	require.True(t, communityPool.IsInteger())
+	require.Equal(t, third.MulInt(minted.Amount).TruncateInt(), communityPool.TruncateInt())
+	require.Equal(t, third.MulInt(minted.Amount).TruncateInt(), nftBalance.Amount)
+	require.Equal(t, minted.Amount, fees.Amount.Add(communityPool.TruncateInt()).Add(nftBalance.Amount))
+
+	// the events record the amount sent to each destination
+	var destinations []string
+	var amounts []sdk.Int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMintDistribution {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeyDestination:
+				destinations = append(destinations, string(attr.Value))
+			case sdk.AttributeKeyAmount:
+				amount, ok := sdk.NewIntFromString(string(attr.Value))
+				require.True(t, ok)
+				amounts = append(amounts, amount)
+			}
+		}
+	}
+	require.Equal(t, []string{types.DestinationFeeCollector, types.DestinationCommunityPool, nft.ModuleName}, destinations)
+	require.Equal(t, []sdk.Int{fees.Amount, communityPool.TruncateInt(), nftBalance.Amount}, amounts)
+}
+
+func TestDistributeMintedCoinsRounding(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	third := sdk.OneDec().QuoInt64(3)
+	proportions := []types.DistributionProportion{
+		{Destination: types.DestinationCommunityPool, Proportion: sdk.OneDec().Sub(third).Sub(third)},
+		{Destination: types.DestinationFeeCollector, Proportion: third},
+		{Destination: nft.ModuleName, Proportion: third},
+	}
+
+	for _, tc := range []struct {
+		minted     int64
+		expAmounts []int64
+	}{
+		{0, []int64{0, 0, 0}},
+		{1, []int64{1, 0, 0}},
+		{2, []int64{2, 0, 0}},
+		{100, []int64{34, 33, 33}},
+		{1000001, []int64{333335, 333333, 333333}},
+	} {
+		minted := sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.minted)
+		require.NoError(t, app.MintKeeper.MintCoins(ctx, sdk.NewCoins(minted)))
+
+		amounts, err := app.MintKeeper.DistributeMintedCoins(ctx, proportions, minted)
+		require.NoError(t, err)
+
+		// the truncated remainder goes to the first destination, so that the
+		// amounts sum to the minted tokens
+		total := sdk.ZeroInt()
+		for i, amount := range amounts {
+			require.Equal(t, sdk.DefaultBondDenom, amount.Denom)
+			require.Equal(t, tc.expAmounts[i], amount.Amount.Int64(), "minted %d", tc.minted)
+			total = total.Add(amount.Amount)
+		}
+		require.Equal(t, minted.Amount, total)
+	}
+
+	// the mint module account is emptied
+	mintAccount := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, mintAccount).IsZero())
+}
+
+func TestDistributionProportionsUnknownModuleAccount(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.DistributionProportions = []types.DistributionProportion{
+		{Destination: types.DestinationFeeCollector, Proportion: sdk.NewDecWithPrec(5, 1)},
+		{Destination: "incentives", Proportion: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, params.Validate())
+	require.Panics(t, func() { app.MintKeeper.SetParams(ctx, params) })
+
+	// param change proposals are rejected as well
+	bz, err := app.LegacyAmino().MarshalJSON(params.DistributionProportions)
+	require.NoError(t, err)
+	subspace := app.GetSubspace(types.ModuleName)
+	require.Error(t, subspace.Update(ctx, types.KeyDistributionProportions, bz))
+
+	params.DistributionProportions[1].Destination = nft.ModuleName
+	bz, err = app.LegacyAmino().MarshalJSON(params.DistributionProportions)
+	require.NoError(t, err)
+	require.NoError(t, subspace.Update(ctx, types.KeyDistributionProportions, bz))
+	require.Equal(t, params, app.MintKeeper.GetParams(ctx))
+}
+
+func TestBeginBlockerMaxSupply(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		headroom         int64
+		expMinted        []int64
+		expReachedHeight int64
+	}{
+		{"partial final block", 250, []int64{100, 100, 50, 0, 0}, 3},
+		{"exact final block", 200, []int64{100, 100, 0, 0, 0}, 2},
+		{"already reached", 0, []int64{0, 0, 0, 0, 0}, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			// mint 100 tokens per block without recalculating the inflation
+			params := app.MintKeeper.GetParams(ctx)
+			params.BlocksPerRecalculation = 1000
+			params.MaxSupply = app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.AddRaw(tc.headroom)
+			app.MintKeeper.SetParams(ctx, params)
+			app.MintKeeper.SetMinter(ctx, types.NewMinter(sdk.NewDecWithPrec(1, 1), sdk.NewDec(100*int64(params.BlocksPerYear))))
+
+			reachedHeight := int64(-1)
+			for i, expMinted := range tc.expMinted {
+				height := int64(i + 1)
+				ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+				supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+				mint.BeginBlocker(ctx, app.MintKeeper, types.DefaultInflationCalculationFn)
+
+				minted := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.Sub(supply)
+				require.Equal(t, expMinted, minted.Int64(), "height %d", height)
+				require.True(t, app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.LTE(params.MaxSupply))
+
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeMaxSupplyReached {
+						require.Equal(t, int64(-1), reachedHeight, "max supply reached twice")
+						reachedHeight = height
+					}
+				}
+			}
+
+			// the event is emitted on the block reaching the max supply, after
+			// which the reported inflation is zero
+			require.Equal(t, tc.expReachedHeight, reachedHeight)
+			require.Equal(t, params.MaxSupply, app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+			require.True(t, app.MintKeeper.GetMinter(ctx).Inflation.IsZero())
+			require.True(t, app.MintKeeper.GetMinter(ctx).AnnualProvisions.IsZero())
+
+			// no tokens are minted on a recalculation height after the max supply
+			ctx = ctx.WithBlockHeight(1000)
+			mint.BeginBlocker(ctx, app.MintKeeper, types.DefaultInflationCalculationFn)
+			require.Equal(t, params.MaxSupply, app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+			require.True(t, app.MintKeeper.GetMinter(ctx).Inflation.IsZero())
+
+			// raising the max supply resumes minting with a recalculated inflation
+			supply := params.MaxSupply
+			params.MaxSupply = params.MaxSupply.MulRaw(2)
+			app.MintKeeper.SetParams(ctx, params)
+			ctx = ctx.WithBlockHeight(1001)
+			mint.BeginBlocker(ctx, app.MintKeeper, types.DefaultInflationCalculationFn)
+			require.True(t, app.MintKeeper.GetMinter(ctx).Inflation.IsPositive())
+			require.True(t, app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.GT(supply))
+		})
+	}
+}
+
+func TestBeginBlockerReductionScheduleHeights(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the formula applies until height 3, then 100 and 40 tokens are minted per
+	// block from heights 3 and 6, regardless of the recalculation cadence
+	params := app.MintKeeper.GetParams(ctx)
+	blocksPerYear := int64(params.BlocksPerYear)
+	params.BlocksPerRecalculation = 1000
+	params.ReductionSchedule = []types.ReductionScheduleEntry{
+		{StartHeight: 3, AnnualProvisions: sdk.NewDec(100 * blocksPerYear)},
+		{StartHeight: 6, AnnualProvisions: sdk.NewDec(40 * blocksPerYear)},
+	}
+	app.MintKeeper.SetParams(ctx, params)
+	app.MintKeeper.SetMinter(ctx, types.DefaultInitialMinter())
+
+	var prevPosition uint64
+	for _, exp := range []struct {
+		height   int64
+		minted   int64
+		position uint64
+	}{
+		{1, 0, 0}, {2, 0, 0}, {3, 100, 1}, {4, 100, 1}, {5, 100, 1}, {6, 40, 2}, {7, 40, 2},
+	} {
+		height := exp.height
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+		stakingSupply := app.MintKeeper.StakingTokenSupply(ctx)
+		mint.BeginBlocker(ctx, app.MintKeeper, types.DefaultInflationCalculationFn)
+
+		minter := app.MintKeeper.GetMinter(ctx)
+		minted := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.Sub(supply)
+		require.Equal(t, exp.position, minter.SchedulePosition, "height %d", height)
+		if exp.position == 0 {
+			// minted from the inflation formula
+			require.Equal(t, minter.BlockProvision(params).Amount, minted, "height %d", height)
+			require.True(t, minted.IsPositive())
+			continue
+		}
+
+		require.Equal(t, exp.minted, minted.Int64(), "height %d", height)
+		annualProvisions := params.ReductionSchedule[exp.position-1].AnnualProvisions
+		require.Equal(t, annualProvisions, minter.AnnualProvisions)
+
+		// the inflation is recalculated from the annual provisions at the boundary
+		if exp.position != prevPosition {
+			require.Equal(t, annualProvisions.QuoInt(stakingSupply), minter.Inflation)
+		}
+		prevPosition = exp.position
+	}
+}
+
+func TestBeginBlockerReductionScheduleTimes(t *testing.T) {
+	app := simapp.Setup(t, false)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+
+	params := app.MintKeeper.GetParams(ctx)
+	blocksPerYear := int64(params.BlocksPerYear)
+	params.ReductionSchedule = []types.ReductionScheduleEntry{
+		{StartTime: start, AnnualProvisions: sdk.NewDec(100 * blocksPerYear)},
+		{StartTime: start.Add(time.Minute), AnnualProvisions: sdk.NewDec(40 * blocksPerYear)},
+	}
+	app.MintKeeper.SetParams(ctx, params)
+
+	// the provisions step on the first block at or after the boundary
+	for i, tc := range []struct {
+		blockTime time.Time
+		expMinted int64
+	}{
+		{start, 100},
+		{start.Add(time.Minute - time.Nanosecond), 100},
+		{start.Add(time.Minute), 40},
+		{start.Add(time.Hour), 40},
+	} {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(tc.blockTime)
+		supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+		mint.BeginBlocker(ctx, app.MintKeeper, types.DefaultInflationCalculationFn)
+
+		minted := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.Sub(supply)
+		require.Equal(t, tc.expMinted, minted.Int64(), "block time %s", tc.blockTime)
+	}
+}
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryStakingAPR(),
 	)
 
 	return mintingQueryCmd
@@ -118,3 +119,38 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryStakingAPR implements a command to return the current nominal
+// staking APR along with the values it is computed from.
+func GetCmdQueryStakingAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apr",
+		Short: "Query the current nominal staking APR",
+		Long: `Query the current nominal staking APR along with the values it is computed from:
+
+apr = annual_provisions * staking_proportion * (1 - community_tax) / bonded_tokens
+
+where the staking_proportion is the proportion of the minted tokens sent to the fee collector.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryStakingAPRRequest{}
+			res, err := queryClient.StakingAPR(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -29,7 +29,7 @@ func (s *IntegrationTestSuite) TestQueryGRPC() {
 			&minttypes.QueryParamsResponse{},
 			&minttypes.QueryParamsResponse{
 				Params: minttypes.NewParams("stake", sdk.NewDecWithPrec(13, 2), sdk.NewDecWithPrec(100, 2),
-					sdk.NewDec(1), sdk.NewDecWithPrec(67, 2), (60 * 60 * 8766 / 5)),
+					sdk.NewDec(1), sdk.NewDecWithPrec(67, 2), (60 * 60 * 8766 / 5), 1, minttypes.DefaultDistributionProportions(), sdk.ZeroInt(), nil),
 			},
 		},
 		{
@@ -52,6 +52,22 @@ func (s *IntegrationTestSuite) TestQueryGRPC() {
 				AnnualProvisions: sdk.NewDec(500000000),
 			},
 		},
+		{
+			"gRPC request staking APR",
+			fmt.Sprintf("%s/cosmos/mint/v1beta1/staking_apr", baseURL),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			&minttypes.QueryStakingAPRResponse{},
+			&minttypes.QueryStakingAPRResponse{
+				Inflation:         sdk.NewDec(1),
+				AnnualProvisions:  sdk.NewDec(500000000),
+				BondedTokens:      sdk.NewInt(100000000),
+				CommunityTax:      sdk.NewDecWithPrec(2, 2),
+				StakingProportion: sdk.OneDec(),
+				Apr:               sdk.NewDecWithPrec(49, 1),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		resp, err := testutil.GetRequestWithHeaders(tc.url, tc.headers)
@@ -67,17 +67,23 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","blocks_per_recalculation":"1","distribution_proportions":[{"destination":"fee_collector","proportion":"1.000000000000000000"}],"max_supply":"0","reduction_schedule":[]}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`blocks_per_year: "6311520"
+			`blocks_per_recalculation: "1"
+blocks_per_year: "6311520"
+distribution_proportions:
+- destination: fee_collector
+  proportion: "1.000000000000000000"
 goal_bonded: "0.670000000000000000"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
-mint_denom: stake`,
+max_supply: "0"
+mint_denom: stake
+reduction_schedule: []`,
 		},
 	}
 
@@ -162,3 +168,42 @@ func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryStakingAPR() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"json output",
+			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			`{"inflation":"1.000000000000000000","annual_provisions":"500000000.000000000000000000","bonded_tokens":"100000000","community_tax":"0.020000000000000000","staking_proportion":"1.000000000000000000","apr":"4.900000000000000000"}`,
+		},
+		{
+			"text output",
+			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			`annual_provisions: "500000000.000000000000000000"
+apr: "4.900000000000000000"
+bonded_tokens: "100000000"
+community_tax: "0.020000000000000000"
+inflation: "1.000000000000000000"
+staking_proportion: "1.000000000000000000"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryStakingAPR()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -32,3 +35,26 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// StakingAPR returns the nominal staking APR along with the minter, staking and
+// distribution values it is computed from.
+func (k Keeper) StakingAPR(c context.Context, _ *types.QueryStakingAPRRequest) (*types.QueryStakingAPRResponse, error) {
+	if k.distrKeeper == nil {
+		return nil, status.Error(codes.Unavailable, "the community tax is unavailable without a distribution keeper")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	bondedTokens := k.stakingKeeper.TotalBondedTokens(ctx)
+	communityTax := k.distrKeeper.GetCommunityTax(ctx)
+	stakingProportion := k.GetParams(ctx).StakingProportion()
+
+	return &types.QueryStakingAPRResponse{
+		Inflation:         minter.Inflation,
+		AnnualProvisions:  minter.AnnualProvisions,
+		BondedTokens:      bondedTokens,
+		CommunityTax:      communityTax,
+		StakingProportion: stakingProportion,
+		Apr:               types.NominalStakingAPR(minter.AnnualProvisions, stakingProportion, communityTax, bondedTokens),
+	}, nil
+}
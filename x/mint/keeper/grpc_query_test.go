@@ -6,10 +6,14 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -51,6 +55,45 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCStakingAPR() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	bondedTokens := app.StakingKeeper.TotalBondedTokens(ctx)
+	suite.Require().Equal(sdk.NewInt(1000000), bondedTokens)
+
+	minter := types.NewMinter(sdk.NewDecWithPrec(13, 2), sdk.NewDec(130000))
+	app.MintKeeper.SetMinter(ctx, minter)
+	params := app.MintKeeper.GetParams(ctx)
+	params.DistributionProportions = []types.DistributionProportion{
+		{Destination: types.DestinationCommunityPool, Proportion: sdk.NewDecWithPrec(25, 2)},
+		{Destination: types.DestinationFeeCollector, Proportion: sdk.NewDecWithPrec(75, 2)},
+	}
+	app.MintKeeper.SetParams(ctx, params)
+	distrParams := app.DistrKeeper.GetParams(ctx)
+	distrParams.CommunityTax = sdk.NewDecWithPrec(2, 2)
+	app.DistrKeeper.SetParams(ctx, distrParams)
+
+	res, err := queryClient.StakingAPR(gocontext.Background(), &types.QueryStakingAPRRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryStakingAPRResponse{
+		Inflation:         minter.Inflation,
+		AnnualProvisions:  minter.AnnualProvisions,
+		BondedTokens:      bondedTokens,
+		CommunityTax:      sdk.NewDecWithPrec(2, 2),
+		StakingProportion: sdk.NewDecWithPrec(75, 2),
+		// 130000 * 0.75 * 0.98 / 1000000
+		Apr: sdk.MustNewDecFromStr("0.09555"),
+	}, res)
+
+	// the community tax is unavailable without a distribution keeper
+	k := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper,
+		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
+	)
+	_, err = k.StakingAPR(sdk.WrapSDKContext(ctx), &types.QueryStakingAPRRequest{})
+	suite.Require().Equal(codes.Unavailable, status.Code(err))
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -16,7 +18,9 @@ type Keeper struct {
 	storeKey         storetypes.StoreKey
 	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
 }
 
@@ -33,7 +37,7 @@ func NewKeeper(
 
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTableWithModuleAccounts(ak))
 	}
 
 	return Keeper{
@@ -41,11 +45,24 @@ func NewKeeper(
 		storeKey:         key,
 		paramSpace:       paramSpace,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
 	}
 }
 
+// SetDistributionKeeper sets the distribution keeper funding the community pool
+// with the minted tokens sent to the community_pool destination.
+func (k *Keeper) SetDistributionKeeper(dk types.DistributionKeeper) *Keeper {
+	if k.distrKeeper != nil {
+		panic("cannot set distribution keeper twice")
+	}
+
+	k.distrKeeper = dk
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -78,6 +95,10 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 
 // SetParams sets the total set of minting parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if err := types.ValidateDistributionModuleAccounts(k.authKeeper, params.DistributionProportions); err != nil {
+		panic(err)
+	}
+
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
@@ -93,6 +114,12 @@ func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
 	return k.stakingKeeper.BondedRatio(ctx)
 }
 
+// TotalSupply implements an alias call to the underlying bank keeper's
+// GetSupply to be used in BeginBlocker.
+func (k Keeper) TotalSupply(ctx sdk.Context, denom string) sdk.Int {
+	return k.bankKeeper.GetSupply(ctx, denom).Amount
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
@@ -109,3 +136,45 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoins sends the minted coin to the destinations of the
+// distribution proportions and returns the amount each of them received. The
+// amounts are truncated, the remainder is sent to the first destination so
+// that the amounts sum to the minted coin.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, proportions []types.DistributionProportion, minted sdk.Coin) ([]sdk.Coin, error) {
+	amounts := make([]sdk.Coin, len(proportions))
+	remainder := minted.Amount
+	for i, dp := range proportions {
+		amounts[i] = sdk.NewCoin(minted.Denom, dp.Proportion.MulInt(minted.Amount).TruncateInt())
+		remainder = remainder.Sub(amounts[i].Amount)
+	}
+	amounts[0] = amounts[0].AddAmount(remainder)
+
+	for i, dp := range proportions {
+		if amounts[i].IsZero() {
+			continue
+		}
+
+		if err := k.sendMintedCoins(ctx, dp.Destination, sdk.NewCoins(amounts[i])); err != nil {
+			return nil, err
+		}
+	}
+
+	return amounts, nil
+}
+
+func (k Keeper) sendMintedCoins(ctx sdk.Context, destination string, coins sdk.Coins) error {
+	switch destination {
+	case types.DestinationFeeCollector:
+		return k.AddCollectedFees(ctx, coins)
+
+	case types.DestinationCommunityPool:
+		if k.distrKeeper == nil {
+			return fmt.Errorf("cannot send minted tokens to the %s without a distribution keeper", destination)
+		}
+		return k.distrKeeper.FundCommunityPool(ctx, coins, k.authKeeper.GetModuleAddress(types.ModuleName))
+
+	default:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination, coins)
+	}
+}
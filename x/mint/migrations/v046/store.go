@@ -0,0 +1,27 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46.
+// The migration includes:
+//
+// - Setting the BlocksPerRecalculation param in the paramstore
+// - Setting the DistributionProportions param in the paramstore
+// - Setting the MaxSupply param in the paramstore, leaving the supply uncapped
+// - Setting an empty ReductionSchedule param in the paramstore
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyBlocksPerRecalculation, types.DefaultBlocksPerRecalculation)
+	paramstore.Set(ctx, types.KeyDistributionProportions, types.DefaultDistributionProportions())
+	paramstore.Set(ctx, types.KeyMaxSupply, sdk.ZeroInt())
+	paramstore.Set(ctx, types.KeyReductionSchedule, []types.ReductionScheduleEntry{})
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046mint "github.com/cosmos/cosmos-sdk/x/mint/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	mintKey := sdk.NewKVStoreKey("mint")
+	tMintKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(mintKey, tMintKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, mintKey, tMintKey, "mint")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyBlocksPerRecalculation))
+	require.False(t, paramstore.Has(ctx, types.KeyDistributionProportions))
+	require.False(t, paramstore.Has(ctx, types.KeyMaxSupply))
+	require.False(t, paramstore.Has(ctx, types.KeyReductionSchedule))
+
+	// Run migrations.
+	require.NoError(t, v046mint.MigrateStore(ctx, paramstore))
+
+	// Make sure the new params are set.
+	var blocksPerRecalculation uint64
+	paramstore.Get(ctx, types.KeyBlocksPerRecalculation, &blocksPerRecalculation)
+	require.Equal(t, types.DefaultBlocksPerRecalculation, blocksPerRecalculation)
+
+	var distributionProportions []types.DistributionProportion
+	paramstore.Get(ctx, types.KeyDistributionProportions, &distributionProportions)
+	require.Equal(t, types.DefaultDistributionProportions(), distributionProportions)
+
+	var maxSupply sdk.Int
+	paramstore.Get(ctx, types.KeyMaxSupply, &maxSupply)
+	require.Equal(t, sdk.ZeroInt(), maxSupply)
+
+	var reductionSchedule []types.ReductionScheduleEntry
+	paramstore.Get(ctx, types.KeyReductionSchedule, &reductionSchedule)
+	require.Empty(t, reductionSchedule)
+}
@@ -14,11 +14,15 @@ import (
 
 // Simulation parameter constants
 const (
-	Inflation           = "inflation"
-	InflationRateChange = "inflation_rate_change"
-	InflationMax        = "inflation_max"
-	InflationMin        = "inflation_min"
-	GoalBonded          = "goal_bonded"
+	Inflation               = "inflation"
+	InflationRateChange     = "inflation_rate_change"
+	InflationMax            = "inflation_max"
+	InflationMin            = "inflation_min"
+	GoalBonded              = "goal_bonded"
+	BlocksPerRecalculation  = "blocks_per_recalculation"
+	DistributionProportions = "distribution_proportions"
+	MaxSupply               = "max_supply"
+	ReductionSchedule       = "reduction_schedule"
 )
 
 // GenInflation randomized Inflation
@@ -46,6 +50,50 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenBlocksPerRecalculation randomized BlocksPerRecalculation
+func GenBlocksPerRecalculation(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10) + 1)
+}
+
+// GenDistributionProportions randomized DistributionProportions, funding the
+// community pool with up to 30% of the minted tokens
+func GenDistributionProportions(r *rand.Rand) []types.DistributionProportion {
+	communityPool := sdk.NewDecWithPrec(int64(r.Intn(4)), 1)
+	if communityPool.IsZero() {
+		return types.DefaultDistributionProportions()
+	}
+
+	return []types.DistributionProportion{
+		{Destination: types.DestinationFeeCollector, Proportion: sdk.OneDec().Sub(communityPool)},
+		{Destination: types.DestinationCommunityPool, Proportion: communityPool},
+	}
+}
+
+// GenMaxSupply randomized MaxSupply, either uncapped or slightly above the
+// initial supply so that the cap is reached during the simulation
+func GenMaxSupply(r *rand.Rand, initialSupply sdk.Int) sdk.Int {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroInt()
+	}
+
+	return initialSupply.Add(initialSupply.MulRaw(int64(r.Intn(10))).QuoRaw(1000000))
+}
+
+// GenReductionSchedule randomized ReductionSchedule, either empty or minting
+// 10% of the initial supply per year from the first block, halved at a later
+// height
+func GenReductionSchedule(r *rand.Rand, initialSupply sdk.Int) []types.ReductionScheduleEntry {
+	if r.Intn(3) != 0 {
+		return nil
+	}
+
+	annualProvisions := sdk.NewDecFromInt(initialSupply).QuoInt64(10)
+	return []types.ReductionScheduleEntry{
+		{StartHeight: 1, AnnualProvisions: annualProvisions},
+		{StartHeight: int64(r.Intn(50) + 2), AnnualProvisions: annualProvisions.QuoInt64(2)},
+	}
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -80,9 +128,37 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
+	var blocksPerRecalculation uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BlocksPerRecalculation, &blocksPerRecalculation, simState.Rand,
+		func(r *rand.Rand) { blocksPerRecalculation = GenBlocksPerRecalculation(r) },
+	)
+
+	var distributionProportions []types.DistributionProportion
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DistributionProportions, &distributionProportions, simState.Rand,
+		func(r *rand.Rand) { distributionProportions = GenDistributionProportions(r) },
+	)
+
+	var maxSupply sdk.Int
+	initialSupply := sdk.NewInt(simState.InitialStake * (int64(len(simState.Accounts)) + simState.NumBonded))
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupply, &maxSupply, simState.Rand,
+		func(r *rand.Rand) { maxSupply = GenMaxSupply(r, initialSupply) },
+	)
+
+	var reductionSchedule []types.ReductionScheduleEntry
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReductionSchedule, &reductionSchedule, simState.Rand,
+		func(r *rand.Rand) { reductionSchedule = GenReductionSchedule(r, initialSupply) },
+	)
+
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params := types.NewParams(
+		mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear,
+		blocksPerRecalculation, distributionProportions, maxSupply, reductionSchedule,
+	)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
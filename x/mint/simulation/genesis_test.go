@@ -45,6 +45,13 @@ func TestRandomizedGenState(t *testing.T) {
 	dec3, _ := sdk.NewDecFromStr("0.070000000000000000")
 
 	require.Equal(t, uint64(6311520), mintGenesis.Params.BlocksPerYear)
+	require.Equal(t, uint64(1), mintGenesis.Params.BlocksPerRecalculation)
+	require.Equal(t, []types.DistributionProportion{
+		{Destination: types.DestinationFeeCollector, Proportion: sdk.NewDecWithPrec(8, 1)},
+		{Destination: types.DestinationCommunityPool, Proportion: sdk.NewDecWithPrec(2, 1)},
+	}, mintGenesis.Params.DistributionProportions)
+	require.Equal(t, sdk.NewInt(6000), mintGenesis.Params.MaxSupply)
+	require.Empty(t, mintGenesis.Params.ReductionSchedule)
 	require.Equal(t, dec1, mintGenesis.Params.GoalBonded)
 	require.Equal(t, dec2, mintGenesis.Params.InflationMax)
 	require.Equal(t, dec3, mintGenesis.Params.InflationMin)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingProportion returns the proportion of the minted tokens sent to the
+// fee collector, and therefore distributed to the stakers.
+func (p Params) StakingProportion() sdk.Dec {
+	for _, dp := range p.DistributionProportions {
+		if dp.Destination == DestinationFeeCollector {
+			return dp.Proportion
+		}
+	}
+
+	return sdk.ZeroDec()
+}
+
+// NominalStakingAPR returns the nominal staking APR paid by the annual
+// provisions to the bonded tokens, that is the annual provisions sent to the
+// stakers net of the community tax over the bonded tokens. It is zero when
+// there are no bonded tokens.
+func NominalStakingAPR(annualProvisions, stakingProportion, communityTax sdk.Dec, bondedTokens sdk.Int) sdk.Dec {
+	if !bondedTokens.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return annualProvisions.Mul(stakingProportion).Mul(sdk.OneDec().Sub(communityTax)).QuoInt(bondedTokens)
+}
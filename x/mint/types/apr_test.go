@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNominalStakingAPR(t *testing.T) {
+	tests := []struct {
+		annualProvisions  sdk.Dec
+		stakingProportion sdk.Dec
+		communityTax      sdk.Dec
+		bondedTokens      sdk.Int
+		expAPR            sdk.Dec
+	}{
+		// 1000000 * 1 * 0.98 / 10000000
+		{sdk.NewDec(1000000), sdk.OneDec(), sdk.NewDecWithPrec(2, 2), sdk.NewInt(10000000), sdk.MustNewDecFromStr("0.098")},
+		// 2600000 * 0.8 * 0.9 / 13000000
+		{sdk.NewDec(2600000), sdk.NewDecWithPrec(8, 1), sdk.NewDecWithPrec(1, 1), sdk.NewInt(13000000), sdk.MustNewDecFromStr("0.144")},
+		// 2 * 1 * 1 / 3, truncated
+		{sdk.NewDec(2), sdk.OneDec(), sdk.ZeroDec(), sdk.NewInt(3), sdk.MustNewDecFromStr("0.666666666666666666")},
+		// 15.5 * 0.5 * 0.5 / 2
+		{sdk.MustNewDecFromStr("15.5"), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewInt(2), sdk.MustNewDecFromStr("1.9375")},
+		// nothing is sent to the stakers
+		{sdk.NewDec(1000000), sdk.ZeroDec(), sdk.NewDecWithPrec(2, 2), sdk.NewInt(10000000), sdk.ZeroDec()},
+		{sdk.NewDec(1000000), sdk.OneDec(), sdk.OneDec(), sdk.NewInt(10000000), sdk.ZeroDec()},
+		// no bonded tokens
+		{sdk.NewDec(1000000), sdk.OneDec(), sdk.NewDecWithPrec(2, 2), sdk.ZeroInt(), sdk.ZeroDec()},
+	}
+
+	for i, tc := range tests {
+		apr := NominalStakingAPR(tc.annualProvisions, tc.stakingProportion, tc.communityTax, tc.bondedTokens)
+		require.True(t, tc.expAPR.Equal(apr), "test %d: expected %s, got %s", i, tc.expAPR, apr)
+	}
+}
+
+func TestStakingProportion(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, sdk.OneDec(), params.StakingProportion())
+
+	params.DistributionProportions = []DistributionProportion{
+		{Destination: DestinationCommunityPool, Proportion: sdk.NewDecWithPrec(3, 1)},
+		{Destination: DestinationFeeCollector, Proportion: sdk.NewDecWithPrec(7, 1)},
+	}
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), params.StakingProportion())
+
+	params.DistributionProportions = []DistributionProportion{
+		{Destination: DestinationCommunityPool, Proportion: sdk.OneDec()},
+	}
+	require.True(t, params.StakingProportion().IsZero())
+}
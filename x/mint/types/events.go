@@ -2,9 +2,14 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint             = ModuleName
+	EventTypeMintDistribution = "mint_distribution"
+	EventTypeMaxSupplyReached = "max_supply_reached"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyDestination      = "destination"
+	AttributeKeyProportion       = "proportion"
+	AttributeKeyMaxSupply        = "max_supply"
 )
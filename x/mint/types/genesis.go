@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,7 +10,8 @@ import (
 // BeginBlock. It receives the minter and params stored in the keeper, along with the current
 // bondedRatio and returns the newly calculated inflation rate.
 // It can be used to specify a custom inflation calculation logic, instead of relying on the
-// default logic provided by the sdk.
+// default logic provided by the sdk. It is only called every BlocksPerRecalculation blocks,
+// and not once the first entry of the reduction schedule is reached.
 type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec
 
 // DefaultInflationCalculationFn is the default function used to calculate inflation.
@@ -39,5 +42,16 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateMinter(data.Minter)
+	if err := ValidateMinter(data.Minter); err != nil {
+		return err
+	}
+
+	if data.Minter.SchedulePosition > uint64(len(data.Params.ReductionSchedule)) {
+		return fmt.Errorf(
+			"minter schedule position %d is beyond the %d entries of the reduction schedule",
+			data.Minter.SchedulePosition, len(data.Params.ReductionSchedule),
+		)
+	}
+
+	return nil
 }
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesisSchedulePosition(t *testing.T) {
+	genesis := DefaultGenesisState()
+	genesis.Params.ReductionSchedule = []ReductionScheduleEntry{
+		{StartHeight: 1, AnnualProvisions: sdk.NewDec(1000)},
+	}
+
+	genesis.Minter.SchedulePosition = 1
+	require.NoError(t, ValidateGenesis(*genesis))
+
+	genesis.Minter.SchedulePosition = 2
+	require.Error(t, ValidateGenesis(*genesis))
+}
@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +35,9 @@ type Minter struct {
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 	// current annual expected provisions
 	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	// number of entries of the reduction schedule reached, the annual provisions
+	// being the ones of the last of them. Zero before the first entry.
+	SchedulePosition uint64 `protobuf:"varint,3,opt,name=schedule_position,json=schedulePosition,proto3" json:"schedule_position,omitempty"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -66,6 +73,13 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+func (m *Minter) GetSchedulePosition() uint64 {
+	if m != nil {
+		return m.SchedulePosition
+	}
+	return 0
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
@@ -80,6 +94,18 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// number of blocks between two recalculations of the inflation rate and the
+	// annual provisions, the block provisions are minted every block
+	BlocksPerRecalculation uint64 `protobuf:"varint,7,opt,name=blocks_per_recalculation,json=blocksPerRecalculation,proto3" json:"blocks_per_recalculation,omitempty"`
+	// destinations of the minted tokens and the proportions of the minted tokens
+	// they receive, summing to one
+	DistributionProportions []DistributionProportion `protobuf:"bytes,8,rep,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
+	// maximum total supply of the mint denom, no tokens are minted once it is
+	// reached. Zero means the supply is uncapped.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// annual provisions minted from a boundary on, overriding the inflation
+	// calculation from the first boundary when non-empty
+	ReductionSchedule []ReductionScheduleEntry `protobuf:"bytes,10,rep,name=reduction_schedule,json=reductionSchedule,proto3" json:"reduction_schedule"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,40 +154,188 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetBlocksPerRecalculation() uint64 {
+	if m != nil {
+		return m.BlocksPerRecalculation
+	}
+	return 0
+}
+
+func (m *Params) GetDistributionProportions() []DistributionProportion {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return nil
+}
+
+func (m *Params) GetReductionSchedule() []ReductionScheduleEntry {
+	if m != nil {
+		return m.ReductionSchedule
+	}
+	return nil
+}
+
+// DistributionProportion defines the proportion of the minted tokens sent to a
+// destination.
+type DistributionProportion struct {
+	// destination is either fee_collector, community_pool or the name of a module
+	// account.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// proportion of the minted tokens sent to the destination
+	Proportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=proportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proportion"`
+}
+
+func (m *DistributionProportion) Reset()         { *m = DistributionProportion{} }
+func (m *DistributionProportion) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportion) ProtoMessage()    {}
+func (*DistributionProportion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *DistributionProportion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportion.Merge(m, src)
+}
+func (m *DistributionProportion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportion proto.InternalMessageInfo
+
+func (m *DistributionProportion) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+// ReductionScheduleEntry defines the annual provisions minted from a boundary,
+// either a height or a time, until the boundary of the next entry.
+type ReductionScheduleEntry struct {
+	// start_height is the height the entry starts at, if set.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the block time the entry starts at, if set.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// annual_provisions minted from the boundary on
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+}
+
+func (m *ReductionScheduleEntry) Reset()         { *m = ReductionScheduleEntry{} }
+func (m *ReductionScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*ReductionScheduleEntry) ProtoMessage()    {}
+func (*ReductionScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *ReductionScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReductionScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReductionScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReductionScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReductionScheduleEntry.Merge(m, src)
+}
+func (m *ReductionScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReductionScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReductionScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReductionScheduleEntry proto.InternalMessageInfo
+
+func (m *ReductionScheduleEntry) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ReductionScheduleEntry) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*DistributionProportion)(nil), "cosmos.mint.v1beta1.DistributionProportion")
+	proto.RegisterType((*ReductionScheduleEntry)(nil), "cosmos.mint.v1beta1.ReductionScheduleEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0xaa, 0xda, 0x40,
-	0x18, 0x85, 0x93, 0x6a, 0x03, 0x4e, 0x2b, 0x6d, 0xc7, 0x16, 0x52, 0xa1, 0x51, 0x5c, 0x88, 0x5d,
-	0x98, 0x20, 0xdd, 0x95, 0xae, 0xd4, 0xad, 0x20, 0xd9, 0x55, 0x28, 0x61, 0x92, 0x4c, 0xe3, 0x60,
-	0x32, 0x13, 0x66, 0x46, 0xd1, 0xb7, 0xe8, 0xb2, 0xcb, 0x3e, 0x44, 0x1f, 0xc2, 0x5d, 0xa5, 0xab,
-	0xd2, 0x85, 0x5c, 0x74, 0x7b, 0x1f, 0xe2, 0x92, 0x99, 0x10, 0xe1, 0x2e, 0xee, 0x2a, 0xab, 0x64,
-	0xce, 0x99, 0xff, 0x3b, 0x27, 0x81, 0x1f, 0x38, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x46, 0xa8, 0xf4,
-	0x76, 0x93, 0x10, 0x4b, 0x34, 0x51, 0x07, 0x37, 0xe7, 0x4c, 0x32, 0xd8, 0xd1, 0xbe, 0xab, 0xa4,
-	0xd2, 0xef, 0xbe, 0x4d, 0x58, 0xc2, 0x94, 0xef, 0x15, 0x6f, 0xfa, 0x6a, 0xf7, 0xbd, 0xbe, 0x1a,
-	0x68, 0xa3, 0x9c, 0x53, 0x87, 0xc1, 0x1f, 0x13, 0x58, 0x0b, 0x42, 0x25, 0xe6, 0x70, 0x05, 0x5a,
-	0x84, 0x7e, 0x4f, 0x91, 0x24, 0x8c, 0xda, 0x66, 0xdf, 0x1c, 0xb5, 0xa6, 0x5f, 0x8e, 0xe7, 0x9e,
-	0xf1, 0xff, 0xdc, 0x1b, 0x26, 0x44, 0xae, 0xb7, 0xa1, 0x1b, 0xb1, 0xac, 0x1c, 0x2f, 0x1f, 0x63,
-	0x11, 0x6f, 0x3c, 0x79, 0xc8, 0xb1, 0x70, 0xe7, 0x38, 0xfa, 0xfb, 0x7b, 0x0c, 0x4a, 0xfa, 0x1c,
-	0x47, 0xfe, 0x0d, 0x07, 0x09, 0x78, 0x83, 0x28, 0xdd, 0xa2, 0xb4, 0xe8, 0xb0, 0x23, 0x82, 0x30,
-	0x2a, 0xec, 0x67, 0x35, 0x64, 0xbc, 0xd6, 0xd8, 0x65, 0x45, 0x1d, 0xdc, 0x37, 0x80, 0xb5, 0x44,
-	0x1c, 0x65, 0x02, 0x7e, 0x00, 0xa0, 0xf8, 0x3b, 0x41, 0x8c, 0x29, 0xcb, 0xf4, 0x27, 0xf9, 0xad,
-	0x42, 0x99, 0x17, 0x02, 0xcc, 0xc1, 0xbb, 0xaa, 0x61, 0xc0, 0x91, 0xc4, 0x41, 0xb4, 0x46, 0x34,
-	0xc1, 0xb5, 0x14, 0xeb, 0x54, 0x68, 0x1f, 0x49, 0x3c, 0x53, 0x60, 0x88, 0x40, 0xfb, 0x96, 0x98,
-	0xa1, 0xbd, 0xdd, 0xa8, 0x21, 0xe9, 0x65, 0x85, 0x5c, 0xa0, 0xfd, 0xa3, 0x08, 0x42, 0xed, 0x66,
-	0xbd, 0x11, 0x84, 0xc2, 0x6f, 0xe0, 0x45, 0xc2, 0x50, 0x1a, 0x84, 0x8c, 0xc6, 0x38, 0xb6, 0x9f,
-	0xd7, 0x10, 0x00, 0x0a, 0xe0, 0x54, 0xf1, 0xe0, 0x10, 0xbc, 0x0a, 0x53, 0x16, 0x6d, 0x44, 0x90,
-	0x63, 0x1e, 0x1c, 0x30, 0xe2, 0xb6, 0xd5, 0x37, 0x47, 0x4d, 0xbf, 0xad, 0xe5, 0x25, 0xe6, 0x5f,
-	0x31, 0xe2, 0x9f, 0x9b, 0x3f, 0x7f, 0xf5, 0x8c, 0xe9, 0xec, 0x78, 0x71, 0xcc, 0xd3, 0xc5, 0x31,
-	0xef, 0x2e, 0x8e, 0xf9, 0xe3, 0xea, 0x18, 0xa7, 0xab, 0x63, 0xfc, 0xbb, 0x3a, 0xc6, 0xea, 0xe3,
-	0x93, 0x4d, 0xf6, 0x7a, 0xb1, 0x54, 0xa1, 0xd0, 0x52, 0xcb, 0xf0, 0xe9, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xde, 0x9f, 0x75, 0x74, 0x03, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x49, 0x08, 0xcd, 0xa4, 0x15, 0xed, 0x16, 0x8a, 0xa9, 0x44, 0x12, 0x72, 0xa8, 0x8a,
+	0xaa, 0x3a, 0x6a, 0xb9, 0x20, 0xc4, 0x29, 0x0d, 0x12, 0x3d, 0x54, 0x8a, 0x5c, 0x2e, 0x14, 0x90,
+	0x59, 0xdb, 0x5b, 0x67, 0x55, 0x7b, 0xd7, 0xda, 0x5d, 0x57, 0xc9, 0x5f, 0xf4, 0xd8, 0x23, 0x1f,
+	0xc1, 0x47, 0xf4, 0x58, 0xc1, 0x05, 0x71, 0x28, 0xa8, 0x3d, 0xf2, 0x0b, 0x1c, 0x90, 0xd7, 0x4e,
+	0x6a, 0x55, 0x51, 0x25, 0xa4, 0x70, 0x4a, 0xfc, 0xde, 0xcc, 0x7b, 0xfb, 0x26, 0xde, 0x09, 0x34,
+	0x3c, 0x2e, 0x23, 0x2e, 0x3b, 0x11, 0x65, 0xaa, 0x73, 0xbc, 0xe5, 0x12, 0x85, 0xb7, 0xf4, 0x83,
+	0x15, 0x0b, 0xae, 0x38, 0x5a, 0xce, 0x78, 0x4b, 0x43, 0x39, 0xbf, 0xfa, 0x20, 0xe0, 0x01, 0xd7,
+	0x7c, 0x27, 0xfd, 0x96, 0x95, 0xae, 0x3e, 0xce, 0x4a, 0x9d, 0x8c, 0xc8, 0xfb, 0x32, 0xaa, 0x19,
+	0x70, 0x1e, 0x84, 0xa4, 0xa3, 0x9f, 0xdc, 0xe4, 0xb0, 0xa3, 0x68, 0x44, 0xa4, 0xc2, 0x51, 0x9c,
+	0x15, 0xb4, 0xff, 0x18, 0x50, 0xdd, 0xa3, 0x4c, 0x11, 0x81, 0x0e, 0xa0, 0x46, 0xd9, 0x61, 0x88,
+	0x15, 0xe5, 0xcc, 0x34, 0x5a, 0xc6, 0x7a, 0xad, 0xfb, 0xea, 0xec, 0xa2, 0x59, 0xfa, 0x71, 0xd1,
+	0x5c, 0x0b, 0xa8, 0x1a, 0x24, 0xae, 0xe5, 0xf1, 0x28, 0xd7, 0xcf, 0x3f, 0x36, 0xa5, 0x7f, 0xd4,
+	0x51, 0xa3, 0x98, 0x48, 0xab, 0x47, 0xbc, 0xaf, 0x5f, 0x36, 0x21, 0xb7, 0xef, 0x11, 0xcf, 0xbe,
+	0x96, 0x43, 0x14, 0x96, 0x30, 0x63, 0x09, 0x0e, 0xd3, 0x43, 0x1e, 0x53, 0x49, 0x39, 0x93, 0xe6,
+	0x9d, 0x19, 0x78, 0x2c, 0x66, 0xb2, 0xfd, 0x89, 0x2a, 0xda, 0x80, 0x25, 0xe9, 0x0d, 0x88, 0x9f,
+	0x84, 0xc4, 0x89, 0xb9, 0xa4, 0x3a, 0x4e, 0xb9, 0x65, 0xac, 0x57, 0xec, 0xc5, 0x31, 0xd1, 0xcf,
+	0xf1, 0xf6, 0xb7, 0x2a, 0x54, 0xfb, 0x58, 0xe0, 0x48, 0xa2, 0x27, 0x00, 0xe9, 0xac, 0x1d, 0x9f,
+	0x30, 0x1e, 0x65, 0xf9, 0xed, 0x5a, 0x8a, 0xf4, 0x52, 0x00, 0xc5, 0xf0, 0x70, 0x12, 0xc7, 0x11,
+	0x58, 0x11, 0xc7, 0x1b, 0x60, 0x16, 0x90, 0x99, 0xa4, 0x58, 0x9e, 0x48, 0xdb, 0x58, 0x91, 0x1d,
+	0x2d, 0x8c, 0x30, 0x2c, 0x5c, 0x3b, 0x46, 0x78, 0x68, 0x96, 0x67, 0xe0, 0x34, 0x3f, 0x91, 0xdc,
+	0xc3, 0xc3, 0x1b, 0x16, 0x94, 0x99, 0x95, 0xd9, 0x5a, 0x50, 0x86, 0x3e, 0x42, 0x3d, 0xe0, 0x38,
+	0x74, 0x5c, 0xce, 0x7c, 0xe2, 0x9b, 0x77, 0x67, 0x60, 0x00, 0xa9, 0x60, 0x57, 0xeb, 0xa1, 0x35,
+	0xb8, 0xef, 0x86, 0xdc, 0x3b, 0x92, 0x4e, 0x4c, 0x84, 0x33, 0x22, 0x58, 0x98, 0x55, 0xfd, 0x5b,
+	0x2f, 0x64, 0x70, 0x9f, 0x88, 0x77, 0x04, 0x0b, 0xf4, 0x02, 0xcc, 0x42, 0x9d, 0x20, 0x1e, 0x0e,
+	0xbd, 0x24, 0x7f, 0xd7, 0xef, 0xe9, 0x86, 0x95, 0x49, 0x83, 0x5d, 0x64, 0x51, 0x08, 0xa6, 0x4f,
+	0xa5, 0x12, 0xd4, 0x4d, 0xf4, 0x98, 0x62, 0xc1, 0x63, 0x2e, 0x94, 0x7e, 0x83, 0xe7, 0x5a, 0xe5,
+	0xf5, 0xfa, 0xf6, 0x86, 0x35, 0xe5, 0xae, 0x5a, 0xbd, 0x42, 0x53, 0x7f, 0xd2, 0xd3, 0xad, 0xa4,
+	0xd1, 0xed, 0x47, 0xfe, 0x54, 0x56, 0xa2, 0xf7, 0x00, 0x11, 0x1e, 0x3a, 0x32, 0x89, 0xe3, 0x70,
+	0x64, 0xd6, 0xfe, 0x79, 0x5a, 0xbb, 0x4c, 0x15, 0xa6, 0xb5, 0xcb, 0x94, 0x5d, 0x8b, 0xf0, 0x70,
+	0x5f, 0xcb, 0xa1, 0x4f, 0x80, 0x04, 0xf1, 0x13, 0x4f, 0xe7, 0x18, 0xdf, 0x05, 0x13, 0x6e, 0x09,
+	0x61, 0x8f, 0xcb, 0xf7, 0xf3, 0xea, 0xd7, 0x4c, 0x89, 0x51, 0x1e, 0x62, 0x49, 0xdc, 0x64, 0x5f,
+	0x56, 0x4e, 0x3f, 0x37, 0x4b, 0xed, 0x53, 0x03, 0x56, 0xa6, 0xc7, 0x47, 0x2d, 0xa8, 0xfb, 0x44,
+	0x2a, 0xca, 0x0a, 0x6b, 0xc6, 0x2e, 0x42, 0xe8, 0x03, 0xc0, 0xf5, 0x88, 0x67, 0x72, 0xbb, 0x0a,
+	0x7a, 0xed, 0xdf, 0x06, 0xac, 0x4c, 0x0f, 0x85, 0x9e, 0xc2, 0xbc, 0x54, 0x58, 0x28, 0x67, 0x40,
+	0x68, 0x30, 0x50, 0xfa, 0x6c, 0x65, 0xbb, 0xae, 0xb1, 0x37, 0x1a, 0x42, 0x3b, 0x00, 0x59, 0x49,
+	0xba, 0x46, 0xf5, 0xd9, 0xea, 0xdb, 0xab, 0x56, 0xb6, 0x63, 0xad, 0xf1, 0x8e, 0xb5, 0xde, 0x8e,
+	0x77, 0x6c, 0x77, 0x2e, 0x3d, 0xf7, 0xc9, 0xcf, 0xa6, 0x61, 0xd7, 0x74, 0x5f, 0xca, 0x4c, 0xdf,
+	0x85, 0xe5, 0xff, 0xb1, 0x0b, 0xbb, 0x3b, 0x67, 0x97, 0x0d, 0xe3, 0xfc, 0xb2, 0x61, 0xfc, 0xba,
+	0x6c, 0x18, 0x27, 0x57, 0x8d, 0xd2, 0xf9, 0x55, 0xa3, 0xf4, 0xfd, 0xaa, 0x51, 0x3a, 0x78, 0x76,
+	0xab, 0xc3, 0x30, 0xfb, 0x5b, 0xd2, 0x46, 0x6e, 0x55, 0x07, 0x7b, 0xfe, 0x77, 0x00, 0x98, 0x62,
+	0xe3, 0x02, 0xb2, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -184,6 +358,11 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SchedulePosition != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.SchedulePosition))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.AnnualProvisions.Size()
 		i -= size
@@ -227,6 +406,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReductionSchedule) > 0 {
+		for iNdEx := len(m.ReductionSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReductionSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.DistributionProportions) > 0 {
+		for iNdEx := len(m.DistributionProportions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionProportions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.BlocksPerRecalculation != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerRecalculation))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -282,6 +504,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionProportion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Proportion.Size()
+		i -= size
+		if _, err := m.Proportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReductionScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReductionScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReductionScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -303,6 +611,9 @@ func (m *Minter) Size() (n int) {
 	n += 1 + l + sovMint(uint64(l))
 	l = m.AnnualProvisions.Size()
 	n += 1 + l + sovMint(uint64(l))
+	if m.SchedulePosition != 0 {
+		n += 1 + sovMint(uint64(m.SchedulePosition))
+	}
 	return n
 }
 
@@ -327,6 +638,54 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.BlocksPerRecalculation != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerRecalculation))
+	}
+	if len(m.DistributionProportions) > 0 {
+		for _, e := range m.DistributionProportions {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.ReductionSchedule) > 0 {
+		for _, e := range m.ReductionSchedule {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionProportion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Proportion.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ReductionScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -433,6 +792,25 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulePosition", wireType)
+			}
+			m.SchedulePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchedulePosition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -670,6 +1048,379 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerRecalculation", wireType)
+			}
+			m.BlocksPerRecalculation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerRecalculation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionProportions = append(m.DistributionProportions, DistributionProportion{})
+			if err := m.DistributionProportions[len(m.DistributionProportions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReductionSchedule = append(m.ReductionSchedule, ReductionScheduleEntry{})
+			if err := m.ReductionSchedule[len(m.ReductionSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReductionScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReductionScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReductionScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
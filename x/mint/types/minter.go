@@ -54,6 +54,12 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
 		Mul(params.InflationRateChange)
 	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear)))
 
+	// the rate change of all the blocks since the last recalculation is applied
+	// at once
+	if params.BlocksPerRecalculation > 1 {
+		inflationRateChange = inflationRateChange.MulInt64(int64(params.BlocksPerRecalculation))
+	}
+
 	// adjust the new annual inflation for this next cycle
 	inflation := m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
 	if inflation.GT(params.InflationMax) {
@@ -54,6 +54,20 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestNextInflationBlocksPerRecalculation(t *testing.T) {
+	minter := InitialMinter(sdk.NewDecWithPrec(10, 2))
+	params := DefaultParams()
+	change := minter.NextInflationRate(params, sdk.ZeroDec()).Sub(minter.Inflation)
+
+	// the rate change of the blocks since the last recalculation is applied at once
+	params.BlocksPerRecalculation = 10
+	require.Equal(t, change.MulInt64(10), minter.NextInflationRate(params, sdk.ZeroDec()).Sub(minter.Inflation))
+
+	// still capped by the max inflation
+	params.BlocksPerRecalculation = params.BlocksPerYear
+	require.Equal(t, params.InflationMax, minter.NextInflationRate(params, sdk.ZeroDec()))
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
 	params := DefaultParams()
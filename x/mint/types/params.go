@@ -1,9 +1,11 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/yaml"
 
@@ -11,14 +13,29 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// DefaultBlocksPerRecalculation recalculates the inflation rate every block
+const DefaultBlocksPerRecalculation = uint64(1)
+
+// Destinations of the minted tokens other than module accounts
+const (
+	// DestinationFeeCollector sends the minted tokens to the fee collector
+	DestinationFeeCollector = "fee_collector"
+	// DestinationCommunityPool funds the community pool with the minted tokens
+	DestinationCommunityPool = "community_pool"
+)
+
 // Parameter store keys
 var (
-	KeyMintDenom           = []byte("MintDenom")
-	KeyInflationRateChange = []byte("InflationRateChange")
-	KeyInflationMax        = []byte("InflationMax")
-	KeyInflationMin        = []byte("InflationMin")
-	KeyGoalBonded          = []byte("GoalBonded")
-	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyMintDenom               = []byte("MintDenom")
+	KeyInflationRateChange     = []byte("InflationRateChange")
+	KeyInflationMax            = []byte("InflationMax")
+	KeyInflationMin            = []byte("InflationMin")
+	KeyGoalBonded              = []byte("GoalBonded")
+	KeyBlocksPerYear           = []byte("BlocksPerYear")
+	KeyBlocksPerRecalculation  = []byte("BlocksPerRecalculation")
+	KeyDistributionProportions = []byte("DistributionProportions")
+	KeyMaxSupply               = []byte("MaxSupply")
+	KeyReductionSchedule       = []byte("ReductionSchedule")
 )
 
 // ParamTable for minting module.
@@ -26,29 +43,66 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// ParamKeyTableWithModuleAccounts returns the param key table of the minting
+// module which also rejects the distribution proportions sending the minted
+// tokens to module accounts unknown to the account keeper.
+func ParamKeyTableWithModuleAccounts(ak AccountKeeper) paramtypes.KeyTable {
+	pairs := (&Params{}).ParamSetPairs()
+	for i, pair := range pairs {
+		if bytes.Equal(pair.Key, KeyDistributionProportions) {
+			pairs[i].ValidatorFn = func(i interface{}) error {
+				if err := validateDistributionProportions(i); err != nil {
+					return err
+				}
+
+				return ValidateDistributionModuleAccounts(ak, i.([]DistributionProportion))
+			}
+		}
+	}
+
+	return paramtypes.NewKeyTable(pairs...)
+}
+
+// DefaultDistributionProportions sends all the minted tokens to the fee
+// collector
+func DefaultDistributionProportions() []DistributionProportion {
+	return []DistributionProportion{
+		{Destination: DestinationFeeCollector, Proportion: sdk.OneDec()},
+	}
+}
+
 func NewParams(
 	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64,
+	blocksPerRecalculation uint64, distributionProportions []DistributionProportion, maxSupply sdk.Int,
+	reductionSchedule []ReductionScheduleEntry,
 ) Params {
 
 	return Params{
-		MintDenom:           mintDenom,
-		InflationRateChange: inflationRateChange,
-		InflationMax:        inflationMax,
-		InflationMin:        inflationMin,
-		GoalBonded:          goalBonded,
-		BlocksPerYear:       blocksPerYear,
+		MintDenom:               mintDenom,
+		InflationRateChange:     inflationRateChange,
+		InflationMax:            inflationMax,
+		InflationMin:            inflationMin,
+		GoalBonded:              goalBonded,
+		BlocksPerYear:           blocksPerYear,
+		BlocksPerRecalculation:  blocksPerRecalculation,
+		DistributionProportions: distributionProportions,
+		MaxSupply:               maxSupply,
+		ReductionSchedule:       reductionSchedule,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: sdk.NewDecWithPrec(13, 2),
-		InflationMax:        sdk.NewDecWithPrec(20, 2),
-		InflationMin:        sdk.NewDecWithPrec(7, 2),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:               sdk.DefaultBondDenom,
+		InflationRateChange:     sdk.NewDecWithPrec(13, 2),
+		InflationMax:            sdk.NewDecWithPrec(20, 2),
+		InflationMin:            sdk.NewDecWithPrec(7, 2),
+		GoalBonded:              sdk.NewDecWithPrec(67, 2),
+		BlocksPerYear:           uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		BlocksPerRecalculation:  DefaultBlocksPerRecalculation,
+		DistributionProportions: DefaultDistributionProportions(),
+		MaxSupply:               sdk.ZeroInt(), // uncapped
 	}
 }
 
@@ -72,6 +126,18 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateBlocksPerRecalculation(p.BlocksPerRecalculation); err != nil {
+		return err
+	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateReductionSchedule(p.ReductionSchedule); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -98,6 +164,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyBlocksPerRecalculation, &p.BlocksPerRecalculation, validateBlocksPerRecalculation),
+		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyReductionSchedule, &p.ReductionSchedule, validateReductionSchedule),
 	}
 }
 
@@ -193,3 +263,143 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateBlocksPerRecalculation(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("blocks per recalculation must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.([]DistributionProportion)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return errors.New("distribution proportions cannot be empty")
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool, len(v))
+	for _, dp := range v {
+		if strings.TrimSpace(dp.Destination) == "" {
+			return errors.New("distribution destination cannot be blank")
+		}
+		if seen[dp.Destination] {
+			return fmt.Errorf("duplicate distribution destination: %s", dp.Destination)
+		}
+		seen[dp.Destination] = true
+
+		if dp.Proportion.IsNil() || !dp.Proportion.IsPositive() {
+			return fmt.Errorf("distribution proportion of %s must be positive: %s", dp.Destination, dp.Proportion)
+		}
+		total = total.Add(dp.Proportion)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution proportions must sum to one: %s", total)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("max supply cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateReductionSchedule(i interface{}) error {
+	v, ok := i.([]ReductionScheduleEntry)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, entry := range v {
+		if entry.StartHeight < 0 {
+			return fmt.Errorf("reduction schedule start height cannot be negative: %d", entry.StartHeight)
+		}
+		if (entry.StartHeight > 0) == !entry.StartTime.IsZero() {
+			return fmt.Errorf("reduction schedule entry %d must set either a start height or a start time", j)
+		}
+		if entry.AnnualProvisions.IsNil() || entry.AnnualProvisions.IsNegative() {
+			return fmt.Errorf("reduction schedule annual provisions cannot be negative: %s", entry.AnnualProvisions)
+		}
+		if j == 0 {
+			continue
+		}
+
+		prev := v[j-1]
+		if (entry.StartHeight > 0) != (prev.StartHeight > 0) {
+			return errors.New("reduction schedule cannot mix start heights and start times")
+		}
+		if entry.StartHeight > 0 && entry.StartHeight <= prev.StartHeight {
+			return fmt.Errorf("reduction schedule start heights must be strictly increasing: %d after %d", entry.StartHeight, prev.StartHeight)
+		}
+		if entry.StartHeight == 0 && !entry.StartTime.After(prev.StartTime) {
+			return fmt.Errorf("reduction schedule start times must be strictly increasing: %s after %s", entry.StartTime, prev.StartTime)
+		}
+	}
+
+	return nil
+}
+
+// SchedulePosition returns the number of entries of the reduction schedule
+// reached at the given height and block time, the current entry being the last
+// of them. It is zero before the first entry.
+func (p Params) SchedulePosition(height int64, blockTime time.Time) uint64 {
+	var position uint64
+	for _, entry := range p.ReductionSchedule {
+		if !entry.Reached(height, blockTime) {
+			break
+		}
+		position++
+	}
+
+	return position
+}
+
+// Reached returns whether the boundary of the entry is reached at the given
+// height and block time.
+func (e ReductionScheduleEntry) Reached(height int64, blockTime time.Time) bool {
+	if e.StartHeight > 0 {
+		return height >= e.StartHeight
+	}
+
+	return !blockTime.Before(e.StartTime)
+}
+
+// ValidateDistributionModuleAccounts returns an error if a distribution
+// proportion sends the minted tokens to a module account unknown to the
+// account keeper.
+func ValidateDistributionModuleAccounts(ak AccountKeeper, proportions []DistributionProportion) error {
+	for _, dp := range proportions {
+		if dp.Destination == DestinationFeeCollector || dp.Destination == DestinationCommunityPool {
+			continue
+		}
+
+		if ak.GetModuleAddress(dp.Destination) == nil {
+			return fmt.Errorf("unknown module account distribution destination: %s", dp.Destination)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateDistributionProportions(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name        string
+		proportions []DistributionProportion
+		expErr      bool
+	}{
+		{"default", DefaultDistributionProportions(), false},
+		{"split", []DistributionProportion{{DestinationFeeCollector, half}, {DestinationCommunityPool, half}}, false},
+		{"empty", nil, true},
+		{"blank destination", []DistributionProportion{{DestinationFeeCollector, half}, {" ", half}}, true},
+		{"duplicate destination", []DistributionProportion{{DestinationFeeCollector, half}, {DestinationFeeCollector, half}}, true},
+		{"nil proportion", []DistributionProportion{{DestinationFeeCollector, sdk.Dec{}}}, true},
+		{"zero proportion", []DistributionProportion{{DestinationFeeCollector, sdk.OneDec()}, {DestinationCommunityPool, sdk.ZeroDec()}}, true},
+		{"negative proportion", []DistributionProportion{{DestinationFeeCollector, sdk.NewDec(2)}, {DestinationCommunityPool, sdk.NewDec(-1)}}, true},
+		{"sum below one", []DistributionProportion{{DestinationFeeCollector, half}}, true},
+		{"sum above one", []DistributionProportion{{DestinationFeeCollector, sdk.OneDec()}, {DestinationCommunityPool, half}}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDistributionProportions(tc.proportions)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMaxSupply(t *testing.T) {
+	require.NoError(t, validateMaxSupply(sdk.ZeroInt()))
+	require.NoError(t, validateMaxSupply(sdk.NewInt(1000000)))
+	require.Error(t, validateMaxSupply(sdk.Int{}))
+	require.Error(t, validateMaxSupply(sdk.NewInt(-1)))
+	require.Error(t, validateMaxSupply(int64(1000000)))
+}
+
+func TestValidateReductionSchedule(t *testing.T) {
+	provisions := sdk.NewDec(1000)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule []ReductionScheduleEntry
+		expErr   bool
+	}{
+		{"empty", nil, false},
+		{"heights", []ReductionScheduleEntry{{StartHeight: 1, AnnualProvisions: provisions}, {StartHeight: 10, AnnualProvisions: sdk.ZeroDec()}}, false},
+		{"times", []ReductionScheduleEntry{{StartTime: start, AnnualProvisions: provisions}, {StartTime: start.AddDate(1, 0, 0), AnnualProvisions: provisions}}, false},
+		{"no boundary", []ReductionScheduleEntry{{AnnualProvisions: provisions}}, true},
+		{"both boundaries", []ReductionScheduleEntry{{StartHeight: 1, StartTime: start, AnnualProvisions: provisions}}, true},
+		{"negative height", []ReductionScheduleEntry{{StartHeight: -1, AnnualProvisions: provisions}}, true},
+		{"nil provisions", []ReductionScheduleEntry{{StartHeight: 1}}, true},
+		{"negative provisions", []ReductionScheduleEntry{{StartHeight: 1, AnnualProvisions: sdk.NewDec(-1)}}, true},
+		{"mixed boundaries", []ReductionScheduleEntry{{StartHeight: 1, AnnualProvisions: provisions}, {StartTime: start, AnnualProvisions: provisions}}, true},
+		{"equal heights", []ReductionScheduleEntry{{StartHeight: 10, AnnualProvisions: provisions}, {StartHeight: 10, AnnualProvisions: provisions}}, true},
+		{"decreasing heights", []ReductionScheduleEntry{{StartHeight: 10, AnnualProvisions: provisions}, {StartHeight: 5, AnnualProvisions: provisions}}, true},
+		{"equal times", []ReductionScheduleEntry{{StartTime: start, AnnualProvisions: provisions}, {StartTime: start, AnnualProvisions: provisions}}, true},
+		{"decreasing times", []ReductionScheduleEntry{{StartTime: start, AnnualProvisions: provisions}, {StartTime: start.Add(-time.Second), AnnualProvisions: provisions}}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateReductionSchedule(tc.schedule)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSchedulePosition(t *testing.T) {
+	provisions := sdk.NewDec(1000)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	params := DefaultParams()
+	require.Equal(t, uint64(0), params.SchedulePosition(100, start))
+
+	params.ReductionSchedule = []ReductionScheduleEntry{
+		{StartHeight: 5, AnnualProvisions: provisions},
+		{StartHeight: 10, AnnualProvisions: provisions},
+	}
+	for height, expPosition := range map[int64]uint64{1: 0, 4: 0, 5: 1, 9: 1, 10: 2, 100: 2} {
+		require.Equal(t, expPosition, params.SchedulePosition(height, start), "height %d", height)
+	}
+
+	params.ReductionSchedule = []ReductionScheduleEntry{
+		{StartTime: start, AnnualProvisions: provisions},
+		{StartTime: start.AddDate(1, 0, 0), AnnualProvisions: provisions},
+	}
+	for blockTime, expPosition := range map[time.Time]uint64{
+		start.Add(-time.Second):                  0,
+		start:                                    1,
+		start.AddDate(1, 0, 0).Add(-time.Second): 1,
+		start.AddDate(1, 0, 0):                   2,
+	} {
+		require.Equal(t, expPosition, params.SchedulePosition(1, blockTime), "time %s", blockTime)
+	}
+}
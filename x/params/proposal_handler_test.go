@@ -69,8 +69,19 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			func() {
 				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
 				suite.Require().Equal(govtypes.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: govtypes.DefaultPeriod,
+					MinDeposit:                 sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
+					MaxDepositPeriod:           govtypes.DefaultPeriod,
+					ExpeditedMinDeposit:        sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, govtypes.DefaultMinExpeditedDepositTokens)),
+					BurnVoteQuorum:             govtypes.DefaultBurnVoteQuorum,
+					BurnProposalDepositPrevote: govtypes.DefaultBurnProposalDepositPrevote,
+					BurnVoteVeto:               govtypes.DefaultBurnVoteVeto,
+					MaxMetadataLen:             govtypes.DefaultMaxMetadataLen,
+					MinInitialDepositRatio:     govtypes.DefaultMinInitialDepositRatio,
+					ProposalCancelRatio:        govtypes.DefaultProposalCancelRatio,
+					ProposalCancelBurn:         govtypes.DefaultProposalCancelBurn,
+					MaxVoteOptions:             govtypes.DefaultMaxVoteOptions,
+					MaxVoteOptionLen:           govtypes.DefaultMaxVoteOptionLen,
+					DepositRecordRetention:     govtypes.DefaultDepositRecordRetention,
 				}, depositParams)
 			},
 			false,
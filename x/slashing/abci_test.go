@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -99,3 +101,117 @@ func TestBeginBlocker(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, stakingtypes.Unbonding, validator.GetStatus())
 }
+
+func TestEndBlockerAutoUnjail(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Unix(1000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	params := testslashing.TestParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimeJailDuration = 10 * time.Minute
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	// more validators than can be unjailed automatically in a block
+	numVals := types.MaxAutoUnjailsPerBlock + 2
+	pks := simapp.CreateTestPubKeys(numVals)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	power := int64(100)
+	votes := make([]abci.VoteInfo, numVals)
+	for i, pk := range pks {
+		tstaking.CreateValidatorWithValPower(sdk.ValAddress(pk.Address()), pk, power, true)
+		votes[i] = abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: power}}
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	beginBlock := func(height int64, signed bool) {
+		ctx = ctx.WithBlockHeight(height)
+		for i := range votes {
+			votes[i].SignedLastBlock = signed
+		}
+		slashing.BeginBlocker(ctx, abci.RequestBeginBlock{LastCommitInfo: abci.LastCommitInfo{Votes: votes}}, app.SlashingKeeper)
+	}
+
+	// all the validators sign the first blocks of the window, then miss enough
+	// blocks to be jailed for downtime at once
+	height := int64(0)
+	for ; height < app.SlashingKeeper.SignedBlocksWindow(ctx); height++ {
+		beginBlock(height, true)
+	}
+	maxMissed := app.SlashingKeeper.SignedBlocksWindow(ctx) - app.SlashingKeeper.MinSignedPerWindow(ctx)
+	for i := int64(0); i <= maxMissed; i, height = i+1, height+1 {
+		beginBlock(height, false)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the first validator is tombstoned after its downtime jail
+	tombstoned := sdk.ConsAddress(pks[0].Address())
+	app.SlashingKeeper.Tombstone(ctx, tombstoned)
+
+	countJailed := func() (jailed int) {
+		for _, pk := range pks {
+			if app.StakingKeeper.Validator(ctx, sdk.ValAddress(pk.Address())).IsJailed() {
+				jailed++
+			}
+		}
+		return jailed
+	}
+	countQueued := func() (queued int) {
+		app.SlashingKeeper.IterateAutoUnjailQueue(ctx, now.Add(params.DowntimeJailDuration), func(sdk.ConsAddress, time.Time) bool {
+			queued++
+			return false
+		})
+		return queued
+	}
+	endBlock := func(blockTime time.Time) (autoUnjails int) {
+		ctx = ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		slashing.EndBlocker(ctx, app.SlashingKeeper)
+		for _, event := range ctx.EventManager().Events() {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyAuto && string(attr.Value) == "true" {
+					autoUnjails++
+				}
+			}
+		}
+		return autoUnjails
+	}
+	require.Equal(t, numVals, countJailed())
+	require.Equal(t, numVals, countQueued())
+
+	jailEnd := now.Add(params.DowntimeJailDuration)
+
+	// nothing happens while automatic unjailing is disabled
+	require.Zero(t, endBlock(jailEnd))
+	require.Equal(t, numVals, countJailed())
+	require.Equal(t, numVals, countQueued())
+
+	params.AutoUnjail = true
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	// nothing happens before the end of the jail period
+	require.Zero(t, endBlock(jailEnd.Add(-time.Second)))
+	require.Equal(t, numVals, countJailed())
+	require.Equal(t, numVals, countQueued())
+
+	// at most MaxAutoUnjailsPerBlock validators are processed per block
+	unjailed := endBlock(jailEnd)
+	require.Equal(t, numVals-types.MaxAutoUnjailsPerBlock, countQueued())
+	require.Equal(t, numVals-unjailed, countJailed())
+	require.LessOrEqual(t, types.MaxAutoUnjailsPerBlock-1, unjailed)
+
+	// the remaining ones are processed in the next block, except the
+	// tombstoned validator which stays jailed
+	unjailed += endBlock(jailEnd.Add(time.Second))
+	require.Zero(t, countQueued())
+	require.Equal(t, numVals-1, unjailed)
+	require.Equal(t, 1, countJailed())
+	require.True(t, app.StakingKeeper.ValidatorByConsAddr(ctx, tombstoned).IsJailed())
+
+	// the unjailed validators rejoin the validator set
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	for _, pk := range pks[1:] {
+		tstaking.CheckValidator(sdk.ValAddress(pk.Address()), stakingtypes.Bonded, false)
+	}
+}
@@ -2,4 +2,7 @@ package cli
 
 const (
 	FlagAddressValidator = "validator"
+	FlagMinMissed        = "min-missed"
+	FlagTombstoned       = "tombstoned"
+	FlagJailedUntilAfter = "jailed-until-after"
 )
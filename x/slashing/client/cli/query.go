@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +29,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQuerySigningInfoByConsAddrs(),
+		GetCmdQueryMissedBlocks(),
+		GetCmdQueryValidatorSlashes(),
 	)
 
 	return slashingQueryCmd
@@ -76,9 +81,13 @@ func GetCmdQuerySigningInfos() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "signing-infos",
 		Short: "Query signing information of all validators",
-		Long: strings.TrimSpace(`signing infos of validators:
+		Long: strings.TrimSpace(`signing infos of validators, optionally filtered by missed blocks, tombstoned
+or jailed status:
 
 $ <appd> query slashing signing-infos
+$ <appd> query slashing signing-infos --min-missed 50
+$ <appd> query slashing signing-infos --tombstoned
+$ <appd> query slashing signing-infos --jailed-until-after 2022-01-01T00:00:00Z
 `),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -93,7 +102,30 @@ $ <appd> query slashing signing-infos
 				return err
 			}
 
-			params := &types.QuerySigningInfosRequest{Pagination: pageReq}
+			minMissed, err := cmd.Flags().GetInt64(FlagMinMissed)
+			if err != nil {
+				return err
+			}
+
+			tombstoned, err := cmd.Flags().GetBool(FlagTombstoned)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySigningInfosRequest{Pagination: pageReq, MinMissedBlocks: minMissed, Tombstoned: tombstoned}
+
+			jailedUntilAfter, err := cmd.Flags().GetString(FlagJailedUntilAfter)
+			if err != nil {
+				return err
+			}
+			if jailedUntilAfter != "" {
+				t, err := time.Parse(time.RFC3339, jailedUntilAfter)
+				if err != nil {
+					return fmt.Errorf("invalid %s timestamp %s: %w", FlagJailedUntilAfter, jailedUntilAfter, err)
+				}
+				params.JailedUntilAfter = &t
+			}
+
 			res, err := queryClient.SigningInfos(cmd.Context(), params)
 			if err != nil {
 				return err
@@ -105,6 +137,134 @@ $ <appd> query slashing signing-infos
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "signing infos")
+	cmd.Flags().Int64(FlagMinMissed, 0, "Only return the signing infos with at least this number of missed blocks")
+	cmd.Flags().Bool(FlagTombstoned, false, "Only return the signing infos of tombstoned validators")
+	cmd.Flags().String(FlagJailedUntilAfter, "", "Only return the signing infos of validators jailed until after this RFC3339 timestamp")
+
+	return cmd
+}
+
+// GetCmdQuerySigningInfoByConsAddrs implements the command to query the
+// signing infos of a batch of consensus addresses.
+func GetCmdQuerySigningInfoByConsAddrs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos-by-cons-addrs [cons-addr]...",
+		Short: "Query the signing information of validators by consensus address",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the signing infos of up to %d validators by consensus address:
+
+$ <appd> query slashing signing-infos-by-cons-addrs cosmosvalcons1nrqsld3aw6lh6t082frdqc84uwxn0t958c cosmosvalcons1jkn38lemcuyzl62vpeak9hzsmsk3c5j9w8rh5y
+`, types.MaxSigningInfoConsAddrs)),
+		Args: cobra.RangeArgs(1, types.MaxSigningInfoConsAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			for _, arg := range args {
+				if _, err := sdk.ConsAddressFromBech32(arg); err != nil {
+					return err
+				}
+			}
+
+			params := &types.QuerySigningInfoByConsAddrsRequest{ConsAddresses: args}
+			res, err := queryClient.SigningInfoByConsAddrs(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMissedBlocks implements the command to query the blocks missed
+// by a validator.
+func GetCmdQueryMissedBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missed-blocks [cons-addr]",
+		Short: "Query the blocks of the signed blocks window missed by a validator",
+		Long: strings.TrimSpace(`Query the indexes of the missed blocks bitmap of a validator marked as missed, along with the
+heights of the blocks:
+
+$ <appd> query slashing missed-blocks cosmosvalcons1nrqsld3aw6lh6t082frdqc84uwxn0t958c
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.MissedBlocks(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "missed blocks")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorSlashes implements the command to query the slash
+// history of a validator.
+func GetCmdQueryValidatorSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-slashes [cons-or-val-addr]",
+		Short: "Query the slash history of a validator",
+		Long: strings.TrimSpace(`Query the most recent downtime and double sign slashes of a validator by consensus or
+operator address, from the oldest one:
+
+$ <appd> query slashing validator-slashes cosmosvalcons1nrqsld3aw6lh6t082frdqc84uwxn0t958c
+$ <appd> query slashing validator-slashes cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorSlashesRequest{Address: args[0], Pagination: pageReq}
+			res, err := queryClient.ValidatorSlashes(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator slashes")
 
 	return cmd
 }
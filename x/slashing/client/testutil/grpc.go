@@ -47,6 +47,35 @@ func (s *IntegrationTestSuite) TestGRPCQueries() {
 				},
 			},
 		},
+		{
+			"get signing infos with min missed blocks",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos?min_missed_blocks=1", baseURL),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			false,
+			&types.QuerySigningInfosResponse{},
+			&types.QuerySigningInfosResponse{
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			"get signing infos by cons addrs (height specific)",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos_by_cons_addrs?cons_addresses=%s", baseURL, consAddr),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			false,
+			&types.QuerySigningInfoByConsAddrsResponse{},
+			&types.QuerySigningInfoByConsAddrsResponse{
+				Info: []types.ValidatorSigningInfo{
+					{
+						Address:     sdk.ConsAddress(val.PubKey.Address()).String(),
+						JailedUntil: time.Unix(0, 0),
+					},
+				},
+			},
+		},
 		{
 			"get signing info (height specific)",
 			fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos/%s", baseURL, consAddr),
@@ -62,6 +91,32 @@ func (s *IntegrationTestSuite) TestGRPCQueries() {
 				},
 			},
 		},
+		{
+			"get missed blocks (height specific)",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos/%s/missed_blocks", baseURL, consAddr),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			false,
+			&types.QueryMissedBlocksResponse{},
+			&types.QueryMissedBlocksResponse{
+				SignedBlocksWindow: types.DefaultSignedBlocksWindow,
+				MinSignedPerWindow: types.DefaultMinSignedPerWindow.MulInt64(types.DefaultSignedBlocksWindow).RoundInt64(),
+				Pagination:         &query.PageResponse{},
+			},
+		},
+		{
+			"get validator slashes (height specific)",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/validator_slashes/%s", baseURL, val.ValAddress),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			false,
+			&types.QueryValidatorSlashesResponse{},
+			&types.QueryValidatorSlashesResponse{
+				Pagination: &query.PageResponse{},
+			},
+		},
 		{
 			"get signing info wrong address",
 			fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos/%s", baseURL, "wrongAddress"),
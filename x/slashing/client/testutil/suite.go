@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 type IntegrationTestSuite struct {
@@ -67,7 +68,7 @@ func (s *IntegrationTestSuite) TestGetCmdQuerySigningInfo() {
 				fmt.Sprintf("--%s=1", flags.FlagHeight),
 			},
 			false,
-			fmt.Sprintf("{\"address\":\"%s\",\"start_height\":\"0\",\"index_offset\":\"0\",\"jailed_until\":\"1970-01-01T00:00:00Z\",\"tombstoned\":false,\"missed_blocks_counter\":\"0\"}", sdk.ConsAddress(val.PubKey.Address())),
+			fmt.Sprintf("{\"address\":\"%s\",\"start_height\":\"0\",\"index_offset\":\"0\",\"jailed_until\":\"1970-01-01T00:00:00Z\",\"tombstoned\":false,\"missed_blocks_counter\":\"0\",\"downtime_jail_count\":\"0\"}", sdk.ConsAddress(val.PubKey.Address())),
 		},
 		{
 			"valid address (text output)",
@@ -78,6 +79,7 @@ func (s *IntegrationTestSuite) TestGetCmdQuerySigningInfo() {
 			},
 			false,
 			fmt.Sprintf(`address: %s
+downtime_jail_count: "0"
 index_offset: "0"
 jailed_until: "1970-01-01T00:00:00Z"
 missed_blocks_counter: "0"
@@ -104,6 +106,229 @@ tombstoned: false`, sdk.ConsAddress(val.PubKey.Address())),
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySigningInfos() {
+	val := s.network.Validators[0]
+	consAddr := sdk.ConsAddress(val.PubKey.Address()).String()
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expInfos  int
+	}{
+		{
+			"invalid jailed until after",
+			[]string{
+				fmt.Sprintf("--%s=foo", cli.FlagJailedUntilAfter),
+			},
+			true,
+			0,
+		},
+		{
+			"no filter",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			1,
+		},
+		{
+			"min missed blocks",
+			[]string{
+				fmt.Sprintf("--%s=50", cli.FlagMinMissed),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			0,
+		},
+		{
+			"tombstoned",
+			[]string{
+				fmt.Sprintf("--%s", cli.FlagTombstoned),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			0,
+		},
+		{
+			"jailed until after",
+			[]string{
+				fmt.Sprintf("--%s=1969-12-31T23:59:59Z", cli.FlagJailedUntilAfter),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySigningInfos()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QuerySigningInfosResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().Len(res.Info, tc.expInfos)
+				for _, info := range res.Info {
+					s.Require().Equal(consAddr, info.Address)
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQuerySigningInfoByConsAddrs() {
+	val := s.network.Validators[0]
+	consAddr := sdk.ConsAddress(val.PubKey.Address()).String()
+	unknown := sdk.ConsAddress(val.Address).String()
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expInfos    int
+		expNotFound []string
+	}{
+		{"invalid address", []string{"foo"}, true, 0, nil},
+		{
+			"valid addresses",
+			[]string{
+				consAddr, unknown,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			1,
+			[]string{unknown},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySigningInfoByConsAddrs()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QuerySigningInfoByConsAddrsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().Len(res.Info, tc.expInfos)
+				s.Require().Equal(consAddr, res.Info[0].Address)
+				s.Require().Equal(tc.expNotFound, res.NotFound)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryMissedBlocks() {
+	val := s.network.Validators[0]
+	consAddr := sdk.ConsAddress(val.PubKey.Address()).String()
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{"invalid address", []string{"foo"}, true, ``},
+		{"unknown address", []string{sdk.ConsAddress(val.Address).String()}, true, ``},
+		{
+			"valid address (json output)",
+			[]string{
+				consAddr,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			`{"missed_blocks":[],"signed_blocks_window":"100","min_signed_per_window":"50","start_height":"0","index_offset":"0","pagination":{"next_key":null,"total":"0"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryMissedBlocks()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorSlashes() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{"invalid address", []string{"foo"}, true, ``},
+		{"unknown validator", []string{sdk.ValAddress(s.network.Validators[0].PubKey.Address()).String()}, true, ``},
+		{
+			"consensus address (json output)",
+			[]string{
+				sdk.ConsAddress(val.PubKey.Address()).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			`{"slashes":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			"operator address (json output)",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			`{"slashes":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorSlashes()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 	val := s.network.Validators[0]
 
@@ -115,12 +340,17 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`,
+			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000","downtime_jail_multiplier":"1.000000000000000000","downtime_jail_decay_window":"86400s","max_downtime_jail_duration":"604800s","auto_unjail":false,"max_slash_records":20}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`downtime_jail_duration: 600s
+			`auto_unjail: false
+downtime_jail_decay_window: 86400s
+downtime_jail_duration: 600s
+downtime_jail_multiplier: "1.000000000000000000"
+max_downtime_jail_duration: 604800s
+max_slash_records: 20
 min_signed_per_window: "0.500000000000000000"
 signed_blocks_window: "100"
 slash_fraction_double_sign: "0.050000000000000000"
@@ -27,6 +27,13 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 			panic(err)
 		}
 		keeper.SetValidatorSigningInfo(ctx, address, info.ValidatorSigningInfo)
+
+		// the auto unjail queue is not exported, it is rebuilt from the signing
+		// infos of the jailed validators
+		validator := stakingKeeper.ValidatorByConsAddr(ctx, address)
+		if validator != nil && validator.IsJailed() && !info.ValidatorSigningInfo.Tombstoned {
+			keeper.InsertAutoUnjailQueue(ctx, address, info.ValidatorSigningInfo.JailedUntil)
+		}
 	}
 
 	for _, array := range data.MissedBlocks {
@@ -39,6 +46,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 	}
 
+	for _, record := range data.SlashRecords {
+		address, err := sdk.ConsAddressFromBech32(record.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetSlashRecord(ctx, address, record)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -66,5 +81,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	slashRecords := make([]types.SlashRecord, 0)
+	keeper.IterateSlashRecords(ctx, func(_ sdk.ConsAddress, record types.SlashRecord) (stop bool) {
+		slashRecords = append(slashRecords, record)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, slashRecords)
 }
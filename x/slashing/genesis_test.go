@@ -57,3 +57,33 @@ func TestExportAndInitGenesis(t *testing.T) {
 	require.Equal(t, info1, newInfo1)
 	require.Equal(t, info2, newInfo2)
 }
+
+func TestExportAndInitGenesisSlashRecords(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	pks := simapp.CreateTestPubKeys(2)
+	consAddr1, consAddr2 := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+	records := []types.SlashRecord{
+		types.NewSlashRecord(consAddr1, 3, sdk.NewDecWithPrec(1, 2), types.SlashTypeDowntime, sdk.NewInt(1000)),
+		types.NewSlashRecord(consAddr1, 9, sdk.NewDecWithPrec(5, 2), types.SlashTypeDoubleSign, sdk.NewInt(4950)),
+		types.NewSlashRecord(consAddr2, 4, sdk.NewDecWithPrec(1, 2), types.SlashTypeDowntime, sdk.NewInt(2000)),
+	}
+	for _, record := range records {
+		consAddr, err := sdk.ConsAddressFromBech32(record.Address)
+		require.NoError(t, err)
+		app.SlashingKeeper.SetSlashRecord(ctx, consAddr, record)
+	}
+
+	genesisState := slashing.ExportGenesis(ctx, app.SlashingKeeper)
+	require.NoError(t, types.ValidateGenesis(*genesisState))
+	require.ElementsMatch(t, records, genesisState.SlashRecords)
+
+	newApp := simapp.Setup(t, false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{})
+	slashing.InitGenesis(newCtx, newApp.SlashingKeeper, newApp.StakingKeeper, genesisState)
+
+	require.Equal(t, records[:2], newApp.SlashingKeeper.GetSlashRecords(newCtx, consAddr1))
+	require.Equal(t, records[2:], newApp.SlashingKeeper.GetSlashRecords(newCtx, consAddr2))
+	require.Equal(t, genesisState, slashing.ExportGenesis(newCtx, newApp.SlashingKeeper))
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// InsertAutoUnjailQueue inserts a validator into the auto unjail queue at
+// jailedUntil
+func (k Keeper) InsertAutoUnjailQueue(ctx sdk.Context, consAddr sdk.ConsAddress, jailedUntil time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoUnjailQueueKey(jailedUntil, consAddr), []byte{})
+}
+
+// RemoveFromAutoUnjailQueue removes a validator from the auto unjail queue
+func (k Keeper) RemoveFromAutoUnjailQueue(ctx sdk.Context, consAddr sdk.ConsAddress, jailedUntil time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoUnjailQueueKey(jailedUntil, consAddr))
+}
+
+// IterateAutoUnjailQueue iterates over the validators in the auto unjail queue
+// whose jail period concluded at endTime and performs a callback function
+func (k Keeper) IterateAutoUnjailQueue(ctx sdk.Context, endTime time.Time,
+	cb func(consAddr sdk.ConsAddress, jailedUntil time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.AutoUnjailQueueKeyPrefix, sdk.PrefixEndBytes(types.AutoUnjailQueueByTimeKey(endTime)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		jailedUntil, consAddr := types.SplitAutoUnjailQueueKey(iter.Key())
+		if cb(consAddr, jailedUntil) {
+			break
+		}
+	}
+}
+
+// AutoUnjailValidators unjails the validators of the auto unjail queue whose
+// jail period has concluded, if the AutoUnjail param is enabled. The queue
+// entries are removed whether the validator could be unjailed or not, and at
+// most MaxAutoUnjailsPerBlock of them are processed per block.
+func (k Keeper) AutoUnjailValidators(ctx sdk.Context) {
+	if !k.AutoUnjail(ctx) {
+		return
+	}
+
+	type entry struct {
+		consAddr    sdk.ConsAddress
+		jailedUntil time.Time
+	}
+
+	var entries []entry
+	k.IterateAutoUnjailQueue(ctx, ctx.BlockHeader().Time, func(consAddr sdk.ConsAddress, jailedUntil time.Time) bool {
+		entries = append(entries, entry{consAddr, jailedUntil})
+		return len(entries) >= types.MaxAutoUnjailsPerBlock
+	})
+
+	logger := k.Logger(ctx)
+	for _, e := range entries {
+		k.RemoveFromAutoUnjailQueue(ctx, e.consAddr, e.jailedUntil)
+
+		validator := k.sk.ValidatorByConsAddr(ctx, e.consAddr)
+		if validator == nil {
+			continue
+		}
+
+		// Unjail checks that the validator is still jailed, is not tombstoned,
+		// has concluded its jail period and meets its min self delegation.
+		valAddr := validator.GetOperator()
+		if err := k.Unjail(ctx, valAddr); err != nil {
+			logger.Debug("validator not unjailed automatically", "validator", valAddr.String(), "err", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyAuto, "true"),
+			),
+		)
+
+		logger.Info("unjailed validator automatically", "validator", valAddr.String())
+	}
+}
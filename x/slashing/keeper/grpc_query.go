@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -58,17 +60,195 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	var signInfos []types.ValidatorSigningInfo
 
 	sigInfoStore := prefix.NewStore(store, types.ValidatorSigningInfoKeyPrefix)
-	pageRes, err := query.Paginate(sigInfoStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(sigInfoStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var info types.ValidatorSigningInfo
 		err := k.cdc.Unmarshal(value, &info)
 		if err != nil {
+			return false, err
+		}
+
+		if info.MissedBlocksCounter < req.MinMissedBlocks ||
+			(req.Tombstoned && !info.Tombstoned) ||
+			(req.JailedUntilAfter != nil && !info.JailedUntil.After(*req.JailedUntilAfter)) {
+			return false, nil
+		}
+
+		if accumulate {
+			signInfos = append(signInfos, info)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SigningInfoByConsAddrs(c context.Context, req *types.QuerySigningInfoByConsAddrsRequest) (*types.QuerySigningInfoByConsAddrsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.ConsAddresses) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.ConsAddresses) > types.MaxSigningInfoConsAddrs {
+		return nil, status.Errorf(codes.InvalidArgument, "too many consensus addresses: %d > %d", len(req.ConsAddresses), types.MaxSigningInfoConsAddrs)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	signInfos := make([]types.ValidatorSigningInfo, 0, len(req.ConsAddresses))
+	var notFound []string
+	seen := make(map[string]bool, len(req.ConsAddresses))
+	for _, addr := range req.ConsAddresses {
+		consAddr, err := sdk.ConsAddressFromBech32(addr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid consensus address %s: %s", addr, err)
+		}
+
+		if seen[consAddr.String()] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate consensus address %s", addr)
+		}
+		seen[consAddr.String()] = true
+
+		signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+		if !found {
+			notFound = append(notFound, addr)
+			continue
+		}
+		signInfos = append(signInfos, signingInfo)
+	}
+
+	return &types.QuerySigningInfoByConsAddrsResponse{Info: signInfos, NotFound: notFound}, nil
+}
+
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	window := k.SignedBlocksWindow(ctx)
+	var missedBlocks []types.MissedBlockIndex
+
+	bitArrayStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorMissedBlockBitArrayPrefixKey(consAddr))
+	pageRes, err := query.FilteredPaginate(bitArrayStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var missed gogotypes.BoolValue
+		if err := k.cdc.Unmarshal(value, &missed); err != nil {
+			return false, err
+		}
+
+		// the bitmap may keep the indexes beyond a shrunk signed blocks window
+		index := int64(binary.LittleEndian.Uint64(key))
+		if !missed.Value || index >= window {
+			return false, nil
+		}
+
+		if accumulate {
+			missedBlocks = append(missedBlocks, types.MissedBlockIndex{
+				Index:  index,
+				Height: missedBlockHeight(signingInfo, index, window),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMissedBlocksResponse{
+		MissedBlocks:       missedBlocks,
+		SignedBlocksWindow: window,
+		MinSignedPerWindow: k.MinSignedPerWindow(ctx),
+		StartHeight:        signingInfo.StartHeight,
+		IndexOffset:        signingInfo.IndexOffset,
+		Pagination:         pageRes,
+	}, nil
+}
+
+func (k Keeper) ValidatorSlashes(c context.Context, req *types.QueryValidatorSlashesRequest) (*types.QueryValidatorSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	consAddr, err := k.validatorConsAddress(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var slashes []types.SlashRecord
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordsPrefixKey(consAddr))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.SlashRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
 			return err
 		}
-		signInfos = append(signInfos, info)
+
+		slashes = append(slashes, record)
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
+
+	return &types.QueryValidatorSlashesResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+// validatorConsAddress returns the consensus address of a validator from
+// either its bech32 consensus address or its bech32 operator address.
+func (k Keeper) validatorConsAddress(ctx sdk.Context, address string) (sdk.ConsAddress, error) {
+	if consAddr, err := sdk.ConsAddressFromBech32(address); err == nil {
+		return consAddr, nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid consensus or operator address %s", address)
+	}
+
+	validator := k.sk.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", address)
+	}
+
+	return validator.GetConsAddr()
+}
+
+// missedBlockHeight returns the height of the block at an index of the missed
+// blocks bitmap. The index offset of the signing info is incremented for every
+// block since its start height, the last index offset the bitmap index was
+// written at is hence the height of the block relative to the start height. It
+// returns zero if no block was written at the index since the start height.
+func missedBlockHeight(info types.ValidatorSigningInfo, index, window int64) int64 {
+	last := info.IndexOffset - 1
+	if last < 0 {
+		return 0
+	}
+
+	offset := last - ((last-index)%window+window)%window
+	if offset < 0 {
+		return 0
+	}
+
+	return info.StartHeight + offset
 }
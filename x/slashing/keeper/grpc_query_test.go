@@ -16,6 +16,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 type SlashingTestSuite struct {
@@ -105,6 +106,276 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCSigningInfosFilters() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	// the signing infos of the suite miss no blocks and are not jailed
+	jailedUntil := time.Unix(100, 0)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 10, sdk.NewInt(1))
+	for i, addr := range addrs {
+		info := types.NewValidatorSigningInfo(sdk.ConsAddress(addr), 0, 0, time.Unix(int64(i%3)*100, 0), i%4 == 0, int64(i))
+		app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addr), info)
+	}
+
+	testCases := []struct {
+		msg    string
+		req    types.QuerySigningInfosRequest
+		filter func(info types.ValidatorSigningInfo) bool
+	}{
+		{
+			"no filter",
+			types.QuerySigningInfosRequest{},
+			func(info types.ValidatorSigningInfo) bool { return true },
+		},
+		{
+			"min missed blocks",
+			types.QuerySigningInfosRequest{MinMissedBlocks: 5},
+			func(info types.ValidatorSigningInfo) bool { return info.MissedBlocksCounter >= 5 },
+		},
+		{
+			"tombstoned",
+			types.QuerySigningInfosRequest{Tombstoned: true},
+			func(info types.ValidatorSigningInfo) bool { return info.Tombstoned },
+		},
+		{
+			"jailed until after",
+			types.QuerySigningInfosRequest{JailedUntilAfter: &jailedUntil},
+			func(info types.ValidatorSigningInfo) bool { return info.JailedUntil.After(jailedUntil) },
+		},
+		{
+			"all filters",
+			types.QuerySigningInfosRequest{MinMissedBlocks: 3, Tombstoned: true, JailedUntilAfter: &jailedUntil},
+			func(info types.ValidatorSigningInfo) bool {
+				return info.MissedBlocksCounter >= 3 && info.Tombstoned && info.JailedUntil.After(jailedUntil)
+			},
+		},
+		{
+			"no match",
+			types.QuerySigningInfosRequest{MinMissedBlocks: 10},
+			func(info types.ValidatorSigningInfo) bool { return false },
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			var expected []types.ValidatorSigningInfo
+			app.SlashingKeeper.IterateValidatorSigningInfos(ctx, func(_ sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+				if tc.filter(info) {
+					expected = append(expected, info)
+				}
+				return false
+			})
+
+			req := tc.req
+			res, err := queryClient.SigningInfos(gocontext.Background(), &req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expected, res.Info)
+			suite.Require().Equal(uint64(len(expected)), res.Pagination.Total)
+
+			// the pages neither skip nor duplicate signing infos
+			for limit := uint64(1); limit <= 4; limit++ {
+				var byKey []types.ValidatorSigningInfo
+				req.Pagination = &query.PageRequest{Limit: limit}
+				for {
+					res, err := queryClient.SigningInfos(gocontext.Background(), &req)
+					suite.Require().NoError(err)
+					suite.Require().LessOrEqual(uint64(len(res.Info)), limit)
+					byKey = append(byKey, res.Info...)
+					if res.Pagination.NextKey == nil {
+						break
+					}
+					req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: limit}
+				}
+				suite.Require().Equal(expected, byKey)
+
+				var byOffset []types.ValidatorSigningInfo
+				for offset := uint64(0); ; offset += limit {
+					req.Pagination = &query.PageRequest{Offset: offset, Limit: limit, CountTotal: true}
+					res, err := queryClient.SigningInfos(gocontext.Background(), &req)
+					suite.Require().NoError(err)
+					suite.Require().Equal(uint64(len(expected)), res.Pagination.Total)
+					byOffset = append(byOffset, res.Info...)
+					if res.Pagination.NextKey == nil {
+						break
+					}
+				}
+				suite.Require().Equal(expected, byOffset)
+			}
+		})
+	}
+}
+
+func (suite *SlashingTestSuite) TestGRPCSigningInfoByConsAddrs() {
+	queryClient := suite.queryClient
+
+	consAddr1, consAddr2 := sdk.ConsAddress(suite.addrDels[0]), sdk.ConsAddress(suite.addrDels[1])
+	info1, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(suite.ctx, consAddr1)
+	suite.Require().True(found)
+	info2, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(suite.ctx, consAddr2)
+	suite.Require().True(found)
+
+	tooMany := make([]string, types.MaxSigningInfoConsAddrs+1)
+	for i, pk := range simapp.CreateTestPubKeys(len(tooMany)) {
+		tooMany[i] = sdk.ConsAddress(pk.Address()).String()
+	}
+	unknown := tooMany[0]
+
+	testCases := []struct {
+		msg         string
+		addrs       []string
+		expPass     bool
+		expInfo     []types.ValidatorSigningInfo
+		expNotFound []string
+	}{
+		{"empty request", nil, false, nil, nil},
+		{"invalid address", []string{consAddr1.String(), "foo"}, false, nil, nil},
+		{"duplicate address", []string{consAddr1.String(), consAddr1.String()}, false, nil, nil},
+		{"too many addresses", tooMany, false, nil, nil},
+		{
+			"in request order",
+			[]string{consAddr2.String(), consAddr1.String()},
+			true,
+			[]types.ValidatorSigningInfo{info2, info1},
+			nil,
+		},
+		{
+			"not found address",
+			[]string{consAddr1.String(), unknown},
+			true,
+			[]types.ValidatorSigningInfo{info1},
+			[]string{unknown},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			res, err := queryClient.SigningInfoByConsAddrs(gocontext.Background(), &types.QuerySigningInfoByConsAddrsRequest{ConsAddresses: tc.addrs})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expInfo, res.Info)
+			suite.Require().Equal(tc.expNotFound, res.NotFound)
+		})
+	}
+
+	// the maximum number of addresses is accepted
+	addrs := append([]string{consAddr1.String(), consAddr2.String()}, tooMany[:types.MaxSigningInfoConsAddrs-2]...)
+	res, err := queryClient.SigningInfoByConsAddrs(gocontext.Background(), &types.QuerySigningInfoByConsAddrsRequest{ConsAddresses: addrs})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Info, 2)
+	suite.Require().Len(res.NotFound, types.MaxSigningInfoConsAddrs-2)
+}
+
+func (suite *SlashingTestSuite) TestGRPCMissedBlocks() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	pks := simapp.CreateTestPubKeys(2)
+	consAddr, unknown := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	info := types.NewValidatorSigningInfo(consAddr, 10, 5, time.Unix(0, 0), false, 3)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	// the bits not set or set as not missed are not returned, nor the ones
+	// beyond the signed blocks window
+	for _, index := range []int64{1, 3, 7, window} {
+		app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+	}
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2, false)
+
+	_, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: "foo"})
+	suite.Require().Error(err)
+	_, err = queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: unknown.String()})
+	suite.Require().Error(err)
+
+	req := &types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()}
+	res, err := queryClient.MissedBlocks(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	// the block at index 7 was not written since the start height
+	suite.Require().Equal([]types.MissedBlockIndex{{Index: 1, Height: 11}, {Index: 3, Height: 13}, {Index: 7, Height: 0}}, res.MissedBlocks)
+	suite.Require().Equal(window, res.SignedBlocksWindow)
+	suite.Require().Equal(app.SlashingKeeper.MinSignedPerWindow(ctx), res.MinSignedPerWindow)
+	suite.Require().Equal(int64(10), res.StartHeight)
+	suite.Require().Equal(int64(5), res.IndexOffset)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	req.Pagination = &query.PageRequest{Limit: 2}
+	res, err = queryClient.MissedBlocks(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MissedBlockIndex{{Index: 1, Height: 11}, {Index: 3, Height: 13}}, res.MissedBlocks)
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	res, err = queryClient.MissedBlocks(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MissedBlockIndex{{Index: 7, Height: 0}}, res.MissedBlocks)
+
+	// once the window wrapped around, the first indexes were written again
+	info.IndexOffset = window + 2
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 0, true)
+	res, err = queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MissedBlockIndex{
+		{Index: 0, Height: 10 + window},
+		{Index: 1, Height: 11 + window},
+		{Index: 3, Height: 13},
+		{Index: 7, Height: 17},
+	}, res.MissedBlocks)
+}
+
+func (suite *SlashingTestSuite) TestGRPCValidatorSlashes() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	pks := simapp.CreateTestPubKeys(2)
+	consAddr, unknown := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+	valAddr := sdk.ValAddress(suite.addrDels[0])
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, pks[0], 100, true)
+
+	records := []types.SlashRecord{
+		types.NewSlashRecord(consAddr, 3, sdk.NewDecWithPrec(1, 2), types.SlashTypeDowntime, sdk.NewInt(1000)),
+		types.NewSlashRecord(consAddr, 7, sdk.NewDecWithPrec(1, 2), types.SlashTypeDowntime, sdk.NewInt(990)),
+		types.NewSlashRecord(consAddr, 7, sdk.NewDecWithPrec(5, 2), types.SlashTypeDoubleSign, sdk.NewInt(4900)),
+	}
+	for _, record := range records {
+		app.SlashingKeeper.SetSlashRecord(ctx, consAddr, record)
+	}
+
+	_, err := queryClient.ValidatorSlashes(gocontext.Background(), &types.QueryValidatorSlashesRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.ValidatorSlashes(gocontext.Background(), &types.QueryValidatorSlashesRequest{Address: "foo"})
+	suite.Require().Error(err)
+	_, err = queryClient.ValidatorSlashes(gocontext.Background(),
+		&types.QueryValidatorSlashesRequest{Address: sdk.ValAddress(suite.addrDels[1]).String()})
+	suite.Require().Error(err)
+
+	res, err := queryClient.ValidatorSlashes(gocontext.Background(),
+		&types.QueryValidatorSlashesRequest{Address: unknown.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Slashes)
+
+	// the slashes are returned from the oldest one by consensus or operator address
+	for _, address := range []string{consAddr.String(), valAddr.String()} {
+		res, err = queryClient.ValidatorSlashes(gocontext.Background(),
+			&types.QueryValidatorSlashesRequest{Address: address})
+		suite.Require().NoError(err)
+		suite.Require().Equal(records, res.Slashes)
+		suite.Require().Equal(uint64(3), res.Pagination.Total)
+	}
+
+	req := &types.QueryValidatorSlashesRequest{Address: consAddr.String(), Pagination: &query.PageRequest{Limit: 2}}
+	res, err = queryClient.ValidatorSlashes(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[:2], res.Slashes)
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	res, err = queryClient.ValidatorSlashes(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[2:], res.Slashes)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -44,6 +45,43 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	return nil
 }
 
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new
+// consensus key and moves the signing info, the missed blocks and the slash
+// records of the validator over to its new consensus address. The relation of
+// the old key is kept so that evidence against it can still be handled.
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		// the validator has never been bonded
+		return nil
+	}
+
+	signingInfo.Address = newConsAddr.String()
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+	k.deleteValidatorSigningInfo(ctx, oldConsAddr)
+
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.AutoUnjailQueueKey(signingInfo.JailedUntil, oldConsAddr)) {
+		k.RemoveFromAutoUnjailQueue(ctx, oldConsAddr, signingInfo.JailedUntil)
+		k.InsertAutoUnjailQueue(ctx, newConsAddr, signingInfo.JailedUntil)
+	}
+
+	for _, missedBlock := range k.GetValidatorMissedBlocks(ctx, oldConsAddr) {
+		k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, missedBlock.Index, missedBlock.Missed)
+	}
+	k.clearValidatorMissedBlockBitArray(ctx, oldConsAddr)
+	k.moveSlashRecords(ctx, oldConsAddr, newConsAddr)
+
+	return nil
+}
+
 // Hooks wrapper struct for slashing keeper
 type Hooks struct {
 	k Keeper
@@ -71,6 +109,11 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) er
 	return h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, _ sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	return h.k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
@@ -88,3 +131,4 @@ func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.Va
 	return nil
 }
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error { return nil }
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error                   { return nil }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		// the validator may have rotated its consensus key, in which case
+		// Tendermint reports the old key until the update takes effect
+		if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+			if currConsAddr, err := validator.GetConsAddr(); err == nil {
+				consAddr = currConsAddr
+				signInfo, found = k.GetValidatorSigningInfo(ctx, consAddr)
+			}
+		}
+	}
 	if !found {
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 	}
@@ -93,11 +104,20 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+					sdk.NewAttribute(types.AttributeKeyDestination, k.sk.SlashDestination(ctx)),
 				),
 			)
+			k.recordSlash(ctx, consAddr, k.SlashFractionDowntime(ctx), types.SlashTypeDowntime, coinsBurned)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			// The consecutive downtime jails are reset once the validator went
+			// through the decay window since the end of its last jail.
+			if !ctx.BlockHeader().Time.Before(signInfo.JailedUntil.Add(k.DowntimeJailDecayWindow(ctx))) {
+				signInfo.DowntimeJailCount = 0
+			}
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.downtimeJailDuration(ctx, signInfo.DowntimeJailCount))
+			signInfo.DowntimeJailCount++
+			k.InsertAutoUnjailQueue(ctx, consAddr, signInfo.JailedUntil)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -112,6 +132,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"threshold", minSignedPerWindow,
 				"slashed", k.SlashFractionDowntime(ctx).String(),
 				"jailed_until", signInfo.JailedUntil,
+				"downtime_jail_count", signInfo.DowntimeJailCount,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
@@ -125,3 +146,31 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// downtimeJailDuration returns the downtime jail duration of a validator which
+// was already jailed for downtime the given number of consecutive times: the
+// downtime jail duration multiplied by the downtime jail multiplier for each of
+// them, capped at the max downtime jail duration. The downtime jail duration is
+// never reduced by the cap.
+func (k Keeper) downtimeJailDuration(ctx sdk.Context, consecutiveJails uint64) time.Duration {
+	duration := k.DowntimeJailDuration(ctx)
+	multiplier := k.DowntimeJailMultiplier(ctx)
+	if consecutiveJails == 0 || multiplier.LTE(sdk.OneDec()) {
+		return duration
+	}
+
+	maxDuration := k.MaxDowntimeJailDuration(ctx)
+	if maxDuration <= duration {
+		return duration
+	}
+
+	multiplied := sdk.NewDec(int64(duration))
+	for i := uint64(0); i < consecutiveJails; i++ {
+		multiplied = multiplied.Mul(multiplier)
+		if multiplied.GTE(sdk.NewDec(int64(maxDuration))) {
+			return maxDuration
+		}
+	}
+
+	return time.Duration(multiplied.TruncateInt64())
+}
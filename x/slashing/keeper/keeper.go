@@ -74,8 +74,10 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.De
 			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
 			sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, k.sk.SlashDestination(ctx)),
 		),
 	)
+	k.recordSlash(ctx, consAddr, fraction, types.SlashTypeDoubleSign, coinsBurned)
 }
 
 // Jail attempts to jail a validator. The slash is delegated to the staking module
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -280,3 +281,125 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test a validator being jailed for downtime repeatedly
+// Ensure that the jail duration escalates with the consecutive downtime jails,
+// is capped at the max downtime jail duration and that the consecutive
+// downtime jails survive unjailing and are reset after the decay window
+func TestHandleRepeatedDowntimeJails(t *testing.T) {
+	// initial setup
+	app := simapp.Setup(t, false)
+	now := time.Unix(1000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	params := testslashing.TestParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimeJailDuration = 10 * time.Minute
+	params.DowntimeJailMultiplier = sdk.NewDec(2)
+	params.DowntimeJailDecayWindow = time.Hour
+	params.MaxDowntimeJailDuration = 30 * time.Minute
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// first blocks of the window OK
+	height := int64(0)
+	for ; height < app.SlashingKeeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	maxMissed := app.SlashingKeeper.SignedBlocksWindow(ctx) - app.SlashingKeeper.MinSignedPerWindow(ctx)
+	missBlocks := func() {
+		for i := int64(0); i <= maxMissed; i++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+			height++
+		}
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(addr, stakingtypes.Unbonding, true)
+	}
+	unjail := func(blockTime time.Time) {
+		ctx = ctx.WithBlockTime(blockTime)
+		require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(addr, stakingtypes.Bonded, false)
+	}
+	checkJail := func(duration time.Duration, count uint64) {
+		info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, ctx.BlockHeader().Time.Add(duration), info.JailedUntil)
+		require.Equal(t, count, info.DowntimeJailCount)
+	}
+
+	// first downtime jail uses the downtime jail duration
+	missBlocks()
+	checkJail(10*time.Minute, 1)
+
+	// the consecutive downtime jails survive unjailing
+	unjail(now.Add(10 * time.Minute))
+	checkJail(0, 1)
+
+	// second downtime jail within the decay window is escalated
+	missBlocks()
+	checkJail(20*time.Minute, 2)
+
+	// third downtime jail is capped at the max downtime jail duration
+	unjail(ctx.BlockHeader().Time.Add(20 * time.Minute))
+	missBlocks()
+	checkJail(30*time.Minute, 3)
+
+	// downtime jail after the decay window uses the downtime jail duration again
+	unjail(ctx.BlockHeader().Time.Add(30*time.Minute + time.Hour))
+	missBlocks()
+	checkJail(10*time.Minute, 1)
+}
+
+// Test the destination of the slashed tokens reported by the slash events
+func TestSlashEventDestination(t *testing.T) {
+	for _, fundCommunityPool := range []bool{false, true} {
+		app := simapp.Setup(t, false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+		stakingParams := app.StakingKeeper.GetParams(ctx)
+		stakingParams.SlashFundCommunityPool = fundCommunityPool
+		app.StakingKeeper.SetParams(ctx, stakingParams)
+
+		addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+		valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+		pks := simapp.CreateTestPubKeys(1)
+		tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+		tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+		staking.EndBlocker(ctx, app.StakingKeeper)
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		app.SlashingKeeper.Slash(ctx, sdk.ConsAddress(pks[0].Address()), app.SlashingKeeper.SlashFractionDoubleSign(ctx), 100, 0)
+
+		expDestination := stakingtypes.SlashDestinationBurn
+		if fundCommunityPool {
+			expDestination = stakingtypes.SlashDestinationCommunityPool
+		}
+
+		var destinations []string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeSlash {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyDestination {
+					destinations = append(destinations, string(attr.Value))
+				}
+			}
+		}
+		require.Equal(t, []string{expDestination}, destinations)
+	}
+}
@@ -42,6 +42,36 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeJailMultiplier - multiplier of the downtime jail duration for each consecutive downtime jail
+func (k Keeper) DowntimeJailMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailMultiplier, &res)
+	return
+}
+
+// DowntimeJailDecayWindow - duration after the end of a jail after which the consecutive downtime jails are reset
+func (k Keeper) DowntimeJailDecayWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDecayWindow, &res)
+	return
+}
+
+// MaxDowntimeJailDuration - maximum multiplied downtime jail duration
+func (k Keeper) MaxDowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyMaxDowntimeJailDuration, &res)
+	return
+}
+
+// AutoUnjail - whether the validators jailed for downtime are unjailed automatically
+func (k Keeper) AutoUnjail(ctx sdk.Context) (res bool) {
+	k.paramspace.Get(ctx, types.KeyAutoUnjail, &res)
+	return
+}
+
+// MaxSlashRecords - number of most recent slash records kept per validator
+func (k Keeper) MaxSlashRecords(ctx sdk.Context) (res uint32) {
+	k.paramspace.Get(ctx, types.KeyMaxSlashRecords, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
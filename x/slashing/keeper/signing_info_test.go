@@ -94,3 +94,42 @@ func TestJailUntil(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, time.Unix(253402300799, 0).UTC(), info.JailedUntil)
 }
+
+func TestAfterConsensusPubKeyUpdate(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	pks := simapp.CreateTestPubKeys(2)
+	oldConsAddr, newConsAddr := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+
+	require.NoError(t, app.SlashingKeeper.AddPubkey(ctx, pks[0]))
+	info := types.NewValidatorSigningInfo(oldConsAddr, int64(4), int64(3), time.Unix(2, 0), false, int64(1))
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, oldConsAddr, info)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, oldConsAddr, 2, true)
+	record := types.NewSlashRecord(oldConsAddr, 3, sdk.NewDecWithPrec(1, 2), types.SlashTypeDowntime, sdk.NewInt(10))
+	app.SlashingKeeper.SetSlashRecord(ctx, oldConsAddr, record)
+
+	require.NoError(t, app.SlashingKeeper.AfterConsensusPubKeyUpdate(ctx, pks[0], pks[1]))
+
+	// the signing info, missed blocks and slash records move to the new
+	// consensus address
+	_, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.False(t, found)
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, oldConsAddr, 2))
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr.String(), info.Address)
+	require.Equal(t, int64(3), info.IndexOffset)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, 2))
+
+	require.Empty(t, app.SlashingKeeper.GetSlashRecords(ctx, oldConsAddr))
+	record.Address = newConsAddr.String()
+	require.Equal(t, []types.SlashRecord{record}, app.SlashingKeeper.GetSlashRecords(ctx, newConsAddr))
+
+	// both keys remain known
+	_, err := app.SlashingKeeper.GetPubkey(ctx, pks[0].Address())
+	require.NoError(t, err)
+	_, err = app.SlashingKeeper.GetPubkey(ctx, pks[1].Address())
+	require.NoError(t, err)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SetSlashRecord sets a slash record of a validator
+func (k Keeper) SetSlashRecord(ctx sdk.Context, consAddr sdk.ConsAddress, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.SlashRecordKey(consAddr, record.Height, record.Type), bz)
+}
+
+// IterateSlashRecords iterates over the slash records of all the validators,
+// sorted by validator and height, and performs a callback function
+func (k Keeper) IterateSlashRecords(ctx sdk.Context, cb func(consAddr sdk.ConsAddress, record types.SlashRecord) (stop bool)) {
+	k.iterateSlashRecords(ctx, types.SlashRecordKeyPrefix, func(_ []byte, record types.SlashRecord) bool {
+		consAddr, err := sdk.ConsAddressFromBech32(record.Address)
+		if err != nil {
+			panic(err)
+		}
+		return cb(consAddr, record)
+	})
+}
+
+// GetSlashRecords returns the slash records of a validator, from the oldest one
+func (k Keeper) GetSlashRecords(ctx sdk.Context, consAddr sdk.ConsAddress) []types.SlashRecord {
+	var records []types.SlashRecord
+	k.iterateSlashRecords(ctx, types.SlashRecordsPrefixKey(consAddr), func(_ []byte, record types.SlashRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+func (k Keeper) iterateSlashRecords(ctx sdk.Context, prefix []byte, cb func(key []byte, record types.SlashRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(iter.Key(), record) {
+			break
+		}
+	}
+}
+
+// recordSlash stores a slash record of a validator at the current height and
+// prunes its oldest records beyond the MaxSlashRecords param. No record is
+// stored if the param is zero.
+func (k Keeper) recordSlash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, slashType types.SlashType, burned sdk.Int) {
+	maxRecords := k.MaxSlashRecords(ctx)
+	if maxRecords == 0 {
+		return
+	}
+
+	k.SetSlashRecord(ctx, consAddr, types.NewSlashRecord(consAddr, ctx.BlockHeight(), fraction, slashType, burned))
+	k.pruneSlashRecords(ctx, consAddr, maxRecords)
+}
+
+// pruneSlashRecords deletes the oldest slash records of a validator so that at
+// most maxRecords of them are kept.
+func (k Keeper) pruneSlashRecords(ctx sdk.Context, consAddr sdk.ConsAddress, maxRecords uint32) {
+	var keys [][]byte
+	k.iterateSlashRecords(ctx, types.SlashRecordsPrefixKey(consAddr), func(key []byte, _ types.SlashRecord) bool {
+		keys = append(keys, key)
+		return false
+	})
+
+	if len(keys) <= int(maxRecords) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys[:len(keys)-int(maxRecords)] {
+		store.Delete(key)
+	}
+}
+
+// moveSlashRecords moves the slash records of a validator over to a new
+// consensus address.
+func (k Keeper) moveSlashRecords(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, record := range k.GetSlashRecords(ctx, oldConsAddr) {
+		store.Delete(types.SlashRecordKey(oldConsAddr, record.Height, record.Type))
+		record.Address = newConsAddr.String()
+		k.SetSlashRecord(ctx, newConsAddr, record)
+	}
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSlashRecordDowntime(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := testslashing.TestParams()
+	params.SignedBlocksWindow = 10
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	tokensBefore := app.StakingKeeper.Validator(ctx, addr).GetTokens()
+
+	// miss enough blocks to be slashed and jailed for downtime
+	height := int64(0)
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	for ; height < window; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+	for ; height <= 2*window; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	tstaking.CheckValidator(addr, stakingtypes.Unbonding, true)
+
+	burned := tokensBefore.Sub(app.StakingKeeper.Validator(ctx, addr).GetTokens())
+	require.True(t, burned.IsPositive())
+
+	records := app.SlashingKeeper.GetSlashRecords(ctx, consAddr)
+	require.Len(t, records, 1)
+	require.Equal(t, consAddr.String(), records[0].Address)
+	require.Equal(t, types.SlashTypeDowntime, records[0].Type)
+	require.Equal(t, app.SlashingKeeper.SlashFractionDowntime(ctx), records[0].Fraction)
+	require.Equal(t, burned, records[0].BurnedAmount)
+	require.Less(t, records[0].Height, height)
+}
+
+func TestSlashRecordDoubleSign(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	tokensBefore := app.StakingKeeper.Validator(ctx, valAddrs[0]).GetTokens()
+
+	fraction := app.SlashingKeeper.SlashFractionDoubleSign(ctx)
+	app.SlashingKeeper.Slash(ctx, consAddr, fraction, 100, 0)
+	burned := tokensBefore.Sub(app.StakingKeeper.Validator(ctx, valAddrs[0]).GetTokens())
+
+	require.Equal(t, []types.SlashRecord{
+		types.NewSlashRecord(consAddr, 5, fraction, types.SlashTypeDoubleSign, burned),
+	}, app.SlashingKeeper.GetSlashRecords(ctx, consAddr))
+}
+
+func TestSlashRecordsRetention(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := testslashing.TestParams()
+	params.MaxSlashRecords = 2
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], pks[1], 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	consAddr, otherConsAddr := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+	fraction := sdk.NewDecWithPrec(1, 2)
+	for height := int64(1); height <= 3; height++ {
+		app.SlashingKeeper.Slash(ctx.WithBlockHeight(height), consAddr, fraction, 100, 0)
+	}
+	app.SlashingKeeper.Slash(ctx.WithBlockHeight(1), otherConsAddr, fraction, 100, 0)
+
+	// only the most recent records are kept, per validator
+	records := app.SlashingKeeper.GetSlashRecords(ctx, consAddr)
+	require.Len(t, records, 2)
+	require.Equal(t, int64(2), records[0].Height)
+	require.Equal(t, int64(3), records[1].Height)
+	require.Len(t, app.SlashingKeeper.GetSlashRecords(ctx, otherConsAddr), 1)
+
+	// lowering the param prunes the older records on the next slash
+	params.MaxSlashRecords = 1
+	app.SlashingKeeper.SetParams(ctx, params)
+	app.SlashingKeeper.Slash(ctx.WithBlockHeight(4), consAddr, fraction, 100, 0)
+	records = app.SlashingKeeper.GetSlashRecords(ctx, consAddr)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(4), records[0].Height)
+
+	// no record is stored when the slash history is disabled
+	params.MaxSlashRecords = 0
+	app.SlashingKeeper.SetParams(ctx, params)
+	app.SlashingKeeper.Slash(ctx.WithBlockHeight(5), consAddr, fraction, 100, 0)
+	records = app.SlashingKeeper.GetSlashRecords(ctx, consAddr)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(4), records[0].Height)
+}
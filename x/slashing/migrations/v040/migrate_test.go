@@ -94,7 +94,12 @@ func TestMigrate(t *testing.T) {
     }
   ],
   "params": {
+    "auto_unjail": false,
+    "downtime_jail_decay_window": "0s",
     "downtime_jail_duration": "600s",
+    "downtime_jail_multiplier": "0",
+    "max_downtime_jail_duration": "0s",
+    "max_slash_records": 0,
     "min_signed_per_window": "0.500000000000000000",
     "signed_blocks_window": "100",
     "slash_fraction_double_sign": "0.050000000000000000",
@@ -105,6 +110,7 @@ func TestMigrate(t *testing.T) {
       "address": "cosmosvalcons104cjmxkrg8y8lmrp25de02e4zf00zle4mzs685",
       "validator_signing_info": {
         "address": "cosmosvalcons104cjmxkrg8y8lmrp25de02e4zf00zle4mzs685",
+        "downtime_jail_count": "0",
         "index_offset": "2",
         "jailed_until": "0001-01-01T00:00:00Z",
         "missed_blocks_counter": "2",
@@ -116,6 +122,7 @@ func TestMigrate(t *testing.T) {
       "address": "cosmosvalcons10e4c5p6qk0sycy9u6u43t7csmlx9fyadr9yxph",
       "validator_signing_info": {
         "address": "cosmosvalcons10e4c5p6qk0sycy9u6u43t7csmlx9fyadr9yxph",
+        "downtime_jail_count": "0",
         "index_offset": "615501",
         "jailed_until": "0001-01-01T00:00:00Z",
         "missed_blocks_counter": "1",
@@ -123,7 +130,8 @@ func TestMigrate(t *testing.T) {
         "tombstoned": false
       }
     }
-  ]
+  ],
+  "slash_records": []
 }`
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
@@ -0,0 +1,58 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43/v0.45 to v0.46.
+// The migration includes:
+//
+// - Setting the DowntimeJailMultiplier, DowntimeJailDecayWindow,
+// MaxDowntimeJailDuration, AutoUnjail and MaxSlashRecords params in the
+// paramstore.
+// - Setting the downtime jail count of the validator signing infos to zero.
+// - Adding the jailed validators which are not tombstoned to the auto unjail
+// queue.
+func MigrateStore(
+	ctx sdk.Context, storeKey storetypes.StoreKey, paramstore types.ParamSubspace, sk types.StakingKeeper,
+	cdc codec.BinaryCodec,
+) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyDowntimeJailMultiplier, types.DefaultDowntimeJailMultiplier)
+	paramstore.Set(ctx, types.KeyDowntimeJailDecayWindow, types.DefaultDowntimeJailDecayWindow)
+	paramstore.Set(ctx, types.KeyMaxDowntimeJailDuration, types.DefaultMaxDowntimeJailDuration)
+	paramstore.Set(ctx, types.KeyAutoUnjail, types.DefaultAutoUnjail)
+	paramstore.Set(ctx, types.KeyMaxSlashRecords, types.DefaultMaxSlashRecords)
+
+	store := ctx.KVStore(storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorSigningInfoKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var info types.ValidatorSigningInfo
+		if err := cdc.Unmarshal(iter.Value(), &info); err != nil {
+			return err
+		}
+
+		info.DowntimeJailCount = 0
+		bz, err := cdc.Marshal(&info)
+		if err != nil {
+			return err
+		}
+		store.Set(iter.Key(), bz)
+
+		consAddr := types.ValidatorSigningInfoAddress(iter.Key())
+		validator := sk.ValidatorByConsAddr(ctx, consAddr)
+		if validator != nil && validator.IsJailed() && !info.Tombstoned {
+			store.Set(types.AutoUnjailQueueKey(info.JailedUntil, consAddr), []byte{})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// stakingKeeper returns the jailed validators of a set of consensus addresses.
+type stakingKeeper struct {
+	types.StakingKeeper
+	jailed map[string]bool
+}
+
+func (sk stakingKeeper) ValidatorByConsAddr(_ sdk.Context, consAddr sdk.ConsAddress) stakingtypes.ValidatorI {
+	jailed, found := sk.jailed[consAddr.String()]
+	if !found {
+		return nil
+	}
+
+	return stakingtypes.Validator{Jailed: jailed}
+}
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	slashingKey := sdk.NewKVStoreKey("slashing")
+	tSlashingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(slashingKey, tSlashingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, slashingKey, tSlashingKey, types.ModuleName)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+	_, _, addr4 := testdata.KeyTestPubAddr()
+	consAddr1, consAddr2 := sdk.ConsAddress(addr1), sdk.ConsAddress(addr2)
+	consAddr3, consAddr4 := sdk.ConsAddress(addr3), sdk.ConsAddress(addr4)
+
+	info1 := types.NewValidatorSigningInfo(consAddr1, 10, 3, time.Unix(100, 0).UTC(), false, 4)
+	info2 := types.NewValidatorSigningInfo(consAddr2, 20, 0, time.Unix(253402300799, 0).UTC(), true, 0)
+	info3 := types.NewValidatorSigningInfo(consAddr3, 30, 0, time.Unix(200, 0).UTC(), false, 0)
+	info4 := types.NewValidatorSigningInfo(consAddr4, 40, 0, time.Unix(300, 0).UTC(), false, 0)
+	store := ctx.KVStore(slashingKey)
+	for _, info := range []types.ValidatorSigningInfo{info1, info2, info3, info4} {
+		consAddr, err := sdk.ConsAddressFromBech32(info.Address)
+		require.NoError(t, err)
+		store.Set(types.ValidatorSigningInfoKey(consAddr), encCfg.Codec.MustMarshal(&info))
+	}
+
+	// only the jailed validators which are not tombstoned are queued for auto unjail
+	sk := stakingKeeper{jailed: map[string]bool{
+		consAddr1.String(): true,
+		consAddr2.String(): true,
+		consAddr3.String(): false,
+	}}
+
+	require.False(t, paramstore.Has(ctx, types.KeyDowntimeJailMultiplier))
+	require.False(t, paramstore.Has(ctx, types.KeyDowntimeJailDecayWindow))
+	require.False(t, paramstore.Has(ctx, types.KeyMaxDowntimeJailDuration))
+
+	require.NoError(t, v046.MigrateStore(ctx, slashingKey, paramstore, sk, encCfg.Codec))
+
+	var multiplier sdk.Dec
+	paramstore.Get(ctx, types.KeyDowntimeJailMultiplier, &multiplier)
+	require.Equal(t, types.DefaultDowntimeJailMultiplier, multiplier)
+
+	var decayWindow, maxDuration time.Duration
+	paramstore.Get(ctx, types.KeyDowntimeJailDecayWindow, &decayWindow)
+	paramstore.Get(ctx, types.KeyMaxDowntimeJailDuration, &maxDuration)
+	require.Equal(t, types.DefaultDowntimeJailDecayWindow, decayWindow)
+	require.Equal(t, types.DefaultMaxDowntimeJailDuration, maxDuration)
+
+	var autoUnjail bool
+	paramstore.Get(ctx, types.KeyAutoUnjail, &autoUnjail)
+	require.Equal(t, types.DefaultAutoUnjail, autoUnjail)
+
+	var maxSlashRecords uint32
+	paramstore.Get(ctx, types.KeyMaxSlashRecords, &maxSlashRecords)
+	require.Equal(t, types.DefaultMaxSlashRecords, maxSlashRecords)
+
+	for _, expected := range []types.ValidatorSigningInfo{info1, info2, info3, info4} {
+		consAddr, err := sdk.ConsAddressFromBech32(expected.Address)
+		require.NoError(t, err)
+
+		var info types.ValidatorSigningInfo
+		encCfg.Codec.MustUnmarshal(store.Get(types.ValidatorSigningInfoKey(consAddr)), &info)
+		require.Equal(t, expected, info)
+		require.Zero(t, info.DowntimeJailCount)
+	}
+
+	iter := sdk.KVStorePrefixIterator(store, types.AutoUnjailQueueKeyPrefix)
+	defer iter.Close()
+	require.True(t, iter.Valid())
+	jailedUntil, consAddr := types.SplitAutoUnjailQueueKey(iter.Key())
+	require.Equal(t, info1.JailedUntil, jailedUntil)
+	require.Equal(t, consAddr1, consAddr)
+	iter.Next()
+	require.False(t, iter.Valid())
+}
@@ -21,6 +21,11 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	DowntimeJailMultiplier  = "downtime_jail_multiplier"
+	DowntimeJailDecayWindow = "downtime_jail_decay_window"
+	MaxDowntimeJailDuration = "max_downtime_jail_duration"
+	AutoUnjail              = "auto_unjail"
+	MaxSlashRecords         = "max_slash_records"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +53,31 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeJailMultiplier randomized DowntimeJailMultiplier
+func GenDowntimeJailMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 1))
+}
+
+// GenDowntimeJailDecayWindow randomized DowntimeJailDecayWindow
+func GenDowntimeJailDecayWindow(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*7)) * time.Second
+}
+
+// GenMaxDowntimeJailDuration randomized MaxDowntimeJailDuration
+func GenMaxDowntimeJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*30)) * time.Second
+}
+
+// GenAutoUnjail randomized AutoUnjail
+func GenAutoUnjail(r *rand.Rand) bool {
+	return r.Int63n(2) == 0
+}
+
+// GenMaxSlashRecords randomized MaxSlashRecords
+func GenMaxSlashRecords(r *rand.Rand) uint32 {
+	return uint32(r.Intn(50))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,12 +110,44 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeJailMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailMultiplier, &downtimeJailMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailMultiplier = GenDowntimeJailMultiplier(r) },
+	)
+
+	var downtimeJailDecayWindow time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailDecayWindow, &downtimeJailDecayWindow, simState.Rand,
+		func(r *rand.Rand) { downtimeJailDecayWindow = GenDowntimeJailDecayWindow(r) },
+	)
+
+	var maxDowntimeJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDowntimeJailDuration, &maxDowntimeJailDuration, simState.Rand,
+		func(r *rand.Rand) { maxDowntimeJailDuration = GenMaxDowntimeJailDuration(r) },
+	)
+
+	var autoUnjail bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoUnjail, &autoUnjail, simState.Rand,
+		func(r *rand.Rand) { autoUnjail = GenAutoUnjail(r) },
+	)
+
+	var maxSlashRecords uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSlashRecords, &maxSlashRecords, simState.Rand,
+		func(r *rand.Rand) { maxSlashRecords = GenMaxSlashRecords(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		downtimeJailMultiplier, downtimeJailDecayWindow, maxDowntimeJailDuration,
+		autoUnjail, maxSlashRecords,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.SlashRecord{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
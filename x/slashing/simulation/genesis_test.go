@@ -50,8 +50,14 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec3, slashingGenesis.Params.SlashFractionDowntime)
 	require.Equal(t, int64(720), slashingGenesis.Params.SignedBlocksWindow)
 	require.Equal(t, time.Duration(34800000000000), slashingGenesis.Params.DowntimeJailDuration)
+	require.Equal(t, sdk.MustNewDecFromStr("2.2"), slashingGenesis.Params.DowntimeJailMultiplier)
+	require.Equal(t, time.Duration(370289000000000), slashingGenesis.Params.DowntimeJailDecayWindow)
+	require.Equal(t, time.Duration(1344568000000000), slashingGenesis.Params.MaxDowntimeJailDuration)
+	require.False(t, slashingGenesis.Params.AutoUnjail)
+	require.Equal(t, uint32(11), slashingGenesis.Params.MaxSlashRecords)
 	require.Len(t, slashingGenesis.MissedBlocks, 0)
 	require.Len(t, slashingGenesis.SigningInfos, 0)
+	require.Len(t, slashingGenesis.SlashRecords, 0)
 
 }
 
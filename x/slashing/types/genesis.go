@@ -9,13 +9,14 @@ import (
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
-	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks, slashRecords []SlashRecord,
 ) *GenesisState {
 
 	return &GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		SlashRecords: slashRecords,
 	}
 }
 
@@ -33,6 +34,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+		SlashRecords: []SlashRecord{},
 	}
 }
 
@@ -63,5 +65,23 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateDowntimeJailMultiplier(data.Params.DowntimeJailMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeJailDecayWindow(data.Params.DowntimeJailDecayWindow); err != nil {
+		return err
+	}
+
+	if err := validateMaxDowntimeJailDuration(data.Params.MaxDowntimeJailDuration); err != nil {
+		return err
+	}
+
+	for _, record := range data.SlashRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -34,6 +34,8 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// slash_records represents the slash history of the validators.
+	SlashRecords []SlashRecord `protobuf:"bytes,4,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +92,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -268,34 +277,35 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0xe3, 0x2e, 0x2c, 0xe0, 0xb4, 0x17, 0x2b, 0x94, 0xd0, 0x43, 0x5a, 0xad, 0x00, 0xf5,
-	0x92, 0x44, 0x5d, 0x8e, 0x88, 0x03, 0xb9, 0x54, 0x1c, 0x50, 0x51, 0x56, 0x42, 0x82, 0x4b, 0xe4,
-	0x6c, 0x5c, 0xd7, 0xea, 0xc6, 0x5e, 0x65, 0xcc, 0xaa, 0xbc, 0x05, 0x0f, 0xc0, 0x23, 0x70, 0xe4,
-	0x21, 0x7a, 0xac, 0x38, 0x71, 0x42, 0x68, 0xf7, 0x29, 0xb8, 0x21, 0x6c, 0x87, 0x8d, 0x60, 0xa3,
-	0x95, 0x38, 0x25, 0x63, 0x7f, 0xf3, 0xcf, 0xcc, 0xef, 0xc1, 0x8f, 0xa7, 0x0a, 0x6a, 0x05, 0x29,
-	0xcc, 0x28, 0x5c, 0x08, 0xc9, 0xd3, 0xc5, 0x49, 0xc9, 0x34, 0x3d, 0x49, 0x39, 0x93, 0x0c, 0x04,
-	0x24, 0xf3, 0x46, 0x69, 0x45, 0x1e, 0x58, 0x2c, 0x69, 0xb1, 0xc4, 0x61, 0x07, 0x01, 0x57, 0x5c,
-	0x19, 0x26, 0xfd, 0xfd, 0x67, 0xf1, 0x83, 0x27, 0x7d, 0xaa, 0x7f, 0xf2, 0x2d, 0xf7, 0xd0, 0x72,
-	0x85, 0x15, 0x70, 0x35, 0x4c, 0x30, 0xfa, 0x89, 0xf0, 0xee, 0xa9, 0xed, 0x61, 0xa2, 0xa9, 0x66,
-	0xe4, 0x39, 0x1e, 0xce, 0x69, 0x43, 0x6b, 0x08, 0xd1, 0x11, 0x3a, 0xf6, 0xc7, 0x87, 0x49, 0x4f,
-	0x4f, 0xc9, 0x6b, 0x83, 0x65, 0xb7, 0xae, 0xbf, 0x1f, 0x7a, 0xb9, 0x4b, 0x22, 0x67, 0x78, 0x0f,
-	0x04, 0x97, 0x42, 0xf2, 0x42, 0xc8, 0x73, 0x05, 0xe1, 0xce, 0xd1, 0xe0, 0xd8, 0x1f, 0x3f, 0xea,
-	0x55, 0x99, 0x58, 0xfa, 0xa5, 0x3c, 0x57, 0x4e, 0x6a, 0x17, 0xd6, 0x47, 0x40, 0xde, 0xe2, 0xbd,
-	0x5a, 0x00, 0xb0, 0xaa, 0x28, 0x67, 0x6a, 0x7a, 0x09, 0xe1, 0xc0, 0x08, 0x26, 0xbd, 0x82, 0x6f,
-	0xe8, 0x4c, 0x54, 0x54, 0xab, 0xe6, 0x95, 0x49, 0xcb, 0x4c, 0x56, 0x2b, 0x5d, 0x77, 0xce, 0x46,
-	0x9f, 0x11, 0xf6, 0x3b, 0xe5, 0xc9, 0x18, 0xdf, 0xa1, 0x55, 0xd5, 0x30, 0xb0, 0xb3, 0xdf, 0xcb,
-	0xc2, 0xaf, 0x5f, 0xe2, 0xc0, 0xd5, 0x79, 0x61, 0x6f, 0x26, 0xba, 0x11, 0x92, 0xe7, 0x2d, 0x48,
-	0x04, 0xde, 0x5f, 0xb4, 0x05, 0x8b, 0xee, 0xe4, 0xe1, 0x8e, 0xb1, 0x2f, 0xde, 0xde, 0xe7, 0xbf,
-	0x0e, 0x04, 0x8b, 0x0d, 0x77, 0xa3, 0x4f, 0x08, 0xdf, 0xdf, 0x38, 0xdc, 0x7f, 0x35, 0x7e, 0xf6,
-	0xb7, 0xaf, 0xdb, 0x1e, 0xaa, 0x53, 0x71, 0xa3, 0x9b, 0xcf, 0xb0, 0xdf, 0x41, 0x48, 0x80, 0x6f,
-	0x0b, 0x59, 0xb1, 0x2b, 0xd3, 0xd1, 0x20, 0xb7, 0x01, 0xd9, 0xc7, 0x43, 0x9b, 0x64, 0xec, 0xb9,
-	0x9b, 0xbb, 0x28, 0x3b, 0xbd, 0x5e, 0x46, 0xe8, 0x66, 0x19, 0xa1, 0x1f, 0xcb, 0x08, 0x7d, 0x5c,
-	0x45, 0xde, 0xcd, 0x2a, 0xf2, 0xbe, 0xad, 0x22, 0xef, 0x5d, 0xcc, 0x85, 0xbe, 0x78, 0x5f, 0x26,
-	0x53, 0x55, 0xbb, 0xcd, 0x75, 0x9f, 0x18, 0xaa, 0xcb, 0xf4, 0x6a, 0xbd, 0xfb, 0xfa, 0xc3, 0x9c,
-	0x41, 0x39, 0x34, 0x6b, 0xfd, 0xf4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xc1, 0x45, 0xfe,
-	0x71, 0x03, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x76, 0x14, 0x70, 0xb7, 0x8b, 0x15, 0x46, 0xd8, 0x21, 0x9b, 0x22, 0x40, 0xbb,
+	0x24, 0xd1, 0xca, 0x11, 0x71, 0x20, 0x97, 0x89, 0x03, 0x1a, 0x4a, 0x25, 0x24, 0xb8, 0x44, 0x4e,
+	0xe2, 0x79, 0xd6, 0x1a, 0xbb, 0xca, 0x67, 0xaa, 0xf1, 0x16, 0x3c, 0x00, 0x8f, 0xc0, 0x91, 0x87,
+	0x98, 0x38, 0x4d, 0x9c, 0x38, 0x21, 0xd4, 0xbe, 0x08, 0xaa, 0xed, 0xac, 0x11, 0x34, 0xaa, 0xb4,
+	0x53, 0xe2, 0xcf, 0xbf, 0xff, 0xdf, 0x7f, 0x7f, 0x9f, 0x8c, 0x9e, 0x15, 0x12, 0x2a, 0x09, 0x31,
+	0x4c, 0x09, 0x5c, 0x70, 0xc1, 0xe2, 0xf9, 0x49, 0x4e, 0x15, 0x39, 0x89, 0x19, 0x15, 0x14, 0x38,
+	0x44, 0xb3, 0x5a, 0x2a, 0x89, 0x1f, 0x1b, 0x2c, 0x6a, 0xb0, 0xc8, 0x62, 0x07, 0x2e, 0x93, 0x4c,
+	0x6a, 0x26, 0x5e, 0xfd, 0x19, 0xfc, 0xe0, 0x79, 0x97, 0xeb, 0xad, 0xde, 0x70, 0x4f, 0x0c, 0x97,
+	0x19, 0x03, 0x7b, 0x86, 0x5e, 0x04, 0x3f, 0xfa, 0x68, 0xf7, 0xd4, 0x64, 0x98, 0x28, 0xa2, 0x28,
+	0x7e, 0x85, 0x86, 0x33, 0x52, 0x93, 0x0a, 0x3c, 0xe7, 0xc8, 0x39, 0x1e, 0x8d, 0x0f, 0xa3, 0x8e,
+	0x4c, 0xd1, 0x3b, 0x8d, 0x25, 0x3b, 0xd7, 0xbf, 0x0f, 0x7b, 0xa9, 0x15, 0xe1, 0x33, 0xb4, 0x07,
+	0x9c, 0x09, 0x2e, 0x58, 0xc6, 0xc5, 0xb9, 0x04, 0xaf, 0x7f, 0x34, 0x38, 0x1e, 0x8d, 0x9f, 0x76,
+	0xba, 0x4c, 0x0c, 0xfd, 0x46, 0x9c, 0x4b, 0x6b, 0xb5, 0x0b, 0xeb, 0x12, 0xe0, 0x0f, 0x68, 0xaf,
+	0xe2, 0x00, 0xb4, 0xcc, 0xf2, 0xa9, 0x2c, 0x2e, 0xc1, 0x1b, 0x68, 0xc3, 0xa8, 0xd3, 0xf0, 0x3d,
+	0x99, 0xf2, 0x92, 0x28, 0x59, 0xbf, 0xd5, 0xb2, 0x44, 0xab, 0x1a, 0xeb, 0xaa, 0x55, 0xd3, 0x59,
+	0x57, 0xea, 0xac, 0xa6, 0x85, 0xac, 0x4b, 0xf0, 0x76, 0xb6, 0x65, 0x5d, 0x15, 0x52, 0x0d, 0xdf,
+	0x66, 0x5d, 0x97, 0x20, 0xf8, 0xe6, 0xa0, 0x51, 0xeb, 0x3e, 0x78, 0x8c, 0xee, 0x93, 0xb2, 0xac,
+	0x29, 0x98, 0x66, 0x3e, 0x4c, 0xbc, 0x9f, 0xdf, 0x43, 0xd7, 0xba, 0xbf, 0x36, 0x3b, 0x13, 0x55,
+	0x73, 0xc1, 0xd2, 0x06, 0xc4, 0x1c, 0xed, 0xcf, 0x9b, 0x1b, 0x64, 0xed, 0x56, 0x7a, 0x7d, 0x3d,
+	0x8f, 0x70, 0xfb, 0xc5, 0xff, 0x6f, 0xa9, 0x3b, 0xdf, 0xb0, 0x17, 0x7c, 0x75, 0xd0, 0xa3, 0x8d,
+	0xdd, 0xba, 0x53, 0xf0, 0xb3, 0x7f, 0x07, 0xb5, 0x6d, 0xf2, 0xad, 0x13, 0x37, 0x8d, 0x27, 0x78,
+	0x89, 0x46, 0x2d, 0x04, 0xbb, 0xe8, 0x1e, 0x17, 0x25, 0xbd, 0xd2, 0x89, 0x06, 0xa9, 0x59, 0xe0,
+	0x7d, 0x34, 0x34, 0x22, 0xdd, 0x9e, 0x07, 0xa9, 0x5d, 0x25, 0xa7, 0xd7, 0x0b, 0xdf, 0xb9, 0x59,
+	0xf8, 0xce, 0x9f, 0x85, 0xef, 0x7c, 0x59, 0xfa, 0xbd, 0x9b, 0xa5, 0xdf, 0xfb, 0xb5, 0xf4, 0x7b,
+	0x1f, 0x43, 0xc6, 0xd5, 0xc5, 0xa7, 0x3c, 0x2a, 0x64, 0x65, 0x9f, 0x82, 0xfd, 0x84, 0x50, 0x5e,
+	0xc6, 0x57, 0xeb, 0xc7, 0xa4, 0x3e, 0xcf, 0x28, 0xe4, 0x43, 0xfd, 0x4e, 0x5e, 0xfc, 0x1d, 0x00,
+	0x76, 0x78, 0x18, 0xca, 0xc2, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -318,6 +328,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -512,6 +536,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -700,6 +730,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
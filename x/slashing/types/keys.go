@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -20,6 +21,10 @@ const (
 
 	// QuerierRoute is the querier route for slashing
 	QuerierRoute = ModuleName
+
+	// MaxAutoUnjailsPerBlock is the maximum number of auto unjail queue entries
+	// processed at the end of a block
+	MaxAutoUnjailsPerBlock = 50
 )
 
 // Keys for slashing store
@@ -30,12 +35,20 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<jailedUntil_Bytes><consAddrLen (1 Byte)><consAddress_Bytes>: []byte{}
+//
+// - 0x05<consAddrLen (1 Byte)><consAddress_Bytes><height_Bytes><slashType (1 Byte)>: SlashRecord
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	AutoUnjailQueueKeyPrefix              = []byte{0x04} // Prefix for auto unjail queue
+	SlashRecordKeyPrefix                  = []byte{0x05} // Prefix for slash records
 )
 
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
 func ValidatorSigningInfoKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorSigningInfoKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
@@ -67,3 +80,44 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// AutoUnjailQueueByTimeKey gets the auto unjail queue key by jailedUntil
+func AutoUnjailQueueByTimeKey(jailedUntil time.Time) []byte {
+	return append(AutoUnjailQueueKeyPrefix, sdk.FormatTimeBytes(jailedUntil)...)
+}
+
+// AutoUnjailQueueKey returns the key for a validator in the auto unjail queue
+func AutoUnjailQueueKey(jailedUntil time.Time, v sdk.ConsAddress) []byte {
+	return append(AutoUnjailQueueByTimeKey(jailedUntil), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// SplitAutoUnjailQueueKey split the auto unjail queue key and returns the
+// jailedUntil and the consensus address of the validator
+func SplitAutoUnjailQueueKey(key []byte) (jailedUntil time.Time, v sdk.ConsAddress) {
+	// <prefix (1 Byte)><jailedUntil_Bytes><consAddrLen (1 Byte)><consAddress_Bytes>
+	kv.AssertKeyAtLeastLength(key, 1+lenTime+1)
+	jailedUntil, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	addrLen := int(key[1+lenTime])
+	kv.AssertKeyLength(key[2+lenTime:], addrLen)
+
+	return jailedUntil, sdk.ConsAddress(key[2+lenTime:])
+}
+
+// SlashRecordsPrefixKey - stored by *Consensus* address (not operator address)
+func SlashRecordsPrefixKey(v sdk.ConsAddress) []byte {
+	return append(SlashRecordKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// SlashRecordKey returns the key of the slash record of a validator at a height
+// for an infraction type. The records of a validator are sorted by height.
+func SlashRecordKey(v sdk.ConsAddress, height int64, slashType SlashType) []byte {
+	b := make([]byte, 9)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	b[8] = byte(slashType)
+
+	return append(SlashRecordsPrefixKey(v), b...)
+}
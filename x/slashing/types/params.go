@@ -10,14 +10,19 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow      = int64(100)
+	DefaultDowntimeJailDuration    = 60 * 10 * time.Second
+	DefaultDowntimeJailDecayWindow = 60 * 60 * 24 * time.Second
+	DefaultMaxDowntimeJailDuration = 60 * 60 * 24 * 7 * time.Second
+	DefaultAutoUnjail              = false
+	DefaultMaxSlashRecords         = uint32(20)
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultDowntimeJailMultiplier  = sdk.OneDec()
 )
 
 // Parameter store keys
@@ -27,6 +32,11 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyDowntimeJailMultiplier  = []byte("DowntimeJailMultiplier")
+	KeyDowntimeJailDecayWindow = []byte("DowntimeJailDecayWindow")
+	KeyMaxDowntimeJailDuration = []byte("MaxDowntimeJailDuration")
+	KeyAutoUnjail              = []byte("AutoUnjail")
+	KeyMaxSlashRecords         = []byte("MaxSlashRecords")
 )
 
 // ParamKeyTable for slashing module
@@ -38,6 +48,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	downtimeJailMultiplier sdk.Dec, downtimeJailDecayWindow, maxDowntimeJailDuration time.Duration,
+	autoUnjail bool, maxSlashRecords uint32,
 ) Params {
 
 	return Params{
@@ -46,6 +58,11 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		DowntimeJailMultiplier:  downtimeJailMultiplier,
+		DowntimeJailDecayWindow: downtimeJailDecayWindow,
+		MaxDowntimeJailDuration: maxDowntimeJailDuration,
+		AutoUnjail:              autoUnjail,
+		MaxSlashRecords:         maxSlashRecords,
 	}
 }
 
@@ -57,6 +74,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeJailMultiplier, &p.DowntimeJailMultiplier, validateDowntimeJailMultiplier),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDecayWindow, &p.DowntimeJailDecayWindow, validateDowntimeJailDecayWindow),
+		paramtypes.NewParamSetPair(KeyMaxDowntimeJailDuration, &p.MaxDowntimeJailDuration, validateMaxDowntimeJailDuration),
+		paramtypes.NewParamSetPair(KeyAutoUnjail, &p.AutoUnjail, validateAutoUnjail),
+		paramtypes.NewParamSetPair(KeyMaxSlashRecords, &p.MaxSlashRecords, validateMaxSlashRecords),
 	}
 }
 
@@ -65,6 +87,8 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultDowntimeJailMultiplier, DefaultDowntimeJailDecayWindow, DefaultMaxDowntimeJailDuration,
+		DefaultAutoUnjail, DefaultMaxSlashRecords,
 	)
 }
 
@@ -141,3 +165,60 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeJailMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime jail multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDecayWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime jail decay window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max downtime jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateAutoUnjail(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxSlashRecords(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -9,6 +9,10 @@ const (
 	QuerySigningInfos = "signingInfos"
 )
 
+// MaxSigningInfoConsAddrs is the maximum number of consensus addresses of a
+// Query/SigningInfoByConsAddrs request.
+const MaxSigningInfoConsAddrs = 100
+
 // QuerySigningInfosParams defines the params for the following queries:
 // - 'custom/slashing/signingInfos'
 type QuerySigningInfosParams struct {
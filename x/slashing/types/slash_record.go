@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSlashRecord creates a new SlashRecord instance
+func NewSlashRecord(
+	consAddr sdk.ConsAddress, height int64, fraction sdk.Dec, slashType SlashType, burnedAmount sdk.Int,
+) SlashRecord {
+
+	return SlashRecord{
+		Address:      consAddr.String(),
+		Height:       height,
+		Fraction:     fraction,
+		Type:         slashType,
+		BurnedAmount: burnedAmount,
+	}
+}
+
+// Validate performs a stateless validation of the slash record
+func (r SlashRecord) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid slash record address %s: %w", r.Address, err)
+	}
+
+	if r.Height < 0 {
+		return fmt.Errorf("slash record height cannot be negative, is %d", r.Height)
+	}
+
+	if r.Fraction.IsNil() || r.Fraction.IsNegative() || r.Fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash record fraction should be less than or equal to one and greater than zero, is %s", r.Fraction)
+	}
+
+	if r.Type != SlashTypeDowntime && r.Type != SlashTypeDoubleSign {
+		return fmt.Errorf("invalid slash record type: %s", r.Type)
+	}
+
+	if r.BurnedAmount.IsNil() || r.BurnedAmount.IsNegative() {
+		return fmt.Errorf("slash record burned amount cannot be negative, is %s", r.BurnedAmount)
+	}
+
+	return nil
+}
@@ -30,6 +30,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SlashType is the infraction a validator was slashed for.
+type SlashType int32
+
+const (
+	// UNSPECIFIED defines an invalid slash type.
+	SlashTypeUnspecified SlashType = 0
+	// DOWNTIME defines a slash for missing too many blocks.
+	SlashTypeDowntime SlashType = 1
+	// DOUBLE_SIGN defines a slash for signing conflicting blocks.
+	SlashTypeDoubleSign SlashType = 2
+)
+
+var SlashType_name = map[int32]string{
+	0: "SLASH_TYPE_UNSPECIFIED",
+	1: "SLASH_TYPE_DOWNTIME",
+	2: "SLASH_TYPE_DOUBLE_SIGN",
+}
+
+var SlashType_value = map[string]int32{
+	"SLASH_TYPE_UNSPECIFIED": 0,
+	"SLASH_TYPE_DOWNTIME":    1,
+	"SLASH_TYPE_DOUBLE_SIGN": 2,
+}
+
+func (x SlashType) String() string {
+	return proto.EnumName(SlashType_name, int32(x))
+}
+
+func (SlashType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -48,6 +80,9 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// The number of consecutive times the validator was jailed for downtime,
+	// reset when it is jailed for downtime again after the decay window.
+	DowntimeJailCount uint64 `protobuf:"varint,7,opt,name=downtime_jail_count,json=downtimeJailCount,proto3" json:"downtime_jail_count,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -124,6 +159,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeJailCount() uint64 {
+	if m != nil {
+		return m.DowntimeJailCount
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -131,6 +173,20 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_jail_multiplier multiplies the downtime jail duration for each
+	// consecutive downtime jail of a validator.
+	DowntimeJailMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=downtime_jail_multiplier,json=downtimeJailMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_multiplier"`
+	// downtime_jail_decay_window is the duration after the end of its last jail
+	// after which the consecutive downtime jails of a validator are reset.
+	DowntimeJailDecayWindow time.Duration `protobuf:"bytes,7,opt,name=downtime_jail_decay_window,json=downtimeJailDecayWindow,proto3,stdduration" json:"downtime_jail_decay_window"`
+	// max_downtime_jail_duration caps the multiplied downtime jail duration.
+	MaxDowntimeJailDuration time.Duration `protobuf:"bytes,8,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3,stdduration" json:"max_downtime_jail_duration"`
+	// auto_unjail enables unjailing the validators jailed for downtime
+	// automatically at the end of their jail period.
+	AutoUnjail bool `protobuf:"varint,9,opt,name=auto_unjail,json=autoUnjail,proto3" json:"auto_unjail,omitempty"`
+	// max_slash_records is the number of most recent slash records kept per
+	// validator. Zero disables the slash history.
+	MaxSlashRecords uint32 `protobuf:"varint,10,opt,name=max_slash_records,json=maxSlashRecords,proto3" json:"max_slash_records,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -180,9 +236,107 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeJailDecayWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDecayWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.MaxDowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetAutoUnjail() bool {
+	if m != nil {
+		return m.AutoUnjail
+	}
+	return false
+}
+
+func (m *Params) GetMaxSlashRecords() uint32 {
+	if m != nil {
+		return m.MaxSlashRecords
+	}
+	return 0
+}
+
+// SlashRecord records a slash of a validator.
+type SlashRecord struct {
+	// address is the consensus address of the slashed validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the height at which the slash was executed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// fraction is the slash fraction applied.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// type is the infraction the validator was slashed for.
+	Type SlashType `protobuf:"varint,4,opt,name=type,proto3,enum=cosmos.slashing.v1beta1.SlashType" json:"type,omitempty"`
+	// burned_amount is the amount of tokens slashed from the validator.
+	BurnedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=burned_amount,json=burnedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burned_amount"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetType() SlashType {
+	if m != nil {
+		return m.Type
+	}
+	return SlashTypeUnspecified
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.SlashType", SlashType_name, SlashType_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*SlashRecord)(nil), "cosmos.slashing.v1beta1.SlashRecord")
 }
 
 func init() {
@@ -190,43 +344,64 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0xfd, 0xdb, 0x27, 0xa7, 0xb5, 0xcf, 0xb2, 0xc5, 0x70, 0xa0, 0x54, 0x0f, 0x81, 0x10,
+	0xc0, 0x54, 0xa3, 0x14, 0x1d, 0x8a, 0x2e, 0x56, 0xa4, 0x24, 0x4a, 0x13, 0xdb, 0xa0, 0xac, 0x06,
+	0xe9, 0xc2, 0x9c, 0xc4, 0x13, 0x75, 0x35, 0x79, 0x27, 0xf0, 0x8e, 0xb5, 0xfc, 0x1f, 0x14, 0x9e,
+	0x32, 0x66, 0x31, 0x10, 0xa0, 0x4b, 0x87, 0x8e, 0xf9, 0x03, 0x3a, 0x7a, 0x0c, 0x32, 0x15, 0x1d,
+	0xd2, 0xc2, 0x1e, 0xda, 0x3f, 0xa3, 0xb8, 0x3b, 0x52, 0x91, 0x9c, 0xa6, 0xa8, 0x3d, 0xd9, 0xfc,
+	0xde, 0x7b, 0xdf, 0x77, 0xef, 0x7b, 0xef, 0x4e, 0xe0, 0x56, 0x8f, 0xf1, 0x88, 0xf1, 0x2a, 0x0f,
+	0x11, 0x1f, 0x10, 0x1a, 0x54, 0x7f, 0xb8, 0xd3, 0xc5, 0x02, 0xdd, 0x19, 0x03, 0xce, 0x30, 0x66,
+	0x82, 0xc1, 0xa2, 0xce, 0x73, 0xc6, 0x70, 0x9a, 0x67, 0x15, 0x02, 0x16, 0x30, 0x95, 0x53, 0x95,
+	0xff, 0xe9, 0x74, 0xcb, 0x0e, 0x18, 0x0b, 0x42, 0x5c, 0x55, 0x5f, 0xdd, 0xa4, 0x5f, 0xf5, 0x93,
+	0x18, 0x09, 0xc2, 0x68, 0x1a, 0x2f, 0x5d, 0x8e, 0x0b, 0x12, 0x61, 0x2e, 0x50, 0x34, 0x4c, 0x13,
+	0x6e, 0x6a, 0x3d, 0x4f, 0x33, 0xa7, 0xe2, 0xea, 0x63, 0xeb, 0xaf, 0x19, 0x50, 0xf8, 0x16, 0x85,
+	0xc4, 0x47, 0x82, 0xc5, 0x6d, 0x12, 0x50, 0x42, 0x83, 0x16, 0xed, 0x33, 0x58, 0x03, 0x8b, 0xc8,
+	0xf7, 0x63, 0xcc, 0xb9, 0x69, 0x94, 0x8d, 0xca, 0x72, 0xdd, 0x7c, 0xfb, 0x7a, 0xbb, 0x90, 0xd6,
+	0xee, 0xe8, 0x48, 0x5b, 0xc4, 0x84, 0x06, 0x6e, 0x96, 0x08, 0x3f, 0x03, 0x2b, 0x5c, 0xa0, 0x58,
+	0x78, 0x03, 0x4c, 0x82, 0x81, 0x30, 0x67, 0xca, 0x46, 0x65, 0xd6, 0xcd, 0x2b, 0xec, 0xa1, 0x82,
+	0x64, 0x0a, 0xa1, 0x3e, 0x1e, 0x79, 0xac, 0xdf, 0xe7, 0x58, 0x98, 0xb3, 0x3a, 0x45, 0x61, 0x7b,
+	0x0a, 0x82, 0x0f, 0xc0, 0xca, 0xf7, 0x88, 0x84, 0xd8, 0xf7, 0x12, 0x2a, 0x48, 0x68, 0xce, 0x95,
+	0x8d, 0x4a, 0xbe, 0x66, 0x39, 0xba, 0x4b, 0x27, 0xeb, 0xd2, 0x39, 0xc8, 0xba, 0xac, 0x2f, 0x9d,
+	0xbd, 0x2b, 0xe5, 0x5e, 0xfc, 0x51, 0x32, 0xdc, 0xbc, 0xae, 0xec, 0xc8, 0x42, 0x68, 0x03, 0x20,
+	0x58, 0xd4, 0xe5, 0x82, 0x51, 0xec, 0x9b, 0xf3, 0x65, 0xa3, 0xb2, 0xe4, 0x4e, 0x20, 0xb0, 0x06,
+	0x36, 0x22, 0xc2, 0x39, 0xf6, 0xbd, 0x6e, 0xc8, 0x7a, 0x87, 0xdc, 0xeb, 0xb1, 0x84, 0x0a, 0x1c,
+	0x9b, 0x0b, 0xea, 0x50, 0xeb, 0x3a, 0x58, 0x57, 0xb1, 0x7b, 0x3a, 0x04, 0x1d, 0xb0, 0xee, 0xb3,
+	0x23, 0x2a, 0x1d, 0xf6, 0xa4, 0x96, 0xae, 0x31, 0x17, 0xcb, 0x46, 0x65, 0xce, 0x5d, 0xcb, 0x42,
+	0x8f, 0x10, 0x09, 0x55, 0xc5, 0x57, 0x4b, 0x2f, 0x5f, 0x95, 0x72, 0x7f, 0xbf, 0x2a, 0x19, 0x5b,
+	0x67, 0x0b, 0x60, 0x61, 0x1f, 0xc5, 0x28, 0xe2, 0xf0, 0x73, 0x50, 0xe0, 0x24, 0xa0, 0xef, 0x85,
+	0x8f, 0x08, 0xf5, 0xd9, 0x91, 0x32, 0x7a, 0xd6, 0x85, 0x3a, 0xa6, 0x75, 0x9f, 0xaa, 0x08, 0x44,
+	0xf2, 0xa8, 0xd4, 0x4b, 0xab, 0x86, 0x38, 0xce, 0x4a, 0xa4, 0xc5, 0x2b, 0x75, 0x47, 0x1a, 0xf0,
+	0xfb, 0xbb, 0xd2, 0xad, 0x80, 0x88, 0x41, 0xd2, 0x75, 0x7a, 0x2c, 0x4a, 0xc7, 0x9c, 0xfe, 0xd9,
+	0xe6, 0xfe, 0x61, 0x55, 0x1c, 0x0f, 0x31, 0x77, 0x1a, 0xb8, 0xe7, 0xc2, 0x88, 0xd0, 0xb6, 0xe2,
+	0xda, 0xc7, 0x71, 0x2a, 0xf1, 0x0c, 0x6c, 0x4e, 0x77, 0x96, 0x6d, 0x99, 0x9a, 0x51, 0xbe, 0x76,
+	0xf3, 0x83, 0x01, 0x34, 0xd2, 0x04, 0xed, 0xff, 0x4b, 0xe9, 0x7f, 0x61, 0xd2, 0x81, 0x2c, 0x0e,
+	0x0f, 0x81, 0xa5, 0x56, 0xdd, 0xeb, 0xc7, 0xa8, 0x27, 0x11, 0xcf, 0x67, 0x49, 0x37, 0xc4, 0xaa,
+	0x1f, 0x73, 0xee, 0x5a, 0x2d, 0x14, 0x15, 0xe3, 0xfd, 0x94, 0xb0, 0xa1, 0xf8, 0x64, 0x4b, 0xb0,
+	0x0f, 0x8a, 0x1f, 0x88, 0xe9, 0x33, 0x99, 0xf3, 0xd7, 0x52, 0xda, 0xb8, 0xa4, 0xa4, 0xc9, 0xe0,
+	0x00, 0x98, 0xd3, 0x7e, 0x45, 0x49, 0x28, 0xc8, 0x30, 0x24, 0xe9, 0x02, 0x5d, 0x5d, 0x68, 0x73,
+	0xd2, 0xbc, 0x27, 0x63, 0x36, 0xf8, 0x1c, 0x58, 0x97, 0x26, 0x83, 0x7b, 0xe8, 0x38, 0xdb, 0x80,
+	0xc5, 0xff, 0x3f, 0x9d, 0xe2, 0xd4, 0x74, 0x24, 0x49, 0x3a, 0xfb, 0xe7, 0xc0, 0x8a, 0xd0, 0xc8,
+	0xfb, 0xc8, 0xfc, 0x97, 0xae, 0xa0, 0x10, 0xa1, 0x51, 0xe3, 0xdf, 0x56, 0xa0, 0x04, 0xf2, 0x28,
+	0x11, 0xcc, 0x4b, 0xa8, 0xe4, 0x36, 0x97, 0xf5, 0x65, 0x94, 0x50, 0x47, 0x21, 0xf0, 0x36, 0x58,
+	0x93, 0x47, 0xd0, 0xa3, 0x8b, 0x71, 0x8f, 0xc5, 0x3e, 0x37, 0x41, 0xd9, 0xa8, 0xdc, 0x70, 0x3f,
+	0x8d, 0xd0, 0xa8, 0x2d, 0x71, 0x57, 0xc3, 0x5b, 0xbf, 0xce, 0x80, 0xfc, 0x04, 0x70, 0xad, 0xb7,
+	0x6a, 0x13, 0x2c, 0x4c, 0xbd, 0x52, 0xe9, 0x17, 0x7c, 0x04, 0x96, 0xb2, 0xc5, 0x31, 0x67, 0xaf,
+	0x35, 0xc6, 0x71, 0x3d, 0xfc, 0x12, 0xcc, 0x49, 0x58, 0x6d, 0xf8, 0x27, 0xb5, 0x2d, 0xe7, 0x23,
+	0xcf, 0xbe, 0xa3, 0x7a, 0x39, 0x38, 0x1e, 0x62, 0x57, 0xe5, 0x43, 0x04, 0x6e, 0x74, 0x93, 0x58,
+	0xde, 0x74, 0x14, 0xa9, 0xe7, 0x65, 0x5e, 0x75, 0xf5, 0xf5, 0x15, 0x0e, 0xd2, 0xa2, 0xe2, 0xed,
+	0xeb, 0x6d, 0x90, 0x2a, 0xb6, 0xa8, 0x70, 0x57, 0x34, 0xe5, 0x8e, 0x62, 0xbc, 0xfd, 0x8b, 0x01,
+	0x96, 0xc7, 0xb2, 0xf0, 0x0b, 0xb0, 0xd9, 0x7e, 0xbc, 0xd3, 0x7e, 0xe8, 0x1d, 0x3c, 0xdb, 0x6f,
+	0x7a, 0x9d, 0xdd, 0xf6, 0x7e, 0xf3, 0x5e, 0xeb, 0x7e, 0xab, 0xd9, 0x58, 0xcd, 0x59, 0xe6, 0xc9,
+	0x69, 0xb9, 0x30, 0x4e, 0xed, 0x50, 0x3e, 0xc4, 0x3d, 0xd2, 0x27, 0xd8, 0x97, 0x6f, 0xe1, 0x44,
+	0x55, 0x63, 0xef, 0xe9, 0xee, 0x41, 0xeb, 0x49, 0x73, 0xd5, 0xb0, 0x36, 0x4e, 0x4e, 0xcb, 0x6b,
+	0xe3, 0x92, 0xf1, 0x8d, 0xb9, 0x3b, 0xa5, 0xd2, 0xd8, 0xeb, 0xd4, 0x1f, 0x37, 0xbd, 0x76, 0xeb,
+	0xc1, 0xee, 0xea, 0x8c, 0x55, 0x3c, 0x39, 0x2d, 0xaf, 0x4f, 0x94, 0x64, 0xd7, 0xd9, 0x9a, 0xfb,
+	0xf1, 0x27, 0x3b, 0x57, 0xff, 0xe6, 0xe7, 0x73, 0xdb, 0x38, 0x3b, 0xb7, 0x8d, 0x37, 0xe7, 0xb6,
+	0xf1, 0xe7, 0xb9, 0x6d, 0xbc, 0xb8, 0xb0, 0x73, 0x6f, 0x2e, 0xec, 0xdc, 0x6f, 0x17, 0x76, 0xee,
+	0xbb, 0xed, 0xff, 0x34, 0x64, 0xf4, 0xfe, 0xf7, 0x58, 0x79, 0xd3, 0x5d, 0x50, 0x1b, 0x7c, 0xf7,
+	0x9f, 0x01, 0x00, 0x92, 0x6c, 0x4e, 0xf0, 0xaf, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -266,6 +441,9 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeJailCount != that1.DowntimeJailCount {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -302,6 +480,57 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if !this.DowntimeJailMultiplier.Equal(that1.DowntimeJailMultiplier) {
+		return false
+	}
+	if this.DowntimeJailDecayWindow != that1.DowntimeJailDecayWindow {
+		return false
+	}
+	if this.MaxDowntimeJailDuration != that1.MaxDowntimeJailDuration {
+		return false
+	}
+	if this.AutoUnjail != that1.AutoUnjail {
+		return false
+	}
+	if this.MaxSlashRecords != that1.MaxSlashRecords {
+		return false
+	}
+	return true
+}
+func (this *SlashRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashRecord)
+	if !ok {
+		that2, ok := that.(SlashRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.BurnedAmount.Equal(that1.BurnedAmount) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -324,6 +553,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeJailCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeJailCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -387,6 +621,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSlashRecords != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxSlashRecords))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AutoUnjail {
+		i--
+		if m.AutoUnjail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDecayWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDecayWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DowntimeJailMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +682,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -433,6 +708,66 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnedAmount.Size()
+		i -= size
+		if _, err := m.BurnedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Type != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -468,6 +803,9 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeJailCount != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeJailCount))
+	}
 	return n
 }
 
@@ -488,6 +826,41 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDecayWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.AutoUnjail {
+		n += 2
+	}
+	if m.MaxSlashRecords != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxSlashRecords))
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovSlashing(uint64(m.Type))
+	}
+	l = m.BurnedAmount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -668,6 +1041,25 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailCount", wireType)
+			}
+			m.DowntimeJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -869,6 +1261,331 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDecayWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDecayWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoUnjail = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashRecords", wireType)
+			}
+			m.MaxSlashRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlashRecords |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SlashType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -23,5 +23,18 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// bump the validators below the minimum commission rate if it was raised,
+	// e.g. by a governance param change, during this block
+	minRate := k.MinCommissionRate(ctx)
+	if lastMinRate := k.GetLastMinCommissionRate(ctx); !minRate.Equal(lastMinRate) {
+		if minRate.GT(lastMinRate) {
+			if err := k.EnforceMinCommissionRate(ctx); err != nil {
+				panic(err)
+			}
+		} else {
+			k.SetLastMinCommissionRate(ctx, minRate)
+		}
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -27,11 +27,17 @@ const (
 
 	FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagSortBy = "sort-by"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
 )
 
+// SortByPower orders the validators by descending power in the validators
+// query.
+const SortByPower = "power"
+
 // common flagsets to add to various functions
 var (
 	fsShares       = flag.NewFlagSet("", flag.ContinueOnError)
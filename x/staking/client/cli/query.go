@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -27,8 +28,10 @@ func GetQueryCmd() *cobra.Command {
 	stakingQueryCmd.AddCommand(
 		GetCmdQueryDelegation(),
 		GetCmdQueryDelegations(),
+		GetCmdQueryPositions(),
 		GetCmdQueryUnbondingDelegation(),
 		GetCmdQueryUnbondingDelegations(),
+		GetCmdQueryUnbondingTotal(),
 		GetCmdQueryRedelegation(),
 		GetCmdQueryRedelegations(),
 		GetCmdQueryValidator(),
@@ -36,7 +39,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
+		GetCmdQueryValidatorLiquidStake(),
 		GetCmdQueryHistoricalInfo(),
+		GetCmdQueryHistoricalValidator(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
 	)
@@ -97,10 +102,14 @@ func GetCmdQueryValidators() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query details about all validators on a network.
 
+With --sort-by=power, the validators which aren't jailed are listed by
+descending power along with their rank.
+
 Example:
 $ %s query staking validators
+$ %s query staking validators --sort-by power --limit 100
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -114,18 +123,38 @@ $ %s query staking validators
 				return err
 			}
 
-			result, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{
-				// Leaving status empty on purpose to query all validators.
-				Pagination: pageReq,
-			})
+			sortBy, err := cmd.Flags().GetString(FlagSortBy)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(result)
+			switch sortBy {
+			case "":
+				result, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{
+					// Leaving status empty on purpose to query all validators.
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(result)
+			case SortByPower:
+				result, err := queryClient.ValidatorsByPower(cmd.Context(), &types.QueryValidatorsByPowerRequest{
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(result)
+			default:
+				return fmt.Errorf("invalid sort order %s, expected %s", sortBy, SortByPower)
+			}
 		},
 	}
 
+	cmd.Flags().String(FlagSortBy, "", fmt.Sprintf("Order of the validators, either unspecified or %s", SortByPower))
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "validators")
 
@@ -186,6 +215,53 @@ $ %s query staking unbonding-delegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9l
 	return cmd
 }
 
+// GetCmdQueryValidatorLiquidStake implements the query of the delegations of a
+// validator owned by module accounts command.
+func GetCmdQueryValidatorLiquidStake() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "liquid-stake [validator-addr]",
+		Short: "Query the delegations of a validator owned by module accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegator shares of a validator owned by module accounts, such as
+liquid staking modules, along with the tokens they are worth and the fraction of
+the validator's delegator shares they represent.
+
+Example:
+$ %s query staking liquid-stake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorLiquidStakeRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorLiquidStake(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryValidatorRedelegations implements the query all redelegatations
 // from a validator command.
 func GetCmdQueryValidatorRedelegations() *cobra.Command {
@@ -350,6 +426,63 @@ $ %s query staking delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+// GetCmdQueryPositions implements the command to query all the delegations
+// made from one delegator along with the info of their validators.
+func GetCmdQueryPositions() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "positions [delegator-addr]",
+		Short: "Query all delegations made by one delegator along with their validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query delegations for an individual delegator on all validators, including the
+moniker, status, jailed flag and commission of each validator and the token
+balance of each delegation.
+
+Example:
+$ %s query staking positions %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDelegatorPositionsRequest{
+				DelegatorAddr: delAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.DelegatorPositions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "positions")
+
+	return cmd
+}
+
 // GetCmdQueryValidatorDelegations implements the command to query all the
 // delegations to a specific validator.
 func GetCmdQueryValidatorDelegations() *cobra.Command {
@@ -515,6 +648,58 @@ $ %s query staking unbonding-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru
 	return cmd
 }
 
+// GetCmdQueryUnbondingTotal implements the command to query the amounts still
+// unbonding for a delegator, per validator and overall.
+func GetCmdQueryUnbondingTotal() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbonding-total [delegator-addr]",
+		Short: "Query the total amount still unbonding for one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the summed balance of the unbonding delegations of an individual delegator,
+per validator and overall.
+
+Example:
+$ %s query staking unbonding-total %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// the totals are computed over all unbonding delegations, so
+			// only a single record is requested along with them
+			params := &types.QueryDelegatorUnbondingDelegationsRequest{
+				DelegatorAddr: delegatorAddr.String(),
+				Pagination:    &query.PageRequest{Limit: 1},
+			}
+
+			res, err := queryClient.DelegatorUnbondingDelegations(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Totals)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryRedelegation implements the command to query a single
 // redelegation record.
 func GetCmdQueryRedelegation() *cobra.Command {
@@ -638,12 +823,14 @@ func GetCmdQueryHistoricalInfo() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "Query historical info at given height",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query historical info at given height.
+			fmt.Sprintf(`Query historical info at given height, optionally restricting the validator set
+to a single validator.
 
 Example:
 $ %s query staking historical-info 5
+$ %s query staking historical-info 5 --validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 `,
-				version.AppName,
+				version.AppName, version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -658,7 +845,12 @@ $ %s query staking historical-info 5
 				return fmt.Errorf("height argument provided must be a non-negative-integer: %v", err)
 			}
 
-			params := &types.QueryHistoricalInfoRequest{Height: height}
+			valAddr, err := cmd.Flags().GetString(FlagAddressValidator)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryHistoricalInfoRequest{Height: height, ValidatorAddr: valAddr}
 			res, err := queryClient.HistoricalInfo(cmd.Context(), params)
 			if err != nil {
 				return err
@@ -668,6 +860,57 @@ $ %s query staking historical-info 5
 		},
 	}
 
+	cmd.Flags().String(FlagAddressValidator, "", "Only return the given validator from the historical validator set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHistoricalValidator implements the historical validator query command.
+func GetCmdQueryHistoricalValidator() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "historical-validator [height] [validator-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query a validator as of a given height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens, shares, commission and consensus power of a validator as
+stored in the historical info at given height.
+
+Example:
+$ %s query staking historical-validator 5 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height < 0 {
+				return fmt.Errorf("height argument provided must be a non-negative-integer: %v", err)
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryHistoricalValidatorRequest{Height: height, ValidatorAddr: valAddr.String()}
+			res, err := queryClient.HistoricalValidator(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
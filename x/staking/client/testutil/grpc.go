@@ -518,11 +518,11 @@ func (s *IntegrationTestSuite) TestGRPCQueryRedelegations() {
 		},
 		{
 			"valid request with dst address",
-			fmt.Sprintf("%s/cosmos/staking/v1beta1/delegators/%s/redelegations?dst_validator_addr=%s", baseURL, val.Address.String(), val.ValAddress.String()),
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/delegators/%s/redelegations?dst_validator_addr=%s", baseURL, val.Address.String(), val2.ValAddress.String()),
 			false,
 		},
 		{
-			"valid request with dst address",
+			"valid request with src and dst address",
 			fmt.Sprintf("%s/cosmos/staking/v1beta1/delegators/%s/redelegations?src_validator_addr=%s&dst_validator_addr=%s", baseURL, val.Address.String(), val.ValAddress.String(), val2.ValAddress.String()),
 			false,
 		},
@@ -678,6 +678,11 @@ func (s *IntegrationTestSuite) TestGRPCQueryHistoricalInfo() {
 			fmt.Sprintf("%s/cosmos/staking/v1beta1/historical_info/%s", baseURL, "2"),
 			false,
 		},
+		{
+			"valid request with validator address",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/historical_info/%s?validator_addr=%s", baseURL, "2", val.ValAddress.String()),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -700,6 +705,60 @@ func (s *IntegrationTestSuite) TestGRPCQueryHistoricalInfo() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGRPCQueryHistoricalValidator() {
+	val := s.network.Validators[0]
+	baseURL := val.APIAddress
+
+	_, err := s.network.WaitForHeight(3)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name  string
+		url   string
+		error bool
+	}{
+		{
+			"wrong height",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/historical_info/%s/validators/%s", baseURL, "-1", val.ValAddress.String()),
+			true,
+		},
+		{
+			"wrong validator address",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/historical_info/%s/validators/%s", baseURL, "2", "wrongValAddress"),
+			true,
+		},
+		{
+			"valid request at height 2",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/historical_info/%s/validators/%s", baseURL, "2", val.ValAddress.String()),
+			false,
+		},
+		{
+			"valid request at height 3",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/historical_info/%s/validators/%s", baseURL, "3", val.ValAddress.String()),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			resp, err := rest.GetRequest(tc.url)
+			s.Require().NoError(err)
+
+			var res types.QueryHistoricalValidatorResponse
+			err = val.ClientCtx.Codec.UnmarshalJSON(resp, &res)
+
+			if tc.error {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(val.ValAddress.String(), res.Validator.OperatorAddress)
+				s.Require().True(res.Power > 0)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGRPCQueryParams() {
 	val := s.network.Validators[0]
 	baseURL := val.APIAddress
@@ -310,6 +310,32 @@ func (s *IntegrationTestSuite) TestGetCmdQueryValidators() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorsByPower() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryValidators(), []string{
+		fmt.Sprintf("--%s=%s", cli.FlagSortBy, cli.SortByPower),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var result types.QueryValidatorsByPowerResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &result))
+	s.Require().NotEmpty(result.Validators)
+	for i, rankedVal := range result.Validators {
+		s.Require().Equal(uint64(i+1), rankedVal.Rank)
+		if i > 0 {
+			s.Require().True(rankedVal.Validator.Tokens.LTE(result.Validators[i-1].Validator.Tokens))
+		}
+	}
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryValidators(), []string{
+		fmt.Sprintf("--%s=tokens", cli.FlagSortBy),
+	})
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryDelegation() {
 	val := s.network.Validators[0]
 	val2 := s.network.Validators[1]
@@ -433,6 +459,113 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegations() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryPositions() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{
+			"with no delegator address",
+			[]string{},
+			true,
+		},
+		{
+			"with wrong delegator address",
+			[]string{"wrongDelAddr"},
+			true,
+		},
+		{
+			"valid request (height specific)",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryPositions()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryDelegatorPositionsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().Len(res.Positions, 1)
+
+				position := res.Positions[0]
+				s.Require().Equal(val.ValAddress.String(), position.ValidatorAddress)
+				s.Require().Equal(val.Moniker, position.Moniker)
+				s.Require().Equal(types.Bonded, position.Status)
+				s.Require().False(position.Jailed)
+				s.Require().Equal(sdk.NewDecFromInt(cli.DefaultTokens), position.Shares)
+				s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, cli.DefaultTokens), position.Balance)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorLiquidStake() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{
+			"with no validator address",
+			[]string{},
+			true,
+		},
+		{
+			"with wrong validator address",
+			[]string{"wrongValAddr"},
+			true,
+		},
+		{
+			"valid request",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorLiquidStake()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryValidatorLiquidStakeResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().True(res.Shares.IsZero())
+				s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), res.Amount)
+				s.Require().True(res.Fraction.IsZero())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryValidatorDelegations() {
 	val := s.network.Validators[0]
 
@@ -537,6 +670,56 @@ func (s *IntegrationTestSuite) TestGetCmdQueryUnbondingDelegations() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryUnbondingTotal() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{
+			"wrong delegator address",
+			[]string{
+				"wrongDelAddr",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid request",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryUnbondingTotal()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expErr {
+				s.Require().Error(err)
+			} else {
+				var totals types.UnbondingTotals
+				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &totals)
+
+				s.Require().NoError(err)
+				s.Require().Len(totals.Validators, 1)
+				s.Require().Equal(val.ValAddress.String(), totals.Validators[0].ValidatorAddress)
+				s.Require().Equal(sdk.NewInt(10), totals.Validators[0].Balance)
+				s.Require().Equal(sdk.NewInt(10), totals.Total)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryUnbondingDelegation() {
 	val := s.network.Validators[0]
 
@@ -871,6 +1054,73 @@ func (s *IntegrationTestSuite) TestGetCmdQueryHistoricalInfo() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryHistoricalValidator() {
+	val := s.network.Validators[0]
+
+	_, err := s.network.WaitForHeight(3)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name  string
+		args  []string
+		error bool
+	}{
+		{
+			"wrong height",
+			[]string{"-1", val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"invalid validator address",
+			[]string{"1", "invalid", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"valid request at height 1",
+			[]string{"1", val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+		{
+			"valid request at height 3",
+			[]string{"3", val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryHistoricalValidator()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.error {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryHistoricalValidatorResponse
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().Equal(val.ValAddress.String(), res.Validator.OperatorAddress)
+				s.Require().True(res.Power > 0)
+			}
+		})
+	}
+
+	// the historical info can be filtered down to a single validator
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryHistoricalInfo(), []string{
+		"2",
+		fmt.Sprintf("--%s=%s", cli.FlagAddressValidator, val.ValAddress.String()),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var historicalInfo types.HistoricalInfo
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &historicalInfo))
+	s.Require().Len(historicalInfo.Valset, 1)
+	s.Require().Equal(val.ValAddress.String(), historicalInfo.Valset[0].OperatorAddress)
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 	val := s.network.Validators[0]
 	testCases := []struct {
@@ -882,16 +1132,24 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			"with text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`bond_denom: stake
+enforce_min_self_delegation: false
 historical_entries: 10000
+key_rotation_fee:
+  amount: "1000000"
+  denom: stake
+max_cons_pubkey_rotations: 1
 max_entries: 7
+max_undelegate_all_positions: 20
+max_validator_power_fraction: "1.000000000000000000"
 max_validators: 100
 min_commission_rate: "0.000000000000000000"
+slash_fund_community_pool: false
 unbonding_time: 1814400s`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000","max_cons_pubkey_rotations":1,"key_rotation_fee":{"denom":"stake","amount":"1000000"},"max_validator_power_fraction":"1.000000000000000000","max_undelegate_all_positions":20,"enforce_min_self_delegation":false,"slash_fund_community_pool":false}`,
 		},
 	}
 	for _, tc := range testCases {
@@ -1144,6 +1402,61 @@ func (s *IntegrationTestSuite) TestNewDelegateCmd() {
 	}
 }
 
+// TestNewDelegateCmdKeyringDir signs a delegation with a key of a keyring in
+// a directory different from the home directory of the validator.
+func (s *IntegrationTestSuite) TestNewDelegateCmdKeyringDir() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	keyringDir := s.T().TempDir()
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, keyringDir, nil, clientCtx.Codec)
+	s.Require().NoError(err)
+	k, _, err := kr.NewMnemonic("keyringDirKey", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	addr, err := k.GetAddress()
+	s.Require().NoError(err)
+
+	_, err = banktestutil.MsgSendExec(
+		clientCtx,
+		val.Address,
+		addr,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(200))), fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	args := []string{
+		val.ValAddress.String(),
+		sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(150)).String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "keyringDirKey"),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// the key is not in the keyring of the home directory
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewDelegateCmd(), args)
+	s.Require().Error(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewDelegateCmd(),
+		append(args, fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, keyringDir)))
+	s.Require().NoError(err, out.String())
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryDelegation(), []string{
+		addr.String(),
+		val.ValAddress.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var delegation types.DelegationResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &delegation), out.String())
+	s.Require().Equal(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(150)), delegation.Balance)
+}
+
 func (s *IntegrationTestSuite) TestNewRedelegateCmd() {
 	val := s.network.Validators[0]
 	val2 := s.network.Validators[1]
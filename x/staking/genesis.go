@@ -33,6 +33,7 @@ func InitGenesis(
 
 	keeper.SetParams(ctx, data.Params)
 	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
+	keeper.SetLastUnbondingID(ctx, data.LastUnbondingId)
 
 	for _, validator := range data.Validators {
 		keeper.SetValidator(ctx, validator)
@@ -53,6 +54,10 @@ func InitGenesis(
 			keeper.InsertUnbondingValidatorQueue(ctx, validator)
 		}
 
+		for _, id := range validator.UnbondingIds {
+			keeper.SetValidatorByUnbondingID(ctx, validator, id)
+		}
+
 		switch validator.GetStatus() {
 		case types.Bonded:
 			bondedTokens = bondedTokens.Add(validator.GetTokens())
@@ -78,6 +83,11 @@ func InitGenesis(
 
 		keeper.SetDelegation(ctx, delegation)
 
+		if keeper.IsModuleAccount(ctx, delegatorAddress) {
+			liquidShares := keeper.GetValidatorLiquidShares(ctx, delegation.GetValidatorAddr())
+			keeper.SetValidatorLiquidShares(ctx, delegation.GetValidatorAddr(), liquidShares.Add(delegation.Shares))
+		}
+
 		// Call the after-modification hook if not exported
 		if !data.Exported {
 			if err := keeper.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
@@ -92,6 +102,10 @@ func InitGenesis(
 		for _, entry := range ubd.Entries {
 			keeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
 			notBondedTokens = notBondedTokens.Add(entry.Balance)
+
+			if entry.UnbondingId != 0 {
+				keeper.SetUnbondingDelegationByUnbondingID(ctx, ubd, entry.UnbondingId)
+			}
 		}
 	}
 
@@ -100,6 +114,16 @@ func InitGenesis(
 
 		for _, entry := range red.Entries {
 			keeper.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+
+			if entry.UnbondingId != 0 {
+				keeper.SetRedelegationByUnbondingID(ctx, red, entry.UnbondingId)
+			}
+		}
+	}
+
+	for _, record := range data.ConsPubkeyRotations {
+		if err := keeper.SetConsPubKeyRotationRecord(ctx, record); err != nil {
+			panic(err)
 		}
 	}
 
@@ -204,6 +228,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		ConsPubkeyRotations:  keeper.GetAllConsPubKeyRotationRecords(ctx),
+		LastUnbondingId:      keeper.GetLastUnbondingID(ctx),
 	}
 }
 
@@ -239,6 +265,10 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateUnbondingIDs(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -279,3 +309,50 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+// validateGenesisStateUnbondingIDs checks that the unbonding ids are unique and
+// were all assigned before the last unbonding id. Entries created before ids
+// were assigned have the id 0 and are skipped.
+func validateGenesisStateUnbondingIDs(data *types.GenesisState) error {
+	ids := make(map[uint64]bool)
+	checkID := func(id uint64) error {
+		if id == 0 {
+			return nil
+		}
+		if id > data.LastUnbondingId {
+			return fmt.Errorf("unbonding id %d is greater than the last unbonding id %d", id, data.LastUnbondingId)
+		}
+		if ids[id] {
+			return fmt.Errorf("duplicate unbonding id %d in genesis state", id)
+		}
+		ids[id] = true
+
+		return nil
+	}
+
+	for _, val := range data.Validators {
+		for _, id := range val.UnbondingIds {
+			if err := checkID(id); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, ubd := range data.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			if err := checkID(entry.UnbondingId); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, red := range data.Redelegations {
+		for _, entry := range red.Entries {
+			if err := checkID(entry.UnbondingId); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -220,6 +220,9 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	unbondingVal := teststaking.NewValidator(t, sdk.ValAddress(pk.Address()), pk)
+	unbondingVal.Status = types.Unbonding
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -240,6 +243,29 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate unbonding ids
+		{"unbonding ids", func(data *types.GenesisState) {
+			unbondingVal.UnbondingIds = []uint64{2}
+			data.Validators = []types.Validator{unbondingVal}
+			data.UnbondingDelegations = []types.UnbondingDelegation{
+				{Entries: []types.UnbondingDelegationEntry{{UnbondingId: 0}, {UnbondingId: 1}}},
+			}
+			data.LastUnbondingId = 2
+		}, false},
+		{"unbonding id greater than the last one", func(data *types.GenesisState) {
+			data.Redelegations = []types.Redelegation{
+				{Entries: []types.RedelegationEntry{{UnbondingId: 3}}},
+			}
+			data.LastUnbondingId = 2
+		}, true},
+		{"duplicate unbonding id", func(data *types.GenesisState) {
+			unbondingVal.UnbondingIds = []uint64{1}
+			data.Validators = []types.Validator{unbondingVal}
+			data.Redelegations = []types.Redelegation{
+				{Entries: []types.RedelegationEntry{{UnbondingId: 1}}},
+			}
+			data.LastUnbondingId = 1
+		}, true},
 	}
 
 	for _, tt := range tests {
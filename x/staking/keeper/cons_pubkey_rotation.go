@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey replaces the consensus public key of a validator. The old
+// consensus address keeps resolving to the validator for an unbonding period so
+// that infractions committed with the old key can still be punished, and the
+// replacement is reported to Tendermint with the validator set updates at the
+// end of the block.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	valAddr := validator.GetOperator()
+
+	// only the key Tendermint knew at the beginning of the block has to be
+	// removed from its validator set, further rotations within the same block
+	// keep it
+	pendingKey := types.GetPendingConsPubKeyRotationKey(valAddr)
+	if !store.Has(pendingKey) {
+		bz, err := k.cdc.MarshalInterface(oldPubKey)
+		if err != nil {
+			return err
+		}
+		store.Set(pendingKey, bz)
+	}
+
+	validator.ConsensusPubkey = pkAny
+	k.SetValidator(ctx, validator)
+	store.Delete(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(oldPubKey)))
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	record, err := types.NewConsPubKeyRotationRecord(
+		valAddr, oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx)),
+	)
+	if err != nil {
+		return err
+	}
+	if err := k.SetConsPubKeyRotationRecord(ctx, record); err != nil {
+		return err
+	}
+
+	return k.AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey)
+}
+
+// SetConsPubKeyRotationRecord stores a consensus key rotation record, maps the
+// rotated consensus address to the new one and queues the record for removal
+// at its completion time.
+func (k Keeper) SetConsPubKeyRotationRecord(ctx sdk.Context, record types.ConsPubKeyRotationRecord) error {
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	oldPubKey, err := record.GetOldConsPubKey()
+	if err != nil {
+		return err
+	}
+	newPubKey, err := record.GetNewConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	recordKey := types.GetConsPubKeyRotationKey(valAddr, oldConsAddr)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(recordKey, types.MustMarshalConsPubKeyRotationRecord(k.cdc, record))
+	store.Set(types.GetOldToNewConsAddrKey(oldConsAddr), sdk.GetConsAddress(newPubKey))
+	store.Set(types.GetConsPubKeyRotationQueueKey(record.CompletionTime, valAddr, oldConsAddr), recordKey)
+
+	return nil
+}
+
+// GetValidatorConsPubKeyRotations returns the consensus key rotation records of
+// a validator which are still within their unbonding period.
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) (records []types.ConsPubKeyRotationRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationsKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.MustUnmarshalConsPubKeyRotationRecord(k.cdc, iterator.Value()))
+	}
+
+	return records
+}
+
+// GetAllConsPubKeyRotationRecords returns all the consensus key rotation records
+// which are still within their unbonding period.
+func (k Keeper) GetAllConsPubKeyRotationRecords(ctx sdk.Context) (records []types.ConsPubKeyRotationRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.MustUnmarshalConsPubKeyRotationRecord(k.cdc, iterator.Value()))
+	}
+
+	return records
+}
+
+// getRotatedConsAddr returns the consensus address which replaced the given
+// rotated consensus address, if the rotation is still within its unbonding
+// period.
+func (k Keeper) getRotatedConsAddr(ctx sdk.Context, oldConsAddr sdk.ConsAddress) (sdk.ConsAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOldToNewConsAddrKey(oldConsAddr))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// DequeueAllMatureConsPubKeyRotations removes the consensus key rotation
+// records whose unbonding period has passed, after which the rotated consensus
+// addresses no longer resolve to their validator.
+func (k Keeper) DequeueAllMatureConsPubKeyRotations(ctx sdk.Context, currTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.ConsPubKeyRotationQueueKey, sdk.PrefixEndBytes(types.GetConsPubKeyRotationTimeKey(currTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		recordKey := iterator.Value()
+		if bz := store.Get(recordKey); bz != nil {
+			record := types.MustUnmarshalConsPubKeyRotationRecord(k.cdc, bz)
+			oldPubKey, err := record.GetOldConsPubKey()
+			if err != nil {
+				panic(err)
+			}
+
+			store.Delete(types.GetOldToNewConsAddrKey(sdk.GetConsAddress(oldPubKey)))
+			store.Delete(recordKey)
+		}
+
+		store.Delete(iterator.Key())
+	}
+}
+
+// getPendingConsPubKeyRotations returns the consensus keys replaced during the
+// current block, by validator operator address.
+func (k Keeper) getPendingConsPubKeyRotations(ctx sdk.Context) (map[string]cryptotypes.PubKey, error) {
+	store := ctx.KVStore(k.storeKey)
+	pending := make(map[string]cryptotypes.PubKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pk cryptotypes.PubKey
+		if err := k.cdc.UnmarshalInterface(iterator.Value(), &pk); err != nil {
+			return nil, err
+		}
+
+		valAddr := sdk.ValAddress(iterator.Key()[2:]) // remove prefix bytes and address length
+		pending[valAddr.String()] = pk
+	}
+
+	return pending, nil
+}
+
+// clearPendingConsPubKeyRotations removes the consensus keys replaced during the
+// current block once they have been reported to Tendermint.
+func (k Keeper) clearPendingConsPubKeyRotations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRotateConsPubKey(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(333, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, valTokens.MulRaw(2))
+	valAddr := sdk.ValAddress(addrs[0])
+	oldPk, newPk := PKs[0], PKs[1]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, oldPk, valTokens, true)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+	tstaking.CheckValidator(valAddr, types.Bonded, false)
+
+	rotate := func(pk cryptotypes.PubKey) error {
+		msg, err := types.NewMsgRotateConsPubKey(valAddr, pk)
+		require.NoError(t, err)
+		_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// a consensus key already in use cannot be rotated to
+	require.ErrorIs(t, rotate(oldPk), types.ErrValidatorPubKeyExists)
+
+	fee := app.StakingKeeper.KeyRotationFee(ctx)
+	supplyBefore := app.BankKeeper.GetSupply(ctx, fee.Denom)
+	balanceBefore := app.BankKeeper.GetBalance(ctx, addrs[0], fee.Denom)
+
+	require.NoError(t, rotate(newPk))
+
+	// the rotation fee is burned
+	require.Equal(t, balanceBefore.Sub(fee), app.BankKeeper.GetBalance(ctx, addrs[0], fee.Denom))
+	require.Equal(t, supplyBefore.Sub(fee), app.BankKeeper.GetSupply(ctx, fee.Denom))
+
+	// both consensus addresses resolve to the validator
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPk))
+	require.True(t, found)
+	require.Equal(t, valAddr, validator.GetOperator())
+	require.True(t, newPk.Equals(validator.ConsensusPubkey.GetCachedValue().(cryptotypes.PubKey)))
+
+	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPk))
+	require.True(t, found)
+	require.Equal(t, valAddr, validator.GetOperator())
+
+	records := app.StakingKeeper.GetValidatorConsPubKeyRotations(ctx, valAddr)
+	require.Len(t, records, 1)
+	require.Equal(t, ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)), records[0].CompletionTime)
+
+	// the number of rotations within the unbonding period is limited
+	require.ErrorIs(t, rotate(PKs[2]), types.ErrExceedingMaxConsPubKeyRotations)
+
+	// Tendermint removes the old key and adds the new one with the same power
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+	require.NoError(t, err)
+	newTmPk, err := cryptocodec.ToTmProtoPublicKey(newPk)
+	require.NoError(t, err)
+
+	updates := applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 2)
+	require.Equal(t, oldTmPk, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, newTmPk, updates[1].PubKey)
+	require.Equal(t, validator.ConsensusPower(app.StakingKeeper.PowerReduction(ctx)), updates[1].Power)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 0)
+
+	// the old consensus address no longer resolves after the unbonding period
+	ctx = ctx.WithBlockTime(records[0].CompletionTime)
+	app.StakingKeeper.DequeueAllMatureConsPubKeyRotations(ctx, ctx.BlockTime())
+
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPk))
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetValidatorConsPubKeyRotations(ctx, valAddr))
+	require.NoError(t, rotate(PKs[2]))
+
+	// rotations can be disabled
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxConsPubkeyRotations = 0
+	app.StakingKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, rotate(PKs[3]), types.ErrConsPubKeyRotationDisabled)
+}
+
+func TestRotateConsPubKeyUnbondingValidator(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, valTokens.MulRaw(2))
+	valAddr := sdk.ValAddress(addrs[0])
+	oldPk, newPk := PKs[0], PKs[1]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, oldPk, valTokens, true)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	msg, err := types.NewMsgRotateConsPubKey(valAddr, newPk)
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// a validator leaving the active set within the same block is removed
+	// from the Tendermint validator set under the key it was bonded with
+	app.StakingKeeper.Jail(ctx, sdk.GetConsAddress(newPk))
+
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+	require.NoError(t, err)
+
+	updates := applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+	require.Equal(t, oldTmPk, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+}
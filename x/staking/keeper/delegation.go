@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -187,6 +188,44 @@ func (k Keeper) IterateUnbondingDelegations(ctx sdk.Context, fn func(index int64
 	}
 }
 
+// IterateDelegatorUnbondingDelegations iterates through all of the unbonding
+// delegations of a delegator.
+func (k Keeper) IterateDelegatorUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress, cb func(ubd types.UnbondingDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUBDsKey(delegator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ubd := types.MustUnmarshalUBD(k.cdc, iterator.Value())
+		if cb(ubd) {
+			break
+		}
+	}
+}
+
+// GetDelegatorUnbondingTotals returns the summed balance of the unbonding
+// delegation entries of a delegator, per validator and overall.
+func (k Keeper) GetDelegatorUnbondingTotals(ctx sdk.Context, delegator sdk.AccAddress) types.UnbondingTotals {
+	totals := types.UnbondingTotals{Total: sdk.ZeroInt()}
+
+	k.IterateDelegatorUnbondingDelegations(ctx, delegator, func(ubd types.UnbondingDelegation) bool {
+		balance := sdk.ZeroInt()
+		for _, entry := range ubd.Entries {
+			balance = balance.Add(entry.Balance)
+		}
+
+		totals.Validators = append(totals.Validators, types.ValidatorUnbondingTotal{
+			ValidatorAddress: ubd.ValidatorAddress,
+			Balance:          balance,
+		})
+		totals.Total = totals.Total.Add(balance)
+		return false
+	})
+
+	return totals
+}
+
 // HasMaxUnbondingDelegationEntries - check if unbonding delegation has maximum number of entries
 func (k Keeper) HasMaxUnbondingDelegationEntries(ctx sdk.Context,
 	delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) bool {
@@ -233,21 +272,30 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 }
 
 // SetUnbondingDelegationEntry adds an entry to the unbonding delegation at
-// the given addresses. It creates the unbonding delegation if it does not exist
+// the given addresses. It creates the unbonding delegation if it does not exist.
+// The entry is assigned a new unbonding id, which is passed to the
+// AfterUnbondingInitiated hook.
 func (k Keeper) SetUnbondingDelegationEntry(
 	ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 	creationHeight int64, minTime time.Time, balance sdk.Int,
-) types.UnbondingDelegation {
+) (types.UnbondingDelegation, error) {
+	id := k.IncrementUnbondingID(ctx)
+
 	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
 	if found {
-		ubd.AddEntry(creationHeight, minTime, balance)
+		ubd.AddEntry(creationHeight, minTime, balance, id)
 	} else {
-		ubd = types.NewUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, minTime, balance)
+		ubd = types.NewUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, minTime, balance, id)
 	}
 
 	k.SetUnbondingDelegation(ctx, ubd)
+	k.SetUnbondingDelegationByUnbondingID(ctx, ubd, id)
+
+	if err := k.AfterUnbondingInitiated(ctx, id); err != nil {
+		return ubd, err
+	}
 
-	return ubd
+	return ubd, nil
 }
 
 // unbonding delegation queue timeslice operations
@@ -419,24 +467,33 @@ func (k Keeper) SetRedelegation(ctx sdk.Context, red types.Redelegation) {
 	store.Set(types.GetREDByValDstIndexKey(delegatorAddress, valSrcAddr, valDestAddr), []byte{})
 }
 
-// SetUnbondingDelegationEntry adds an entry to the unbonding delegation at
-// the given addresses. It creates the unbonding delegation if it does not exist
+// SetRedelegationEntry adds an entry to the redelegation at the given
+// addresses. It creates the redelegation if it does not exist. The entry is
+// assigned a new unbonding id, which is passed to the AfterUnbondingInitiated
+// hook.
 func (k Keeper) SetRedelegationEntry(ctx sdk.Context,
 	delegatorAddr sdk.AccAddress, validatorSrcAddr,
 	validatorDstAddr sdk.ValAddress, creationHeight int64,
 	minTime time.Time, balance sdk.Int,
-	sharesSrc, sharesDst sdk.Dec) types.Redelegation {
+	sharesSrc, sharesDst sdk.Dec) (types.Redelegation, error) {
+	id := k.IncrementUnbondingID(ctx)
+
 	red, found := k.GetRedelegation(ctx, delegatorAddr, validatorSrcAddr, validatorDstAddr)
 	if found {
-		red.AddEntry(creationHeight, minTime, balance, sharesDst)
+		red.AddEntry(creationHeight, minTime, balance, sharesDst, id)
 	} else {
 		red = types.NewRedelegation(delegatorAddr, validatorSrcAddr,
-			validatorDstAddr, creationHeight, minTime, balance, sharesDst)
+			validatorDstAddr, creationHeight, minTime, balance, sharesDst, id)
 	}
 
 	k.SetRedelegation(ctx, red)
+	k.SetRedelegationByUnbondingID(ctx, red, id)
 
-	return red
+	if err := k.AfterUnbondingInitiated(ctx, id); err != nil {
+		return red, err
+	}
+
+	return red, nil
 }
 
 // iterate through all redelegations
@@ -627,6 +684,7 @@ func (k Keeper) Delegate(
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
+	k.updateValidatorLiquidShares(ctx, delegatorAddress, delegation.GetValidatorAddr(), newShares)
 
 	// Call the after-modification hook
 	if err := k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
@@ -674,10 +732,12 @@ func (k Keeper) Unbond(
 
 	// If the delegation is the operator of the validator and undelegating will decrease the validator's
 	// self-delegation below their minimum, we jail the validator.
-	if isValidatorOperator && !validator.Jailed &&
-		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
-		k.jailValidator(ctx, validator)
-		validator = k.mustGetValidator(ctx, validator.GetOperator())
+	if isValidatorOperator && !validator.Jailed {
+		selfDelegation := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		if selfDelegation.LT(validator.MinSelfDelegation) {
+			k.jailBelowMinSelfDelegation(ctx, validator, selfDelegation, types.AttributeValueSelfUndelegation)
+			validator = k.mustGetValidator(ctx, validator.GetOperator())
+		}
 	}
 
 	// Remove the delegation if the resulting shares yield a truncated zero amount
@@ -685,8 +745,11 @@ func (k Keeper) Unbond(
 	// may not be zero, but rather a small fractional amount. Otherwise, we update
 	// the delegation object.
 	if validator.TokensFromShares(delegation.Shares).TruncateInt().IsZero() || delegation.Shares.IsZero() {
+		// the remaining fractional shares are dropped along with the delegation
+		k.updateValidatorLiquidShares(ctx, delegatorAddress, valAddr, shares.Add(delegation.Shares).Neg())
 		err = k.RemoveDelegation(ctx, delegation)
 	} else {
+		k.updateValidatorLiquidShares(ctx, delegatorAddress, valAddr, shares.Neg())
 		k.SetDelegation(ctx, delegation)
 		// call the after delegation modification hook
 		err = k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
@@ -743,18 +806,67 @@ func (k Keeper) getBeginInfo(
 func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
 ) (time.Time, error) {
+	completionTime, _, err := k.undelegate(ctx, delAddr, valAddr, sharesAmount)
+	return completionTime, err
+}
+
+// UndelegateAll unbonds all the delegations of a delegator, in ascending
+// validator operator address order. At most maxPositions delegations are
+// unbonded, the operator addresses of the delegations left untouched, either
+// because of that limit or because their unbonding delegation already has the
+// maximum number of entries, are returned so that they can be unbonded later.
+func (k Keeper) UndelegateAll(
+	ctx sdk.Context, delAddr sdk.AccAddress, maxPositions uint32,
+) (entries []types.UndelegateAllEntry, remaining []string, err error) {
+	delegations := k.GetAllDelegatorDelegations(ctx, delAddr)
+	if len(delegations) == 0 {
+		return nil, nil, types.ErrNoDelegation
+	}
+
+	sort.Slice(delegations, func(i, j int) bool {
+		return bytes.Compare(delegations[i].GetValidatorAddr(), delegations[j].GetValidatorAddr()) < 0
+	})
+
+	bondDenom := k.BondDenom(ctx)
+	for _, delegation := range delegations {
+		valAddr := delegation.GetValidatorAddr()
+		if uint32(len(entries)) >= maxPositions || k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+			remaining = append(remaining, delegation.ValidatorAddress)
+			continue
+		}
+
+		completionTime, amount, err := k.undelegate(ctx, delAddr, valAddr, delegation.Shares)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		entries = append(entries, types.UndelegateAllEntry{
+			ValidatorAddress: delegation.ValidatorAddress,
+			Amount:           sdk.NewCoin(bondDenom, amount),
+			CompletionTime:   completionTime,
+		})
+	}
+
+	return entries, remaining, nil
+}
+
+// undelegate unbonds an amount of delegator shares from a given validator and
+// returns the completion time and the amount of tokens of the unbonding.
+func (k Keeper) undelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
+) (time.Time, sdk.Int, error) {
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
-		return time.Time{}, types.ErrNoDelegatorForAddress
+		return time.Time{}, sdk.Int{}, types.ErrNoDelegatorForAddress
 	}
 
 	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
+		return time.Time{}, sdk.Int{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
-		return time.Time{}, err
+		return time.Time{}, sdk.Int{}, err
 	}
 
 	// transfer the validator tokens to the not bonded pool
@@ -763,10 +875,13 @@ func (k Keeper) Undelegate(
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	ubd, err := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	if err != nil {
+		return time.Time{}, sdk.Int{}, err
+	}
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
-	return completionTime, nil
+	return completionTime, returnAmount, nil
 }
 
 // CompleteUnbonding completes the unbonding of all mature entries in the
@@ -787,12 +902,14 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 		return nil, err
 	}
 
-	// loop through all the entries and complete unbonding mature entries
+	// loop through all the entries and complete unbonding mature entries,
+	// entries on hold are completed once all their holds are released
 	for i := 0; i < len(ubd.Entries); i++ {
 		entry := ubd.Entries[i]
-		if entry.IsMature(ctxTime) {
+		if entry.IsMature(ctxTime) && !entry.OnHold() {
 			ubd.RemoveEntry(int64(i))
 			i--
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
 
 			// track undelegation only when remaining or truncated shares are non-zero
 			if !entry.Balance.IsZero() {
@@ -866,10 +983,13 @@ func (k Keeper) BeginRedelegation(
 		return completionTime, nil
 	}
 
-	red := k.SetRedelegationEntry(
+	red, err := k.SetRedelegationEntry(
 		ctx, delAddr, valSrcAddr, valDstAddr,
 		height, completionTime, returnAmount, sharesAmount, sharesCreated,
 	)
+	if err != nil {
+		return time.Time{}, err
+	}
 	k.InsertRedelegationQueue(ctx, red, completionTime)
 
 	return completionTime, nil
@@ -890,12 +1010,14 @@ func (k Keeper) CompleteRedelegation(
 	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
 
-	// loop through all the entries and complete mature redelegation entries
+	// loop through all the entries and complete mature redelegation entries,
+	// entries on hold are completed once all their holds are released
 	for i := 0; i < len(red.Entries); i++ {
 		entry := red.Entries[i]
-		if entry.IsMature(ctxTime) {
+		if entry.IsMature(ctxTime) && !entry.OnHold() {
 			red.RemoveEntry(int64(i))
 			i--
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
 
 			if !entry.InitialBalance.IsZero() {
 				balances = balances.Add(sdk.NewCoin(bondDenom, entry.InitialBalance))
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+	"sort"
 	"testing"
 	"time"
 
@@ -152,7 +154,7 @@ func TestUnbondingDelegation(t *testing.T) {
 		valAddrs[0],
 		0,
 		time.Unix(0, 0).UTC(),
-		sdk.NewInt(5),
+		sdk.NewInt(5), 0,
 	)
 
 	// set and retrieve a record
@@ -616,7 +618,7 @@ func TestGetRedelegationsFromSrcValidator(t *testing.T) {
 
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
 		time.Unix(0, 0), sdk.NewInt(5),
-		sdk.NewDec(5))
+		sdk.NewDec(5), 0)
 
 	// set and retrieve a record
 	app.StakingKeeper.SetRedelegation(ctx, rd)
@@ -643,7 +645,7 @@ func TestRedelegation(t *testing.T) {
 
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
 		time.Unix(0, 0).UTC(), sdk.NewInt(5),
-		sdk.NewDec(5))
+		sdk.NewDec(5), 0)
 
 	// test shouldn't have and redelegations
 	has := app.StakingKeeper.HasReceivingRedelegation(ctx, addrDels[0], addrVals[1])
@@ -991,3 +993,343 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(333, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, valTokens)
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	delAddr, valAddr := addrDels[1], addrVals[0]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, PKs[0], valTokens, true)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+	tstaking.CheckValidator(valAddr, types.Bonded, false)
+
+	tstaking.Delegate(delAddr, valAddr, valTokens)
+	tstaking.Undelegate(delAddr, valAddr, valTokens.QuoRaw(2), true)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	sharesBefore := delegation.Shares
+
+	unbondingAmt := valTokens.QuoRaw(2)
+	cancel := func(amount sdk.Int, height int64) error {
+		msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, height, sdk.NewCoin(bondDenom, amount))
+		_, err := msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// invalid requests leave the unbonding delegation untouched
+	msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 10, sdk.NewCoin("foo", sdk.OneInt()))
+	_, err := msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+	require.ErrorIs(t, cancel(sdk.OneInt(), 11), types.ErrNoUnbondingDelegationEntry)
+	require.Error(t, cancel(unbondingAmt.AddRaw(1), 10))
+
+	// partial cancellation reduces the entry and re-bonds the tokens
+	partialAmt := unbondingAmt.QuoRaw(5)
+	require.NoError(t, cancel(partialAmt, 10))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondingAmt.Sub(partialAmt), ubd.Entries[0].Balance)
+	require.Equal(t, unbondingAmt.Sub(partialAmt), ubd.Entries[0].InitialBalance)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sharesBefore.Add(partialAmt.ToDec()), delegation.Shares)
+
+	// cancelling the remaining balance removes the unbonding delegation
+	require.NoError(t, cancel(unbondingAmt.Sub(partialAmt), 10))
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+
+	// a slashed entry can only be cancelled up to its remaining balance
+	ctx = ctx.WithBlockHeight(12)
+	tstaking.Ctx = ctx
+	tstaking.Undelegate(delAddr, valAddr, unbondingAmt, true)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 12, sdk.NewDecWithPrec(5, 1))
+	slashedBalance := unbondingAmt.QuoRaw(2)
+
+	require.Error(t, cancel(unbondingAmt, 12))
+
+	// shares are issued at the validator's current exchange rate
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	validator = app.StakingKeeper.RemoveValidatorTokens(ctx, validator, validator.Tokens.QuoRaw(4))
+	expShares, err := validator.SharesFromTokens(slashedBalance)
+	require.NoError(t, err)
+	require.True(t, expShares.GT(slashedBalance.ToDec()))
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	sharesBefore = delegation.Shares
+
+	require.NoError(t, cancel(slashedBalance, 12))
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sharesBefore.Add(expShares), delegation.Shares)
+
+	// matured entries can no longer be cancelled
+	tstaking.Undelegate(delAddr, valAddr, sdk.OneInt(), true)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	require.ErrorIs(t, cancel(sdk.OneInt(), 12), types.ErrNoUnbondingDelegationEntry)
+}
+
+func TestDelegateValidatorPowerCap(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, valTokens.MulRaw(10))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	delAddr := addrDels[2]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(addrVals[0], PKs[0], valTokens, true)
+	tstaking.CreateValidator(addrVals[1], PKs[1], valTokens, true)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxValidatorPowerFraction = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	delegate := func(valAddr sdk.ValAddress, amount sdk.Int) error {
+		msg := types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, amount))
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// the delegated tokens count towards the bonded tokens, so a bonded
+	// validator reaches half of them after receiving bonded - 2 * tokens
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	maxAmount := app.StakingKeeper.TotalBondedTokens(ctx).Sub(validator.Tokens.MulRaw(2))
+	require.True(t, maxAmount.IsPositive())
+
+	require.ErrorIs(t, delegate(addrVals[0], maxAmount.AddRaw(1)), types.ErrValidatorPowerCapExceeded)
+	require.NoError(t, delegate(addrVals[0], maxAmount))
+
+	// a validator at the cap cannot receive further delegations, others can
+	require.ErrorIs(t, delegate(addrVals[0], sdk.OneInt()), types.ErrValidatorPowerCapExceeded)
+	require.NoError(t, delegate(addrVals[1], sdk.OneInt()))
+
+	// growing above the cap because of other validators unbonding is allowed
+	tstaking.Undelegate(sdk.AccAddress(addrVals[1]), addrVals[1], valTokens.QuoRaw(2), true)
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.Tokens.ToDec().GT(params.MaxValidatorPowerFraction.MulInt(app.StakingKeeper.TotalBondedTokens(ctx))))
+	require.ErrorIs(t, delegate(addrVals[0], sdk.OneInt()), types.ErrValidatorPowerCapExceeded)
+
+	// the cap is disabled at 100%
+	params.MaxValidatorPowerFraction = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+	require.NoError(t, delegate(addrVals[0], sdk.OneInt()))
+}
+
+func TestRedelegateValidatorPowerCap(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, valTokens.MulRaw(10))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	delAddr := addrDels[2]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(addrVals[0], PKs[0], valTokens, true)
+	tstaking.CreateValidator(addrVals[1], PKs[1], valTokens, true)
+	tstaking.Delegate(delAddr, addrVals[1], valTokens)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxValidatorPowerFraction = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	redelegate := func(amount sdk.Int) error {
+		msg := types.NewMsgBeginRedelegate(delAddr, addrVals[1], addrVals[0], sdk.NewCoin(bondDenom, amount))
+		_, err := msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// redelegating between bonded validators leaves the bonded tokens
+	// unchanged, so the destination can receive up to half of them
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	maxAmount := params.MaxValidatorPowerFraction.MulInt(app.StakingKeeper.TotalBondedTokens(ctx)).TruncateInt().Sub(validator.Tokens)
+	require.True(t, maxAmount.IsPositive())
+
+	require.ErrorIs(t, redelegate(maxAmount.AddRaw(1)), types.ErrValidatorPowerCapExceeded)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, delAddr, addrVals[1], addrVals[0])
+	require.False(t, found)
+
+	require.NoError(t, redelegate(maxAmount))
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens.ToDec(), params.MaxValidatorPowerFraction.MulInt(app.StakingKeeper.TotalBondedTokens(ctx)))
+
+	// the destination is now at the cap
+	require.ErrorIs(t, redelegate(sdk.OneInt()), types.ErrValidatorPowerCapExceeded)
+}
+
+func TestCancelUnbondingDelegationValidatorPowerCap(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(333, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 5, valTokens.MulRaw(10))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	delAddr, otherAddr := addrDels[3], addrDels[4]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for i := 0; i < 3; i++ {
+		tstaking.CreateValidator(addrVals[i], PKs[i], valTokens, true)
+	}
+	tstaking.Delegate(delAddr, addrVals[0], valTokens)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+	tstaking.Undelegate(delAddr, addrVals[0], valTokens, true)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxValidatorPowerFraction = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// other delegators top up the validator while the tokens are unbonding
+	tstaking.Delegate(otherAddr, addrVals[0], valTokens.QuoRaw(2))
+
+	cancel := func(amount sdk.Int) error {
+		msg := types.NewMsgCancelUnbondingDelegation(delAddr, addrVals[0], 10, sdk.NewCoin(bondDenom, amount))
+		_, err := msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// the re-bonded tokens count towards the bonded tokens, so the bonded
+	// validator reaches half of them after receiving bonded - 2 * tokens
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+	maxAmount := app.StakingKeeper.TotalBondedTokens(ctx).Sub(validator.Tokens.MulRaw(2))
+	require.True(t, maxAmount.IsPositive())
+	require.True(t, maxAmount.LT(valTokens))
+
+	require.ErrorIs(t, cancel(maxAmount.AddRaw(1)), types.ErrValidatorPowerCapExceeded)
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, valTokens, ubd.Entries[0].Balance)
+
+	require.NoError(t, cancel(maxAmount))
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens.ToDec(), params.MaxValidatorPowerFraction.MulInt(app.StakingKeeper.TotalBondedTokens(ctx)))
+
+	// the rest of the entry stays unbonding
+	require.ErrorIs(t, cancel(sdk.OneInt()), types.ErrValidatorPowerCapExceeded)
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, valTokens.Sub(maxAmount), ubd.Entries[0].Balance)
+}
+
+func TestUndelegateAll(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	const numVals = 30
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, numVals+1, valTokens.MulRaw(numVals))
+	delAddr := addrs[numVals]
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:numVals])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for i, valAddr := range valAddrs {
+		tstaking.CreateValidator(valAddr, PKs[i], valTokens, true)
+		tstaking.Delegate(delAddr, valAddr, delTokens)
+	}
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	sort.Slice(valAddrs, func(i, j int) bool {
+		return bytes.Compare(valAddrs[i], valAddrs[j]) < 0
+	})
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxEntries = 1
+	params.MaxUndelegateAllPositions = 10
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the first delegation already has the maximum number of unbonding entries
+	tstaking.Undelegate(delAddr, valAddrs[0], delTokens.QuoRaw(2), true)
+
+	undelegateAll := func() (*types.MsgUndelegateAllResponse, sdk.Events, error) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		res, err := msgServer.UndelegateAll(sdk.WrapSDKContext(ctx), types.NewMsgUndelegateAll(delAddr))
+		return res, ctx.EventManager().Events(), err
+	}
+	validatorAddresses := func(valAddrs []sdk.ValAddress) (addrs []string) {
+		for _, valAddr := range valAddrs {
+			addrs = append(addrs, valAddr.String())
+		}
+		return addrs
+	}
+
+	res, events, err := undelegateAll()
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 10)
+	for i, entry := range res.Entries {
+		require.Equal(t, valAddrs[i+1].String(), entry.ValidatorAddress)
+		require.Equal(t, sdk.NewCoin(bondDenom, delTokens), entry.Amount)
+		require.Equal(t, ctx.BlockTime().Add(params.UnbondingTime), entry.CompletionTime)
+
+		_, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddrs[i+1])
+		require.False(t, found)
+		ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddrs[i+1])
+		require.True(t, found)
+		require.Equal(t, delTokens, ubd.Entries[0].Balance)
+	}
+	require.Equal(t, append(validatorAddresses(valAddrs[:1]), validatorAddresses(valAddrs[11:])...), res.RemainingValidatorAddresses)
+
+	unbondEvents := 0
+	for _, event := range events {
+		if event.Type == types.EventTypeUnbond {
+			unbondEvents++
+		}
+	}
+	require.Equal(t, 10, unbondEvents)
+
+	res, _, err = undelegateAll()
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 10)
+	require.Equal(t, valAddrs[11].String(), res.Entries[0].ValidatorAddress)
+	require.Equal(t, append(validatorAddresses(valAddrs[:1]), validatorAddresses(valAddrs[21:])...), res.RemainingValidatorAddresses)
+
+	// the remaining delegations fit exactly within the cap once the first
+	// unbonding delegation has matured
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.UnbondingTime))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	res, _, err = undelegateAll()
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 10)
+	require.Equal(t, valAddrs[0].String(), res.Entries[0].ValidatorAddress)
+	require.Equal(t, sdk.NewCoin(bondDenom, delTokens.Sub(delTokens.QuoRaw(2))), res.Entries[0].Amount)
+	require.Empty(t, res.RemainingValidatorAddresses)
+	require.Empty(t, app.StakingKeeper.GetAllDelegatorDelegations(ctx, delAddr))
+
+	_, _, err = undelegateAll()
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -61,6 +63,83 @@ func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest
 	return &types.QueryValidatorsResponse{Validators: validators, Pagination: pageRes}, nil
 }
 
+// ValidatorsByPower queries all validators that match the given status by
+// descending power
+func (k Querier) ValidatorsByPower(c context.Context, req *types.QueryValidatorsByPowerRequest) (*types.QueryValidatorsByPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// validate the provided status, return all the validators if the status is empty
+	if req.Status != "" && !(req.Status == types.Bonded.String() || req.Status == types.Unbonded.String() || req.Status == types.Unbonding.String()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator status %s", req.Status)
+	}
+
+	// the power index is sorted by ascending power, so it is always walked
+	// backwards for the ranks to be computed as the validators are visited
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		if req.Pagination.Reverse {
+			return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+		}
+		*pageReq = *req.Pagination
+	}
+	pageReq.Reverse = true
+
+	var validators []types.RankedValidator
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	powerStore := prefix.NewStore(store, types.ValidatorsByPowerIndexKey)
+
+	getValidator := func(value []byte) (types.Validator, bool, error) {
+		val, found := k.GetValidator(ctx, value)
+		if !found {
+			return val, false, sdkerrors.Wrapf(types.ErrNoValidatorFound, "%s is in the power index", sdk.ValAddress(value))
+		}
+
+		return val, req.Status == "" || strings.EqualFold(val.GetStatus().String(), req.Status), nil
+	}
+
+	// when resuming from a key, the validators ranked above it are counted
+	// first, which is the part of the index following the key
+	var rank uint64
+	if len(pageReq.Key) != 0 {
+		iterator := powerStore.Iterator(append(sdk.CopyBytes(pageReq.Key), 0x00), nil)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			_, match, err := getValidator(iterator.Value())
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if match {
+				rank++
+			}
+		}
+	}
+
+	pageRes, err := query.FilteredPaginate(powerStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		val, match, err := getValidator(value)
+		if err != nil || !match {
+			return false, err
+		}
+
+		rank++
+		if accumulate {
+			validators = append(validators, types.RankedValidator{Validator: val, Rank: rank})
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorsByPowerResponse{Validators: validators, Pagination: pageRes}, nil
+}
+
 // Validator queries validator info for given validator address
 func (k Querier) Validator(c context.Context, req *types.QueryValidatorRequest) (*types.QueryValidatorResponse, error) {
 	if req == nil {
@@ -174,6 +253,36 @@ func (k Querier) ValidatorUnbondingDelegations(c context.Context, req *types.Que
 	}, nil
 }
 
+// ValidatorLiquidStake queries the delegations of a validator owned by module accounts
+func (k Querier) ValidatorLiquidStake(c context.Context, req *types.QueryValidatorLiquidStakeRequest) (*types.QueryValidatorLiquidStakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	shares, amount, fraction := k.GetValidatorLiquidStake(ctx, validator)
+
+	return &types.QueryValidatorLiquidStakeResponse{
+		Shares:   shares,
+		Amount:   sdk.NewCoin(k.BondDenom(ctx), amount),
+		Fraction: fraction,
+	}, nil
+}
+
 // Delegation queries delegate info for given validator delegator pair
 func (k Querier) Delegation(c context.Context, req *types.QueryDelegationRequest) (*types.QueryDelegationResponse, error) {
 	if req == nil {
@@ -290,6 +399,56 @@ func (k Querier) DelegatorDelegations(c context.Context, req *types.QueryDelegat
 
 }
 
+// DelegatorPositions queries all delegations of a given delegator address
+// along with the info of their validators
+func (k Querier) DelegatorPositions(c context.Context, req *types.QueryDelegatorPositionsRequest) (*types.QueryDelegatorPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	var positions []types.DelegatorPosition
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	store := ctx.KVStore(k.storeKey)
+	delStore := prefix.NewStore(store, types.GetDelegationsKey(delAddr))
+	pageRes, err := query.Paginate(delStore, req.Pagination, func(key []byte, value []byte) error {
+		delegation, err := types.UnmarshalDelegation(k.cdc, value)
+		if err != nil {
+			return err
+		}
+
+		validator, found := k.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return types.ErrNoValidatorFound
+		}
+
+		positions = append(positions, types.DelegatorPosition{
+			ValidatorAddress: validator.OperatorAddress,
+			Moniker:          validator.Description.Moniker,
+			Status:           validator.Status,
+			Jailed:           validator.Jailed,
+			Commission:       validator.Commission,
+			Shares:           delegation.Shares,
+			Balance:          sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt()),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorPositionsResponse{Positions: positions, Pagination: pageRes}, nil
+}
+
 // DelegatorValidator queries validator info for given delegator validator pair
 func (k Querier) DelegatorValidator(c context.Context, req *types.QueryDelegatorValidatorRequest) (*types.QueryDelegatorValidatorResponse, error) {
 	if req == nil {
@@ -354,7 +513,10 @@ func (k Querier) DelegatorUnbondingDelegations(c context.Context, req *types.Que
 	}
 
 	return &types.QueryDelegatorUnbondingDelegationsResponse{
-		UnbondingResponses: unbondingDelegations, Pagination: pageRes}, nil
+		UnbondingResponses: unbondingDelegations,
+		Pagination:         pageRes,
+		Totals:             k.GetDelegatorUnbondingTotals(ctx, delAddr),
+	}, nil
 }
 
 // HistoricalInfo queries the historical info for given height
@@ -367,14 +529,75 @@ func (k Querier) HistoricalInfo(c context.Context, req *types.QueryHistoricalInf
 		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	hi, found := k.GetHistoricalInfo(ctx, req.Height)
-	if !found {
-		return nil, status.Errorf(codes.NotFound, "historical info for height %d not found", req.Height)
+	hi, err := k.getHistoricalInfo(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ValidatorAddr != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		val, found := hi.GetValidator(valAddr)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found in historical info for height %d", req.ValidatorAddr, req.Height)
+		}
+		hi.Valset = []types.Validator{val}
 	}
 
 	return &types.QueryHistoricalInfoResponse{Hist: &hi}, nil
 }
 
+// HistoricalValidator queries a validator as stored in the historical info for given height
+func (k Querier) HistoricalValidator(c context.Context, req *types.QueryHistoricalValidatorRequest) (*types.QueryHistoricalValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	hi, err := k.getHistoricalInfo(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	val, found := hi.GetValidator(valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found in historical info for height %d", req.ValidatorAddr, req.Height)
+	}
+
+	return &types.QueryHistoricalValidatorResponse{
+		Validator: val,
+		Power:     val.ConsensusPower(k.PowerReduction(ctx)),
+	}, nil
+}
+
+func (k Querier) getHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, error) {
+	hi, found := k.GetHistoricalInfo(ctx, height)
+	if !found {
+		if k.IsHistoricalInfoPruned(ctx, height) {
+			return hi, sdkerrors.Wrapf(types.ErrHistoricalInfoPruned, "height %d is outside of the %d retained historical entries", height, k.HistoricalEntries(ctx))
+		}
+
+		return hi, status.Errorf(codes.NotFound, "historical info for height %d not found", height)
+	}
+
+	return hi, nil
+}
+
 // Redelegations queries redelegations of given address
 func (k Querier) Redelegations(c context.Context, req *types.QueryRedelegationsRequest) (*types.QueryRedelegationsResponse, error) {
 	if req == nil {
@@ -392,8 +615,12 @@ func (k Querier) Redelegations(c context.Context, req *types.QueryRedelegationsR
 		redels, err = queryRedelegation(ctx, k, req)
 	case req.DelegatorAddr == "" && req.SrcValidatorAddr != "" && req.DstValidatorAddr == "":
 		redels, pageRes, err = queryRedelegationsFromSrcValidator(store, k, req)
-	default:
+	case req.DelegatorAddr != "" && req.SrcValidatorAddr == "" && req.DstValidatorAddr != "":
+		redels, pageRes, err = queryDelegatorRedelegationsToDstValidator(store, k, req)
+	case req.DelegatorAddr != "":
 		redels, pageRes, err = queryAllRedelegations(store, k, req)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "delegator address or source validator address must be set")
 	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -521,13 +748,51 @@ func queryRedelegationsFromSrcValidator(store sdk.KVStore, k Querier, req *types
 	return redels, res, err
 }
 
+func queryDelegatorRedelegationsToDstValidator(store sdk.KVStore, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, res *query.PageResponse, err error) {
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.DstValidatorAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delPrefix := types.GetREDsByDelToValDstIndexKey(delAddr, valAddr)
+	redStore := prefix.NewStore(store, delPrefix)
+	res, err = query.Paginate(redStore, req.Pagination, func(key []byte, value []byte) error {
+		storeKey := types.GetREDKeyFromValDstIndexKey(append(delPrefix, key...))
+		storeValue := store.Get(storeKey)
+		red, err := types.UnmarshalRED(k.cdc, storeValue)
+		if err != nil {
+			return err
+		}
+		redels = append(redels, red)
+		return nil
+	})
+
+	return redels, res, err
+}
+
+// queryAllRedelegations iterates the redelegations of a delegator, optionally
+// narrowed down to a single source validator.
 func queryAllRedelegations(store sdk.KVStore, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, res *query.PageResponse, err error) {
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	redStore := prefix.NewStore(store, types.GetREDsKey(delAddr))
+	redPrefix := types.GetREDsKey(delAddr)
+	if req.SrcValidatorAddr != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.SrcValidatorAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+		redPrefix = append(redPrefix, address.MustLengthPrefix(valAddr)...)
+	}
+
+	redStore := prefix.NewStore(store, redPrefix)
 	res, err = query.Paginate(redStore, req.Pagination, func(key []byte, value []byte) error {
 		redelegation, err := types.UnmarshalRED(k.cdc, value)
 		if err != nil {
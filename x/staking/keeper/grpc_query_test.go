@@ -6,10 +6,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -86,6 +90,81 @@ func (suite *KeeperTestSuite) TestGRPCQueryValidators() {
 	}
 }
 
+func TestGRPCQueryValidatorsByPower(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxValidators = 3
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the genesis validator has the lowest power and is unbonding once the
+	// other validators are created
+	genesisVals := app.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, genesisVals, 1)
+
+	// three validators share the highest power, they are ordered by ascending
+	// operator address
+	powers := []int64{10, 20, 20, 5, 20, 15}
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, len(powers), app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	valAddrs = append(valAddrs, genesisVals[0].GetOperator())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for i, power := range powers {
+		tstaking.CreateValidatorWithValPower(valAddrs[i], PKs[i], power, true)
+	}
+
+	// jailed validators are not in the power index
+	jailed, found := app.StakingKeeper.GetValidator(ctx, valAddrs[5])
+	require.True(t, found)
+	consAddr, err := jailed.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Jail(ctx, consAddr)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	queryByPower := func(req *types.QueryValidatorsByPowerRequest) *types.QueryValidatorsByPowerResponse {
+		res, err := querier.ValidatorsByPower(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		return res
+	}
+	requireValidators := func(res *types.QueryValidatorsByPowerResponse, expected []int, firstRank uint64) {
+		require.Len(t, res.Validators, len(expected))
+		for i, idx := range expected {
+			require.Equal(t, valAddrs[idx].String(), res.Validators[i].Validator.OperatorAddress)
+			require.Equal(t, firstRank+uint64(i), res.Validators[i].Rank)
+		}
+	}
+
+	res := queryByPower(&types.QueryValidatorsByPowerRequest{})
+	requireValidators(res, []int{1, 2, 4, 0, 3, 6}, 1)
+	require.Equal(t, uint64(6), res.Pagination.Total)
+
+	// the ranks carry over pages, both with keys and offsets
+	res = queryByPower(&types.QueryValidatorsByPowerRequest{Pagination: &query.PageRequest{Limit: 2}})
+	requireValidators(res, []int{1, 2}, 1)
+	nextKey := res.Pagination.NextKey
+	res = queryByPower(&types.QueryValidatorsByPowerRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 2}})
+	requireValidators(res, []int{4, 0}, 3)
+	res = queryByPower(&types.QueryValidatorsByPowerRequest{Pagination: &query.PageRequest{Offset: 3, Limit: 2}})
+	requireValidators(res, []int{0, 3}, 4)
+
+	// the ranks are computed among the validators matching the status
+	res = queryByPower(&types.QueryValidatorsByPowerRequest{Status: types.Bonded.String()})
+	requireValidators(res, []int{1, 2, 4}, 1)
+	res = queryByPower(&types.QueryValidatorsByPowerRequest{Status: types.Unbonded.String(), Pagination: &query.PageRequest{Offset: 1}})
+	requireValidators(res, []int{3}, 2)
+
+	// a key stays valid when the power of other validators changes
+	tstaking.DelegateWithPower(addrs[0], valAddrs[3], 10)
+	res = queryByPower(&types.QueryValidatorsByPowerRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 2}})
+	requireValidators(res, []int{4, 3}, 3)
+
+	_, err = querier.ValidatorsByPower(sdk.WrapSDKContext(ctx), &types.QueryValidatorsByPowerRequest{Status: "invalid"})
+	require.Error(t, err)
+	_, err = querier.ValidatorsByPower(sdk.WrapSDKContext(ctx), &types.QueryValidatorsByPowerRequest{Pagination: &query.PageRequest{Reverse: true}})
+	require.Error(t, err)
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryValidator() {
 	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
 	validator, found := app.StakingKeeper.GetValidator(ctx, vals[0].GetOperator())
@@ -347,6 +426,166 @@ func (suite *KeeperTestSuite) TestGRPCQueryDelegatorDelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryDelegatorPositions() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	addrAcc := addrs[0]
+
+	// slash the first validator so that its shares are worth less tokens
+	consAddr, err := vals[0].GetConsAddr()
+	suite.NoError(err)
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 9, sdk.NewDecWithPrec(5, 1))
+	slashedVal, found := app.StakingKeeper.GetValidator(ctx, vals[0].GetOperator())
+	suite.True(found)
+	slashedDel, found := app.StakingKeeper.GetDelegation(ctx, addrAcc, slashedVal.GetOperator())
+	suite.True(found)
+	var req *types.QueryDelegatorPositionsRequest
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		onSuccess func(response *types.QueryDelegatorPositionsResponse)
+		expErr    bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryDelegatorPositionsRequest{}
+			},
+			func(response *types.QueryDelegatorPositionsResponse) {},
+			true,
+		},
+		{
+			"valid request with no delegations",
+			func() {
+				req = &types.QueryDelegatorPositionsRequest{DelegatorAddr: addrs[4].String()}
+			},
+			func(response *types.QueryDelegatorPositionsResponse) {
+				suite.Equal(uint64(0), response.Pagination.Total)
+				suite.Len(response.Positions, 0)
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryDelegatorPositionsRequest{DelegatorAddr: addrAcc.String(),
+					Pagination: &query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(response *types.QueryDelegatorPositionsResponse) {
+				suite.Equal(uint64(2), response.Pagination.Total)
+				suite.Len(response.Positions, 1)
+
+				position := response.Positions[0]
+				suite.Equal(slashedVal.OperatorAddress, position.ValidatorAddress)
+				suite.Equal(slashedVal.Description.Moniker, position.Moniker)
+				suite.Equal(types.Bonded, position.Status)
+				suite.False(position.Jailed)
+				suite.Equal(slashedVal.Commission, position.Commission)
+				suite.Equal(slashedDel.Shares, position.Shares)
+
+				// the balance uses the exchange rate of the slashed validator
+				expBalance := slashedVal.TokensFromShares(slashedDel.Shares).TruncateInt()
+				suite.True(expBalance.LT(slashedDel.Shares.TruncateInt()))
+				suite.Equal(sdk.NewCoin(sdk.DefaultBondDenom, expBalance), position.Balance)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.DelegatorPositions(gocontext.Background(), req)
+			if tc.expErr {
+				suite.Error(err)
+			} else {
+				suite.NoError(err)
+				tc.onSuccess(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorLiquidStake() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+
+	// delegate to the first validator from a module account
+	moduleAcc := authtypes.NewEmptyModuleAccount("liquidstaking")
+	app.AccountKeeper.SetModuleAccount(ctx, moduleAcc)
+	bondAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 9)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))
+	suite.NoError(banktestutil.FundAccount(app.BankKeeper, ctx, moduleAcc.GetAddress(), coins))
+	_, err := app.StakingKeeper.Delegate(ctx, moduleAcc.GetAddress(), bondAmt, types.Unbonded, vals[0], true)
+	suite.NoError(err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, vals[0].GetOperator())
+	suite.True(found)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, moduleAcc.GetAddress(), vals[0].GetOperator())
+	suite.True(found)
+	var req *types.QueryValidatorLiquidStakeRequest
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		onSuccess func(response *types.QueryValidatorLiquidStakeResponse)
+		expErr    bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryValidatorLiquidStakeRequest{}
+			},
+			func(response *types.QueryValidatorLiquidStakeResponse) {},
+			true,
+		},
+		{
+			"invalid validator",
+			func() {
+				req = &types.QueryValidatorLiquidStakeRequest{ValidatorAddr: sdk.ValAddress(suite.addrs[4]).String()}
+			},
+			func(response *types.QueryValidatorLiquidStakeResponse) {},
+			true,
+		},
+		{
+			"valid request with no liquid stake",
+			func() {
+				req = &types.QueryValidatorLiquidStakeRequest{ValidatorAddr: vals[1].OperatorAddress}
+			},
+			func(response *types.QueryValidatorLiquidStakeResponse) {
+				suite.True(response.Shares.IsZero())
+				suite.Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), response.Amount)
+				suite.True(response.Fraction.IsZero())
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				req = &types.QueryValidatorLiquidStakeRequest{ValidatorAddr: vals[0].OperatorAddress}
+			},
+			func(response *types.QueryValidatorLiquidStakeResponse) {
+				suite.Equal(delegation.Shares, response.Shares)
+				suite.Equal(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt), response.Amount)
+				suite.Equal(delegation.Shares.Quo(validator.DelegatorShares), response.Fraction)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.ValidatorLiquidStake(gocontext.Background(), req)
+			if tc.expErr {
+				suite.Error(err)
+			} else {
+				suite.NoError(err)
+				tc.onSuccess(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryValidatorDelegations() {
 	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
 	addrAcc := addrs[0]
@@ -620,6 +859,102 @@ func (suite *KeeperTestSuite) TestGRPCQueryHistoricalInfo() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryHistoricalValidator() {
+	app, ctx, addrs, vals := suite.app, suite.ctx, suite.addrs, suite.vals
+	valAddr := vals[0].GetOperator()
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.HistoricalEntries = 2
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// commit heights 6 to 8, adding one unit of power to the validator each block
+	for height := int64(6); height <= 8; height++ {
+		ctx = ctx.WithBlockHeader(tmproto.Header{ChainID: "HelloChain", Height: height})
+
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		suite.True(found)
+		_, err := app.StakingKeeper.Delegate(ctx, addrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 1), types.Unbonded, validator, true)
+		suite.NoError(err)
+		applyValidatorSetUpdates(suite.T(), ctx, app.StakingKeeper, -1)
+
+		app.StakingKeeper.TrackHistoricalInfo(ctx)
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: app.StakingKeeper})
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// heights within the retained window return the validator as of that height
+	for height, power := range map[int64]int64{7: 11, 8: 12} {
+		res, err := queryClient.HistoricalValidator(gocontext.Background(), &types.QueryHistoricalValidatorRequest{
+			Height: height, ValidatorAddr: valAddr.String(),
+		})
+		suite.NoError(err)
+		suite.Equal(valAddr.String(), res.Validator.OperatorAddress)
+		suite.Equal(power, res.Power)
+		suite.Equal(app.StakingKeeper.TokensFromConsensusPower(ctx, power), res.Validator.Tokens)
+		suite.Equal(vals[0].Commission, res.Validator.Commission)
+
+		// the historical info can be filtered down to the same validator
+		hiRes, err := queryClient.HistoricalInfo(gocontext.Background(), &types.QueryHistoricalInfoRequest{
+			Height: height, ValidatorAddr: valAddr.String(),
+		})
+		suite.NoError(err)
+		suite.Equal(height, hiRes.Hist.Header.Height)
+		suite.Require().Len(hiRes.Hist.Valset, 1)
+		suite.True(res.Validator.Equal(&hiRes.Hist.Valset[0]))
+	}
+
+	testCases := []struct {
+		msg    string
+		req    *types.QueryHistoricalValidatorRequest
+		expErr error
+	}{
+		{
+			"empty request",
+			&types.QueryHistoricalValidatorRequest{},
+			nil,
+		},
+		{
+			"invalid validator address",
+			&types.QueryHistoricalValidatorRequest{Height: 8, ValidatorAddr: "invalid"},
+			nil,
+		},
+		{
+			"validator not in the historical validator set",
+			&types.QueryHistoricalValidatorRequest{Height: 8, ValidatorAddr: sdk.ValAddress(addrs[4]).String()},
+			nil,
+		},
+		{
+			"pruned height",
+			&types.QueryHistoricalValidatorRequest{Height: 6, ValidatorAddr: valAddr.String()},
+			types.ErrHistoricalInfoPruned,
+		},
+		{
+			"future height",
+			&types.QueryHistoricalValidatorRequest{Height: 9, ValidatorAddr: valAddr.String()},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.HistoricalValidator(gocontext.Background(), tc.req)
+			suite.Error(err)
+			suite.Nil(res)
+			if tc.expErr != nil {
+				suite.ErrorIs(err, tc.expErr)
+			} else {
+				suite.NotErrorIs(err, types.ErrHistoricalInfoPruned)
+			}
+		})
+	}
+
+	// the historical info query reports pruned heights the same way
+	_, err := queryClient.HistoricalInfo(gocontext.Background(), &types.QueryHistoricalInfoRequest{Height: 6})
+	suite.ErrorIs(err, types.ErrHistoricalInfoPruned)
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryRedelegations() {
 	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
 
@@ -693,6 +1028,33 @@ func (suite *KeeperTestSuite) TestGRPCQueryRedelegations() {
 			true,
 			false,
 		},
+		{
+			"request redelegations with delegatoraddr and destValAddr",
+			func() {
+				req = &types.QueryRedelegationsRequest{
+					DelegatorAddr: addrAcc1.String(), DstValidatorAddr: val2.OperatorAddress,
+					Pagination: &query.PageRequest{}}
+			},
+			true,
+			false,
+		},
+		{
+			"request redelegations with delegatoraddr and non existent sourceValAddr",
+			func() {
+				req = &types.QueryRedelegationsRequest{
+					DelegatorAddr: addrAcc1.String(), SrcValidatorAddr: val3.String()}
+			},
+			false,
+			false,
+		},
+		{
+			"request redelegations with destValAddr only",
+			func() {
+				req = &types.QueryRedelegationsRequest{DstValidatorAddr: val2.OperatorAddress}
+			},
+			false,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -717,6 +1079,113 @@ func (suite *KeeperTestSuite) TestGRPCQueryRedelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryDelegatorRedelegationsPagination() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	delAddr := addrs[2]
+	val1, val2 := vals[0], vals[1]
+	val3 := teststaking.NewValidator(suite.T(), sdk.ValAddress(addrs[3]), PKs[3])
+	app.StakingKeeper.SetValidator(ctx, val3)
+
+	delAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	_, err := app.StakingKeeper.Delegate(ctx, delAddr, delAmount, types.Unbonded, val1, true)
+	suite.NoError(err)
+	applyValidatorSetUpdates(suite.T(), ctx, app.StakingKeeper, -1)
+
+	rdAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	for _, dst := range []types.Validator{val2, val3} {
+		_, err = app.StakingKeeper.BeginRedelegation(ctx, delAddr, val1.GetOperator(), dst.GetOperator(), rdAmount.ToDec())
+		suite.NoError(err)
+	}
+
+	// page through the redelegations of the delegator without naming any validator
+	var (
+		nextKey []byte
+		dsts    []string
+	)
+	for {
+		res, err := queryClient.Redelegations(gocontext.Background(), &types.QueryRedelegationsRequest{
+			DelegatorAddr: delAddr.String(),
+			Pagination:    &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.RedelegationResponses, 1)
+		dsts = append(dsts, res.RedelegationResponses[0].Redelegation.ValidatorDstAddress)
+
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	suite.ElementsMatch([]string{val2.OperatorAddress, val3.OperatorAddress}, dsts)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryDelegatorUnbondingTotals() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	delAddr := addrs[2]
+	val3 := teststaking.NewValidator(suite.T(), sdk.ValAddress(addrs[3]), PKs[3])
+	app.StakingKeeper.SetValidator(ctx, val3)
+	validators := []types.Validator{vals[0], vals[1], val3}
+
+	delAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	for _, val := range validators {
+		_, err := app.StakingKeeper.Delegate(ctx, delAddr, delAmount, types.Unbonded, val, true)
+		suite.NoError(err)
+	}
+	applyValidatorSetUpdates(suite.T(), ctx, app.StakingKeeper, -1)
+
+	// the first validator gets several unbonding entries
+	unbondAmounts := [][]int64{{1, 2}, {3}, {1}}
+	expTotals := map[string]sdk.Int{}
+	expTotal := sdk.ZeroInt()
+	for i, amounts := range unbondAmounts {
+		valAddr := validators[i].GetOperator()
+		expTotals[valAddr.String()] = sdk.ZeroInt()
+		for j, power := range amounts {
+			ctx = ctx.WithBlockHeight(int64(j + 1))
+			amt := app.StakingKeeper.TokensFromConsensusPower(ctx, power)
+			_, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, amt.ToDec())
+			suite.NoError(err)
+			expTotals[valAddr.String()] = expTotals[valAddr.String()].Add(amt)
+			expTotal = expTotal.Add(amt)
+		}
+	}
+
+	// the totals do not depend on the requested page
+	var (
+		nextKey []byte
+		seen    []string
+	)
+	for {
+		res, err := queryClient.DelegatorUnbondingDelegations(gocontext.Background(), &types.QueryDelegatorUnbondingDelegationsRequest{
+			DelegatorAddr: delAddr.String(),
+			Pagination:    &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.UnbondingResponses, 1)
+		seen = append(seen, res.UnbondingResponses[0].ValidatorAddress)
+
+		suite.True(expTotal.Equal(res.Totals.Total))
+		suite.Require().Len(res.Totals.Validators, len(validators))
+		for _, valTotal := range res.Totals.Validators {
+			suite.True(expTotals[valTotal.ValidatorAddress].Equal(valTotal.Balance), valTotal.ValidatorAddress)
+		}
+
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	suite.Len(seen, len(validators))
+
+	// a delegator without unbonding delegations has zero totals
+	res, err := queryClient.DelegatorUnbondingDelegations(gocontext.Background(), &types.QueryDelegatorUnbondingDelegationsRequest{
+		DelegatorAddr: addrs[4].String(),
+	})
+	suite.Require().NoError(err)
+	suite.True(res.Totals.Total.IsZero())
+	suite.Empty(res.Totals.Validators)
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryValidatorUnbondingDelegations() {
 	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
 	addrAcc1, _ := addrs[0], addrs[1]
@@ -34,6 +34,12 @@ func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
 	store.Delete(key)
 }
 
+// IsHistoricalInfoPruned returns whether the given height is below the window
+// of historical info retained as per the HistoricalEntries param.
+func (k Keeper) IsHistoricalInfoPruned(ctx sdk.Context, height int64) bool {
+	return height <= ctx.BlockHeight()-int64(k.HistoricalEntries(ctx))
+}
+
 // IterateHistoricalInfo provides an interator over all stored HistoricalInfo
 //  objects. For each HistoricalInfo object, cb will be called. If the cb returns
 // true, the iterator will close and stop.
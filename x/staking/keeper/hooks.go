@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -87,3 +88,19 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 	}
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate - call hook if registered
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if k.hooks != nil {
+		return k.hooks.AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey)
+	}
+	return nil
+}
+
+// AfterUnbondingInitiated - call hook if registered
+func (k Keeper) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterUnbondingInitiated(ctx, id)
+	}
+	return nil
+}
@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "liquid-shares",
+		LiquidSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -38,7 +40,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return LiquidSharesInvariant(k)(ctx)
 	}
 }
 
@@ -184,3 +191,48 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// LiquidSharesInvariant checks that the liquid shares of each validator add up
+// to the shares of its delegations owned by module accounts, and thus never
+// exceed its total delegator shares nor amount to more than its tokens.
+func LiquidSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validators := k.GetAllValidators(ctx)
+		for _, validator := range validators {
+			liquidShares, amount, _ := k.GetValidatorLiquidStake(ctx, validator)
+			totalLiquidShares := sdk.ZeroDec()
+
+			delegations := k.GetValidatorDelegations(ctx, validator.GetOperator())
+			for _, delegation := range delegations {
+				if k.IsModuleAccount(ctx, delegation.GetDelegatorAddr()) {
+					totalLiquidShares = totalLiquidShares.Add(delegation.Shares)
+				}
+			}
+
+			if !liquidShares.Equal(totalLiquidShares) {
+				broken = true
+				msg += fmt.Sprintf("broken liquid shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator liquid shares: %v\n"+
+					"\tsum of module account Delegator.Shares: %v\n",
+					validator.OperatorAddress, liquidShares, totalLiquidShares)
+			}
+
+			if liquidShares.GT(validator.DelegatorShares) || amount.GT(validator.Tokens) {
+				broken = true
+				msg += fmt.Sprintf("liquid stake exceeds validator delegations:\n"+
+					"\tvalidator: %s\n"+
+					"\tliquid shares: %v, validator.DelegatorShares: %v\n"+
+					"\tliquid amount: %v, validator.Tokens: %v\n",
+					validator.OperatorAddress, liquidShares, validator.DelegatorShares, amount, validator.Tokens)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "liquid shares", msg), broken
+	}
+}
@@ -20,12 +20,13 @@ var _ types.DelegationSet = Keeper{}
 
 // keeper of the staking store
 type Keeper struct {
-	storeKey   storetypes.StoreKey
-	cdc        codec.BinaryCodec
-	authKeeper types.AccountKeeper
-	bankKeeper types.BankKeeper
-	hooks      types.StakingHooks
-	paramstore paramtypes.Subspace
+	storeKey    storetypes.StoreKey
+	cdc         codec.BinaryCodec
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
+	hooks       types.StakingHooks
+	paramstore  paramtypes.Subspace
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -73,6 +74,19 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	return k
 }
 
+// SetDistributionKeeper sets the distribution keeper funded with the slashed
+// tokens when the SlashFundCommunityPool param is enabled. It is set after the
+// keeper is created as the distribution keeper depends on the staking keeper.
+func (k *Keeper) SetDistributionKeeper(dk types.DistributionKeeper) *Keeper {
+	if k.distrKeeper != nil {
+		panic("cannot set distribution keeper twice")
+	}
+
+	k.distrKeeper = dk
+
+	return k
+}
+
 // Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
@@ -94,3 +108,27 @@ func (k Keeper) SetLastTotalPower(ctx sdk.Context, power sdk.Int) {
 	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: power})
 	store.Set(types.LastTotalPowerKey, bz)
 }
+
+// GetLastMinCommissionRate returns the minimum commission rate last enforced
+// on all validators.
+func (k Keeper) GetLastMinCommissionRate(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastMinCommissionRateKey)
+
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetLastMinCommissionRate sets the minimum commission rate last enforced on
+// all validators.
+func (k Keeper) SetLastMinCommissionRate(ctx sdk.Context, rate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: rate})
+	store.Set(types.LastMinCommissionRateKey, bz)
+}
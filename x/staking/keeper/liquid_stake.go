@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorLiquidShares returns the delegator shares of a validator owned by
+// module accounts.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator owned by
+// module accounts. The record is removed once no shares are left.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// IsModuleAccount returns true if the address belongs to a module account.
+func (k Keeper) IsModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.authKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
+
+// updateValidatorLiquidShares adds the given shares, negative when removing
+// them, to the liquid shares of a validator if the delegator is a module
+// account.
+func (k Keeper) updateValidatorLiquidShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	if !k.IsModuleAccount(ctx, delAddr) {
+		return
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(shares))
+}
+
+// GetValidatorLiquidStake returns the delegator shares of a validator owned by
+// module accounts, along with the tokens they are worth and the fraction of the
+// validator's delegator shares they represent.
+func (k Keeper) GetValidatorLiquidStake(ctx sdk.Context, validator types.Validator) (shares sdk.Dec, amount sdk.Int, fraction sdk.Dec) {
+	shares = k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	amount = validator.TokensFromShares(shares).TruncateInt()
+
+	fraction = sdk.ZeroDec()
+	if validator.DelegatorShares.IsPositive() {
+		fraction = shares.Quo(validator.DelegatorShares)
+	}
+
+	return shares, amount, fraction
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupLiquidStake creates two bonded validators, along with a funded module
+// account and a funded regular account to delegate to them.
+func setupLiquidStake(t *testing.T) (*simapp.SimApp, sdk.Context, *teststaking.Helper, sdk.AccAddress, sdk.AccAddress, []sdk.ValAddress) {
+	_, app, ctx := createTestInput(t)
+
+	balance := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, balance)
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:2])
+
+	moduleAcc := authtypes.NewEmptyModuleAccount("liquidstaking")
+	app.AccountKeeper.SetModuleAccount(ctx, moduleAcc)
+	coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), balance))
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, moduleAcc.GetAddress(), coins))
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 10, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], PKs[1], 10, true)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 2)
+
+	return app, ctx, tstaking, moduleAcc.GetAddress(), addrs[2], valAddrs
+}
+
+func requireLiquidSharesInvariant(t *testing.T, ctx sdk.Context, k keeper.Keeper) {
+	msg, broken := keeper.LiquidSharesInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestValidatorLiquidShares(t *testing.T) {
+	app, ctx, tstaking, moduleAddr, userAddr, valAddrs := setupLiquidStake(t)
+	k := app.StakingKeeper
+
+	// delegations from regular accounts are not tracked
+	tstaking.Delegate(userAddr, valAddrs[0], k.TokensFromConsensusPower(ctx, 10))
+	require.True(t, k.GetValidatorLiquidShares(ctx, valAddrs[0]).IsZero())
+
+	tstaking.Delegate(moduleAddr, valAddrs[0], k.TokensFromConsensusPower(ctx, 20))
+	delegation, found := k.GetDelegation(ctx, moduleAddr, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, k.GetValidatorLiquidShares(ctx, valAddrs[0]))
+	requireLiquidSharesInvariant(t, ctx, k)
+
+	validator := tstaking.CheckValidator(valAddrs[0], types.Bonded, false)
+	shares, amount, fraction := k.GetValidatorLiquidStake(ctx, validator)
+	require.Equal(t, delegation.Shares, shares)
+	require.Equal(t, k.TokensFromConsensusPower(ctx, 20), amount)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), fraction)
+
+	// undelegate part of the module account's delegation
+	tstaking.Undelegate(moduleAddr, valAddrs[0], k.TokensFromConsensusPower(ctx, 5), true)
+	delegation, found = k.GetDelegation(ctx, moduleAddr, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, k.GetValidatorLiquidShares(ctx, valAddrs[0]))
+	requireLiquidSharesInvariant(t, ctx, k)
+
+	// redelegate part of it to the second validator
+	_, err := k.BeginRedelegation(ctx, moduleAddr, valAddrs[0], valAddrs[1], k.TokensFromConsensusPower(ctx, 5).ToDec())
+	require.NoError(t, err)
+	delegation, found = k.GetDelegation(ctx, moduleAddr, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, k.GetValidatorLiquidShares(ctx, valAddrs[0]))
+	dstDelegation, found := k.GetDelegation(ctx, moduleAddr, valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, dstDelegation.Shares, k.GetValidatorLiquidShares(ctx, valAddrs[1]))
+	requireLiquidSharesInvariant(t, ctx, k)
+
+	// undelegate the rest of the module account's delegation
+	tstaking.Undelegate(moduleAddr, valAddrs[0], k.TokensFromConsensusPower(ctx, 10), true)
+	require.True(t, k.GetValidatorLiquidShares(ctx, valAddrs[0]).IsZero())
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.GetValidatorLiquidSharesKey(valAddrs[0])))
+	requireLiquidSharesInvariant(t, ctx, k)
+}
+
+func TestValidatorLiquidStakeAfterSlash(t *testing.T) {
+	app, ctx, tstaking, moduleAddr, _, valAddrs := setupLiquidStake(t)
+	k := app.StakingKeeper
+
+	tstaking.Delegate(moduleAddr, valAddrs[0], k.TokensFromConsensusPower(ctx, 10))
+	validator := tstaking.CheckValidator(valAddrs[0], types.Bonded, false)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	k.Slash(ctx, consAddr, ctx.BlockHeight(), 20, sdk.NewDecWithPrec(5, 1))
+
+	// the shares are unchanged but are worth half as many tokens
+	validator = tstaking.CheckValidator(valAddrs[0], types.Bonded, false)
+	shares, amount, fraction := k.GetValidatorLiquidStake(ctx, validator)
+	delegation, found := k.GetDelegation(ctx, moduleAddr, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, shares)
+	require.Equal(t, k.TokensFromConsensusPower(ctx, 5), amount)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), fraction)
+	requireLiquidSharesInvariant(t, ctx, k)
+}
+
+func TestLiquidSharesInvariant(t *testing.T) {
+	app, ctx, tstaking, moduleAddr, _, valAddrs := setupLiquidStake(t)
+	k := app.StakingKeeper
+
+	tstaking.Delegate(moduleAddr, valAddrs[0], k.TokensFromConsensusPower(ctx, 10))
+	requireLiquidSharesInvariant(t, ctx, k)
+
+	// liquid shares exceeding the validator's delegator shares break the invariant
+	validator := tstaking.CheckValidator(valAddrs[0], types.Bonded, false)
+	k.SetValidatorLiquidShares(ctx, valAddrs[0], validator.DelegatorShares.Add(sdk.OneDec()))
+	_, broken := keeper.LiquidSharesInvariant(k)(ctx)
+	require.True(t, broken)
+
+	// so do liquid shares not matching the module account's delegation
+	k.SetValidatorLiquidShares(ctx, valAddrs[0], sdk.OneDec())
+	_, broken = keeper.LiquidSharesInvariant(k)(ctx)
+	require.True(t, broken)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetPendingMinSelfDelegationCheck marks a validator to have its
+// self-delegation checked against its minimum at the end of the block.
+func (k Keeper) SetPendingMinSelfDelegationCheck(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingMinSelfDelegationKey(valAddr), []byte{})
+}
+
+// JailValidatorsBelowMinSelfDelegation jails the validators marked during the
+// current block whose self-delegation is below their minimum self-delegation,
+// and clears the marks.
+func (k Keeper) JailValidatorsBelowMinSelfDelegation(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var valAddrs []sdk.ValAddress
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingMinSelfDelegationKey)
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, iterator.Key()[2:]) // remove prefix bytes and address length
+	}
+	iterator.Close()
+
+	for _, valAddr := range valAddrs {
+		store.Delete(types.GetPendingMinSelfDelegationKey(valAddr))
+
+		// the validator may have been removed, or jailed for the infraction
+		// it was slashed for, since it was marked
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found || validator.Jailed {
+			continue
+		}
+
+		selfDelegation := sdk.ZeroInt()
+		if delegation, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr); found {
+			selfDelegation = validator.TokensFromShares(delegation.Shares).TruncateInt()
+		}
+
+		if selfDelegation.LT(validator.MinSelfDelegation) {
+			k.jailBelowMinSelfDelegation(ctx, validator, selfDelegation, types.AttributeValueSlash)
+		}
+	}
+}
+
+// jailBelowMinSelfDelegation jails a validator whose self-delegation fell
+// below its minimum, the reason being the operation which lowered it.
+func (k Keeper) jailBelowMinSelfDelegation(ctx sdk.Context, validator types.Validator, selfDelegation sdk.Int, reason string) {
+	k.jailValidator(ctx, validator)
+
+	k.Logger(ctx).Info(
+		"validator jailed for self-delegation below minimum",
+		"validator", validator.OperatorAddress,
+		"self_delegation", selfDelegation.String(),
+		"min_self_delegation", validator.MinSelfDelegation.String(),
+		"reason", reason,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMinSelfDelegationJail,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeySelfDelegation, selfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupMinSelfDelegation creates a bonded validator whose self-delegation is
+// equal to its minimum self-delegation.
+func setupMinSelfDelegation(t *testing.T, enforce bool) (*simapp.SimApp, sdk.Context, *teststaking.Helper, types.Validator) {
+	_, app, ctx := createTestInput(t)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.EnforceMinSelfDelegation = enforce
+	app.StakingKeeper.SetParams(ctx, params)
+
+	selfBond := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, selfBond.MulRaw(2))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	msg := tstaking.CreateValidatorMsg(valAddr, PKs[0], selfBond)
+	msg.MinSelfDelegation = selfBond
+	_, err := tstaking.CreateValidatorWithMsg(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+
+	validator := tstaking.CheckValidator(valAddr, types.Bonded, false)
+	return app, ctx, tstaking, validator
+}
+
+func requireMinSelfDelegationJailEvent(t *testing.T, ctx sdk.Context, reason string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMinSelfDelegationJail {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyReason {
+				require.Equal(t, reason, string(attr.Value))
+				return
+			}
+		}
+	}
+
+	require.Fail(t, "no min self-delegation jail event emitted")
+}
+
+func TestSlashBelowMinSelfDelegation(t *testing.T) {
+	app, ctx, tstaking, validator := setupMinSelfDelegation(t, true)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, sdk.NewDecWithPrec(1, 1))
+
+	// the validator is only jailed at the end of the block
+	tstaking.CheckValidator(validator.GetOperator(), types.Bonded, false)
+	updates := app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(0), updates[0].Power)
+	tstaking.CheckValidator(validator.GetOperator(), types.Unbonding, true)
+	requireMinSelfDelegationJailEvent(t, ctx, types.AttributeValueSlash)
+}
+
+func TestSlashBelowMinSelfDelegationNotEnforced(t *testing.T) {
+	app, ctx, tstaking, validator := setupMinSelfDelegation(t, false)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, sdk.NewDecWithPrec(1, 1))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	tstaking.CheckValidator(validator.GetOperator(), types.Bonded, false)
+}
+
+func TestSlashAndJailBelowMinSelfDelegation(t *testing.T) {
+	app, ctx, tstaking, validator := setupMinSelfDelegation(t, true)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	// a validator jailed along with the slash is left as is
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, sdk.NewDecWithPrec(1, 1))
+	app.StakingKeeper.Jail(ctx, consAddr)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+
+	tstaking.CheckValidator(validator.GetOperator(), types.Unbonding, true)
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeMinSelfDelegationJail, event.Type)
+	}
+}
+
+func TestSelfUndelegateBelowMinSelfDelegation(t *testing.T) {
+	app, ctx, tstaking, validator := setupMinSelfDelegation(t, false)
+	valAddr := validator.GetOperator()
+
+	tstaking.Undelegate(sdk.AccAddress(valAddr), valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 4), true)
+
+	// jailed right away, regardless of the param
+	tstaking.CheckValidator(valAddr, types.Bonded, true)
+	requireMinSelfDelegationJailEvent(t, ctx, types.AttributeValueSelfUndelegation)
+
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+	tstaking.CheckValidator(valAddr, types.Unbonding, true)
+}
+
+func TestUnjailAfterMinSelfDelegationTopUp(t *testing.T) {
+	app, ctx, tstaking, validator := setupMinSelfDelegation(t, true)
+	valAddr := validator.GetOperator()
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, sdk.NewDecWithPrec(1, 1))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+	tstaking.CheckValidator(valAddr, types.Unbonding, true)
+
+	err = app.SlashingKeeper.Unjail(ctx, valAddr)
+	require.ErrorIs(t, err, slashingtypes.ErrSelfDelegationTooLowToUnjail)
+
+	tstaking.Delegate(sdk.AccAddress(valAddr), valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, valAddr))
+
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+	tstaking.CheckValidator(valAddr, types.Bonded, false)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -219,6 +220,14 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		)
 	}
 
+	bondedDelta := sdk.ZeroInt()
+	if validator.IsBonded() {
+		bondedDelta = msg.Amount.Amount
+	}
+	if err := k.ValidateValidatorPowerCap(ctx, validator, msg.Amount.Amount, bondedDelta); err != nil {
+		return nil, err
+	}
+
 	// NOTE: source funds are always unbonded
 	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
 	if err != nil {
@@ -283,6 +292,22 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// the cap only applies to existing destinations, BeginRedelegation rejects
+	// the others
+	if dstValidator, found := k.GetValidator(ctx, valDstAddr); found && !valSrcAddr.Equals(valDstAddr) {
+		bondedDelta := sdk.ZeroInt()
+		if dstValidator.IsBonded() {
+			bondedDelta = bondedDelta.Add(msg.Amount.Amount)
+		}
+		if srcValidator, found := k.GetValidator(ctx, valSrcAddr); found && srcValidator.IsBonded() {
+			bondedDelta = bondedDelta.Sub(msg.Amount.Amount)
+		}
+
+		if err := k.ValidateValidatorPowerCap(ctx, dstValidator, msg.Amount.Amount, bondedDelta); err != nil {
+			return nil, err
+		}
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -381,3 +406,236 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		CompletionTime: completionTime,
 	}, nil
 }
+
+// UndelegateAll defines a method for performing an undelegation of all the
+// delegations of a delegator
+func (k msgServer) UndelegateAll(goCtx context.Context, msg *types.MsgUndelegateAll) (*types.MsgUndelegateAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, remaining, err := k.Keeper.UndelegateAll(ctx, delegatorAddress, k.MaxUndelegateAllPositions(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(float32(len(entries)), types.ModuleName, "undelegate")
+
+	events := make(sdk.Events, 0, len(entries)+1)
+	for _, entry := range entries {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, entry.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, entry.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, entry.CompletionTime.Format(time.RFC3339)),
+		))
+	}
+	events = append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+	))
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgUndelegateAllResponse{
+		Entries:                     entries,
+		RemainingValidatorAddresses: remaining,
+	}, nil
+}
+
+// CancelUnbondingDelegation defines a method for canceling the unbonding delegation
+// and delegate back to the validator.
+func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	// the tokens are only re-bonded to a validator which is still active or
+	// on its way out of the active set
+	if validator.IsUnbonded() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "validator %s is unbonded", msg.ValidatorAddress)
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == msg.CreationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return nil, sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "no entry at height %d", msg.CreationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return nil, sdkerrors.Wrap(types.ErrNoUnbondingDelegationEntry, "unbonding delegation entry is already mature")
+	}
+
+	// the balance reflects any slashing applied to the entry since it was created
+	if entry.Balance.LT(msg.Amount.Amount) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "amount %s is greater than the unbonding delegation entry balance %s", msg.Amount.Amount, entry.Balance,
+		)
+	}
+
+	// the validator may have grown while the tokens were unbonding, they are
+	// only re-bonded within the power cap
+	bondedDelta := sdk.ZeroInt()
+	if validator.IsBonded() {
+		bondedDelta = msg.Amount.Amount
+	}
+	if err := k.ValidateValidatorPowerCap(ctx, validator, msg.Amount.Amount, bondedDelta); err != nil {
+		return nil, err
+	}
+
+	// the unbonding tokens are held by the not bonded pool, shares are
+	// computed from the current exchange rate of the validator
+	if _, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false); err != nil {
+		return nil, err
+	}
+
+	entry.Balance = entry.Balance.Sub(msg.Amount.Amount)
+	if entry.Balance.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+	} else {
+		entry.InitialBalance = entry.InitialBalance.Sub(msg.Amount.Amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgCancelUnbondingDelegationResponse{}, nil
+}
+
+// RotateConsPubKey defines a method for replacing the consensus public key of
+// a validator
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	maxRotations := k.MaxConsPubkeyRotations(ctx)
+	if maxRotations == 0 {
+		return nil, types.ErrConsPubKeyRotationDisabled
+	}
+
+	if uint32(len(k.GetValidatorConsPubKeyRotations(ctx, valAddr))) >= maxRotations {
+		return nil, sdkerrors.Wrapf(
+			types.ErrExceedingMaxConsPubKeyRotations,
+			"validator has already rotated its consensus key %d times within the unbonding period", maxRotations,
+		)
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
+		return nil, types.ErrValidatorPubKeyExists
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Validator != nil {
+		if !tmstrings.StringInSlice(pk.Type(), cp.Validator.PubKeyTypes) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// the rotation fee is burned
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		fees := sdk.NewCoins(fee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.NotBondedPoolName, fees); err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, fees); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(pk).String()),
+			sdk.NewAttribute(types.AttributeKeyKeyRotationFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(valAddr).String()),
+		),
+	})
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
@@ -53,6 +53,47 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// MaxConsPubkeyRotations - Maximum number of consensus key rotations per
+// validator within an unbonding period
+func (k Keeper) MaxConsPubkeyRotations(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxConsPubkeyRotations, &res)
+	return
+}
+
+// KeyRotationFee - Fee burned on every consensus key rotation
+func (k Keeper) KeyRotationFee(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyKeyRotationFee, &res)
+	return
+}
+
+// MaxValidatorPowerFraction - Maximum fraction of the bonded tokens a single
+// validator can be delegated up to
+func (k Keeper) MaxValidatorPowerFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxValidatorPowerFraction, &res)
+	return
+}
+
+// MaxUndelegateAllPositions - Maximum number of delegations unbonded by a
+// single MsgUndelegateAll
+func (k Keeper) MaxUndelegateAllPositions(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxUndelegateAllPositions, &res)
+	return
+}
+
+// EnforceMinSelfDelegation - Whether validators slashed below their minimum
+// self-delegation are jailed
+func (k Keeper) EnforceMinSelfDelegation(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyEnforceMinSelfDelegation, &res)
+	return
+}
+
+// SlashFundCommunityPool - Whether the slashed tokens are sent to the community
+// pool instead of being burned
+func (k Keeper) SlashFundCommunityPool(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeySlashFundCommunityPool, &res)
+	return
+}
+
 // Get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -62,6 +103,12 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
 		k.MinCommissionRate(ctx),
+		k.MaxConsPubkeyRotations(ctx),
+		k.KeyRotationFee(ctx),
+		k.MaxValidatorPowerFraction(ctx),
+		k.MaxUndelegateAllPositions(ctx),
+		k.EnforceMinSelfDelegation(ctx),
+		k.SlashFundCommunityPool(ctx),
 	)
 }
 
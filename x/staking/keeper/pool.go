@@ -41,7 +41,7 @@ func (k Keeper) burnBondedTokens(ctx sdk.Context, amt sdk.Int) error {
 
 	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
 
-	return k.bankKeeper.BurnCoins(ctx, types.BondedPoolName, coins)
+	return k.removeSlashedCoins(ctx, types.BondedPoolName, coins)
 }
 
 // burnNotBondedTokens removes coins from the not bonded pool module account
@@ -53,7 +53,18 @@ func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt sdk.Int) error {
 
 	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
 
-	return k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins)
+	return k.removeSlashedCoins(ctx, types.NotBondedPoolName, coins)
+}
+
+// removeSlashedCoins removes slashed coins from a pool module account: they
+// are sent to the community pool if that is the slash destination, and burned
+// otherwise
+func (k Keeper) removeSlashedCoins(ctx sdk.Context, poolName string, coins sdk.Coins) error {
+	if k.SlashDestination(ctx) == types.SlashDestinationCommunityPool {
+		return k.distrKeeper.FundCommunityPool(ctx, coins, k.authKeeper.GetModuleAddress(poolName))
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, poolName, coins)
 }
 
 // TotalBondedTokens total staking tokens supply which is bonded
@@ -514,6 +514,7 @@ func RedelegationsToRedelegationResponses(
 				entry.SharesDst,
 				entry.InitialBalance,
 				val.TokensFromShares(entry.SharesDst).TruncateInt(),
+				entry.UnbondingId,
 			)
 		}
 
@@ -134,15 +134,34 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		panic("invalid validator status")
 	}
 
+	// the slash may have taken the self-delegation below its minimum, which is
+	// checked at the end of the block, once the caller had the chance to jail
+	// the validator for the infraction
+	if k.EnforceMinSelfDelegation(ctx) {
+		k.SetPendingMinSelfDelegationCheck(ctx, operatorAddress)
+	}
+
 	logger.Info(
 		"validator slashed by slash factor",
 		"validator", validator.GetOperator().String(),
 		"slash_factor", slashFactor.String(),
 		"burned", tokensToBurn,
+		"destination", k.SlashDestination(ctx),
 	)
 	return tokensToBurn
 }
 
+// SlashDestination returns where the slashed tokens go: the community pool if
+// the SlashFundCommunityPool param is enabled and the distribution keeper is
+// set, and burned otherwise
+func (k Keeper) SlashDestination(ctx sdk.Context) string {
+	if k.distrKeeper != nil && k.SlashFundCommunityPool(ctx) {
+		return types.SlashDestinationCommunityPool
+	}
+
+	return types.SlashDestinationBurn
+}
+
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
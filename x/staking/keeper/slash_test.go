@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -81,7 +82,7 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 	// set an unbonding delegation with expiration timestamp (beyond which the
 	// unbonding delegation shouldn't be slashed)
 	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0,
-		time.Unix(5, 0), sdk.NewInt(10))
+		time.Unix(5, 0), sdk.NewInt(10), 0)
 
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
 
@@ -132,7 +133,7 @@ func TestSlashRedelegation(t *testing.T) {
 	// set a redelegation with an expiration timestamp beyond which the
 	// redelegation shouldn't be slashed
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
-		time.Unix(5, 0), sdk.NewInt(10), sdk.NewDec(10))
+		time.Unix(5, 0), sdk.NewInt(10), sdk.NewDec(10), 0)
 
 	app.StakingKeeper.SetRedelegation(ctx, rd)
 
@@ -265,7 +266,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// set an unbonding delegation with expiration timestamp beyond which the
 	// unbonding delegation shouldn't be slashed
 	ubdTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
-	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11, time.Unix(0, 0), ubdTokens)
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11, time.Unix(0, 0), ubdTokens, 0)
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
 
 	// slash validator for the first time
@@ -381,6 +382,78 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	require.Equal(t, validator.GetStatus(), types.Unbonding)
 }
 
+// tests the destination of the tokens slashed from the bonded and the not
+// bonded pools
+func TestSlashFundCommunityPool(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		slashFundCommunityPool bool
+		setDistrKeeper         bool
+		expDestination         string
+	}{
+		{"burned by default", false, true, types.SlashDestinationBurn},
+		{"sent to the community pool", true, true, types.SlashDestinationCommunityPool},
+		{"burned without distribution keeper", true, false, types.SlashDestinationBurn},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+			if tc.setDistrKeeper {
+				app.StakingKeeper.SetDistributionKeeper(app.DistrKeeper)
+			}
+
+			params := app.StakingKeeper.GetParams(ctx)
+			params.SlashFundCommunityPool = tc.slashFundCommunityPool
+			app.StakingKeeper.SetParams(ctx, params)
+			require.Equal(t, tc.expDestination, app.StakingKeeper.SlashDestination(ctx))
+
+			consAddr := sdk.ConsAddress(PKs[0].Address())
+			fraction := sdk.NewDecWithPrec(5, 1)
+			bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+			// an unbonding delegation contributing to the infraction
+			ubdTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+			ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11, time.Unix(0, 0), ubdTokens, 0)
+			app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+			ctx = ctx.WithBlockHeight(12)
+			bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+			notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+			distrAddr := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+			oldBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+			oldNotBonded := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount
+			oldDistr := app.BankKeeper.GetBalance(ctx, distrAddr, bondDenom).Amount
+			oldSupply := app.BankKeeper.GetSupply(ctx, bondDenom).Amount
+			oldCommunityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+			// 3 bonded and 2 unbonding tokens are slashed
+			app.StakingKeeper.Slash(ctx, consAddr, 10, 10, fraction)
+			slashedBonded := app.StakingKeeper.TokensFromConsensusPower(ctx, 3)
+			slashedNotBonded := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+			slashed := slashedBonded.Add(slashedNotBonded)
+
+			require.Equal(t, oldBonded.Sub(slashedBonded), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+			require.Equal(t, oldNotBonded.Sub(slashedNotBonded), app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount)
+
+			res, err := app.DistrKeeper.CommunityPool(sdk.WrapSDKContext(ctx), &distrtypes.QueryCommunityPoolRequest{})
+			require.NoError(t, err)
+
+			if tc.expDestination == types.SlashDestinationCommunityPool {
+				require.Equal(t, oldSupply, app.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+				require.Equal(t, oldDistr.Add(slashed), app.BankKeeper.GetBalance(ctx, distrAddr, bondDenom).Amount)
+				require.Equal(t, oldCommunityPool.Add(sdk.NewDecCoin(bondDenom, slashed)), res.Pool)
+			} else {
+				require.Equal(t, oldSupply.Sub(slashed), app.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+				require.Equal(t, oldDistr, app.BankKeeper.GetBalance(ctx, distrAddr, bondDenom).Amount)
+				require.Equal(t, oldCommunityPool, res.Pool)
+			}
+		})
+	}
+}
+
 // tests Slash at a previous height with a redelegation
 func TestSlashWithRedelegation(t *testing.T) {
 	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
@@ -391,7 +464,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	// set a redelegation
 	rdTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 6)
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11,
-		time.Unix(0, 0), rdTokens, rdTokens.ToDec())
+		time.Unix(0, 0), rdTokens, rdTokens.ToDec(), 0)
 	app.StakingKeeper.SetRedelegation(ctx, rd)
 
 	// set the associated delegation
@@ -545,7 +618,7 @@ func TestSlashBoth(t *testing.T) {
 	rdATokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 6)
 	rdA := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11,
 		time.Unix(0, 0), rdATokens,
-		rdATokens.ToDec())
+		rdATokens.ToDec(), 0)
 	app.StakingKeeper.SetRedelegation(ctx, rdA)
 
 	// set the associated delegation
@@ -556,7 +629,7 @@ func TestSlashBoth(t *testing.T) {
 	// unbonding delegation shouldn't be slashed)
 	ubdATokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
 	ubdA := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11,
-		time.Unix(0, 0), ubdATokens)
+		time.Unix(0, 0), ubdATokens, 0)
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubdA)
 
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, rdATokens.MulRaw(2)))
@@ -0,0 +1,315 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IncrementUnbondingID increments and returns a unique id for an unbonding
+// operation, i.e. an unbonding delegation entry, a redelegation entry or a
+// validator unbonding. Ids start at 1, 0 is left for the operations created
+// before ids were assigned.
+func (k Keeper) IncrementUnbondingID(ctx sdk.Context) (unbondingID uint64) {
+	unbondingID = k.GetLastUnbondingID(ctx) + 1
+	k.SetLastUnbondingID(ctx, unbondingID)
+
+	return unbondingID
+}
+
+// GetLastUnbondingID returns the id assigned to the latest unbonding operation.
+func (k Keeper) GetLastUnbondingID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UnbondingIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastUnbondingID sets the id assigned to the latest unbonding operation.
+func (k Keeper) SetLastUnbondingID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnbondingIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetUnbondingDelegationByUnbondingID indexes the unbonding delegation holding
+// the entry with the given unbonding id.
+func (k Keeper) SetUnbondingDelegationByUnbondingID(ctx sdk.Context, ubd types.UnbondingDelegation, id uint64) {
+	delAddr, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingIndexKey(id), types.GetUBDKey(delAddr, valAddr))
+}
+
+// SetRedelegationByUnbondingID indexes the redelegation holding the entry with
+// the given unbonding id.
+func (k Keeper) SetRedelegationByUnbondingID(ctx sdk.Context, red types.Redelegation, id uint64) {
+	delAddr, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+	if err != nil {
+		panic(err)
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingIndexKey(id), types.GetREDKey(delAddr, valSrcAddr, valDstAddr))
+}
+
+// SetValidatorByUnbondingID indexes the validator whose unbonding was assigned
+// the given unbonding id.
+func (k Keeper) SetValidatorByUnbondingID(ctx sdk.Context, validator types.Validator, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingIndexKey(id), types.GetValidatorKey(validator.GetOperator()))
+}
+
+// DeleteUnbondingIndex removes the mapping of a completed unbonding operation.
+func (k Keeper) DeleteUnbondingIndex(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingIndexKey(id))
+}
+
+// getUnbondingIndex returns the key of the object the unbonding id was assigned
+// to, along with its value.
+func (k Keeper) getUnbondingIndex(ctx sdk.Context, id uint64) (key, value []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	key = store.Get(types.GetUnbondingIndexKey(id))
+	if key == nil {
+		return nil, nil
+	}
+
+	return key, store.Get(key)
+}
+
+// GetUnbondingDelegationByUnbondingID returns the unbonding delegation holding
+// the entry with the given unbonding id.
+func (k Keeper) GetUnbondingDelegationByUnbondingID(ctx sdk.Context, id uint64) (ubd types.UnbondingDelegation, found bool) {
+	key, value := k.getUnbondingIndex(ctx, id)
+	if value == nil || !bytes.HasPrefix(key, types.UnbondingDelegationKey) {
+		return ubd, false
+	}
+
+	return types.MustUnmarshalUBD(k.cdc, value), true
+}
+
+// GetRedelegationByUnbondingID returns the redelegation holding the entry with
+// the given unbonding id.
+func (k Keeper) GetRedelegationByUnbondingID(ctx sdk.Context, id uint64) (red types.Redelegation, found bool) {
+	key, value := k.getUnbondingIndex(ctx, id)
+	if value == nil || !bytes.HasPrefix(key, types.RedelegationKey) {
+		return red, false
+	}
+
+	return types.MustUnmarshalRED(k.cdc, value), true
+}
+
+// GetValidatorByUnbondingID returns the validator whose unbonding was assigned
+// the given unbonding id.
+func (k Keeper) GetValidatorByUnbondingID(ctx sdk.Context, id uint64) (validator types.Validator, found bool) {
+	key, value := k.getUnbondingIndex(ctx, id)
+	if value == nil || !bytes.HasPrefix(key, types.ValidatorsKey) {
+		return validator, false
+	}
+
+	return types.MustUnmarshalValidator(k.cdc, value), true
+}
+
+// PutUnbondingOnHold prevents the unbonding operation with the given id from
+// completing until UnbondingCanComplete is called with the same id. Holds are
+// counted, so that several modules can hold the same operation, which only
+// completes once all of them have released it.
+func (k Keeper) PutUnbondingOnHold(ctx sdk.Context, id uint64) error {
+	if ubd, found := k.GetUnbondingDelegationByUnbondingID(ctx, id); found {
+		i, found := unbondingDelegationEntryIndex(ubd, id)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUnbondingNotFound, "unbonding id %d", id)
+		}
+
+		ubd.Entries[i].UnbondingOnHoldRefCount++
+		k.SetUnbondingDelegation(ctx, ubd)
+
+		return nil
+	}
+
+	if red, found := k.GetRedelegationByUnbondingID(ctx, id); found {
+		i, found := redelegationEntryIndex(red, id)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUnbondingNotFound, "unbonding id %d", id)
+		}
+
+		red.Entries[i].UnbondingOnHoldRefCount++
+		k.SetRedelegation(ctx, red)
+
+		return nil
+	}
+
+	if validator, found := k.GetValidatorByUnbondingID(ctx, id); found {
+		validator.UnbondingOnHoldRefCount++
+		k.SetValidator(ctx, validator)
+
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrUnbondingNotFound, "unbonding id %d", id)
+}
+
+// UnbondingCanComplete releases a hold put on the unbonding operation with the
+// given id. Unbonding delegation and redelegation entries which have matured
+// complete as soon as their last hold is released, while validators complete
+// their unbonding at the end of the block.
+func (k Keeper) UnbondingCanComplete(ctx sdk.Context, id uint64) error {
+	if ubd, found := k.GetUnbondingDelegationByUnbondingID(ctx, id); found {
+		return k.unbondingDelegationEntryCanComplete(ctx, ubd, id)
+	}
+
+	if red, found := k.GetRedelegationByUnbondingID(ctx, id); found {
+		return k.redelegationEntryCanComplete(ctx, red, id)
+	}
+
+	if validator, found := k.GetValidatorByUnbondingID(ctx, id); found {
+		if validator.UnbondingOnHoldRefCount <= 0 {
+			return sdkerrors.Wrapf(types.ErrUnbondingNotOnHold, "unbonding id %d", id)
+		}
+
+		validator.UnbondingOnHoldRefCount--
+		k.SetValidator(ctx, validator)
+
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrUnbondingNotFound, "unbonding id %d", id)
+}
+
+func (k Keeper) unbondingDelegationEntryCanComplete(ctx sdk.Context, ubd types.UnbondingDelegation, id uint64) error {
+	i, found := unbondingDelegationEntryIndex(ubd, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnbondingNotFound, "unbonding id %d", id)
+	}
+
+	entry := &ubd.Entries[i]
+	if !entry.OnHold() {
+		return sdkerrors.Wrapf(types.ErrUnbondingNotOnHold, "unbonding id %d", id)
+	}
+
+	entry.UnbondingOnHoldRefCount--
+	k.SetUnbondingDelegation(ctx, ubd)
+
+	// a mature entry has already left the unbonding queue, so it completes as
+	// soon as its last hold is released
+	if entry.OnHold() || !entry.IsMature(ctx.BlockHeader().Time) {
+		return nil
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	balances, err := k.CompleteUnbonding(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteUnbonding,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, ubd.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, ubd.DelegatorAddress),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) redelegationEntryCanComplete(ctx sdk.Context, red types.Redelegation, id uint64) error {
+	i, found := redelegationEntryIndex(red, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnbondingNotFound, "unbonding id %d", id)
+	}
+
+	entry := &red.Entries[i]
+	if !entry.OnHold() {
+		return sdkerrors.Wrapf(types.ErrUnbondingNotOnHold, "unbonding id %d", id)
+	}
+
+	entry.UnbondingOnHoldRefCount--
+	k.SetRedelegation(ctx, red)
+
+	// a mature entry has already left the redelegation queue, so it completes
+	// as soon as its last hold is released
+	if entry.OnHold() || !entry.IsMature(ctx.BlockHeader().Time) {
+		return nil
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+	if err != nil {
+		return err
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+	if err != nil {
+		return err
+	}
+
+	balances, err := k.CompleteRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteRedelegation,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, red.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, red.ValidatorSrcAddress),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, red.ValidatorDstAddress),
+		),
+	)
+
+	return nil
+}
+
+func unbondingDelegationEntryIndex(ubd types.UnbondingDelegation, id uint64) (int, bool) {
+	for i, entry := range ubd.Entries {
+		if entry.UnbondingId == id {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func redelegationEntryIndex(red types.Redelegation, id uint64) (int, bool) {
+	for i, entry := range red.Entries {
+		if entry.UnbondingId == id {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// unbondingHooks records the unbonding ids passed to AfterUnbondingInitiated
+// and puts the unbonding operations on hold once for each of its holders.
+type unbondingHooks struct {
+	types.MultiStakingHooks
+
+	keeper  *keeper.Keeper
+	holders int
+	err     error
+	ids     []uint64
+}
+
+func (h *unbondingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	h.ids = append(h.ids, id)
+	for i := 0; i < h.holders; i++ {
+		if err := h.keeper.PutUnbondingOnHold(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return h.err
+}
+
+// setupUnbondingHooks creates two bonded validators and a delegator with a
+// delegation to the first one, and registers unbondingHooks on the keeper.
+func setupUnbondingHooks(t *testing.T) (*simapp.SimApp, sdk.Context, *unbondingHooks, sdk.AccAddress, []sdk.ValAddress) {
+	_, app, ctx := createTestInput(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0).UTC())
+
+	hooks := &unbondingHooks{keeper: &app.StakingKeeper}
+	app.StakingKeeper.SetHooks(hooks)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, valTokens.MulRaw(10))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:2])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], PKs[0], valTokens, true)
+	tstaking.CreateValidator(valAddrs[1], PKs[1], valTokens, true)
+	tstaking.Delegate(addrs[2], valAddrs[0], valTokens)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 2)
+
+	return app, ctx, hooks, addrs[2], valAddrs
+}
+
+func redelegate(t *testing.T, ctx sdk.Context, app *simapp.SimApp, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, amount sdk.Int) {
+	_, err := app.StakingKeeper.BeginRedelegation(ctx, delAddr, valSrcAddr, valDstAddr, amount.ToDec())
+	require.NoError(t, err)
+}
+
+func TestUnbondingIDs(t *testing.T) {
+	app, ctx, hooks, delAddr, valAddrs := setupUnbondingHooks(t)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	unbondAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+
+	tstaking.Undelegate(delAddr, valAddrs[0], unbondAmt, true)
+	ubd, found := app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, delAddr.String(), ubd.DelegatorAddress)
+	require.Equal(t, uint64(1), ubd.Entries[0].UnbondingId)
+
+	redelegate(t, ctx, app, delAddr, valAddrs[0], valAddrs[1], unbondAmt)
+	red, found := app.StakingKeeper.GetRedelegationByUnbondingID(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, valAddrs[1].String(), red.ValidatorDstAddress)
+	require.Equal(t, uint64(2), red.Entries[0].UnbondingId)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Jail(ctx, consAddr)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 2)
+
+	validator, found = app.StakingKeeper.GetValidatorByUnbondingID(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, valAddrs[0], validator.GetOperator())
+	require.Equal(t, []uint64{3}, validator.UnbondingIds)
+
+	// ids are unique across the unbonding operations and passed to the hook
+	require.Equal(t, []uint64{1, 2, 3}, hooks.ids)
+	require.Equal(t, uint64(3), app.StakingKeeper.GetLastUnbondingID(ctx))
+	_, found = app.StakingKeeper.GetRedelegationByUnbondingID(ctx, 1)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetValidatorByUnbondingID(ctx, 2)
+	require.False(t, found)
+
+	// the ids are released once the operations complete
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, 1)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegationByUnbondingID(ctx, 2)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetValidatorByUnbondingID(ctx, 3)
+	require.False(t, found)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.Unbonded, validator.Status)
+	require.Empty(t, validator.UnbondingIds)
+
+	require.ErrorIs(t, app.StakingKeeper.PutUnbondingOnHold(ctx, 1), types.ErrUnbondingNotFound)
+	require.ErrorIs(t, app.StakingKeeper.UnbondingCanComplete(ctx, 4), types.ErrUnbondingNotFound)
+}
+
+func TestUnbondingDelegationOnHold(t *testing.T) {
+	app, ctx, hooks, delAddr, valAddrs := setupUnbondingHooks(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	unbondingTime := app.StakingKeeper.UnbondingTime(ctx)
+	heldAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	freeAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+
+	// the first entry is held by two modules, the second one isn't held
+	hooks.holders = 2
+	teststaking.NewHelper(t, ctx, app.StakingKeeper).Undelegate(delAddr, valAddrs[0], heldAmt, true)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	hooks.holders = 0
+	teststaking.NewHelper(t, ctx, app.StakingKeeper).Undelegate(delAddr, valAddrs[0], freeAmt, true)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	require.Equal(t, int64(2), ubd.Entries[0].UnbondingOnHoldRefCount)
+	require.Equal(t, int64(0), ubd.Entries[1].UnbondingOnHoldRefCount)
+
+	// once both entries have matured only the second one completes
+	balance := app.BankKeeper.GetBalance(ctx, delAddr, bondDenom)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	require.Equal(t, balance.AddAmount(freeAmt), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, uint64(1), ubd.Entries[0].UnbondingId)
+
+	// the held entry completes as soon as the last holder releases it
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 1))
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(1), ubd.Entries[0].UnbondingOnHoldRefCount)
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Equal(t, balance.AddAmount(freeAmt), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 1))
+	require.Equal(t, balance.AddAmount(freeAmt).AddAmount(heldAmt), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddrs[0])
+	require.False(t, found)
+
+	require.ErrorIs(t, app.StakingKeeper.UnbondingCanComplete(ctx, 1), types.ErrUnbondingNotFound)
+	require.ErrorIs(t, app.StakingKeeper.UnbondingCanComplete(ctx, 2), types.ErrUnbondingNotFound)
+}
+
+func TestRedelegationOnHold(t *testing.T) {
+	app, ctx, hooks, delAddr, valAddrs := setupUnbondingHooks(t)
+	unbondingTime := app.StakingKeeper.UnbondingTime(ctx)
+	redAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+
+	hooks.holders = 1
+	redelegate(t, ctx, app, delAddr, valAddrs[0], valAddrs[1], redAmt)
+
+	// an entry released before maturity completes with the redelegation queue
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 1))
+	require.ErrorIs(t, app.StakingKeeper.UnbondingCanComplete(ctx, 1), types.ErrUnbondingNotOnHold)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	redelegate(t, ctx, app, delAddr, valAddrs[0], valAddrs[1], redAmt)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	red, found := app.StakingKeeper.GetRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, red.Entries, 1)
+	require.Equal(t, uint64(2), red.Entries[0].UnbondingId)
+
+	// a hold can be added by another module after the operation started
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(ctx, 2))
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 2))
+	_, found = app.StakingKeeper.GetRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1])
+	require.True(t, found)
+
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 2))
+	_, found = app.StakingKeeper.GetRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegationByUnbondingID(ctx, 2)
+	require.False(t, found)
+}
+
+func TestValidatorUnbondingOnHold(t *testing.T) {
+	app, ctx, hooks, _, valAddrs := setupUnbondingHooks(t)
+
+	// both validators begin unbonding in the same block, only the first one is
+	// held by two modules
+	jail := func(valAddr sdk.ValAddress) {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		consAddr, err := validator.GetConsAddr()
+		require.NoError(t, err)
+		app.StakingKeeper.Jail(ctx, consAddr)
+	}
+	jail(valAddrs[0])
+	jail(valAddrs[1])
+
+	hooks.holders = 2
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 2)
+	for _, id := range hooks.ids {
+		validator, found := app.StakingKeeper.GetValidatorByUnbondingID(ctx, id)
+		require.True(t, found)
+		if validator.GetOperator().Equals(valAddrs[1]) {
+			require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, id))
+			require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, id))
+		}
+	}
+
+	checkStatus := func(valAddr sdk.ValAddress, status types.BondStatus) {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		require.Equal(t, status, validator.Status)
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+	checkStatus(valAddrs[0], types.Unbonding)
+	checkStatus(valAddrs[1], types.Unbonded)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	id := validator.UnbondingIds[0]
+
+	// the held validator completes its unbonding at the end of the block in
+	// which the last hold is released
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, id))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+	checkStatus(valAddrs[0], types.Unbonding)
+
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, id))
+	checkStatus(valAddrs[0], types.Unbonding)
+	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+	checkStatus(valAddrs[0], types.Unbonded)
+
+	_, found = app.StakingKeeper.GetValidatorByUnbondingID(ctx, id)
+	require.False(t, found)
+}
+
+func TestAfterUnbondingInitiatedError(t *testing.T) {
+	app, ctx, hooks, delAddr, valAddrs := setupUnbondingHooks(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	hooks.err = errors.New("hook failed")
+	msg := types.NewMsgUndelegate(delAddr, valAddrs[0], sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	_, err := msgServer.Undelegate(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, hooks.err)
+}
@@ -8,6 +8,8 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -15,6 +17,10 @@ import (
 // BlockValidatorUpdates calculates the ValidatorUpdates for the current block
 // Called in each EndBlock
 func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
+	// Jail the validators slashed below their minimum self-delegation, so that
+	// they are removed from the validator set in this block.
+	k.JailValidatorsBelowMinSelfDelegation(ctx)
+
 	// Calculate validator set changes.
 	//
 	// NOTE: ApplyAndReturnValidatorSetUpdates has to come before
@@ -32,6 +38,9 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
+	// stop resolving rotated consensus addresses whose unbonding period has passed
+	k.DequeueAllMatureConsPubKeyRotations(ctx, ctx.BlockHeader().Time)
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvPair := range matureUnbonds {
@@ -124,6 +133,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Consensus keys replaced during this block, which Tendermint still knows
+	// the validators by.
+	rotated, err := k.getPendingConsPubKeyRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -173,8 +189,20 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		oldPk, rotatedKey := rotated[valAddrStr]
+
+		// a validator which was bonded with a rotated key has to be removed
+		// from the Tendermint validator set under the old key
+		if found && rotatedKey {
+			update, err := consPubKeyUpdateZero(oldPk)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		}
+
+		// update the validator set if power or consensus key has changed
+		if !found || rotatedKey || !bytes.Equal(oldPowerBytes, newPowerBytes) {
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
@@ -199,9 +227,20 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		if oldPk, ok := rotated[validator.OperatorAddress]; ok {
+			update, err := consPubKeyUpdateZero(oldPk)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
+	k.clearPendingConsPubKeyRotations(ctx)
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -225,6 +264,20 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	return updates, err
 }
 
+// consPubKeyUpdateZero returns a zero power abci.ValidatorUpdate for the given
+// consensus public key.
+func consPubKeyUpdateZero(pk cryptotypes.PubKey) (abci.ValidatorUpdate, error) {
+	tmProtoPk, err := cryptocodec.ToTmProtoPublicKey(pk)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: tmProtoPk,
+		Power:  0,
+	}, nil
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) (types.Validator, error) {
@@ -324,6 +377,11 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	validator.UnbondingTime = ctx.BlockHeader().Time.Add(params.UnbondingTime)
 	validator.UnbondingHeight = ctx.BlockHeader().Height
 
+	// a validator can begin unbonding again before completing a previous
+	// unbonding, the ids are released together once it is unbonded
+	id := k.IncrementUnbondingID(ctx)
+	validator.UnbondingIds = append(validator.UnbondingIds, id)
+
 	// save the now unbonded validator record and power index
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator)
@@ -338,6 +396,11 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	}
 	k.AfterValidatorBeginUnbonding(ctx, consAddr, validator.GetOperator())
 
+	k.SetValidatorByUnbondingID(ctx, validator, id)
+	if err := k.AfterUnbondingInitiated(ctx, id); err != nil {
+		return validator, err
+	}
+
 	return validator, nil
 }
 
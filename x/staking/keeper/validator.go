@@ -7,6 +7,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -32,13 +33,47 @@ func (k Keeper) mustGetValidator(ctx sdk.Context, addr sdk.ValAddress) types.Val
 	return validator
 }
 
-// get a single validator by consensus address
+// ValidateValidatorPowerCap returns an error if delegating the given amount of
+// tokens to the validator would put it above the MaxValidatorPowerFraction of
+// the bonded tokens. bondedDelta is the change in total bonded tokens caused by
+// the delegation, e.g. zero when redelegating between two bonded validators.
+func (k Keeper) ValidateValidatorPowerCap(ctx sdk.Context, validator types.Validator, amount, bondedDelta sdk.Int) error {
+	maxFraction := k.MaxValidatorPowerFraction(ctx)
+	if maxFraction.GTE(sdk.OneDec()) {
+		return nil
+	}
+
+	bondedTokens := k.TotalBondedTokens(ctx).Add(bondedDelta)
+	if !bondedTokens.IsPositive() {
+		return nil
+	}
+
+	maxTokens := maxFraction.MulInt(bondedTokens)
+	if validator.Tokens.Add(amount).ToDec().GT(maxTokens) {
+		return sdkerrors.Wrapf(
+			types.ErrValidatorPowerCapExceeded,
+			"validator %s would hold %s of %s bonded tokens, above the maximum fraction of %s",
+			validator.OperatorAddress, validator.Tokens.Add(amount), bondedTokens, maxFraction,
+		)
+	}
+
+	return nil
+}
+
+// get a single validator by consensus address, consensus addresses rotated
+// away from within the unbonding period resolve to the validator as well
 func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator types.Validator, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
 	opAddr := store.Get(types.GetValidatorByConsAddrKey(consAddr))
-	if opAddr == nil {
-		return validator, false
+	for opAddr == nil {
+		newConsAddr, rotated := k.getRotatedConsAddr(ctx, consAddr)
+		if !rotated {
+			return validator, false
+		}
+
+		consAddr = newConsAddr
+		opAddr = store.Get(types.GetValidatorByConsAddrKey(consAddr))
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -148,6 +183,49 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	return commission, nil
 }
 
+// EnforceMinCommissionRate raises the commission rate of every validator below
+// the MinCommissionRate param up to it, along with the max rate if needed. The
+// max change rate and the time of the last commission change are not taken into
+// account since the change is not initiated by the validator. It can be called
+// from an upgrade handler and is run automatically at the end of a block in
+// which the param was raised.
+func (k Keeper) EnforceMinCommissionRate(ctx sdk.Context) error {
+	minRate := k.MinCommissionRate(ctx)
+	blockTime := ctx.BlockHeader().Time
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		prevRate := validator.Commission.Rate
+		if prevRate.GTE(minRate) {
+			continue
+		}
+
+		if err := k.BeforeValidatorModified(ctx, validator.GetOperator()); err != nil {
+			return err
+		}
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		validator.Commission.UpdateTime = blockTime
+
+		k.SetValidator(ctx, validator)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMinCommissionBump,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator().String()),
+				sdk.NewAttribute(types.AttributeKeyPrevCommissionRate, prevRate.String()),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, minRate.String()),
+			),
+		)
+	}
+
+	k.SetLastMinCommissionRate(ctx, minRate)
+
+	return nil
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 // TODO, this function panics, and it's not good.
@@ -176,6 +254,7 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(types.GetValidatorLiquidSharesKey(address))
 
 	// call hooks
 	k.AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator())
@@ -425,6 +504,9 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 			addrs := types.ValAddresses{}
 			k.cdc.MustUnmarshal(unbondingValIterator.Value(), &addrs)
 
+			// validators on hold stay in the queue until all their holds
+			// are released
+			var onHold []string
 			for _, valAddr := range addrs.Addresses {
 				addr, err := sdk.ValAddressFromBech32(valAddr)
 				if err != nil {
@@ -439,13 +521,27 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 					panic("unexpected validator in unbonding queue; status was not unbonding")
 				}
 
+				if val.UnbondingOnHoldRefCount > 0 {
+					onHold = append(onHold, valAddr)
+					continue
+				}
+
+				for _, id := range val.UnbondingIds {
+					k.DeleteUnbondingIndex(ctx, id)
+				}
+				val.UnbondingIds = nil
+
 				val = k.UnbondingToUnbonded(ctx, val)
 				if val.GetDelegatorShares().IsZero() {
 					k.RemoveValidator(ctx, val.GetOperator())
 				}
 			}
 
-			store.Delete(key)
+			if len(onHold) == 0 {
+				store.Delete(key)
+			} else {
+				store.Set(key, k.cdc.MustMarshal(&types.ValAddresses{Addresses: onHold}))
+			}
 		}
 	}
 }
@@ -1082,6 +1082,8 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		{val2, sdk.NewDecWithPrec(4, 1), true},
 		{val2, sdk.NewDecWithPrec(3, 1), true},
 		{val2, sdk.NewDecWithPrec(1, 2), true},
+		{val2, sdk.NewDecWithPrec(5, 2).Sub(sdk.SmallestDec()), true},
+		{val2, sdk.NewDecWithPrec(5, 2), false},
 		{val2, sdk.NewDecWithPrec(2, 1), false},
 	}
 
@@ -1111,6 +1113,83 @@ func TestUpdateValidatorCommission(t *testing.T) {
 	}
 }
 
+func TestCreateValidatorMinCommissionRate(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapValidatorTest(t, 1000, 20)
+	pks := simapp.CreateTestPubKeys(2)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	amt := sdk.NewInt(100)
+
+	tstaking.Commission = types.NewCommissionRates(params.MinCommissionRate.Sub(sdk.SmallestDec()), sdk.OneDec(), sdk.OneDec())
+	tstaking.CreateValidator(addrVals[0], pks[0], amt, false)
+
+	tstaking.Commission = types.NewCommissionRates(params.MinCommissionRate, sdk.OneDec(), sdk.OneDec())
+	tstaking.CreateValidator(addrVals[1], pks[1], amt, true)
+}
+
+func TestEnforceMinCommissionRate(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapValidatorTest(t, 1000, 20)
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: time.Now().UTC()})
+	minRate := sdk.NewDecWithPrec(5, 2)
+
+	commissions := []types.Commission{
+		types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.ZeroDec()),
+		types.NewCommission(minRate.Sub(sdk.SmallestDec()), sdk.OneDec(), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommission(minRate, sdk.OneDec(), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.OneDec(), sdk.NewDecWithPrec(1, 2)),
+	}
+	for i, commission := range commissions {
+		val := teststaking.NewValidator(t, addrVals[i], PKs[i])
+		val.Commission = commission
+		app.StakingKeeper.SetValidator(ctx, val)
+	}
+
+	// raising the param bumps the validators below the floor at the end of the block
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+	app.StakingKeeper.SetParams(ctx, params)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	expRates := []sdk.Dec{minRate, minRate, minRate, sdk.NewDecWithPrec(1, 1)}
+	expMaxRates := []sdk.Dec{minRate, sdk.OneDec(), sdk.OneDec(), sdk.OneDec()}
+	for i := range commissions {
+		val, found := app.StakingKeeper.GetValidator(ctx, addrVals[i])
+		require.True(t, found)
+		require.Equal(t, expRates[i], val.Commission.Rate, "validator #%d", i)
+		require.Equal(t, expMaxRates[i], val.Commission.MaxRate, "validator #%d", i)
+		require.NoError(t, val.Commission.Validate())
+	}
+
+	bumped := make(map[string]string)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMinCommissionBump {
+			bumped[string(event.Attributes[0].Value)] = string(event.Attributes[1].Value)
+		}
+	}
+	require.Equal(t, sdk.ZeroDec().String(), bumped[addrVals[0].String()])
+	require.Equal(t, minRate.Sub(sdk.SmallestDec()).String(), bumped[addrVals[1].String()])
+	require.NotContains(t, bumped, addrVals[2].String())
+	require.NotContains(t, bumped, addrVals[3].String())
+	require.Equal(t, minRate, app.StakingKeeper.GetLastMinCommissionRate(ctx))
+
+	// lowering the param leaves the commissions untouched
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	params.MinCommissionRate = sdk.ZeroDec()
+	app.StakingKeeper.SetParams(ctx, params)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	val, _ := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, minRate, val.Commission.Rate)
+	require.True(t, app.StakingKeeper.GetLastMinCommissionRate(ctx).IsZero())
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeMinCommissionBump, event.Type)
+	}
+}
+
 func applyValidatorSetUpdates(t *testing.T, ctx sdk.Context, k keeper.Keeper, expectedUpdatesLen int) []abci.ValidatorUpdate {
 	updates, err := k.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.NoError(t, err)
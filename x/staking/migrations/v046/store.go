@@ -0,0 +1,108 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46.
+// The migration includes:
+//
+// - Setting the MaxConsPubkeyRotations and KeyRotationFee params in the paramstore
+// - Setting the MaxValidatorPowerFraction param in the paramstore
+// - Setting the MaxUndelegateAllPositions param in the paramstore
+// - Setting the EnforceMinSelfDelegation param in the paramstore
+// - Setting the SlashFundCommunityPool param in the paramstore
+// - Backfilling the delegator shares of each validator owned by module accounts
+func MigrateStore(
+	ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec,
+	paramstore paramtypes.Subspace, ak types.AccountKeeper,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	migrateParamsStore(ctx, paramstore)
+
+	return migrateValidatorLiquidShares(ctx, store, cdc, ak)
+}
+
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyMaxConsPubkeyRotations, types.DefaultMaxConsPubkeyRotations)
+	paramstore.Set(ctx, types.KeyKeyRotationFee, types.DefaultKeyRotationFee)
+	paramstore.Set(ctx, types.KeyMaxValidatorPowerFraction, types.DefaultMaxValidatorPowerFraction)
+	paramstore.Set(ctx, types.KeyMaxUndelegateAllPositions, types.DefaultMaxUndelegateAllPositions)
+	paramstore.Set(ctx, types.KeyEnforceMinSelfDelegation, types.DefaultEnforceMinSelfDelegation)
+	paramstore.Set(ctx, types.KeySlashFundCommunityPool, types.DefaultSlashFundCommunityPool)
+}
+
+// migrateValidatorLiquidShares sets the delegator shares of each validator
+// owned by module accounts.
+func migrateValidatorLiquidShares(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, ak types.AccountKeeper) error {
+	valAddrs, liquidShares, err := sumLiquidShares(ctx, store, cdc, ak)
+	if err != nil {
+		return err
+	}
+
+	for _, valAddrStr := range valAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			return err
+		}
+
+		bz, err := cdc.Marshal(&sdk.DecProto{Dec: liquidShares[valAddrStr]})
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+	}
+
+	return nil
+}
+
+// sumLiquidShares sums up the shares of the delegations owned by module
+// accounts for each validator, returning the validators in the order they were
+// first delegated to.
+func sumLiquidShares(
+	ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, ak types.AccountKeeper,
+) (valAddrs []string, liquidShares map[string]sdk.Dec, err error) {
+	prefixDelStore := prefix.NewStore(store, types.DelegationKey)
+
+	delStoreIter := prefixDelStore.Iterator(nil, nil)
+	defer delStoreIter.Close()
+
+	liquidShares = make(map[string]sdk.Dec)
+
+	for ; delStoreIter.Valid(); delStoreIter.Next() {
+		var delegation types.Delegation
+		if err := cdc.Unmarshal(delStoreIter.Value(), &delegation); err != nil {
+			return nil, nil, err
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if _, ok := ak.GetAccount(ctx, delAddr).(authtypes.ModuleAccountI); !ok {
+			continue
+		}
+
+		shares, ok := liquidShares[delegation.ValidatorAddress]
+		if !ok {
+			shares = sdk.ZeroDec()
+			valAddrs = append(valAddrs, delegation.ValidatorAddress)
+		}
+		liquidShares[delegation.ValidatorAddress] = shares.Add(delegation.Shares)
+	}
+
+	return valAddrs, liquidShares, nil
+}
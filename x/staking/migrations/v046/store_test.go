@@ -0,0 +1,129 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	v046staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyMaxConsPubkeyRotations))
+	require.False(t, paramstore.Has(ctx, types.KeyKeyRotationFee))
+	require.False(t, paramstore.Has(ctx, types.KeyMaxValidatorPowerFraction))
+	require.False(t, paramstore.Has(ctx, types.KeyMaxUndelegateAllPositions))
+	require.False(t, paramstore.Has(ctx, types.KeyEnforceMinSelfDelegation))
+	require.False(t, paramstore.Has(ctx, types.KeySlashFundCommunityPool))
+
+	// Run migrations. The account keeper is only needed when there are
+	// delegations to go through.
+	err := v046staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore, nil)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	var maxRotations uint32
+	paramstore.Get(ctx, types.KeyMaxConsPubkeyRotations, &maxRotations)
+	require.Equal(t, types.DefaultMaxConsPubkeyRotations, maxRotations)
+
+	var fee sdk.Coin
+	paramstore.Get(ctx, types.KeyKeyRotationFee, &fee)
+	require.Equal(t, types.DefaultKeyRotationFee, fee)
+
+	var maxFraction sdk.Dec
+	paramstore.Get(ctx, types.KeyMaxValidatorPowerFraction, &maxFraction)
+	require.Equal(t, types.DefaultMaxValidatorPowerFraction, maxFraction)
+
+	var maxPositions uint32
+	paramstore.Get(ctx, types.KeyMaxUndelegateAllPositions, &maxPositions)
+	require.Equal(t, types.DefaultMaxUndelegateAllPositions, maxPositions)
+
+	var enforceMinSelfDelegation bool
+	paramstore.Get(ctx, types.KeyEnforceMinSelfDelegation, &enforceMinSelfDelegation)
+	require.Equal(t, types.DefaultEnforceMinSelfDelegation, enforceMinSelfDelegation)
+
+	var slashFundCommunityPool bool
+	paramstore.Get(ctx, types.KeySlashFundCommunityPool, &slashFundCommunityPool)
+	require.Equal(t, types.DefaultSlashFundCommunityPool, slashFundCommunityPool)
+}
+
+func TestMigrator(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// drop the new params, as if the store was of consensus version 3
+	paramsStore := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	for _, key := range [][]byte{
+		types.KeyMaxConsPubkeyRotations, types.KeyKeyRotationFee, types.KeyMaxValidatorPowerFraction,
+		types.KeyMaxUndelegateAllPositions, types.KeyEnforceMinSelfDelegation, types.KeySlashFundCommunityPool,
+	} {
+		paramsStore.Delete(append([]byte(types.ModuleName+"/"), key...))
+	}
+
+	// Run migrations through the keeper, whose subspace has its key table.
+	require.NoError(t, keeper.NewMigrator(app.StakingKeeper).Migrate3to4(ctx))
+
+	// Make sure the new params are set.
+	require.Equal(t, types.DefaultMaxConsPubkeyRotations, app.StakingKeeper.MaxConsPubkeyRotations(ctx))
+	require.Equal(t, types.DefaultKeyRotationFee, app.StakingKeeper.KeyRotationFee(ctx))
+	require.Equal(t, types.DefaultMaxValidatorPowerFraction, app.StakingKeeper.MaxValidatorPowerFraction(ctx))
+	require.Equal(t, types.DefaultMaxUndelegateAllPositions, app.StakingKeeper.MaxUndelegateAllPositions(ctx))
+	require.Equal(t, types.DefaultEnforceMinSelfDelegation, app.StakingKeeper.EnforceMinSelfDelegation(ctx))
+	require.Equal(t, types.DefaultSlashFundCommunityPool, app.StakingKeeper.SlashFundCommunityPool(ctx))
+}
+
+func TestMigrateValidatorLiquidShares(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	stakingKey := app.GetKey(types.StoreKey)
+	paramstore := paramtypes.NewSubspace(app.AppCodec(), app.LegacyAmino(), app.GetKey(paramtypes.StoreKey), app.GetTKey(paramtypes.TStoreKey), types.ModuleName)
+
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	bondAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), bondAmt))
+
+	// delegate from a module account and from a regular account
+	moduleAcc := authtypes.NewEmptyModuleAccount("liquidstaking")
+	app.AccountKeeper.SetModuleAccount(ctx, moduleAcc)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, moduleAcc.GetAddress(), coins))
+	_, err := app.StakingKeeper.Delegate(ctx, moduleAcc.GetAddress(), bondAmt, types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	userAddr := simapp.AddTestAddrs(app, ctx, 1, bondAmt)[0]
+	validator, _ = app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	_, err = app.StakingKeeper.Delegate(ctx, userAddr, bondAmt, types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// drop the liquid shares, as if the delegations were made before they
+	// were tracked
+	expShares := app.StakingKeeper.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	require.True(t, expShares.IsPositive())
+	ctx.KVStore(stakingKey).Delete(types.GetValidatorLiquidSharesKey(validator.GetOperator()))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, validator.GetOperator()).IsZero())
+
+	// Run migrations.
+	err = v046staking.MigrateStore(ctx, stakingKey, app.AppCodec(), paramstore, app.AccountKeeper)
+	require.NoError(t, err)
+
+	// Make sure only the module account's delegation is counted.
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, moduleAcc.GetAddress(), validator.GetOperator())
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, expShares)
+	require.Equal(t, expShares, app.StakingKeeper.GetValidatorLiquidShares(ctx, validator.GetOperator()))
+}
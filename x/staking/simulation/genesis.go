@@ -64,7 +64,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate,
+		types.DefaultMaxConsPubkeyRotations, types.DefaultKeyRotationFee, types.DefaultMaxValidatorPowerFraction,
+		types.DefaultMaxUndelegateAllPositions, types.DefaultEnforceMinSelfDelegation, types.DefaultSlashFundCommunityPool,
+	)
 
 	// validators & delegations
 	var (
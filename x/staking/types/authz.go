@@ -54,55 +54,65 @@ func (a StakeAuthorization) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unknown authorization type")
 	}
 
+	var validators []string
+	switch v := a.Validators.(type) {
+	case *StakeAuthorization_AllowList:
+		validators = v.AllowList.GetAddress()
+	case *StakeAuthorization_DenyList:
+		validators = v.DenyList.GetAddress()
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrap("either allowed or deny list must be set")
+	}
+	if len(validators) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("both allowed & deny list cannot be empty")
+	}
+	for _, validator := range validators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address %s: %s", validator, err)
+		}
+	}
+
 	return nil
 }
 
-// Accept implements Authorization.Accept.
+// Accept implements Authorization.Accept. Both the source and the destination
+// validators of a redelegation are checked against the allowed or deny list.
 func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
-	var validatorAddress string
+	var validatorAddresses []string
 	var amount sdk.Coin
 
 	switch msg := msg.(type) {
 	case *MsgDelegate:
-		validatorAddress = msg.ValidatorAddress
+		validatorAddresses = []string{msg.ValidatorAddress}
 		amount = msg.Amount
 	case *MsgUndelegate:
-		validatorAddress = msg.ValidatorAddress
+		validatorAddresses = []string{msg.ValidatorAddress}
 		amount = msg.Amount
 	case *MsgBeginRedelegate:
-		validatorAddress = msg.ValidatorDstAddress
+		validatorAddresses = []string{msg.ValidatorSrcAddress, msg.ValidatorDstAddress}
 		amount = msg.Amount
 	default:
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
 	}
 
-	isValidatorExists := false
-	allowedList := a.GetAllowList().GetAddress()
-	for _, validator := range allowedList {
-		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "stake authorization")
-		if validator == validatorAddress {
-			isValidatorExists = true
-			break
+	for _, validatorAddress := range validatorAddresses {
+		if err := a.acceptValidator(ctx, validatorAddress); err != nil {
+			return authz.AcceptResponse{}, err
 		}
 	}
 
-	denyList := a.GetDenyList().GetAddress()
-	for _, validator := range denyList {
-		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "stake authorization")
-		if validator == validatorAddress {
-			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(" cannot delegate/undelegate to %s validator", validator)
-		}
-	}
-
-	if !isValidatorExists {
-		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validatorAddress)
-	}
-
 	if a.MaxTokens == nil {
 		return authz.AcceptResponse{Accept: true, Delete: false,
 			Updated: &StakeAuthorization{Validators: a.GetValidators(), AuthorizationType: a.GetAuthorizationType()}}, nil
 	}
 
+	if amount.Denom != a.MaxTokens.Denom {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidCoins.Wrapf("expected %s denom, got %s", a.MaxTokens.Denom, amount.Denom)
+	}
+	if a.MaxTokens.IsLT(amount) {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount %s is more than the max tokens %s", amount, a.MaxTokens)
+	}
+
 	limitLeft := a.MaxTokens.Sub(amount)
 	if limitLeft.IsZero() {
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
@@ -111,6 +121,29 @@ func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRe
 		Updated: &StakeAuthorization{Validators: a.GetValidators(), AuthorizationType: a.GetAuthorizationType(), MaxTokens: &limitLeft}}, nil
 }
 
+// acceptValidator returns an error if the validator is not in the allowed list
+// or is in the deny list of the authorization.
+func (a StakeAuthorization) acceptValidator(ctx sdk.Context, validatorAddress string) error {
+	if allowList := a.GetAllowList(); allowList != nil {
+		for _, validator := range allowList.GetAddress() {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "stake authorization")
+			if validator == validatorAddress {
+				return nil
+			}
+		}
+		return sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validatorAddress)
+	}
+
+	for _, validator := range a.GetDenyList().GetAddress() {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "stake authorization")
+		if validator == validatorAddress {
+			return sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validatorAddress)
+		}
+	}
+
+	return nil
+}
+
 func validateAndBech32fy(allowed []sdk.ValAddress, denied []sdk.ValAddress) ([]string, []string, error) {
 	if len(allowed) == 0 && len(denied) == 0 {
 		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("both allowed & deny list cannot be empty")
@@ -244,6 +244,144 @@ func TestAuthzAuthorizations(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"delegate: deny list accepts other validators",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			stakingtypes.NewMsgDelegate(delAddr, val2, coin50),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_DenyList{
+					DenyList: &stakingtypes.StakeAuthorization_Validators{Address: []string{val1.String()}},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+		},
+		{
+			"delegate: fail max tokens exhausted",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin50,
+			stakingtypes.NewMsgDelegate(delAddr, val1, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"delegate: fail max tokens denom mismatch",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			stakingtypes.NewMsgDelegate(delAddr, val1, sdk.NewInt64Coin("stake", 50)),
+			true,
+			false,
+			nil,
+		},
+		{
+			"undelegate: deny list accepts other validators",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE,
+			&coin100,
+			stakingtypes.NewMsgUndelegate(delAddr, val2, coin100),
+			false,
+			true,
+			nil,
+		},
+		{
+			"undelegate: fail max tokens exhausted",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE,
+			&coin50,
+			stakingtypes.NewMsgUndelegate(delAddr, val2, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"redelegate: fail source validator not allowed",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			stakingtypes.NewMsgBeginRedelegate(delAddr, val3, val1, coin50),
+			true,
+			false,
+			nil,
+		},
+		{
+			"redelegate: fail destination validator not allowed",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			stakingtypes.NewMsgBeginRedelegate(delAddr, val1, val3, coin50),
+			true,
+			false,
+			nil,
+		},
+		{
+			"redelegate: deny list accepts other validators",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val3},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			stakingtypes.NewMsgBeginRedelegate(delAddr, val1, val2, coin50),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_DenyList{
+					DenyList: &stakingtypes.StakeAuthorization_Validators{Address: []string{val3.String()}},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE},
+		},
+		{
+			"redelegate: fail source validator denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val3},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			stakingtypes.NewMsgBeginRedelegate(delAddr, val3, val1, coin50),
+			true,
+			false,
+			nil,
+		},
+		{
+			"redelegate: fail destination validator denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val3},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			stakingtypes.NewMsgBeginRedelegate(delAddr, val1, val3, coin50),
+			true,
+			false,
+			nil,
+		},
+		{
+			"redelegate: expect 0 remaining coins after redelegation",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin50,
+			stakingtypes.NewMsgBeginRedelegate(delAddr, val1, val2, coin50),
+			false,
+			true,
+			nil,
+		},
+		{
+			"redelegate: fail max tokens exhausted",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val3},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin50,
+			stakingtypes.NewMsgBeginRedelegate(delAddr, val1, val2, coin100),
+			true,
+			false,
+			nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -264,3 +402,63 @@ func TestAuthzAuthorizations(t *testing.T) {
 		})
 	}
 }
+
+func TestStakeAuthorizationValidateBasic(t *testing.T) {
+	delegate := stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE
+
+	testCases := []struct {
+		msg       string
+		auth      stakingtypes.StakeAuthorization
+		expectErr bool
+	}{
+		{
+			"valid allow list",
+			stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: []string{val1.String()}},
+				}, MaxTokens: &coin100, AuthorizationType: delegate},
+			false,
+		},
+		{
+			"valid deny list",
+			stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_DenyList{
+					DenyList: &stakingtypes.StakeAuthorization_Validators{Address: []string{val1.String()}},
+				}, AuthorizationType: delegate},
+			false,
+		},
+		{
+			"no validators list",
+			stakingtypes.StakeAuthorization{MaxTokens: &coin100, AuthorizationType: delegate},
+			true,
+		},
+		{
+			"empty deny list",
+			stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_DenyList{
+					DenyList: &stakingtypes.StakeAuthorization_Validators{},
+				}, AuthorizationType: delegate},
+			true,
+		},
+		{
+			"invalid validator address",
+			stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: []string{"invalid"}},
+				}, AuthorizationType: delegate},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
@@ -16,7 +17,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditValidator{}, "cosmos-sdk/MsgEditValidator", nil)
 	cdc.RegisterConcrete(&MsgDelegate{}, "cosmos-sdk/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "cosmos-sdk/MsgUndelegate", nil)
+	cdc.RegisterConcrete(&MsgUndelegateAll{}, "cosmos-sdk/MsgUndelegateAll", nil)
 	cdc.RegisterConcrete(&MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation", nil)
+	cdc.RegisterConcrete(&MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey", nil)
 }
 
 // RegisterInterfaces registers the x/staking interfaces types with the interface registry
@@ -26,7 +30,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEditValidator{},
 		&MsgDelegate{},
 		&MsgUndelegate{},
+		&MsgUndelegateAll{},
 		&MsgBeginRedelegate{},
+		&MsgCancelUnbondingDelegation{},
+		&MsgRotateConsPubKey{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -36,6 +43,36 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
+// RegisterMsgRenderers registers the human-readable renderers of the x/staking
+// Msgs on the provided registry.
+func RegisterMsgRenderers(registry *signing.MsgRendererRegistry) {
+	registry.Register(&MsgDelegate{}, func(msg sdk.Msg) ([]signing.Field, error) {
+		m := msg.(*MsgDelegate)
+		return []signing.Field{
+			{Label: "Delegator", Value: m.DelegatorAddress},
+			{Label: "Validator", Value: m.ValidatorAddress},
+			{Label: "Amount", Value: m.Amount.String()},
+		}, nil
+	})
+	registry.Register(&MsgUndelegate{}, func(msg sdk.Msg) ([]signing.Field, error) {
+		m := msg.(*MsgUndelegate)
+		return []signing.Field{
+			{Label: "Delegator", Value: m.DelegatorAddress},
+			{Label: "Validator", Value: m.ValidatorAddress},
+			{Label: "Amount", Value: m.Amount.String()},
+		}, nil
+	})
+	registry.Register(&MsgBeginRedelegate{}, func(msg sdk.Msg) ([]signing.Field, error) {
+		m := msg.(*MsgBeginRedelegate)
+		return []signing.Field{
+			{Label: "Delegator", Value: m.DelegatorAddress},
+			{Label: "Source validator", Value: m.ValidatorSrcAddress},
+			{Label: "Destination validator", Value: m.ValidatorDstAddress},
+			{Label: "Amount", Value: m.Amount.String()},
+		}, nil
+	})
+}
+
 var (
 	amino = codec.NewLegacyAmino()
 
@@ -52,4 +89,6 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	RegisterMsgRenderers(signing.DefaultMsgRenderers)
 }
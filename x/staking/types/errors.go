@@ -50,4 +50,11 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 41, "no unbonding delegation entry found")
+	ErrExceedingMaxConsPubKeyRotations = sdkerrors.Register(ModuleName, 42, "exceeding maximum consensus key rotations within the unbonding period")
+	ErrConsPubKeyRotationDisabled      = sdkerrors.Register(ModuleName, 43, "consensus key rotation is disabled")
+	ErrHistoricalInfoPruned            = sdkerrors.Register(ModuleName, 44, "historical info has been pruned")
+	ErrValidatorPowerCapExceeded       = sdkerrors.Register(ModuleName, 45, "delegation would exceed the maximum validator power fraction")
+	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 46, "unbonding operation not found")
+	ErrUnbondingNotOnHold              = sdkerrors.Register(ModuleName, 47, "unbonding operation is not on hold")
 )
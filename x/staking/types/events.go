@@ -2,21 +2,35 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding         = "complete_unbonding"
+	EventTypeCompleteRedelegation      = "complete_redelegation"
+	EventTypeCreateValidator           = "create_validator"
+	EventTypeEditValidator             = "edit_validator"
+	EventTypeDelegate                  = "delegate"
+	EventTypeUnbond                    = "unbond"
+	EventTypeRedelegate                = "redelegate"
+	EventTypeMinCommissionBump         = "min_commission_bump"
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
+	EventTypeMinSelfDelegationJail     = "min_self_delegation_jail"
 
-	AttributeKeyValidator         = "validator"
-	AttributeKeyCommissionRate    = "commission_rate"
-	AttributeKeyMinSelfDelegation = "min_self_delegation"
-	AttributeKeySrcValidator      = "source_validator"
-	AttributeKeyDstValidator      = "destination_validator"
-	AttributeKeyDelegator         = "delegator"
-	AttributeKeyCompletionTime    = "completion_time"
-	AttributeKeyNewShares         = "new_shares"
-	AttributeValueCategory        = ModuleName
+	AttributeKeyValidator          = "validator"
+	AttributeKeyCommissionRate     = "commission_rate"
+	AttributeKeyPrevCommissionRate = "previous_commission_rate"
+	AttributeKeyMinSelfDelegation  = "min_self_delegation"
+	AttributeKeySrcValidator       = "source_validator"
+	AttributeKeyDstValidator       = "destination_validator"
+	AttributeKeyDelegator          = "delegator"
+	AttributeKeyCompletionTime     = "completion_time"
+	AttributeKeyCreationHeight     = "creation_height"
+	AttributeKeyNewShares          = "new_shares"
+	AttributeKeyOldConsAddress     = "old_consensus_address"
+	AttributeKeyNewConsAddress     = "new_consensus_address"
+	AttributeKeyKeyRotationFee     = "key_rotation_fee"
+	AttributeKeySelfDelegation     = "self_delegation"
+	AttributeKeyReason             = "reason"
+	AttributeValueCategory         = ModuleName
+
+	AttributeValueSlash            = "slash"
+	AttributeValueSelfUndelegation = "self_undelegation"
 )
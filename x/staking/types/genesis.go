@@ -42,5 +42,10 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.ConsPubkeyRotations {
+		if err := g.ConsPubkeyRotations[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -44,6 +44,12 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// cons_pubkey_rotations defines the consensus key rotations which are still
+	// within their unbonding period.
+	ConsPubkeyRotations []ConsPubKeyRotationRecord `protobuf:"bytes,9,rep,name=cons_pubkey_rotations,json=consPubkeyRotations,proto3" json:"cons_pubkey_rotations"`
+	// last_unbonding_id is the id assigned to the latest unbonding delegation
+	// entry, redelegation entry or unbonding validator.
+	LastUnbondingId uint64 `protobuf:"varint,10,opt,name=last_unbonding_id,json=lastUnbondingId,proto3" json:"last_unbonding_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +134,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetConsPubkeyRotations() []ConsPubKeyRotationRecord {
+	if m != nil {
+		return m.ConsPubkeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastUnbondingId() uint64 {
+	if m != nil {
+		return m.LastUnbondingId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -179,38 +199,42 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x13, 0xd6, 0x75, 0x9d, 0x3b, 0xfe, 0x99, 0x16, 0x85, 0x1e, 0xd2, 0x52, 0x4d, 0xa8,
+	0x1a, 0x2c, 0x65, 0xe5, 0x86, 0xb8, 0x50, 0x10, 0xd3, 0x80, 0x43, 0x95, 0x01, 0x42, 0x5c, 0x22,
+	0xa7, 0x36, 0x59, 0x68, 0x6b, 0x47, 0xb6, 0x3b, 0xd6, 0x6f, 0xc0, 0x0d, 0x3e, 0xc2, 0x3e, 0x04,
+	0x1f, 0x62, 0xc7, 0x89, 0x13, 0xe2, 0x30, 0xa1, 0xf6, 0xc2, 0xc7, 0x40, 0xb1, 0xdd, 0xb4, 0x50,
+	0xc2, 0xa9, 0xb5, 0xde, 0xe7, 0xf9, 0xbd, 0x8f, 0x1c, 0x3f, 0x60, 0xbb, 0xcf, 0xc4, 0x88, 0x89,
+	0xb6, 0x90, 0x68, 0x10, 0xd3, 0xa8, 0x7d, 0xbc, 0x17, 0x12, 0x89, 0xf6, 0xda, 0x11, 0xa1, 0x44,
+	0xc4, 0xc2, 0x4b, 0x38, 0x93, 0x0c, 0xde, 0xd4, 0x2a, 0xcf, 0xa8, 0x3c, 0xa3, 0xaa, 0x55, 0x22,
+	0x16, 0x31, 0x25, 0x69, 0xa7, 0xff, 0xb4, 0xba, 0x96, 0xc7, 0x9c, 0xbb, 0xb5, 0xea, 0x96, 0x56,
+	0x05, 0xda, 0x6e, 0x16, 0xa8, 0x43, 0xf3, 0x73, 0x11, 0x6c, 0xed, 0xeb, 0x00, 0x87, 0x12, 0x49,
+	0x02, 0x1f, 0x81, 0x62, 0x82, 0x38, 0x1a, 0x09, 0xc7, 0x6e, 0xd8, 0xad, 0x72, 0xc7, 0xf5, 0xfe,
+	0x1d, 0xc8, 0xeb, 0x29, 0x55, 0xb7, 0x70, 0x76, 0x51, 0xb7, 0x7c, 0xe3, 0x81, 0x6f, 0xc1, 0xb5,
+	0x21, 0x12, 0x32, 0x90, 0x4c, 0xa2, 0x61, 0x90, 0xb0, 0x8f, 0x84, 0x3b, 0x97, 0x1a, 0x76, 0x6b,
+	0xab, 0xeb, 0xa5, 0xba, 0x1f, 0x17, 0xf5, 0x3b, 0x51, 0x2c, 0x8f, 0xc6, 0xa1, 0xd7, 0x67, 0x23,
+	0x93, 0xc4, 0xfc, 0xec, 0x0a, 0x3c, 0x68, 0xcb, 0x49, 0x42, 0x84, 0x77, 0x40, 0xa5, 0x7f, 0x25,
+	0xe5, 0xbc, 0x4a, 0x31, 0xbd, 0x94, 0x02, 0x31, 0xa8, 0x2a, 0xf2, 0x31, 0x1a, 0xc6, 0x18, 0x49,
+	0xc6, 0x35, 0x5d, 0x38, 0x6b, 0x8d, 0xb5, 0x56, 0xb9, 0xb3, 0x93, 0x17, 0xf3, 0x25, 0x12, 0xf2,
+	0xcd, 0xdc, 0xa3, 0x50, 0x26, 0xf2, 0x8d, 0xe1, 0xca, 0x44, 0xc0, 0x7d, 0x00, 0xb2, 0x05, 0xc2,
+	0x29, 0x28, 0xf4, 0xed, 0x3c, 0x74, 0x66, 0x36, 0xc4, 0x25, 0x2b, 0x7c, 0x0e, 0xca, 0x98, 0x0c,
+	0x49, 0x84, 0x64, 0xcc, 0xa8, 0x70, 0xd6, 0x15, 0xa9, 0x99, 0x47, 0x7a, 0x9a, 0x49, 0x0d, 0x6a,
+	0xd9, 0x0c, 0xdf, 0x83, 0xea, 0x98, 0x86, 0x8c, 0xe2, 0x98, 0x46, 0xc1, 0x32, 0xb5, 0xa8, 0xa8,
+	0x77, 0xf3, 0xa8, 0xaf, 0xe7, 0xa6, 0x15, 0x7c, 0x65, 0xbc, 0x3a, 0x12, 0xb0, 0x07, 0x2e, 0x73,
+	0xb2, 0xcc, 0xdf, 0x50, 0xfc, 0xed, 0x3c, 0xbe, 0x4f, 0xf0, 0xdf, 0xe0, 0x3f, 0x01, 0xb0, 0x06,
+	0x4a, 0xe4, 0x24, 0x61, 0x5c, 0x12, 0xec, 0x94, 0x1a, 0x76, 0xab, 0xe4, 0x67, 0x67, 0xf8, 0x01,
+	0x54, 0xfb, 0x8c, 0x8a, 0x20, 0x19, 0x87, 0x03, 0x32, 0x09, 0x38, 0x93, 0x66, 0xeb, 0xa6, 0xda,
+	0x7a, 0x3f, 0x6f, 0xeb, 0x13, 0x46, 0x45, 0x6f, 0x1c, 0xbe, 0x20, 0x13, 0xdf, 0x58, 0x7c, 0xd2,
+	0x67, 0x1c, 0xcf, 0x3f, 0x6b, 0x5f, 0xcf, 0x07, 0x8b, 0xb9, 0x80, 0x3b, 0xe0, 0xba, 0x7a, 0x3c,
+	0x8b, 0x6b, 0x8c, 0xb1, 0x03, 0x1a, 0x76, 0xab, 0xe0, 0x5f, 0x4d, 0x07, 0xd9, 0x4d, 0x1d, 0xe0,
+	0xe6, 0x11, 0x80, 0xab, 0x6f, 0x06, 0x76, 0xc0, 0x06, 0xc2, 0x98, 0x13, 0xa1, 0x7b, 0xb1, 0xd9,
+	0x75, 0xbe, 0x7d, 0xdd, 0xad, 0x98, 0x88, 0x8f, 0xf5, 0xe4, 0x50, 0xf2, 0x98, 0x46, 0xfe, 0x5c,
+	0x08, 0x2b, 0x60, 0x7d, 0xd1, 0x80, 0x35, 0x5f, 0x1f, 0x1e, 0x96, 0x3e, 0x9d, 0xd6, 0xad, 0x5f,
+	0xa7, 0x75, 0xab, 0xfb, 0xec, 0x6c, 0xea, 0xda, 0xe7, 0x53, 0xd7, 0xfe, 0x39, 0x75, 0xed, 0x2f,
+	0x33, 0xd7, 0x3a, 0x9f, 0xb9, 0xd6, 0xf7, 0x99, 0x6b, 0xbd, 0xbb, 0xf7, 0xdf, 0x92, 0x9c, 0x64,
+	0x75, 0x57, 0x75, 0x09, 0x8b, 0xaa, 0xca, 0x0f, 0x7e, 0x0f, 0x00, 0xaa, 0xa0, 0xde, 0x37, 0x61,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +257,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastUnbondingId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ConsPubkeyRotations) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -425,6 +468,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.ConsPubkeyRotations) > 0 {
+		for _, e := range m.ConsPubkeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastUnbondingId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUnbondingId))
+	}
 	return n
 }
 
@@ -735,6 +787,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotations = append(m.ConsPubkeyRotations, ConsPubKeyRotationRecord{})
+			if err := m.ConsPubkeyRotations[len(m.ConsPubkeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingId", wireType)
+			}
+			m.LastUnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -55,6 +55,18 @@ func ValidateBasic(hi HistoricalInfo) error {
 	return nil
 }
 
+// GetValidator returns the validator with the given operator address from the
+// historical validator set.
+func (hi HistoricalInfo) GetValidator(valAddr sdk.ValAddress) (Validator, bool) {
+	for _, val := range hi.Valset {
+		if val.GetOperator().Equals(valAddr) {
+			return val, true
+		}
+	}
+
+	return Validator{}, false
+}
+
 // Equal checks if receiver is equal to the parameter
 func (hi *HistoricalInfo) Equal(hi2 *HistoricalInfo) bool {
 	if !proto.Equal(&hi.Header, &hi2.Header) {
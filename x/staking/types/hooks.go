@@ -1,6 +1,7 @@
 package types
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -94,3 +95,19 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 	}
 	return nil
 }
+func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	for i := range h {
+		if err := h[i].AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	for i := range h {
+		if err := h[i].AfterUnbondingInitiated(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -29,12 +29,17 @@ const (
 var (
 	// Keys for store prefixes
 	// Last* values are constant during a block.
-	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
-	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
+	LastValidatorPowerKey    = []byte{0x11} // prefix for each key to a validator index, for bonded validators
+	LastTotalPowerKey        = []byte{0x12} // prefix for the total power
+	LastMinCommissionRateKey = []byte{0x13} // key for the minimum commission rate last enforced on validators
+
+	PendingConsPubKeyRotationKey = []byte{0x14} // prefix for the consensus keys replaced during the current block, by validator operator
+	PendingMinSelfDelegationKey  = []byte{0x15} // prefix for the validators slashed during the current block, by validator operator
 
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorLiquidSharesKey  = []byte{0x24} // prefix for each key to the delegator shares of a validator owned by module accounts
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -43,11 +48,19 @@ var (
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
 
+	UnbondingIDKey    = []byte{0x37} // key for the counter of the unbonding ids
+	UnbondingIndexKey = []byte{0x38} // prefix for each key to an unbonding delegation, redelegation or validator, by unbonding id
+
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
+	ConsPubKeyRotationQueueKey = []byte{0x44} // prefix for the timestamps in consensus key rotation queue
+
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ConsPubKeyRotationKey = []byte{0x60} // prefix for each key to a consensus key rotation record, by validator operator
+	OldToNewConsAddrKey   = []byte{0x61} // prefix for each key to the consensus address replacing a rotated one
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -56,6 +69,13 @@ func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(ValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
 
+// GetValidatorLiquidSharesKey creates the key for the delegator shares of a
+// validator owned by module accounts
+// VALUE: sdk.DecProto
+func GetValidatorLiquidSharesKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
 // GetValidatorByConsAddrKey creates the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
@@ -347,3 +367,58 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetPendingConsPubKeyRotationKey creates the key for the consensus key a
+// validator replaced during the current block.
+// VALUE: the replaced consensus public key (Any)
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetPendingMinSelfDelegationKey creates the key for a validator whose
+// self-delegation is checked against its minimum at the end of the block.
+// VALUE: nil
+func GetPendingMinSelfDelegationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingMinSelfDelegationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationsKey returns a key prefix for indexing the consensus key
+// rotation records of a validator.
+func GetConsPubKeyRotationsKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationKey creates the key for the consensus key rotation
+// record of a validator away from the given consensus address.
+// VALUE: staking/ConsPubKeyRotationRecord
+func GetConsPubKeyRotationKey(valAddr sdk.ValAddress, oldConsAddr sdk.ConsAddress) []byte {
+	return append(GetConsPubKeyRotationsKey(valAddr), address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetOldToNewConsAddrKey creates the key for the consensus address which
+// replaced the given rotated consensus address.
+// VALUE: the new consensus address ([]byte)
+func GetOldToNewConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(OldToNewConsAddrKey, address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetConsPubKeyRotationTimeKey creates the prefix for the consensus key
+// rotation records expiring at the given time.
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ConsPubKeyRotationQueueKey, bz...)
+}
+
+// GetConsPubKeyRotationQueueKey creates the key for a consensus key rotation
+// record in the queue.
+// VALUE: the key of the consensus key rotation record
+func GetConsPubKeyRotationQueueKey(timestamp time.Time, valAddr sdk.ValAddress, oldConsAddr sdk.ConsAddress) []byte {
+	return append(GetConsPubKeyRotationTimeKey(timestamp), GetConsPubKeyRotationKey(valAddr, oldConsAddr)[1:]...)
+}
+
+// GetUnbondingIndexKey creates the key for the object an unbonding id was
+// assigned to.
+// VALUE: the key of the unbonding delegation, redelegation or validator
+func GetUnbondingIndexKey(id uint64) []byte {
+	return append(UnbondingIndexKey, sdk.Uint64ToBigEndian(id)...)
+}
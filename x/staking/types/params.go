@@ -30,11 +30,38 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// Default maximum number of consensus key rotations per validator within
+	// an unbonding period
+	DefaultMaxConsPubkeyRotations uint32 = 1
+
+	// Default maximum number of delegations unbonded by a single
+	// MsgUndelegateAll
+	DefaultMaxUndelegateAllPositions uint32 = 20
+
+	// DefaultEnforceMinSelfDelegation leaves the validators slashed below their
+	// minimum self-delegation bonded
+	DefaultEnforceMinSelfDelegation = false
+
+	// DefaultSlashFundCommunityPool burns the slashed tokens
+	DefaultSlashFundCommunityPool = false
+)
+
+// Destinations of the slashed tokens
+const (
+	SlashDestinationBurn          = "burn"
+	SlashDestinationCommunityPool = "community_pool"
 )
 
 var (
 	// DefaultMinCommissionRate is set to 0%
 	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultKeyRotationFee is set to 1000000 of the default bond denom
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultMaxValidatorPowerFraction is set to 100%, which disables the cap
+	DefaultMaxValidatorPowerFraction = sdk.OneDec()
 )
 
 var (
@@ -44,6 +71,14 @@ var (
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
 	KeyMinCommissionRate = []byte("MinCommissionRate")
+
+	KeyMaxConsPubkeyRotations = []byte("MaxConsPubkeyRotations")
+	KeyKeyRotationFee         = []byte("KeyRotationFee")
+
+	KeyMaxValidatorPowerFraction = []byte("MaxValidatorPowerFraction")
+	KeyMaxUndelegateAllPositions = []byte("MaxUndelegateAllPositions")
+	KeyEnforceMinSelfDelegation  = []byte("EnforceMinSelfDelegation")
+	KeySlashFundCommunityPool    = []byte("SlashFundCommunityPool")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -54,14 +89,25 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate sdk.Dec, maxConsPubKeyRotations uint32, keyRotationFee sdk.Coin,
+	maxValidatorPowerFraction sdk.Dec, maxUndelegateAllPositions uint32, enforceMinSelfDelegation bool,
+	slashFundCommunityPool bool,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		MaxConsPubkeyRotations:    maxConsPubKeyRotations,
+		KeyRotationFee:            keyRotationFee,
+		MaxValidatorPowerFraction: maxValidatorPowerFraction,
+		MaxUndelegateAllPositions: maxUndelegateAllPositions,
+		EnforceMinSelfDelegation:  enforceMinSelfDelegation,
+		SlashFundCommunityPool:    slashFundCommunityPool,
 	}
 }
 
@@ -74,6 +120,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyMaxConsPubkeyRotations, &p.MaxConsPubkeyRotations, validateMaxConsPubkeyRotations),
+		paramtypes.NewParamSetPair(KeyKeyRotationFee, &p.KeyRotationFee, validateKeyRotationFee),
+		paramtypes.NewParamSetPair(KeyMaxValidatorPowerFraction, &p.MaxValidatorPowerFraction, validateMaxValidatorPowerFraction),
+		paramtypes.NewParamSetPair(KeyMaxUndelegateAllPositions, &p.MaxUndelegateAllPositions, validateMaxUndelegateAllPositions),
+		paramtypes.NewParamSetPair(KeyEnforceMinSelfDelegation, &p.EnforceMinSelfDelegation, validateEnforceMinSelfDelegation),
+		paramtypes.NewParamSetPair(KeySlashFundCommunityPool, &p.SlashFundCommunityPool, validateSlashFundCommunityPool),
 	}
 }
 
@@ -86,6 +138,12 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultMaxConsPubkeyRotations,
+		DefaultKeyRotationFee,
+		DefaultMaxValidatorPowerFraction,
+		DefaultMaxUndelegateAllPositions,
+		DefaultEnforceMinSelfDelegation,
+		DefaultSlashFundCommunityPool,
 	)
 }
 
@@ -137,6 +195,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateMaxValidatorPowerFraction(p.MaxValidatorPowerFraction); err != nil {
+		return err
+	}
+
+	if err := validateMaxUndelegateAllPositions(p.MaxUndelegateAllPositions); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -233,3 +303,72 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxConsPubkeyRotations(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxValidatorPowerFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("max validator power fraction must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max validator power fraction cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxUndelegateAllPositions(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max undelegate all positions must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateEnforceMinSelfDelegation(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateSlashFundCommunityPool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
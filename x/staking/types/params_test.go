@@ -35,4 +35,22 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+
+	// validate max validator power fraction
+	params.MaxValidatorPowerFraction = sdk.ZeroDec()
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorPowerFraction = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorPowerFraction = sdk.NewDecWithPrec(1, 1)
+	require.NoError(t, params.Validate())
+
+	params = types.DefaultParams()
+
+	// validate max undelegate all positions
+	params.MaxUndelegateAllPositions = 0
+	require.Error(t, params.Validate())
 }
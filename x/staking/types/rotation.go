@@ -0,0 +1,78 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotationRecord{}
+
+// NewConsPubKeyRotationRecord creates a new ConsPubKeyRotationRecord instance.
+func NewConsPubKeyRotationRecord(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, completionTime time.Time, //nolint:interfacer
+) (ConsPubKeyRotationRecord, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationRecord{}, err
+	}
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationRecord{}, err
+	}
+
+	return ConsPubKeyRotationRecord{
+		ValidatorAddress: valAddr.String(),
+		OldConsPubkey:    oldPkAny,
+		NewConsPubkey:    newPkAny,
+		Height:           height,
+		CompletionTime:   completionTime,
+	}, nil
+}
+
+// GetOldConsPubKey returns the consensus public key the validator rotated away from.
+func (r ConsPubKeyRotationRecord) GetOldConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// GetNewConsPubKey returns the consensus public key the validator rotated to.
+func (r ConsPubKeyRotationRecord) GetNewConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ConsPubKeyRotationRecord) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(r.NewConsPubkey, &pk)
+}
+
+// MustMarshalConsPubKeyRotationRecord returns the consensus key rotation record bytes.
+// Panics if fails.
+func MustMarshalConsPubKeyRotationRecord(cdc codec.BinaryCodec, record ConsPubKeyRotationRecord) []byte {
+	return cdc.MustMarshal(&record)
+}
+
+// MustUnmarshalConsPubKeyRotationRecord unmarshals a consensus key rotation
+// record from a store value. Panics if fails.
+func MustUnmarshalConsPubKeyRotationRecord(cdc codec.BinaryCodec, value []byte) ConsPubKeyRotationRecord {
+	var record ConsPubKeyRotationRecord
+	cdc.MustUnmarshal(value, &record)
+	return record
+}
@@ -334,6 +334,10 @@ type Validator struct {
 	Commission Commission `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission"`
 	// min_self_delegation is the validator's self declared minimum self delegation.
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// unbonding_on_hold_ref_count is the number of holds preventing the validator from completing its unbonding.
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,12,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
+	// unbonding_ids are the unbonding ids the validator was assigned every time it began unbonding.
+	UnbondingIds []uint64 `protobuf:"varint,13,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -681,6 +685,10 @@ type UnbondingDelegationEntry struct {
 	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance"`
 	// balance defines the tokens to receive at completion.
 	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// unbonding_id is the unique id of the unbonding entry.
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// unbonding_on_hold_ref_count is the number of holds preventing the entry from completing.
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 }
 
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
@@ -729,6 +737,20 @@ func (m *UnbondingDelegationEntry) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *UnbondingDelegationEntry) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func (m *UnbondingDelegationEntry) GetUnbondingOnHoldRefCount() int64 {
+	if m != nil {
+		return m.UnbondingOnHoldRefCount
+	}
+	return 0
+}
+
 // RedelegationEntry defines a redelegation object with relevant metadata.
 type RedelegationEntry struct {
 	// creation_height  defines the height which the redelegation took place.
@@ -739,6 +761,10 @@ type RedelegationEntry struct {
 	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance"`
 	// shares_dst is the amount of destination-validator shares created by redelegation.
 	SharesDst github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares_dst,json=sharesDst,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_dst"`
+	// unbonding_id is the unique id of the redelegation entry.
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// unbonding_on_hold_ref_count is the number of holds preventing the entry from completing.
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 }
 
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
@@ -787,6 +813,20 @@ func (m *RedelegationEntry) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *RedelegationEntry) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func (m *RedelegationEntry) GetUnbondingOnHoldRefCount() int64 {
+	if m != nil {
+		return m.UnbondingOnHoldRefCount
+	}
+	return 0
+}
+
 // Redelegation contains the list of a particular delegator's redelegating bonds
 // from a particular source validator to a particular destination validator.
 type Redelegation struct {
@@ -846,6 +886,22 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// max_cons_pubkey_rotations is the maximum number of consensus key rotations a validator can perform within an
+	// unbonding period. A value of zero disables consensus key rotation.
+	MaxConsPubkeyRotations uint32 `protobuf:"varint,7,opt,name=max_cons_pubkey_rotations,json=maxConsPubkeyRotations,proto3" json:"max_cons_pubkey_rotations,omitempty" yaml:"max_cons_pubkey_rotations"`
+	// key_rotation_fee is the fee burned from the validator operator on every consensus key rotation.
+	KeyRotationFee types2.Coin `protobuf:"bytes,8,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// max_validator_power_fraction is the maximum fraction of the bonded tokens a single validator can be delegated
+	// up to through delegations and redelegations. A value of one disables the cap.
+	MaxValidatorPowerFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_validator_power_fraction,json=maxValidatorPowerFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_power_fraction" yaml:"max_validator_power_fraction"`
+	// max_undelegate_all_positions is the maximum number of delegations a single MsgUndelegateAll unbonds, the
+	// remaining ones are left for a follow-up message.
+	MaxUndelegateAllPositions uint32 `protobuf:"varint,10,opt,name=max_undelegate_all_positions,json=maxUndelegateAllPositions,proto3" json:"max_undelegate_all_positions,omitempty" yaml:"max_undelegate_all_positions"`
+	// enforce_min_self_delegation enables jailing, at the end of the block, the validators whose self-delegation was
+	// slashed below their min_self_delegation.
+	EnforceMinSelfDelegation bool `protobuf:"varint,11,opt,name=enforce_min_self_delegation,json=enforceMinSelfDelegation,proto3" json:"enforce_min_self_delegation,omitempty" yaml:"enforce_min_self_delegation"`
+	// slash_fund_community_pool sends the slashed tokens to the community pool instead of burning them.
+	SlashFundCommunityPool bool `protobuf:"varint,12,opt,name=slash_fund_community_pool,json=slashFundCommunityPool,proto3" json:"slash_fund_community_pool,omitempty" yaml:"slash_fund_community_pool"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -915,6 +971,90 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxConsPubkeyRotations() uint32 {
+	if m != nil {
+		return m.MaxConsPubkeyRotations
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+func (m *Params) GetMaxUndelegateAllPositions() uint32 {
+	if m != nil {
+		return m.MaxUndelegateAllPositions
+	}
+	return 0
+}
+
+func (m *Params) GetEnforceMinSelfDelegation() bool {
+	if m != nil {
+		return m.EnforceMinSelfDelegation
+	}
+	return false
+}
+
+func (m *Params) GetSlashFundCommunityPool() bool {
+	if m != nil {
+		return m.SlashFundCommunityPool
+	}
+	return false
+}
+
+// ConsPubKeyRotationRecord records a consensus key rotation of a validator. It
+// is kept for an unbonding period, during which infractions committed with the
+// old consensus key are still attributed to the validator.
+type ConsPubKeyRotationRecord struct {
+	// validator_address defines the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key the validator rotated away from.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key the validator rotated to.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the block height at which the rotation happened.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// completion_time is the time at which the record expires.
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *ConsPubKeyRotationRecord) Reset()         { *m = ConsPubKeyRotationRecord{} }
+func (m *ConsPubKeyRotationRecord) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationRecord) ProtoMessage()    {}
+func (*ConsPubKeyRotationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
+}
+func (m *ConsPubKeyRotationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationRecord.Merge(m, src)
+}
+func (m *ConsPubKeyRotationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationRecord proto.InternalMessageInfo
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -925,7 +1065,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,7 +1120,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1168,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1222,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1129,6 +1269,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*ConsPubKeyRotationRecord)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationRecord")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1140,112 +1281,135 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
-	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x53, 0xab, 0x4e, 0x5c,
-	0x86, 0x94, 0x55, 0xf4, 0x07, 0x5d, 0x0c, 0x77, 0x47, 0xd4, 0x54, 0xbb, 0xb3, 0xc4, 0xce, 0xd0,
-	0x15, 0x81, 0x16, 0x28, 0xd0, 0x4b, 0xea, 0x53, 0x8e, 0xb9, 0x18, 0x30, 0x90, 0x1e, 0x73, 0x0c,
-	0x7a, 0xe9, 0xa1, 0xd7, 0x34, 0x27, 0x23, 0xa7, 0xa6, 0x2d, 0xd4, 0xc2, 0xbe, 0x14, 0x3d, 0x15,
-	0xb9, 0xb7, 0x28, 0xe6, 0x67, 0x7f, 0x4c, 0x8a, 0x8a, 0x14, 0xa8, 0x40, 0x00, 0x5f, 0xec, 0x9d,
-	0x99, 0xf7, 0xbe, 0x79, 0xef, 0x7b, 0x3f, 0x7a, 0x43, 0x78, 0xd1, 0x09, 0xb8, 0x1f, 0xf0, 0x4d,
-	0x2e, 0xf0, 0x11, 0x65, 0xc3, 0xcd, 0x7b, 0x37, 0x06, 0x44, 0xe0, 0x1b, 0xd1, 0xba, 0x39, 0x0a,
-	0x03, 0x11, 0xa0, 0x17, 0xb4, 0x54, 0x33, 0xda, 0x35, 0x52, 0x95, 0x8d, 0x61, 0x30, 0x0c, 0x94,
-	0xc8, 0xa6, 0xfc, 0xd2, 0xd2, 0x95, 0xab, 0xc3, 0x20, 0x18, 0x7a, 0x64, 0x53, 0xad, 0x06, 0xe3,
-	0x83, 0x4d, 0xcc, 0x26, 0xe6, 0xa8, 0x3a, 0x7d, 0xe4, 0x8e, 0x43, 0x2c, 0x68, 0xc0, 0xcc, 0x79,
-	0x6d, 0xfa, 0x5c, 0x50, 0x9f, 0x70, 0x81, 0xfd, 0x51, 0x84, 0xad, 0x2d, 0xb1, 0xf5, 0xa5, 0xc6,
-	0x2c, 0x83, 0x6d, 0x5c, 0x19, 0x60, 0x4e, 0x62, 0x3f, 0x9c, 0x80, 0x46, 0xd8, 0x5f, 0x16, 0x84,
-	0xb9, 0x24, 0xf4, 0x29, 0x13, 0x9b, 0x62, 0x32, 0x22, 0x5c, 0xff, 0xab, 0x4f, 0x1b, 0xbf, 0xb5,
-	0x60, 0xf5, 0x16, 0xe5, 0x22, 0x08, 0xa9, 0x83, 0xbd, 0x5d, 0x76, 0x10, 0xa0, 0xd7, 0x20, 0x7f,
-	0x48, 0xb0, 0x4b, 0xc2, 0xb2, 0x55, 0xb7, 0xae, 0x15, 0xb7, 0xca, 0xcd, 0x04, 0xa1, 0xa9, 0x75,
-	0x6f, 0xa9, 0xf3, 0x56, 0xee, 0xc3, 0x93, 0x5a, 0xa6, 0x67, 0xa4, 0xd1, 0x77, 0x21, 0x7f, 0x0f,
-	0x7b, 0x9c, 0x88, 0x72, 0xb6, 0xbe, 0x70, 0xad, 0xb8, 0xf5, 0xd5, 0xe6, 0xe9, 0xf4, 0x35, 0xf7,
-	0xb1, 0x47, 0x5d, 0x2c, 0x82, 0x18, 0x40, 0xab, 0x35, 0xde, 0xcf, 0x42, 0x69, 0x27, 0xf0, 0x7d,
-	0xca, 0x39, 0x0d, 0x58, 0x0f, 0x0b, 0xc2, 0x51, 0x17, 0x72, 0x21, 0x16, 0x44, 0x99, 0xb2, 0xd4,
-	0xfa, 0x8e, 0x94, 0xff, 0xcb, 0x49, 0xed, 0xa5, 0x21, 0x15, 0x87, 0xe3, 0x41, 0xd3, 0x09, 0x7c,
-	0x43, 0x86, 0xf9, 0xef, 0x3a, 0x77, 0x8f, 0x8c, 0x7f, 0x6d, 0xe2, 0x7c, 0xfc, 0xc1, 0x75, 0x30,
-	0x36, 0xb4, 0x89, 0xd3, 0x53, 0x48, 0xe8, 0x87, 0x50, 0xf0, 0xf1, 0xb1, 0xad, 0x50, 0xb3, 0x97,
-	0x80, 0xba, 0xe8, 0xe3, 0x63, 0x69, 0x2b, 0x72, 0xa1, 0x24, 0x81, 0x9d, 0x43, 0xcc, 0x86, 0x44,
-	0xe3, 0x2f, 0x5c, 0x02, 0xfe, 0x8a, 0x8f, 0x8f, 0x77, 0x14, 0xa6, 0xbc, 0x65, 0xbb, 0xf0, 0xee,
-	0xc3, 0x5a, 0xe6, 0x9f, 0x0f, 0x6b, 0x56, 0xe3, 0x0f, 0x16, 0x40, 0x42, 0x17, 0xfa, 0x29, 0xac,
-	0x39, 0xf1, 0x4a, 0x5d, 0xcf, 0x4d, 0x00, 0x5f, 0x9e, 0x17, 0x88, 0x29, 0xb2, 0x5b, 0x05, 0x69,
-	0xe8, 0xa3, 0x93, 0x9a, 0xd5, 0x2b, 0x39, 0x53, 0x71, 0xe8, 0x40, 0x71, 0x3c, 0x72, 0xb1, 0x20,
-	0xb6, 0x4c, 0x4d, 0x45, 0x5c, 0x71, 0xab, 0xd2, 0xd4, 0x79, 0xdb, 0x8c, 0xf2, 0xb6, 0xb9, 0x17,
-	0xe5, 0xad, 0xc6, 0x7a, 0xe7, 0xef, 0x35, 0xab, 0x07, 0x5a, 0x51, 0x1e, 0xa5, 0xac, 0x7f, 0xdf,
-	0x82, 0x62, 0x9b, 0x70, 0x27, 0xa4, 0x23, 0x59, 0x08, 0xa8, 0x0c, 0x8b, 0x7e, 0xc0, 0xe8, 0x91,
-	0x49, 0xbb, 0xa5, 0x5e, 0xb4, 0x44, 0x15, 0x28, 0x50, 0x97, 0x30, 0x41, 0xc5, 0x44, 0x07, 0xac,
-	0x17, 0xaf, 0xa5, 0xd6, 0x2f, 0xc8, 0x80, 0xd3, 0x88, 0xeb, 0x5e, 0xb4, 0x44, 0xaf, 0xc0, 0x1a,
-	0x27, 0xce, 0x38, 0xa4, 0x62, 0x62, 0x3b, 0x01, 0x13, 0xd8, 0x11, 0xe5, 0x9c, 0x12, 0x29, 0x45,
-	0xfb, 0x3b, 0x7a, 0x5b, 0x82, 0xb8, 0x44, 0x60, 0xea, 0xf1, 0xf2, 0x73, 0x1a, 0xc4, 0x2c, 0x53,
-	0xe6, 0xfe, 0x29, 0x0f, 0x4b, 0x71, 0xde, 0xa2, 0x1d, 0x58, 0x0b, 0x46, 0x24, 0x94, 0xdf, 0x36,
-	0x76, 0xdd, 0x90, 0x70, 0x6e, 0x32, 0xb4, 0xfc, 0xf1, 0x07, 0xd7, 0x37, 0x0c, 0xdd, 0x37, 0xf5,
-	0x49, 0x5f, 0x84, 0x94, 0x0d, 0x7b, 0xa5, 0x48, 0xc3, 0x6c, 0xa3, 0x1f, 0xc9, 0x80, 0x31, 0x4e,
-	0x18, 0x1f, 0x73, 0x7b, 0x34, 0x1e, 0x1c, 0x91, 0x89, 0xe1, 0x75, 0x63, 0x86, 0xd7, 0x9b, 0x6c,
-	0xd2, 0x2a, 0x7f, 0x94, 0x40, 0x3b, 0xe1, 0x64, 0x24, 0x82, 0x66, 0x77, 0x3c, 0xb8, 0x4d, 0x26,
-	0x32, 0x5a, 0x06, 0xa7, 0xab, 0x60, 0xd0, 0x0b, 0x90, 0xff, 0x39, 0xa6, 0x1e, 0x71, 0x15, 0x2b,
-	0x85, 0x9e, 0x59, 0xa1, 0x6d, 0xc8, 0x73, 0x81, 0xc5, 0x98, 0x2b, 0x2a, 0x56, 0xb7, 0x1a, 0xf3,
-	0x32, 0xa3, 0x15, 0x30, 0xb7, 0xaf, 0x24, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x5e, 0x04, 0x47, 0x84,
-	0x19, 0x92, 0x2e, 0x94, 0xd5, 0xbb, 0x4c, 0xa4, 0xb2, 0x7a, 0x97, 0x89, 0x9e, 0xc1, 0x42, 0x43,
-	0x58, 0x73, 0x89, 0x47, 0x86, 0x8a, 0x4a, 0x7e, 0x88, 0x43, 0xc2, 0xcb, 0xf9, 0x4b, 0xa8, 0x9a,
-	0x52, 0x8c, 0xda, 0x57, 0xa0, 0xe8, 0x36, 0x14, 0xdd, 0x24, 0xdd, 0xca, 0x8b, 0x8a, 0xe8, 0xaf,
-	0xcd, 0xf3, 0x3f, 0x95, 0x99, 0xa6, 0x49, 0xa5, 0xb5, 0x65, 0x72, 0x8d, 0xd9, 0x20, 0x60, 0x2e,
-	0x65, 0x43, 0xfb, 0x90, 0xd0, 0xe1, 0xa1, 0x28, 0x17, 0xea, 0xd6, 0xb5, 0x85, 0x5e, 0x29, 0xde,
-	0xbf, 0xa5, 0xb6, 0xd1, 0x6d, 0x58, 0x4d, 0x44, 0x55, 0xed, 0x2c, 0x5d, 0xa0, 0x76, 0x56, 0x62,
-	0x5d, 0x79, 0x8a, 0x6e, 0x01, 0x24, 0x85, 0x59, 0x06, 0x05, 0xd4, 0xf8, 0xec, 0xea, 0x36, 0x2e,
-	0xa4, 0x74, 0x91, 0x07, 0x57, 0x7c, 0xca, 0x6c, 0x4e, 0xbc, 0x03, 0xdb, 0x50, 0x25, 0x21, 0x8b,
-	0x97, 0x10, 0xda, 0x75, 0x9f, 0xb2, 0x3e, 0xf1, 0x0e, 0xda, 0x31, 0xec, 0xf6, 0xf2, 0xdb, 0x0f,
-	0x6b, 0x19, 0x53, 0x4b, 0x99, 0x46, 0x17, 0x96, 0xf7, 0xb1, 0x67, 0xca, 0x80, 0x70, 0xf4, 0x1a,
-	0x2c, 0xe1, 0x68, 0x51, 0xb6, 0xea, 0x0b, 0x67, 0x96, 0x51, 0x22, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x5b, 0x8d, 0xdf, 0x59, 0x90, 0x6f, 0xef, 0x77, 0x31, 0x0d, 0x51, 0x07, 0xd6, 0x93, 0x84,
-	0x3a, 0x6f, 0x6d, 0x26, 0x39, 0x18, 0x15, 0x67, 0x07, 0xd6, 0xef, 0x45, 0xe5, 0x1e, 0xc3, 0x64,
-	0x3f, 0x0b, 0x26, 0x56, 0x31, 0xfb, 0x53, 0x8e, 0x77, 0x60, 0x51, 0x5b, 0xc9, 0xd1, 0x36, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xe2, 0x56, 0x75, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0xd2,
-	0xf8, 0x8f, 0x05, 0xd0, 0xde, 0xdf, 0xdf, 0x0b, 0xe9, 0xc8, 0x23, 0xe2, 0xb2, 0x3c, 0x7e, 0x03,
-	0x9e, 0x4f, 0x3c, 0xe6, 0xa1, 0x73, 0x6e, 0xaf, 0xaf, 0xc4, 0x6a, 0xfd, 0xd0, 0x39, 0x15, 0xcd,
-	0xe5, 0x22, 0x46, 0x5b, 0x38, 0x37, 0x5a, 0x9b, 0x8b, 0xd3, 0x69, 0xec, 0x43, 0x31, 0x71, 0x9f,
-	0xa3, 0x36, 0x14, 0x84, 0xf9, 0x36, 0x6c, 0x36, 0xe6, 0xb3, 0x19, 0xa9, 0x19, 0x46, 0x63, 0xcd,
-	0xc6, 0x7f, 0x25, 0xa9, 0x71, 0xc6, 0x7e, 0xb1, 0xd2, 0x48, 0xf6, 0x5e, 0xd3, 0x1b, 0x2f, 0x63,
-	0xa2, 0x30, 0x58, 0x53, 0xac, 0xfe, 0x26, 0x0b, 0x57, 0xee, 0x46, 0xdd, 0xe6, 0x0b, 0xcb, 0x44,
-	0x17, 0x16, 0x09, 0x13, 0x21, 0x55, 0x54, 0xc8, 0x58, 0x7f, 0x73, 0x5e, 0xac, 0x4f, 0xf1, 0xa5,
-	0xc3, 0x44, 0x38, 0x31, 0x91, 0x8f, 0x60, 0xa6, 0x58, 0xf8, 0x6b, 0x16, 0xca, 0xf3, 0x34, 0xd1,
-	0xcb, 0x50, 0x72, 0x42, 0xa2, 0x36, 0xa2, 0xae, 0x6f, 0xa9, 0xae, 0xbf, 0x1a, 0x6d, 0x9b, 0xa6,
-	0xff, 0x26, 0xc8, 0x01, 0x4a, 0x26, 0x96, 0x14, 0xbd, 0xf0, 0xc4, 0xb4, 0x9a, 0x28, 0xab, 0xb6,
-	0x4f, 0xa0, 0x44, 0x19, 0x15, 0x14, 0x7b, 0xf6, 0x00, 0x7b, 0x98, 0x39, 0x9f, 0x67, 0xb2, 0x9c,
-	0x6d, 0xd4, 0xab, 0x06, 0xb4, 0xa5, 0x31, 0xd1, 0x3e, 0x2c, 0x46, 0xf0, 0xb9, 0x4b, 0x80, 0x8f,
-	0xc0, 0x52, 0x53, 0xd4, 0x27, 0x59, 0x58, 0xef, 0x11, 0xf7, 0xd9, 0xa2, 0xf5, 0x27, 0x00, 0xba,
-	0xe0, 0x64, 0x1f, 0xfc, 0x1c, 0xcc, 0xce, 0x16, 0xf0, 0x92, 0xc6, 0x6b, 0x73, 0x91, 0xe2, 0xf6,
-	0xa3, 0x2c, 0x2c, 0xa7, 0xb9, 0x7d, 0x06, 0xfe, 0x2e, 0xa0, 0xdd, 0xa4, 0x1b, 0xe4, 0x54, 0x37,
-	0x78, 0x65, 0x5e, 0x37, 0x98, 0xc9, 0xba, 0xb3, 0xdb, 0xc0, 0xa7, 0x59, 0xc8, 0x77, 0x71, 0x88,
-	0x7d, 0x8e, 0xbe, 0x3f, 0x33, 0xc0, 0xe9, 0x57, 0xd5, 0xd5, 0x99, 0x9c, 0x6b, 0x9b, 0x47, 0xbd,
-	0x4e, 0xb9, 0x77, 0x4f, 0x99, 0xdf, 0xbe, 0x0e, 0xab, 0xf2, 0x89, 0x18, 0xbb, 0xa2, 0x49, 0x5c,
-	0x51, 0x6f, 0xbc, 0xf8, 0x75, 0xc1, 0x51, 0x0d, 0x8a, 0x52, 0x2c, 0x69, 0x74, 0x52, 0x06, 0x7c,
-	0x7c, 0xdc, 0xd1, 0x3b, 0xe8, 0x3a, 0xa0, 0xc3, 0xf8, 0xd1, 0x6e, 0x27, 0x14, 0x48, 0xb9, 0xf5,
-	0xe4, 0x24, 0x12, 0xff, 0x0a, 0x80, 0xb4, 0xc2, 0x76, 0x09, 0x0b, 0x7c, 0xf3, 0xc6, 0x59, 0x92,
-	0x3b, 0x6d, 0xb9, 0x81, 0x7e, 0xa9, 0x67, 0xc1, 0xa9, 0xd7, 0xa3, 0x19, 0xc3, 0xdf, 0xb8, 0x58,
-	0xa6, 0x7e, 0x7a, 0x52, 0xab, 0x4c, 0xb0, 0xef, 0x6d, 0x37, 0x4e, 0x81, 0x6c, 0xa8, 0xd9, 0xf0,
-	0xe9, 0x57, 0x67, 0x2a, 0x83, 0xdf, 0xb3, 0x00, 0x25, 0x2d, 0xb7, 0x47, 0xf8, 0x48, 0x3e, 0x6b,
-	0xe4, 0xd0, 0x9b, 0x9a, 0x50, 0xad, 0xb3, 0x87, 0xde, 0x44, 0x3f, 0x1a, 0x7a, 0x53, 0x15, 0xf1,
-	0xed, 0xa4, 0xc1, 0x65, 0x4d, 0x0c, 0x0d, 0xcc, 0x00, 0x73, 0x92, 0x1a, 0x9c, 0x69, 0xa4, 0x3d,
-	0xd3, 0xc3, 0x32, 0x8d, 0x4f, 0x2c, 0xb8, 0x3a, 0x93, 0x4d, 0xb1, 0xb1, 0x3f, 0x03, 0x14, 0xa6,
-	0x0e, 0x55, 0x6c, 0x26, 0xc6, 0xe8, 0x0b, 0x27, 0xe7, 0x7a, 0x38, 0xd3, 0x2b, 0xff, 0x5f, 0x3d,
-	0x3a, 0xa7, 0x22, 0xf0, 0x47, 0x0b, 0x36, 0xd2, 0xc6, 0xc4, 0x6e, 0xdd, 0x81, 0xe5, 0xb4, 0x2d,
-	0xc6, 0xa1, 0x17, 0xcf, 0xe3, 0x90, 0xf1, 0xe5, 0x29, 0x7d, 0xf4, 0x56, 0x52, 0xb8, 0xfa, 0xc7,
-	0xa2, 0x1b, 0xe7, 0xe6, 0x26, 0xb2, 0x69, 0xba, 0x80, 0x73, 0xd1, 0x14, 0x93, 0xeb, 0x06, 0x81,
-	0x87, 0x7e, 0x05, 0xeb, 0x2c, 0x10, 0xb6, 0xcc, 0x72, 0xe2, 0xda, 0xe6, 0xe5, 0xaa, 0xbb, 0xdf,
-	0x5b, 0x17, 0xa3, 0xec, 0x5f, 0x27, 0xb5, 0x59, 0xa8, 0x29, 0x1e, 0x4b, 0x2c, 0x10, 0x2d, 0x75,
-	0xbe, 0xa7, 0xdf, 0xb5, 0x21, 0xac, 0x3c, 0x7d, 0xb5, 0xee, 0x96, 0x6f, 0x5e, 0xf8, 0xea, 0x95,
-	0xb3, 0xae, 0x5d, 0x1e, 0xa4, 0xee, 0xdc, 0x2e, 0xc8, 0x18, 0xfe, 0xfb, 0x61, 0xcd, 0xfa, 0xc6,
-	0xef, 0x2d, 0x80, 0xe4, 0x09, 0x8f, 0x5e, 0x85, 0x2f, 0xb5, 0x7e, 0x70, 0xa7, 0x6d, 0xf7, 0xf7,
-	0x6e, 0xee, 0xdd, 0xed, 0xdb, 0x77, 0xef, 0xf4, 0xbb, 0x9d, 0x9d, 0xdd, 0xd7, 0x77, 0x3b, 0xed,
-	0xb5, 0x4c, 0xa5, 0x74, 0xff, 0x41, 0xbd, 0x78, 0x97, 0xf1, 0x11, 0x71, 0xe8, 0x01, 0x25, 0x2e,
-	0x7a, 0x09, 0x36, 0x9e, 0x96, 0x96, 0xab, 0x4e, 0x7b, 0xcd, 0xaa, 0x2c, 0xdf, 0x7f, 0x50, 0x2f,
-	0xe8, 0xe9, 0x88, 0xb8, 0xe8, 0x1a, 0x3c, 0x3f, 0x2b, 0xb7, 0x7b, 0xe7, 0x7b, 0x6b, 0xd9, 0xca,
-	0xca, 0xfd, 0x07, 0xf5, 0xa5, 0x78, 0x8c, 0x42, 0x0d, 0x40, 0x69, 0x49, 0x83, 0xb7, 0x50, 0x81,
-	0xfb, 0x0f, 0xea, 0x79, 0x4d, 0x5b, 0x25, 0xf7, 0xf6, 0x7b, 0xd5, 0x4c, 0xeb, 0xf5, 0x0f, 0x1f,
-	0x57, 0xad, 0x47, 0x8f, 0xab, 0xd6, 0x3f, 0x1e, 0x57, 0xad, 0x77, 0x9e, 0x54, 0x33, 0x8f, 0x9e,
-	0x54, 0x33, 0x7f, 0x7e, 0x52, 0xcd, 0xfc, 0xf8, 0xd5, 0x33, 0x19, 0x3b, 0x8e, 0x7f, 0xc9, 0x55,
-	0xdc, 0x0d, 0xf2, 0xaa, 0x29, 0x7f, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x0e, 0x8f,
-	0x94, 0xe8, 0x15, 0x00, 0x00,
+	// 2042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0x8a, 0x0c, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x76, 0xec, 0x15, 0x93, 0x8a, 0xcc, 0xda,
+	0xb5, 0x9d, 0x22, 0xa6, 0x6a, 0x17, 0x08, 0x50, 0x21, 0x40, 0x61, 0x8a, 0x52, 0xa5, 0x3a, 0x76,
+	0x98, 0xd5, 0x4f, 0xd1, 0x1f, 0x74, 0xb1, 0xdc, 0x1d, 0x92, 0x5b, 0x2d, 0x67, 0x88, 0x9d, 0xa1,
+	0x2d, 0x02, 0x2d, 0x50, 0xb4, 0x3d, 0xb8, 0x3e, 0xe5, 0x54, 0xe4, 0x62, 0xc0, 0x40, 0x7a, 0xcc,
+	0x31, 0xe8, 0xa1, 0x2d, 0xd0, 0x6b, 0x90, 0x93, 0x91, 0x53, 0x5b, 0x14, 0x6a, 0x61, 0x5f, 0x8a,
+	0x9e, 0x0a, 0xdf, 0x5b, 0x14, 0x33, 0x3b, 0xfb, 0x23, 0x4a, 0x94, 0x25, 0x43, 0x2d, 0x02, 0xe4,
+	0x62, 0x73, 0xe7, 0xbd, 0xf7, 0xcd, 0xbc, 0x6f, 0xde, 0x7b, 0x33, 0x6f, 0x04, 0x97, 0x1d, 0xca,
+	0x7a, 0x94, 0x2d, 0x31, 0x6e, 0xef, 0x7a, 0xa4, 0xb3, 0x74, 0xef, 0x46, 0x0b, 0x73, 0xfb, 0x46,
+	0xf4, 0x5d, 0xeb, 0x07, 0x94, 0x53, 0x74, 0x21, 0xd4, 0xaa, 0x45, 0xa3, 0x4a, 0xab, 0x7c, 0xbe,
+	0x43, 0x3b, 0x54, 0xaa, 0x2c, 0x89, 0x5f, 0xa1, 0x76, 0x79, 0xa1, 0x43, 0x69, 0xc7, 0xc7, 0x4b,
+	0xf2, 0xab, 0x35, 0x68, 0x2f, 0xd9, 0x64, 0xa8, 0x44, 0x8b, 0xa3, 0x22, 0x77, 0x10, 0xd8, 0xdc,
+	0xa3, 0x44, 0xc9, 0x2b, 0xa3, 0x72, 0xee, 0xf5, 0x30, 0xe3, 0x76, 0xaf, 0x1f, 0x61, 0x87, 0x2b,
+	0xb1, 0xc2, 0x49, 0xd5, 0xb2, 0x14, 0xb6, 0x72, 0xa5, 0x65, 0x33, 0x1c, 0xfb, 0xe1, 0x50, 0x2f,
+	0xc2, 0x7e, 0x9d, 0x63, 0xe2, 0xe2, 0xa0, 0xe7, 0x11, 0xbe, 0xc4, 0x87, 0x7d, 0xcc, 0xc2, 0x7f,
+	0x43, 0xa9, 0xf1, 0x2b, 0x0d, 0x66, 0xd7, 0x3d, 0xc6, 0x69, 0xe0, 0x39, 0xb6, 0xbf, 0x41, 0xda,
+	0x14, 0xbd, 0x0d, 0xf9, 0x2e, 0xb6, 0x5d, 0x1c, 0xe8, 0x5a, 0x55, 0xbb, 0x56, 0xbc, 0xa9, 0xd7,
+	0x12, 0x84, 0x5a, 0x68, 0xbb, 0x2e, 0xe5, 0xf5, 0xdc, 0xa7, 0xfb, 0x95, 0x8c, 0xa9, 0xb4, 0xd1,
+	0xb7, 0x20, 0x7f, 0xcf, 0xf6, 0x19, 0xe6, 0xfa, 0x44, 0x35, 0x7b, 0xad, 0x78, 0xf3, 0x8d, 0xda,
+	0xd1, 0xf4, 0xd5, 0x76, 0x6c, 0xdf, 0x73, 0x6d, 0x4e, 0x63, 0x80, 0xd0, 0xcc, 0xf8, 0x78, 0x02,
+	0x4a, 0x2b, 0xb4, 0xd7, 0xf3, 0x18, 0xf3, 0x28, 0x31, 0x6d, 0x8e, 0x19, 0x6a, 0x42, 0x2e, 0xb0,
+	0x39, 0x96, 0x4b, 0x99, 0xaa, 0xbf, 0x23, 0xf4, 0xff, 0xb2, 0x5f, 0xb9, 0xd2, 0xf1, 0x78, 0x77,
+	0xd0, 0xaa, 0x39, 0xb4, 0xa7, 0xc8, 0x50, 0xff, 0x5d, 0x67, 0xee, 0xae, 0xf2, 0xaf, 0x81, 0x9d,
+	0xcf, 0x3f, 0xb9, 0x0e, 0x6a, 0x0d, 0x0d, 0xec, 0x98, 0x12, 0x09, 0x7d, 0x17, 0x0a, 0x3d, 0x7b,
+	0xcf, 0x92, 0xa8, 0x13, 0x67, 0x80, 0x3a, 0xd9, 0xb3, 0xf7, 0xc4, 0x5a, 0x91, 0x0b, 0x25, 0x01,
+	0xec, 0x74, 0x6d, 0xd2, 0xc1, 0x21, 0x7e, 0xf6, 0x0c, 0xf0, 0x67, 0x7a, 0xf6, 0xde, 0x8a, 0xc4,
+	0x14, 0xb3, 0x2c, 0x17, 0x3e, 0x7c, 0x5c, 0xc9, 0xfc, 0xe3, 0x71, 0x45, 0x33, 0x7e, 0xaf, 0x01,
+	0x24, 0x74, 0xa1, 0x1f, 0xc2, 0x9c, 0x13, 0x7f, 0xc9, 0xe9, 0x99, 0xda, 0xc0, 0xab, 0xe3, 0x36,
+	0x62, 0x84, 0xec, 0x7a, 0x41, 0x2c, 0xf4, 0xc9, 0x7e, 0x45, 0x33, 0x4b, 0xce, 0xc8, 0x3e, 0xac,
+	0x42, 0x71, 0xd0, 0x77, 0x6d, 0x8e, 0x2d, 0x11, 0x9a, 0x92, 0xb8, 0xe2, 0xcd, 0x72, 0x2d, 0x8c,
+	0xdb, 0x5a, 0x14, 0xb7, 0xb5, 0xad, 0x28, 0x6e, 0x43, 0xac, 0x0f, 0xfe, 0x56, 0xd1, 0x4c, 0x08,
+	0x0d, 0x85, 0x28, 0xb5, 0xfa, 0x8f, 0x35, 0x28, 0x36, 0x30, 0x73, 0x02, 0xaf, 0x2f, 0x12, 0x01,
+	0xe9, 0x30, 0xd9, 0xa3, 0xc4, 0xdb, 0x55, 0x61, 0x37, 0x65, 0x46, 0x9f, 0xa8, 0x0c, 0x05, 0xcf,
+	0xc5, 0x84, 0x7b, 0x7c, 0x18, 0x6e, 0x98, 0x19, 0x7f, 0x0b, 0xab, 0xfb, 0xb8, 0xc5, 0xbc, 0x88,
+	0x6b, 0x33, 0xfa, 0x44, 0x6f, 0xc2, 0x1c, 0xc3, 0xce, 0x20, 0xf0, 0xf8, 0xd0, 0x72, 0x28, 0xe1,
+	0xb6, 0xc3, 0xf5, 0x9c, 0x54, 0x29, 0x45, 0xe3, 0x2b, 0xe1, 0xb0, 0x00, 0x71, 0x31, 0xb7, 0x3d,
+	0x9f, 0xe9, 0xaf, 0x84, 0x20, 0xea, 0x33, 0xbd, 0xdc, 0x49, 0x98, 0x8a, 0xe3, 0x16, 0xad, 0xc0,
+	0x1c, 0xed, 0xe3, 0x40, 0xfc, 0xb6, 0x6c, 0xd7, 0x0d, 0x30, 0x63, 0x2a, 0x42, 0xf5, 0xcf, 0x3f,
+	0xb9, 0x7e, 0x5e, 0xd1, 0x7d, 0x2b, 0x94, 0x6c, 0xf2, 0xc0, 0x23, 0x1d, 0xb3, 0x14, 0x59, 0xa8,
+	0x61, 0xf4, 0x3d, 0xb1, 0x61, 0x84, 0x61, 0xc2, 0x06, 0xcc, 0xea, 0x0f, 0x5a, 0xbb, 0x78, 0xa8,
+	0x78, 0x3d, 0x7f, 0x88, 0xd7, 0x5b, 0x64, 0x58, 0xd7, 0x3f, 0x4b, 0xa0, 0x9d, 0x60, 0xd8, 0xe7,
+	0xb4, 0xd6, 0x1c, 0xb4, 0x6e, 0xe3, 0xa1, 0x59, 0x8a, 0x71, 0x9a, 0x12, 0x06, 0x5d, 0x80, 0xfc,
+	0x8f, 0x6d, 0xcf, 0xc7, 0xae, 0x64, 0xa5, 0x60, 0xaa, 0x2f, 0xb4, 0x0c, 0x79, 0xc6, 0x6d, 0x3e,
+	0x60, 0x92, 0x8a, 0xd9, 0x9b, 0xc6, 0xb8, 0xc8, 0xa8, 0x53, 0xe2, 0x6e, 0x4a, 0x4d, 0x53, 0x59,
+	0xa0, 0x2d, 0xc8, 0x73, 0xba, 0x8b, 0x89, 0x22, 0xe9, 0x54, 0x51, 0xbd, 0x41, 0x78, 0x2a, 0xaa,
+	0x37, 0x08, 0x37, 0x15, 0x16, 0xea, 0xc0, 0x9c, 0x8b, 0x7d, 0xdc, 0x91, 0x54, 0xb2, 0xae, 0x1d,
+	0x60, 0xa6, 0xe7, 0xcf, 0x20, 0x6b, 0x4a, 0x31, 0xea, 0xa6, 0x04, 0x45, 0xb7, 0xa1, 0xe8, 0x26,
+	0xe1, 0xa6, 0x4f, 0x4a, 0xa2, 0x2f, 0x8d, 0xf3, 0x3f, 0x15, 0x99, 0xaa, 0x48, 0xa5, 0xad, 0x45,
+	0x70, 0x0d, 0x48, 0x8b, 0x12, 0xd7, 0x23, 0x1d, 0xab, 0x8b, 0xbd, 0x4e, 0x97, 0xeb, 0x85, 0xaa,
+	0x76, 0x2d, 0x6b, 0x96, 0xe2, 0xf1, 0x75, 0x39, 0x8c, 0x6e, 0xc3, 0x6c, 0xa2, 0x2a, 0x73, 0x67,
+	0xea, 0x14, 0xb9, 0x33, 0x13, 0xdb, 0x0a, 0x29, 0x5a, 0x07, 0x48, 0x12, 0x53, 0x07, 0x09, 0x64,
+	0xbc, 0x38, 0xbb, 0x95, 0x0b, 0x29, 0x5b, 0xe4, 0xc3, 0xb9, 0x9e, 0x47, 0x2c, 0x86, 0xfd, 0xb6,
+	0xa5, 0xa8, 0x12, 0x90, 0xc5, 0x33, 0xd8, 0xda, 0xf9, 0x9e, 0x47, 0x36, 0xb1, 0xdf, 0x6e, 0xc4,
+	0xb0, 0xe8, 0x1d, 0x78, 0x2d, 0x21, 0x81, 0x12, 0xab, 0x4b, 0x7d, 0xd7, 0x0a, 0x70, 0xdb, 0x72,
+	0xe8, 0x80, 0x70, 0x7d, 0x5a, 0x52, 0x77, 0x31, 0x56, 0x79, 0x8f, 0xac, 0x53, 0xdf, 0x35, 0x71,
+	0x7b, 0x45, 0x88, 0xd1, 0x25, 0x48, 0x68, 0xb0, 0x3c, 0x97, 0xe9, 0x33, 0xd5, 0xec, 0xb5, 0x9c,
+	0x39, 0x1d, 0x0f, 0x6e, 0xb8, 0x6c, 0x79, 0xfa, 0xc1, 0xe3, 0x4a, 0x46, 0xa5, 0x6b, 0xc6, 0x68,
+	0xc2, 0xf4, 0x8e, 0xed, 0xab, 0x4c, 0xc3, 0x0c, 0xbd, 0x0d, 0x53, 0x76, 0xf4, 0xa1, 0x6b, 0xd5,
+	0xec, 0xb1, 0x99, 0x9a, 0xa8, 0x86, 0x05, 0xe0, 0x67, 0x7f, 0xad, 0x6a, 0xc6, 0x6f, 0x34, 0xc8,
+	0x37, 0x76, 0x9a, 0xb6, 0x17, 0xa0, 0x55, 0x98, 0x4f, 0x62, 0xf6, 0xa4, 0xe9, 0x9f, 0x84, 0xb9,
+	0x1a, 0x17, 0x30, 0xf7, 0xa2, 0x8a, 0x12, 0xc3, 0x4c, 0xbc, 0x08, 0x26, 0x36, 0x51, 0xe3, 0x23,
+	0x8e, 0xaf, 0xc2, 0x64, 0xb8, 0x4a, 0x86, 0x96, 0xe1, 0x95, 0xbe, 0xf8, 0x21, 0xfd, 0x2d, 0xde,
+	0x5c, 0x1c, 0x1b, 0xeb, 0x52, 0x5f, 0xc5, 0x48, 0x68, 0x62, 0xfc, 0x5b, 0x03, 0x68, 0xec, 0xec,
+	0x6c, 0x05, 0x5e, 0xdf, 0xc7, 0xfc, 0xac, 0x3c, 0x7e, 0x17, 0x5e, 0x4d, 0x3c, 0x66, 0x81, 0x73,
+	0x62, 0xaf, 0xcf, 0xc5, 0x66, 0x9b, 0x81, 0x73, 0x24, 0x9a, 0xcb, 0x78, 0x8c, 0x96, 0x3d, 0x31,
+	0x5a, 0x83, 0xf1, 0xa3, 0x69, 0xdc, 0x84, 0x62, 0xe2, 0x3e, 0x43, 0x0d, 0x28, 0x70, 0xf5, 0x5b,
+	0xb1, 0x69, 0x8c, 0x67, 0x33, 0x32, 0x53, 0x8c, 0xc6, 0x96, 0xc6, 0x7f, 0x04, 0xa9, 0x49, 0x52,
+	0x7c, 0xa1, 0xc2, 0x48, 0x94, 0x77, 0x55, 0x7e, 0xcf, 0xe2, 0xd2, 0xa2, 0xb0, 0x46, 0x58, 0xfd,
+	0xc5, 0x04, 0x9c, 0xdb, 0x8e, 0x92, 0xf6, 0x0b, 0xcb, 0x44, 0x13, 0x26, 0x31, 0xe1, 0x81, 0x27,
+	0xa9, 0x10, 0x7b, 0xfd, 0xf5, 0x71, 0x7b, 0x7d, 0x84, 0x2f, 0xab, 0x84, 0x07, 0x43, 0xb5, 0xf3,
+	0x11, 0xcc, 0x08, 0x0b, 0x7f, 0xc8, 0x82, 0x3e, 0xce, 0x12, 0x5d, 0x85, 0x92, 0x13, 0x60, 0x39,
+	0x10, 0x1d, 0x2c, 0x9a, 0xac, 0x8e, 0xb3, 0xd1, 0xb0, 0x3a, 0x57, 0xee, 0x80, 0xb8, 0xa3, 0x89,
+	0xc0, 0x12, 0xaa, 0xa7, 0xbe, 0x94, 0xcd, 0x26, 0xc6, 0x42, 0x8c, 0x30, 0x94, 0x3c, 0xe2, 0x71,
+	0xcf, 0xf6, 0xad, 0x96, 0xed, 0xdb, 0xc4, 0x79, 0x99, 0xcb, 0xeb, 0xe1, 0xb3, 0x60, 0x56, 0x81,
+	0xd6, 0x43, 0x4c, 0xb4, 0x03, 0x93, 0x11, 0x7c, 0xee, 0x0c, 0xe0, 0x23, 0x30, 0xf4, 0x06, 0x4c,
+	0xa7, 0x8f, 0x08, 0x79, 0x45, 0xc9, 0x99, 0xc5, 0xd4, 0x09, 0xf1, 0xa2, 0x33, 0x28, 0x7f, 0xec,
+	0x19, 0x94, 0xba, 0x09, 0xfe, 0x2e, 0x0b, 0xf3, 0x26, 0x76, 0xbf, 0x5c, 0xfb, 0xf6, 0x03, 0x80,
+	0x30, 0xa3, 0x45, 0xa1, 0xd5, 0x73, 0x67, 0x50, 0x21, 0xa6, 0x42, 0xbc, 0x06, 0xe3, 0xff, 0xcf,
+	0xcd, 0xfb, 0x6c, 0x02, 0xa6, 0xd3, 0x9b, 0xf7, 0x25, 0x38, 0xd9, 0xd0, 0x46, 0x52, 0xcf, 0x72,
+	0xb2, 0x9e, 0xbd, 0x39, 0xae, 0x9e, 0x1d, 0x0a, 0xeb, 0xe3, 0x0b, 0xd9, 0x2f, 0x0b, 0x90, 0x6f,
+	0xda, 0x81, 0xdd, 0x63, 0xe8, 0x3b, 0x87, 0x6e, 0xb9, 0x61, 0xeb, 0xb9, 0x70, 0x28, 0xa8, 0x1b,
+	0xea, 0xe5, 0x23, 0x8c, 0xe9, 0x0f, 0x8f, 0xb8, 0xe4, 0x7e, 0x15, 0x66, 0x45, 0x1f, 0x1d, 0xbb,
+	0x12, 0x92, 0x38, 0x23, 0x1b, 0xe1, 0xb8, 0x05, 0x63, 0xa8, 0x02, 0x45, 0xa1, 0x96, 0x94, 0x6a,
+	0xa1, 0x03, 0x3d, 0x7b, 0x6f, 0x35, 0x1c, 0x41, 0xd7, 0x01, 0x75, 0xe3, 0x97, 0x0d, 0x2b, 0xa1,
+	0x40, 0xe8, 0xcd, 0x27, 0x92, 0x48, 0xfd, 0x2b, 0x00, 0x62, 0x15, 0x96, 0x8b, 0x09, 0xed, 0xa9,
+	0x46, 0x70, 0x4a, 0x8c, 0x34, 0xc4, 0x00, 0xfa, 0x49, 0x78, 0x61, 0x1e, 0x69, 0xb1, 0x55, 0xaf,
+	0xf2, 0xee, 0xe9, 0x52, 0xe1, 0xf9, 0x7e, 0xa5, 0x3c, 0xb4, 0x7b, 0xfe, 0xb2, 0x71, 0x04, 0xa4,
+	0x21, 0x2f, 0xd0, 0x07, 0x5b, 0x73, 0x64, 0xc1, 0x82, 0x70, 0xd6, 0xa1, 0x24, 0x6a, 0x15, 0xad,
+	0x80, 0x72, 0x49, 0x24, 0x93, 0xbd, 0xcc, 0x4c, 0xfd, 0xf2, 0xf3, 0xfd, 0x4a, 0x55, 0xa1, 0x8e,
+	0x53, 0x35, 0xcc, 0x0b, 0xe2, 0x35, 0x81, 0x12, 0xd5, 0x28, 0x9a, 0x91, 0x00, 0xb9, 0x30, 0x97,
+	0xd6, 0xb4, 0xda, 0x18, 0xeb, 0x05, 0xb5, 0x85, 0x2a, 0x5a, 0xc4, 0x03, 0x53, 0xaa, 0xb9, 0xf0,
+	0x48, 0xbd, 0x22, 0xdc, 0x7e, 0xbe, 0x5f, 0xb9, 0x18, 0x4e, 0x3b, 0x0a, 0x60, 0x98, 0xb3, 0xa9,
+	0x39, 0xd6, 0x30, 0x46, 0xbf, 0xd6, 0xe0, 0xf5, 0x03, 0x7b, 0x6b, 0xf5, 0xe9, 0x7d, 0x1c, 0x58,
+	0xed, 0xc0, 0x76, 0x84, 0x8e, 0xec, 0x8d, 0xa6, 0xea, 0xdb, 0xa7, 0xa6, 0xf3, 0x52, 0xe2, 0xf8,
+	0x38, 0x6c, 0xc3, 0x5c, 0x48, 0x07, 0x50, 0x53, 0x08, 0xd7, 0x94, 0x0c, 0x75, 0xc3, 0x75, 0x0d,
+	0x88, 0x4a, 0x00, 0x6c, 0xd9, 0xbe, 0x6f, 0xf5, 0x29, 0xf3, 0x42, 0x8a, 0x41, 0x52, 0x7c, 0xf5,
+	0xe0, 0x4c, 0xe3, 0xb4, 0xc3, 0x99, 0xb6, 0x63, 0xe9, 0x2d, 0xdf, 0x6f, 0x46, 0x32, 0x84, 0xe1,
+	0x35, 0x4c, 0xda, 0x34, 0x70, 0xb0, 0x35, 0xae, 0x01, 0x2b, 0xd4, 0xaf, 0x3c, 0xdf, 0xaf, 0x18,
+	0xe1, 0x44, 0xc7, 0x28, 0x1b, 0xa6, 0xae, 0xa4, 0x77, 0x0e, 0x75, 0x5c, 0x16, 0x2c, 0x30, 0xdf,
+	0x66, 0x5d, 0xab, 0x3d, 0x20, 0xae, 0x0c, 0xb1, 0x01, 0x11, 0x4f, 0x21, 0x7d, 0x4a, 0x7d, 0xd9,
+	0x6f, 0x15, 0xd2, 0x01, 0x33, 0x56, 0xd5, 0x30, 0x2f, 0x48, 0xd9, 0xda, 0x80, 0xb8, 0x2b, 0x91,
+	0xa4, 0x49, 0xa9, 0x9f, 0xaa, 0xa9, 0x3f, 0xcf, 0x82, 0xae, 0x42, 0xea, 0x76, 0xb2, 0xdd, 0x26,
+	0x76, 0x68, 0xe0, 0x1e, 0x7d, 0x27, 0xd3, 0x4e, 0x7d, 0x27, 0xdb, 0x81, 0x92, 0xa8, 0xf8, 0xa9,
+	0xa0, 0x7e, 0xc9, 0xa7, 0x92, 0x19, 0xea, 0xbb, 0x49, 0xfc, 0x0b, 0x5c, 0x82, 0xef, 0x1f, 0xc0,
+	0xcd, 0xbe, 0x1c, 0x2e, 0xc1, 0xf7, 0x53, 0xb8, 0x17, 0xc4, 0x1b, 0xaa, 0xbc, 0x05, 0xe4, 0xe4,
+	0xd1, 0x94, 0xef, 0x8e, 0x3d, 0xfd, 0x5f, 0x79, 0xf9, 0xd3, 0x7f, 0xb9, 0xf0, 0x20, 0xaa, 0xc5,
+	0x1f, 0x69, 0x80, 0x92, 0xed, 0x37, 0x31, 0xeb, 0x53, 0xc2, 0xe4, 0x83, 0x41, 0x2a, 0xb8, 0xb4,
+	0xe3, 0x1f, 0x0c, 0x12, 0xfb, 0xe8, 0xc1, 0x20, 0xb1, 0x45, 0xdf, 0x4c, 0x6e, 0x6e, 0x13, 0x2f,
+	0xaa, 0x0b, 0xea, 0xd4, 0x50, 0xfa, 0x71, 0xa8, 0x64, 0x8c, 0x3f, 0x6b, 0xb0, 0x70, 0xe8, 0x90,
+	0x89, 0x17, 0xfb, 0x23, 0x40, 0x41, 0x4a, 0x28, 0x4b, 0xf6, 0x50, 0x2d, 0xfa, 0xd4, 0x67, 0xd6,
+	0x7c, 0x30, 0x2a, 0xf8, 0x5f, 0x5d, 0x3e, 0x97, 0x73, 0x32, 0x0d, 0xfe, 0xa8, 0xc1, 0xf9, 0xf4,
+	0x62, 0x62, 0xb7, 0xee, 0xc2, 0x74, 0x7a, 0x2d, 0xca, 0xa1, 0xcb, 0x27, 0x71, 0x48, 0xf9, 0x72,
+	0xc0, 0x1e, 0xbd, 0x9f, 0x9c, 0xe7, 0xe1, 0x43, 0xfb, 0x8d, 0x13, 0x73, 0x13, 0xad, 0x69, 0xf4,
+	0x5c, 0xcf, 0x45, 0xed, 0x59, 0x4e, 0xe4, 0x36, 0xfa, 0x29, 0xcc, 0x13, 0xca, 0x2d, 0x71, 0xf8,
+	0x61, 0xd7, 0x52, 0xaf, 0x7e, 0x61, 0xd2, 0xbe, 0x7f, 0x3a, 0xca, 0xfe, 0xb9, 0x5f, 0x39, 0x0c,
+	0x35, 0xc2, 0x63, 0x89, 0x50, 0x5e, 0x97, 0xf2, 0x2d, 0x29, 0x46, 0x01, 0xcc, 0x1c, 0x9c, 0x3a,
+	0xbc, 0x44, 0xdd, 0x39, 0xf5, 0xd4, 0x33, 0xc7, 0x4d, 0x3b, 0xdd, 0x4a, 0xcd, 0xb9, 0x5c, 0x10,
+	0x7b, 0xf8, 0xaf, 0xc7, 0x15, 0xed, 0x6b, 0xbf, 0xd5, 0x00, 0x92, 0xe7, 0x4f, 0xf4, 0x16, 0x5c,
+	0xac, 0xbf, 0x77, 0xb7, 0x61, 0x6d, 0x6e, 0xdd, 0xda, 0xda, 0xde, 0xb4, 0xb6, 0xef, 0x6e, 0x36,
+	0x57, 0x57, 0x36, 0xd6, 0x36, 0x56, 0x1b, 0x73, 0x99, 0x72, 0xe9, 0xe1, 0xa3, 0x6a, 0x71, 0x9b,
+	0xb0, 0x3e, 0x76, 0xbc, 0xb6, 0x87, 0x5d, 0x74, 0x05, 0xce, 0x1f, 0xd4, 0x16, 0x5f, 0xab, 0x8d,
+	0x39, 0xad, 0x3c, 0xfd, 0xf0, 0x51, 0xb5, 0x10, 0xb6, 0x7d, 0xd8, 0x45, 0xd7, 0xe0, 0xd5, 0xc3,
+	0x7a, 0x1b, 0x77, 0xbf, 0x3d, 0x37, 0x51, 0x9e, 0x79, 0xf8, 0xa8, 0x3a, 0x15, 0xf7, 0x87, 0xc8,
+	0x00, 0x94, 0xd6, 0x54, 0x78, 0xd9, 0x32, 0x3c, 0x7c, 0x54, 0xcd, 0x87, 0xb4, 0x95, 0x73, 0x0f,
+	0x3e, 0x5a, 0xcc, 0xd4, 0xd7, 0x3e, 0x7d, 0xba, 0xa8, 0x3d, 0x79, 0xba, 0xa8, 0xfd, 0xfd, 0xe9,
+	0xa2, 0xf6, 0xc1, 0xb3, 0xc5, 0xcc, 0x93, 0x67, 0x8b, 0x99, 0x3f, 0x3d, 0x5b, 0xcc, 0x7c, 0xff,
+	0xad, 0x63, 0x19, 0xdb, 0x8b, 0xff, 0x0a, 0x26, 0xb9, 0x6b, 0xe5, 0x65, 0x09, 0xfa, 0xc6, 0x7f,
+	0x07, 0x00, 0xcb, 0x0e, 0x08, 0x4e, 0x24, 0x1b, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1254,610 +1418,672 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9649 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7b, 0x70, 0x24, 0xc7,
-		0x79, 0x1f, 0x66, 0x5f, 0xd8, 0xfd, 0xb0, 0x00, 0x16, 0x0d, 0xdc, 0x71, 0x6f, 0x49, 0x02, 0xe0,
-		0xf2, 0x71, 0xc7, 0x23, 0x89, 0x23, 0x8f, 0xbc, 0xd7, 0x9e, 0x24, 0x0a, 0x0b, 0xec, 0xe1, 0xc0,
-		0xc3, 0x8b, 0x03, 0xe0, 0xf8, 0x90, 0x9d, 0xad, 0xc1, 0x6c, 0x63, 0x31, 0xc4, 0xec, 0xcc, 0x68,
-		0x66, 0xf6, 0x78, 0x38, 0x59, 0x29, 0xca, 0x4a, 0x1c, 0x8a, 0xa9, 0x24, 0x72, 0x94, 0x8a, 0x25,
-		0x59, 0xa7, 0x50, 0x96, 0x12, 0x39, 0xb2, 0x9c, 0x48, 0x16, 0xa5, 0xf8, 0x91, 0x72, 0xa4, 0x54,
-		0x39, 0x96, 0xf4, 0x47, 0x4a, 0x72, 0x52, 0xb1, 0xe5, 0x38, 0x94, 0x42, 0xa9, 0x6c, 0x46, 0x51,
-		0x62, 0x45, 0x51, 0xca, 0x49, 0xa9, 0x94, 0x4a, 0xf5, 0x6b, 0x1e, 0xfb, 0x9a, 0x05, 0x88, 0x93,
-		0xe9, 0xe8, 0x2f, 0x6c, 0x77, 0x7f, 0xdf, 0xaf, 0xbf, 0xfe, 0xfa, 0xeb, 0xaf, 0xbf, 0x7e, 0x0d,
-		0xe0, 0xb3, 0x17, 0x61, 0xba, 0x6e, 0x9a, 0x75, 0x1d, 0x9f, 0xb2, 0x6c, 0xd3, 0x35, 0xb7, 0x9a,
-		0xdb, 0xa7, 0x6a, 0xd8, 0x51, 0x6d, 0xcd, 0x72, 0x4d, 0x7b, 0x86, 0xe6, 0xa1, 0x51, 0x46, 0x31,
-		0x23, 0x28, 0x8a, 0xcb, 0x30, 0x76, 0x49, 0xd3, 0xf1, 0xbc, 0x47, 0xb8, 0x8e, 0x5d, 0x74, 0x1e,
-		0x12, 0xdb, 0x9a, 0x8e, 0xf3, 0xd2, 0x74, 0xfc, 0xc4, 0xd0, 0xe9, 0x7b, 0x66, 0x5a, 0x98, 0x66,
-		0xc2, 0x1c, 0x6b, 0x24, 0x5b, 0xa6, 0x1c, 0xc5, 0xef, 0x24, 0x60, 0xbc, 0x43, 0x29, 0x42, 0x90,
-		0x30, 0x94, 0x06, 0x41, 0x94, 0x4e, 0x64, 0x64, 0xfa, 0x1b, 0xe5, 0x61, 0xd0, 0x52, 0xd4, 0x5d,
-		0xa5, 0x8e, 0xf3, 0x31, 0x9a, 0x2d, 0x92, 0x68, 0x12, 0xa0, 0x86, 0x2d, 0x6c, 0xd4, 0xb0, 0xa1,
-		0xee, 0xe5, 0xe3, 0xd3, 0xf1, 0x13, 0x19, 0x39, 0x90, 0x83, 0x1e, 0x80, 0x31, 0xab, 0xb9, 0xa5,
-		0x6b, 0x6a, 0x35, 0x40, 0x06, 0xd3, 0xf1, 0x13, 0x49, 0x39, 0xc7, 0x0a, 0xe6, 0x7d, 0xe2, 0xe3,
-		0x30, 0xfa, 0x3c, 0x56, 0x76, 0x83, 0xa4, 0x43, 0x94, 0x74, 0x84, 0x64, 0x07, 0x08, 0xe7, 0x20,
-		0xdb, 0xc0, 0x8e, 0xa3, 0xd4, 0x71, 0xd5, 0xdd, 0xb3, 0x70, 0x3e, 0x41, 0x5b, 0x3f, 0xdd, 0xd6,
-		0xfa, 0xd6, 0x96, 0x0f, 0x71, 0xae, 0x8d, 0x3d, 0x0b, 0xa3, 0x59, 0xc8, 0x60, 0xa3, 0xd9, 0x60,
-		0x08, 0xc9, 0x2e, 0xfa, 0xab, 0x18, 0xcd, 0x46, 0x2b, 0x4a, 0x9a, 0xb0, 0x71, 0x88, 0x41, 0x07,
-		0xdb, 0xd7, 0x34, 0x15, 0xe7, 0x53, 0x14, 0xe0, 0x78, 0x1b, 0xc0, 0x3a, 0x2b, 0x6f, 0xc5, 0x10,
-		0x7c, 0x68, 0x0e, 0x32, 0xf8, 0xba, 0x8b, 0x0d, 0x47, 0x33, 0x8d, 0xfc, 0x20, 0x05, 0xb9, 0xb7,
-		0x43, 0x2f, 0x62, 0xbd, 0xd6, 0x0a, 0xe1, 0xf3, 0xa1, 0xb3, 0x30, 0x68, 0x5a, 0xae, 0x66, 0x1a,
-		0x4e, 0x3e, 0x3d, 0x2d, 0x9d, 0x18, 0x3a, 0x7d, 0x47, 0x47, 0x43, 0x58, 0x65, 0x34, 0xb2, 0x20,
-		0x46, 0x8b, 0x90, 0x73, 0xcc, 0xa6, 0xad, 0xe2, 0xaa, 0x6a, 0xd6, 0x70, 0x55, 0x33, 0xb6, 0xcd,
-		0x7c, 0x86, 0x02, 0x4c, 0xb5, 0x37, 0x84, 0x12, 0xce, 0x99, 0x35, 0xbc, 0x68, 0x6c, 0x9b, 0xf2,
-		0x88, 0x13, 0x4a, 0xa3, 0xa3, 0x90, 0x72, 0xf6, 0x0c, 0x57, 0xb9, 0x9e, 0xcf, 0x52, 0x0b, 0xe1,
-		0xa9, 0xe2, 0x6f, 0xa5, 0x60, 0xb4, 0x1f, 0x13, 0xbb, 0x08, 0xc9, 0x6d, 0xd2, 0xca, 0x7c, 0x6c,
-		0x3f, 0x3a, 0x60, 0x3c, 0x61, 0x25, 0xa6, 0x0e, 0xa8, 0xc4, 0x59, 0x18, 0x32, 0xb0, 0xe3, 0xe2,
-		0x1a, 0xb3, 0x88, 0x78, 0x9f, 0x36, 0x05, 0x8c, 0xa9, 0xdd, 0xa4, 0x12, 0x07, 0x32, 0xa9, 0xa7,
-		0x61, 0xd4, 0x13, 0xa9, 0x6a, 0x2b, 0x46, 0x5d, 0xd8, 0xe6, 0xa9, 0x28, 0x49, 0x66, 0x2a, 0x82,
-		0x4f, 0x26, 0x6c, 0xf2, 0x08, 0x0e, 0xa5, 0xd1, 0x3c, 0x80, 0x69, 0x60, 0x73, 0xbb, 0x5a, 0xc3,
-		0xaa, 0x9e, 0x4f, 0x77, 0xd1, 0xd2, 0x2a, 0x21, 0x69, 0xd3, 0x92, 0xc9, 0x72, 0x55, 0x1d, 0x5d,
-		0xf0, 0x4d, 0x6d, 0xb0, 0x8b, 0xa5, 0x2c, 0xb3, 0x41, 0xd6, 0x66, 0x6d, 0x9b, 0x30, 0x62, 0x63,
-		0x62, 0xf7, 0xb8, 0xc6, 0x5b, 0x96, 0xa1, 0x42, 0xcc, 0x44, 0xb6, 0x4c, 0xe6, 0x6c, 0xac, 0x61,
-		0xc3, 0x76, 0x30, 0x89, 0xee, 0x06, 0x2f, 0xa3, 0x4a, 0xcd, 0x0a, 0xa8, 0x17, 0xca, 0x8a, 0xcc,
-		0x15, 0xa5, 0x81, 0x0b, 0x37, 0x60, 0x24, 0xac, 0x1e, 0x34, 0x01, 0x49, 0xc7, 0x55, 0x6c, 0x97,
-		0x5a, 0x61, 0x52, 0x66, 0x09, 0x94, 0x83, 0x38, 0x36, 0x6a, 0xd4, 0xcb, 0x25, 0x65, 0xf2, 0x13,
-		0xbd, 0xdd, 0x6f, 0x70, 0x9c, 0x36, 0xf8, 0xbe, 0xf6, 0x1e, 0x0d, 0x21, 0xb7, 0xb6, 0xbb, 0x70,
-		0x0e, 0x86, 0x43, 0x0d, 0xe8, 0xb7, 0xea, 0xe2, 0xcf, 0xc1, 0x91, 0x8e, 0xd0, 0xe8, 0x69, 0x98,
-		0x68, 0x1a, 0x9a, 0xe1, 0x62, 0xdb, 0xb2, 0x31, 0xb1, 0x58, 0x56, 0x55, 0xfe, 0xcf, 0x06, 0xbb,
-		0xd8, 0xdc, 0x66, 0x90, 0x9a, 0xa1, 0xc8, 0xe3, 0xcd, 0xf6, 0xcc, 0x93, 0x99, 0xf4, 0xeb, 0x83,
-		0xb9, 0x17, 0x5e, 0x78, 0xe1, 0x85, 0x58, 0xf1, 0x4b, 0x29, 0x98, 0xe8, 0x34, 0x66, 0x3a, 0x0e,
-		0xdf, 0xa3, 0x90, 0x32, 0x9a, 0x8d, 0x2d, 0x6c, 0x53, 0x25, 0x25, 0x65, 0x9e, 0x42, 0xb3, 0x90,
-		0xd4, 0x95, 0x2d, 0xac, 0xe7, 0x13, 0xd3, 0xd2, 0x89, 0x91, 0xd3, 0x0f, 0xf4, 0x35, 0x2a, 0x67,
-		0x96, 0x08, 0x8b, 0xcc, 0x38, 0xd1, 0xdb, 0x20, 0xc1, 0x5d, 0x34, 0x41, 0x38, 0xd9, 0x1f, 0x02,
-		0x19, 0x4b, 0x32, 0xe5, 0x43, 0xb7, 0x43, 0x86, 0xfc, 0x65, 0xb6, 0x91, 0xa2, 0x32, 0xa7, 0x49,
-		0x06, 0xb1, 0x0b, 0x54, 0x80, 0x34, 0x1d, 0x26, 0x35, 0x2c, 0xa6, 0x36, 0x2f, 0x4d, 0x0c, 0xab,
-		0x86, 0xb7, 0x95, 0xa6, 0xee, 0x56, 0xaf, 0x29, 0x7a, 0x13, 0x53, 0x83, 0xcf, 0xc8, 0x59, 0x9e,
-		0x79, 0x95, 0xe4, 0xa1, 0x29, 0x18, 0x62, 0xa3, 0x4a, 0x33, 0x6a, 0xf8, 0x3a, 0xf5, 0x9e, 0x49,
-		0x99, 0x0d, 0xb4, 0x45, 0x92, 0x43, 0xaa, 0x7f, 0xce, 0x31, 0x0d, 0x61, 0x9a, 0xb4, 0x0a, 0x92,
-		0x41, 0xab, 0x3f, 0xd7, 0xea, 0xb8, 0xef, 0xec, 0xdc, 0xbc, 0xb6, 0xb1, 0x74, 0x1c, 0x46, 0x29,
-		0xc5, 0xa3, 0xbc, 0xeb, 0x15, 0x3d, 0x3f, 0x36, 0x2d, 0x9d, 0x48, 0xcb, 0x23, 0x2c, 0x7b, 0x95,
-		0xe7, 0x16, 0xbf, 0x10, 0x83, 0x04, 0x75, 0x2c, 0xa3, 0x30, 0xb4, 0xf1, 0xcc, 0x5a, 0xa5, 0x3a,
-		0xbf, 0xba, 0x59, 0x5e, 0xaa, 0xe4, 0x24, 0x34, 0x02, 0x40, 0x33, 0x2e, 0x2d, 0xad, 0xce, 0x6e,
-		0xe4, 0x62, 0x5e, 0x7a, 0x71, 0x65, 0xe3, 0xec, 0x63, 0xb9, 0xb8, 0xc7, 0xb0, 0xc9, 0x32, 0x12,
-		0x41, 0x82, 0x47, 0x4f, 0xe7, 0x92, 0x28, 0x07, 0x59, 0x06, 0xb0, 0xf8, 0x74, 0x65, 0xfe, 0xec,
-		0x63, 0xb9, 0x54, 0x38, 0xe7, 0xd1, 0xd3, 0xb9, 0x41, 0x34, 0x0c, 0x19, 0x9a, 0x53, 0x5e, 0x5d,
-		0x5d, 0xca, 0xa5, 0x3d, 0xcc, 0xf5, 0x0d, 0x79, 0x71, 0x65, 0x21, 0x97, 0xf1, 0x30, 0x17, 0xe4,
-		0xd5, 0xcd, 0xb5, 0x1c, 0x78, 0x08, 0xcb, 0x95, 0xf5, 0xf5, 0xd9, 0x85, 0x4a, 0x6e, 0xc8, 0xa3,
-		0x28, 0x3f, 0xb3, 0x51, 0x59, 0xcf, 0x65, 0x43, 0x62, 0x3d, 0x7a, 0x3a, 0x37, 0xec, 0x55, 0x51,
-		0x59, 0xd9, 0x5c, 0xce, 0x8d, 0xa0, 0x31, 0x18, 0x66, 0x55, 0x08, 0x21, 0x46, 0x5b, 0xb2, 0xce,
-		0x3e, 0x96, 0xcb, 0xf9, 0x82, 0x30, 0x94, 0xb1, 0x50, 0xc6, 0xd9, 0xc7, 0x72, 0xa8, 0x38, 0x07,
-		0x49, 0x6a, 0x86, 0x08, 0xc1, 0xc8, 0xd2, 0x6c, 0xb9, 0xb2, 0x54, 0x5d, 0x5d, 0xdb, 0x58, 0x5c,
-		0x5d, 0x99, 0x5d, 0xca, 0x49, 0x7e, 0x9e, 0x5c, 0x79, 0x72, 0x73, 0x51, 0xae, 0xcc, 0xe7, 0x62,
-		0xc1, 0xbc, 0xb5, 0xca, 0xec, 0x46, 0x65, 0x3e, 0x17, 0x2f, 0xaa, 0x30, 0xd1, 0xc9, 0xa1, 0x76,
-		0x1c, 0x42, 0x01, 0x5b, 0x88, 0x75, 0xb1, 0x05, 0x8a, 0xd5, 0x6a, 0x0b, 0xc5, 0x6f, 0xc7, 0x60,
-		0xbc, 0xc3, 0xa4, 0xd2, 0xb1, 0x92, 0xc7, 0x21, 0xc9, 0x6c, 0x99, 0x4d, 0xb3, 0xf7, 0x77, 0x9c,
-		0x9d, 0xa8, 0x65, 0xb7, 0x4d, 0xb5, 0x94, 0x2f, 0x18, 0x6a, 0xc4, 0xbb, 0x84, 0x1a, 0x04, 0xa2,
-		0xcd, 0x60, 0x7f, 0xb6, 0xcd, 0xf9, 0xb3, 0xf9, 0xf1, 0x6c, 0x3f, 0xf3, 0x23, 0xcd, 0xdb, 0xdf,
-		0x24, 0x90, 0xec, 0x30, 0x09, 0x5c, 0x84, 0xb1, 0x36, 0xa0, 0xbe, 0x9d, 0xf1, 0x7b, 0x25, 0xc8,
-		0x77, 0x53, 0x4e, 0x84, 0x4b, 0x8c, 0x85, 0x5c, 0xe2, 0xc5, 0x56, 0x0d, 0xde, 0xd5, 0xbd, 0x13,
-		0xda, 0xfa, 0xfa, 0x93, 0x12, 0x1c, 0xed, 0x1c, 0x52, 0x76, 0x94, 0xe1, 0x6d, 0x90, 0x6a, 0x60,
-		0x77, 0xc7, 0x14, 0x61, 0xd5, 0x7d, 0x1d, 0x26, 0x6b, 0x52, 0xdc, 0xda, 0xd9, 0x9c, 0x2b, 0x38,
-		0xdb, 0xc7, 0xbb, 0xc5, 0x85, 0x4c, 0x9a, 0x36, 0x49, 0xdf, 0x17, 0x83, 0x23, 0x1d, 0xc1, 0x3b,
-		0x0a, 0x7a, 0x27, 0x80, 0x66, 0x58, 0x4d, 0x97, 0x85, 0x4e, 0xcc, 0x13, 0x67, 0x68, 0x0e, 0x75,
-		0x5e, 0xc4, 0xcb, 0x36, 0x5d, 0xaf, 0x3c, 0x4e, 0xcb, 0x81, 0x65, 0x51, 0x82, 0xf3, 0xbe, 0xa0,
-		0x09, 0x2a, 0xe8, 0x64, 0x97, 0x96, 0xb6, 0x19, 0xe6, 0xc3, 0x90, 0x53, 0x75, 0x0d, 0x1b, 0x6e,
-		0xd5, 0x71, 0x6d, 0xac, 0x34, 0x34, 0xa3, 0x4e, 0xa7, 0x9a, 0x74, 0x29, 0xb9, 0xad, 0xe8, 0x0e,
-		0x96, 0x47, 0x59, 0xf1, 0xba, 0x28, 0x25, 0x1c, 0xd4, 0x80, 0xec, 0x00, 0x47, 0x2a, 0xc4, 0xc1,
-		0x8a, 0x3d, 0x8e, 0xe2, 0x2f, 0x66, 0x60, 0x28, 0x10, 0x80, 0xa3, 0xbb, 0x20, 0xfb, 0x9c, 0x72,
-		0x4d, 0xa9, 0x8a, 0x45, 0x15, 0xd3, 0xc4, 0x10, 0xc9, 0x5b, 0xe3, 0x0b, 0xab, 0x87, 0x61, 0x82,
-		0x92, 0x98, 0x4d, 0x17, 0xdb, 0x55, 0x55, 0x57, 0x1c, 0x87, 0x2a, 0x2d, 0x4d, 0x49, 0x11, 0x29,
-		0x5b, 0x25, 0x45, 0x73, 0xa2, 0x04, 0x9d, 0x81, 0x71, 0xca, 0xd1, 0x68, 0xea, 0xae, 0x66, 0xe9,
-		0xb8, 0x4a, 0x96, 0x79, 0x0e, 0x9d, 0x72, 0x3c, 0xc9, 0xc6, 0x08, 0xc5, 0x32, 0x27, 0x20, 0x12,
-		0x39, 0x68, 0x1e, 0xee, 0xa4, 0x6c, 0x75, 0x6c, 0x60, 0x5b, 0x71, 0x71, 0x15, 0xbf, 0xb3, 0xa9,
-		0xe8, 0x4e, 0x55, 0x31, 0x6a, 0xd5, 0x1d, 0xc5, 0xd9, 0xc9, 0x4f, 0x10, 0x80, 0x72, 0x2c, 0x2f,
-		0xc9, 0xc7, 0x08, 0xe1, 0x02, 0xa7, 0xab, 0x50, 0xb2, 0x59, 0xa3, 0x76, 0x59, 0x71, 0x76, 0x50,
-		0x09, 0x8e, 0x52, 0x14, 0xc7, 0xb5, 0x35, 0xa3, 0x5e, 0x55, 0x77, 0xb0, 0xba, 0x5b, 0x6d, 0xba,
-		0xdb, 0xe7, 0xf3, 0xb7, 0x07, 0xeb, 0xa7, 0x12, 0xae, 0x53, 0x9a, 0x39, 0x42, 0xb2, 0xe9, 0x6e,
-		0x9f, 0x47, 0xeb, 0x90, 0x25, 0x9d, 0xd1, 0xd0, 0x6e, 0xe0, 0xea, 0xb6, 0x69, 0xd3, 0x39, 0x74,
-		0xa4, 0x83, 0x6b, 0x0a, 0x68, 0x70, 0x66, 0x95, 0x33, 0x2c, 0x9b, 0x35, 0x5c, 0x4a, 0xae, 0xaf,
-		0x55, 0x2a, 0xf3, 0xf2, 0x90, 0x40, 0xb9, 0x64, 0xda, 0xc4, 0xa0, 0xea, 0xa6, 0xa7, 0xe0, 0x21,
-		0x66, 0x50, 0x75, 0x53, 0xa8, 0xf7, 0x0c, 0x8c, 0xab, 0x2a, 0x6b, 0xb3, 0xa6, 0x56, 0xf9, 0x62,
-		0xcc, 0xc9, 0xe7, 0x42, 0xca, 0x52, 0xd5, 0x05, 0x46, 0xc0, 0x6d, 0xdc, 0x41, 0x17, 0xe0, 0x88,
-		0xaf, 0xac, 0x20, 0xe3, 0x58, 0x5b, 0x2b, 0x5b, 0x59, 0xcf, 0xc0, 0xb8, 0xb5, 0xd7, 0xce, 0x88,
-		0x42, 0x35, 0x5a, 0x7b, 0xad, 0x6c, 0xe7, 0x60, 0xc2, 0xda, 0xb1, 0xda, 0xf9, 0x4e, 0x06, 0xf9,
-		0x90, 0xb5, 0x63, 0xb5, 0x32, 0xde, 0x4b, 0x57, 0xe6, 0x36, 0x56, 0x15, 0x17, 0xd7, 0xf2, 0xb7,
-		0x05, 0xc9, 0x03, 0x05, 0x68, 0x06, 0x72, 0xaa, 0x5a, 0xc5, 0x86, 0xb2, 0xa5, 0xe3, 0xaa, 0x62,
-		0x63, 0x43, 0x71, 0xf2, 0x53, 0x94, 0x38, 0xe1, 0xda, 0x4d, 0x2c, 0x8f, 0xa8, 0x6a, 0x85, 0x16,
-		0xce, 0xd2, 0x32, 0x74, 0x12, 0xc6, 0xcc, 0xad, 0xe7, 0x54, 0x66, 0x91, 0x55, 0xcb, 0xc6, 0xdb,
-		0xda, 0xf5, 0xfc, 0x3d, 0x54, 0xbd, 0xa3, 0xa4, 0x80, 0xda, 0xe3, 0x1a, 0xcd, 0x46, 0xf7, 0x43,
-		0x4e, 0x75, 0x76, 0x14, 0xdb, 0xa2, 0x2e, 0xd9, 0xb1, 0x14, 0x15, 0xe7, 0xef, 0x65, 0xa4, 0x2c,
-		0x7f, 0x45, 0x64, 0x93, 0x11, 0xe1, 0x3c, 0xaf, 0x6d, 0xbb, 0x02, 0xf1, 0x38, 0x1b, 0x11, 0x34,
-		0x8f, 0xa3, 0x9d, 0x80, 0x1c, 0xd1, 0x44, 0xa8, 0xe2, 0x13, 0x94, 0x6c, 0xc4, 0xda, 0xb1, 0x82,
-		0xf5, 0xde, 0x0d, 0xc3, 0x84, 0xd2, 0xaf, 0xf4, 0x7e, 0x16, 0xb8, 0x59, 0x3b, 0x81, 0x1a, 0x1f,
-		0x83, 0xa3, 0x84, 0xa8, 0x81, 0x5d, 0xa5, 0xa6, 0xb8, 0x4a, 0x80, 0xfa, 0x41, 0x4a, 0x4d, 0xd4,
-		0xbe, 0xcc, 0x0b, 0x43, 0x72, 0xda, 0xcd, 0xad, 0x3d, 0xcf, 0xb0, 0x1e, 0x62, 0x72, 0x92, 0x3c,
-		0x61, 0x5a, 0xb7, 0x2c, 0x38, 0x2f, 0x96, 0x20, 0x1b, 0xb4, 0x7b, 0x94, 0x01, 0x66, 0xf9, 0x39,
-		0x89, 0x04, 0x41, 0x73, 0xab, 0xf3, 0x24, 0x7c, 0x79, 0xb6, 0x92, 0x8b, 0x91, 0x30, 0x6a, 0x69,
-		0x71, 0xa3, 0x52, 0x95, 0x37, 0x57, 0x36, 0x16, 0x97, 0x2b, 0xb9, 0x78, 0x20, 0xb0, 0x7f, 0x22,
-		0x91, 0xbe, 0x2f, 0x77, 0xbc, 0xf8, 0xf5, 0x18, 0x8c, 0x84, 0x57, 0x6a, 0xe8, 0x2d, 0x70, 0x9b,
-		0xd8, 0x56, 0x71, 0xb0, 0x5b, 0x7d, 0x5e, 0xb3, 0xe9, 0x80, 0x6c, 0x28, 0x6c, 0x72, 0xf4, 0xec,
-		0x67, 0x82, 0x53, 0xad, 0x63, 0xf7, 0x29, 0xcd, 0x26, 0xc3, 0xad, 0xa1, 0xb8, 0x68, 0x09, 0xa6,
-		0x0c, 0xb3, 0xea, 0xb8, 0x8a, 0x51, 0x53, 0xec, 0x5a, 0xd5, 0xdf, 0xd0, 0xaa, 0x2a, 0xaa, 0x8a,
-		0x1d, 0xc7, 0x64, 0x13, 0xa1, 0x87, 0x72, 0x87, 0x61, 0xae, 0x73, 0x62, 0x7f, 0x86, 0x98, 0xe5,
-		0xa4, 0x2d, 0xe6, 0x1b, 0xef, 0x66, 0xbe, 0xb7, 0x43, 0xa6, 0xa1, 0x58, 0x55, 0x6c, 0xb8, 0xf6,
-		0x1e, 0x8d, 0xcf, 0xd3, 0x72, 0xba, 0xa1, 0x58, 0x15, 0x92, 0xfe, 0x89, 0x2c, 0x93, 0x9e, 0x48,
-		0xa4, 0xd3, 0xb9, 0xcc, 0x13, 0x89, 0x74, 0x26, 0x07, 0xc5, 0xd7, 0xe2, 0x90, 0x0d, 0xc6, 0xeb,
-		0x64, 0xf9, 0xa3, 0xd2, 0x19, 0x4b, 0xa2, 0x3e, 0xed, 0xee, 0x9e, 0xd1, 0xfd, 0xcc, 0x1c, 0x99,
-		0xca, 0x4a, 0x29, 0x16, 0x1c, 0xcb, 0x8c, 0x93, 0x84, 0x11, 0xc4, 0xd8, 0x30, 0x0b, 0x46, 0xd2,
-		0x32, 0x4f, 0xa1, 0x05, 0x48, 0x3d, 0xe7, 0x50, 0xec, 0x14, 0xc5, 0xbe, 0xa7, 0x37, 0xf6, 0x13,
-		0xeb, 0x14, 0x3c, 0xf3, 0xc4, 0x7a, 0x75, 0x65, 0x55, 0x5e, 0x9e, 0x5d, 0x92, 0x39, 0x3b, 0x3a,
-		0x06, 0x09, 0x5d, 0xb9, 0xb1, 0x17, 0x9e, 0xf4, 0x68, 0x56, 0xbf, 0x9d, 0x70, 0x0c, 0x12, 0xcf,
-		0x63, 0x65, 0x37, 0x3c, 0xd5, 0xd0, 0xac, 0x5b, 0x38, 0x18, 0x4e, 0x41, 0x92, 0xea, 0x0b, 0x01,
-		0x70, 0x8d, 0xe5, 0x06, 0x50, 0x1a, 0x12, 0x73, 0xab, 0x32, 0x19, 0x10, 0x39, 0xc8, 0xb2, 0xdc,
-		0xea, 0xda, 0x62, 0x65, 0xae, 0x92, 0x8b, 0x15, 0xcf, 0x40, 0x8a, 0x29, 0x81, 0x0c, 0x16, 0x4f,
-		0x0d, 0xb9, 0x01, 0x9e, 0xe4, 0x18, 0x92, 0x28, 0xdd, 0x5c, 0x2e, 0x57, 0xe4, 0x5c, 0x2c, 0xdc,
-		0xd5, 0x89, 0x5c, 0xb2, 0xe8, 0x40, 0x36, 0x18, 0x87, 0xff, 0x64, 0x16, 0xe3, 0x5f, 0x94, 0x60,
-		0x28, 0x10, 0x57, 0x93, 0x80, 0x48, 0xd1, 0x75, 0xf3, 0xf9, 0xaa, 0xa2, 0x6b, 0x8a, 0xc3, 0x4d,
-		0x03, 0x68, 0xd6, 0x2c, 0xc9, 0xe9, 0xb7, 0xeb, 0x7e, 0x42, 0x43, 0x24, 0x99, 0x4b, 0x15, 0x3f,
-		0x2a, 0x41, 0xae, 0x35, 0xb0, 0x6d, 0x11, 0x53, 0xfa, 0xcb, 0x14, 0xb3, 0xf8, 0x11, 0x09, 0x46,
-		0xc2, 0xd1, 0x6c, 0x8b, 0x78, 0x77, 0xfd, 0xa5, 0x8a, 0xf7, 0xad, 0x18, 0x0c, 0x87, 0x62, 0xd8,
-		0x7e, 0xa5, 0x7b, 0x27, 0x8c, 0x69, 0x35, 0xdc, 0xb0, 0x4c, 0x17, 0x1b, 0xea, 0x5e, 0x55, 0xc7,
-		0xd7, 0xb0, 0x9e, 0x2f, 0x52, 0xa7, 0x71, 0xaa, 0x77, 0x94, 0x3c, 0xb3, 0xe8, 0xf3, 0x2d, 0x11,
-		0xb6, 0xd2, 0xf8, 0xe2, 0x7c, 0x65, 0x79, 0x6d, 0x75, 0xa3, 0xb2, 0x32, 0xf7, 0x4c, 0x75, 0x73,
-		0xe5, 0xca, 0xca, 0xea, 0x53, 0x2b, 0x72, 0x4e, 0x6b, 0x21, 0xbb, 0x85, 0xc3, 0x7e, 0x0d, 0x72,
-		0xad, 0x42, 0xa1, 0xdb, 0xa0, 0x93, 0x58, 0xb9, 0x01, 0x34, 0x0e, 0xa3, 0x2b, 0xab, 0xd5, 0xf5,
-		0xc5, 0xf9, 0x4a, 0xb5, 0x72, 0xe9, 0x52, 0x65, 0x6e, 0x63, 0x9d, 0xed, 0x7b, 0x78, 0xd4, 0x1b,
-		0xa1, 0x01, 0x5e, 0xfc, 0x70, 0x1c, 0xc6, 0x3b, 0x48, 0x82, 0x66, 0xf9, 0x8a, 0x85, 0x2d, 0xa2,
-		0x1e, 0xea, 0x47, 0xfa, 0x19, 0x12, 0x33, 0xac, 0x29, 0xb6, 0xcb, 0x17, 0x38, 0xf7, 0x03, 0xd1,
-		0x92, 0xe1, 0x6a, 0xdb, 0x1a, 0xb6, 0xf9, 0x7e, 0x12, 0x5b, 0xc6, 0x8c, 0xfa, 0xf9, 0x6c, 0x4b,
-		0xe9, 0x41, 0x40, 0x96, 0xe9, 0x68, 0xae, 0x76, 0x0d, 0x57, 0x35, 0x43, 0x6c, 0x3e, 0x91, 0x65,
-		0x4d, 0x42, 0xce, 0x89, 0x92, 0x45, 0xc3, 0xf5, 0xa8, 0x0d, 0x5c, 0x57, 0x5a, 0xa8, 0x89, 0x33,
-		0x8f, 0xcb, 0x39, 0x51, 0xe2, 0x51, 0xdf, 0x05, 0xd9, 0x9a, 0xd9, 0x24, 0xb1, 0x1e, 0xa3, 0x23,
-		0x73, 0x87, 0x24, 0x0f, 0xb1, 0x3c, 0x8f, 0x84, 0x47, 0xf1, 0xfe, 0xae, 0x57, 0x56, 0x1e, 0x62,
-		0x79, 0x8c, 0xe4, 0x38, 0x8c, 0x2a, 0xf5, 0xba, 0x4d, 0xc0, 0x05, 0x10, 0x5b, 0x97, 0x8c, 0x78,
-		0xd9, 0x94, 0xb0, 0xf0, 0x04, 0xa4, 0x85, 0x1e, 0xc8, 0x54, 0x4d, 0x34, 0x51, 0xb5, 0xd8, 0x62,
-		0x3b, 0x76, 0x22, 0x23, 0xa7, 0x0d, 0x51, 0x78, 0x17, 0x64, 0x35, 0xa7, 0xea, 0x6f, 0xe2, 0xc7,
-		0xa6, 0x63, 0x27, 0xd2, 0xf2, 0x90, 0xe6, 0x78, 0x1b, 0xa0, 0xc5, 0x4f, 0xc6, 0x60, 0x24, 0x7c,
-		0x08, 0x81, 0xe6, 0x21, 0xad, 0x9b, 0xaa, 0x42, 0x4d, 0x8b, 0x9d, 0x80, 0x9d, 0x88, 0x38, 0xb7,
-		0x98, 0x59, 0xe2, 0xf4, 0xb2, 0xc7, 0x59, 0xf8, 0xb7, 0x12, 0xa4, 0x45, 0x36, 0x3a, 0x0a, 0x09,
-		0x4b, 0x71, 0x77, 0x28, 0x5c, 0xb2, 0x1c, 0xcb, 0x49, 0x32, 0x4d, 0x93, 0x7c, 0xc7, 0x52, 0x0c,
-		0x6a, 0x02, 0x3c, 0x9f, 0xa4, 0x49, 0xbf, 0xea, 0x58, 0xa9, 0xd1, 0x45, 0x8f, 0xd9, 0x68, 0x60,
-		0xc3, 0x75, 0x44, 0xbf, 0xf2, 0xfc, 0x39, 0x9e, 0x8d, 0x1e, 0x80, 0x31, 0xd7, 0x56, 0x34, 0x3d,
-		0x44, 0x9b, 0xa0, 0xb4, 0x39, 0x51, 0xe0, 0x11, 0x97, 0xe0, 0x98, 0xc0, 0xad, 0x61, 0x57, 0x51,
-		0x77, 0x70, 0xcd, 0x67, 0x4a, 0xd1, 0xcd, 0x8d, 0xdb, 0x38, 0xc1, 0x3c, 0x2f, 0x17, 0xbc, 0xc5,
-		0xaf, 0x4b, 0x30, 0x26, 0x96, 0x69, 0x35, 0x4f, 0x59, 0xcb, 0x00, 0x8a, 0x61, 0x98, 0x6e, 0x50,
-		0x5d, 0xed, 0xa6, 0xdc, 0xc6, 0x37, 0x33, 0xeb, 0x31, 0xc9, 0x01, 0x80, 0x42, 0x03, 0xc0, 0x2f,
-		0xe9, 0xaa, 0xb6, 0x29, 0x18, 0xe2, 0x27, 0x4c, 0xf4, 0x98, 0x92, 0x2d, 0xec, 0x81, 0x65, 0x91,
-		0xf5, 0x1c, 0x9a, 0x80, 0xe4, 0x16, 0xae, 0x6b, 0x06, 0xdf, 0x37, 0x66, 0x09, 0xb1, 0xfd, 0x92,
-		0xf0, 0xb6, 0x5f, 0xca, 0x7f, 0x1d, 0xc6, 0x55, 0xb3, 0xd1, 0x2a, 0x6e, 0x39, 0xd7, 0xb2, 0xb9,
-		0xe0, 0x5c, 0x96, 0x9e, 0x7d, 0x88, 0x13, 0xd5, 0x4d, 0x5d, 0x31, 0xea, 0x33, 0xa6, 0x5d, 0xf7,
-		0x8f, 0x59, 0x49, 0xc4, 0xe3, 0x04, 0x0e, 0x5b, 0xad, 0xad, 0xff, 0x2d, 0x49, 0xbf, 0x12, 0x8b,
-		0x2f, 0xac, 0x95, 0x3f, 0x15, 0x2b, 0x2c, 0x30, 0xc6, 0x35, 0xa1, 0x0c, 0x19, 0x6f, 0xeb, 0x58,
-		0x25, 0x0d, 0x84, 0xef, 0x3e, 0x00, 0x13, 0x75, 0xb3, 0x6e, 0x52, 0xa4, 0x53, 0xe4, 0x17, 0x3f,
-		0xa7, 0xcd, 0x78, 0xb9, 0x85, 0xc8, 0x43, 0xdd, 0xd2, 0x0a, 0x8c, 0x73, 0xe2, 0x2a, 0x3d, 0x28,
-		0x62, 0xcb, 0x18, 0xd4, 0x73, 0x0f, 0x2d, 0xff, 0xd9, 0xef, 0xd0, 0xe9, 0x5b, 0x1e, 0xe3, 0xac,
-		0xa4, 0x8c, 0xad, 0x74, 0x4a, 0x32, 0x1c, 0x09, 0xe1, 0xb1, 0x41, 0x8a, 0xed, 0x08, 0xc4, 0xdf,
-		0xe3, 0x88, 0xe3, 0x01, 0xc4, 0x75, 0xce, 0x5a, 0x9a, 0x83, 0xe1, 0xfd, 0x60, 0xfd, 0x1b, 0x8e,
-		0x95, 0xc5, 0x41, 0x90, 0x05, 0x18, 0xa5, 0x20, 0x6a, 0xd3, 0x71, 0xcd, 0x06, 0xf5, 0x80, 0xbd,
-		0x61, 0x7e, 0xff, 0x3b, 0x6c, 0xd4, 0x8c, 0x10, 0xb6, 0x39, 0x8f, 0xab, 0x54, 0x02, 0x7a, 0x36,
-		0x56, 0xc3, 0xaa, 0x1e, 0x81, 0xf0, 0x65, 0x2e, 0x88, 0x47, 0x5f, 0xba, 0x0a, 0x13, 0xe4, 0x37,
-		0x75, 0x50, 0x41, 0x49, 0xa2, 0x37, 0xdc, 0xf2, 0x5f, 0x7f, 0x2f, 0x1b, 0x98, 0xe3, 0x1e, 0x40,
-		0x40, 0xa6, 0x40, 0x2f, 0xd6, 0xb1, 0xeb, 0x62, 0xdb, 0xa9, 0x2a, 0x7a, 0x27, 0xf1, 0x02, 0x3b,
-		0x16, 0xf9, 0x0f, 0x7d, 0x2f, 0xdc, 0x8b, 0x0b, 0x8c, 0x73, 0x56, 0xd7, 0x4b, 0x9b, 0x70, 0x5b,
-		0x07, 0xab, 0xe8, 0x03, 0xf3, 0xc3, 0x1c, 0x73, 0xa2, 0xcd, 0x32, 0x08, 0xec, 0x1a, 0x88, 0x7c,
-		0xaf, 0x2f, 0xfb, 0xc0, 0xfc, 0x65, 0x8e, 0x89, 0x38, 0xaf, 0xe8, 0x52, 0x82, 0xf8, 0x04, 0x8c,
-		0x5d, 0xc3, 0xf6, 0x96, 0xe9, 0xf0, 0x5d, 0xa2, 0x3e, 0xe0, 0x3e, 0xc2, 0xe1, 0x46, 0x39, 0x23,
-		0xdd, 0x36, 0x22, 0x58, 0x17, 0x20, 0xbd, 0xad, 0xa8, 0xb8, 0x0f, 0x88, 0x9b, 0x1c, 0x62, 0x90,
-		0xd0, 0x13, 0xd6, 0x59, 0xc8, 0xd6, 0x4d, 0x3e, 0x47, 0x45, 0xb3, 0x7f, 0x94, 0xb3, 0x0f, 0x09,
-		0x1e, 0x0e, 0x61, 0x99, 0x56, 0x53, 0x27, 0x13, 0x58, 0x34, 0xc4, 0x3f, 0x12, 0x10, 0x82, 0x87,
-		0x43, 0xec, 0x43, 0xad, 0x2f, 0x0b, 0x08, 0x27, 0xa0, 0xcf, 0xc7, 0x61, 0xc8, 0x34, 0xf4, 0x3d,
-		0xd3, 0xe8, 0x47, 0x88, 0x8f, 0x71, 0x04, 0xe0, 0x2c, 0x04, 0xe0, 0x22, 0x64, 0xfa, 0xed, 0x88,
-		0x7f, 0xfc, 0x3d, 0x31, 0x3c, 0x44, 0x0f, 0x2c, 0xc0, 0xa8, 0x70, 0x50, 0x9a, 0x69, 0xf4, 0x01,
-		0xf1, 0x4f, 0x38, 0xc4, 0x48, 0x80, 0x8d, 0x37, 0xc3, 0xc5, 0x8e, 0x5b, 0xc7, 0xfd, 0x80, 0x7c,
-		0x52, 0x34, 0x83, 0xb3, 0x70, 0x55, 0x6e, 0x61, 0x43, 0xdd, 0xe9, 0x0f, 0xe1, 0x57, 0x85, 0x2a,
-		0x05, 0x0f, 0x81, 0x98, 0x83, 0xe1, 0x86, 0x62, 0x3b, 0x3b, 0x8a, 0xde, 0x57, 0x77, 0xfc, 0x53,
-		0x8e, 0x91, 0xf5, 0x98, 0xb8, 0x46, 0x9a, 0xc6, 0x7e, 0x60, 0x3e, 0x25, 0x34, 0x12, 0x60, 0xe3,
-		0x43, 0xcf, 0x71, 0xe9, 0x96, 0xda, 0x7e, 0xd0, 0x7e, 0x4d, 0x0c, 0x3d, 0xc6, 0xbb, 0x1c, 0x44,
-		0xbc, 0x08, 0x19, 0x47, 0xbb, 0xd1, 0x17, 0xcc, 0xa7, 0x45, 0x4f, 0x53, 0x06, 0xc2, 0xfc, 0x0c,
-		0x1c, 0xeb, 0x38, 0x4d, 0xf4, 0x01, 0xf6, 0xeb, 0x1c, 0xec, 0x68, 0x87, 0xa9, 0x82, 0xbb, 0x84,
-		0xfd, 0x42, 0xfe, 0x33, 0xe1, 0x12, 0x70, 0x0b, 0xd6, 0x1a, 0x59, 0x35, 0x38, 0xca, 0xf6, 0xfe,
-		0xb4, 0xf6, 0xcf, 0x85, 0xd6, 0x18, 0x6f, 0x48, 0x6b, 0x1b, 0x70, 0x94, 0x23, 0xee, 0xaf, 0x5f,
-		0x3f, 0x23, 0x1c, 0x2b, 0xe3, 0xde, 0x0c, 0xf7, 0xee, 0x3b, 0xa0, 0xe0, 0xa9, 0x53, 0x84, 0xa7,
-		0x4e, 0xb5, 0xa1, 0x58, 0x7d, 0x20, 0x7f, 0x96, 0x23, 0x0b, 0x8f, 0xef, 0xc5, 0xb7, 0xce, 0xb2,
-		0x62, 0x11, 0xf0, 0xa7, 0x21, 0x2f, 0xc0, 0x9b, 0x86, 0x8d, 0x55, 0xb3, 0x6e, 0x68, 0x37, 0x70,
-		0xad, 0x0f, 0xe8, 0xdf, 0x68, 0xe9, 0xaa, 0xcd, 0x00, 0x3b, 0x41, 0x5e, 0x84, 0x9c, 0x17, 0xab,
-		0x54, 0xb5, 0x86, 0x65, 0xda, 0x6e, 0x04, 0xe2, 0xe7, 0x44, 0x4f, 0x79, 0x7c, 0x8b, 0x94, 0xad,
-		0x54, 0x01, 0x76, 0xce, 0xdc, 0xaf, 0x49, 0xbe, 0xc2, 0x81, 0x86, 0x7d, 0x2e, 0xee, 0x38, 0x54,
-		0xb3, 0x61, 0x29, 0x76, 0x3f, 0xfe, 0xef, 0xf3, 0xc2, 0x71, 0x70, 0x16, 0xee, 0x38, 0x48, 0x44,
-		0x47, 0x66, 0xfb, 0x3e, 0x10, 0xbe, 0x20, 0x1c, 0x87, 0xe0, 0xe1, 0x10, 0x22, 0x60, 0xe8, 0x03,
-		0xe2, 0x5f, 0x08, 0x08, 0xc1, 0x43, 0x20, 0x9e, 0xf4, 0x27, 0x5a, 0x1b, 0xd7, 0x35, 0xc7, 0xb5,
-		0x59, 0x50, 0xdc, 0x1b, 0xea, 0x37, 0xbf, 0x17, 0x0e, 0xc2, 0xe4, 0x00, 0x2b, 0xf1, 0x44, 0x7c,
-		0x93, 0x95, 0xae, 0x99, 0xa2, 0x05, 0xfb, 0x2d, 0xe1, 0x89, 0x02, 0x6c, 0x44, 0xb6, 0x40, 0x84,
-		0x48, 0xd4, 0xae, 0x92, 0x95, 0x42, 0x1f, 0x70, 0xbf, 0xdd, 0x22, 0xdc, 0xba, 0xe0, 0x25, 0x98,
-		0x81, 0xf8, 0xa7, 0x69, 0xec, 0xe2, 0xbd, 0xbe, 0xac, 0xf3, 0x77, 0x5a, 0xe2, 0x9f, 0x4d, 0xc6,
-		0xc9, 0x7c, 0xc8, 0x68, 0x4b, 0x3c, 0x85, 0xa2, 0x6e, 0x15, 0xe5, 0xdf, 0xf3, 0x43, 0xde, 0xde,
-		0x70, 0x38, 0x55, 0x5a, 0x22, 0x46, 0x1e, 0x0e, 0x7a, 0xa2, 0xc1, 0xde, 0xfb, 0x43, 0xcf, 0xce,
-		0x43, 0x31, 0x4f, 0xe9, 0x12, 0x0c, 0x87, 0x02, 0x9e, 0x68, 0xa8, 0xbf, 0xc1, 0xa1, 0xb2, 0xc1,
-		0x78, 0xa7, 0x74, 0x06, 0x12, 0x24, 0x78, 0x89, 0x66, 0xff, 0x9b, 0x9c, 0x9d, 0x92, 0x97, 0xde,
-		0x0a, 0x69, 0x11, 0xb4, 0x44, 0xb3, 0xfe, 0x02, 0x67, 0xf5, 0x58, 0x08, 0xbb, 0x08, 0x58, 0xa2,
-		0xd9, 0xff, 0x96, 0x60, 0x17, 0x2c, 0x84, 0xbd, 0x7f, 0x15, 0x7e, 0xf1, 0x6f, 0x27, 0xf8, 0xa4,
-		0x23, 0x74, 0x77, 0x11, 0x06, 0x79, 0xa4, 0x12, 0xcd, 0xfd, 0x3e, 0x5e, 0xb9, 0xe0, 0x28, 0x9d,
-		0x83, 0x64, 0x9f, 0x0a, 0xff, 0x3b, 0x9c, 0x95, 0xd1, 0x97, 0xe6, 0x60, 0x28, 0x10, 0x9d, 0x44,
-		0xb3, 0xff, 0x5d, 0xce, 0x1e, 0xe4, 0x22, 0xa2, 0xf3, 0xe8, 0x24, 0x1a, 0xe0, 0xef, 0x09, 0xd1,
-		0x39, 0x07, 0x51, 0x9b, 0x08, 0x4c, 0xa2, 0xb9, 0xdf, 0x2f, 0xb4, 0x2e, 0x58, 0x4a, 0x8f, 0x43,
-		0xc6, 0x9b, 0x6c, 0xa2, 0xf9, 0x7f, 0x91, 0xf3, 0xfb, 0x3c, 0x44, 0x03, 0x81, 0xc9, 0x2e, 0x1a,
-		0xe2, 0xef, 0x0b, 0x0d, 0x04, 0xb8, 0xc8, 0x30, 0x6a, 0x0d, 0x60, 0xa2, 0x91, 0x3e, 0x20, 0x86,
-		0x51, 0x4b, 0xfc, 0x42, 0x7a, 0x93, 0xfa, 0xfc, 0x68, 0x88, 0x7f, 0x20, 0x7a, 0x93, 0xd2, 0x13,
-		0x31, 0x5a, 0x23, 0x82, 0x68, 0x8c, 0x5f, 0x12, 0x62, 0xb4, 0x04, 0x04, 0xa5, 0x35, 0x40, 0xed,
-		0xd1, 0x40, 0x34, 0xde, 0x07, 0x39, 0xde, 0x58, 0x5b, 0x30, 0x50, 0x7a, 0x0a, 0x8e, 0x76, 0x8e,
-		0x04, 0xa2, 0x51, 0x3f, 0xf4, 0xc3, 0x96, 0xb5, 0x5b, 0x30, 0x10, 0x28, 0x6d, 0xf8, 0x53, 0x4a,
-		0x30, 0x0a, 0x88, 0x86, 0xfd, 0xf0, 0x0f, 0xc3, 0x8e, 0x3b, 0x18, 0x04, 0x94, 0x66, 0x01, 0xfc,
-		0x09, 0x38, 0x1a, 0xeb, 0x23, 0x1c, 0x2b, 0xc0, 0x44, 0x86, 0x06, 0x9f, 0x7f, 0xa3, 0xf9, 0x6f,
-		0x8a, 0xa1, 0xc1, 0x39, 0xc8, 0xd0, 0x10, 0x53, 0x6f, 0x34, 0xf7, 0x47, 0xc5, 0xd0, 0x10, 0x2c,
-		0xc4, 0xb2, 0x03, 0xb3, 0x5b, 0x34, 0xc2, 0xc7, 0x84, 0x65, 0x07, 0xb8, 0x4a, 0x2b, 0x30, 0xd6,
-		0x36, 0x21, 0x46, 0x43, 0xfd, 0x0a, 0x87, 0xca, 0xb5, 0xce, 0x87, 0xc1, 0xc9, 0x8b, 0x4f, 0x86,
-		0xd1, 0x68, 0x1f, 0x6f, 0x99, 0xbc, 0xf8, 0x5c, 0x58, 0xba, 0x08, 0x69, 0xa3, 0xa9, 0xeb, 0x64,
-		0xf0, 0xa0, 0xde, 0x37, 0x01, 0xf3, 0xff, 0xe5, 0x47, 0x5c, 0x3b, 0x82, 0xa1, 0x74, 0x06, 0x92,
-		0xb8, 0xb1, 0x85, 0x6b, 0x51, 0x9c, 0xdf, 0xfd, 0x91, 0x70, 0x98, 0x84, 0xba, 0xf4, 0x38, 0x00,
-		0xdb, 0x1a, 0xa1, 0x87, 0x81, 0x11, 0xbc, 0xff, 0xf5, 0x47, 0xfc, 0xea, 0x8d, 0xcf, 0xe2, 0x03,
-		0xb0, 0x8b, 0x3c, 0xbd, 0x01, 0xbe, 0x17, 0x06, 0xa0, 0x3d, 0x72, 0x01, 0x06, 0x9f, 0x73, 0x4c,
-		0xc3, 0x55, 0xea, 0x51, 0xdc, 0xff, 0x8d, 0x73, 0x0b, 0x7a, 0xa2, 0xb0, 0x86, 0x69, 0x63, 0x57,
-		0xa9, 0x3b, 0x51, 0xbc, 0xff, 0x9d, 0xf3, 0x7a, 0x0c, 0x84, 0x59, 0x55, 0x1c, 0xb7, 0x9f, 0x76,
-		0xff, 0xb9, 0x60, 0x16, 0x0c, 0x44, 0x68, 0xf2, 0x7b, 0x17, 0xef, 0x45, 0xf1, 0x7e, 0x5f, 0x08,
-		0xcd, 0xe9, 0x4b, 0x6f, 0x85, 0x0c, 0xf9, 0xc9, 0xee, 0xd3, 0x45, 0x30, 0xff, 0x0f, 0xce, 0xec,
-		0x73, 0x90, 0x9a, 0x1d, 0xb7, 0xe6, 0x6a, 0xd1, 0xca, 0xfe, 0x01, 0xef, 0x69, 0x41, 0x5f, 0x9a,
-		0x85, 0x21, 0xc7, 0xad, 0xd5, 0x9a, 0x3c, 0x3e, 0x8d, 0x60, 0xff, 0x9f, 0x3f, 0xf2, 0xb6, 0x2c,
-		0x3c, 0x1e, 0xd2, 0xdb, 0xcf, 0xef, 0xba, 0x96, 0x49, 0x0f, 0x3c, 0xa2, 0x10, 0x7e, 0xc8, 0x11,
-		0x02, 0x2c, 0xa5, 0x39, 0xc8, 0x92, 0xb6, 0xd8, 0xd8, 0xc2, 0xf4, 0x74, 0x2a, 0x02, 0xe2, 0x7f,
-		0x71, 0x05, 0x84, 0x98, 0xca, 0x3f, 0xfb, 0xe5, 0xd7, 0x26, 0xa5, 0xaf, 0xbd, 0x36, 0x29, 0x7d,
-		0xeb, 0xb5, 0x49, 0xe9, 0xfd, 0xdf, 0x9e, 0x1c, 0xf8, 0xda, 0xb7, 0x27, 0x07, 0xfe, 0xe8, 0xdb,
-		0x93, 0x03, 0x9d, 0x77, 0x89, 0x61, 0xc1, 0x5c, 0x30, 0xd9, 0xfe, 0xf0, 0xb3, 0xc5, 0xba, 0xe6,
-		0xee, 0x34, 0xb7, 0x66, 0x54, 0xb3, 0x41, 0xb7, 0x71, 0xfd, 0xdd, 0x5a, 0x6f, 0x91, 0x03, 0xdf,
-		0x8d, 0xc1, 0x31, 0xd5, 0x74, 0x1a, 0xa6, 0x53, 0x65, 0xfb, 0xbd, 0x2c, 0xc1, 0x77, 0x7c, 0xb3,
-		0xc1, 0xa2, 0x3e, 0x36, 0x7d, 0x37, 0x60, 0x42, 0x6b, 0x58, 0x3a, 0xa6, 0x9b, 0xf3, 0x55, 0xaa,
-		0x85, 0xfe, 0x82, 0xc1, 0xaf, 0xfc, 0x87, 0x24, 0xdb, 0x84, 0xf4, 0xd9, 0x17, 0x05, 0x77, 0x69,
-		0x09, 0xc6, 0x14, 0x55, 0xc5, 0x56, 0x08, 0x32, 0x42, 0x99, 0x02, 0x30, 0xc7, 0x39, 0x7d, 0xb4,
-		0x73, 0x90, 0x72, 0x54, 0x45, 0x57, 0x22, 0xbb, 0xf4, 0xab, 0x1c, 0x82, 0x93, 0x97, 0xcf, 0x77,
-		0xeb, 0x89, 0x67, 0x27, 0x03, 0x8a, 0x66, 0x1a, 0xe3, 0x7f, 0x1e, 0x62, 0xc8, 0x29, 0x76, 0x53,
-		0x19, 0xfe, 0x30, 0x0e, 0x93, 0xbc, 0x7c, 0x4b, 0x71, 0xf0, 0xa9, 0x6b, 0x8f, 0x6c, 0x61, 0x57,
-		0x79, 0xe4, 0x94, 0x6a, 0x6a, 0x06, 0xd7, 0xf8, 0x38, 0xd7, 0x3f, 0x29, 0x9f, 0xe1, 0xe5, 0x85,
-		0x8e, 0xdb, 0xf1, 0x85, 0xee, 0xfd, 0x56, 0xdc, 0x84, 0xc4, 0x9c, 0xa9, 0x19, 0x68, 0x02, 0x92,
-		0x35, 0x6c, 0x98, 0x0d, 0x7e, 0xed, 0x8e, 0x25, 0xd0, 0x23, 0x90, 0x52, 0x1a, 0x66, 0xd3, 0x70,
-		0xd9, 0x21, 0x45, 0xf9, 0xd8, 0x97, 0x5f, 0x9d, 0x1a, 0xf8, 0xe3, 0x57, 0xa7, 0xe2, 0x8b, 0x86,
-		0xfb, 0x07, 0xaf, 0x3c, 0x04, 0x1c, 0x6a, 0xd1, 0x70, 0x65, 0x4e, 0x58, 0x4a, 0xbc, 0xfe, 0xf2,
-		0x94, 0x54, 0x7c, 0x1a, 0x06, 0xe7, 0xb1, 0x7a, 0x10, 0xe4, 0x79, 0xac, 0x06, 0x90, 0xe7, 0xb1,
-		0xda, 0x82, 0x7c, 0x0e, 0xd2, 0x8b, 0x86, 0xcb, 0x2e, 0x4d, 0x3e, 0x00, 0x71, 0xcd, 0x60, 0xf7,
-		0x70, 0x7a, 0xca, 0x46, 0xa8, 0x08, 0xe3, 0x3c, 0x56, 0x3d, 0xc6, 0x1a, 0x56, 0x5b, 0x19, 0xdb,
-		0xab, 0x26, 0x54, 0xe5, 0xf9, 0x3f, 0xfa, 0xcf, 0x93, 0x03, 0x2f, 0xbc, 0x36, 0x39, 0xd0, 0xb5,
-		0x57, 0x8b, 0x5d, 0x7b, 0xd5, 0xa9, 0xed, 0xb2, 0xe3, 0x15, 0xaf, 0x67, 0xff, 0x2c, 0x05, 0x45,
-		0x4e, 0xe3, 0xb8, 0xca, 0xae, 0x66, 0xd4, 0xbd, 0xce, 0x55, 0x9a, 0xee, 0xce, 0x0d, 0xde, 0xbb,
-		0x47, 0xb9, 0x14, 0x9c, 0xe6, 0xc0, 0x1d, 0x5c, 0x88, 0x30, 0xa3, 0xe2, 0x9f, 0xc6, 0x01, 0xad,
-		0xbb, 0xca, 0x2e, 0x9e, 0x6d, 0xba, 0x3b, 0xa6, 0xad, 0xdd, 0x60, 0x6e, 0x10, 0x03, 0x34, 0x94,
-		0xeb, 0x55, 0xd7, 0xdc, 0xc5, 0x86, 0x43, 0x15, 0x35, 0x74, 0xfa, 0xd8, 0x4c, 0x07, 0x93, 0x9b,
-		0x21, 0x9d, 0x5c, 0x7e, 0xe0, 0x53, 0xdf, 0x9c, 0x3a, 0x1e, 0xad, 0x05, 0x4a, 0x4c, 0xe2, 0xf2,
-		0xeb, 0x1b, 0x14, 0x18, 0x5d, 0x05, 0x76, 0x3f, 0xa3, 0xaa, 0x6b, 0x8e, 0xcb, 0xaf, 0x78, 0x9f,
-		0x99, 0xe9, 0xdc, 0xf6, 0x99, 0x76, 0x31, 0x67, 0xae, 0x2a, 0xba, 0x56, 0x53, 0x5c, 0xd3, 0x76,
-		0x2e, 0x0f, 0xc8, 0x19, 0x0a, 0xb5, 0xa4, 0x39, 0x2e, 0xda, 0x80, 0x4c, 0x0d, 0x1b, 0x7b, 0x0c,
-		0x36, 0xfe, 0xc6, 0x60, 0xd3, 0x04, 0x89, 0xa2, 0x3e, 0x0d, 0x48, 0x09, 0xd2, 0x89, 0x37, 0x4d,
-		0xec, 0x6a, 0x66, 0x17, 0xf8, 0x10, 0x32, 0x7d, 0x82, 0x31, 0xa6, 0xb4, 0x66, 0x15, 0xde, 0x0e,
-		0xe0, 0xd7, 0x89, 0x4e, 0xc3, 0xa0, 0x52, 0xab, 0xd9, 0xd8, 0x71, 0xe8, 0xd9, 0x61, 0xa6, 0x9c,
-		0xff, 0x83, 0x57, 0x1e, 0x9a, 0xe0, 0xf8, 0xb3, 0xac, 0x84, 0x2d, 0xc7, 0x65, 0x41, 0x58, 0x1a,
-		0xfb, 0xea, 0x2b, 0x0f, 0x0d, 0x87, 0xea, 0x2a, 0x67, 0x01, 0xae, 0x79, 0xa0, 0x27, 0x3f, 0x2a,
-		0xc1, 0x58, 0x9b, 0x2c, 0xa8, 0x08, 0x93, 0xb3, 0x9b, 0x1b, 0x97, 0x57, 0xe5, 0xc5, 0x67, 0x67,
-		0x37, 0x16, 0x57, 0x57, 0xaa, 0xec, 0x1d, 0xc1, 0xca, 0xfa, 0x5a, 0x65, 0x6e, 0xf1, 0xd2, 0x62,
-		0x65, 0x3e, 0x37, 0x80, 0xa6, 0xe0, 0xf6, 0x0e, 0x34, 0xf3, 0x95, 0xa5, 0xca, 0xc2, 0xec, 0x46,
-		0x25, 0x27, 0xa1, 0xbb, 0xe0, 0xce, 0x8e, 0x20, 0x1e, 0x49, 0xac, 0x0b, 0x89, 0x5c, 0xf1, 0x48,
-		0xe2, 0xe5, 0x4b, 0x5d, 0xc7, 0xd7, 0x83, 0x3d, 0x2d, 0xeb, 0xba, 0x37, 0x90, 0xc2, 0x23, 0xed,
-		0x3d, 0x31, 0x38, 0xd6, 0x3a, 0x0f, 0x29, 0xc6, 0x5e, 0x97, 0xa7, 0xa4, 0x9d, 0x47, 0x56, 0xf1,
-		0x32, 0xc4, 0x67, 0x8d, 0x3d, 0x74, 0x8c, 0x05, 0xe9, 0xd5, 0xa6, 0xad, 0x73, 0x3f, 0x36, 0x48,
-		0xd2, 0x9b, 0xb6, 0x4e, 0xfc, 0x9b, 0x78, 0x3d, 0x20, 0x9d, 0xc8, 0xf2, 0x27, 0x01, 0xa5, 0xdc,
-		0x07, 0x5f, 0x9e, 0x1a, 0xf8, 0xcc, 0xcb, 0x53, 0x03, 0xdf, 0xff, 0xd8, 0xd4, 0xc0, 0x0b, 0x7f,
-		0x32, 0x3d, 0x50, 0xde, 0x6d, 0x6d, 0xde, 0x17, 0x23, 0xa7, 0xe8, 0xf4, 0xac, 0xb1, 0x47, 0x1d,
-		0xd6, 0x9a, 0xf4, 0x6c, 0x92, 0x36, 0x4e, 0x9c, 0xca, 0x4e, 0xb6, 0x9e, 0xca, 0x3e, 0x85, 0x75,
-		0xfd, 0x8a, 0x61, 0x3e, 0x4f, 0x7b, 0xd5, 0xd7, 0xc1, 0x07, 0x62, 0x30, 0xd9, 0x36, 0x17, 0xf3,
-		0xb0, 0xa5, 0xdb, 0x9b, 0xda, 0x12, 0xa4, 0xe7, 0x45, 0x34, 0x94, 0x87, 0x41, 0x07, 0xab, 0xa6,
-		0x51, 0x63, 0x3e, 0x20, 0x2e, 0x8b, 0x24, 0x69, 0xb6, 0xa1, 0x18, 0xa6, 0xc3, 0x2f, 0xf2, 0xb3,
-		0x44, 0xf9, 0x97, 0xa5, 0xfd, 0x05, 0x21, 0xc3, 0xa2, 0x26, 0xd1, 0xcc, 0x47, 0x22, 0xcf, 0xa9,
-		0x77, 0x49, 0x2b, 0xbd, 0x46, 0x84, 0xce, 0xaa, 0xfb, 0xd5, 0xca, 0x2f, 0xc5, 0x60, 0xaa, 0x55,
-		0x2b, 0x24, 0x16, 0x74, 0x5c, 0xa5, 0x61, 0x75, 0x53, 0xcb, 0x45, 0xc8, 0x6c, 0x08, 0x9a, 0x7d,
-		0xeb, 0xe5, 0xe6, 0x3e, 0xf5, 0x32, 0xe2, 0x55, 0x25, 0x14, 0x73, 0xba, 0x4f, 0xc5, 0x78, 0xed,
-		0x38, 0x90, 0x66, 0x3e, 0x95, 0x80, 0x3b, 0xe9, 0x4b, 0x2f, 0xbb, 0xa1, 0x19, 0xee, 0x29, 0xd5,
-		0xde, 0xb3, 0x5c, 0x1a, 0x0d, 0x9a, 0xdb, 0x5c, 0x2f, 0x63, 0x7e, 0xf1, 0x0c, 0x2b, 0xee, 0x32,
-		0x72, 0xb6, 0x21, 0xb9, 0x46, 0xf8, 0x88, 0x46, 0x5c, 0xd3, 0x55, 0x74, 0xae, 0x29, 0x96, 0x20,
-		0xb9, 0xec, 0x75, 0x58, 0x8c, 0xe5, 0x6a, 0xe2, 0x61, 0x98, 0x8e, 0x95, 0x6d, 0x76, 0xc9, 0x3e,
-		0x4e, 0x07, 0x54, 0x9a, 0x64, 0xd0, 0xfb, 0xf4, 0x13, 0x90, 0x54, 0x9a, 0xec, 0x7e, 0x48, 0x9c,
-		0x8c, 0x34, 0x9a, 0x28, 0x5e, 0x81, 0x41, 0x7e, 0x4a, 0x8d, 0x72, 0x10, 0xdf, 0xc5, 0x7b, 0xb4,
-		0x9e, 0xac, 0x4c, 0x7e, 0xa2, 0x19, 0x48, 0x52, 0xe1, 0xf9, 0xd4, 0x92, 0x9f, 0x69, 0x93, 0x7e,
-		0x86, 0x0a, 0x29, 0x33, 0xb2, 0xe2, 0x13, 0x90, 0x9e, 0x37, 0x1b, 0x9a, 0x61, 0x86, 0xd1, 0x32,
-		0x0c, 0x8d, 0xca, 0x6c, 0x35, 0x79, 0xcc, 0x22, 0xb3, 0x04, 0x3a, 0x0a, 0x29, 0xf6, 0xe8, 0x82,
-		0xdf, 0x71, 0xe1, 0xa9, 0xe2, 0x1c, 0x0c, 0x52, 0xec, 0x55, 0x0b, 0x21, 0xfe, 0x5c, 0x8f, 0xbf,
-		0xee, 0xa0, 0xab, 0x2b, 0x0e, 0x1f, 0xf3, 0x85, 0x45, 0x90, 0xa8, 0x29, 0xae, 0xc2, 0xdb, 0x4d,
-		0x7f, 0x17, 0xdf, 0x06, 0x69, 0x0e, 0x42, 0xa6, 0x85, 0xb8, 0x69, 0x39, 0xfc, 0x96, 0x4a, 0xa1,
-		0x5b, 0x53, 0x56, 0xad, 0x72, 0x82, 0x44, 0x34, 0x32, 0x21, 0x2e, 0xcb, 0x5d, 0x9d, 0xea, 0xf9,
-		0x80, 0x53, 0x0d, 0x74, 0x79, 0xe0, 0x27, 0xeb, 0xd2, 0x36, 0x73, 0xf0, 0x8c, 0xe5, 0x63, 0x31,
-		0x98, 0x0c, 0x94, 0x5e, 0xc3, 0xb6, 0xa3, 0x99, 0x06, 0x9f, 0xe9, 0x99, 0xb5, 0xa0, 0x80, 0x90,
-		0xbc, 0xbc, 0x8b, 0xb9, 0xbc, 0x15, 0xe2, 0xb3, 0x96, 0x85, 0x0a, 0x90, 0xa6, 0x69, 0xd5, 0x64,
-		0xf6, 0x92, 0x90, 0xbd, 0x34, 0x29, 0x73, 0xcc, 0x6d, 0xf7, 0x79, 0xc5, 0xf6, 0xde, 0x25, 0x8a,
-		0x74, 0xf1, 0x02, 0x64, 0xe6, 0x4c, 0xc3, 0xc1, 0x86, 0xd3, 0xa4, 0x63, 0x70, 0x4b, 0x37, 0xd5,
-		0x5d, 0x8e, 0xc0, 0x12, 0x44, 0xe1, 0x8a, 0x65, 0x51, 0xce, 0x84, 0x4c, 0x7e, 0xb2, 0x88, 0xb2,
-		0xbc, 0xde, 0x55, 0x45, 0x17, 0xf6, 0xaf, 0x22, 0xde, 0x48, 0x4f, 0x47, 0x3f, 0x96, 0xe0, 0x8e,
-		0xf6, 0x01, 0xb5, 0x8b, 0xf7, 0x9c, 0xfd, 0x8e, 0xa7, 0xa7, 0x21, 0xb3, 0x46, 0x3f, 0x0e, 0x70,
-		0x05, 0xef, 0xa1, 0x02, 0x0c, 0xe2, 0xda, 0xe9, 0x33, 0x67, 0x1e, 0xb9, 0xc0, 0xac, 0xfd, 0xf2,
-		0x80, 0x2c, 0x32, 0xd0, 0x24, 0x64, 0x1c, 0xac, 0x5a, 0xa7, 0xcf, 0x9c, 0xdd, 0x7d, 0x84, 0x99,
-		0x17, 0x89, 0x8d, 0xbc, 0xac, 0x52, 0x9a, 0xb4, 0xfa, 0xf5, 0x8f, 0x4d, 0x49, 0xe5, 0x24, 0xc4,
-		0x9d, 0x66, 0xe3, 0x96, 0xda, 0xc8, 0x87, 0x93, 0x30, 0x1d, 0xe4, 0xa4, 0x9e, 0xca, 0x8b, 0x4a,
-		0xb8, 0x0e, 0x72, 0x01, 0x1d, 0x50, 0x8a, 0x2e, 0x61, 0x6e, 0x4f, 0x4d, 0x16, 0x7f, 0x43, 0x82,
-		0xac, 0x17, 0x44, 0xad, 0x63, 0x17, 0x5d, 0x0c, 0xc6, 0x3f, 0x7c, 0xd8, 0xdc, 0x3e, 0xd3, 0x5a,
-		0x97, 0x1f, 0xec, 0xc9, 0x01, 0x72, 0x74, 0x8e, 0x1a, 0xa2, 0x65, 0x3a, 0xfc, 0xad, 0x5a, 0x04,
-		0xab, 0x47, 0x8c, 0x1e, 0x04, 0x44, 0x3d, 0x5c, 0xf5, 0x9a, 0xe9, 0x6a, 0x46, 0xbd, 0x6a, 0x99,
-		0xcf, 0xf3, 0x17, 0xc0, 0x71, 0x39, 0x47, 0x4b, 0xae, 0xd2, 0x82, 0x35, 0x92, 0x4f, 0x84, 0xce,
-		0x78, 0x28, 0x64, 0x5a, 0xf1, 0x03, 0x3f, 0xe2, 0x04, 0x44, 0x12, 0x5d, 0x84, 0x41, 0xab, 0xb9,
-		0x55, 0x15, 0x1e, 0x63, 0xe8, 0xf4, 0x1d, 0x9d, 0xc6, 0xbf, 0xb0, 0x0f, 0xee, 0x01, 0x52, 0x56,
-		0x73, 0x8b, 0x58, 0xcb, 0x5d, 0x90, 0xed, 0x20, 0xcc, 0xd0, 0x35, 0x5f, 0x0e, 0xfa, 0x4d, 0x0a,
-		0xde, 0x82, 0xaa, 0x65, 0x6b, 0xa6, 0xad, 0xb9, 0x7b, 0x34, 0xb2, 0x8d, 0xcb, 0x39, 0x51, 0xb0,
-		0xc6, 0xf3, 0x8b, 0xbb, 0x30, 0xba, 0x4e, 0x97, 0xdf, 0xbe, 0xe4, 0x67, 0x7c, 0xf9, 0xa4, 0x68,
-		0xf9, 0xba, 0x4a, 0x16, 0x6b, 0x93, 0xac, 0xfc, 0x64, 0x57, 0xeb, 0x3c, 0xb7, 0x7f, 0xeb, 0x0c,
-		0x47, 0x88, 0x7f, 0x7e, 0x2c, 0x34, 0x38, 0x99, 0x71, 0x06, 0xdd, 0x57, 0xbf, 0x86, 0x19, 0x15,
-		0x4d, 0x14, 0x7a, 0x4f, 0xaa, 0x85, 0x08, 0x37, 0x5a, 0x88, 0x1c, 0x42, 0xc5, 0x0b, 0x30, 0xbc,
-		0xa6, 0xd8, 0xee, 0x3a, 0x76, 0x2f, 0x63, 0xa5, 0x86, 0xed, 0xf0, 0xac, 0x3b, 0x2c, 0x66, 0x5d,
-		0x04, 0x09, 0x3a, 0xb5, 0xb2, 0x59, 0x87, 0xfe, 0x2e, 0xee, 0x40, 0x82, 0x5e, 0x37, 0xf5, 0x66,
-		0x64, 0xce, 0xc1, 0x66, 0x64, 0xe2, 0x4b, 0xf7, 0x5c, 0xec, 0x88, 0xf0, 0x96, 0x26, 0xd0, 0x63,
-		0x62, 0x5e, 0x8d, 0xf7, 0x9e, 0x57, 0xb9, 0x21, 0xf2, 0xd9, 0x55, 0x87, 0xc1, 0x32, 0x71, 0xc5,
-		0x8b, 0xf3, 0x9e, 0x20, 0x92, 0x2f, 0x08, 0x5a, 0x86, 0x51, 0x4b, 0xb1, 0x5d, 0xfa, 0xce, 0x66,
-		0x87, 0xb6, 0x82, 0xdb, 0xfa, 0x54, 0xfb, 0xc8, 0x0b, 0x35, 0x96, 0xd7, 0x32, 0x6c, 0x05, 0x33,
-		0x8b, 0x7f, 0x9a, 0x80, 0x14, 0x57, 0xc6, 0x5b, 0x61, 0x90, 0xab, 0x95, 0x5b, 0xe7, 0x9d, 0x33,
-		0xed, 0x13, 0xd3, 0x8c, 0x37, 0x81, 0x70, 0x3c, 0xc1, 0x83, 0xee, 0x83, 0xb4, 0xba, 0xa3, 0x68,
-		0x46, 0x55, 0xab, 0xf1, 0xed, 0x8a, 0xa1, 0xd7, 0x5e, 0x9d, 0x1a, 0x9c, 0x23, 0x79, 0x8b, 0xf3,
-		0xf2, 0x20, 0x2d, 0x5c, 0xac, 0x91, 0x48, 0x60, 0x07, 0x6b, 0xf5, 0x1d, 0x97, 0x8f, 0x30, 0x9e,
-		0x42, 0xe7, 0x21, 0x41, 0x0c, 0x82, 0xbf, 0xc2, 0x2c, 0xb4, 0x6d, 0x26, 0x79, 0xc1, 0x5e, 0x39,
-		0x4d, 0x2a, 0x7e, 0xff, 0x37, 0xa7, 0x24, 0x99, 0x72, 0xa0, 0x39, 0x18, 0xd6, 0x15, 0xc7, 0xad,
-		0xd2, 0x19, 0x8c, 0x54, 0x9f, 0xe4, 0x2b, 0xf1, 0x36, 0x85, 0x70, 0xc5, 0x72, 0xd1, 0x87, 0x08,
-		0x17, 0xcb, 0xaa, 0xa1, 0x13, 0x90, 0xa3, 0x20, 0xaa, 0xd9, 0x68, 0x68, 0x2e, 0x8b, 0xad, 0x52,
-		0x54, 0xef, 0x23, 0x24, 0x7f, 0x8e, 0x66, 0xd3, 0x08, 0xeb, 0x76, 0xc8, 0xd0, 0x77, 0x5f, 0x94,
-		0x84, 0xdd, 0x71, 0x4e, 0x93, 0x0c, 0x5a, 0x78, 0x1c, 0x46, 0x7d, 0xff, 0xc8, 0x48, 0xd2, 0x0c,
-		0xc5, 0xcf, 0xa6, 0x84, 0x0f, 0xc3, 0x84, 0x81, 0xaf, 0xd3, 0x5b, 0xd7, 0x21, 0xea, 0x0c, 0xa5,
-		0x46, 0xa4, 0xec, 0x6a, 0x98, 0xe3, 0x5e, 0x18, 0x51, 0x85, 0xf2, 0x19, 0x2d, 0x50, 0xda, 0x61,
-		0x2f, 0x97, 0x92, 0x1d, 0x83, 0xb4, 0x62, 0x59, 0x8c, 0x60, 0x88, 0xfb, 0x47, 0xcb, 0xa2, 0x45,
-		0x27, 0x61, 0x8c, 0xb6, 0xd1, 0xc6, 0x4e, 0x53, 0x77, 0x39, 0x48, 0x96, 0xd2, 0x8c, 0x92, 0x02,
-		0x99, 0xe5, 0x53, 0xda, 0xbb, 0x61, 0x18, 0x5f, 0xd3, 0x6a, 0xd8, 0x50, 0x31, 0xa3, 0x1b, 0xa6,
-		0x74, 0x59, 0x91, 0x49, 0x89, 0xee, 0x07, 0xcf, 0xef, 0x55, 0x85, 0x4f, 0x1e, 0x61, 0x78, 0x22,
-		0x9f, 0xaf, 0xc4, 0x8b, 0x79, 0x48, 0xcc, 0x2b, 0xae, 0x42, 0x02, 0x0c, 0xf7, 0x3a, 0x9b, 0x68,
-		0xb2, 0x32, 0xf9, 0x59, 0x7c, 0x3d, 0x06, 0x89, 0xab, 0xa6, 0x8b, 0xd1, 0xa3, 0x81, 0x00, 0x70,
-		0xa4, 0x93, 0x3d, 0xaf, 0x6b, 0x75, 0x03, 0xd7, 0x96, 0x9d, 0x7a, 0xe0, 0x23, 0x0d, 0xbe, 0x39,
-		0xc5, 0x42, 0xe6, 0x34, 0x01, 0x49, 0xdb, 0x6c, 0x1a, 0x35, 0x71, 0x3d, 0x98, 0x26, 0x50, 0x05,
-		0xd2, 0x9e, 0x95, 0x24, 0xa2, 0xac, 0x64, 0x94, 0x58, 0x09, 0xb1, 0x61, 0x9e, 0x21, 0x0f, 0x6e,
-		0x71, 0x63, 0x29, 0x43, 0xc6, 0x73, 0x5e, 0xdc, 0xda, 0xfa, 0x33, 0x58, 0x9f, 0x8d, 0x4c, 0x26,
-		0x5e, 0xdf, 0x7b, 0xca, 0x63, 0x16, 0x97, 0xf3, 0x0a, 0xb8, 0xf6, 0x42, 0x66, 0xc5, 0x3f, 0x18,
-		0x31, 0x48, 0xdb, 0xe5, 0x9b, 0x15, 0xfb, 0x68, 0xc4, 0x1d, 0x90, 0x71, 0xb4, 0xba, 0xa1, 0xb8,
-		0x4d, 0x1b, 0x73, 0xcb, 0xf3, 0x33, 0x8a, 0x5f, 0x94, 0x20, 0xc5, 0x2c, 0x39, 0xa0, 0x37, 0xa9,
-		0xb3, 0xde, 0x62, 0xdd, 0xf4, 0x16, 0x3f, 0xb8, 0xde, 0x66, 0x01, 0x3c, 0x61, 0x1c, 0xfe, 0x8e,
-		0xbf, 0x43, 0xc4, 0xc0, 0x44, 0x5c, 0xd7, 0xea, 0x7c, 0xa0, 0x06, 0x98, 0x8a, 0xff, 0x49, 0x22,
-		0x41, 0x2c, 0x2f, 0x47, 0xb3, 0x30, 0x2c, 0xe4, 0xaa, 0x6e, 0xeb, 0x4a, 0x9d, 0xdb, 0xce, 0x9d,
-		0x5d, 0x85, 0xbb, 0xa4, 0x2b, 0x75, 0x79, 0x88, 0xcb, 0x43, 0x12, 0x9d, 0xfb, 0x21, 0xd6, 0xa5,
-		0x1f, 0x42, 0x1d, 0x1f, 0x3f, 0x58, 0xc7, 0x87, 0xba, 0x28, 0xd1, 0xda, 0x45, 0x9f, 0x8b, 0xd1,
-		0xc5, 0x8c, 0x65, 0x3a, 0x8a, 0xfe, 0x93, 0x18, 0x11, 0xb7, 0x43, 0xc6, 0x32, 0xf5, 0x2a, 0x2b,
-		0x61, 0xd7, 0xe6, 0xd3, 0x96, 0xa9, 0xcb, 0x6d, 0xdd, 0x9e, 0x3c, 0xa4, 0xe1, 0x92, 0x3a, 0x04,
-		0xad, 0x0d, 0xb6, 0x6a, 0xcd, 0x86, 0x2c, 0x53, 0x05, 0x9f, 0xcb, 0x1e, 0x26, 0x3a, 0xa0, 0x93,
-		0xa3, 0xd4, 0x3e, 0xf7, 0x32, 0xb1, 0x19, 0xa5, 0xcc, 0xe9, 0x08, 0x07, 0x73, 0xfd, 0x9d, 0x56,
-		0xc1, 0x41, 0xb3, 0x94, 0x39, 0x5d, 0xf1, 0x1f, 0x4a, 0x00, 0x4b, 0x44, 0xb3, 0xb4, 0xbd, 0x64,
-		0x16, 0x72, 0xa8, 0x08, 0xd5, 0x50, 0xcd, 0x93, 0xdd, 0x3a, 0x8d, 0xd7, 0x9f, 0x75, 0x82, 0x72,
-		0xcf, 0xc1, 0xb0, 0x6f, 0x8c, 0x0e, 0x16, 0xc2, 0x4c, 0xf6, 0x88, 0xaa, 0xd7, 0xb1, 0x2b, 0x67,
-		0xaf, 0x05, 0x52, 0xc5, 0x7f, 0x2d, 0x41, 0x86, 0xca, 0xb4, 0x8c, 0x5d, 0x25, 0xd4, 0x87, 0xd2,
-		0xc1, 0xfb, 0xf0, 0x4e, 0x00, 0x06, 0xe3, 0x68, 0x37, 0x30, 0xb7, 0xac, 0x0c, 0xcd, 0x59, 0xd7,
-		0x6e, 0x60, 0x74, 0xd6, 0x53, 0x78, 0xbc, 0xb7, 0xc2, 0x45, 0xd4, 0xcd, 0xd5, 0x7e, 0x1b, 0x0c,
-		0xd2, 0xef, 0x5e, 0x5d, 0x77, 0x78, 0x20, 0x9d, 0x32, 0x9a, 0x8d, 0x8d, 0xeb, 0x4e, 0xf1, 0x39,
-		0x18, 0xdc, 0xb8, 0xce, 0xf6, 0x46, 0x6e, 0x87, 0x8c, 0x6d, 0x9a, 0x7c, 0x4e, 0x66, 0xb1, 0x50,
-		0x9a, 0x64, 0xd0, 0x29, 0x48, 0xec, 0x07, 0xc4, 0xfc, 0xfd, 0x00, 0x7f, 0x43, 0x23, 0xde, 0xd7,
-		0x86, 0xc6, 0xc9, 0x3f, 0x94, 0x60, 0x28, 0xe0, 0x1f, 0xd0, 0x23, 0x70, 0xa4, 0xbc, 0xb4, 0x3a,
-		0x77, 0xa5, 0xba, 0x38, 0x5f, 0xbd, 0xb4, 0x34, 0xbb, 0xe0, 0x3f, 0x0c, 0x2b, 0x1c, 0x7d, 0xe9,
-		0xe6, 0x34, 0x0a, 0xd0, 0x6e, 0x1a, 0x74, 0x47, 0x09, 0x9d, 0x82, 0x89, 0x30, 0xcb, 0x6c, 0x79,
-		0xbd, 0xb2, 0xb2, 0x91, 0x93, 0x0a, 0x47, 0x5e, 0xba, 0x39, 0x3d, 0x16, 0xe0, 0x98, 0xdd, 0x72,
-		0xb0, 0xe1, 0xb6, 0x33, 0xcc, 0xad, 0x2e, 0x2f, 0x2f, 0x6e, 0xe4, 0x62, 0x6d, 0x0c, 0xdc, 0x61,
-		0xdf, 0x0f, 0x63, 0x61, 0x86, 0x95, 0xc5, 0xa5, 0x5c, 0xbc, 0x80, 0x5e, 0xba, 0x39, 0x3d, 0x12,
-		0xa0, 0x5e, 0xd1, 0xf4, 0x42, 0xfa, 0xc5, 0x8f, 0x4f, 0x0e, 0xfc, 0xea, 0x27, 0x26, 0x25, 0xd2,
-		0xb2, 0xe1, 0x90, 0x8f, 0x40, 0x0f, 0xc2, 0x6d, 0xeb, 0x8b, 0x0b, 0x2b, 0x95, 0xf9, 0xea, 0xf2,
-		0xfa, 0x82, 0xd8, 0x83, 0x16, 0xad, 0x1b, 0x7d, 0xe9, 0xe6, 0xf4, 0x10, 0x6f, 0x52, 0x37, 0xea,
-		0x35, 0xb9, 0x72, 0x75, 0x75, 0xa3, 0x92, 0x93, 0x18, 0xf5, 0x9a, 0x8d, 0xaf, 0x99, 0x2e, 0xfb,
-		0x30, 0xde, 0xc3, 0x70, 0xac, 0x03, 0xb5, 0xd7, 0xb0, 0xb1, 0x97, 0x6e, 0x4e, 0x0f, 0xaf, 0xd9,
-		0x98, 0x8d, 0x1f, 0xca, 0x31, 0x03, 0xf9, 0x76, 0x8e, 0xd5, 0xb5, 0xd5, 0xf5, 0xd9, 0xa5, 0xdc,
-		0x74, 0x21, 0xf7, 0xd2, 0xcd, 0xe9, 0xac, 0x70, 0x86, 0xf4, 0x08, 0xc0, 0x6b, 0xd9, 0xad, 0x5c,
-		0xf1, 0xbc, 0xfe, 0x00, 0xdc, 0xd3, 0xe5, 0xf4, 0x49, 0x9c, 0x5b, 0x1c, 0xe8, 0xfc, 0xa9, 0xeb,
-		0x3e, 0x7b, 0x21, 0x62, 0xfb, 0x39, 0x7a, 0xe9, 0x74, 0xf0, 0xb3, 0xad, 0x42, 0xcf, 0xc5, 0x5d,
-		0xf1, 0x7d, 0x12, 0x8c, 0x5c, 0xd6, 0x1c, 0xd7, 0xb4, 0x35, 0x55, 0xd1, 0xe9, 0x73, 0xb0, 0xb3,
-		0xfd, 0xfa, 0xd6, 0x96, 0xa1, 0xfe, 0x38, 0xa4, 0xae, 0x29, 0x3a, 0x73, 0x6a, 0x71, 0xfa, 0xf5,
-		0x9a, 0x2e, 0x87, 0x41, 0x9e, 0x6b, 0x13, 0x00, 0x8c, 0xad, 0xf8, 0xe9, 0x18, 0x8c, 0xd2, 0xc1,
-		0xe0, 0xb0, 0xef, 0x9a, 0x91, 0x35, 0xd6, 0x1a, 0x24, 0x6c, 0xc5, 0xe5, 0x9b, 0x86, 0xe5, 0xb7,
-		0xf0, 0x53, 0xca, 0xfb, 0xfa, 0x38, 0x65, 0x6b, 0x3f, 0xc8, 0xa4, 0x48, 0xe8, 0x29, 0x48, 0x37,
-		0x94, 0xeb, 0x55, 0x8a, 0x1a, 0x3b, 0x04, 0xd4, 0xc1, 0x86, 0x72, 0x9d, 0xc8, 0x8a, 0x6a, 0x30,
-		0x4a, 0x80, 0xd5, 0x1d, 0xc5, 0xa8, 0x63, 0x86, 0x1f, 0x3f, 0x04, 0xfc, 0xe1, 0x86, 0x72, 0x7d,
-		0x8e, 0x62, 0x92, 0x5a, 0x4a, 0xe9, 0x0f, 0xbe, 0x3c, 0x35, 0x40, 0x0f, 0x81, 0x7f, 0x47, 0x02,
-		0xf0, 0xd5, 0x85, 0x7e, 0x06, 0x72, 0xaa, 0x97, 0xa2, 0xd5, 0x8b, 0x23, 0xcb, 0xe3, 0xdd, 0x3a,
-		0xa2, 0x45, 0xd9, 0x6c, 0x62, 0xfe, 0xda, 0xab, 0x53, 0x92, 0x3c, 0xaa, 0xb6, 0xf4, 0x43, 0x05,
-		0x86, 0x9a, 0x56, 0x4d, 0x71, 0x71, 0x95, 0x2e, 0xe2, 0x62, 0xfb, 0x98, 0xe4, 0x81, 0x31, 0x92,
-		0xa2, 0x80, 0xf4, 0x9f, 0x96, 0x60, 0x68, 0x3e, 0x70, 0x1f, 0x33, 0x0f, 0x83, 0x0d, 0xd3, 0xd0,
-		0x76, 0xb9, 0xd9, 0x65, 0x64, 0x91, 0x44, 0x05, 0x48, 0xb3, 0x87, 0xb0, 0xee, 0x9e, 0xd8, 0xf1,
-		0x14, 0x69, 0xc2, 0xf5, 0x3c, 0xde, 0x72, 0x34, 0xa1, 0x6b, 0x59, 0x24, 0xc9, 0xd2, 0xc5, 0xc1,
-		0x6a, 0xd3, 0xd6, 0xdc, 0xbd, 0xaa, 0x6a, 0x1a, 0xae, 0xa2, 0xba, 0xfc, 0x49, 0xe5, 0xa8, 0xc8,
-		0x9f, 0x63, 0xd9, 0x04, 0xa4, 0x86, 0x5d, 0x45, 0xd3, 0x9d, 0x3c, 0xbb, 0xc2, 0x20, 0x92, 0x01,
-		0x71, 0xbf, 0x92, 0x0a, 0x6e, 0x51, 0xcd, 0x41, 0xce, 0xb4, 0xb0, 0x1d, 0x0a, 0x29, 0x99, 0x85,
-		0x76, 0x3f, 0xa4, 0x1c, 0x15, 0x1c, 0x22, 0xd6, 0x7c, 0x86, 0x74, 0x98, 0x58, 0xef, 0x59, 0xcd,
-		0x2d, 0x7f, 0x5b, 0x6b, 0xa2, 0x4d, 0xaf, 0xb3, 0xc6, 0x5e, 0x39, 0xff, 0x55, 0x1f, 0xda, 0xdf,
-		0x4b, 0xba, 0x82, 0xf7, 0x48, 0x6f, 0x71, 0x9c, 0x35, 0x0a, 0x43, 0x42, 0xc4, 0xe7, 0x14, 0x4d,
-		0x17, 0xef, 0xfb, 0x65, 0x9e, 0x42, 0x25, 0x48, 0x39, 0xae, 0xe2, 0x36, 0x1d, 0x7e, 0x5e, 0x5b,
-		0xec, 0x66, 0x19, 0x65, 0xd3, 0xa8, 0xad, 0x53, 0x4a, 0x99, 0x73, 0xa0, 0x0d, 0x48, 0xf1, 0x83,
-		0xf0, 0xe4, 0xbe, 0xad, 0xba, 0xc3, 0x4d, 0x09, 0x86, 0x85, 0xea, 0x90, 0xab, 0x61, 0x1d, 0xd7,
-		0x59, 0x40, 0xb4, 0xa3, 0x90, 0x75, 0x43, 0xea, 0x10, 0x46, 0xcd, 0xa8, 0x87, 0xba, 0x4e, 0x41,
-		0xd1, 0x95, 0xd0, 0xf5, 0x5f, 0xfe, 0x89, 0xca, 0xbb, 0xbb, 0xb5, 0x3f, 0x60, 0x99, 0x62, 0x33,
-		0x21, 0x78, 0x79, 0xf8, 0x7e, 0xc8, 0x35, 0x8d, 0x2d, 0xd3, 0xa0, 0xaf, 0x70, 0x79, 0x30, 0x9e,
-		0xa6, 0xe1, 0xcd, 0xa8, 0x97, 0x7f, 0x99, 0x45, 0xe5, 0x57, 0x60, 0xc4, 0x27, 0xa5, 0x63, 0x27,
-		0xb3, 0x8f, 0xb1, 0x33, 0xec, 0xf1, 0x92, 0x52, 0x74, 0x19, 0xc0, 0x1f, 0x98, 0x74, 0x7b, 0x60,
-		0xa8, 0x7b, 0x1f, 0xfa, 0xa3, 0x5b, 0x2c, 0xb3, 0x7c, 0x5e, 0xa4, 0xc3, 0x78, 0x43, 0x33, 0xaa,
-		0x0e, 0xd6, 0xb7, 0xab, 0x5c, 0x55, 0x04, 0x72, 0xe8, 0x10, 0xba, 0x76, 0xac, 0xa1, 0x19, 0xeb,
-		0x58, 0xdf, 0x9e, 0xf7, 0x60, 0x4b, 0xd9, 0x17, 0x5f, 0x9e, 0x1a, 0xe0, 0x63, 0x69, 0xa0, 0xb8,
-		0x46, 0xb7, 0xa8, 0xf9, 0x30, 0xc0, 0x0e, 0x3a, 0x0b, 0x19, 0x45, 0x24, 0x22, 0xcf, 0xfa, 0x7d,
-		0x52, 0x36, 0x3a, 0x5f, 0xf8, 0x93, 0x69, 0xa9, 0xf8, 0x09, 0x09, 0x52, 0xf3, 0x57, 0xd7, 0x14,
-		0xcd, 0x46, 0x15, 0x18, 0xf3, 0x0d, 0xaa, 0xdf, 0xb1, 0xe9, 0xdb, 0xa0, 0x18, 0x9c, 0x95, 0x6e,
-		0xab, 0xc6, 0x9e, 0x30, 0xad, 0xeb, 0xc9, 0x96, 0x86, 0x57, 0x60, 0x90, 0x49, 0xe9, 0xa0, 0x12,
-		0x24, 0x2d, 0xf2, 0x83, 0xef, 0xc8, 0x4f, 0x76, 0x35, 0x44, 0x4a, 0xef, 0xed, 0x20, 0x12, 0x96,
-		0xe2, 0x8f, 0x25, 0x80, 0xf9, 0xab, 0x57, 0x37, 0x6c, 0xcd, 0xd2, 0xb1, 0x7b, 0x58, 0x2d, 0x5e,
-		0x82, 0x23, 0x81, 0xa5, 0x89, 0xad, 0xf6, 0xdd, 0xea, 0x71, 0x7f, 0x71, 0x62, 0xab, 0x1d, 0xd1,
-		0x6a, 0x8e, 0xeb, 0xa1, 0xc5, 0xfb, 0x46, 0x9b, 0x77, 0xdc, 0xce, 0x6a, 0x5c, 0x87, 0x21, 0xbf,
-		0xf9, 0x0e, 0x9a, 0x87, 0xb4, 0xcb, 0x7f, 0x73, 0x6d, 0x16, 0xbb, 0x6b, 0x53, 0xb0, 0x71, 0x8d,
-		0x7a, 0x9c, 0xc5, 0xff, 0x4b, 0x94, 0xea, 0x59, 0xec, 0x9b, 0xcb, 0x8c, 0x88, 0xef, 0xe5, 0xbe,
-		0xf1, 0x30, 0x22, 0x0a, 0x8e, 0xd5, 0xa2, 0xd5, 0xf7, 0xc6, 0x60, 0x7c, 0x53, 0x78, 0x9b, 0x37,
-		0xad, 0x26, 0xd6, 0x60, 0x10, 0x1b, 0xae, 0xad, 0x51, 0x55, 0x90, 0xbe, 0x7e, 0xb8, 0x5b, 0x5f,
-		0x77, 0x68, 0x0b, 0xfd, 0xf8, 0x93, 0xd8, 0xd7, 0xe6, 0x30, 0x2d, 0x5a, 0xf8, 0x8f, 0x31, 0xc8,
-		0x77, 0xe3, 0x44, 0xc7, 0x61, 0x54, 0xb5, 0x31, 0xbb, 0xf5, 0x14, 0xda, 0x5c, 0x1b, 0x11, 0xd9,
-		0xdc, 0xe9, 0x2f, 0x03, 0x09, 0xa0, 0x88, 0x61, 0xd1, 0x0b, 0x52, 0xfb, 0x8d, 0x98, 0x46, 0x7c,
-		0x66, 0xea, 0xf6, 0x31, 0x8c, 0x6a, 0x86, 0xe6, 0x6a, 0x8a, 0x5e, 0xdd, 0x52, 0x74, 0xc5, 0x50,
-		0x0f, 0x12, 0x59, 0xb6, 0x3b, 0xea, 0x11, 0x0e, 0x5a, 0x66, 0x98, 0xe8, 0x2a, 0x0c, 0x0a, 0xf8,
-		0xc4, 0x21, 0xc0, 0x0b, 0xb0, 0x40, 0x14, 0xf5, 0x8d, 0x18, 0x8c, 0xc9, 0xb8, 0xf6, 0xd3, 0xa5,
-		0xd6, 0x77, 0x00, 0xb0, 0x01, 0x47, 0xfc, 0xe0, 0x01, 0x34, 0xdb, 0x3e, 0x80, 0x33, 0x0c, 0x6f,
-		0xde, 0x71, 0x03, 0xba, 0xfd, 0x6a, 0x0c, 0xb2, 0x41, 0xdd, 0xfe, 0x14, 0xcc, 0x0b, 0x68, 0xd1,
-		0xf7, 0x06, 0x09, 0xfe, 0xd9, 0xda, 0x2e, 0xde, 0xa0, 0xcd, 0xea, 0x7a, 0xbb, 0x81, 0x1f, 0xc4,
-		0x20, 0xb5, 0xa6, 0xd8, 0x4a, 0xc3, 0x41, 0x4f, 0xb4, 0x05, 0x70, 0x62, 0x97, 0xad, 0xed, 0xe3,
-		0xe4, 0x7c, 0x51, 0xcf, 0x4c, 0xee, 0x83, 0x1d, 0xe2, 0xb7, 0x7b, 0x61, 0x84, 0x2c, 0x11, 0x03,
-		0x07, 0xf2, 0x31, 0x7a, 0xcc, 0x48, 0xd6, 0x78, 0x81, 0xab, 0x8f, 0x53, 0x30, 0x44, 0xc8, 0x7c,
-		0x47, 0x47, 0x68, 0xa0, 0xa1, 0x5c, 0xaf, 0xb0, 0x1c, 0xf4, 0x10, 0xa0, 0x1d, 0x6f, 0xd1, 0x5e,
-		0xf5, 0x55, 0x40, 0xe8, 0xc6, 0xfc, 0x12, 0x41, 0x7e, 0x27, 0x00, 0x91, 0xa2, 0xca, 0xae, 0x20,
-		0xb3, 0x35, 0x4e, 0x86, 0xe4, 0xcc, 0xd3, 0x6b, 0xc8, 0x3f, 0xc7, 0x62, 0xc1, 0x96, 0xd5, 0x23,
-		0x0f, 0xc3, 0x97, 0xf6, 0x67, 0xa9, 0x3f, 0x78, 0x75, 0xaa, 0xb0, 0xa7, 0x34, 0xf4, 0x52, 0xb1,
-		0x03, 0x64, 0x91, 0xc6, 0x86, 0xe1, 0x55, 0x67, 0xc0, 0x82, 0x3f, 0x2e, 0x01, 0xf2, 0x5d, 0xae,
-		0x8c, 0x1d, 0x8b, 0x2c, 0x6b, 0x48, 0xd0, 0x1b, 0x88, 0x50, 0xa5, 0xde, 0x41, 0xaf, 0xcf, 0x2f,
-		0x82, 0xde, 0xc0, 0x88, 0xb8, 0xe0, 0x3b, 0xb8, 0x58, 0xd4, 0x65, 0x5e, 0x6e, 0x1e, 0xad, 0x3e,
-		0x6c, 0xa0, 0xf8, 0x0d, 0x09, 0x8e, 0xb5, 0x59, 0x93, 0x27, 0xec, 0x5f, 0x03, 0x64, 0x07, 0x0a,
-		0xf9, 0xf7, 0x07, 0x99, 0xd0, 0xfb, 0x36, 0xce, 0x31, 0xbb, 0xcd, 0x57, 0xde, 0x2a, 0x1f, 0xcd,
-		0xee, 0x95, 0xff, 0x2b, 0x09, 0x26, 0x82, 0xc2, 0x78, 0xcd, 0x5a, 0x81, 0x6c, 0x50, 0x16, 0xde,
-		0xa0, 0x7b, 0xfa, 0x69, 0x10, 0x6f, 0x4b, 0x88, 0x1f, 0x3d, 0xe9, 0x0f, 0x5c, 0xb6, 0x59, 0xf4,
-		0x48, 0xdf, 0xba, 0x11, 0x32, 0xb5, 0x0e, 0xe0, 0x84, 0x88, 0x62, 0x12, 0x6b, 0xa6, 0xa9, 0xa3,
-		0x77, 0xc3, 0x98, 0x61, 0xba, 0x55, 0x62, 0xe5, 0xb8, 0x16, 0xbc, 0xc2, 0x9d, 0x29, 0x3f, 0xb9,
-		0x3f, 0x95, 0x7d, 0xf7, 0xd5, 0xa9, 0x76, 0xa8, 0x16, 0x3d, 0x8e, 0x1a, 0xa6, 0x5b, 0xa6, 0xe5,
-		0xfc, 0x4e, 0xb7, 0x0d, 0xc3, 0xe1, 0xaa, 0x99, 0xb7, 0x5c, 0xde, 0x77, 0xd5, 0xc3, 0xbd, 0xaa,
-		0xcd, 0x6e, 0x05, 0xea, 0x64, 0x77, 0x9a, 0xbe, 0xff, 0xf2, 0x94, 0x74, 0xf2, 0x0b, 0x12, 0x80,
-		0xbf, 0x84, 0x47, 0x0f, 0xc2, 0x6d, 0xe5, 0xd5, 0x95, 0xf9, 0xea, 0xfa, 0xc6, 0xec, 0xc6, 0xe6,
-		0x7a, 0xf8, 0x62, 0xb3, 0xd8, 0x13, 0x76, 0x2c, 0xac, 0x6a, 0xdb, 0x1a, 0xae, 0xa1, 0xfb, 0x60,
-		0x22, 0x4c, 0x4d, 0x52, 0x95, 0xf9, 0x9c, 0x54, 0xc8, 0xbe, 0x74, 0x73, 0x3a, 0xcd, 0xa2, 0x23,
-		0x5c, 0x43, 0x27, 0xe0, 0x48, 0x3b, 0xdd, 0xe2, 0xca, 0x42, 0x2e, 0x56, 0x18, 0x7e, 0xe9, 0xe6,
-		0x74, 0xc6, 0x0b, 0xa3, 0x50, 0x11, 0x50, 0x90, 0x92, 0xe3, 0xc5, 0x0b, 0xf0, 0xd2, 0xcd, 0xe9,
-		0x14, 0x53, 0x5b, 0x21, 0xf1, 0xe2, 0xc7, 0x27, 0x07, 0x0e, 0xfd, 0xfa, 0xf3, 0x57, 0x06, 0xbb,
-		0x6e, 0xf5, 0xd6, 0xb1, 0x81, 0x1d, 0xcd, 0x39, 0xd0, 0x56, 0x6f, 0x5f, 0xdb, 0xc7, 0xbd, 0x5e,
-		0x9c, 0xfc, 0x45, 0x02, 0xb2, 0x0b, 0x4c, 0x00, 0xd2, 0x47, 0x18, 0xbd, 0x05, 0x52, 0x16, 0x9d,
-		0x6f, 0xbc, 0x63, 0xa5, 0x2e, 0xe3, 0x81, 0xcd, 0x4a, 0xde, 0xdd, 0x26, 0x36, 0x47, 0x3d, 0xcd,
-		0x2f, 0x37, 0xb0, 0x3b, 0x57, 0xfe, 0x2d, 0xa2, 0x6c, 0x79, 0x66, 0x7f, 0x06, 0xc7, 0x2e, 0x43,
-		0x6c, 0x10, 0x18, 0x76, 0x25, 0xaa, 0x06, 0x47, 0x28, 0xb2, 0x3f, 0x69, 0x53, 0x74, 0x11, 0x7d,
-		0x9f, 0xec, 0x26, 0xe6, 0x92, 0xe2, 0xf8, 0xf7, 0x1b, 0xd8, 0x1d, 0x26, 0x26, 0xf2, 0xb8, 0xde,
-		0x56, 0xe2, 0xa0, 0x85, 0xd0, 0x25, 0xb5, 0xc4, 0xfe, 0xb6, 0x8f, 0x83, 0x17, 0xd6, 0x9e, 0x80,
-		0x21, 0xdf, 0x5d, 0x38, 0xfc, 0x1f, 0xa4, 0xf4, 0x3f, 0x59, 0x04, 0x99, 0xd1, 0x36, 0x1c, 0xf1,
-		0x27, 0xfe, 0x20, 0x2a, 0xfb, 0x3f, 0x32, 0x0f, 0xec, 0x63, 0xe1, 0xc1, 0xe1, 0x27, 0x9a, 0xed,
-		0x45, 0x64, 0x49, 0x33, 0x1c, 0xf4, 0x8d, 0x4e, 0x5e, 0x7c, 0x0a, 0xb1, 0x7f, 0xe7, 0x1a, 0x06,
-		0x60, 0xff, 0xbb, 0xc2, 0x32, 0x6d, 0x17, 0xd7, 0xe8, 0xb6, 0x54, 0x5a, 0xf6, 0xd2, 0xc5, 0x1d,
-		0x40, 0xed, 0x7d, 0x13, 0x7e, 0x6c, 0x21, 0xf5, 0xf5, 0xd8, 0x02, 0x4d, 0x40, 0x32, 0x78, 0x5f,
-		0x8d, 0x25, 0x4a, 0xe9, 0x17, 0xf9, 0x44, 0x79, 0xe8, 0x63, 0xf9, 0x9b, 0x31, 0x38, 0x19, 0x3c,
-		0xeb, 0x78, 0x67, 0x13, 0xdb, 0x7b, 0xde, 0xd0, 0xb3, 0x94, 0xba, 0x66, 0x04, 0xaf, 0xf4, 0x1f,
-		0x0b, 0x4e, 0xed, 0x94, 0x56, 0x68, 0xb0, 0xf8, 0xa2, 0x04, 0x43, 0x6b, 0x4a, 0x1d, 0xcb, 0xf8,
-		0x9d, 0x4d, 0xec, 0xb8, 0x1d, 0xae, 0x4c, 0x1f, 0x85, 0x94, 0xb9, 0xbd, 0x2d, 0x0e, 0x68, 0x13,
-		0x32, 0x4f, 0x91, 0x36, 0xeb, 0x5a, 0x43, 0x63, 0x77, 0x9b, 0x12, 0x32, 0x4b, 0x90, 0x78, 0x4d,
-		0x35, 0x9b, 0x06, 0x1f, 0x7f, 0xf9, 0x84, 0xf8, 0x1a, 0x49, 0xd3, 0x60, 0x43, 0x09, 0xe5, 0x61,
-		0xd0, 0xc6, 0xd7, 0xb0, 0xed, 0xb0, 0xef, 0x2f, 0xa6, 0x65, 0x91, 0x2c, 0x3e, 0x0e, 0x59, 0x26,
-		0x09, 0x9f, 0x68, 0x8f, 0x41, 0x9a, 0x5e, 0x1b, 0xf2, 0xe5, 0x19, 0x24, 0xe9, 0x2b, 0xec, 0xe2,
-		0x35, 0xc3, 0x67, 0x22, 0xb1, 0x44, 0xb9, 0xdc, 0x55, 0xcb, 0x27, 0xa2, 0x87, 0x3c, 0xd3, 0xa1,
-		0xa7, 0xe1, 0xdf, 0x4b, 0xc2, 0x11, 0x7e, 0x12, 0xa5, 0x58, 0xda, 0xa9, 0x1d, 0xd7, 0x15, 0x0f,
-		0x01, 0x80, 0xc7, 0xba, 0x8a, 0xa5, 0x15, 0xf7, 0x20, 0x71, 0xd9, 0x75, 0x2d, 0x74, 0x12, 0x92,
-		0x76, 0x53, 0xc7, 0x62, 0xd3, 0xc5, 0xdb, 0xb4, 0x56, 0x2c, 0x6d, 0x86, 0x10, 0xc8, 0x4d, 0x1d,
-		0xcb, 0x8c, 0x04, 0x55, 0x60, 0x6a, 0xbb, 0xa9, 0xeb, 0x7b, 0xd5, 0x1a, 0xa6, 0xff, 0x50, 0xca,
-		0xfb, 0x97, 0x0c, 0xf8, 0xba, 0xa5, 0x88, 0x0f, 0x3b, 0x12, 0xc5, 0xdc, 0x41, 0xc9, 0xe6, 0x29,
-		0x95, 0xf8, 0x77, 0x0c, 0x15, 0x41, 0x53, 0xfc, 0xe3, 0x18, 0xa4, 0x05, 0x34, 0xbd, 0x09, 0x8d,
-		0x75, 0xac, 0xba, 0xa6, 0x38, 0x32, 0xf0, 0xd2, 0x08, 0x41, 0xbc, 0xce, 0x3b, 0x2f, 0x73, 0x79,
-		0x40, 0x26, 0x09, 0x92, 0xe7, 0xdd, 0x4f, 0x27, 0x79, 0x56, 0x93, 0xf4, 0x67, 0xc2, 0x32, 0xc5,
-		0xaa, 0xec, 0xf2, 0x80, 0x4c, 0x53, 0x28, 0x0f, 0x29, 0x32, 0x9c, 0x5c, 0xd6, 0x5b, 0x24, 0x9f,
-		0xa7, 0xd1, 0x51, 0x48, 0x5a, 0x8a, 0xab, 0xb2, 0xab, 0x63, 0xa4, 0x80, 0x25, 0xd1, 0x39, 0x48,
-		0xb1, 0x77, 0xcb, 0xad, 0xff, 0xad, 0x85, 0x28, 0x83, 0x7d, 0x20, 0x8e, 0xc8, 0xbd, 0xa6, 0xb8,
-		0x2e, 0xb6, 0x0d, 0x02, 0xc8, 0xc8, 0x11, 0x82, 0xc4, 0x96, 0x59, 0xdb, 0xe3, 0xff, 0x41, 0x86,
-		0xfe, 0xe6, 0xff, 0xb2, 0x82, 0xda, 0x43, 0x95, 0x16, 0xb2, 0x7f, 0x9c, 0x95, 0x15, 0x99, 0x65,
-		0x42, 0x54, 0x81, 0x71, 0xa5, 0x56, 0xd3, 0xd8, 0x3f, 0x73, 0xa9, 0x6e, 0x69, 0xd4, 0xad, 0x38,
-		0xf4, 0xdf, 0xa2, 0x75, 0xeb, 0x0b, 0xe4, 0x33, 0x94, 0x39, 0x7d, 0x39, 0x03, 0x83, 0x16, 0x13,
-		0xaa, 0x78, 0x11, 0xc6, 0xda, 0x24, 0x25, 0xf2, 0xed, 0x6a, 0x46, 0x4d, 0x5c, 0xda, 0x27, 0xbf,
-		0x49, 0x1e, 0xfd, 0xa4, 0x23, 0x3b, 0x8c, 0xa1, 0xbf, 0xcb, 0x3f, 0xdf, 0xfd, 0x6d, 0xc7, 0x48,
-		0xe0, 0x6d, 0x87, 0x62, 0x69, 0xe5, 0x0c, 0xc5, 0xe7, 0x2f, 0x3a, 0x66, 0xdb, 0x5f, 0x74, 0xd4,
-		0xb1, 0x21, 0x26, 0x5c, 0x52, 0xa4, 0x58, 0x9a, 0x43, 0xcd, 0xd1, 0xff, 0xc4, 0xa4, 0x73, 0x31,
-		0xf0, 0x9b, 0x3e, 0xf0, 0x48, 0x2c, 0xcc, 0xae, 0x2d, 0x7a, 0x76, 0xfc, 0xa5, 0x18, 0xdc, 0x11,
-		0xb0, 0xe3, 0x00, 0x71, 0xbb, 0x39, 0x17, 0x3a, 0x5b, 0x7c, 0x1f, 0xaf, 0x77, 0xaf, 0x40, 0x82,
-		0xd0, 0xa3, 0x88, 0x7f, 0x28, 0x91, 0xff, 0xcc, 0x57, 0xff, 0x65, 0x31, 0x7c, 0xac, 0x13, 0xea,
-		0x15, 0x0a, 0x52, 0xfe, 0x85, 0xfe, 0xf5, 0x97, 0xf3, 0xbf, 0xae, 0xe9, 0x1c, 0x9e, 0x1a, 0x5b,
-		0x75, 0xf8, 0xe2, 0x85, 0xae, 0x4f, 0x34, 0x99, 0x33, 0xed, 0x1d, 0x37, 0xed, 0xc3, 0x53, 0x77,
-		0xbb, 0xe7, 0xde, 0xab, 0x07, 0xdf, 0x78, 0x04, 0x76, 0x1d, 0x8e, 0x3e, 0x49, 0xc4, 0xf2, 0x97,
-		0xdd, 0x62, 0x36, 0x38, 0xea, 0x1d, 0x92, 0x49, 0xfc, 0x41, 0x33, 0x8b, 0xa2, 0x2f, 0x01, 0xf8,
-		0xa2, 0xf3, 0x05, 0xe4, 0x7d, 0x33, 0x5d, 0x67, 0x99, 0x99, 0xc0, 0x0c, 0x23, 0x07, 0x38, 0x8b,
-		0xbf, 0x26, 0xc1, 0x6d, 0x6d, 0x55, 0x73, 0xf7, 0xbf, 0xd0, 0xe1, 0xb6, 0xfe, 0x81, 0x02, 0xa1,
-		0x85, 0x0e, 0xc2, 0x1e, 0x8f, 0x14, 0x96, 0x49, 0x11, 0x92, 0xf6, 0x69, 0x38, 0x12, 0x16, 0x56,
-		0xa8, 0xe9, 0x71, 0x18, 0x09, 0x6f, 0xe8, 0x46, 0x46, 0x0e, 0xc3, 0xa1, 0xdd, 0xdc, 0x62, 0xb5,
-		0xb5, 0x07, 0x3c, 0x2d, 0x54, 0x20, 0xe3, 0x91, 0xf2, 0x78, 0xb8, 0x6f, 0x25, 0xf8, 0x9c, 0x44,
-		0xd1, 0xd3, 0xe1, 0x1a, 0x02, 0x61, 0xd7, 0x61, 0x35, 0xe3, 0xd0, 0xcc, 0xe2, 0x75, 0x09, 0xee,
-		0xea, 0x21, 0x2d, 0x57, 0xcd, 0x0d, 0x98, 0x08, 0xec, 0x2e, 0x88, 0x19, 0x41, 0x98, 0xca, 0xc9,
-		0xe8, 0x48, 0xd7, 0x5b, 0x3e, 0xdf, 0x4e, 0xd4, 0xf5, 0xa9, 0x6f, 0x4e, 0x8d, 0xb7, 0x97, 0x39,
-		0xf2, 0x78, 0xfb, 0x1e, 0xc0, 0x21, 0xda, 0xd4, 0x2b, 0x12, 0xdc, 0x1f, 0x6e, 0x6a, 0x87, 0x98,
-		0xf9, 0xcd, 0xd7, 0x43, 0xdf, 0x90, 0xe0, 0x64, 0x3f, 0x62, 0xf3, 0xae, 0xda, 0x82, 0x71, 0x7f,
-		0xfd, 0xd0, 0xda, 0x53, 0x07, 0x58, 0x3d, 0x20, 0x0f, 0xed, 0x16, 0x74, 0xc9, 0x27, 0x24, 0x3e,
-		0x1a, 0x83, 0xd6, 0xe0, 0xe9, 0x3f, 0xbc, 0x8f, 0x1c, 0xad, 0xff, 0xd0, 0x26, 0x72, 0x87, 0x0e,
-		0x8c, 0xed, 0xab, 0x03, 0xfd, 0x35, 0x45, 0xf1, 0x1a, 0x77, 0x9d, 0x1d, 0xb6, 0x09, 0xdf, 0x01,
-		0xe3, 0x1d, 0x46, 0x06, 0x77, 0x1f, 0xfb, 0x18, 0x18, 0x32, 0x6a, 0xb7, 0xfd, 0xe2, 0xaf, 0x4b,
-		0x30, 0x45, 0x2b, 0xee, 0xd0, 0x3d, 0x6f, 0x46, 0x3d, 0x35, 0xb8, 0xe7, 0xeb, 0x28, 0x2e, 0x57,
-		0xd8, 0x22, 0xa4, 0x98, 0x45, 0x71, 0x1d, 0x1d, 0xc0, 0x24, 0x39, 0x40, 0xf1, 0xf3, 0xc2, 0xd3,
-		0xce, 0x8b, 0x06, 0x75, 0x1e, 0xc7, 0x6f, 0x4c, 0x3f, 0x87, 0x34, 0x8e, 0x03, 0x6a, 0xfa, 0xba,
-		0xf0, 0xb9, 0x9d, 0xe5, 0xe6, 0x8a, 0x52, 0x0f, 0xcd, 0xe7, 0xf2, 0x2d, 0x90, 0x5b, 0xea, 0x5c,
-		0x7f, 0x57, 0x38, 0x57, 0xaf, 0x4d, 0x11, 0xce, 0xf5, 0xcd, 0xd6, 0x29, 0x9e, 0x9b, 0x8d, 0x68,
-		0xc0, 0x5f, 0x45, 0x37, 0xfb, 0xbb, 0x31, 0x38, 0x46, 0xdb, 0x16, 0xdc, 0xc4, 0x39, 0xcc, 0xce,
-		0x40, 0x8e, 0xad, 0x56, 0xf7, 0xe9, 0x45, 0x72, 0x8e, 0xad, 0x5e, 0x6d, 0x99, 0x31, 0x51, 0x2d,
-		0xb4, 0xe9, 0x47, 0x71, 0xe2, 0x91, 0x67, 0x87, 0x81, 0x7d, 0xa5, 0x0e, 0xc6, 0x91, 0x38, 0x04,
-		0xe3, 0xf8, 0x9a, 0x04, 0x85, 0x4e, 0x0a, 0xe4, 0xc6, 0xa0, 0xc1, 0xd1, 0xd0, 0xf1, 0x4b, 0xab,
-		0x3d, 0x3c, 0xd8, 0xcf, 0xa6, 0x5a, 0xcb, 0x70, 0x3d, 0x62, 0xe3, 0x5b, 0x1d, 0x0d, 0x4d, 0x85,
-		0xed, 0xbd, 0x7d, 0x4d, 0xf2, 0x26, 0x1c, 0xa6, 0xaf, 0xb4, 0xf9, 0xfc, 0xbf, 0x12, 0xeb, 0x99,
-		0x4f, 0x4b, 0x30, 0xd9, 0x45, 0xec, 0x37, 0xe3, 0x44, 0xbe, 0xd3, 0xd5, 0x36, 0x0e, 0x7b, 0xb5,
-		0xf4, 0x18, 0x1f, 0x58, 0xe1, 0xdb, 0xe0, 0x81, 0x45, 0x71, 0xa7, 0xe7, 0x64, 0xc5, 0x67, 0xe0,
-		0xf6, 0x8e, 0x5c, 0x5c, 0xb6, 0x12, 0x24, 0x76, 0x34, 0xc7, 0xe5, 0x62, 0xdd, 0xd7, 0x4d, 0xac,
-		0x16, 0x6e, 0xca, 0x53, 0x44, 0x90, 0xa3, 0xd0, 0x6b, 0xa6, 0xa9, 0x73, 0x31, 0x8a, 0x57, 0x60,
-		0x2c, 0x90, 0xc7, 0x2b, 0x39, 0x0b, 0x09, 0xcb, 0xe4, 0x9f, 0x4a, 0x18, 0x3a, 0x7d, 0x47, 0xd7,
-		0x93, 0x13, 0xd3, 0xd4, 0x79, 0xb3, 0x29, 0x7d, 0x71, 0x02, 0x10, 0x03, 0xa3, 0x87, 0x28, 0xa2,
-		0x8a, 0x75, 0x18, 0x0f, 0xe5, 0xf2, 0x4a, 0xde, 0xd0, 0x01, 0xcd, 0xe9, 0xef, 0x1e, 0x81, 0x24,
-		0x45, 0x45, 0x1f, 0x92, 0x42, 0x5f, 0x39, 0x9a, 0xe9, 0x06, 0xd3, 0x79, 0x73, 0xa2, 0x70, 0xaa,
-		0x6f, 0x7a, 0x1e, 0xb9, 0x9e, 0xfc, 0xf9, 0x7f, 0xf7, 0x9d, 0x0f, 0xc4, 0xee, 0x41, 0xc5, 0x53,
-		0x5d, 0x76, 0x4c, 0x02, 0x83, 0xec, 0x93, 0xa1, 0x77, 0xf8, 0x0f, 0xf5, 0x57, 0x95, 0x90, 0x6c,
-		0xa6, 0x5f, 0x72, 0x2e, 0xd8, 0x45, 0x2a, 0xd8, 0x19, 0xf4, 0x68, 0xb4, 0x60, 0xa7, 0xde, 0x15,
-		0x1e, 0x4e, 0xef, 0x46, 0xff, 0x5e, 0x82, 0x89, 0x4e, 0xeb, 0x64, 0x74, 0xbe, 0x3f, 0x29, 0xda,
-		0x23, 0xa1, 0xc2, 0x85, 0x03, 0x70, 0xf2, 0xa6, 0x2c, 0xd0, 0xa6, 0xcc, 0xa2, 0xc7, 0x0f, 0xd0,
-		0x94, 0x53, 0xc1, 0x83, 0x9b, 0xff, 0x23, 0xc1, 0x9d, 0x3d, 0x17, 0x97, 0x68, 0xb6, 0x3f, 0x29,
-		0x7b, 0x84, 0x7c, 0x85, 0xf2, 0x1b, 0x81, 0xe0, 0x2d, 0x7e, 0x92, 0xb6, 0xf8, 0x0a, 0x5a, 0x3c,
-		0x48, 0x8b, 0x3b, 0x9e, 0xaa, 0xa1, 0xdf, 0x0f, 0x5f, 0xc0, 0xec, 0x6d, 0x4e, 0x6d, 0xab, 0xaf,
-		0x88, 0x81, 0xd1, 0x1e, 0x8b, 0x17, 0x9f, 0xa6, 0x4d, 0x90, 0xd1, 0xda, 0x1b, 0xec, 0xb4, 0x53,
-		0xef, 0x0a, 0x4f, 0x16, 0xef, 0x46, 0x7f, 0x21, 0x75, 0xbe, 0x49, 0x79, 0xae, 0xa7, 0x88, 0xdd,
-		0x57, 0x96, 0x85, 0xf3, 0xfb, 0x67, 0xe4, 0x8d, 0x6c, 0xd0, 0x46, 0xd6, 0x11, 0x3e, 0xec, 0x46,
-		0x76, 0xec, 0x44, 0xf4, 0x15, 0x09, 0x26, 0x3a, 0x2d, 0xa5, 0x22, 0x86, 0x65, 0x8f, 0x55, 0x63,
-		0xc4, 0xb0, 0xec, 0xb5, 0x6e, 0x2b, 0xbe, 0x85, 0x36, 0xfe, 0x2c, 0x7a, 0xac, 0x5b, 0xe3, 0x7b,
-		0xf6, 0x22, 0x19, 0x8b, 0x3d, 0x57, 0x20, 0x11, 0x63, 0xb1, 0x9f, 0xe5, 0x57, 0xc4, 0x58, 0xec,
-		0x6b, 0x01, 0x14, 0x3d, 0x16, 0xbd, 0x96, 0xf5, 0xd9, 0x8d, 0x0e, 0xfa, 0x92, 0x04, 0xc3, 0xa1,
-		0x00, 0x1b, 0x3d, 0xd2, 0x53, 0xd0, 0x4e, 0xab, 0x99, 0xc2, 0xe9, 0xfd, 0xb0, 0xf0, 0xb6, 0x2c,
-		0xd2, 0xb6, 0xcc, 0xa1, 0xd9, 0x83, 0xb4, 0x25, 0x7c, 0x08, 0xfe, 0x35, 0x09, 0xc6, 0x3b, 0x84,
-		0xa6, 0x11, 0xa3, 0xb0, 0x7b, 0x0c, 0x5e, 0x38, 0xbf, 0x7f, 0x46, 0xde, 0xaa, 0x4b, 0xb4, 0x55,
-		0x6f, 0x47, 0x6f, 0x3b, 0x48, 0xab, 0x02, 0xf3, 0xf3, 0xab, 0xfe, 0x05, 0xb9, 0x40, 0x3d, 0xe8,
-		0xec, 0x3e, 0x05, 0x13, 0x0d, 0x3a, 0xb7, 0x6f, 0x3e, 0xde, 0x9e, 0xa7, 0x68, 0x7b, 0x9e, 0x44,
-		0xab, 0x6f, 0xac, 0x3d, 0xed, 0xd3, 0xfa, 0xe7, 0xda, 0x5f, 0x23, 0xf6, 0xb6, 0xa2, 0x8e, 0xc1,
-		0x6a, 0xe1, 0xd1, 0x7d, 0xf1, 0xf0, 0x46, 0x9d, 0xa7, 0x8d, 0x3a, 0x8d, 0x1e, 0xee, 0xd6, 0xa8,
-		0xc0, 0x8d, 0x4b, 0xcd, 0xd8, 0x36, 0x4f, 0xbd, 0x8b, 0x85, 0xc0, 0xef, 0x46, 0xef, 0x91, 0xf8,
-		0x9d, 0xb3, 0x13, 0x3d, 0xeb, 0x0d, 0xc4, 0xb1, 0x85, 0xfb, 0xfb, 0xa0, 0xe4, 0x72, 0xdd, 0x43,
-		0xe5, 0x9a, 0x44, 0x77, 0x74, 0x93, 0x8b, 0xc4, 0xb2, 0xe8, 0x7d, 0x92, 0x77, 0x61, 0xf5, 0x64,
-		0x6f, 0xec, 0x60, 0xb0, 0x5b, 0x78, 0xa0, 0x2f, 0x5a, 0x2e, 0xc9, 0x7d, 0x54, 0x92, 0x69, 0x34,
-		0xd9, 0x55, 0x12, 0x16, 0xfa, 0x1e, 0xf6, 0xc5, 0x8f, 0xdf, 0x3c, 0x0a, 0x53, 0x5d, 0x6a, 0x74,
-		0xaf, 0x47, 0x9c, 0x43, 0xf6, 0x78, 0x94, 0x1b, 0xf9, 0xe8, 0xf6, 0xb0, 0x3f, 0x33, 0xdb, 0xdf,
-		0xa1, 0x65, 0xf1, 0xf3, 0x09, 0x40, 0xcb, 0x4e, 0x7d, 0xce, 0xc6, 0xec, 0xbf, 0x65, 0xf2, 0x51,
-		0xde, 0xf2, 0x80, 0x4d, 0x7a, 0x43, 0x0f, 0xd8, 0x96, 0x43, 0x0f, 0xc9, 0x62, 0xfb, 0x7b, 0x26,
-		0xda, 0xf7, 0x6b, 0xb2, 0xf8, 0x2d, 0x79, 0x4d, 0xd6, 0xf9, 0x62, 0x7b, 0xe2, 0x70, 0x5e, 0xa4,
-		0x24, 0xf7, 0xfd, 0x22, 0xe5, 0x12, 0xa4, 0xf8, 0xe3, 0xcd, 0xd4, 0x81, 0x1e, 0x6f, 0x72, 0x6e,
-		0x74, 0x46, 0x7c, 0x42, 0x75, 0xb0, 0xbf, 0xab, 0xc9, 0xfc, 0x1b, 0xab, 0xfe, 0x56, 0xc1, 0x1d,
-		0x50, 0x68, 0x37, 0x1b, 0x6f, 0xf0, 0xfe, 0x38, 0x06, 0xb9, 0x65, 0xa7, 0x5e, 0xa9, 0x69, 0xee,
-		0x2d, 0xb2, 0xa9, 0x43, 0x7a, 0xe1, 0xa3, 0xc0, 0x68, 0xeb, 0x4d, 0x74, 0x66, 0x47, 0xe7, 0x0f,
-		0xfc, 0x5e, 0x62, 0x24, 0xfc, 0x9a, 0x19, 0xed, 0x74, 0x36, 0xd7, 0xc4, 0xbe, 0xaa, 0xe9, 0xeb,
-		0xe1, 0xa3, 0xdf, 0x3b, 0x05, 0xc8, 0xb7, 0xaa, 0xdf, 0xeb, 0x9b, 0x57, 0x25, 0x18, 0x5a, 0x76,
-		0x44, 0x70, 0x87, 0xdf, 0x64, 0x4f, 0xae, 0xce, 0x79, 0x5f, 0x29, 0x8f, 0xf7, 0x67, 0x99, 0xe2,
-		0x5b, 0xe5, 0x7e, 0xe3, 0x8f, 0xc0, 0x78, 0xa0, 0x7d, 0x5e, 0xbb, 0x7f, 0x3b, 0x46, 0x3d, 0x5d,
-		0x19, 0xd7, 0x35, 0xc3, 0x8b, 0x07, 0xf1, 0x4f, 0xc3, 0xc3, 0x15, 0x5f, 0xa7, 0x89, 0x83, 0xea,
-		0x74, 0x97, 0x0e, 0xf7, 0x16, 0xdd, 0x79, 0xdb, 0x55, 0x1d, 0x9e, 0x4a, 0x49, 0x07, 0x7f, 0x2a,
-		0x55, 0xfc, 0x96, 0x04, 0xc3, 0xcb, 0x4e, 0x7d, 0xd3, 0xa8, 0xfd, 0x7f, 0x6b, 0xa3, 0xdb, 0x70,
-		0x24, 0xd4, 0xc2, 0x5b, 0xa4, 0xca, 0xd3, 0x1f, 0x4e, 0x40, 0x7c, 0xd9, 0xa9, 0xa3, 0x77, 0xc2,
-		0x68, 0xeb, 0x14, 0xdf, 0x35, 0x72, 0x6b, 0xf7, 0xeb, 0xdd, 0x57, 0x57, 0xdd, 0xe7, 0x00, 0xb4,
-		0x0b, 0xc3, 0x61, 0xff, 0x7f, 0xa2, 0x07, 0x48, 0x88, 0xb2, 0xf0, 0x70, 0xbf, 0x94, 0x5e, 0x65,
-		0x3f, 0x03, 0x69, 0xcf, 0xa1, 0xdd, 0xdd, 0x83, 0x5b, 0x10, 0x75, 0x8f, 0x45, 0x3b, 0xb8, 0x0e,
-		0xa2, 0xbd, 0x56, 0xb7, 0xd1, 0x4b, 0x7b, 0x2d, 0xb4, 0x3d, 0xb5, 0xd7, 0x6d, 0x48, 0x6d, 0x01,
-		0x04, 0xec, 0xff, 0xde, 0x1e, 0x08, 0x3e, 0x59, 0xe1, 0xa1, 0xbe, 0xc8, 0xbc, 0x13, 0xa7, 0x43,
-		0x0e, 0x9d, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0xf7, 0x64, 0x62, 0x51, 0x98, 0x00,
+		// 10641 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x7b, 0x70, 0x24, 0xd7,
+		0x75, 0x1f, 0xbc, 0x3d, 0x33, 0x00, 0x66, 0x0e, 0x5e, 0x83, 0x0b, 0xec, 0xee, 0xec, 0xec, 0x2e,
+		0x00, 0x36, 0x1f, 0xbb, 0x5c, 0x8a, 0x58, 0x72, 0xc9, 0x5d, 0x2e, 0x67, 0x29, 0x51, 0x18, 0x60,
+		0x16, 0x0b, 0x2e, 0xb0, 0x00, 0x1b, 0xc0, 0xf2, 0x61, 0xfb, 0xeb, 0x6a, 0xcc, 0x5c, 0x0c, 0x9a,
+		0xe8, 0xe9, 0x1e, 0x76, 0xf7, 0xec, 0x2e, 0x68, 0xeb, 0x33, 0x6d, 0x39, 0x8e, 0xc4, 0x3c, 0x64,
+		0x47, 0x29, 0x5b, 0x92, 0xb5, 0x0a, 0x65, 0x29, 0x91, 0x43, 0xcb, 0x89, 0x64, 0x51, 0xb2, 0x25,
+		0xab, 0x6c, 0x29, 0x55, 0x8e, 0x65, 0x55, 0x2a, 0x25, 0x39, 0x0f, 0x3f, 0xca, 0xa1, 0x64, 0x4a,
+		0x65, 0x2b, 0x8a, 0x12, 0x2b, 0x8a, 0x52, 0x49, 0x4a, 0xa5, 0x54, 0xea, 0xbe, 0xfa, 0x31, 0xd3,
+		0x33, 0x3d, 0x83, 0xc5, 0xd2, 0x74, 0xe9, 0x2f, 0x4c, 0xdf, 0x7b, 0xce, 0xef, 0x9e, 0x7b, 0xee,
+		0xb9, 0xf7, 0x9e, 0x7b, 0xee, 0x03, 0xf0, 0xc9, 0x0b, 0x30, 0x5d, 0xb5, 0xac, 0xaa, 0x81, 0x4f,
+		0xd7, 0x6d, 0xcb, 0xb5, 0x36, 0x1b, 0x5b, 0xa7, 0x2b, 0xd8, 0x29, 0xdb, 0x7a, 0xdd, 0xb5, 0xec,
+		0x19, 0x9a, 0x86, 0x46, 0x19, 0xc5, 0x8c, 0xa0, 0x90, 0x97, 0x61, 0xec, 0xa2, 0x6e, 0xe0, 0x79,
+		0x8f, 0x70, 0x0d, 0xbb, 0xe8, 0x3c, 0xa4, 0xb6, 0x74, 0x03, 0xe7, 0xa4, 0xe9, 0xe4, 0xc9, 0xc1,
+		0x33, 0x77, 0xcd, 0x34, 0x31, 0xcd, 0x84, 0x39, 0x56, 0x49, 0xb2, 0x42, 0x39, 0xe4, 0x6f, 0xa6,
+		0x60, 0x3c, 0x22, 0x17, 0x21, 0x48, 0x99, 0x5a, 0x8d, 0x20, 0x4a, 0x27, 0x33, 0x0a, 0xfd, 0x8d,
+		0x72, 0x30, 0x50, 0xd7, 0xca, 0x3b, 0x5a, 0x15, 0xe7, 0x12, 0x34, 0x59, 0x7c, 0xa2, 0x49, 0x80,
+		0x0a, 0xae, 0x63, 0xb3, 0x82, 0xcd, 0xf2, 0x6e, 0x2e, 0x39, 0x9d, 0x3c, 0x99, 0x51, 0x02, 0x29,
+		0xe8, 0x3e, 0x18, 0xab, 0x37, 0x36, 0x0d, 0xbd, 0xac, 0x06, 0xc8, 0x60, 0x3a, 0x79, 0xb2, 0x4f,
+		0xc9, 0xb2, 0x8c, 0x79, 0x9f, 0xf8, 0x04, 0x8c, 0x5e, 0xc7, 0xda, 0x4e, 0x90, 0x74, 0x90, 0x92,
+		0x8e, 0x90, 0xe4, 0x00, 0xe1, 0x1c, 0x0c, 0xd5, 0xb0, 0xe3, 0x68, 0x55, 0xac, 0xba, 0xbb, 0x75,
+		0x9c, 0x4b, 0xd1, 0xda, 0x4f, 0xb7, 0xd4, 0xbe, 0xb9, 0xe6, 0x83, 0x9c, 0x6b, 0x7d, 0xb7, 0x8e,
+		0xd1, 0x2c, 0x64, 0xb0, 0xd9, 0xa8, 0x31, 0x84, 0xbe, 0x36, 0xfa, 0x2b, 0x99, 0x8d, 0x5a, 0x33,
+		0x4a, 0x9a, 0xb0, 0x71, 0x88, 0x01, 0x07, 0xdb, 0xd7, 0xf4, 0x32, 0xce, 0xf5, 0x53, 0x80, 0x13,
+		0x2d, 0x00, 0x6b, 0x2c, 0xbf, 0x19, 0x43, 0xf0, 0xa1, 0x39, 0xc8, 0xe0, 0x1b, 0x2e, 0x36, 0x1d,
+		0xdd, 0x32, 0x73, 0x03, 0x14, 0xe4, 0xee, 0x88, 0x56, 0xc4, 0x46, 0xa5, 0x19, 0xc2, 0xe7, 0x43,
+		0xe7, 0x60, 0xc0, 0xaa, 0xbb, 0xba, 0x65, 0x3a, 0xb9, 0xf4, 0xb4, 0x74, 0x72, 0xf0, 0xcc, 0xb1,
+		0x48, 0x43, 0x58, 0x61, 0x34, 0x8a, 0x20, 0x46, 0x8b, 0x90, 0x75, 0xac, 0x86, 0x5d, 0xc6, 0x6a,
+		0xd9, 0xaa, 0x60, 0x55, 0x37, 0xb7, 0xac, 0x5c, 0x86, 0x02, 0x4c, 0xb5, 0x56, 0x84, 0x12, 0xce,
+		0x59, 0x15, 0xbc, 0x68, 0x6e, 0x59, 0xca, 0x88, 0x13, 0xfa, 0x46, 0x87, 0xa0, 0xdf, 0xd9, 0x35,
+		0x5d, 0xed, 0x46, 0x6e, 0x88, 0x5a, 0x08, 0xff, 0x92, 0x3f, 0xdb, 0x0f, 0xa3, 0xdd, 0x98, 0xd8,
+		0x05, 0xe8, 0xdb, 0x22, 0xb5, 0xcc, 0x25, 0x7a, 0xd1, 0x01, 0xe3, 0x09, 0x2b, 0xb1, 0x7f, 0x8f,
+		0x4a, 0x9c, 0x85, 0x41, 0x13, 0x3b, 0x2e, 0xae, 0x30, 0x8b, 0x48, 0x76, 0x69, 0x53, 0xc0, 0x98,
+		0x5a, 0x4d, 0x2a, 0xb5, 0x27, 0x93, 0x7a, 0x1a, 0x46, 0x3d, 0x91, 0x54, 0x5b, 0x33, 0xab, 0xc2,
+		0x36, 0x4f, 0xc7, 0x49, 0x32, 0x53, 0x12, 0x7c, 0x0a, 0x61, 0x53, 0x46, 0x70, 0xe8, 0x1b, 0xcd,
+		0x03, 0x58, 0x26, 0xb6, 0xb6, 0xd4, 0x0a, 0x2e, 0x1b, 0xb9, 0x74, 0x1b, 0x2d, 0xad, 0x10, 0x92,
+		0x16, 0x2d, 0x59, 0x2c, 0xb5, 0x6c, 0xa0, 0x47, 0x7d, 0x53, 0x1b, 0x68, 0x63, 0x29, 0xcb, 0xac,
+		0x93, 0xb5, 0x58, 0xdb, 0x06, 0x8c, 0xd8, 0x98, 0xd8, 0x3d, 0xae, 0xf0, 0x9a, 0x65, 0xa8, 0x10,
+		0x33, 0xb1, 0x35, 0x53, 0x38, 0x1b, 0xab, 0xd8, 0xb0, 0x1d, 0xfc, 0x44, 0x77, 0x82, 0x97, 0xa0,
+		0x52, 0xb3, 0x02, 0x3a, 0x0a, 0x0d, 0x89, 0xc4, 0x2b, 0x5a, 0x0d, 0xe7, 0x5f, 0x80, 0x91, 0xb0,
+		0x7a, 0xd0, 0x04, 0xf4, 0x39, 0xae, 0x66, 0xbb, 0xd4, 0x0a, 0xfb, 0x14, 0xf6, 0x81, 0xb2, 0x90,
+		0xc4, 0x66, 0x85, 0x8e, 0x72, 0x7d, 0x0a, 0xf9, 0x89, 0xde, 0xee, 0x57, 0x38, 0x49, 0x2b, 0x7c,
+		0x4f, 0x6b, 0x8b, 0x86, 0x90, 0x9b, 0xeb, 0x9d, 0x7f, 0x04, 0x86, 0x43, 0x15, 0xe8, 0xb6, 0x68,
+		0xf9, 0xa7, 0xe0, 0x60, 0x24, 0x34, 0x7a, 0x1a, 0x26, 0x1a, 0xa6, 0x6e, 0xba, 0xd8, 0xae, 0xdb,
+		0x98, 0x58, 0x2c, 0x2b, 0x2a, 0xf7, 0x57, 0x03, 0x6d, 0x6c, 0x6e, 0x23, 0x48, 0xcd, 0x50, 0x94,
+		0xf1, 0x46, 0x6b, 0xe2, 0xa9, 0x4c, 0xfa, 0x5b, 0x03, 0xd9, 0x17, 0x5f, 0x7c, 0xf1, 0xc5, 0x84,
+		0xfc, 0xc5, 0x7e, 0x98, 0x88, 0xea, 0x33, 0x91, 0xdd, 0xf7, 0x10, 0xf4, 0x9b, 0x8d, 0xda, 0x26,
+		0xb6, 0xa9, 0x92, 0xfa, 0x14, 0xfe, 0x85, 0x66, 0xa1, 0xcf, 0xd0, 0x36, 0xb1, 0x91, 0x4b, 0x4d,
+		0x4b, 0x27, 0x47, 0xce, 0xdc, 0xd7, 0x55, 0xaf, 0x9c, 0x59, 0x22, 0x2c, 0x0a, 0xe3, 0x44, 0x6f,
+		0x83, 0x14, 0x1f, 0xa2, 0x09, 0xc2, 0xa9, 0xee, 0x10, 0x48, 0x5f, 0x52, 0x28, 0x1f, 0x3a, 0x0a,
+		0x19, 0xf2, 0x97, 0xd9, 0x46, 0x3f, 0x95, 0x39, 0x4d, 0x12, 0x88, 0x5d, 0xa0, 0x3c, 0xa4, 0x69,
+		0x37, 0xa9, 0x60, 0x31, 0xb5, 0x79, 0xdf, 0xc4, 0xb0, 0x2a, 0x78, 0x4b, 0x6b, 0x18, 0xae, 0x7a,
+		0x4d, 0x33, 0x1a, 0x98, 0x1a, 0x7c, 0x46, 0x19, 0xe2, 0x89, 0x57, 0x49, 0x1a, 0x9a, 0x82, 0x41,
+		0xd6, 0xab, 0x74, 0xb3, 0x82, 0x6f, 0xd0, 0xd1, 0xb3, 0x4f, 0x61, 0x1d, 0x6d, 0x91, 0xa4, 0x90,
+		0xe2, 0x9f, 0x73, 0x2c, 0x53, 0x98, 0x26, 0x2d, 0x82, 0x24, 0xd0, 0xe2, 0x1f, 0x69, 0x1e, 0xb8,
+		0x8f, 0x47, 0x57, 0xaf, 0xa5, 0x2f, 0x9d, 0x80, 0x51, 0x4a, 0xf1, 0x10, 0x6f, 0x7a, 0xcd, 0xc8,
+		0x8d, 0x4d, 0x4b, 0x27, 0xd3, 0xca, 0x08, 0x4b, 0x5e, 0xe1, 0xa9, 0xf2, 0x67, 0x12, 0x90, 0xa2,
+		0x03, 0xcb, 0x28, 0x0c, 0xae, 0x3f, 0xb3, 0x5a, 0x52, 0xe7, 0x57, 0x36, 0x8a, 0x4b, 0xa5, 0xac,
+		0x84, 0x46, 0x00, 0x68, 0xc2, 0xc5, 0xa5, 0x95, 0xd9, 0xf5, 0x6c, 0xc2, 0xfb, 0x5e, 0xbc, 0xb2,
+		0x7e, 0xee, 0xe1, 0x6c, 0xd2, 0x63, 0xd8, 0x60, 0x09, 0xa9, 0x20, 0xc1, 0x43, 0x67, 0xb2, 0x7d,
+		0x28, 0x0b, 0x43, 0x0c, 0x60, 0xf1, 0xe9, 0xd2, 0xfc, 0xb9, 0x87, 0xb3, 0xfd, 0xe1, 0x94, 0x87,
+		0xce, 0x64, 0x07, 0xd0, 0x30, 0x64, 0x68, 0x4a, 0x71, 0x65, 0x65, 0x29, 0x9b, 0xf6, 0x30, 0xd7,
+		0xd6, 0x95, 0xc5, 0x2b, 0x0b, 0xd9, 0x8c, 0x87, 0xb9, 0xa0, 0xac, 0x6c, 0xac, 0x66, 0xc1, 0x43,
+		0x58, 0x2e, 0xad, 0xad, 0xcd, 0x2e, 0x94, 0xb2, 0x83, 0x1e, 0x45, 0xf1, 0x99, 0xf5, 0xd2, 0x5a,
+		0x76, 0x28, 0x24, 0xd6, 0x43, 0x67, 0xb2, 0xc3, 0x5e, 0x11, 0xa5, 0x2b, 0x1b, 0xcb, 0xd9, 0x11,
+		0x34, 0x06, 0xc3, 0xac, 0x08, 0x21, 0xc4, 0x68, 0x53, 0xd2, 0xb9, 0x87, 0xb3, 0x59, 0x5f, 0x10,
+		0x86, 0x32, 0x16, 0x4a, 0x38, 0xf7, 0x70, 0x16, 0xc9, 0x73, 0xd0, 0x47, 0xcd, 0x10, 0x21, 0x18,
+		0x59, 0x9a, 0x2d, 0x96, 0x96, 0xd4, 0x95, 0xd5, 0xf5, 0xc5, 0x95, 0x2b, 0xb3, 0x4b, 0x59, 0xc9,
+		0x4f, 0x53, 0x4a, 0x4f, 0x6e, 0x2c, 0x2a, 0xa5, 0xf9, 0x6c, 0x22, 0x98, 0xb6, 0x5a, 0x9a, 0x5d,
+		0x2f, 0xcd, 0x67, 0x93, 0x72, 0x19, 0x26, 0xa2, 0x06, 0xd4, 0xc8, 0x2e, 0x14, 0xb0, 0x85, 0x44,
+		0x1b, 0x5b, 0xa0, 0x58, 0xcd, 0xb6, 0x20, 0x7f, 0x23, 0x01, 0xe3, 0x11, 0x93, 0x4a, 0x64, 0x21,
+		0x8f, 0x43, 0x1f, 0xb3, 0x65, 0x36, 0xcd, 0xde, 0x1b, 0x39, 0x3b, 0x51, 0xcb, 0x6e, 0x99, 0x6a,
+		0x29, 0x5f, 0xd0, 0xd5, 0x48, 0xb6, 0x71, 0x35, 0x08, 0x44, 0x8b, 0xc1, 0xfe, 0x44, 0xcb, 0xe0,
+		0xcf, 0xe6, 0xc7, 0x73, 0xdd, 0xcc, 0x8f, 0x34, 0xad, 0xb7, 0x49, 0xa0, 0x2f, 0x62, 0x12, 0xb8,
+		0x00, 0x63, 0x2d, 0x40, 0x5d, 0x0f, 0xc6, 0xef, 0x94, 0x20, 0xd7, 0x4e, 0x39, 0x31, 0x43, 0x62,
+		0x22, 0x34, 0x24, 0x5e, 0x68, 0xd6, 0xe0, 0x1d, 0xed, 0x1b, 0xa1, 0xa5, 0xad, 0x3f, 0x26, 0xc1,
+		0xa1, 0x68, 0x97, 0x32, 0x52, 0x86, 0xb7, 0x41, 0x7f, 0x0d, 0xbb, 0xdb, 0x96, 0x70, 0xab, 0xee,
+		0x89, 0x98, 0xac, 0x49, 0x76, 0x73, 0x63, 0x73, 0x2e, 0xf4, 0x68, 0xb3, 0xac, 0x53, 0xed, 0x1c,
+		0xdc, 0x16, 0x49, 0xdf, 0x9d, 0x80, 0x83, 0x91, 0xe0, 0x91, 0x82, 0x1e, 0x07, 0xd0, 0xcd, 0x7a,
+		0xc3, 0x65, 0xae, 0x13, 0x1b, 0x89, 0x33, 0x34, 0x85, 0x0e, 0x5e, 0x64, 0x94, 0x6d, 0xb8, 0x5e,
+		0x7e, 0x92, 0xe6, 0x03, 0x4b, 0xa2, 0x04, 0xe7, 0x7d, 0x41, 0x53, 0x54, 0xd0, 0xc9, 0x36, 0x35,
+		0x6d, 0x31, 0xcc, 0x07, 0x20, 0x5b, 0x36, 0x74, 0x6c, 0xba, 0xaa, 0xe3, 0xda, 0x58, 0xab, 0xe9,
+		0x66, 0x95, 0x4e, 0x35, 0xe9, 0x42, 0xdf, 0x96, 0x66, 0x38, 0x58, 0x19, 0x65, 0xd9, 0x6b, 0x22,
+		0x97, 0x70, 0x50, 0x03, 0xb2, 0x03, 0x1c, 0xfd, 0x21, 0x0e, 0x96, 0xed, 0x71, 0xc8, 0xbf, 0x98,
+		0x81, 0xc1, 0x80, 0x03, 0x8e, 0xee, 0x80, 0xa1, 0xe7, 0xb4, 0x6b, 0x9a, 0x2a, 0x16, 0x55, 0x4c,
+		0x13, 0x83, 0x24, 0x6d, 0x95, 0x25, 0xa1, 0x07, 0x60, 0x82, 0x92, 0x58, 0x0d, 0x17, 0xdb, 0x6a,
+		0xd9, 0xd0, 0x1c, 0x87, 0x2a, 0x2d, 0x4d, 0x49, 0x11, 0xc9, 0x5b, 0x21, 0x59, 0x73, 0x22, 0x07,
+		0x9d, 0x85, 0x71, 0xca, 0x51, 0x6b, 0x18, 0xae, 0x5e, 0x37, 0xb0, 0x4a, 0x96, 0x79, 0x4e, 0x0e,
+		0x82, 0x92, 0x8d, 0x11, 0x8a, 0x65, 0x4e, 0x40, 0x24, 0x72, 0xd0, 0x3c, 0x1c, 0xa7, 0x6c, 0x55,
+		0x6c, 0x62, 0x5b, 0x73, 0xb1, 0x8a, 0x9f, 0x6f, 0x68, 0x86, 0xa3, 0x6a, 0x66, 0x45, 0xdd, 0xd6,
+		0x9c, 0xed, 0xdc, 0x04, 0x01, 0x28, 0x26, 0x72, 0x92, 0x72, 0x84, 0x10, 0x2e, 0x70, 0xba, 0x12,
+		0x25, 0x9b, 0x35, 0x2b, 0x97, 0x34, 0x67, 0x1b, 0x15, 0xe0, 0x10, 0x45, 0x71, 0x5c, 0x5b, 0x37,
+		0xab, 0x6a, 0x79, 0x1b, 0x97, 0x77, 0xd4, 0x86, 0xbb, 0x75, 0x3e, 0x77, 0x34, 0x58, 0x3e, 0x95,
+		0x70, 0x8d, 0xd2, 0xcc, 0x11, 0x92, 0x0d, 0x77, 0xeb, 0x3c, 0x5a, 0x83, 0x21, 0xd2, 0x18, 0x35,
+		0xfd, 0x05, 0xac, 0x6e, 0x59, 0x36, 0x9d, 0x43, 0x47, 0x22, 0x86, 0xa6, 0x80, 0x06, 0x67, 0x56,
+		0x38, 0xc3, 0xb2, 0x55, 0xc1, 0x85, 0xbe, 0xb5, 0xd5, 0x52, 0x69, 0x5e, 0x19, 0x14, 0x28, 0x17,
+		0x2d, 0x9b, 0x18, 0x54, 0xd5, 0xf2, 0x14, 0x3c, 0xc8, 0x0c, 0xaa, 0x6a, 0x09, 0xf5, 0x9e, 0x85,
+		0xf1, 0x72, 0x99, 0xd5, 0x59, 0x2f, 0xab, 0x7c, 0x31, 0xe6, 0xe4, 0xb2, 0x21, 0x65, 0x95, 0xcb,
+		0x0b, 0x8c, 0x80, 0xdb, 0xb8, 0x83, 0x1e, 0x85, 0x83, 0xbe, 0xb2, 0x82, 0x8c, 0x63, 0x2d, 0xb5,
+		0x6c, 0x66, 0x3d, 0x0b, 0xe3, 0xf5, 0xdd, 0x56, 0x46, 0x14, 0x2a, 0xb1, 0xbe, 0xdb, 0xcc, 0xf6,
+		0x08, 0x4c, 0xd4, 0xb7, 0xeb, 0xad, 0x7c, 0xa7, 0x82, 0x7c, 0xa8, 0xbe, 0x5d, 0x6f, 0x66, 0xbc,
+		0x9b, 0xae, 0xcc, 0x6d, 0x5c, 0xd6, 0x5c, 0x5c, 0xc9, 0x1d, 0x0e, 0x92, 0x07, 0x32, 0xd0, 0x0c,
+		0x64, 0xcb, 0x65, 0x15, 0x9b, 0xda, 0xa6, 0x81, 0x55, 0xcd, 0xc6, 0xa6, 0xe6, 0xe4, 0xa6, 0x28,
+		0x71, 0xca, 0xb5, 0x1b, 0x58, 0x19, 0x29, 0x97, 0x4b, 0x34, 0x73, 0x96, 0xe6, 0xa1, 0x53, 0x30,
+		0x66, 0x6d, 0x3e, 0x57, 0x66, 0x16, 0xa9, 0xd6, 0x6d, 0xbc, 0xa5, 0xdf, 0xc8, 0xdd, 0x45, 0xd5,
+		0x3b, 0x4a, 0x32, 0xa8, 0x3d, 0xae, 0xd2, 0x64, 0x74, 0x2f, 0x64, 0xcb, 0xce, 0xb6, 0x66, 0xd7,
+		0xe9, 0x90, 0xec, 0xd4, 0xb5, 0x32, 0xce, 0xdd, 0xcd, 0x48, 0x59, 0xfa, 0x15, 0x91, 0x4c, 0x7a,
+		0x84, 0x73, 0x5d, 0xdf, 0x72, 0x05, 0xe2, 0x09, 0xd6, 0x23, 0x68, 0x1a, 0x47, 0x3b, 0x09, 0x59,
+		0xa2, 0x89, 0x50, 0xc1, 0x27, 0x29, 0xd9, 0x48, 0x7d, 0xbb, 0x1e, 0x2c, 0xf7, 0x4e, 0x18, 0xae,
+		0x6f, 0x07, 0x0b, 0xbd, 0x97, 0x39, 0x6e, 0xf5, 0xed, 0x40, 0x89, 0x0f, 0xc3, 0x21, 0x42, 0x54,
+		0xc3, 0xae, 0x56, 0xd1, 0x5c, 0x2d, 0x40, 0xfd, 0x16, 0x4a, 0x4d, 0xd4, 0xbe, 0xcc, 0x33, 0x43,
+		0x72, 0xda, 0x8d, 0xcd, 0x5d, 0xcf, 0xb0, 0xee, 0x67, 0x72, 0x92, 0x34, 0x61, 0x5a, 0xb7, 0xcd,
+		0x39, 0x97, 0x0b, 0x30, 0x14, 0xb4, 0x7b, 0x94, 0x01, 0x66, 0xf9, 0x59, 0x89, 0x38, 0x41, 0x73,
+		0x2b, 0xf3, 0xc4, 0x7d, 0x79, 0xb6, 0x94, 0x4d, 0x10, 0x37, 0x6a, 0x69, 0x71, 0xbd, 0xa4, 0x2a,
+		0x1b, 0x57, 0xd6, 0x17, 0x97, 0x4b, 0xd9, 0x64, 0xc0, 0xb1, 0x7f, 0x22, 0x95, 0xbe, 0x27, 0x7b,
+		0x42, 0xfe, 0x6a, 0x02, 0x46, 0xc2, 0x2b, 0x35, 0xf4, 0x18, 0x1c, 0x16, 0x61, 0x15, 0x07, 0xbb,
+		0xea, 0x75, 0xdd, 0xa6, 0x1d, 0xb2, 0xa6, 0xb1, 0xc9, 0xd1, 0xb3, 0x9f, 0x09, 0x4e, 0xb5, 0x86,
+		0xdd, 0xa7, 0x74, 0x9b, 0x74, 0xb7, 0x9a, 0xe6, 0xa2, 0x25, 0x98, 0x32, 0x2d, 0xd5, 0x71, 0x35,
+		0xb3, 0xa2, 0xd9, 0x15, 0xd5, 0x0f, 0x68, 0xa9, 0x5a, 0xb9, 0x8c, 0x1d, 0xc7, 0x62, 0x13, 0xa1,
+		0x87, 0x72, 0xcc, 0xb4, 0xd6, 0x38, 0xb1, 0x3f, 0x43, 0xcc, 0x72, 0xd2, 0x26, 0xf3, 0x4d, 0xb6,
+		0x33, 0xdf, 0xa3, 0x90, 0xa9, 0x69, 0x75, 0x15, 0x9b, 0xae, 0xbd, 0x4b, 0xfd, 0xf3, 0xb4, 0x92,
+		0xae, 0x69, 0xf5, 0x12, 0xf9, 0x7e, 0x43, 0x96, 0x49, 0x4f, 0xa4, 0xd2, 0xe9, 0x6c, 0xe6, 0x89,
+		0x54, 0x3a, 0x93, 0x05, 0xf9, 0xf5, 0x24, 0x0c, 0x05, 0xfd, 0x75, 0xb2, 0xfc, 0x29, 0xd3, 0x19,
+		0x4b, 0xa2, 0x63, 0xda, 0x9d, 0x1d, 0xbd, 0xfb, 0x99, 0x39, 0x32, 0x95, 0x15, 0xfa, 0x99, 0x73,
+		0xac, 0x30, 0x4e, 0xe2, 0x46, 0x10, 0x63, 0xc3, 0xcc, 0x19, 0x49, 0x2b, 0xfc, 0x0b, 0x2d, 0x40,
+		0xff, 0x73, 0x0e, 0xc5, 0xee, 0xa7, 0xd8, 0x77, 0x75, 0xc6, 0x7e, 0x62, 0x8d, 0x82, 0x67, 0x9e,
+		0x58, 0x53, 0xaf, 0xac, 0x28, 0xcb, 0xb3, 0x4b, 0x0a, 0x67, 0x47, 0x47, 0x20, 0x65, 0x68, 0x2f,
+		0xec, 0x86, 0x27, 0x3d, 0x9a, 0xd4, 0x6d, 0x23, 0x1c, 0x81, 0x14, 0x09, 0xd0, 0x85, 0xa7, 0x1a,
+		0x9a, 0x74, 0x1b, 0x3b, 0xc3, 0x69, 0xe8, 0xa3, 0xfa, 0x42, 0x00, 0x5c, 0x63, 0xd9, 0x03, 0x28,
+		0x0d, 0xa9, 0xb9, 0x15, 0x85, 0x74, 0x88, 0x2c, 0x0c, 0xb1, 0x54, 0x75, 0x75, 0xb1, 0x34, 0x57,
+		0xca, 0x26, 0xe4, 0xb3, 0xd0, 0xcf, 0x94, 0x40, 0x3a, 0x8b, 0xa7, 0x86, 0xec, 0x01, 0xfe, 0xc9,
+		0x31, 0x24, 0x91, 0xbb, 0xb1, 0x5c, 0x2c, 0x29, 0xd9, 0x44, 0xb8, 0xa9, 0x53, 0xd9, 0x3e, 0xd9,
+		0x81, 0xa1, 0xa0, 0x1f, 0xfe, 0xc6, 0x2c, 0xc6, 0xbf, 0x20, 0xc1, 0x60, 0xc0, 0xaf, 0x26, 0x0e,
+		0x91, 0x66, 0x18, 0xd6, 0x75, 0x55, 0x33, 0x74, 0xcd, 0xe1, 0xa6, 0x01, 0x34, 0x69, 0x96, 0xa4,
+		0x74, 0xdb, 0x74, 0x6f, 0x50, 0x17, 0xe9, 0xcb, 0xf6, 0xcb, 0x1f, 0x92, 0x20, 0xdb, 0xec, 0xd8,
+		0x36, 0x89, 0x29, 0xfd, 0x4d, 0x8a, 0x29, 0x7f, 0x50, 0x82, 0x91, 0xb0, 0x37, 0xdb, 0x24, 0xde,
+		0x1d, 0x7f, 0xa3, 0xe2, 0x7d, 0x3d, 0x01, 0xc3, 0x21, 0x1f, 0xb6, 0x5b, 0xe9, 0x9e, 0x87, 0x31,
+		0xbd, 0x82, 0x6b, 0x75, 0xcb, 0x25, 0xc1, 0x73, 0xd5, 0xc0, 0xd7, 0xb0, 0x91, 0x93, 0xe9, 0xa0,
+		0x71, 0xba, 0xb3, 0x97, 0x3c, 0xb3, 0xe8, 0xf3, 0x2d, 0x11, 0xb6, 0xc2, 0xf8, 0xe2, 0x7c, 0x69,
+		0x79, 0x75, 0x65, 0xbd, 0x74, 0x65, 0xee, 0x19, 0x75, 0xe3, 0xca, 0xe5, 0x2b, 0x2b, 0x4f, 0x5d,
+		0x51, 0xb2, 0x7a, 0x13, 0xd9, 0x6d, 0xec, 0xf6, 0xab, 0x90, 0x6d, 0x16, 0x0a, 0x1d, 0x86, 0x28,
+		0xb1, 0xb2, 0x07, 0xd0, 0x38, 0x8c, 0x5e, 0x59, 0x51, 0xd7, 0x16, 0xe7, 0x4b, 0x6a, 0xe9, 0xe2,
+		0xc5, 0xd2, 0xdc, 0xfa, 0x1a, 0x8b, 0x7b, 0x78, 0xd4, 0xeb, 0xa1, 0x0e, 0x2e, 0x7f, 0x20, 0x09,
+		0xe3, 0x11, 0x92, 0xa0, 0x59, 0xbe, 0x62, 0x61, 0x8b, 0xa8, 0xfb, 0xbb, 0x91, 0x7e, 0x86, 0xf8,
+		0x0c, 0xab, 0x9a, 0xed, 0xf2, 0x05, 0xce, 0xbd, 0x40, 0xb4, 0x64, 0xba, 0xfa, 0x96, 0x8e, 0x6d,
+		0x1e, 0x4f, 0x62, 0xcb, 0x98, 0x51, 0x3f, 0x9d, 0x85, 0x94, 0xde, 0x02, 0xa8, 0x6e, 0x39, 0xba,
+		0xab, 0x5f, 0x23, 0x21, 0x79, 0x11, 0x7c, 0x22, 0xcb, 0x9a, 0x94, 0x92, 0x15, 0x39, 0x8b, 0xa6,
+		0xeb, 0x51, 0x9b, 0xb8, 0xaa, 0x35, 0x51, 0x93, 0xc1, 0x3c, 0xa9, 0x64, 0x45, 0x8e, 0x47, 0x7d,
+		0x07, 0x0c, 0x55, 0xac, 0x06, 0xf1, 0xf5, 0x18, 0x1d, 0x99, 0x3b, 0x24, 0x65, 0x90, 0xa5, 0x79,
+		0x24, 0xdc, 0x8b, 0xf7, 0xa3, 0x5e, 0x43, 0xca, 0x20, 0x4b, 0x63, 0x24, 0x27, 0x60, 0x54, 0xab,
+		0x56, 0x6d, 0x02, 0x2e, 0x80, 0xd8, 0xba, 0x64, 0xc4, 0x4b, 0xa6, 0x84, 0xf9, 0x27, 0x20, 0x2d,
+		0xf4, 0x40, 0xa6, 0x6a, 0xa2, 0x09, 0xb5, 0xce, 0x16, 0xdb, 0x09, 0x12, 0x08, 0x33, 0x45, 0xe6,
+		0x1d, 0x30, 0xa4, 0x3b, 0xaa, 0x1f, 0xc4, 0x4f, 0x4c, 0x27, 0x4e, 0xa6, 0x95, 0x41, 0xdd, 0xf1,
+		0x02, 0xa0, 0xf2, 0xc7, 0x12, 0x30, 0x12, 0xde, 0x84, 0x40, 0xf3, 0x90, 0x36, 0xac, 0xb2, 0x46,
+		0x4d, 0x8b, 0xed, 0x80, 0x9d, 0x8c, 0xd9, 0xb7, 0x98, 0x59, 0xe2, 0xf4, 0x8a, 0xc7, 0x99, 0xff,
+		0xb7, 0x12, 0xa4, 0x45, 0x32, 0x3a, 0x04, 0xa9, 0xba, 0xe6, 0x6e, 0x53, 0xb8, 0xbe, 0x62, 0x22,
+		0x2b, 0x29, 0xf4, 0x9b, 0xa4, 0x3b, 0x75, 0xcd, 0xcc, 0x25, 0xfc, 0x74, 0xf2, 0x4d, 0xda, 0xd5,
+		0xc0, 0x5a, 0x85, 0x2e, 0x7a, 0xac, 0x5a, 0x0d, 0x9b, 0xae, 0x23, 0xda, 0x95, 0xa7, 0xcf, 0xf1,
+		0x64, 0xb2, 0x17, 0xe6, 0xda, 0x9a, 0x6e, 0x84, 0x68, 0x53, 0x94, 0x36, 0x2b, 0x32, 0x3c, 0xe2,
+		0x02, 0x1c, 0x11, 0xb8, 0x15, 0xec, 0x6a, 0xe5, 0x6d, 0x5c, 0xf1, 0x99, 0xfa, 0x69, 0x70, 0xe3,
+		0x30, 0x27, 0x98, 0xe7, 0xf9, 0x82, 0x57, 0xfe, 0xaa, 0x04, 0x63, 0x62, 0x99, 0x56, 0xf1, 0x94,
+		0xb5, 0x0c, 0xa0, 0x99, 0xa6, 0xe5, 0x06, 0xd5, 0xd5, 0x6a, 0xca, 0x2d, 0x7c, 0x33, 0xb3, 0x1e,
+		0x93, 0x12, 0x00, 0xc8, 0xd7, 0x00, 0xfc, 0x9c, 0xb6, 0x6a, 0x9b, 0x82, 0x41, 0xbe, 0xc3, 0x44,
+		0xb7, 0x29, 0xd9, 0xc2, 0x1e, 0x58, 0x12, 0x59, 0xcf, 0x91, 0xf0, 0xcb, 0x26, 0xae, 0xea, 0x26,
+		0x8f, 0x1b, 0xb3, 0x0f, 0x11, 0x7e, 0x49, 0x79, 0xe1, 0x97, 0xe2, 0xff, 0x0f, 0xe3, 0x65, 0xab,
+		0xd6, 0x2c, 0x6e, 0x31, 0xdb, 0x14, 0x5c, 0x70, 0x2e, 0x49, 0xcf, 0xde, 0xcf, 0x89, 0xaa, 0x96,
+		0xa1, 0x99, 0xd5, 0x19, 0xcb, 0xae, 0xfa, 0xdb, 0xac, 0xc4, 0xe3, 0x71, 0x02, 0x9b, 0xad, 0xf5,
+		0xcd, 0xff, 0x2d, 0x49, 0xbf, 0x9a, 0x48, 0x2e, 0xac, 0x16, 0x5f, 0x49, 0xe4, 0x17, 0x18, 0xe3,
+		0xaa, 0x50, 0x86, 0x82, 0xb7, 0x0c, 0x5c, 0x26, 0x15, 0x84, 0x6f, 0xdf, 0x07, 0x13, 0x55, 0xab,
+		0x6a, 0x51, 0xa4, 0xd3, 0xe4, 0x17, 0xdf, 0xa7, 0xcd, 0x78, 0xa9, 0xf9, 0xd8, 0x4d, 0xdd, 0xc2,
+		0x15, 0x18, 0xe7, 0xc4, 0x2a, 0xdd, 0x28, 0x62, 0xcb, 0x18, 0xd4, 0x31, 0x86, 0x96, 0xfb, 0xe4,
+		0x37, 0xe9, 0xf4, 0xad, 0x8c, 0x71, 0x56, 0x92, 0xc7, 0x56, 0x3a, 0x05, 0x05, 0x0e, 0x86, 0xf0,
+		0x58, 0x27, 0xc5, 0x76, 0x0c, 0xe2, 0xef, 0x73, 0xc4, 0xf1, 0x00, 0xe2, 0x1a, 0x67, 0x2d, 0xcc,
+		0xc1, 0x70, 0x2f, 0x58, 0xff, 0x9a, 0x63, 0x0d, 0xe1, 0x20, 0xc8, 0x02, 0x8c, 0x52, 0x90, 0x72,
+		0xc3, 0x71, 0xad, 0x1a, 0x1d, 0x01, 0x3b, 0xc3, 0xfc, 0xc1, 0x37, 0x59, 0xaf, 0x19, 0x21, 0x6c,
+		0x73, 0x1e, 0x57, 0xa1, 0x00, 0x74, 0x6f, 0x8c, 0xec, 0x59, 0xc5, 0x20, 0x7c, 0x89, 0x0b, 0xe2,
+		0xd1, 0x17, 0xae, 0xc2, 0x04, 0xf9, 0x4d, 0x07, 0xa8, 0xa0, 0x24, 0xf1, 0x01, 0xb7, 0xdc, 0x57,
+		0xdf, 0xc9, 0x3a, 0xe6, 0xb8, 0x07, 0x10, 0x90, 0x29, 0xd0, 0x8a, 0x55, 0xec, 0xba, 0xd8, 0x76,
+		0x54, 0xcd, 0x88, 0x12, 0x2f, 0x10, 0xb1, 0xc8, 0xbd, 0xff, 0x3b, 0xe1, 0x56, 0x5c, 0x60, 0x9c,
+		0xb3, 0x86, 0x51, 0xd8, 0x80, 0xc3, 0x11, 0x56, 0xd1, 0x05, 0xe6, 0x07, 0x38, 0xe6, 0x44, 0x8b,
+		0x65, 0x10, 0xd8, 0x55, 0x10, 0xe9, 0x5e, 0x5b, 0x76, 0x81, 0xf9, 0x2b, 0x1c, 0x13, 0x71, 0x5e,
+		0xd1, 0xa4, 0x04, 0xf1, 0x09, 0x18, 0xbb, 0x86, 0xed, 0x4d, 0xcb, 0xe1, 0x51, 0xa2, 0x2e, 0xe0,
+		0x3e, 0xc8, 0xe1, 0x46, 0x39, 0x23, 0x0d, 0x1b, 0x11, 0xac, 0x47, 0x21, 0xbd, 0xa5, 0x95, 0x71,
+		0x17, 0x10, 0x37, 0x39, 0xc4, 0x00, 0xa1, 0x27, 0xac, 0xb3, 0x30, 0x54, 0xb5, 0xf8, 0x1c, 0x15,
+		0xcf, 0xfe, 0x21, 0xce, 0x3e, 0x28, 0x78, 0x38, 0x44, 0xdd, 0xaa, 0x37, 0x0c, 0x32, 0x81, 0xc5,
+		0x43, 0xfc, 0x13, 0x01, 0x21, 0x78, 0x38, 0x44, 0x0f, 0x6a, 0x7d, 0x59, 0x40, 0x38, 0x01, 0x7d,
+		0x3e, 0x4e, 0x36, 0x8f, 0x8c, 0x5d, 0xcb, 0xec, 0x46, 0x88, 0x0f, 0x73, 0x04, 0xe0, 0x2c, 0x04,
+		0xe0, 0x02, 0x64, 0xba, 0x6d, 0x88, 0x7f, 0xfa, 0x1d, 0xd1, 0x3d, 0x44, 0x0b, 0x2c, 0xc0, 0xa8,
+		0x18, 0xa0, 0xc8, 0x66, 0x73, 0x3c, 0xc4, 0x3f, 0xe3, 0x10, 0x23, 0x01, 0x36, 0x5e, 0x0d, 0x17,
+		0x3b, 0x6e, 0x15, 0x77, 0x03, 0xf2, 0x31, 0x51, 0x0d, 0xce, 0xc2, 0x55, 0xb9, 0x89, 0xcd, 0xf2,
+		0x76, 0x77, 0x08, 0xbf, 0x26, 0x54, 0x29, 0x78, 0x08, 0xc4, 0x1c, 0x0c, 0xd7, 0x34, 0xdb, 0xd9,
+		0xd6, 0x8c, 0xae, 0x9a, 0xe3, 0x9f, 0x73, 0x8c, 0x21, 0x8f, 0x89, 0x6b, 0xa4, 0x61, 0xf6, 0x02,
+		0xf3, 0x8a, 0xd0, 0x48, 0xc3, 0x0c, 0x01, 0xad, 0xc2, 0x84, 0xe3, 0xd2, 0x90, 0x5a, 0x2f, 0x68,
+		0xbf, 0x2e, 0xba, 0x1e, 0xe3, 0x5d, 0x0e, 0x22, 0x5e, 0x80, 0x8c, 0xa3, 0xbf, 0xd0, 0x15, 0xcc,
+		0xc7, 0x45, 0x4b, 0x53, 0x06, 0xc2, 0xfc, 0x0c, 0x1c, 0x89, 0x9c, 0x26, 0xba, 0x00, 0xfb, 0x0d,
+		0x0e, 0x76, 0x28, 0x62, 0xaa, 0xe0, 0x43, 0x42, 0xaf, 0x90, 0xff, 0x42, 0x0c, 0x09, 0xb8, 0x09,
+		0x6b, 0x95, 0xac, 0x1a, 0x1c, 0x6d, 0xab, 0x37, 0xad, 0xfd, 0x4b, 0xa1, 0x35, 0xc6, 0x1b, 0xd2,
+		0xda, 0x3a, 0x1c, 0xe2, 0x88, 0xbd, 0xb5, 0xeb, 0x27, 0xc4, 0xc0, 0xca, 0xb8, 0x37, 0xc2, 0xad,
+		0xfb, 0x63, 0x90, 0xf7, 0xd4, 0x29, 0xdc, 0x53, 0x47, 0x25, 0x71, 0xa8, 0x78, 0xe4, 0x4f, 0x72,
+		0x64, 0x31, 0xe2, 0x7b, 0xfe, 0xad, 0xb3, 0xac, 0xd5, 0x09, 0xf8, 0xd3, 0x90, 0x13, 0xe0, 0x0d,
+		0xd3, 0xc6, 0x65, 0xab, 0x6a, 0xea, 0x2f, 0xe0, 0x4a, 0x17, 0xd0, 0xbf, 0xd9, 0xd4, 0x54, 0x1b,
+		0x01, 0x76, 0x82, 0xbc, 0x08, 0x59, 0xcf, 0x57, 0x51, 0xf5, 0x5a, 0xdd, 0xb2, 0xdd, 0x18, 0xc4,
+		0x4f, 0x89, 0x96, 0xf2, 0xf8, 0x16, 0x29, 0x5b, 0xa1, 0x04, 0x6c, 0x9f, 0xb9, 0x5b, 0x93, 0x7c,
+		0x95, 0x03, 0x0d, 0xfb, 0x5c, 0x7c, 0xe0, 0x28, 0x5b, 0xb5, 0xba, 0x66, 0x77, 0x33, 0xfe, 0x7d,
+		0x5a, 0x0c, 0x1c, 0x9c, 0x85, 0x0f, 0x1c, 0xc4, 0xa3, 0x23, 0xb3, 0x7d, 0x17, 0x08, 0x9f, 0x11,
+		0x03, 0x87, 0xe0, 0xe1, 0x10, 0xc2, 0x61, 0xe8, 0x02, 0xe2, 0xb7, 0x04, 0x84, 0xe0, 0x21, 0x10,
+		0x4f, 0xfa, 0x13, 0xad, 0x8d, 0xab, 0xba, 0xe3, 0xda, 0xcc, 0x29, 0xee, 0x0c, 0xf5, 0xdb, 0xdf,
+		0x09, 0x3b, 0x61, 0x4a, 0x80, 0x95, 0x8c, 0x44, 0x3c, 0xc8, 0x4a, 0xd7, 0x4c, 0xf1, 0x82, 0x7d,
+		0x56, 0x8c, 0x44, 0x01, 0x36, 0x22, 0x5b, 0xc0, 0x43, 0x24, 0x6a, 0x2f, 0x93, 0x95, 0x42, 0x17,
+		0x70, 0x9f, 0x6b, 0x12, 0x6e, 0x4d, 0xf0, 0x12, 0xcc, 0x80, 0xff, 0xd3, 0x30, 0x77, 0xf0, 0x6e,
+		0x57, 0xd6, 0xf9, 0x3b, 0x4d, 0xfe, 0xcf, 0x06, 0xe3, 0x64, 0x63, 0xc8, 0x68, 0x93, 0x3f, 0x85,
+		0xe2, 0x4e, 0x15, 0xe5, 0x7e, 0xe6, 0xfb, 0xbc, 0xbe, 0x61, 0x77, 0xaa, 0xb0, 0x04, 0x59, 0x9e,
+		0xe2, 0x3b, 0xb0, 0xb1, 0x60, 0xef, 0xfc, 0xbe, 0x67, 0xe7, 0x21, 0x9f, 0xa7, 0x70, 0x11, 0x86,
+		0x43, 0x0e, 0x4f, 0x3c, 0xd4, 0xcf, 0x71, 0xa8, 0xa1, 0xa0, 0xbf, 0x53, 0x38, 0x0b, 0x29, 0xe2,
+		0xbc, 0xc4, 0xb3, 0xff, 0x1d, 0xce, 0x4e, 0xc9, 0x0b, 0x6f, 0x85, 0xb4, 0x70, 0x5a, 0xe2, 0x59,
+		0x7f, 0x9e, 0xb3, 0x7a, 0x2c, 0x84, 0x5d, 0x38, 0x2c, 0xf1, 0xec, 0x7f, 0x57, 0xb0, 0x0b, 0x16,
+		0xc2, 0xde, 0xbd, 0x0a, 0xbf, 0xf0, 0xf7, 0x52, 0x8c, 0x5d, 0xb0, 0x14, 0xc8, 0x3e, 0x37, 0xf3,
+		0x54, 0xe2, 0xb9, 0xdf, 0xcd, 0x0b, 0x17, 0x1c, 0x85, 0x47, 0xa0, 0xaf, 0x4b, 0x85, 0xff, 0x03,
+		0xce, 0xca, 0xe8, 0x0b, 0x73, 0x30, 0x18, 0xf0, 0x4e, 0xe2, 0xd9, 0xff, 0x21, 0x67, 0x0f, 0x72,
+		0x11, 0xd1, 0xb9, 0x77, 0x12, 0x0f, 0xf0, 0x1e, 0x21, 0x3a, 0xe7, 0x20, 0x6a, 0x13, 0x8e, 0x49,
+		0x3c, 0xf7, 0x2f, 0x08, 0xad, 0x0b, 0x96, 0xc2, 0xe3, 0x90, 0xf1, 0x26, 0x9b, 0x78, 0xfe, 0x5f,
+		0xe4, 0xfc, 0x3e, 0x0f, 0xd1, 0x40, 0xc3, 0xec, 0x01, 0xe2, 0x1f, 0x09, 0x0d, 0x04, 0xb8, 0x48,
+		0x37, 0x6a, 0x76, 0x60, 0xe2, 0x91, 0xde, 0x2b, 0xba, 0x51, 0x93, 0xff, 0x42, 0x5a, 0x93, 0x8e,
+		0xf9, 0xf1, 0x10, 0xff, 0x58, 0xb4, 0x26, 0xa5, 0x27, 0x62, 0x34, 0x7b, 0x04, 0xf1, 0x18, 0xbf,
+		0x2c, 0xc4, 0x68, 0x72, 0x08, 0x0a, 0xab, 0x80, 0x5a, 0xbd, 0x81, 0x78, 0xbc, 0xf7, 0x71, 0xbc,
+		0xb1, 0x16, 0x67, 0xa0, 0xf0, 0x14, 0x1c, 0x8a, 0xf6, 0x04, 0xe2, 0x51, 0xdf, 0xff, 0xfd, 0xa6,
+		0xb5, 0x5b, 0xd0, 0x11, 0x28, 0xac, 0xc3, 0x44, 0x94, 0x17, 0x10, 0x0f, 0xfb, 0x81, 0xef, 0x87,
+		0x07, 0xee, 0xa0, 0x13, 0x50, 0x98, 0x05, 0xf0, 0x27, 0xe0, 0x78, 0xac, 0x0f, 0x72, 0xac, 0x00,
+		0x13, 0xe9, 0x1a, 0x7c, 0xfe, 0x8d, 0xe7, 0xbf, 0x29, 0xba, 0x06, 0xe7, 0x20, 0x5d, 0x43, 0x4c,
+		0xbd, 0xf1, 0xdc, 0x1f, 0x12, 0x5d, 0x43, 0xb0, 0x10, 0xcb, 0x0e, 0xcc, 0x6e, 0xf1, 0x08, 0x1f,
+		0x16, 0x96, 0x1d, 0xe0, 0x2a, 0x5c, 0x81, 0xb1, 0x96, 0x09, 0x31, 0x1e, 0xea, 0x57, 0x39, 0x54,
+		0xb6, 0x79, 0x3e, 0x0c, 0x4e, 0x5e, 0x7c, 0x32, 0x8c, 0x47, 0xfb, 0x48, 0xd3, 0xe4, 0xc5, 0xe7,
+		0xc2, 0xc2, 0x05, 0x48, 0x9b, 0x0d, 0xc3, 0x20, 0x9d, 0x07, 0x75, 0x3e, 0x09, 0x98, 0xfb, 0xcf,
+		0x3f, 0xe0, 0xda, 0x11, 0x0c, 0x85, 0xb3, 0xd0, 0x87, 0x6b, 0x9b, 0xb8, 0x12, 0xc7, 0xf9, 0xed,
+		0x1f, 0x88, 0x01, 0x93, 0x50, 0x17, 0x1e, 0x07, 0x60, 0xa1, 0x11, 0xba, 0x19, 0x18, 0xc3, 0xfb,
+		0x5f, 0x7e, 0xc0, 0x8f, 0xde, 0xf8, 0x2c, 0x3e, 0x00, 0x3b, 0xc8, 0xd3, 0x19, 0xe0, 0x3b, 0x61,
+		0x00, 0xda, 0x22, 0x8f, 0xc2, 0x00, 0x39, 0x10, 0xe9, 0x6a, 0xd5, 0x38, 0xee, 0xff, 0xca, 0xb9,
+		0x05, 0x3d, 0x51, 0x58, 0xcd, 0xb2, 0xb1, 0xab, 0x55, 0x9d, 0x38, 0xde, 0xff, 0xc6, 0x79, 0x3d,
+		0x06, 0xc2, 0x5c, 0xd6, 0x1c, 0xb7, 0x9b, 0x7a, 0xff, 0xb5, 0x60, 0x16, 0x0c, 0x44, 0x68, 0xf2,
+		0x7b, 0x07, 0xef, 0xc6, 0xf1, 0x7e, 0x57, 0x08, 0xcd, 0xe9, 0x0b, 0x6f, 0x85, 0x0c, 0xf9, 0xc9,
+		0xce, 0xd3, 0xc5, 0x30, 0xff, 0x77, 0xce, 0xec, 0x73, 0x90, 0x92, 0x1d, 0xb7, 0xe2, 0xea, 0xf1,
+		0xca, 0xfe, 0x1e, 0x6f, 0x69, 0x41, 0x5f, 0x98, 0x85, 0x41, 0xc7, 0xad, 0x54, 0x1a, 0xdc, 0x3f,
+		0x8d, 0x61, 0xff, 0x1f, 0x3f, 0xf0, 0x42, 0x16, 0x1e, 0x0f, 0x69, 0xed, 0xeb, 0x3b, 0x6e, 0xdd,
+		0xa2, 0x1b, 0x1e, 0x71, 0x08, 0xdf, 0xe7, 0x08, 0x01, 0x96, 0xc2, 0x1c, 0x0c, 0x91, 0xba, 0xd8,
+		0xb8, 0x8e, 0xe9, 0xee, 0x54, 0x0c, 0xc4, 0xff, 0xe4, 0x0a, 0x08, 0x31, 0x15, 0x7f, 0xe2, 0x4b,
+		0xaf, 0x4f, 0x4a, 0x5f, 0x79, 0x7d, 0x52, 0xfa, 0xfa, 0xeb, 0x93, 0xd2, 0x2f, 0x7c, 0x63, 0xf2,
+		0xc0, 0x57, 0xbe, 0x31, 0x79, 0xe0, 0x4f, 0xbe, 0x31, 0x79, 0x20, 0x3a, 0x4a, 0x0c, 0x0b, 0xd6,
+		0x82, 0xc5, 0xe2, 0xc3, 0xcf, 0xca, 0x55, 0xdd, 0xdd, 0x6e, 0x6c, 0xce, 0x94, 0xad, 0x1a, 0x0d,
+		0xe3, 0xfa, 0xd1, 0x5a, 0x6f, 0x91, 0x03, 0xdf, 0x4e, 0xc0, 0x91, 0xb2, 0xe5, 0xd4, 0x2c, 0x47,
+		0x65, 0xf1, 0x5e, 0xf6, 0xc1, 0x00, 0xd1, 0x50, 0x30, 0xab, 0x8b, 0xa0, 0xef, 0x3a, 0x4c, 0xe8,
+		0xb5, 0xba, 0x81, 0x69, 0x70, 0x5e, 0xa5, 0x5a, 0xe8, 0xce, 0x19, 0xfc, 0xc3, 0xff, 0xd8, 0xc7,
+		0x82, 0x90, 0x3e, 0xfb, 0xa2, 0xe0, 0x2e, 0x2c, 0xc1, 0x18, 0x39, 0x57, 0x51, 0x0f, 0x41, 0xc6,
+		0x28, 0x53, 0x00, 0x66, 0x39, 0xa7, 0x8f, 0xf6, 0x08, 0xf4, 0x3b, 0x65, 0xcd, 0xd0, 0x62, 0x9b,
+		0xf4, 0xcb, 0x1c, 0x82, 0x93, 0x17, 0xcf, 0xb7, 0x6b, 0x89, 0x67, 0x27, 0x03, 0x8a, 0x66, 0x1a,
+		0xe3, 0x7f, 0xee, 0x67, 0xc8, 0xfd, 0xf4, 0xcf, 0x43, 0xf0, 0xc7, 0x49, 0x98, 0xe4, 0xf9, 0x9b,
+		0x9a, 0x83, 0x4f, 0x5f, 0x7b, 0x70, 0x13, 0xbb, 0xda, 0x83, 0xa7, 0xcb, 0x96, 0x6e, 0x72, 0x8d,
+		0x8f, 0x73, 0xfd, 0x93, 0xfc, 0x19, 0x9e, 0x9f, 0x8f, 0x0c, 0xc7, 0xe7, 0xdb, 0xb7, 0x9b, 0xbc,
+		0x01, 0xa9, 0x39, 0x4b, 0x37, 0xc9, 0x96, 0x43, 0x05, 0x9b, 0x56, 0x8d, 0x1f, 0xbb, 0x63, 0x1f,
+		0xe8, 0x41, 0xe8, 0xd7, 0x6a, 0x56, 0xc3, 0x74, 0xd9, 0x26, 0x45, 0xf1, 0xc8, 0x97, 0x5e, 0x9b,
+		0x3a, 0xf0, 0x67, 0xaf, 0x4d, 0x25, 0x17, 0x4d, 0xf7, 0x8f, 0x5e, 0xbd, 0x1f, 0x38, 0xd4, 0xa2,
+		0xe9, 0x2a, 0x9c, 0xb0, 0x90, 0xfa, 0xd6, 0xcb, 0x53, 0x92, 0xfc, 0x34, 0x0c, 0xcc, 0xe3, 0xf2,
+		0x5e, 0x90, 0xe7, 0x71, 0x39, 0x80, 0x3c, 0x8f, 0xcb, 0x4d, 0xc8, 0x8f, 0x40, 0x7a, 0xd1, 0x74,
+		0xd9, 0xa1, 0xc9, 0xfb, 0x20, 0xa9, 0x9b, 0xec, 0x1c, 0x4e, 0x47, 0xd9, 0x08, 0x15, 0x61, 0x9c,
+		0xc7, 0x65, 0x8f, 0xb1, 0x82, 0xcb, 0x39, 0x29, 0xae, 0x68, 0x42, 0x55, 0x9c, 0xff, 0x93, 0xbf,
+		0x98, 0x3c, 0xf0, 0xe2, 0xeb, 0x93, 0x07, 0xda, 0xb6, 0xaa, 0xdc, 0xb6, 0x55, 0x9d, 0xca, 0x0e,
+		0xdb, 0x5e, 0xf1, 0x5a, 0xf6, 0xaf, 0xfa, 0x41, 0xe6, 0x34, 0x8e, 0xab, 0xed, 0xe8, 0x66, 0xd5,
+		0x6b, 0x5c, 0xad, 0xe1, 0x6e, 0xbf, 0xc0, 0x5b, 0xf7, 0x10, 0x97, 0x82, 0xd3, 0xec, 0xb9, 0x81,
+		0xf3, 0x31, 0x66, 0x24, 0xff, 0x65, 0x12, 0xd0, 0x9a, 0xab, 0xed, 0xe0, 0xd9, 0x86, 0xbb, 0x6d,
+		0xd9, 0xfa, 0x0b, 0x6c, 0x18, 0xc4, 0x00, 0x35, 0xed, 0x86, 0xea, 0x5a, 0x3b, 0xd8, 0x74, 0xa8,
+		0xa2, 0x06, 0xcf, 0x1c, 0x99, 0x89, 0x30, 0xb9, 0x19, 0xd2, 0xc8, 0xc5, 0xfb, 0x5e, 0xf9, 0xda,
+		0xd4, 0x89, 0x78, 0x2d, 0x50, 0x62, 0xe2, 0x97, 0xdf, 0x58, 0xa7, 0xc0, 0xe8, 0x2a, 0xb0, 0xf3,
+		0x19, 0xaa, 0xa1, 0x3b, 0x2e, 0x3f, 0xe2, 0x7d, 0x76, 0x26, 0xba, 0xee, 0x33, 0xad, 0x62, 0xce,
+		0x5c, 0xd5, 0x0c, 0xbd, 0xa2, 0xb9, 0x96, 0xed, 0x5c, 0x3a, 0xa0, 0x64, 0x28, 0xd4, 0x92, 0xee,
+		0xb8, 0x68, 0x1d, 0x32, 0x15, 0x6c, 0xee, 0x32, 0xd8, 0xe4, 0xad, 0xc1, 0xa6, 0x09, 0x12, 0x45,
+		0x7d, 0x1a, 0x90, 0x16, 0xa4, 0x13, 0x77, 0x9a, 0xd8, 0xd1, 0xcc, 0x36, 0xf0, 0x21, 0x64, 0x7a,
+		0x05, 0x63, 0x4c, 0x6b, 0x4e, 0xca, 0xbf, 0x1d, 0xc0, 0x2f, 0x13, 0x9d, 0x81, 0x01, 0xad, 0x52,
+		0xb1, 0xb1, 0xe3, 0xd0, 0xbd, 0xc3, 0x4c, 0x31, 0xf7, 0x47, 0xaf, 0xde, 0x3f, 0xc1, 0xf1, 0x67,
+		0x59, 0x0e, 0x5b, 0x8e, 0x2b, 0x82, 0xb0, 0x30, 0xf6, 0xe5, 0x57, 0xef, 0x1f, 0x0e, 0x95, 0x55,
+		0x1c, 0x02, 0xb8, 0xe6, 0x81, 0x9e, 0xfa, 0x90, 0x04, 0x63, 0x2d, 0xb2, 0x20, 0x19, 0x26, 0x67,
+		0x37, 0xd6, 0x2f, 0xad, 0x28, 0x8b, 0xcf, 0xce, 0x92, 0x93, 0xfc, 0x2a, 0xbb, 0x47, 0x70, 0x65,
+		0x6d, 0xb5, 0x34, 0xb7, 0x78, 0x71, 0xb1, 0x34, 0x9f, 0x3d, 0x80, 0xa6, 0xe0, 0x68, 0x04, 0xcd,
+		0x7c, 0x69, 0xa9, 0xb4, 0x30, 0xbb, 0x4e, 0x6e, 0x4d, 0xdc, 0x01, 0xc7, 0x23, 0x41, 0x3c, 0x92,
+		0x44, 0x1b, 0x12, 0xa5, 0xe4, 0x91, 0x24, 0x8b, 0x17, 0xdb, 0xf6, 0xaf, 0xb7, 0x74, 0xb4, 0xac,
+		0x1b, 0x5e, 0x47, 0x0a, 0xf7, 0xb4, 0x9f, 0x49, 0xc0, 0x11, 0x36, 0x6c, 0xfb, 0xf3, 0x90, 0x66,
+		0xee, 0xb6, 0xb9, 0x4a, 0x1a, 0xdd, 0xb3, 0xe4, 0x4b, 0x90, 0x9c, 0x35, 0x77, 0xd1, 0x11, 0xe6,
+		0xa4, 0xab, 0x0d, 0xdb, 0xe0, 0xe3, 0xd8, 0x00, 0xf9, 0xde, 0xb0, 0x0d, 0x32, 0xbe, 0x89, 0xdb,
+		0x03, 0xe4, 0x4c, 0x00, 0xfb, 0x28, 0x64, 0xdf, 0xf7, 0xf2, 0xd4, 0x81, 0x4f, 0xbc, 0x3c, 0x75,
+		0xe0, 0xbb, 0x1f, 0x9e, 0x3a, 0xf0, 0xe2, 0x9f, 0x4f, 0x1f, 0x28, 0xee, 0x34, 0x57, 0xef, 0x0b,
+		0xb1, 0x53, 0x74, 0x7a, 0xd6, 0xdc, 0xa5, 0x03, 0xd6, 0xaa, 0xf4, 0x6c, 0x1f, 0xad, 0x9c, 0xd8,
+		0x95, 0x9d, 0x6c, 0xde, 0x95, 0x7d, 0x0a, 0x1b, 0xc6, 0x65, 0xd3, 0xba, 0x6e, 0xae, 0x87, 0x74,
+		0xf0, 0xde, 0x04, 0x4c, 0xb6, 0xcc, 0xc5, 0xdc, 0x6d, 0x69, 0x77, 0xa7, 0xb6, 0x00, 0xe9, 0x79,
+		0x4e, 0x42, 0x2e, 0xb9, 0x3a, 0xb8, 0x6c, 0x99, 0x15, 0x36, 0x06, 0x24, 0x15, 0xf1, 0x49, 0xaa,
+		0x6d, 0x6a, 0xa6, 0xe5, 0xf0, 0x83, 0xfc, 0xec, 0xa3, 0xf8, 0x2b, 0x52, 0x6f, 0x4e, 0xc8, 0xb0,
+		0x28, 0x49, 0x54, 0xf3, 0xc1, 0xd8, 0x7d, 0xea, 0x1d, 0x52, 0x4b, 0xaf, 0x12, 0xa1, 0xbd, 0xea,
+		0x6e, 0xb5, 0xf2, 0xcb, 0x09, 0x98, 0x6a, 0xd6, 0x0a, 0xf1, 0x05, 0x1d, 0x57, 0xab, 0xd5, 0xdb,
+		0xa9, 0xe5, 0x02, 0x64, 0xd6, 0x05, 0x4d, 0xcf, 0x7a, 0xb9, 0xd9, 0xa3, 0x5e, 0x46, 0xbc, 0xa2,
+		0x84, 0x62, 0xce, 0x74, 0xa9, 0x18, 0xaf, 0x1e, 0x7b, 0xd2, 0xcc, 0x2b, 0x29, 0x38, 0x4e, 0x6f,
+		0x7a, 0xd9, 0x35, 0xdd, 0x74, 0x4f, 0x97, 0xed, 0xdd, 0xba, 0x4b, 0xbd, 0x41, 0x6b, 0x8b, 0xeb,
+		0x65, 0xcc, 0xcf, 0x9e, 0x61, 0xd9, 0x6d, 0x7a, 0xce, 0x16, 0xf4, 0xad, 0x12, 0x3e, 0xa2, 0x11,
+		0xd7, 0x72, 0x35, 0x83, 0x6b, 0x8a, 0x7d, 0x90, 0x54, 0x76, 0x3b, 0x2c, 0xc1, 0x52, 0x75, 0x71,
+		0x31, 0xcc, 0xc0, 0xda, 0x16, 0x3b, 0x64, 0x9f, 0xa4, 0x1d, 0x2a, 0x4d, 0x12, 0xe8, 0x79, 0xfa,
+		0x09, 0xe8, 0xd3, 0x1a, 0xec, 0x7c, 0x48, 0x92, 0xf4, 0x34, 0xfa, 0x21, 0x5f, 0x86, 0x01, 0xbe,
+		0x4b, 0x4d, 0x4e, 0x48, 0xec, 0xe0, 0x5d, 0x5a, 0xce, 0x90, 0x42, 0x7e, 0xa2, 0x19, 0xe8, 0xa3,
+		0xc2, 0xf3, 0xa9, 0x25, 0x37, 0xd3, 0x22, 0xfd, 0x0c, 0x15, 0x52, 0x61, 0x64, 0xf2, 0x13, 0x90,
+		0x9e, 0xb7, 0x6a, 0xba, 0x69, 0x85, 0xd1, 0x32, 0x0c, 0x8d, 0xca, 0x5c, 0x6f, 0x70, 0x9f, 0x45,
+		0x61, 0x1f, 0xe4, 0x30, 0x2a, 0xbb, 0x74, 0xc1, 0xcf, 0xb8, 0xf0, 0x2f, 0x79, 0x0e, 0x06, 0x28,
+		0xf6, 0x4a, 0x9d, 0xdc, 0xee, 0xf0, 0x4e, 0xbc, 0x66, 0xf8, 0x15, 0x3c, 0x0e, 0x9f, 0xf0, 0x85,
+		0x45, 0x90, 0xaa, 0x68, 0xae, 0xc6, 0xeb, 0x4d, 0x7f, 0xcb, 0x6f, 0x83, 0x34, 0x07, 0x21, 0xd3,
+		0x42, 0xd2, 0xaa, 0x3b, 0xfc, 0x94, 0x4a, 0xbe, 0x5d, 0x55, 0x56, 0xea, 0xc5, 0x14, 0xf1, 0x68,
+		0x14, 0x42, 0x5c, 0x54, 0xda, 0x0e, 0xaa, 0xe7, 0x03, 0x83, 0x6a, 0xa0, 0xc9, 0x03, 0x3f, 0x59,
+		0x93, 0xb6, 0x98, 0x83, 0x67, 0x2c, 0x1f, 0x4e, 0xc0, 0x64, 0x20, 0xf7, 0x1a, 0xb6, 0x1d, 0xdd,
+		0x32, 0xf9, 0x4c, 0xcf, 0xac, 0x05, 0x05, 0x84, 0xe4, 0xf9, 0x6d, 0xcc, 0xe5, 0xad, 0x90, 0x9c,
+		0xad, 0xd7, 0xc9, 0xdd, 0x43, 0xfa, 0x5d, 0xb6, 0x98, 0xbd, 0xa4, 0x14, 0xef, 0x9b, 0xe4, 0x39,
+		0xd6, 0x96, 0x7b, 0x5d, 0xb3, 0xbd, 0x7b, 0x89, 0xe2, 0x5b, 0x7e, 0x14, 0x32, 0x73, 0x96, 0xe9,
+		0x60, 0xd3, 0x69, 0xd0, 0x3e, 0xb8, 0x69, 0x58, 0xe5, 0x1d, 0x8e, 0xc0, 0x3e, 0x88, 0xc2, 0xb5,
+		0x7a, 0x9d, 0x72, 0xa6, 0x14, 0xf2, 0x93, 0x79, 0x94, 0xc5, 0xb5, 0xb6, 0x2a, 0x7a, 0xb4, 0x77,
+		0x15, 0xf1, 0x4a, 0x7a, 0x3a, 0xfa, 0xa1, 0x04, 0xc7, 0x5a, 0x3b, 0xd4, 0x0e, 0xde, 0x75, 0x7a,
+		0xed, 0x4f, 0x4f, 0x43, 0x66, 0x95, 0x3e, 0x0e, 0x70, 0x19, 0xef, 0xa2, 0x3c, 0x0c, 0xe0, 0xca,
+		0x99, 0xb3, 0x67, 0x1f, 0x7c, 0x94, 0x59, 0xfb, 0xa5, 0x03, 0x8a, 0x48, 0x40, 0x93, 0x90, 0x71,
+		0x70, 0xb9, 0x7e, 0xe6, 0xec, 0xb9, 0x9d, 0x07, 0x99, 0x79, 0x11, 0xdf, 0xc8, 0x4b, 0x2a, 0xa4,
+		0x49, 0xad, 0xbf, 0xf5, 0xe1, 0x29, 0xa9, 0xd8, 0x07, 0x49, 0xa7, 0x51, 0xbb, 0xad, 0x36, 0xf2,
+		0x81, 0x3e, 0x98, 0x0e, 0x72, 0xd2, 0x91, 0xca, 0xf3, 0x4a, 0xb8, 0x0e, 0xb2, 0x01, 0x1d, 0x50,
+		0x8a, 0x36, 0x6e, 0x6e, 0x47, 0x4d, 0xca, 0xbf, 0x29, 0xc1, 0x90, 0xe7, 0x44, 0x91, 0x77, 0x20,
+		0x2e, 0x04, 0xfd, 0x1f, 0xde, 0x6d, 0x8e, 0xce, 0x34, 0x97, 0xe5, 0x3b, 0x7b, 0x4a, 0x80, 0x1c,
+		0x3d, 0x42, 0x0d, 0xb1, 0x6e, 0x39, 0xfc, 0xae, 0x5a, 0x0c, 0xab, 0x47, 0x4c, 0xce, 0x1e, 0xd2,
+		0x11, 0x4e, 0xbd, 0x66, 0xb9, 0xe4, 0x30, 0x46, 0xdd, 0xba, 0xce, 0x6f, 0x00, 0x27, 0x95, 0x2c,
+		0xcd, 0xb9, 0x4a, 0x33, 0x56, 0x49, 0x3a, 0x11, 0x3a, 0xe3, 0xa1, 0x90, 0x69, 0xc5, 0x77, 0xfc,
+		0xc8, 0x20, 0x20, 0x3e, 0xc9, 0x05, 0xb9, 0x7a, 0x63, 0x53, 0x15, 0x23, 0x06, 0xb9, 0x62, 0x18,
+		0xd1, 0xff, 0x85, 0x7d, 0xf0, 0x11, 0xa0, 0xbf, 0xde, 0xd8, 0x24, 0xd6, 0x72, 0x07, 0x0c, 0x45,
+		0x08, 0x33, 0x78, 0xcd, 0x97, 0x83, 0xbe, 0x49, 0xc1, 0x6b, 0xa0, 0xd6, 0x6d, 0xdd, 0xb2, 0x75,
+		0x77, 0x97, 0x7a, 0xb6, 0x49, 0x25, 0x2b, 0x32, 0x56, 0x79, 0xba, 0xbc, 0x03, 0xa3, 0x6b, 0x74,
+		0xf9, 0xed, 0x4b, 0x7e, 0xd6, 0x97, 0x4f, 0x8a, 0x97, 0xaf, 0xad, 0x64, 0x89, 0x16, 0xc9, 0x8a,
+		0x4f, 0xb6, 0xb5, 0xce, 0x47, 0x7a, 0xb7, 0xce, 0xb0, 0x87, 0xf8, 0xd7, 0x47, 0xe0, 0x58, 0x73,
+		0x66, 0x68, 0xf8, 0xea, 0xd6, 0x30, 0xe3, 0xbc, 0x89, 0x7c, 0xe7, 0x49, 0x35, 0x1f, 0x33, 0x8c,
+		0xe6, 0x63, 0xbb, 0x90, 0xfc, 0x28, 0x0c, 0x93, 0x33, 0xa3, 0x6b, 0xd8, 0xbd, 0x84, 0xb5, 0x0a,
+		0xb6, 0xc3, 0xb3, 0xee, 0xb0, 0x98, 0x75, 0x11, 0xa4, 0xe8, 0xd4, 0xca, 0x66, 0x1d, 0xfa, 0x5b,
+		0xde, 0x86, 0x14, 0x61, 0xf5, 0x67, 0x64, 0xce, 0x41, 0x3f, 0x48, 0xea, 0xe6, 0xae, 0x8b, 0x1d,
+		0xe1, 0xde, 0xd2, 0x0f, 0xf4, 0xb0, 0x98, 0x57, 0x93, 0x9d, 0xe7, 0x55, 0x6e, 0x88, 0x7c, 0x76,
+		0x35, 0x60, 0xa0, 0x48, 0x86, 0xe2, 0xc5, 0x79, 0x4f, 0x10, 0xc9, 0x17, 0x04, 0x2d, 0xc3, 0x68,
+		0x5d, 0xb3, 0x5d, 0x7a, 0xcf, 0x66, 0x9b, 0xd6, 0x82, 0xdb, 0xfa, 0x54, 0x6b, 0xcf, 0x0b, 0x55,
+		0x96, 0x97, 0x32, 0x5c, 0x0f, 0x26, 0xca, 0x7f, 0x99, 0x82, 0x7e, 0xae, 0x8c, 0xb7, 0xc2, 0x00,
+		0x57, 0x2b, 0xb7, 0xce, 0xe3, 0x33, 0xad, 0x13, 0xd3, 0x8c, 0x37, 0x81, 0x70, 0x3c, 0xc1, 0x83,
+		0xee, 0x81, 0x74, 0x79, 0x5b, 0xd3, 0x4d, 0x55, 0xaf, 0xf0, 0x70, 0xc5, 0xe0, 0xeb, 0xaf, 0x4d,
+		0x0d, 0xcc, 0x91, 0xb4, 0xc5, 0x79, 0x65, 0x80, 0x66, 0x2e, 0x56, 0x88, 0x27, 0xb0, 0x8d, 0xf5,
+		0xea, 0xb6, 0xcb, 0x7b, 0x18, 0xff, 0x22, 0x0f, 0xd2, 0x10, 0x83, 0xe0, 0xb7, 0x30, 0xf3, 0x2d,
+		0xc1, 0x24, 0xcf, 0xd9, 0x2b, 0xa6, 0x49, 0xc1, 0xbf, 0xf0, 0xb5, 0x29, 0x49, 0xa1, 0x1c, 0x68,
+		0x0e, 0x86, 0x0d, 0xcd, 0x71, 0x55, 0x3a, 0x83, 0x91, 0xe2, 0xfb, 0xf8, 0x4a, 0xbc, 0x45, 0x21,
+		0x5c, 0xb1, 0x5c, 0xf4, 0x41, 0xc2, 0xc5, 0x92, 0x2a, 0xe4, 0x92, 0x18, 0x05, 0x21, 0x47, 0x65,
+		0x75, 0x97, 0xf9, 0x56, 0xfd, 0x54, 0xef, 0x23, 0x24, 0x7d, 0x8e, 0x26, 0x53, 0x0f, 0xeb, 0x28,
+		0x64, 0xe8, 0xbd, 0x2f, 0x4a, 0xc2, 0xce, 0x38, 0xa7, 0x49, 0x02, 0xcd, 0x3c, 0x01, 0xa3, 0xfe,
+		0xf8, 0xc8, 0x48, 0xd2, 0x0c, 0xc5, 0x4f, 0xa6, 0x84, 0x0f, 0xc0, 0x84, 0x89, 0x6f, 0xb8, 0xaa,
+		0x9f, 0xcc, 0xa8, 0x33, 0x94, 0x1a, 0x91, 0xbc, 0xab, 0x61, 0x8e, 0xbb, 0x61, 0xa4, 0x2c, 0x94,
+		0xcf, 0x68, 0x81, 0xd2, 0x0e, 0x7b, 0xa9, 0x94, 0xec, 0x08, 0xa4, 0xb5, 0x7a, 0x9d, 0x11, 0x0c,
+		0xf2, 0xf1, 0xb1, 0x5e, 0xa7, 0x59, 0xa7, 0x60, 0x8c, 0xd6, 0xd1, 0xc6, 0x4e, 0xc3, 0x70, 0x39,
+		0xc8, 0x10, 0xa5, 0x19, 0x25, 0x19, 0x0a, 0x4b, 0xa7, 0xb4, 0x77, 0xc2, 0x30, 0xbe, 0xa6, 0x57,
+		0xb0, 0x59, 0xc6, 0x8c, 0x6e, 0x98, 0xd2, 0x0d, 0x89, 0x44, 0x4a, 0x74, 0x2f, 0x78, 0xe3, 0x9e,
+		0x2a, 0xc6, 0xe4, 0x11, 0x86, 0x27, 0xd2, 0xf9, 0x4a, 0x5c, 0xce, 0x41, 0x6a, 0x5e, 0x73, 0x35,
+		0xe2, 0x60, 0xb8, 0x37, 0xd8, 0x44, 0x33, 0xa4, 0x90, 0x9f, 0xf2, 0xb7, 0x12, 0x90, 0xba, 0x6a,
+		0xb9, 0x18, 0x3d, 0x14, 0x70, 0x00, 0x47, 0xa2, 0xec, 0x79, 0x4d, 0xaf, 0x9a, 0xb8, 0xb2, 0xec,
+		0x54, 0x03, 0x8f, 0x34, 0xf8, 0xe6, 0x94, 0x08, 0x99, 0xd3, 0x04, 0xf4, 0xd9, 0x56, 0xc3, 0xac,
+		0x88, 0xe3, 0xc1, 0xf4, 0x03, 0x95, 0x20, 0xed, 0x59, 0x49, 0x2a, 0xce, 0x4a, 0x46, 0x89, 0x95,
+		0x10, 0x1b, 0xe6, 0x09, 0xca, 0xc0, 0x26, 0x37, 0x96, 0x22, 0x64, 0xbc, 0xc1, 0x2b, 0xd7, 0xd7,
+		0x83, 0xc1, 0xfa, 0x6c, 0x64, 0x32, 0xf1, 0xda, 0xde, 0x53, 0x1e, 0xb3, 0xb8, 0xac, 0x97, 0xc1,
+		0xb5, 0x17, 0x32, 0x2b, 0xfe, 0x60, 0xc4, 0x00, 0xad, 0x97, 0x6f, 0x56, 0xec, 0xd1, 0x88, 0x63,
+		0xe4, 0xb4, 0x57, 0xd5, 0xd4, 0xdc, 0x86, 0x8d, 0xb9, 0xe5, 0xf9, 0x09, 0xe4, 0x32, 0x50, 0x3f,
+		0xb3, 0xe4, 0x80, 0xde, 0xa4, 0x68, 0xbd, 0x25, 0xda, 0xe9, 0x2d, 0xb9, 0x77, 0xbd, 0xcd, 0x02,
+		0x78, 0xc2, 0x38, 0xfc, 0x1e, 0x7f, 0x84, 0xc7, 0xc0, 0x44, 0x5c, 0xd3, 0xab, 0xbc, 0xa3, 0x06,
+		0x98, 0xe4, 0xff, 0x24, 0x41, 0xc6, 0xcb, 0x47, 0xb3, 0x30, 0x2c, 0xe4, 0x52, 0xb7, 0x0c, 0xad,
+		0xca, 0x6d, 0xe7, 0x78, 0x5b, 0xe1, 0x2e, 0x1a, 0x5a, 0x55, 0x19, 0xe4, 0xf2, 0x90, 0x8f, 0xe8,
+		0x76, 0x48, 0xb4, 0x69, 0x87, 0x50, 0xc3, 0x27, 0xf7, 0xd6, 0xf0, 0xa1, 0x26, 0x4a, 0x35, 0x37,
+		0xd1, 0xa7, 0x12, 0x74, 0x31, 0x53, 0xb7, 0x1c, 0xcd, 0x78, 0x23, 0x7a, 0xc4, 0x51, 0xc8, 0xd4,
+		0x2d, 0x43, 0x65, 0x39, 0xec, 0xd8, 0x7c, 0xba, 0x6e, 0x19, 0x4a, 0x4b, 0xb3, 0xf7, 0xed, 0x53,
+		0x77, 0xe9, 0xdf, 0x07, 0xad, 0x0d, 0x34, 0x6b, 0xcd, 0x86, 0x21, 0xa6, 0x0a, 0x3e, 0x97, 0x3d,
+		0x40, 0x74, 0x40, 0x7e, 0xe5, 0xa4, 0xd6, 0xb9, 0x97, 0x89, 0xcd, 0x28, 0x95, 0xfe, 0x6d, 0x8f,
+		0x83, 0x0d, 0xfd, 0xb9, 0x44, 0x3b, 0x0e, 0x66, 0x76, 0x0a, 0xa7, 0x93, 0x7f, 0x49, 0x02, 0x58,
+		0x22, 0x9a, 0xa5, 0xf5, 0x25, 0xb3, 0x90, 0x43, 0x45, 0x50, 0x43, 0x25, 0x4f, 0xb6, 0x6b, 0x34,
+		0x5e, 0xfe, 0x90, 0x13, 0x94, 0x7b, 0x0e, 0x86, 0x7d, 0x63, 0x74, 0xb0, 0x10, 0x66, 0xb2, 0x83,
+		0x57, 0xbd, 0x86, 0x5d, 0x65, 0xe8, 0x5a, 0xe0, 0x4b, 0xfe, 0x57, 0x12, 0x64, 0xa8, 0x4c, 0xe4,
+		0x16, 0x72, 0xa8, 0x0d, 0xa5, 0xbd, 0xb7, 0xe1, 0x71, 0x00, 0x06, 0x43, 0xf6, 0xbe, 0xb9, 0x65,
+		0x65, 0x68, 0x0a, 0xd9, 0xd1, 0x46, 0xe7, 0x3c, 0x85, 0x27, 0x3b, 0x2b, 0x5c, 0x78, 0xdd, 0x5c,
+		0xed, 0x87, 0x61, 0x80, 0xbe, 0x7b, 0x75, 0xc3, 0xe1, 0x8e, 0x34, 0x79, 0xec, 0x62, 0xfd, 0x86,
+		0x23, 0x3f, 0x07, 0x03, 0xeb, 0x37, 0x58, 0x6c, 0xe4, 0x28, 0x64, 0x6c, 0xcb, 0xe2, 0x73, 0x32,
+		0xf3, 0x85, 0xd2, 0x24, 0x81, 0x4e, 0x41, 0x22, 0x1e, 0x90, 0xf0, 0xe3, 0x01, 0x7e, 0x40, 0x23,
+		0xd9, 0x55, 0x40, 0xe3, 0xd4, 0x1f, 0x4b, 0x30, 0x18, 0x18, 0x1f, 0xd0, 0x83, 0x70, 0xb0, 0xb8,
+		0xb4, 0x32, 0x77, 0x59, 0x5d, 0x9c, 0x57, 0x2f, 0x2e, 0xcd, 0x2e, 0xf8, 0x17, 0xc3, 0xf2, 0x87,
+		0x5e, 0xba, 0x39, 0x8d, 0x02, 0xb4, 0x1b, 0x26, 0x8d, 0x28, 0xa1, 0xd3, 0x30, 0x11, 0x66, 0x99,
+		0x2d, 0xae, 0x91, 0x5b, 0x62, 0x52, 0xfe, 0xe0, 0x4b, 0x37, 0xa7, 0xc7, 0x02, 0x1c, 0xb3, 0x9b,
+		0x0e, 0x36, 0xdd, 0x56, 0x86, 0xb9, 0x95, 0xe5, 0xe5, 0xc5, 0xf5, 0x6c, 0xa2, 0x85, 0x81, 0x0f,
+		0xd8, 0xf7, 0xc2, 0x58, 0x98, 0xe1, 0xca, 0xe2, 0x52, 0x36, 0x99, 0x47, 0x2f, 0xdd, 0x9c, 0x1e,
+		0x09, 0x50, 0x5f, 0xd1, 0x8d, 0x7c, 0xfa, 0x5d, 0x1f, 0x99, 0x3c, 0xf0, 0x6b, 0x1f, 0x9d, 0x94,
+		0x48, 0xcd, 0x86, 0x43, 0x63, 0x04, 0x7a, 0x0b, 0x1c, 0x5e, 0x5b, 0x5c, 0xb8, 0x52, 0x9a, 0x57,
+		0x97, 0xd7, 0x16, 0x44, 0x0c, 0x5a, 0xd4, 0x6e, 0xf4, 0xa5, 0x9b, 0xd3, 0x83, 0xbc, 0x4a, 0xed,
+		0xa8, 0x57, 0x95, 0xd2, 0xd5, 0x15, 0x12, 0xd1, 0x66, 0xd4, 0xab, 0x36, 0xbe, 0x66, 0xb9, 0xec,
+		0x61, 0xbc, 0x07, 0xe0, 0x48, 0x04, 0xb5, 0x57, 0xb1, 0xb1, 0x97, 0x6e, 0x4e, 0x0f, 0xaf, 0xda,
+		0x98, 0xf5, 0x1f, 0xca, 0x31, 0x03, 0xb9, 0x56, 0x8e, 0x95, 0xd5, 0x95, 0xb5, 0xd9, 0xa5, 0xec,
+		0x74, 0x3e, 0xfb, 0xd2, 0xcd, 0xe9, 0x21, 0x31, 0x18, 0xd2, 0x2d, 0x00, 0xaf, 0x66, 0xb7, 0x73,
+		0xc5, 0xf3, 0xd1, 0x73, 0x70, 0x57, 0x9b, 0xdd, 0x27, 0xfe, 0xbd, 0xb7, 0xfd, 0xa7, 0xb6, 0x71,
+		0xf6, 0x7c, 0x4c, 0xf8, 0x39, 0x7e, 0xe9, 0xb4, 0xf7, 0xbd, 0xad, 0x7c, 0xc7, 0xc5, 0x9d, 0xfc,
+		0x6e, 0x09, 0x46, 0x2e, 0xe9, 0x8e, 0x6b, 0xd9, 0x7a, 0x59, 0x33, 0xe8, 0x75, 0xb0, 0x73, 0xdd,
+		0x8e, 0xad, 0x4d, 0x5d, 0xfd, 0x71, 0xe8, 0xbf, 0xa6, 0x19, 0x6c, 0x50, 0x4b, 0xd2, 0xd7, 0x6b,
+		0xda, 0x6c, 0x06, 0x79, 0x43, 0x9b, 0x00, 0x60, 0x6c, 0xf2, 0xc7, 0x13, 0x30, 0x4a, 0x3b, 0x83,
+		0xc3, 0xde, 0x35, 0x23, 0x6b, 0xac, 0x55, 0x48, 0xd9, 0x9a, 0xcb, 0x83, 0x86, 0xc5, 0xc7, 0xf8,
+		0x2e, 0xe5, 0x3d, 0x5d, 0xec, 0xb2, 0xb5, 0x6e, 0x64, 0x52, 0x24, 0xf4, 0x14, 0xa4, 0xc9, 0xa6,
+		0x1e, 0x45, 0x4d, 0xec, 0x03, 0xea, 0x40, 0x4d, 0xbb, 0x41, 0x64, 0x45, 0x15, 0x18, 0x25, 0xc0,
+		0xe5, 0x6d, 0xcd, 0xac, 0x62, 0x86, 0x9f, 0xdc, 0x07, 0xfc, 0xe1, 0x9a, 0x76, 0x63, 0x8e, 0x62,
+		0x92, 0x52, 0x0a, 0x69, 0xb2, 0xa7, 0x42, 0x37, 0x81, 0x7f, 0x47, 0x02, 0xf0, 0xd5, 0x85, 0x7e,
+		0x1c, 0xb2, 0x65, 0xef, 0x8b, 0x16, 0x2f, 0xb6, 0x2c, 0x4f, 0xb4, 0x6b, 0x88, 0x26, 0x65, 0xb3,
+		0x89, 0xf9, 0x2b, 0xaf, 0x4d, 0x49, 0xca, 0x68, 0xb9, 0xa9, 0x1d, 0x4a, 0x30, 0xd8, 0xa8, 0x57,
+		0x34, 0x17, 0xab, 0x74, 0x11, 0x97, 0xe8, 0x61, 0x92, 0x07, 0xc6, 0x48, 0xb2, 0x02, 0xd2, 0x7f,
+		0x5c, 0x82, 0xc1, 0xf9, 0xc0, 0x79, 0xcc, 0x1c, 0x0c, 0xd4, 0x2c, 0x53, 0xdf, 0xe1, 0x66, 0x97,
+		0x51, 0xc4, 0x27, 0x89, 0x78, 0xb2, 0x8b, 0xb0, 0xee, 0xae, 0x88, 0x78, 0x8a, 0x6f, 0xc2, 0x75,
+		0x1d, 0x6f, 0x3a, 0xba, 0xd0, 0xb5, 0x22, 0x3e, 0xc9, 0xd2, 0xc5, 0xc1, 0xe5, 0x06, 0x09, 0xd5,
+		0xa8, 0x65, 0xcb, 0x74, 0xb5, 0xb2, 0xcb, 0xaf, 0x54, 0x8e, 0x8a, 0xf4, 0x39, 0x96, 0x4c, 0x40,
+		0x2a, 0xd8, 0xd5, 0x74, 0xc3, 0xc9, 0xb1, 0x23, 0x0c, 0xe2, 0x33, 0x28, 0xee, 0x40, 0x30, 0x44,
+		0x35, 0x07, 0x59, 0xab, 0x8e, 0xed, 0x90, 0x4b, 0xc9, 0x2c, 0xb4, 0xfd, 0x26, 0xe5, 0xa8, 0xe0,
+		0xe0, 0xc9, 0xe8, 0x19, 0xc8, 0x7a, 0x2b, 0x3b, 0xb5, 0xde, 0xd8, 0xf4, 0xc3, 0x5a, 0x13, 0x2d,
+		0x7a, 0x9d, 0x35, 0x77, 0x8b, 0xb9, 0x2f, 0xfb, 0xd0, 0x7e, 0x2c, 0x89, 0x04, 0x92, 0x46, 0x3d,
+		0x9c, 0x55, 0x0a, 0x43, 0x5c, 0xc4, 0xe7, 0x34, 0xdd, 0x10, 0xf7, 0xfb, 0x15, 0xfe, 0x85, 0x0a,
+		0xd0, 0xef, 0xb8, 0x9a, 0xdb, 0x70, 0xf8, 0x7e, 0xad, 0xdc, 0xce, 0x32, 0x8a, 0x96, 0x59, 0x59,
+		0xa3, 0x94, 0x0a, 0xe7, 0x40, 0xeb, 0xd0, 0xcf, 0x37, 0xc2, 0xfb, 0x7a, 0xb6, 0xea, 0x88, 0x93,
+		0x12, 0x0c, 0x0b, 0x55, 0x21, 0x5b, 0xc1, 0x06, 0xae, 0x32, 0x87, 0x68, 0x5b, 0x23, 0xeb, 0x86,
+		0xfe, 0x7d, 0xe8, 0x35, 0xa3, 0x1e, 0xea, 0x1a, 0x05, 0x45, 0x97, 0x43, 0xc7, 0x7f, 0xf9, 0x13,
+		0x95, 0x77, 0xb6, 0xab, 0x7f, 0xc0, 0x32, 0x45, 0x30, 0x21, 0xc0, 0x4d, 0x8c, 0xab, 0x61, 0x6e,
+		0x5a, 0x26, 0xbd, 0x85, 0xcb, 0x9d, 0xf1, 0x34, 0x75, 0x6f, 0x46, 0xbd, 0xf4, 0x4b, 0x34, 0x19,
+		0x5d, 0x86, 0x11, 0x9f, 0x94, 0xf6, 0x9d, 0x4c, 0x0f, 0x7d, 0x67, 0xd8, 0xe3, 0x25, 0xb9, 0xe8,
+		0x12, 0x80, 0xdf, 0x31, 0x69, 0x78, 0x60, 0xf0, 0x8c, 0x1c, 0xdf, 0xbb, 0xc5, 0x32, 0xcb, 0xe7,
+		0x45, 0x06, 0x8c, 0xd7, 0x74, 0x53, 0x75, 0xb0, 0xb1, 0xa5, 0x72, 0x55, 0x11, 0xc8, 0xc1, 0x7d,
+		0x68, 0xda, 0xb1, 0x9a, 0x6e, 0xae, 0x61, 0x63, 0x6b, 0xde, 0x83, 0x45, 0x8f, 0xc1, 0x51, 0x5f,
+		0x09, 0x96, 0xa9, 0x6e, 0x5b, 0x46, 0x45, 0xb5, 0xf1, 0x96, 0x5a, 0xa6, 0xa7, 0x5f, 0x86, 0xa8,
+		0xea, 0x0e, 0x7b, 0x24, 0x2b, 0xe6, 0x25, 0xcb, 0xa8, 0x28, 0x78, 0x6b, 0x8e, 0x64, 0x93, 0x50,
+		0x85, 0xcf, 0xad, 0x57, 0x9c, 0xdc, 0xf0, 0x74, 0xf2, 0x64, 0x4a, 0x19, 0xf2, 0x12, 0x17, 0x2b,
+		0x4e, 0x61, 0xe8, 0x5d, 0x2f, 0x4f, 0x1d, 0xe0, 0xdd, 0xf5, 0x80, 0xbc, 0x4a, 0xa3, 0xe0, 0xbc,
+		0xa7, 0x61, 0x07, 0x9d, 0x83, 0x8c, 0x26, 0x3e, 0x62, 0x8f, 0x13, 0xf8, 0xa4, 0x6c, 0x00, 0x78,
+		0xf1, 0xcf, 0xa7, 0x25, 0xf9, 0xa3, 0x12, 0xf4, 0xcf, 0x5f, 0x5d, 0xd5, 0x74, 0x1b, 0x95, 0x60,
+		0xcc, 0xb7, 0xd9, 0x6e, 0xbb, 0xbf, 0x6f, 0xe6, 0x3c, 0x9d, 0xc0, 0x44, 0x2f, 0x4c, 0x3b, 0xc2,
+		0x34, 0x2f, 0x59, 0x9b, 0x2a, 0x5e, 0x82, 0x01, 0x26, 0x25, 0xb9, 0x28, 0xde, 0x57, 0x27, 0x3f,
+		0x78, 0xd0, 0x7f, 0xb2, 0xad, 0xad, 0x53, 0x7a, 0x2f, 0x48, 0x49, 0x58, 0xe4, 0x1f, 0x4a, 0x00,
+		0xf3, 0x57, 0xaf, 0xae, 0xdb, 0x7a, 0xdd, 0xc0, 0xee, 0x7e, 0xd5, 0x78, 0x09, 0x0e, 0xfa, 0x35,
+		0x76, 0xec, 0x72, 0xd7, 0xb5, 0x1e, 0xf7, 0xd7, 0x3f, 0x76, 0x39, 0x12, 0xad, 0xe2, 0xb8, 0x1e,
+		0x5a, 0xb2, 0x6b, 0xb4, 0x79, 0xc7, 0x8d, 0x56, 0xe3, 0x1a, 0x0c, 0xfa, 0xd5, 0x27, 0x4f, 0xa1,
+		0xa5, 0x5d, 0xfe, 0x9b, 0x6b, 0x53, 0x6e, 0xaf, 0x4d, 0xc1, 0xc6, 0x35, 0xea, 0x71, 0xca, 0xff,
+		0x97, 0x28, 0xd5, 0xef, 0x14, 0x6f, 0x2a, 0x33, 0x22, 0xc3, 0x3b, 0x1f, 0x7e, 0xf7, 0xc3, 0x69,
+		0xe1, 0x58, 0x4d, 0x5a, 0x7d, 0x67, 0x82, 0xbc, 0xa2, 0xc1, 0x3b, 0xed, 0x9b, 0x56, 0x13, 0xab,
+		0x30, 0x80, 0x4d, 0xd7, 0xd6, 0xa9, 0x2a, 0x48, 0x5b, 0x3f, 0xd0, 0xae, 0xad, 0x23, 0xea, 0x42,
+		0xdf, 0x97, 0x12, 0xa1, 0x73, 0x0e, 0xd3, 0xa4, 0x85, 0xcf, 0x27, 0x21, 0xd7, 0x8e, 0x93, 0x04,
+		0x02, 0xcb, 0x36, 0xa6, 0x09, 0x6a, 0x28, 0x7e, 0x37, 0x22, 0x92, 0xf9, 0xbc, 0xb2, 0x0c, 0xc4,
+		0x47, 0x23, 0x86, 0x45, 0x48, 0x7b, 0x76, 0xca, 0x46, 0x7c, 0x66, 0x92, 0x8d, 0x30, 0x8c, 0xea,
+		0xa6, 0xee, 0xea, 0x9a, 0xa1, 0x6e, 0x6a, 0x86, 0x66, 0x96, 0xf7, 0xe2, 0xbc, 0xb6, 0xce, 0x05,
+		0x23, 0x1c, 0xb4, 0xc8, 0x30, 0xd1, 0x55, 0x18, 0x10, 0xf0, 0xa9, 0x7d, 0x80, 0x17, 0x60, 0x64,
+		0x0b, 0x2d, 0x38, 0x45, 0x50, 0x17, 0x25, 0xa5, 0x0c, 0x7a, 0x69, 0x8b, 0x95, 0xb8, 0x39, 0xa8,
+		0xbf, 0xe3, 0x1c, 0x14, 0xf0, 0x04, 0x3f, 0x97, 0x84, 0x31, 0x05, 0x57, 0x7e, 0xb4, 0xda, 0xed,
+		0xc7, 0x00, 0x58, 0x8f, 0x26, 0x03, 0x6d, 0x2e, 0xb5, 0x0f, 0x23, 0x44, 0x86, 0xe1, 0xcd, 0x3b,
+		0xee, 0x1b, 0xd9, 0x78, 0x5f, 0x4e, 0xc0, 0x50, 0xb0, 0xf1, 0x7e, 0x04, 0x66, 0x36, 0xb4, 0xe8,
+		0x8f, 0x67, 0x29, 0xfe, 0xb6, 0x6f, 0x9b, 0xf1, 0xac, 0xc5, 0xac, 0x3b, 0x0f, 0x64, 0x3f, 0x97,
+		0x86, 0xfe, 0x55, 0xcd, 0xd6, 0x6a, 0x0e, 0x7a, 0xa2, 0xc5, 0xcb, 0x15, 0xa1, 0xc8, 0x96, 0x17,
+		0xdc, 0x79, 0xe4, 0x83, 0xd9, 0xf4, 0xfb, 0x22, 0x9c, 0xdc, 0xbb, 0x61, 0x84, 0xac, 0xa3, 0x03,
+		0xa7, 0x16, 0x12, 0x74, 0x2f, 0x96, 0x2c, 0x84, 0x03, 0xe7, 0x43, 0xa7, 0x60, 0x90, 0x90, 0xf9,
+		0x43, 0x35, 0xa1, 0x21, 0xe7, 0x75, 0x4b, 0x2c, 0x05, 0xdd, 0x0f, 0x68, 0xdb, 0x8b, 0x6c, 0xa8,
+		0xbe, 0x0a, 0x08, 0xdd, 0x98, 0x9f, 0x23, 0xc8, 0x49, 0x00, 0xd4, 0x32, 0x2b, 0x2a, 0x3b, 0xa7,
+		0xcd, 0x16, 0x82, 0x19, 0x92, 0x32, 0x4f, 0x12, 0xd0, 0x4f, 0x31, 0x87, 0xb9, 0x69, 0x89, 0xcd,
+		0xd7, 0x2a, 0x4b, 0xbd, 0x75, 0x85, 0xef, 0xbd, 0x36, 0x95, 0xdf, 0xd5, 0x6a, 0x46, 0x41, 0x8e,
+		0x80, 0x94, 0xa9, 0x03, 0x1d, 0x5e, 0x9a, 0x23, 0x15, 0x8e, 0xd0, 0xd8, 0x82, 0x65, 0x8a, 0xa5,
+		0xa2, 0x6a, 0xf3, 0x17, 0x76, 0xd8, 0x73, 0xfb, 0xc3, 0xc5, 0xbb, 0xbe, 0xf7, 0xda, 0xd4, 0x34,
+		0x47, 0x6d, 0x47, 0x2a, 0x2b, 0x87, 0x48, 0x34, 0xc1, 0x32, 0xf9, 0x42, 0x51, 0x11, 0x19, 0xa8,
+		0x02, 0xd9, 0x20, 0xa5, 0xba, 0x85, 0x71, 0x2e, 0x1d, 0x77, 0xe0, 0x79, 0x8a, 0x54, 0xfb, 0x7b,
+		0xaf, 0x4d, 0x1d, 0x66, 0xc5, 0x36, 0x03, 0xc8, 0xca, 0x48, 0xa0, 0x8c, 0x8b, 0x18, 0xa3, 0x5f,
+		0x92, 0xe0, 0x58, 0xa8, 0x6d, 0xd9, 0x89, 0x07, 0x75, 0xcb, 0xd6, 0xe8, 0x63, 0x3a, 0x74, 0x6d,
+		0x94, 0x29, 0x6e, 0xf4, 0xac, 0xce, 0x3b, 0xfd, 0x8a, 0xb7, 0xc3, 0x96, 0x95, 0x23, 0x41, 0x03,
+		0xa2, 0xe7, 0x2a, 0x2e, 0xf2, 0x3c, 0xb4, 0xcd, 0xe4, 0x6a, 0x98, 0xbc, 0x03, 0xd0, 0x9b, 0xd0,
+		0x2a, 0x7b, 0x52, 0x8b, 0xa8, 0x18, 0xa8, 0x8a, 0x4f, 0x84, 0x4b, 0x6a, 0x47, 0xcd, 0x4a, 0xda,
+		0xf0, 0x72, 0x67, 0x0d, 0x63, 0x55, 0xe4, 0x21, 0x0c, 0x47, 0xb1, 0xb9, 0x65, 0x91, 0x87, 0x8f,
+		0xda, 0x2d, 0xc0, 0xd2, 0xc5, 0x7b, 0xbe, 0xf7, 0xda, 0x94, 0xcc, 0x0a, 0xea, 0x40, 0x2c, 0x2b,
+		0x39, 0x9e, 0xbb, 0xdc, 0xb2, 0xe2, 0x52, 0xe1, 0x88, 0x63, 0x68, 0xce, 0xb6, 0xba, 0xd5, 0x30,
+		0xd9, 0xfb, 0x50, 0x0d, 0x93, 0x84, 0x42, 0xea, 0x96, 0x65, 0xd0, 0xf5, 0x56, 0x3a, 0x68, 0x30,
+		0x6d, 0x49, 0x65, 0xe5, 0x10, 0xcd, 0xbb, 0xd8, 0x30, 0x2b, 0x73, 0x22, 0x67, 0xd5, 0xb2, 0x8c,
+		0xc0, 0x98, 0xfa, 0xb3, 0x49, 0xc8, 0x71, 0x93, 0xba, 0xec, 0x37, 0xb7, 0x82, 0xcb, 0x96, 0x5d,
+		0x89, 0xf6, 0xc9, 0xa4, 0x9e, 0x7d, 0xb2, 0xab, 0x30, 0x4a, 0x46, 0xfc, 0x80, 0x51, 0xef, 0x31,
+		0x54, 0x32, 0x6c, 0x19, 0x15, 0xdf, 0xfe, 0x09, 0xae, 0x89, 0xaf, 0x87, 0x70, 0x93, 0x7b, 0xc3,
+		0x35, 0xf1, 0xf5, 0x00, 0xae, 0xbf, 0x47, 0x97, 0x0a, 0xed, 0xd1, 0x45, 0xcc, 0xfe, 0x7d, 0x7b,
+		0x9f, 0xfd, 0x0b, 0xe9, 0x77, 0x89, 0xb1, 0xf8, 0x23, 0x12, 0x20, 0xbf, 0xf9, 0x15, 0xec, 0xd4,
+		0x2d, 0xd3, 0xa1, 0x01, 0x83, 0x80, 0x71, 0x49, 0x9d, 0x03, 0x06, 0x3e, 0xbf, 0x08, 0x18, 0xf8,
+		0xbc, 0xe4, 0xc1, 0x6f, 0xe1, 0x60, 0x24, 0xe2, 0xc6, 0x05, 0x3e, 0x6b, 0x70, 0x7a, 0xcf, 0x54,
+		0x0e, 0xc8, 0x7f, 0x2a, 0xc1, 0x91, 0x96, 0x49, 0xc6, 0x13, 0xf6, 0xff, 0x03, 0x64, 0x07, 0x32,
+		0xf9, 0xdb, 0xad, 0x4c, 0xe8, 0x9e, 0xe7, 0xac, 0x31, 0xbb, 0x39, 0xe3, 0x76, 0x39, 0x9f, 0xfc,
+		0x4e, 0xce, 0xef, 0x49, 0x30, 0x11, 0x14, 0xc6, 0xab, 0xd6, 0x15, 0x18, 0x0a, 0xca, 0xc2, 0x2b,
+		0x74, 0x57, 0x37, 0x15, 0xe2, 0x75, 0x09, 0xf1, 0xa3, 0x27, 0xfd, 0xf9, 0x9c, 0x05, 0xda, 0x1f,
+		0xec, 0x5a, 0x37, 0x42, 0xa6, 0xe6, 0x79, 0x3d, 0x25, 0x96, 0x67, 0x29, 0xd2, 0xb7, 0xd1, 0x3b,
+		0x60, 0xcc, 0xb4, 0x5c, 0x95, 0x4c, 0x7e, 0xb8, 0x12, 0xbc, 0xfe, 0x92, 0x29, 0x3e, 0xd9, 0x9b,
+		0xca, 0xbe, 0xfd, 0xda, 0x54, 0x2b, 0x54, 0x93, 0x1e, 0x47, 0x4d, 0xcb, 0x2d, 0xd2, 0x7c, 0x7e,
+		0x1f, 0xc6, 0x86, 0xe1, 0x70, 0xd1, 0xcc, 0x89, 0x5a, 0xee, 0xb9, 0xe8, 0xe1, 0x4e, 0xc5, 0x0e,
+		0x6d, 0x06, 0xca, 0x64, 0xe7, 0x41, 0xbf, 0xfb, 0xf2, 0x94, 0x74, 0xea, 0x33, 0x12, 0x80, 0x1f,
+		0xfe, 0x24, 0x3b, 0x64, 0xc5, 0x95, 0x2b, 0xf3, 0xea, 0xda, 0xfa, 0xec, 0xfa, 0xc6, 0x5a, 0xf8,
+		0x52, 0x88, 0xd8, 0x4f, 0x73, 0xea, 0xb8, 0x4c, 0x9e, 0x69, 0xac, 0xa0, 0x7b, 0x60, 0x22, 0x4c,
+		0x4d, 0xbe, 0xc8, 0x3b, 0xcc, 0xf9, 0xa1, 0x97, 0x6e, 0x4e, 0xa7, 0xd9, 0xb2, 0x0f, 0x93, 0xd3,
+		0x48, 0x07, 0x5b, 0xe9, 0xc8, 0x2b, 0xb3, 0x89, 0xfc, 0xf0, 0x4b, 0x37, 0xa7, 0x33, 0xde, 0xfa,
+		0x10, 0xc9, 0x80, 0x82, 0x94, 0x1c, 0x2f, 0x99, 0x87, 0x97, 0x6e, 0x4e, 0xf7, 0x33, 0xb5, 0xe5,
+		0x53, 0x64, 0xd7, 0x6c, 0xdf, 0xaf, 0x8e, 0xfc, 0x45, 0xba, 0xed, 0x36, 0x59, 0x15, 0x9b, 0xd8,
+		0xd1, 0x9d, 0x3d, 0x6d, 0x93, 0x75, 0xb5, 0xf5, 0xd6, 0xe9, 0xb6, 0xde, 0x7b, 0xfa, 0x61, 0x68,
+		0x81, 0x09, 0x40, 0xda, 0x08, 0xa3, 0xc7, 0xc8, 0x43, 0xc8, 0xc4, 0x0d, 0xf5, 0xb6, 0xe4, 0xdb,
+		0xf4, 0x07, 0xe6, 0xac, 0x7a, 0xe7, 0x42, 0xe9, 0x17, 0x7a, 0x9a, 0x1f, 0x0c, 0x63, 0xe7, 0x55,
+		0xfd, 0x13, 0x98, 0x43, 0xc5, 0x99, 0xde, 0x0c, 0x8e, 0x1d, 0x24, 0x5b, 0x27, 0x30, 0xec, 0x38,
+		0x69, 0x05, 0x0e, 0x52, 0xe4, 0x26, 0x8f, 0x44, 0x84, 0x15, 0x4e, 0xb5, 0x13, 0x73, 0x49, 0x73,
+		0xdc, 0xb0, 0x9f, 0xc2, 0x45, 0x1e, 0x37, 0x5a, 0x72, 0x1c, 0xb4, 0x10, 0x3a, 0xe0, 0x9b, 0xea,
+		0x6d, 0xeb, 0x2d, 0xc0, 0x8a, 0x9e, 0x80, 0x41, 0x7f, 0xb8, 0x70, 0xf8, 0x3f, 0x97, 0xea, 0x7e,
+		0xb2, 0x08, 0x32, 0xa3, 0x2d, 0x38, 0xe8, 0xaf, 0x07, 0x82, 0xa8, 0xec, 0x7f, 0x70, 0xdd, 0xd7,
+		0x43, 0x44, 0x85, 0xc3, 0x4f, 0x34, 0x5a, 0xb3, 0x48, 0xac, 0x66, 0x38, 0x38, 0x36, 0x3a, 0x39,
+		0xf1, 0x8c, 0x6c, 0xf7, 0x83, 0x6b, 0x18, 0x80, 0xfd, 0xdf, 0x9f, 0xba, 0x65, 0xbb, 0xb8, 0x92,
+		0x4b, 0xf3, 0x77, 0xd1, 0xf8, 0x37, 0x7a, 0x0e, 0x0e, 0x46, 0x7b, 0xe0, 0x99, 0xce, 0x71, 0xa2,
+		0x76, 0xde, 0x91, 0x68, 0xd6, 0x72, 0x84, 0x43, 0x2e, 0xce, 0xf2, 0x85, 0x56, 0xc6, 0x40, 0x57,
+		0xc6, 0xf4, 0x2c, 0xdf, 0x86, 0xbf, 0x3a, 0x96, 0xb7, 0x01, 0xb5, 0xda, 0x4c, 0xf8, 0x02, 0x9d,
+		0xd4, 0xd5, 0x05, 0x3a, 0x72, 0x86, 0x28, 0x78, 0x06, 0x99, 0x7d, 0xf8, 0x6e, 0xc6, 0xbe, 0x8f,
+		0x31, 0x5f, 0x4b, 0xc0, 0xa9, 0xe0, 0xfe, 0xf5, 0xf3, 0x0d, 0x6c, 0xef, 0x7a, 0x43, 0x42, 0x5d,
+		0xab, 0xea, 0x66, 0xf0, 0x9a, 0xd6, 0x91, 0xa0, 0xcb, 0x41, 0x69, 0x85, 0x8e, 0xe5, 0x77, 0x49,
+		0x30, 0xb8, 0xaa, 0x55, 0xb1, 0x82, 0x9f, 0x6f, 0x60, 0xc7, 0x8d, 0xb8, 0x06, 0x43, 0xae, 0xa8,
+		0x6c, 0x6d, 0x89, 0x43, 0x37, 0x29, 0x85, 0x7f, 0x91, 0x3a, 0x1b, 0x3a, 0x39, 0x18, 0x94, 0xa4,
+		0xc9, 0xec, 0x83, 0x2c, 0x2f, 0x69, 0x6c, 0x81, 0x8d, 0x0b, 0xb9, 0x94, 0x78, 0x61, 0xaa, 0x61,
+		0xb2, 0x2e, 0x4e, 0x76, 0x0d, 0x6d, 0x4c, 0x0e, 0xc7, 0x32, 0x17, 0x2e, 0xad, 0x88, 0x4f, 0xf9,
+		0x71, 0x18, 0x62, 0x92, 0x70, 0x07, 0xe0, 0x08, 0xa4, 0xe9, 0x51, 0x50, 0x5f, 0x9e, 0x01, 0xf2,
+		0x7d, 0x99, 0x5d, 0xa6, 0x61, 0xf8, 0x4c, 0x24, 0xf6, 0x51, 0x2c, 0xb6, 0xd5, 0xf2, 0xc9, 0xf8,
+		0xa1, 0x88, 0xe9, 0xd0, 0xd3, 0xf0, 0xef, 0xf7, 0xc1, 0x41, 0xe6, 0x5a, 0x9e, 0xd6, 0xea, 0xfa,
+		0xe9, 0x6d, 0xd7, 0x15, 0x97, 0xbb, 0x80, 0x25, 0xcf, 0x68, 0x75, 0x5d, 0xde, 0x85, 0xd4, 0x25,
+		0xd7, 0xad, 0xa3, 0x53, 0xd0, 0x67, 0x37, 0x0c, 0x2c, 0xa2, 0xdc, 0x9e, 0x17, 0xac, 0xd5, 0xf5,
+		0x19, 0x42, 0xa0, 0x34, 0x0c, 0xac, 0x30, 0x12, 0x54, 0x82, 0xa9, 0xad, 0x86, 0x61, 0xec, 0x92,
+		0x7f, 0x1b, 0x67, 0x55, 0xb0, 0xea, 0xfd, 0x9b, 0x1d, 0x7c, 0xa3, 0xae, 0x89, 0xc7, 0x7a, 0x89,
+		0x62, 0x8e, 0x51, 0xb2, 0x79, 0x4a, 0x25, 0xfe, 0xc5, 0x4e, 0x49, 0xd0, 0xc8, 0x7f, 0x96, 0x80,
+		0xb4, 0x80, 0x26, 0xbd, 0xcf, 0xc1, 0x06, 0x2e, 0xbb, 0x96, 0xd8, 0x06, 0xf6, 0xbe, 0x11, 0x82,
+		0x64, 0x95, 0x37, 0x5e, 0xe6, 0xd2, 0x01, 0x85, 0x7c, 0x90, 0x34, 0xef, 0xce, 0x11, 0x49, 0x23,
+		0x57, 0x91, 0x26, 0x20, 0x55, 0xb7, 0x44, 0x94, 0xea, 0xd2, 0x01, 0x85, 0x7e, 0xa1, 0x1c, 0xf4,
+		0x93, 0x6e, 0xee, 0xb2, 0xd6, 0x22, 0xe9, 0xfc, 0x1b, 0x1d, 0x22, 0xfb, 0x24, 0x6e, 0x99, 0x1d,
+		0x07, 0x26, 0x19, 0xec, 0x13, 0x3d, 0x02, 0xfd, 0xec, 0x2d, 0x8a, 0xe6, 0xff, 0xc0, 0x45, 0x94,
+		0xc1, 0x1e, 0xfd, 0x24, 0x72, 0xaf, 0x6a, 0xae, 0x8b, 0x6d, 0x93, 0x00, 0x32, 0x72, 0x72, 0x64,
+		0x69, 0xd3, 0xaa, 0xec, 0xf2, 0xff, 0x0a, 0x46, 0x7f, 0xf3, 0x7f, 0x43, 0x44, 0xed, 0x41, 0xa5,
+		0x99, 0xec, 0x9f, 0x21, 0x0e, 0x89, 0xc4, 0x22, 0x21, 0x2a, 0xc1, 0xb8, 0x56, 0xa9, 0xe8, 0xec,
+		0x1f, 0x74, 0xa9, 0x9b, 0x3a, 0xed, 0xdf, 0x4e, 0x6e, 0xb0, 0x43, 0x5b, 0x20, 0x9f, 0xa1, 0xc8,
+		0xe9, 0x8b, 0x19, 0xf2, 0x4f, 0x39, 0xa9, 0x50, 0xf2, 0x05, 0x18, 0x6b, 0x91, 0x94, 0xc8, 0xb7,
+		0xa3, 0x9b, 0x15, 0x71, 0x11, 0x8b, 0xfc, 0x26, 0x69, 0xf4, 0x99, 0x5e, 0xb6, 0xc1, 0x4e, 0x7f,
+		0x17, 0x7f, 0xb6, 0xfd, 0x7d, 0xbd, 0x91, 0xc0, 0x7d, 0x3d, 0xad, 0xae, 0x17, 0x33, 0x14, 0x9f,
+		0xdf, 0xd2, 0x9b, 0x6d, 0xbd, 0xa5, 0x57, 0xc5, 0xa6, 0x70, 0x04, 0x48, 0x96, 0x56, 0xd7, 0x1d,
+		0x6a, 0x8e, 0xfe, 0xb3, 0xc1, 0xce, 0x85, 0xc0, 0x6f, 0x7a, 0x69, 0x2f, 0xb5, 0x30, 0xbb, 0xba,
+		0xe8, 0xd9, 0xf1, 0x17, 0x13, 0x70, 0x2c, 0x60, 0xc7, 0x01, 0xe2, 0x56, 0x73, 0xce, 0x47, 0x5b,
+		0x7c, 0x17, 0x2f, 0x32, 0x5c, 0x86, 0x14, 0xa1, 0x47, 0x31, 0xff, 0x24, 0x28, 0xf7, 0x89, 0x2f,
+		0x7f, 0x5e, 0x9e, 0x96, 0xda, 0xb6, 0x0a, 0x05, 0x29, 0xfe, 0x7c, 0xf7, 0xfa, 0xcb, 0xfa, 0x2f,
+		0x26, 0x3b, 0xfb, 0xa7, 0xc6, 0x66, 0x1d, 0xbe, 0xa2, 0xb4, 0xbd, 0x76, 0xcf, 0x06, 0xd3, 0xce,
+		0xfe, 0x5c, 0x0f, 0x23, 0x75, 0xbb, 0xbb, 0x4b, 0x9d, 0x5a, 0x30, 0xf6, 0x24, 0xd3, 0x2d, 0x7b,
+		0x8e, 0x37, 0xe0, 0xd0, 0x93, 0x44, 0x6c, 0x3f, 0x8a, 0x28, 0x66, 0x8b, 0x43, 0xde, 0xc1, 0x08,
+		0x89, 0x3f, 0x62, 0x41, 0xbf, 0xd0, 0x45, 0x00, 0xbf, 0x6a, 0x7c, 0xe1, 0x7b, 0xcf, 0x4c, 0xdb,
+		0x59, 0x68, 0x26, 0x30, 0x03, 0x29, 0x01, 0x4e, 0xf9, 0xd7, 0x25, 0x38, 0xdc, 0x52, 0x34, 0x9f,
+		0x1e, 0x16, 0x22, 0x6e, 0x68, 0xed, 0xc9, 0x81, 0x5b, 0x88, 0x10, 0xf6, 0x44, 0xac, 0xb0, 0x4c,
+		0x8a, 0x90, 0xb4, 0x3f, 0x0d, 0xc7, 0x9b, 0x84, 0x2d, 0xee, 0x52, 0x9f, 0xe2, 0x8d, 0x52, 0xd7,
+		0x6f, 0x4b, 0x30, 0xd9, 0x4e, 0x02, 0xae, 0xb5, 0xe5, 0x08, 0xad, 0xb5, 0x3d, 0xe8, 0xa4, 0x68,
+		0xe6, 0x0e, 0xae, 0xbc, 0x21, 0xba, 0x33, 0x60, 0xb4, 0xa9, 0x34, 0x54, 0x82, 0x8c, 0x57, 0x12,
+		0x5f, 0xa2, 0x74, 0xdd, 0xbe, 0x3e, 0x27, 0x19, 0xa2, 0x6d, 0xcd, 0xdc, 0xe1, 0xae, 0x02, 0xfd,
+		0x2d, 0x3f, 0x0d, 0x07, 0xc3, 0x7a, 0x12, 0x2d, 0xf4, 0x38, 0x8c, 0x84, 0xe3, 0x6e, 0xb1, 0x3e,
+		0xe0, 0x70, 0x28, 0xe8, 0x26, 0xab, 0xcd, 0x7d, 0xc5, 0xd3, 0xfc, 0xfe, 0x54, 0x87, 0x74, 0x89,
+		0xe9, 0x70, 0x09, 0x01, 0xc7, 0x7e, 0xbf, 0xaa, 0xb1, 0x6f, 0x16, 0xf9, 0x2d, 0x09, 0xee, 0xe8,
+		0x20, 0x2d, 0x57, 0xcd, 0x0b, 0x30, 0x11, 0x88, 0x5f, 0x89, 0xb9, 0x5d, 0x98, 0xe7, 0xa9, 0xf8,
+		0xb5, 0x94, 0x17, 0xa0, 0x39, 0x4a, 0xd4, 0xf5, 0xca, 0xd7, 0xa6, 0xc6, 0x5b, 0xf3, 0x1c, 0x65,
+		0xbc, 0x35, 0xca, 0xb4, 0x8f, 0x16, 0xfc, 0xaa, 0x04, 0xf7, 0x86, 0xab, 0x1a, 0xb1, 0x2a, 0x7b,
+		0xf3, 0xb5, 0xd0, 0x9f, 0x4a, 0x70, 0xaa, 0x1b, 0xb1, 0x79, 0x53, 0x6d, 0xc2, 0xb8, 0xbf, 0xb4,
+		0x6a, 0x6e, 0xa9, 0x3d, 0xac, 0x4f, 0x91, 0x87, 0x76, 0x1b, 0x9a, 0xa4, 0xdc, 0xdc, 0x55, 0x96,
+		0xf4, 0xe7, 0x1b, 0x7a, 0x85, 0x3e, 0xda, 0xb2, 0x6f, 0x3d, 0xfe, 0xef, 0x27, 0xe0, 0x8e, 0x0e,
+		0xa5, 0x70, 0xbd, 0xf9, 0xe7, 0x44, 0xa4, 0xfd, 0x3b, 0x27, 0x42, 0x7c, 0xed, 0xc0, 0x4b, 0x48,
+		0x5d, 0x04, 0x97, 0x39, 0x39, 0x7a, 0x1a, 0xd2, 0xde, 0xe6, 0xd1, 0x7e, 0x1c, 0x5c, 0xf1, 0xd0,
+		0xc8, 0x81, 0x2f, 0x36, 0x02, 0x06, 0x7b, 0xa0, 0xa7, 0xea, 0xf0, 0xa6, 0x71, 0xbc, 0xaa, 0x43,
+		0x3b, 0xc6, 0x11, 0x6d, 0x95, 0xe8, 0xa9, 0xad, 0x02, 0x81, 0xff, 0x6b, 0x70, 0xb8, 0x45, 0x4a,
+		0xde, 0x54, 0x3f, 0x06, 0xe3, 0x11, 0xa3, 0x11, 0x1f, 0xb2, 0x7b, 0x18, 0x8c, 0x14, 0xd4, 0x3a,
+		0xde, 0xc8, 0xbf, 0x21, 0xc1, 0x14, 0x2d, 0x38, 0xa2, 0x4b, 0xbc, 0x19, 0xf5, 0x54, 0x83, 0xe9,
+		0xf6, 0xe2, 0x72, 0x85, 0x2d, 0x42, 0x3f, 0xeb, 0xc5, 0x5c, 0x47, 0x7b, 0x18, 0x06, 0x38, 0x80,
+		0xfc, 0x69, 0x31, 0xbb, 0xcd, 0x8b, 0x0a, 0x45, 0x8f, 0x9d, 0xb7, 0xa6, 0x9f, 0x7d, 0x1a, 0x3b,
+		0x03, 0x6a, 0xfa, 0xaa, 0x98, 0xe7, 0xa2, 0xe5, 0xe6, 0x8a, 0x2a, 0xef, 0xdb, 0x3c, 0xc7, 0x23,
+		0x60, 0xb7, 0x75, 0x42, 0xfb, 0x94, 0xf0, 0x26, 0xbd, 0x3a, 0x79, 0xdb, 0xb1, 0x6f, 0xe2, 0x96,
+		0xf8, 0xac, 0xe8, 0x60, 0x51, 0x52, 0x7b, 0x4e, 0x70, 0xc6, 0xdf, 0xa3, 0x96, 0x3a, 0x1f, 0xee,
+		0x68, 0x81, 0x11, 0x2e, 0x99, 0x87, 0xb0, 0x7f, 0x1a, 0xff, 0x48, 0x12, 0xc6, 0x5a, 0xca, 0xdb,
+		0xaf, 0xbd, 0xe0, 0xc0, 0x4d, 0x81, 0x44, 0xf8, 0xa6, 0x80, 0x7f, 0xbc, 0x3d, 0xd9, 0xf3, 0xf1,
+		0x76, 0xff, 0xc8, 0x7c, 0x2a, 0x74, 0x64, 0x3e, 0x7c, 0xe4, 0xba, 0xef, 0x16, 0x8e, 0x5c, 0xfb,
+		0x33, 0x67, 0xff, 0x3e, 0xce, 0x9c, 0x81, 0x7d, 0xd9, 0x81, 0xde, 0xf6, 0x65, 0xe5, 0xdf, 0x15,
+		0x8e, 0x9e, 0xd7, 0x54, 0x31, 0x8e, 0xde, 0x9b, 0xad, 0x8b, 0xbc, 0x9c, 0xe0, 0x2e, 0x5f, 0x4c,
+		0x05, 0xfe, 0x16, 0xba, 0x7c, 0xa8, 0x44, 0xae, 0x5b, 0xb8, 0x9a, 0x21, 0xfe, 0xbf, 0xf6, 0x89,
+		0x58, 0xf9, 0x68, 0x58, 0xda, 0xdb, 0xdd, 0x62, 0xcc, 0xf2, 0xe7, 0x25, 0x18, 0x6d, 0xa2, 0x40,
+		0x1b, 0x11, 0x4b, 0xe7, 0xd3, 0xb1, 0x2b, 0xb8, 0x30, 0x4a, 0xc4, 0x12, 0x5a, 0x09, 0xc6, 0xb2,
+		0x6f, 0x75, 0x73, 0x9d, 0x41, 0x91, 0x40, 0xc0, 0xe1, 0x36, 0x12, 0xec, 0xdf, 0xd1, 0x92, 0xd0,
+		0xc1, 0x86, 0xfd, 0x3a, 0x15, 0x20, 0xff, 0x6e, 0x02, 0x8e, 0x50, 0xe3, 0x0c, 0xee, 0x39, 0xed,
+		0x67, 0x6f, 0x42, 0xe4, 0x9c, 0x61, 0x8f, 0xee, 0x51, 0xd6, 0xb1, 0xcb, 0x57, 0x9b, 0x96, 0x5f,
+		0xa8, 0xe2, 0xb8, 0xcd, 0x38, 0x71, 0x07, 0x0d, 0xb3, 0x95, 0xc0, 0x76, 0x53, 0x44, 0xef, 0x4e,
+		0xed, 0x43, 0xef, 0xfe, 0x8a, 0x04, 0xf9, 0x28, 0x05, 0xf2, 0xde, 0xac, 0xc3, 0xa1, 0xd0, 0x69,
+		0x91, 0xe6, 0x0e, 0xfd, 0x96, 0x6e, 0xf6, 0x00, 0x9b, 0xfc, 0x90, 0x83, 0x36, 0xbe, 0xdd, 0x4b,
+		0xeb, 0xa6, 0x39, 0xbd, 0x35, 0x14, 0xf9, 0x26, 0x1c, 0x67, 0x5f, 0x6d, 0x71, 0x66, 0xff, 0x56,
+		0x84, 0x31, 0x3f, 0xde, 0xe2, 0xf7, 0x45, 0x85, 0xc9, 0xde, 0x34, 0x2b, 0x94, 0xed, 0xb6, 0xb6,
+		0xb1, 0xdf, 0xa1, 0xb7, 0x06, 0xef, 0x58, 0xe1, 0x8b, 0xbf, 0x81, 0xe0, 0x6e, 0xe4, 0xcb, 0x21,
+		0xb7, 0x5a, 0x55, 0xf9, 0x19, 0x38, 0x1a, 0x59, 0x2c, 0xaf, 0x5c, 0x01, 0x52, 0xe4, 0x54, 0x6e,
+		0x4e, 0x0a, 0x5b, 0x6c, 0x73, 0xbd, 0x9a, 0xb8, 0x29, 0x8f, 0xfc, 0x73, 0xa2, 0x63, 0xf9, 0xb9,
+		0x2d, 0x6d, 0x7d, 0xbb, 0xea, 0x15, 0x68, 0xc2, 0x9f, 0x86, 0xe9, 0xf6, 0x52, 0xec, 0x6b, 0x1b,
+		0x46, 0xef, 0xd4, 0xcb, 0x08, 0xb2, 0x54, 0x00, 0x72, 0x8c, 0x8b, 0xd7, 0x5b, 0xbe, 0x0c, 0x63,
+		0x81, 0x34, 0x2e, 0xc5, 0x39, 0xb2, 0x49, 0x6a, 0x19, 0xde, 0x3b, 0x65, 0xed, 0x4e, 0xcc, 0x58,
+		0x96, 0x98, 0xea, 0x29, 0xbd, 0x3c, 0x01, 0x88, 0x81, 0xd1, 0xc3, 0x33, 0xa2, 0x88, 0x35, 0x18,
+		0x0f, 0xa5, 0xf2, 0x42, 0x6e, 0xe9, 0x60, 0xce, 0x99, 0x8f, 0x1f, 0x83, 0x3e, 0x8a, 0x8a, 0xde,
+		0x2f, 0x85, 0x5e, 0x06, 0x9e, 0x69, 0x07, 0x13, 0xbd, 0xb9, 0x93, 0x3f, 0xdd, 0x35, 0x3d, 0x8f,
+		0x6d, 0x9c, 0xfa, 0xd9, 0x7f, 0xf7, 0xcd, 0xf7, 0x26, 0xee, 0x42, 0xf2, 0xe9, 0x36, 0x3b, 0x4e,
+		0x81, 0xd1, 0xea, 0xb7, 0x24, 0x18, 0x6b, 0xd9, 0xa5, 0x40, 0x67, 0xbb, 0x2c, 0x32, 0xbc, 0xaf,
+		0x92, 0x3f, 0xd7, 0x2b, 0x1b, 0x17, 0xf8, 0x21, 0x2a, 0xf0, 0xfd, 0xe8, 0xbe, 0x78, 0x81, 0xd5,
+		0xcd, 0x5d, 0x76, 0x0c, 0x09, 0x7d, 0x2c, 0xf4, 0xea, 0xde, 0xfd, 0xdd, 0x15, 0x2d, 0x24, 0x9d,
+		0xe9, 0x96, 0x9c, 0x4b, 0x78, 0x81, 0x4a, 0x78, 0x16, 0x3d, 0x14, 0x2f, 0xe1, 0xe9, 0x9f, 0x0c,
+		0x77, 0xc7, 0x77, 0xa0, 0x7f, 0x2f, 0xc1, 0x44, 0x54, 0xdc, 0x1d, 0x9d, 0xef, 0x4e, 0x8a, 0xd6,
+		0xd5, 0x4c, 0xfe, 0xd1, 0x3d, 0x70, 0xf2, 0xaa, 0x2c, 0xd0, 0xaa, 0xcc, 0xa2, 0xc7, 0xf7, 0x50,
+		0x95, 0xd3, 0xc1, 0xa3, 0x46, 0xff, 0x47, 0x82, 0xe3, 0x1d, 0x83, 0xd5, 0x68, 0xb6, 0x3b, 0x29,
+		0x3b, 0x2c, 0xdb, 0xf2, 0xc5, 0x5b, 0x81, 0xe0, 0x35, 0x7e, 0x92, 0xd6, 0xf8, 0x32, 0x5a, 0xdc,
+		0x4b, 0x8d, 0x23, 0xcf, 0x81, 0xa1, 0xff, 0x10, 0x6c, 0xd2, 0x40, 0x9c, 0xb9, 0xdb, 0x26, 0x6d,
+		0x0d, 0x80, 0xe7, 0x1f, 0xdd, 0x03, 0x27, 0xaf, 0xe0, 0x25, 0x5a, 0xc1, 0x22, 0x7a, 0xfb, 0x5e,
+		0x2a, 0x68, 0x50, 0x40, 0xd5, 0xa1, 0xe2, 0xff, 0x41, 0xf8, 0x8e, 0x67, 0xe7, 0x6e, 0xd2, 0x12,
+		0x31, 0xcd, 0x9f, 0xee, 0x9a, 0x9e, 0x4b, 0xfe, 0x34, 0x95, 0x5c, 0x41, 0xab, 0xb7, 0x68, 0x8c,
+		0xa7, 0x7f, 0x32, 0xec, 0x07, 0xbd, 0x03, 0xfd, 0x2f, 0x29, 0xfa, 0xb2, 0xe6, 0x23, 0x1d, 0x45,
+		0x6c, 0x1f, 0x0d, 0xce, 0x9f, 0xef, 0x9d, 0x91, 0x57, 0xb2, 0x46, 0x2b, 0x59, 0x45, 0x78, 0xbf,
+		0x2b, 0x19, 0x69, 0x9c, 0xe8, 0x0f, 0x25, 0x98, 0x88, 0x0a, 0x7f, 0xc6, 0xd8, 0x66, 0x87, 0x48,
+		0x6f, 0x8c, 0x6d, 0x76, 0x8a, 0xb5, 0xca, 0x8f, 0xd1, 0xca, 0x9f, 0x43, 0x0f, 0xb7, 0xab, 0x7c,
+		0xc7, 0x56, 0xfc, 0x37, 0xfe, 0xbd, 0x80, 0x40, 0x00, 0x11, 0x9d, 0xeb, 0x4e, 0x9e, 0xe6, 0x38,
+		0x69, 0xfe, 0x91, 0x9e, 0xf9, 0x78, 0x2d, 0x4a, 0xb4, 0x16, 0x8f, 0xa3, 0xb7, 0xc6, 0xd4, 0x82,
+		0x36, 0x61, 0x73, 0x2b, 0xf9, 0x11, 0x4a, 0x32, 0x64, 0x76, 0x0c, 0xf6, 0xc4, 0x0c, 0x99, 0xdd,
+		0x44, 0xba, 0x62, 0x86, 0xcc, 0xae, 0x62, 0x4d, 0xf1, 0x43, 0x66, 0xa7, 0xfa, 0x46, 0x0f, 0x99,
+		0x5f, 0x94, 0x60, 0x38, 0xb4, 0x14, 0x46, 0x0f, 0x76, 0x14, 0x34, 0x2a, 0xee, 0x90, 0x3f, 0xd3,
+		0x0b, 0x0b, 0xaf, 0xcb, 0x22, 0xad, 0xcb, 0x1c, 0x9a, 0xdd, 0x4b, 0x5d, 0xc2, 0xa7, 0x6b, 0xbf,
+		0x22, 0xc1, 0x78, 0xc4, 0x22, 0x12, 0x75, 0x69, 0x57, 0xad, 0xbe, 0xdd, 0xf9, 0xde, 0x19, 0x79,
+		0xad, 0x2e, 0xd2, 0x5a, 0xbd, 0x1d, 0xbd, 0x6d, 0x2f, 0xb5, 0x0a, 0x38, 0x80, 0xaf, 0x05, 0x7b,
+		0x98, 0xef, 0x4f, 0x9d, 0xeb, 0x51, 0xb0, 0x1e, 0x7b, 0x58, 0xab, 0x87, 0xf5, 0x14, 0xad, 0xcf,
+		0x93, 0x68, 0xe5, 0xd6, 0xea, 0xd3, 0xea, 0x7d, 0x7d, 0xaa, 0xf5, 0x89, 0xa8, 0xce, 0x56, 0x14,
+		0xb9, 0xac, 0xcc, 0x3f, 0xd4, 0x13, 0x0f, 0xaf, 0xd4, 0x79, 0x5a, 0xa9, 0x33, 0xe8, 0x81, 0x76,
+		0x95, 0x0a, 0xdc, 0xf0, 0xd4, 0xcd, 0x2d, 0xeb, 0xf4, 0x4f, 0xb2, 0x45, 0xdd, 0x3b, 0x48, 0xb3,
+		0x8c, 0x47, 0x2c, 0xc3, 0x62, 0x2c, 0xad, 0xfd, 0xf2, 0x31, 0x7f, 0xbe, 0x77, 0x46, 0x5e, 0x89,
+		0x75, 0x5a, 0x89, 0x2b, 0x68, 0xa9, 0xd7, 0x4a, 0x74, 0x6c, 0x96, 0x9f, 0x91, 0xf8, 0x6d, 0x9d,
+		0x93, 0x1d, 0x05, 0x0b, 0xac, 0x04, 0xf3, 0xf7, 0x76, 0x41, 0xc9, 0x65, 0xbe, 0x8b, 0xca, 0x3c,
+		0x89, 0x8e, 0xb5, 0x93, 0x99, 0xac, 0x06, 0xd1, 0xbb, 0x25, 0xef, 0x06, 0xf0, 0xa9, 0xce, 0xd8,
+		0xc1, 0xe5, 0x62, 0xfe, 0xbe, 0xae, 0x68, 0xb9, 0x24, 0xf7, 0x50, 0x49, 0xa6, 0xd1, 0x64, 0x5b,
+		0x49, 0xd8, 0xe2, 0x71, 0xbf, 0x8f, 0xa6, 0xff, 0xde, 0x1d, 0x30, 0xd5, 0xa6, 0x44, 0xf7, 0x46,
+		0xcc, 0x49, 0xc9, 0x0e, 0x4f, 0xc1, 0xc5, 0x3e, 0xf5, 0xb6, 0xdf, 0xff, 0xdc, 0xa8, 0xbb, 0x63,
+		0x93, 0xf2, 0xa7, 0x53, 0x80, 0x96, 0x9d, 0xea, 0x9c, 0x8d, 0x35, 0x37, 0xf0, 0xa4, 0x79, 0xd3,
+		0xb3, 0x49, 0xd2, 0x2d, 0x3d, 0x9b, 0xb4, 0x1c, 0xda, 0x4b, 0x4b, 0xf4, 0xf6, 0x38, 0x59, 0xd7,
+		0x6f, 0x18, 0x25, 0x6f, 0xcf, 0x1b, 0x46, 0x91, 0x2f, 0x05, 0xa4, 0xf6, 0xe7, 0x91, 0x92, 0xbe,
+		0x9e, 0x77, 0x2d, 0x2e, 0x42, 0x3f, 0xbf, 0xaf, 0xda, 0xbf, 0xa7, 0xfb, 0xaa, 0x9c, 0x1b, 0x9d,
+		0x15, 0xff, 0xb8, 0xa7, 0xcb, 0xcd, 0x43, 0x46, 0x1d, 0x08, 0x79, 0x1d, 0x83, 0x7c, 0xab, 0xd9,
+		0x78, 0x9d, 0xf7, 0x87, 0x09, 0xc8, 0x2e, 0x3b, 0xd5, 0x52, 0x45, 0x77, 0x6f, 0x93, 0x4d, 0xed,
+		0xd3, 0xa3, 0x2f, 0x1a, 0x8c, 0x36, 0x5f, 0xed, 0x67, 0x76, 0x74, 0x7e, 0xcf, 0x3b, 0xb4, 0x23,
+		0xe1, 0x37, 0xf4, 0xd0, 0x76, 0xb4, 0xb9, 0xa6, 0x7a, 0x2a, 0xa6, 0x1b, 0x53, 0x0d, 0xb4, 0x4e,
+		0x1e, 0x72, 0xcd, 0xea, 0xf7, 0xda, 0xe6, 0x35, 0x09, 0x06, 0x97, 0x1d, 0xe1, 0xbd, 0xe2, 0x37,
+		0xd9, 0x2b, 0x3c, 0xfe, 0x89, 0xb0, 0x64, 0x4f, 0x27, 0xc2, 0x02, 0x95, 0x3f, 0x08, 0xe3, 0x81,
+		0xfa, 0x79, 0xf5, 0xfe, 0x5c, 0x82, 0x8e, 0x74, 0x45, 0x5c, 0xd5, 0x4d, 0xcf, 0xe1, 0xc5, 0x3f,
+		0x0a, 0x2f, 0x81, 0xf8, 0x3a, 0x4d, 0xed, 0x55, 0xa7, 0x3b, 0x90, 0x6f, 0xd5, 0x5d, 0xe0, 0x3c,
+		0x4a, 0xcb, 0xf5, 0x76, 0x69, 0xef, 0xd7, 0xdb, 0xe5, 0xaf, 0x4b, 0x30, 0xbc, 0xec, 0x54, 0x37,
+		0xcc, 0xfd, 0x6e, 0xa4, 0x37, 0x8f, 0x8d, 0x6e, 0xc1, 0xc1, 0x50, 0x0d, 0x6f, 0x97, 0x2a, 0xcb,
+		0x90, 0x0d, 0x95, 0x33, 0x6b, 0x18, 0xfb, 0xa4, 0xcc, 0x40, 0x65, 0x5e, 0x91, 0x20, 0xd7, 0x5c,
+		0x8a, 0x57, 0xa1, 0x27, 0xfc, 0x6b, 0xeb, 0x31, 0xc7, 0xc4, 0x42, 0xfc, 0x51, 0xef, 0xd0, 0xa0,
+		0x22, 0x1c, 0xb7, 0x71, 0x4d, 0xd3, 0x4d, 0xb2, 0x46, 0x6e, 0x69, 0x49, 0x7e, 0x31, 0x3e, 0xa3,
+		0x1c, 0xf5, 0x88, 0xae, 0x36, 0x35, 0x1d, 0x76, 0xe4, 0x6f, 0x48, 0x80, 0x5a, 0x4b, 0xda, 0xaf,
+		0x53, 0x05, 0x7b, 0x3e, 0xd0, 0x1a, 0xd1, 0xee, 0xc9, 0x5b, 0x68, 0xf7, 0xf7, 0x25, 0xe0, 0x18,
+		0x99, 0x9f, 0xc9, 0x91, 0x04, 0xe3, 0xcd, 0xff, 0xf6, 0xda, 0x5e, 0x7b, 0x54, 0xd4, 0xfb, 0x5b,
+		0xa9, 0xa8, 0xf7, 0xb7, 0x02, 0xd6, 0x7a, 0x0f, 0xdc, 0xd5, 0x49, 0x33, 0xde, 0x7c, 0xf1, 0x69,
+		0x89, 0xce, 0x23, 0xf4, 0x6a, 0x2e, 0xf6, 0xaf, 0xf2, 0xee, 0x97, 0xa5, 0x2c, 0x03, 0x90, 0x27,
+		0x48, 0x6e, 0xe9, 0x55, 0x93, 0x8c, 0x89, 0xaf, 0xb3, 0x0b, 0xc4, 0x81, 0xfa, 0x1d, 0x87, 0xa3,
+		0x11, 0x62, 0x8b, 0x6a, 0x9d, 0x79, 0xcf, 0x00, 0x24, 0x97, 0x9d, 0x2a, 0x7a, 0x1e, 0x46, 0x9b,
+		0x9d, 0xfe, 0xb6, 0x3d, 0xb3, 0xd5, 0xd3, 0xcb, 0x9f, 0xe9, 0x9e, 0xd6, 0x1b, 0x0a, 0x76, 0x60,
+		0x38, 0xec, 0x11, 0x9e, 0xec, 0x00, 0x12, 0xa2, 0xcc, 0x3f, 0xd0, 0x2d, 0xa5, 0x57, 0xd8, 0x8f,
+		0x43, 0x9a, 0x37, 0x2a, 0x46, 0x77, 0x76, 0xe0, 0x16, 0x44, 0xf9, 0xfb, 0xba, 0x20, 0xf2, 0xd0,
+		0x9f, 0x87, 0xd1, 0x66, 0x47, 0xa2, 0x93, 0xf6, 0x9a, 0x68, 0xf3, 0x67, 0xba, 0xa7, 0x0d, 0x1c,
+		0x63, 0x83, 0xc0, 0x8c, 0x78, 0x77, 0x07, 0x04, 0x9f, 0x2c, 0x7f, 0x7f, 0x57, 0x64, 0xc1, 0x16,
+		0x0a, 0xcf, 0x15, 0x27, 0xbb, 0xe2, 0x9f, 0x35, 0x8c, 0xfc, 0x03, 0xdd, 0x52, 0x7a, 0x85, 0xbd,
+		0x47, 0x82, 0x23, 0xed, 0x07, 0xa8, 0x87, 0x3b, 0x19, 0x58, 0x3b, 0xae, 0xfc, 0x63, 0x7b, 0xe1,
+		0xf2, 0x24, 0x72, 0x21, 0xdb, 0xd2, 0xdd, 0x3b, 0x99, 0x45, 0x33, 0x71, 0xfe, 0xa1, 0x1e, 0x88,
+		0x45, 0xa9, 0xfb, 0x1d, 0xc1, 0xf8, 0x7f, 0x03, 0x00, 0xb1, 0xe6, 0x9a, 0x0f, 0x4e, 0xb0, 0x00,
 		0x00,
 	}
 	r := bytes.NewReader(gzipped)
@@ -1998,6 +2224,12 @@ func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
 	if !this.Balance.Equal(that1.Balance) {
 		return false
 	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntry) Equal(that interface{}) bool {
@@ -2031,6 +2263,12 @@ func (this *RedelegationEntry) Equal(that interface{}) bool {
 	if !this.SharesDst.Equal(that1.SharesDst) {
 		return false
 	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -2070,6 +2308,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if this.MaxConsPubkeyRotations != that1.MaxConsPubkeyRotations {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if !this.MaxValidatorPowerFraction.Equal(that1.MaxValidatorPowerFraction) {
+		return false
+	}
+	if this.MaxUndelegateAllPositions != that1.MaxUndelegateAllPositions {
+		return false
+	}
+	if this.EnforceMinSelfDelegation != that1.EnforceMinSelfDelegation {
+		return false
+	}
+	if this.SlashFundCommunityPool != that1.SlashFundCommunityPool {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2345,6 +2601,29 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnbondingIds) > 0 {
+		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
+		var j4 int
+		for _, num := range m.UnbondingIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStaking(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x60
+	}
 	{
 		size := m.MinSelfDelegation.Size()
 		i -= size
@@ -2365,12 +2644,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintStaking(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintStaking(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2750,6 +3029,16 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.Balance.Size()
 		i -= size
@@ -2770,12 +3059,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2806,6 +3095,16 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.SharesDst.Size()
 		i -= size
@@ -2826,12 +3125,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err9 != nil {
-		return 0, err9
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2920,6 +3219,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SlashFundCommunityPool {
+		i--
+		if m.SlashFundCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EnforceMinSelfDelegation {
+		i--
+		if m.EnforceMinSelfDelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxUndelegateAllPositions != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxUndelegateAllPositions))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MaxValidatorPowerFraction.Size()
+		i -= size
+		if _, err := m.MaxValidatorPowerFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxConsPubkeyRotations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxConsPubkeyRotations))
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -2952,17 +3301,84 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsPubKeyRotationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3259,6 +3675,16 @@ func (m *Validator) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	if len(m.UnbondingIds) > 0 {
+		l = 0
+		for _, e := range m.UnbondingIds {
+			l += sovStaking(uint64(e))
+		}
+		n += 1 + sovStaking(uint64(l)) + l
+	}
 	return n
 }
 
@@ -3402,6 +3828,12 @@ func (m *UnbondingDelegationEntry) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.Balance.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
 	return n
 }
 
@@ -3420,6 +3852,12 @@ func (m *RedelegationEntry) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.SharesDst.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
 	return n
 }
 
@@ -3473,6 +3911,48 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxConsPubkeyRotations != 0 {
+		n += 1 + sovStaking(uint64(m.MaxConsPubkeyRotations))
+	}
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxValidatorPowerFraction.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxUndelegateAllPositions != 0 {
+		n += 1 + sovStaking(uint64(m.MaxUndelegateAllPositions))
+	}
+	if m.EnforceMinSelfDelegation {
+		n += 2
+	}
+	if m.SlashFundCommunityPool {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4507,6 +4987,101 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingIds = append(m.UnbondingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingIds) == 0 {
+					m.UnbondingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingIds = append(m.UnbondingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5483,14 +6058,52 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -5653,6 +6266,44 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6039,6 +6690,357 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsPubkeyRotations", wireType)
+			}
+			m.MaxConsPubkeyRotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsPubkeyRotations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorPowerFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUndelegateAllPositions", wireType)
+			}
+			m.MaxUndelegateAllPositions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUndelegateAllPositions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceMinSelfDelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceMinSelfDelegation = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFundCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashFundCommunityPool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -21,3 +21,17 @@ func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *storetypes.StoreUpgr
 		return baseapp.DefaultStoreLoader(ms)
 	}
 }
+
+// PlanStoreLoader returns the StoreLoader applying the store upgrades of the
+// given plan, as read from the upgrade info written to disk by the old binary,
+// with storeUpgrades mapping the names of the plans to their store upgrades,
+// e.g. the stores of renamed modules. It loads the stores without upgrades if
+// the plan has none.
+func PlanStoreLoader(plan Plan, storeUpgrades map[string]*storetypes.StoreUpgrades) baseapp.StoreLoader {
+	upgrades, ok := storeUpgrades[plan.Name]
+	if !ok || plan.Height == 0 {
+		return baseapp.DefaultStoreLoader
+	}
+
+	return UpgradeStoreLoader(plan.Height, upgrades)
+}
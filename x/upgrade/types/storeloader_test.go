@@ -108,6 +108,19 @@ func TestSetLoader(t *testing.T) {
 			origStoreKey: "foo",
 			loadStoreKey: "bar",
 		},
+		"rename with the store upgrades of the plan": {
+			setLoader: func(app *baseapp.BaseApp) {
+				app.SetStoreLoader(PlanStoreLoader(*upgradeInfo, map[string]*storetypes.StoreUpgrades{
+					"other": {Deleted: []string{"foo"}},
+					"test": {Renamed: []storetypes.StoreRename{{
+						OldKey: "foo",
+						NewKey: "bar",
+					}}},
+				}))
+			},
+			origStoreKey: "foo",
+			loadStoreKey: "bar",
+		},
 	}
 
 	k := []byte("key")